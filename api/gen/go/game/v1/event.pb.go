@@ -559,6 +559,119 @@ func (x *ProjectionField) GetValue() string {
 	return ""
 }
 
+// SubscribeEventsRequest describes the parameters for a live event subscription.
+type SubscribeEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required: scope to a campaign.
+	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Resume cursor: only events with seq greater than this value are delivered.
+	// Use 0 to replay from the start of the journal, or the seq of the last
+	// received event to resume after a disconnect.
+	AfterSeq uint64 `protobuf:"varint,2,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"`
+	// AIP-160 filter expression using the same grammar as ListEvents.
+	// Filterable fields: session_id, type, system_id, system_version, actor_type, actor_id, entity_type, entity_id, ts.
+	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	mi := &file_game_v1_event_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_event_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SubscribeEventsRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SubscribeEventsRequest) GetAfterSeq() uint64 {
+	if x != nil {
+		return x.AfterSeq
+	}
+	return 0
+}
+
+func (x *SubscribeEventsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// SubscribeEventsResponse carries a single committed event.
+type SubscribeEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The committed event, delivered in ascending seq order.
+	Event         *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeEventsResponse) Reset() {
+	*x = SubscribeEventsResponse{}
+	mi := &file_game_v1_event_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsResponse) ProtoMessage() {}
+
+func (x *SubscribeEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_event_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SubscribeEventsResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
 // AppendEventRequest describes the input for appending a new event.
 type AppendEventRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -577,14 +690,18 @@ type AppendEventRequest struct {
 	// The ID of the entity affected.
 	EntityId string `protobuf:"bytes,7,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 	// Event-specific data as JSON.
-	PayloadJson   []byte `protobuf:"bytes,8,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PayloadJson []byte `protobuf:"bytes,8,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	// Optional optimistic concurrency check: the campaign's last event seq the
+	// caller read. A mismatch fails with ABORTED and the current seq in the
+	// EVENT_SEQ_CONFLICT error info metadata (LastSeq).
+	ExpectedLastSeq *uint64 `protobuf:"varint,9,opt,name=expected_last_seq,json=expectedLastSeq,proto3,oneof" json:"expected_last_seq,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AppendEventRequest) Reset() {
 	*x = AppendEventRequest{}
-	mi := &file_game_v1_event_proto_msgTypes[7]
+	mi := &file_game_v1_event_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -596,7 +713,7 @@ func (x *AppendEventRequest) String() string {
 func (*AppendEventRequest) ProtoMessage() {}
 
 func (x *AppendEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_event_proto_msgTypes[7]
+	mi := &file_game_v1_event_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,7 +726,7 @@ func (x *AppendEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppendEventRequest.ProtoReflect.Descriptor instead.
 func (*AppendEventRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_event_proto_rawDescGZIP(), []int{7}
+	return file_game_v1_event_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AppendEventRequest) GetCampaignId() string {
@@ -668,6 +785,13 @@ func (x *AppendEventRequest) GetPayloadJson() []byte {
 	return nil
 }
 
+func (x *AppendEventRequest) GetExpectedLastSeq() uint64 {
+	if x != nil && x.ExpectedLastSeq != nil {
+		return *x.ExpectedLastSeq
+	}
+	return 0
+}
+
 // AppendEventResponse contains the stored event.
 type AppendEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -678,7 +802,7 @@ type AppendEventResponse struct {
 
 func (x *AppendEventResponse) Reset() {
 	*x = AppendEventResponse{}
-	mi := &file_game_v1_event_proto_msgTypes[8]
+	mi := &file_game_v1_event_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -690,7 +814,7 @@ func (x *AppendEventResponse) String() string {
 func (*AppendEventResponse) ProtoMessage() {}
 
 func (x *AppendEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_event_proto_msgTypes[8]
+	mi := &file_game_v1_event_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +827,7 @@ func (x *AppendEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppendEventResponse.ProtoReflect.Descriptor instead.
 func (*AppendEventResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_event_proto_rawDescGZIP(), []int{8}
+	return file_game_v1_event_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AppendEventResponse) GetEvent() *Event {
@@ -752,7 +876,7 @@ type Event struct {
 
 func (x *Event) Reset() {
 	*x = Event{}
-	mi := &file_game_v1_event_proto_msgTypes[9]
+	mi := &file_game_v1_event_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -764,7 +888,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_event_proto_msgTypes[9]
+	mi := &file_game_v1_event_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,7 +901,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_game_v1_event_proto_rawDescGZIP(), []int{9}
+	return file_game_v1_event_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Event) GetCampaignId() string {
@@ -936,7 +1060,14 @@ const file_game_v1_event_proto_rawDesc = "" +
 	"\x06fields\x18\x04 \x03(\v2\x18.game.v1.ProjectionFieldR\x06fields\"=\n" +
 	"\x0fProjectionField\x12\x14\n" +
 	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"\x83\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"n\n" +
+	"\x16SubscribeEventsRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1b\n" +
+	"\tafter_seq\x18\x02 \x01(\x04R\bafterSeq\x12\x16\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\"?\n" +
+	"\x17SubscribeEventsResponse\x12$\n" +
+	"\x05event\x18\x01 \x01(\v2\x0e.game.v1.EventR\x05event\"\xca\x02\n" +
 	"\x12AppendEventRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12\x12\n" +
@@ -949,7 +1080,9 @@ const file_game_v1_event_proto_rawDesc = "" +
 	"\ventity_type\x18\x06 \x01(\tR\n" +
 	"entityType\x12\x1b\n" +
 	"\tentity_id\x18\a \x01(\tR\bentityId\x12!\n" +
-	"\fpayload_json\x18\b \x01(\fR\vpayloadJson\";\n" +
+	"\fpayload_json\x18\b \x01(\fR\vpayloadJson\x12/\n" +
+	"\x11expected_last_seq\x18\t \x01(\x04H\x00R\x0fexpectedLastSeq\x88\x01\x01B\x14\n" +
+	"\x12_expected_last_seq\";\n" +
 	"\x13AppendEventResponse\x12$\n" +
 	"\x05event\x18\x01 \x01(\v2\x0e.game.v1.EventR\x05event\"\xd0\x03\n" +
 	"\x05Event\x12\x1f\n" +
@@ -973,12 +1106,13 @@ const file_game_v1_event_proto_rawDesc = "" +
 	"\ventity_type\x18\r \x01(\tR\n" +
 	"entityType\x12\x1b\n" +
 	"\tentity_id\x18\x0e \x01(\tR\bentityId\x12!\n" +
-	"\fpayload_json\x18\x0f \x01(\fR\vpayloadJson2\x81\x02\n" +
+	"\fpayload_json\x18\x0f \x01(\fR\vpayloadJson2\xd9\x02\n" +
 	"\fEventService\x12H\n" +
 	"\vAppendEvent\x12\x1b.game.v1.AppendEventRequest\x1a\x1c.game.v1.AppendEventResponse\x12E\n" +
 	"\n" +
 	"ListEvents\x12\x1a.game.v1.ListEventsRequest\x1a\x1b.game.v1.ListEventsResponse\x12`\n" +
-	"\x13ListTimelineEntries\x12#.game.v1.ListTimelineEntriesRequest\x1a$.game.v1.ListTimelineEntriesResponseBCZAgithub.com/louisbranch/fracturing.space/api/gen/go/game/v1;gamev1b\x06proto3"
+	"\x13ListTimelineEntries\x12#.game.v1.ListTimelineEntriesRequest\x1a$.game.v1.ListTimelineEntriesResponse\x12V\n" +
+	"\x0fSubscribeEvents\x12\x1f.game.v1.SubscribeEventsRequest\x1a .game.v1.SubscribeEventsResponse0\x01BCZAgithub.com/louisbranch/fracturing.space/api/gen/go/game/v1;gamev1b\x06proto3"
 
 var (
 	file_game_v1_event_proto_rawDescOnce sync.Once
@@ -992,7 +1126,7 @@ func file_game_v1_event_proto_rawDescGZIP() []byte {
 	return file_game_v1_event_proto_rawDescData
 }
 
-var file_game_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_game_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_game_v1_event_proto_goTypes = []any{
 	(*ListEventsRequest)(nil),           // 0: game.v1.ListEventsRequest
 	(*ListEventsResponse)(nil),          // 1: game.v1.ListEventsResponse
@@ -1001,32 +1135,37 @@ var file_game_v1_event_proto_goTypes = []any{
 	(*TimelineEntry)(nil),               // 4: game.v1.TimelineEntry
 	(*ProjectionDisplay)(nil),           // 5: game.v1.ProjectionDisplay
 	(*ProjectionField)(nil),             // 6: game.v1.ProjectionField
-	(*AppendEventRequest)(nil),          // 7: game.v1.AppendEventRequest
-	(*AppendEventResponse)(nil),         // 8: game.v1.AppendEventResponse
-	(*Event)(nil),                       // 9: game.v1.Event
-	(*timestamppb.Timestamp)(nil),       // 10: google.protobuf.Timestamp
-	(v1.IconId)(0),                      // 11: common.v1.IconId
+	(*SubscribeEventsRequest)(nil),      // 7: game.v1.SubscribeEventsRequest
+	(*SubscribeEventsResponse)(nil),     // 8: game.v1.SubscribeEventsResponse
+	(*AppendEventRequest)(nil),          // 9: game.v1.AppendEventRequest
+	(*AppendEventResponse)(nil),         // 10: game.v1.AppendEventResponse
+	(*Event)(nil),                       // 11: game.v1.Event
+	(*timestamppb.Timestamp)(nil),       // 12: google.protobuf.Timestamp
+	(v1.IconId)(0),                      // 13: common.v1.IconId
 }
 var file_game_v1_event_proto_depIdxs = []int32{
-	9,  // 0: game.v1.ListEventsResponse.events:type_name -> game.v1.Event
+	11, // 0: game.v1.ListEventsResponse.events:type_name -> game.v1.Event
 	4,  // 1: game.v1.ListTimelineEntriesResponse.entries:type_name -> game.v1.TimelineEntry
-	10, // 2: game.v1.TimelineEntry.event_time:type_name -> google.protobuf.Timestamp
-	11, // 3: game.v1.TimelineEntry.icon_id:type_name -> common.v1.IconId
+	12, // 2: game.v1.TimelineEntry.event_time:type_name -> google.protobuf.Timestamp
+	13, // 3: game.v1.TimelineEntry.icon_id:type_name -> common.v1.IconId
 	5,  // 4: game.v1.TimelineEntry.projection:type_name -> game.v1.ProjectionDisplay
 	6,  // 5: game.v1.ProjectionDisplay.fields:type_name -> game.v1.ProjectionField
-	9,  // 6: game.v1.AppendEventResponse.event:type_name -> game.v1.Event
-	10, // 7: game.v1.Event.ts:type_name -> google.protobuf.Timestamp
-	7,  // 8: game.v1.EventService.AppendEvent:input_type -> game.v1.AppendEventRequest
-	0,  // 9: game.v1.EventService.ListEvents:input_type -> game.v1.ListEventsRequest
-	2,  // 10: game.v1.EventService.ListTimelineEntries:input_type -> game.v1.ListTimelineEntriesRequest
-	8,  // 11: game.v1.EventService.AppendEvent:output_type -> game.v1.AppendEventResponse
-	1,  // 12: game.v1.EventService.ListEvents:output_type -> game.v1.ListEventsResponse
-	3,  // 13: game.v1.EventService.ListTimelineEntries:output_type -> game.v1.ListTimelineEntriesResponse
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	11, // 6: game.v1.SubscribeEventsResponse.event:type_name -> game.v1.Event
+	11, // 7: game.v1.AppendEventResponse.event:type_name -> game.v1.Event
+	12, // 8: game.v1.Event.ts:type_name -> google.protobuf.Timestamp
+	9,  // 9: game.v1.EventService.AppendEvent:input_type -> game.v1.AppendEventRequest
+	0,  // 10: game.v1.EventService.ListEvents:input_type -> game.v1.ListEventsRequest
+	2,  // 11: game.v1.EventService.ListTimelineEntries:input_type -> game.v1.ListTimelineEntriesRequest
+	7,  // 12: game.v1.EventService.SubscribeEvents:input_type -> game.v1.SubscribeEventsRequest
+	10, // 13: game.v1.EventService.AppendEvent:output_type -> game.v1.AppendEventResponse
+	1,  // 14: game.v1.EventService.ListEvents:output_type -> game.v1.ListEventsResponse
+	3,  // 15: game.v1.EventService.ListTimelineEntries:output_type -> game.v1.ListTimelineEntriesResponse
+	8,  // 16: game.v1.EventService.SubscribeEvents:output_type -> game.v1.SubscribeEventsResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_game_v1_event_proto_init() }
@@ -1034,13 +1173,14 @@ func file_game_v1_event_proto_init() {
 	if File_game_v1_event_proto != nil {
 		return
 	}
+	file_game_v1_event_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_v1_event_proto_rawDesc), len(file_game_v1_event_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -256,16 +256,91 @@ func (x *GetGameSystemRequest) GetVersion() string {
 	return ""
 }
 
+// EventPayloadSchema is the JSON Schema appended payloads of an event type are
+// validated against.
+type EventPayloadSchema struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	EventType string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Game system that owns the event type; empty for core events.
+	SystemId string `protobuf:"bytes,2,opt,name=system_id,json=systemId,proto3" json:"system_id,omitempty"`
+	// Payload version the schema describes.
+	PayloadVersion int32 `protobuf:"varint,3,opt,name=payload_version,json=payloadVersion,proto3" json:"payload_version,omitempty"`
+	// JSON Schema (draft 2020-12) document.
+	SchemaJson    []byte `protobuf:"bytes,4,opt,name=schema_json,json=schemaJson,proto3" json:"schema_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventPayloadSchema) Reset() {
+	*x = EventPayloadSchema{}
+	mi := &file_game_v1_system_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventPayloadSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventPayloadSchema) ProtoMessage() {}
+
+func (x *EventPayloadSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_system_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventPayloadSchema.ProtoReflect.Descriptor instead.
+func (*EventPayloadSchema) Descriptor() ([]byte, []int) {
+	return file_game_v1_system_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventPayloadSchema) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *EventPayloadSchema) GetSystemId() string {
+	if x != nil {
+		return x.SystemId
+	}
+	return ""
+}
+
+func (x *EventPayloadSchema) GetPayloadVersion() int32 {
+	if x != nil {
+		return x.PayloadVersion
+	}
+	return 0
+}
+
+func (x *EventPayloadSchema) GetSchemaJson() []byte {
+	if x != nil {
+		return x.SchemaJson
+	}
+	return nil
+}
+
 type GetGameSystemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	System        *GameSystemInfo        `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	System *GameSystemInfo        `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	// Payload schemas of the core events and the events of this system.
+	EventSchemas  []*EventPayloadSchema `protobuf:"bytes,2,rep,name=event_schemas,json=eventSchemas,proto3" json:"event_schemas,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetGameSystemResponse) Reset() {
 	*x = GetGameSystemResponse{}
-	mi := &file_game_v1_system_proto_msgTypes[4]
+	mi := &file_game_v1_system_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -277,7 +352,7 @@ func (x *GetGameSystemResponse) String() string {
 func (*GetGameSystemResponse) ProtoMessage() {}
 
 func (x *GetGameSystemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_system_proto_msgTypes[4]
+	mi := &file_game_v1_system_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -290,7 +365,7 @@ func (x *GetGameSystemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGameSystemResponse.ProtoReflect.Descriptor instead.
 func (*GetGameSystemResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_system_proto_rawDescGZIP(), []int{4}
+	return file_game_v1_system_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetGameSystemResponse) GetSystem() *GameSystemInfo {
@@ -300,6 +375,13 @@ func (x *GetGameSystemResponse) GetSystem() *GameSystemInfo {
 	return nil
 }
 
+func (x *GetGameSystemResponse) GetEventSchemas() []*EventPayloadSchema {
+	if x != nil {
+		return x.EventSchemas
+	}
+	return nil
+}
+
 var File_game_v1_system_proto protoreflect.FileDescriptor
 
 const file_game_v1_system_proto_rawDesc = "" +
@@ -320,9 +402,17 @@ const file_game_v1_system_proto_rawDesc = "" +
 	"\asystems\x18\x01 \x03(\v2\x17.game.v1.GameSystemInfoR\asystems\"W\n" +
 	"\x14GetGameSystemRequest\x12%\n" +
 	"\x02id\x18\x01 \x01(\x0e2\x15.common.v1.GameSystemR\x02id\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\tR\aversion\"H\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\"\x9a\x01\n" +
+	"\x12EventPayloadSchema\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\tR\teventType\x12\x1b\n" +
+	"\tsystem_id\x18\x02 \x01(\tR\bsystemId\x12'\n" +
+	"\x0fpayload_version\x18\x03 \x01(\x05R\x0epayloadVersion\x12\x1f\n" +
+	"\vschema_json\x18\x04 \x01(\fR\n" +
+	"schemaJson\"\x8a\x01\n" +
 	"\x15GetGameSystemResponse\x12/\n" +
-	"\x06system\x18\x01 \x01(\v2\x17.game.v1.GameSystemInfoR\x06system2\xb5\x01\n" +
+	"\x06system\x18\x01 \x01(\v2\x17.game.v1.GameSystemInfoR\x06system\x12@\n" +
+	"\revent_schemas\x18\x02 \x03(\v2\x1b.game.v1.EventPayloadSchemaR\feventSchemas2\xb5\x01\n" +
 	"\rSystemService\x12T\n" +
 	"\x0fListGameSystems\x12\x1f.game.v1.ListGameSystemsRequest\x1a .game.v1.ListGameSystemsResponse\x12N\n" +
 	"\rGetGameSystem\x12\x1d.game.v1.GetGameSystemRequest\x1a\x1e.game.v1.GetGameSystemResponseBCZAgithub.com/louisbranch/fracturing.space/api/gen/go/game/v1;gamev1b\x06proto3"
@@ -339,35 +429,37 @@ func file_game_v1_system_proto_rawDescGZIP() []byte {
 	return file_game_v1_system_proto_rawDescData
 }
 
-var file_game_v1_system_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_game_v1_system_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_game_v1_system_proto_goTypes = []any{
 	(*GameSystemInfo)(nil),                // 0: game.v1.GameSystemInfo
 	(*ListGameSystemsRequest)(nil),        // 1: game.v1.ListGameSystemsRequest
 	(*ListGameSystemsResponse)(nil),       // 2: game.v1.ListGameSystemsResponse
 	(*GetGameSystemRequest)(nil),          // 3: game.v1.GetGameSystemRequest
-	(*GetGameSystemResponse)(nil),         // 4: game.v1.GetGameSystemResponse
-	(v1.GameSystem)(0),                    // 5: common.v1.GameSystem
-	(v1.GameSystemImplementationStage)(0), // 6: common.v1.GameSystemImplementationStage
-	(v1.GameSystemOperationalStatus)(0),   // 7: common.v1.GameSystemOperationalStatus
-	(v1.GameSystemAccessLevel)(0),         // 8: common.v1.GameSystemAccessLevel
+	(*EventPayloadSchema)(nil),            // 4: game.v1.EventPayloadSchema
+	(*GetGameSystemResponse)(nil),         // 5: game.v1.GetGameSystemResponse
+	(v1.GameSystem)(0),                    // 6: common.v1.GameSystem
+	(v1.GameSystemImplementationStage)(0), // 7: common.v1.GameSystemImplementationStage
+	(v1.GameSystemOperationalStatus)(0),   // 8: common.v1.GameSystemOperationalStatus
+	(v1.GameSystemAccessLevel)(0),         // 9: common.v1.GameSystemAccessLevel
 }
 var file_game_v1_system_proto_depIdxs = []int32{
-	5, // 0: game.v1.GameSystemInfo.id:type_name -> common.v1.GameSystem
-	6, // 1: game.v1.GameSystemInfo.implementation_stage:type_name -> common.v1.GameSystemImplementationStage
-	7, // 2: game.v1.GameSystemInfo.operational_status:type_name -> common.v1.GameSystemOperationalStatus
-	8, // 3: game.v1.GameSystemInfo.access_level:type_name -> common.v1.GameSystemAccessLevel
-	0, // 4: game.v1.ListGameSystemsResponse.systems:type_name -> game.v1.GameSystemInfo
-	5, // 5: game.v1.GetGameSystemRequest.id:type_name -> common.v1.GameSystem
-	0, // 6: game.v1.GetGameSystemResponse.system:type_name -> game.v1.GameSystemInfo
-	1, // 7: game.v1.SystemService.ListGameSystems:input_type -> game.v1.ListGameSystemsRequest
-	3, // 8: game.v1.SystemService.GetGameSystem:input_type -> game.v1.GetGameSystemRequest
-	2, // 9: game.v1.SystemService.ListGameSystems:output_type -> game.v1.ListGameSystemsResponse
-	4, // 10: game.v1.SystemService.GetGameSystem:output_type -> game.v1.GetGameSystemResponse
-	9, // [9:11] is the sub-list for method output_type
-	7, // [7:9] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	6,  // 0: game.v1.GameSystemInfo.id:type_name -> common.v1.GameSystem
+	7,  // 1: game.v1.GameSystemInfo.implementation_stage:type_name -> common.v1.GameSystemImplementationStage
+	8,  // 2: game.v1.GameSystemInfo.operational_status:type_name -> common.v1.GameSystemOperationalStatus
+	9,  // 3: game.v1.GameSystemInfo.access_level:type_name -> common.v1.GameSystemAccessLevel
+	0,  // 4: game.v1.ListGameSystemsResponse.systems:type_name -> game.v1.GameSystemInfo
+	6,  // 5: game.v1.GetGameSystemRequest.id:type_name -> common.v1.GameSystem
+	0,  // 6: game.v1.GetGameSystemResponse.system:type_name -> game.v1.GameSystemInfo
+	4,  // 7: game.v1.GetGameSystemResponse.event_schemas:type_name -> game.v1.EventPayloadSchema
+	1,  // 8: game.v1.SystemService.ListGameSystems:input_type -> game.v1.ListGameSystemsRequest
+	3,  // 9: game.v1.SystemService.GetGameSystem:input_type -> game.v1.GetGameSystemRequest
+	2,  // 10: game.v1.SystemService.ListGameSystems:output_type -> game.v1.ListGameSystemsResponse
+	5,  // 11: game.v1.SystemService.GetGameSystem:output_type -> game.v1.GetGameSystemResponse
+	10, // [10:12] is the sub-list for method output_type
+	8,  // [8:10] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_game_v1_system_proto_init() }
@@ -381,7 +473,7 @@ func file_game_v1_system_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_v1_system_proto_rawDesc), len(file_game_v1_system_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
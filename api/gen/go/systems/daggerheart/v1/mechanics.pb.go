@@ -91,6 +91,62 @@ func (Outcome) EnumDescriptor() ([]byte, []int) {
 	return file_systems_daggerheart_v1_mechanics_proto_rawDescGZIP(), []int{0}
 }
 
+// AdvantageSourceKind classifies why a roll gains advantage or disadvantage.
+type AdvantageSourceKind int32
+
+const (
+	AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_UNSPECIFIED AdvantageSourceKind = 0
+	AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_CONDITION   AdvantageSourceKind = 1
+	AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_TARGET      AdvantageSourceKind = 2
+	AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_FEATURE     AdvantageSourceKind = 3
+	AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_GM_RULING   AdvantageSourceKind = 4
+)
+
+// Enum value maps for AdvantageSourceKind.
+var (
+	AdvantageSourceKind_name = map[int32]string{
+		0: "ADVANTAGE_SOURCE_KIND_UNSPECIFIED",
+		1: "ADVANTAGE_SOURCE_KIND_CONDITION",
+		2: "ADVANTAGE_SOURCE_KIND_TARGET",
+		3: "ADVANTAGE_SOURCE_KIND_FEATURE",
+		4: "ADVANTAGE_SOURCE_KIND_GM_RULING",
+	}
+	AdvantageSourceKind_value = map[string]int32{
+		"ADVANTAGE_SOURCE_KIND_UNSPECIFIED": 0,
+		"ADVANTAGE_SOURCE_KIND_CONDITION":   1,
+		"ADVANTAGE_SOURCE_KIND_TARGET":      2,
+		"ADVANTAGE_SOURCE_KIND_FEATURE":     3,
+		"ADVANTAGE_SOURCE_KIND_GM_RULING":   4,
+	}
+)
+
+func (x AdvantageSourceKind) Enum() *AdvantageSourceKind {
+	p := new(AdvantageSourceKind)
+	*p = x
+	return p
+}
+
+func (x AdvantageSourceKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdvantageSourceKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_mechanics_proto_enumTypes[1].Descriptor()
+}
+
+func (AdvantageSourceKind) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_mechanics_proto_enumTypes[1]
+}
+
+func (x AdvantageSourceKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdvantageSourceKind.Descriptor instead.
+func (AdvantageSourceKind) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_mechanics_proto_rawDescGZIP(), []int{1}
+}
+
 // DualityDice represents the paired Hope and Fear d12 dice used in Daggerheart.
 type DualityDice struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -510,6 +566,70 @@ func (x *ActionRollModifier) GetValue() int32 {
 	return 0
 }
 
+// AdvantageSource is a named reason a roll gains advantage or disadvantage.
+// Sources cancel one-for-one; a roll never gains more than one d6.
+type AdvantageSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  AdvantageSourceKind    `protobuf:"varint,1,opt,name=kind,proto3,enum=systems.daggerheart.v1.AdvantageSourceKind" json:"kind,omitempty"`
+	// Display name, e.g. "Hidden" or "Vulnerable target".
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// When true the source imposes disadvantage instead of advantage.
+	Disadvantage  bool `protobuf:"varint,3,opt,name=disadvantage,proto3" json:"disadvantage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdvantageSource) Reset() {
+	*x = AdvantageSource{}
+	mi := &file_systems_daggerheart_v1_mechanics_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvantageSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvantageSource) ProtoMessage() {}
+
+func (x *AdvantageSource) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_mechanics_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvantageSource.ProtoReflect.Descriptor instead.
+func (*AdvantageSource) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_mechanics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AdvantageSource) GetKind() AdvantageSourceKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_UNSPECIFIED
+}
+
+func (x *AdvantageSource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdvantageSource) GetDisadvantage() bool {
+	if x != nil {
+		return x.Disadvantage
+	}
+	return false
+}
+
 // OutcomeCharacterState represents the state updates for a character after outcome application.
 type OutcomeCharacterState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -523,7 +643,7 @@ type OutcomeCharacterState struct {
 
 func (x *OutcomeCharacterState) Reset() {
 	*x = OutcomeCharacterState{}
-	mi := &file_systems_daggerheart_v1_mechanics_proto_msgTypes[7]
+	mi := &file_systems_daggerheart_v1_mechanics_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -535,7 +655,7 @@ func (x *OutcomeCharacterState) String() string {
 func (*OutcomeCharacterState) ProtoMessage() {}
 
 func (x *OutcomeCharacterState) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_mechanics_proto_msgTypes[7]
+	mi := &file_systems_daggerheart_v1_mechanics_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +668,7 @@ func (x *OutcomeCharacterState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutcomeCharacterState.ProtoReflect.Descriptor instead.
 func (*OutcomeCharacterState) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_mechanics_proto_rawDescGZIP(), []int{7}
+	return file_systems_daggerheart_v1_mechanics_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OutcomeCharacterState) GetCharacterId() string {
@@ -590,7 +710,7 @@ type OutcomeUpdated struct {
 
 func (x *OutcomeUpdated) Reset() {
 	*x = OutcomeUpdated{}
-	mi := &file_systems_daggerheart_v1_mechanics_proto_msgTypes[8]
+	mi := &file_systems_daggerheart_v1_mechanics_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -602,7 +722,7 @@ func (x *OutcomeUpdated) String() string {
 func (*OutcomeUpdated) ProtoMessage() {}
 
 func (x *OutcomeUpdated) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_mechanics_proto_msgTypes[8]
+	mi := &file_systems_daggerheart_v1_mechanics_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,7 +735,7 @@ func (x *OutcomeUpdated) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutcomeUpdated.ProtoReflect.Descriptor instead.
 func (*OutcomeUpdated) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_mechanics_proto_rawDescGZIP(), []int{8}
+	return file_systems_daggerheart_v1_mechanics_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *OutcomeUpdated) GetCharacterStates() []*OutcomeCharacterState {
@@ -666,7 +786,11 @@ const file_systems_daggerheart_v1_mechanics_proto_rawDesc = "" +
 	"\x05count\x18\x02 \x01(\x05R\x05count\"B\n" +
 	"\x12ActionRollModifier\x12\x16\n" +
 	"\x06source\x18\x01 \x01(\tR\x06source\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value\"v\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value\"\x8a\x01\n" +
+	"\x0fAdvantageSource\x12?\n" +
+	"\x04kind\x18\x01 \x01(\x0e2+.systems.daggerheart.v1.AdvantageSourceKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\fdisadvantage\x18\x03 \x01(\bR\fdisadvantage\"v\n" +
 	"\x15OutcomeCharacterState\x12!\n" +
 	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x12\n" +
 	"\x04hope\x18\x02 \x01(\x05R\x04hope\x12\x16\n" +
@@ -685,7 +809,13 @@ const file_systems_daggerheart_v1_mechanics_proto_rawDesc = "" +
 	"\x11SUCCESS_WITH_FEAR\x10\x04\x12\x15\n" +
 	"\x11FAILURE_WITH_HOPE\x10\x05\x12\x15\n" +
 	"\x11FAILURE_WITH_FEAR\x10\x06\x12\x14\n" +
-	"\x10CRITICAL_SUCCESS\x10\aBYZWgithub.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1;daggerheartv1b\x06proto3"
+	"\x10CRITICAL_SUCCESS\x10\a*\xcb\x01\n" +
+	"\x13AdvantageSourceKind\x12%\n" +
+	"!ADVANTAGE_SOURCE_KIND_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fADVANTAGE_SOURCE_KIND_CONDITION\x10\x01\x12 \n" +
+	"\x1cADVANTAGE_SOURCE_KIND_TARGET\x10\x02\x12!\n" +
+	"\x1dADVANTAGE_SOURCE_KIND_FEATURE\x10\x03\x12#\n" +
+	"\x1fADVANTAGE_SOURCE_KIND_GM_RULING\x10\x04BYZWgithub.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1;daggerheartv1b\x06proto3"
 
 var (
 	file_systems_daggerheart_v1_mechanics_proto_rawDescOnce sync.Once
@@ -699,30 +829,33 @@ func file_systems_daggerheart_v1_mechanics_proto_rawDescGZIP() []byte {
 	return file_systems_daggerheart_v1_mechanics_proto_rawDescData
 }
 
-var file_systems_daggerheart_v1_mechanics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_systems_daggerheart_v1_mechanics_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_systems_daggerheart_v1_mechanics_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_systems_daggerheart_v1_mechanics_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_systems_daggerheart_v1_mechanics_proto_goTypes = []any{
 	(Outcome)(0),                  // 0: systems.daggerheart.v1.Outcome
-	(*DualityDice)(nil),           // 1: systems.daggerheart.v1.DualityDice
-	(*DiceSpec)(nil),              // 2: systems.daggerheart.v1.DiceSpec
-	(*DiceRoll)(nil),              // 3: systems.daggerheart.v1.DiceRoll
-	(*Intermediates)(nil),         // 4: systems.daggerheart.v1.Intermediates
-	(*ExplainStep)(nil),           // 5: systems.daggerheart.v1.ExplainStep
-	(*OutcomeCount)(nil),          // 6: systems.daggerheart.v1.OutcomeCount
-	(*ActionRollModifier)(nil),    // 7: systems.daggerheart.v1.ActionRollModifier
-	(*OutcomeCharacterState)(nil), // 8: systems.daggerheart.v1.OutcomeCharacterState
-	(*OutcomeUpdated)(nil),        // 9: systems.daggerheart.v1.OutcomeUpdated
-	(*structpb.Struct)(nil),       // 10: google.protobuf.Struct
+	(AdvantageSourceKind)(0),      // 1: systems.daggerheart.v1.AdvantageSourceKind
+	(*DualityDice)(nil),           // 2: systems.daggerheart.v1.DualityDice
+	(*DiceSpec)(nil),              // 3: systems.daggerheart.v1.DiceSpec
+	(*DiceRoll)(nil),              // 4: systems.daggerheart.v1.DiceRoll
+	(*Intermediates)(nil),         // 5: systems.daggerheart.v1.Intermediates
+	(*ExplainStep)(nil),           // 6: systems.daggerheart.v1.ExplainStep
+	(*OutcomeCount)(nil),          // 7: systems.daggerheart.v1.OutcomeCount
+	(*ActionRollModifier)(nil),    // 8: systems.daggerheart.v1.ActionRollModifier
+	(*AdvantageSource)(nil),       // 9: systems.daggerheart.v1.AdvantageSource
+	(*OutcomeCharacterState)(nil), // 10: systems.daggerheart.v1.OutcomeCharacterState
+	(*OutcomeUpdated)(nil),        // 11: systems.daggerheart.v1.OutcomeUpdated
+	(*structpb.Struct)(nil),       // 12: google.protobuf.Struct
 }
 var file_systems_daggerheart_v1_mechanics_proto_depIdxs = []int32{
-	10, // 0: systems.daggerheart.v1.ExplainStep.data:type_name -> google.protobuf.Struct
+	12, // 0: systems.daggerheart.v1.ExplainStep.data:type_name -> google.protobuf.Struct
 	0,  // 1: systems.daggerheart.v1.OutcomeCount.outcome:type_name -> systems.daggerheart.v1.Outcome
-	8,  // 2: systems.daggerheart.v1.OutcomeUpdated.character_states:type_name -> systems.daggerheart.v1.OutcomeCharacterState
-	3,  // [3:3] is the sub-list for method output_type
-	3,  // [3:3] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	1,  // 2: systems.daggerheart.v1.AdvantageSource.kind:type_name -> systems.daggerheart.v1.AdvantageSourceKind
+	10, // 3: systems.daggerheart.v1.OutcomeUpdated.character_states:type_name -> systems.daggerheart.v1.OutcomeCharacterState
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_systems_daggerheart_v1_mechanics_proto_init() }
@@ -730,14 +863,14 @@ func file_systems_daggerheart_v1_mechanics_proto_init() {
 	if File_systems_daggerheart_v1_mechanics_proto != nil {
 		return
 	}
-	file_systems_daggerheart_v1_mechanics_proto_msgTypes[8].OneofWrappers = []any{}
+	file_systems_daggerheart_v1_mechanics_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_systems_daggerheart_v1_mechanics_proto_rawDesc), len(file_systems_daggerheart_v1_mechanics_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
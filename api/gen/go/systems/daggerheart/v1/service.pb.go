@@ -123,6 +123,430 @@ func (DaggerheartCountdownDirection) EnumDescriptor() ([]byte, []int) {
 	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{1}
 }
 
+// DaggerheartCountdownTrigger names the flow that ticks a countdown automatically.
+// ACTION_ROLL ticks on action rolls (not reactions), REST on completed rests,
+// FEAR_SPENT whenever the GM spends Fear, and MAJOR_DAMAGE when a character
+// takes major or severe damage.
+type DaggerheartCountdownTrigger int32
+
+const (
+	DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_UNSPECIFIED  DaggerheartCountdownTrigger = 0
+	DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL  DaggerheartCountdownTrigger = 1
+	DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_REST         DaggerheartCountdownTrigger = 2
+	DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_FEAR_SPENT   DaggerheartCountdownTrigger = 3
+	DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_MAJOR_DAMAGE DaggerheartCountdownTrigger = 4
+)
+
+// Enum value maps for DaggerheartCountdownTrigger.
+var (
+	DaggerheartCountdownTrigger_name = map[int32]string{
+		0: "DAGGERHEART_COUNTDOWN_TRIGGER_UNSPECIFIED",
+		1: "DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL",
+		2: "DAGGERHEART_COUNTDOWN_TRIGGER_REST",
+		3: "DAGGERHEART_COUNTDOWN_TRIGGER_FEAR_SPENT",
+		4: "DAGGERHEART_COUNTDOWN_TRIGGER_MAJOR_DAMAGE",
+	}
+	DaggerheartCountdownTrigger_value = map[string]int32{
+		"DAGGERHEART_COUNTDOWN_TRIGGER_UNSPECIFIED":  0,
+		"DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL":  1,
+		"DAGGERHEART_COUNTDOWN_TRIGGER_REST":         2,
+		"DAGGERHEART_COUNTDOWN_TRIGGER_FEAR_SPENT":   3,
+		"DAGGERHEART_COUNTDOWN_TRIGGER_MAJOR_DAMAGE": 4,
+	}
+)
+
+func (x DaggerheartCountdownTrigger) Enum() *DaggerheartCountdownTrigger {
+	p := new(DaggerheartCountdownTrigger)
+	*p = x
+	return p
+}
+
+func (x DaggerheartCountdownTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartCountdownTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_service_proto_enumTypes[2].Descriptor()
+}
+
+func (DaggerheartCountdownTrigger) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_service_proto_enumTypes[2]
+}
+
+func (x DaggerheartCountdownTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartCountdownTrigger.Descriptor instead.
+func (DaggerheartCountdownTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+type DaggerheartChaseStatus int32
+
+const (
+	DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_UNSPECIFIED DaggerheartChaseStatus = 0
+	DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_ACTIVE      DaggerheartChaseStatus = 1
+	DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_RESOLVED    DaggerheartChaseStatus = 2
+)
+
+// Enum value maps for DaggerheartChaseStatus.
+var (
+	DaggerheartChaseStatus_name = map[int32]string{
+		0: "DAGGERHEART_CHASE_STATUS_UNSPECIFIED",
+		1: "DAGGERHEART_CHASE_STATUS_ACTIVE",
+		2: "DAGGERHEART_CHASE_STATUS_RESOLVED",
+	}
+	DaggerheartChaseStatus_value = map[string]int32{
+		"DAGGERHEART_CHASE_STATUS_UNSPECIFIED": 0,
+		"DAGGERHEART_CHASE_STATUS_ACTIVE":      1,
+		"DAGGERHEART_CHASE_STATUS_RESOLVED":    2,
+	}
+)
+
+func (x DaggerheartChaseStatus) Enum() *DaggerheartChaseStatus {
+	p := new(DaggerheartChaseStatus)
+	*p = x
+	return p
+}
+
+func (x DaggerheartChaseStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartChaseStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_service_proto_enumTypes[3].Descriptor()
+}
+
+func (DaggerheartChaseStatus) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_service_proto_enumTypes[3]
+}
+
+func (x DaggerheartChaseStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartChaseStatus.Descriptor instead.
+func (DaggerheartChaseStatus) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+type DaggerheartChaseWinner int32
+
+const (
+	DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_UNSPECIFIED DaggerheartChaseWinner = 0
+	DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_PROGRESS    DaggerheartChaseWinner = 1
+	DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_CONSEQUENCE DaggerheartChaseWinner = 2
+)
+
+// Enum value maps for DaggerheartChaseWinner.
+var (
+	DaggerheartChaseWinner_name = map[int32]string{
+		0: "DAGGERHEART_CHASE_WINNER_UNSPECIFIED",
+		1: "DAGGERHEART_CHASE_WINNER_PROGRESS",
+		2: "DAGGERHEART_CHASE_WINNER_CONSEQUENCE",
+	}
+	DaggerheartChaseWinner_value = map[string]int32{
+		"DAGGERHEART_CHASE_WINNER_UNSPECIFIED": 0,
+		"DAGGERHEART_CHASE_WINNER_PROGRESS":    1,
+		"DAGGERHEART_CHASE_WINNER_CONSEQUENCE": 2,
+	}
+)
+
+func (x DaggerheartChaseWinner) Enum() *DaggerheartChaseWinner {
+	p := new(DaggerheartChaseWinner)
+	*p = x
+	return p
+}
+
+func (x DaggerheartChaseWinner) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartChaseWinner) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_service_proto_enumTypes[4].Descriptor()
+}
+
+func (DaggerheartChaseWinner) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_service_proto_enumTypes[4]
+}
+
+func (x DaggerheartChaseWinner) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartChaseWinner.Descriptor instead.
+func (DaggerheartChaseWinner) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+type DaggerheartRangeBand int32
+
+const (
+	DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_UNSPECIFIED  DaggerheartRangeBand = 0
+	DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_MELEE        DaggerheartRangeBand = 1
+	DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_VERY_CLOSE   DaggerheartRangeBand = 2
+	DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_CLOSE        DaggerheartRangeBand = 3
+	DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_FAR          DaggerheartRangeBand = 4
+	DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_VERY_FAR     DaggerheartRangeBand = 5
+	DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_OUT_OF_RANGE DaggerheartRangeBand = 6
+)
+
+// Enum value maps for DaggerheartRangeBand.
+var (
+	DaggerheartRangeBand_name = map[int32]string{
+		0: "DAGGERHEART_RANGE_BAND_UNSPECIFIED",
+		1: "DAGGERHEART_RANGE_BAND_MELEE",
+		2: "DAGGERHEART_RANGE_BAND_VERY_CLOSE",
+		3: "DAGGERHEART_RANGE_BAND_CLOSE",
+		4: "DAGGERHEART_RANGE_BAND_FAR",
+		5: "DAGGERHEART_RANGE_BAND_VERY_FAR",
+		6: "DAGGERHEART_RANGE_BAND_OUT_OF_RANGE",
+	}
+	DaggerheartRangeBand_value = map[string]int32{
+		"DAGGERHEART_RANGE_BAND_UNSPECIFIED":  0,
+		"DAGGERHEART_RANGE_BAND_MELEE":        1,
+		"DAGGERHEART_RANGE_BAND_VERY_CLOSE":   2,
+		"DAGGERHEART_RANGE_BAND_CLOSE":        3,
+		"DAGGERHEART_RANGE_BAND_FAR":          4,
+		"DAGGERHEART_RANGE_BAND_VERY_FAR":     5,
+		"DAGGERHEART_RANGE_BAND_OUT_OF_RANGE": 6,
+	}
+)
+
+func (x DaggerheartRangeBand) Enum() *DaggerheartRangeBand {
+	p := new(DaggerheartRangeBand)
+	*p = x
+	return p
+}
+
+func (x DaggerheartRangeBand) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartRangeBand) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_service_proto_enumTypes[5].Descriptor()
+}
+
+func (DaggerheartRangeBand) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_service_proto_enumTypes[5]
+}
+
+func (x DaggerheartRangeBand) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartRangeBand.Descriptor instead.
+func (DaggerheartRangeBand) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+type DaggerheartSceneEntityType int32
+
+const (
+	DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_UNSPECIFIED DaggerheartSceneEntityType = 0
+	DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_CHARACTER   DaggerheartSceneEntityType = 1
+	DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ADVERSARY   DaggerheartSceneEntityType = 2
+	// A named area of the scene, such as an environment zone.
+	DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ZONE DaggerheartSceneEntityType = 3
+)
+
+// Enum value maps for DaggerheartSceneEntityType.
+var (
+	DaggerheartSceneEntityType_name = map[int32]string{
+		0: "DAGGERHEART_SCENE_ENTITY_TYPE_UNSPECIFIED",
+		1: "DAGGERHEART_SCENE_ENTITY_TYPE_CHARACTER",
+		2: "DAGGERHEART_SCENE_ENTITY_TYPE_ADVERSARY",
+		3: "DAGGERHEART_SCENE_ENTITY_TYPE_ZONE",
+	}
+	DaggerheartSceneEntityType_value = map[string]int32{
+		"DAGGERHEART_SCENE_ENTITY_TYPE_UNSPECIFIED": 0,
+		"DAGGERHEART_SCENE_ENTITY_TYPE_CHARACTER":   1,
+		"DAGGERHEART_SCENE_ENTITY_TYPE_ADVERSARY":   2,
+		"DAGGERHEART_SCENE_ENTITY_TYPE_ZONE":        3,
+	}
+)
+
+func (x DaggerheartSceneEntityType) Enum() *DaggerheartSceneEntityType {
+	p := new(DaggerheartSceneEntityType)
+	*p = x
+	return p
+}
+
+func (x DaggerheartSceneEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartSceneEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_service_proto_enumTypes[6].Descriptor()
+}
+
+func (DaggerheartSceneEntityType) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_service_proto_enumTypes[6]
+}
+
+func (x DaggerheartSceneEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartSceneEntityType.Descriptor instead.
+func (DaggerheartSceneEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+type DaggerheartMovementKind int32
+
+const (
+	DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_UNSPECIFIED DaggerheartMovementKind = 0
+	DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_MOVE        DaggerheartMovementKind = 1
+	DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_KNOCKBACK   DaggerheartMovementKind = 2
+	DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_TELEPORT    DaggerheartMovementKind = 3
+	DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_FORCED      DaggerheartMovementKind = 4
+)
+
+// Enum value maps for DaggerheartMovementKind.
+var (
+	DaggerheartMovementKind_name = map[int32]string{
+		0: "DAGGERHEART_MOVEMENT_KIND_UNSPECIFIED",
+		1: "DAGGERHEART_MOVEMENT_KIND_MOVE",
+		2: "DAGGERHEART_MOVEMENT_KIND_KNOCKBACK",
+		3: "DAGGERHEART_MOVEMENT_KIND_TELEPORT",
+		4: "DAGGERHEART_MOVEMENT_KIND_FORCED",
+	}
+	DaggerheartMovementKind_value = map[string]int32{
+		"DAGGERHEART_MOVEMENT_KIND_UNSPECIFIED": 0,
+		"DAGGERHEART_MOVEMENT_KIND_MOVE":        1,
+		"DAGGERHEART_MOVEMENT_KIND_KNOCKBACK":   2,
+		"DAGGERHEART_MOVEMENT_KIND_TELEPORT":    3,
+		"DAGGERHEART_MOVEMENT_KIND_FORCED":      4,
+	}
+)
+
+func (x DaggerheartMovementKind) Enum() *DaggerheartMovementKind {
+	p := new(DaggerheartMovementKind)
+	*p = x
+	return p
+}
+
+func (x DaggerheartMovementKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartMovementKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_service_proto_enumTypes[7].Descriptor()
+}
+
+func (DaggerheartMovementKind) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_service_proto_enumTypes[7]
+}
+
+func (x DaggerheartMovementKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartMovementKind.Descriptor instead.
+func (DaggerheartMovementKind) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+type DaggerheartEnvironmentFeatureKind int32
+
+const (
+	DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_UNSPECIFIED DaggerheartEnvironmentFeatureKind = 0
+	DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_ACTION      DaggerheartEnvironmentFeatureKind = 1
+	DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_REACTION    DaggerheartEnvironmentFeatureKind = 2
+)
+
+// Enum value maps for DaggerheartEnvironmentFeatureKind.
+var (
+	DaggerheartEnvironmentFeatureKind_name = map[int32]string{
+		0: "DAGGERHEART_ENVIRONMENT_FEATURE_KIND_UNSPECIFIED",
+		1: "DAGGERHEART_ENVIRONMENT_FEATURE_KIND_ACTION",
+		2: "DAGGERHEART_ENVIRONMENT_FEATURE_KIND_REACTION",
+	}
+	DaggerheartEnvironmentFeatureKind_value = map[string]int32{
+		"DAGGERHEART_ENVIRONMENT_FEATURE_KIND_UNSPECIFIED": 0,
+		"DAGGERHEART_ENVIRONMENT_FEATURE_KIND_ACTION":      1,
+		"DAGGERHEART_ENVIRONMENT_FEATURE_KIND_REACTION":    2,
+	}
+)
+
+func (x DaggerheartEnvironmentFeatureKind) Enum() *DaggerheartEnvironmentFeatureKind {
+	p := new(DaggerheartEnvironmentFeatureKind)
+	*p = x
+	return p
+}
+
+func (x DaggerheartEnvironmentFeatureKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartEnvironmentFeatureKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_service_proto_enumTypes[8].Descriptor()
+}
+
+func (DaggerheartEnvironmentFeatureKind) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_service_proto_enumTypes[8]
+}
+
+func (x DaggerheartEnvironmentFeatureKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartEnvironmentFeatureKind.Descriptor instead.
+func (DaggerheartEnvironmentFeatureKind) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+type DaggerheartAdversaryFeatureStatus int32
+
+const (
+	DaggerheartAdversaryFeatureStatus_DAGGERHEART_ADVERSARY_FEATURE_STATUS_UNSPECIFIED DaggerheartAdversaryFeatureStatus = 0
+	DaggerheartAdversaryFeatureStatus_DAGGERHEART_ADVERSARY_FEATURE_STATUS_READY       DaggerheartAdversaryFeatureStatus = 1
+	DaggerheartAdversaryFeatureStatus_DAGGERHEART_ADVERSARY_FEATURE_STATUS_SPENT       DaggerheartAdversaryFeatureStatus = 2
+)
+
+// Enum value maps for DaggerheartAdversaryFeatureStatus.
+var (
+	DaggerheartAdversaryFeatureStatus_name = map[int32]string{
+		0: "DAGGERHEART_ADVERSARY_FEATURE_STATUS_UNSPECIFIED",
+		1: "DAGGERHEART_ADVERSARY_FEATURE_STATUS_READY",
+		2: "DAGGERHEART_ADVERSARY_FEATURE_STATUS_SPENT",
+	}
+	DaggerheartAdversaryFeatureStatus_value = map[string]int32{
+		"DAGGERHEART_ADVERSARY_FEATURE_STATUS_UNSPECIFIED": 0,
+		"DAGGERHEART_ADVERSARY_FEATURE_STATUS_READY":       1,
+		"DAGGERHEART_ADVERSARY_FEATURE_STATUS_SPENT":       2,
+	}
+)
+
+func (x DaggerheartAdversaryFeatureStatus) Enum() *DaggerheartAdversaryFeatureStatus {
+	p := new(DaggerheartAdversaryFeatureStatus)
+	*p = x
+	return p
+}
+
+func (x DaggerheartAdversaryFeatureStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartAdversaryFeatureStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_service_proto_enumTypes[9].Descriptor()
+}
+
+func (DaggerheartAdversaryFeatureStatus) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_service_proto_enumTypes[9]
+}
+
+func (x DaggerheartAdversaryFeatureStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartAdversaryFeatureStatus.Descriptor instead.
+func (DaggerheartAdversaryFeatureStatus) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
 type RollKind int32
 
 const (
@@ -156,11 +580,11 @@ func (x RollKind) String() string {
 }
 
 func (RollKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_systems_daggerheart_v1_service_proto_enumTypes[2].Descriptor()
+	return file_systems_daggerheart_v1_service_proto_enumTypes[10].Descriptor()
 }
 
 func (RollKind) Type() protoreflect.EnumType {
-	return &file_systems_daggerheart_v1_service_proto_enumTypes[2]
+	return &file_systems_daggerheart_v1_service_proto_enumTypes[10]
 }
 
 func (x RollKind) Number() protoreflect.EnumNumber {
@@ -169,7 +593,87 @@ func (x RollKind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RollKind.Descriptor instead.
 func (RollKind) EnumDescriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{2}
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+// DaggerheartAdvancementType enumerates level-up advancement options.
+type DaggerheartAdvancementType int32
+
+const (
+	DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_UNSPECIFIED DaggerheartAdvancementType = 0
+	// +1 to two unmarked traits, which are then marked.
+	DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_TRAITS DaggerheartAdvancementType = 1
+	// Permanently gain one Hit Point slot.
+	DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_HP_SLOT DaggerheartAdvancementType = 2
+	// Permanently gain one Stress slot.
+	DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_STRESS_SLOT DaggerheartAdvancementType = 3
+	// +1 to two Experiences.
+	DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_EXPERIENCES DaggerheartAdvancementType = 4
+	// Take an additional domain card of your level or lower.
+	DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_DOMAIN_CARD DaggerheartAdvancementType = 5
+	// +1 to Evasion.
+	DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_EVASION DaggerheartAdvancementType = 6
+	// Take an upgraded subclass card.
+	DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_SUBCLASS_UPGRADE DaggerheartAdvancementType = 7
+	// +1 to Proficiency (uses both advancement slots).
+	DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_PROFICIENCY DaggerheartAdvancementType = 8
+	// Choose an additional class (uses both advancement slots, tier 3+).
+	DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_MULTICLASS DaggerheartAdvancementType = 9
+)
+
+// Enum value maps for DaggerheartAdvancementType.
+var (
+	DaggerheartAdvancementType_name = map[int32]string{
+		0: "DAGGERHEART_ADVANCEMENT_TYPE_UNSPECIFIED",
+		1: "DAGGERHEART_ADVANCEMENT_TYPE_TRAITS",
+		2: "DAGGERHEART_ADVANCEMENT_TYPE_HP_SLOT",
+		3: "DAGGERHEART_ADVANCEMENT_TYPE_STRESS_SLOT",
+		4: "DAGGERHEART_ADVANCEMENT_TYPE_EXPERIENCES",
+		5: "DAGGERHEART_ADVANCEMENT_TYPE_DOMAIN_CARD",
+		6: "DAGGERHEART_ADVANCEMENT_TYPE_EVASION",
+		7: "DAGGERHEART_ADVANCEMENT_TYPE_SUBCLASS_UPGRADE",
+		8: "DAGGERHEART_ADVANCEMENT_TYPE_PROFICIENCY",
+		9: "DAGGERHEART_ADVANCEMENT_TYPE_MULTICLASS",
+	}
+	DaggerheartAdvancementType_value = map[string]int32{
+		"DAGGERHEART_ADVANCEMENT_TYPE_UNSPECIFIED":      0,
+		"DAGGERHEART_ADVANCEMENT_TYPE_TRAITS":           1,
+		"DAGGERHEART_ADVANCEMENT_TYPE_HP_SLOT":          2,
+		"DAGGERHEART_ADVANCEMENT_TYPE_STRESS_SLOT":      3,
+		"DAGGERHEART_ADVANCEMENT_TYPE_EXPERIENCES":      4,
+		"DAGGERHEART_ADVANCEMENT_TYPE_DOMAIN_CARD":      5,
+		"DAGGERHEART_ADVANCEMENT_TYPE_EVASION":          6,
+		"DAGGERHEART_ADVANCEMENT_TYPE_SUBCLASS_UPGRADE": 7,
+		"DAGGERHEART_ADVANCEMENT_TYPE_PROFICIENCY":      8,
+		"DAGGERHEART_ADVANCEMENT_TYPE_MULTICLASS":       9,
+	}
+)
+
+func (x DaggerheartAdvancementType) Enum() *DaggerheartAdvancementType {
+	p := new(DaggerheartAdvancementType)
+	*p = x
+	return p
+}
+
+func (x DaggerheartAdvancementType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartAdvancementType) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_service_proto_enumTypes[11].Descriptor()
+}
+
+func (DaggerheartAdvancementType) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_service_proto_enumTypes[11]
+}
+
+func (x DaggerheartAdvancementType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartAdvancementType.Descriptor instead.
+func (DaggerheartAdvancementType) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{11}
 }
 
 type DaggerheartApplyDamageRequest struct {
@@ -307,6 +811,9 @@ type DaggerheartApplyAdversaryDamageRequest struct {
 	Damage            *DaggerheartDamageRequest `protobuf:"bytes,3,opt,name=damage,proto3" json:"damage,omitempty"`
 	RollSeq           *uint64                   `protobuf:"varint,4,opt,name=roll_seq,json=rollSeq,proto3,oneof" json:"roll_seq,omitempty"`
 	RequireDamageRoll bool                      `protobuf:"varint,5,opt,name=require_damage_roll,json=requireDamageRoll,proto3" json:"require_damage_roll,omitempty"`
+	// Additional Minions defeated by overflow when the target is a Minion (N).
+	// At most one per N damage dealt; the caller picks valid targets in range.
+	MinionOverflowIds []string `protobuf:"bytes,6,rep,name=minion_overflow_ids,json=minionOverflowIds,proto3" json:"minion_overflow_ids,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -376,12 +883,23 @@ func (x *DaggerheartApplyAdversaryDamageRequest) GetRequireDamageRoll() bool {
 	return false
 }
 
+func (x *DaggerheartApplyAdversaryDamageRequest) GetMinionOverflowIds() []string {
+	if x != nil {
+		return x.MinionOverflowIds
+	}
+	return nil
+}
+
 type DaggerheartApplyAdversaryDamageResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AdversaryId   string                 `protobuf:"bytes,1,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
-	Adversary     *DaggerheartAdversary  `protobuf:"bytes,2,opt,name=adversary,proto3" json:"adversary,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AdversaryId string                 `protobuf:"bytes,1,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	Adversary   *DaggerheartAdversary  `protobuf:"bytes,2,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	// Additional Minions the damage could defeat through overflow.
+	MinionOverflow int32 `protobuf:"varint,3,opt,name=minion_overflow,json=minionOverflow,proto3" json:"minion_overflow,omitempty"`
+	// Minions defeated by overflow, in request order.
+	DefeatedAdversaryIds []string `protobuf:"bytes,4,rep,name=defeated_adversary_ids,json=defeatedAdversaryIds,proto3" json:"defeated_adversary_ids,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *DaggerheartApplyAdversaryDamageResponse) Reset() {
@@ -428,15 +946,29 @@ func (x *DaggerheartApplyAdversaryDamageResponse) GetAdversary() *DaggerheartAdv
 	return nil
 }
 
-type DaggerheartApplyRestRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	CampaignId    string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	CharacterIds  []string                `protobuf:"bytes,2,rep,name=character_ids,json=characterIds,proto3" json:"character_ids,omitempty"`
-	Rest          *DaggerheartRestRequest `protobuf:"bytes,3,opt,name=rest,proto3" json:"rest,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
+func (x *DaggerheartApplyAdversaryDamageResponse) GetMinionOverflow() int32 {
+	if x != nil {
+		return x.MinionOverflow
+	}
+	return 0
+}
+
+func (x *DaggerheartApplyAdversaryDamageResponse) GetDefeatedAdversaryIds() []string {
+	if x != nil {
+		return x.DefeatedAdversaryIds
+	}
+	return nil
+}
+
+type DaggerheartApplyRestRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	CampaignId    string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterIds  []string                `protobuf:"bytes,2,rep,name=character_ids,json=characterIds,proto3" json:"character_ids,omitempty"`
+	Rest          *DaggerheartRestRequest `protobuf:"bytes,3,opt,name=rest,proto3" json:"rest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
 func (x *DaggerheartApplyRestRequest) Reset() {
 	*x = DaggerheartApplyRestRequest{}
 	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[4]
@@ -1508,22 +2040,139 @@ func (x *DaggerheartApplyGmMoveResponse) GetGmFearAfter() int32 {
 	return 0
 }
 
+// DaggerheartCountdownOutcomeDelta overrides the tick delta for one action roll outcome.
+type DaggerheartCountdownOutcomeDelta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outcome       Outcome                `protobuf:"varint,1,opt,name=outcome,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcome,omitempty"`
+	Delta         int32                  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartCountdownOutcomeDelta) Reset() {
+	*x = DaggerheartCountdownOutcomeDelta{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartCountdownOutcomeDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartCountdownOutcomeDelta) ProtoMessage() {}
+
+func (x *DaggerheartCountdownOutcomeDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartCountdownOutcomeDelta.ProtoReflect.Descriptor instead.
+func (*DaggerheartCountdownOutcomeDelta) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DaggerheartCountdownOutcomeDelta) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+func (x *DaggerheartCountdownOutcomeDelta) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+// DaggerheartCountdownTickPolicy declares when a countdown advances on its own.
+// Delta counts steps toward the countdown's end and defaults to 1 when no
+// outcome deltas are given.
+type DaggerheartCountdownTickPolicy struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Trigger       DaggerheartCountdownTrigger         `protobuf:"varint,1,opt,name=trigger,proto3,enum=systems.daggerheart.v1.DaggerheartCountdownTrigger" json:"trigger,omitempty"`
+	Delta         int32                               `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	OutcomeDeltas []*DaggerheartCountdownOutcomeDelta `protobuf:"bytes,3,rep,name=outcome_deltas,json=outcomeDeltas,proto3" json:"outcome_deltas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartCountdownTickPolicy) Reset() {
+	*x = DaggerheartCountdownTickPolicy{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartCountdownTickPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartCountdownTickPolicy) ProtoMessage() {}
+
+func (x *DaggerheartCountdownTickPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartCountdownTickPolicy.ProtoReflect.Descriptor instead.
+func (*DaggerheartCountdownTickPolicy) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DaggerheartCountdownTickPolicy) GetTrigger() DaggerheartCountdownTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_UNSPECIFIED
+}
+
+func (x *DaggerheartCountdownTickPolicy) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *DaggerheartCountdownTickPolicy) GetOutcomeDeltas() []*DaggerheartCountdownOutcomeDelta {
+	if x != nil {
+		return x.OutcomeDeltas
+	}
+	return nil
+}
+
 type DaggerheartCountdown struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	CountdownId   string                        `protobuf:"bytes,1,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
-	Name          string                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Kind          DaggerheartCountdownKind      `protobuf:"varint,3,opt,name=kind,proto3,enum=systems.daggerheart.v1.DaggerheartCountdownKind" json:"kind,omitempty"`
-	Current       int32                         `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`
-	Max           int32                         `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
-	Direction     DaggerheartCountdownDirection `protobuf:"varint,6,opt,name=direction,proto3,enum=systems.daggerheart.v1.DaggerheartCountdownDirection" json:"direction,omitempty"`
-	Looping       bool                          `protobuf:"varint,7,opt,name=looping,proto3" json:"looping,omitempty"`
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	CountdownId   string                          `protobuf:"bytes,1,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	Name          string                          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          DaggerheartCountdownKind        `protobuf:"varint,3,opt,name=kind,proto3,enum=systems.daggerheart.v1.DaggerheartCountdownKind" json:"kind,omitempty"`
+	Current       int32                           `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`
+	Max           int32                           `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
+	Direction     DaggerheartCountdownDirection   `protobuf:"varint,6,opt,name=direction,proto3,enum=systems.daggerheart.v1.DaggerheartCountdownDirection" json:"direction,omitempty"`
+	Looping       bool                            `protobuf:"varint,7,opt,name=looping,proto3" json:"looping,omitempty"`
+	TickPolicy    *DaggerheartCountdownTickPolicy `protobuf:"bytes,8,opt,name=tick_policy,json=tickPolicy,proto3" json:"tick_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DaggerheartCountdown) Reset() {
 	*x = DaggerheartCountdown{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[20]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1535,7 +2184,7 @@ func (x *DaggerheartCountdown) String() string {
 func (*DaggerheartCountdown) ProtoMessage() {}
 
 func (x *DaggerheartCountdown) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[20]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1548,7 +2197,7 @@ func (x *DaggerheartCountdown) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DaggerheartCountdown.ProtoReflect.Descriptor instead.
 func (*DaggerheartCountdown) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{20}
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DaggerheartCountdown) GetCountdownId() string {
@@ -1600,24 +2249,32 @@ func (x *DaggerheartCountdown) GetLooping() bool {
 	return false
 }
 
+func (x *DaggerheartCountdown) GetTickPolicy() *DaggerheartCountdownTickPolicy {
+	if x != nil {
+		return x.TickPolicy
+	}
+	return nil
+}
+
 type DaggerheartCreateCountdownRequest struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	CampaignId    string                        `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	SessionId     string                        `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	CountdownId   string                        `protobuf:"bytes,3,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
-	Name          string                        `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Kind          DaggerheartCountdownKind      `protobuf:"varint,5,opt,name=kind,proto3,enum=systems.daggerheart.v1.DaggerheartCountdownKind" json:"kind,omitempty"`
-	Current       int32                         `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`
-	Max           int32                         `protobuf:"varint,7,opt,name=max,proto3" json:"max,omitempty"`
-	Direction     DaggerheartCountdownDirection `protobuf:"varint,8,opt,name=direction,proto3,enum=systems.daggerheart.v1.DaggerheartCountdownDirection" json:"direction,omitempty"`
-	Looping       bool                          `protobuf:"varint,9,opt,name=looping,proto3" json:"looping,omitempty"`
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	CampaignId    string                          `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                          `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CountdownId   string                          `protobuf:"bytes,3,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	Name          string                          `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          DaggerheartCountdownKind        `protobuf:"varint,5,opt,name=kind,proto3,enum=systems.daggerheart.v1.DaggerheartCountdownKind" json:"kind,omitempty"`
+	Current       int32                           `protobuf:"varint,6,opt,name=current,proto3" json:"current,omitempty"`
+	Max           int32                           `protobuf:"varint,7,opt,name=max,proto3" json:"max,omitempty"`
+	Direction     DaggerheartCountdownDirection   `protobuf:"varint,8,opt,name=direction,proto3,enum=systems.daggerheart.v1.DaggerheartCountdownDirection" json:"direction,omitempty"`
+	Looping       bool                            `protobuf:"varint,9,opt,name=looping,proto3" json:"looping,omitempty"`
+	TickPolicy    *DaggerheartCountdownTickPolicy `protobuf:"bytes,10,opt,name=tick_policy,json=tickPolicy,proto3" json:"tick_policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DaggerheartCreateCountdownRequest) Reset() {
 	*x = DaggerheartCreateCountdownRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[21]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1629,7 +2286,7 @@ func (x *DaggerheartCreateCountdownRequest) String() string {
 func (*DaggerheartCreateCountdownRequest) ProtoMessage() {}
 
 func (x *DaggerheartCreateCountdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[21]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1642,7 +2299,7 @@ func (x *DaggerheartCreateCountdownRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DaggerheartCreateCountdownRequest.ProtoReflect.Descriptor instead.
 func (*DaggerheartCreateCountdownRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{21}
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DaggerheartCreateCountdownRequest) GetCampaignId() string {
@@ -1708,6 +2365,13 @@ func (x *DaggerheartCreateCountdownRequest) GetLooping() bool {
 	return false
 }
 
+func (x *DaggerheartCreateCountdownRequest) GetTickPolicy() *DaggerheartCountdownTickPolicy {
+	if x != nil {
+		return x.TickPolicy
+	}
+	return nil
+}
+
 type DaggerheartCreateCountdownResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Countdown     *DaggerheartCountdown  `protobuf:"bytes,1,opt,name=countdown,proto3" json:"countdown,omitempty"`
@@ -1717,7 +2381,7 @@ type DaggerheartCreateCountdownResponse struct {
 
 func (x *DaggerheartCreateCountdownResponse) Reset() {
 	*x = DaggerheartCreateCountdownResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[22]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1729,7 +2393,7 @@ func (x *DaggerheartCreateCountdownResponse) String() string {
 func (*DaggerheartCreateCountdownResponse) ProtoMessage() {}
 
 func (x *DaggerheartCreateCountdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[22]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1742,7 +2406,7 @@ func (x *DaggerheartCreateCountdownResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DaggerheartCreateCountdownResponse.ProtoReflect.Descriptor instead.
 func (*DaggerheartCreateCountdownResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{22}
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DaggerheartCreateCountdownResponse) GetCountdown() *DaggerheartCountdown {
@@ -1766,7 +2430,7 @@ type DaggerheartUpdateCountdownRequest struct {
 
 func (x *DaggerheartUpdateCountdownRequest) Reset() {
 	*x = DaggerheartUpdateCountdownRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[23]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1778,7 +2442,7 @@ func (x *DaggerheartUpdateCountdownRequest) String() string {
 func (*DaggerheartUpdateCountdownRequest) ProtoMessage() {}
 
 func (x *DaggerheartUpdateCountdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[23]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,7 +2455,7 @@ func (x *DaggerheartUpdateCountdownRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DaggerheartUpdateCountdownRequest.ProtoReflect.Descriptor instead.
 func (*DaggerheartUpdateCountdownRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{23}
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DaggerheartUpdateCountdownRequest) GetCampaignId() string {
@@ -1848,7 +2512,7 @@ type DaggerheartUpdateCountdownResponse struct {
 
 func (x *DaggerheartUpdateCountdownResponse) Reset() {
 	*x = DaggerheartUpdateCountdownResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[24]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1860,7 +2524,7 @@ func (x *DaggerheartUpdateCountdownResponse) String() string {
 func (*DaggerheartUpdateCountdownResponse) ProtoMessage() {}
 
 func (x *DaggerheartUpdateCountdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[24]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1873,7 +2537,7 @@ func (x *DaggerheartUpdateCountdownResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DaggerheartUpdateCountdownResponse.ProtoReflect.Descriptor instead.
 func (*DaggerheartUpdateCountdownResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{24}
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DaggerheartUpdateCountdownResponse) GetCountdown() *DaggerheartCountdown {
@@ -1916,7 +2580,7 @@ type DaggerheartDeleteCountdownRequest struct {
 
 func (x *DaggerheartDeleteCountdownRequest) Reset() {
 	*x = DaggerheartDeleteCountdownRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[25]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1928,7 +2592,7 @@ func (x *DaggerheartDeleteCountdownRequest) String() string {
 func (*DaggerheartDeleteCountdownRequest) ProtoMessage() {}
 
 func (x *DaggerheartDeleteCountdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[25]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1941,7 +2605,7 @@ func (x *DaggerheartDeleteCountdownRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DaggerheartDeleteCountdownRequest.ProtoReflect.Descriptor instead.
 func (*DaggerheartDeleteCountdownRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{25}
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DaggerheartDeleteCountdownRequest) GetCampaignId() string {
@@ -1981,7 +2645,7 @@ type DaggerheartDeleteCountdownResponse struct {
 
 func (x *DaggerheartDeleteCountdownResponse) Reset() {
 	*x = DaggerheartDeleteCountdownResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[26]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1993,7 +2657,7 @@ func (x *DaggerheartDeleteCountdownResponse) String() string {
 func (*DaggerheartDeleteCountdownResponse) ProtoMessage() {}
 
 func (x *DaggerheartDeleteCountdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[26]
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2006,7 +2670,7 @@ func (x *DaggerheartDeleteCountdownResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DaggerheartDeleteCountdownResponse.ProtoReflect.Descriptor instead.
 func (*DaggerheartDeleteCountdownResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{26}
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DaggerheartDeleteCountdownResponse) GetCountdownId() string {
@@ -2016,44 +2680,35 @@ func (x *DaggerheartDeleteCountdownResponse) GetCountdownId() string {
 	return ""
 }
 
-type DaggerheartAdversary struct {
-	state           protoimpl.MessageState  `protogen:"open.v1"`
-	Id              string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CampaignId      string                  `protobuf:"bytes,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	Name            string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Kind            string                  `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
-	SessionId       *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Notes           string                  `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	Hp              int32                   `protobuf:"varint,7,opt,name=hp,proto3" json:"hp,omitempty"`
-	HpMax           int32                   `protobuf:"varint,8,opt,name=hp_max,json=hpMax,proto3" json:"hp_max,omitempty"`
-	Stress          int32                   `protobuf:"varint,9,opt,name=stress,proto3" json:"stress,omitempty"`
-	StressMax       int32                   `protobuf:"varint,10,opt,name=stress_max,json=stressMax,proto3" json:"stress_max,omitempty"`
-	Evasion         int32                   `protobuf:"varint,11,opt,name=evasion,proto3" json:"evasion,omitempty"`
-	MajorThreshold  int32                   `protobuf:"varint,12,opt,name=major_threshold,json=majorThreshold,proto3" json:"major_threshold,omitempty"`
-	SevereThreshold int32                   `protobuf:"varint,13,opt,name=severe_threshold,json=severeThreshold,proto3" json:"severe_threshold,omitempty"`
-	Armor           int32                   `protobuf:"varint,14,opt,name=armor,proto3" json:"armor,omitempty"`
-	Conditions      []DaggerheartCondition  `protobuf:"varint,15,rep,packed,name=conditions,proto3,enum=systems.daggerheart.v1.DaggerheartCondition" json:"conditions,omitempty"`
-	CreatedAt       *timestamppb.Timestamp  `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp  `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// DaggerheartChase links a progress countdown racing a consequence countdown.
+// Both tick on every action roll; the first to trigger decides the winner.
+type DaggerheartChase struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChaseId       string                 `protobuf:"bytes,1,opt,name=chase_id,json=chaseId,proto3" json:"chase_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status        DaggerheartChaseStatus `protobuf:"varint,3,opt,name=status,proto3,enum=systems.daggerheart.v1.DaggerheartChaseStatus" json:"status,omitempty"`
+	Winner        DaggerheartChaseWinner `protobuf:"varint,4,opt,name=winner,proto3,enum=systems.daggerheart.v1.DaggerheartChaseWinner" json:"winner,omitempty"`
+	Progress      *DaggerheartCountdown  `protobuf:"bytes,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	Consequence   *DaggerheartCountdown  `protobuf:"bytes,6,opt,name=consequence,proto3" json:"consequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartAdversary) Reset() {
-	*x = DaggerheartAdversary{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[27]
+func (x *DaggerheartChase) Reset() {
+	*x = DaggerheartChase{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartAdversary) String() string {
+func (x *DaggerheartChase) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartAdversary) ProtoMessage() {}
+func (*DaggerheartChase) ProtoMessage() {}
 
-func (x *DaggerheartAdversary) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[27]
+func (x *DaggerheartChase) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2064,164 +2719,151 @@ func (x *DaggerheartAdversary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartAdversary.ProtoReflect.Descriptor instead.
-func (*DaggerheartAdversary) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use DaggerheartChase.ProtoReflect.Descriptor instead.
+func (*DaggerheartChase) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *DaggerheartAdversary) GetId() string {
+func (x *DaggerheartChase) GetChaseId() string {
 	if x != nil {
-		return x.Id
+		return x.ChaseId
 	}
 	return ""
 }
 
-func (x *DaggerheartAdversary) GetCampaignId() string {
+func (x *DaggerheartChase) GetName() string {
 	if x != nil {
-		return x.CampaignId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DaggerheartAdversary) GetName() string {
+func (x *DaggerheartChase) GetStatus() DaggerheartChaseStatus {
 	if x != nil {
-		return x.Name
+		return x.Status
 	}
-	return ""
+	return DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_UNSPECIFIED
 }
 
-func (x *DaggerheartAdversary) GetKind() string {
+func (x *DaggerheartChase) GetWinner() DaggerheartChaseWinner {
 	if x != nil {
-		return x.Kind
+		return x.Winner
 	}
-	return ""
+	return DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_UNSPECIFIED
 }
 
-func (x *DaggerheartAdversary) GetSessionId() *wrapperspb.StringValue {
+func (x *DaggerheartChase) GetProgress() *DaggerheartCountdown {
 	if x != nil {
-		return x.SessionId
+		return x.Progress
 	}
 	return nil
 }
 
-func (x *DaggerheartAdversary) GetNotes() string {
+func (x *DaggerheartChase) GetConsequence() *DaggerheartCountdown {
 	if x != nil {
-		return x.Notes
+		return x.Consequence
 	}
-	return ""
+	return nil
 }
 
-func (x *DaggerheartAdversary) GetHp() int32 {
-	if x != nil {
-		return x.Hp
-	}
-	return 0
+// DaggerheartChaseCountdownSpec describes one side of a chase. The countdown
+// starts at max and ticks down to zero. Outcome deltas replace the default
+// dynamic ticks for the listed outcomes.
+type DaggerheartChaseCountdownSpec struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	CountdownId   string                              `protobuf:"bytes,1,opt,name=countdown_id,json=countdownId,proto3" json:"countdown_id,omitempty"`
+	Name          string                              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Max           int32                               `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
+	OutcomeDeltas []*DaggerheartCountdownOutcomeDelta `protobuf:"bytes,4,rep,name=outcome_deltas,json=outcomeDeltas,proto3" json:"outcome_deltas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartAdversary) GetHpMax() int32 {
+func (x *DaggerheartChaseCountdownSpec) Reset() {
+	*x = DaggerheartChaseCountdownSpec{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartChaseCountdownSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartChaseCountdownSpec) ProtoMessage() {}
+
+func (x *DaggerheartChaseCountdownSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[30]
 	if x != nil {
-		return x.HpMax
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DaggerheartAdversary) GetStress() int32 {
+// Deprecated: Use DaggerheartChaseCountdownSpec.ProtoReflect.Descriptor instead.
+func (*DaggerheartChaseCountdownSpec) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DaggerheartChaseCountdownSpec) GetCountdownId() string {
 	if x != nil {
-		return x.Stress
+		return x.CountdownId
 	}
-	return 0
+	return ""
 }
 
-func (x *DaggerheartAdversary) GetStressMax() int32 {
+func (x *DaggerheartChaseCountdownSpec) GetName() string {
 	if x != nil {
-		return x.StressMax
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *DaggerheartAdversary) GetEvasion() int32 {
+func (x *DaggerheartChaseCountdownSpec) GetMax() int32 {
 	if x != nil {
-		return x.Evasion
+		return x.Max
 	}
 	return 0
 }
 
-func (x *DaggerheartAdversary) GetMajorThreshold() int32 {
+func (x *DaggerheartChaseCountdownSpec) GetOutcomeDeltas() []*DaggerheartCountdownOutcomeDelta {
 	if x != nil {
-		return x.MajorThreshold
+		return x.OutcomeDeltas
 	}
-	return 0
+	return nil
 }
 
-func (x *DaggerheartAdversary) GetSevereThreshold() int32 {
-	if x != nil {
-		return x.SevereThreshold
-	}
-	return 0
-}
-
-func (x *DaggerheartAdversary) GetArmor() int32 {
-	if x != nil {
-		return x.Armor
-	}
-	return 0
-}
-
-func (x *DaggerheartAdversary) GetConditions() []DaggerheartCondition {
-	if x != nil {
-		return x.Conditions
-	}
-	return nil
-}
-
-func (x *DaggerheartAdversary) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *DaggerheartAdversary) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-type DaggerheartCreateAdversaryRequest struct {
-	state           protoimpl.MessageState  `protogen:"open.v1"`
-	CampaignId      string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	Name            string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Kind            string                  `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
-	SessionId       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Notes           string                  `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
-	Hp              *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=hp,proto3" json:"hp,omitempty"`
-	HpMax           *wrapperspb.Int32Value  `protobuf:"bytes,7,opt,name=hp_max,json=hpMax,proto3" json:"hp_max,omitempty"`
-	Stress          *wrapperspb.Int32Value  `protobuf:"bytes,8,opt,name=stress,proto3" json:"stress,omitempty"`
-	StressMax       *wrapperspb.Int32Value  `protobuf:"bytes,9,opt,name=stress_max,json=stressMax,proto3" json:"stress_max,omitempty"`
-	Evasion         *wrapperspb.Int32Value  `protobuf:"bytes,10,opt,name=evasion,proto3" json:"evasion,omitempty"`
-	MajorThreshold  *wrapperspb.Int32Value  `protobuf:"bytes,11,opt,name=major_threshold,json=majorThreshold,proto3" json:"major_threshold,omitempty"`
-	SevereThreshold *wrapperspb.Int32Value  `protobuf:"bytes,12,opt,name=severe_threshold,json=severeThreshold,proto3" json:"severe_threshold,omitempty"`
-	Armor           *wrapperspb.Int32Value  `protobuf:"bytes,13,opt,name=armor,proto3" json:"armor,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type DaggerheartStartChaseRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	CampaignId    string                         `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                         `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ChaseId       string                         `protobuf:"bytes,3,opt,name=chase_id,json=chaseId,proto3" json:"chase_id,omitempty"`
+	Name          string                         `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Progress      *DaggerheartChaseCountdownSpec `protobuf:"bytes,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	Consequence   *DaggerheartChaseCountdownSpec `protobuf:"bytes,6,opt,name=consequence,proto3" json:"consequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartCreateAdversaryRequest) Reset() {
-	*x = DaggerheartCreateAdversaryRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[28]
+func (x *DaggerheartStartChaseRequest) Reset() {
+	*x = DaggerheartStartChaseRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartCreateAdversaryRequest) String() string {
+func (x *DaggerheartStartChaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartCreateAdversaryRequest) ProtoMessage() {}
+func (*DaggerheartStartChaseRequest) ProtoMessage() {}
 
-func (x *DaggerheartCreateAdversaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[28]
+func (x *DaggerheartStartChaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2232,124 +2874,171 @@ func (x *DaggerheartCreateAdversaryRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartCreateAdversaryRequest.ProtoReflect.Descriptor instead.
-func (*DaggerheartCreateAdversaryRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use DaggerheartStartChaseRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartStartChaseRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetCampaignId() string {
+func (x *DaggerheartStartChaseRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetName() string {
+func (x *DaggerheartStartChaseRequest) GetSessionId() string {
 	if x != nil {
-		return x.Name
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetKind() string {
+func (x *DaggerheartStartChaseRequest) GetChaseId() string {
 	if x != nil {
-		return x.Kind
+		return x.ChaseId
 	}
 	return ""
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetSessionId() *wrapperspb.StringValue {
-	if x != nil {
-		return x.SessionId
-	}
-	return nil
-}
-
-func (x *DaggerheartCreateAdversaryRequest) GetNotes() string {
+func (x *DaggerheartStartChaseRequest) GetName() string {
 	if x != nil {
-		return x.Notes
+		return x.Name
 	}
 	return ""
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetHp() *wrapperspb.Int32Value {
+func (x *DaggerheartStartChaseRequest) GetProgress() *DaggerheartChaseCountdownSpec {
 	if x != nil {
-		return x.Hp
+		return x.Progress
 	}
 	return nil
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetHpMax() *wrapperspb.Int32Value {
+func (x *DaggerheartStartChaseRequest) GetConsequence() *DaggerheartChaseCountdownSpec {
 	if x != nil {
-		return x.HpMax
+		return x.Consequence
 	}
 	return nil
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetStress() *wrapperspb.Int32Value {
-	if x != nil {
-		return x.Stress
-	}
-	return nil
+type DaggerheartStartChaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chase         *DaggerheartChase      `protobuf:"bytes,1,opt,name=chase,proto3" json:"chase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetStressMax() *wrapperspb.Int32Value {
+func (x *DaggerheartStartChaseResponse) Reset() {
+	*x = DaggerheartStartChaseResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartStartChaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartStartChaseResponse) ProtoMessage() {}
+
+func (x *DaggerheartStartChaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[32]
 	if x != nil {
-		return x.StressMax
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetEvasion() *wrapperspb.Int32Value {
+// Deprecated: Use DaggerheartStartChaseResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartStartChaseResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DaggerheartStartChaseResponse) GetChase() *DaggerheartChase {
 	if x != nil {
-		return x.Evasion
+		return x.Chase
 	}
 	return nil
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetMajorThreshold() *wrapperspb.Int32Value {
+type DaggerheartGetChaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	ChaseId       string                 `protobuf:"bytes,2,opt,name=chase_id,json=chaseId,proto3" json:"chase_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartGetChaseRequest) Reset() {
+	*x = DaggerheartGetChaseRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartGetChaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartGetChaseRequest) ProtoMessage() {}
+
+func (x *DaggerheartGetChaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[33]
 	if x != nil {
-		return x.MajorThreshold
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetSevereThreshold() *wrapperspb.Int32Value {
+// Deprecated: Use DaggerheartGetChaseRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartGetChaseRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DaggerheartGetChaseRequest) GetCampaignId() string {
 	if x != nil {
-		return x.SevereThreshold
+		return x.CampaignId
 	}
-	return nil
+	return ""
 }
 
-func (x *DaggerheartCreateAdversaryRequest) GetArmor() *wrapperspb.Int32Value {
+func (x *DaggerheartGetChaseRequest) GetChaseId() string {
 	if x != nil {
-		return x.Armor
+		return x.ChaseId
 	}
-	return nil
+	return ""
 }
 
-type DaggerheartCreateAdversaryResponse struct {
+type DaggerheartGetChaseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Adversary     *DaggerheartAdversary  `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	Chase         *DaggerheartChase      `protobuf:"bytes,1,opt,name=chase,proto3" json:"chase,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartCreateAdversaryResponse) Reset() {
-	*x = DaggerheartCreateAdversaryResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[29]
+func (x *DaggerheartGetChaseResponse) Reset() {
+	*x = DaggerheartGetChaseResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartCreateAdversaryResponse) String() string {
+func (x *DaggerheartGetChaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartCreateAdversaryResponse) ProtoMessage() {}
+func (*DaggerheartGetChaseResponse) ProtoMessage() {}
 
-func (x *DaggerheartCreateAdversaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[29]
+func (x *DaggerheartGetChaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2360,53 +3049,40 @@ func (x *DaggerheartCreateAdversaryResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartCreateAdversaryResponse.ProtoReflect.Descriptor instead.
-func (*DaggerheartCreateAdversaryResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DaggerheartGetChaseResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartGetChaseResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DaggerheartCreateAdversaryResponse) GetAdversary() *DaggerheartAdversary {
+func (x *DaggerheartGetChaseResponse) GetChase() *DaggerheartChase {
 	if x != nil {
-		return x.Adversary
+		return x.Chase
 	}
 	return nil
 }
 
-type DaggerheartUpdateAdversaryRequest struct {
-	state           protoimpl.MessageState  `protogen:"open.v1"`
-	CampaignId      string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	AdversaryId     string                  `protobuf:"bytes,2,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
-	Name            *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Kind            *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
-	SessionId       *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Notes           *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	Hp              *wrapperspb.Int32Value  `protobuf:"bytes,7,opt,name=hp,proto3" json:"hp,omitempty"`
-	HpMax           *wrapperspb.Int32Value  `protobuf:"bytes,8,opt,name=hp_max,json=hpMax,proto3" json:"hp_max,omitempty"`
-	Stress          *wrapperspb.Int32Value  `protobuf:"bytes,9,opt,name=stress,proto3" json:"stress,omitempty"`
-	StressMax       *wrapperspb.Int32Value  `protobuf:"bytes,10,opt,name=stress_max,json=stressMax,proto3" json:"stress_max,omitempty"`
-	Evasion         *wrapperspb.Int32Value  `protobuf:"bytes,11,opt,name=evasion,proto3" json:"evasion,omitempty"`
-	MajorThreshold  *wrapperspb.Int32Value  `protobuf:"bytes,12,opt,name=major_threshold,json=majorThreshold,proto3" json:"major_threshold,omitempty"`
-	SevereThreshold *wrapperspb.Int32Value  `protobuf:"bytes,13,opt,name=severe_threshold,json=severeThreshold,proto3" json:"severe_threshold,omitempty"`
-	Armor           *wrapperspb.Int32Value  `protobuf:"bytes,14,opt,name=armor,proto3" json:"armor,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type DaggerheartListChasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) Reset() {
-	*x = DaggerheartUpdateAdversaryRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[30]
+func (x *DaggerheartListChasesRequest) Reset() {
+	*x = DaggerheartListChasesRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) String() string {
+func (x *DaggerheartListChasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartUpdateAdversaryRequest) ProtoMessage() {}
+func (*DaggerheartListChasesRequest) ProtoMessage() {}
 
-func (x *DaggerheartUpdateAdversaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[30]
+func (x *DaggerheartListChasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2417,131 +3093,139 @@ func (x *DaggerheartUpdateAdversaryRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartUpdateAdversaryRequest.ProtoReflect.Descriptor instead.
-func (*DaggerheartUpdateAdversaryRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use DaggerheartListChasesRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartListChasesRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetCampaignId() string {
+func (x *DaggerheartListChasesRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetAdversaryId() string {
-	if x != nil {
-		return x.AdversaryId
-	}
-	return ""
+type DaggerheartListChasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chases        []*DaggerheartChase    `protobuf:"bytes,1,rep,name=chases,proto3" json:"chases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetName() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Name
-	}
-	return nil
+func (x *DaggerheartListChasesResponse) Reset() {
+	*x = DaggerheartListChasesResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetKind() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Kind
-	}
-	return nil
+func (x *DaggerheartListChasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetSessionId() *wrapperspb.StringValue {
+func (*DaggerheartListChasesResponse) ProtoMessage() {}
+
+func (x *DaggerheartListChasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[36]
 	if x != nil {
-		return x.SessionId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetNotes() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Notes
-	}
-	return nil
+// Deprecated: Use DaggerheartListChasesResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartListChasesResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetHp() *wrapperspb.Int32Value {
+func (x *DaggerheartListChasesResponse) GetChases() []*DaggerheartChase {
 	if x != nil {
-		return x.Hp
+		return x.Chases
 	}
 	return nil
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetHpMax() *wrapperspb.Int32Value {
-	if x != nil {
-		return x.HpMax
-	}
-	return nil
+type DaggerheartSceneEntity struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Id            string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          DaggerheartSceneEntityType `protobuf:"varint,2,opt,name=type,proto3,enum=systems.daggerheart.v1.DaggerheartSceneEntityType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetStress() *wrapperspb.Int32Value {
-	if x != nil {
-		return x.Stress
-	}
-	return nil
+func (x *DaggerheartSceneEntity) Reset() {
+	*x = DaggerheartSceneEntity{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetStressMax() *wrapperspb.Int32Value {
-	if x != nil {
-		return x.StressMax
-	}
-	return nil
+func (x *DaggerheartSceneEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetEvasion() *wrapperspb.Int32Value {
+func (*DaggerheartSceneEntity) ProtoMessage() {}
+
+func (x *DaggerheartSceneEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[37]
 	if x != nil {
-		return x.Evasion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetMajorThreshold() *wrapperspb.Int32Value {
-	if x != nil {
-		return x.MajorThreshold
-	}
-	return nil
+// Deprecated: Use DaggerheartSceneEntity.ProtoReflect.Descriptor instead.
+func (*DaggerheartSceneEntity) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetSevereThreshold() *wrapperspb.Int32Value {
+func (x *DaggerheartSceneEntity) GetId() string {
 	if x != nil {
-		return x.SevereThreshold
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *DaggerheartUpdateAdversaryRequest) GetArmor() *wrapperspb.Int32Value {
+func (x *DaggerheartSceneEntity) GetType() DaggerheartSceneEntityType {
 	if x != nil {
-		return x.Armor
+		return x.Type
 	}
-	return nil
+	return DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_UNSPECIFIED
 }
 
-type DaggerheartUpdateAdversaryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Adversary     *DaggerheartAdversary  `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+// Relative range band between two scene entities; ranges are symmetric.
+type DaggerheartSceneRange struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	From          *DaggerheartSceneEntity `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *DaggerheartSceneEntity `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Band          DaggerheartRangeBand    `protobuf:"varint,3,opt,name=band,proto3,enum=systems.daggerheart.v1.DaggerheartRangeBand" json:"band,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartUpdateAdversaryResponse) Reset() {
-	*x = DaggerheartUpdateAdversaryResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[31]
+func (x *DaggerheartSceneRange) Reset() {
+	*x = DaggerheartSceneRange{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartUpdateAdversaryResponse) String() string {
+func (x *DaggerheartSceneRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartUpdateAdversaryResponse) ProtoMessage() {}
+func (*DaggerheartSceneRange) ProtoMessage() {}
 
-func (x *DaggerheartUpdateAdversaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[31]
+func (x *DaggerheartSceneRange) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2552,42 +3236,56 @@ func (x *DaggerheartUpdateAdversaryResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartUpdateAdversaryResponse.ProtoReflect.Descriptor instead.
-func (*DaggerheartUpdateAdversaryResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use DaggerheartSceneRange.ProtoReflect.Descriptor instead.
+func (*DaggerheartSceneRange) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *DaggerheartUpdateAdversaryResponse) GetAdversary() *DaggerheartAdversary {
+func (x *DaggerheartSceneRange) GetFrom() *DaggerheartSceneEntity {
 	if x != nil {
-		return x.Adversary
+		return x.From
 	}
 	return nil
 }
 
-type DaggerheartDeleteAdversaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	AdversaryId   string                 `protobuf:"bytes,2,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+func (x *DaggerheartSceneRange) GetTo() *DaggerheartSceneEntity {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DaggerheartSceneRange) GetBand() DaggerheartRangeBand {
+	if x != nil {
+		return x.Band
+	}
+	return DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_UNSPECIFIED
+}
+
+type DaggerheartSetSceneRangesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	CampaignId    string                   `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                   `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Ranges        []*DaggerheartSceneRange `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartDeleteAdversaryRequest) Reset() {
-	*x = DaggerheartDeleteAdversaryRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[32]
+func (x *DaggerheartSetSceneRangesRequest) Reset() {
+	*x = DaggerheartSetSceneRangesRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartDeleteAdversaryRequest) String() string {
+func (x *DaggerheartSetSceneRangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartDeleteAdversaryRequest) ProtoMessage() {}
+func (*DaggerheartSetSceneRangesRequest) ProtoMessage() {}
 
-func (x *DaggerheartDeleteAdversaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[32]
+func (x *DaggerheartSetSceneRangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2598,54 +3296,54 @@ func (x *DaggerheartDeleteAdversaryRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartDeleteAdversaryRequest.ProtoReflect.Descriptor instead.
-func (*DaggerheartDeleteAdversaryRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use DaggerheartSetSceneRangesRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartSetSceneRangesRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *DaggerheartDeleteAdversaryRequest) GetCampaignId() string {
+func (x *DaggerheartSetSceneRangesRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *DaggerheartDeleteAdversaryRequest) GetAdversaryId() string {
+func (x *DaggerheartSetSceneRangesRequest) GetSessionId() string {
 	if x != nil {
-		return x.AdversaryId
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *DaggerheartDeleteAdversaryRequest) GetReason() string {
+func (x *DaggerheartSetSceneRangesRequest) GetRanges() []*DaggerheartSceneRange {
 	if x != nil {
-		return x.Reason
+		return x.Ranges
 	}
-	return ""
+	return nil
 }
 
-type DaggerheartDeleteAdversaryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Adversary     *DaggerheartAdversary  `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+type DaggerheartSetSceneRangesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Ranges        []*DaggerheartSceneRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartDeleteAdversaryResponse) Reset() {
-	*x = DaggerheartDeleteAdversaryResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[33]
+func (x *DaggerheartSetSceneRangesResponse) Reset() {
+	*x = DaggerheartSetSceneRangesResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartDeleteAdversaryResponse) String() string {
+func (x *DaggerheartSetSceneRangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartDeleteAdversaryResponse) ProtoMessage() {}
+func (*DaggerheartSetSceneRangesResponse) ProtoMessage() {}
 
-func (x *DaggerheartDeleteAdversaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[33]
+func (x *DaggerheartSetSceneRangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2656,41 +3354,41 @@ func (x *DaggerheartDeleteAdversaryResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartDeleteAdversaryResponse.ProtoReflect.Descriptor instead.
-func (*DaggerheartDeleteAdversaryResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DaggerheartSetSceneRangesResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartSetSceneRangesResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DaggerheartDeleteAdversaryResponse) GetAdversary() *DaggerheartAdversary {
+func (x *DaggerheartSetSceneRangesResponse) GetRanges() []*DaggerheartSceneRange {
 	if x != nil {
-		return x.Adversary
+		return x.Ranges
 	}
 	return nil
 }
 
-type DaggerheartGetAdversaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	AdversaryId   string                 `protobuf:"bytes,2,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+type DaggerheartSceneRangeUpdate struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Target        *DaggerheartSceneEntity `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Band          DaggerheartRangeBand    `protobuf:"varint,2,opt,name=band,proto3,enum=systems.daggerheart.v1.DaggerheartRangeBand" json:"band,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartGetAdversaryRequest) Reset() {
-	*x = DaggerheartGetAdversaryRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[34]
+func (x *DaggerheartSceneRangeUpdate) Reset() {
+	*x = DaggerheartSceneRangeUpdate{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartGetAdversaryRequest) String() string {
+func (x *DaggerheartSceneRangeUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartGetAdversaryRequest) ProtoMessage() {}
+func (*DaggerheartSceneRangeUpdate) ProtoMessage() {}
 
-func (x *DaggerheartGetAdversaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[34]
+func (x *DaggerheartSceneRangeUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2701,47 +3399,58 @@ func (x *DaggerheartGetAdversaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartGetAdversaryRequest.ProtoReflect.Descriptor instead.
-func (*DaggerheartGetAdversaryRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use DaggerheartSceneRangeUpdate.ProtoReflect.Descriptor instead.
+func (*DaggerheartSceneRangeUpdate) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DaggerheartGetAdversaryRequest) GetCampaignId() string {
+func (x *DaggerheartSceneRangeUpdate) GetTarget() *DaggerheartSceneEntity {
 	if x != nil {
-		return x.CampaignId
+		return x.Target
 	}
-	return ""
+	return nil
 }
 
-func (x *DaggerheartGetAdversaryRequest) GetAdversaryId() string {
+func (x *DaggerheartSceneRangeUpdate) GetBand() DaggerheartRangeBand {
 	if x != nil {
-		return x.AdversaryId
+		return x.Band
 	}
-	return ""
+	return DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_UNSPECIFIED
 }
 
-type DaggerheartGetAdversaryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Adversary     *DaggerheartAdversary  `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+type DaggerheartMoveSceneEntityRequest struct {
+	state      protoimpl.MessageState  `protogen:"open.v1"`
+	CampaignId string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId  string                  `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Entity     *DaggerheartSceneEntity `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	Kind       DaggerheartMovementKind `protobuf:"varint,4,opt,name=kind,proto3,enum=systems.daggerheart.v1.DaggerheartMovementKind" json:"kind,omitempty"`
+	// Creature or effect causing knockback or forced movement.
+	Source *DaggerheartSceneEntity `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	// New range bands from the moved entity to other scene entities.
+	Ranges []*DaggerheartSceneRangeUpdate `protobuf:"bytes,6,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	// Knockback only: bands to push the entity away from the source when no
+	// explicit range to the source is given.
+	PushBands     int32  `protobuf:"varint,7,opt,name=push_bands,json=pushBands,proto3" json:"push_bands,omitempty"`
+	Reason        string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartGetAdversaryResponse) Reset() {
-	*x = DaggerheartGetAdversaryResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[35]
+func (x *DaggerheartMoveSceneEntityRequest) Reset() {
+	*x = DaggerheartMoveSceneEntityRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartGetAdversaryResponse) String() string {
+func (x *DaggerheartMoveSceneEntityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartGetAdversaryResponse) ProtoMessage() {}
+func (*DaggerheartMoveSceneEntityRequest) ProtoMessage() {}
 
-func (x *DaggerheartGetAdversaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[35]
+func (x *DaggerheartMoveSceneEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2752,92 +3461,89 @@ func (x *DaggerheartGetAdversaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartGetAdversaryResponse.ProtoReflect.Descriptor instead.
-func (*DaggerheartGetAdversaryResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use DaggerheartMoveSceneEntityRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartMoveSceneEntityRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DaggerheartGetAdversaryResponse) GetAdversary() *DaggerheartAdversary {
+func (x *DaggerheartMoveSceneEntityRequest) GetCampaignId() string {
 	if x != nil {
-		return x.Adversary
+		return x.CampaignId
 	}
-	return nil
+	return ""
 }
 
-type DaggerheartListAdversariesRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	CampaignId    string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	SessionId     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DaggerheartMoveSceneEntityRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
 }
 
-func (x *DaggerheartListAdversariesRequest) Reset() {
-	*x = DaggerheartListAdversariesRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DaggerheartMoveSceneEntityRequest) GetEntity() *DaggerheartSceneEntity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
 }
 
-func (x *DaggerheartListAdversariesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DaggerheartMoveSceneEntityRequest) GetKind() DaggerheartMovementKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_UNSPECIFIED
 }
 
-func (*DaggerheartListAdversariesRequest) ProtoMessage() {}
-
-func (x *DaggerheartListAdversariesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[36]
+func (x *DaggerheartMoveSceneEntityRequest) GetSource() *DaggerheartSceneEntity {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Source
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use DaggerheartListAdversariesRequest.ProtoReflect.Descriptor instead.
-func (*DaggerheartListAdversariesRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{36}
+func (x *DaggerheartMoveSceneEntityRequest) GetRanges() []*DaggerheartSceneRangeUpdate {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
 }
 
-func (x *DaggerheartListAdversariesRequest) GetCampaignId() string {
+func (x *DaggerheartMoveSceneEntityRequest) GetPushBands() int32 {
 	if x != nil {
-		return x.CampaignId
+		return x.PushBands
 	}
-	return ""
+	return 0
 }
 
-func (x *DaggerheartListAdversariesRequest) GetSessionId() *wrapperspb.StringValue {
+func (x *DaggerheartMoveSceneEntityRequest) GetReason() string {
 	if x != nil {
-		return x.SessionId
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type DaggerheartListAdversariesResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Adversaries   []*DaggerheartAdversary `protobuf:"bytes,1,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+type DaggerheartMoveSceneEntityResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Ranges        []*DaggerheartSceneRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartListAdversariesResponse) Reset() {
-	*x = DaggerheartListAdversariesResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[37]
+func (x *DaggerheartMoveSceneEntityResponse) Reset() {
+	*x = DaggerheartMoveSceneEntityResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartListAdversariesResponse) String() string {
+func (x *DaggerheartMoveSceneEntityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartListAdversariesResponse) ProtoMessage() {}
+func (*DaggerheartMoveSceneEntityResponse) ProtoMessage() {}
 
-func (x *DaggerheartListAdversariesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[37]
+func (x *DaggerheartMoveSceneEntityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2848,41 +3554,43 @@ func (x *DaggerheartListAdversariesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartListAdversariesResponse.ProtoReflect.Descriptor instead.
-func (*DaggerheartListAdversariesResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use DaggerheartMoveSceneEntityResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartMoveSceneEntityResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DaggerheartListAdversariesResponse) GetAdversaries() []*DaggerheartAdversary {
+func (x *DaggerheartMoveSceneEntityResponse) GetRanges() []*DaggerheartSceneRange {
 	if x != nil {
-		return x.Adversaries
+		return x.Ranges
 	}
 	return nil
 }
 
-type DaggerheartResolveBlazeOfGloryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	CharacterId   string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+type DaggerheartListSceneRangesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId  string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Optional entity filter; when set only ranges involving it are returned.
+	EntityId      string `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartResolveBlazeOfGloryRequest) Reset() {
-	*x = DaggerheartResolveBlazeOfGloryRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[38]
+func (x *DaggerheartListSceneRangesRequest) Reset() {
+	*x = DaggerheartListSceneRangesRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartResolveBlazeOfGloryRequest) String() string {
+func (x *DaggerheartListSceneRangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartResolveBlazeOfGloryRequest) ProtoMessage() {}
+func (*DaggerheartListSceneRangesRequest) ProtoMessage() {}
 
-func (x *DaggerheartResolveBlazeOfGloryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[38]
+func (x *DaggerheartListSceneRangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2893,47 +3601,54 @@ func (x *DaggerheartResolveBlazeOfGloryRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartResolveBlazeOfGloryRequest.ProtoReflect.Descriptor instead.
-func (*DaggerheartResolveBlazeOfGloryRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use DaggerheartListSceneRangesRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartListSceneRangesRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DaggerheartResolveBlazeOfGloryRequest) GetCampaignId() string {
+func (x *DaggerheartListSceneRangesRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *DaggerheartResolveBlazeOfGloryRequest) GetCharacterId() string {
+func (x *DaggerheartListSceneRangesRequest) GetSessionId() string {
 	if x != nil {
-		return x.CharacterId
+		return x.SessionId
 	}
 	return ""
 }
 
-type DaggerheartBlazeOfGloryResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LifeState     DaggerheartLifeState   `protobuf:"varint,1,opt,name=life_state,json=lifeState,proto3,enum=systems.daggerheart.v1.DaggerheartLifeState" json:"life_state,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+func (x *DaggerheartListSceneRangesRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
 
-func (x *DaggerheartBlazeOfGloryResult) Reset() {
-	*x = DaggerheartBlazeOfGloryResult{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[39]
+type DaggerheartListSceneRangesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Ranges        []*DaggerheartSceneRange `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartListSceneRangesResponse) Reset() {
+	*x = DaggerheartListSceneRangesResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartBlazeOfGloryResult) String() string {
+func (x *DaggerheartListSceneRangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartBlazeOfGloryResult) ProtoMessage() {}
+func (*DaggerheartListSceneRangesResponse) ProtoMessage() {}
 
-func (x *DaggerheartBlazeOfGloryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[39]
+func (x *DaggerheartListSceneRangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2944,42 +3659,47 @@ func (x *DaggerheartBlazeOfGloryResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartBlazeOfGloryResult.ProtoReflect.Descriptor instead.
-func (*DaggerheartBlazeOfGloryResult) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use DaggerheartListSceneRangesResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartListSceneRangesResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *DaggerheartBlazeOfGloryResult) GetLifeState() DaggerheartLifeState {
+func (x *DaggerheartListSceneRangesResponse) GetRanges() []*DaggerheartSceneRange {
 	if x != nil {
-		return x.LifeState
+		return x.Ranges
 	}
-	return DaggerheartLifeState_DAGGERHEART_LIFE_STATE_UNSPECIFIED
+	return nil
 }
 
-type DaggerheartResolveBlazeOfGloryResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	CharacterId   string                         `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	State         *DaggerheartCharacterState     `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Result        *DaggerheartBlazeOfGloryResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+// Environment active in a session, copied from the content catalog.
+type DaggerheartSessionEnvironment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	EnvironmentId string                 `protobuf:"bytes,3,opt,name=environment_id,json=environmentId,proto3" json:"environment_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Tier          int32                  `protobuf:"varint,5,opt,name=tier,proto3" json:"tier,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Difficulty    int32                  `protobuf:"varint,7,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartResolveBlazeOfGloryResponse) Reset() {
-	*x = DaggerheartResolveBlazeOfGloryResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[40]
+func (x *DaggerheartSessionEnvironment) Reset() {
+	*x = DaggerheartSessionEnvironment{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartResolveBlazeOfGloryResponse) String() string {
+func (x *DaggerheartSessionEnvironment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartResolveBlazeOfGloryResponse) ProtoMessage() {}
+func (*DaggerheartSessionEnvironment) ProtoMessage() {}
 
-func (x *DaggerheartResolveBlazeOfGloryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[40]
+func (x *DaggerheartSessionEnvironment) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2990,64 +3710,86 @@ func (x *DaggerheartResolveBlazeOfGloryResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartResolveBlazeOfGloryResponse.ProtoReflect.Descriptor instead.
-func (*DaggerheartResolveBlazeOfGloryResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use DaggerheartSessionEnvironment.ProtoReflect.Descriptor instead.
+func (*DaggerheartSessionEnvironment) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *DaggerheartResolveBlazeOfGloryResponse) GetCharacterId() string {
+func (x *DaggerheartSessionEnvironment) GetCampaignId() string {
 	if x != nil {
-		return x.CharacterId
+		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *DaggerheartResolveBlazeOfGloryResponse) GetState() *DaggerheartCharacterState {
+func (x *DaggerheartSessionEnvironment) GetSessionId() string {
 	if x != nil {
-		return x.State
+		return x.SessionId
 	}
-	return nil
+	return ""
 }
 
-func (x *DaggerheartResolveBlazeOfGloryResponse) GetResult() *DaggerheartBlazeOfGloryResult {
+func (x *DaggerheartSessionEnvironment) GetEnvironmentId() string {
 	if x != nil {
-		return x.Result
+		return x.EnvironmentId
 	}
-	return nil
+	return ""
 }
 
-type ActionRollRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Additive modifier applied after summing the two d12s.
-	Modifier int32 `protobuf:"varint,1,opt,name=modifier,proto3" json:"modifier,omitempty"`
-	// If omitted, the server returns a roll outcome (Hope/Fear/Crit) but does not
-	// classify as success/failure.
-	Difficulty *int32 `protobuf:"varint,2,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
-	// Count of advantage dice (d6). Cancels against disadvantage.
-	Advantage int32 `protobuf:"varint,3,opt,name=advantage,proto3" json:"advantage,omitempty"`
-	// Count of disadvantage dice (d6). Cancels against advantage.
-	Disadvantage int32 `protobuf:"varint,4,opt,name=disadvantage,proto3" json:"disadvantage,omitempty"`
-	// Optional RNG configuration for deterministic rolls.
-	Rng           *v1.RngRequest `protobuf:"bytes,5,opt,name=rng,proto3" json:"rng,omitempty"`
+func (x *DaggerheartSessionEnvironment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DaggerheartSessionEnvironment) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *DaggerheartSessionEnvironment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DaggerheartSessionEnvironment) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+type DaggerheartCreateSessionEnvironmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	EnvironmentId string                 `protobuf:"bytes,3,opt,name=environment_id,json=environmentId,proto3" json:"environment_id,omitempty"`
+	// Optional Difficulty override; defaults to the catalog Difficulty.
+	Difficulty    *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActionRollRequest) Reset() {
-	*x = ActionRollRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[41]
+func (x *DaggerheartCreateSessionEnvironmentRequest) Reset() {
+	*x = DaggerheartCreateSessionEnvironmentRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActionRollRequest) String() string {
+func (x *DaggerheartCreateSessionEnvironmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActionRollRequest) ProtoMessage() {}
+func (*DaggerheartCreateSessionEnvironmentRequest) ProtoMessage() {}
 
-func (x *ActionRollRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[41]
+func (x *DaggerheartCreateSessionEnvironmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,82 +3800,61 @@ func (x *ActionRollRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActionRollRequest.ProtoReflect.Descriptor instead.
-func (*ActionRollRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use DaggerheartCreateSessionEnvironmentRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartCreateSessionEnvironmentRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ActionRollRequest) GetModifier() int32 {
+func (x *DaggerheartCreateSessionEnvironmentRequest) GetCampaignId() string {
 	if x != nil {
-		return x.Modifier
-	}
-	return 0
-}
-
-func (x *ActionRollRequest) GetDifficulty() int32 {
-	if x != nil && x.Difficulty != nil {
-		return *x.Difficulty
+		return x.CampaignId
 	}
-	return 0
+	return ""
 }
 
-func (x *ActionRollRequest) GetAdvantage() int32 {
+func (x *DaggerheartCreateSessionEnvironmentRequest) GetSessionId() string {
 	if x != nil {
-		return x.Advantage
+		return x.SessionId
 	}
-	return 0
+	return ""
 }
 
-func (x *ActionRollRequest) GetDisadvantage() int32 {
+func (x *DaggerheartCreateSessionEnvironmentRequest) GetEnvironmentId() string {
 	if x != nil {
-		return x.Disadvantage
+		return x.EnvironmentId
 	}
-	return 0
+	return ""
 }
 
-func (x *ActionRollRequest) GetRng() *v1.RngRequest {
+func (x *DaggerheartCreateSessionEnvironmentRequest) GetDifficulty() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.Rng
+		return x.Difficulty
 	}
 	return nil
 }
 
-type ActionRollResponse struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Hope     int32                  `protobuf:"varint,1,opt,name=hope,proto3" json:"hope,omitempty"`
-	Fear     int32                  `protobuf:"varint,2,opt,name=fear,proto3" json:"fear,omitempty"`
-	Modifier int32                  `protobuf:"varint,3,opt,name=modifier,proto3" json:"modifier,omitempty"`
-	// Applied d6 roll when advantage/disadvantage is present.
-	AdvantageDie int32 `protobuf:"varint,4,opt,name=advantage_die,json=advantageDie,proto3" json:"advantage_die,omitempty"`
-	// Signed modifier derived from advantage_die.
-	AdvantageModifier int32 `protobuf:"varint,5,opt,name=advantage_modifier,json=advantageModifier,proto3" json:"advantage_modifier,omitempty"`
-	// Echoed back if provided in the request.
-	Difficulty *int32 `protobuf:"varint,6,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
-	// total = hope + fear + modifier
-	Total           int32           `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
-	IsCrit          bool            `protobuf:"varint,8,opt,name=is_crit,json=isCrit,proto3" json:"is_crit,omitempty"`
-	MeetsDifficulty bool            `protobuf:"varint,9,opt,name=meets_difficulty,json=meetsDifficulty,proto3" json:"meets_difficulty,omitempty"`
-	Outcome         Outcome         `protobuf:"varint,10,opt,name=outcome,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcome,omitempty"`
-	Rng             *v1.RngResponse `protobuf:"bytes,11,opt,name=rng,proto3" json:"rng,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type DaggerheartCreateSessionEnvironmentResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Environment   *DaggerheartSessionEnvironment `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActionRollResponse) Reset() {
-	*x = ActionRollResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[42]
+func (x *DaggerheartCreateSessionEnvironmentResponse) Reset() {
+	*x = DaggerheartCreateSessionEnvironmentResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActionRollResponse) String() string {
+func (x *DaggerheartCreateSessionEnvironmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActionRollResponse) ProtoMessage() {}
+func (*DaggerheartCreateSessionEnvironmentResponse) ProtoMessage() {}
 
-func (x *ActionRollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[42]
+func (x *DaggerheartCreateSessionEnvironmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3144,115 +3865,118 @@ func (x *ActionRollResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActionRollResponse.ProtoReflect.Descriptor instead.
-func (*ActionRollResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use DaggerheartCreateSessionEnvironmentResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartCreateSessionEnvironmentResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ActionRollResponse) GetHope() int32 {
+func (x *DaggerheartCreateSessionEnvironmentResponse) GetEnvironment() *DaggerheartSessionEnvironment {
 	if x != nil {
-		return x.Hope
+		return x.Environment
 	}
-	return 0
+	return nil
 }
 
-func (x *ActionRollResponse) GetFear() int32 {
-	if x != nil {
-		return x.Fear
-	}
-	return 0
+type DaggerheartShiftSessionEnvironmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	EnvironmentId string                 `protobuf:"bytes,3,opt,name=environment_id,json=environmentId,proto3" json:"environment_id,omitempty"`
+	// Optional Difficulty override; defaults to the catalog Difficulty.
+	Difficulty    *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActionRollResponse) GetModifier() int32 {
-	if x != nil {
-		return x.Modifier
-	}
-	return 0
+func (x *DaggerheartShiftSessionEnvironmentRequest) Reset() {
+	*x = DaggerheartShiftSessionEnvironmentRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActionRollResponse) GetAdvantageDie() int32 {
-	if x != nil {
-		return x.AdvantageDie
-	}
-	return 0
+func (x *DaggerheartShiftSessionEnvironmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ActionRollResponse) GetAdvantageModifier() int32 {
+func (*DaggerheartShiftSessionEnvironmentRequest) ProtoMessage() {}
+
+func (x *DaggerheartShiftSessionEnvironmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[49]
 	if x != nil {
-		return x.AdvantageModifier
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ActionRollResponse) GetDifficulty() int32 {
-	if x != nil && x.Difficulty != nil {
-		return *x.Difficulty
-	}
-	return 0
+// Deprecated: Use DaggerheartShiftSessionEnvironmentRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartShiftSessionEnvironmentRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ActionRollResponse) GetTotal() int32 {
+func (x *DaggerheartShiftSessionEnvironmentRequest) GetCampaignId() string {
 	if x != nil {
-		return x.Total
+		return x.CampaignId
 	}
-	return 0
+	return ""
 }
 
-func (x *ActionRollResponse) GetIsCrit() bool {
+func (x *DaggerheartShiftSessionEnvironmentRequest) GetSessionId() string {
 	if x != nil {
-		return x.IsCrit
+		return x.SessionId
 	}
-	return false
+	return ""
 }
 
-func (x *ActionRollResponse) GetMeetsDifficulty() bool {
+func (x *DaggerheartShiftSessionEnvironmentRequest) GetEnvironmentId() string {
 	if x != nil {
-		return x.MeetsDifficulty
+		return x.EnvironmentId
 	}
-	return false
+	return ""
 }
 
-func (x *ActionRollResponse) GetOutcome() Outcome {
+func (x *DaggerheartShiftSessionEnvironmentRequest) GetDifficulty() *wrapperspb.Int32Value {
 	if x != nil {
-		return x.Outcome
+		return x.Difficulty
 	}
-	return Outcome_OUTCOME_UNSPECIFIED
+	return nil
 }
 
-func (x *ActionRollResponse) GetRng() *v1.RngResponse {
+func (x *DaggerheartShiftSessionEnvironmentRequest) GetReason() string {
 	if x != nil {
-		return x.Rng
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type DualityOutcomeRequest struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Hope     int32                  `protobuf:"varint,1,opt,name=hope,proto3" json:"hope,omitempty"`
-	Fear     int32                  `protobuf:"varint,2,opt,name=fear,proto3" json:"fear,omitempty"`
-	Modifier int32                  `protobuf:"varint,3,opt,name=modifier,proto3" json:"modifier,omitempty"`
-	// If omitted, the server returns a roll outcome (Hope/Fear/Crit) but does not
-	// classify as success/failure.
-	Difficulty    *int32 `protobuf:"varint,4,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DaggerheartShiftSessionEnvironmentResponse struct {
+	state                 protoimpl.MessageState         `protogen:"open.v1"`
+	Environment           *DaggerheartSessionEnvironment `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	PreviousEnvironmentId string                         `protobuf:"bytes,2,opt,name=previous_environment_id,json=previousEnvironmentId,proto3" json:"previous_environment_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *DualityOutcomeRequest) Reset() {
-	*x = DualityOutcomeRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[43]
+func (x *DaggerheartShiftSessionEnvironmentResponse) Reset() {
+	*x = DaggerheartShiftSessionEnvironmentResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DualityOutcomeRequest) String() string {
+func (x *DaggerheartShiftSessionEnvironmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DualityOutcomeRequest) ProtoMessage() {}
+func (*DaggerheartShiftSessionEnvironmentResponse) ProtoMessage() {}
 
-func (x *DualityOutcomeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[43]
+func (x *DaggerheartShiftSessionEnvironmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3263,70 +3987,49 @@ func (x *DualityOutcomeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DualityOutcomeRequest.ProtoReflect.Descriptor instead.
-func (*DualityOutcomeRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *DualityOutcomeRequest) GetHope() int32 {
-	if x != nil {
-		return x.Hope
-	}
-	return 0
+// Deprecated: Use DaggerheartShiftSessionEnvironmentResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartShiftSessionEnvironmentResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DualityOutcomeRequest) GetFear() int32 {
+func (x *DaggerheartShiftSessionEnvironmentResponse) GetEnvironment() *DaggerheartSessionEnvironment {
 	if x != nil {
-		return x.Fear
+		return x.Environment
 	}
-	return 0
+	return nil
 }
 
-func (x *DualityOutcomeRequest) GetModifier() int32 {
+func (x *DaggerheartShiftSessionEnvironmentResponse) GetPreviousEnvironmentId() string {
 	if x != nil {
-		return x.Modifier
+		return x.PreviousEnvironmentId
 	}
-	return 0
-}
-
-func (x *DualityOutcomeRequest) GetDifficulty() int32 {
-	if x != nil && x.Difficulty != nil {
-		return *x.Difficulty
-	}
-	return 0
+	return ""
 }
 
-type DualityOutcomeResponse struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Hope     int32                  `protobuf:"varint,1,opt,name=hope,proto3" json:"hope,omitempty"`
-	Fear     int32                  `protobuf:"varint,2,opt,name=fear,proto3" json:"fear,omitempty"`
-	Modifier int32                  `protobuf:"varint,3,opt,name=modifier,proto3" json:"modifier,omitempty"`
-	// Echoed back if provided in the request.
-	Difficulty *int32 `protobuf:"varint,4,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
-	// total = hope + fear + modifier
-	Total           int32   `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
-	IsCrit          bool    `protobuf:"varint,6,opt,name=is_crit,json=isCrit,proto3" json:"is_crit,omitempty"`
-	MeetsDifficulty bool    `protobuf:"varint,7,opt,name=meets_difficulty,json=meetsDifficulty,proto3" json:"meets_difficulty,omitempty"`
-	Outcome         Outcome `protobuf:"varint,8,opt,name=outcome,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcome,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type DaggerheartClearSessionEnvironmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DualityOutcomeResponse) Reset() {
-	*x = DualityOutcomeResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[44]
+func (x *DaggerheartClearSessionEnvironmentRequest) Reset() {
+	*x = DaggerheartClearSessionEnvironmentRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DualityOutcomeResponse) String() string {
+func (x *DaggerheartClearSessionEnvironmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DualityOutcomeResponse) ProtoMessage() {}
+func (*DaggerheartClearSessionEnvironmentRequest) ProtoMessage() {}
 
-func (x *DualityOutcomeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[44]
+func (x *DaggerheartClearSessionEnvironmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3337,96 +4040,99 @@ func (x *DualityOutcomeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DualityOutcomeResponse.ProtoReflect.Descriptor instead.
-func (*DualityOutcomeResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use DaggerheartClearSessionEnvironmentRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartClearSessionEnvironmentRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *DualityOutcomeResponse) GetHope() int32 {
+func (x *DaggerheartClearSessionEnvironmentRequest) GetCampaignId() string {
 	if x != nil {
-		return x.Hope
+		return x.CampaignId
 	}
-	return 0
+	return ""
 }
 
-func (x *DualityOutcomeResponse) GetFear() int32 {
+func (x *DaggerheartClearSessionEnvironmentRequest) GetSessionId() string {
 	if x != nil {
-		return x.Fear
+		return x.SessionId
 	}
-	return 0
+	return ""
 }
 
-func (x *DualityOutcomeResponse) GetModifier() int32 {
+func (x *DaggerheartClearSessionEnvironmentRequest) GetReason() string {
 	if x != nil {
-		return x.Modifier
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *DualityOutcomeResponse) GetDifficulty() int32 {
-	if x != nil && x.Difficulty != nil {
-		return *x.Difficulty
-	}
-	return 0
+type DaggerheartClearSessionEnvironmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnvironmentId string                 `protobuf:"bytes,1,opt,name=environment_id,json=environmentId,proto3" json:"environment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DualityOutcomeResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+func (x *DaggerheartClearSessionEnvironmentResponse) Reset() {
+	*x = DaggerheartClearSessionEnvironmentResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DualityOutcomeResponse) GetIsCrit() bool {
-	if x != nil {
-		return x.IsCrit
-	}
-	return false
+func (x *DaggerheartClearSessionEnvironmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DualityOutcomeResponse) GetMeetsDifficulty() bool {
+func (*DaggerheartClearSessionEnvironmentResponse) ProtoMessage() {}
+
+func (x *DaggerheartClearSessionEnvironmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[52]
 	if x != nil {
-		return x.MeetsDifficulty
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *DualityOutcomeResponse) GetOutcome() Outcome {
+// Deprecated: Use DaggerheartClearSessionEnvironmentResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartClearSessionEnvironmentResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DaggerheartClearSessionEnvironmentResponse) GetEnvironmentId() string {
 	if x != nil {
-		return x.Outcome
+		return x.EnvironmentId
 	}
-	return Outcome_OUTCOME_UNSPECIFIED
+	return ""
 }
 
-type DualityExplainRequest struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Hope     int32                  `protobuf:"varint,1,opt,name=hope,proto3" json:"hope,omitempty"`
-	Fear     int32                  `protobuf:"varint,2,opt,name=fear,proto3" json:"fear,omitempty"`
-	Modifier int32                  `protobuf:"varint,3,opt,name=modifier,proto3" json:"modifier,omitempty"`
-	// If omitted, the server returns a roll outcome (Hope/Fear/Crit) but does not
-	// classify as success/failure.
-	Difficulty *int32 `protobuf:"varint,4,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
-	// Optional correlation identifier for callers.
-	RequestId     *string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`
+type DaggerheartGetSessionEnvironmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DualityExplainRequest) Reset() {
-	*x = DualityExplainRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[45]
+func (x *DaggerheartGetSessionEnvironmentRequest) Reset() {
+	*x = DaggerheartGetSessionEnvironmentRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DualityExplainRequest) String() string {
+func (x *DaggerheartGetSessionEnvironmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DualityExplainRequest) ProtoMessage() {}
+func (*DaggerheartGetSessionEnvironmentRequest) ProtoMessage() {}
 
-func (x *DualityExplainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[45]
+func (x *DaggerheartGetSessionEnvironmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3437,80 +4143,96 @@ func (x *DualityExplainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DualityExplainRequest.ProtoReflect.Descriptor instead.
-func (*DualityExplainRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use DaggerheartGetSessionEnvironmentRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartGetSessionEnvironmentRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *DualityExplainRequest) GetHope() int32 {
+func (x *DaggerheartGetSessionEnvironmentRequest) GetCampaignId() string {
 	if x != nil {
-		return x.Hope
+		return x.CampaignId
 	}
-	return 0
+	return ""
 }
 
-func (x *DualityExplainRequest) GetFear() int32 {
+func (x *DaggerheartGetSessionEnvironmentRequest) GetSessionId() string {
 	if x != nil {
-		return x.Fear
+		return x.SessionId
 	}
-	return 0
+	return ""
 }
 
-func (x *DualityExplainRequest) GetModifier() int32 {
+type DaggerheartGetSessionEnvironmentResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Environment   *DaggerheartSessionEnvironment `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartGetSessionEnvironmentResponse) Reset() {
+	*x = DaggerheartGetSessionEnvironmentResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartGetSessionEnvironmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartGetSessionEnvironmentResponse) ProtoMessage() {}
+
+func (x *DaggerheartGetSessionEnvironmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[54]
 	if x != nil {
-		return x.Modifier
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DualityExplainRequest) GetDifficulty() int32 {
-	if x != nil && x.Difficulty != nil {
-		return *x.Difficulty
-	}
-	return 0
+// Deprecated: Use DaggerheartGetSessionEnvironmentResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartGetSessionEnvironmentResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *DualityExplainRequest) GetRequestId() string {
-	if x != nil && x.RequestId != nil {
-		return *x.RequestId
+func (x *DaggerheartGetSessionEnvironmentResponse) GetEnvironment() *DaggerheartSessionEnvironment {
+	if x != nil {
+		return x.Environment
 	}
-	return ""
+	return nil
 }
 
-type DualityExplainResponse struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Hope     int32                  `protobuf:"varint,1,opt,name=hope,proto3" json:"hope,omitempty"`
-	Fear     int32                  `protobuf:"varint,2,opt,name=fear,proto3" json:"fear,omitempty"`
-	Modifier int32                  `protobuf:"varint,3,opt,name=modifier,proto3" json:"modifier,omitempty"`
-	// Echoed back if provided in the request.
-	Difficulty *int32 `protobuf:"varint,4,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
-	// total = hope + fear + modifier
-	Total           int32          `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
-	IsCrit          bool           `protobuf:"varint,6,opt,name=is_crit,json=isCrit,proto3" json:"is_crit,omitempty"`
-	MeetsDifficulty bool           `protobuf:"varint,7,opt,name=meets_difficulty,json=meetsDifficulty,proto3" json:"meets_difficulty,omitempty"`
-	Outcome         Outcome        `protobuf:"varint,8,opt,name=outcome,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcome,omitempty"`
-	RulesVersion    string         `protobuf:"bytes,9,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
-	Intermediates   *Intermediates `protobuf:"bytes,10,opt,name=intermediates,proto3" json:"intermediates,omitempty"`
-	Steps           []*ExplainStep `protobuf:"bytes,11,rep,name=steps,proto3" json:"steps,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Adversaries to spawn from a catalog entry when a feature triggers.
+type DaggerheartEnvironmentSpawn struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AdversaryEntryId string                 `protobuf:"bytes,1,opt,name=adversary_entry_id,json=adversaryEntryId,proto3" json:"adversary_entry_id,omitempty"`
+	// Optional name; defaults to the catalog name. Numbered when count > 1.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of adversaries to spawn; defaults to 1, at most 50.
+	Count         int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DualityExplainResponse) Reset() {
-	*x = DualityExplainResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[46]
+func (x *DaggerheartEnvironmentSpawn) Reset() {
+	*x = DaggerheartEnvironmentSpawn{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DualityExplainResponse) String() string {
+func (x *DaggerheartEnvironmentSpawn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DualityExplainResponse) ProtoMessage() {}
+func (*DaggerheartEnvironmentSpawn) ProtoMessage() {}
 
-func (x *DualityExplainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[46]
+func (x *DaggerheartEnvironmentSpawn) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3521,166 +4243,151 @@ func (x *DualityExplainResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DualityExplainResponse.ProtoReflect.Descriptor instead.
-func (*DualityExplainResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use DaggerheartEnvironmentSpawn.ProtoReflect.Descriptor instead.
+func (*DaggerheartEnvironmentSpawn) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *DualityExplainResponse) GetHope() int32 {
+func (x *DaggerheartEnvironmentSpawn) GetAdversaryEntryId() string {
 	if x != nil {
-		return x.Hope
+		return x.AdversaryEntryId
 	}
-	return 0
+	return ""
 }
 
-func (x *DualityExplainResponse) GetFear() int32 {
+func (x *DaggerheartEnvironmentSpawn) GetName() string {
 	if x != nil {
-		return x.Fear
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *DualityExplainResponse) GetModifier() int32 {
+func (x *DaggerheartEnvironmentSpawn) GetCount() int32 {
 	if x != nil {
-		return x.Modifier
+		return x.Count
 	}
 	return 0
 }
 
-func (x *DualityExplainResponse) GetDifficulty() int32 {
-	if x != nil && x.Difficulty != nil {
-		return *x.Difficulty
-	}
-	return 0
+type DaggerheartTriggerEnvironmentFeatureRequest struct {
+	state      protoimpl.MessageState            `protogen:"open.v1"`
+	CampaignId string                            `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId  string                            `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	FeatureId  string                            `protobuf:"bytes,3,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	Kind       DaggerheartEnvironmentFeatureKind `protobuf:"varint,4,opt,name=kind,proto3,enum=systems.daggerheart.v1.DaggerheartEnvironmentFeatureKind" json:"kind,omitempty"`
+	// Fear deducted from the GM Fear pool before the feature resolves.
+	FearCost      int32                          `protobuf:"varint,5,opt,name=fear_cost,json=fearCost,proto3" json:"fear_cost,omitempty"`
+	Spawns        []*DaggerheartEnvironmentSpawn `protobuf:"bytes,6,rep,name=spawns,proto3" json:"spawns,omitempty"`
+	Description   string                         `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DualityExplainResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+func (x *DaggerheartTriggerEnvironmentFeatureRequest) Reset() {
+	*x = DaggerheartTriggerEnvironmentFeatureRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DualityExplainResponse) GetIsCrit() bool {
-	if x != nil {
-		return x.IsCrit
-	}
-	return false
+func (x *DaggerheartTriggerEnvironmentFeatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DualityExplainResponse) GetMeetsDifficulty() bool {
-	if x != nil {
-		return x.MeetsDifficulty
-	}
-	return false
-}
+func (*DaggerheartTriggerEnvironmentFeatureRequest) ProtoMessage() {}
 
-func (x *DualityExplainResponse) GetOutcome() Outcome {
+func (x *DaggerheartTriggerEnvironmentFeatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[56]
 	if x != nil {
-		return x.Outcome
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Outcome_OUTCOME_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *DualityExplainResponse) GetRulesVersion() string {
+// Deprecated: Use DaggerheartTriggerEnvironmentFeatureRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartTriggerEnvironmentFeatureRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DaggerheartTriggerEnvironmentFeatureRequest) GetCampaignId() string {
 	if x != nil {
-		return x.RulesVersion
+		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *DualityExplainResponse) GetIntermediates() *Intermediates {
+func (x *DaggerheartTriggerEnvironmentFeatureRequest) GetSessionId() string {
 	if x != nil {
-		return x.Intermediates
+		return x.SessionId
 	}
-	return nil
+	return ""
 }
 
-func (x *DualityExplainResponse) GetSteps() []*ExplainStep {
+func (x *DaggerheartTriggerEnvironmentFeatureRequest) GetFeatureId() string {
 	if x != nil {
-		return x.Steps
+		return x.FeatureId
 	}
-	return nil
-}
-
-type DualityProbabilityRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Modifier      int32                  `protobuf:"varint,1,opt,name=modifier,proto3" json:"modifier,omitempty"`
-	Difficulty    int32                  `protobuf:"varint,2,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DualityProbabilityRequest) Reset() {
-	*x = DualityProbabilityRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[47]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *DualityProbabilityRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*DualityProbabilityRequest) ProtoMessage() {}
-
-func (x *DualityProbabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[47]
+func (x *DaggerheartTriggerEnvironmentFeatureRequest) GetKind() DaggerheartEnvironmentFeatureKind {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Kind
 	}
-	return mi.MessageOf(x)
+	return DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_UNSPECIFIED
 }
 
-// Deprecated: Use DualityProbabilityRequest.ProtoReflect.Descriptor instead.
-func (*DualityProbabilityRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{47}
+func (x *DaggerheartTriggerEnvironmentFeatureRequest) GetFearCost() int32 {
+	if x != nil {
+		return x.FearCost
+	}
+	return 0
 }
 
-func (x *DualityProbabilityRequest) GetModifier() int32 {
+func (x *DaggerheartTriggerEnvironmentFeatureRequest) GetSpawns() []*DaggerheartEnvironmentSpawn {
 	if x != nil {
-		return x.Modifier
+		return x.Spawns
 	}
-	return 0
+	return nil
 }
 
-func (x *DualityProbabilityRequest) GetDifficulty() int32 {
+func (x *DaggerheartTriggerEnvironmentFeatureRequest) GetDescription() string {
 	if x != nil {
-		return x.Difficulty
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-type DualityProbabilityResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalOutcomes int32                  `protobuf:"varint,1,opt,name=total_outcomes,json=totalOutcomes,proto3" json:"total_outcomes,omitempty"`
-	CritCount     int32                  `protobuf:"varint,2,opt,name=crit_count,json=critCount,proto3" json:"crit_count,omitempty"`
-	SuccessCount  int32                  `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
-	FailureCount  int32                  `protobuf:"varint,4,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
-	OutcomeCounts []*OutcomeCount        `protobuf:"bytes,5,rep,name=outcome_counts,json=outcomeCounts,proto3" json:"outcome_counts,omitempty"`
+type DaggerheartTriggerEnvironmentFeatureResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	EnvironmentId string                  `protobuf:"bytes,1,opt,name=environment_id,json=environmentId,proto3" json:"environment_id,omitempty"`
+	FeatureId     string                  `protobuf:"bytes,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	GmFearBefore  int32                   `protobuf:"varint,3,opt,name=gm_fear_before,json=gmFearBefore,proto3" json:"gm_fear_before,omitempty"`
+	GmFearAfter   int32                   `protobuf:"varint,4,opt,name=gm_fear_after,json=gmFearAfter,proto3" json:"gm_fear_after,omitempty"`
+	Adversaries   []*DaggerheartAdversary `protobuf:"bytes,5,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DualityProbabilityResponse) Reset() {
-	*x = DualityProbabilityResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[48]
+func (x *DaggerheartTriggerEnvironmentFeatureResponse) Reset() {
+	*x = DaggerheartTriggerEnvironmentFeatureResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DualityProbabilityResponse) String() string {
+func (x *DaggerheartTriggerEnvironmentFeatureResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DualityProbabilityResponse) ProtoMessage() {}
+func (*DaggerheartTriggerEnvironmentFeatureResponse) ProtoMessage() {}
 
-func (x *DualityProbabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[48]
+func (x *DaggerheartTriggerEnvironmentFeatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3691,67 +4398,93 @@ func (x *DualityProbabilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DualityProbabilityResponse.ProtoReflect.Descriptor instead.
-func (*DualityProbabilityResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use DaggerheartTriggerEnvironmentFeatureResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartTriggerEnvironmentFeatureResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *DualityProbabilityResponse) GetTotalOutcomes() int32 {
+func (x *DaggerheartTriggerEnvironmentFeatureResponse) GetEnvironmentId() string {
 	if x != nil {
-		return x.TotalOutcomes
+		return x.EnvironmentId
 	}
-	return 0
+	return ""
 }
 
-func (x *DualityProbabilityResponse) GetCritCount() int32 {
+func (x *DaggerheartTriggerEnvironmentFeatureResponse) GetFeatureId() string {
 	if x != nil {
-		return x.CritCount
+		return x.FeatureId
 	}
-	return 0
+	return ""
 }
 
-func (x *DualityProbabilityResponse) GetSuccessCount() int32 {
+func (x *DaggerheartTriggerEnvironmentFeatureResponse) GetGmFearBefore() int32 {
 	if x != nil {
-		return x.SuccessCount
+		return x.GmFearBefore
 	}
 	return 0
 }
 
-func (x *DualityProbabilityResponse) GetFailureCount() int32 {
+func (x *DaggerheartTriggerEnvironmentFeatureResponse) GetGmFearAfter() int32 {
 	if x != nil {
-		return x.FailureCount
+		return x.GmFearAfter
 	}
 	return 0
 }
 
-func (x *DualityProbabilityResponse) GetOutcomeCounts() []*OutcomeCount {
+func (x *DaggerheartTriggerEnvironmentFeatureResponse) GetAdversaries() []*DaggerheartAdversary {
 	if x != nil {
-		return x.OutcomeCounts
+		return x.Adversaries
 	}
 	return nil
 }
 
-type RulesVersionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DaggerheartAdversary struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Id              string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CampaignId      string                  `protobuf:"bytes,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Name            string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Kind            string                  `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	SessionId       *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Notes           string                  `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Hp              int32                   `protobuf:"varint,7,opt,name=hp,proto3" json:"hp,omitempty"`
+	HpMax           int32                   `protobuf:"varint,8,opt,name=hp_max,json=hpMax,proto3" json:"hp_max,omitempty"`
+	Stress          int32                   `protobuf:"varint,9,opt,name=stress,proto3" json:"stress,omitempty"`
+	StressMax       int32                   `protobuf:"varint,10,opt,name=stress_max,json=stressMax,proto3" json:"stress_max,omitempty"`
+	Evasion         int32                   `protobuf:"varint,11,opt,name=evasion,proto3" json:"evasion,omitempty"`
+	MajorThreshold  int32                   `protobuf:"varint,12,opt,name=major_threshold,json=majorThreshold,proto3" json:"major_threshold,omitempty"`
+	SevereThreshold int32                   `protobuf:"varint,13,opt,name=severe_threshold,json=severeThreshold,proto3" json:"severe_threshold,omitempty"`
+	Armor           int32                   `protobuf:"varint,14,opt,name=armor,proto3" json:"armor,omitempty"`
+	Conditions      []DaggerheartCondition  `protobuf:"varint,15,rep,packed,name=conditions,proto3,enum=systems.daggerheart.v1.DaggerheartCondition" json:"conditions,omitempty"`
+	CreatedAt       *timestamppb.Timestamp  `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp  `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Minion (N) overflow threshold; zero when the adversary is not a Minion.
+	MinionThreshold int32 `protobuf:"varint,18,opt,name=minion_threshold,json=minionThreshold,proto3" json:"minion_threshold,omitempty"`
+	// Catalog entry the adversary was instantiated from; empty for hand-built
+	// stat blocks. The catalog fields below are copied at creation.
+	AdversaryEntryId string                            `protobuf:"bytes,19,opt,name=adversary_entry_id,json=adversaryEntryId,proto3" json:"adversary_entry_id,omitempty"`
+	AttackModifier   int32                             `protobuf:"varint,20,opt,name=attack_modifier,json=attackModifier,proto3" json:"attack_modifier,omitempty"`
+	StandardAttack   *DaggerheartAdversaryAttack       `protobuf:"bytes,21,opt,name=standard_attack,json=standardAttack,proto3" json:"standard_attack,omitempty"`
+	Experiences      []*DaggerheartAdversaryExperience `protobuf:"bytes,22,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	Features         []*DaggerheartAdversaryFeature    `protobuf:"bytes,23,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *RulesVersionRequest) Reset() {
-	*x = RulesVersionRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[49]
+func (x *DaggerheartAdversary) Reset() {
+	*x = DaggerheartAdversary{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RulesVersionRequest) String() string {
+func (x *DaggerheartAdversary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RulesVersionRequest) ProtoMessage() {}
+func (*DaggerheartAdversary) ProtoMessage() {}
 
-func (x *RulesVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[49]
+func (x *DaggerheartAdversary) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3762,136 +4495,6010 @@ func (x *RulesVersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RulesVersionRequest.ProtoReflect.Descriptor instead.
-func (*RulesVersionRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use DaggerheartAdversary.ProtoReflect.Descriptor instead.
+func (*DaggerheartAdversary) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{58}
 }
 
-type RulesVersionResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	System         string                 `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
-	Module         string                 `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
-	RulesVersion   string                 `protobuf:"bytes,3,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
-	DiceModel      string                 `protobuf:"bytes,4,opt,name=dice_model,json=diceModel,proto3" json:"dice_model,omitempty"`
-	TotalFormula   string                 `protobuf:"bytes,5,opt,name=total_formula,json=totalFormula,proto3" json:"total_formula,omitempty"`
-	CritRule       string                 `protobuf:"bytes,6,opt,name=crit_rule,json=critRule,proto3" json:"crit_rule,omitempty"`
-	DifficultyRule string                 `protobuf:"bytes,7,opt,name=difficulty_rule,json=difficultyRule,proto3" json:"difficulty_rule,omitempty"`
-	Outcomes       []Outcome              `protobuf:"varint,8,rep,packed,name=outcomes,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcomes,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *DaggerheartAdversary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *RulesVersionResponse) Reset() {
-	*x = RulesVersionResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[50]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DaggerheartAdversary) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
 }
 
-func (x *RulesVersionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DaggerheartAdversary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*RulesVersionResponse) ProtoMessage() {}
-
-func (x *RulesVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[50]
+func (x *DaggerheartAdversary) GetKind() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Kind
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RulesVersionResponse.ProtoReflect.Descriptor instead.
-func (*RulesVersionResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{50}
+func (x *DaggerheartAdversary) GetSessionId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
 }
 
-func (x *RulesVersionResponse) GetSystem() string {
+func (x *DaggerheartAdversary) GetNotes() string {
 	if x != nil {
-		return x.System
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *RulesVersionResponse) GetModule() string {
+func (x *DaggerheartAdversary) GetHp() int32 {
 	if x != nil {
-		return x.Module
+		return x.Hp
 	}
-	return ""
+	return 0
 }
 
-func (x *RulesVersionResponse) GetRulesVersion() string {
+func (x *DaggerheartAdversary) GetHpMax() int32 {
 	if x != nil {
-		return x.RulesVersion
+		return x.HpMax
 	}
-	return ""
+	return 0
 }
 
-func (x *RulesVersionResponse) GetDiceModel() string {
+func (x *DaggerheartAdversary) GetStress() int32 {
 	if x != nil {
-		return x.DiceModel
+		return x.Stress
 	}
-	return ""
+	return 0
 }
 
-func (x *RulesVersionResponse) GetTotalFormula() string {
+func (x *DaggerheartAdversary) GetStressMax() int32 {
 	if x != nil {
-		return x.TotalFormula
+		return x.StressMax
 	}
-	return ""
+	return 0
 }
 
-func (x *RulesVersionResponse) GetCritRule() string {
+func (x *DaggerheartAdversary) GetEvasion() int32 {
 	if x != nil {
-		return x.CritRule
+		return x.Evasion
 	}
-	return ""
+	return 0
 }
 
-func (x *RulesVersionResponse) GetDifficultyRule() string {
+func (x *DaggerheartAdversary) GetMajorThreshold() int32 {
 	if x != nil {
-		return x.DifficultyRule
+		return x.MajorThreshold
 	}
-	return ""
+	return 0
 }
 
-func (x *RulesVersionResponse) GetOutcomes() []Outcome {
+func (x *DaggerheartAdversary) GetSevereThreshold() int32 {
 	if x != nil {
-		return x.Outcomes
+		return x.SevereThreshold
 	}
-	return nil
+	return 0
 }
 
-type RollDiceRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The dice to roll in order.
-	Dice []*DiceSpec `protobuf:"bytes,1,rep,name=dice,proto3" json:"dice,omitempty"`
+func (x *DaggerheartAdversary) GetArmor() int32 {
+	if x != nil {
+		return x.Armor
+	}
+	return 0
+}
+
+func (x *DaggerheartAdversary) GetConditions() []DaggerheartCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *DaggerheartAdversary) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DaggerheartAdversary) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *DaggerheartAdversary) GetMinionThreshold() int32 {
+	if x != nil {
+		return x.MinionThreshold
+	}
+	return 0
+}
+
+func (x *DaggerheartAdversary) GetAdversaryEntryId() string {
+	if x != nil {
+		return x.AdversaryEntryId
+	}
+	return ""
+}
+
+func (x *DaggerheartAdversary) GetAttackModifier() int32 {
+	if x != nil {
+		return x.AttackModifier
+	}
+	return 0
+}
+
+func (x *DaggerheartAdversary) GetStandardAttack() *DaggerheartAdversaryAttack {
+	if x != nil {
+		return x.StandardAttack
+	}
+	return nil
+}
+
+func (x *DaggerheartAdversary) GetExperiences() []*DaggerheartAdversaryExperience {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+func (x *DaggerheartAdversary) GetFeatures() []*DaggerheartAdversaryFeature {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type DaggerheartCreateAdversaryRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	CampaignId      string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Name            string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind            string                  `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	SessionId       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Notes           string                  `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Hp              *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=hp,proto3" json:"hp,omitempty"`
+	HpMax           *wrapperspb.Int32Value  `protobuf:"bytes,7,opt,name=hp_max,json=hpMax,proto3" json:"hp_max,omitempty"`
+	Stress          *wrapperspb.Int32Value  `protobuf:"bytes,8,opt,name=stress,proto3" json:"stress,omitempty"`
+	StressMax       *wrapperspb.Int32Value  `protobuf:"bytes,9,opt,name=stress_max,json=stressMax,proto3" json:"stress_max,omitempty"`
+	Evasion         *wrapperspb.Int32Value  `protobuf:"bytes,10,opt,name=evasion,proto3" json:"evasion,omitempty"`
+	MajorThreshold  *wrapperspb.Int32Value  `protobuf:"bytes,11,opt,name=major_threshold,json=majorThreshold,proto3" json:"major_threshold,omitempty"`
+	SevereThreshold *wrapperspb.Int32Value  `protobuf:"bytes,12,opt,name=severe_threshold,json=severeThreshold,proto3" json:"severe_threshold,omitempty"`
+	Armor           *wrapperspb.Int32Value  `protobuf:"bytes,13,opt,name=armor,proto3" json:"armor,omitempty"`
+	MinionThreshold *wrapperspb.Int32Value  `protobuf:"bytes,14,opt,name=minion_threshold,json=minionThreshold,proto3" json:"minion_threshold,omitempty"`
+	// Optional catalog adversary to copy the stat block from. Name and kind
+	// default to the catalog name and role; stat fields above override it.
+	AdversaryEntryId string `protobuf:"bytes,15,opt,name=adversary_entry_id,json=adversaryEntryId,proto3" json:"adversary_entry_id,omitempty"`
+	// Number of adversaries to create; defaults to 1, at most 50. Groups are
+	// numbered and created in one event batch.
+	Count int32 `protobuf:"varint,16,opt,name=count,proto3" json:"count,omitempty"`
+	// Optional per-adversary names, in order; blanks keep the default name.
+	Names         []string `protobuf:"bytes,17,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartCreateAdversaryRequest) Reset() {
+	*x = DaggerheartCreateAdversaryRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartCreateAdversaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartCreateAdversaryRequest) ProtoMessage() {}
+
+func (x *DaggerheartCreateAdversaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartCreateAdversaryRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartCreateAdversaryRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetSessionId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetHp() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Hp
+	}
+	return nil
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetHpMax() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.HpMax
+	}
+	return nil
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetStress() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Stress
+	}
+	return nil
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetStressMax() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.StressMax
+	}
+	return nil
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetEvasion() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Evasion
+	}
+	return nil
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetMajorThreshold() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MajorThreshold
+	}
+	return nil
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetSevereThreshold() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.SevereThreshold
+	}
+	return nil
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetArmor() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Armor
+	}
+	return nil
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetMinionThreshold() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinionThreshold
+	}
+	return nil
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetAdversaryEntryId() string {
+	if x != nil {
+		return x.AdversaryEntryId
+	}
+	return ""
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DaggerheartCreateAdversaryRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type DaggerheartCreateAdversaryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// First created adversary.
+	Adversary *DaggerheartAdversary `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	// Every created adversary, in creation order.
+	Adversaries   []*DaggerheartAdversary `protobuf:"bytes,2,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartCreateAdversaryResponse) Reset() {
+	*x = DaggerheartCreateAdversaryResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartCreateAdversaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartCreateAdversaryResponse) ProtoMessage() {}
+
+func (x *DaggerheartCreateAdversaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartCreateAdversaryResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartCreateAdversaryResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DaggerheartCreateAdversaryResponse) GetAdversary() *DaggerheartAdversary {
+	if x != nil {
+		return x.Adversary
+	}
+	return nil
+}
+
+func (x *DaggerheartCreateAdversaryResponse) GetAdversaries() []*DaggerheartAdversary {
+	if x != nil {
+		return x.Adversaries
+	}
+	return nil
+}
+
+type DaggerheartUpdateAdversaryRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	CampaignId      string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	AdversaryId     string                  `protobuf:"bytes,2,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	Name            *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Kind            *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	SessionId       *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Notes           *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Hp              *wrapperspb.Int32Value  `protobuf:"bytes,7,opt,name=hp,proto3" json:"hp,omitempty"`
+	HpMax           *wrapperspb.Int32Value  `protobuf:"bytes,8,opt,name=hp_max,json=hpMax,proto3" json:"hp_max,omitempty"`
+	Stress          *wrapperspb.Int32Value  `protobuf:"bytes,9,opt,name=stress,proto3" json:"stress,omitempty"`
+	StressMax       *wrapperspb.Int32Value  `protobuf:"bytes,10,opt,name=stress_max,json=stressMax,proto3" json:"stress_max,omitempty"`
+	Evasion         *wrapperspb.Int32Value  `protobuf:"bytes,11,opt,name=evasion,proto3" json:"evasion,omitempty"`
+	MajorThreshold  *wrapperspb.Int32Value  `protobuf:"bytes,12,opt,name=major_threshold,json=majorThreshold,proto3" json:"major_threshold,omitempty"`
+	SevereThreshold *wrapperspb.Int32Value  `protobuf:"bytes,13,opt,name=severe_threshold,json=severeThreshold,proto3" json:"severe_threshold,omitempty"`
+	Armor           *wrapperspb.Int32Value  `protobuf:"bytes,14,opt,name=armor,proto3" json:"armor,omitempty"`
+	MinionThreshold *wrapperspb.Int32Value  `protobuf:"bytes,15,opt,name=minion_threshold,json=minionThreshold,proto3" json:"minion_threshold,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) Reset() {
+	*x = DaggerheartUpdateAdversaryRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartUpdateAdversaryRequest) ProtoMessage() {}
+
+func (x *DaggerheartUpdateAdversaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartUpdateAdversaryRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartUpdateAdversaryRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetAdversaryId() string {
+	if x != nil {
+		return x.AdversaryId
+	}
+	return ""
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetKind() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Kind
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetSessionId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetNotes() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetHp() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Hp
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetHpMax() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.HpMax
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetStress() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Stress
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetStressMax() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.StressMax
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetEvasion() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Evasion
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetMajorThreshold() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MajorThreshold
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetSevereThreshold() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.SevereThreshold
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetArmor() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Armor
+	}
+	return nil
+}
+
+func (x *DaggerheartUpdateAdversaryRequest) GetMinionThreshold() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MinionThreshold
+	}
+	return nil
+}
+
+type DaggerheartUpdateAdversaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adversary     *DaggerheartAdversary  `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartUpdateAdversaryResponse) Reset() {
+	*x = DaggerheartUpdateAdversaryResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartUpdateAdversaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartUpdateAdversaryResponse) ProtoMessage() {}
+
+func (x *DaggerheartUpdateAdversaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartUpdateAdversaryResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartUpdateAdversaryResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DaggerheartUpdateAdversaryResponse) GetAdversary() *DaggerheartAdversary {
+	if x != nil {
+		return x.Adversary
+	}
+	return nil
+}
+
+type DaggerheartDeleteAdversaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	AdversaryId   string                 `protobuf:"bytes,2,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartDeleteAdversaryRequest) Reset() {
+	*x = DaggerheartDeleteAdversaryRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartDeleteAdversaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartDeleteAdversaryRequest) ProtoMessage() {}
+
+func (x *DaggerheartDeleteAdversaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartDeleteAdversaryRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartDeleteAdversaryRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DaggerheartDeleteAdversaryRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DaggerheartDeleteAdversaryRequest) GetAdversaryId() string {
+	if x != nil {
+		return x.AdversaryId
+	}
+	return ""
+}
+
+func (x *DaggerheartDeleteAdversaryRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DaggerheartDeleteAdversaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adversary     *DaggerheartAdversary  `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartDeleteAdversaryResponse) Reset() {
+	*x = DaggerheartDeleteAdversaryResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartDeleteAdversaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartDeleteAdversaryResponse) ProtoMessage() {}
+
+func (x *DaggerheartDeleteAdversaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartDeleteAdversaryResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartDeleteAdversaryResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DaggerheartDeleteAdversaryResponse) GetAdversary() *DaggerheartAdversary {
+	if x != nil {
+		return x.Adversary
+	}
+	return nil
+}
+
+type DaggerheartGetAdversaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	AdversaryId   string                 `protobuf:"bytes,2,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartGetAdversaryRequest) Reset() {
+	*x = DaggerheartGetAdversaryRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartGetAdversaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartGetAdversaryRequest) ProtoMessage() {}
+
+func (x *DaggerheartGetAdversaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartGetAdversaryRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartGetAdversaryRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DaggerheartGetAdversaryRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DaggerheartGetAdversaryRequest) GetAdversaryId() string {
+	if x != nil {
+		return x.AdversaryId
+	}
+	return ""
+}
+
+type DaggerheartGetAdversaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adversary     *DaggerheartAdversary  `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartGetAdversaryResponse) Reset() {
+	*x = DaggerheartGetAdversaryResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartGetAdversaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartGetAdversaryResponse) ProtoMessage() {}
+
+func (x *DaggerheartGetAdversaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartGetAdversaryResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartGetAdversaryResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DaggerheartGetAdversaryResponse) GetAdversary() *DaggerheartAdversary {
+	if x != nil {
+		return x.Adversary
+	}
+	return nil
+}
+
+type DaggerheartListAdversariesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	CampaignId    string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartListAdversariesRequest) Reset() {
+	*x = DaggerheartListAdversariesRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartListAdversariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartListAdversariesRequest) ProtoMessage() {}
+
+func (x *DaggerheartListAdversariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartListAdversariesRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartListAdversariesRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DaggerheartListAdversariesRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DaggerheartListAdversariesRequest) GetSessionId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+type DaggerheartListAdversariesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Adversaries   []*DaggerheartAdversary `protobuf:"bytes,1,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartListAdversariesResponse) Reset() {
+	*x = DaggerheartListAdversariesResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartListAdversariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartListAdversariesResponse) ProtoMessage() {}
+
+func (x *DaggerheartListAdversariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartListAdversariesResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartListAdversariesResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DaggerheartListAdversariesResponse) GetAdversaries() []*DaggerheartAdversary {
+	if x != nil {
+		return x.Adversaries
+	}
+	return nil
+}
+
+type DaggerheartAdversaryFeatureState struct {
+	state       protoimpl.MessageState            `protogen:"open.v1"`
+	AdversaryId string                            `protobuf:"bytes,1,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	FeatureId   string                            `protobuf:"bytes,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	Status      DaggerheartAdversaryFeatureStatus `protobuf:"varint,3,opt,name=status,proto3,enum=systems.daggerheart.v1.DaggerheartAdversaryFeatureStatus" json:"status,omitempty"`
+	// What readies a spent feature: feature, scene_end or long_rest.
+	RefreshOn     string `protobuf:"bytes,4,opt,name=refresh_on,json=refreshOn,proto3" json:"refresh_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartAdversaryFeatureState) Reset() {
+	*x = DaggerheartAdversaryFeatureState{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartAdversaryFeatureState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartAdversaryFeatureState) ProtoMessage() {}
+
+func (x *DaggerheartAdversaryFeatureState) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartAdversaryFeatureState.ProtoReflect.Descriptor instead.
+func (*DaggerheartAdversaryFeatureState) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DaggerheartAdversaryFeatureState) GetAdversaryId() string {
+	if x != nil {
+		return x.AdversaryId
+	}
+	return ""
+}
+
+func (x *DaggerheartAdversaryFeatureState) GetFeatureId() string {
+	if x != nil {
+		return x.FeatureId
+	}
+	return ""
+}
+
+func (x *DaggerheartAdversaryFeatureState) GetStatus() DaggerheartAdversaryFeatureStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DaggerheartAdversaryFeatureStatus_DAGGERHEART_ADVERSARY_FEATURE_STATUS_UNSPECIFIED
+}
+
+func (x *DaggerheartAdversaryFeatureState) GetRefreshOn() string {
+	if x != nil {
+		return x.RefreshOn
+	}
+	return ""
+}
+
+type DaggerheartActivateAdversaryFeatureRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId  string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId   string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AdversaryId string                 `protobuf:"bytes,3,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	// Catalog adversary entry that lists the feature. Defaults to the entry the
+	// adversary was instantiated from, using its copied features.
+	AdversaryEntryId string `protobuf:"bytes,4,opt,name=adversary_entry_id,json=adversaryEntryId,proto3" json:"adversary_entry_id,omitempty"`
+	FeatureId        string `protobuf:"bytes,5,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	// Overrides for the Fear and Stress costs read from the catalog feature.
+	FearCost   *wrapperspb.Int32Value `protobuf:"bytes,6,opt,name=fear_cost,json=fearCost,proto3" json:"fear_cost,omitempty"`
+	StressCost *wrapperspb.Int32Value `protobuf:"bytes,7,opt,name=stress_cost,json=stressCost,proto3" json:"stress_cost,omitempty"`
+	// Override for the Difficulty bonus read from the catalog feature.
+	DifficultyBonus *wrapperspb.Int32Value `protobuf:"bytes,8,opt,name=difficulty_bonus,json=difficultyBonus,proto3" json:"difficulty_bonus,omitempty"`
+	TargetIds       []string               `protobuf:"bytes,9,rep,name=target_ids,json=targetIds,proto3" json:"target_ids,omitempty"`
+	Description     string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) Reset() {
+	*x = DaggerheartActivateAdversaryFeatureRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartActivateAdversaryFeatureRequest) ProtoMessage() {}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartActivateAdversaryFeatureRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartActivateAdversaryFeatureRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) GetAdversaryId() string {
+	if x != nil {
+		return x.AdversaryId
+	}
+	return ""
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) GetAdversaryEntryId() string {
+	if x != nil {
+		return x.AdversaryEntryId
+	}
+	return ""
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) GetFeatureId() string {
+	if x != nil {
+		return x.FeatureId
+	}
+	return ""
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) GetFearCost() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.FearCost
+	}
+	return nil
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) GetStressCost() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.StressCost
+	}
+	return nil
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) GetDifficultyBonus() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.DifficultyBonus
+	}
+	return nil
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) GetTargetIds() []string {
+	if x != nil {
+		return x.TargetIds
+	}
+	return nil
+}
+
+func (x *DaggerheartActivateAdversaryFeatureRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DaggerheartActivateAdversaryFeatureResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Adversary    *DaggerheartAdversary  `protobuf:"bytes,1,opt,name=adversary,proto3" json:"adversary,omitempty"`
+	FeatureId    string                 `protobuf:"bytes,2,opt,name=feature_id,json=featureId,proto3" json:"feature_id,omitempty"`
+	GmFearBefore int32                  `protobuf:"varint,3,opt,name=gm_fear_before,json=gmFearBefore,proto3" json:"gm_fear_before,omitempty"`
+	GmFearAfter  int32                  `protobuf:"varint,4,opt,name=gm_fear_after,json=gmFearAfter,proto3" json:"gm_fear_after,omitempty"`
+	// Cooldown state of the adversary's features after activation.
+	FeatureStates []*DaggerheartAdversaryFeatureState `protobuf:"bytes,5,rep,name=feature_states,json=featureStates,proto3" json:"feature_states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartActivateAdversaryFeatureResponse) Reset() {
+	*x = DaggerheartActivateAdversaryFeatureResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartActivateAdversaryFeatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartActivateAdversaryFeatureResponse) ProtoMessage() {}
+
+func (x *DaggerheartActivateAdversaryFeatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartActivateAdversaryFeatureResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartActivateAdversaryFeatureResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DaggerheartActivateAdversaryFeatureResponse) GetAdversary() *DaggerheartAdversary {
+	if x != nil {
+		return x.Adversary
+	}
+	return nil
+}
+
+func (x *DaggerheartActivateAdversaryFeatureResponse) GetFeatureId() string {
+	if x != nil {
+		return x.FeatureId
+	}
+	return ""
+}
+
+func (x *DaggerheartActivateAdversaryFeatureResponse) GetGmFearBefore() int32 {
+	if x != nil {
+		return x.GmFearBefore
+	}
+	return 0
+}
+
+func (x *DaggerheartActivateAdversaryFeatureResponse) GetGmFearAfter() int32 {
+	if x != nil {
+		return x.GmFearAfter
+	}
+	return 0
+}
+
+func (x *DaggerheartActivateAdversaryFeatureResponse) GetFeatureStates() []*DaggerheartAdversaryFeatureState {
+	if x != nil {
+		return x.FeatureStates
+	}
+	return nil
+}
+
+type DaggerheartListAdversaryFeatureStatesRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Optional adversary filter; empty lists every adversary in the campaign.
+	AdversaryId   string `protobuf:"bytes,2,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartListAdversaryFeatureStatesRequest) Reset() {
+	*x = DaggerheartListAdversaryFeatureStatesRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartListAdversaryFeatureStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartListAdversaryFeatureStatesRequest) ProtoMessage() {}
+
+func (x *DaggerheartListAdversaryFeatureStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartListAdversaryFeatureStatesRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartListAdversaryFeatureStatesRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DaggerheartListAdversaryFeatureStatesRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DaggerheartListAdversaryFeatureStatesRequest) GetAdversaryId() string {
+	if x != nil {
+		return x.AdversaryId
+	}
+	return ""
+}
+
+type DaggerheartListAdversaryFeatureStatesResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	FeatureStates []*DaggerheartAdversaryFeatureState `protobuf:"bytes,1,rep,name=feature_states,json=featureStates,proto3" json:"feature_states,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartListAdversaryFeatureStatesResponse) Reset() {
+	*x = DaggerheartListAdversaryFeatureStatesResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartListAdversaryFeatureStatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartListAdversaryFeatureStatesResponse) ProtoMessage() {}
+
+func (x *DaggerheartListAdversaryFeatureStatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartListAdversaryFeatureStatesResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartListAdversaryFeatureStatesResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DaggerheartListAdversaryFeatureStatesResponse) GetFeatureStates() []*DaggerheartAdversaryFeatureState {
+	if x != nil {
+		return x.FeatureStates
+	}
+	return nil
+}
+
+// A group of catalog adversaries in an encounter.
+type DaggerheartEncounterGroup struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AdversaryEntryId string                 `protobuf:"bytes,1,opt,name=adversary_entry_id,json=adversaryEntryId,proto3" json:"adversary_entry_id,omitempty"`
+	// Number of adversaries; defaults to 1, at most 50.
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// Optional battle point role; defaults to the catalog role.
+	Role string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// Optional per-adversary names, in order; blanks keep the default name.
+	Names         []string `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartEncounterGroup) Reset() {
+	*x = DaggerheartEncounterGroup{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartEncounterGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartEncounterGroup) ProtoMessage() {}
+
+func (x *DaggerheartEncounterGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartEncounterGroup.ProtoReflect.Descriptor instead.
+func (*DaggerheartEncounterGroup) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DaggerheartEncounterGroup) GetAdversaryEntryId() string {
+	if x != nil {
+		return x.AdversaryEntryId
+	}
+	return ""
+}
+
+func (x *DaggerheartEncounterGroup) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DaggerheartEncounterGroup) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *DaggerheartEncounterGroup) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// GM choices that adjust the battle point budget.
+type DaggerheartEncounterOptions struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EasierFight bool                   `protobuf:"varint,1,opt,name=easier_fight,json=easierFight,proto3" json:"easier_fight,omitempty"`
+	HarderFight bool                   `protobuf:"varint,2,opt,name=harder_fight,json=harderFight,proto3" json:"harder_fight,omitempty"`
+	// Adversaries deal +1d4 (or a static +2) bonus damage.
+	BonusDamage bool `protobuf:"varint,3,opt,name=bonus_damage,json=bonusDamage,proto3" json:"bonus_damage,omitempty"`
+	// Optional party size; defaults to the campaign's player characters.
+	PartySize     *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartEncounterOptions) Reset() {
+	*x = DaggerheartEncounterOptions{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartEncounterOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartEncounterOptions) ProtoMessage() {}
+
+func (x *DaggerheartEncounterOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartEncounterOptions.ProtoReflect.Descriptor instead.
+func (*DaggerheartEncounterOptions) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DaggerheartEncounterOptions) GetEasierFight() bool {
+	if x != nil {
+		return x.EasierFight
+	}
+	return false
+}
+
+func (x *DaggerheartEncounterOptions) GetHarderFight() bool {
+	if x != nil {
+		return x.HarderFight
+	}
+	return false
+}
+
+func (x *DaggerheartEncounterOptions) GetBonusDamage() bool {
+	if x != nil {
+		return x.BonusDamage
+	}
+	return false
+}
+
+func (x *DaggerheartEncounterOptions) GetPartySize() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.PartySize
+	}
+	return nil
+}
+
+type DaggerheartEncounterAdjustment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Points        int32                  `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartEncounterAdjustment) Reset() {
+	*x = DaggerheartEncounterAdjustment{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartEncounterAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartEncounterAdjustment) ProtoMessage() {}
+
+func (x *DaggerheartEncounterAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartEncounterAdjustment.ProtoReflect.Descriptor instead.
+func (*DaggerheartEncounterAdjustment) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DaggerheartEncounterAdjustment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DaggerheartEncounterAdjustment) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type DaggerheartEncounterGroupCost struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AdversaryEntryId string                 `protobuf:"bytes,1,opt,name=adversary_entry_id,json=adversaryEntryId,proto3" json:"adversary_entry_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role             string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Tier             int32                  `protobuf:"varint,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	Count            int32                  `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	BattlePoints     int32                  `protobuf:"varint,6,opt,name=battle_points,json=battlePoints,proto3" json:"battle_points,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DaggerheartEncounterGroupCost) Reset() {
+	*x = DaggerheartEncounterGroupCost{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartEncounterGroupCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartEncounterGroupCost) ProtoMessage() {}
+
+func (x *DaggerheartEncounterGroupCost) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartEncounterGroupCost.ProtoReflect.Descriptor instead.
+func (*DaggerheartEncounterGroupCost) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DaggerheartEncounterGroupCost) GetAdversaryEntryId() string {
+	if x != nil {
+		return x.AdversaryEntryId
+	}
+	return ""
+}
+
+func (x *DaggerheartEncounterGroupCost) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DaggerheartEncounterGroupCost) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *DaggerheartEncounterGroupCost) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *DaggerheartEncounterGroupCost) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DaggerheartEncounterGroupCost) GetBattlePoints() int32 {
+	if x != nil {
+		return x.BattlePoints
+	}
+	return 0
+}
+
+type DaggerheartEncounterBudget struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PartySize int32                  `protobuf:"varint,1,opt,name=party_size,json=partySize,proto3" json:"party_size,omitempty"`
+	PartyTier int32                  `protobuf:"varint,2,opt,name=party_tier,json=partyTier,proto3" json:"party_tier,omitempty"`
+	// Base budget of (3 x party size) + 2.
+	Base        int32                             `protobuf:"varint,3,opt,name=base,proto3" json:"base,omitempty"`
+	Adjustments []*DaggerheartEncounterAdjustment `protobuf:"bytes,4,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	Total       int32                             `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Spent       int32                             `protobuf:"varint,6,opt,name=spent,proto3" json:"spent,omitempty"`
+	// Budget left after the groups; negative when over budget.
+	Remaining     int32                            `protobuf:"varint,7,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Groups        []*DaggerheartEncounterGroupCost `protobuf:"bytes,8,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartEncounterBudget) Reset() {
+	*x = DaggerheartEncounterBudget{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartEncounterBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartEncounterBudget) ProtoMessage() {}
+
+func (x *DaggerheartEncounterBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartEncounterBudget.ProtoReflect.Descriptor instead.
+func (*DaggerheartEncounterBudget) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DaggerheartEncounterBudget) GetPartySize() int32 {
+	if x != nil {
+		return x.PartySize
+	}
+	return 0
+}
+
+func (x *DaggerheartEncounterBudget) GetPartyTier() int32 {
+	if x != nil {
+		return x.PartyTier
+	}
+	return 0
+}
+
+func (x *DaggerheartEncounterBudget) GetBase() int32 {
+	if x != nil {
+		return x.Base
+	}
+	return 0
+}
+
+func (x *DaggerheartEncounterBudget) GetAdjustments() []*DaggerheartEncounterAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+func (x *DaggerheartEncounterBudget) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DaggerheartEncounterBudget) GetSpent() int32 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *DaggerheartEncounterBudget) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *DaggerheartEncounterBudget) GetGroups() []*DaggerheartEncounterGroupCost {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type DaggerheartPlanEncounterRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	CampaignId    string                       `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Groups        []*DaggerheartEncounterGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	Options       *DaggerheartEncounterOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartPlanEncounterRequest) Reset() {
+	*x = DaggerheartPlanEncounterRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartPlanEncounterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartPlanEncounterRequest) ProtoMessage() {}
+
+func (x *DaggerheartPlanEncounterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartPlanEncounterRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartPlanEncounterRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DaggerheartPlanEncounterRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DaggerheartPlanEncounterRequest) GetGroups() []*DaggerheartEncounterGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *DaggerheartPlanEncounterRequest) GetOptions() *DaggerheartEncounterOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type DaggerheartPlanEncounterResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Budget        *DaggerheartEncounterBudget `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartPlanEncounterResponse) Reset() {
+	*x = DaggerheartPlanEncounterResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartPlanEncounterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartPlanEncounterResponse) ProtoMessage() {}
+
+func (x *DaggerheartPlanEncounterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartPlanEncounterResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartPlanEncounterResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DaggerheartPlanEncounterResponse) GetBudget() *DaggerheartEncounterBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type DaggerheartCommitEncounterRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	CampaignId    string                       `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                       `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Groups        []*DaggerheartEncounterGroup `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	Options       *DaggerheartEncounterOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartCommitEncounterRequest) Reset() {
+	*x = DaggerheartCommitEncounterRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartCommitEncounterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartCommitEncounterRequest) ProtoMessage() {}
+
+func (x *DaggerheartCommitEncounterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartCommitEncounterRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartCommitEncounterRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DaggerheartCommitEncounterRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DaggerheartCommitEncounterRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DaggerheartCommitEncounterRequest) GetGroups() []*DaggerheartEncounterGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *DaggerheartCommitEncounterRequest) GetOptions() *DaggerheartEncounterOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type DaggerheartCommitEncounterResponse struct {
+	state  protoimpl.MessageState      `protogen:"open.v1"`
+	Budget *DaggerheartEncounterBudget `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	// Every created adversary, in group order.
+	Adversaries   []*DaggerheartAdversary `protobuf:"bytes,2,rep,name=adversaries,proto3" json:"adversaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartCommitEncounterResponse) Reset() {
+	*x = DaggerheartCommitEncounterResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartCommitEncounterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartCommitEncounterResponse) ProtoMessage() {}
+
+func (x *DaggerheartCommitEncounterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartCommitEncounterResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartCommitEncounterResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DaggerheartCommitEncounterResponse) GetBudget() *DaggerheartEncounterBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+func (x *DaggerheartCommitEncounterResponse) GetAdversaries() []*DaggerheartAdversary {
+	if x != nil {
+		return x.Adversaries
+	}
+	return nil
+}
+
+type DaggerheartResolveBlazeOfGloryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId   string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartResolveBlazeOfGloryRequest) Reset() {
+	*x = DaggerheartResolveBlazeOfGloryRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartResolveBlazeOfGloryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartResolveBlazeOfGloryRequest) ProtoMessage() {}
+
+func (x *DaggerheartResolveBlazeOfGloryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartResolveBlazeOfGloryRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartResolveBlazeOfGloryRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DaggerheartResolveBlazeOfGloryRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *DaggerheartResolveBlazeOfGloryRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+type DaggerheartBlazeOfGloryResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LifeState     DaggerheartLifeState   `protobuf:"varint,1,opt,name=life_state,json=lifeState,proto3,enum=systems.daggerheart.v1.DaggerheartLifeState" json:"life_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartBlazeOfGloryResult) Reset() {
+	*x = DaggerheartBlazeOfGloryResult{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartBlazeOfGloryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartBlazeOfGloryResult) ProtoMessage() {}
+
+func (x *DaggerheartBlazeOfGloryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartBlazeOfGloryResult.ProtoReflect.Descriptor instead.
+func (*DaggerheartBlazeOfGloryResult) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DaggerheartBlazeOfGloryResult) GetLifeState() DaggerheartLifeState {
+	if x != nil {
+		return x.LifeState
+	}
+	return DaggerheartLifeState_DAGGERHEART_LIFE_STATE_UNSPECIFIED
+}
+
+type DaggerheartResolveBlazeOfGloryResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	CharacterId   string                         `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	State         *DaggerheartCharacterState     `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Result        *DaggerheartBlazeOfGloryResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartResolveBlazeOfGloryResponse) Reset() {
+	*x = DaggerheartResolveBlazeOfGloryResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartResolveBlazeOfGloryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartResolveBlazeOfGloryResponse) ProtoMessage() {}
+
+func (x *DaggerheartResolveBlazeOfGloryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartResolveBlazeOfGloryResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartResolveBlazeOfGloryResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DaggerheartResolveBlazeOfGloryResponse) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *DaggerheartResolveBlazeOfGloryResponse) GetState() *DaggerheartCharacterState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *DaggerheartResolveBlazeOfGloryResponse) GetResult() *DaggerheartBlazeOfGloryResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ActionRollRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Additive modifier applied after summing the two d12s.
+	Modifier int32 `protobuf:"varint,1,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	// If omitted, the server returns a roll outcome (Hope/Fear/Crit) but does not
+	// classify as success/failure.
+	Difficulty *int32 `protobuf:"varint,2,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
+	// Count of advantage dice (d6). Cancels against disadvantage.
+	Advantage int32 `protobuf:"varint,3,opt,name=advantage,proto3" json:"advantage,omitempty"`
+	// Count of disadvantage dice (d6). Cancels against advantage.
+	Disadvantage int32 `protobuf:"varint,4,opt,name=disadvantage,proto3" json:"disadvantage,omitempty"`
+	// Optional RNG configuration for deterministic rolls.
+	Rng *v1.RngRequest `protobuf:"bytes,5,opt,name=rng,proto3" json:"rng,omitempty"`
+	// Named advantage/disadvantage sources pooled with the counts above.
+	AdvantageSources []*AdvantageSource `protobuf:"bytes,6,rep,name=advantage_sources,json=advantageSources,proto3" json:"advantage_sources,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ActionRollRequest) Reset() {
+	*x = ActionRollRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionRollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionRollRequest) ProtoMessage() {}
+
+func (x *ActionRollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionRollRequest.ProtoReflect.Descriptor instead.
+func (*ActionRollRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ActionRollRequest) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *ActionRollRequest) GetDifficulty() int32 {
+	if x != nil && x.Difficulty != nil {
+		return *x.Difficulty
+	}
+	return 0
+}
+
+func (x *ActionRollRequest) GetAdvantage() int32 {
+	if x != nil {
+		return x.Advantage
+	}
+	return 0
+}
+
+func (x *ActionRollRequest) GetDisadvantage() int32 {
+	if x != nil {
+		return x.Disadvantage
+	}
+	return 0
+}
+
+func (x *ActionRollRequest) GetRng() *v1.RngRequest {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+func (x *ActionRollRequest) GetAdvantageSources() []*AdvantageSource {
+	if x != nil {
+		return x.AdvantageSources
+	}
+	return nil
+}
+
+type ActionRollResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Hope     int32                  `protobuf:"varint,1,opt,name=hope,proto3" json:"hope,omitempty"`
+	Fear     int32                  `protobuf:"varint,2,opt,name=fear,proto3" json:"fear,omitempty"`
+	Modifier int32                  `protobuf:"varint,3,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	// Applied d6 roll when advantage/disadvantage is present.
+	AdvantageDie int32 `protobuf:"varint,4,opt,name=advantage_die,json=advantageDie,proto3" json:"advantage_die,omitempty"`
+	// Signed modifier derived from advantage_die.
+	AdvantageModifier int32 `protobuf:"varint,5,opt,name=advantage_modifier,json=advantageModifier,proto3" json:"advantage_modifier,omitempty"`
+	// Echoed back if provided in the request.
+	Difficulty *int32 `protobuf:"varint,6,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
+	// total = hope + fear + modifier
+	Total           int32           `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	IsCrit          bool            `protobuf:"varint,8,opt,name=is_crit,json=isCrit,proto3" json:"is_crit,omitempty"`
+	MeetsDifficulty bool            `protobuf:"varint,9,opt,name=meets_difficulty,json=meetsDifficulty,proto3" json:"meets_difficulty,omitempty"`
+	Outcome         Outcome         `protobuf:"varint,10,opt,name=outcome,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcome,omitempty"`
+	Rng             *v1.RngResponse `protobuf:"bytes,11,opt,name=rng,proto3" json:"rng,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ActionRollResponse) Reset() {
+	*x = ActionRollResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionRollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionRollResponse) ProtoMessage() {}
+
+func (x *ActionRollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionRollResponse.ProtoReflect.Descriptor instead.
+func (*ActionRollResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ActionRollResponse) GetHope() int32 {
+	if x != nil {
+		return x.Hope
+	}
+	return 0
+}
+
+func (x *ActionRollResponse) GetFear() int32 {
+	if x != nil {
+		return x.Fear
+	}
+	return 0
+}
+
+func (x *ActionRollResponse) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *ActionRollResponse) GetAdvantageDie() int32 {
+	if x != nil {
+		return x.AdvantageDie
+	}
+	return 0
+}
+
+func (x *ActionRollResponse) GetAdvantageModifier() int32 {
+	if x != nil {
+		return x.AdvantageModifier
+	}
+	return 0
+}
+
+func (x *ActionRollResponse) GetDifficulty() int32 {
+	if x != nil && x.Difficulty != nil {
+		return *x.Difficulty
+	}
+	return 0
+}
+
+func (x *ActionRollResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ActionRollResponse) GetIsCrit() bool {
+	if x != nil {
+		return x.IsCrit
+	}
+	return false
+}
+
+func (x *ActionRollResponse) GetMeetsDifficulty() bool {
+	if x != nil {
+		return x.MeetsDifficulty
+	}
+	return false
+}
+
+func (x *ActionRollResponse) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+func (x *ActionRollResponse) GetRng() *v1.RngResponse {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+type DualityOutcomeRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Hope     int32                  `protobuf:"varint,1,opt,name=hope,proto3" json:"hope,omitempty"`
+	Fear     int32                  `protobuf:"varint,2,opt,name=fear,proto3" json:"fear,omitempty"`
+	Modifier int32                  `protobuf:"varint,3,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	// If omitted, the server returns a roll outcome (Hope/Fear/Crit) but does not
+	// classify as success/failure.
+	Difficulty    *int32 `protobuf:"varint,4,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DualityOutcomeRequest) Reset() {
+	*x = DualityOutcomeRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DualityOutcomeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DualityOutcomeRequest) ProtoMessage() {}
+
+func (x *DualityOutcomeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DualityOutcomeRequest.ProtoReflect.Descriptor instead.
+func (*DualityOutcomeRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DualityOutcomeRequest) GetHope() int32 {
+	if x != nil {
+		return x.Hope
+	}
+	return 0
+}
+
+func (x *DualityOutcomeRequest) GetFear() int32 {
+	if x != nil {
+		return x.Fear
+	}
+	return 0
+}
+
+func (x *DualityOutcomeRequest) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *DualityOutcomeRequest) GetDifficulty() int32 {
+	if x != nil && x.Difficulty != nil {
+		return *x.Difficulty
+	}
+	return 0
+}
+
+type DualityOutcomeResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Hope     int32                  `protobuf:"varint,1,opt,name=hope,proto3" json:"hope,omitempty"`
+	Fear     int32                  `protobuf:"varint,2,opt,name=fear,proto3" json:"fear,omitempty"`
+	Modifier int32                  `protobuf:"varint,3,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	// Echoed back if provided in the request.
+	Difficulty *int32 `protobuf:"varint,4,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
+	// total = hope + fear + modifier
+	Total           int32   `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	IsCrit          bool    `protobuf:"varint,6,opt,name=is_crit,json=isCrit,proto3" json:"is_crit,omitempty"`
+	MeetsDifficulty bool    `protobuf:"varint,7,opt,name=meets_difficulty,json=meetsDifficulty,proto3" json:"meets_difficulty,omitempty"`
+	Outcome         Outcome `protobuf:"varint,8,opt,name=outcome,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcome,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DualityOutcomeResponse) Reset() {
+	*x = DualityOutcomeResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DualityOutcomeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DualityOutcomeResponse) ProtoMessage() {}
+
+func (x *DualityOutcomeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DualityOutcomeResponse.ProtoReflect.Descriptor instead.
+func (*DualityOutcomeResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DualityOutcomeResponse) GetHope() int32 {
+	if x != nil {
+		return x.Hope
+	}
+	return 0
+}
+
+func (x *DualityOutcomeResponse) GetFear() int32 {
+	if x != nil {
+		return x.Fear
+	}
+	return 0
+}
+
+func (x *DualityOutcomeResponse) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *DualityOutcomeResponse) GetDifficulty() int32 {
+	if x != nil && x.Difficulty != nil {
+		return *x.Difficulty
+	}
+	return 0
+}
+
+func (x *DualityOutcomeResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DualityOutcomeResponse) GetIsCrit() bool {
+	if x != nil {
+		return x.IsCrit
+	}
+	return false
+}
+
+func (x *DualityOutcomeResponse) GetMeetsDifficulty() bool {
+	if x != nil {
+		return x.MeetsDifficulty
+	}
+	return false
+}
+
+func (x *DualityOutcomeResponse) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+type DualityExplainRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Hope     int32                  `protobuf:"varint,1,opt,name=hope,proto3" json:"hope,omitempty"`
+	Fear     int32                  `protobuf:"varint,2,opt,name=fear,proto3" json:"fear,omitempty"`
+	Modifier int32                  `protobuf:"varint,3,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	// If omitted, the server returns a roll outcome (Hope/Fear/Crit) but does not
+	// classify as success/failure.
+	Difficulty *int32 `protobuf:"varint,4,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
+	// Optional correlation identifier for callers.
+	RequestId *string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`
+	// Named advantage/disadvantage sources to explain. The applied d6 is
+	// expected to be included in modifier.
+	AdvantageSources []*AdvantageSource `protobuf:"bytes,6,rep,name=advantage_sources,json=advantageSources,proto3" json:"advantage_sources,omitempty"`
+	// Help an Ally d6s left after cancellation, in helper order. Each helper
+	// pools as an advantage source; the highest die kept is expected to be
+	// included in modifier.
+	HelpDice []int32 `protobuf:"varint,7,rep,packed,name=help_dice,json=helpDice,proto3" json:"help_dice,omitempty"`
+	// Experiences spent on the roll, each for 1 Hope. Their modifiers are
+	// expected to be included in modifier.
+	Experiences   []*DaggerheartExperience `protobuf:"bytes,8,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DualityExplainRequest) Reset() {
+	*x = DualityExplainRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DualityExplainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DualityExplainRequest) ProtoMessage() {}
+
+func (x *DualityExplainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DualityExplainRequest.ProtoReflect.Descriptor instead.
+func (*DualityExplainRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DualityExplainRequest) GetHope() int32 {
+	if x != nil {
+		return x.Hope
+	}
+	return 0
+}
+
+func (x *DualityExplainRequest) GetFear() int32 {
+	if x != nil {
+		return x.Fear
+	}
+	return 0
+}
+
+func (x *DualityExplainRequest) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *DualityExplainRequest) GetDifficulty() int32 {
+	if x != nil && x.Difficulty != nil {
+		return *x.Difficulty
+	}
+	return 0
+}
+
+func (x *DualityExplainRequest) GetRequestId() string {
+	if x != nil && x.RequestId != nil {
+		return *x.RequestId
+	}
+	return ""
+}
+
+func (x *DualityExplainRequest) GetAdvantageSources() []*AdvantageSource {
+	if x != nil {
+		return x.AdvantageSources
+	}
+	return nil
+}
+
+func (x *DualityExplainRequest) GetHelpDice() []int32 {
+	if x != nil {
+		return x.HelpDice
+	}
+	return nil
+}
+
+func (x *DualityExplainRequest) GetExperiences() []*DaggerheartExperience {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+type DualityExplainResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Hope     int32                  `protobuf:"varint,1,opt,name=hope,proto3" json:"hope,omitempty"`
+	Fear     int32                  `protobuf:"varint,2,opt,name=fear,proto3" json:"fear,omitempty"`
+	Modifier int32                  `protobuf:"varint,3,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	// Echoed back if provided in the request.
+	Difficulty *int32 `protobuf:"varint,4,opt,name=difficulty,proto3,oneof" json:"difficulty,omitempty"`
+	// total = hope + fear + modifier
+	Total           int32          `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	IsCrit          bool           `protobuf:"varint,6,opt,name=is_crit,json=isCrit,proto3" json:"is_crit,omitempty"`
+	MeetsDifficulty bool           `protobuf:"varint,7,opt,name=meets_difficulty,json=meetsDifficulty,proto3" json:"meets_difficulty,omitempty"`
+	Outcome         Outcome        `protobuf:"varint,8,opt,name=outcome,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcome,omitempty"`
+	RulesVersion    string         `protobuf:"bytes,9,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
+	Intermediates   *Intermediates `protobuf:"bytes,10,opt,name=intermediates,proto3" json:"intermediates,omitempty"`
+	Steps           []*ExplainStep `protobuf:"bytes,11,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DualityExplainResponse) Reset() {
+	*x = DualityExplainResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DualityExplainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DualityExplainResponse) ProtoMessage() {}
+
+func (x *DualityExplainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DualityExplainResponse.ProtoReflect.Descriptor instead.
+func (*DualityExplainResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *DualityExplainResponse) GetHope() int32 {
+	if x != nil {
+		return x.Hope
+	}
+	return 0
+}
+
+func (x *DualityExplainResponse) GetFear() int32 {
+	if x != nil {
+		return x.Fear
+	}
+	return 0
+}
+
+func (x *DualityExplainResponse) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *DualityExplainResponse) GetDifficulty() int32 {
+	if x != nil && x.Difficulty != nil {
+		return *x.Difficulty
+	}
+	return 0
+}
+
+func (x *DualityExplainResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DualityExplainResponse) GetIsCrit() bool {
+	if x != nil {
+		return x.IsCrit
+	}
+	return false
+}
+
+func (x *DualityExplainResponse) GetMeetsDifficulty() bool {
+	if x != nil {
+		return x.MeetsDifficulty
+	}
+	return false
+}
+
+func (x *DualityExplainResponse) GetOutcome() Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Outcome_OUTCOME_UNSPECIFIED
+}
+
+func (x *DualityExplainResponse) GetRulesVersion() string {
+	if x != nil {
+		return x.RulesVersion
+	}
+	return ""
+}
+
+func (x *DualityExplainResponse) GetIntermediates() *Intermediates {
+	if x != nil {
+		return x.Intermediates
+	}
+	return nil
+}
+
+func (x *DualityExplainResponse) GetSteps() []*ExplainStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type DualityProbabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Modifier      int32                  `protobuf:"varint,1,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	Difficulty    int32                  `protobuf:"varint,2,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DualityProbabilityRequest) Reset() {
+	*x = DualityProbabilityRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DualityProbabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DualityProbabilityRequest) ProtoMessage() {}
+
+func (x *DualityProbabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DualityProbabilityRequest.ProtoReflect.Descriptor instead.
+func (*DualityProbabilityRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DualityProbabilityRequest) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *DualityProbabilityRequest) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+type DualityProbabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalOutcomes int32                  `protobuf:"varint,1,opt,name=total_outcomes,json=totalOutcomes,proto3" json:"total_outcomes,omitempty"`
+	CritCount     int32                  `protobuf:"varint,2,opt,name=crit_count,json=critCount,proto3" json:"crit_count,omitempty"`
+	SuccessCount  int32                  `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount  int32                  `protobuf:"varint,4,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	OutcomeCounts []*OutcomeCount        `protobuf:"bytes,5,rep,name=outcome_counts,json=outcomeCounts,proto3" json:"outcome_counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DualityProbabilityResponse) Reset() {
+	*x = DualityProbabilityResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DualityProbabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DualityProbabilityResponse) ProtoMessage() {}
+
+func (x *DualityProbabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DualityProbabilityResponse.ProtoReflect.Descriptor instead.
+func (*DualityProbabilityResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DualityProbabilityResponse) GetTotalOutcomes() int32 {
+	if x != nil {
+		return x.TotalOutcomes
+	}
+	return 0
+}
+
+func (x *DualityProbabilityResponse) GetCritCount() int32 {
+	if x != nil {
+		return x.CritCount
+	}
+	return 0
+}
+
+func (x *DualityProbabilityResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *DualityProbabilityResponse) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *DualityProbabilityResponse) GetOutcomeCounts() []*OutcomeCount {
+	if x != nil {
+		return x.OutcomeCounts
+	}
+	return nil
+}
+
+type RulesVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RulesVersionRequest) Reset() {
+	*x = RulesVersionRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RulesVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesVersionRequest) ProtoMessage() {}
+
+func (x *RulesVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesVersionRequest.ProtoReflect.Descriptor instead.
+func (*RulesVersionRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{94}
+}
+
+type RulesVersionResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	System         string                 `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	Module         string                 `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	RulesVersion   string                 `protobuf:"bytes,3,opt,name=rules_version,json=rulesVersion,proto3" json:"rules_version,omitempty"`
+	DiceModel      string                 `protobuf:"bytes,4,opt,name=dice_model,json=diceModel,proto3" json:"dice_model,omitempty"`
+	TotalFormula   string                 `protobuf:"bytes,5,opt,name=total_formula,json=totalFormula,proto3" json:"total_formula,omitempty"`
+	CritRule       string                 `protobuf:"bytes,6,opt,name=crit_rule,json=critRule,proto3" json:"crit_rule,omitempty"`
+	DifficultyRule string                 `protobuf:"bytes,7,opt,name=difficulty_rule,json=difficultyRule,proto3" json:"difficulty_rule,omitempty"`
+	Outcomes       []Outcome              `protobuf:"varint,8,rep,packed,name=outcomes,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcomes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RulesVersionResponse) Reset() {
+	*x = RulesVersionResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RulesVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesVersionResponse) ProtoMessage() {}
+
+func (x *RulesVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesVersionResponse.ProtoReflect.Descriptor instead.
+func (*RulesVersionResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *RulesVersionResponse) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *RulesVersionResponse) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *RulesVersionResponse) GetRulesVersion() string {
+	if x != nil {
+		return x.RulesVersion
+	}
+	return ""
+}
+
+func (x *RulesVersionResponse) GetDiceModel() string {
+	if x != nil {
+		return x.DiceModel
+	}
+	return ""
+}
+
+func (x *RulesVersionResponse) GetTotalFormula() string {
+	if x != nil {
+		return x.TotalFormula
+	}
+	return ""
+}
+
+func (x *RulesVersionResponse) GetCritRule() string {
+	if x != nil {
+		return x.CritRule
+	}
+	return ""
+}
+
+func (x *RulesVersionResponse) GetDifficultyRule() string {
+	if x != nil {
+		return x.DifficultyRule
+	}
+	return ""
+}
+
+func (x *RulesVersionResponse) GetOutcomes() []Outcome {
+	if x != nil {
+		return x.Outcomes
+	}
+	return nil
+}
+
+type RollDiceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The dice to roll in order.
+	Dice []*DiceSpec `protobuf:"bytes,1,rep,name=dice,proto3" json:"dice,omitempty"`
+	// Optional RNG configuration for deterministic rolls.
+	Rng           *v1.RngRequest `protobuf:"bytes,2,opt,name=rng,proto3" json:"rng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollDiceRequest) Reset() {
+	*x = RollDiceRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollDiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollDiceRequest) ProtoMessage() {}
+
+func (x *RollDiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollDiceRequest.ProtoReflect.Descriptor instead.
+func (*RollDiceRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RollDiceRequest) GetDice() []*DiceSpec {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+func (x *RollDiceRequest) GetRng() *v1.RngRequest {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+type RollDiceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Rolls []*DiceRoll            `protobuf:"bytes,1,rep,name=rolls,proto3" json:"rolls,omitempty"`
+	// The sum of all rolls.
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// RNG details used for this roll.
+	Rng           *v1.RngResponse `protobuf:"bytes,3,opt,name=rng,proto3" json:"rng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollDiceResponse) Reset() {
+	*x = RollDiceResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollDiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollDiceResponse) ProtoMessage() {}
+
+func (x *RollDiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollDiceResponse.ProtoReflect.Descriptor instead.
+func (*RollDiceResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RollDiceResponse) GetRolls() []*DiceRoll {
+	if x != nil {
+		return x.Rolls
+	}
+	return nil
+}
+
+func (x *RollDiceResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RollDiceResponse) GetRng() *v1.RngResponse {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+type SessionActionRollRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The campaign ID for validation.
+	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// The session ID to associate with this roll.
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The character performing the roll.
+	CharacterId string `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// The trait being rolled.
+	Trait string `protobuf:"bytes,4,opt,name=trait,proto3" json:"trait,omitempty"`
+	// The kind of roll (action or reaction).
+	RollKind RollKind `protobuf:"varint,5,opt,name=roll_kind,json=rollKind,proto3,enum=systems.daggerheart.v1.RollKind" json:"roll_kind,omitempty"`
+	// The difficulty target.
+	Difficulty int32 `protobuf:"varint,6,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// Optional modifiers applied to the roll.
+	Modifiers []*ActionRollModifier `protobuf:"bytes,7,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	// Count of advantage dice (d6). Cancels against disadvantage.
+	Advantage int32 `protobuf:"varint,8,opt,name=advantage,proto3" json:"advantage,omitempty"`
+	// Count of disadvantage dice (d6). Cancels against advantage.
+	Disadvantage int32 `protobuf:"varint,9,opt,name=disadvantage,proto3" json:"disadvantage,omitempty"`
+	// Whether the roll is underwater (optional rules).
+	Underwater bool `protobuf:"varint,10,opt,name=underwater,proto3" json:"underwater,omitempty"`
+	// Optional breath countdown to advance for underwater actions.
+	BreathCountdownId string `protobuf:"bytes,11,opt,name=breath_countdown_id,json=breathCountdownId,proto3" json:"breath_countdown_id,omitempty"`
+	// Optional RNG configuration for deterministic rolls.
+	Rng *v1.RngRequest `protobuf:"bytes,12,opt,name=rng,proto3" json:"rng,omitempty"`
+	// Living player characters helping an ally; each spends 1 Hope and adds an
+	// advantage d6 that disadvantage cancels. Action rolls only.
+	HelperCharacterIds []string `protobuf:"bytes,13,rep,name=helper_character_ids,json=helperCharacterIds,proto3" json:"helper_character_ids,omitempty"`
+	// Experience names from the roller's profile; each costs 1 Hope and adds
+	// its modifier to the roll. Cannot be combined with an "experience"
+	// modifier.
+	Experiences []string `protobuf:"bytes,14,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	// Named advantage/disadvantage sources pooled with the counts above.
+	AdvantageSources []*AdvantageSource `protobuf:"bytes,15,rep,name=advantage_sources,json=advantageSources,proto3" json:"advantage_sources,omitempty"`
+	// Roll a second Fear die and keep the higher (Chaos Magic). Action rolls only.
+	ExtraFearDie  bool `protobuf:"varint,16,opt,name=extra_fear_die,json=extraFearDie,proto3" json:"extra_fear_die,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionActionRollRequest) Reset() {
+	*x = SessionActionRollRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionActionRollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionActionRollRequest) ProtoMessage() {}
+
+func (x *SessionActionRollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionActionRollRequest.ProtoReflect.Descriptor instead.
+func (*SessionActionRollRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SessionActionRollRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionActionRollRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionActionRollRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SessionActionRollRequest) GetTrait() string {
+	if x != nil {
+		return x.Trait
+	}
+	return ""
+}
+
+func (x *SessionActionRollRequest) GetRollKind() RollKind {
+	if x != nil {
+		return x.RollKind
+	}
+	return RollKind_ROLL_KIND_UNSPECIFIED
+}
+
+func (x *SessionActionRollRequest) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *SessionActionRollRequest) GetModifiers() []*ActionRollModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *SessionActionRollRequest) GetAdvantage() int32 {
+	if x != nil {
+		return x.Advantage
+	}
+	return 0
+}
+
+func (x *SessionActionRollRequest) GetDisadvantage() int32 {
+	if x != nil {
+		return x.Disadvantage
+	}
+	return 0
+}
+
+func (x *SessionActionRollRequest) GetUnderwater() bool {
+	if x != nil {
+		return x.Underwater
+	}
+	return false
+}
+
+func (x *SessionActionRollRequest) GetBreathCountdownId() string {
+	if x != nil {
+		return x.BreathCountdownId
+	}
+	return ""
+}
+
+func (x *SessionActionRollRequest) GetRng() *v1.RngRequest {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+func (x *SessionActionRollRequest) GetHelperCharacterIds() []string {
+	if x != nil {
+		return x.HelperCharacterIds
+	}
+	return nil
+}
+
+func (x *SessionActionRollRequest) GetExperiences() []string {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+func (x *SessionActionRollRequest) GetAdvantageSources() []*AdvantageSource {
+	if x != nil {
+		return x.AdvantageSources
+	}
+	return nil
+}
+
+func (x *SessionActionRollRequest) GetExtraFearDie() bool {
+	if x != nil {
+		return x.ExtraFearDie
+	}
+	return false
+}
+
+type SessionActionRollResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	RollSeq    uint64                 `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	HopeDie    int32                  `protobuf:"varint,2,opt,name=hope_die,json=hopeDie,proto3" json:"hope_die,omitempty"`
+	FearDie    int32                  `protobuf:"varint,3,opt,name=fear_die,json=fearDie,proto3" json:"fear_die,omitempty"`
+	Total      int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Difficulty int32                  `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Success    bool                   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	Flavor     string                 `protobuf:"bytes,7,opt,name=flavor,proto3" json:"flavor,omitempty"`
+	Crit       bool                   `protobuf:"varint,8,opt,name=crit,proto3" json:"crit,omitempty"`
+	Rng        *v1.RngResponse        `protobuf:"bytes,9,opt,name=rng,proto3" json:"rng,omitempty"`
+	// Helper dice rolled for Help an Ally, in helper order; dice cancelled by
+	// disadvantage are not rolled.
+	HelperDice []int32 `protobuf:"varint,10,rep,packed,name=helper_dice,json=helperDice,proto3" json:"helper_dice,omitempty"`
+	// Helper die added to the total when it is the highest advantage die kept.
+	HelpBonus int32 `protobuf:"varint,11,opt,name=help_bonus,json=helpBonus,proto3" json:"help_bonus,omitempty"`
+	// Sum of the Experience modifiers added to the total.
+	ExperienceBonus int32 `protobuf:"varint,12,opt,name=experience_bonus,json=experienceBonus,proto3" json:"experience_bonus,omitempty"`
+	// All Fear dice rolled when extra_fear_die is set; fear_die is the kept one.
+	FearDice      []int32 `protobuf:"varint,13,rep,packed,name=fear_dice,json=fearDice,proto3" json:"fear_dice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionActionRollResponse) Reset() {
+	*x = SessionActionRollResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionActionRollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionActionRollResponse) ProtoMessage() {}
+
+func (x *SessionActionRollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionActionRollResponse.ProtoReflect.Descriptor instead.
+func (*SessionActionRollResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SessionActionRollResponse) GetRollSeq() uint64 {
+	if x != nil {
+		return x.RollSeq
+	}
+	return 0
+}
+
+func (x *SessionActionRollResponse) GetHopeDie() int32 {
+	if x != nil {
+		return x.HopeDie
+	}
+	return 0
+}
+
+func (x *SessionActionRollResponse) GetFearDie() int32 {
+	if x != nil {
+		return x.FearDie
+	}
+	return 0
+}
+
+func (x *SessionActionRollResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SessionActionRollResponse) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *SessionActionRollResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SessionActionRollResponse) GetFlavor() string {
+	if x != nil {
+		return x.Flavor
+	}
+	return ""
+}
+
+func (x *SessionActionRollResponse) GetCrit() bool {
+	if x != nil {
+		return x.Crit
+	}
+	return false
+}
+
+func (x *SessionActionRollResponse) GetRng() *v1.RngResponse {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+func (x *SessionActionRollResponse) GetHelperDice() []int32 {
+	if x != nil {
+		return x.HelperDice
+	}
+	return nil
+}
+
+func (x *SessionActionRollResponse) GetHelpBonus() int32 {
+	if x != nil {
+		return x.HelpBonus
+	}
+	return 0
+}
+
+func (x *SessionActionRollResponse) GetExperienceBonus() int32 {
+	if x != nil {
+		return x.ExperienceBonus
+	}
+	return 0
+}
+
+func (x *SessionActionRollResponse) GetFearDice() []int32 {
+	if x != nil {
+		return x.FearDice
+	}
+	return nil
+}
+
+type SessionDamageRollRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The campaign ID for validation.
+	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// The session ID to associate with this roll.
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The character performing the roll.
+	CharacterId string `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// Damage dice to roll.
+	Dice []*DiceSpec `protobuf:"bytes,4,rep,name=dice,proto3" json:"dice,omitempty"`
+	// Flat modifier applied after summing rolls.
+	Modifier int32 `protobuf:"varint,5,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	// Whether to apply critical damage bonus.
+	Critical bool `protobuf:"varint,6,opt,name=critical,proto3" json:"critical,omitempty"`
 	// Optional RNG configuration for deterministic rolls.
-	Rng           *v1.RngRequest `protobuf:"bytes,2,opt,name=rng,proto3" json:"rng,omitempty"`
+	Rng *v1.RngRequest `protobuf:"bytes,7,opt,name=rng,proto3" json:"rng,omitempty"`
+	// Catalog weapon the damage is rolled for. When dice are omitted, the
+	// weapon's dice are rolled a number of times equal to the character's
+	// Proficiency; without a weapon ID the equipped primary weapon is used.
+	WeaponId      string `protobuf:"bytes,8,opt,name=weapon_id,json=weaponId,proto3" json:"weapon_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionDamageRollRequest) Reset() {
+	*x = SessionDamageRollRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionDamageRollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionDamageRollRequest) ProtoMessage() {}
+
+func (x *SessionDamageRollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionDamageRollRequest.ProtoReflect.Descriptor instead.
+func (*SessionDamageRollRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SessionDamageRollRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionDamageRollRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionDamageRollRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SessionDamageRollRequest) GetDice() []*DiceSpec {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+func (x *SessionDamageRollRequest) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *SessionDamageRollRequest) GetCritical() bool {
+	if x != nil {
+		return x.Critical
+	}
+	return false
+}
+
+func (x *SessionDamageRollRequest) GetRng() *v1.RngRequest {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+func (x *SessionDamageRollRequest) GetWeaponId() string {
+	if x != nil {
+		return x.WeaponId
+	}
+	return ""
+}
+
+type SessionDamageRollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RollSeq       uint64                 `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	Rolls         []*DiceRoll            `protobuf:"bytes,2,rep,name=rolls,proto3" json:"rolls,omitempty"`
+	BaseTotal     int32                  `protobuf:"varint,3,opt,name=base_total,json=baseTotal,proto3" json:"base_total,omitempty"`
+	Modifier      int32                  `protobuf:"varint,4,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	CriticalBonus int32                  `protobuf:"varint,5,opt,name=critical_bonus,json=criticalBonus,proto3" json:"critical_bonus,omitempty"`
+	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Critical      bool                   `protobuf:"varint,7,opt,name=critical,proto3" json:"critical,omitempty"`
+	Rng           *v1.RngResponse        `protobuf:"bytes,8,opt,name=rng,proto3" json:"rng,omitempty"`
+	WeaponId      string                 `protobuf:"bytes,9,opt,name=weapon_id,json=weaponId,proto3" json:"weapon_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionDamageRollResponse) Reset() {
+	*x = SessionDamageRollResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionDamageRollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionDamageRollResponse) ProtoMessage() {}
+
+func (x *SessionDamageRollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionDamageRollResponse.ProtoReflect.Descriptor instead.
+func (*SessionDamageRollResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SessionDamageRollResponse) GetRollSeq() uint64 {
+	if x != nil {
+		return x.RollSeq
+	}
+	return 0
+}
+
+func (x *SessionDamageRollResponse) GetRolls() []*DiceRoll {
+	if x != nil {
+		return x.Rolls
+	}
+	return nil
+}
+
+func (x *SessionDamageRollResponse) GetBaseTotal() int32 {
+	if x != nil {
+		return x.BaseTotal
+	}
+	return 0
+}
+
+func (x *SessionDamageRollResponse) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *SessionDamageRollResponse) GetCriticalBonus() int32 {
+	if x != nil {
+		return x.CriticalBonus
+	}
+	return 0
+}
+
+func (x *SessionDamageRollResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SessionDamageRollResponse) GetCritical() bool {
+	if x != nil {
+		return x.Critical
+	}
+	return false
+}
+
+func (x *SessionDamageRollResponse) GetRng() *v1.RngResponse {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+func (x *SessionDamageRollResponse) GetWeaponId() string {
+	if x != nil {
+		return x.WeaponId
+	}
+	return ""
+}
+
+type DaggerheartAttackDamageSpec struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	DamageType         DaggerheartDamageType  `protobuf:"varint,1,opt,name=damage_type,json=damageType,proto3,enum=systems.daggerheart.v1.DaggerheartDamageType" json:"damage_type,omitempty"`
+	ResistPhysical     bool                   `protobuf:"varint,2,opt,name=resist_physical,json=resistPhysical,proto3" json:"resist_physical,omitempty"`
+	ResistMagic        bool                   `protobuf:"varint,3,opt,name=resist_magic,json=resistMagic,proto3" json:"resist_magic,omitempty"`
+	ImmunePhysical     bool                   `protobuf:"varint,4,opt,name=immune_physical,json=immunePhysical,proto3" json:"immune_physical,omitempty"`
+	ImmuneMagic        bool                   `protobuf:"varint,5,opt,name=immune_magic,json=immuneMagic,proto3" json:"immune_magic,omitempty"`
+	Direct             bool                   `protobuf:"varint,6,opt,name=direct,proto3" json:"direct,omitempty"`
+	MassiveDamage      bool                   `protobuf:"varint,7,opt,name=massive_damage,json=massiveDamage,proto3" json:"massive_damage,omitempty"`
+	Source             string                 `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+	SourceCharacterIds []string               `protobuf:"bytes,9,rep,name=source_character_ids,json=sourceCharacterIds,proto3" json:"source_character_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DaggerheartAttackDamageSpec) Reset() {
+	*x = DaggerheartAttackDamageSpec{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartAttackDamageSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartAttackDamageSpec) ProtoMessage() {}
+
+func (x *DaggerheartAttackDamageSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartAttackDamageSpec.ProtoReflect.Descriptor instead.
+func (*DaggerheartAttackDamageSpec) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DaggerheartAttackDamageSpec) GetDamageType() DaggerheartDamageType {
+	if x != nil {
+		return x.DamageType
+	}
+	return DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_UNSPECIFIED
+}
+
+func (x *DaggerheartAttackDamageSpec) GetResistPhysical() bool {
+	if x != nil {
+		return x.ResistPhysical
+	}
+	return false
+}
+
+func (x *DaggerheartAttackDamageSpec) GetResistMagic() bool {
+	if x != nil {
+		return x.ResistMagic
+	}
+	return false
+}
+
+func (x *DaggerheartAttackDamageSpec) GetImmunePhysical() bool {
+	if x != nil {
+		return x.ImmunePhysical
+	}
+	return false
+}
+
+func (x *DaggerheartAttackDamageSpec) GetImmuneMagic() bool {
+	if x != nil {
+		return x.ImmuneMagic
+	}
+	return false
+}
+
+func (x *DaggerheartAttackDamageSpec) GetDirect() bool {
+	if x != nil {
+		return x.Direct
+	}
+	return false
+}
+
+func (x *DaggerheartAttackDamageSpec) GetMassiveDamage() bool {
+	if x != nil {
+		return x.MassiveDamage
+	}
+	return false
+}
+
+func (x *DaggerheartAttackDamageSpec) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DaggerheartAttackDamageSpec) GetSourceCharacterIds() []string {
+	if x != nil {
+		return x.SourceCharacterIds
+	}
+	return nil
+}
+
+type SessionAttackFlowRequest struct {
+	state             protoimpl.MessageState       `protogen:"open.v1"`
+	CampaignId        string                       `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId         string                       `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CharacterId       string                       `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Trait             string                       `protobuf:"bytes,4,opt,name=trait,proto3" json:"trait,omitempty"`
+	Difficulty        int32                        `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Modifiers         []*ActionRollModifier        `protobuf:"bytes,6,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	Underwater        bool                         `protobuf:"varint,7,opt,name=underwater,proto3" json:"underwater,omitempty"`
+	BreathCountdownId string                       `protobuf:"bytes,8,opt,name=breath_countdown_id,json=breathCountdownId,proto3" json:"breath_countdown_id,omitempty"`
+	TargetId          string                       `protobuf:"bytes,9,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	DamageDice        []*DiceSpec                  `protobuf:"bytes,10,rep,name=damage_dice,json=damageDice,proto3" json:"damage_dice,omitempty"`
+	DamageModifier    int32                        `protobuf:"varint,11,opt,name=damage_modifier,json=damageModifier,proto3" json:"damage_modifier,omitempty"`
+	Damage            *DaggerheartAttackDamageSpec `protobuf:"bytes,12,opt,name=damage,proto3" json:"damage,omitempty"`
+	RequireDamageRoll bool                         `protobuf:"varint,13,opt,name=require_damage_roll,json=requireDamageRoll,proto3" json:"require_damage_roll,omitempty"`
+	DamageCritical    bool                         `protobuf:"varint,14,opt,name=damage_critical,json=damageCritical,proto3" json:"damage_critical,omitempty"`
+	ActionRng         *v1.RngRequest               `protobuf:"bytes,15,opt,name=action_rng,json=actionRng,proto3" json:"action_rng,omitempty"`
+	DamageRng         *v1.RngRequest               `protobuf:"bytes,16,opt,name=damage_rng,json=damageRng,proto3" json:"damage_rng,omitempty"`
+	// Catalog weapon used for the attack; when set the target must be within
+	// the weapon's range if both are positioned in the scene. Without a weapon
+	// ID the weapon equipped in weapon_slot is used. The weapon supplies the
+	// trait, damage dice and damage type; trait, damage_dice and
+	// damage.damage_type override them when set.
+	WeaponId string `protobuf:"bytes,17,opt,name=weapon_id,json=weaponId,proto3" json:"weapon_id,omitempty"`
+	// Equipped weapon to attack with; defaults to the primary weapon.
+	WeaponSlot DaggerheartEquipSlot `protobuf:"varint,18,opt,name=weapon_slot,json=weaponSlot,proto3,enum=systems.daggerheart.v1.DaggerheartEquipSlot" json:"weapon_slot,omitempty"`
+	// Improvised attacks ignore equipped weapons; trait, damage_dice and
+	// damage are then required.
+	Improvised    bool `protobuf:"varint,19,opt,name=improvised,proto3" json:"improvised,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionAttackFlowRequest) Reset() {
+	*x = SessionAttackFlowRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAttackFlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAttackFlowRequest) ProtoMessage() {}
+
+func (x *SessionAttackFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAttackFlowRequest.ProtoReflect.Descriptor instead.
+func (*SessionAttackFlowRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *SessionAttackFlowRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionAttackFlowRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionAttackFlowRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SessionAttackFlowRequest) GetTrait() string {
+	if x != nil {
+		return x.Trait
+	}
+	return ""
+}
+
+func (x *SessionAttackFlowRequest) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *SessionAttackFlowRequest) GetModifiers() []*ActionRollModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *SessionAttackFlowRequest) GetUnderwater() bool {
+	if x != nil {
+		return x.Underwater
+	}
+	return false
+}
+
+func (x *SessionAttackFlowRequest) GetBreathCountdownId() string {
+	if x != nil {
+		return x.BreathCountdownId
+	}
+	return ""
+}
+
+func (x *SessionAttackFlowRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SessionAttackFlowRequest) GetDamageDice() []*DiceSpec {
+	if x != nil {
+		return x.DamageDice
+	}
+	return nil
+}
+
+func (x *SessionAttackFlowRequest) GetDamageModifier() int32 {
+	if x != nil {
+		return x.DamageModifier
+	}
+	return 0
+}
+
+func (x *SessionAttackFlowRequest) GetDamage() *DaggerheartAttackDamageSpec {
+	if x != nil {
+		return x.Damage
+	}
+	return nil
+}
+
+func (x *SessionAttackFlowRequest) GetRequireDamageRoll() bool {
+	if x != nil {
+		return x.RequireDamageRoll
+	}
+	return false
+}
+
+func (x *SessionAttackFlowRequest) GetDamageCritical() bool {
+	if x != nil {
+		return x.DamageCritical
+	}
+	return false
+}
+
+func (x *SessionAttackFlowRequest) GetActionRng() *v1.RngRequest {
+	if x != nil {
+		return x.ActionRng
+	}
+	return nil
+}
+
+func (x *SessionAttackFlowRequest) GetDamageRng() *v1.RngRequest {
+	if x != nil {
+		return x.DamageRng
+	}
+	return nil
+}
+
+func (x *SessionAttackFlowRequest) GetWeaponId() string {
+	if x != nil {
+		return x.WeaponId
+	}
+	return ""
+}
+
+func (x *SessionAttackFlowRequest) GetWeaponSlot() DaggerheartEquipSlot {
+	if x != nil {
+		return x.WeaponSlot
+	}
+	return DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED
+}
+
+func (x *SessionAttackFlowRequest) GetImprovised() bool {
+	if x != nil {
+		return x.Improvised
+	}
+	return false
+}
+
+type SessionAttackFlowResponse struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	ActionRoll    *SessionActionRollResponse             `protobuf:"bytes,1,opt,name=action_roll,json=actionRoll,proto3" json:"action_roll,omitempty"`
+	RollOutcome   *ApplyRollOutcomeResponse              `protobuf:"bytes,2,opt,name=roll_outcome,json=rollOutcome,proto3" json:"roll_outcome,omitempty"`
+	AttackOutcome *DaggerheartApplyAttackOutcomeResponse `protobuf:"bytes,3,opt,name=attack_outcome,json=attackOutcome,proto3" json:"attack_outcome,omitempty"`
+	DamageRoll    *SessionDamageRollResponse             `protobuf:"bytes,4,opt,name=damage_roll,json=damageRoll,proto3" json:"damage_roll,omitempty"`
+	DamageApplied *DaggerheartApplyDamageResponse        `protobuf:"bytes,5,opt,name=damage_applied,json=damageApplied,proto3" json:"damage_applied,omitempty"`
+	// Catalog weapon the attack was made with; empty for improvised attacks.
+	WeaponId      string `protobuf:"bytes,6,opt,name=weapon_id,json=weaponId,proto3" json:"weapon_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionAttackFlowResponse) Reset() {
+	*x = SessionAttackFlowResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAttackFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAttackFlowResponse) ProtoMessage() {}
+
+func (x *SessionAttackFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAttackFlowResponse.ProtoReflect.Descriptor instead.
+func (*SessionAttackFlowResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SessionAttackFlowResponse) GetActionRoll() *SessionActionRollResponse {
+	if x != nil {
+		return x.ActionRoll
+	}
+	return nil
+}
+
+func (x *SessionAttackFlowResponse) GetRollOutcome() *ApplyRollOutcomeResponse {
+	if x != nil {
+		return x.RollOutcome
+	}
+	return nil
+}
+
+func (x *SessionAttackFlowResponse) GetAttackOutcome() *DaggerheartApplyAttackOutcomeResponse {
+	if x != nil {
+		return x.AttackOutcome
+	}
+	return nil
+}
+
+func (x *SessionAttackFlowResponse) GetDamageRoll() *SessionDamageRollResponse {
+	if x != nil {
+		return x.DamageRoll
+	}
+	return nil
+}
+
+func (x *SessionAttackFlowResponse) GetDamageApplied() *DaggerheartApplyDamageResponse {
+	if x != nil {
+		return x.DamageApplied
+	}
+	return nil
+}
+
+func (x *SessionAttackFlowResponse) GetWeaponId() string {
+	if x != nil {
+		return x.WeaponId
+	}
+	return ""
+}
+
+type SessionSpellcastFlowRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId  string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId   string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CharacterId string                 `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// Domain card being cast; must be in the character's active loadout.
+	DomainCardId string `protobuf:"bytes,4,opt,name=domain_card_id,json=domainCardId,proto3" json:"domain_card_id,omitempty"`
+	Difficulty   int32  `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// Hope spent to cast the card, paid after the roll resolves.
+	HopeCost         int32                 `protobuf:"varint,6,opt,name=hope_cost,json=hopeCost,proto3" json:"hope_cost,omitempty"`
+	Modifiers        []*ActionRollModifier `protobuf:"bytes,7,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	AdvantageSources []*AdvantageSource    `protobuf:"bytes,8,rep,name=advantage_sources,json=advantageSources,proto3" json:"advantage_sources,omitempty"`
+	// Roll a second Fear die and keep the higher (Chaos Magic).
+	ExtraFearDie bool `protobuf:"varint,9,opt,name=extra_fear_die,json=extraFearDie,proto3" json:"extra_fear_die,omitempty"`
+	// Damage die size; when set, a successful cast rolls this die a number of
+	// times equal to the spellcast trait (minimum 1).
+	DamageDieSides int32          `protobuf:"varint,10,opt,name=damage_die_sides,json=damageDieSides,proto3" json:"damage_die_sides,omitempty"`
+	DamageModifier int32          `protobuf:"varint,11,opt,name=damage_modifier,json=damageModifier,proto3" json:"damage_modifier,omitempty"`
+	ActionRng      *v1.RngRequest `protobuf:"bytes,12,opt,name=action_rng,json=actionRng,proto3" json:"action_rng,omitempty"`
+	DamageRng      *v1.RngRequest `protobuf:"bytes,13,opt,name=damage_rng,json=damageRng,proto3" json:"damage_rng,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SessionSpellcastFlowRequest) Reset() {
+	*x = SessionSpellcastFlowRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionSpellcastFlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionSpellcastFlowRequest) ProtoMessage() {}
+
+func (x *SessionSpellcastFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionSpellcastFlowRequest.ProtoReflect.Descriptor instead.
+func (*SessionSpellcastFlowRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *SessionSpellcastFlowRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionSpellcastFlowRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionSpellcastFlowRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SessionSpellcastFlowRequest) GetDomainCardId() string {
+	if x != nil {
+		return x.DomainCardId
+	}
+	return ""
+}
+
+func (x *SessionSpellcastFlowRequest) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *SessionSpellcastFlowRequest) GetHopeCost() int32 {
+	if x != nil {
+		return x.HopeCost
+	}
+	return 0
+}
+
+func (x *SessionSpellcastFlowRequest) GetModifiers() []*ActionRollModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *SessionSpellcastFlowRequest) GetAdvantageSources() []*AdvantageSource {
+	if x != nil {
+		return x.AdvantageSources
+	}
+	return nil
+}
+
+func (x *SessionSpellcastFlowRequest) GetExtraFearDie() bool {
+	if x != nil {
+		return x.ExtraFearDie
+	}
+	return false
+}
+
+func (x *SessionSpellcastFlowRequest) GetDamageDieSides() int32 {
+	if x != nil {
+		return x.DamageDieSides
+	}
+	return 0
+}
+
+func (x *SessionSpellcastFlowRequest) GetDamageModifier() int32 {
+	if x != nil {
+		return x.DamageModifier
+	}
+	return 0
+}
+
+func (x *SessionSpellcastFlowRequest) GetActionRng() *v1.RngRequest {
+	if x != nil {
+		return x.ActionRng
+	}
+	return nil
+}
+
+func (x *SessionSpellcastFlowRequest) GetDamageRng() *v1.RngRequest {
+	if x != nil {
+		return x.DamageRng
+	}
+	return nil
+}
+
+type SessionSpellcastFlowResponse struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	ActionRoll     *SessionActionRollResponse `protobuf:"bytes,1,opt,name=action_roll,json=actionRoll,proto3" json:"action_roll,omitempty"`
+	RollOutcome    *ApplyRollOutcomeResponse  `protobuf:"bytes,2,opt,name=roll_outcome,json=rollOutcome,proto3" json:"roll_outcome,omitempty"`
+	SpellcastTrait string                     `protobuf:"bytes,3,opt,name=spellcast_trait,json=spellcastTrait,proto3" json:"spellcast_trait,omitempty"`
+	TraitValue     int32                      `protobuf:"varint,4,opt,name=trait_value,json=traitValue,proto3" json:"trait_value,omitempty"`
+	SubclassId     string                     `protobuf:"bytes,5,opt,name=subclass_id,json=subclassId,proto3" json:"subclass_id,omitempty"`
+	HopeSpent      int32                      `protobuf:"varint,6,opt,name=hope_spent,json=hopeSpent,proto3" json:"hope_spent,omitempty"`
+	DamageRoll     *SessionDamageRollResponse `protobuf:"bytes,7,opt,name=damage_roll,json=damageRoll,proto3" json:"damage_roll,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SessionSpellcastFlowResponse) Reset() {
+	*x = SessionSpellcastFlowResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionSpellcastFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionSpellcastFlowResponse) ProtoMessage() {}
+
+func (x *SessionSpellcastFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionSpellcastFlowResponse.ProtoReflect.Descriptor instead.
+func (*SessionSpellcastFlowResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *SessionSpellcastFlowResponse) GetActionRoll() *SessionActionRollResponse {
+	if x != nil {
+		return x.ActionRoll
+	}
+	return nil
+}
+
+func (x *SessionSpellcastFlowResponse) GetRollOutcome() *ApplyRollOutcomeResponse {
+	if x != nil {
+		return x.RollOutcome
+	}
+	return nil
+}
+
+func (x *SessionSpellcastFlowResponse) GetSpellcastTrait() string {
+	if x != nil {
+		return x.SpellcastTrait
+	}
+	return ""
+}
+
+func (x *SessionSpellcastFlowResponse) GetTraitValue() int32 {
+	if x != nil {
+		return x.TraitValue
+	}
+	return 0
+}
+
+func (x *SessionSpellcastFlowResponse) GetSubclassId() string {
+	if x != nil {
+		return x.SubclassId
+	}
+	return ""
+}
+
+func (x *SessionSpellcastFlowResponse) GetHopeSpent() int32 {
+	if x != nil {
+		return x.HopeSpent
+	}
+	return 0
+}
+
+func (x *SessionSpellcastFlowResponse) GetDamageRoll() *SessionDamageRollResponse {
+	if x != nil {
+		return x.DamageRoll
+	}
+	return nil
+}
+
+type SessionReactionFlowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CharacterId   string                 `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Trait         string                 `protobuf:"bytes,4,opt,name=trait,proto3" json:"trait,omitempty"`
+	Difficulty    int32                  `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Modifiers     []*ActionRollModifier  `protobuf:"bytes,6,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	ReactionRng   *v1.RngRequest         `protobuf:"bytes,7,opt,name=reaction_rng,json=reactionRng,proto3" json:"reaction_rng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionReactionFlowRequest) Reset() {
+	*x = SessionReactionFlowRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionReactionFlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionReactionFlowRequest) ProtoMessage() {}
+
+func (x *SessionReactionFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionReactionFlowRequest.ProtoReflect.Descriptor instead.
+func (*SessionReactionFlowRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *SessionReactionFlowRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionReactionFlowRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionReactionFlowRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SessionReactionFlowRequest) GetTrait() string {
+	if x != nil {
+		return x.Trait
+	}
+	return ""
+}
+
+func (x *SessionReactionFlowRequest) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *SessionReactionFlowRequest) GetModifiers() []*ActionRollModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *SessionReactionFlowRequest) GetReactionRng() *v1.RngRequest {
+	if x != nil {
+		return x.ReactionRng
+	}
+	return nil
+}
+
+type SessionReactionFlowResponse struct {
+	state           protoimpl.MessageState                   `protogen:"open.v1"`
+	ActionRoll      *SessionActionRollResponse               `protobuf:"bytes,1,opt,name=action_roll,json=actionRoll,proto3" json:"action_roll,omitempty"`
+	RollOutcome     *ApplyRollOutcomeResponse                `protobuf:"bytes,2,opt,name=roll_outcome,json=rollOutcome,proto3" json:"roll_outcome,omitempty"`
+	ReactionOutcome *DaggerheartApplyReactionOutcomeResponse `protobuf:"bytes,3,opt,name=reaction_outcome,json=reactionOutcome,proto3" json:"reaction_outcome,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SessionReactionFlowResponse) Reset() {
+	*x = SessionReactionFlowResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionReactionFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionReactionFlowResponse) ProtoMessage() {}
+
+func (x *SessionReactionFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionReactionFlowResponse.ProtoReflect.Descriptor instead.
+func (*SessionReactionFlowResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SessionReactionFlowResponse) GetActionRoll() *SessionActionRollResponse {
+	if x != nil {
+		return x.ActionRoll
+	}
+	return nil
+}
+
+func (x *SessionReactionFlowResponse) GetRollOutcome() *ApplyRollOutcomeResponse {
+	if x != nil {
+		return x.RollOutcome
+	}
+	return nil
+}
+
+func (x *SessionReactionFlowResponse) GetReactionOutcome() *DaggerheartApplyReactionOutcomeResponse {
+	if x != nil {
+		return x.ReactionOutcome
+	}
+	return nil
+}
+
+type SessionAdversaryAttackRollRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId     string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId      string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AdversaryId    string                 `protobuf:"bytes,3,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	AttackModifier int32                  `protobuf:"varint,4,opt,name=attack_modifier,json=attackModifier,proto3" json:"attack_modifier,omitempty"`
+	Advantage      int32                  `protobuf:"varint,5,opt,name=advantage,proto3" json:"advantage,omitempty"`
+	Disadvantage   int32                  `protobuf:"varint,6,opt,name=disadvantage,proto3" json:"disadvantage,omitempty"`
+	Rng            *v1.RngRequest         `protobuf:"bytes,7,opt,name=rng,proto3" json:"rng,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SessionAdversaryAttackRollRequest) Reset() {
+	*x = SessionAdversaryAttackRollRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAdversaryAttackRollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAdversaryAttackRollRequest) ProtoMessage() {}
+
+func (x *SessionAdversaryAttackRollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAdversaryAttackRollRequest.ProtoReflect.Descriptor instead.
+func (*SessionAdversaryAttackRollRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *SessionAdversaryAttackRollRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryAttackRollRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryAttackRollRequest) GetAdversaryId() string {
+	if x != nil {
+		return x.AdversaryId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryAttackRollRequest) GetAttackModifier() int32 {
+	if x != nil {
+		return x.AttackModifier
+	}
+	return 0
+}
+
+func (x *SessionAdversaryAttackRollRequest) GetAdvantage() int32 {
+	if x != nil {
+		return x.Advantage
+	}
+	return 0
+}
+
+func (x *SessionAdversaryAttackRollRequest) GetDisadvantage() int32 {
+	if x != nil {
+		return x.Disadvantage
+	}
+	return 0
+}
+
+func (x *SessionAdversaryAttackRollRequest) GetRng() *v1.RngRequest {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+type SessionAdversaryActionCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AdversaryId   string                 `protobuf:"bytes,3,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	Difficulty    int32                  `protobuf:"varint,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Dramatic      bool                   `protobuf:"varint,5,opt,name=dramatic,proto3" json:"dramatic,omitempty"`
+	Modifier      int32                  `protobuf:"varint,6,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	Rng           *v1.RngRequest         `protobuf:"bytes,7,opt,name=rng,proto3" json:"rng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionAdversaryActionCheckRequest) Reset() {
+	*x = SessionAdversaryActionCheckRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAdversaryActionCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAdversaryActionCheckRequest) ProtoMessage() {}
+
+func (x *SessionAdversaryActionCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAdversaryActionCheckRequest.ProtoReflect.Descriptor instead.
+func (*SessionAdversaryActionCheckRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SessionAdversaryActionCheckRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryActionCheckRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryActionCheckRequest) GetAdversaryId() string {
+	if x != nil {
+		return x.AdversaryId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryActionCheckRequest) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *SessionAdversaryActionCheckRequest) GetDramatic() bool {
+	if x != nil {
+		return x.Dramatic
+	}
+	return false
+}
+
+func (x *SessionAdversaryActionCheckRequest) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *SessionAdversaryActionCheckRequest) GetRng() *v1.RngRequest {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+type SessionAdversaryActionCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RollSeq       uint64                 `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	AutoSuccess   bool                   `protobuf:"varint,2,opt,name=auto_success,json=autoSuccess,proto3" json:"auto_success,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Roll          int32                  `protobuf:"varint,4,opt,name=roll,proto3" json:"roll,omitempty"`
+	Modifier      int32                  `protobuf:"varint,5,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Rng           *v1.RngResponse        `protobuf:"bytes,7,opt,name=rng,proto3" json:"rng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionAdversaryActionCheckResponse) Reset() {
+	*x = SessionAdversaryActionCheckResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAdversaryActionCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAdversaryActionCheckResponse) ProtoMessage() {}
+
+func (x *SessionAdversaryActionCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAdversaryActionCheckResponse.ProtoReflect.Descriptor instead.
+func (*SessionAdversaryActionCheckResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *SessionAdversaryActionCheckResponse) GetRollSeq() uint64 {
+	if x != nil {
+		return x.RollSeq
+	}
+	return 0
+}
+
+func (x *SessionAdversaryActionCheckResponse) GetAutoSuccess() bool {
+	if x != nil {
+		return x.AutoSuccess
+	}
+	return false
+}
+
+func (x *SessionAdversaryActionCheckResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SessionAdversaryActionCheckResponse) GetRoll() int32 {
+	if x != nil {
+		return x.Roll
+	}
+	return 0
+}
+
+func (x *SessionAdversaryActionCheckResponse) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *SessionAdversaryActionCheckResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SessionAdversaryActionCheckResponse) GetRng() *v1.RngResponse {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+type SessionAdversaryAttackRollResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RollSeq       uint64                 `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	Roll          int32                  `protobuf:"varint,2,opt,name=roll,proto3" json:"roll,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Rolls         []int32                `protobuf:"varint,4,rep,packed,name=rolls,proto3" json:"rolls,omitempty"`
+	Rng           *v1.RngResponse        `protobuf:"bytes,5,opt,name=rng,proto3" json:"rng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionAdversaryAttackRollResponse) Reset() {
+	*x = SessionAdversaryAttackRollResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAdversaryAttackRollResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAdversaryAttackRollResponse) ProtoMessage() {}
+
+func (x *SessionAdversaryAttackRollResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAdversaryAttackRollResponse.ProtoReflect.Descriptor instead.
+func (*SessionAdversaryAttackRollResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *SessionAdversaryAttackRollResponse) GetRollSeq() uint64 {
+	if x != nil {
+		return x.RollSeq
+	}
+	return 0
+}
+
+func (x *SessionAdversaryAttackRollResponse) GetRoll() int32 {
+	if x != nil {
+		return x.Roll
+	}
+	return 0
+}
+
+func (x *SessionAdversaryAttackRollResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SessionAdversaryAttackRollResponse) GetRolls() []int32 {
+	if x != nil {
+		return x.Rolls
+	}
+	return nil
+}
+
+func (x *SessionAdversaryAttackRollResponse) GetRng() *v1.RngResponse {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+type SessionAdversaryAttackFlowRequest struct {
+	state             protoimpl.MessageState       `protogen:"open.v1"`
+	CampaignId        string                       `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId         string                       `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AdversaryId       string                       `protobuf:"bytes,3,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	TargetId          string                       `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Difficulty        int32                        `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	AttackModifier    int32                        `protobuf:"varint,6,opt,name=attack_modifier,json=attackModifier,proto3" json:"attack_modifier,omitempty"`
+	Advantage         int32                        `protobuf:"varint,7,opt,name=advantage,proto3" json:"advantage,omitempty"`
+	Disadvantage      int32                        `protobuf:"varint,8,opt,name=disadvantage,proto3" json:"disadvantage,omitempty"`
+	DamageDice        []*DiceSpec                  `protobuf:"bytes,9,rep,name=damage_dice,json=damageDice,proto3" json:"damage_dice,omitempty"`
+	DamageModifier    int32                        `protobuf:"varint,10,opt,name=damage_modifier,json=damageModifier,proto3" json:"damage_modifier,omitempty"`
+	Damage            *DaggerheartAttackDamageSpec `protobuf:"bytes,11,opt,name=damage,proto3" json:"damage,omitempty"`
+	RequireDamageRoll bool                         `protobuf:"varint,12,opt,name=require_damage_roll,json=requireDamageRoll,proto3" json:"require_damage_roll,omitempty"`
+	DamageCritical    bool                         `protobuf:"varint,13,opt,name=damage_critical,json=damageCritical,proto3" json:"damage_critical,omitempty"`
+	AttackRng         *v1.RngRequest               `protobuf:"bytes,14,opt,name=attack_rng,json=attackRng,proto3" json:"attack_rng,omitempty"`
+	DamageRng         *v1.RngRequest               `protobuf:"bytes,15,opt,name=damage_rng,json=damageRng,proto3" json:"damage_rng,omitempty"`
+	// Other Minions joining a group attack led by adversary_id. The group makes
+	// one attack roll and each Minion adds the listed damage to a single source.
+	GroupAdversaryIds []string `protobuf:"bytes,16,rep,name=group_adversary_ids,json=groupAdversaryIds,proto3" json:"group_adversary_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SessionAdversaryAttackFlowRequest) Reset() {
+	*x = SessionAdversaryAttackFlowRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAdversaryAttackFlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAdversaryAttackFlowRequest) ProtoMessage() {}
+
+func (x *SessionAdversaryAttackFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAdversaryAttackFlowRequest.ProtoReflect.Descriptor instead.
+func (*SessionAdversaryAttackFlowRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetAdversaryId() string {
+	if x != nil {
+		return x.AdversaryId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetAttackModifier() int32 {
+	if x != nil {
+		return x.AttackModifier
+	}
+	return 0
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetAdvantage() int32 {
+	if x != nil {
+		return x.Advantage
+	}
+	return 0
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetDisadvantage() int32 {
+	if x != nil {
+		return x.Disadvantage
+	}
+	return 0
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetDamageDice() []*DiceSpec {
+	if x != nil {
+		return x.DamageDice
+	}
+	return nil
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetDamageModifier() int32 {
+	if x != nil {
+		return x.DamageModifier
+	}
+	return 0
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetDamage() *DaggerheartAttackDamageSpec {
+	if x != nil {
+		return x.Damage
+	}
+	return nil
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetRequireDamageRoll() bool {
+	if x != nil {
+		return x.RequireDamageRoll
+	}
+	return false
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetDamageCritical() bool {
+	if x != nil {
+		return x.DamageCritical
+	}
+	return false
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetAttackRng() *v1.RngRequest {
+	if x != nil {
+		return x.AttackRng
+	}
+	return nil
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetDamageRng() *v1.RngRequest {
+	if x != nil {
+		return x.DamageRng
+	}
+	return nil
+}
+
+func (x *SessionAdversaryAttackFlowRequest) GetGroupAdversaryIds() []string {
+	if x != nil {
+		return x.GroupAdversaryIds
+	}
+	return nil
+}
+
+type SessionAdversaryAttackFlowResponse struct {
+	state         protoimpl.MessageState                          `protogen:"open.v1"`
+	AttackRoll    *SessionAdversaryAttackRollResponse             `protobuf:"bytes,1,opt,name=attack_roll,json=attackRoll,proto3" json:"attack_roll,omitempty"`
+	AttackOutcome *DaggerheartApplyAdversaryAttackOutcomeResponse `protobuf:"bytes,2,opt,name=attack_outcome,json=attackOutcome,proto3" json:"attack_outcome,omitempty"`
+	DamageRoll    *SessionDamageRollResponse                      `protobuf:"bytes,3,opt,name=damage_roll,json=damageRoll,proto3" json:"damage_roll,omitempty"`
+	DamageApplied *DaggerheartApplyDamageResponse                 `protobuf:"bytes,4,opt,name=damage_applied,json=damageApplied,proto3" json:"damage_applied,omitempty"`
+	// All Minions in the group attack, leader first.
+	GroupAdversaryIds []string `protobuf:"bytes,5,rep,name=group_adversary_ids,json=groupAdversaryIds,proto3" json:"group_adversary_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SessionAdversaryAttackFlowResponse) Reset() {
+	*x = SessionAdversaryAttackFlowResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAdversaryAttackFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAdversaryAttackFlowResponse) ProtoMessage() {}
+
+func (x *SessionAdversaryAttackFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAdversaryAttackFlowResponse.ProtoReflect.Descriptor instead.
+func (*SessionAdversaryAttackFlowResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SessionAdversaryAttackFlowResponse) GetAttackRoll() *SessionAdversaryAttackRollResponse {
+	if x != nil {
+		return x.AttackRoll
+	}
+	return nil
+}
+
+func (x *SessionAdversaryAttackFlowResponse) GetAttackOutcome() *DaggerheartApplyAdversaryAttackOutcomeResponse {
+	if x != nil {
+		return x.AttackOutcome
+	}
+	return nil
+}
+
+func (x *SessionAdversaryAttackFlowResponse) GetDamageRoll() *SessionDamageRollResponse {
+	if x != nil {
+		return x.DamageRoll
+	}
+	return nil
+}
+
+func (x *SessionAdversaryAttackFlowResponse) GetDamageApplied() *DaggerheartApplyDamageResponse {
+	if x != nil {
+		return x.DamageApplied
+	}
+	return nil
+}
+
+func (x *SessionAdversaryAttackFlowResponse) GetGroupAdversaryIds() []string {
+	if x != nil {
+		return x.GroupAdversaryIds
+	}
+	return nil
+}
+
+type MultiAttackTarget struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TargetId string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Overrides the target's Evasion (PCs) or Difficulty (adversaries).
+	Difficulty *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// PC targets only: take the hit without marking an Armor Slot.
+	DeclineArmor  bool `protobuf:"varint,3,opt,name=decline_armor,json=declineArmor,proto3" json:"decline_armor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiAttackTarget) Reset() {
+	*x = MultiAttackTarget{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiAttackTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiAttackTarget) ProtoMessage() {}
+
+func (x *MultiAttackTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiAttackTarget.ProtoReflect.Descriptor instead.
+func (*MultiAttackTarget) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *MultiAttackTarget) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *MultiAttackTarget) GetDifficulty() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Difficulty
+	}
+	return nil
+}
+
+func (x *MultiAttackTarget) GetDeclineArmor() bool {
+	if x != nil {
+		return x.DeclineArmor
+	}
+	return false
+}
+
+type MultiAttackTargetResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TargetId string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// "character" or "adversary".
+	TargetType    string `protobuf:"bytes,2,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	Difficulty    int32  `protobuf:"varint,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Hit           bool   `protobuf:"varint,4,opt,name=hit,proto3" json:"hit,omitempty"`
+	HpMarked      int32  `protobuf:"varint,5,opt,name=hp_marked,json=hpMarked,proto3" json:"hp_marked,omitempty"`
+	ArmorSpent    int32  `protobuf:"varint,6,opt,name=armor_spent,json=armorSpent,proto3" json:"armor_spent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiAttackTargetResult) Reset() {
+	*x = MultiAttackTargetResult{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiAttackTargetResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiAttackTargetResult) ProtoMessage() {}
+
+func (x *MultiAttackTargetResult) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiAttackTargetResult.ProtoReflect.Descriptor instead.
+func (*MultiAttackTargetResult) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *MultiAttackTargetResult) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *MultiAttackTargetResult) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *MultiAttackTargetResult) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *MultiAttackTargetResult) GetHit() bool {
+	if x != nil {
+		return x.Hit
+	}
+	return false
+}
+
+func (x *MultiAttackTargetResult) GetHpMarked() int32 {
+	if x != nil {
+		return x.HpMarked
+	}
+	return 0
+}
+
+func (x *MultiAttackTargetResult) GetArmorSpent() int32 {
+	if x != nil {
+		return x.ArmorSpent
+	}
+	return 0
+}
+
+type SessionMultiAttackFlowRequest struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	CampaignId     string                       `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId      string                       `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	CharacterId    string                       `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Trait          string                       `protobuf:"bytes,4,opt,name=trait,proto3" json:"trait,omitempty"`
+	Modifiers      []*ActionRollModifier        `protobuf:"bytes,5,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	Targets        []*MultiAttackTarget         `protobuf:"bytes,6,rep,name=targets,proto3" json:"targets,omitempty"`
+	DamageDice     []*DiceSpec                  `protobuf:"bytes,7,rep,name=damage_dice,json=damageDice,proto3" json:"damage_dice,omitempty"`
+	DamageModifier int32                        `protobuf:"varint,8,opt,name=damage_modifier,json=damageModifier,proto3" json:"damage_modifier,omitempty"`
+	Damage         *DaggerheartAttackDamageSpec `protobuf:"bytes,9,opt,name=damage,proto3" json:"damage,omitempty"`
+	DamageCritical bool                         `protobuf:"varint,10,opt,name=damage_critical,json=damageCritical,proto3" json:"damage_critical,omitempty"`
+	ActionRng      *v1.RngRequest               `protobuf:"bytes,11,opt,name=action_rng,json=actionRng,proto3" json:"action_rng,omitempty"`
+	DamageRng      *v1.RngRequest               `protobuf:"bytes,12,opt,name=damage_rng,json=damageRng,proto3" json:"damage_rng,omitempty"`
+	// Catalog weapon used for the attack; every positioned target must be
+	// within its range.
+	WeaponId      string `protobuf:"bytes,13,opt,name=weapon_id,json=weaponId,proto3" json:"weapon_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionMultiAttackFlowRequest) Reset() {
+	*x = SessionMultiAttackFlowRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionMultiAttackFlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionMultiAttackFlowRequest) ProtoMessage() {}
+
+func (x *SessionMultiAttackFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionMultiAttackFlowRequest.ProtoReflect.Descriptor instead.
+func (*SessionMultiAttackFlowRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *SessionMultiAttackFlowRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionMultiAttackFlowRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionMultiAttackFlowRequest) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SessionMultiAttackFlowRequest) GetTrait() string {
+	if x != nil {
+		return x.Trait
+	}
+	return ""
+}
+
+func (x *SessionMultiAttackFlowRequest) GetModifiers() []*ActionRollModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *SessionMultiAttackFlowRequest) GetTargets() []*MultiAttackTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *SessionMultiAttackFlowRequest) GetDamageDice() []*DiceSpec {
+	if x != nil {
+		return x.DamageDice
+	}
+	return nil
+}
+
+func (x *SessionMultiAttackFlowRequest) GetDamageModifier() int32 {
+	if x != nil {
+		return x.DamageModifier
+	}
+	return 0
+}
+
+func (x *SessionMultiAttackFlowRequest) GetDamage() *DaggerheartAttackDamageSpec {
+	if x != nil {
+		return x.Damage
+	}
+	return nil
+}
+
+func (x *SessionMultiAttackFlowRequest) GetDamageCritical() bool {
+	if x != nil {
+		return x.DamageCritical
+	}
+	return false
+}
+
+func (x *SessionMultiAttackFlowRequest) GetActionRng() *v1.RngRequest {
+	if x != nil {
+		return x.ActionRng
+	}
+	return nil
+}
+
+func (x *SessionMultiAttackFlowRequest) GetDamageRng() *v1.RngRequest {
+	if x != nil {
+		return x.DamageRng
+	}
+	return nil
+}
+
+func (x *SessionMultiAttackFlowRequest) GetWeaponId() string {
+	if x != nil {
+		return x.WeaponId
+	}
+	return ""
+}
+
+type SessionMultiAttackFlowResponse struct {
+	state       protoimpl.MessageState     `protogen:"open.v1"`
+	ActionRoll  *SessionActionRollResponse `protobuf:"bytes,1,opt,name=action_roll,json=actionRoll,proto3" json:"action_roll,omitempty"`
+	RollOutcome *ApplyRollOutcomeResponse  `protobuf:"bytes,2,opt,name=roll_outcome,json=rollOutcome,proto3" json:"roll_outcome,omitempty"`
+	// Present when at least one target was hit.
+	DamageRoll *SessionDamageRollResponse `protobuf:"bytes,3,opt,name=damage_roll,json=damageRoll,proto3" json:"damage_roll,omitempty"`
+	// Per-target results, in request order.
+	Results       []*MultiAttackTargetResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionMultiAttackFlowResponse) Reset() {
+	*x = SessionMultiAttackFlowResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionMultiAttackFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionMultiAttackFlowResponse) ProtoMessage() {}
+
+func (x *SessionMultiAttackFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionMultiAttackFlowResponse.ProtoReflect.Descriptor instead.
+func (*SessionMultiAttackFlowResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *SessionMultiAttackFlowResponse) GetActionRoll() *SessionActionRollResponse {
+	if x != nil {
+		return x.ActionRoll
+	}
+	return nil
+}
+
+func (x *SessionMultiAttackFlowResponse) GetRollOutcome() *ApplyRollOutcomeResponse {
+	if x != nil {
+		return x.RollOutcome
+	}
+	return nil
+}
+
+func (x *SessionMultiAttackFlowResponse) GetDamageRoll() *SessionDamageRollResponse {
+	if x != nil {
+		return x.DamageRoll
+	}
+	return nil
+}
+
+func (x *SessionMultiAttackFlowResponse) GetResults() []*MultiAttackTargetResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SessionAdversaryMultiAttackFlowRequest struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	CampaignId     string                       `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId      string                       `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	AdversaryId    string                       `protobuf:"bytes,3,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	Targets        []*MultiAttackTarget         `protobuf:"bytes,4,rep,name=targets,proto3" json:"targets,omitempty"`
+	AttackModifier int32                        `protobuf:"varint,5,opt,name=attack_modifier,json=attackModifier,proto3" json:"attack_modifier,omitempty"`
+	Advantage      int32                        `protobuf:"varint,6,opt,name=advantage,proto3" json:"advantage,omitempty"`
+	Disadvantage   int32                        `protobuf:"varint,7,opt,name=disadvantage,proto3" json:"disadvantage,omitempty"`
+	DamageDice     []*DiceSpec                  `protobuf:"bytes,8,rep,name=damage_dice,json=damageDice,proto3" json:"damage_dice,omitempty"`
+	DamageModifier int32                        `protobuf:"varint,9,opt,name=damage_modifier,json=damageModifier,proto3" json:"damage_modifier,omitempty"`
+	Damage         *DaggerheartAttackDamageSpec `protobuf:"bytes,10,opt,name=damage,proto3" json:"damage,omitempty"`
+	DamageCritical bool                         `protobuf:"varint,11,opt,name=damage_critical,json=damageCritical,proto3" json:"damage_critical,omitempty"`
+	AttackRng      *v1.RngRequest               `protobuf:"bytes,12,opt,name=attack_rng,json=attackRng,proto3" json:"attack_rng,omitempty"`
+	DamageRng      *v1.RngRequest               `protobuf:"bytes,13,opt,name=damage_rng,json=damageRng,proto3" json:"damage_rng,omitempty"`
+	// Stress the adversary marks to use the action (e.g. a sweeping attack).
+	StressCost    int32 `protobuf:"varint,14,opt,name=stress_cost,json=stressCost,proto3" json:"stress_cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) Reset() {
+	*x = SessionAdversaryMultiAttackFlowRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAdversaryMultiAttackFlowRequest) ProtoMessage() {}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAdversaryMultiAttackFlowRequest.ProtoReflect.Descriptor instead.
+func (*SessionAdversaryMultiAttackFlowRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetAdversaryId() string {
+	if x != nil {
+		return x.AdversaryId
+	}
+	return ""
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetTargets() []*MultiAttackTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetAttackModifier() int32 {
+	if x != nil {
+		return x.AttackModifier
+	}
+	return 0
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetAdvantage() int32 {
+	if x != nil {
+		return x.Advantage
+	}
+	return 0
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetDisadvantage() int32 {
+	if x != nil {
+		return x.Disadvantage
+	}
+	return 0
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetDamageDice() []*DiceSpec {
+	if x != nil {
+		return x.DamageDice
+	}
+	return nil
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetDamageModifier() int32 {
+	if x != nil {
+		return x.DamageModifier
+	}
+	return 0
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetDamage() *DaggerheartAttackDamageSpec {
+	if x != nil {
+		return x.Damage
+	}
+	return nil
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetDamageCritical() bool {
+	if x != nil {
+		return x.DamageCritical
+	}
+	return false
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetAttackRng() *v1.RngRequest {
+	if x != nil {
+		return x.AttackRng
+	}
+	return nil
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetDamageRng() *v1.RngRequest {
+	if x != nil {
+		return x.DamageRng
+	}
+	return nil
+}
+
+func (x *SessionAdversaryMultiAttackFlowRequest) GetStressCost() int32 {
+	if x != nil {
+		return x.StressCost
+	}
+	return 0
+}
+
+type SessionAdversaryMultiAttackFlowResponse struct {
+	state      protoimpl.MessageState              `protogen:"open.v1"`
+	AttackRoll *SessionAdversaryAttackRollResponse `protobuf:"bytes,1,opt,name=attack_roll,json=attackRoll,proto3" json:"attack_roll,omitempty"`
+	// Present when at least one target was hit.
+	DamageRoll *SessionDamageRollResponse `protobuf:"bytes,2,opt,name=damage_roll,json=damageRoll,proto3" json:"damage_roll,omitempty"`
+	// Per-target results, in request order.
+	Results       []*MultiAttackTargetResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionAdversaryMultiAttackFlowResponse) Reset() {
+	*x = SessionAdversaryMultiAttackFlowResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionAdversaryMultiAttackFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAdversaryMultiAttackFlowResponse) ProtoMessage() {}
+
+func (x *SessionAdversaryMultiAttackFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAdversaryMultiAttackFlowResponse.ProtoReflect.Descriptor instead.
+func (*SessionAdversaryMultiAttackFlowResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *SessionAdversaryMultiAttackFlowResponse) GetAttackRoll() *SessionAdversaryAttackRollResponse {
+	if x != nil {
+		return x.AttackRoll
+	}
+	return nil
+}
+
+func (x *SessionAdversaryMultiAttackFlowResponse) GetDamageRoll() *SessionDamageRollResponse {
+	if x != nil {
+		return x.DamageRoll
+	}
+	return nil
+}
+
+func (x *SessionAdversaryMultiAttackFlowResponse) GetResults() []*MultiAttackTargetResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GroupActionSupporter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Trait         string                 `protobuf:"bytes,2,opt,name=trait,proto3" json:"trait,omitempty"`
+	Modifiers     []*ActionRollModifier  `protobuf:"bytes,3,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	Rng           *v1.RngRequest         `protobuf:"bytes,4,opt,name=rng,proto3" json:"rng,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupActionSupporter) Reset() {
+	*x = GroupActionSupporter{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupActionSupporter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupActionSupporter) ProtoMessage() {}
+
+func (x *GroupActionSupporter) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupActionSupporter.ProtoReflect.Descriptor instead.
+func (*GroupActionSupporter) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GroupActionSupporter) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *GroupActionSupporter) GetTrait() string {
+	if x != nil {
+		return x.Trait
+	}
+	return ""
+}
+
+func (x *GroupActionSupporter) GetModifiers() []*ActionRollModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *GroupActionSupporter) GetRng() *v1.RngRequest {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+type GroupActionSupporterRoll struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	CharacterId   string                     `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	ActionRoll    *SessionActionRollResponse `protobuf:"bytes,2,opt,name=action_roll,json=actionRoll,proto3" json:"action_roll,omitempty"`
+	Success       bool                       `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupActionSupporterRoll) Reset() {
+	*x = GroupActionSupporterRoll{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupActionSupporterRoll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupActionSupporterRoll) ProtoMessage() {}
+
+func (x *GroupActionSupporterRoll) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupActionSupporterRoll.ProtoReflect.Descriptor instead.
+func (*GroupActionSupporterRoll) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GroupActionSupporterRoll) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *GroupActionSupporterRoll) GetActionRoll() *SessionActionRollResponse {
+	if x != nil {
+		return x.ActionRoll
+	}
+	return nil
+}
+
+func (x *GroupActionSupporterRoll) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SessionGroupActionFlowRequest struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	CampaignId        string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId         string                  `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	LeaderCharacterId string                  `protobuf:"bytes,3,opt,name=leader_character_id,json=leaderCharacterId,proto3" json:"leader_character_id,omitempty"`
+	LeaderTrait       string                  `protobuf:"bytes,4,opt,name=leader_trait,json=leaderTrait,proto3" json:"leader_trait,omitempty"`
+	Difficulty        int32                   `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	LeaderModifiers   []*ActionRollModifier   `protobuf:"bytes,6,rep,name=leader_modifiers,json=leaderModifiers,proto3" json:"leader_modifiers,omitempty"`
+	Supporters        []*GroupActionSupporter `protobuf:"bytes,7,rep,name=supporters,proto3" json:"supporters,omitempty"`
+	LeaderRng         *v1.RngRequest          `protobuf:"bytes,8,opt,name=leader_rng,json=leaderRng,proto3" json:"leader_rng,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SessionGroupActionFlowRequest) Reset() {
+	*x = SessionGroupActionFlowRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionGroupActionFlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionGroupActionFlowRequest) ProtoMessage() {}
+
+func (x *SessionGroupActionFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionGroupActionFlowRequest.ProtoReflect.Descriptor instead.
+func (*SessionGroupActionFlowRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *SessionGroupActionFlowRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionGroupActionFlowRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionGroupActionFlowRequest) GetLeaderCharacterId() string {
+	if x != nil {
+		return x.LeaderCharacterId
+	}
+	return ""
+}
+
+func (x *SessionGroupActionFlowRequest) GetLeaderTrait() string {
+	if x != nil {
+		return x.LeaderTrait
+	}
+	return ""
+}
+
+func (x *SessionGroupActionFlowRequest) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *SessionGroupActionFlowRequest) GetLeaderModifiers() []*ActionRollModifier {
+	if x != nil {
+		return x.LeaderModifiers
+	}
+	return nil
+}
+
+func (x *SessionGroupActionFlowRequest) GetSupporters() []*GroupActionSupporter {
+	if x != nil {
+		return x.Supporters
+	}
+	return nil
+}
+
+func (x *SessionGroupActionFlowRequest) GetLeaderRng() *v1.RngRequest {
+	if x != nil {
+		return x.LeaderRng
+	}
+	return nil
+}
+
+type SessionGroupActionFlowResponse struct {
+	state            protoimpl.MessageState      `protogen:"open.v1"`
+	LeaderRoll       *SessionActionRollResponse  `protobuf:"bytes,1,opt,name=leader_roll,json=leaderRoll,proto3" json:"leader_roll,omitempty"`
+	LeaderOutcome    *ApplyRollOutcomeResponse   `protobuf:"bytes,2,opt,name=leader_outcome,json=leaderOutcome,proto3" json:"leader_outcome,omitempty"`
+	SupporterRolls   []*GroupActionSupporterRoll `protobuf:"bytes,3,rep,name=supporter_rolls,json=supporterRolls,proto3" json:"supporter_rolls,omitempty"`
+	SupportModifier  int32                       `protobuf:"varint,4,opt,name=support_modifier,json=supportModifier,proto3" json:"support_modifier,omitempty"`
+	SupportSuccesses int32                       `protobuf:"varint,5,opt,name=support_successes,json=supportSuccesses,proto3" json:"support_successes,omitempty"`
+	SupportFailures  int32                       `protobuf:"varint,6,opt,name=support_failures,json=supportFailures,proto3" json:"support_failures,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SessionGroupActionFlowResponse) Reset() {
+	*x = SessionGroupActionFlowResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionGroupActionFlowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionGroupActionFlowResponse) ProtoMessage() {}
+
+func (x *SessionGroupActionFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionGroupActionFlowResponse.ProtoReflect.Descriptor instead.
+func (*SessionGroupActionFlowResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SessionGroupActionFlowResponse) GetLeaderRoll() *SessionActionRollResponse {
+	if x != nil {
+		return x.LeaderRoll
+	}
+	return nil
+}
+
+func (x *SessionGroupActionFlowResponse) GetLeaderOutcome() *ApplyRollOutcomeResponse {
+	if x != nil {
+		return x.LeaderOutcome
+	}
+	return nil
+}
+
+func (x *SessionGroupActionFlowResponse) GetSupporterRolls() []*GroupActionSupporterRoll {
+	if x != nil {
+		return x.SupporterRolls
+	}
+	return nil
+}
+
+func (x *SessionGroupActionFlowResponse) GetSupportModifier() int32 {
+	if x != nil {
+		return x.SupportModifier
+	}
+	return 0
+}
+
+func (x *SessionGroupActionFlowResponse) GetSupportSuccesses() int32 {
+	if x != nil {
+		return x.SupportSuccesses
+	}
+	return 0
+}
+
+func (x *SessionGroupActionFlowResponse) GetSupportFailures() int32 {
+	if x != nil {
+		return x.SupportFailures
+	}
+	return 0
+}
+
+type TagTeamParticipant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Trait         string                 `protobuf:"bytes,2,opt,name=trait,proto3" json:"trait,omitempty"`
+	Modifiers     []*ActionRollModifier  `protobuf:"bytes,3,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	Rng           *v1.RngRequest         `protobuf:"bytes,4,opt,name=rng,proto3" json:"rng,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RollDiceRequest) Reset() {
-	*x = RollDiceRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[51]
+func (x *TagTeamParticipant) Reset() {
+	*x = TagTeamParticipant{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagTeamParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagTeamParticipant) ProtoMessage() {}
+
+func (x *TagTeamParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagTeamParticipant.ProtoReflect.Descriptor instead.
+func (*TagTeamParticipant) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *TagTeamParticipant) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *TagTeamParticipant) GetTrait() string {
+	if x != nil {
+		return x.Trait
+	}
+	return ""
+}
+
+func (x *TagTeamParticipant) GetModifiers() []*ActionRollModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *TagTeamParticipant) GetRng() *v1.RngRequest {
+	if x != nil {
+		return x.Rng
+	}
+	return nil
+}
+
+type SessionTagTeamFlowRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId          string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId           string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	First               *TagTeamParticipant    `protobuf:"bytes,3,opt,name=first,proto3" json:"first,omitempty"`
+	Second              *TagTeamParticipant    `protobuf:"bytes,4,opt,name=second,proto3" json:"second,omitempty"`
+	Difficulty          int32                  `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	SelectedCharacterId string                 `protobuf:"bytes,6,opt,name=selected_character_id,json=selectedCharacterId,proto3" json:"selected_character_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SessionTagTeamFlowRequest) Reset() {
+	*x = SessionTagTeamFlowRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionTagTeamFlowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionTagTeamFlowRequest) ProtoMessage() {}
+
+func (x *SessionTagTeamFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionTagTeamFlowRequest.ProtoReflect.Descriptor instead.
+func (*SessionTagTeamFlowRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SessionTagTeamFlowRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionTagTeamFlowRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionTagTeamFlowRequest) GetFirst() *TagTeamParticipant {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *SessionTagTeamFlowRequest) GetSecond() *TagTeamParticipant {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *SessionTagTeamFlowRequest) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *SessionTagTeamFlowRequest) GetSelectedCharacterId() string {
+	if x != nil {
+		return x.SelectedCharacterId
+	}
+	return ""
+}
+
+type SessionTagTeamFlowResponse struct {
+	state               protoimpl.MessageState     `protogen:"open.v1"`
+	FirstRoll           *SessionActionRollResponse `protobuf:"bytes,1,opt,name=first_roll,json=firstRoll,proto3" json:"first_roll,omitempty"`
+	SecondRoll          *SessionActionRollResponse `protobuf:"bytes,2,opt,name=second_roll,json=secondRoll,proto3" json:"second_roll,omitempty"`
+	SelectedOutcome     *ApplyRollOutcomeResponse  `protobuf:"bytes,3,opt,name=selected_outcome,json=selectedOutcome,proto3" json:"selected_outcome,omitempty"`
+	SelectedCharacterId string                     `protobuf:"bytes,4,opt,name=selected_character_id,json=selectedCharacterId,proto3" json:"selected_character_id,omitempty"`
+	SelectedRollSeq     uint64                     `protobuf:"varint,5,opt,name=selected_roll_seq,json=selectedRollSeq,proto3" json:"selected_roll_seq,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SessionTagTeamFlowResponse) Reset() {
+	*x = SessionTagTeamFlowResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollDiceRequest) String() string {
+func (x *SessionTagTeamFlowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollDiceRequest) ProtoMessage() {}
+func (*SessionTagTeamFlowResponse) ProtoMessage() {}
 
-func (x *RollDiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[51]
+func (x *SessionTagTeamFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3902,51 +10509,74 @@ func (x *RollDiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollDiceRequest.ProtoReflect.Descriptor instead.
-func (*RollDiceRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use SessionTagTeamFlowResponse.ProtoReflect.Descriptor instead.
+func (*SessionTagTeamFlowResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *RollDiceRequest) GetDice() []*DiceSpec {
+func (x *SessionTagTeamFlowResponse) GetFirstRoll() *SessionActionRollResponse {
 	if x != nil {
-		return x.Dice
+		return x.FirstRoll
 	}
 	return nil
 }
 
-func (x *RollDiceRequest) GetRng() *v1.RngRequest {
+func (x *SessionTagTeamFlowResponse) GetSecondRoll() *SessionActionRollResponse {
 	if x != nil {
-		return x.Rng
+		return x.SecondRoll
 	}
 	return nil
 }
 
-type RollDiceResponse struct {
+func (x *SessionTagTeamFlowResponse) GetSelectedOutcome() *ApplyRollOutcomeResponse {
+	if x != nil {
+		return x.SelectedOutcome
+	}
+	return nil
+}
+
+func (x *SessionTagTeamFlowResponse) GetSelectedCharacterId() string {
+	if x != nil {
+		return x.SelectedCharacterId
+	}
+	return ""
+}
+
+func (x *SessionTagTeamFlowResponse) GetSelectedRollSeq() uint64 {
+	if x != nil {
+		return x.SelectedRollSeq
+	}
+	return 0
+}
+
+type ApplyRollOutcomeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Rolls []*DiceRoll            `protobuf:"bytes,1,rep,name=rolls,proto3" json:"rolls,omitempty"`
-	// The sum of all rolls.
-	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	// RNG details used for this roll.
-	Rng           *v1.RngResponse `protobuf:"bytes,3,opt,name=rng,proto3" json:"rng,omitempty"`
+	// The session ID to apply the outcome within.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The roll sequence number of the ACTION_ROLL_RESOLVED event.
+	RollSeq uint64 `protobuf:"varint,2,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	// Optional list of character IDs to apply the outcome to.
+	// If omitted, defaults to the roller character in the roll event.
+	Targets       []string `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RollDiceResponse) Reset() {
-	*x = RollDiceResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[52]
+func (x *ApplyRollOutcomeRequest) Reset() {
+	*x = ApplyRollOutcomeRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RollDiceResponse) String() string {
+func (x *ApplyRollOutcomeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RollDiceResponse) ProtoMessage() {}
+func (*ApplyRollOutcomeRequest) ProtoMessage() {}
 
-func (x *RollDiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[52]
+func (x *ApplyRollOutcomeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3957,77 +10587,118 @@ func (x *RollDiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RollDiceResponse.ProtoReflect.Descriptor instead.
-func (*RollDiceResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ApplyRollOutcomeRequest.ProtoReflect.Descriptor instead.
+func (*ApplyRollOutcomeRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *RollDiceResponse) GetRolls() []*DiceRoll {
+func (x *ApplyRollOutcomeRequest) GetSessionId() string {
 	if x != nil {
-		return x.Rolls
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ApplyRollOutcomeRequest) GetRollSeq() uint64 {
+	if x != nil {
+		return x.RollSeq
+	}
+	return 0
+}
+
+func (x *ApplyRollOutcomeRequest) GetTargets() []string {
+	if x != nil {
+		return x.Targets
 	}
 	return nil
 }
 
-func (x *RollDiceResponse) GetTotal() int32 {
+type ApplyRollOutcomeResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RollSeq              uint64                 `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	RequiresComplication bool                   `protobuf:"varint,2,opt,name=requires_complication,json=requiresComplication,proto3" json:"requires_complication,omitempty"`
+	Updated              *OutcomeUpdated        `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ApplyRollOutcomeResponse) Reset() {
+	*x = ApplyRollOutcomeResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyRollOutcomeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyRollOutcomeResponse) ProtoMessage() {}
+
+func (x *ApplyRollOutcomeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[129]
 	if x != nil {
-		return x.Total
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyRollOutcomeResponse.ProtoReflect.Descriptor instead.
+func (*ApplyRollOutcomeResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ApplyRollOutcomeResponse) GetRollSeq() uint64 {
+	if x != nil {
+		return x.RollSeq
 	}
 	return 0
 }
 
-func (x *RollDiceResponse) GetRng() *v1.RngResponse {
+func (x *ApplyRollOutcomeResponse) GetRequiresComplication() bool {
 	if x != nil {
-		return x.Rng
+		return x.RequiresComplication
+	}
+	return false
+}
+
+func (x *ApplyRollOutcomeResponse) GetUpdated() *OutcomeUpdated {
+	if x != nil {
+		return x.Updated
 	}
 	return nil
 }
 
-type SessionActionRollRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The campaign ID for validation.
-	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	// The session ID to associate with this roll.
-	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// The character performing the roll.
-	CharacterId string `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	// The trait being rolled.
-	Trait string `protobuf:"bytes,4,opt,name=trait,proto3" json:"trait,omitempty"`
-	// The kind of roll (action or reaction).
-	RollKind RollKind `protobuf:"varint,5,opt,name=roll_kind,json=rollKind,proto3,enum=systems.daggerheart.v1.RollKind" json:"roll_kind,omitempty"`
-	// The difficulty target.
-	Difficulty int32 `protobuf:"varint,6,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	// Optional modifiers applied to the roll.
-	Modifiers []*ActionRollModifier `protobuf:"bytes,7,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
-	// Count of advantage dice (d6). Cancels against disadvantage.
-	Advantage int32 `protobuf:"varint,8,opt,name=advantage,proto3" json:"advantage,omitempty"`
-	// Count of disadvantage dice (d6). Cancels against advantage.
-	Disadvantage int32 `protobuf:"varint,9,opt,name=disadvantage,proto3" json:"disadvantage,omitempty"`
-	// Whether the roll is underwater (optional rules).
-	Underwater bool `protobuf:"varint,10,opt,name=underwater,proto3" json:"underwater,omitempty"`
-	// Optional breath countdown to advance for underwater actions.
-	BreathCountdownId string `protobuf:"bytes,11,opt,name=breath_countdown_id,json=breathCountdownId,proto3" json:"breath_countdown_id,omitempty"`
-	// Optional RNG configuration for deterministic rolls.
-	Rng           *v1.RngRequest `protobuf:"bytes,12,opt,name=rng,proto3" json:"rng,omitempty"`
+type DaggerheartApplyAttackOutcomeRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RollSeq   uint64                 `protobuf:"varint,2,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	Targets   []string               `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+	// Catalog weapon the attack was made with, recorded on the event.
+	WeaponId      string `protobuf:"bytes,4,opt,name=weapon_id,json=weaponId,proto3" json:"weapon_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionActionRollRequest) Reset() {
-	*x = SessionActionRollRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[53]
+func (x *DaggerheartApplyAttackOutcomeRequest) Reset() {
+	*x = DaggerheartApplyAttackOutcomeRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionActionRollRequest) String() string {
+func (x *DaggerheartApplyAttackOutcomeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionActionRollRequest) ProtoMessage() {}
+func (*DaggerheartApplyAttackOutcomeRequest) ProtoMessage() {}
 
-func (x *SessionActionRollRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[53]
+func (x *DaggerheartApplyAttackOutcomeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4038,125 +10709,132 @@ func (x *SessionActionRollRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionActionRollRequest.ProtoReflect.Descriptor instead.
-func (*SessionActionRollRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use DaggerheartApplyAttackOutcomeRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartApplyAttackOutcomeRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *SessionActionRollRequest) GetCampaignId() string {
+func (x *DaggerheartApplyAttackOutcomeRequest) GetSessionId() string {
 	if x != nil {
-		return x.CampaignId
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *SessionActionRollRequest) GetSessionId() string {
+func (x *DaggerheartApplyAttackOutcomeRequest) GetRollSeq() uint64 {
 	if x != nil {
-		return x.SessionId
+		return x.RollSeq
 	}
-	return ""
+	return 0
 }
 
-func (x *SessionActionRollRequest) GetCharacterId() string {
+func (x *DaggerheartApplyAttackOutcomeRequest) GetTargets() []string {
 	if x != nil {
-		return x.CharacterId
+		return x.Targets
 	}
-	return ""
+	return nil
 }
 
-func (x *SessionActionRollRequest) GetTrait() string {
+func (x *DaggerheartApplyAttackOutcomeRequest) GetWeaponId() string {
 	if x != nil {
-		return x.Trait
+		return x.WeaponId
 	}
 	return ""
 }
 
-func (x *SessionActionRollRequest) GetRollKind() RollKind {
-	if x != nil {
-		return x.RollKind
-	}
-	return RollKind_ROLL_KIND_UNSPECIFIED
+type DaggerheartApplyAdversaryAttackOutcomeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RollSeq       uint64                 `protobuf:"varint,2,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	Targets       []string               `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+	Difficulty    int32                  `protobuf:"varint,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionActionRollRequest) GetDifficulty() int32 {
-	if x != nil {
-		return x.Difficulty
-	}
-	return 0
+func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) Reset() {
+	*x = DaggerheartApplyAdversaryAttackOutcomeRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionActionRollRequest) GetModifiers() []*ActionRollModifier {
-	if x != nil {
-		return x.Modifiers
-	}
-	return nil
+func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SessionActionRollRequest) GetAdvantage() int32 {
+func (*DaggerheartApplyAdversaryAttackOutcomeRequest) ProtoMessage() {}
+
+func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[131]
 	if x != nil {
-		return x.Advantage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SessionActionRollRequest) GetDisadvantage() int32 {
+// Deprecated: Use DaggerheartApplyAdversaryAttackOutcomeRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartApplyAdversaryAttackOutcomeRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) GetSessionId() string {
 	if x != nil {
-		return x.Disadvantage
+		return x.SessionId
 	}
-	return 0
+	return ""
 }
 
-func (x *SessionActionRollRequest) GetUnderwater() bool {
+func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) GetRollSeq() uint64 {
 	if x != nil {
-		return x.Underwater
+		return x.RollSeq
 	}
-	return false
+	return 0
 }
 
-func (x *SessionActionRollRequest) GetBreathCountdownId() string {
+func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) GetTargets() []string {
 	if x != nil {
-		return x.BreathCountdownId
+		return x.Targets
 	}
-	return ""
+	return nil
 }
 
-func (x *SessionActionRollRequest) GetRng() *v1.RngRequest {
+func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) GetDifficulty() int32 {
 	if x != nil {
-		return x.Rng
+		return x.Difficulty
 	}
-	return nil
+	return 0
 }
 
-type SessionActionRollResponse struct {
+type DaggerheartAttackOutcomeResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RollSeq       uint64                 `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
-	HopeDie       int32                  `protobuf:"varint,2,opt,name=hope_die,json=hopeDie,proto3" json:"hope_die,omitempty"`
-	FearDie       int32                  `protobuf:"varint,3,opt,name=fear_die,json=fearDie,proto3" json:"fear_die,omitempty"`
-	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Difficulty    int32                  `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	Success       bool                   `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
-	Flavor        string                 `protobuf:"bytes,7,opt,name=flavor,proto3" json:"flavor,omitempty"`
-	Crit          bool                   `protobuf:"varint,8,opt,name=crit,proto3" json:"crit,omitempty"`
-	Rng           *v1.RngResponse        `protobuf:"bytes,9,opt,name=rng,proto3" json:"rng,omitempty"`
+	Outcome       Outcome                `protobuf:"varint,1,opt,name=outcome,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcome,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Crit          bool                   `protobuf:"varint,3,opt,name=crit,proto3" json:"crit,omitempty"`
+	Flavor        string                 `protobuf:"bytes,4,opt,name=flavor,proto3" json:"flavor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionActionRollResponse) Reset() {
-	*x = SessionActionRollResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[54]
+func (x *DaggerheartAttackOutcomeResult) Reset() {
+	*x = DaggerheartAttackOutcomeResult{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionActionRollResponse) String() string {
+func (x *DaggerheartAttackOutcomeResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionActionRollResponse) ProtoMessage() {}
+func (*DaggerheartAttackOutcomeResult) ProtoMessage() {}
 
-func (x *SessionActionRollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[54]
+func (x *DaggerheartAttackOutcomeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4167,109 +10845,133 @@ func (x *SessionActionRollResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionActionRollResponse.ProtoReflect.Descriptor instead.
-func (*SessionActionRollResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use DaggerheartAttackOutcomeResult.ProtoReflect.Descriptor instead.
+func (*DaggerheartAttackOutcomeResult) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *SessionActionRollResponse) GetRollSeq() uint64 {
+func (x *DaggerheartAttackOutcomeResult) GetOutcome() Outcome {
 	if x != nil {
-		return x.RollSeq
+		return x.Outcome
 	}
-	return 0
+	return Outcome_OUTCOME_UNSPECIFIED
 }
 
-func (x *SessionActionRollResponse) GetHopeDie() int32 {
+func (x *DaggerheartAttackOutcomeResult) GetSuccess() bool {
 	if x != nil {
-		return x.HopeDie
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *SessionActionRollResponse) GetFearDie() int32 {
+func (x *DaggerheartAttackOutcomeResult) GetCrit() bool {
 	if x != nil {
-		return x.FearDie
+		return x.Crit
 	}
-	return 0
+	return false
 }
 
-func (x *SessionActionRollResponse) GetTotal() int32 {
+func (x *DaggerheartAttackOutcomeResult) GetFlavor() string {
 	if x != nil {
-		return x.Total
+		return x.Flavor
 	}
-	return 0
+	return ""
 }
 
-func (x *SessionActionRollResponse) GetDifficulty() int32 {
+type DaggerheartApplyAttackOutcomeResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	RollSeq       uint64                          `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	CharacterId   string                          `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Targets       []string                        `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+	Result        *DaggerheartAttackOutcomeResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartApplyAttackOutcomeResponse) Reset() {
+	*x = DaggerheartApplyAttackOutcomeResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartApplyAttackOutcomeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartApplyAttackOutcomeResponse) ProtoMessage() {}
+
+func (x *DaggerheartApplyAttackOutcomeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[133]
 	if x != nil {
-		return x.Difficulty
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SessionActionRollResponse) GetSuccess() bool {
+// Deprecated: Use DaggerheartApplyAttackOutcomeResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartApplyAttackOutcomeResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *DaggerheartApplyAttackOutcomeResponse) GetRollSeq() uint64 {
 	if x != nil {
-		return x.Success
+		return x.RollSeq
 	}
-	return false
+	return 0
 }
 
-func (x *SessionActionRollResponse) GetFlavor() string {
+func (x *DaggerheartApplyAttackOutcomeResponse) GetCharacterId() string {
 	if x != nil {
-		return x.Flavor
+		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *SessionActionRollResponse) GetCrit() bool {
+func (x *DaggerheartApplyAttackOutcomeResponse) GetTargets() []string {
 	if x != nil {
-		return x.Crit
+		return x.Targets
 	}
-	return false
+	return nil
 }
 
-func (x *SessionActionRollResponse) GetRng() *v1.RngResponse {
+func (x *DaggerheartApplyAttackOutcomeResponse) GetResult() *DaggerheartAttackOutcomeResult {
 	if x != nil {
-		return x.Rng
+		return x.Result
 	}
 	return nil
 }
 
-type SessionDamageRollRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The campaign ID for validation.
-	CampaignId string `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	// The session ID to associate with this roll.
-	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// The character performing the roll.
-	CharacterId string `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	// Damage dice to roll.
-	Dice []*DiceSpec `protobuf:"bytes,4,rep,name=dice,proto3" json:"dice,omitempty"`
-	// Flat modifier applied after summing rolls.
-	Modifier int32 `protobuf:"varint,5,opt,name=modifier,proto3" json:"modifier,omitempty"`
-	// Whether to apply critical damage bonus.
-	Critical bool `protobuf:"varint,6,opt,name=critical,proto3" json:"critical,omitempty"`
-	// Optional RNG configuration for deterministic rolls.
-	Rng           *v1.RngRequest `protobuf:"bytes,7,opt,name=rng,proto3" json:"rng,omitempty"`
+type DaggerheartAdversaryAttackOutcomeResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Crit          bool                   `protobuf:"varint,2,opt,name=crit,proto3" json:"crit,omitempty"`
+	Roll          int32                  `protobuf:"varint,3,opt,name=roll,proto3" json:"roll,omitempty"`
+	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Difficulty    int32                  `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionDamageRollRequest) Reset() {
-	*x = SessionDamageRollRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[55]
+func (x *DaggerheartAdversaryAttackOutcomeResult) Reset() {
+	*x = DaggerheartAdversaryAttackOutcomeResult{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionDamageRollRequest) String() string {
+func (x *DaggerheartAdversaryAttackOutcomeResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionDamageRollRequest) ProtoMessage() {}
+func (*DaggerheartAdversaryAttackOutcomeResult) ProtoMessage() {}
 
-func (x *SessionDamageRollRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[55]
+func (x *DaggerheartAdversaryAttackOutcomeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4280,89 +10982,71 @@ func (x *SessionDamageRollRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionDamageRollRequest.ProtoReflect.Descriptor instead.
-func (*SessionDamageRollRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{55}
-}
-
-func (x *SessionDamageRollRequest) GetCampaignId() string {
-	if x != nil {
-		return x.CampaignId
-	}
-	return ""
-}
-
-func (x *SessionDamageRollRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
+// Deprecated: Use DaggerheartAdversaryAttackOutcomeResult.ProtoReflect.Descriptor instead.
+func (*DaggerheartAdversaryAttackOutcomeResult) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *SessionDamageRollRequest) GetCharacterId() string {
+func (x *DaggerheartAdversaryAttackOutcomeResult) GetSuccess() bool {
 	if x != nil {
-		return x.CharacterId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *SessionDamageRollRequest) GetDice() []*DiceSpec {
+func (x *DaggerheartAdversaryAttackOutcomeResult) GetCrit() bool {
 	if x != nil {
-		return x.Dice
+		return x.Crit
 	}
-	return nil
+	return false
 }
 
-func (x *SessionDamageRollRequest) GetModifier() int32 {
+func (x *DaggerheartAdversaryAttackOutcomeResult) GetRoll() int32 {
 	if x != nil {
-		return x.Modifier
+		return x.Roll
 	}
 	return 0
 }
 
-func (x *SessionDamageRollRequest) GetCritical() bool {
+func (x *DaggerheartAdversaryAttackOutcomeResult) GetTotal() int32 {
 	if x != nil {
-		return x.Critical
+		return x.Total
 	}
-	return false
+	return 0
 }
 
-func (x *SessionDamageRollRequest) GetRng() *v1.RngRequest {
+func (x *DaggerheartAdversaryAttackOutcomeResult) GetDifficulty() int32 {
 	if x != nil {
-		return x.Rng
+		return x.Difficulty
 	}
-	return nil
+	return 0
 }
 
-type SessionDamageRollResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RollSeq       uint64                 `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
-	Rolls         []*DiceRoll            `protobuf:"bytes,2,rep,name=rolls,proto3" json:"rolls,omitempty"`
-	BaseTotal     int32                  `protobuf:"varint,3,opt,name=base_total,json=baseTotal,proto3" json:"base_total,omitempty"`
-	Modifier      int32                  `protobuf:"varint,4,opt,name=modifier,proto3" json:"modifier,omitempty"`
-	CriticalBonus int32                  `protobuf:"varint,5,opt,name=critical_bonus,json=criticalBonus,proto3" json:"critical_bonus,omitempty"`
-	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
-	Critical      bool                   `protobuf:"varint,7,opt,name=critical,proto3" json:"critical,omitempty"`
-	Rng           *v1.RngResponse        `protobuf:"bytes,8,opt,name=rng,proto3" json:"rng,omitempty"`
+type DaggerheartApplyAdversaryAttackOutcomeResponse struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	RollSeq       uint64                                   `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	AdversaryId   string                                   `protobuf:"bytes,2,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
+	Targets       []string                                 `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+	Result        *DaggerheartAdversaryAttackOutcomeResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionDamageRollResponse) Reset() {
-	*x = SessionDamageRollResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[56]
+func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) Reset() {
+	*x = DaggerheartApplyAdversaryAttackOutcomeResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionDamageRollResponse) String() string {
+func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionDamageRollResponse) ProtoMessage() {}
+func (*DaggerheartApplyAdversaryAttackOutcomeResponse) ProtoMessage() {}
 
-func (x *SessionDamageRollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[56]
+func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4373,97 +11057,117 @@ func (x *SessionDamageRollResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionDamageRollResponse.ProtoReflect.Descriptor instead.
-func (*SessionDamageRollResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use DaggerheartApplyAdversaryAttackOutcomeResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartApplyAdversaryAttackOutcomeResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *SessionDamageRollResponse) GetRollSeq() uint64 {
+func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) GetRollSeq() uint64 {
 	if x != nil {
 		return x.RollSeq
 	}
 	return 0
 }
 
-func (x *SessionDamageRollResponse) GetRolls() []*DiceRoll {
+func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) GetAdversaryId() string {
 	if x != nil {
-		return x.Rolls
+		return x.AdversaryId
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionDamageRollResponse) GetBaseTotal() int32 {
+func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) GetTargets() []string {
 	if x != nil {
-		return x.BaseTotal
+		return x.Targets
 	}
-	return 0
+	return nil
 }
 
-func (x *SessionDamageRollResponse) GetModifier() int32 {
+func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) GetResult() *DaggerheartAdversaryAttackOutcomeResult {
 	if x != nil {
-		return x.Modifier
+		return x.Result
 	}
-	return 0
+	return nil
 }
 
-func (x *SessionDamageRollResponse) GetCriticalBonus() int32 {
-	if x != nil {
-		return x.CriticalBonus
-	}
-	return 0
+type DaggerheartApplyReactionOutcomeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RollSeq       uint64                 `protobuf:"varint,2,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionDamageRollResponse) GetTotal() int32 {
+func (x *DaggerheartApplyReactionOutcomeRequest) Reset() {
+	*x = DaggerheartApplyReactionOutcomeRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartApplyReactionOutcomeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartApplyReactionOutcomeRequest) ProtoMessage() {}
+
+func (x *DaggerheartApplyReactionOutcomeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[136]
 	if x != nil {
-		return x.Total
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SessionDamageRollResponse) GetCritical() bool {
+// Deprecated: Use DaggerheartApplyReactionOutcomeRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartApplyReactionOutcomeRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *DaggerheartApplyReactionOutcomeRequest) GetSessionId() string {
 	if x != nil {
-		return x.Critical
+		return x.SessionId
 	}
-	return false
+	return ""
 }
 
-func (x *SessionDamageRollResponse) GetRng() *v1.RngResponse {
+func (x *DaggerheartApplyReactionOutcomeRequest) GetRollSeq() uint64 {
 	if x != nil {
-		return x.Rng
+		return x.RollSeq
 	}
-	return nil
+	return 0
 }
 
-type DaggerheartAttackDamageSpec struct {
+type DaggerheartReactionOutcomeResult struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
-	DamageType         DaggerheartDamageType  `protobuf:"varint,1,opt,name=damage_type,json=damageType,proto3,enum=systems.daggerheart.v1.DaggerheartDamageType" json:"damage_type,omitempty"`
-	ResistPhysical     bool                   `protobuf:"varint,2,opt,name=resist_physical,json=resistPhysical,proto3" json:"resist_physical,omitempty"`
-	ResistMagic        bool                   `protobuf:"varint,3,opt,name=resist_magic,json=resistMagic,proto3" json:"resist_magic,omitempty"`
-	ImmunePhysical     bool                   `protobuf:"varint,4,opt,name=immune_physical,json=immunePhysical,proto3" json:"immune_physical,omitempty"`
-	ImmuneMagic        bool                   `protobuf:"varint,5,opt,name=immune_magic,json=immuneMagic,proto3" json:"immune_magic,omitempty"`
-	Direct             bool                   `protobuf:"varint,6,opt,name=direct,proto3" json:"direct,omitempty"`
-	MassiveDamage      bool                   `protobuf:"varint,7,opt,name=massive_damage,json=massiveDamage,proto3" json:"massive_damage,omitempty"`
-	Source             string                 `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
-	SourceCharacterIds []string               `protobuf:"bytes,9,rep,name=source_character_ids,json=sourceCharacterIds,proto3" json:"source_character_ids,omitempty"`
+	Outcome            Outcome                `protobuf:"varint,1,opt,name=outcome,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcome,omitempty"`
+	Success            bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Crit               bool                   `protobuf:"varint,3,opt,name=crit,proto3" json:"crit,omitempty"`
+	CritNegatesEffects bool                   `protobuf:"varint,4,opt,name=crit_negates_effects,json=critNegatesEffects,proto3" json:"crit_negates_effects,omitempty"`
+	EffectsNegated     bool                   `protobuf:"varint,5,opt,name=effects_negated,json=effectsNegated,proto3" json:"effects_negated,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
-func (x *DaggerheartAttackDamageSpec) Reset() {
-	*x = DaggerheartAttackDamageSpec{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[57]
+func (x *DaggerheartReactionOutcomeResult) Reset() {
+	*x = DaggerheartReactionOutcomeResult{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartAttackDamageSpec) String() string {
+func (x *DaggerheartReactionOutcomeResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartAttackDamageSpec) ProtoMessage() {}
+func (*DaggerheartReactionOutcomeResult) ProtoMessage() {}
 
-func (x *DaggerheartAttackDamageSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[57]
+func (x *DaggerheartReactionOutcomeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4474,111 +11178,70 @@ func (x *DaggerheartAttackDamageSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartAttackDamageSpec.ProtoReflect.Descriptor instead.
-func (*DaggerheartAttackDamageSpec) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *DaggerheartAttackDamageSpec) GetDamageType() DaggerheartDamageType {
-	if x != nil {
-		return x.DamageType
-	}
-	return DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_UNSPECIFIED
-}
-
-func (x *DaggerheartAttackDamageSpec) GetResistPhysical() bool {
-	if x != nil {
-		return x.ResistPhysical
-	}
-	return false
+// Deprecated: Use DaggerheartReactionOutcomeResult.ProtoReflect.Descriptor instead.
+func (*DaggerheartReactionOutcomeResult) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *DaggerheartAttackDamageSpec) GetResistMagic() bool {
+func (x *DaggerheartReactionOutcomeResult) GetOutcome() Outcome {
 	if x != nil {
-		return x.ResistMagic
+		return x.Outcome
 	}
-	return false
+	return Outcome_OUTCOME_UNSPECIFIED
 }
 
-func (x *DaggerheartAttackDamageSpec) GetImmunePhysical() bool {
+func (x *DaggerheartReactionOutcomeResult) GetSuccess() bool {
 	if x != nil {
-		return x.ImmunePhysical
+		return x.Success
 	}
 	return false
 }
 
-func (x *DaggerheartAttackDamageSpec) GetImmuneMagic() bool {
+func (x *DaggerheartReactionOutcomeResult) GetCrit() bool {
 	if x != nil {
-		return x.ImmuneMagic
+		return x.Crit
 	}
 	return false
 }
 
-func (x *DaggerheartAttackDamageSpec) GetDirect() bool {
+func (x *DaggerheartReactionOutcomeResult) GetCritNegatesEffects() bool {
 	if x != nil {
-		return x.Direct
+		return x.CritNegatesEffects
 	}
 	return false
 }
 
-func (x *DaggerheartAttackDamageSpec) GetMassiveDamage() bool {
+func (x *DaggerheartReactionOutcomeResult) GetEffectsNegated() bool {
 	if x != nil {
-		return x.MassiveDamage
+		return x.EffectsNegated
 	}
 	return false
 }
 
-func (x *DaggerheartAttackDamageSpec) GetSource() string {
-	if x != nil {
-		return x.Source
-	}
-	return ""
-}
-
-func (x *DaggerheartAttackDamageSpec) GetSourceCharacterIds() []string {
-	if x != nil {
-		return x.SourceCharacterIds
-	}
-	return nil
-}
-
-type SessionAttackFlowRequest struct {
-	state             protoimpl.MessageState       `protogen:"open.v1"`
-	CampaignId        string                       `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	SessionId         string                       `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	CharacterId       string                       `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	Trait             string                       `protobuf:"bytes,4,opt,name=trait,proto3" json:"trait,omitempty"`
-	Difficulty        int32                        `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	Modifiers         []*ActionRollModifier        `protobuf:"bytes,6,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
-	Underwater        bool                         `protobuf:"varint,7,opt,name=underwater,proto3" json:"underwater,omitempty"`
-	BreathCountdownId string                       `protobuf:"bytes,8,opt,name=breath_countdown_id,json=breathCountdownId,proto3" json:"breath_countdown_id,omitempty"`
-	TargetId          string                       `protobuf:"bytes,9,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
-	DamageDice        []*DiceSpec                  `protobuf:"bytes,10,rep,name=damage_dice,json=damageDice,proto3" json:"damage_dice,omitempty"`
-	DamageModifier    int32                        `protobuf:"varint,11,opt,name=damage_modifier,json=damageModifier,proto3" json:"damage_modifier,omitempty"`
-	Damage            *DaggerheartAttackDamageSpec `protobuf:"bytes,12,opt,name=damage,proto3" json:"damage,omitempty"`
-	RequireDamageRoll bool                         `protobuf:"varint,13,opt,name=require_damage_roll,json=requireDamageRoll,proto3" json:"require_damage_roll,omitempty"`
-	DamageCritical    bool                         `protobuf:"varint,14,opt,name=damage_critical,json=damageCritical,proto3" json:"damage_critical,omitempty"`
-	ActionRng         *v1.RngRequest               `protobuf:"bytes,15,opt,name=action_rng,json=actionRng,proto3" json:"action_rng,omitempty"`
-	DamageRng         *v1.RngRequest               `protobuf:"bytes,16,opt,name=damage_rng,json=damageRng,proto3" json:"damage_rng,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type DaggerheartApplyReactionOutcomeResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	RollSeq       uint64                            `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	CharacterId   string                            `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Result        *DaggerheartReactionOutcomeResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionAttackFlowRequest) Reset() {
-	*x = SessionAttackFlowRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[58]
+func (x *DaggerheartApplyReactionOutcomeResponse) Reset() {
+	*x = DaggerheartApplyReactionOutcomeResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionAttackFlowRequest) String() string {
+func (x *DaggerheartApplyReactionOutcomeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionAttackFlowRequest) ProtoMessage() {}
+func (*DaggerheartApplyReactionOutcomeResponse) ProtoMessage() {}
 
-func (x *SessionAttackFlowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[58]
+func (x *DaggerheartApplyReactionOutcomeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4589,149 +11252,160 @@ func (x *SessionAttackFlowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionAttackFlowRequest.ProtoReflect.Descriptor instead.
-func (*SessionAttackFlowRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *SessionAttackFlowRequest) GetCampaignId() string {
-	if x != nil {
-		return x.CampaignId
-	}
-	return ""
+// Deprecated: Use DaggerheartApplyReactionOutcomeResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartApplyReactionOutcomeResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *SessionAttackFlowRequest) GetSessionId() string {
+func (x *DaggerheartApplyReactionOutcomeResponse) GetRollSeq() uint64 {
 	if x != nil {
-		return x.SessionId
+		return x.RollSeq
 	}
-	return ""
+	return 0
 }
 
-func (x *SessionAttackFlowRequest) GetCharacterId() string {
+func (x *DaggerheartApplyReactionOutcomeResponse) GetCharacterId() string {
 	if x != nil {
 		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *SessionAttackFlowRequest) GetTrait() string {
+func (x *DaggerheartApplyReactionOutcomeResponse) GetResult() *DaggerheartReactionOutcomeResult {
 	if x != nil {
-		return x.Trait
+		return x.Result
 	}
-	return ""
+	return nil
 }
 
-func (x *SessionAttackFlowRequest) GetDifficulty() int32 {
-	if x != nil {
-		return x.Difficulty
-	}
-	return 0
+// DaggerheartAdvancement is a single advancement chosen during a level-up.
+type DaggerheartAdvancement struct {
+	state protoimpl.MessageState     `protogen:"open.v1"`
+	Type  DaggerheartAdvancementType `protobuf:"varint,1,opt,name=type,proto3,enum=systems.daggerheart.v1.DaggerheartAdvancementType" json:"type,omitempty"`
+	// Traits to increase (exactly two) for TRAITS advancements.
+	Traits []string `protobuf:"bytes,2,rep,name=traits,proto3" json:"traits,omitempty"`
+	// Experience names to increase (exactly two) for EXPERIENCES advancements.
+	Experiences []string `protobuf:"bytes,3,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	// Domain card to acquire for DOMAIN_CARD advancements.
+	DomainCardId string `protobuf:"bytes,4,opt,name=domain_card_id,json=domainCardId,proto3" json:"domain_card_id,omitempty"`
+	// Subclass for SUBCLASS_UPGRADE or MULTICLASS advancements.
+	SubclassId string `protobuf:"bytes,5,opt,name=subclass_id,json=subclassId,proto3" json:"subclass_id,omitempty"`
+	// Class to add for MULTICLASS advancements.
+	ClassId string `protobuf:"bytes,6,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// Domain from the new class for MULTICLASS advancements.
+	DomainId      string `protobuf:"bytes,7,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionAttackFlowRequest) GetModifiers() []*ActionRollModifier {
-	if x != nil {
-		return x.Modifiers
-	}
-	return nil
+func (x *DaggerheartAdvancement) Reset() {
+	*x = DaggerheartAdvancement{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionAttackFlowRequest) GetUnderwater() bool {
-	if x != nil {
-		return x.Underwater
-	}
-	return false
+func (x *DaggerheartAdvancement) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SessionAttackFlowRequest) GetBreathCountdownId() string {
+func (*DaggerheartAdvancement) ProtoMessage() {}
+
+func (x *DaggerheartAdvancement) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[139]
 	if x != nil {
-		return x.BreathCountdownId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SessionAttackFlowRequest) GetTargetId() string {
-	if x != nil {
-		return x.TargetId
-	}
-	return ""
+// Deprecated: Use DaggerheartAdvancement.ProtoReflect.Descriptor instead.
+func (*DaggerheartAdvancement) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *SessionAttackFlowRequest) GetDamageDice() []*DiceSpec {
+func (x *DaggerheartAdvancement) GetType() DaggerheartAdvancementType {
 	if x != nil {
-		return x.DamageDice
+		return x.Type
 	}
-	return nil
+	return DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_UNSPECIFIED
 }
 
-func (x *SessionAttackFlowRequest) GetDamageModifier() int32 {
+func (x *DaggerheartAdvancement) GetTraits() []string {
 	if x != nil {
-		return x.DamageModifier
+		return x.Traits
 	}
-	return 0
+	return nil
 }
 
-func (x *SessionAttackFlowRequest) GetDamage() *DaggerheartAttackDamageSpec {
+func (x *DaggerheartAdvancement) GetExperiences() []string {
 	if x != nil {
-		return x.Damage
+		return x.Experiences
 	}
 	return nil
 }
 
-func (x *SessionAttackFlowRequest) GetRequireDamageRoll() bool {
+func (x *DaggerheartAdvancement) GetDomainCardId() string {
 	if x != nil {
-		return x.RequireDamageRoll
+		return x.DomainCardId
 	}
-	return false
+	return ""
 }
 
-func (x *SessionAttackFlowRequest) GetDamageCritical() bool {
+func (x *DaggerheartAdvancement) GetSubclassId() string {
 	if x != nil {
-		return x.DamageCritical
+		return x.SubclassId
 	}
-	return false
+	return ""
 }
 
-func (x *SessionAttackFlowRequest) GetActionRng() *v1.RngRequest {
+func (x *DaggerheartAdvancement) GetClassId() string {
 	if x != nil {
-		return x.ActionRng
+		return x.ClassId
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionAttackFlowRequest) GetDamageRng() *v1.RngRequest {
+func (x *DaggerheartAdvancement) GetDomainId() string {
 	if x != nil {
-		return x.DamageRng
+		return x.DomainId
 	}
-	return nil
+	return ""
 }
 
-type SessionAttackFlowResponse struct {
-	state         protoimpl.MessageState                 `protogen:"open.v1"`
-	ActionRoll    *SessionActionRollResponse             `protobuf:"bytes,1,opt,name=action_roll,json=actionRoll,proto3" json:"action_roll,omitempty"`
-	RollOutcome   *ApplyRollOutcomeResponse              `protobuf:"bytes,2,opt,name=roll_outcome,json=rollOutcome,proto3" json:"roll_outcome,omitempty"`
-	AttackOutcome *DaggerheartApplyAttackOutcomeResponse `protobuf:"bytes,3,opt,name=attack_outcome,json=attackOutcome,proto3" json:"attack_outcome,omitempty"`
-	DamageRoll    *SessionDamageRollResponse             `protobuf:"bytes,4,opt,name=damage_roll,json=damageRoll,proto3" json:"damage_roll,omitempty"`
-	DamageApplied *DaggerheartApplyDamageResponse        `protobuf:"bytes,5,opt,name=damage_applied,json=damageApplied,proto3" json:"damage_applied,omitempty"`
+type DaggerheartLevelUpRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId  string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// Advancements must spend exactly two slots.
+	Advancements []*DaggerheartAdvancement `protobuf:"bytes,3,rep,name=advancements,proto3" json:"advancements,omitempty"`
+	// Name of the +2 experience gained when entering a new tier (levels 2, 5, 8).
+	NewExperience string `protobuf:"bytes,4,opt,name=new_experience,json=newExperience,proto3" json:"new_experience,omitempty"`
+	// Domain card gained at the new level.
+	DomainCardId  string `protobuf:"bytes,5,opt,name=domain_card_id,json=domainCardId,proto3" json:"domain_card_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionAttackFlowResponse) Reset() {
-	*x = SessionAttackFlowResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[59]
+func (x *DaggerheartLevelUpRequest) Reset() {
+	*x = DaggerheartLevelUpRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionAttackFlowResponse) String() string {
+func (x *DaggerheartLevelUpRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionAttackFlowResponse) ProtoMessage() {}
+func (*DaggerheartLevelUpRequest) ProtoMessage() {}
 
-func (x *SessionAttackFlowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[59]
+func (x *DaggerheartLevelUpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4742,74 +11416,72 @@ func (x *SessionAttackFlowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionAttackFlowResponse.ProtoReflect.Descriptor instead.
-func (*SessionAttackFlowResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use DaggerheartLevelUpRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartLevelUpRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *SessionAttackFlowResponse) GetActionRoll() *SessionActionRollResponse {
+func (x *DaggerheartLevelUpRequest) GetCampaignId() string {
 	if x != nil {
-		return x.ActionRoll
+		return x.CampaignId
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionAttackFlowResponse) GetRollOutcome() *ApplyRollOutcomeResponse {
+func (x *DaggerheartLevelUpRequest) GetCharacterId() string {
 	if x != nil {
-		return x.RollOutcome
+		return x.CharacterId
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionAttackFlowResponse) GetAttackOutcome() *DaggerheartApplyAttackOutcomeResponse {
+func (x *DaggerheartLevelUpRequest) GetAdvancements() []*DaggerheartAdvancement {
 	if x != nil {
-		return x.AttackOutcome
+		return x.Advancements
 	}
 	return nil
 }
 
-func (x *SessionAttackFlowResponse) GetDamageRoll() *SessionDamageRollResponse {
+func (x *DaggerheartLevelUpRequest) GetNewExperience() string {
 	if x != nil {
-		return x.DamageRoll
+		return x.NewExperience
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionAttackFlowResponse) GetDamageApplied() *DaggerheartApplyDamageResponse {
+func (x *DaggerheartLevelUpRequest) GetDomainCardId() string {
 	if x != nil {
-		return x.DamageApplied
+		return x.DomainCardId
 	}
-	return nil
+	return ""
 }
 
-type SessionReactionFlowRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	CharacterId   string                 `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	Trait         string                 `protobuf:"bytes,4,opt,name=trait,proto3" json:"trait,omitempty"`
-	Difficulty    int32                  `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	Modifiers     []*ActionRollModifier  `protobuf:"bytes,6,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
-	ReactionRng   *v1.RngRequest         `protobuf:"bytes,7,opt,name=reaction_rng,json=reactionRng,proto3" json:"reaction_rng,omitempty"`
+type DaggerheartLevelUpResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	CharacterId   string                     `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Level         int32                      `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Tier          int32                      `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	Profile       *DaggerheartProfile        `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	State         *DaggerheartCharacterState `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionReactionFlowRequest) Reset() {
-	*x = SessionReactionFlowRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[60]
+func (x *DaggerheartLevelUpResponse) Reset() {
+	*x = DaggerheartLevelUpResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionReactionFlowRequest) String() string {
+func (x *DaggerheartLevelUpResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionReactionFlowRequest) ProtoMessage() {}
+func (*DaggerheartLevelUpResponse) ProtoMessage() {}
 
-func (x *SessionReactionFlowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[60]
+func (x *DaggerheartLevelUpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4820,84 +11492,74 @@ func (x *SessionReactionFlowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionReactionFlowRequest.ProtoReflect.Descriptor instead.
-func (*SessionReactionFlowRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{60}
-}
-
-func (x *SessionReactionFlowRequest) GetCampaignId() string {
-	if x != nil {
-		return x.CampaignId
-	}
-	return ""
-}
-
-func (x *SessionReactionFlowRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
+// Deprecated: Use DaggerheartLevelUpResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartLevelUpResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *SessionReactionFlowRequest) GetCharacterId() string {
+func (x *DaggerheartLevelUpResponse) GetCharacterId() string {
 	if x != nil {
 		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *SessionReactionFlowRequest) GetTrait() string {
+func (x *DaggerheartLevelUpResponse) GetLevel() int32 {
 	if x != nil {
-		return x.Trait
+		return x.Level
 	}
-	return ""
+	return 0
 }
 
-func (x *SessionReactionFlowRequest) GetDifficulty() int32 {
+func (x *DaggerheartLevelUpResponse) GetTier() int32 {
 	if x != nil {
-		return x.Difficulty
+		return x.Tier
 	}
 	return 0
 }
 
-func (x *SessionReactionFlowRequest) GetModifiers() []*ActionRollModifier {
+func (x *DaggerheartLevelUpResponse) GetProfile() *DaggerheartProfile {
 	if x != nil {
-		return x.Modifiers
+		return x.Profile
 	}
 	return nil
 }
 
-func (x *SessionReactionFlowRequest) GetReactionRng() *v1.RngRequest {
+func (x *DaggerheartLevelUpResponse) GetState() *DaggerheartCharacterState {
 	if x != nil {
-		return x.ReactionRng
+		return x.State
 	}
 	return nil
 }
 
-type SessionReactionFlowResponse struct {
-	state           protoimpl.MessageState                   `protogen:"open.v1"`
-	ActionRoll      *SessionActionRollResponse               `protobuf:"bytes,1,opt,name=action_roll,json=actionRoll,proto3" json:"action_roll,omitempty"`
-	RollOutcome     *ApplyRollOutcomeResponse                `protobuf:"bytes,2,opt,name=roll_outcome,json=rollOutcome,proto3" json:"roll_outcome,omitempty"`
-	ReactionOutcome *DaggerheartApplyReactionOutcomeResponse `protobuf:"bytes,3,opt,name=reaction_outcome,json=reactionOutcome,proto3" json:"reaction_outcome,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type DaggerheartAcquireItemRequest struct {
+	state       protoimpl.MessageState       `protogen:"open.v1"`
+	CampaignId  string                       `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId string                       `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	ItemId      string                       `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Kind        DaggerheartInventoryItemKind `protobuf:"varint,4,opt,name=kind,proto3,enum=systems.daggerheart.v1.DaggerheartInventoryItemKind" json:"kind,omitempty"`
+	// Defaults to 1.
+	Quantity      int32  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Source        string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionReactionFlowResponse) Reset() {
-	*x = SessionReactionFlowResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[61]
+func (x *DaggerheartAcquireItemRequest) Reset() {
+	*x = DaggerheartAcquireItemRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionReactionFlowResponse) String() string {
+func (x *DaggerheartAcquireItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionReactionFlowResponse) ProtoMessage() {}
+func (*DaggerheartAcquireItemRequest) ProtoMessage() {}
 
-func (x *SessionReactionFlowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[61]
+func (x *DaggerheartAcquireItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4908,152 +11570,132 @@ func (x *SessionReactionFlowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionReactionFlowResponse.ProtoReflect.Descriptor instead.
-func (*SessionReactionFlowResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use DaggerheartAcquireItemRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartAcquireItemRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *SessionReactionFlowResponse) GetActionRoll() *SessionActionRollResponse {
+func (x *DaggerheartAcquireItemRequest) GetCampaignId() string {
 	if x != nil {
-		return x.ActionRoll
+		return x.CampaignId
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionReactionFlowResponse) GetRollOutcome() *ApplyRollOutcomeResponse {
+func (x *DaggerheartAcquireItemRequest) GetCharacterId() string {
 	if x != nil {
-		return x.RollOutcome
+		return x.CharacterId
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionReactionFlowResponse) GetReactionOutcome() *DaggerheartApplyReactionOutcomeResponse {
+func (x *DaggerheartAcquireItemRequest) GetItemId() string {
 	if x != nil {
-		return x.ReactionOutcome
+		return x.ItemId
 	}
-	return nil
-}
-
-type SessionAdversaryAttackRollRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId     string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	SessionId      string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	AdversaryId    string                 `protobuf:"bytes,3,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
-	AttackModifier int32                  `protobuf:"varint,4,opt,name=attack_modifier,json=attackModifier,proto3" json:"attack_modifier,omitempty"`
-	Advantage      int32                  `protobuf:"varint,5,opt,name=advantage,proto3" json:"advantage,omitempty"`
-	Disadvantage   int32                  `protobuf:"varint,6,opt,name=disadvantage,proto3" json:"disadvantage,omitempty"`
-	Rng            *v1.RngRequest         `protobuf:"bytes,7,opt,name=rng,proto3" json:"rng,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *SessionAdversaryAttackRollRequest) Reset() {
-	*x = SessionAdversaryAttackRollRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[62]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SessionAdversaryAttackRollRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*SessionAdversaryAttackRollRequest) ProtoMessage() {}
-
-func (x *SessionAdversaryAttackRollRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[62]
+func (x *DaggerheartAcquireItemRequest) GetKind() DaggerheartInventoryItemKind {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Kind
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SessionAdversaryAttackRollRequest.ProtoReflect.Descriptor instead.
-func (*SessionAdversaryAttackRollRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{62}
+	return DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_UNSPECIFIED
 }
 
-func (x *SessionAdversaryAttackRollRequest) GetCampaignId() string {
+func (x *DaggerheartAcquireItemRequest) GetQuantity() int32 {
 	if x != nil {
-		return x.CampaignId
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
-func (x *SessionAdversaryAttackRollRequest) GetSessionId() string {
+func (x *DaggerheartAcquireItemRequest) GetSource() string {
 	if x != nil {
-		return x.SessionId
+		return x.Source
 	}
 	return ""
 }
 
-func (x *SessionAdversaryAttackRollRequest) GetAdversaryId() string {
-	if x != nil {
-		return x.AdversaryId
-	}
-	return ""
+type DaggerheartAcquireItemResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	CharacterId   string                     `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	State         *DaggerheartCharacterState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartAcquireItemResponse) Reset() {
+	*x = DaggerheartAcquireItemResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartAcquireItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SessionAdversaryAttackRollRequest) GetAttackModifier() int32 {
+func (*DaggerheartAcquireItemResponse) ProtoMessage() {}
+
+func (x *DaggerheartAcquireItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[143]
 	if x != nil {
-		return x.AttackModifier
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SessionAdversaryAttackRollRequest) GetAdvantage() int32 {
-	if x != nil {
-		return x.Advantage
-	}
-	return 0
+// Deprecated: Use DaggerheartAcquireItemResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartAcquireItemResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *SessionAdversaryAttackRollRequest) GetDisadvantage() int32 {
+func (x *DaggerheartAcquireItemResponse) GetCharacterId() string {
 	if x != nil {
-		return x.Disadvantage
+		return x.CharacterId
 	}
-	return 0
+	return ""
 }
 
-func (x *SessionAdversaryAttackRollRequest) GetRng() *v1.RngRequest {
+func (x *DaggerheartAcquireItemResponse) GetState() *DaggerheartCharacterState {
 	if x != nil {
-		return x.Rng
+		return x.State
 	}
 	return nil
 }
 
-type SessionAdversaryActionCheckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	AdversaryId   string                 `protobuf:"bytes,3,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
-	Difficulty    int32                  `protobuf:"varint,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	Dramatic      bool                   `protobuf:"varint,5,opt,name=dramatic,proto3" json:"dramatic,omitempty"`
-	Modifier      int32                  `protobuf:"varint,6,opt,name=modifier,proto3" json:"modifier,omitempty"`
-	Rng           *v1.RngRequest         `protobuf:"bytes,7,opt,name=rng,proto3" json:"rng,omitempty"`
+type DaggerheartDropItemRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId  string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	ItemId      string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// Defaults to 1.
+	Quantity      int32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionAdversaryActionCheckRequest) Reset() {
-	*x = SessionAdversaryActionCheckRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[63]
+func (x *DaggerheartDropItemRequest) Reset() {
+	*x = DaggerheartDropItemRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionAdversaryActionCheckRequest) String() string {
+func (x *DaggerheartDropItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionAdversaryActionCheckRequest) ProtoMessage() {}
+func (*DaggerheartDropItemRequest) ProtoMessage() {}
 
-func (x *SessionAdversaryActionCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[63]
+func (x *DaggerheartDropItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5064,88 +11706,125 @@ func (x *SessionAdversaryActionCheckRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionAdversaryActionCheckRequest.ProtoReflect.Descriptor instead.
-func (*SessionAdversaryActionCheckRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use DaggerheartDropItemRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartDropItemRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *SessionAdversaryActionCheckRequest) GetCampaignId() string {
+func (x *DaggerheartDropItemRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *SessionAdversaryActionCheckRequest) GetSessionId() string {
+func (x *DaggerheartDropItemRequest) GetCharacterId() string {
 	if x != nil {
-		return x.SessionId
+		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *SessionAdversaryActionCheckRequest) GetAdversaryId() string {
+func (x *DaggerheartDropItemRequest) GetItemId() string {
 	if x != nil {
-		return x.AdversaryId
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *SessionAdversaryActionCheckRequest) GetDifficulty() int32 {
+func (x *DaggerheartDropItemRequest) GetQuantity() int32 {
 	if x != nil {
-		return x.Difficulty
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *SessionAdversaryActionCheckRequest) GetDramatic() bool {
+func (x *DaggerheartDropItemRequest) GetReason() string {
 	if x != nil {
-		return x.Dramatic
+		return x.Reason
 	}
-	return false
+	return ""
 }
 
-func (x *SessionAdversaryActionCheckRequest) GetModifier() int32 {
+type DaggerheartDropItemResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	CharacterId   string                     `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	State         *DaggerheartCharacterState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartDropItemResponse) Reset() {
+	*x = DaggerheartDropItemResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartDropItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartDropItemResponse) ProtoMessage() {}
+
+func (x *DaggerheartDropItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[145]
 	if x != nil {
-		return x.Modifier
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SessionAdversaryActionCheckRequest) GetRng() *v1.RngRequest {
+// Deprecated: Use DaggerheartDropItemResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartDropItemResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *DaggerheartDropItemResponse) GetCharacterId() string {
 	if x != nil {
-		return x.Rng
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *DaggerheartDropItemResponse) GetState() *DaggerheartCharacterState {
+	if x != nil {
+		return x.State
 	}
 	return nil
 }
 
-type SessionAdversaryActionCheckResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RollSeq       uint64                 `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
-	AutoSuccess   bool                   `protobuf:"varint,2,opt,name=auto_success,json=autoSuccess,proto3" json:"auto_success,omitempty"`
-	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	Roll          int32                  `protobuf:"varint,4,opt,name=roll,proto3" json:"roll,omitempty"`
-	Modifier      int32                  `protobuf:"varint,5,opt,name=modifier,proto3" json:"modifier,omitempty"`
-	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
-	Rng           *v1.RngResponse        `protobuf:"bytes,7,opt,name=rng,proto3" json:"rng,omitempty"`
+type DaggerheartTransferItemRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId      string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	FromCharacterId string                 `protobuf:"bytes,2,opt,name=from_character_id,json=fromCharacterId,proto3" json:"from_character_id,omitempty"`
+	ToCharacterId   string                 `protobuf:"bytes,3,opt,name=to_character_id,json=toCharacterId,proto3" json:"to_character_id,omitempty"`
+	ItemId          string                 `protobuf:"bytes,4,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// Defaults to 1.
+	Quantity      int32 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionAdversaryActionCheckResponse) Reset() {
-	*x = SessionAdversaryActionCheckResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[64]
+func (x *DaggerheartTransferItemRequest) Reset() {
+	*x = DaggerheartTransferItemRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionAdversaryActionCheckResponse) String() string {
+func (x *DaggerheartTransferItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionAdversaryActionCheckResponse) ProtoMessage() {}
+func (*DaggerheartTransferItemRequest) ProtoMessage() {}
 
-func (x *SessionAdversaryActionCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[64]
+func (x *DaggerheartTransferItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5156,86 +11835,69 @@ func (x *SessionAdversaryActionCheckResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionAdversaryActionCheckResponse.ProtoReflect.Descriptor instead.
-func (*SessionAdversaryActionCheckResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{64}
-}
-
-func (x *SessionAdversaryActionCheckResponse) GetRollSeq() uint64 {
-	if x != nil {
-		return x.RollSeq
-	}
-	return 0
+// Deprecated: Use DaggerheartTransferItemRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartTransferItemRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *SessionAdversaryActionCheckResponse) GetAutoSuccess() bool {
+func (x *DaggerheartTransferItemRequest) GetCampaignId() string {
 	if x != nil {
-		return x.AutoSuccess
+		return x.CampaignId
 	}
-	return false
+	return ""
 }
 
-func (x *SessionAdversaryActionCheckResponse) GetSuccess() bool {
+func (x *DaggerheartTransferItemRequest) GetFromCharacterId() string {
 	if x != nil {
-		return x.Success
+		return x.FromCharacterId
 	}
-	return false
+	return ""
 }
 
-func (x *SessionAdversaryActionCheckResponse) GetRoll() int32 {
+func (x *DaggerheartTransferItemRequest) GetToCharacterId() string {
 	if x != nil {
-		return x.Roll
+		return x.ToCharacterId
 	}
-	return 0
+	return ""
 }
 
-func (x *SessionAdversaryActionCheckResponse) GetModifier() int32 {
+func (x *DaggerheartTransferItemRequest) GetItemId() string {
 	if x != nil {
-		return x.Modifier
+		return x.ItemId
 	}
-	return 0
+	return ""
 }
 
-func (x *SessionAdversaryActionCheckResponse) GetTotal() int32 {
+func (x *DaggerheartTransferItemRequest) GetQuantity() int32 {
 	if x != nil {
-		return x.Total
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *SessionAdversaryActionCheckResponse) GetRng() *v1.RngResponse {
-	if x != nil {
-		return x.Rng
-	}
-	return nil
-}
-
-type SessionAdversaryAttackRollResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RollSeq       uint64                 `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
-	Roll          int32                  `protobuf:"varint,2,opt,name=roll,proto3" json:"roll,omitempty"`
-	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
-	Rolls         []int32                `protobuf:"varint,4,rep,packed,name=rolls,proto3" json:"rolls,omitempty"`
-	Rng           *v1.RngResponse        `protobuf:"bytes,5,opt,name=rng,proto3" json:"rng,omitempty"`
+type DaggerheartTransferItemResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	FromState     *DaggerheartCharacterState `protobuf:"bytes,1,opt,name=from_state,json=fromState,proto3" json:"from_state,omitempty"`
+	ToState       *DaggerheartCharacterState `protobuf:"bytes,2,opt,name=to_state,json=toState,proto3" json:"to_state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionAdversaryAttackRollResponse) Reset() {
-	*x = SessionAdversaryAttackRollResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[65]
+func (x *DaggerheartTransferItemResponse) Reset() {
+	*x = DaggerheartTransferItemResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionAdversaryAttackRollResponse) String() string {
+func (x *DaggerheartTransferItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionAdversaryAttackRollResponse) ProtoMessage() {}
+func (*DaggerheartTransferItemResponse) ProtoMessage() {}
 
-func (x *SessionAdversaryAttackRollResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[65]
+func (x *DaggerheartTransferItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5246,82 +11908,51 @@ func (x *SessionAdversaryAttackRollResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionAdversaryAttackRollResponse.ProtoReflect.Descriptor instead.
-func (*SessionAdversaryAttackRollResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{65}
-}
-
-func (x *SessionAdversaryAttackRollResponse) GetRollSeq() uint64 {
-	if x != nil {
-		return x.RollSeq
-	}
-	return 0
-}
-
-func (x *SessionAdversaryAttackRollResponse) GetRoll() int32 {
-	if x != nil {
-		return x.Roll
-	}
-	return 0
-}
-
-func (x *SessionAdversaryAttackRollResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use DaggerheartTransferItemResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartTransferItemResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *SessionAdversaryAttackRollResponse) GetRolls() []int32 {
+func (x *DaggerheartTransferItemResponse) GetFromState() *DaggerheartCharacterState {
 	if x != nil {
-		return x.Rolls
+		return x.FromState
 	}
 	return nil
 }
 
-func (x *SessionAdversaryAttackRollResponse) GetRng() *v1.RngResponse {
+func (x *DaggerheartTransferItemResponse) GetToState() *DaggerheartCharacterState {
 	if x != nil {
-		return x.Rng
+		return x.ToState
 	}
 	return nil
 }
 
-type SessionAdversaryAttackFlowRequest struct {
-	state             protoimpl.MessageState       `protogen:"open.v1"`
-	CampaignId        string                       `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	SessionId         string                       `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	AdversaryId       string                       `protobuf:"bytes,3,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
-	TargetId          string                       `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
-	Difficulty        int32                        `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	AttackModifier    int32                        `protobuf:"varint,6,opt,name=attack_modifier,json=attackModifier,proto3" json:"attack_modifier,omitempty"`
-	Advantage         int32                        `protobuf:"varint,7,opt,name=advantage,proto3" json:"advantage,omitempty"`
-	Disadvantage      int32                        `protobuf:"varint,8,opt,name=disadvantage,proto3" json:"disadvantage,omitempty"`
-	DamageDice        []*DiceSpec                  `protobuf:"bytes,9,rep,name=damage_dice,json=damageDice,proto3" json:"damage_dice,omitempty"`
-	DamageModifier    int32                        `protobuf:"varint,10,opt,name=damage_modifier,json=damageModifier,proto3" json:"damage_modifier,omitempty"`
-	Damage            *DaggerheartAttackDamageSpec `protobuf:"bytes,11,opt,name=damage,proto3" json:"damage,omitempty"`
-	RequireDamageRoll bool                         `protobuf:"varint,12,opt,name=require_damage_roll,json=requireDamageRoll,proto3" json:"require_damage_roll,omitempty"`
-	DamageCritical    bool                         `protobuf:"varint,13,opt,name=damage_critical,json=damageCritical,proto3" json:"damage_critical,omitempty"`
-	AttackRng         *v1.RngRequest               `protobuf:"bytes,14,opt,name=attack_rng,json=attackRng,proto3" json:"attack_rng,omitempty"`
-	DamageRng         *v1.RngRequest               `protobuf:"bytes,15,opt,name=damage_rng,json=damageRng,proto3" json:"damage_rng,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type DaggerheartEquipItemRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId  string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	ItemId      string                 `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// Optional for weapons, which default to the slot of their category.
+	Slot          DaggerheartEquipSlot `protobuf:"varint,4,opt,name=slot,proto3,enum=systems.daggerheart.v1.DaggerheartEquipSlot" json:"slot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionAdversaryAttackFlowRequest) Reset() {
-	*x = SessionAdversaryAttackFlowRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[66]
+func (x *DaggerheartEquipItemRequest) Reset() {
+	*x = DaggerheartEquipItemRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionAdversaryAttackFlowRequest) String() string {
+func (x *DaggerheartEquipItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionAdversaryAttackFlowRequest) ProtoMessage() {}
+func (*DaggerheartEquipItemRequest) ProtoMessage() {}
 
-func (x *SessionAdversaryAttackFlowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[66]
+func (x *DaggerheartEquipItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5332,141 +11963,191 @@ func (x *SessionAdversaryAttackFlowRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionAdversaryAttackFlowRequest.ProtoReflect.Descriptor instead.
-func (*SessionAdversaryAttackFlowRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use DaggerheartEquipItemRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartEquipItemRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetCampaignId() string {
+func (x *DaggerheartEquipItemRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetSessionId() string {
+func (x *DaggerheartEquipItemRequest) GetCharacterId() string {
 	if x != nil {
-		return x.SessionId
+		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetAdversaryId() string {
+func (x *DaggerheartEquipItemRequest) GetItemId() string {
 	if x != nil {
-		return x.AdversaryId
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *DaggerheartEquipItemRequest) GetSlot() DaggerheartEquipSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED
+}
+
+type DaggerheartEquipItemResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	CharacterId   string                     `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Profile       *DaggerheartProfile        `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	State         *DaggerheartCharacterState `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartEquipItemResponse) Reset() {
+	*x = DaggerheartEquipItemResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartEquipItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartEquipItemResponse) ProtoMessage() {}
+
+func (x *DaggerheartEquipItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetTargetId() string {
+// Deprecated: Use DaggerheartEquipItemResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartEquipItemResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *DaggerheartEquipItemResponse) GetCharacterId() string {
 	if x != nil {
-		return x.TargetId
+		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetDifficulty() int32 {
+func (x *DaggerheartEquipItemResponse) GetProfile() *DaggerheartProfile {
 	if x != nil {
-		return x.Difficulty
+		return x.Profile
 	}
-	return 0
+	return nil
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetAttackModifier() int32 {
+func (x *DaggerheartEquipItemResponse) GetState() *DaggerheartCharacterState {
 	if x != nil {
-		return x.AttackModifier
+		return x.State
 	}
-	return 0
+	return nil
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetAdvantage() int32 {
-	if x != nil {
-		return x.Advantage
-	}
-	return 0
+type DaggerheartUnequipItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId   string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Slot          DaggerheartEquipSlot   `protobuf:"varint,3,opt,name=slot,proto3,enum=systems.daggerheart.v1.DaggerheartEquipSlot" json:"slot,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetDisadvantage() int32 {
-	if x != nil {
-		return x.Disadvantage
-	}
-	return 0
+func (x *DaggerheartUnequipItemRequest) Reset() {
+	*x = DaggerheartUnequipItemRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetDamageDice() []*DiceSpec {
-	if x != nil {
-		return x.DamageDice
-	}
-	return nil
+func (x *DaggerheartUnequipItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetDamageModifier() int32 {
+func (*DaggerheartUnequipItemRequest) ProtoMessage() {}
+
+func (x *DaggerheartUnequipItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[150]
 	if x != nil {
-		return x.DamageModifier
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetDamage() *DaggerheartAttackDamageSpec {
-	if x != nil {
-		return x.Damage
-	}
-	return nil
+// Deprecated: Use DaggerheartUnequipItemRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartUnequipItemRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetRequireDamageRoll() bool {
+func (x *DaggerheartUnequipItemRequest) GetCampaignId() string {
 	if x != nil {
-		return x.RequireDamageRoll
+		return x.CampaignId
 	}
-	return false
+	return ""
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetDamageCritical() bool {
+func (x *DaggerheartUnequipItemRequest) GetCharacterId() string {
 	if x != nil {
-		return x.DamageCritical
+		return x.CharacterId
 	}
-	return false
+	return ""
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetAttackRng() *v1.RngRequest {
+func (x *DaggerheartUnequipItemRequest) GetSlot() DaggerheartEquipSlot {
 	if x != nil {
-		return x.AttackRng
+		return x.Slot
 	}
-	return nil
+	return DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED
 }
 
-func (x *SessionAdversaryAttackFlowRequest) GetDamageRng() *v1.RngRequest {
+func (x *DaggerheartUnequipItemRequest) GetReason() string {
 	if x != nil {
-		return x.DamageRng
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type SessionAdversaryAttackFlowResponse struct {
-	state         protoimpl.MessageState                          `protogen:"open.v1"`
-	AttackRoll    *SessionAdversaryAttackRollResponse             `protobuf:"bytes,1,opt,name=attack_roll,json=attackRoll,proto3" json:"attack_roll,omitempty"`
-	AttackOutcome *DaggerheartApplyAdversaryAttackOutcomeResponse `protobuf:"bytes,2,opt,name=attack_outcome,json=attackOutcome,proto3" json:"attack_outcome,omitempty"`
-	DamageRoll    *SessionDamageRollResponse                      `protobuf:"bytes,3,opt,name=damage_roll,json=damageRoll,proto3" json:"damage_roll,omitempty"`
-	DamageApplied *DaggerheartApplyDamageResponse                 `protobuf:"bytes,4,opt,name=damage_applied,json=damageApplied,proto3" json:"damage_applied,omitempty"`
+type DaggerheartUnequipItemResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	CharacterId   string                     `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Profile       *DaggerheartProfile        `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	State         *DaggerheartCharacterState `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionAdversaryAttackFlowResponse) Reset() {
-	*x = SessionAdversaryAttackFlowResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[67]
+func (x *DaggerheartUnequipItemResponse) Reset() {
+	*x = DaggerheartUnequipItemResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionAdversaryAttackFlowResponse) String() string {
+func (x *DaggerheartUnequipItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionAdversaryAttackFlowResponse) ProtoMessage() {}
+func (*DaggerheartUnequipItemResponse) ProtoMessage() {}
 
-func (x *SessionAdversaryAttackFlowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[67]
+func (x *DaggerheartUnequipItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5477,64 +12158,60 @@ func (x *SessionAdversaryAttackFlowResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionAdversaryAttackFlowResponse.ProtoReflect.Descriptor instead.
-func (*SessionAdversaryAttackFlowResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *SessionAdversaryAttackFlowResponse) GetAttackRoll() *SessionAdversaryAttackRollResponse {
-	if x != nil {
-		return x.AttackRoll
-	}
-	return nil
+// Deprecated: Use DaggerheartUnequipItemResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartUnequipItemResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *SessionAdversaryAttackFlowResponse) GetAttackOutcome() *DaggerheartApplyAdversaryAttackOutcomeResponse {
+func (x *DaggerheartUnequipItemResponse) GetCharacterId() string {
 	if x != nil {
-		return x.AttackOutcome
+		return x.CharacterId
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionAdversaryAttackFlowResponse) GetDamageRoll() *SessionDamageRollResponse {
+func (x *DaggerheartUnequipItemResponse) GetProfile() *DaggerheartProfile {
 	if x != nil {
-		return x.DamageRoll
+		return x.Profile
 	}
 	return nil
 }
 
-func (x *SessionAdversaryAttackFlowResponse) GetDamageApplied() *DaggerheartApplyDamageResponse {
+func (x *DaggerheartUnequipItemResponse) GetState() *DaggerheartCharacterState {
 	if x != nil {
-		return x.DamageApplied
+		return x.State
 	}
 	return nil
 }
 
-type GroupActionSupporter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	Trait         string                 `protobuf:"bytes,2,opt,name=trait,proto3" json:"trait,omitempty"`
-	Modifiers     []*ActionRollModifier  `protobuf:"bytes,3,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
-	Rng           *v1.RngRequest         `protobuf:"bytes,4,opt,name=rng,proto3" json:"rng,omitempty"`
+type DaggerheartUpdateGoldRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId  string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// Deltas are combined into handfuls; negative values spend gold.
+	Handfuls      int32  `protobuf:"varint,3,opt,name=handfuls,proto3" json:"handfuls,omitempty"`
+	Bags          int32  `protobuf:"varint,4,opt,name=bags,proto3" json:"bags,omitempty"`
+	Chests        int32  `protobuf:"varint,5,opt,name=chests,proto3" json:"chests,omitempty"`
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupActionSupporter) Reset() {
-	*x = GroupActionSupporter{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[68]
+func (x *DaggerheartUpdateGoldRequest) Reset() {
+	*x = DaggerheartUpdateGoldRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupActionSupporter) String() string {
+func (x *DaggerheartUpdateGoldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupActionSupporter) ProtoMessage() {}
+func (*DaggerheartUpdateGoldRequest) ProtoMessage() {}
 
-func (x *GroupActionSupporter) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[68]
+func (x *DaggerheartUpdateGoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5545,63 +12222,76 @@ func (x *GroupActionSupporter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupActionSupporter.ProtoReflect.Descriptor instead.
-func (*GroupActionSupporter) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use DaggerheartUpdateGoldRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartUpdateGoldRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *GroupActionSupporter) GetCharacterId() string {
+func (x *DaggerheartUpdateGoldRequest) GetCampaignId() string {
 	if x != nil {
-		return x.CharacterId
+		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *GroupActionSupporter) GetTrait() string {
+func (x *DaggerheartUpdateGoldRequest) GetCharacterId() string {
 	if x != nil {
-		return x.Trait
+		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *GroupActionSupporter) GetModifiers() []*ActionRollModifier {
+func (x *DaggerheartUpdateGoldRequest) GetHandfuls() int32 {
 	if x != nil {
-		return x.Modifiers
+		return x.Handfuls
 	}
-	return nil
+	return 0
 }
 
-func (x *GroupActionSupporter) GetRng() *v1.RngRequest {
+func (x *DaggerheartUpdateGoldRequest) GetBags() int32 {
 	if x != nil {
-		return x.Rng
+		return x.Bags
 	}
-	return nil
+	return 0
 }
 
-type GroupActionSupporterRoll struct {
+func (x *DaggerheartUpdateGoldRequest) GetChests() int32 {
+	if x != nil {
+		return x.Chests
+	}
+	return 0
+}
+
+func (x *DaggerheartUpdateGoldRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DaggerheartUpdateGoldResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	CharacterId   string                     `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	ActionRoll    *SessionActionRollResponse `protobuf:"bytes,2,opt,name=action_roll,json=actionRoll,proto3" json:"action_roll,omitempty"`
-	Success       bool                       `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	State         *DaggerheartCharacterState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GroupActionSupporterRoll) Reset() {
-	*x = GroupActionSupporterRoll{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[69]
+func (x *DaggerheartUpdateGoldResponse) Reset() {
+	*x = DaggerheartUpdateGoldResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GroupActionSupporterRoll) String() string {
+func (x *DaggerheartUpdateGoldResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupActionSupporterRoll) ProtoMessage() {}
+func (*DaggerheartUpdateGoldResponse) ProtoMessage() {}
 
-func (x *GroupActionSupporterRoll) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[69]
+func (x *DaggerheartUpdateGoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5612,61 +12302,51 @@ func (x *GroupActionSupporterRoll) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupActionSupporterRoll.ProtoReflect.Descriptor instead.
-func (*GroupActionSupporterRoll) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use DaggerheartUpdateGoldResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartUpdateGoldResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *GroupActionSupporterRoll) GetCharacterId() string {
+func (x *DaggerheartUpdateGoldResponse) GetCharacterId() string {
 	if x != nil {
 		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *GroupActionSupporterRoll) GetActionRoll() *SessionActionRollResponse {
+func (x *DaggerheartUpdateGoldResponse) GetState() *DaggerheartCharacterState {
 	if x != nil {
-		return x.ActionRoll
+		return x.State
 	}
 	return nil
 }
 
-func (x *GroupActionSupporterRoll) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-type SessionGroupActionFlowRequest struct {
-	state             protoimpl.MessageState  `protogen:"open.v1"`
-	CampaignId        string                  `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	SessionId         string                  `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	LeaderCharacterId string                  `protobuf:"bytes,3,opt,name=leader_character_id,json=leaderCharacterId,proto3" json:"leader_character_id,omitempty"`
-	LeaderTrait       string                  `protobuf:"bytes,4,opt,name=leader_trait,json=leaderTrait,proto3" json:"leader_trait,omitempty"`
-	Difficulty        int32                   `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	LeaderModifiers   []*ActionRollModifier   `protobuf:"bytes,6,rep,name=leader_modifiers,json=leaderModifiers,proto3" json:"leader_modifiers,omitempty"`
-	Supporters        []*GroupActionSupporter `protobuf:"bytes,7,rep,name=supporters,proto3" json:"supporters,omitempty"`
-	LeaderRng         *v1.RngRequest          `protobuf:"bytes,8,opt,name=leader_rng,json=leaderRng,proto3" json:"leader_rng,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type DaggerheartCreateCompanionRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId  string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Exactly two companion experience catalog IDs.
+	ExperienceIds []string `protobuf:"bytes,4,rep,name=experience_ids,json=experienceIds,proto3" json:"experience_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionGroupActionFlowRequest) Reset() {
-	*x = SessionGroupActionFlowRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[70]
+func (x *DaggerheartCreateCompanionRequest) Reset() {
+	*x = DaggerheartCreateCompanionRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionGroupActionFlowRequest) String() string {
+func (x *DaggerheartCreateCompanionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionGroupActionFlowRequest) ProtoMessage() {}
+func (*DaggerheartCreateCompanionRequest) ProtoMessage() {}
 
-func (x *SessionGroupActionFlowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[70]
+func (x *DaggerheartCreateCompanionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5677,94 +12357,119 @@ func (x *SessionGroupActionFlowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionGroupActionFlowRequest.ProtoReflect.Descriptor instead.
-func (*SessionGroupActionFlowRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use DaggerheartCreateCompanionRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartCreateCompanionRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *SessionGroupActionFlowRequest) GetCampaignId() string {
+func (x *DaggerheartCreateCompanionRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *SessionGroupActionFlowRequest) GetSessionId() string {
+func (x *DaggerheartCreateCompanionRequest) GetCharacterId() string {
 	if x != nil {
-		return x.SessionId
+		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *SessionGroupActionFlowRequest) GetLeaderCharacterId() string {
+func (x *DaggerheartCreateCompanionRequest) GetName() string {
 	if x != nil {
-		return x.LeaderCharacterId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SessionGroupActionFlowRequest) GetLeaderTrait() string {
+func (x *DaggerheartCreateCompanionRequest) GetExperienceIds() []string {
 	if x != nil {
-		return x.LeaderTrait
+		return x.ExperienceIds
 	}
-	return ""
+	return nil
 }
 
-func (x *SessionGroupActionFlowRequest) GetDifficulty() int32 {
-	if x != nil {
-		return x.Difficulty
-	}
-	return 0
+type DaggerheartCreateCompanionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Companion     *DaggerheartCompanion  `protobuf:"bytes,2,opt,name=companion,proto3" json:"companion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionGroupActionFlowRequest) GetLeaderModifiers() []*ActionRollModifier {
-	if x != nil {
-		return x.LeaderModifiers
-	}
-	return nil
+func (x *DaggerheartCreateCompanionResponse) Reset() {
+	*x = DaggerheartCreateCompanionResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionGroupActionFlowRequest) GetSupporters() []*GroupActionSupporter {
-	if x != nil {
-		return x.Supporters
-	}
-	return nil
+func (x *DaggerheartCreateCompanionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SessionGroupActionFlowRequest) GetLeaderRng() *v1.RngRequest {
+func (*DaggerheartCreateCompanionResponse) ProtoMessage() {}
+
+func (x *DaggerheartCreateCompanionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[155]
 	if x != nil {
-		return x.LeaderRng
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type SessionGroupActionFlowResponse struct {
-	state            protoimpl.MessageState      `protogen:"open.v1"`
-	LeaderRoll       *SessionActionRollResponse  `protobuf:"bytes,1,opt,name=leader_roll,json=leaderRoll,proto3" json:"leader_roll,omitempty"`
-	LeaderOutcome    *ApplyRollOutcomeResponse   `protobuf:"bytes,2,opt,name=leader_outcome,json=leaderOutcome,proto3" json:"leader_outcome,omitempty"`
-	SupporterRolls   []*GroupActionSupporterRoll `protobuf:"bytes,3,rep,name=supporter_rolls,json=supporterRolls,proto3" json:"supporter_rolls,omitempty"`
-	SupportModifier  int32                       `protobuf:"varint,4,opt,name=support_modifier,json=supportModifier,proto3" json:"support_modifier,omitempty"`
-	SupportSuccesses int32                       `protobuf:"varint,5,opt,name=support_successes,json=supportSuccesses,proto3" json:"support_successes,omitempty"`
-	SupportFailures  int32                       `protobuf:"varint,6,opt,name=support_failures,json=supportFailures,proto3" json:"support_failures,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// Deprecated: Use DaggerheartCreateCompanionResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartCreateCompanionResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *DaggerheartCreateCompanionResponse) GetCharacterId() string {
+	if x != nil {
+		return x.CharacterId
+	}
+	return ""
 }
 
-func (x *SessionGroupActionFlowResponse) Reset() {
-	*x = SessionGroupActionFlowResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[71]
+func (x *DaggerheartCreateCompanionResponse) GetCompanion() *DaggerheartCompanion {
+	if x != nil {
+		return x.Companion
+	}
+	return nil
+}
+
+type DaggerheartLevelUpCompanionRequest struct {
+	state       protoimpl.MessageState      `protogen:"open.v1"`
+	CampaignId  string                      `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId string                      `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Upgrade     DaggerheartCompanionUpgrade `protobuf:"varint,3,opt,name=upgrade,proto3,enum=systems.daggerheart.v1.DaggerheartCompanionUpgrade" json:"upgrade,omitempty"`
+	// Companion experience to increase for INTELLIGENT upgrades.
+	ExperienceId string `protobuf:"bytes,4,opt,name=experience_id,json=experienceId,proto3" json:"experience_id,omitempty"`
+	// VICIOUS upgrades extend the range instead of stepping up the damage die.
+	ViciousRange  bool `protobuf:"varint,5,opt,name=vicious_range,json=viciousRange,proto3" json:"vicious_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartLevelUpCompanionRequest) Reset() {
+	*x = DaggerheartLevelUpCompanionRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionGroupActionFlowResponse) String() string {
+func (x *DaggerheartLevelUpCompanionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionGroupActionFlowResponse) ProtoMessage() {}
+func (*DaggerheartLevelUpCompanionRequest) ProtoMessage() {}
 
-func (x *SessionGroupActionFlowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[71]
+func (x *DaggerheartLevelUpCompanionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5775,78 +12480,69 @@ func (x *SessionGroupActionFlowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionGroupActionFlowResponse.ProtoReflect.Descriptor instead.
-func (*SessionGroupActionFlowResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *SessionGroupActionFlowResponse) GetLeaderRoll() *SessionActionRollResponse {
-	if x != nil {
-		return x.LeaderRoll
-	}
-	return nil
+// Deprecated: Use DaggerheartLevelUpCompanionRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartLevelUpCompanionRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *SessionGroupActionFlowResponse) GetLeaderOutcome() *ApplyRollOutcomeResponse {
+func (x *DaggerheartLevelUpCompanionRequest) GetCampaignId() string {
 	if x != nil {
-		return x.LeaderOutcome
+		return x.CampaignId
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionGroupActionFlowResponse) GetSupporterRolls() []*GroupActionSupporterRoll {
+func (x *DaggerheartLevelUpCompanionRequest) GetCharacterId() string {
 	if x != nil {
-		return x.SupporterRolls
+		return x.CharacterId
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionGroupActionFlowResponse) GetSupportModifier() int32 {
+func (x *DaggerheartLevelUpCompanionRequest) GetUpgrade() DaggerheartCompanionUpgrade {
 	if x != nil {
-		return x.SupportModifier
+		return x.Upgrade
 	}
-	return 0
+	return DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_UNSPECIFIED
 }
 
-func (x *SessionGroupActionFlowResponse) GetSupportSuccesses() int32 {
+func (x *DaggerheartLevelUpCompanionRequest) GetExperienceId() string {
 	if x != nil {
-		return x.SupportSuccesses
+		return x.ExperienceId
 	}
-	return 0
+	return ""
 }
 
-func (x *SessionGroupActionFlowResponse) GetSupportFailures() int32 {
+func (x *DaggerheartLevelUpCompanionRequest) GetViciousRange() bool {
 	if x != nil {
-		return x.SupportFailures
+		return x.ViciousRange
 	}
-	return 0
+	return false
 }
 
-type TagTeamParticipant struct {
+type DaggerheartLevelUpCompanionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	Trait         string                 `protobuf:"bytes,2,opt,name=trait,proto3" json:"trait,omitempty"`
-	Modifiers     []*ActionRollModifier  `protobuf:"bytes,3,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
-	Rng           *v1.RngRequest         `protobuf:"bytes,4,opt,name=rng,proto3" json:"rng,omitempty"`
+	Companion     *DaggerheartCompanion  `protobuf:"bytes,2,opt,name=companion,proto3" json:"companion,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TagTeamParticipant) Reset() {
-	*x = TagTeamParticipant{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[72]
+func (x *DaggerheartLevelUpCompanionResponse) Reset() {
+	*x = DaggerheartLevelUpCompanionResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TagTeamParticipant) String() string {
+func (x *DaggerheartLevelUpCompanionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TagTeamParticipant) ProtoMessage() {}
+func (*DaggerheartLevelUpCompanionResponse) ProtoMessage() {}
 
-func (x *TagTeamParticipant) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[72]
+func (x *DaggerheartLevelUpCompanionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5857,66 +12553,51 @@ func (x *TagTeamParticipant) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TagTeamParticipant.ProtoReflect.Descriptor instead.
-func (*TagTeamParticipant) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use DaggerheartLevelUpCompanionResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartLevelUpCompanionResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *TagTeamParticipant) GetCharacterId() string {
+func (x *DaggerheartLevelUpCompanionResponse) GetCharacterId() string {
 	if x != nil {
 		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *TagTeamParticipant) GetTrait() string {
-	if x != nil {
-		return x.Trait
-	}
-	return ""
-}
-
-func (x *TagTeamParticipant) GetModifiers() []*ActionRollModifier {
-	if x != nil {
-		return x.Modifiers
-	}
-	return nil
-}
-
-func (x *TagTeamParticipant) GetRng() *v1.RngRequest {
+func (x *DaggerheartLevelUpCompanionResponse) GetCompanion() *DaggerheartCompanion {
 	if x != nil {
-		return x.Rng
+		return x.Companion
 	}
 	return nil
 }
 
-type SessionTagTeamFlowRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	CampaignId          string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
-	SessionId           string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	First               *TagTeamParticipant    `protobuf:"bytes,3,opt,name=first,proto3" json:"first,omitempty"`
-	Second              *TagTeamParticipant    `protobuf:"bytes,4,opt,name=second,proto3" json:"second,omitempty"`
-	Difficulty          int32                  `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	SelectedCharacterId string                 `protobuf:"bytes,6,opt,name=selected_character_id,json=selectedCharacterId,proto3" json:"selected_character_id,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type DaggerheartUpdateCompanionStressRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId  string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// Positive values mark Stress, negative values clear it.
+	Delta         int32  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionTagTeamFlowRequest) Reset() {
-	*x = SessionTagTeamFlowRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[73]
+func (x *DaggerheartUpdateCompanionStressRequest) Reset() {
+	*x = DaggerheartUpdateCompanionStressRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionTagTeamFlowRequest) String() string {
+func (x *DaggerheartUpdateCompanionStressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionTagTeamFlowRequest) ProtoMessage() {}
+func (*DaggerheartUpdateCompanionStressRequest) ProtoMessage() {}
 
-func (x *SessionTagTeamFlowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[73]
+func (x *DaggerheartUpdateCompanionStressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5927,79 +12608,62 @@ func (x *SessionTagTeamFlowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionTagTeamFlowRequest.ProtoReflect.Descriptor instead.
-func (*SessionTagTeamFlowRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use DaggerheartUpdateCompanionStressRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartUpdateCompanionStressRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *SessionTagTeamFlowRequest) GetCampaignId() string {
+func (x *DaggerheartUpdateCompanionStressRequest) GetCampaignId() string {
 	if x != nil {
 		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *SessionTagTeamFlowRequest) GetSessionId() string {
+func (x *DaggerheartUpdateCompanionStressRequest) GetCharacterId() string {
 	if x != nil {
-		return x.SessionId
+		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *SessionTagTeamFlowRequest) GetFirst() *TagTeamParticipant {
-	if x != nil {
-		return x.First
-	}
-	return nil
-}
-
-func (x *SessionTagTeamFlowRequest) GetSecond() *TagTeamParticipant {
-	if x != nil {
-		return x.Second
-	}
-	return nil
-}
-
-func (x *SessionTagTeamFlowRequest) GetDifficulty() int32 {
+func (x *DaggerheartUpdateCompanionStressRequest) GetDelta() int32 {
 	if x != nil {
-		return x.Difficulty
+		return x.Delta
 	}
 	return 0
 }
 
-func (x *SessionTagTeamFlowRequest) GetSelectedCharacterId() string {
+func (x *DaggerheartUpdateCompanionStressRequest) GetReason() string {
 	if x != nil {
-		return x.SelectedCharacterId
+		return x.Reason
 	}
 	return ""
 }
 
-type SessionTagTeamFlowResponse struct {
-	state               protoimpl.MessageState     `protogen:"open.v1"`
-	FirstRoll           *SessionActionRollResponse `protobuf:"bytes,1,opt,name=first_roll,json=firstRoll,proto3" json:"first_roll,omitempty"`
-	SecondRoll          *SessionActionRollResponse `protobuf:"bytes,2,opt,name=second_roll,json=secondRoll,proto3" json:"second_roll,omitempty"`
-	SelectedOutcome     *ApplyRollOutcomeResponse  `protobuf:"bytes,3,opt,name=selected_outcome,json=selectedOutcome,proto3" json:"selected_outcome,omitempty"`
-	SelectedCharacterId string                     `protobuf:"bytes,4,opt,name=selected_character_id,json=selectedCharacterId,proto3" json:"selected_character_id,omitempty"`
-	SelectedRollSeq     uint64                     `protobuf:"varint,5,opt,name=selected_roll_seq,json=selectedRollSeq,proto3" json:"selected_roll_seq,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type DaggerheartUpdateCompanionStressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   string                 `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	Companion     *DaggerheartCompanion  `protobuf:"bytes,2,opt,name=companion,proto3" json:"companion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionTagTeamFlowResponse) Reset() {
-	*x = SessionTagTeamFlowResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[74]
+func (x *DaggerheartUpdateCompanionStressResponse) Reset() {
+	*x = DaggerheartUpdateCompanionStressResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionTagTeamFlowResponse) String() string {
+func (x *DaggerheartUpdateCompanionStressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionTagTeamFlowResponse) ProtoMessage() {}
+func (*DaggerheartUpdateCompanionStressResponse) ProtoMessage() {}
 
-func (x *SessionTagTeamFlowResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[74]
+func (x *DaggerheartUpdateCompanionStressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6010,74 +12674,58 @@ func (x *SessionTagTeamFlowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionTagTeamFlowResponse.ProtoReflect.Descriptor instead.
-func (*SessionTagTeamFlowResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{74}
-}
-
-func (x *SessionTagTeamFlowResponse) GetFirstRoll() *SessionActionRollResponse {
-	if x != nil {
-		return x.FirstRoll
-	}
-	return nil
-}
-
-func (x *SessionTagTeamFlowResponse) GetSecondRoll() *SessionActionRollResponse {
-	if x != nil {
-		return x.SecondRoll
-	}
-	return nil
-}
-
-func (x *SessionTagTeamFlowResponse) GetSelectedOutcome() *ApplyRollOutcomeResponse {
-	if x != nil {
-		return x.SelectedOutcome
-	}
-	return nil
+// Deprecated: Use DaggerheartUpdateCompanionStressResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartUpdateCompanionStressResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *SessionTagTeamFlowResponse) GetSelectedCharacterId() string {
+func (x *DaggerheartUpdateCompanionStressResponse) GetCharacterId() string {
 	if x != nil {
-		return x.SelectedCharacterId
+		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *SessionTagTeamFlowResponse) GetSelectedRollSeq() uint64 {
+func (x *DaggerheartUpdateCompanionStressResponse) GetCompanion() *DaggerheartCompanion {
 	if x != nil {
-		return x.SelectedRollSeq
+		return x.Companion
 	}
-	return 0
+	return nil
 }
 
-type ApplyRollOutcomeRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The session ID to apply the outcome within.
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// The roll sequence number of the ACTION_ROLL_RESOLVED event.
-	RollSeq uint64 `protobuf:"varint,2,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
-	// Optional list of character IDs to apply the outcome to.
-	// If omitted, defaults to the roller character in the roll event.
-	Targets       []string `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SessionCompanionAttackFlowRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId  string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Character whose companion attacks.
+	CharacterId string `protobuf:"bytes,3,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// Defaults to the character's Spellcast trait.
+	Trait          string                `protobuf:"bytes,4,opt,name=trait,proto3" json:"trait,omitempty"`
+	Target         *MultiAttackTarget    `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	Modifiers      []*ActionRollModifier `protobuf:"bytes,6,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	DamageModifier int32                 `protobuf:"varint,7,opt,name=damage_modifier,json=damageModifier,proto3" json:"damage_modifier,omitempty"`
+	DamageCritical bool                  `protobuf:"varint,8,opt,name=damage_critical,json=damageCritical,proto3" json:"damage_critical,omitempty"`
+	ActionRng      *v1.RngRequest        `protobuf:"bytes,9,opt,name=action_rng,json=actionRng,proto3" json:"action_rng,omitempty"`
+	DamageRng      *v1.RngRequest        `protobuf:"bytes,10,opt,name=damage_rng,json=damageRng,proto3" json:"damage_rng,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ApplyRollOutcomeRequest) Reset() {
-	*x = ApplyRollOutcomeRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[75]
+func (x *SessionCompanionAttackFlowRequest) Reset() {
+	*x = SessionCompanionAttackFlowRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyRollOutcomeRequest) String() string {
+func (x *SessionCompanionAttackFlowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyRollOutcomeRequest) ProtoMessage() {}
+func (*SessionCompanionAttackFlowRequest) ProtoMessage() {}
 
-func (x *ApplyRollOutcomeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[75]
+func (x *SessionCompanionAttackFlowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6088,56 +12736,107 @@ func (x *ApplyRollOutcomeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyRollOutcomeRequest.ProtoReflect.Descriptor instead.
-func (*ApplyRollOutcomeRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use SessionCompanionAttackFlowRequest.ProtoReflect.Descriptor instead.
+func (*SessionCompanionAttackFlowRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *ApplyRollOutcomeRequest) GetSessionId() string {
+func (x *SessionCompanionAttackFlowRequest) GetCampaignId() string {
+	if x != nil {
+		return x.CampaignId
+	}
+	return ""
+}
+
+func (x *SessionCompanionAttackFlowRequest) GetSessionId() string {
 	if x != nil {
 		return x.SessionId
 	}
 	return ""
 }
 
-func (x *ApplyRollOutcomeRequest) GetRollSeq() uint64 {
+func (x *SessionCompanionAttackFlowRequest) GetCharacterId() string {
 	if x != nil {
-		return x.RollSeq
+		return x.CharacterId
+	}
+	return ""
+}
+
+func (x *SessionCompanionAttackFlowRequest) GetTrait() string {
+	if x != nil {
+		return x.Trait
+	}
+	return ""
+}
+
+func (x *SessionCompanionAttackFlowRequest) GetTarget() *MultiAttackTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *SessionCompanionAttackFlowRequest) GetModifiers() []*ActionRollModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *SessionCompanionAttackFlowRequest) GetDamageModifier() int32 {
+	if x != nil {
+		return x.DamageModifier
 	}
 	return 0
 }
 
-func (x *ApplyRollOutcomeRequest) GetTargets() []string {
+func (x *SessionCompanionAttackFlowRequest) GetDamageCritical() bool {
 	if x != nil {
-		return x.Targets
+		return x.DamageCritical
+	}
+	return false
+}
+
+func (x *SessionCompanionAttackFlowRequest) GetActionRng() *v1.RngRequest {
+	if x != nil {
+		return x.ActionRng
 	}
 	return nil
 }
 
-type ApplyRollOutcomeResponse struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	RollSeq              uint64                 `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
-	RequiresComplication bool                   `protobuf:"varint,2,opt,name=requires_complication,json=requiresComplication,proto3" json:"requires_complication,omitempty"`
-	Updated              *OutcomeUpdated        `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *SessionCompanionAttackFlowRequest) GetDamageRng() *v1.RngRequest {
+	if x != nil {
+		return x.DamageRng
+	}
+	return nil
+}
+
+type SessionCompanionAttackFlowResponse struct {
+	state       protoimpl.MessageState     `protogen:"open.v1"`
+	ActionRoll  *SessionActionRollResponse `protobuf:"bytes,1,opt,name=action_roll,json=actionRoll,proto3" json:"action_roll,omitempty"`
+	RollOutcome *ApplyRollOutcomeResponse  `protobuf:"bytes,2,opt,name=roll_outcome,json=rollOutcome,proto3" json:"roll_outcome,omitempty"`
+	// Present when the attack hit.
+	DamageRoll    *SessionDamageRollResponse `protobuf:"bytes,3,opt,name=damage_roll,json=damageRoll,proto3" json:"damage_roll,omitempty"`
+	Result        *MultiAttackTargetResult   `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyRollOutcomeResponse) Reset() {
-	*x = ApplyRollOutcomeResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[76]
+func (x *SessionCompanionAttackFlowResponse) Reset() {
+	*x = SessionCompanionAttackFlowResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyRollOutcomeResponse) String() string {
+func (x *SessionCompanionAttackFlowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyRollOutcomeResponse) ProtoMessage() {}
+func (*SessionCompanionAttackFlowResponse) ProtoMessage() {}
 
-func (x *ApplyRollOutcomeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[76]
+func (x *SessionCompanionAttackFlowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6148,56 +12847,64 @@ func (x *ApplyRollOutcomeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyRollOutcomeResponse.ProtoReflect.Descriptor instead.
-func (*ApplyRollOutcomeResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use SessionCompanionAttackFlowResponse.ProtoReflect.Descriptor instead.
+func (*SessionCompanionAttackFlowResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *ApplyRollOutcomeResponse) GetRollSeq() uint64 {
+func (x *SessionCompanionAttackFlowResponse) GetActionRoll() *SessionActionRollResponse {
 	if x != nil {
-		return x.RollSeq
+		return x.ActionRoll
 	}
-	return 0
+	return nil
 }
 
-func (x *ApplyRollOutcomeResponse) GetRequiresComplication() bool {
+func (x *SessionCompanionAttackFlowResponse) GetRollOutcome() *ApplyRollOutcomeResponse {
 	if x != nil {
-		return x.RequiresComplication
+		return x.RollOutcome
 	}
-	return false
+	return nil
 }
 
-func (x *ApplyRollOutcomeResponse) GetUpdated() *OutcomeUpdated {
+func (x *SessionCompanionAttackFlowResponse) GetDamageRoll() *SessionDamageRollResponse {
 	if x != nil {
-		return x.Updated
+		return x.DamageRoll
 	}
 	return nil
 }
 
-type DaggerheartApplyAttackOutcomeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	RollSeq       uint64                 `protobuf:"varint,2,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
-	Targets       []string               `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
+func (x *SessionCompanionAttackFlowResponse) GetResult() *MultiAttackTargetResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type DaggerheartEnterBeastformRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId  string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// Catalog beastform ID at or below the character's tier.
+	BeastformId   string `protobuf:"bytes,3,opt,name=beastform_id,json=beastformId,proto3" json:"beastform_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartApplyAttackOutcomeRequest) Reset() {
-	*x = DaggerheartApplyAttackOutcomeRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[77]
+func (x *DaggerheartEnterBeastformRequest) Reset() {
+	*x = DaggerheartEnterBeastformRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartApplyAttackOutcomeRequest) String() string {
+func (x *DaggerheartEnterBeastformRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartApplyAttackOutcomeRequest) ProtoMessage() {}
+func (*DaggerheartEnterBeastformRequest) ProtoMessage() {}
 
-func (x *DaggerheartApplyAttackOutcomeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[77]
+func (x *DaggerheartEnterBeastformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6208,57 +12915,57 @@ func (x *DaggerheartApplyAttackOutcomeRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartApplyAttackOutcomeRequest.ProtoReflect.Descriptor instead.
-func (*DaggerheartApplyAttackOutcomeRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use DaggerheartEnterBeastformRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartEnterBeastformRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *DaggerheartApplyAttackOutcomeRequest) GetSessionId() string {
+func (x *DaggerheartEnterBeastformRequest) GetCampaignId() string {
 	if x != nil {
-		return x.SessionId
+		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *DaggerheartApplyAttackOutcomeRequest) GetRollSeq() uint64 {
+func (x *DaggerheartEnterBeastformRequest) GetCharacterId() string {
 	if x != nil {
-		return x.RollSeq
+		return x.CharacterId
 	}
-	return 0
+	return ""
 }
 
-func (x *DaggerheartApplyAttackOutcomeRequest) GetTargets() []string {
+func (x *DaggerheartEnterBeastformRequest) GetBeastformId() string {
 	if x != nil {
-		return x.Targets
+		return x.BeastformId
 	}
-	return nil
+	return ""
 }
 
-type DaggerheartApplyAdversaryAttackOutcomeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	RollSeq       uint64                 `protobuf:"varint,2,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
-	Targets       []string               `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
-	Difficulty    int32                  `protobuf:"varint,4,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+type DaggerheartEnterBeastformResponse struct {
+	state       protoimpl.MessageState     `protogen:"open.v1"`
+	CharacterId string                     `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	State       *DaggerheartCharacterState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Evasion while transformed, including the beastform bonus.
+	Evasion       int32 `protobuf:"varint,3,opt,name=evasion,proto3" json:"evasion,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) Reset() {
-	*x = DaggerheartApplyAdversaryAttackOutcomeRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[78]
+func (x *DaggerheartEnterBeastformResponse) Reset() {
+	*x = DaggerheartEnterBeastformResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) String() string {
+func (x *DaggerheartEnterBeastformResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartApplyAdversaryAttackOutcomeRequest) ProtoMessage() {}
+func (*DaggerheartEnterBeastformResponse) ProtoMessage() {}
 
-func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[78]
+func (x *DaggerheartEnterBeastformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6269,64 +12976,57 @@ func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartApplyAdversaryAttackOutcomeRequest.ProtoReflect.Descriptor instead.
-func (*DaggerheartApplyAdversaryAttackOutcomeRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use DaggerheartEnterBeastformResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartEnterBeastformResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) GetSessionId() string {
+func (x *DaggerheartEnterBeastformResponse) GetCharacterId() string {
 	if x != nil {
-		return x.SessionId
+		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) GetRollSeq() uint64 {
-	if x != nil {
-		return x.RollSeq
-	}
-	return 0
-}
-
-func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) GetTargets() []string {
+func (x *DaggerheartEnterBeastformResponse) GetState() *DaggerheartCharacterState {
 	if x != nil {
-		return x.Targets
+		return x.State
 	}
 	return nil
 }
 
-func (x *DaggerheartApplyAdversaryAttackOutcomeRequest) GetDifficulty() int32 {
+func (x *DaggerheartEnterBeastformResponse) GetEvasion() int32 {
 	if x != nil {
-		return x.Difficulty
+		return x.Evasion
 	}
 	return 0
 }
 
-type DaggerheartAttackOutcomeResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Outcome       Outcome                `protobuf:"varint,1,opt,name=outcome,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcome,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Crit          bool                   `protobuf:"varint,3,opt,name=crit,proto3" json:"crit,omitempty"`
-	Flavor        string                 `protobuf:"bytes,4,opt,name=flavor,proto3" json:"flavor,omitempty"`
+type DaggerheartExitBeastformRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId  string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	CharacterId string                 `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	// Defaults to "voluntary".
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartAttackOutcomeResult) Reset() {
-	*x = DaggerheartAttackOutcomeResult{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[79]
+func (x *DaggerheartExitBeastformRequest) Reset() {
+	*x = DaggerheartExitBeastformRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartAttackOutcomeResult) String() string {
+func (x *DaggerheartExitBeastformRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartAttackOutcomeResult) ProtoMessage() {}
+func (*DaggerheartExitBeastformRequest) ProtoMessage() {}
 
-func (x *DaggerheartAttackOutcomeResult) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[79]
+func (x *DaggerheartExitBeastformRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6337,64 +13037,55 @@ func (x *DaggerheartAttackOutcomeResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartAttackOutcomeResult.ProtoReflect.Descriptor instead.
-func (*DaggerheartAttackOutcomeResult) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{79}
-}
-
-func (x *DaggerheartAttackOutcomeResult) GetOutcome() Outcome {
-	if x != nil {
-		return x.Outcome
-	}
-	return Outcome_OUTCOME_UNSPECIFIED
+// Deprecated: Use DaggerheartExitBeastformRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartExitBeastformRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *DaggerheartAttackOutcomeResult) GetSuccess() bool {
+func (x *DaggerheartExitBeastformRequest) GetCampaignId() string {
 	if x != nil {
-		return x.Success
+		return x.CampaignId
 	}
-	return false
+	return ""
 }
 
-func (x *DaggerheartAttackOutcomeResult) GetCrit() bool {
+func (x *DaggerheartExitBeastformRequest) GetCharacterId() string {
 	if x != nil {
-		return x.Crit
+		return x.CharacterId
 	}
-	return false
+	return ""
 }
 
-func (x *DaggerheartAttackOutcomeResult) GetFlavor() string {
+func (x *DaggerheartExitBeastformRequest) GetReason() string {
 	if x != nil {
-		return x.Flavor
+		return x.Reason
 	}
 	return ""
 }
 
-type DaggerheartApplyAttackOutcomeResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	RollSeq       uint64                          `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
-	CharacterId   string                          `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	Targets       []string                        `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
-	Result        *DaggerheartAttackOutcomeResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+type DaggerheartExitBeastformResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	CharacterId   string                     `protobuf:"bytes,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
+	State         *DaggerheartCharacterState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartApplyAttackOutcomeResponse) Reset() {
-	*x = DaggerheartApplyAttackOutcomeResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[80]
+func (x *DaggerheartExitBeastformResponse) Reset() {
+	*x = DaggerheartExitBeastformResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartApplyAttackOutcomeResponse) String() string {
+func (x *DaggerheartExitBeastformResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartApplyAttackOutcomeResponse) ProtoMessage() {}
+func (*DaggerheartExitBeastformResponse) ProtoMessage() {}
 
-func (x *DaggerheartApplyAttackOutcomeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[80]
+func (x *DaggerheartExitBeastformResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6405,65 +13096,57 @@ func (x *DaggerheartApplyAttackOutcomeResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartApplyAttackOutcomeResponse.ProtoReflect.Descriptor instead.
-func (*DaggerheartApplyAttackOutcomeResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{80}
-}
-
-func (x *DaggerheartApplyAttackOutcomeResponse) GetRollSeq() uint64 {
-	if x != nil {
-		return x.RollSeq
-	}
-	return 0
+// Deprecated: Use DaggerheartExitBeastformResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartExitBeastformResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *DaggerheartApplyAttackOutcomeResponse) GetCharacterId() string {
+func (x *DaggerheartExitBeastformResponse) GetCharacterId() string {
 	if x != nil {
 		return x.CharacterId
 	}
 	return ""
 }
 
-func (x *DaggerheartApplyAttackOutcomeResponse) GetTargets() []string {
-	if x != nil {
-		return x.Targets
-	}
-	return nil
-}
-
-func (x *DaggerheartApplyAttackOutcomeResponse) GetResult() *DaggerheartAttackOutcomeResult {
+func (x *DaggerheartExitBeastformResponse) GetState() *DaggerheartCharacterState {
 	if x != nil {
-		return x.Result
+		return x.State
 	}
 	return nil
 }
 
-type DaggerheartAdversaryAttackOutcomeResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Crit          bool                   `protobuf:"varint,2,opt,name=crit,proto3" json:"crit,omitempty"`
-	Roll          int32                  `protobuf:"varint,3,opt,name=roll,proto3" json:"roll,omitempty"`
-	Total         int32                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Difficulty    int32                  `protobuf:"varint,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+type DaggerheartApplyEffectRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Generated when empty.
+	EffectId   string                      `protobuf:"bytes,2,opt,name=effect_id,json=effectId,proto3" json:"effect_id,omitempty"`
+	TargetType DaggerheartEffectTargetType `protobuf:"varint,3,opt,name=target_type,json=targetType,proto3,enum=systems.daggerheart.v1.DaggerheartEffectTargetType" json:"target_type,omitempty"`
+	TargetId   string                      `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// Names what granted the effect; replace stacking matches on it.
+	Source        string                    `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Kind          DaggerheartEffectKind     `protobuf:"varint,6,opt,name=kind,proto3,enum=systems.daggerheart.v1.DaggerheartEffectKind" json:"kind,omitempty"`
+	Value         int32                     `protobuf:"varint,7,opt,name=value,proto3" json:"value,omitempty"`
+	Stacking      DaggerheartEffectStacking `protobuf:"varint,8,opt,name=stacking,proto3,enum=systems.daggerheart.v1.DaggerheartEffectStacking" json:"stacking,omitempty"`
+	ExpiresOn     DaggerheartEffectExpiry   `protobuf:"varint,9,opt,name=expires_on,json=expiresOn,proto3,enum=systems.daggerheart.v1.DaggerheartEffectExpiry" json:"expires_on,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartAdversaryAttackOutcomeResult) Reset() {
-	*x = DaggerheartAdversaryAttackOutcomeResult{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[81]
+func (x *DaggerheartApplyEffectRequest) Reset() {
+	*x = DaggerheartApplyEffectRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartAdversaryAttackOutcomeResult) String() string {
+func (x *DaggerheartApplyEffectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartAdversaryAttackOutcomeResult) ProtoMessage() {}
+func (*DaggerheartApplyEffectRequest) ProtoMessage() {}
 
-func (x *DaggerheartAdversaryAttackOutcomeResult) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[81]
+func (x *DaggerheartApplyEffectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6474,71 +13157,98 @@ func (x *DaggerheartAdversaryAttackOutcomeResult) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartAdversaryAttackOutcomeResult.ProtoReflect.Descriptor instead.
-func (*DaggerheartAdversaryAttackOutcomeResult) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use DaggerheartApplyEffectRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartApplyEffectRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *DaggerheartAdversaryAttackOutcomeResult) GetSuccess() bool {
+func (x *DaggerheartApplyEffectRequest) GetCampaignId() string {
 	if x != nil {
-		return x.Success
+		return x.CampaignId
 	}
-	return false
+	return ""
 }
 
-func (x *DaggerheartAdversaryAttackOutcomeResult) GetCrit() bool {
+func (x *DaggerheartApplyEffectRequest) GetEffectId() string {
 	if x != nil {
-		return x.Crit
+		return x.EffectId
 	}
-	return false
+	return ""
 }
 
-func (x *DaggerheartAdversaryAttackOutcomeResult) GetRoll() int32 {
+func (x *DaggerheartApplyEffectRequest) GetTargetType() DaggerheartEffectTargetType {
 	if x != nil {
-		return x.Roll
+		return x.TargetType
 	}
-	return 0
+	return DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_UNSPECIFIED
 }
 
-func (x *DaggerheartAdversaryAttackOutcomeResult) GetTotal() int32 {
+func (x *DaggerheartApplyEffectRequest) GetTargetId() string {
 	if x != nil {
-		return x.Total
+		return x.TargetId
 	}
-	return 0
+	return ""
 }
 
-func (x *DaggerheartAdversaryAttackOutcomeResult) GetDifficulty() int32 {
+func (x *DaggerheartApplyEffectRequest) GetSource() string {
 	if x != nil {
-		return x.Difficulty
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DaggerheartApplyEffectRequest) GetKind() DaggerheartEffectKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_UNSPECIFIED
+}
+
+func (x *DaggerheartApplyEffectRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
 	}
 	return 0
 }
 
-type DaggerheartApplyAdversaryAttackOutcomeResponse struct {
-	state         protoimpl.MessageState                   `protogen:"open.v1"`
-	RollSeq       uint64                                   `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
-	AdversaryId   string                                   `protobuf:"bytes,2,opt,name=adversary_id,json=adversaryId,proto3" json:"adversary_id,omitempty"`
-	Targets       []string                                 `protobuf:"bytes,3,rep,name=targets,proto3" json:"targets,omitempty"`
-	Result        *DaggerheartAdversaryAttackOutcomeResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DaggerheartApplyEffectRequest) GetStacking() DaggerheartEffectStacking {
+	if x != nil {
+		return x.Stacking
+	}
+	return DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_UNSPECIFIED
 }
 
-func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) Reset() {
-	*x = DaggerheartApplyAdversaryAttackOutcomeResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[82]
+func (x *DaggerheartApplyEffectRequest) GetExpiresOn() DaggerheartEffectExpiry {
+	if x != nil {
+		return x.ExpiresOn
+	}
+	return DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_UNSPECIFIED
+}
+
+type DaggerheartApplyEffectResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Effect *DaggerheartEffect     `protobuf:"bytes,1,opt,name=effect,proto3" json:"effect,omitempty"`
+	// Effects on the target that this effect replaced.
+	ReplacedEffectIds []string `protobuf:"bytes,2,rep,name=replaced_effect_ids,json=replacedEffectIds,proto3" json:"replaced_effect_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DaggerheartApplyEffectResponse) Reset() {
+	*x = DaggerheartApplyEffectResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) String() string {
+func (x *DaggerheartApplyEffectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartApplyAdversaryAttackOutcomeResponse) ProtoMessage() {}
+func (*DaggerheartApplyEffectResponse) ProtoMessage() {}
 
-func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[82]
+func (x *DaggerheartApplyEffectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6549,62 +13259,48 @@ func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) ProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartApplyAdversaryAttackOutcomeResponse.ProtoReflect.Descriptor instead.
-func (*DaggerheartApplyAdversaryAttackOutcomeResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{82}
-}
-
-func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) GetRollSeq() uint64 {
-	if x != nil {
-		return x.RollSeq
-	}
-	return 0
-}
-
-func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) GetAdversaryId() string {
-	if x != nil {
-		return x.AdversaryId
-	}
-	return ""
+// Deprecated: Use DaggerheartApplyEffectResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartApplyEffectResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) GetTargets() []string {
+func (x *DaggerheartApplyEffectResponse) GetEffect() *DaggerheartEffect {
 	if x != nil {
-		return x.Targets
+		return x.Effect
 	}
 	return nil
 }
 
-func (x *DaggerheartApplyAdversaryAttackOutcomeResponse) GetResult() *DaggerheartAdversaryAttackOutcomeResult {
+func (x *DaggerheartApplyEffectResponse) GetReplacedEffectIds() []string {
 	if x != nil {
-		return x.Result
+		return x.ReplacedEffectIds
 	}
 	return nil
 }
 
-type DaggerheartApplyReactionOutcomeRequest struct {
+type DaggerheartRemoveEffectRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	RollSeq       uint64                 `protobuf:"varint,2,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
+	CampaignId    string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	EffectId      string                 `protobuf:"bytes,2,opt,name=effect_id,json=effectId,proto3" json:"effect_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartApplyReactionOutcomeRequest) Reset() {
-	*x = DaggerheartApplyReactionOutcomeRequest{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[83]
+func (x *DaggerheartRemoveEffectRequest) Reset() {
+	*x = DaggerheartRemoveEffectRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartApplyReactionOutcomeRequest) String() string {
+func (x *DaggerheartRemoveEffectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartApplyReactionOutcomeRequest) ProtoMessage() {}
+func (*DaggerheartRemoveEffectRequest) ProtoMessage() {}
 
-func (x *DaggerheartApplyReactionOutcomeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[83]
+func (x *DaggerheartRemoveEffectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6615,51 +13311,47 @@ func (x *DaggerheartApplyReactionOutcomeRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartApplyReactionOutcomeRequest.ProtoReflect.Descriptor instead.
-func (*DaggerheartApplyReactionOutcomeRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use DaggerheartRemoveEffectRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartRemoveEffectRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *DaggerheartApplyReactionOutcomeRequest) GetSessionId() string {
+func (x *DaggerheartRemoveEffectRequest) GetCampaignId() string {
 	if x != nil {
-		return x.SessionId
+		return x.CampaignId
 	}
 	return ""
 }
 
-func (x *DaggerheartApplyReactionOutcomeRequest) GetRollSeq() uint64 {
+func (x *DaggerheartRemoveEffectRequest) GetEffectId() string {
 	if x != nil {
-		return x.RollSeq
+		return x.EffectId
 	}
-	return 0
+	return ""
 }
 
-type DaggerheartReactionOutcomeResult struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Outcome            Outcome                `protobuf:"varint,1,opt,name=outcome,proto3,enum=systems.daggerheart.v1.Outcome" json:"outcome,omitempty"`
-	Success            bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Crit               bool                   `protobuf:"varint,3,opt,name=crit,proto3" json:"crit,omitempty"`
-	CritNegatesEffects bool                   `protobuf:"varint,4,opt,name=crit_negates_effects,json=critNegatesEffects,proto3" json:"crit_negates_effects,omitempty"`
-	EffectsNegated     bool                   `protobuf:"varint,5,opt,name=effects_negated,json=effectsNegated,proto3" json:"effects_negated,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type DaggerheartRemoveEffectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EffectId      string                 `protobuf:"bytes,1,opt,name=effect_id,json=effectId,proto3" json:"effect_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartReactionOutcomeResult) Reset() {
-	*x = DaggerheartReactionOutcomeResult{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[84]
+func (x *DaggerheartRemoveEffectResponse) Reset() {
+	*x = DaggerheartRemoveEffectResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartReactionOutcomeResult) String() string {
+func (x *DaggerheartRemoveEffectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartReactionOutcomeResult) ProtoMessage() {}
+func (*DaggerheartRemoveEffectResponse) ProtoMessage() {}
 
-func (x *DaggerheartReactionOutcomeResult) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[84]
+func (x *DaggerheartRemoveEffectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6670,70 +13362,93 @@ func (x *DaggerheartReactionOutcomeResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartReactionOutcomeResult.ProtoReflect.Descriptor instead.
-func (*DaggerheartReactionOutcomeResult) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use DaggerheartRemoveEffectResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartRemoveEffectResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *DaggerheartReactionOutcomeResult) GetOutcome() Outcome {
+func (x *DaggerheartRemoveEffectResponse) GetEffectId() string {
 	if x != nil {
-		return x.Outcome
+		return x.EffectId
 	}
-	return Outcome_OUTCOME_UNSPECIFIED
+	return ""
 }
 
-func (x *DaggerheartReactionOutcomeResult) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+type DaggerheartListEffectsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId string                 `protobuf:"bytes,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// Optional; lists every effect in the campaign when empty.
+	TargetId      string `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartReactionOutcomeResult) GetCrit() bool {
+func (x *DaggerheartListEffectsRequest) Reset() {
+	*x = DaggerheartListEffectsRequest{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartListEffectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartListEffectsRequest) ProtoMessage() {}
+
+func (x *DaggerheartListEffectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[170]
 	if x != nil {
-		return x.Crit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *DaggerheartReactionOutcomeResult) GetCritNegatesEffects() bool {
+// Deprecated: Use DaggerheartListEffectsRequest.ProtoReflect.Descriptor instead.
+func (*DaggerheartListEffectsRequest) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *DaggerheartListEffectsRequest) GetCampaignId() string {
 	if x != nil {
-		return x.CritNegatesEffects
+		return x.CampaignId
 	}
-	return false
+	return ""
 }
 
-func (x *DaggerheartReactionOutcomeResult) GetEffectsNegated() bool {
+func (x *DaggerheartListEffectsRequest) GetTargetId() string {
 	if x != nil {
-		return x.EffectsNegated
+		return x.TargetId
 	}
-	return false
+	return ""
 }
 
-type DaggerheartApplyReactionOutcomeResponse struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	RollSeq       uint64                            `protobuf:"varint,1,opt,name=roll_seq,json=rollSeq,proto3" json:"roll_seq,omitempty"`
-	CharacterId   string                            `protobuf:"bytes,2,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"`
-	Result        *DaggerheartReactionOutcomeResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+type DaggerheartListEffectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Effects       []*DaggerheartEffect   `protobuf:"bytes,1,rep,name=effects,proto3" json:"effects,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DaggerheartApplyReactionOutcomeResponse) Reset() {
-	*x = DaggerheartApplyReactionOutcomeResponse{}
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[85]
+func (x *DaggerheartListEffectsResponse) Reset() {
+	*x = DaggerheartListEffectsResponse{}
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DaggerheartApplyReactionOutcomeResponse) String() string {
+func (x *DaggerheartListEffectsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DaggerheartApplyReactionOutcomeResponse) ProtoMessage() {}
+func (*DaggerheartListEffectsResponse) ProtoMessage() {}
 
-func (x *DaggerheartApplyReactionOutcomeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[85]
+func (x *DaggerheartListEffectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_service_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6744,28 +13459,14 @@ func (x *DaggerheartApplyReactionOutcomeResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DaggerheartApplyReactionOutcomeResponse.ProtoReflect.Descriptor instead.
-func (*DaggerheartApplyReactionOutcomeResponse) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *DaggerheartApplyReactionOutcomeResponse) GetRollSeq() uint64 {
-	if x != nil {
-		return x.RollSeq
-	}
-	return 0
-}
-
-func (x *DaggerheartApplyReactionOutcomeResponse) GetCharacterId() string {
-	if x != nil {
-		return x.CharacterId
-	}
-	return ""
+// Deprecated: Use DaggerheartListEffectsResponse.ProtoReflect.Descriptor instead.
+func (*DaggerheartListEffectsResponse) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_service_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *DaggerheartApplyReactionOutcomeResponse) GetResult() *DaggerheartReactionOutcomeResult {
+func (x *DaggerheartListEffectsResponse) GetEffects() []*DaggerheartEffect {
 	if x != nil {
-		return x.Result
+		return x.Effects
 	}
 	return nil
 }
@@ -6774,7 +13475,7 @@ var File_systems_daggerheart_v1_service_proto protoreflect.FileDescriptor
 
 const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"$systems/daggerheart/v1/service.proto\x12\x16systems.daggerheart.v1\x1a\x13common/v1/rng.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a&systems/daggerheart/v1/mechanics.proto\x1a\"systems/daggerheart/v1/state.proto\"\x8a\x02\n" +
+	"$systems/daggerheart/v1/service.proto\x12\x16systems.daggerheart.v1\x1a\x13common/v1/rng.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a$systems/daggerheart/v1/content.proto\x1a&systems/daggerheart/v1/mechanics.proto\x1a\"systems/daggerheart/v1/state.proto\"\x8a\x02\n" +
 	"\x1dDaggerheartApplyDamageRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12!\n" +
@@ -6785,18 +13486,21 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\t_roll_seq\"\x8c\x01\n" +
 	"\x1eDaggerheartApplyDamageResponse\x12!\n" +
 	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12G\n" +
-	"\x05state\x18\x02 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\x05state\"\x93\x02\n" +
+	"\x05state\x18\x02 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\x05state\"\xc3\x02\n" +
 	"&DaggerheartApplyAdversaryDamageRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12!\n" +
 	"\fadversary_id\x18\x02 \x01(\tR\vadversaryId\x12H\n" +
 	"\x06damage\x18\x03 \x01(\v20.systems.daggerheart.v1.DaggerheartDamageRequestR\x06damage\x12\x1e\n" +
 	"\broll_seq\x18\x04 \x01(\x04H\x00R\arollSeq\x88\x01\x01\x12.\n" +
-	"\x13require_damage_roll\x18\x05 \x01(\bR\x11requireDamageRollB\v\n" +
-	"\t_roll_seq\"\x98\x01\n" +
+	"\x13require_damage_roll\x18\x05 \x01(\bR\x11requireDamageRoll\x12.\n" +
+	"\x13minion_overflow_ids\x18\x06 \x03(\tR\x11minionOverflowIdsB\v\n" +
+	"\t_roll_seq\"\xf7\x01\n" +
 	"'DaggerheartApplyAdversaryDamageResponse\x12!\n" +
 	"\fadversary_id\x18\x01 \x01(\tR\vadversaryId\x12J\n" +
-	"\tadversary\x18\x02 \x01(\v2,.systems.daggerheart.v1.DaggerheartAdversaryR\tadversary\"\xa7\x01\n" +
+	"\tadversary\x18\x02 \x01(\v2,.systems.daggerheart.v1.DaggerheartAdversaryR\tadversary\x12'\n" +
+	"\x0fminion_overflow\x18\x03 \x01(\x05R\x0eminionOverflow\x124\n" +
+	"\x16defeated_adversary_ids\x18\x04 \x03(\tR\x14defeatedAdversaryIds\"\xa7\x01\n" +
 	"\x1bDaggerheartApplyRestRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12#\n" +
@@ -6895,7 +13599,14 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12$\n" +
 	"\x0egm_fear_before\x18\x02 \x01(\x05R\fgmFearBefore\x12\"\n" +
-	"\rgm_fear_after\x18\x03 \x01(\x05R\vgmFearAfter\"\xae\x02\n" +
+	"\rgm_fear_after\x18\x03 \x01(\x05R\vgmFearAfter\"s\n" +
+	" DaggerheartCountdownOutcomeDelta\x129\n" +
+	"\aoutcome\x18\x01 \x01(\x0e2\x1f.systems.daggerheart.v1.OutcomeR\aoutcome\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x05R\x05delta\"\xe6\x01\n" +
+	"\x1eDaggerheartCountdownTickPolicy\x12M\n" +
+	"\atrigger\x18\x01 \x01(\x0e23.systems.daggerheart.v1.DaggerheartCountdownTriggerR\atrigger\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x05R\x05delta\x12_\n" +
+	"\x0eoutcome_deltas\x18\x03 \x03(\v28.systems.daggerheart.v1.DaggerheartCountdownOutcomeDeltaR\routcomeDeltas\"\x87\x03\n" +
 	"\x14DaggerheartCountdown\x12!\n" +
 	"\fcountdown_id\x18\x01 \x01(\tR\vcountdownId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12D\n" +
@@ -6903,7 +13614,9 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\acurrent\x18\x04 \x01(\x05R\acurrent\x12\x10\n" +
 	"\x03max\x18\x05 \x01(\x05R\x03max\x12S\n" +
 	"\tdirection\x18\x06 \x01(\x0e25.systems.daggerheart.v1.DaggerheartCountdownDirectionR\tdirection\x12\x18\n" +
-	"\alooping\x18\a \x01(\bR\alooping\"\xfb\x02\n" +
+	"\alooping\x18\a \x01(\bR\alooping\x12W\n" +
+	"\vtick_policy\x18\b \x01(\v26.systems.daggerheart.v1.DaggerheartCountdownTickPolicyR\n" +
+	"tickPolicy\"\xd4\x03\n" +
 	"!DaggerheartCreateCountdownRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12\x1d\n" +
@@ -6915,7 +13628,10 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\acurrent\x18\x06 \x01(\x05R\acurrent\x12\x10\n" +
 	"\x03max\x18\a \x01(\x05R\x03max\x12S\n" +
 	"\tdirection\x18\b \x01(\x0e25.systems.daggerheart.v1.DaggerheartCountdownDirectionR\tdirection\x12\x18\n" +
-	"\alooping\x18\t \x01(\bR\alooping\"p\n" +
+	"\alooping\x18\t \x01(\bR\alooping\x12W\n" +
+	"\vtick_policy\x18\n" +
+	" \x01(\v26.systems.daggerheart.v1.DaggerheartCountdownTickPolicyR\n" +
+	"tickPolicy\"p\n" +
 	"\"DaggerheartCreateCountdownResponse\x12J\n" +
 	"\tcountdown\x18\x01 \x01(\v2,.systems.daggerheart.v1.DaggerheartCountdownR\tcountdown\"\xdf\x01\n" +
 	"!DaggerheartUpdateCountdownRequest\x12\x1f\n" +
@@ -6942,7 +13658,154 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\fcountdown_id\x18\x03 \x01(\tR\vcountdownId\x12\x16\n" +
 	"\x06reason\x18\x04 \x01(\tR\x06reason\"G\n" +
 	"\"DaggerheartDeleteCountdownResponse\x12!\n" +
-	"\fcountdown_id\x18\x01 \x01(\tR\vcountdownId\"\xe8\x04\n" +
+	"\fcountdown_id\x18\x01 \x01(\tR\vcountdownId\"\xeb\x02\n" +
+	"\x10DaggerheartChase\x12\x19\n" +
+	"\bchase_id\x18\x01 \x01(\tR\achaseId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12F\n" +
+	"\x06status\x18\x03 \x01(\x0e2..systems.daggerheart.v1.DaggerheartChaseStatusR\x06status\x12F\n" +
+	"\x06winner\x18\x04 \x01(\x0e2..systems.daggerheart.v1.DaggerheartChaseWinnerR\x06winner\x12H\n" +
+	"\bprogress\x18\x05 \x01(\v2,.systems.daggerheart.v1.DaggerheartCountdownR\bprogress\x12N\n" +
+	"\vconsequence\x18\x06 \x01(\v2,.systems.daggerheart.v1.DaggerheartCountdownR\vconsequence\"\xc9\x01\n" +
+	"\x1dDaggerheartChaseCountdownSpec\x12!\n" +
+	"\fcountdown_id\x18\x01 \x01(\tR\vcountdownId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03max\x18\x03 \x01(\x05R\x03max\x12_\n" +
+	"\x0eoutcome_deltas\x18\x04 \x03(\v28.systems.daggerheart.v1.DaggerheartCountdownOutcomeDeltaR\routcomeDeltas\"\xb9\x02\n" +
+	"\x1cDaggerheartStartChaseRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x19\n" +
+	"\bchase_id\x18\x03 \x01(\tR\achaseId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12Q\n" +
+	"\bprogress\x18\x05 \x01(\v25.systems.daggerheart.v1.DaggerheartChaseCountdownSpecR\bprogress\x12W\n" +
+	"\vconsequence\x18\x06 \x01(\v25.systems.daggerheart.v1.DaggerheartChaseCountdownSpecR\vconsequence\"_\n" +
+	"\x1dDaggerheartStartChaseResponse\x12>\n" +
+	"\x05chase\x18\x01 \x01(\v2(.systems.daggerheart.v1.DaggerheartChaseR\x05chase\"X\n" +
+	"\x1aDaggerheartGetChaseRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x19\n" +
+	"\bchase_id\x18\x02 \x01(\tR\achaseId\"]\n" +
+	"\x1bDaggerheartGetChaseResponse\x12>\n" +
+	"\x05chase\x18\x01 \x01(\v2(.systems.daggerheart.v1.DaggerheartChaseR\x05chase\"?\n" +
+	"\x1cDaggerheartListChasesRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\"a\n" +
+	"\x1dDaggerheartListChasesResponse\x12@\n" +
+	"\x06chases\x18\x01 \x03(\v2(.systems.daggerheart.v1.DaggerheartChaseR\x06chases\"p\n" +
+	"\x16DaggerheartSceneEntity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12F\n" +
+	"\x04type\x18\x02 \x01(\x0e22.systems.daggerheart.v1.DaggerheartSceneEntityTypeR\x04type\"\xdd\x01\n" +
+	"\x15DaggerheartSceneRange\x12B\n" +
+	"\x04from\x18\x01 \x01(\v2..systems.daggerheart.v1.DaggerheartSceneEntityR\x04from\x12>\n" +
+	"\x02to\x18\x02 \x01(\v2..systems.daggerheart.v1.DaggerheartSceneEntityR\x02to\x12@\n" +
+	"\x04band\x18\x03 \x01(\x0e2,.systems.daggerheart.v1.DaggerheartRangeBandR\x04band\"\xa9\x01\n" +
+	" DaggerheartSetSceneRangesRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12E\n" +
+	"\x06ranges\x18\x03 \x03(\v2-.systems.daggerheart.v1.DaggerheartSceneRangeR\x06ranges\"j\n" +
+	"!DaggerheartSetSceneRangesResponse\x12E\n" +
+	"\x06ranges\x18\x01 \x03(\v2-.systems.daggerheart.v1.DaggerheartSceneRangeR\x06ranges\"\xa7\x01\n" +
+	"\x1bDaggerheartSceneRangeUpdate\x12F\n" +
+	"\x06target\x18\x01 \x01(\v2..systems.daggerheart.v1.DaggerheartSceneEntityR\x06target\x12@\n" +
+	"\x04band\x18\x02 \x01(\x0e2,.systems.daggerheart.v1.DaggerheartRangeBandR\x04band\"\xbc\x03\n" +
+	"!DaggerheartMoveSceneEntityRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12F\n" +
+	"\x06entity\x18\x03 \x01(\v2..systems.daggerheart.v1.DaggerheartSceneEntityR\x06entity\x12C\n" +
+	"\x04kind\x18\x04 \x01(\x0e2/.systems.daggerheart.v1.DaggerheartMovementKindR\x04kind\x12F\n" +
+	"\x06source\x18\x05 \x01(\v2..systems.daggerheart.v1.DaggerheartSceneEntityR\x06source\x12K\n" +
+	"\x06ranges\x18\x06 \x03(\v23.systems.daggerheart.v1.DaggerheartSceneRangeUpdateR\x06ranges\x12\x1d\n" +
+	"\n" +
+	"push_bands\x18\a \x01(\x05R\tpushBands\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\"k\n" +
+	"\"DaggerheartMoveSceneEntityResponse\x12E\n" +
+	"\x06ranges\x18\x01 \x03(\v2-.systems.daggerheart.v1.DaggerheartSceneRangeR\x06ranges\"\x80\x01\n" +
+	"!DaggerheartListSceneRangesRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\"k\n" +
+	"\"DaggerheartListSceneRangesResponse\x12E\n" +
+	"\x06ranges\x18\x01 \x03(\v2-.systems.daggerheart.v1.DaggerheartSceneRangeR\x06ranges\"\xe2\x01\n" +
+	"\x1dDaggerheartSessionEnvironment\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12%\n" +
+	"\x0eenvironment_id\x18\x03 \x01(\tR\renvironmentId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x12\n" +
+	"\x04tier\x18\x05 \x01(\x05R\x04tier\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\a \x01(\x05R\n" +
+	"difficulty\"\xd0\x01\n" +
+	"*DaggerheartCreateSessionEnvironmentRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12%\n" +
+	"\x0eenvironment_id\x18\x03 \x01(\tR\renvironmentId\x12;\n" +
+	"\n" +
+	"difficulty\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueR\n" +
+	"difficulty\"\x86\x01\n" +
+	"+DaggerheartCreateSessionEnvironmentResponse\x12W\n" +
+	"\venvironment\x18\x01 \x01(\v25.systems.daggerheart.v1.DaggerheartSessionEnvironmentR\venvironment\"\xe7\x01\n" +
+	")DaggerheartShiftSessionEnvironmentRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12%\n" +
+	"\x0eenvironment_id\x18\x03 \x01(\tR\renvironmentId\x12;\n" +
+	"\n" +
+	"difficulty\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueR\n" +
+	"difficulty\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xbd\x01\n" +
+	"*DaggerheartShiftSessionEnvironmentResponse\x12W\n" +
+	"\venvironment\x18\x01 \x01(\v25.systems.daggerheart.v1.DaggerheartSessionEnvironmentR\venvironment\x126\n" +
+	"\x17previous_environment_id\x18\x02 \x01(\tR\x15previousEnvironmentId\"\x83\x01\n" +
+	")DaggerheartClearSessionEnvironmentRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"S\n" +
+	"*DaggerheartClearSessionEnvironmentResponse\x12%\n" +
+	"\x0eenvironment_id\x18\x01 \x01(\tR\renvironmentId\"i\n" +
+	"'DaggerheartGetSessionEnvironmentRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"\x83\x01\n" +
+	"(DaggerheartGetSessionEnvironmentResponse\x12W\n" +
+	"\venvironment\x18\x01 \x01(\v25.systems.daggerheart.v1.DaggerheartSessionEnvironmentR\venvironment\"u\n" +
+	"\x1bDaggerheartEnvironmentSpawn\x12,\n" +
+	"\x12adversary_entry_id\x18\x01 \x01(\tR\x10adversaryEntryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\xe7\x02\n" +
+	"+DaggerheartTriggerEnvironmentFeatureRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x03 \x01(\tR\tfeatureId\x12M\n" +
+	"\x04kind\x18\x04 \x01(\x0e29.systems.daggerheart.v1.DaggerheartEnvironmentFeatureKindR\x04kind\x12\x1b\n" +
+	"\tfear_cost\x18\x05 \x01(\x05R\bfearCost\x12K\n" +
+	"\x06spawns\x18\x06 \x03(\v23.systems.daggerheart.v1.DaggerheartEnvironmentSpawnR\x06spawns\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\"\x8e\x02\n" +
+	",DaggerheartTriggerEnvironmentFeatureResponse\x12%\n" +
+	"\x0eenvironment_id\x18\x01 \x01(\tR\renvironmentId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\tR\tfeatureId\x12$\n" +
+	"\x0egm_fear_before\x18\x03 \x01(\x05R\fgmFearBefore\x12\"\n" +
+	"\rgm_fear_after\x18\x04 \x01(\x05R\vgmFearAfter\x12N\n" +
+	"\vadversaries\x18\x05 \x03(\v2,.systems.daggerheart.v1.DaggerheartAdversaryR\vadversaries\"\xf2\a\n" +
 	"\x14DaggerheartAdversary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vcampaign_id\x18\x02 \x01(\tR\n" +
@@ -6968,7 +13831,13 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x89\x05\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12)\n" +
+	"\x10minion_threshold\x18\x12 \x01(\x05R\x0fminionThreshold\x12,\n" +
+	"\x12adversary_entry_id\x18\x13 \x01(\tR\x10adversaryEntryId\x12'\n" +
+	"\x0fattack_modifier\x18\x14 \x01(\x05R\x0eattackModifier\x12[\n" +
+	"\x0fstandard_attack\x18\x15 \x01(\v22.systems.daggerheart.v1.DaggerheartAdversaryAttackR\x0estandardAttack\x12X\n" +
+	"\vexperiences\x18\x16 \x03(\v26.systems.daggerheart.v1.DaggerheartAdversaryExperienceR\vexperiences\x12O\n" +
+	"\bfeatures\x18\x17 \x03(\v23.systems.daggerheart.v1.DaggerheartAdversaryFeatureR\bfeatures\"\xab\x06\n" +
 	"!DaggerheartCreateAdversaryRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12\x12\n" +
@@ -6986,9 +13855,14 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	" \x01(\v2\x1b.google.protobuf.Int32ValueR\aevasion\x12D\n" +
 	"\x0fmajor_threshold\x18\v \x01(\v2\x1b.google.protobuf.Int32ValueR\x0emajorThreshold\x12F\n" +
 	"\x10severe_threshold\x18\f \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fsevereThreshold\x121\n" +
-	"\x05armor\x18\r \x01(\v2\x1b.google.protobuf.Int32ValueR\x05armor\"p\n" +
+	"\x05armor\x18\r \x01(\v2\x1b.google.protobuf.Int32ValueR\x05armor\x12F\n" +
+	"\x10minion_threshold\x18\x0e \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fminionThreshold\x12,\n" +
+	"\x12adversary_entry_id\x18\x0f \x01(\tR\x10adversaryEntryId\x12\x14\n" +
+	"\x05count\x18\x10 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05names\x18\x11 \x03(\tR\x05names\"\xc0\x01\n" +
 	"\"DaggerheartCreateAdversaryResponse\x12J\n" +
-	"\tadversary\x18\x01 \x01(\v2,.systems.daggerheart.v1.DaggerheartAdversaryR\tadversary\"\x86\x06\n" +
+	"\tadversary\x18\x01 \x01(\v2,.systems.daggerheart.v1.DaggerheartAdversaryR\tadversary\x12N\n" +
+	"\vadversaries\x18\x02 \x03(\v2,.systems.daggerheart.v1.DaggerheartAdversaryR\vadversaries\"\xce\x06\n" +
 	"!DaggerheartUpdateAdversaryRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12!\n" +
@@ -7007,7 +13881,8 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\aevasion\x18\v \x01(\v2\x1b.google.protobuf.Int32ValueR\aevasion\x12D\n" +
 	"\x0fmajor_threshold\x18\f \x01(\v2\x1b.google.protobuf.Int32ValueR\x0emajorThreshold\x12F\n" +
 	"\x10severe_threshold\x18\r \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fsevereThreshold\x121\n" +
-	"\x05armor\x18\x0e \x01(\v2\x1b.google.protobuf.Int32ValueR\x05armor\"p\n" +
+	"\x05armor\x18\x0e \x01(\v2\x1b.google.protobuf.Int32ValueR\x05armor\x12F\n" +
+	"\x10minion_threshold\x18\x0f \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fminionThreshold\"p\n" +
 	"\"DaggerheartUpdateAdversaryResponse\x12J\n" +
 	"\tadversary\x18\x01 \x01(\v2,.systems.daggerheart.v1.DaggerheartAdversaryR\tadversary\"\x7f\n" +
 	"!DaggerheartDeleteAdversaryRequest\x12\x1f\n" +
@@ -7029,7 +13904,93 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\n" +
 	"session_id\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\tsessionId\"t\n" +
 	"\"DaggerheartListAdversariesResponse\x12N\n" +
-	"\vadversaries\x18\x01 \x03(\v2,.systems.daggerheart.v1.DaggerheartAdversaryR\vadversaries\"k\n" +
+	"\vadversaries\x18\x01 \x03(\v2,.systems.daggerheart.v1.DaggerheartAdversaryR\vadversaries\"\xd6\x01\n" +
+	" DaggerheartAdversaryFeatureState\x12!\n" +
+	"\fadversary_id\x18\x01 \x01(\tR\vadversaryId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\tR\tfeatureId\x12Q\n" +
+	"\x06status\x18\x03 \x01(\x0e29.systems.daggerheart.v1.DaggerheartAdversaryFeatureStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"refresh_on\x18\x04 \x01(\tR\trefreshOn\"\xdd\x03\n" +
+	"*DaggerheartActivateAdversaryFeatureRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12!\n" +
+	"\fadversary_id\x18\x03 \x01(\tR\vadversaryId\x12,\n" +
+	"\x12adversary_entry_id\x18\x04 \x01(\tR\x10adversaryEntryId\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x05 \x01(\tR\tfeatureId\x128\n" +
+	"\tfear_cost\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\bfearCost\x12<\n" +
+	"\vstress_cost\x18\a \x01(\v2\x1b.google.protobuf.Int32ValueR\n" +
+	"stressCost\x12F\n" +
+	"\x10difficulty_bonus\x18\b \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdifficultyBonus\x12\x1d\n" +
+	"\n" +
+	"target_ids\x18\t \x03(\tR\ttargetIds\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\"\xc3\x02\n" +
+	"+DaggerheartActivateAdversaryFeatureResponse\x12J\n" +
+	"\tadversary\x18\x01 \x01(\v2,.systems.daggerheart.v1.DaggerheartAdversaryR\tadversary\x12\x1d\n" +
+	"\n" +
+	"feature_id\x18\x02 \x01(\tR\tfeatureId\x12$\n" +
+	"\x0egm_fear_before\x18\x03 \x01(\x05R\fgmFearBefore\x12\"\n" +
+	"\rgm_fear_after\x18\x04 \x01(\x05R\vgmFearAfter\x12_\n" +
+	"\x0efeature_states\x18\x05 \x03(\v28.systems.daggerheart.v1.DaggerheartAdversaryFeatureStateR\rfeatureStates\"r\n" +
+	",DaggerheartListAdversaryFeatureStatesRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fadversary_id\x18\x02 \x01(\tR\vadversaryId\"\x90\x01\n" +
+	"-DaggerheartListAdversaryFeatureStatesResponse\x12_\n" +
+	"\x0efeature_states\x18\x01 \x03(\v28.systems.daggerheart.v1.DaggerheartAdversaryFeatureStateR\rfeatureStates\"\x89\x01\n" +
+	"\x19DaggerheartEncounterGroup\x12,\n" +
+	"\x12adversary_entry_id\x18\x01 \x01(\tR\x10adversaryEntryId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x14\n" +
+	"\x05names\x18\x04 \x03(\tR\x05names\"\xc2\x01\n" +
+	"\x1bDaggerheartEncounterOptions\x12!\n" +
+	"\feasier_fight\x18\x01 \x01(\bR\veasierFight\x12!\n" +
+	"\fharder_fight\x18\x02 \x01(\bR\vharderFight\x12!\n" +
+	"\fbonus_damage\x18\x03 \x01(\bR\vbonusDamage\x12:\n" +
+	"\n" +
+	"party_size\x18\x04 \x01(\v2\x1b.google.protobuf.Int32ValueR\tpartySize\"P\n" +
+	"\x1eDaggerheartEncounterAdjustment\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06points\x18\x02 \x01(\x05R\x06points\"\xc4\x01\n" +
+	"\x1dDaggerheartEncounterGroupCost\x12,\n" +
+	"\x12adversary_entry_id\x18\x01 \x01(\tR\x10adversaryEntryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x12\n" +
+	"\x04tier\x18\x04 \x01(\x05R\x04tier\x12\x14\n" +
+	"\x05count\x18\x05 \x01(\x05R\x05count\x12#\n" +
+	"\rbattle_points\x18\x06 \x01(\x05R\fbattlePoints\"\xe1\x02\n" +
+	"\x1aDaggerheartEncounterBudget\x12\x1d\n" +
+	"\n" +
+	"party_size\x18\x01 \x01(\x05R\tpartySize\x12\x1d\n" +
+	"\n" +
+	"party_tier\x18\x02 \x01(\x05R\tpartyTier\x12\x12\n" +
+	"\x04base\x18\x03 \x01(\x05R\x04base\x12X\n" +
+	"\vadjustments\x18\x04 \x03(\v26.systems.daggerheart.v1.DaggerheartEncounterAdjustmentR\vadjustments\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05spent\x18\x06 \x01(\x05R\x05spent\x12\x1c\n" +
+	"\tremaining\x18\a \x01(\x05R\tremaining\x12M\n" +
+	"\x06groups\x18\b \x03(\v25.systems.daggerheart.v1.DaggerheartEncounterGroupCostR\x06groups\"\xdc\x01\n" +
+	"\x1fDaggerheartPlanEncounterRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12I\n" +
+	"\x06groups\x18\x02 \x03(\v21.systems.daggerheart.v1.DaggerheartEncounterGroupR\x06groups\x12M\n" +
+	"\aoptions\x18\x03 \x01(\v23.systems.daggerheart.v1.DaggerheartEncounterOptionsR\aoptions\"n\n" +
+	" DaggerheartPlanEncounterResponse\x12J\n" +
+	"\x06budget\x18\x01 \x01(\v22.systems.daggerheart.v1.DaggerheartEncounterBudgetR\x06budget\"\xfd\x01\n" +
+	"!DaggerheartCommitEncounterRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12I\n" +
+	"\x06groups\x18\x03 \x03(\v21.systems.daggerheart.v1.DaggerheartEncounterGroupR\x06groups\x12M\n" +
+	"\aoptions\x18\x04 \x01(\v23.systems.daggerheart.v1.DaggerheartEncounterOptionsR\aoptions\"\xc0\x01\n" +
+	"\"DaggerheartCommitEncounterResponse\x12J\n" +
+	"\x06budget\x18\x01 \x01(\v22.systems.daggerheart.v1.DaggerheartEncounterBudgetR\x06budget\x12N\n" +
+	"\vadversaries\x18\x02 \x03(\v2,.systems.daggerheart.v1.DaggerheartAdversaryR\vadversaries\"k\n" +
 	"%DaggerheartResolveBlazeOfGloryRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12!\n" +
@@ -7040,7 +14001,7 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"&DaggerheartResolveBlazeOfGloryResponse\x12!\n" +
 	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12G\n" +
 	"\x05state\x18\x02 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\x05state\x12M\n" +
-	"\x06result\x18\x03 \x01(\v25.systems.daggerheart.v1.DaggerheartBlazeOfGloryResultR\x06result\"\xce\x01\n" +
+	"\x06result\x18\x03 \x01(\v25.systems.daggerheart.v1.DaggerheartBlazeOfGloryResultR\x06result\"\xa4\x02\n" +
 	"\x11ActionRollRequest\x12\x1a\n" +
 	"\bmodifier\x18\x01 \x01(\x05R\bmodifier\x12#\n" +
 	"\n" +
@@ -7048,7 +14009,8 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"difficulty\x88\x01\x01\x12\x1c\n" +
 	"\tadvantage\x18\x03 \x01(\x05R\tadvantage\x12\"\n" +
 	"\fdisadvantage\x18\x04 \x01(\x05R\fdisadvantage\x12'\n" +
-	"\x03rng\x18\x05 \x01(\v2\x15.common.v1.RngRequestR\x03rngB\r\n" +
+	"\x03rng\x18\x05 \x01(\v2\x15.common.v1.RngRequestR\x03rng\x12T\n" +
+	"\x11advantage_sources\x18\x06 \x03(\v2'.systems.daggerheart.v1.AdvantageSourceR\x10advantageSourcesB\r\n" +
 	"\v_difficulty\"\x9f\x03\n" +
 	"\x12ActionRollResponse\x12\x12\n" +
 	"\x04hope\x18\x01 \x01(\x05R\x04hope\x12\x12\n" +
@@ -7085,7 +14047,7 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\ais_crit\x18\x06 \x01(\bR\x06isCrit\x12)\n" +
 	"\x10meets_difficulty\x18\a \x01(\bR\x0fmeetsDifficulty\x129\n" +
 	"\aoutcome\x18\b \x01(\x0e2\x1f.systems.daggerheart.v1.OutcomeR\aoutcomeB\r\n" +
-	"\v_difficulty\"\xc2\x01\n" +
+	"\v_difficulty\"\x86\x03\n" +
 	"\x15DualityExplainRequest\x12\x12\n" +
 	"\x04hope\x18\x01 \x01(\x05R\x04hope\x12\x12\n" +
 	"\x04fear\x18\x02 \x01(\x05R\x04fear\x12\x1a\n" +
@@ -7094,7 +14056,10 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"difficulty\x18\x04 \x01(\x05H\x00R\n" +
 	"difficulty\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"request_id\x18\x05 \x01(\tH\x01R\trequestId\x88\x01\x01B\r\n" +
+	"request_id\x18\x05 \x01(\tH\x01R\trequestId\x88\x01\x01\x12T\n" +
+	"\x11advantage_sources\x18\x06 \x03(\v2'.systems.daggerheart.v1.AdvantageSourceR\x10advantageSources\x12\x1b\n" +
+	"\thelp_dice\x18\a \x03(\x05R\bhelpDice\x12O\n" +
+	"\vexperiences\x18\b \x03(\v2-.systems.daggerheart.v1.DaggerheartExperienceR\vexperiencesB\r\n" +
 	"\v_difficultyB\r\n" +
 	"\v_request_id\"\xd2\x03\n" +
 	"\x16DualityExplainResponse\x12\x12\n" +
@@ -7142,7 +14107,7 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\x10RollDiceResponse\x126\n" +
 	"\x05rolls\x18\x01 \x03(\v2 .systems.daggerheart.v1.DiceRollR\x05rolls\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12(\n" +
-	"\x03rng\x18\x03 \x01(\v2\x16.common.v1.RngResponseR\x03rng\"\xf7\x03\n" +
+	"\x03rng\x18\x03 \x01(\v2\x16.common.v1.RngResponseR\x03rng\"\xc7\x05\n" +
 	"\x18SessionActionRollRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12\x1d\n" +
@@ -7162,7 +14127,11 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	" \x01(\bR\n" +
 	"underwater\x12.\n" +
 	"\x13breath_countdown_id\x18\v \x01(\tR\x11breathCountdownId\x12'\n" +
-	"\x03rng\x18\f \x01(\v2\x15.common.v1.RngRequestR\x03rng\"\x92\x02\n" +
+	"\x03rng\x18\f \x01(\v2\x15.common.v1.RngRequestR\x03rng\x120\n" +
+	"\x14helper_character_ids\x18\r \x03(\tR\x12helperCharacterIds\x12 \n" +
+	"\vexperiences\x18\x0e \x03(\tR\vexperiences\x12T\n" +
+	"\x11advantage_sources\x18\x0f \x03(\v2'.systems.daggerheart.v1.AdvantageSourceR\x10advantageSources\x12$\n" +
+	"\x0eextra_fear_die\x18\x10 \x01(\bR\fextraFearDie\"\x9a\x03\n" +
 	"\x19SessionActionRollResponse\x12\x19\n" +
 	"\broll_seq\x18\x01 \x01(\x04R\arollSeq\x12\x19\n" +
 	"\bhope_die\x18\x02 \x01(\x05R\ahopeDie\x12\x19\n" +
@@ -7174,7 +14143,14 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\asuccess\x18\x06 \x01(\bR\asuccess\x12\x16\n" +
 	"\x06flavor\x18\a \x01(\tR\x06flavor\x12\x12\n" +
 	"\x04crit\x18\b \x01(\bR\x04crit\x12(\n" +
-	"\x03rng\x18\t \x01(\v2\x16.common.v1.RngResponseR\x03rng\"\x94\x02\n" +
+	"\x03rng\x18\t \x01(\v2\x16.common.v1.RngResponseR\x03rng\x12\x1f\n" +
+	"\vhelper_dice\x18\n" +
+	" \x03(\x05R\n" +
+	"helperDice\x12\x1d\n" +
+	"\n" +
+	"help_bonus\x18\v \x01(\x05R\thelpBonus\x12)\n" +
+	"\x10experience_bonus\x18\f \x01(\x05R\x0fexperienceBonus\x12\x1b\n" +
+	"\tfear_dice\x18\r \x03(\x05R\bfearDice\"\xb1\x02\n" +
 	"\x18SessionDamageRollRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12\x1d\n" +
@@ -7184,7 +14160,8 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\x04dice\x18\x04 \x03(\v2 .systems.daggerheart.v1.DiceSpecR\x04dice\x12\x1a\n" +
 	"\bmodifier\x18\x05 \x01(\x05R\bmodifier\x12\x1a\n" +
 	"\bcritical\x18\x06 \x01(\bR\bcritical\x12'\n" +
-	"\x03rng\x18\a \x01(\v2\x15.common.v1.RngRequestR\x03rng\"\xac\x02\n" +
+	"\x03rng\x18\a \x01(\v2\x15.common.v1.RngRequestR\x03rng\x12\x1b\n" +
+	"\tweapon_id\x18\b \x01(\tR\bweaponId\"\xc9\x02\n" +
 	"\x19SessionDamageRollResponse\x12\x19\n" +
 	"\broll_seq\x18\x01 \x01(\x04R\arollSeq\x126\n" +
 	"\x05rolls\x18\x02 \x03(\v2 .systems.daggerheart.v1.DiceRollR\x05rolls\x12\x1d\n" +
@@ -7194,7 +14171,8 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\x0ecritical_bonus\x18\x05 \x01(\x05R\rcriticalBonus\x12\x14\n" +
 	"\x05total\x18\x06 \x01(\x05R\x05total\x12\x1a\n" +
 	"\bcritical\x18\a \x01(\bR\bcritical\x12(\n" +
-	"\x03rng\x18\b \x01(\v2\x16.common.v1.RngResponseR\x03rng\"\x8e\x03\n" +
+	"\x03rng\x18\b \x01(\v2\x16.common.v1.RngResponseR\x03rng\x12\x1b\n" +
+	"\tweapon_id\x18\t \x01(\tR\bweaponId\"\x8e\x03\n" +
 	"\x1bDaggerheartAttackDamageSpec\x12N\n" +
 	"\vdamage_type\x18\x01 \x01(\x0e2-.systems.daggerheart.v1.DaggerheartDamageTypeR\n" +
 	"damageType\x12'\n" +
@@ -7205,7 +14183,7 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\x06direct\x18\x06 \x01(\bR\x06direct\x12%\n" +
 	"\x0emassive_damage\x18\a \x01(\bR\rmassiveDamage\x12\x16\n" +
 	"\x06source\x18\b \x01(\tR\x06source\x120\n" +
-	"\x14source_character_ids\x18\t \x03(\tR\x12sourceCharacterIds\"\xe8\x05\n" +
+	"\x14source_character_ids\x18\t \x03(\tR\x12sourceCharacterIds\"\xf4\x06\n" +
 	"\x18SessionAttackFlowRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12\x1d\n" +
@@ -7232,7 +14210,13 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\n" +
 	"action_rng\x18\x0f \x01(\v2\x15.common.v1.RngRequestR\tactionRng\x124\n" +
 	"\n" +
-	"damage_rng\x18\x10 \x01(\v2\x15.common.v1.RngRequestR\tdamageRng\"\xdd\x03\n" +
+	"damage_rng\x18\x10 \x01(\v2\x15.common.v1.RngRequestR\tdamageRng\x12\x1b\n" +
+	"\tweapon_id\x18\x11 \x01(\tR\bweaponId\x12M\n" +
+	"\vweapon_slot\x18\x12 \x01(\x0e2,.systems.daggerheart.v1.DaggerheartEquipSlotR\n" +
+	"weaponSlot\x12\x1e\n" +
+	"\n" +
+	"improvised\x18\x13 \x01(\bR\n" +
+	"improvised\"\xfa\x03\n" +
 	"\x19SessionAttackFlowResponse\x12R\n" +
 	"\vaction_roll\x18\x01 \x01(\v21.systems.daggerheart.v1.SessionActionRollResponseR\n" +
 	"actionRoll\x12S\n" +
@@ -7240,7 +14224,42 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\x0eattack_outcome\x18\x03 \x01(\v2=.systems.daggerheart.v1.DaggerheartApplyAttackOutcomeResponseR\rattackOutcome\x12R\n" +
 	"\vdamage_roll\x18\x04 \x01(\v21.systems.daggerheart.v1.SessionDamageRollResponseR\n" +
 	"damageRoll\x12]\n" +
-	"\x0edamage_applied\x18\x05 \x01(\v26.systems.daggerheart.v1.DaggerheartApplyDamageResponseR\rdamageApplied\"\xb9\x02\n" +
+	"\x0edamage_applied\x18\x05 \x01(\v26.systems.daggerheart.v1.DaggerheartApplyDamageResponseR\rdamageApplied\x12\x1b\n" +
+	"\tweapon_id\x18\x06 \x01(\tR\bweaponId\"\xe8\x04\n" +
+	"\x1bSessionSpellcastFlowRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12!\n" +
+	"\fcharacter_id\x18\x03 \x01(\tR\vcharacterId\x12$\n" +
+	"\x0edomain_card_id\x18\x04 \x01(\tR\fdomainCardId\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x05 \x01(\x05R\n" +
+	"difficulty\x12\x1b\n" +
+	"\thope_cost\x18\x06 \x01(\x05R\bhopeCost\x12H\n" +
+	"\tmodifiers\x18\a \x03(\v2*.systems.daggerheart.v1.ActionRollModifierR\tmodifiers\x12T\n" +
+	"\x11advantage_sources\x18\b \x03(\v2'.systems.daggerheart.v1.AdvantageSourceR\x10advantageSources\x12$\n" +
+	"\x0eextra_fear_die\x18\t \x01(\bR\fextraFearDie\x12(\n" +
+	"\x10damage_die_sides\x18\n" +
+	" \x01(\x05R\x0edamageDieSides\x12'\n" +
+	"\x0fdamage_modifier\x18\v \x01(\x05R\x0edamageModifier\x124\n" +
+	"\n" +
+	"action_rng\x18\f \x01(\v2\x15.common.v1.RngRequestR\tactionRng\x124\n" +
+	"\n" +
+	"damage_rng\x18\r \x01(\v2\x15.common.v1.RngRequestR\tdamageRng\"\xa5\x03\n" +
+	"\x1cSessionSpellcastFlowResponse\x12R\n" +
+	"\vaction_roll\x18\x01 \x01(\v21.systems.daggerheart.v1.SessionActionRollResponseR\n" +
+	"actionRoll\x12S\n" +
+	"\froll_outcome\x18\x02 \x01(\v20.systems.daggerheart.v1.ApplyRollOutcomeResponseR\vrollOutcome\x12'\n" +
+	"\x0fspellcast_trait\x18\x03 \x01(\tR\x0espellcastTrait\x12\x1f\n" +
+	"\vtrait_value\x18\x04 \x01(\x05R\n" +
+	"traitValue\x12\x1f\n" +
+	"\vsubclass_id\x18\x05 \x01(\tR\n" +
+	"subclassId\x12\x1d\n" +
+	"\n" +
+	"hope_spent\x18\x06 \x01(\x05R\thopeSpent\x12R\n" +
+	"\vdamage_roll\x18\a \x01(\v21.systems.daggerheart.v1.SessionDamageRollResponseR\n" +
+	"damageRoll\"\xb9\x02\n" +
 	"\x1aSessionReactionFlowRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12\x1d\n" +
@@ -7293,7 +14312,7 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\x04roll\x18\x02 \x01(\x05R\x04roll\x12\x14\n" +
 	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x14\n" +
 	"\x05rolls\x18\x04 \x03(\x05R\x05rolls\x12(\n" +
-	"\x03rng\x18\x05 \x01(\v2\x16.common.v1.RngResponseR\x03rng\"\xac\x05\n" +
+	"\x03rng\x18\x05 \x01(\v2\x16.common.v1.RngResponseR\x03rng\"\xdc\x05\n" +
 	"!SessionAdversaryAttackFlowRequest\x12\x1f\n" +
 	"\vcampaign_id\x18\x01 \x01(\tR\n" +
 	"campaignId\x12\x1d\n" +
@@ -7317,14 +14336,88 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\n" +
 	"attack_rng\x18\x0e \x01(\v2\x15.common.v1.RngRequestR\tattackRng\x124\n" +
 	"\n" +
-	"damage_rng\x18\x0f \x01(\v2\x15.common.v1.RngRequestR\tdamageRng\"\xa3\x03\n" +
+	"damage_rng\x18\x0f \x01(\v2\x15.common.v1.RngRequestR\tdamageRng\x12.\n" +
+	"\x13group_adversary_ids\x18\x10 \x03(\tR\x11groupAdversaryIds\"\xd3\x03\n" +
 	"\"SessionAdversaryAttackFlowResponse\x12[\n" +
 	"\vattack_roll\x18\x01 \x01(\v2:.systems.daggerheart.v1.SessionAdversaryAttackRollResponseR\n" +
 	"attackRoll\x12m\n" +
 	"\x0eattack_outcome\x18\x02 \x01(\v2F.systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeResponseR\rattackOutcome\x12R\n" +
 	"\vdamage_roll\x18\x03 \x01(\v21.systems.daggerheart.v1.SessionDamageRollResponseR\n" +
 	"damageRoll\x12]\n" +
-	"\x0edamage_applied\x18\x04 \x01(\v26.systems.daggerheart.v1.DaggerheartApplyDamageResponseR\rdamageApplied\"\xc2\x01\n" +
+	"\x0edamage_applied\x18\x04 \x01(\v26.systems.daggerheart.v1.DaggerheartApplyDamageResponseR\rdamageApplied\x12.\n" +
+	"\x13group_adversary_ids\x18\x05 \x03(\tR\x11groupAdversaryIds\"\x92\x01\n" +
+	"\x11MultiAttackTarget\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12;\n" +
+	"\n" +
+	"difficulty\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\n" +
+	"difficulty\x12#\n" +
+	"\rdecline_armor\x18\x03 \x01(\bR\fdeclineArmor\"\xc7\x01\n" +
+	"\x17MultiAttackTargetResult\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12\x1f\n" +
+	"\vtarget_type\x18\x02 \x01(\tR\n" +
+	"targetType\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x03 \x01(\x05R\n" +
+	"difficulty\x12\x10\n" +
+	"\x03hit\x18\x04 \x01(\bR\x03hit\x12\x1b\n" +
+	"\thp_marked\x18\x05 \x01(\x05R\bhpMarked\x12\x1f\n" +
+	"\varmor_spent\x18\x06 \x01(\x05R\n" +
+	"armorSpent\"\x92\x05\n" +
+	"\x1dSessionMultiAttackFlowRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12!\n" +
+	"\fcharacter_id\x18\x03 \x01(\tR\vcharacterId\x12\x14\n" +
+	"\x05trait\x18\x04 \x01(\tR\x05trait\x12H\n" +
+	"\tmodifiers\x18\x05 \x03(\v2*.systems.daggerheart.v1.ActionRollModifierR\tmodifiers\x12C\n" +
+	"\atargets\x18\x06 \x03(\v2).systems.daggerheart.v1.MultiAttackTargetR\atargets\x12A\n" +
+	"\vdamage_dice\x18\a \x03(\v2 .systems.daggerheart.v1.DiceSpecR\n" +
+	"damageDice\x12'\n" +
+	"\x0fdamage_modifier\x18\b \x01(\x05R\x0edamageModifier\x12K\n" +
+	"\x06damage\x18\t \x01(\v23.systems.daggerheart.v1.DaggerheartAttackDamageSpecR\x06damage\x12'\n" +
+	"\x0fdamage_critical\x18\n" +
+	" \x01(\bR\x0edamageCritical\x124\n" +
+	"\n" +
+	"action_rng\x18\v \x01(\v2\x15.common.v1.RngRequestR\tactionRng\x124\n" +
+	"\n" +
+	"damage_rng\x18\f \x01(\v2\x15.common.v1.RngRequestR\tdamageRng\x12\x1b\n" +
+	"\tweapon_id\x18\r \x01(\tR\bweaponId\"\xe8\x02\n" +
+	"\x1eSessionMultiAttackFlowResponse\x12R\n" +
+	"\vaction_roll\x18\x01 \x01(\v21.systems.daggerheart.v1.SessionActionRollResponseR\n" +
+	"actionRoll\x12S\n" +
+	"\froll_outcome\x18\x02 \x01(\v20.systems.daggerheart.v1.ApplyRollOutcomeResponseR\vrollOutcome\x12R\n" +
+	"\vdamage_roll\x18\x03 \x01(\v21.systems.daggerheart.v1.SessionDamageRollResponseR\n" +
+	"damageRoll\x12I\n" +
+	"\aresults\x18\x04 \x03(\v2/.systems.daggerheart.v1.MultiAttackTargetResultR\aresults\"\xaa\x05\n" +
+	"&SessionAdversaryMultiAttackFlowRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12!\n" +
+	"\fadversary_id\x18\x03 \x01(\tR\vadversaryId\x12C\n" +
+	"\atargets\x18\x04 \x03(\v2).systems.daggerheart.v1.MultiAttackTargetR\atargets\x12'\n" +
+	"\x0fattack_modifier\x18\x05 \x01(\x05R\x0eattackModifier\x12\x1c\n" +
+	"\tadvantage\x18\x06 \x01(\x05R\tadvantage\x12\"\n" +
+	"\fdisadvantage\x18\a \x01(\x05R\fdisadvantage\x12A\n" +
+	"\vdamage_dice\x18\b \x03(\v2 .systems.daggerheart.v1.DiceSpecR\n" +
+	"damageDice\x12'\n" +
+	"\x0fdamage_modifier\x18\t \x01(\x05R\x0edamageModifier\x12K\n" +
+	"\x06damage\x18\n" +
+	" \x01(\v23.systems.daggerheart.v1.DaggerheartAttackDamageSpecR\x06damage\x12'\n" +
+	"\x0fdamage_critical\x18\v \x01(\bR\x0edamageCritical\x124\n" +
+	"\n" +
+	"attack_rng\x18\f \x01(\v2\x15.common.v1.RngRequestR\tattackRng\x124\n" +
+	"\n" +
+	"damage_rng\x18\r \x01(\v2\x15.common.v1.RngRequestR\tdamageRng\x12\x1f\n" +
+	"\vstress_cost\x18\x0e \x01(\x05R\n" +
+	"stressCost\"\xa5\x02\n" +
+	"'SessionAdversaryMultiAttackFlowResponse\x12[\n" +
+	"\vattack_roll\x18\x01 \x01(\v2:.systems.daggerheart.v1.SessionAdversaryAttackRollResponseR\n" +
+	"attackRoll\x12R\n" +
+	"\vdamage_roll\x18\x02 \x01(\v21.systems.daggerheart.v1.SessionDamageRollResponseR\n" +
+	"damageRoll\x12I\n" +
+	"\aresults\x18\x03 \x03(\v2/.systems.daggerheart.v1.MultiAttackTargetResultR\aresults\"\xc2\x01\n" +
 	"\x14GroupActionSupporter\x12!\n" +
 	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x14\n" +
 	"\x05trait\x18\x02 \x01(\tR\x05trait\x12H\n" +
@@ -7391,12 +14484,13 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\x18ApplyRollOutcomeResponse\x12\x19\n" +
 	"\broll_seq\x18\x01 \x01(\x04R\arollSeq\x123\n" +
 	"\x15requires_complication\x18\x02 \x01(\bR\x14requiresComplication\x12@\n" +
-	"\aupdated\x18\x03 \x01(\v2&.systems.daggerheart.v1.OutcomeUpdatedR\aupdated\"z\n" +
+	"\aupdated\x18\x03 \x01(\v2&.systems.daggerheart.v1.OutcomeUpdatedR\aupdated\"\x97\x01\n" +
 	"$DaggerheartApplyAttackOutcomeRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x19\n" +
 	"\broll_seq\x18\x02 \x01(\x04R\arollSeq\x12\x18\n" +
-	"\atargets\x18\x03 \x03(\tR\atargets\"\xa3\x01\n" +
+	"\atargets\x18\x03 \x03(\tR\atargets\x12\x1b\n" +
+	"\tweapon_id\x18\x04 \x01(\tR\bweaponId\"\xa3\x01\n" +
 	"-DaggerheartApplyAdversaryAttackOutcomeRequest\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x19\n" +
@@ -7441,7 +14535,188 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"'DaggerheartApplyReactionOutcomeResponse\x12\x19\n" +
 	"\broll_seq\x18\x01 \x01(\x04R\arollSeq\x12!\n" +
 	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12P\n" +
-	"\x06result\x18\x03 \x01(\v28.systems.daggerheart.v1.DaggerheartReactionOutcomeResultR\x06result*\x9b\x01\n" +
+	"\x06result\x18\x03 \x01(\v28.systems.daggerheart.v1.DaggerheartReactionOutcomeResultR\x06result\"\x99\x02\n" +
+	"\x16DaggerheartAdvancement\x12F\n" +
+	"\x04type\x18\x01 \x01(\x0e22.systems.daggerheart.v1.DaggerheartAdvancementTypeR\x04type\x12\x16\n" +
+	"\x06traits\x18\x02 \x03(\tR\x06traits\x12 \n" +
+	"\vexperiences\x18\x03 \x03(\tR\vexperiences\x12$\n" +
+	"\x0edomain_card_id\x18\x04 \x01(\tR\fdomainCardId\x12\x1f\n" +
+	"\vsubclass_id\x18\x05 \x01(\tR\n" +
+	"subclassId\x12\x19\n" +
+	"\bclass_id\x18\x06 \x01(\tR\aclassId\x12\x1b\n" +
+	"\tdomain_id\x18\a \x01(\tR\bdomainId\"\x80\x02\n" +
+	"\x19DaggerheartLevelUpRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12R\n" +
+	"\fadvancements\x18\x03 \x03(\v2..systems.daggerheart.v1.DaggerheartAdvancementR\fadvancements\x12%\n" +
+	"\x0enew_experience\x18\x04 \x01(\tR\rnewExperience\x12$\n" +
+	"\x0edomain_card_id\x18\x05 \x01(\tR\fdomainCardId\"\xf8\x01\n" +
+	"\x1aDaggerheartLevelUpResponse\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\x05R\x04tier\x12D\n" +
+	"\aprofile\x18\x04 \x01(\v2*.systems.daggerheart.v1.DaggerheartProfileR\aprofile\x12G\n" +
+	"\x05state\x18\x05 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\x05state\"\xfa\x01\n" +
+	"\x1dDaggerheartAcquireItemRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\tR\x06itemId\x12H\n" +
+	"\x04kind\x18\x04 \x01(\x0e24.systems.daggerheart.v1.DaggerheartInventoryItemKindR\x04kind\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\"\x8c\x01\n" +
+	"\x1eDaggerheartAcquireItemResponse\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12G\n" +
+	"\x05state\x18\x02 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\x05state\"\xad\x01\n" +
+	"\x1aDaggerheartDropItemRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x89\x01\n" +
+	"\x1bDaggerheartDropItemResponse\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12G\n" +
+	"\x05state\x18\x02 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\x05state\"\xca\x01\n" +
+	"\x1eDaggerheartTransferItemRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12*\n" +
+	"\x11from_character_id\x18\x02 \x01(\tR\x0ffromCharacterId\x12&\n" +
+	"\x0fto_character_id\x18\x03 \x01(\tR\rtoCharacterId\x12\x17\n" +
+	"\aitem_id\x18\x04 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\"\xc1\x01\n" +
+	"\x1fDaggerheartTransferItemResponse\x12P\n" +
+	"\n" +
+	"from_state\x18\x01 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\tfromState\x12L\n" +
+	"\bto_state\x18\x02 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\atoState\"\xbc\x01\n" +
+	"\x1bDaggerheartEquipItemRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\tR\x06itemId\x12@\n" +
+	"\x04slot\x18\x04 \x01(\x0e2,.systems.daggerheart.v1.DaggerheartEquipSlotR\x04slot\"\xd0\x01\n" +
+	"\x1cDaggerheartEquipItemResponse\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12D\n" +
+	"\aprofile\x18\x02 \x01(\v2*.systems.daggerheart.v1.DaggerheartProfileR\aprofile\x12G\n" +
+	"\x05state\x18\x03 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\x05state\"\xbd\x01\n" +
+	"\x1dDaggerheartUnequipItemRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12@\n" +
+	"\x04slot\x18\x03 \x01(\x0e2,.systems.daggerheart.v1.DaggerheartEquipSlotR\x04slot\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xd2\x01\n" +
+	"\x1eDaggerheartUnequipItemResponse\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12D\n" +
+	"\aprofile\x18\x02 \x01(\v2*.systems.daggerheart.v1.DaggerheartProfileR\aprofile\x12G\n" +
+	"\x05state\x18\x03 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\x05state\"\xc2\x01\n" +
+	"\x1cDaggerheartUpdateGoldRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x1a\n" +
+	"\bhandfuls\x18\x03 \x01(\x05R\bhandfuls\x12\x12\n" +
+	"\x04bags\x18\x04 \x01(\x05R\x04bags\x12\x16\n" +
+	"\x06chests\x18\x05 \x01(\x05R\x06chests\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\x8b\x01\n" +
+	"\x1dDaggerheartUpdateGoldResponse\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12G\n" +
+	"\x05state\x18\x02 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\x05state\"\xa2\x01\n" +
+	"!DaggerheartCreateCompanionRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12%\n" +
+	"\x0eexperience_ids\x18\x04 \x03(\tR\rexperienceIds\"\x93\x01\n" +
+	"\"DaggerheartCreateCompanionResponse\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12J\n" +
+	"\tcompanion\x18\x02 \x01(\v2,.systems.daggerheart.v1.DaggerheartCompanionR\tcompanion\"\x81\x02\n" +
+	"\"DaggerheartLevelUpCompanionRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12M\n" +
+	"\aupgrade\x18\x03 \x01(\x0e23.systems.daggerheart.v1.DaggerheartCompanionUpgradeR\aupgrade\x12#\n" +
+	"\rexperience_id\x18\x04 \x01(\tR\fexperienceId\x12#\n" +
+	"\rvicious_range\x18\x05 \x01(\bR\fviciousRange\"\x94\x01\n" +
+	"#DaggerheartLevelUpCompanionResponse\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12J\n" +
+	"\tcompanion\x18\x02 \x01(\v2,.systems.daggerheart.v1.DaggerheartCompanionR\tcompanion\"\x9b\x01\n" +
+	"'DaggerheartUpdateCompanionStressRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x05R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x99\x01\n" +
+	"(DaggerheartUpdateCompanionStressResponse\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12J\n" +
+	"\tcompanion\x18\x02 \x01(\v2,.systems.daggerheart.v1.DaggerheartCompanionR\tcompanion\"\xe7\x03\n" +
+	"!SessionCompanionAttackFlowRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12!\n" +
+	"\fcharacter_id\x18\x03 \x01(\tR\vcharacterId\x12\x14\n" +
+	"\x05trait\x18\x04 \x01(\tR\x05trait\x12A\n" +
+	"\x06target\x18\x05 \x01(\v2).systems.daggerheart.v1.MultiAttackTargetR\x06target\x12H\n" +
+	"\tmodifiers\x18\x06 \x03(\v2*.systems.daggerheart.v1.ActionRollModifierR\tmodifiers\x12'\n" +
+	"\x0fdamage_modifier\x18\a \x01(\x05R\x0edamageModifier\x12'\n" +
+	"\x0fdamage_critical\x18\b \x01(\bR\x0edamageCritical\x124\n" +
+	"\n" +
+	"action_rng\x18\t \x01(\v2\x15.common.v1.RngRequestR\tactionRng\x124\n" +
+	"\n" +
+	"damage_rng\x18\n" +
+	" \x01(\v2\x15.common.v1.RngRequestR\tdamageRng\"\xea\x02\n" +
+	"\"SessionCompanionAttackFlowResponse\x12R\n" +
+	"\vaction_roll\x18\x01 \x01(\v21.systems.daggerheart.v1.SessionActionRollResponseR\n" +
+	"actionRoll\x12S\n" +
+	"\froll_outcome\x18\x02 \x01(\v20.systems.daggerheart.v1.ApplyRollOutcomeResponseR\vrollOutcome\x12R\n" +
+	"\vdamage_roll\x18\x03 \x01(\v21.systems.daggerheart.v1.SessionDamageRollResponseR\n" +
+	"damageRoll\x12G\n" +
+	"\x06result\x18\x04 \x01(\v2/.systems.daggerheart.v1.MultiAttackTargetResultR\x06result\"\x89\x01\n" +
+	" DaggerheartEnterBeastformRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12!\n" +
+	"\fbeastform_id\x18\x03 \x01(\tR\vbeastformId\"\xa9\x01\n" +
+	"!DaggerheartEnterBeastformResponse\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12G\n" +
+	"\x05state\x18\x02 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\x05state\x12\x18\n" +
+	"\aevasion\x18\x03 \x01(\x05R\aevasion\"}\n" +
+	"\x1fDaggerheartExitBeastformRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12!\n" +
+	"\fcharacter_id\x18\x02 \x01(\tR\vcharacterId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x8e\x01\n" +
+	" DaggerheartExitBeastformResponse\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\tR\vcharacterId\x12G\n" +
+	"\x05state\x18\x02 \x01(\v21.systems.daggerheart.v1.DaggerheartCharacterStateR\x05state\"\xe0\x03\n" +
+	"\x1dDaggerheartApplyEffectRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1b\n" +
+	"\teffect_id\x18\x02 \x01(\tR\beffectId\x12T\n" +
+	"\vtarget_type\x18\x03 \x01(\x0e23.systems.daggerheart.v1.DaggerheartEffectTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x04 \x01(\tR\btargetId\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12A\n" +
+	"\x04kind\x18\x06 \x01(\x0e2-.systems.daggerheart.v1.DaggerheartEffectKindR\x04kind\x12\x14\n" +
+	"\x05value\x18\a \x01(\x05R\x05value\x12M\n" +
+	"\bstacking\x18\b \x01(\x0e21.systems.daggerheart.v1.DaggerheartEffectStackingR\bstacking\x12N\n" +
+	"\n" +
+	"expires_on\x18\t \x01(\x0e2/.systems.daggerheart.v1.DaggerheartEffectExpiryR\texpiresOn\"\x93\x01\n" +
+	"\x1eDaggerheartApplyEffectResponse\x12A\n" +
+	"\x06effect\x18\x01 \x01(\v2).systems.daggerheart.v1.DaggerheartEffectR\x06effect\x12.\n" +
+	"\x13replaced_effect_ids\x18\x02 \x03(\tR\x11replacedEffectIds\"^\n" +
+	"\x1eDaggerheartRemoveEffectRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1b\n" +
+	"\teffect_id\x18\x02 \x01(\tR\beffectId\">\n" +
+	"\x1fDaggerheartRemoveEffectResponse\x12\x1b\n" +
+	"\teffect_id\x18\x01 \x01(\tR\beffectId\"]\n" +
+	"\x1dDaggerheartListEffectsRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\tR\n" +
+	"campaignId\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\"e\n" +
+	"\x1eDaggerheartListEffectsResponse\x12C\n" +
+	"\aeffects\x18\x01 \x03(\v2).systems.daggerheart.v1.DaggerheartEffectR\aeffects*\x9b\x01\n" +
 	"\x18DaggerheartCountdownKind\x12*\n" +
 	"&DAGGERHEART_COUNTDOWN_KIND_UNSPECIFIED\x10\x00\x12'\n" +
 	"#DAGGERHEART_COUNTDOWN_KIND_PROGRESS\x10\x01\x12*\n" +
@@ -7449,11 +14724,63 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\x1dDaggerheartCountdownDirection\x12/\n" +
 	"+DAGGERHEART_COUNTDOWN_DIRECTION_UNSPECIFIED\x10\x00\x12,\n" +
 	"(DAGGERHEART_COUNTDOWN_DIRECTION_INCREASE\x10\x01\x12,\n" +
-	"(DAGGERHEART_COUNTDOWN_DIRECTION_DECREASE\x10\x02*S\n" +
+	"(DAGGERHEART_COUNTDOWN_DIRECTION_DECREASE\x10\x02*\x81\x02\n" +
+	"\x1bDaggerheartCountdownTrigger\x12-\n" +
+	")DAGGERHEART_COUNTDOWN_TRIGGER_UNSPECIFIED\x10\x00\x12-\n" +
+	")DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL\x10\x01\x12&\n" +
+	"\"DAGGERHEART_COUNTDOWN_TRIGGER_REST\x10\x02\x12,\n" +
+	"(DAGGERHEART_COUNTDOWN_TRIGGER_FEAR_SPENT\x10\x03\x12.\n" +
+	"*DAGGERHEART_COUNTDOWN_TRIGGER_MAJOR_DAMAGE\x10\x04*\x8e\x01\n" +
+	"\x16DaggerheartChaseStatus\x12(\n" +
+	"$DAGGERHEART_CHASE_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fDAGGERHEART_CHASE_STATUS_ACTIVE\x10\x01\x12%\n" +
+	"!DAGGERHEART_CHASE_STATUS_RESOLVED\x10\x02*\x93\x01\n" +
+	"\x16DaggerheartChaseWinner\x12(\n" +
+	"$DAGGERHEART_CHASE_WINNER_UNSPECIFIED\x10\x00\x12%\n" +
+	"!DAGGERHEART_CHASE_WINNER_PROGRESS\x10\x01\x12(\n" +
+	"$DAGGERHEART_CHASE_WINNER_CONSEQUENCE\x10\x02*\x97\x02\n" +
+	"\x14DaggerheartRangeBand\x12&\n" +
+	"\"DAGGERHEART_RANGE_BAND_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cDAGGERHEART_RANGE_BAND_MELEE\x10\x01\x12%\n" +
+	"!DAGGERHEART_RANGE_BAND_VERY_CLOSE\x10\x02\x12 \n" +
+	"\x1cDAGGERHEART_RANGE_BAND_CLOSE\x10\x03\x12\x1e\n" +
+	"\x1aDAGGERHEART_RANGE_BAND_FAR\x10\x04\x12#\n" +
+	"\x1fDAGGERHEART_RANGE_BAND_VERY_FAR\x10\x05\x12'\n" +
+	"#DAGGERHEART_RANGE_BAND_OUT_OF_RANGE\x10\x06*\xcd\x01\n" +
+	"\x1aDaggerheartSceneEntityType\x12-\n" +
+	")DAGGERHEART_SCENE_ENTITY_TYPE_UNSPECIFIED\x10\x00\x12+\n" +
+	"'DAGGERHEART_SCENE_ENTITY_TYPE_CHARACTER\x10\x01\x12+\n" +
+	"'DAGGERHEART_SCENE_ENTITY_TYPE_ADVERSARY\x10\x02\x12&\n" +
+	"\"DAGGERHEART_SCENE_ENTITY_TYPE_ZONE\x10\x03*\xdf\x01\n" +
+	"\x17DaggerheartMovementKind\x12)\n" +
+	"%DAGGERHEART_MOVEMENT_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eDAGGERHEART_MOVEMENT_KIND_MOVE\x10\x01\x12'\n" +
+	"#DAGGERHEART_MOVEMENT_KIND_KNOCKBACK\x10\x02\x12&\n" +
+	"\"DAGGERHEART_MOVEMENT_KIND_TELEPORT\x10\x03\x12$\n" +
+	" DAGGERHEART_MOVEMENT_KIND_FORCED\x10\x04*\xbd\x01\n" +
+	"!DaggerheartEnvironmentFeatureKind\x124\n" +
+	"0DAGGERHEART_ENVIRONMENT_FEATURE_KIND_UNSPECIFIED\x10\x00\x12/\n" +
+	"+DAGGERHEART_ENVIRONMENT_FEATURE_KIND_ACTION\x10\x01\x121\n" +
+	"-DAGGERHEART_ENVIRONMENT_FEATURE_KIND_REACTION\x10\x02*\xb9\x01\n" +
+	"!DaggerheartAdversaryFeatureStatus\x124\n" +
+	"0DAGGERHEART_ADVERSARY_FEATURE_STATUS_UNSPECIFIED\x10\x00\x12.\n" +
+	"*DAGGERHEART_ADVERSARY_FEATURE_STATUS_READY\x10\x01\x12.\n" +
+	"*DAGGERHEART_ADVERSARY_FEATURE_STATUS_SPENT\x10\x02*S\n" +
 	"\bRollKind\x12\x19\n" +
 	"\x15ROLL_KIND_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10ROLL_KIND_ACTION\x10\x01\x12\x16\n" +
-	"\x12ROLL_KIND_REACTION\x10\x022\xeb&\n" +
+	"\x12ROLL_KIND_REACTION\x10\x02*\xdf\x03\n" +
+	"\x1aDaggerheartAdvancementType\x12,\n" +
+	"(DAGGERHEART_ADVANCEMENT_TYPE_UNSPECIFIED\x10\x00\x12'\n" +
+	"#DAGGERHEART_ADVANCEMENT_TYPE_TRAITS\x10\x01\x12(\n" +
+	"$DAGGERHEART_ADVANCEMENT_TYPE_HP_SLOT\x10\x02\x12,\n" +
+	"(DAGGERHEART_ADVANCEMENT_TYPE_STRESS_SLOT\x10\x03\x12,\n" +
+	"(DAGGERHEART_ADVANCEMENT_TYPE_EXPERIENCES\x10\x04\x12,\n" +
+	"(DAGGERHEART_ADVANCEMENT_TYPE_DOMAIN_CARD\x10\x05\x12(\n" +
+	"$DAGGERHEART_ADVANCEMENT_TYPE_EVASION\x10\x06\x121\n" +
+	"-DAGGERHEART_ADVANCEMENT_TYPE_SUBCLASS_UPGRADE\x10\a\x12,\n" +
+	"(DAGGERHEART_ADVANCEMENT_TYPE_PROFICIENCY\x10\b\x12+\n" +
+	"'DAGGERHEART_ADVANCEMENT_TYPE_MULTICLASS\x10\t2\xe8K\n" +
 	"\x12DaggerheartService\x12c\n" +
 	"\n" +
 	"ActionRoll\x12).systems.daggerheart.v1.ActionRollRequest\x1a*.systems.daggerheart.v1.ActionRollResponse\x12o\n" +
@@ -7473,26 +14800,63 @@ const file_systems_daggerheart_v1_service_proto_rawDesc = "" +
 	"\vApplyGmMove\x125.systems.daggerheart.v1.DaggerheartApplyGmMoveRequest\x1a6.systems.daggerheart.v1.DaggerheartApplyGmMoveResponse\x12\x88\x01\n" +
 	"\x0fCreateCountdown\x129.systems.daggerheart.v1.DaggerheartCreateCountdownRequest\x1a:.systems.daggerheart.v1.DaggerheartCreateCountdownResponse\x12\x88\x01\n" +
 	"\x0fUpdateCountdown\x129.systems.daggerheart.v1.DaggerheartUpdateCountdownRequest\x1a:.systems.daggerheart.v1.DaggerheartUpdateCountdownResponse\x12\x88\x01\n" +
-	"\x0fDeleteCountdown\x129.systems.daggerheart.v1.DaggerheartDeleteCountdownRequest\x1a:.systems.daggerheart.v1.DaggerheartDeleteCountdownResponse\x12\x88\x01\n" +
+	"\x0fDeleteCountdown\x129.systems.daggerheart.v1.DaggerheartDeleteCountdownRequest\x1a:.systems.daggerheart.v1.DaggerheartDeleteCountdownResponse\x12y\n" +
+	"\n" +
+	"StartChase\x124.systems.daggerheart.v1.DaggerheartStartChaseRequest\x1a5.systems.daggerheart.v1.DaggerheartStartChaseResponse\x12s\n" +
+	"\bGetChase\x122.systems.daggerheart.v1.DaggerheartGetChaseRequest\x1a3.systems.daggerheart.v1.DaggerheartGetChaseResponse\x12y\n" +
+	"\n" +
+	"ListChases\x124.systems.daggerheart.v1.DaggerheartListChasesRequest\x1a5.systems.daggerheart.v1.DaggerheartListChasesResponse\x12\x85\x01\n" +
+	"\x0eSetSceneRanges\x128.systems.daggerheart.v1.DaggerheartSetSceneRangesRequest\x1a9.systems.daggerheart.v1.DaggerheartSetSceneRangesResponse\x12\x88\x01\n" +
+	"\x0fMoveSceneEntity\x129.systems.daggerheart.v1.DaggerheartMoveSceneEntityRequest\x1a:.systems.daggerheart.v1.DaggerheartMoveSceneEntityResponse\x12\x88\x01\n" +
+	"\x0fListSceneRanges\x129.systems.daggerheart.v1.DaggerheartListSceneRangesRequest\x1a:.systems.daggerheart.v1.DaggerheartListSceneRangesResponse\x12\xa3\x01\n" +
+	"\x18CreateSessionEnvironment\x12B.systems.daggerheart.v1.DaggerheartCreateSessionEnvironmentRequest\x1aC.systems.daggerheart.v1.DaggerheartCreateSessionEnvironmentResponse\x12\xa0\x01\n" +
+	"\x17ShiftSessionEnvironment\x12A.systems.daggerheart.v1.DaggerheartShiftSessionEnvironmentRequest\x1aB.systems.daggerheart.v1.DaggerheartShiftSessionEnvironmentResponse\x12\xa0\x01\n" +
+	"\x17ClearSessionEnvironment\x12A.systems.daggerheart.v1.DaggerheartClearSessionEnvironmentRequest\x1aB.systems.daggerheart.v1.DaggerheartClearSessionEnvironmentResponse\x12\x9a\x01\n" +
+	"\x15GetSessionEnvironment\x12?.systems.daggerheart.v1.DaggerheartGetSessionEnvironmentRequest\x1a@.systems.daggerheart.v1.DaggerheartGetSessionEnvironmentResponse\x12\xa6\x01\n" +
+	"\x19TriggerEnvironmentFeature\x12C.systems.daggerheart.v1.DaggerheartTriggerEnvironmentFeatureRequest\x1aD.systems.daggerheart.v1.DaggerheartTriggerEnvironmentFeatureResponse\x12\x88\x01\n" +
 	"\x0fCreateAdversary\x129.systems.daggerheart.v1.DaggerheartCreateAdversaryRequest\x1a:.systems.daggerheart.v1.DaggerheartCreateAdversaryResponse\x12\x88\x01\n" +
 	"\x0fUpdateAdversary\x129.systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest\x1a:.systems.daggerheart.v1.DaggerheartUpdateAdversaryResponse\x12\x88\x01\n" +
 	"\x0fDeleteAdversary\x129.systems.daggerheart.v1.DaggerheartDeleteAdversaryRequest\x1a:.systems.daggerheart.v1.DaggerheartDeleteAdversaryResponse\x12\x7f\n" +
 	"\fGetAdversary\x126.systems.daggerheart.v1.DaggerheartGetAdversaryRequest\x1a7.systems.daggerheart.v1.DaggerheartGetAdversaryResponse\x12\x88\x01\n" +
-	"\x0fListAdversaries\x129.systems.daggerheart.v1.DaggerheartListAdversariesRequest\x1a:.systems.daggerheart.v1.DaggerheartListAdversariesResponse\x12\x94\x01\n" +
+	"\x0fListAdversaries\x129.systems.daggerheart.v1.DaggerheartListAdversariesRequest\x1a:.systems.daggerheart.v1.DaggerheartListAdversariesResponse\x12\xa3\x01\n" +
+	"\x18ActivateAdversaryFeature\x12B.systems.daggerheart.v1.DaggerheartActivateAdversaryFeatureRequest\x1aC.systems.daggerheart.v1.DaggerheartActivateAdversaryFeatureResponse\x12\xa9\x01\n" +
+	"\x1aListAdversaryFeatureStates\x12D.systems.daggerheart.v1.DaggerheartListAdversaryFeatureStatesRequest\x1aE.systems.daggerheart.v1.DaggerheartListAdversaryFeatureStatesResponse\x12\x82\x01\n" +
+	"\rPlanEncounter\x127.systems.daggerheart.v1.DaggerheartPlanEncounterRequest\x1a8.systems.daggerheart.v1.DaggerheartPlanEncounterResponse\x12\x88\x01\n" +
+	"\x0fCommitEncounter\x129.systems.daggerheart.v1.DaggerheartCommitEncounterRequest\x1a:.systems.daggerheart.v1.DaggerheartCommitEncounterResponse\x12\x94\x01\n" +
 	"\x13ResolveBlazeOfGlory\x12=.systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryRequest\x1a>.systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryResponse\x12x\n" +
 	"\x11SessionActionRoll\x120.systems.daggerheart.v1.SessionActionRollRequest\x1a1.systems.daggerheart.v1.SessionActionRollResponse\x12x\n" +
 	"\x11SessionDamageRoll\x120.systems.daggerheart.v1.SessionDamageRollRequest\x1a1.systems.daggerheart.v1.SessionDamageRollResponse\x12x\n" +
-	"\x11SessionAttackFlow\x120.systems.daggerheart.v1.SessionAttackFlowRequest\x1a1.systems.daggerheart.v1.SessionAttackFlowResponse\x12~\n" +
+	"\x11SessionAttackFlow\x120.systems.daggerheart.v1.SessionAttackFlowRequest\x1a1.systems.daggerheart.v1.SessionAttackFlowResponse\x12\x87\x01\n" +
+	"\x16SessionMultiAttackFlow\x125.systems.daggerheart.v1.SessionMultiAttackFlowRequest\x1a6.systems.daggerheart.v1.SessionMultiAttackFlowResponse\x12\x81\x01\n" +
+	"\x14SessionSpellcastFlow\x123.systems.daggerheart.v1.SessionSpellcastFlowRequest\x1a4.systems.daggerheart.v1.SessionSpellcastFlowResponse\x12~\n" +
 	"\x13SessionReactionFlow\x122.systems.daggerheart.v1.SessionReactionFlowRequest\x1a3.systems.daggerheart.v1.SessionReactionFlowResponse\x12\x93\x01\n" +
 	"\x1aSessionAdversaryAttackRoll\x129.systems.daggerheart.v1.SessionAdversaryAttackRollRequest\x1a:.systems.daggerheart.v1.SessionAdversaryAttackRollResponse\x12\x96\x01\n" +
 	"\x1bSessionAdversaryActionCheck\x12:.systems.daggerheart.v1.SessionAdversaryActionCheckRequest\x1a;.systems.daggerheart.v1.SessionAdversaryActionCheckResponse\x12\x93\x01\n" +
-	"\x1aSessionAdversaryAttackFlow\x129.systems.daggerheart.v1.SessionAdversaryAttackFlowRequest\x1a:.systems.daggerheart.v1.SessionAdversaryAttackFlowResponse\x12\x87\x01\n" +
+	"\x1aSessionAdversaryAttackFlow\x129.systems.daggerheart.v1.SessionAdversaryAttackFlowRequest\x1a:.systems.daggerheart.v1.SessionAdversaryAttackFlowResponse\x12\xa2\x01\n" +
+	"\x1fSessionAdversaryMultiAttackFlow\x12>.systems.daggerheart.v1.SessionAdversaryMultiAttackFlowRequest\x1a?.systems.daggerheart.v1.SessionAdversaryMultiAttackFlowResponse\x12\x87\x01\n" +
 	"\x16SessionGroupActionFlow\x125.systems.daggerheart.v1.SessionGroupActionFlowRequest\x1a6.systems.daggerheart.v1.SessionGroupActionFlowResponse\x12{\n" +
 	"\x12SessionTagTeamFlow\x121.systems.daggerheart.v1.SessionTagTeamFlowRequest\x1a2.systems.daggerheart.v1.SessionTagTeamFlowResponse\x12u\n" +
 	"\x10ApplyRollOutcome\x12/.systems.daggerheart.v1.ApplyRollOutcomeRequest\x1a0.systems.daggerheart.v1.ApplyRollOutcomeResponse\x12\x91\x01\n" +
 	"\x12ApplyAttackOutcome\x12<.systems.daggerheart.v1.DaggerheartApplyAttackOutcomeRequest\x1a=.systems.daggerheart.v1.DaggerheartApplyAttackOutcomeResponse\x12\xac\x01\n" +
 	"\x1bApplyAdversaryAttackOutcome\x12E.systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeRequest\x1aF.systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeResponse\x12\x97\x01\n" +
-	"\x14ApplyReactionOutcome\x12>.systems.daggerheart.v1.DaggerheartApplyReactionOutcomeRequest\x1a?.systems.daggerheart.v1.DaggerheartApplyReactionOutcomeResponseBYZWgithub.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1;daggerheartv1b\x06proto3"
+	"\x14ApplyReactionOutcome\x12>.systems.daggerheart.v1.DaggerheartApplyReactionOutcomeRequest\x1a?.systems.daggerheart.v1.DaggerheartApplyReactionOutcomeResponse\x12p\n" +
+	"\aLevelUp\x121.systems.daggerheart.v1.DaggerheartLevelUpRequest\x1a2.systems.daggerheart.v1.DaggerheartLevelUpResponse\x12|\n" +
+	"\vAcquireItem\x125.systems.daggerheart.v1.DaggerheartAcquireItemRequest\x1a6.systems.daggerheart.v1.DaggerheartAcquireItemResponse\x12s\n" +
+	"\bDropItem\x122.systems.daggerheart.v1.DaggerheartDropItemRequest\x1a3.systems.daggerheart.v1.DaggerheartDropItemResponse\x12\x7f\n" +
+	"\fTransferItem\x126.systems.daggerheart.v1.DaggerheartTransferItemRequest\x1a7.systems.daggerheart.v1.DaggerheartTransferItemResponse\x12v\n" +
+	"\tEquipItem\x123.systems.daggerheart.v1.DaggerheartEquipItemRequest\x1a4.systems.daggerheart.v1.DaggerheartEquipItemResponse\x12|\n" +
+	"\vUnequipItem\x125.systems.daggerheart.v1.DaggerheartUnequipItemRequest\x1a6.systems.daggerheart.v1.DaggerheartUnequipItemResponse\x12y\n" +
+	"\n" +
+	"UpdateGold\x124.systems.daggerheart.v1.DaggerheartUpdateGoldRequest\x1a5.systems.daggerheart.v1.DaggerheartUpdateGoldResponse\x12\x88\x01\n" +
+	"\x0fCreateCompanion\x129.systems.daggerheart.v1.DaggerheartCreateCompanionRequest\x1a:.systems.daggerheart.v1.DaggerheartCreateCompanionResponse\x12\x8b\x01\n" +
+	"\x10LevelUpCompanion\x12:.systems.daggerheart.v1.DaggerheartLevelUpCompanionRequest\x1a;.systems.daggerheart.v1.DaggerheartLevelUpCompanionResponse\x12\x9a\x01\n" +
+	"\x15UpdateCompanionStress\x12?.systems.daggerheart.v1.DaggerheartUpdateCompanionStressRequest\x1a@.systems.daggerheart.v1.DaggerheartUpdateCompanionStressResponse\x12\x93\x01\n" +
+	"\x1aSessionCompanionAttackFlow\x129.systems.daggerheart.v1.SessionCompanionAttackFlowRequest\x1a:.systems.daggerheart.v1.SessionCompanionAttackFlowResponse\x12\x85\x01\n" +
+	"\x0eEnterBeastform\x128.systems.daggerheart.v1.DaggerheartEnterBeastformRequest\x1a9.systems.daggerheart.v1.DaggerheartEnterBeastformResponse\x12\x82\x01\n" +
+	"\rExitBeastform\x127.systems.daggerheart.v1.DaggerheartExitBeastformRequest\x1a8.systems.daggerheart.v1.DaggerheartExitBeastformResponse\x12|\n" +
+	"\vApplyEffect\x125.systems.daggerheart.v1.DaggerheartApplyEffectRequest\x1a6.systems.daggerheart.v1.DaggerheartApplyEffectResponse\x12\x7f\n" +
+	"\fRemoveEffect\x126.systems.daggerheart.v1.DaggerheartRemoveEffectRequest\x1a7.systems.daggerheart.v1.DaggerheartRemoveEffectResponse\x12|\n" +
+	"\vListEffects\x125.systems.daggerheart.v1.DaggerheartListEffectsRequest\x1a6.systems.daggerheart.v1.DaggerheartListEffectsResponseBYZWgithub.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1;daggerheartv1b\x06proto3"
 
 var (
 	file_systems_daggerheart_v1_service_proto_rawDescOnce sync.Once
@@ -7506,342 +14870,647 @@ func file_systems_daggerheart_v1_service_proto_rawDescGZIP() []byte {
 	return file_systems_daggerheart_v1_service_proto_rawDescData
 }
 
-var file_systems_daggerheart_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_systems_daggerheart_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
+var file_systems_daggerheart_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_systems_daggerheart_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 172)
 var file_systems_daggerheart_v1_service_proto_goTypes = []any{
 	(DaggerheartCountdownKind)(0),                          // 0: systems.daggerheart.v1.DaggerheartCountdownKind
 	(DaggerheartCountdownDirection)(0),                     // 1: systems.daggerheart.v1.DaggerheartCountdownDirection
-	(RollKind)(0),                                          // 2: systems.daggerheart.v1.RollKind
-	(*DaggerheartApplyDamageRequest)(nil),                  // 3: systems.daggerheart.v1.DaggerheartApplyDamageRequest
-	(*DaggerheartApplyDamageResponse)(nil),                 // 4: systems.daggerheart.v1.DaggerheartApplyDamageResponse
-	(*DaggerheartApplyAdversaryDamageRequest)(nil),         // 5: systems.daggerheart.v1.DaggerheartApplyAdversaryDamageRequest
-	(*DaggerheartApplyAdversaryDamageResponse)(nil),        // 6: systems.daggerheart.v1.DaggerheartApplyAdversaryDamageResponse
-	(*DaggerheartApplyRestRequest)(nil),                    // 7: systems.daggerheart.v1.DaggerheartApplyRestRequest
-	(*DaggerheartCharacterStateEntry)(nil),                 // 8: systems.daggerheart.v1.DaggerheartCharacterStateEntry
-	(*DaggerheartApplyRestResponse)(nil),                   // 9: systems.daggerheart.v1.DaggerheartApplyRestResponse
-	(*DaggerheartApplyDowntimeMoveRequest)(nil),            // 10: systems.daggerheart.v1.DaggerheartApplyDowntimeMoveRequest
-	(*DaggerheartApplyDowntimeMoveResponse)(nil),           // 11: systems.daggerheart.v1.DaggerheartApplyDowntimeMoveResponse
-	(*DaggerheartSwapLoadoutRequest)(nil),                  // 12: systems.daggerheart.v1.DaggerheartSwapLoadoutRequest
-	(*DaggerheartSwapLoadoutResponse)(nil),                 // 13: systems.daggerheart.v1.DaggerheartSwapLoadoutResponse
-	(*DaggerheartApplyDeathMoveRequest)(nil),               // 14: systems.daggerheart.v1.DaggerheartApplyDeathMoveRequest
-	(*DaggerheartDeathMoveResult)(nil),                     // 15: systems.daggerheart.v1.DaggerheartDeathMoveResult
-	(*DaggerheartApplyDeathMoveResponse)(nil),              // 16: systems.daggerheart.v1.DaggerheartApplyDeathMoveResponse
-	(*DaggerheartApplyConditionsRequest)(nil),              // 17: systems.daggerheart.v1.DaggerheartApplyConditionsRequest
-	(*DaggerheartApplyConditionsResponse)(nil),             // 18: systems.daggerheart.v1.DaggerheartApplyConditionsResponse
-	(*DaggerheartApplyAdversaryConditionsRequest)(nil),     // 19: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsRequest
-	(*DaggerheartApplyAdversaryConditionsResponse)(nil),    // 20: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsResponse
-	(*DaggerheartApplyGmMoveRequest)(nil),                  // 21: systems.daggerheart.v1.DaggerheartApplyGmMoveRequest
-	(*DaggerheartApplyGmMoveResponse)(nil),                 // 22: systems.daggerheart.v1.DaggerheartApplyGmMoveResponse
-	(*DaggerheartCountdown)(nil),                           // 23: systems.daggerheart.v1.DaggerheartCountdown
-	(*DaggerheartCreateCountdownRequest)(nil),              // 24: systems.daggerheart.v1.DaggerheartCreateCountdownRequest
-	(*DaggerheartCreateCountdownResponse)(nil),             // 25: systems.daggerheart.v1.DaggerheartCreateCountdownResponse
-	(*DaggerheartUpdateCountdownRequest)(nil),              // 26: systems.daggerheart.v1.DaggerheartUpdateCountdownRequest
-	(*DaggerheartUpdateCountdownResponse)(nil),             // 27: systems.daggerheart.v1.DaggerheartUpdateCountdownResponse
-	(*DaggerheartDeleteCountdownRequest)(nil),              // 28: systems.daggerheart.v1.DaggerheartDeleteCountdownRequest
-	(*DaggerheartDeleteCountdownResponse)(nil),             // 29: systems.daggerheart.v1.DaggerheartDeleteCountdownResponse
-	(*DaggerheartAdversary)(nil),                           // 30: systems.daggerheart.v1.DaggerheartAdversary
-	(*DaggerheartCreateAdversaryRequest)(nil),              // 31: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest
-	(*DaggerheartCreateAdversaryResponse)(nil),             // 32: systems.daggerheart.v1.DaggerheartCreateAdversaryResponse
-	(*DaggerheartUpdateAdversaryRequest)(nil),              // 33: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest
-	(*DaggerheartUpdateAdversaryResponse)(nil),             // 34: systems.daggerheart.v1.DaggerheartUpdateAdversaryResponse
-	(*DaggerheartDeleteAdversaryRequest)(nil),              // 35: systems.daggerheart.v1.DaggerheartDeleteAdversaryRequest
-	(*DaggerheartDeleteAdversaryResponse)(nil),             // 36: systems.daggerheart.v1.DaggerheartDeleteAdversaryResponse
-	(*DaggerheartGetAdversaryRequest)(nil),                 // 37: systems.daggerheart.v1.DaggerheartGetAdversaryRequest
-	(*DaggerheartGetAdversaryResponse)(nil),                // 38: systems.daggerheart.v1.DaggerheartGetAdversaryResponse
-	(*DaggerheartListAdversariesRequest)(nil),              // 39: systems.daggerheart.v1.DaggerheartListAdversariesRequest
-	(*DaggerheartListAdversariesResponse)(nil),             // 40: systems.daggerheart.v1.DaggerheartListAdversariesResponse
-	(*DaggerheartResolveBlazeOfGloryRequest)(nil),          // 41: systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryRequest
-	(*DaggerheartBlazeOfGloryResult)(nil),                  // 42: systems.daggerheart.v1.DaggerheartBlazeOfGloryResult
-	(*DaggerheartResolveBlazeOfGloryResponse)(nil),         // 43: systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryResponse
-	(*ActionRollRequest)(nil),                              // 44: systems.daggerheart.v1.ActionRollRequest
-	(*ActionRollResponse)(nil),                             // 45: systems.daggerheart.v1.ActionRollResponse
-	(*DualityOutcomeRequest)(nil),                          // 46: systems.daggerheart.v1.DualityOutcomeRequest
-	(*DualityOutcomeResponse)(nil),                         // 47: systems.daggerheart.v1.DualityOutcomeResponse
-	(*DualityExplainRequest)(nil),                          // 48: systems.daggerheart.v1.DualityExplainRequest
-	(*DualityExplainResponse)(nil),                         // 49: systems.daggerheart.v1.DualityExplainResponse
-	(*DualityProbabilityRequest)(nil),                      // 50: systems.daggerheart.v1.DualityProbabilityRequest
-	(*DualityProbabilityResponse)(nil),                     // 51: systems.daggerheart.v1.DualityProbabilityResponse
-	(*RulesVersionRequest)(nil),                            // 52: systems.daggerheart.v1.RulesVersionRequest
-	(*RulesVersionResponse)(nil),                           // 53: systems.daggerheart.v1.RulesVersionResponse
-	(*RollDiceRequest)(nil),                                // 54: systems.daggerheart.v1.RollDiceRequest
-	(*RollDiceResponse)(nil),                               // 55: systems.daggerheart.v1.RollDiceResponse
-	(*SessionActionRollRequest)(nil),                       // 56: systems.daggerheart.v1.SessionActionRollRequest
-	(*SessionActionRollResponse)(nil),                      // 57: systems.daggerheart.v1.SessionActionRollResponse
-	(*SessionDamageRollRequest)(nil),                       // 58: systems.daggerheart.v1.SessionDamageRollRequest
-	(*SessionDamageRollResponse)(nil),                      // 59: systems.daggerheart.v1.SessionDamageRollResponse
-	(*DaggerheartAttackDamageSpec)(nil),                    // 60: systems.daggerheart.v1.DaggerheartAttackDamageSpec
-	(*SessionAttackFlowRequest)(nil),                       // 61: systems.daggerheart.v1.SessionAttackFlowRequest
-	(*SessionAttackFlowResponse)(nil),                      // 62: systems.daggerheart.v1.SessionAttackFlowResponse
-	(*SessionReactionFlowRequest)(nil),                     // 63: systems.daggerheart.v1.SessionReactionFlowRequest
-	(*SessionReactionFlowResponse)(nil),                    // 64: systems.daggerheart.v1.SessionReactionFlowResponse
-	(*SessionAdversaryAttackRollRequest)(nil),              // 65: systems.daggerheart.v1.SessionAdversaryAttackRollRequest
-	(*SessionAdversaryActionCheckRequest)(nil),             // 66: systems.daggerheart.v1.SessionAdversaryActionCheckRequest
-	(*SessionAdversaryActionCheckResponse)(nil),            // 67: systems.daggerheart.v1.SessionAdversaryActionCheckResponse
-	(*SessionAdversaryAttackRollResponse)(nil),             // 68: systems.daggerheart.v1.SessionAdversaryAttackRollResponse
-	(*SessionAdversaryAttackFlowRequest)(nil),              // 69: systems.daggerheart.v1.SessionAdversaryAttackFlowRequest
-	(*SessionAdversaryAttackFlowResponse)(nil),             // 70: systems.daggerheart.v1.SessionAdversaryAttackFlowResponse
-	(*GroupActionSupporter)(nil),                           // 71: systems.daggerheart.v1.GroupActionSupporter
-	(*GroupActionSupporterRoll)(nil),                       // 72: systems.daggerheart.v1.GroupActionSupporterRoll
-	(*SessionGroupActionFlowRequest)(nil),                  // 73: systems.daggerheart.v1.SessionGroupActionFlowRequest
-	(*SessionGroupActionFlowResponse)(nil),                 // 74: systems.daggerheart.v1.SessionGroupActionFlowResponse
-	(*TagTeamParticipant)(nil),                             // 75: systems.daggerheart.v1.TagTeamParticipant
-	(*SessionTagTeamFlowRequest)(nil),                      // 76: systems.daggerheart.v1.SessionTagTeamFlowRequest
-	(*SessionTagTeamFlowResponse)(nil),                     // 77: systems.daggerheart.v1.SessionTagTeamFlowResponse
-	(*ApplyRollOutcomeRequest)(nil),                        // 78: systems.daggerheart.v1.ApplyRollOutcomeRequest
-	(*ApplyRollOutcomeResponse)(nil),                       // 79: systems.daggerheart.v1.ApplyRollOutcomeResponse
-	(*DaggerheartApplyAttackOutcomeRequest)(nil),           // 80: systems.daggerheart.v1.DaggerheartApplyAttackOutcomeRequest
-	(*DaggerheartApplyAdversaryAttackOutcomeRequest)(nil),  // 81: systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeRequest
-	(*DaggerheartAttackOutcomeResult)(nil),                 // 82: systems.daggerheart.v1.DaggerheartAttackOutcomeResult
-	(*DaggerheartApplyAttackOutcomeResponse)(nil),          // 83: systems.daggerheart.v1.DaggerheartApplyAttackOutcomeResponse
-	(*DaggerheartAdversaryAttackOutcomeResult)(nil),        // 84: systems.daggerheart.v1.DaggerheartAdversaryAttackOutcomeResult
-	(*DaggerheartApplyAdversaryAttackOutcomeResponse)(nil), // 85: systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeResponse
-	(*DaggerheartApplyReactionOutcomeRequest)(nil),         // 86: systems.daggerheart.v1.DaggerheartApplyReactionOutcomeRequest
-	(*DaggerheartReactionOutcomeResult)(nil),               // 87: systems.daggerheart.v1.DaggerheartReactionOutcomeResult
-	(*DaggerheartApplyReactionOutcomeResponse)(nil),        // 88: systems.daggerheart.v1.DaggerheartApplyReactionOutcomeResponse
-	(*DaggerheartDamageRequest)(nil),                       // 89: systems.daggerheart.v1.DaggerheartDamageRequest
-	(*DaggerheartCharacterState)(nil),                      // 90: systems.daggerheart.v1.DaggerheartCharacterState
-	(*DaggerheartRestRequest)(nil),                         // 91: systems.daggerheart.v1.DaggerheartRestRequest
-	(*DaggerheartSnapshot)(nil),                            // 92: systems.daggerheart.v1.DaggerheartSnapshot
-	(*DaggerheartDowntimeRequest)(nil),                     // 93: systems.daggerheart.v1.DaggerheartDowntimeRequest
-	(*DaggerheartLoadoutSwapRequest)(nil),                  // 94: systems.daggerheart.v1.DaggerheartLoadoutSwapRequest
-	(DaggerheartDeathMove)(0),                              // 95: systems.daggerheart.v1.DaggerheartDeathMove
-	(*v1.RngRequest)(nil),                                  // 96: common.v1.RngRequest
-	(DaggerheartLifeState)(0),                              // 97: systems.daggerheart.v1.DaggerheartLifeState
-	(DaggerheartCondition)(0),                              // 98: systems.daggerheart.v1.DaggerheartCondition
-	(*wrapperspb.StringValue)(nil),                         // 99: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),                          // 100: google.protobuf.Timestamp
-	(*wrapperspb.Int32Value)(nil),                          // 101: google.protobuf.Int32Value
-	(Outcome)(0),                                           // 102: systems.daggerheart.v1.Outcome
-	(*v1.RngResponse)(nil),                                 // 103: common.v1.RngResponse
-	(*Intermediates)(nil),                                  // 104: systems.daggerheart.v1.Intermediates
-	(*ExplainStep)(nil),                                    // 105: systems.daggerheart.v1.ExplainStep
-	(*OutcomeCount)(nil),                                   // 106: systems.daggerheart.v1.OutcomeCount
-	(*DiceSpec)(nil),                                       // 107: systems.daggerheart.v1.DiceSpec
-	(*DiceRoll)(nil),                                       // 108: systems.daggerheart.v1.DiceRoll
-	(*ActionRollModifier)(nil),                             // 109: systems.daggerheart.v1.ActionRollModifier
-	(DaggerheartDamageType)(0),                             // 110: systems.daggerheart.v1.DaggerheartDamageType
-	(*OutcomeUpdated)(nil),                                 // 111: systems.daggerheart.v1.OutcomeUpdated
+	(DaggerheartCountdownTrigger)(0),                       // 2: systems.daggerheart.v1.DaggerheartCountdownTrigger
+	(DaggerheartChaseStatus)(0),                            // 3: systems.daggerheart.v1.DaggerheartChaseStatus
+	(DaggerheartChaseWinner)(0),                            // 4: systems.daggerheart.v1.DaggerheartChaseWinner
+	(DaggerheartRangeBand)(0),                              // 5: systems.daggerheart.v1.DaggerheartRangeBand
+	(DaggerheartSceneEntityType)(0),                        // 6: systems.daggerheart.v1.DaggerheartSceneEntityType
+	(DaggerheartMovementKind)(0),                           // 7: systems.daggerheart.v1.DaggerheartMovementKind
+	(DaggerheartEnvironmentFeatureKind)(0),                 // 8: systems.daggerheart.v1.DaggerheartEnvironmentFeatureKind
+	(DaggerheartAdversaryFeatureStatus)(0),                 // 9: systems.daggerheart.v1.DaggerheartAdversaryFeatureStatus
+	(RollKind)(0),                                          // 10: systems.daggerheart.v1.RollKind
+	(DaggerheartAdvancementType)(0),                        // 11: systems.daggerheart.v1.DaggerheartAdvancementType
+	(*DaggerheartApplyDamageRequest)(nil),                  // 12: systems.daggerheart.v1.DaggerheartApplyDamageRequest
+	(*DaggerheartApplyDamageResponse)(nil),                 // 13: systems.daggerheart.v1.DaggerheartApplyDamageResponse
+	(*DaggerheartApplyAdversaryDamageRequest)(nil),         // 14: systems.daggerheart.v1.DaggerheartApplyAdversaryDamageRequest
+	(*DaggerheartApplyAdversaryDamageResponse)(nil),        // 15: systems.daggerheart.v1.DaggerheartApplyAdversaryDamageResponse
+	(*DaggerheartApplyRestRequest)(nil),                    // 16: systems.daggerheart.v1.DaggerheartApplyRestRequest
+	(*DaggerheartCharacterStateEntry)(nil),                 // 17: systems.daggerheart.v1.DaggerheartCharacterStateEntry
+	(*DaggerheartApplyRestResponse)(nil),                   // 18: systems.daggerheart.v1.DaggerheartApplyRestResponse
+	(*DaggerheartApplyDowntimeMoveRequest)(nil),            // 19: systems.daggerheart.v1.DaggerheartApplyDowntimeMoveRequest
+	(*DaggerheartApplyDowntimeMoveResponse)(nil),           // 20: systems.daggerheart.v1.DaggerheartApplyDowntimeMoveResponse
+	(*DaggerheartSwapLoadoutRequest)(nil),                  // 21: systems.daggerheart.v1.DaggerheartSwapLoadoutRequest
+	(*DaggerheartSwapLoadoutResponse)(nil),                 // 22: systems.daggerheart.v1.DaggerheartSwapLoadoutResponse
+	(*DaggerheartApplyDeathMoveRequest)(nil),               // 23: systems.daggerheart.v1.DaggerheartApplyDeathMoveRequest
+	(*DaggerheartDeathMoveResult)(nil),                     // 24: systems.daggerheart.v1.DaggerheartDeathMoveResult
+	(*DaggerheartApplyDeathMoveResponse)(nil),              // 25: systems.daggerheart.v1.DaggerheartApplyDeathMoveResponse
+	(*DaggerheartApplyConditionsRequest)(nil),              // 26: systems.daggerheart.v1.DaggerheartApplyConditionsRequest
+	(*DaggerheartApplyConditionsResponse)(nil),             // 27: systems.daggerheart.v1.DaggerheartApplyConditionsResponse
+	(*DaggerheartApplyAdversaryConditionsRequest)(nil),     // 28: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsRequest
+	(*DaggerheartApplyAdversaryConditionsResponse)(nil),    // 29: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsResponse
+	(*DaggerheartApplyGmMoveRequest)(nil),                  // 30: systems.daggerheart.v1.DaggerheartApplyGmMoveRequest
+	(*DaggerheartApplyGmMoveResponse)(nil),                 // 31: systems.daggerheart.v1.DaggerheartApplyGmMoveResponse
+	(*DaggerheartCountdownOutcomeDelta)(nil),               // 32: systems.daggerheart.v1.DaggerheartCountdownOutcomeDelta
+	(*DaggerheartCountdownTickPolicy)(nil),                 // 33: systems.daggerheart.v1.DaggerheartCountdownTickPolicy
+	(*DaggerheartCountdown)(nil),                           // 34: systems.daggerheart.v1.DaggerheartCountdown
+	(*DaggerheartCreateCountdownRequest)(nil),              // 35: systems.daggerheart.v1.DaggerheartCreateCountdownRequest
+	(*DaggerheartCreateCountdownResponse)(nil),             // 36: systems.daggerheart.v1.DaggerheartCreateCountdownResponse
+	(*DaggerheartUpdateCountdownRequest)(nil),              // 37: systems.daggerheart.v1.DaggerheartUpdateCountdownRequest
+	(*DaggerheartUpdateCountdownResponse)(nil),             // 38: systems.daggerheart.v1.DaggerheartUpdateCountdownResponse
+	(*DaggerheartDeleteCountdownRequest)(nil),              // 39: systems.daggerheart.v1.DaggerheartDeleteCountdownRequest
+	(*DaggerheartDeleteCountdownResponse)(nil),             // 40: systems.daggerheart.v1.DaggerheartDeleteCountdownResponse
+	(*DaggerheartChase)(nil),                               // 41: systems.daggerheart.v1.DaggerheartChase
+	(*DaggerheartChaseCountdownSpec)(nil),                  // 42: systems.daggerheart.v1.DaggerheartChaseCountdownSpec
+	(*DaggerheartStartChaseRequest)(nil),                   // 43: systems.daggerheart.v1.DaggerheartStartChaseRequest
+	(*DaggerheartStartChaseResponse)(nil),                  // 44: systems.daggerheart.v1.DaggerheartStartChaseResponse
+	(*DaggerheartGetChaseRequest)(nil),                     // 45: systems.daggerheart.v1.DaggerheartGetChaseRequest
+	(*DaggerheartGetChaseResponse)(nil),                    // 46: systems.daggerheart.v1.DaggerheartGetChaseResponse
+	(*DaggerheartListChasesRequest)(nil),                   // 47: systems.daggerheart.v1.DaggerheartListChasesRequest
+	(*DaggerheartListChasesResponse)(nil),                  // 48: systems.daggerheart.v1.DaggerheartListChasesResponse
+	(*DaggerheartSceneEntity)(nil),                         // 49: systems.daggerheart.v1.DaggerheartSceneEntity
+	(*DaggerheartSceneRange)(nil),                          // 50: systems.daggerheart.v1.DaggerheartSceneRange
+	(*DaggerheartSetSceneRangesRequest)(nil),               // 51: systems.daggerheart.v1.DaggerheartSetSceneRangesRequest
+	(*DaggerheartSetSceneRangesResponse)(nil),              // 52: systems.daggerheart.v1.DaggerheartSetSceneRangesResponse
+	(*DaggerheartSceneRangeUpdate)(nil),                    // 53: systems.daggerheart.v1.DaggerheartSceneRangeUpdate
+	(*DaggerheartMoveSceneEntityRequest)(nil),              // 54: systems.daggerheart.v1.DaggerheartMoveSceneEntityRequest
+	(*DaggerheartMoveSceneEntityResponse)(nil),             // 55: systems.daggerheart.v1.DaggerheartMoveSceneEntityResponse
+	(*DaggerheartListSceneRangesRequest)(nil),              // 56: systems.daggerheart.v1.DaggerheartListSceneRangesRequest
+	(*DaggerheartListSceneRangesResponse)(nil),             // 57: systems.daggerheart.v1.DaggerheartListSceneRangesResponse
+	(*DaggerheartSessionEnvironment)(nil),                  // 58: systems.daggerheart.v1.DaggerheartSessionEnvironment
+	(*DaggerheartCreateSessionEnvironmentRequest)(nil),     // 59: systems.daggerheart.v1.DaggerheartCreateSessionEnvironmentRequest
+	(*DaggerheartCreateSessionEnvironmentResponse)(nil),    // 60: systems.daggerheart.v1.DaggerheartCreateSessionEnvironmentResponse
+	(*DaggerheartShiftSessionEnvironmentRequest)(nil),      // 61: systems.daggerheart.v1.DaggerheartShiftSessionEnvironmentRequest
+	(*DaggerheartShiftSessionEnvironmentResponse)(nil),     // 62: systems.daggerheart.v1.DaggerheartShiftSessionEnvironmentResponse
+	(*DaggerheartClearSessionEnvironmentRequest)(nil),      // 63: systems.daggerheart.v1.DaggerheartClearSessionEnvironmentRequest
+	(*DaggerheartClearSessionEnvironmentResponse)(nil),     // 64: systems.daggerheart.v1.DaggerheartClearSessionEnvironmentResponse
+	(*DaggerheartGetSessionEnvironmentRequest)(nil),        // 65: systems.daggerheart.v1.DaggerheartGetSessionEnvironmentRequest
+	(*DaggerheartGetSessionEnvironmentResponse)(nil),       // 66: systems.daggerheart.v1.DaggerheartGetSessionEnvironmentResponse
+	(*DaggerheartEnvironmentSpawn)(nil),                    // 67: systems.daggerheart.v1.DaggerheartEnvironmentSpawn
+	(*DaggerheartTriggerEnvironmentFeatureRequest)(nil),    // 68: systems.daggerheart.v1.DaggerheartTriggerEnvironmentFeatureRequest
+	(*DaggerheartTriggerEnvironmentFeatureResponse)(nil),   // 69: systems.daggerheart.v1.DaggerheartTriggerEnvironmentFeatureResponse
+	(*DaggerheartAdversary)(nil),                           // 70: systems.daggerheart.v1.DaggerheartAdversary
+	(*DaggerheartCreateAdversaryRequest)(nil),              // 71: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest
+	(*DaggerheartCreateAdversaryResponse)(nil),             // 72: systems.daggerheart.v1.DaggerheartCreateAdversaryResponse
+	(*DaggerheartUpdateAdversaryRequest)(nil),              // 73: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest
+	(*DaggerheartUpdateAdversaryResponse)(nil),             // 74: systems.daggerheart.v1.DaggerheartUpdateAdversaryResponse
+	(*DaggerheartDeleteAdversaryRequest)(nil),              // 75: systems.daggerheart.v1.DaggerheartDeleteAdversaryRequest
+	(*DaggerheartDeleteAdversaryResponse)(nil),             // 76: systems.daggerheart.v1.DaggerheartDeleteAdversaryResponse
+	(*DaggerheartGetAdversaryRequest)(nil),                 // 77: systems.daggerheart.v1.DaggerheartGetAdversaryRequest
+	(*DaggerheartGetAdversaryResponse)(nil),                // 78: systems.daggerheart.v1.DaggerheartGetAdversaryResponse
+	(*DaggerheartListAdversariesRequest)(nil),              // 79: systems.daggerheart.v1.DaggerheartListAdversariesRequest
+	(*DaggerheartListAdversariesResponse)(nil),             // 80: systems.daggerheart.v1.DaggerheartListAdversariesResponse
+	(*DaggerheartAdversaryFeatureState)(nil),               // 81: systems.daggerheart.v1.DaggerheartAdversaryFeatureState
+	(*DaggerheartActivateAdversaryFeatureRequest)(nil),     // 82: systems.daggerheart.v1.DaggerheartActivateAdversaryFeatureRequest
+	(*DaggerheartActivateAdversaryFeatureResponse)(nil),    // 83: systems.daggerheart.v1.DaggerheartActivateAdversaryFeatureResponse
+	(*DaggerheartListAdversaryFeatureStatesRequest)(nil),   // 84: systems.daggerheart.v1.DaggerheartListAdversaryFeatureStatesRequest
+	(*DaggerheartListAdversaryFeatureStatesResponse)(nil),  // 85: systems.daggerheart.v1.DaggerheartListAdversaryFeatureStatesResponse
+	(*DaggerheartEncounterGroup)(nil),                      // 86: systems.daggerheart.v1.DaggerheartEncounterGroup
+	(*DaggerheartEncounterOptions)(nil),                    // 87: systems.daggerheart.v1.DaggerheartEncounterOptions
+	(*DaggerheartEncounterAdjustment)(nil),                 // 88: systems.daggerheart.v1.DaggerheartEncounterAdjustment
+	(*DaggerheartEncounterGroupCost)(nil),                  // 89: systems.daggerheart.v1.DaggerheartEncounterGroupCost
+	(*DaggerheartEncounterBudget)(nil),                     // 90: systems.daggerheart.v1.DaggerheartEncounterBudget
+	(*DaggerheartPlanEncounterRequest)(nil),                // 91: systems.daggerheart.v1.DaggerheartPlanEncounterRequest
+	(*DaggerheartPlanEncounterResponse)(nil),               // 92: systems.daggerheart.v1.DaggerheartPlanEncounterResponse
+	(*DaggerheartCommitEncounterRequest)(nil),              // 93: systems.daggerheart.v1.DaggerheartCommitEncounterRequest
+	(*DaggerheartCommitEncounterResponse)(nil),             // 94: systems.daggerheart.v1.DaggerheartCommitEncounterResponse
+	(*DaggerheartResolveBlazeOfGloryRequest)(nil),          // 95: systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryRequest
+	(*DaggerheartBlazeOfGloryResult)(nil),                  // 96: systems.daggerheart.v1.DaggerheartBlazeOfGloryResult
+	(*DaggerheartResolveBlazeOfGloryResponse)(nil),         // 97: systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryResponse
+	(*ActionRollRequest)(nil),                              // 98: systems.daggerheart.v1.ActionRollRequest
+	(*ActionRollResponse)(nil),                             // 99: systems.daggerheart.v1.ActionRollResponse
+	(*DualityOutcomeRequest)(nil),                          // 100: systems.daggerheart.v1.DualityOutcomeRequest
+	(*DualityOutcomeResponse)(nil),                         // 101: systems.daggerheart.v1.DualityOutcomeResponse
+	(*DualityExplainRequest)(nil),                          // 102: systems.daggerheart.v1.DualityExplainRequest
+	(*DualityExplainResponse)(nil),                         // 103: systems.daggerheart.v1.DualityExplainResponse
+	(*DualityProbabilityRequest)(nil),                      // 104: systems.daggerheart.v1.DualityProbabilityRequest
+	(*DualityProbabilityResponse)(nil),                     // 105: systems.daggerheart.v1.DualityProbabilityResponse
+	(*RulesVersionRequest)(nil),                            // 106: systems.daggerheart.v1.RulesVersionRequest
+	(*RulesVersionResponse)(nil),                           // 107: systems.daggerheart.v1.RulesVersionResponse
+	(*RollDiceRequest)(nil),                                // 108: systems.daggerheart.v1.RollDiceRequest
+	(*RollDiceResponse)(nil),                               // 109: systems.daggerheart.v1.RollDiceResponse
+	(*SessionActionRollRequest)(nil),                       // 110: systems.daggerheart.v1.SessionActionRollRequest
+	(*SessionActionRollResponse)(nil),                      // 111: systems.daggerheart.v1.SessionActionRollResponse
+	(*SessionDamageRollRequest)(nil),                       // 112: systems.daggerheart.v1.SessionDamageRollRequest
+	(*SessionDamageRollResponse)(nil),                      // 113: systems.daggerheart.v1.SessionDamageRollResponse
+	(*DaggerheartAttackDamageSpec)(nil),                    // 114: systems.daggerheart.v1.DaggerheartAttackDamageSpec
+	(*SessionAttackFlowRequest)(nil),                       // 115: systems.daggerheart.v1.SessionAttackFlowRequest
+	(*SessionAttackFlowResponse)(nil),                      // 116: systems.daggerheart.v1.SessionAttackFlowResponse
+	(*SessionSpellcastFlowRequest)(nil),                    // 117: systems.daggerheart.v1.SessionSpellcastFlowRequest
+	(*SessionSpellcastFlowResponse)(nil),                   // 118: systems.daggerheart.v1.SessionSpellcastFlowResponse
+	(*SessionReactionFlowRequest)(nil),                     // 119: systems.daggerheart.v1.SessionReactionFlowRequest
+	(*SessionReactionFlowResponse)(nil),                    // 120: systems.daggerheart.v1.SessionReactionFlowResponse
+	(*SessionAdversaryAttackRollRequest)(nil),              // 121: systems.daggerheart.v1.SessionAdversaryAttackRollRequest
+	(*SessionAdversaryActionCheckRequest)(nil),             // 122: systems.daggerheart.v1.SessionAdversaryActionCheckRequest
+	(*SessionAdversaryActionCheckResponse)(nil),            // 123: systems.daggerheart.v1.SessionAdversaryActionCheckResponse
+	(*SessionAdversaryAttackRollResponse)(nil),             // 124: systems.daggerheart.v1.SessionAdversaryAttackRollResponse
+	(*SessionAdversaryAttackFlowRequest)(nil),              // 125: systems.daggerheart.v1.SessionAdversaryAttackFlowRequest
+	(*SessionAdversaryAttackFlowResponse)(nil),             // 126: systems.daggerheart.v1.SessionAdversaryAttackFlowResponse
+	(*MultiAttackTarget)(nil),                              // 127: systems.daggerheart.v1.MultiAttackTarget
+	(*MultiAttackTargetResult)(nil),                        // 128: systems.daggerheart.v1.MultiAttackTargetResult
+	(*SessionMultiAttackFlowRequest)(nil),                  // 129: systems.daggerheart.v1.SessionMultiAttackFlowRequest
+	(*SessionMultiAttackFlowResponse)(nil),                 // 130: systems.daggerheart.v1.SessionMultiAttackFlowResponse
+	(*SessionAdversaryMultiAttackFlowRequest)(nil),         // 131: systems.daggerheart.v1.SessionAdversaryMultiAttackFlowRequest
+	(*SessionAdversaryMultiAttackFlowResponse)(nil),        // 132: systems.daggerheart.v1.SessionAdversaryMultiAttackFlowResponse
+	(*GroupActionSupporter)(nil),                           // 133: systems.daggerheart.v1.GroupActionSupporter
+	(*GroupActionSupporterRoll)(nil),                       // 134: systems.daggerheart.v1.GroupActionSupporterRoll
+	(*SessionGroupActionFlowRequest)(nil),                  // 135: systems.daggerheart.v1.SessionGroupActionFlowRequest
+	(*SessionGroupActionFlowResponse)(nil),                 // 136: systems.daggerheart.v1.SessionGroupActionFlowResponse
+	(*TagTeamParticipant)(nil),                             // 137: systems.daggerheart.v1.TagTeamParticipant
+	(*SessionTagTeamFlowRequest)(nil),                      // 138: systems.daggerheart.v1.SessionTagTeamFlowRequest
+	(*SessionTagTeamFlowResponse)(nil),                     // 139: systems.daggerheart.v1.SessionTagTeamFlowResponse
+	(*ApplyRollOutcomeRequest)(nil),                        // 140: systems.daggerheart.v1.ApplyRollOutcomeRequest
+	(*ApplyRollOutcomeResponse)(nil),                       // 141: systems.daggerheart.v1.ApplyRollOutcomeResponse
+	(*DaggerheartApplyAttackOutcomeRequest)(nil),           // 142: systems.daggerheart.v1.DaggerheartApplyAttackOutcomeRequest
+	(*DaggerheartApplyAdversaryAttackOutcomeRequest)(nil),  // 143: systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeRequest
+	(*DaggerheartAttackOutcomeResult)(nil),                 // 144: systems.daggerheart.v1.DaggerheartAttackOutcomeResult
+	(*DaggerheartApplyAttackOutcomeResponse)(nil),          // 145: systems.daggerheart.v1.DaggerheartApplyAttackOutcomeResponse
+	(*DaggerheartAdversaryAttackOutcomeResult)(nil),        // 146: systems.daggerheart.v1.DaggerheartAdversaryAttackOutcomeResult
+	(*DaggerheartApplyAdversaryAttackOutcomeResponse)(nil), // 147: systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeResponse
+	(*DaggerheartApplyReactionOutcomeRequest)(nil),         // 148: systems.daggerheart.v1.DaggerheartApplyReactionOutcomeRequest
+	(*DaggerheartReactionOutcomeResult)(nil),               // 149: systems.daggerheart.v1.DaggerheartReactionOutcomeResult
+	(*DaggerheartApplyReactionOutcomeResponse)(nil),        // 150: systems.daggerheart.v1.DaggerheartApplyReactionOutcomeResponse
+	(*DaggerheartAdvancement)(nil),                         // 151: systems.daggerheart.v1.DaggerheartAdvancement
+	(*DaggerheartLevelUpRequest)(nil),                      // 152: systems.daggerheart.v1.DaggerheartLevelUpRequest
+	(*DaggerheartLevelUpResponse)(nil),                     // 153: systems.daggerheart.v1.DaggerheartLevelUpResponse
+	(*DaggerheartAcquireItemRequest)(nil),                  // 154: systems.daggerheart.v1.DaggerheartAcquireItemRequest
+	(*DaggerheartAcquireItemResponse)(nil),                 // 155: systems.daggerheart.v1.DaggerheartAcquireItemResponse
+	(*DaggerheartDropItemRequest)(nil),                     // 156: systems.daggerheart.v1.DaggerheartDropItemRequest
+	(*DaggerheartDropItemResponse)(nil),                    // 157: systems.daggerheart.v1.DaggerheartDropItemResponse
+	(*DaggerheartTransferItemRequest)(nil),                 // 158: systems.daggerheart.v1.DaggerheartTransferItemRequest
+	(*DaggerheartTransferItemResponse)(nil),                // 159: systems.daggerheart.v1.DaggerheartTransferItemResponse
+	(*DaggerheartEquipItemRequest)(nil),                    // 160: systems.daggerheart.v1.DaggerheartEquipItemRequest
+	(*DaggerheartEquipItemResponse)(nil),                   // 161: systems.daggerheart.v1.DaggerheartEquipItemResponse
+	(*DaggerheartUnequipItemRequest)(nil),                  // 162: systems.daggerheart.v1.DaggerheartUnequipItemRequest
+	(*DaggerheartUnequipItemResponse)(nil),                 // 163: systems.daggerheart.v1.DaggerheartUnequipItemResponse
+	(*DaggerheartUpdateGoldRequest)(nil),                   // 164: systems.daggerheart.v1.DaggerheartUpdateGoldRequest
+	(*DaggerheartUpdateGoldResponse)(nil),                  // 165: systems.daggerheart.v1.DaggerheartUpdateGoldResponse
+	(*DaggerheartCreateCompanionRequest)(nil),              // 166: systems.daggerheart.v1.DaggerheartCreateCompanionRequest
+	(*DaggerheartCreateCompanionResponse)(nil),             // 167: systems.daggerheart.v1.DaggerheartCreateCompanionResponse
+	(*DaggerheartLevelUpCompanionRequest)(nil),             // 168: systems.daggerheart.v1.DaggerheartLevelUpCompanionRequest
+	(*DaggerheartLevelUpCompanionResponse)(nil),            // 169: systems.daggerheart.v1.DaggerheartLevelUpCompanionResponse
+	(*DaggerheartUpdateCompanionStressRequest)(nil),        // 170: systems.daggerheart.v1.DaggerheartUpdateCompanionStressRequest
+	(*DaggerheartUpdateCompanionStressResponse)(nil),       // 171: systems.daggerheart.v1.DaggerheartUpdateCompanionStressResponse
+	(*SessionCompanionAttackFlowRequest)(nil),              // 172: systems.daggerheart.v1.SessionCompanionAttackFlowRequest
+	(*SessionCompanionAttackFlowResponse)(nil),             // 173: systems.daggerheart.v1.SessionCompanionAttackFlowResponse
+	(*DaggerheartEnterBeastformRequest)(nil),               // 174: systems.daggerheart.v1.DaggerheartEnterBeastformRequest
+	(*DaggerheartEnterBeastformResponse)(nil),              // 175: systems.daggerheart.v1.DaggerheartEnterBeastformResponse
+	(*DaggerheartExitBeastformRequest)(nil),                // 176: systems.daggerheart.v1.DaggerheartExitBeastformRequest
+	(*DaggerheartExitBeastformResponse)(nil),               // 177: systems.daggerheart.v1.DaggerheartExitBeastformResponse
+	(*DaggerheartApplyEffectRequest)(nil),                  // 178: systems.daggerheart.v1.DaggerheartApplyEffectRequest
+	(*DaggerheartApplyEffectResponse)(nil),                 // 179: systems.daggerheart.v1.DaggerheartApplyEffectResponse
+	(*DaggerheartRemoveEffectRequest)(nil),                 // 180: systems.daggerheart.v1.DaggerheartRemoveEffectRequest
+	(*DaggerheartRemoveEffectResponse)(nil),                // 181: systems.daggerheart.v1.DaggerheartRemoveEffectResponse
+	(*DaggerheartListEffectsRequest)(nil),                  // 182: systems.daggerheart.v1.DaggerheartListEffectsRequest
+	(*DaggerheartListEffectsResponse)(nil),                 // 183: systems.daggerheart.v1.DaggerheartListEffectsResponse
+	(*DaggerheartDamageRequest)(nil),                       // 184: systems.daggerheart.v1.DaggerheartDamageRequest
+	(*DaggerheartCharacterState)(nil),                      // 185: systems.daggerheart.v1.DaggerheartCharacterState
+	(*DaggerheartRestRequest)(nil),                         // 186: systems.daggerheart.v1.DaggerheartRestRequest
+	(*DaggerheartSnapshot)(nil),                            // 187: systems.daggerheart.v1.DaggerheartSnapshot
+	(*DaggerheartDowntimeRequest)(nil),                     // 188: systems.daggerheart.v1.DaggerheartDowntimeRequest
+	(*DaggerheartLoadoutSwapRequest)(nil),                  // 189: systems.daggerheart.v1.DaggerheartLoadoutSwapRequest
+	(DaggerheartDeathMove)(0),                              // 190: systems.daggerheart.v1.DaggerheartDeathMove
+	(*v1.RngRequest)(nil),                                  // 191: common.v1.RngRequest
+	(DaggerheartLifeState)(0),                              // 192: systems.daggerheart.v1.DaggerheartLifeState
+	(DaggerheartCondition)(0),                              // 193: systems.daggerheart.v1.DaggerheartCondition
+	(Outcome)(0),                                           // 194: systems.daggerheart.v1.Outcome
+	(*wrapperspb.Int32Value)(nil),                          // 195: google.protobuf.Int32Value
+	(*wrapperspb.StringValue)(nil),                         // 196: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),                          // 197: google.protobuf.Timestamp
+	(*DaggerheartAdversaryAttack)(nil),                     // 198: systems.daggerheart.v1.DaggerheartAdversaryAttack
+	(*DaggerheartAdversaryExperience)(nil),                 // 199: systems.daggerheart.v1.DaggerheartAdversaryExperience
+	(*DaggerheartAdversaryFeature)(nil),                    // 200: systems.daggerheart.v1.DaggerheartAdversaryFeature
+	(*AdvantageSource)(nil),                                // 201: systems.daggerheart.v1.AdvantageSource
+	(*v1.RngResponse)(nil),                                 // 202: common.v1.RngResponse
+	(*DaggerheartExperience)(nil),                          // 203: systems.daggerheart.v1.DaggerheartExperience
+	(*Intermediates)(nil),                                  // 204: systems.daggerheart.v1.Intermediates
+	(*ExplainStep)(nil),                                    // 205: systems.daggerheart.v1.ExplainStep
+	(*OutcomeCount)(nil),                                   // 206: systems.daggerheart.v1.OutcomeCount
+	(*DiceSpec)(nil),                                       // 207: systems.daggerheart.v1.DiceSpec
+	(*DiceRoll)(nil),                                       // 208: systems.daggerheart.v1.DiceRoll
+	(*ActionRollModifier)(nil),                             // 209: systems.daggerheart.v1.ActionRollModifier
+	(DaggerheartDamageType)(0),                             // 210: systems.daggerheart.v1.DaggerheartDamageType
+	(DaggerheartEquipSlot)(0),                              // 211: systems.daggerheart.v1.DaggerheartEquipSlot
+	(*OutcomeUpdated)(nil),                                 // 212: systems.daggerheart.v1.OutcomeUpdated
+	(*DaggerheartProfile)(nil),                             // 213: systems.daggerheart.v1.DaggerheartProfile
+	(DaggerheartInventoryItemKind)(0),                      // 214: systems.daggerheart.v1.DaggerheartInventoryItemKind
+	(*DaggerheartCompanion)(nil),                           // 215: systems.daggerheart.v1.DaggerheartCompanion
+	(DaggerheartCompanionUpgrade)(0),                       // 216: systems.daggerheart.v1.DaggerheartCompanionUpgrade
+	(DaggerheartEffectTargetType)(0),                       // 217: systems.daggerheart.v1.DaggerheartEffectTargetType
+	(DaggerheartEffectKind)(0),                             // 218: systems.daggerheart.v1.DaggerheartEffectKind
+	(DaggerheartEffectStacking)(0),                         // 219: systems.daggerheart.v1.DaggerheartEffectStacking
+	(DaggerheartEffectExpiry)(0),                           // 220: systems.daggerheart.v1.DaggerheartEffectExpiry
+	(*DaggerheartEffect)(nil),                              // 221: systems.daggerheart.v1.DaggerheartEffect
 }
 var file_systems_daggerheart_v1_service_proto_depIdxs = []int32{
-	89,  // 0: systems.daggerheart.v1.DaggerheartApplyDamageRequest.damage:type_name -> systems.daggerheart.v1.DaggerheartDamageRequest
-	90,  // 1: systems.daggerheart.v1.DaggerheartApplyDamageResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
-	89,  // 2: systems.daggerheart.v1.DaggerheartApplyAdversaryDamageRequest.damage:type_name -> systems.daggerheart.v1.DaggerheartDamageRequest
-	30,  // 3: systems.daggerheart.v1.DaggerheartApplyAdversaryDamageResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
-	91,  // 4: systems.daggerheart.v1.DaggerheartApplyRestRequest.rest:type_name -> systems.daggerheart.v1.DaggerheartRestRequest
-	90,  // 5: systems.daggerheart.v1.DaggerheartCharacterStateEntry.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
-	92,  // 6: systems.daggerheart.v1.DaggerheartApplyRestResponse.snapshot:type_name -> systems.daggerheart.v1.DaggerheartSnapshot
-	8,   // 7: systems.daggerheart.v1.DaggerheartApplyRestResponse.character_states:type_name -> systems.daggerheart.v1.DaggerheartCharacterStateEntry
-	93,  // 8: systems.daggerheart.v1.DaggerheartApplyDowntimeMoveRequest.move:type_name -> systems.daggerheart.v1.DaggerheartDowntimeRequest
-	90,  // 9: systems.daggerheart.v1.DaggerheartApplyDowntimeMoveResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
-	94,  // 10: systems.daggerheart.v1.DaggerheartSwapLoadoutRequest.swap:type_name -> systems.daggerheart.v1.DaggerheartLoadoutSwapRequest
-	90,  // 11: systems.daggerheart.v1.DaggerheartSwapLoadoutResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
-	95,  // 12: systems.daggerheart.v1.DaggerheartApplyDeathMoveRequest.move:type_name -> systems.daggerheart.v1.DaggerheartDeathMove
-	96,  // 13: systems.daggerheart.v1.DaggerheartApplyDeathMoveRequest.rng:type_name -> common.v1.RngRequest
-	95,  // 14: systems.daggerheart.v1.DaggerheartDeathMoveResult.move:type_name -> systems.daggerheart.v1.DaggerheartDeathMove
-	97,  // 15: systems.daggerheart.v1.DaggerheartDeathMoveResult.life_state:type_name -> systems.daggerheart.v1.DaggerheartLifeState
-	90,  // 16: systems.daggerheart.v1.DaggerheartApplyDeathMoveResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
-	15,  // 17: systems.daggerheart.v1.DaggerheartApplyDeathMoveResponse.result:type_name -> systems.daggerheart.v1.DaggerheartDeathMoveResult
-	98,  // 18: systems.daggerheart.v1.DaggerheartApplyConditionsRequest.add:type_name -> systems.daggerheart.v1.DaggerheartCondition
-	98,  // 19: systems.daggerheart.v1.DaggerheartApplyConditionsRequest.remove:type_name -> systems.daggerheart.v1.DaggerheartCondition
-	97,  // 20: systems.daggerheart.v1.DaggerheartApplyConditionsRequest.life_state:type_name -> systems.daggerheart.v1.DaggerheartLifeState
-	90,  // 21: systems.daggerheart.v1.DaggerheartApplyConditionsResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
-	98,  // 22: systems.daggerheart.v1.DaggerheartApplyConditionsResponse.added:type_name -> systems.daggerheart.v1.DaggerheartCondition
-	98,  // 23: systems.daggerheart.v1.DaggerheartApplyConditionsResponse.removed:type_name -> systems.daggerheart.v1.DaggerheartCondition
-	98,  // 24: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsRequest.add:type_name -> systems.daggerheart.v1.DaggerheartCondition
-	98,  // 25: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsRequest.remove:type_name -> systems.daggerheart.v1.DaggerheartCondition
-	30,  // 26: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
-	98,  // 27: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsResponse.added:type_name -> systems.daggerheart.v1.DaggerheartCondition
-	98,  // 28: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsResponse.removed:type_name -> systems.daggerheart.v1.DaggerheartCondition
-	0,   // 29: systems.daggerheart.v1.DaggerheartCountdown.kind:type_name -> systems.daggerheart.v1.DaggerheartCountdownKind
-	1,   // 30: systems.daggerheart.v1.DaggerheartCountdown.direction:type_name -> systems.daggerheart.v1.DaggerheartCountdownDirection
-	0,   // 31: systems.daggerheart.v1.DaggerheartCreateCountdownRequest.kind:type_name -> systems.daggerheart.v1.DaggerheartCountdownKind
-	1,   // 32: systems.daggerheart.v1.DaggerheartCreateCountdownRequest.direction:type_name -> systems.daggerheart.v1.DaggerheartCountdownDirection
-	23,  // 33: systems.daggerheart.v1.DaggerheartCreateCountdownResponse.countdown:type_name -> systems.daggerheart.v1.DaggerheartCountdown
-	23,  // 34: systems.daggerheart.v1.DaggerheartUpdateCountdownResponse.countdown:type_name -> systems.daggerheart.v1.DaggerheartCountdown
-	99,  // 35: systems.daggerheart.v1.DaggerheartAdversary.session_id:type_name -> google.protobuf.StringValue
-	98,  // 36: systems.daggerheart.v1.DaggerheartAdversary.conditions:type_name -> systems.daggerheart.v1.DaggerheartCondition
-	100, // 37: systems.daggerheart.v1.DaggerheartAdversary.created_at:type_name -> google.protobuf.Timestamp
-	100, // 38: systems.daggerheart.v1.DaggerheartAdversary.updated_at:type_name -> google.protobuf.Timestamp
-	99,  // 39: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.session_id:type_name -> google.protobuf.StringValue
-	101, // 40: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.hp:type_name -> google.protobuf.Int32Value
-	101, // 41: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.hp_max:type_name -> google.protobuf.Int32Value
-	101, // 42: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.stress:type_name -> google.protobuf.Int32Value
-	101, // 43: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.stress_max:type_name -> google.protobuf.Int32Value
-	101, // 44: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.evasion:type_name -> google.protobuf.Int32Value
-	101, // 45: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.major_threshold:type_name -> google.protobuf.Int32Value
-	101, // 46: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.severe_threshold:type_name -> google.protobuf.Int32Value
-	101, // 47: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.armor:type_name -> google.protobuf.Int32Value
-	30,  // 48: systems.daggerheart.v1.DaggerheartCreateAdversaryResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
-	99,  // 49: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.name:type_name -> google.protobuf.StringValue
-	99,  // 50: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.kind:type_name -> google.protobuf.StringValue
-	99,  // 51: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.session_id:type_name -> google.protobuf.StringValue
-	99,  // 52: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.notes:type_name -> google.protobuf.StringValue
-	101, // 53: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.hp:type_name -> google.protobuf.Int32Value
-	101, // 54: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.hp_max:type_name -> google.protobuf.Int32Value
-	101, // 55: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.stress:type_name -> google.protobuf.Int32Value
-	101, // 56: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.stress_max:type_name -> google.protobuf.Int32Value
-	101, // 57: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.evasion:type_name -> google.protobuf.Int32Value
-	101, // 58: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.major_threshold:type_name -> google.protobuf.Int32Value
-	101, // 59: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.severe_threshold:type_name -> google.protobuf.Int32Value
-	101, // 60: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.armor:type_name -> google.protobuf.Int32Value
-	30,  // 61: systems.daggerheart.v1.DaggerheartUpdateAdversaryResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
-	30,  // 62: systems.daggerheart.v1.DaggerheartDeleteAdversaryResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
-	30,  // 63: systems.daggerheart.v1.DaggerheartGetAdversaryResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
-	99,  // 64: systems.daggerheart.v1.DaggerheartListAdversariesRequest.session_id:type_name -> google.protobuf.StringValue
-	30,  // 65: systems.daggerheart.v1.DaggerheartListAdversariesResponse.adversaries:type_name -> systems.daggerheart.v1.DaggerheartAdversary
-	97,  // 66: systems.daggerheart.v1.DaggerheartBlazeOfGloryResult.life_state:type_name -> systems.daggerheart.v1.DaggerheartLifeState
-	90,  // 67: systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
-	42,  // 68: systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryResponse.result:type_name -> systems.daggerheart.v1.DaggerheartBlazeOfGloryResult
-	96,  // 69: systems.daggerheart.v1.ActionRollRequest.rng:type_name -> common.v1.RngRequest
-	102, // 70: systems.daggerheart.v1.ActionRollResponse.outcome:type_name -> systems.daggerheart.v1.Outcome
-	103, // 71: systems.daggerheart.v1.ActionRollResponse.rng:type_name -> common.v1.RngResponse
-	102, // 72: systems.daggerheart.v1.DualityOutcomeResponse.outcome:type_name -> systems.daggerheart.v1.Outcome
-	102, // 73: systems.daggerheart.v1.DualityExplainResponse.outcome:type_name -> systems.daggerheart.v1.Outcome
-	104, // 74: systems.daggerheart.v1.DualityExplainResponse.intermediates:type_name -> systems.daggerheart.v1.Intermediates
-	105, // 75: systems.daggerheart.v1.DualityExplainResponse.steps:type_name -> systems.daggerheart.v1.ExplainStep
-	106, // 76: systems.daggerheart.v1.DualityProbabilityResponse.outcome_counts:type_name -> systems.daggerheart.v1.OutcomeCount
-	102, // 77: systems.daggerheart.v1.RulesVersionResponse.outcomes:type_name -> systems.daggerheart.v1.Outcome
-	107, // 78: systems.daggerheart.v1.RollDiceRequest.dice:type_name -> systems.daggerheart.v1.DiceSpec
-	96,  // 79: systems.daggerheart.v1.RollDiceRequest.rng:type_name -> common.v1.RngRequest
-	108, // 80: systems.daggerheart.v1.RollDiceResponse.rolls:type_name -> systems.daggerheart.v1.DiceRoll
-	103, // 81: systems.daggerheart.v1.RollDiceResponse.rng:type_name -> common.v1.RngResponse
-	2,   // 82: systems.daggerheart.v1.SessionActionRollRequest.roll_kind:type_name -> systems.daggerheart.v1.RollKind
-	109, // 83: systems.daggerheart.v1.SessionActionRollRequest.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
-	96,  // 84: systems.daggerheart.v1.SessionActionRollRequest.rng:type_name -> common.v1.RngRequest
-	103, // 85: systems.daggerheart.v1.SessionActionRollResponse.rng:type_name -> common.v1.RngResponse
-	107, // 86: systems.daggerheart.v1.SessionDamageRollRequest.dice:type_name -> systems.daggerheart.v1.DiceSpec
-	96,  // 87: systems.daggerheart.v1.SessionDamageRollRequest.rng:type_name -> common.v1.RngRequest
-	108, // 88: systems.daggerheart.v1.SessionDamageRollResponse.rolls:type_name -> systems.daggerheart.v1.DiceRoll
-	103, // 89: systems.daggerheart.v1.SessionDamageRollResponse.rng:type_name -> common.v1.RngResponse
-	110, // 90: systems.daggerheart.v1.DaggerheartAttackDamageSpec.damage_type:type_name -> systems.daggerheart.v1.DaggerheartDamageType
-	109, // 91: systems.daggerheart.v1.SessionAttackFlowRequest.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
-	107, // 92: systems.daggerheart.v1.SessionAttackFlowRequest.damage_dice:type_name -> systems.daggerheart.v1.DiceSpec
-	60,  // 93: systems.daggerheart.v1.SessionAttackFlowRequest.damage:type_name -> systems.daggerheart.v1.DaggerheartAttackDamageSpec
-	96,  // 94: systems.daggerheart.v1.SessionAttackFlowRequest.action_rng:type_name -> common.v1.RngRequest
-	96,  // 95: systems.daggerheart.v1.SessionAttackFlowRequest.damage_rng:type_name -> common.v1.RngRequest
-	57,  // 96: systems.daggerheart.v1.SessionAttackFlowResponse.action_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
-	79,  // 97: systems.daggerheart.v1.SessionAttackFlowResponse.roll_outcome:type_name -> systems.daggerheart.v1.ApplyRollOutcomeResponse
-	83,  // 98: systems.daggerheart.v1.SessionAttackFlowResponse.attack_outcome:type_name -> systems.daggerheart.v1.DaggerheartApplyAttackOutcomeResponse
-	59,  // 99: systems.daggerheart.v1.SessionAttackFlowResponse.damage_roll:type_name -> systems.daggerheart.v1.SessionDamageRollResponse
-	4,   // 100: systems.daggerheart.v1.SessionAttackFlowResponse.damage_applied:type_name -> systems.daggerheart.v1.DaggerheartApplyDamageResponse
-	109, // 101: systems.daggerheart.v1.SessionReactionFlowRequest.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
-	96,  // 102: systems.daggerheart.v1.SessionReactionFlowRequest.reaction_rng:type_name -> common.v1.RngRequest
-	57,  // 103: systems.daggerheart.v1.SessionReactionFlowResponse.action_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
-	79,  // 104: systems.daggerheart.v1.SessionReactionFlowResponse.roll_outcome:type_name -> systems.daggerheart.v1.ApplyRollOutcomeResponse
-	88,  // 105: systems.daggerheart.v1.SessionReactionFlowResponse.reaction_outcome:type_name -> systems.daggerheart.v1.DaggerheartApplyReactionOutcomeResponse
-	96,  // 106: systems.daggerheart.v1.SessionAdversaryAttackRollRequest.rng:type_name -> common.v1.RngRequest
-	96,  // 107: systems.daggerheart.v1.SessionAdversaryActionCheckRequest.rng:type_name -> common.v1.RngRequest
-	103, // 108: systems.daggerheart.v1.SessionAdversaryActionCheckResponse.rng:type_name -> common.v1.RngResponse
-	103, // 109: systems.daggerheart.v1.SessionAdversaryAttackRollResponse.rng:type_name -> common.v1.RngResponse
-	107, // 110: systems.daggerheart.v1.SessionAdversaryAttackFlowRequest.damage_dice:type_name -> systems.daggerheart.v1.DiceSpec
-	60,  // 111: systems.daggerheart.v1.SessionAdversaryAttackFlowRequest.damage:type_name -> systems.daggerheart.v1.DaggerheartAttackDamageSpec
-	96,  // 112: systems.daggerheart.v1.SessionAdversaryAttackFlowRequest.attack_rng:type_name -> common.v1.RngRequest
-	96,  // 113: systems.daggerheart.v1.SessionAdversaryAttackFlowRequest.damage_rng:type_name -> common.v1.RngRequest
-	68,  // 114: systems.daggerheart.v1.SessionAdversaryAttackFlowResponse.attack_roll:type_name -> systems.daggerheart.v1.SessionAdversaryAttackRollResponse
-	85,  // 115: systems.daggerheart.v1.SessionAdversaryAttackFlowResponse.attack_outcome:type_name -> systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeResponse
-	59,  // 116: systems.daggerheart.v1.SessionAdversaryAttackFlowResponse.damage_roll:type_name -> systems.daggerheart.v1.SessionDamageRollResponse
-	4,   // 117: systems.daggerheart.v1.SessionAdversaryAttackFlowResponse.damage_applied:type_name -> systems.daggerheart.v1.DaggerheartApplyDamageResponse
-	109, // 118: systems.daggerheart.v1.GroupActionSupporter.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
-	96,  // 119: systems.daggerheart.v1.GroupActionSupporter.rng:type_name -> common.v1.RngRequest
-	57,  // 120: systems.daggerheart.v1.GroupActionSupporterRoll.action_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
-	109, // 121: systems.daggerheart.v1.SessionGroupActionFlowRequest.leader_modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
-	71,  // 122: systems.daggerheart.v1.SessionGroupActionFlowRequest.supporters:type_name -> systems.daggerheart.v1.GroupActionSupporter
-	96,  // 123: systems.daggerheart.v1.SessionGroupActionFlowRequest.leader_rng:type_name -> common.v1.RngRequest
-	57,  // 124: systems.daggerheart.v1.SessionGroupActionFlowResponse.leader_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
-	79,  // 125: systems.daggerheart.v1.SessionGroupActionFlowResponse.leader_outcome:type_name -> systems.daggerheart.v1.ApplyRollOutcomeResponse
-	72,  // 126: systems.daggerheart.v1.SessionGroupActionFlowResponse.supporter_rolls:type_name -> systems.daggerheart.v1.GroupActionSupporterRoll
-	109, // 127: systems.daggerheart.v1.TagTeamParticipant.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
-	96,  // 128: systems.daggerheart.v1.TagTeamParticipant.rng:type_name -> common.v1.RngRequest
-	75,  // 129: systems.daggerheart.v1.SessionTagTeamFlowRequest.first:type_name -> systems.daggerheart.v1.TagTeamParticipant
-	75,  // 130: systems.daggerheart.v1.SessionTagTeamFlowRequest.second:type_name -> systems.daggerheart.v1.TagTeamParticipant
-	57,  // 131: systems.daggerheart.v1.SessionTagTeamFlowResponse.first_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
-	57,  // 132: systems.daggerheart.v1.SessionTagTeamFlowResponse.second_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
-	79,  // 133: systems.daggerheart.v1.SessionTagTeamFlowResponse.selected_outcome:type_name -> systems.daggerheart.v1.ApplyRollOutcomeResponse
-	111, // 134: systems.daggerheart.v1.ApplyRollOutcomeResponse.updated:type_name -> systems.daggerheart.v1.OutcomeUpdated
-	102, // 135: systems.daggerheart.v1.DaggerheartAttackOutcomeResult.outcome:type_name -> systems.daggerheart.v1.Outcome
-	82,  // 136: systems.daggerheart.v1.DaggerheartApplyAttackOutcomeResponse.result:type_name -> systems.daggerheart.v1.DaggerheartAttackOutcomeResult
-	84,  // 137: systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeResponse.result:type_name -> systems.daggerheart.v1.DaggerheartAdversaryAttackOutcomeResult
-	102, // 138: systems.daggerheart.v1.DaggerheartReactionOutcomeResult.outcome:type_name -> systems.daggerheart.v1.Outcome
-	87,  // 139: systems.daggerheart.v1.DaggerheartApplyReactionOutcomeResponse.result:type_name -> systems.daggerheart.v1.DaggerheartReactionOutcomeResult
-	44,  // 140: systems.daggerheart.v1.DaggerheartService.ActionRoll:input_type -> systems.daggerheart.v1.ActionRollRequest
-	46,  // 141: systems.daggerheart.v1.DaggerheartService.DualityOutcome:input_type -> systems.daggerheart.v1.DualityOutcomeRequest
-	48,  // 142: systems.daggerheart.v1.DaggerheartService.DualityExplain:input_type -> systems.daggerheart.v1.DualityExplainRequest
-	50,  // 143: systems.daggerheart.v1.DaggerheartService.DualityProbability:input_type -> systems.daggerheart.v1.DualityProbabilityRequest
-	52,  // 144: systems.daggerheart.v1.DaggerheartService.RulesVersion:input_type -> systems.daggerheart.v1.RulesVersionRequest
-	54,  // 145: systems.daggerheart.v1.DaggerheartService.RollDice:input_type -> systems.daggerheart.v1.RollDiceRequest
-	3,   // 146: systems.daggerheart.v1.DaggerheartService.ApplyDamage:input_type -> systems.daggerheart.v1.DaggerheartApplyDamageRequest
-	5,   // 147: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryDamage:input_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryDamageRequest
-	7,   // 148: systems.daggerheart.v1.DaggerheartService.ApplyRest:input_type -> systems.daggerheart.v1.DaggerheartApplyRestRequest
-	10,  // 149: systems.daggerheart.v1.DaggerheartService.ApplyDowntimeMove:input_type -> systems.daggerheart.v1.DaggerheartApplyDowntimeMoveRequest
-	12,  // 150: systems.daggerheart.v1.DaggerheartService.SwapLoadout:input_type -> systems.daggerheart.v1.DaggerheartSwapLoadoutRequest
-	14,  // 151: systems.daggerheart.v1.DaggerheartService.ApplyDeathMove:input_type -> systems.daggerheart.v1.DaggerheartApplyDeathMoveRequest
-	17,  // 152: systems.daggerheart.v1.DaggerheartService.ApplyConditions:input_type -> systems.daggerheart.v1.DaggerheartApplyConditionsRequest
-	19,  // 153: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryConditions:input_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsRequest
-	21,  // 154: systems.daggerheart.v1.DaggerheartService.ApplyGmMove:input_type -> systems.daggerheart.v1.DaggerheartApplyGmMoveRequest
-	24,  // 155: systems.daggerheart.v1.DaggerheartService.CreateCountdown:input_type -> systems.daggerheart.v1.DaggerheartCreateCountdownRequest
-	26,  // 156: systems.daggerheart.v1.DaggerheartService.UpdateCountdown:input_type -> systems.daggerheart.v1.DaggerheartUpdateCountdownRequest
-	28,  // 157: systems.daggerheart.v1.DaggerheartService.DeleteCountdown:input_type -> systems.daggerheart.v1.DaggerheartDeleteCountdownRequest
-	31,  // 158: systems.daggerheart.v1.DaggerheartService.CreateAdversary:input_type -> systems.daggerheart.v1.DaggerheartCreateAdversaryRequest
-	33,  // 159: systems.daggerheart.v1.DaggerheartService.UpdateAdversary:input_type -> systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest
-	35,  // 160: systems.daggerheart.v1.DaggerheartService.DeleteAdversary:input_type -> systems.daggerheart.v1.DaggerheartDeleteAdversaryRequest
-	37,  // 161: systems.daggerheart.v1.DaggerheartService.GetAdversary:input_type -> systems.daggerheart.v1.DaggerheartGetAdversaryRequest
-	39,  // 162: systems.daggerheart.v1.DaggerheartService.ListAdversaries:input_type -> systems.daggerheart.v1.DaggerheartListAdversariesRequest
-	41,  // 163: systems.daggerheart.v1.DaggerheartService.ResolveBlazeOfGlory:input_type -> systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryRequest
-	56,  // 164: systems.daggerheart.v1.DaggerheartService.SessionActionRoll:input_type -> systems.daggerheart.v1.SessionActionRollRequest
-	58,  // 165: systems.daggerheart.v1.DaggerheartService.SessionDamageRoll:input_type -> systems.daggerheart.v1.SessionDamageRollRequest
-	61,  // 166: systems.daggerheart.v1.DaggerheartService.SessionAttackFlow:input_type -> systems.daggerheart.v1.SessionAttackFlowRequest
-	63,  // 167: systems.daggerheart.v1.DaggerheartService.SessionReactionFlow:input_type -> systems.daggerheart.v1.SessionReactionFlowRequest
-	65,  // 168: systems.daggerheart.v1.DaggerheartService.SessionAdversaryAttackRoll:input_type -> systems.daggerheart.v1.SessionAdversaryAttackRollRequest
-	66,  // 169: systems.daggerheart.v1.DaggerheartService.SessionAdversaryActionCheck:input_type -> systems.daggerheart.v1.SessionAdversaryActionCheckRequest
-	69,  // 170: systems.daggerheart.v1.DaggerheartService.SessionAdversaryAttackFlow:input_type -> systems.daggerheart.v1.SessionAdversaryAttackFlowRequest
-	73,  // 171: systems.daggerheart.v1.DaggerheartService.SessionGroupActionFlow:input_type -> systems.daggerheart.v1.SessionGroupActionFlowRequest
-	76,  // 172: systems.daggerheart.v1.DaggerheartService.SessionTagTeamFlow:input_type -> systems.daggerheart.v1.SessionTagTeamFlowRequest
-	78,  // 173: systems.daggerheart.v1.DaggerheartService.ApplyRollOutcome:input_type -> systems.daggerheart.v1.ApplyRollOutcomeRequest
-	80,  // 174: systems.daggerheart.v1.DaggerheartService.ApplyAttackOutcome:input_type -> systems.daggerheart.v1.DaggerheartApplyAttackOutcomeRequest
-	81,  // 175: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryAttackOutcome:input_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeRequest
-	86,  // 176: systems.daggerheart.v1.DaggerheartService.ApplyReactionOutcome:input_type -> systems.daggerheart.v1.DaggerheartApplyReactionOutcomeRequest
-	45,  // 177: systems.daggerheart.v1.DaggerheartService.ActionRoll:output_type -> systems.daggerheart.v1.ActionRollResponse
-	47,  // 178: systems.daggerheart.v1.DaggerheartService.DualityOutcome:output_type -> systems.daggerheart.v1.DualityOutcomeResponse
-	49,  // 179: systems.daggerheart.v1.DaggerheartService.DualityExplain:output_type -> systems.daggerheart.v1.DualityExplainResponse
-	51,  // 180: systems.daggerheart.v1.DaggerheartService.DualityProbability:output_type -> systems.daggerheart.v1.DualityProbabilityResponse
-	53,  // 181: systems.daggerheart.v1.DaggerheartService.RulesVersion:output_type -> systems.daggerheart.v1.RulesVersionResponse
-	55,  // 182: systems.daggerheart.v1.DaggerheartService.RollDice:output_type -> systems.daggerheart.v1.RollDiceResponse
-	4,   // 183: systems.daggerheart.v1.DaggerheartService.ApplyDamage:output_type -> systems.daggerheart.v1.DaggerheartApplyDamageResponse
-	6,   // 184: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryDamage:output_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryDamageResponse
-	9,   // 185: systems.daggerheart.v1.DaggerheartService.ApplyRest:output_type -> systems.daggerheart.v1.DaggerheartApplyRestResponse
-	11,  // 186: systems.daggerheart.v1.DaggerheartService.ApplyDowntimeMove:output_type -> systems.daggerheart.v1.DaggerheartApplyDowntimeMoveResponse
-	13,  // 187: systems.daggerheart.v1.DaggerheartService.SwapLoadout:output_type -> systems.daggerheart.v1.DaggerheartSwapLoadoutResponse
-	16,  // 188: systems.daggerheart.v1.DaggerheartService.ApplyDeathMove:output_type -> systems.daggerheart.v1.DaggerheartApplyDeathMoveResponse
-	18,  // 189: systems.daggerheart.v1.DaggerheartService.ApplyConditions:output_type -> systems.daggerheart.v1.DaggerheartApplyConditionsResponse
-	20,  // 190: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryConditions:output_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsResponse
-	22,  // 191: systems.daggerheart.v1.DaggerheartService.ApplyGmMove:output_type -> systems.daggerheart.v1.DaggerheartApplyGmMoveResponse
-	25,  // 192: systems.daggerheart.v1.DaggerheartService.CreateCountdown:output_type -> systems.daggerheart.v1.DaggerheartCreateCountdownResponse
-	27,  // 193: systems.daggerheart.v1.DaggerheartService.UpdateCountdown:output_type -> systems.daggerheart.v1.DaggerheartUpdateCountdownResponse
-	29,  // 194: systems.daggerheart.v1.DaggerheartService.DeleteCountdown:output_type -> systems.daggerheart.v1.DaggerheartDeleteCountdownResponse
-	32,  // 195: systems.daggerheart.v1.DaggerheartService.CreateAdversary:output_type -> systems.daggerheart.v1.DaggerheartCreateAdversaryResponse
-	34,  // 196: systems.daggerheart.v1.DaggerheartService.UpdateAdversary:output_type -> systems.daggerheart.v1.DaggerheartUpdateAdversaryResponse
-	36,  // 197: systems.daggerheart.v1.DaggerheartService.DeleteAdversary:output_type -> systems.daggerheart.v1.DaggerheartDeleteAdversaryResponse
-	38,  // 198: systems.daggerheart.v1.DaggerheartService.GetAdversary:output_type -> systems.daggerheart.v1.DaggerheartGetAdversaryResponse
-	40,  // 199: systems.daggerheart.v1.DaggerheartService.ListAdversaries:output_type -> systems.daggerheart.v1.DaggerheartListAdversariesResponse
-	43,  // 200: systems.daggerheart.v1.DaggerheartService.ResolveBlazeOfGlory:output_type -> systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryResponse
-	57,  // 201: systems.daggerheart.v1.DaggerheartService.SessionActionRoll:output_type -> systems.daggerheart.v1.SessionActionRollResponse
-	59,  // 202: systems.daggerheart.v1.DaggerheartService.SessionDamageRoll:output_type -> systems.daggerheart.v1.SessionDamageRollResponse
-	62,  // 203: systems.daggerheart.v1.DaggerheartService.SessionAttackFlow:output_type -> systems.daggerheart.v1.SessionAttackFlowResponse
-	64,  // 204: systems.daggerheart.v1.DaggerheartService.SessionReactionFlow:output_type -> systems.daggerheart.v1.SessionReactionFlowResponse
-	68,  // 205: systems.daggerheart.v1.DaggerheartService.SessionAdversaryAttackRoll:output_type -> systems.daggerheart.v1.SessionAdversaryAttackRollResponse
-	67,  // 206: systems.daggerheart.v1.DaggerheartService.SessionAdversaryActionCheck:output_type -> systems.daggerheart.v1.SessionAdversaryActionCheckResponse
-	70,  // 207: systems.daggerheart.v1.DaggerheartService.SessionAdversaryAttackFlow:output_type -> systems.daggerheart.v1.SessionAdversaryAttackFlowResponse
-	74,  // 208: systems.daggerheart.v1.DaggerheartService.SessionGroupActionFlow:output_type -> systems.daggerheart.v1.SessionGroupActionFlowResponse
-	77,  // 209: systems.daggerheart.v1.DaggerheartService.SessionTagTeamFlow:output_type -> systems.daggerheart.v1.SessionTagTeamFlowResponse
-	79,  // 210: systems.daggerheart.v1.DaggerheartService.ApplyRollOutcome:output_type -> systems.daggerheart.v1.ApplyRollOutcomeResponse
-	83,  // 211: systems.daggerheart.v1.DaggerheartService.ApplyAttackOutcome:output_type -> systems.daggerheart.v1.DaggerheartApplyAttackOutcomeResponse
-	85,  // 212: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryAttackOutcome:output_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeResponse
-	88,  // 213: systems.daggerheart.v1.DaggerheartService.ApplyReactionOutcome:output_type -> systems.daggerheart.v1.DaggerheartApplyReactionOutcomeResponse
-	177, // [177:214] is the sub-list for method output_type
-	140, // [140:177] is the sub-list for method input_type
-	140, // [140:140] is the sub-list for extension type_name
-	140, // [140:140] is the sub-list for extension extendee
-	0,   // [0:140] is the sub-list for field type_name
+	184, // 0: systems.daggerheart.v1.DaggerheartApplyDamageRequest.damage:type_name -> systems.daggerheart.v1.DaggerheartDamageRequest
+	185, // 1: systems.daggerheart.v1.DaggerheartApplyDamageResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	184, // 2: systems.daggerheart.v1.DaggerheartApplyAdversaryDamageRequest.damage:type_name -> systems.daggerheart.v1.DaggerheartDamageRequest
+	70,  // 3: systems.daggerheart.v1.DaggerheartApplyAdversaryDamageResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
+	186, // 4: systems.daggerheart.v1.DaggerheartApplyRestRequest.rest:type_name -> systems.daggerheart.v1.DaggerheartRestRequest
+	185, // 5: systems.daggerheart.v1.DaggerheartCharacterStateEntry.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	187, // 6: systems.daggerheart.v1.DaggerheartApplyRestResponse.snapshot:type_name -> systems.daggerheart.v1.DaggerheartSnapshot
+	17,  // 7: systems.daggerheart.v1.DaggerheartApplyRestResponse.character_states:type_name -> systems.daggerheart.v1.DaggerheartCharacterStateEntry
+	188, // 8: systems.daggerheart.v1.DaggerheartApplyDowntimeMoveRequest.move:type_name -> systems.daggerheart.v1.DaggerheartDowntimeRequest
+	185, // 9: systems.daggerheart.v1.DaggerheartApplyDowntimeMoveResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	189, // 10: systems.daggerheart.v1.DaggerheartSwapLoadoutRequest.swap:type_name -> systems.daggerheart.v1.DaggerheartLoadoutSwapRequest
+	185, // 11: systems.daggerheart.v1.DaggerheartSwapLoadoutResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	190, // 12: systems.daggerheart.v1.DaggerheartApplyDeathMoveRequest.move:type_name -> systems.daggerheart.v1.DaggerheartDeathMove
+	191, // 13: systems.daggerheart.v1.DaggerheartApplyDeathMoveRequest.rng:type_name -> common.v1.RngRequest
+	190, // 14: systems.daggerheart.v1.DaggerheartDeathMoveResult.move:type_name -> systems.daggerheart.v1.DaggerheartDeathMove
+	192, // 15: systems.daggerheart.v1.DaggerheartDeathMoveResult.life_state:type_name -> systems.daggerheart.v1.DaggerheartLifeState
+	185, // 16: systems.daggerheart.v1.DaggerheartApplyDeathMoveResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	24,  // 17: systems.daggerheart.v1.DaggerheartApplyDeathMoveResponse.result:type_name -> systems.daggerheart.v1.DaggerheartDeathMoveResult
+	193, // 18: systems.daggerheart.v1.DaggerheartApplyConditionsRequest.add:type_name -> systems.daggerheart.v1.DaggerheartCondition
+	193, // 19: systems.daggerheart.v1.DaggerheartApplyConditionsRequest.remove:type_name -> systems.daggerheart.v1.DaggerheartCondition
+	192, // 20: systems.daggerheart.v1.DaggerheartApplyConditionsRequest.life_state:type_name -> systems.daggerheart.v1.DaggerheartLifeState
+	185, // 21: systems.daggerheart.v1.DaggerheartApplyConditionsResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	193, // 22: systems.daggerheart.v1.DaggerheartApplyConditionsResponse.added:type_name -> systems.daggerheart.v1.DaggerheartCondition
+	193, // 23: systems.daggerheart.v1.DaggerheartApplyConditionsResponse.removed:type_name -> systems.daggerheart.v1.DaggerheartCondition
+	193, // 24: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsRequest.add:type_name -> systems.daggerheart.v1.DaggerheartCondition
+	193, // 25: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsRequest.remove:type_name -> systems.daggerheart.v1.DaggerheartCondition
+	70,  // 26: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
+	193, // 27: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsResponse.added:type_name -> systems.daggerheart.v1.DaggerheartCondition
+	193, // 28: systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsResponse.removed:type_name -> systems.daggerheart.v1.DaggerheartCondition
+	194, // 29: systems.daggerheart.v1.DaggerheartCountdownOutcomeDelta.outcome:type_name -> systems.daggerheart.v1.Outcome
+	2,   // 30: systems.daggerheart.v1.DaggerheartCountdownTickPolicy.trigger:type_name -> systems.daggerheart.v1.DaggerheartCountdownTrigger
+	32,  // 31: systems.daggerheart.v1.DaggerheartCountdownTickPolicy.outcome_deltas:type_name -> systems.daggerheart.v1.DaggerheartCountdownOutcomeDelta
+	0,   // 32: systems.daggerheart.v1.DaggerheartCountdown.kind:type_name -> systems.daggerheart.v1.DaggerheartCountdownKind
+	1,   // 33: systems.daggerheart.v1.DaggerheartCountdown.direction:type_name -> systems.daggerheart.v1.DaggerheartCountdownDirection
+	33,  // 34: systems.daggerheart.v1.DaggerheartCountdown.tick_policy:type_name -> systems.daggerheart.v1.DaggerheartCountdownTickPolicy
+	0,   // 35: systems.daggerheart.v1.DaggerheartCreateCountdownRequest.kind:type_name -> systems.daggerheart.v1.DaggerheartCountdownKind
+	1,   // 36: systems.daggerheart.v1.DaggerheartCreateCountdownRequest.direction:type_name -> systems.daggerheart.v1.DaggerheartCountdownDirection
+	33,  // 37: systems.daggerheart.v1.DaggerheartCreateCountdownRequest.tick_policy:type_name -> systems.daggerheart.v1.DaggerheartCountdownTickPolicy
+	34,  // 38: systems.daggerheart.v1.DaggerheartCreateCountdownResponse.countdown:type_name -> systems.daggerheart.v1.DaggerheartCountdown
+	34,  // 39: systems.daggerheart.v1.DaggerheartUpdateCountdownResponse.countdown:type_name -> systems.daggerheart.v1.DaggerheartCountdown
+	3,   // 40: systems.daggerheart.v1.DaggerheartChase.status:type_name -> systems.daggerheart.v1.DaggerheartChaseStatus
+	4,   // 41: systems.daggerheart.v1.DaggerheartChase.winner:type_name -> systems.daggerheart.v1.DaggerheartChaseWinner
+	34,  // 42: systems.daggerheart.v1.DaggerheartChase.progress:type_name -> systems.daggerheart.v1.DaggerheartCountdown
+	34,  // 43: systems.daggerheart.v1.DaggerheartChase.consequence:type_name -> systems.daggerheart.v1.DaggerheartCountdown
+	32,  // 44: systems.daggerheart.v1.DaggerheartChaseCountdownSpec.outcome_deltas:type_name -> systems.daggerheart.v1.DaggerheartCountdownOutcomeDelta
+	42,  // 45: systems.daggerheart.v1.DaggerheartStartChaseRequest.progress:type_name -> systems.daggerheart.v1.DaggerheartChaseCountdownSpec
+	42,  // 46: systems.daggerheart.v1.DaggerheartStartChaseRequest.consequence:type_name -> systems.daggerheart.v1.DaggerheartChaseCountdownSpec
+	41,  // 47: systems.daggerheart.v1.DaggerheartStartChaseResponse.chase:type_name -> systems.daggerheart.v1.DaggerheartChase
+	41,  // 48: systems.daggerheart.v1.DaggerheartGetChaseResponse.chase:type_name -> systems.daggerheart.v1.DaggerheartChase
+	41,  // 49: systems.daggerheart.v1.DaggerheartListChasesResponse.chases:type_name -> systems.daggerheart.v1.DaggerheartChase
+	6,   // 50: systems.daggerheart.v1.DaggerheartSceneEntity.type:type_name -> systems.daggerheart.v1.DaggerheartSceneEntityType
+	49,  // 51: systems.daggerheart.v1.DaggerheartSceneRange.from:type_name -> systems.daggerheart.v1.DaggerheartSceneEntity
+	49,  // 52: systems.daggerheart.v1.DaggerheartSceneRange.to:type_name -> systems.daggerheart.v1.DaggerheartSceneEntity
+	5,   // 53: systems.daggerheart.v1.DaggerheartSceneRange.band:type_name -> systems.daggerheart.v1.DaggerheartRangeBand
+	50,  // 54: systems.daggerheart.v1.DaggerheartSetSceneRangesRequest.ranges:type_name -> systems.daggerheart.v1.DaggerheartSceneRange
+	50,  // 55: systems.daggerheart.v1.DaggerheartSetSceneRangesResponse.ranges:type_name -> systems.daggerheart.v1.DaggerheartSceneRange
+	49,  // 56: systems.daggerheart.v1.DaggerheartSceneRangeUpdate.target:type_name -> systems.daggerheart.v1.DaggerheartSceneEntity
+	5,   // 57: systems.daggerheart.v1.DaggerheartSceneRangeUpdate.band:type_name -> systems.daggerheart.v1.DaggerheartRangeBand
+	49,  // 58: systems.daggerheart.v1.DaggerheartMoveSceneEntityRequest.entity:type_name -> systems.daggerheart.v1.DaggerheartSceneEntity
+	7,   // 59: systems.daggerheart.v1.DaggerheartMoveSceneEntityRequest.kind:type_name -> systems.daggerheart.v1.DaggerheartMovementKind
+	49,  // 60: systems.daggerheart.v1.DaggerheartMoveSceneEntityRequest.source:type_name -> systems.daggerheart.v1.DaggerheartSceneEntity
+	53,  // 61: systems.daggerheart.v1.DaggerheartMoveSceneEntityRequest.ranges:type_name -> systems.daggerheart.v1.DaggerheartSceneRangeUpdate
+	50,  // 62: systems.daggerheart.v1.DaggerheartMoveSceneEntityResponse.ranges:type_name -> systems.daggerheart.v1.DaggerheartSceneRange
+	50,  // 63: systems.daggerheart.v1.DaggerheartListSceneRangesResponse.ranges:type_name -> systems.daggerheart.v1.DaggerheartSceneRange
+	195, // 64: systems.daggerheart.v1.DaggerheartCreateSessionEnvironmentRequest.difficulty:type_name -> google.protobuf.Int32Value
+	58,  // 65: systems.daggerheart.v1.DaggerheartCreateSessionEnvironmentResponse.environment:type_name -> systems.daggerheart.v1.DaggerheartSessionEnvironment
+	195, // 66: systems.daggerheart.v1.DaggerheartShiftSessionEnvironmentRequest.difficulty:type_name -> google.protobuf.Int32Value
+	58,  // 67: systems.daggerheart.v1.DaggerheartShiftSessionEnvironmentResponse.environment:type_name -> systems.daggerheart.v1.DaggerheartSessionEnvironment
+	58,  // 68: systems.daggerheart.v1.DaggerheartGetSessionEnvironmentResponse.environment:type_name -> systems.daggerheart.v1.DaggerheartSessionEnvironment
+	8,   // 69: systems.daggerheart.v1.DaggerheartTriggerEnvironmentFeatureRequest.kind:type_name -> systems.daggerheart.v1.DaggerheartEnvironmentFeatureKind
+	67,  // 70: systems.daggerheart.v1.DaggerheartTriggerEnvironmentFeatureRequest.spawns:type_name -> systems.daggerheart.v1.DaggerheartEnvironmentSpawn
+	70,  // 71: systems.daggerheart.v1.DaggerheartTriggerEnvironmentFeatureResponse.adversaries:type_name -> systems.daggerheart.v1.DaggerheartAdversary
+	196, // 72: systems.daggerheart.v1.DaggerheartAdversary.session_id:type_name -> google.protobuf.StringValue
+	193, // 73: systems.daggerheart.v1.DaggerheartAdversary.conditions:type_name -> systems.daggerheart.v1.DaggerheartCondition
+	197, // 74: systems.daggerheart.v1.DaggerheartAdversary.created_at:type_name -> google.protobuf.Timestamp
+	197, // 75: systems.daggerheart.v1.DaggerheartAdversary.updated_at:type_name -> google.protobuf.Timestamp
+	198, // 76: systems.daggerheart.v1.DaggerheartAdversary.standard_attack:type_name -> systems.daggerheart.v1.DaggerheartAdversaryAttack
+	199, // 77: systems.daggerheart.v1.DaggerheartAdversary.experiences:type_name -> systems.daggerheart.v1.DaggerheartAdversaryExperience
+	200, // 78: systems.daggerheart.v1.DaggerheartAdversary.features:type_name -> systems.daggerheart.v1.DaggerheartAdversaryFeature
+	196, // 79: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.session_id:type_name -> google.protobuf.StringValue
+	195, // 80: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.hp:type_name -> google.protobuf.Int32Value
+	195, // 81: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.hp_max:type_name -> google.protobuf.Int32Value
+	195, // 82: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.stress:type_name -> google.protobuf.Int32Value
+	195, // 83: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.stress_max:type_name -> google.protobuf.Int32Value
+	195, // 84: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.evasion:type_name -> google.protobuf.Int32Value
+	195, // 85: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.major_threshold:type_name -> google.protobuf.Int32Value
+	195, // 86: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.severe_threshold:type_name -> google.protobuf.Int32Value
+	195, // 87: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.armor:type_name -> google.protobuf.Int32Value
+	195, // 88: systems.daggerheart.v1.DaggerheartCreateAdversaryRequest.minion_threshold:type_name -> google.protobuf.Int32Value
+	70,  // 89: systems.daggerheart.v1.DaggerheartCreateAdversaryResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
+	70,  // 90: systems.daggerheart.v1.DaggerheartCreateAdversaryResponse.adversaries:type_name -> systems.daggerheart.v1.DaggerheartAdversary
+	196, // 91: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.name:type_name -> google.protobuf.StringValue
+	196, // 92: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.kind:type_name -> google.protobuf.StringValue
+	196, // 93: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.session_id:type_name -> google.protobuf.StringValue
+	196, // 94: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.notes:type_name -> google.protobuf.StringValue
+	195, // 95: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.hp:type_name -> google.protobuf.Int32Value
+	195, // 96: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.hp_max:type_name -> google.protobuf.Int32Value
+	195, // 97: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.stress:type_name -> google.protobuf.Int32Value
+	195, // 98: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.stress_max:type_name -> google.protobuf.Int32Value
+	195, // 99: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.evasion:type_name -> google.protobuf.Int32Value
+	195, // 100: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.major_threshold:type_name -> google.protobuf.Int32Value
+	195, // 101: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.severe_threshold:type_name -> google.protobuf.Int32Value
+	195, // 102: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.armor:type_name -> google.protobuf.Int32Value
+	195, // 103: systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest.minion_threshold:type_name -> google.protobuf.Int32Value
+	70,  // 104: systems.daggerheart.v1.DaggerheartUpdateAdversaryResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
+	70,  // 105: systems.daggerheart.v1.DaggerheartDeleteAdversaryResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
+	70,  // 106: systems.daggerheart.v1.DaggerheartGetAdversaryResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
+	196, // 107: systems.daggerheart.v1.DaggerheartListAdversariesRequest.session_id:type_name -> google.protobuf.StringValue
+	70,  // 108: systems.daggerheart.v1.DaggerheartListAdversariesResponse.adversaries:type_name -> systems.daggerheart.v1.DaggerheartAdversary
+	9,   // 109: systems.daggerheart.v1.DaggerheartAdversaryFeatureState.status:type_name -> systems.daggerheart.v1.DaggerheartAdversaryFeatureStatus
+	195, // 110: systems.daggerheart.v1.DaggerheartActivateAdversaryFeatureRequest.fear_cost:type_name -> google.protobuf.Int32Value
+	195, // 111: systems.daggerheart.v1.DaggerheartActivateAdversaryFeatureRequest.stress_cost:type_name -> google.protobuf.Int32Value
+	195, // 112: systems.daggerheart.v1.DaggerheartActivateAdversaryFeatureRequest.difficulty_bonus:type_name -> google.protobuf.Int32Value
+	70,  // 113: systems.daggerheart.v1.DaggerheartActivateAdversaryFeatureResponse.adversary:type_name -> systems.daggerheart.v1.DaggerheartAdversary
+	81,  // 114: systems.daggerheart.v1.DaggerheartActivateAdversaryFeatureResponse.feature_states:type_name -> systems.daggerheart.v1.DaggerheartAdversaryFeatureState
+	81,  // 115: systems.daggerheart.v1.DaggerheartListAdversaryFeatureStatesResponse.feature_states:type_name -> systems.daggerheart.v1.DaggerheartAdversaryFeatureState
+	195, // 116: systems.daggerheart.v1.DaggerheartEncounterOptions.party_size:type_name -> google.protobuf.Int32Value
+	88,  // 117: systems.daggerheart.v1.DaggerheartEncounterBudget.adjustments:type_name -> systems.daggerheart.v1.DaggerheartEncounterAdjustment
+	89,  // 118: systems.daggerheart.v1.DaggerheartEncounterBudget.groups:type_name -> systems.daggerheart.v1.DaggerheartEncounterGroupCost
+	86,  // 119: systems.daggerheart.v1.DaggerheartPlanEncounterRequest.groups:type_name -> systems.daggerheart.v1.DaggerheartEncounterGroup
+	87,  // 120: systems.daggerheart.v1.DaggerheartPlanEncounterRequest.options:type_name -> systems.daggerheart.v1.DaggerheartEncounterOptions
+	90,  // 121: systems.daggerheart.v1.DaggerheartPlanEncounterResponse.budget:type_name -> systems.daggerheart.v1.DaggerheartEncounterBudget
+	86,  // 122: systems.daggerheart.v1.DaggerheartCommitEncounterRequest.groups:type_name -> systems.daggerheart.v1.DaggerheartEncounterGroup
+	87,  // 123: systems.daggerheart.v1.DaggerheartCommitEncounterRequest.options:type_name -> systems.daggerheart.v1.DaggerheartEncounterOptions
+	90,  // 124: systems.daggerheart.v1.DaggerheartCommitEncounterResponse.budget:type_name -> systems.daggerheart.v1.DaggerheartEncounterBudget
+	70,  // 125: systems.daggerheart.v1.DaggerheartCommitEncounterResponse.adversaries:type_name -> systems.daggerheart.v1.DaggerheartAdversary
+	192, // 126: systems.daggerheart.v1.DaggerheartBlazeOfGloryResult.life_state:type_name -> systems.daggerheart.v1.DaggerheartLifeState
+	185, // 127: systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	96,  // 128: systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryResponse.result:type_name -> systems.daggerheart.v1.DaggerheartBlazeOfGloryResult
+	191, // 129: systems.daggerheart.v1.ActionRollRequest.rng:type_name -> common.v1.RngRequest
+	201, // 130: systems.daggerheart.v1.ActionRollRequest.advantage_sources:type_name -> systems.daggerheart.v1.AdvantageSource
+	194, // 131: systems.daggerheart.v1.ActionRollResponse.outcome:type_name -> systems.daggerheart.v1.Outcome
+	202, // 132: systems.daggerheart.v1.ActionRollResponse.rng:type_name -> common.v1.RngResponse
+	194, // 133: systems.daggerheart.v1.DualityOutcomeResponse.outcome:type_name -> systems.daggerheart.v1.Outcome
+	201, // 134: systems.daggerheart.v1.DualityExplainRequest.advantage_sources:type_name -> systems.daggerheart.v1.AdvantageSource
+	203, // 135: systems.daggerheart.v1.DualityExplainRequest.experiences:type_name -> systems.daggerheart.v1.DaggerheartExperience
+	194, // 136: systems.daggerheart.v1.DualityExplainResponse.outcome:type_name -> systems.daggerheart.v1.Outcome
+	204, // 137: systems.daggerheart.v1.DualityExplainResponse.intermediates:type_name -> systems.daggerheart.v1.Intermediates
+	205, // 138: systems.daggerheart.v1.DualityExplainResponse.steps:type_name -> systems.daggerheart.v1.ExplainStep
+	206, // 139: systems.daggerheart.v1.DualityProbabilityResponse.outcome_counts:type_name -> systems.daggerheart.v1.OutcomeCount
+	194, // 140: systems.daggerheart.v1.RulesVersionResponse.outcomes:type_name -> systems.daggerheart.v1.Outcome
+	207, // 141: systems.daggerheart.v1.RollDiceRequest.dice:type_name -> systems.daggerheart.v1.DiceSpec
+	191, // 142: systems.daggerheart.v1.RollDiceRequest.rng:type_name -> common.v1.RngRequest
+	208, // 143: systems.daggerheart.v1.RollDiceResponse.rolls:type_name -> systems.daggerheart.v1.DiceRoll
+	202, // 144: systems.daggerheart.v1.RollDiceResponse.rng:type_name -> common.v1.RngResponse
+	10,  // 145: systems.daggerheart.v1.SessionActionRollRequest.roll_kind:type_name -> systems.daggerheart.v1.RollKind
+	209, // 146: systems.daggerheart.v1.SessionActionRollRequest.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
+	191, // 147: systems.daggerheart.v1.SessionActionRollRequest.rng:type_name -> common.v1.RngRequest
+	201, // 148: systems.daggerheart.v1.SessionActionRollRequest.advantage_sources:type_name -> systems.daggerheart.v1.AdvantageSource
+	202, // 149: systems.daggerheart.v1.SessionActionRollResponse.rng:type_name -> common.v1.RngResponse
+	207, // 150: systems.daggerheart.v1.SessionDamageRollRequest.dice:type_name -> systems.daggerheart.v1.DiceSpec
+	191, // 151: systems.daggerheart.v1.SessionDamageRollRequest.rng:type_name -> common.v1.RngRequest
+	208, // 152: systems.daggerheart.v1.SessionDamageRollResponse.rolls:type_name -> systems.daggerheart.v1.DiceRoll
+	202, // 153: systems.daggerheart.v1.SessionDamageRollResponse.rng:type_name -> common.v1.RngResponse
+	210, // 154: systems.daggerheart.v1.DaggerheartAttackDamageSpec.damage_type:type_name -> systems.daggerheart.v1.DaggerheartDamageType
+	209, // 155: systems.daggerheart.v1.SessionAttackFlowRequest.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
+	207, // 156: systems.daggerheart.v1.SessionAttackFlowRequest.damage_dice:type_name -> systems.daggerheart.v1.DiceSpec
+	114, // 157: systems.daggerheart.v1.SessionAttackFlowRequest.damage:type_name -> systems.daggerheart.v1.DaggerheartAttackDamageSpec
+	191, // 158: systems.daggerheart.v1.SessionAttackFlowRequest.action_rng:type_name -> common.v1.RngRequest
+	191, // 159: systems.daggerheart.v1.SessionAttackFlowRequest.damage_rng:type_name -> common.v1.RngRequest
+	211, // 160: systems.daggerheart.v1.SessionAttackFlowRequest.weapon_slot:type_name -> systems.daggerheart.v1.DaggerheartEquipSlot
+	111, // 161: systems.daggerheart.v1.SessionAttackFlowResponse.action_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
+	141, // 162: systems.daggerheart.v1.SessionAttackFlowResponse.roll_outcome:type_name -> systems.daggerheart.v1.ApplyRollOutcomeResponse
+	145, // 163: systems.daggerheart.v1.SessionAttackFlowResponse.attack_outcome:type_name -> systems.daggerheart.v1.DaggerheartApplyAttackOutcomeResponse
+	113, // 164: systems.daggerheart.v1.SessionAttackFlowResponse.damage_roll:type_name -> systems.daggerheart.v1.SessionDamageRollResponse
+	13,  // 165: systems.daggerheart.v1.SessionAttackFlowResponse.damage_applied:type_name -> systems.daggerheart.v1.DaggerheartApplyDamageResponse
+	209, // 166: systems.daggerheart.v1.SessionSpellcastFlowRequest.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
+	201, // 167: systems.daggerheart.v1.SessionSpellcastFlowRequest.advantage_sources:type_name -> systems.daggerheart.v1.AdvantageSource
+	191, // 168: systems.daggerheart.v1.SessionSpellcastFlowRequest.action_rng:type_name -> common.v1.RngRequest
+	191, // 169: systems.daggerheart.v1.SessionSpellcastFlowRequest.damage_rng:type_name -> common.v1.RngRequest
+	111, // 170: systems.daggerheart.v1.SessionSpellcastFlowResponse.action_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
+	141, // 171: systems.daggerheart.v1.SessionSpellcastFlowResponse.roll_outcome:type_name -> systems.daggerheart.v1.ApplyRollOutcomeResponse
+	113, // 172: systems.daggerheart.v1.SessionSpellcastFlowResponse.damage_roll:type_name -> systems.daggerheart.v1.SessionDamageRollResponse
+	209, // 173: systems.daggerheart.v1.SessionReactionFlowRequest.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
+	191, // 174: systems.daggerheart.v1.SessionReactionFlowRequest.reaction_rng:type_name -> common.v1.RngRequest
+	111, // 175: systems.daggerheart.v1.SessionReactionFlowResponse.action_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
+	141, // 176: systems.daggerheart.v1.SessionReactionFlowResponse.roll_outcome:type_name -> systems.daggerheart.v1.ApplyRollOutcomeResponse
+	150, // 177: systems.daggerheart.v1.SessionReactionFlowResponse.reaction_outcome:type_name -> systems.daggerheart.v1.DaggerheartApplyReactionOutcomeResponse
+	191, // 178: systems.daggerheart.v1.SessionAdversaryAttackRollRequest.rng:type_name -> common.v1.RngRequest
+	191, // 179: systems.daggerheart.v1.SessionAdversaryActionCheckRequest.rng:type_name -> common.v1.RngRequest
+	202, // 180: systems.daggerheart.v1.SessionAdversaryActionCheckResponse.rng:type_name -> common.v1.RngResponse
+	202, // 181: systems.daggerheart.v1.SessionAdversaryAttackRollResponse.rng:type_name -> common.v1.RngResponse
+	207, // 182: systems.daggerheart.v1.SessionAdversaryAttackFlowRequest.damage_dice:type_name -> systems.daggerheart.v1.DiceSpec
+	114, // 183: systems.daggerheart.v1.SessionAdversaryAttackFlowRequest.damage:type_name -> systems.daggerheart.v1.DaggerheartAttackDamageSpec
+	191, // 184: systems.daggerheart.v1.SessionAdversaryAttackFlowRequest.attack_rng:type_name -> common.v1.RngRequest
+	191, // 185: systems.daggerheart.v1.SessionAdversaryAttackFlowRequest.damage_rng:type_name -> common.v1.RngRequest
+	124, // 186: systems.daggerheart.v1.SessionAdversaryAttackFlowResponse.attack_roll:type_name -> systems.daggerheart.v1.SessionAdversaryAttackRollResponse
+	147, // 187: systems.daggerheart.v1.SessionAdversaryAttackFlowResponse.attack_outcome:type_name -> systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeResponse
+	113, // 188: systems.daggerheart.v1.SessionAdversaryAttackFlowResponse.damage_roll:type_name -> systems.daggerheart.v1.SessionDamageRollResponse
+	13,  // 189: systems.daggerheart.v1.SessionAdversaryAttackFlowResponse.damage_applied:type_name -> systems.daggerheart.v1.DaggerheartApplyDamageResponse
+	195, // 190: systems.daggerheart.v1.MultiAttackTarget.difficulty:type_name -> google.protobuf.Int32Value
+	209, // 191: systems.daggerheart.v1.SessionMultiAttackFlowRequest.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
+	127, // 192: systems.daggerheart.v1.SessionMultiAttackFlowRequest.targets:type_name -> systems.daggerheart.v1.MultiAttackTarget
+	207, // 193: systems.daggerheart.v1.SessionMultiAttackFlowRequest.damage_dice:type_name -> systems.daggerheart.v1.DiceSpec
+	114, // 194: systems.daggerheart.v1.SessionMultiAttackFlowRequest.damage:type_name -> systems.daggerheart.v1.DaggerheartAttackDamageSpec
+	191, // 195: systems.daggerheart.v1.SessionMultiAttackFlowRequest.action_rng:type_name -> common.v1.RngRequest
+	191, // 196: systems.daggerheart.v1.SessionMultiAttackFlowRequest.damage_rng:type_name -> common.v1.RngRequest
+	111, // 197: systems.daggerheart.v1.SessionMultiAttackFlowResponse.action_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
+	141, // 198: systems.daggerheart.v1.SessionMultiAttackFlowResponse.roll_outcome:type_name -> systems.daggerheart.v1.ApplyRollOutcomeResponse
+	113, // 199: systems.daggerheart.v1.SessionMultiAttackFlowResponse.damage_roll:type_name -> systems.daggerheart.v1.SessionDamageRollResponse
+	128, // 200: systems.daggerheart.v1.SessionMultiAttackFlowResponse.results:type_name -> systems.daggerheart.v1.MultiAttackTargetResult
+	127, // 201: systems.daggerheart.v1.SessionAdversaryMultiAttackFlowRequest.targets:type_name -> systems.daggerheart.v1.MultiAttackTarget
+	207, // 202: systems.daggerheart.v1.SessionAdversaryMultiAttackFlowRequest.damage_dice:type_name -> systems.daggerheart.v1.DiceSpec
+	114, // 203: systems.daggerheart.v1.SessionAdversaryMultiAttackFlowRequest.damage:type_name -> systems.daggerheart.v1.DaggerheartAttackDamageSpec
+	191, // 204: systems.daggerheart.v1.SessionAdversaryMultiAttackFlowRequest.attack_rng:type_name -> common.v1.RngRequest
+	191, // 205: systems.daggerheart.v1.SessionAdversaryMultiAttackFlowRequest.damage_rng:type_name -> common.v1.RngRequest
+	124, // 206: systems.daggerheart.v1.SessionAdversaryMultiAttackFlowResponse.attack_roll:type_name -> systems.daggerheart.v1.SessionAdversaryAttackRollResponse
+	113, // 207: systems.daggerheart.v1.SessionAdversaryMultiAttackFlowResponse.damage_roll:type_name -> systems.daggerheart.v1.SessionDamageRollResponse
+	128, // 208: systems.daggerheart.v1.SessionAdversaryMultiAttackFlowResponse.results:type_name -> systems.daggerheart.v1.MultiAttackTargetResult
+	209, // 209: systems.daggerheart.v1.GroupActionSupporter.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
+	191, // 210: systems.daggerheart.v1.GroupActionSupporter.rng:type_name -> common.v1.RngRequest
+	111, // 211: systems.daggerheart.v1.GroupActionSupporterRoll.action_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
+	209, // 212: systems.daggerheart.v1.SessionGroupActionFlowRequest.leader_modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
+	133, // 213: systems.daggerheart.v1.SessionGroupActionFlowRequest.supporters:type_name -> systems.daggerheart.v1.GroupActionSupporter
+	191, // 214: systems.daggerheart.v1.SessionGroupActionFlowRequest.leader_rng:type_name -> common.v1.RngRequest
+	111, // 215: systems.daggerheart.v1.SessionGroupActionFlowResponse.leader_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
+	141, // 216: systems.daggerheart.v1.SessionGroupActionFlowResponse.leader_outcome:type_name -> systems.daggerheart.v1.ApplyRollOutcomeResponse
+	134, // 217: systems.daggerheart.v1.SessionGroupActionFlowResponse.supporter_rolls:type_name -> systems.daggerheart.v1.GroupActionSupporterRoll
+	209, // 218: systems.daggerheart.v1.TagTeamParticipant.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
+	191, // 219: systems.daggerheart.v1.TagTeamParticipant.rng:type_name -> common.v1.RngRequest
+	137, // 220: systems.daggerheart.v1.SessionTagTeamFlowRequest.first:type_name -> systems.daggerheart.v1.TagTeamParticipant
+	137, // 221: systems.daggerheart.v1.SessionTagTeamFlowRequest.second:type_name -> systems.daggerheart.v1.TagTeamParticipant
+	111, // 222: systems.daggerheart.v1.SessionTagTeamFlowResponse.first_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
+	111, // 223: systems.daggerheart.v1.SessionTagTeamFlowResponse.second_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
+	141, // 224: systems.daggerheart.v1.SessionTagTeamFlowResponse.selected_outcome:type_name -> systems.daggerheart.v1.ApplyRollOutcomeResponse
+	212, // 225: systems.daggerheart.v1.ApplyRollOutcomeResponse.updated:type_name -> systems.daggerheart.v1.OutcomeUpdated
+	194, // 226: systems.daggerheart.v1.DaggerheartAttackOutcomeResult.outcome:type_name -> systems.daggerheart.v1.Outcome
+	144, // 227: systems.daggerheart.v1.DaggerheartApplyAttackOutcomeResponse.result:type_name -> systems.daggerheart.v1.DaggerheartAttackOutcomeResult
+	146, // 228: systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeResponse.result:type_name -> systems.daggerheart.v1.DaggerheartAdversaryAttackOutcomeResult
+	194, // 229: systems.daggerheart.v1.DaggerheartReactionOutcomeResult.outcome:type_name -> systems.daggerheart.v1.Outcome
+	149, // 230: systems.daggerheart.v1.DaggerheartApplyReactionOutcomeResponse.result:type_name -> systems.daggerheart.v1.DaggerheartReactionOutcomeResult
+	11,  // 231: systems.daggerheart.v1.DaggerheartAdvancement.type:type_name -> systems.daggerheart.v1.DaggerheartAdvancementType
+	151, // 232: systems.daggerheart.v1.DaggerheartLevelUpRequest.advancements:type_name -> systems.daggerheart.v1.DaggerheartAdvancement
+	213, // 233: systems.daggerheart.v1.DaggerheartLevelUpResponse.profile:type_name -> systems.daggerheart.v1.DaggerheartProfile
+	185, // 234: systems.daggerheart.v1.DaggerheartLevelUpResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	214, // 235: systems.daggerheart.v1.DaggerheartAcquireItemRequest.kind:type_name -> systems.daggerheart.v1.DaggerheartInventoryItemKind
+	185, // 236: systems.daggerheart.v1.DaggerheartAcquireItemResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	185, // 237: systems.daggerheart.v1.DaggerheartDropItemResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	185, // 238: systems.daggerheart.v1.DaggerheartTransferItemResponse.from_state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	185, // 239: systems.daggerheart.v1.DaggerheartTransferItemResponse.to_state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	211, // 240: systems.daggerheart.v1.DaggerheartEquipItemRequest.slot:type_name -> systems.daggerheart.v1.DaggerheartEquipSlot
+	213, // 241: systems.daggerheart.v1.DaggerheartEquipItemResponse.profile:type_name -> systems.daggerheart.v1.DaggerheartProfile
+	185, // 242: systems.daggerheart.v1.DaggerheartEquipItemResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	211, // 243: systems.daggerheart.v1.DaggerheartUnequipItemRequest.slot:type_name -> systems.daggerheart.v1.DaggerheartEquipSlot
+	213, // 244: systems.daggerheart.v1.DaggerheartUnequipItemResponse.profile:type_name -> systems.daggerheart.v1.DaggerheartProfile
+	185, // 245: systems.daggerheart.v1.DaggerheartUnequipItemResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	185, // 246: systems.daggerheart.v1.DaggerheartUpdateGoldResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	215, // 247: systems.daggerheart.v1.DaggerheartCreateCompanionResponse.companion:type_name -> systems.daggerheart.v1.DaggerheartCompanion
+	216, // 248: systems.daggerheart.v1.DaggerheartLevelUpCompanionRequest.upgrade:type_name -> systems.daggerheart.v1.DaggerheartCompanionUpgrade
+	215, // 249: systems.daggerheart.v1.DaggerheartLevelUpCompanionResponse.companion:type_name -> systems.daggerheart.v1.DaggerheartCompanion
+	215, // 250: systems.daggerheart.v1.DaggerheartUpdateCompanionStressResponse.companion:type_name -> systems.daggerheart.v1.DaggerheartCompanion
+	127, // 251: systems.daggerheart.v1.SessionCompanionAttackFlowRequest.target:type_name -> systems.daggerheart.v1.MultiAttackTarget
+	209, // 252: systems.daggerheart.v1.SessionCompanionAttackFlowRequest.modifiers:type_name -> systems.daggerheart.v1.ActionRollModifier
+	191, // 253: systems.daggerheart.v1.SessionCompanionAttackFlowRequest.action_rng:type_name -> common.v1.RngRequest
+	191, // 254: systems.daggerheart.v1.SessionCompanionAttackFlowRequest.damage_rng:type_name -> common.v1.RngRequest
+	111, // 255: systems.daggerheart.v1.SessionCompanionAttackFlowResponse.action_roll:type_name -> systems.daggerheart.v1.SessionActionRollResponse
+	141, // 256: systems.daggerheart.v1.SessionCompanionAttackFlowResponse.roll_outcome:type_name -> systems.daggerheart.v1.ApplyRollOutcomeResponse
+	113, // 257: systems.daggerheart.v1.SessionCompanionAttackFlowResponse.damage_roll:type_name -> systems.daggerheart.v1.SessionDamageRollResponse
+	128, // 258: systems.daggerheart.v1.SessionCompanionAttackFlowResponse.result:type_name -> systems.daggerheart.v1.MultiAttackTargetResult
+	185, // 259: systems.daggerheart.v1.DaggerheartEnterBeastformResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	185, // 260: systems.daggerheart.v1.DaggerheartExitBeastformResponse.state:type_name -> systems.daggerheart.v1.DaggerheartCharacterState
+	217, // 261: systems.daggerheart.v1.DaggerheartApplyEffectRequest.target_type:type_name -> systems.daggerheart.v1.DaggerheartEffectTargetType
+	218, // 262: systems.daggerheart.v1.DaggerheartApplyEffectRequest.kind:type_name -> systems.daggerheart.v1.DaggerheartEffectKind
+	219, // 263: systems.daggerheart.v1.DaggerheartApplyEffectRequest.stacking:type_name -> systems.daggerheart.v1.DaggerheartEffectStacking
+	220, // 264: systems.daggerheart.v1.DaggerheartApplyEffectRequest.expires_on:type_name -> systems.daggerheart.v1.DaggerheartEffectExpiry
+	221, // 265: systems.daggerheart.v1.DaggerheartApplyEffectResponse.effect:type_name -> systems.daggerheart.v1.DaggerheartEffect
+	221, // 266: systems.daggerheart.v1.DaggerheartListEffectsResponse.effects:type_name -> systems.daggerheart.v1.DaggerheartEffect
+	98,  // 267: systems.daggerheart.v1.DaggerheartService.ActionRoll:input_type -> systems.daggerheart.v1.ActionRollRequest
+	100, // 268: systems.daggerheart.v1.DaggerheartService.DualityOutcome:input_type -> systems.daggerheart.v1.DualityOutcomeRequest
+	102, // 269: systems.daggerheart.v1.DaggerheartService.DualityExplain:input_type -> systems.daggerheart.v1.DualityExplainRequest
+	104, // 270: systems.daggerheart.v1.DaggerheartService.DualityProbability:input_type -> systems.daggerheart.v1.DualityProbabilityRequest
+	106, // 271: systems.daggerheart.v1.DaggerheartService.RulesVersion:input_type -> systems.daggerheart.v1.RulesVersionRequest
+	108, // 272: systems.daggerheart.v1.DaggerheartService.RollDice:input_type -> systems.daggerheart.v1.RollDiceRequest
+	12,  // 273: systems.daggerheart.v1.DaggerheartService.ApplyDamage:input_type -> systems.daggerheart.v1.DaggerheartApplyDamageRequest
+	14,  // 274: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryDamage:input_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryDamageRequest
+	16,  // 275: systems.daggerheart.v1.DaggerheartService.ApplyRest:input_type -> systems.daggerheart.v1.DaggerheartApplyRestRequest
+	19,  // 276: systems.daggerheart.v1.DaggerheartService.ApplyDowntimeMove:input_type -> systems.daggerheart.v1.DaggerheartApplyDowntimeMoveRequest
+	21,  // 277: systems.daggerheart.v1.DaggerheartService.SwapLoadout:input_type -> systems.daggerheart.v1.DaggerheartSwapLoadoutRequest
+	23,  // 278: systems.daggerheart.v1.DaggerheartService.ApplyDeathMove:input_type -> systems.daggerheart.v1.DaggerheartApplyDeathMoveRequest
+	26,  // 279: systems.daggerheart.v1.DaggerheartService.ApplyConditions:input_type -> systems.daggerheart.v1.DaggerheartApplyConditionsRequest
+	28,  // 280: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryConditions:input_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsRequest
+	30,  // 281: systems.daggerheart.v1.DaggerheartService.ApplyGmMove:input_type -> systems.daggerheart.v1.DaggerheartApplyGmMoveRequest
+	35,  // 282: systems.daggerheart.v1.DaggerheartService.CreateCountdown:input_type -> systems.daggerheart.v1.DaggerheartCreateCountdownRequest
+	37,  // 283: systems.daggerheart.v1.DaggerheartService.UpdateCountdown:input_type -> systems.daggerheart.v1.DaggerheartUpdateCountdownRequest
+	39,  // 284: systems.daggerheart.v1.DaggerheartService.DeleteCountdown:input_type -> systems.daggerheart.v1.DaggerheartDeleteCountdownRequest
+	43,  // 285: systems.daggerheart.v1.DaggerheartService.StartChase:input_type -> systems.daggerheart.v1.DaggerheartStartChaseRequest
+	45,  // 286: systems.daggerheart.v1.DaggerheartService.GetChase:input_type -> systems.daggerheart.v1.DaggerheartGetChaseRequest
+	47,  // 287: systems.daggerheart.v1.DaggerheartService.ListChases:input_type -> systems.daggerheart.v1.DaggerheartListChasesRequest
+	51,  // 288: systems.daggerheart.v1.DaggerheartService.SetSceneRanges:input_type -> systems.daggerheart.v1.DaggerheartSetSceneRangesRequest
+	54,  // 289: systems.daggerheart.v1.DaggerheartService.MoveSceneEntity:input_type -> systems.daggerheart.v1.DaggerheartMoveSceneEntityRequest
+	56,  // 290: systems.daggerheart.v1.DaggerheartService.ListSceneRanges:input_type -> systems.daggerheart.v1.DaggerheartListSceneRangesRequest
+	59,  // 291: systems.daggerheart.v1.DaggerheartService.CreateSessionEnvironment:input_type -> systems.daggerheart.v1.DaggerheartCreateSessionEnvironmentRequest
+	61,  // 292: systems.daggerheart.v1.DaggerheartService.ShiftSessionEnvironment:input_type -> systems.daggerheart.v1.DaggerheartShiftSessionEnvironmentRequest
+	63,  // 293: systems.daggerheart.v1.DaggerheartService.ClearSessionEnvironment:input_type -> systems.daggerheart.v1.DaggerheartClearSessionEnvironmentRequest
+	65,  // 294: systems.daggerheart.v1.DaggerheartService.GetSessionEnvironment:input_type -> systems.daggerheart.v1.DaggerheartGetSessionEnvironmentRequest
+	68,  // 295: systems.daggerheart.v1.DaggerheartService.TriggerEnvironmentFeature:input_type -> systems.daggerheart.v1.DaggerheartTriggerEnvironmentFeatureRequest
+	71,  // 296: systems.daggerheart.v1.DaggerheartService.CreateAdversary:input_type -> systems.daggerheart.v1.DaggerheartCreateAdversaryRequest
+	73,  // 297: systems.daggerheart.v1.DaggerheartService.UpdateAdversary:input_type -> systems.daggerheart.v1.DaggerheartUpdateAdversaryRequest
+	75,  // 298: systems.daggerheart.v1.DaggerheartService.DeleteAdversary:input_type -> systems.daggerheart.v1.DaggerheartDeleteAdversaryRequest
+	77,  // 299: systems.daggerheart.v1.DaggerheartService.GetAdversary:input_type -> systems.daggerheart.v1.DaggerheartGetAdversaryRequest
+	79,  // 300: systems.daggerheart.v1.DaggerheartService.ListAdversaries:input_type -> systems.daggerheart.v1.DaggerheartListAdversariesRequest
+	82,  // 301: systems.daggerheart.v1.DaggerheartService.ActivateAdversaryFeature:input_type -> systems.daggerheart.v1.DaggerheartActivateAdversaryFeatureRequest
+	84,  // 302: systems.daggerheart.v1.DaggerheartService.ListAdversaryFeatureStates:input_type -> systems.daggerheart.v1.DaggerheartListAdversaryFeatureStatesRequest
+	91,  // 303: systems.daggerheart.v1.DaggerheartService.PlanEncounter:input_type -> systems.daggerheart.v1.DaggerheartPlanEncounterRequest
+	93,  // 304: systems.daggerheart.v1.DaggerheartService.CommitEncounter:input_type -> systems.daggerheart.v1.DaggerheartCommitEncounterRequest
+	95,  // 305: systems.daggerheart.v1.DaggerheartService.ResolveBlazeOfGlory:input_type -> systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryRequest
+	110, // 306: systems.daggerheart.v1.DaggerheartService.SessionActionRoll:input_type -> systems.daggerheart.v1.SessionActionRollRequest
+	112, // 307: systems.daggerheart.v1.DaggerheartService.SessionDamageRoll:input_type -> systems.daggerheart.v1.SessionDamageRollRequest
+	115, // 308: systems.daggerheart.v1.DaggerheartService.SessionAttackFlow:input_type -> systems.daggerheart.v1.SessionAttackFlowRequest
+	129, // 309: systems.daggerheart.v1.DaggerheartService.SessionMultiAttackFlow:input_type -> systems.daggerheart.v1.SessionMultiAttackFlowRequest
+	117, // 310: systems.daggerheart.v1.DaggerheartService.SessionSpellcastFlow:input_type -> systems.daggerheart.v1.SessionSpellcastFlowRequest
+	119, // 311: systems.daggerheart.v1.DaggerheartService.SessionReactionFlow:input_type -> systems.daggerheart.v1.SessionReactionFlowRequest
+	121, // 312: systems.daggerheart.v1.DaggerheartService.SessionAdversaryAttackRoll:input_type -> systems.daggerheart.v1.SessionAdversaryAttackRollRequest
+	122, // 313: systems.daggerheart.v1.DaggerheartService.SessionAdversaryActionCheck:input_type -> systems.daggerheart.v1.SessionAdversaryActionCheckRequest
+	125, // 314: systems.daggerheart.v1.DaggerheartService.SessionAdversaryAttackFlow:input_type -> systems.daggerheart.v1.SessionAdversaryAttackFlowRequest
+	131, // 315: systems.daggerheart.v1.DaggerheartService.SessionAdversaryMultiAttackFlow:input_type -> systems.daggerheart.v1.SessionAdversaryMultiAttackFlowRequest
+	135, // 316: systems.daggerheart.v1.DaggerheartService.SessionGroupActionFlow:input_type -> systems.daggerheart.v1.SessionGroupActionFlowRequest
+	138, // 317: systems.daggerheart.v1.DaggerheartService.SessionTagTeamFlow:input_type -> systems.daggerheart.v1.SessionTagTeamFlowRequest
+	140, // 318: systems.daggerheart.v1.DaggerheartService.ApplyRollOutcome:input_type -> systems.daggerheart.v1.ApplyRollOutcomeRequest
+	142, // 319: systems.daggerheart.v1.DaggerheartService.ApplyAttackOutcome:input_type -> systems.daggerheart.v1.DaggerheartApplyAttackOutcomeRequest
+	143, // 320: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryAttackOutcome:input_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeRequest
+	148, // 321: systems.daggerheart.v1.DaggerheartService.ApplyReactionOutcome:input_type -> systems.daggerheart.v1.DaggerheartApplyReactionOutcomeRequest
+	152, // 322: systems.daggerheart.v1.DaggerheartService.LevelUp:input_type -> systems.daggerheart.v1.DaggerheartLevelUpRequest
+	154, // 323: systems.daggerheart.v1.DaggerheartService.AcquireItem:input_type -> systems.daggerheart.v1.DaggerheartAcquireItemRequest
+	156, // 324: systems.daggerheart.v1.DaggerheartService.DropItem:input_type -> systems.daggerheart.v1.DaggerheartDropItemRequest
+	158, // 325: systems.daggerheart.v1.DaggerheartService.TransferItem:input_type -> systems.daggerheart.v1.DaggerheartTransferItemRequest
+	160, // 326: systems.daggerheart.v1.DaggerheartService.EquipItem:input_type -> systems.daggerheart.v1.DaggerheartEquipItemRequest
+	162, // 327: systems.daggerheart.v1.DaggerheartService.UnequipItem:input_type -> systems.daggerheart.v1.DaggerheartUnequipItemRequest
+	164, // 328: systems.daggerheart.v1.DaggerheartService.UpdateGold:input_type -> systems.daggerheart.v1.DaggerheartUpdateGoldRequest
+	166, // 329: systems.daggerheart.v1.DaggerheartService.CreateCompanion:input_type -> systems.daggerheart.v1.DaggerheartCreateCompanionRequest
+	168, // 330: systems.daggerheart.v1.DaggerheartService.LevelUpCompanion:input_type -> systems.daggerheart.v1.DaggerheartLevelUpCompanionRequest
+	170, // 331: systems.daggerheart.v1.DaggerheartService.UpdateCompanionStress:input_type -> systems.daggerheart.v1.DaggerheartUpdateCompanionStressRequest
+	172, // 332: systems.daggerheart.v1.DaggerheartService.SessionCompanionAttackFlow:input_type -> systems.daggerheart.v1.SessionCompanionAttackFlowRequest
+	174, // 333: systems.daggerheart.v1.DaggerheartService.EnterBeastform:input_type -> systems.daggerheart.v1.DaggerheartEnterBeastformRequest
+	176, // 334: systems.daggerheart.v1.DaggerheartService.ExitBeastform:input_type -> systems.daggerheart.v1.DaggerheartExitBeastformRequest
+	178, // 335: systems.daggerheart.v1.DaggerheartService.ApplyEffect:input_type -> systems.daggerheart.v1.DaggerheartApplyEffectRequest
+	180, // 336: systems.daggerheart.v1.DaggerheartService.RemoveEffect:input_type -> systems.daggerheart.v1.DaggerheartRemoveEffectRequest
+	182, // 337: systems.daggerheart.v1.DaggerheartService.ListEffects:input_type -> systems.daggerheart.v1.DaggerheartListEffectsRequest
+	99,  // 338: systems.daggerheart.v1.DaggerheartService.ActionRoll:output_type -> systems.daggerheart.v1.ActionRollResponse
+	101, // 339: systems.daggerheart.v1.DaggerheartService.DualityOutcome:output_type -> systems.daggerheart.v1.DualityOutcomeResponse
+	103, // 340: systems.daggerheart.v1.DaggerheartService.DualityExplain:output_type -> systems.daggerheart.v1.DualityExplainResponse
+	105, // 341: systems.daggerheart.v1.DaggerheartService.DualityProbability:output_type -> systems.daggerheart.v1.DualityProbabilityResponse
+	107, // 342: systems.daggerheart.v1.DaggerheartService.RulesVersion:output_type -> systems.daggerheart.v1.RulesVersionResponse
+	109, // 343: systems.daggerheart.v1.DaggerheartService.RollDice:output_type -> systems.daggerheart.v1.RollDiceResponse
+	13,  // 344: systems.daggerheart.v1.DaggerheartService.ApplyDamage:output_type -> systems.daggerheart.v1.DaggerheartApplyDamageResponse
+	15,  // 345: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryDamage:output_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryDamageResponse
+	18,  // 346: systems.daggerheart.v1.DaggerheartService.ApplyRest:output_type -> systems.daggerheart.v1.DaggerheartApplyRestResponse
+	20,  // 347: systems.daggerheart.v1.DaggerheartService.ApplyDowntimeMove:output_type -> systems.daggerheart.v1.DaggerheartApplyDowntimeMoveResponse
+	22,  // 348: systems.daggerheart.v1.DaggerheartService.SwapLoadout:output_type -> systems.daggerheart.v1.DaggerheartSwapLoadoutResponse
+	25,  // 349: systems.daggerheart.v1.DaggerheartService.ApplyDeathMove:output_type -> systems.daggerheart.v1.DaggerheartApplyDeathMoveResponse
+	27,  // 350: systems.daggerheart.v1.DaggerheartService.ApplyConditions:output_type -> systems.daggerheart.v1.DaggerheartApplyConditionsResponse
+	29,  // 351: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryConditions:output_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryConditionsResponse
+	31,  // 352: systems.daggerheart.v1.DaggerheartService.ApplyGmMove:output_type -> systems.daggerheart.v1.DaggerheartApplyGmMoveResponse
+	36,  // 353: systems.daggerheart.v1.DaggerheartService.CreateCountdown:output_type -> systems.daggerheart.v1.DaggerheartCreateCountdownResponse
+	38,  // 354: systems.daggerheart.v1.DaggerheartService.UpdateCountdown:output_type -> systems.daggerheart.v1.DaggerheartUpdateCountdownResponse
+	40,  // 355: systems.daggerheart.v1.DaggerheartService.DeleteCountdown:output_type -> systems.daggerheart.v1.DaggerheartDeleteCountdownResponse
+	44,  // 356: systems.daggerheart.v1.DaggerheartService.StartChase:output_type -> systems.daggerheart.v1.DaggerheartStartChaseResponse
+	46,  // 357: systems.daggerheart.v1.DaggerheartService.GetChase:output_type -> systems.daggerheart.v1.DaggerheartGetChaseResponse
+	48,  // 358: systems.daggerheart.v1.DaggerheartService.ListChases:output_type -> systems.daggerheart.v1.DaggerheartListChasesResponse
+	52,  // 359: systems.daggerheart.v1.DaggerheartService.SetSceneRanges:output_type -> systems.daggerheart.v1.DaggerheartSetSceneRangesResponse
+	55,  // 360: systems.daggerheart.v1.DaggerheartService.MoveSceneEntity:output_type -> systems.daggerheart.v1.DaggerheartMoveSceneEntityResponse
+	57,  // 361: systems.daggerheart.v1.DaggerheartService.ListSceneRanges:output_type -> systems.daggerheart.v1.DaggerheartListSceneRangesResponse
+	60,  // 362: systems.daggerheart.v1.DaggerheartService.CreateSessionEnvironment:output_type -> systems.daggerheart.v1.DaggerheartCreateSessionEnvironmentResponse
+	62,  // 363: systems.daggerheart.v1.DaggerheartService.ShiftSessionEnvironment:output_type -> systems.daggerheart.v1.DaggerheartShiftSessionEnvironmentResponse
+	64,  // 364: systems.daggerheart.v1.DaggerheartService.ClearSessionEnvironment:output_type -> systems.daggerheart.v1.DaggerheartClearSessionEnvironmentResponse
+	66,  // 365: systems.daggerheart.v1.DaggerheartService.GetSessionEnvironment:output_type -> systems.daggerheart.v1.DaggerheartGetSessionEnvironmentResponse
+	69,  // 366: systems.daggerheart.v1.DaggerheartService.TriggerEnvironmentFeature:output_type -> systems.daggerheart.v1.DaggerheartTriggerEnvironmentFeatureResponse
+	72,  // 367: systems.daggerheart.v1.DaggerheartService.CreateAdversary:output_type -> systems.daggerheart.v1.DaggerheartCreateAdversaryResponse
+	74,  // 368: systems.daggerheart.v1.DaggerheartService.UpdateAdversary:output_type -> systems.daggerheart.v1.DaggerheartUpdateAdversaryResponse
+	76,  // 369: systems.daggerheart.v1.DaggerheartService.DeleteAdversary:output_type -> systems.daggerheart.v1.DaggerheartDeleteAdversaryResponse
+	78,  // 370: systems.daggerheart.v1.DaggerheartService.GetAdversary:output_type -> systems.daggerheart.v1.DaggerheartGetAdversaryResponse
+	80,  // 371: systems.daggerheart.v1.DaggerheartService.ListAdversaries:output_type -> systems.daggerheart.v1.DaggerheartListAdversariesResponse
+	83,  // 372: systems.daggerheart.v1.DaggerheartService.ActivateAdversaryFeature:output_type -> systems.daggerheart.v1.DaggerheartActivateAdversaryFeatureResponse
+	85,  // 373: systems.daggerheart.v1.DaggerheartService.ListAdversaryFeatureStates:output_type -> systems.daggerheart.v1.DaggerheartListAdversaryFeatureStatesResponse
+	92,  // 374: systems.daggerheart.v1.DaggerheartService.PlanEncounter:output_type -> systems.daggerheart.v1.DaggerheartPlanEncounterResponse
+	94,  // 375: systems.daggerheart.v1.DaggerheartService.CommitEncounter:output_type -> systems.daggerheart.v1.DaggerheartCommitEncounterResponse
+	97,  // 376: systems.daggerheart.v1.DaggerheartService.ResolveBlazeOfGlory:output_type -> systems.daggerheart.v1.DaggerheartResolveBlazeOfGloryResponse
+	111, // 377: systems.daggerheart.v1.DaggerheartService.SessionActionRoll:output_type -> systems.daggerheart.v1.SessionActionRollResponse
+	113, // 378: systems.daggerheart.v1.DaggerheartService.SessionDamageRoll:output_type -> systems.daggerheart.v1.SessionDamageRollResponse
+	116, // 379: systems.daggerheart.v1.DaggerheartService.SessionAttackFlow:output_type -> systems.daggerheart.v1.SessionAttackFlowResponse
+	130, // 380: systems.daggerheart.v1.DaggerheartService.SessionMultiAttackFlow:output_type -> systems.daggerheart.v1.SessionMultiAttackFlowResponse
+	118, // 381: systems.daggerheart.v1.DaggerheartService.SessionSpellcastFlow:output_type -> systems.daggerheart.v1.SessionSpellcastFlowResponse
+	120, // 382: systems.daggerheart.v1.DaggerheartService.SessionReactionFlow:output_type -> systems.daggerheart.v1.SessionReactionFlowResponse
+	124, // 383: systems.daggerheart.v1.DaggerheartService.SessionAdversaryAttackRoll:output_type -> systems.daggerheart.v1.SessionAdversaryAttackRollResponse
+	123, // 384: systems.daggerheart.v1.DaggerheartService.SessionAdversaryActionCheck:output_type -> systems.daggerheart.v1.SessionAdversaryActionCheckResponse
+	126, // 385: systems.daggerheart.v1.DaggerheartService.SessionAdversaryAttackFlow:output_type -> systems.daggerheart.v1.SessionAdversaryAttackFlowResponse
+	132, // 386: systems.daggerheart.v1.DaggerheartService.SessionAdversaryMultiAttackFlow:output_type -> systems.daggerheart.v1.SessionAdversaryMultiAttackFlowResponse
+	136, // 387: systems.daggerheart.v1.DaggerheartService.SessionGroupActionFlow:output_type -> systems.daggerheart.v1.SessionGroupActionFlowResponse
+	139, // 388: systems.daggerheart.v1.DaggerheartService.SessionTagTeamFlow:output_type -> systems.daggerheart.v1.SessionTagTeamFlowResponse
+	141, // 389: systems.daggerheart.v1.DaggerheartService.ApplyRollOutcome:output_type -> systems.daggerheart.v1.ApplyRollOutcomeResponse
+	145, // 390: systems.daggerheart.v1.DaggerheartService.ApplyAttackOutcome:output_type -> systems.daggerheart.v1.DaggerheartApplyAttackOutcomeResponse
+	147, // 391: systems.daggerheart.v1.DaggerheartService.ApplyAdversaryAttackOutcome:output_type -> systems.daggerheart.v1.DaggerheartApplyAdversaryAttackOutcomeResponse
+	150, // 392: systems.daggerheart.v1.DaggerheartService.ApplyReactionOutcome:output_type -> systems.daggerheart.v1.DaggerheartApplyReactionOutcomeResponse
+	153, // 393: systems.daggerheart.v1.DaggerheartService.LevelUp:output_type -> systems.daggerheart.v1.DaggerheartLevelUpResponse
+	155, // 394: systems.daggerheart.v1.DaggerheartService.AcquireItem:output_type -> systems.daggerheart.v1.DaggerheartAcquireItemResponse
+	157, // 395: systems.daggerheart.v1.DaggerheartService.DropItem:output_type -> systems.daggerheart.v1.DaggerheartDropItemResponse
+	159, // 396: systems.daggerheart.v1.DaggerheartService.TransferItem:output_type -> systems.daggerheart.v1.DaggerheartTransferItemResponse
+	161, // 397: systems.daggerheart.v1.DaggerheartService.EquipItem:output_type -> systems.daggerheart.v1.DaggerheartEquipItemResponse
+	163, // 398: systems.daggerheart.v1.DaggerheartService.UnequipItem:output_type -> systems.daggerheart.v1.DaggerheartUnequipItemResponse
+	165, // 399: systems.daggerheart.v1.DaggerheartService.UpdateGold:output_type -> systems.daggerheart.v1.DaggerheartUpdateGoldResponse
+	167, // 400: systems.daggerheart.v1.DaggerheartService.CreateCompanion:output_type -> systems.daggerheart.v1.DaggerheartCreateCompanionResponse
+	169, // 401: systems.daggerheart.v1.DaggerheartService.LevelUpCompanion:output_type -> systems.daggerheart.v1.DaggerheartLevelUpCompanionResponse
+	171, // 402: systems.daggerheart.v1.DaggerheartService.UpdateCompanionStress:output_type -> systems.daggerheart.v1.DaggerheartUpdateCompanionStressResponse
+	173, // 403: systems.daggerheart.v1.DaggerheartService.SessionCompanionAttackFlow:output_type -> systems.daggerheart.v1.SessionCompanionAttackFlowResponse
+	175, // 404: systems.daggerheart.v1.DaggerheartService.EnterBeastform:output_type -> systems.daggerheart.v1.DaggerheartEnterBeastformResponse
+	177, // 405: systems.daggerheart.v1.DaggerheartService.ExitBeastform:output_type -> systems.daggerheart.v1.DaggerheartExitBeastformResponse
+	179, // 406: systems.daggerheart.v1.DaggerheartService.ApplyEffect:output_type -> systems.daggerheart.v1.DaggerheartApplyEffectResponse
+	181, // 407: systems.daggerheart.v1.DaggerheartService.RemoveEffect:output_type -> systems.daggerheart.v1.DaggerheartRemoveEffectResponse
+	183, // 408: systems.daggerheart.v1.DaggerheartService.ListEffects:output_type -> systems.daggerheart.v1.DaggerheartListEffectsResponse
+	338, // [338:409] is the sub-list for method output_type
+	267, // [267:338] is the sub-list for method input_type
+	267, // [267:267] is the sub-list for extension type_name
+	267, // [267:267] is the sub-list for extension extendee
+	0,   // [0:267] is the sub-list for field type_name
 }
 
 func init() { file_systems_daggerheart_v1_service_proto_init() }
@@ -7849,6 +15518,7 @@ func file_systems_daggerheart_v1_service_proto_init() {
 	if File_systems_daggerheart_v1_service_proto != nil {
 		return
 	}
+	file_systems_daggerheart_v1_content_proto_init()
 	file_systems_daggerheart_v1_mechanics_proto_init()
 	file_systems_daggerheart_v1_state_proto_init()
 	file_systems_daggerheart_v1_service_proto_msgTypes[0].OneofWrappers = []any{}
@@ -7857,20 +15527,20 @@ func file_systems_daggerheart_v1_service_proto_init() {
 	file_systems_daggerheart_v1_service_proto_msgTypes[12].OneofWrappers = []any{}
 	file_systems_daggerheart_v1_service_proto_msgTypes[14].OneofWrappers = []any{}
 	file_systems_daggerheart_v1_service_proto_msgTypes[16].OneofWrappers = []any{}
-	file_systems_daggerheart_v1_service_proto_msgTypes[23].OneofWrappers = []any{}
-	file_systems_daggerheart_v1_service_proto_msgTypes[41].OneofWrappers = []any{}
-	file_systems_daggerheart_v1_service_proto_msgTypes[42].OneofWrappers = []any{}
-	file_systems_daggerheart_v1_service_proto_msgTypes[43].OneofWrappers = []any{}
-	file_systems_daggerheart_v1_service_proto_msgTypes[44].OneofWrappers = []any{}
-	file_systems_daggerheart_v1_service_proto_msgTypes[45].OneofWrappers = []any{}
-	file_systems_daggerheart_v1_service_proto_msgTypes[46].OneofWrappers = []any{}
+	file_systems_daggerheart_v1_service_proto_msgTypes[25].OneofWrappers = []any{}
+	file_systems_daggerheart_v1_service_proto_msgTypes[86].OneofWrappers = []any{}
+	file_systems_daggerheart_v1_service_proto_msgTypes[87].OneofWrappers = []any{}
+	file_systems_daggerheart_v1_service_proto_msgTypes[88].OneofWrappers = []any{}
+	file_systems_daggerheart_v1_service_proto_msgTypes[89].OneofWrappers = []any{}
+	file_systems_daggerheart_v1_service_proto_msgTypes[90].OneofWrappers = []any{}
+	file_systems_daggerheart_v1_service_proto_msgTypes[91].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_systems_daggerheart_v1_service_proto_rawDesc), len(file_systems_daggerheart_v1_service_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   86,
+			NumEnums:      12,
+			NumMessages:   172,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
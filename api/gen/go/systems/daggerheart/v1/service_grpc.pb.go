@@ -20,43 +20,77 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DaggerheartService_ActionRoll_FullMethodName                  = "/systems.daggerheart.v1.DaggerheartService/ActionRoll"
-	DaggerheartService_DualityOutcome_FullMethodName              = "/systems.daggerheart.v1.DaggerheartService/DualityOutcome"
-	DaggerheartService_DualityExplain_FullMethodName              = "/systems.daggerheart.v1.DaggerheartService/DualityExplain"
-	DaggerheartService_DualityProbability_FullMethodName          = "/systems.daggerheart.v1.DaggerheartService/DualityProbability"
-	DaggerheartService_RulesVersion_FullMethodName                = "/systems.daggerheart.v1.DaggerheartService/RulesVersion"
-	DaggerheartService_RollDice_FullMethodName                    = "/systems.daggerheart.v1.DaggerheartService/RollDice"
-	DaggerheartService_ApplyDamage_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/ApplyDamage"
-	DaggerheartService_ApplyAdversaryDamage_FullMethodName        = "/systems.daggerheart.v1.DaggerheartService/ApplyAdversaryDamage"
-	DaggerheartService_ApplyRest_FullMethodName                   = "/systems.daggerheart.v1.DaggerheartService/ApplyRest"
-	DaggerheartService_ApplyDowntimeMove_FullMethodName           = "/systems.daggerheart.v1.DaggerheartService/ApplyDowntimeMove"
-	DaggerheartService_SwapLoadout_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/SwapLoadout"
-	DaggerheartService_ApplyDeathMove_FullMethodName              = "/systems.daggerheart.v1.DaggerheartService/ApplyDeathMove"
-	DaggerheartService_ApplyConditions_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/ApplyConditions"
-	DaggerheartService_ApplyAdversaryConditions_FullMethodName    = "/systems.daggerheart.v1.DaggerheartService/ApplyAdversaryConditions"
-	DaggerheartService_ApplyGmMove_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/ApplyGmMove"
-	DaggerheartService_CreateCountdown_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/CreateCountdown"
-	DaggerheartService_UpdateCountdown_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/UpdateCountdown"
-	DaggerheartService_DeleteCountdown_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/DeleteCountdown"
-	DaggerheartService_CreateAdversary_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/CreateAdversary"
-	DaggerheartService_UpdateAdversary_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/UpdateAdversary"
-	DaggerheartService_DeleteAdversary_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/DeleteAdversary"
-	DaggerheartService_GetAdversary_FullMethodName                = "/systems.daggerheart.v1.DaggerheartService/GetAdversary"
-	DaggerheartService_ListAdversaries_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/ListAdversaries"
-	DaggerheartService_ResolveBlazeOfGlory_FullMethodName         = "/systems.daggerheart.v1.DaggerheartService/ResolveBlazeOfGlory"
-	DaggerheartService_SessionActionRoll_FullMethodName           = "/systems.daggerheart.v1.DaggerheartService/SessionActionRoll"
-	DaggerheartService_SessionDamageRoll_FullMethodName           = "/systems.daggerheart.v1.DaggerheartService/SessionDamageRoll"
-	DaggerheartService_SessionAttackFlow_FullMethodName           = "/systems.daggerheart.v1.DaggerheartService/SessionAttackFlow"
-	DaggerheartService_SessionReactionFlow_FullMethodName         = "/systems.daggerheart.v1.DaggerheartService/SessionReactionFlow"
-	DaggerheartService_SessionAdversaryAttackRoll_FullMethodName  = "/systems.daggerheart.v1.DaggerheartService/SessionAdversaryAttackRoll"
-	DaggerheartService_SessionAdversaryActionCheck_FullMethodName = "/systems.daggerheart.v1.DaggerheartService/SessionAdversaryActionCheck"
-	DaggerheartService_SessionAdversaryAttackFlow_FullMethodName  = "/systems.daggerheart.v1.DaggerheartService/SessionAdversaryAttackFlow"
-	DaggerheartService_SessionGroupActionFlow_FullMethodName      = "/systems.daggerheart.v1.DaggerheartService/SessionGroupActionFlow"
-	DaggerheartService_SessionTagTeamFlow_FullMethodName          = "/systems.daggerheart.v1.DaggerheartService/SessionTagTeamFlow"
-	DaggerheartService_ApplyRollOutcome_FullMethodName            = "/systems.daggerheart.v1.DaggerheartService/ApplyRollOutcome"
-	DaggerheartService_ApplyAttackOutcome_FullMethodName          = "/systems.daggerheart.v1.DaggerheartService/ApplyAttackOutcome"
-	DaggerheartService_ApplyAdversaryAttackOutcome_FullMethodName = "/systems.daggerheart.v1.DaggerheartService/ApplyAdversaryAttackOutcome"
-	DaggerheartService_ApplyReactionOutcome_FullMethodName        = "/systems.daggerheart.v1.DaggerheartService/ApplyReactionOutcome"
+	DaggerheartService_ActionRoll_FullMethodName                      = "/systems.daggerheart.v1.DaggerheartService/ActionRoll"
+	DaggerheartService_DualityOutcome_FullMethodName                  = "/systems.daggerheart.v1.DaggerheartService/DualityOutcome"
+	DaggerheartService_DualityExplain_FullMethodName                  = "/systems.daggerheart.v1.DaggerheartService/DualityExplain"
+	DaggerheartService_DualityProbability_FullMethodName              = "/systems.daggerheart.v1.DaggerheartService/DualityProbability"
+	DaggerheartService_RulesVersion_FullMethodName                    = "/systems.daggerheart.v1.DaggerheartService/RulesVersion"
+	DaggerheartService_RollDice_FullMethodName                        = "/systems.daggerheart.v1.DaggerheartService/RollDice"
+	DaggerheartService_ApplyDamage_FullMethodName                     = "/systems.daggerheart.v1.DaggerheartService/ApplyDamage"
+	DaggerheartService_ApplyAdversaryDamage_FullMethodName            = "/systems.daggerheart.v1.DaggerheartService/ApplyAdversaryDamage"
+	DaggerheartService_ApplyRest_FullMethodName                       = "/systems.daggerheart.v1.DaggerheartService/ApplyRest"
+	DaggerheartService_ApplyDowntimeMove_FullMethodName               = "/systems.daggerheart.v1.DaggerheartService/ApplyDowntimeMove"
+	DaggerheartService_SwapLoadout_FullMethodName                     = "/systems.daggerheart.v1.DaggerheartService/SwapLoadout"
+	DaggerheartService_ApplyDeathMove_FullMethodName                  = "/systems.daggerheart.v1.DaggerheartService/ApplyDeathMove"
+	DaggerheartService_ApplyConditions_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/ApplyConditions"
+	DaggerheartService_ApplyAdversaryConditions_FullMethodName        = "/systems.daggerheart.v1.DaggerheartService/ApplyAdversaryConditions"
+	DaggerheartService_ApplyGmMove_FullMethodName                     = "/systems.daggerheart.v1.DaggerheartService/ApplyGmMove"
+	DaggerheartService_CreateCountdown_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/CreateCountdown"
+	DaggerheartService_UpdateCountdown_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/UpdateCountdown"
+	DaggerheartService_DeleteCountdown_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/DeleteCountdown"
+	DaggerheartService_StartChase_FullMethodName                      = "/systems.daggerheart.v1.DaggerheartService/StartChase"
+	DaggerheartService_GetChase_FullMethodName                        = "/systems.daggerheart.v1.DaggerheartService/GetChase"
+	DaggerheartService_ListChases_FullMethodName                      = "/systems.daggerheart.v1.DaggerheartService/ListChases"
+	DaggerheartService_SetSceneRanges_FullMethodName                  = "/systems.daggerheart.v1.DaggerheartService/SetSceneRanges"
+	DaggerheartService_MoveSceneEntity_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/MoveSceneEntity"
+	DaggerheartService_ListSceneRanges_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/ListSceneRanges"
+	DaggerheartService_CreateSessionEnvironment_FullMethodName        = "/systems.daggerheart.v1.DaggerheartService/CreateSessionEnvironment"
+	DaggerheartService_ShiftSessionEnvironment_FullMethodName         = "/systems.daggerheart.v1.DaggerheartService/ShiftSessionEnvironment"
+	DaggerheartService_ClearSessionEnvironment_FullMethodName         = "/systems.daggerheart.v1.DaggerheartService/ClearSessionEnvironment"
+	DaggerheartService_GetSessionEnvironment_FullMethodName           = "/systems.daggerheart.v1.DaggerheartService/GetSessionEnvironment"
+	DaggerheartService_TriggerEnvironmentFeature_FullMethodName       = "/systems.daggerheart.v1.DaggerheartService/TriggerEnvironmentFeature"
+	DaggerheartService_CreateAdversary_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/CreateAdversary"
+	DaggerheartService_UpdateAdversary_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/UpdateAdversary"
+	DaggerheartService_DeleteAdversary_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/DeleteAdversary"
+	DaggerheartService_GetAdversary_FullMethodName                    = "/systems.daggerheart.v1.DaggerheartService/GetAdversary"
+	DaggerheartService_ListAdversaries_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/ListAdversaries"
+	DaggerheartService_ActivateAdversaryFeature_FullMethodName        = "/systems.daggerheart.v1.DaggerheartService/ActivateAdversaryFeature"
+	DaggerheartService_ListAdversaryFeatureStates_FullMethodName      = "/systems.daggerheart.v1.DaggerheartService/ListAdversaryFeatureStates"
+	DaggerheartService_PlanEncounter_FullMethodName                   = "/systems.daggerheart.v1.DaggerheartService/PlanEncounter"
+	DaggerheartService_CommitEncounter_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/CommitEncounter"
+	DaggerheartService_ResolveBlazeOfGlory_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/ResolveBlazeOfGlory"
+	DaggerheartService_SessionActionRoll_FullMethodName               = "/systems.daggerheart.v1.DaggerheartService/SessionActionRoll"
+	DaggerheartService_SessionDamageRoll_FullMethodName               = "/systems.daggerheart.v1.DaggerheartService/SessionDamageRoll"
+	DaggerheartService_SessionAttackFlow_FullMethodName               = "/systems.daggerheart.v1.DaggerheartService/SessionAttackFlow"
+	DaggerheartService_SessionMultiAttackFlow_FullMethodName          = "/systems.daggerheart.v1.DaggerheartService/SessionMultiAttackFlow"
+	DaggerheartService_SessionSpellcastFlow_FullMethodName            = "/systems.daggerheart.v1.DaggerheartService/SessionSpellcastFlow"
+	DaggerheartService_SessionReactionFlow_FullMethodName             = "/systems.daggerheart.v1.DaggerheartService/SessionReactionFlow"
+	DaggerheartService_SessionAdversaryAttackRoll_FullMethodName      = "/systems.daggerheart.v1.DaggerheartService/SessionAdversaryAttackRoll"
+	DaggerheartService_SessionAdversaryActionCheck_FullMethodName     = "/systems.daggerheart.v1.DaggerheartService/SessionAdversaryActionCheck"
+	DaggerheartService_SessionAdversaryAttackFlow_FullMethodName      = "/systems.daggerheart.v1.DaggerheartService/SessionAdversaryAttackFlow"
+	DaggerheartService_SessionAdversaryMultiAttackFlow_FullMethodName = "/systems.daggerheart.v1.DaggerheartService/SessionAdversaryMultiAttackFlow"
+	DaggerheartService_SessionGroupActionFlow_FullMethodName          = "/systems.daggerheart.v1.DaggerheartService/SessionGroupActionFlow"
+	DaggerheartService_SessionTagTeamFlow_FullMethodName              = "/systems.daggerheart.v1.DaggerheartService/SessionTagTeamFlow"
+	DaggerheartService_ApplyRollOutcome_FullMethodName                = "/systems.daggerheart.v1.DaggerheartService/ApplyRollOutcome"
+	DaggerheartService_ApplyAttackOutcome_FullMethodName              = "/systems.daggerheart.v1.DaggerheartService/ApplyAttackOutcome"
+	DaggerheartService_ApplyAdversaryAttackOutcome_FullMethodName     = "/systems.daggerheart.v1.DaggerheartService/ApplyAdversaryAttackOutcome"
+	DaggerheartService_ApplyReactionOutcome_FullMethodName            = "/systems.daggerheart.v1.DaggerheartService/ApplyReactionOutcome"
+	DaggerheartService_LevelUp_FullMethodName                         = "/systems.daggerheart.v1.DaggerheartService/LevelUp"
+	DaggerheartService_AcquireItem_FullMethodName                     = "/systems.daggerheart.v1.DaggerheartService/AcquireItem"
+	DaggerheartService_DropItem_FullMethodName                        = "/systems.daggerheart.v1.DaggerheartService/DropItem"
+	DaggerheartService_TransferItem_FullMethodName                    = "/systems.daggerheart.v1.DaggerheartService/TransferItem"
+	DaggerheartService_EquipItem_FullMethodName                       = "/systems.daggerheart.v1.DaggerheartService/EquipItem"
+	DaggerheartService_UnequipItem_FullMethodName                     = "/systems.daggerheart.v1.DaggerheartService/UnequipItem"
+	DaggerheartService_UpdateGold_FullMethodName                      = "/systems.daggerheart.v1.DaggerheartService/UpdateGold"
+	DaggerheartService_CreateCompanion_FullMethodName                 = "/systems.daggerheart.v1.DaggerheartService/CreateCompanion"
+	DaggerheartService_LevelUpCompanion_FullMethodName                = "/systems.daggerheart.v1.DaggerheartService/LevelUpCompanion"
+	DaggerheartService_UpdateCompanionStress_FullMethodName           = "/systems.daggerheart.v1.DaggerheartService/UpdateCompanionStress"
+	DaggerheartService_SessionCompanionAttackFlow_FullMethodName      = "/systems.daggerheart.v1.DaggerheartService/SessionCompanionAttackFlow"
+	DaggerheartService_EnterBeastform_FullMethodName                  = "/systems.daggerheart.v1.DaggerheartService/EnterBeastform"
+	DaggerheartService_ExitBeastform_FullMethodName                   = "/systems.daggerheart.v1.DaggerheartService/ExitBeastform"
+	DaggerheartService_ApplyEffect_FullMethodName                     = "/systems.daggerheart.v1.DaggerheartService/ApplyEffect"
+	DaggerheartService_RemoveEffect_FullMethodName                    = "/systems.daggerheart.v1.DaggerheartService/RemoveEffect"
+	DaggerheartService_ListEffects_FullMethodName                     = "/systems.daggerheart.v1.DaggerheartService/ListEffects"
 )
 
 // DaggerheartServiceClient is the client API for DaggerheartService service.
@@ -103,6 +137,28 @@ type DaggerheartServiceClient interface {
 	UpdateCountdown(ctx context.Context, in *DaggerheartUpdateCountdownRequest, opts ...grpc.CallOption) (*DaggerheartUpdateCountdownResponse, error)
 	// Delete a countdown (system-specific).
 	DeleteCountdown(ctx context.Context, in *DaggerheartDeleteCountdownRequest, opts ...grpc.CallOption) (*DaggerheartDeleteCountdownResponse, error)
+	// Start a chase: a progress countdown racing a consequence countdown.
+	StartChase(ctx context.Context, in *DaggerheartStartChaseRequest, opts ...grpc.CallOption) (*DaggerheartStartChaseResponse, error)
+	// Get a chase with its linked countdowns.
+	GetChase(ctx context.Context, in *DaggerheartGetChaseRequest, opts ...grpc.CallOption) (*DaggerheartGetChaseResponse, error)
+	// List the chases in a campaign.
+	ListChases(ctx context.Context, in *DaggerheartListChasesRequest, opts ...grpc.CallOption) (*DaggerheartListChasesResponse, error)
+	// Set range bands between scene entities (system-specific).
+	SetSceneRanges(ctx context.Context, in *DaggerheartSetSceneRangesRequest, opts ...grpc.CallOption) (*DaggerheartSetSceneRangesResponse, error)
+	// Move a scene entity and record its new range bands (system-specific).
+	MoveSceneEntity(ctx context.Context, in *DaggerheartMoveSceneEntityRequest, opts ...grpc.CallOption) (*DaggerheartMoveSceneEntityResponse, error)
+	// List range bands for a session scene (system-specific).
+	ListSceneRanges(ctx context.Context, in *DaggerheartListSceneRangesRequest, opts ...grpc.CallOption) (*DaggerheartListSceneRangesResponse, error)
+	// Activate an environment for a session (system-specific).
+	CreateSessionEnvironment(ctx context.Context, in *DaggerheartCreateSessionEnvironmentRequest, opts ...grpc.CallOption) (*DaggerheartCreateSessionEnvironmentResponse, error)
+	// Shift a session to a different environment (system-specific).
+	ShiftSessionEnvironment(ctx context.Context, in *DaggerheartShiftSessionEnvironmentRequest, opts ...grpc.CallOption) (*DaggerheartShiftSessionEnvironmentResponse, error)
+	// Clear the active session environment (system-specific).
+	ClearSessionEnvironment(ctx context.Context, in *DaggerheartClearSessionEnvironmentRequest, opts ...grpc.CallOption) (*DaggerheartClearSessionEnvironmentResponse, error)
+	// Get the active session environment (system-specific).
+	GetSessionEnvironment(ctx context.Context, in *DaggerheartGetSessionEnvironmentRequest, opts ...grpc.CallOption) (*DaggerheartGetSessionEnvironmentResponse, error)
+	// Trigger an action or reaction feature of the active environment (system-specific).
+	TriggerEnvironmentFeature(ctx context.Context, in *DaggerheartTriggerEnvironmentFeatureRequest, opts ...grpc.CallOption) (*DaggerheartTriggerEnvironmentFeatureResponse, error)
 	// Create an adversary (system-specific).
 	CreateAdversary(ctx context.Context, in *DaggerheartCreateAdversaryRequest, opts ...grpc.CallOption) (*DaggerheartCreateAdversaryResponse, error)
 	// Update an adversary (system-specific).
@@ -113,6 +169,14 @@ type DaggerheartServiceClient interface {
 	GetAdversary(ctx context.Context, in *DaggerheartGetAdversaryRequest, opts ...grpc.CallOption) (*DaggerheartGetAdversaryResponse, error)
 	// List adversaries (system-specific).
 	ListAdversaries(ctx context.Context, in *DaggerheartListAdversariesRequest, opts ...grpc.CallOption) (*DaggerheartListAdversariesResponse, error)
+	// Use a catalog feature of an adversary, paying its Fear and Stress costs (system-specific).
+	ActivateAdversaryFeature(ctx context.Context, in *DaggerheartActivateAdversaryFeatureRequest, opts ...grpc.CallOption) (*DaggerheartActivateAdversaryFeatureResponse, error)
+	// List the cooldown state of adversary features (system-specific).
+	ListAdversaryFeatureStates(ctx context.Context, in *DaggerheartListAdversaryFeatureStatesRequest, opts ...grpc.CallOption) (*DaggerheartListAdversaryFeatureStatesResponse, error)
+	// Price catalog adversaries against the party's battle point budget (system-specific).
+	PlanEncounter(ctx context.Context, in *DaggerheartPlanEncounterRequest, opts ...grpc.CallOption) (*DaggerheartPlanEncounterResponse, error)
+	// Instantiate a planned encounter's adversaries for a session in one event batch (system-specific).
+	CommitEncounter(ctx context.Context, in *DaggerheartCommitEncounterRequest, opts ...grpc.CallOption) (*DaggerheartCommitEncounterResponse, error)
 	// Resolve a Blaze of Glory finale (system-specific).
 	ResolveBlazeOfGlory(ctx context.Context, in *DaggerheartResolveBlazeOfGloryRequest, opts ...grpc.CallOption) (*DaggerheartResolveBlazeOfGloryResponse, error)
 	// Roll Duality dice for a session (combines roll + event recording).
@@ -121,6 +185,10 @@ type DaggerheartServiceClient interface {
 	SessionDamageRoll(ctx context.Context, in *SessionDamageRollRequest, opts ...grpc.CallOption) (*SessionDamageRollResponse, error)
 	// Run a full attack flow (roll, outcome, damage roll, apply damage).
 	SessionAttackFlow(ctx context.Context, in *SessionAttackFlowRequest, opts ...grpc.CallOption) (*SessionAttackFlowResponse, error)
+	// Run a multi-target attack flow (one roll and one damage roll fanned out to each target).
+	SessionMultiAttackFlow(ctx context.Context, in *SessionMultiAttackFlowRequest, opts ...grpc.CallOption) (*SessionMultiAttackFlowResponse, error)
+	// Run a spellcast flow (spellcast roll, outcome, Hope cost, optional damage roll).
+	SessionSpellcastFlow(ctx context.Context, in *SessionSpellcastFlowRequest, opts ...grpc.CallOption) (*SessionSpellcastFlowResponse, error)
 	// Run a full reaction flow (roll, outcome, reaction outcome).
 	SessionReactionFlow(ctx context.Context, in *SessionReactionFlowRequest, opts ...grpc.CallOption) (*SessionReactionFlowResponse, error)
 	// Roll adversary attack dice (d20-based).
@@ -129,6 +197,8 @@ type DaggerheartServiceClient interface {
 	SessionAdversaryActionCheck(ctx context.Context, in *SessionAdversaryActionCheckRequest, opts ...grpc.CallOption) (*SessionAdversaryActionCheckResponse, error)
 	// Run a full adversary attack flow (roll, outcome, damage roll, apply damage).
 	SessionAdversaryAttackFlow(ctx context.Context, in *SessionAdversaryAttackFlowRequest, opts ...grpc.CallOption) (*SessionAdversaryAttackFlowResponse, error)
+	// Run a multi-target adversary attack flow (one attack roll against each target's Evasion).
+	SessionAdversaryMultiAttackFlow(ctx context.Context, in *SessionAdversaryMultiAttackFlowRequest, opts ...grpc.CallOption) (*SessionAdversaryMultiAttackFlowResponse, error)
 	// Run a group action flow (supporter reactions + leader roll + outcome).
 	SessionGroupActionFlow(ctx context.Context, in *SessionGroupActionFlowRequest, opts ...grpc.CallOption) (*SessionGroupActionFlowResponse, error)
 	// Run a tag team flow (two rolls + selected outcome).
@@ -141,6 +211,38 @@ type DaggerheartServiceClient interface {
 	ApplyAdversaryAttackOutcome(ctx context.Context, in *DaggerheartApplyAdversaryAttackOutcomeRequest, opts ...grpc.CallOption) (*DaggerheartApplyAdversaryAttackOutcomeResponse, error)
 	// Apply a reaction outcome from a resolved reaction roll.
 	ApplyReactionOutcome(ctx context.Context, in *DaggerheartApplyReactionOutcomeRequest, opts ...grpc.CallOption) (*DaggerheartApplyReactionOutcomeResponse, error)
+	// Level up a character with the chosen advancements.
+	LevelUp(ctx context.Context, in *DaggerheartLevelUpRequest, opts ...grpc.CallOption) (*DaggerheartLevelUpResponse, error)
+	// Add a catalog weapon, armor, item or loot entry to a character's inventory.
+	AcquireItem(ctx context.Context, in *DaggerheartAcquireItemRequest, opts ...grpc.CallOption) (*DaggerheartAcquireItemResponse, error)
+	// Remove items from a character's inventory.
+	DropItem(ctx context.Context, in *DaggerheartDropItemRequest, opts ...grpc.CallOption) (*DaggerheartDropItemResponse, error)
+	// Move items from one character's inventory to another's.
+	TransferItem(ctx context.Context, in *DaggerheartTransferItemRequest, opts ...grpc.CallOption) (*DaggerheartTransferItemResponse, error)
+	// Equip a held weapon or armor; armor sets armor score and thresholds.
+	EquipItem(ctx context.Context, in *DaggerheartEquipItemRequest, opts ...grpc.CallOption) (*DaggerheartEquipItemResponse, error)
+	// Clear an equipment slot; removing armor restores unarmored thresholds.
+	UnequipItem(ctx context.Context, in *DaggerheartUnequipItemRequest, opts ...grpc.CallOption) (*DaggerheartUnequipItemResponse, error)
+	// Gain or spend gold.
+	UpdateGold(ctx context.Context, in *DaggerheartUpdateGoldRequest, opts ...grpc.CallOption) (*DaggerheartUpdateGoldResponse, error)
+	// Bond an animal companion to a character.
+	CreateCompanion(ctx context.Context, in *DaggerheartCreateCompanionRequest, opts ...grpc.CallOption) (*DaggerheartCreateCompanionResponse, error)
+	// Level up a character's companion with one upgrade.
+	LevelUpCompanion(ctx context.Context, in *DaggerheartLevelUpCompanionRequest, opts ...grpc.CallOption) (*DaggerheartLevelUpCompanionResponse, error)
+	// Mark or clear companion Stress; a companion marks Stress instead of taking damage.
+	UpdateCompanionStress(ctx context.Context, in *DaggerheartUpdateCompanionStressRequest, opts ...grpc.CallOption) (*DaggerheartUpdateCompanionStressResponse, error)
+	// Attack with a companion: spellcast roll, hit resolution and damage.
+	SessionCompanionAttackFlow(ctx context.Context, in *SessionCompanionAttackFlowRequest, opts ...grpc.CallOption) (*SessionCompanionAttackFlowResponse, error)
+	// Mark a Stress to transform into a beastform from the catalog.
+	EnterBeastform(ctx context.Context, in *DaggerheartEnterBeastformRequest, opts ...grpc.CallOption) (*DaggerheartEnterBeastformResponse, error)
+	// Drop out of the character's current beastform.
+	ExitBeastform(ctx context.Context, in *DaggerheartExitBeastformRequest, opts ...grpc.CallOption) (*DaggerheartExitBeastformResponse, error)
+	// Place a timed effect on a character or adversary.
+	ApplyEffect(ctx context.Context, in *DaggerheartApplyEffectRequest, opts ...grpc.CallOption) (*DaggerheartApplyEffectResponse, error)
+	// End a timed effect before its expiry trigger.
+	RemoveEffect(ctx context.Context, in *DaggerheartRemoveEffectRequest, opts ...grpc.CallOption) (*DaggerheartRemoveEffectResponse, error)
+	// List the timed effects in a campaign, optionally for one target.
+	ListEffects(ctx context.Context, in *DaggerheartListEffectsRequest, opts ...grpc.CallOption) (*DaggerheartListEffectsResponse, error)
 }
 
 type daggerheartServiceClient struct {
@@ -331,6 +433,116 @@ func (c *daggerheartServiceClient) DeleteCountdown(ctx context.Context, in *Dagg
 	return out, nil
 }
 
+func (c *daggerheartServiceClient) StartChase(ctx context.Context, in *DaggerheartStartChaseRequest, opts ...grpc.CallOption) (*DaggerheartStartChaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartStartChaseResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_StartChase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) GetChase(ctx context.Context, in *DaggerheartGetChaseRequest, opts ...grpc.CallOption) (*DaggerheartGetChaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartGetChaseResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_GetChase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) ListChases(ctx context.Context, in *DaggerheartListChasesRequest, opts ...grpc.CallOption) (*DaggerheartListChasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartListChasesResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_ListChases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) SetSceneRanges(ctx context.Context, in *DaggerheartSetSceneRangesRequest, opts ...grpc.CallOption) (*DaggerheartSetSceneRangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartSetSceneRangesResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_SetSceneRanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) MoveSceneEntity(ctx context.Context, in *DaggerheartMoveSceneEntityRequest, opts ...grpc.CallOption) (*DaggerheartMoveSceneEntityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartMoveSceneEntityResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_MoveSceneEntity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) ListSceneRanges(ctx context.Context, in *DaggerheartListSceneRangesRequest, opts ...grpc.CallOption) (*DaggerheartListSceneRangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartListSceneRangesResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_ListSceneRanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) CreateSessionEnvironment(ctx context.Context, in *DaggerheartCreateSessionEnvironmentRequest, opts ...grpc.CallOption) (*DaggerheartCreateSessionEnvironmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartCreateSessionEnvironmentResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_CreateSessionEnvironment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) ShiftSessionEnvironment(ctx context.Context, in *DaggerheartShiftSessionEnvironmentRequest, opts ...grpc.CallOption) (*DaggerheartShiftSessionEnvironmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartShiftSessionEnvironmentResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_ShiftSessionEnvironment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) ClearSessionEnvironment(ctx context.Context, in *DaggerheartClearSessionEnvironmentRequest, opts ...grpc.CallOption) (*DaggerheartClearSessionEnvironmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartClearSessionEnvironmentResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_ClearSessionEnvironment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) GetSessionEnvironment(ctx context.Context, in *DaggerheartGetSessionEnvironmentRequest, opts ...grpc.CallOption) (*DaggerheartGetSessionEnvironmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartGetSessionEnvironmentResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_GetSessionEnvironment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) TriggerEnvironmentFeature(ctx context.Context, in *DaggerheartTriggerEnvironmentFeatureRequest, opts ...grpc.CallOption) (*DaggerheartTriggerEnvironmentFeatureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartTriggerEnvironmentFeatureResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_TriggerEnvironmentFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daggerheartServiceClient) CreateAdversary(ctx context.Context, in *DaggerheartCreateAdversaryRequest, opts ...grpc.CallOption) (*DaggerheartCreateAdversaryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DaggerheartCreateAdversaryResponse)
@@ -381,6 +593,46 @@ func (c *daggerheartServiceClient) ListAdversaries(ctx context.Context, in *Dagg
 	return out, nil
 }
 
+func (c *daggerheartServiceClient) ActivateAdversaryFeature(ctx context.Context, in *DaggerheartActivateAdversaryFeatureRequest, opts ...grpc.CallOption) (*DaggerheartActivateAdversaryFeatureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartActivateAdversaryFeatureResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_ActivateAdversaryFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) ListAdversaryFeatureStates(ctx context.Context, in *DaggerheartListAdversaryFeatureStatesRequest, opts ...grpc.CallOption) (*DaggerheartListAdversaryFeatureStatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartListAdversaryFeatureStatesResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_ListAdversaryFeatureStates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) PlanEncounter(ctx context.Context, in *DaggerheartPlanEncounterRequest, opts ...grpc.CallOption) (*DaggerheartPlanEncounterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartPlanEncounterResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_PlanEncounter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) CommitEncounter(ctx context.Context, in *DaggerheartCommitEncounterRequest, opts ...grpc.CallOption) (*DaggerheartCommitEncounterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartCommitEncounterResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_CommitEncounter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daggerheartServiceClient) ResolveBlazeOfGlory(ctx context.Context, in *DaggerheartResolveBlazeOfGloryRequest, opts ...grpc.CallOption) (*DaggerheartResolveBlazeOfGloryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DaggerheartResolveBlazeOfGloryResponse)
@@ -421,6 +673,26 @@ func (c *daggerheartServiceClient) SessionAttackFlow(ctx context.Context, in *Se
 	return out, nil
 }
 
+func (c *daggerheartServiceClient) SessionMultiAttackFlow(ctx context.Context, in *SessionMultiAttackFlowRequest, opts ...grpc.CallOption) (*SessionMultiAttackFlowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionMultiAttackFlowResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_SessionMultiAttackFlow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) SessionSpellcastFlow(ctx context.Context, in *SessionSpellcastFlowRequest, opts ...grpc.CallOption) (*SessionSpellcastFlowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionSpellcastFlowResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_SessionSpellcastFlow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daggerheartServiceClient) SessionReactionFlow(ctx context.Context, in *SessionReactionFlowRequest, opts ...grpc.CallOption) (*SessionReactionFlowResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SessionReactionFlowResponse)
@@ -461,6 +733,16 @@ func (c *daggerheartServiceClient) SessionAdversaryAttackFlow(ctx context.Contex
 	return out, nil
 }
 
+func (c *daggerheartServiceClient) SessionAdversaryMultiAttackFlow(ctx context.Context, in *SessionAdversaryMultiAttackFlowRequest, opts ...grpc.CallOption) (*SessionAdversaryMultiAttackFlowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionAdversaryMultiAttackFlowResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_SessionAdversaryMultiAttackFlow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daggerheartServiceClient) SessionGroupActionFlow(ctx context.Context, in *SessionGroupActionFlowRequest, opts ...grpc.CallOption) (*SessionGroupActionFlowResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SessionGroupActionFlowResponse)
@@ -521,6 +803,166 @@ func (c *daggerheartServiceClient) ApplyReactionOutcome(ctx context.Context, in
 	return out, nil
 }
 
+func (c *daggerheartServiceClient) LevelUp(ctx context.Context, in *DaggerheartLevelUpRequest, opts ...grpc.CallOption) (*DaggerheartLevelUpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartLevelUpResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_LevelUp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) AcquireItem(ctx context.Context, in *DaggerheartAcquireItemRequest, opts ...grpc.CallOption) (*DaggerheartAcquireItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartAcquireItemResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_AcquireItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) DropItem(ctx context.Context, in *DaggerheartDropItemRequest, opts ...grpc.CallOption) (*DaggerheartDropItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartDropItemResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_DropItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) TransferItem(ctx context.Context, in *DaggerheartTransferItemRequest, opts ...grpc.CallOption) (*DaggerheartTransferItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartTransferItemResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_TransferItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) EquipItem(ctx context.Context, in *DaggerheartEquipItemRequest, opts ...grpc.CallOption) (*DaggerheartEquipItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartEquipItemResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_EquipItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) UnequipItem(ctx context.Context, in *DaggerheartUnequipItemRequest, opts ...grpc.CallOption) (*DaggerheartUnequipItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartUnequipItemResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_UnequipItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) UpdateGold(ctx context.Context, in *DaggerheartUpdateGoldRequest, opts ...grpc.CallOption) (*DaggerheartUpdateGoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartUpdateGoldResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_UpdateGold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) CreateCompanion(ctx context.Context, in *DaggerheartCreateCompanionRequest, opts ...grpc.CallOption) (*DaggerheartCreateCompanionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartCreateCompanionResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_CreateCompanion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) LevelUpCompanion(ctx context.Context, in *DaggerheartLevelUpCompanionRequest, opts ...grpc.CallOption) (*DaggerheartLevelUpCompanionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartLevelUpCompanionResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_LevelUpCompanion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) UpdateCompanionStress(ctx context.Context, in *DaggerheartUpdateCompanionStressRequest, opts ...grpc.CallOption) (*DaggerheartUpdateCompanionStressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartUpdateCompanionStressResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_UpdateCompanionStress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) SessionCompanionAttackFlow(ctx context.Context, in *SessionCompanionAttackFlowRequest, opts ...grpc.CallOption) (*SessionCompanionAttackFlowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SessionCompanionAttackFlowResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_SessionCompanionAttackFlow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) EnterBeastform(ctx context.Context, in *DaggerheartEnterBeastformRequest, opts ...grpc.CallOption) (*DaggerheartEnterBeastformResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartEnterBeastformResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_EnterBeastform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) ExitBeastform(ctx context.Context, in *DaggerheartExitBeastformRequest, opts ...grpc.CallOption) (*DaggerheartExitBeastformResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartExitBeastformResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_ExitBeastform_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) ApplyEffect(ctx context.Context, in *DaggerheartApplyEffectRequest, opts ...grpc.CallOption) (*DaggerheartApplyEffectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartApplyEffectResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_ApplyEffect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) RemoveEffect(ctx context.Context, in *DaggerheartRemoveEffectRequest, opts ...grpc.CallOption) (*DaggerheartRemoveEffectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartRemoveEffectResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_RemoveEffect_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daggerheartServiceClient) ListEffects(ctx context.Context, in *DaggerheartListEffectsRequest, opts ...grpc.CallOption) (*DaggerheartListEffectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DaggerheartListEffectsResponse)
+	err := c.cc.Invoke(ctx, DaggerheartService_ListEffects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaggerheartServiceServer is the server API for DaggerheartService service.
 // All implementations must embed UnimplementedDaggerheartServiceServer
 // for forward compatibility.
@@ -565,6 +1007,28 @@ type DaggerheartServiceServer interface {
 	UpdateCountdown(context.Context, *DaggerheartUpdateCountdownRequest) (*DaggerheartUpdateCountdownResponse, error)
 	// Delete a countdown (system-specific).
 	DeleteCountdown(context.Context, *DaggerheartDeleteCountdownRequest) (*DaggerheartDeleteCountdownResponse, error)
+	// Start a chase: a progress countdown racing a consequence countdown.
+	StartChase(context.Context, *DaggerheartStartChaseRequest) (*DaggerheartStartChaseResponse, error)
+	// Get a chase with its linked countdowns.
+	GetChase(context.Context, *DaggerheartGetChaseRequest) (*DaggerheartGetChaseResponse, error)
+	// List the chases in a campaign.
+	ListChases(context.Context, *DaggerheartListChasesRequest) (*DaggerheartListChasesResponse, error)
+	// Set range bands between scene entities (system-specific).
+	SetSceneRanges(context.Context, *DaggerheartSetSceneRangesRequest) (*DaggerheartSetSceneRangesResponse, error)
+	// Move a scene entity and record its new range bands (system-specific).
+	MoveSceneEntity(context.Context, *DaggerheartMoveSceneEntityRequest) (*DaggerheartMoveSceneEntityResponse, error)
+	// List range bands for a session scene (system-specific).
+	ListSceneRanges(context.Context, *DaggerheartListSceneRangesRequest) (*DaggerheartListSceneRangesResponse, error)
+	// Activate an environment for a session (system-specific).
+	CreateSessionEnvironment(context.Context, *DaggerheartCreateSessionEnvironmentRequest) (*DaggerheartCreateSessionEnvironmentResponse, error)
+	// Shift a session to a different environment (system-specific).
+	ShiftSessionEnvironment(context.Context, *DaggerheartShiftSessionEnvironmentRequest) (*DaggerheartShiftSessionEnvironmentResponse, error)
+	// Clear the active session environment (system-specific).
+	ClearSessionEnvironment(context.Context, *DaggerheartClearSessionEnvironmentRequest) (*DaggerheartClearSessionEnvironmentResponse, error)
+	// Get the active session environment (system-specific).
+	GetSessionEnvironment(context.Context, *DaggerheartGetSessionEnvironmentRequest) (*DaggerheartGetSessionEnvironmentResponse, error)
+	// Trigger an action or reaction feature of the active environment (system-specific).
+	TriggerEnvironmentFeature(context.Context, *DaggerheartTriggerEnvironmentFeatureRequest) (*DaggerheartTriggerEnvironmentFeatureResponse, error)
 	// Create an adversary (system-specific).
 	CreateAdversary(context.Context, *DaggerheartCreateAdversaryRequest) (*DaggerheartCreateAdversaryResponse, error)
 	// Update an adversary (system-specific).
@@ -575,6 +1039,14 @@ type DaggerheartServiceServer interface {
 	GetAdversary(context.Context, *DaggerheartGetAdversaryRequest) (*DaggerheartGetAdversaryResponse, error)
 	// List adversaries (system-specific).
 	ListAdversaries(context.Context, *DaggerheartListAdversariesRequest) (*DaggerheartListAdversariesResponse, error)
+	// Use a catalog feature of an adversary, paying its Fear and Stress costs (system-specific).
+	ActivateAdversaryFeature(context.Context, *DaggerheartActivateAdversaryFeatureRequest) (*DaggerheartActivateAdversaryFeatureResponse, error)
+	// List the cooldown state of adversary features (system-specific).
+	ListAdversaryFeatureStates(context.Context, *DaggerheartListAdversaryFeatureStatesRequest) (*DaggerheartListAdversaryFeatureStatesResponse, error)
+	// Price catalog adversaries against the party's battle point budget (system-specific).
+	PlanEncounter(context.Context, *DaggerheartPlanEncounterRequest) (*DaggerheartPlanEncounterResponse, error)
+	// Instantiate a planned encounter's adversaries for a session in one event batch (system-specific).
+	CommitEncounter(context.Context, *DaggerheartCommitEncounterRequest) (*DaggerheartCommitEncounterResponse, error)
 	// Resolve a Blaze of Glory finale (system-specific).
 	ResolveBlazeOfGlory(context.Context, *DaggerheartResolveBlazeOfGloryRequest) (*DaggerheartResolveBlazeOfGloryResponse, error)
 	// Roll Duality dice for a session (combines roll + event recording).
@@ -583,6 +1055,10 @@ type DaggerheartServiceServer interface {
 	SessionDamageRoll(context.Context, *SessionDamageRollRequest) (*SessionDamageRollResponse, error)
 	// Run a full attack flow (roll, outcome, damage roll, apply damage).
 	SessionAttackFlow(context.Context, *SessionAttackFlowRequest) (*SessionAttackFlowResponse, error)
+	// Run a multi-target attack flow (one roll and one damage roll fanned out to each target).
+	SessionMultiAttackFlow(context.Context, *SessionMultiAttackFlowRequest) (*SessionMultiAttackFlowResponse, error)
+	// Run a spellcast flow (spellcast roll, outcome, Hope cost, optional damage roll).
+	SessionSpellcastFlow(context.Context, *SessionSpellcastFlowRequest) (*SessionSpellcastFlowResponse, error)
 	// Run a full reaction flow (roll, outcome, reaction outcome).
 	SessionReactionFlow(context.Context, *SessionReactionFlowRequest) (*SessionReactionFlowResponse, error)
 	// Roll adversary attack dice (d20-based).
@@ -591,6 +1067,8 @@ type DaggerheartServiceServer interface {
 	SessionAdversaryActionCheck(context.Context, *SessionAdversaryActionCheckRequest) (*SessionAdversaryActionCheckResponse, error)
 	// Run a full adversary attack flow (roll, outcome, damage roll, apply damage).
 	SessionAdversaryAttackFlow(context.Context, *SessionAdversaryAttackFlowRequest) (*SessionAdversaryAttackFlowResponse, error)
+	// Run a multi-target adversary attack flow (one attack roll against each target's Evasion).
+	SessionAdversaryMultiAttackFlow(context.Context, *SessionAdversaryMultiAttackFlowRequest) (*SessionAdversaryMultiAttackFlowResponse, error)
 	// Run a group action flow (supporter reactions + leader roll + outcome).
 	SessionGroupActionFlow(context.Context, *SessionGroupActionFlowRequest) (*SessionGroupActionFlowResponse, error)
 	// Run a tag team flow (two rolls + selected outcome).
@@ -603,6 +1081,38 @@ type DaggerheartServiceServer interface {
 	ApplyAdversaryAttackOutcome(context.Context, *DaggerheartApplyAdversaryAttackOutcomeRequest) (*DaggerheartApplyAdversaryAttackOutcomeResponse, error)
 	// Apply a reaction outcome from a resolved reaction roll.
 	ApplyReactionOutcome(context.Context, *DaggerheartApplyReactionOutcomeRequest) (*DaggerheartApplyReactionOutcomeResponse, error)
+	// Level up a character with the chosen advancements.
+	LevelUp(context.Context, *DaggerheartLevelUpRequest) (*DaggerheartLevelUpResponse, error)
+	// Add a catalog weapon, armor, item or loot entry to a character's inventory.
+	AcquireItem(context.Context, *DaggerheartAcquireItemRequest) (*DaggerheartAcquireItemResponse, error)
+	// Remove items from a character's inventory.
+	DropItem(context.Context, *DaggerheartDropItemRequest) (*DaggerheartDropItemResponse, error)
+	// Move items from one character's inventory to another's.
+	TransferItem(context.Context, *DaggerheartTransferItemRequest) (*DaggerheartTransferItemResponse, error)
+	// Equip a held weapon or armor; armor sets armor score and thresholds.
+	EquipItem(context.Context, *DaggerheartEquipItemRequest) (*DaggerheartEquipItemResponse, error)
+	// Clear an equipment slot; removing armor restores unarmored thresholds.
+	UnequipItem(context.Context, *DaggerheartUnequipItemRequest) (*DaggerheartUnequipItemResponse, error)
+	// Gain or spend gold.
+	UpdateGold(context.Context, *DaggerheartUpdateGoldRequest) (*DaggerheartUpdateGoldResponse, error)
+	// Bond an animal companion to a character.
+	CreateCompanion(context.Context, *DaggerheartCreateCompanionRequest) (*DaggerheartCreateCompanionResponse, error)
+	// Level up a character's companion with one upgrade.
+	LevelUpCompanion(context.Context, *DaggerheartLevelUpCompanionRequest) (*DaggerheartLevelUpCompanionResponse, error)
+	// Mark or clear companion Stress; a companion marks Stress instead of taking damage.
+	UpdateCompanionStress(context.Context, *DaggerheartUpdateCompanionStressRequest) (*DaggerheartUpdateCompanionStressResponse, error)
+	// Attack with a companion: spellcast roll, hit resolution and damage.
+	SessionCompanionAttackFlow(context.Context, *SessionCompanionAttackFlowRequest) (*SessionCompanionAttackFlowResponse, error)
+	// Mark a Stress to transform into a beastform from the catalog.
+	EnterBeastform(context.Context, *DaggerheartEnterBeastformRequest) (*DaggerheartEnterBeastformResponse, error)
+	// Drop out of the character's current beastform.
+	ExitBeastform(context.Context, *DaggerheartExitBeastformRequest) (*DaggerheartExitBeastformResponse, error)
+	// Place a timed effect on a character or adversary.
+	ApplyEffect(context.Context, *DaggerheartApplyEffectRequest) (*DaggerheartApplyEffectResponse, error)
+	// End a timed effect before its expiry trigger.
+	RemoveEffect(context.Context, *DaggerheartRemoveEffectRequest) (*DaggerheartRemoveEffectResponse, error)
+	// List the timed effects in a campaign, optionally for one target.
+	ListEffects(context.Context, *DaggerheartListEffectsRequest) (*DaggerheartListEffectsResponse, error)
 	mustEmbedUnimplementedDaggerheartServiceServer()
 }
 
@@ -667,6 +1177,39 @@ func (UnimplementedDaggerheartServiceServer) UpdateCountdown(context.Context, *D
 func (UnimplementedDaggerheartServiceServer) DeleteCountdown(context.Context, *DaggerheartDeleteCountdownRequest) (*DaggerheartDeleteCountdownResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteCountdown not implemented")
 }
+func (UnimplementedDaggerheartServiceServer) StartChase(context.Context, *DaggerheartStartChaseRequest) (*DaggerheartStartChaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartChase not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) GetChase(context.Context, *DaggerheartGetChaseRequest) (*DaggerheartGetChaseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChase not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) ListChases(context.Context, *DaggerheartListChasesRequest) (*DaggerheartListChasesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListChases not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) SetSceneRanges(context.Context, *DaggerheartSetSceneRangesRequest) (*DaggerheartSetSceneRangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetSceneRanges not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) MoveSceneEntity(context.Context, *DaggerheartMoveSceneEntityRequest) (*DaggerheartMoveSceneEntityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveSceneEntity not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) ListSceneRanges(context.Context, *DaggerheartListSceneRangesRequest) (*DaggerheartListSceneRangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSceneRanges not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) CreateSessionEnvironment(context.Context, *DaggerheartCreateSessionEnvironmentRequest) (*DaggerheartCreateSessionEnvironmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSessionEnvironment not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) ShiftSessionEnvironment(context.Context, *DaggerheartShiftSessionEnvironmentRequest) (*DaggerheartShiftSessionEnvironmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShiftSessionEnvironment not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) ClearSessionEnvironment(context.Context, *DaggerheartClearSessionEnvironmentRequest) (*DaggerheartClearSessionEnvironmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ClearSessionEnvironment not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) GetSessionEnvironment(context.Context, *DaggerheartGetSessionEnvironmentRequest) (*DaggerheartGetSessionEnvironmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSessionEnvironment not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) TriggerEnvironmentFeature(context.Context, *DaggerheartTriggerEnvironmentFeatureRequest) (*DaggerheartTriggerEnvironmentFeatureResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TriggerEnvironmentFeature not implemented")
+}
 func (UnimplementedDaggerheartServiceServer) CreateAdversary(context.Context, *DaggerheartCreateAdversaryRequest) (*DaggerheartCreateAdversaryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateAdversary not implemented")
 }
@@ -682,6 +1225,18 @@ func (UnimplementedDaggerheartServiceServer) GetAdversary(context.Context, *Dagg
 func (UnimplementedDaggerheartServiceServer) ListAdversaries(context.Context, *DaggerheartListAdversariesRequest) (*DaggerheartListAdversariesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListAdversaries not implemented")
 }
+func (UnimplementedDaggerheartServiceServer) ActivateAdversaryFeature(context.Context, *DaggerheartActivateAdversaryFeatureRequest) (*DaggerheartActivateAdversaryFeatureResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivateAdversaryFeature not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) ListAdversaryFeatureStates(context.Context, *DaggerheartListAdversaryFeatureStatesRequest) (*DaggerheartListAdversaryFeatureStatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAdversaryFeatureStates not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) PlanEncounter(context.Context, *DaggerheartPlanEncounterRequest) (*DaggerheartPlanEncounterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlanEncounter not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) CommitEncounter(context.Context, *DaggerheartCommitEncounterRequest) (*DaggerheartCommitEncounterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommitEncounter not implemented")
+}
 func (UnimplementedDaggerheartServiceServer) ResolveBlazeOfGlory(context.Context, *DaggerheartResolveBlazeOfGloryRequest) (*DaggerheartResolveBlazeOfGloryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ResolveBlazeOfGlory not implemented")
 }
@@ -694,6 +1249,12 @@ func (UnimplementedDaggerheartServiceServer) SessionDamageRoll(context.Context,
 func (UnimplementedDaggerheartServiceServer) SessionAttackFlow(context.Context, *SessionAttackFlowRequest) (*SessionAttackFlowResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SessionAttackFlow not implemented")
 }
+func (UnimplementedDaggerheartServiceServer) SessionMultiAttackFlow(context.Context, *SessionMultiAttackFlowRequest) (*SessionMultiAttackFlowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SessionMultiAttackFlow not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) SessionSpellcastFlow(context.Context, *SessionSpellcastFlowRequest) (*SessionSpellcastFlowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SessionSpellcastFlow not implemented")
+}
 func (UnimplementedDaggerheartServiceServer) SessionReactionFlow(context.Context, *SessionReactionFlowRequest) (*SessionReactionFlowResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SessionReactionFlow not implemented")
 }
@@ -706,6 +1267,9 @@ func (UnimplementedDaggerheartServiceServer) SessionAdversaryActionCheck(context
 func (UnimplementedDaggerheartServiceServer) SessionAdversaryAttackFlow(context.Context, *SessionAdversaryAttackFlowRequest) (*SessionAdversaryAttackFlowResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SessionAdversaryAttackFlow not implemented")
 }
+func (UnimplementedDaggerheartServiceServer) SessionAdversaryMultiAttackFlow(context.Context, *SessionAdversaryMultiAttackFlowRequest) (*SessionAdversaryMultiAttackFlowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SessionAdversaryMultiAttackFlow not implemented")
+}
 func (UnimplementedDaggerheartServiceServer) SessionGroupActionFlow(context.Context, *SessionGroupActionFlowRequest) (*SessionGroupActionFlowResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SessionGroupActionFlow not implemented")
 }
@@ -724,6 +1288,54 @@ func (UnimplementedDaggerheartServiceServer) ApplyAdversaryAttackOutcome(context
 func (UnimplementedDaggerheartServiceServer) ApplyReactionOutcome(context.Context, *DaggerheartApplyReactionOutcomeRequest) (*DaggerheartApplyReactionOutcomeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ApplyReactionOutcome not implemented")
 }
+func (UnimplementedDaggerheartServiceServer) LevelUp(context.Context, *DaggerheartLevelUpRequest) (*DaggerheartLevelUpResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LevelUp not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) AcquireItem(context.Context, *DaggerheartAcquireItemRequest) (*DaggerheartAcquireItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcquireItem not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) DropItem(context.Context, *DaggerheartDropItemRequest) (*DaggerheartDropItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DropItem not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) TransferItem(context.Context, *DaggerheartTransferItemRequest) (*DaggerheartTransferItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferItem not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) EquipItem(context.Context, *DaggerheartEquipItemRequest) (*DaggerheartEquipItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EquipItem not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) UnequipItem(context.Context, *DaggerheartUnequipItemRequest) (*DaggerheartUnequipItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnequipItem not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) UpdateGold(context.Context, *DaggerheartUpdateGoldRequest) (*DaggerheartUpdateGoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGold not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) CreateCompanion(context.Context, *DaggerheartCreateCompanionRequest) (*DaggerheartCreateCompanionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCompanion not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) LevelUpCompanion(context.Context, *DaggerheartLevelUpCompanionRequest) (*DaggerheartLevelUpCompanionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LevelUpCompanion not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) UpdateCompanionStress(context.Context, *DaggerheartUpdateCompanionStressRequest) (*DaggerheartUpdateCompanionStressResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCompanionStress not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) SessionCompanionAttackFlow(context.Context, *SessionCompanionAttackFlowRequest) (*SessionCompanionAttackFlowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SessionCompanionAttackFlow not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) EnterBeastform(context.Context, *DaggerheartEnterBeastformRequest) (*DaggerheartEnterBeastformResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EnterBeastform not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) ExitBeastform(context.Context, *DaggerheartExitBeastformRequest) (*DaggerheartExitBeastformResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExitBeastform not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) ApplyEffect(context.Context, *DaggerheartApplyEffectRequest) (*DaggerheartApplyEffectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyEffect not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) RemoveEffect(context.Context, *DaggerheartRemoveEffectRequest) (*DaggerheartRemoveEffectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveEffect not implemented")
+}
+func (UnimplementedDaggerheartServiceServer) ListEffects(context.Context, *DaggerheartListEffectsRequest) (*DaggerheartListEffectsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEffects not implemented")
+}
 func (UnimplementedDaggerheartServiceServer) mustEmbedUnimplementedDaggerheartServiceServer() {}
 func (UnimplementedDaggerheartServiceServer) testEmbeddedByValue()                            {}
 
@@ -913,500 +1525,1112 @@ func _DaggerheartService_ApplyDowntimeMove_Handler(srv interface{}, ctx context.
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).ApplyDowntimeMove(ctx, in)
+		return srv.(DaggerheartServiceServer).ApplyDowntimeMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ApplyDowntimeMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ApplyDowntimeMove(ctx, req.(*DaggerheartApplyDowntimeMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_SwapLoadout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartSwapLoadoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).SwapLoadout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_SwapLoadout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).SwapLoadout(ctx, req.(*DaggerheartSwapLoadoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ApplyDeathMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartApplyDeathMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ApplyDeathMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ApplyDeathMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ApplyDeathMove(ctx, req.(*DaggerheartApplyDeathMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ApplyConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartApplyConditionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ApplyConditions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ApplyConditions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ApplyConditions(ctx, req.(*DaggerheartApplyConditionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ApplyAdversaryConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartApplyAdversaryConditionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ApplyAdversaryConditions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ApplyAdversaryConditions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ApplyAdversaryConditions(ctx, req.(*DaggerheartApplyAdversaryConditionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ApplyGmMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartApplyGmMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ApplyGmMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ApplyGmMove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ApplyGmMove(ctx, req.(*DaggerheartApplyGmMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_CreateCountdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartCreateCountdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).CreateCountdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_CreateCountdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).CreateCountdown(ctx, req.(*DaggerheartCreateCountdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_UpdateCountdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartUpdateCountdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).UpdateCountdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_UpdateCountdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).UpdateCountdown(ctx, req.(*DaggerheartUpdateCountdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_DeleteCountdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartDeleteCountdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).DeleteCountdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_DeleteCountdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).DeleteCountdown(ctx, req.(*DaggerheartDeleteCountdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_StartChase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartStartChaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).StartChase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_StartChase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).StartChase(ctx, req.(*DaggerheartStartChaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_GetChase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartGetChaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).GetChase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_GetChase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).GetChase(ctx, req.(*DaggerheartGetChaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ListChases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartListChasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ListChases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ListChases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ListChases(ctx, req.(*DaggerheartListChasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_SetSceneRanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartSetSceneRangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).SetSceneRanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_SetSceneRanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).SetSceneRanges(ctx, req.(*DaggerheartSetSceneRangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_MoveSceneEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartMoveSceneEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).MoveSceneEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_MoveSceneEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).MoveSceneEntity(ctx, req.(*DaggerheartMoveSceneEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ListSceneRanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartListSceneRangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ListSceneRanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ListSceneRanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ListSceneRanges(ctx, req.(*DaggerheartListSceneRangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_CreateSessionEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartCreateSessionEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).CreateSessionEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_CreateSessionEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).CreateSessionEnvironment(ctx, req.(*DaggerheartCreateSessionEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ShiftSessionEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartShiftSessionEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ShiftSessionEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ShiftSessionEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ShiftSessionEnvironment(ctx, req.(*DaggerheartShiftSessionEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ClearSessionEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartClearSessionEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ClearSessionEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ClearSessionEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ClearSessionEnvironment(ctx, req.(*DaggerheartClearSessionEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_GetSessionEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartGetSessionEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).GetSessionEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_GetSessionEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).GetSessionEnvironment(ctx, req.(*DaggerheartGetSessionEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_TriggerEnvironmentFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartTriggerEnvironmentFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).TriggerEnvironmentFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_TriggerEnvironmentFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).TriggerEnvironmentFeature(ctx, req.(*DaggerheartTriggerEnvironmentFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_CreateAdversary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartCreateAdversaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).CreateAdversary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_CreateAdversary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).CreateAdversary(ctx, req.(*DaggerheartCreateAdversaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_UpdateAdversary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartUpdateAdversaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).UpdateAdversary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_UpdateAdversary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).UpdateAdversary(ctx, req.(*DaggerheartUpdateAdversaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_DeleteAdversary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartDeleteAdversaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).DeleteAdversary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_DeleteAdversary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).DeleteAdversary(ctx, req.(*DaggerheartDeleteAdversaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_GetAdversary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartGetAdversaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).GetAdversary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_GetAdversary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).GetAdversary(ctx, req.(*DaggerheartGetAdversaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ListAdversaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartListAdversariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ListAdversaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ListAdversaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ListAdversaries(ctx, req.(*DaggerheartListAdversariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ActivateAdversaryFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartActivateAdversaryFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ActivateAdversaryFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ActivateAdversaryFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ActivateAdversaryFeature(ctx, req.(*DaggerheartActivateAdversaryFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ListAdversaryFeatureStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartListAdversaryFeatureStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ListAdversaryFeatureStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ListAdversaryFeatureStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ListAdversaryFeatureStates(ctx, req.(*DaggerheartListAdversaryFeatureStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_PlanEncounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartPlanEncounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).PlanEncounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_PlanEncounter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).PlanEncounter(ctx, req.(*DaggerheartPlanEncounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_CommitEncounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartCommitEncounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).CommitEncounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_CommitEncounter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).CommitEncounter(ctx, req.(*DaggerheartCommitEncounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_ResolveBlazeOfGlory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartResolveBlazeOfGloryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).ResolveBlazeOfGlory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_ResolveBlazeOfGlory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).ResolveBlazeOfGlory(ctx, req.(*DaggerheartResolveBlazeOfGloryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_SessionActionRoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionActionRollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).SessionActionRoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_SessionActionRoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).SessionActionRoll(ctx, req.(*SessionActionRollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_SessionDamageRoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionDamageRollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).SessionDamageRoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_SessionDamageRoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).SessionDamageRoll(ctx, req.(*SessionDamageRollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_SessionAttackFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionAttackFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).SessionAttackFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_SessionAttackFlow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).SessionAttackFlow(ctx, req.(*SessionAttackFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_SessionMultiAttackFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionMultiAttackFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).SessionMultiAttackFlow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaggerheartService_SessionMultiAttackFlow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaggerheartServiceServer).SessionMultiAttackFlow(ctx, req.(*SessionMultiAttackFlowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaggerheartService_SessionSpellcastFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionSpellcastFlowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaggerheartServiceServer).SessionSpellcastFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_ApplyDowntimeMove_FullMethodName,
+		FullMethod: DaggerheartService_SessionSpellcastFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).ApplyDowntimeMove(ctx, req.(*DaggerheartApplyDowntimeMoveRequest))
+		return srv.(DaggerheartServiceServer).SessionSpellcastFlow(ctx, req.(*SessionSpellcastFlowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_SwapLoadout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartSwapLoadoutRequest)
+func _DaggerheartService_SessionReactionFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionReactionFlowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).SwapLoadout(ctx, in)
+		return srv.(DaggerheartServiceServer).SessionReactionFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_SwapLoadout_FullMethodName,
+		FullMethod: DaggerheartService_SessionReactionFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).SwapLoadout(ctx, req.(*DaggerheartSwapLoadoutRequest))
+		return srv.(DaggerheartServiceServer).SessionReactionFlow(ctx, req.(*SessionReactionFlowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_ApplyDeathMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartApplyDeathMoveRequest)
+func _DaggerheartService_SessionAdversaryAttackRoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionAdversaryAttackRollRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).ApplyDeathMove(ctx, in)
+		return srv.(DaggerheartServiceServer).SessionAdversaryAttackRoll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_ApplyDeathMove_FullMethodName,
+		FullMethod: DaggerheartService_SessionAdversaryAttackRoll_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).ApplyDeathMove(ctx, req.(*DaggerheartApplyDeathMoveRequest))
+		return srv.(DaggerheartServiceServer).SessionAdversaryAttackRoll(ctx, req.(*SessionAdversaryAttackRollRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_ApplyConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartApplyConditionsRequest)
+func _DaggerheartService_SessionAdversaryActionCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionAdversaryActionCheckRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).ApplyConditions(ctx, in)
+		return srv.(DaggerheartServiceServer).SessionAdversaryActionCheck(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_ApplyConditions_FullMethodName,
+		FullMethod: DaggerheartService_SessionAdversaryActionCheck_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).ApplyConditions(ctx, req.(*DaggerheartApplyConditionsRequest))
+		return srv.(DaggerheartServiceServer).SessionAdversaryActionCheck(ctx, req.(*SessionAdversaryActionCheckRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_ApplyAdversaryConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartApplyAdversaryConditionsRequest)
+func _DaggerheartService_SessionAdversaryAttackFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionAdversaryAttackFlowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).ApplyAdversaryConditions(ctx, in)
+		return srv.(DaggerheartServiceServer).SessionAdversaryAttackFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_ApplyAdversaryConditions_FullMethodName,
+		FullMethod: DaggerheartService_SessionAdversaryAttackFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).ApplyAdversaryConditions(ctx, req.(*DaggerheartApplyAdversaryConditionsRequest))
+		return srv.(DaggerheartServiceServer).SessionAdversaryAttackFlow(ctx, req.(*SessionAdversaryAttackFlowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_ApplyGmMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartApplyGmMoveRequest)
+func _DaggerheartService_SessionAdversaryMultiAttackFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionAdversaryMultiAttackFlowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).ApplyGmMove(ctx, in)
+		return srv.(DaggerheartServiceServer).SessionAdversaryMultiAttackFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_ApplyGmMove_FullMethodName,
+		FullMethod: DaggerheartService_SessionAdversaryMultiAttackFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).ApplyGmMove(ctx, req.(*DaggerheartApplyGmMoveRequest))
+		return srv.(DaggerheartServiceServer).SessionAdversaryMultiAttackFlow(ctx, req.(*SessionAdversaryMultiAttackFlowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_CreateCountdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartCreateCountdownRequest)
+func _DaggerheartService_SessionGroupActionFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionGroupActionFlowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).CreateCountdown(ctx, in)
+		return srv.(DaggerheartServiceServer).SessionGroupActionFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_CreateCountdown_FullMethodName,
+		FullMethod: DaggerheartService_SessionGroupActionFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).CreateCountdown(ctx, req.(*DaggerheartCreateCountdownRequest))
+		return srv.(DaggerheartServiceServer).SessionGroupActionFlow(ctx, req.(*SessionGroupActionFlowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_UpdateCountdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartUpdateCountdownRequest)
+func _DaggerheartService_SessionTagTeamFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionTagTeamFlowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).UpdateCountdown(ctx, in)
+		return srv.(DaggerheartServiceServer).SessionTagTeamFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_UpdateCountdown_FullMethodName,
+		FullMethod: DaggerheartService_SessionTagTeamFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).UpdateCountdown(ctx, req.(*DaggerheartUpdateCountdownRequest))
+		return srv.(DaggerheartServiceServer).SessionTagTeamFlow(ctx, req.(*SessionTagTeamFlowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_DeleteCountdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartDeleteCountdownRequest)
+func _DaggerheartService_ApplyRollOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRollOutcomeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).DeleteCountdown(ctx, in)
+		return srv.(DaggerheartServiceServer).ApplyRollOutcome(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_DeleteCountdown_FullMethodName,
+		FullMethod: DaggerheartService_ApplyRollOutcome_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).DeleteCountdown(ctx, req.(*DaggerheartDeleteCountdownRequest))
+		return srv.(DaggerheartServiceServer).ApplyRollOutcome(ctx, req.(*ApplyRollOutcomeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_CreateAdversary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartCreateAdversaryRequest)
+func _DaggerheartService_ApplyAttackOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartApplyAttackOutcomeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).CreateAdversary(ctx, in)
+		return srv.(DaggerheartServiceServer).ApplyAttackOutcome(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_CreateAdversary_FullMethodName,
+		FullMethod: DaggerheartService_ApplyAttackOutcome_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).CreateAdversary(ctx, req.(*DaggerheartCreateAdversaryRequest))
+		return srv.(DaggerheartServiceServer).ApplyAttackOutcome(ctx, req.(*DaggerheartApplyAttackOutcomeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_UpdateAdversary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartUpdateAdversaryRequest)
+func _DaggerheartService_ApplyAdversaryAttackOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartApplyAdversaryAttackOutcomeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).UpdateAdversary(ctx, in)
+		return srv.(DaggerheartServiceServer).ApplyAdversaryAttackOutcome(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_UpdateAdversary_FullMethodName,
+		FullMethod: DaggerheartService_ApplyAdversaryAttackOutcome_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).UpdateAdversary(ctx, req.(*DaggerheartUpdateAdversaryRequest))
+		return srv.(DaggerheartServiceServer).ApplyAdversaryAttackOutcome(ctx, req.(*DaggerheartApplyAdversaryAttackOutcomeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_DeleteAdversary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartDeleteAdversaryRequest)
+func _DaggerheartService_ApplyReactionOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartApplyReactionOutcomeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).DeleteAdversary(ctx, in)
+		return srv.(DaggerheartServiceServer).ApplyReactionOutcome(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_DeleteAdversary_FullMethodName,
+		FullMethod: DaggerheartService_ApplyReactionOutcome_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).DeleteAdversary(ctx, req.(*DaggerheartDeleteAdversaryRequest))
+		return srv.(DaggerheartServiceServer).ApplyReactionOutcome(ctx, req.(*DaggerheartApplyReactionOutcomeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_GetAdversary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartGetAdversaryRequest)
+func _DaggerheartService_LevelUp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartLevelUpRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).GetAdversary(ctx, in)
+		return srv.(DaggerheartServiceServer).LevelUp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_GetAdversary_FullMethodName,
+		FullMethod: DaggerheartService_LevelUp_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).GetAdversary(ctx, req.(*DaggerheartGetAdversaryRequest))
+		return srv.(DaggerheartServiceServer).LevelUp(ctx, req.(*DaggerheartLevelUpRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_ListAdversaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartListAdversariesRequest)
+func _DaggerheartService_AcquireItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartAcquireItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).ListAdversaries(ctx, in)
+		return srv.(DaggerheartServiceServer).AcquireItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_ListAdversaries_FullMethodName,
+		FullMethod: DaggerheartService_AcquireItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).ListAdversaries(ctx, req.(*DaggerheartListAdversariesRequest))
+		return srv.(DaggerheartServiceServer).AcquireItem(ctx, req.(*DaggerheartAcquireItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_ResolveBlazeOfGlory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartResolveBlazeOfGloryRequest)
+func _DaggerheartService_DropItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartDropItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).ResolveBlazeOfGlory(ctx, in)
+		return srv.(DaggerheartServiceServer).DropItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_ResolveBlazeOfGlory_FullMethodName,
+		FullMethod: DaggerheartService_DropItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).ResolveBlazeOfGlory(ctx, req.(*DaggerheartResolveBlazeOfGloryRequest))
+		return srv.(DaggerheartServiceServer).DropItem(ctx, req.(*DaggerheartDropItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_SessionActionRoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionActionRollRequest)
+func _DaggerheartService_TransferItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartTransferItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).SessionActionRoll(ctx, in)
+		return srv.(DaggerheartServiceServer).TransferItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_SessionActionRoll_FullMethodName,
+		FullMethod: DaggerheartService_TransferItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).SessionActionRoll(ctx, req.(*SessionActionRollRequest))
+		return srv.(DaggerheartServiceServer).TransferItem(ctx, req.(*DaggerheartTransferItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_SessionDamageRoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionDamageRollRequest)
+func _DaggerheartService_EquipItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartEquipItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).SessionDamageRoll(ctx, in)
+		return srv.(DaggerheartServiceServer).EquipItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_SessionDamageRoll_FullMethodName,
+		FullMethod: DaggerheartService_EquipItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).SessionDamageRoll(ctx, req.(*SessionDamageRollRequest))
+		return srv.(DaggerheartServiceServer).EquipItem(ctx, req.(*DaggerheartEquipItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_SessionAttackFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionAttackFlowRequest)
+func _DaggerheartService_UnequipItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartUnequipItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).SessionAttackFlow(ctx, in)
+		return srv.(DaggerheartServiceServer).UnequipItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_SessionAttackFlow_FullMethodName,
+		FullMethod: DaggerheartService_UnequipItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).SessionAttackFlow(ctx, req.(*SessionAttackFlowRequest))
+		return srv.(DaggerheartServiceServer).UnequipItem(ctx, req.(*DaggerheartUnequipItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_SessionReactionFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionReactionFlowRequest)
+func _DaggerheartService_UpdateGold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartUpdateGoldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).SessionReactionFlow(ctx, in)
+		return srv.(DaggerheartServiceServer).UpdateGold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_SessionReactionFlow_FullMethodName,
+		FullMethod: DaggerheartService_UpdateGold_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).SessionReactionFlow(ctx, req.(*SessionReactionFlowRequest))
+		return srv.(DaggerheartServiceServer).UpdateGold(ctx, req.(*DaggerheartUpdateGoldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_SessionAdversaryAttackRoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionAdversaryAttackRollRequest)
+func _DaggerheartService_CreateCompanion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartCreateCompanionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).SessionAdversaryAttackRoll(ctx, in)
+		return srv.(DaggerheartServiceServer).CreateCompanion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_SessionAdversaryAttackRoll_FullMethodName,
+		FullMethod: DaggerheartService_CreateCompanion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).SessionAdversaryAttackRoll(ctx, req.(*SessionAdversaryAttackRollRequest))
+		return srv.(DaggerheartServiceServer).CreateCompanion(ctx, req.(*DaggerheartCreateCompanionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_SessionAdversaryActionCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionAdversaryActionCheckRequest)
+func _DaggerheartService_LevelUpCompanion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartLevelUpCompanionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).SessionAdversaryActionCheck(ctx, in)
+		return srv.(DaggerheartServiceServer).LevelUpCompanion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_SessionAdversaryActionCheck_FullMethodName,
+		FullMethod: DaggerheartService_LevelUpCompanion_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).SessionAdversaryActionCheck(ctx, req.(*SessionAdversaryActionCheckRequest))
+		return srv.(DaggerheartServiceServer).LevelUpCompanion(ctx, req.(*DaggerheartLevelUpCompanionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_SessionAdversaryAttackFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionAdversaryAttackFlowRequest)
+func _DaggerheartService_UpdateCompanionStress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartUpdateCompanionStressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).SessionAdversaryAttackFlow(ctx, in)
+		return srv.(DaggerheartServiceServer).UpdateCompanionStress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_SessionAdversaryAttackFlow_FullMethodName,
+		FullMethod: DaggerheartService_UpdateCompanionStress_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).SessionAdversaryAttackFlow(ctx, req.(*SessionAdversaryAttackFlowRequest))
+		return srv.(DaggerheartServiceServer).UpdateCompanionStress(ctx, req.(*DaggerheartUpdateCompanionStressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_SessionGroupActionFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionGroupActionFlowRequest)
+func _DaggerheartService_SessionCompanionAttackFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionCompanionAttackFlowRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).SessionGroupActionFlow(ctx, in)
+		return srv.(DaggerheartServiceServer).SessionCompanionAttackFlow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_SessionGroupActionFlow_FullMethodName,
+		FullMethod: DaggerheartService_SessionCompanionAttackFlow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).SessionGroupActionFlow(ctx, req.(*SessionGroupActionFlowRequest))
+		return srv.(DaggerheartServiceServer).SessionCompanionAttackFlow(ctx, req.(*SessionCompanionAttackFlowRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_SessionTagTeamFlow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionTagTeamFlowRequest)
+func _DaggerheartService_EnterBeastform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartEnterBeastformRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).SessionTagTeamFlow(ctx, in)
+		return srv.(DaggerheartServiceServer).EnterBeastform(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_SessionTagTeamFlow_FullMethodName,
+		FullMethod: DaggerheartService_EnterBeastform_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).SessionTagTeamFlow(ctx, req.(*SessionTagTeamFlowRequest))
+		return srv.(DaggerheartServiceServer).EnterBeastform(ctx, req.(*DaggerheartEnterBeastformRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_ApplyRollOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyRollOutcomeRequest)
+func _DaggerheartService_ExitBeastform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartExitBeastformRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).ApplyRollOutcome(ctx, in)
+		return srv.(DaggerheartServiceServer).ExitBeastform(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_ApplyRollOutcome_FullMethodName,
+		FullMethod: DaggerheartService_ExitBeastform_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).ApplyRollOutcome(ctx, req.(*ApplyRollOutcomeRequest))
+		return srv.(DaggerheartServiceServer).ExitBeastform(ctx, req.(*DaggerheartExitBeastformRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_ApplyAttackOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartApplyAttackOutcomeRequest)
+func _DaggerheartService_ApplyEffect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartApplyEffectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).ApplyAttackOutcome(ctx, in)
+		return srv.(DaggerheartServiceServer).ApplyEffect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_ApplyAttackOutcome_FullMethodName,
+		FullMethod: DaggerheartService_ApplyEffect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).ApplyAttackOutcome(ctx, req.(*DaggerheartApplyAttackOutcomeRequest))
+		return srv.(DaggerheartServiceServer).ApplyEffect(ctx, req.(*DaggerheartApplyEffectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_ApplyAdversaryAttackOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartApplyAdversaryAttackOutcomeRequest)
+func _DaggerheartService_RemoveEffect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartRemoveEffectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).ApplyAdversaryAttackOutcome(ctx, in)
+		return srv.(DaggerheartServiceServer).RemoveEffect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_ApplyAdversaryAttackOutcome_FullMethodName,
+		FullMethod: DaggerheartService_RemoveEffect_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).ApplyAdversaryAttackOutcome(ctx, req.(*DaggerheartApplyAdversaryAttackOutcomeRequest))
+		return srv.(DaggerheartServiceServer).RemoveEffect(ctx, req.(*DaggerheartRemoveEffectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DaggerheartService_ApplyReactionOutcome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DaggerheartApplyReactionOutcomeRequest)
+func _DaggerheartService_ListEffects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaggerheartListEffectsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaggerheartServiceServer).ApplyReactionOutcome(ctx, in)
+		return srv.(DaggerheartServiceServer).ListEffects(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DaggerheartService_ApplyReactionOutcome_FullMethodName,
+		FullMethod: DaggerheartService_ListEffects_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaggerheartServiceServer).ApplyReactionOutcome(ctx, req.(*DaggerheartApplyReactionOutcomeRequest))
+		return srv.(DaggerheartServiceServer).ListEffects(ctx, req.(*DaggerheartListEffectsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1490,6 +2714,50 @@ var DaggerheartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCountdown",
 			Handler:    _DaggerheartService_DeleteCountdown_Handler,
 		},
+		{
+			MethodName: "StartChase",
+			Handler:    _DaggerheartService_StartChase_Handler,
+		},
+		{
+			MethodName: "GetChase",
+			Handler:    _DaggerheartService_GetChase_Handler,
+		},
+		{
+			MethodName: "ListChases",
+			Handler:    _DaggerheartService_ListChases_Handler,
+		},
+		{
+			MethodName: "SetSceneRanges",
+			Handler:    _DaggerheartService_SetSceneRanges_Handler,
+		},
+		{
+			MethodName: "MoveSceneEntity",
+			Handler:    _DaggerheartService_MoveSceneEntity_Handler,
+		},
+		{
+			MethodName: "ListSceneRanges",
+			Handler:    _DaggerheartService_ListSceneRanges_Handler,
+		},
+		{
+			MethodName: "CreateSessionEnvironment",
+			Handler:    _DaggerheartService_CreateSessionEnvironment_Handler,
+		},
+		{
+			MethodName: "ShiftSessionEnvironment",
+			Handler:    _DaggerheartService_ShiftSessionEnvironment_Handler,
+		},
+		{
+			MethodName: "ClearSessionEnvironment",
+			Handler:    _DaggerheartService_ClearSessionEnvironment_Handler,
+		},
+		{
+			MethodName: "GetSessionEnvironment",
+			Handler:    _DaggerheartService_GetSessionEnvironment_Handler,
+		},
+		{
+			MethodName: "TriggerEnvironmentFeature",
+			Handler:    _DaggerheartService_TriggerEnvironmentFeature_Handler,
+		},
 		{
 			MethodName: "CreateAdversary",
 			Handler:    _DaggerheartService_CreateAdversary_Handler,
@@ -1510,6 +2778,22 @@ var DaggerheartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAdversaries",
 			Handler:    _DaggerheartService_ListAdversaries_Handler,
 		},
+		{
+			MethodName: "ActivateAdversaryFeature",
+			Handler:    _DaggerheartService_ActivateAdversaryFeature_Handler,
+		},
+		{
+			MethodName: "ListAdversaryFeatureStates",
+			Handler:    _DaggerheartService_ListAdversaryFeatureStates_Handler,
+		},
+		{
+			MethodName: "PlanEncounter",
+			Handler:    _DaggerheartService_PlanEncounter_Handler,
+		},
+		{
+			MethodName: "CommitEncounter",
+			Handler:    _DaggerheartService_CommitEncounter_Handler,
+		},
 		{
 			MethodName: "ResolveBlazeOfGlory",
 			Handler:    _DaggerheartService_ResolveBlazeOfGlory_Handler,
@@ -1526,6 +2810,14 @@ var DaggerheartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SessionAttackFlow",
 			Handler:    _DaggerheartService_SessionAttackFlow_Handler,
 		},
+		{
+			MethodName: "SessionMultiAttackFlow",
+			Handler:    _DaggerheartService_SessionMultiAttackFlow_Handler,
+		},
+		{
+			MethodName: "SessionSpellcastFlow",
+			Handler:    _DaggerheartService_SessionSpellcastFlow_Handler,
+		},
 		{
 			MethodName: "SessionReactionFlow",
 			Handler:    _DaggerheartService_SessionReactionFlow_Handler,
@@ -1542,6 +2834,10 @@ var DaggerheartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SessionAdversaryAttackFlow",
 			Handler:    _DaggerheartService_SessionAdversaryAttackFlow_Handler,
 		},
+		{
+			MethodName: "SessionAdversaryMultiAttackFlow",
+			Handler:    _DaggerheartService_SessionAdversaryMultiAttackFlow_Handler,
+		},
 		{
 			MethodName: "SessionGroupActionFlow",
 			Handler:    _DaggerheartService_SessionGroupActionFlow_Handler,
@@ -1566,6 +2862,70 @@ var DaggerheartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyReactionOutcome",
 			Handler:    _DaggerheartService_ApplyReactionOutcome_Handler,
 		},
+		{
+			MethodName: "LevelUp",
+			Handler:    _DaggerheartService_LevelUp_Handler,
+		},
+		{
+			MethodName: "AcquireItem",
+			Handler:    _DaggerheartService_AcquireItem_Handler,
+		},
+		{
+			MethodName: "DropItem",
+			Handler:    _DaggerheartService_DropItem_Handler,
+		},
+		{
+			MethodName: "TransferItem",
+			Handler:    _DaggerheartService_TransferItem_Handler,
+		},
+		{
+			MethodName: "EquipItem",
+			Handler:    _DaggerheartService_EquipItem_Handler,
+		},
+		{
+			MethodName: "UnequipItem",
+			Handler:    _DaggerheartService_UnequipItem_Handler,
+		},
+		{
+			MethodName: "UpdateGold",
+			Handler:    _DaggerheartService_UpdateGold_Handler,
+		},
+		{
+			MethodName: "CreateCompanion",
+			Handler:    _DaggerheartService_CreateCompanion_Handler,
+		},
+		{
+			MethodName: "LevelUpCompanion",
+			Handler:    _DaggerheartService_LevelUpCompanion_Handler,
+		},
+		{
+			MethodName: "UpdateCompanionStress",
+			Handler:    _DaggerheartService_UpdateCompanionStress_Handler,
+		},
+		{
+			MethodName: "SessionCompanionAttackFlow",
+			Handler:    _DaggerheartService_SessionCompanionAttackFlow_Handler,
+		},
+		{
+			MethodName: "EnterBeastform",
+			Handler:    _DaggerheartService_EnterBeastform_Handler,
+		},
+		{
+			MethodName: "ExitBeastform",
+			Handler:    _DaggerheartService_ExitBeastform_Handler,
+		},
+		{
+			MethodName: "ApplyEffect",
+			Handler:    _DaggerheartService_ApplyEffect_Handler,
+		},
+		{
+			MethodName: "RemoveEffect",
+			Handler:    _DaggerheartService_RemoveEffect_Handler,
+		},
+		{
+			MethodName: "ListEffects",
+			Handler:    _DaggerheartService_ListEffects_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "systems/daggerheart/v1/service.proto",
@@ -24,6 +24,79 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DaggerheartCompanionUpgrade enumerates the upgrades a companion can take
+// when it levels up.
+type DaggerheartCompanionUpgrade int32
+
+const (
+	DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_UNSPECIFIED DaggerheartCompanionUpgrade = 0
+	// +1 to one companion Experience.
+	DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_INTELLIGENT       DaggerheartCompanionUpgrade = 1
+	DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_LIGHT_IN_THE_DARK DaggerheartCompanionUpgrade = 2
+	DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_CREATURE_COMFORT  DaggerheartCompanionUpgrade = 3
+	DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_ARMORED           DaggerheartCompanionUpgrade = 4
+	// Step up the damage die or extend the attack range by one band.
+	DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_VICIOUS DaggerheartCompanionUpgrade = 5
+	// Permanently gain one Stress slot.
+	DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_RESILIENT DaggerheartCompanionUpgrade = 6
+	DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_BONDED    DaggerheartCompanionUpgrade = 7
+	// +2 to Evasion.
+	DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_AWARE DaggerheartCompanionUpgrade = 8
+)
+
+// Enum value maps for DaggerheartCompanionUpgrade.
+var (
+	DaggerheartCompanionUpgrade_name = map[int32]string{
+		0: "DAGGERHEART_COMPANION_UPGRADE_UNSPECIFIED",
+		1: "DAGGERHEART_COMPANION_UPGRADE_INTELLIGENT",
+		2: "DAGGERHEART_COMPANION_UPGRADE_LIGHT_IN_THE_DARK",
+		3: "DAGGERHEART_COMPANION_UPGRADE_CREATURE_COMFORT",
+		4: "DAGGERHEART_COMPANION_UPGRADE_ARMORED",
+		5: "DAGGERHEART_COMPANION_UPGRADE_VICIOUS",
+		6: "DAGGERHEART_COMPANION_UPGRADE_RESILIENT",
+		7: "DAGGERHEART_COMPANION_UPGRADE_BONDED",
+		8: "DAGGERHEART_COMPANION_UPGRADE_AWARE",
+	}
+	DaggerheartCompanionUpgrade_value = map[string]int32{
+		"DAGGERHEART_COMPANION_UPGRADE_UNSPECIFIED":       0,
+		"DAGGERHEART_COMPANION_UPGRADE_INTELLIGENT":       1,
+		"DAGGERHEART_COMPANION_UPGRADE_LIGHT_IN_THE_DARK": 2,
+		"DAGGERHEART_COMPANION_UPGRADE_CREATURE_COMFORT":  3,
+		"DAGGERHEART_COMPANION_UPGRADE_ARMORED":           4,
+		"DAGGERHEART_COMPANION_UPGRADE_VICIOUS":           5,
+		"DAGGERHEART_COMPANION_UPGRADE_RESILIENT":         6,
+		"DAGGERHEART_COMPANION_UPGRADE_BONDED":            7,
+		"DAGGERHEART_COMPANION_UPGRADE_AWARE":             8,
+	}
+)
+
+func (x DaggerheartCompanionUpgrade) Enum() *DaggerheartCompanionUpgrade {
+	p := new(DaggerheartCompanionUpgrade)
+	*p = x
+	return p
+}
+
+func (x DaggerheartCompanionUpgrade) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartCompanionUpgrade) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_state_proto_enumTypes[0].Descriptor()
+}
+
+func (DaggerheartCompanionUpgrade) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[0]
+}
+
+func (x DaggerheartCompanionUpgrade) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartCompanionUpgrade.Descriptor instead.
+func (DaggerheartCompanionUpgrade) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
 // DaggerheartCondition enumerates supported character conditions.
 type DaggerheartCondition int32
 
@@ -61,11 +134,11 @@ func (x DaggerheartCondition) String() string {
 }
 
 func (DaggerheartCondition) Descriptor() protoreflect.EnumDescriptor {
-	return file_systems_daggerheart_v1_state_proto_enumTypes[0].Descriptor()
+	return file_systems_daggerheart_v1_state_proto_enumTypes[1].Descriptor()
 }
 
 func (DaggerheartCondition) Type() protoreflect.EnumType {
-	return &file_systems_daggerheart_v1_state_proto_enumTypes[0]
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[1]
 }
 
 func (x DaggerheartCondition) Number() protoreflect.EnumNumber {
@@ -74,7 +147,7 @@ func (x DaggerheartCondition) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DaggerheartCondition.Descriptor instead.
 func (DaggerheartCondition) EnumDescriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{0}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{1}
 }
 
 // DaggerheartLifeState represents a character's life state.
@@ -117,11 +190,11 @@ func (x DaggerheartLifeState) String() string {
 }
 
 func (DaggerheartLifeState) Descriptor() protoreflect.EnumDescriptor {
-	return file_systems_daggerheart_v1_state_proto_enumTypes[1].Descriptor()
+	return file_systems_daggerheart_v1_state_proto_enumTypes[2].Descriptor()
 }
 
 func (DaggerheartLifeState) Type() protoreflect.EnumType {
-	return &file_systems_daggerheart_v1_state_proto_enumTypes[1]
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[2]
 }
 
 func (x DaggerheartLifeState) Number() protoreflect.EnumNumber {
@@ -130,7 +203,7 @@ func (x DaggerheartLifeState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DaggerheartLifeState.Descriptor instead.
 func (DaggerheartLifeState) EnumDescriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{1}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{2}
 }
 
 // DaggerheartDeathMove enumerates supported death move options.
@@ -170,11 +243,11 @@ func (x DaggerheartDeathMove) String() string {
 }
 
 func (DaggerheartDeathMove) Descriptor() protoreflect.EnumDescriptor {
-	return file_systems_daggerheart_v1_state_proto_enumTypes[2].Descriptor()
+	return file_systems_daggerheart_v1_state_proto_enumTypes[3].Descriptor()
 }
 
 func (DaggerheartDeathMove) Type() protoreflect.EnumType {
-	return &file_systems_daggerheart_v1_state_proto_enumTypes[2]
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[3]
 }
 
 func (x DaggerheartDeathMove) Number() protoreflect.EnumNumber {
@@ -183,7 +256,347 @@ func (x DaggerheartDeathMove) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DaggerheartDeathMove.Descriptor instead.
 func (DaggerheartDeathMove) EnumDescriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{2}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{3}
+}
+
+// DaggerheartInventoryItemKind identifies the catalog an inventory item comes from.
+type DaggerheartInventoryItemKind int32
+
+const (
+	DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_UNSPECIFIED DaggerheartInventoryItemKind = 0
+	DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_WEAPON      DaggerheartInventoryItemKind = 1
+	DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ARMOR       DaggerheartInventoryItemKind = 2
+	DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ITEM        DaggerheartInventoryItemKind = 3
+	DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_LOOT        DaggerheartInventoryItemKind = 4
+)
+
+// Enum value maps for DaggerheartInventoryItemKind.
+var (
+	DaggerheartInventoryItemKind_name = map[int32]string{
+		0: "DAGGERHEART_INVENTORY_ITEM_KIND_UNSPECIFIED",
+		1: "DAGGERHEART_INVENTORY_ITEM_KIND_WEAPON",
+		2: "DAGGERHEART_INVENTORY_ITEM_KIND_ARMOR",
+		3: "DAGGERHEART_INVENTORY_ITEM_KIND_ITEM",
+		4: "DAGGERHEART_INVENTORY_ITEM_KIND_LOOT",
+	}
+	DaggerheartInventoryItemKind_value = map[string]int32{
+		"DAGGERHEART_INVENTORY_ITEM_KIND_UNSPECIFIED": 0,
+		"DAGGERHEART_INVENTORY_ITEM_KIND_WEAPON":      1,
+		"DAGGERHEART_INVENTORY_ITEM_KIND_ARMOR":       2,
+		"DAGGERHEART_INVENTORY_ITEM_KIND_ITEM":        3,
+		"DAGGERHEART_INVENTORY_ITEM_KIND_LOOT":        4,
+	}
+)
+
+func (x DaggerheartInventoryItemKind) Enum() *DaggerheartInventoryItemKind {
+	p := new(DaggerheartInventoryItemKind)
+	*p = x
+	return p
+}
+
+func (x DaggerheartInventoryItemKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartInventoryItemKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_state_proto_enumTypes[4].Descriptor()
+}
+
+func (DaggerheartInventoryItemKind) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[4]
+}
+
+func (x DaggerheartInventoryItemKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartInventoryItemKind.Descriptor instead.
+func (DaggerheartInventoryItemKind) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{4}
+}
+
+// DaggerheartEquipSlot enumerates the equipment slots filled from inventory.
+type DaggerheartEquipSlot int32
+
+const (
+	DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED      DaggerheartEquipSlot = 0
+	DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_ARMOR            DaggerheartEquipSlot = 1
+	DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_PRIMARY_WEAPON   DaggerheartEquipSlot = 2
+	DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_SECONDARY_WEAPON DaggerheartEquipSlot = 3
+)
+
+// Enum value maps for DaggerheartEquipSlot.
+var (
+	DaggerheartEquipSlot_name = map[int32]string{
+		0: "DAGGERHEART_EQUIP_SLOT_UNSPECIFIED",
+		1: "DAGGERHEART_EQUIP_SLOT_ARMOR",
+		2: "DAGGERHEART_EQUIP_SLOT_PRIMARY_WEAPON",
+		3: "DAGGERHEART_EQUIP_SLOT_SECONDARY_WEAPON",
+	}
+	DaggerheartEquipSlot_value = map[string]int32{
+		"DAGGERHEART_EQUIP_SLOT_UNSPECIFIED":      0,
+		"DAGGERHEART_EQUIP_SLOT_ARMOR":            1,
+		"DAGGERHEART_EQUIP_SLOT_PRIMARY_WEAPON":   2,
+		"DAGGERHEART_EQUIP_SLOT_SECONDARY_WEAPON": 3,
+	}
+)
+
+func (x DaggerheartEquipSlot) Enum() *DaggerheartEquipSlot {
+	p := new(DaggerheartEquipSlot)
+	*p = x
+	return p
+}
+
+func (x DaggerheartEquipSlot) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartEquipSlot) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_state_proto_enumTypes[5].Descriptor()
+}
+
+func (DaggerheartEquipSlot) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[5]
+}
+
+func (x DaggerheartEquipSlot) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartEquipSlot.Descriptor instead.
+func (DaggerheartEquipSlot) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{5}
+}
+
+// DaggerheartEffectTargetType identifies what a timed effect is placed on.
+type DaggerheartEffectTargetType int32
+
+const (
+	DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_UNSPECIFIED DaggerheartEffectTargetType = 0
+	DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_CHARACTER   DaggerheartEffectTargetType = 1
+	DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_ADVERSARY   DaggerheartEffectTargetType = 2
+)
+
+// Enum value maps for DaggerheartEffectTargetType.
+var (
+	DaggerheartEffectTargetType_name = map[int32]string{
+		0: "DAGGERHEART_EFFECT_TARGET_TYPE_UNSPECIFIED",
+		1: "DAGGERHEART_EFFECT_TARGET_TYPE_CHARACTER",
+		2: "DAGGERHEART_EFFECT_TARGET_TYPE_ADVERSARY",
+	}
+	DaggerheartEffectTargetType_value = map[string]int32{
+		"DAGGERHEART_EFFECT_TARGET_TYPE_UNSPECIFIED": 0,
+		"DAGGERHEART_EFFECT_TARGET_TYPE_CHARACTER":   1,
+		"DAGGERHEART_EFFECT_TARGET_TYPE_ADVERSARY":   2,
+	}
+)
+
+func (x DaggerheartEffectTargetType) Enum() *DaggerheartEffectTargetType {
+	p := new(DaggerheartEffectTargetType)
+	*p = x
+	return p
+}
+
+func (x DaggerheartEffectTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartEffectTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_state_proto_enumTypes[6].Descriptor()
+}
+
+func (DaggerheartEffectTargetType) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[6]
+}
+
+func (x DaggerheartEffectTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartEffectTargetType.Descriptor instead.
+func (DaggerheartEffectTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{6}
+}
+
+// DaggerheartEffectKind identifies what a timed effect modifies.
+type DaggerheartEffectKind int32
+
+const (
+	DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_UNSPECIFIED DaggerheartEffectKind = 0
+	// Temporary Armor Slots; characters only.
+	DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ARMOR   DaggerheartEffectKind = 1
+	DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_EVASION DaggerheartEffectKind = 2
+	// Added to action and attack rolls.
+	DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ROLL DaggerheartEffectKind = 3
+	// Added to damage the target deals.
+	DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_DAMAGE DaggerheartEffectKind = 4
+	// Changes the sides of the target's Hope Die (-2 turns a d12 into a d10).
+	DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_HOPE_DIE DaggerheartEffectKind = 5
+)
+
+// Enum value maps for DaggerheartEffectKind.
+var (
+	DaggerheartEffectKind_name = map[int32]string{
+		0: "DAGGERHEART_EFFECT_KIND_UNSPECIFIED",
+		1: "DAGGERHEART_EFFECT_KIND_ARMOR",
+		2: "DAGGERHEART_EFFECT_KIND_EVASION",
+		3: "DAGGERHEART_EFFECT_KIND_ROLL",
+		4: "DAGGERHEART_EFFECT_KIND_DAMAGE",
+		5: "DAGGERHEART_EFFECT_KIND_HOPE_DIE",
+	}
+	DaggerheartEffectKind_value = map[string]int32{
+		"DAGGERHEART_EFFECT_KIND_UNSPECIFIED": 0,
+		"DAGGERHEART_EFFECT_KIND_ARMOR":       1,
+		"DAGGERHEART_EFFECT_KIND_EVASION":     2,
+		"DAGGERHEART_EFFECT_KIND_ROLL":        3,
+		"DAGGERHEART_EFFECT_KIND_DAMAGE":      4,
+		"DAGGERHEART_EFFECT_KIND_HOPE_DIE":    5,
+	}
+)
+
+func (x DaggerheartEffectKind) Enum() *DaggerheartEffectKind {
+	p := new(DaggerheartEffectKind)
+	*p = x
+	return p
+}
+
+func (x DaggerheartEffectKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartEffectKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_state_proto_enumTypes[7].Descriptor()
+}
+
+func (DaggerheartEffectKind) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[7]
+}
+
+func (x DaggerheartEffectKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartEffectKind.Descriptor instead.
+func (DaggerheartEffectKind) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{7}
+}
+
+// DaggerheartEffectStacking decides how effects of one kind on a target combine.
+type DaggerheartEffectStacking int32
+
+const (
+	// Defaults to stack.
+	DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_UNSPECIFIED DaggerheartEffectStacking = 0
+	DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_STACK       DaggerheartEffectStacking = 1
+	// Only the largest highest-only effect of a kind applies.
+	DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_HIGHEST DaggerheartEffectStacking = 2
+	// Expires earlier effects of the same kind and source on the target.
+	DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_REPLACE DaggerheartEffectStacking = 3
+)
+
+// Enum value maps for DaggerheartEffectStacking.
+var (
+	DaggerheartEffectStacking_name = map[int32]string{
+		0: "DAGGERHEART_EFFECT_STACKING_UNSPECIFIED",
+		1: "DAGGERHEART_EFFECT_STACKING_STACK",
+		2: "DAGGERHEART_EFFECT_STACKING_HIGHEST",
+		3: "DAGGERHEART_EFFECT_STACKING_REPLACE",
+	}
+	DaggerheartEffectStacking_value = map[string]int32{
+		"DAGGERHEART_EFFECT_STACKING_UNSPECIFIED": 0,
+		"DAGGERHEART_EFFECT_STACKING_STACK":       1,
+		"DAGGERHEART_EFFECT_STACKING_HIGHEST":     2,
+		"DAGGERHEART_EFFECT_STACKING_REPLACE":     3,
+	}
+)
+
+func (x DaggerheartEffectStacking) Enum() *DaggerheartEffectStacking {
+	p := new(DaggerheartEffectStacking)
+	*p = x
+	return p
+}
+
+func (x DaggerheartEffectStacking) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartEffectStacking) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_state_proto_enumTypes[8].Descriptor()
+}
+
+func (DaggerheartEffectStacking) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[8]
+}
+
+func (x DaggerheartEffectStacking) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartEffectStacking.Descriptor instead.
+func (DaggerheartEffectStacking) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{8}
+}
+
+// DaggerheartEffectExpiry is the trigger that ends a timed effect.
+type DaggerheartEffectExpiry int32
+
+const (
+	// Defaults to manual.
+	DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_UNSPECIFIED DaggerheartEffectExpiry = 0
+	DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_MANUAL      DaggerheartEffectExpiry = 1
+	DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_REST        DaggerheartEffectExpiry = 2
+	DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_LONG_REST   DaggerheartEffectExpiry = 3
+	// The session environment shifts or clears.
+	DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_SCENE_END DaggerheartEffectExpiry = 4
+	// The target resolves an attack.
+	DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK DaggerheartEffectExpiry = 5
+)
+
+// Enum value maps for DaggerheartEffectExpiry.
+var (
+	DaggerheartEffectExpiry_name = map[int32]string{
+		0: "DAGGERHEART_EFFECT_EXPIRY_UNSPECIFIED",
+		1: "DAGGERHEART_EFFECT_EXPIRY_MANUAL",
+		2: "DAGGERHEART_EFFECT_EXPIRY_REST",
+		3: "DAGGERHEART_EFFECT_EXPIRY_LONG_REST",
+		4: "DAGGERHEART_EFFECT_EXPIRY_SCENE_END",
+		5: "DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK",
+	}
+	DaggerheartEffectExpiry_value = map[string]int32{
+		"DAGGERHEART_EFFECT_EXPIRY_UNSPECIFIED": 0,
+		"DAGGERHEART_EFFECT_EXPIRY_MANUAL":      1,
+		"DAGGERHEART_EFFECT_EXPIRY_REST":        2,
+		"DAGGERHEART_EFFECT_EXPIRY_LONG_REST":   3,
+		"DAGGERHEART_EFFECT_EXPIRY_SCENE_END":   4,
+		"DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK": 5,
+	}
+)
+
+func (x DaggerheartEffectExpiry) Enum() *DaggerheartEffectExpiry {
+	p := new(DaggerheartEffectExpiry)
+	*p = x
+	return p
+}
+
+func (x DaggerheartEffectExpiry) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaggerheartEffectExpiry) Descriptor() protoreflect.EnumDescriptor {
+	return file_systems_daggerheart_v1_state_proto_enumTypes[9].Descriptor()
+}
+
+func (DaggerheartEffectExpiry) Type() protoreflect.EnumType {
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[9]
+}
+
+func (x DaggerheartEffectExpiry) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaggerheartEffectExpiry.Descriptor instead.
+func (DaggerheartEffectExpiry) EnumDescriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{9}
 }
 
 type DaggerheartRestType int32
@@ -219,11 +632,11 @@ func (x DaggerheartRestType) String() string {
 }
 
 func (DaggerheartRestType) Descriptor() protoreflect.EnumDescriptor {
-	return file_systems_daggerheart_v1_state_proto_enumTypes[3].Descriptor()
+	return file_systems_daggerheart_v1_state_proto_enumTypes[10].Descriptor()
 }
 
 func (DaggerheartRestType) Type() protoreflect.EnumType {
-	return &file_systems_daggerheart_v1_state_proto_enumTypes[3]
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[10]
 }
 
 func (x DaggerheartRestType) Number() protoreflect.EnumNumber {
@@ -232,7 +645,7 @@ func (x DaggerheartRestType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DaggerheartRestType.Descriptor instead.
 func (DaggerheartRestType) EnumDescriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{3}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{10}
 }
 
 type DaggerheartDowntimeMove int32
@@ -274,11 +687,11 @@ func (x DaggerheartDowntimeMove) String() string {
 }
 
 func (DaggerheartDowntimeMove) Descriptor() protoreflect.EnumDescriptor {
-	return file_systems_daggerheart_v1_state_proto_enumTypes[4].Descriptor()
+	return file_systems_daggerheart_v1_state_proto_enumTypes[11].Descriptor()
 }
 
 func (DaggerheartDowntimeMove) Type() protoreflect.EnumType {
-	return &file_systems_daggerheart_v1_state_proto_enumTypes[4]
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[11]
 }
 
 func (x DaggerheartDowntimeMove) Number() protoreflect.EnumNumber {
@@ -287,7 +700,7 @@ func (x DaggerheartDowntimeMove) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DaggerheartDowntimeMove.Descriptor instead.
 func (DaggerheartDowntimeMove) EnumDescriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{4}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{11}
 }
 
 type DaggerheartDamageType int32
@@ -326,11 +739,11 @@ func (x DaggerheartDamageType) String() string {
 }
 
 func (DaggerheartDamageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_systems_daggerheart_v1_state_proto_enumTypes[5].Descriptor()
+	return file_systems_daggerheart_v1_state_proto_enumTypes[12].Descriptor()
 }
 
 func (DaggerheartDamageType) Type() protoreflect.EnumType {
-	return &file_systems_daggerheart_v1_state_proto_enumTypes[5]
+	return &file_systems_daggerheart_v1_state_proto_enumTypes[12]
 }
 
 func (x DaggerheartDamageType) Number() protoreflect.EnumNumber {
@@ -339,7 +752,7 @@ func (x DaggerheartDamageType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DaggerheartDamageType.Descriptor instead.
 func (DaggerheartDamageType) EnumDescriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{5}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{12}
 }
 
 // DaggerheartExperience captures a named experience and modifier.
@@ -402,6 +815,7 @@ type DaggerheartProfile struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Character level (1-10).
 	// Plain int32: 0 is never valid, so 0 means "not provided" in patches.
+	// Patches may not change it; levels only change through LevelUp.
 	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
 	// Maximum hit points (typically 6 for PCs, 3 for NPCs).
 	// Plain int32: 0 is never valid (can't have 0 max HP), so current behavior is correct.
@@ -432,7 +846,33 @@ type DaggerheartProfile struct {
 	Presence  *wrapperspb.Int32Value `protobuf:"bytes,14,opt,name=presence,proto3" json:"presence,omitempty"`
 	Knowledge *wrapperspb.Int32Value `protobuf:"bytes,15,opt,name=knowledge,proto3" json:"knowledge,omitempty"`
 	// Character experiences.
-	Experiences   []*DaggerheartExperience `protobuf:"bytes,16,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	Experiences []*DaggerheartExperience `protobuf:"bytes,16,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	// Progression fields are maintained by LevelUp and ignored in patches.
+	// Traits marked by advancements in the current tier.
+	MarkedTraits []string `protobuf:"bytes,17,rep,name=marked_traits,json=markedTraits,proto3" json:"marked_traits,omitempty"`
+	// Domain cards in the active loadout.
+	LoadoutActive []string `protobuf:"bytes,18,rep,name=loadout_active,json=loadoutActive,proto3" json:"loadout_active,omitempty"`
+	// Domain cards in the vault.
+	LoadoutVault []string `protobuf:"bytes,19,rep,name=loadout_vault,json=loadoutVault,proto3" json:"loadout_vault,omitempty"`
+	// Level-ups taken, in order.
+	LevelHistory []*DaggerheartLevelUpRecord `protobuf:"bytes,20,rep,name=level_history,json=levelHistory,proto3" json:"level_history,omitempty"`
+	// Class and subclass content IDs. Empty strings are ignored in patches.
+	ClassId    string `protobuf:"bytes,21,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	SubclassId string `protobuf:"bytes,22,opt,name=subclass_id,json=subclassId,proto3" json:"subclass_id,omitempty"`
+	// Subclass feature stage: foundation, specialization, or mastery.
+	// Maintained by LevelUp; a patched subclass starts at foundation.
+	SubclassStage string `protobuf:"bytes,23,opt,name=subclass_stage,json=subclassStage,proto3" json:"subclass_stage,omitempty"`
+	// Multiclass fields are maintained by LevelUp and ignored in patches.
+	MulticlassClassId       string `protobuf:"bytes,24,opt,name=multiclass_class_id,json=multiclassClassId,proto3" json:"multiclass_class_id,omitempty"`
+	MulticlassSubclassId    string `protobuf:"bytes,25,opt,name=multiclass_subclass_id,json=multiclassSubclassId,proto3" json:"multiclass_subclass_id,omitempty"`
+	MulticlassSubclassStage string `protobuf:"bytes,26,opt,name=multiclass_subclass_stage,json=multiclassSubclassStage,proto3" json:"multiclass_subclass_stage,omitempty"`
+	MulticlassDomainId      string `protobuf:"bytes,27,opt,name=multiclass_domain_id,json=multiclassDomainId,proto3" json:"multiclass_domain_id,omitempty"`
+	// Domains the character may draw domain cards from (read-only).
+	DomainIds []string `protobuf:"bytes,28,rep,name=domain_ids,json=domainIds,proto3" json:"domain_ids,omitempty"`
+	// Class and subclass features unlocked for the character (read-only).
+	Features []*DaggerheartCharacterFeature `protobuf:"bytes,29,rep,name=features,proto3" json:"features,omitempty"`
+	// Animal companion bonded to the character, if any (read-only).
+	Companion     *DaggerheartCompanion `protobuf:"bytes,30,opt,name=companion,proto3" json:"companion,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -579,56 +1019,592 @@ func (x *DaggerheartProfile) GetExperiences() []*DaggerheartExperience {
 	return nil
 }
 
-// DaggerheartCharacterState contains Daggerheart-specific mutable character state.
-// This is materialized as a projection derived from the event journal.
-type DaggerheartCharacterState struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Current hit points (0 to hp_max from profile).
-	Hp int32 `protobuf:"varint,1,opt,name=hp,proto3" json:"hp,omitempty"`
-	// Current hope value (0-6).
-	Hope int32 `protobuf:"varint,2,opt,name=hope,proto3" json:"hope,omitempty"`
-	// Maximum hope slots (reduced by scars).
-	HopeMax int32 `protobuf:"varint,3,opt,name=hope_max,json=hopeMax,proto3" json:"hope_max,omitempty"`
-	// Current stress value (0 to stress_max from profile).
-	Stress int32 `protobuf:"varint,4,opt,name=stress,proto3" json:"stress,omitempty"`
-	// Current armor slots (0 to armor_max from profile).
-	Armor int32 `protobuf:"varint,5,opt,name=armor,proto3" json:"armor,omitempty"`
-	// Active conditions for the character.
-	Conditions []DaggerheartCondition `protobuf:"varint,6,rep,packed,name=conditions,proto3,enum=systems.daggerheart.v1.DaggerheartCondition" json:"conditions,omitempty"`
-	// Current life state.
-	LifeState     DaggerheartLifeState `protobuf:"varint,7,opt,name=life_state,json=lifeState,proto3,enum=systems.daggerheart.v1.DaggerheartLifeState" json:"life_state,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DaggerheartProfile) GetMarkedTraits() []string {
+	if x != nil {
+		return x.MarkedTraits
+	}
+	return nil
 }
 
-func (x *DaggerheartCharacterState) Reset() {
-	*x = DaggerheartCharacterState{}
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DaggerheartProfile) GetLoadoutActive() []string {
+	if x != nil {
+		return x.LoadoutActive
+	}
+	return nil
 }
 
-func (x *DaggerheartCharacterState) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DaggerheartProfile) GetLoadoutVault() []string {
+	if x != nil {
+		return x.LoadoutVault
+	}
+	return nil
 }
 
-func (*DaggerheartCharacterState) ProtoMessage() {}
+func (x *DaggerheartProfile) GetLevelHistory() []*DaggerheartLevelUpRecord {
+	if x != nil {
+		return x.LevelHistory
+	}
+	return nil
+}
 
-func (x *DaggerheartCharacterState) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[2]
+func (x *DaggerheartProfile) GetClassId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ClassId
 	}
-	return mi.MessageOf(x)
+	return ""
+}
+
+func (x *DaggerheartProfile) GetSubclassId() string {
+	if x != nil {
+		return x.SubclassId
+	}
+	return ""
+}
+
+func (x *DaggerheartProfile) GetSubclassStage() string {
+	if x != nil {
+		return x.SubclassStage
+	}
+	return ""
+}
+
+func (x *DaggerheartProfile) GetMulticlassClassId() string {
+	if x != nil {
+		return x.MulticlassClassId
+	}
+	return ""
+}
+
+func (x *DaggerheartProfile) GetMulticlassSubclassId() string {
+	if x != nil {
+		return x.MulticlassSubclassId
+	}
+	return ""
+}
+
+func (x *DaggerheartProfile) GetMulticlassSubclassStage() string {
+	if x != nil {
+		return x.MulticlassSubclassStage
+	}
+	return ""
+}
+
+func (x *DaggerheartProfile) GetMulticlassDomainId() string {
+	if x != nil {
+		return x.MulticlassDomainId
+	}
+	return ""
+}
+
+func (x *DaggerheartProfile) GetDomainIds() []string {
+	if x != nil {
+		return x.DomainIds
+	}
+	return nil
+}
+
+func (x *DaggerheartProfile) GetFeatures() []*DaggerheartCharacterFeature {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *DaggerheartProfile) GetCompanion() *DaggerheartCompanion {
+	if x != nil {
+		return x.Companion
+	}
+	return nil
+}
+
+// DaggerheartCompanionExperience is a companion Experience and its modifier.
+type DaggerheartCompanionExperience struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Companion experience catalog ID.
+	ExperienceId  string `protobuf:"bytes,1,opt,name=experience_id,json=experienceId,proto3" json:"experience_id,omitempty"`
+	Modifier      int32  `protobuf:"varint,2,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartCompanionExperience) Reset() {
+	*x = DaggerheartCompanionExperience{}
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartCompanionExperience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartCompanionExperience) ProtoMessage() {}
+
+func (x *DaggerheartCompanionExperience) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartCompanionExperience.ProtoReflect.Descriptor instead.
+func (*DaggerheartCompanionExperience) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DaggerheartCompanionExperience) GetExperienceId() string {
+	if x != nil {
+		return x.ExperienceId
+	}
+	return ""
+}
+
+func (x *DaggerheartCompanionExperience) GetModifier() int32 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+// DaggerheartCompanion is an animal companion bonded to a character.
+type DaggerheartCompanion struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Evasion   int32                  `protobuf:"varint,2,opt,name=evasion,proto3" json:"evasion,omitempty"`
+	Stress    int32                  `protobuf:"varint,3,opt,name=stress,proto3" json:"stress,omitempty"`
+	StressMax int32                  `protobuf:"varint,4,opt,name=stress_max,json=stressMax,proto3" json:"stress_max,omitempty"`
+	// Sides of the companion damage die; it rolls one die per Proficiency.
+	DamageDieSides int32 `protobuf:"varint,5,opt,name=damage_die_sides,json=damageDieSides,proto3" json:"damage_die_sides,omitempty"`
+	// Attack range band: melee, very_close, close, far or very_far.
+	Range       string                            `protobuf:"bytes,6,opt,name=range,proto3" json:"range,omitempty"`
+	Experiences []*DaggerheartCompanionExperience `protobuf:"bytes,7,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	Level       int32                             `protobuf:"varint,8,opt,name=level,proto3" json:"level,omitempty"`
+	// Upgrades taken at each level-up, oldest first.
+	Upgrades []DaggerheartCompanionUpgrade `protobuf:"varint,9,rep,packed,name=upgrades,proto3,enum=systems.daggerheart.v1.DaggerheartCompanionUpgrade" json:"upgrades,omitempty"`
+	// A companion that marks its last Stress leaves the scene until it clears one.
+	OutOfScene    bool `protobuf:"varint,10,opt,name=out_of_scene,json=outOfScene,proto3" json:"out_of_scene,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartCompanion) Reset() {
+	*x = DaggerheartCompanion{}
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartCompanion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartCompanion) ProtoMessage() {}
+
+func (x *DaggerheartCompanion) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartCompanion.ProtoReflect.Descriptor instead.
+func (*DaggerheartCompanion) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DaggerheartCompanion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DaggerheartCompanion) GetEvasion() int32 {
+	if x != nil {
+		return x.Evasion
+	}
+	return 0
+}
+
+func (x *DaggerheartCompanion) GetStress() int32 {
+	if x != nil {
+		return x.Stress
+	}
+	return 0
+}
+
+func (x *DaggerheartCompanion) GetStressMax() int32 {
+	if x != nil {
+		return x.StressMax
+	}
+	return 0
+}
+
+func (x *DaggerheartCompanion) GetDamageDieSides() int32 {
+	if x != nil {
+		return x.DamageDieSides
+	}
+	return 0
+}
+
+func (x *DaggerheartCompanion) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *DaggerheartCompanion) GetExperiences() []*DaggerheartCompanionExperience {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+func (x *DaggerheartCompanion) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *DaggerheartCompanion) GetUpgrades() []DaggerheartCompanionUpgrade {
+	if x != nil {
+		return x.Upgrades
+	}
+	return nil
+}
+
+func (x *DaggerheartCompanion) GetOutOfScene() bool {
+	if x != nil {
+		return x.OutOfScene
+	}
+	return false
+}
+
+// DaggerheartCharacterFeature is a feature granted by a class or subclass.
+type DaggerheartCharacterFeature struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Level       int32                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	// Source of the feature: class, hope, foundation, specialization, or mastery.
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	// Class or subclass content ID that grants the feature.
+	SourceId      string `protobuf:"bytes,6,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartCharacterFeature) Reset() {
+	*x = DaggerheartCharacterFeature{}
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartCharacterFeature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartCharacterFeature) ProtoMessage() {}
+
+func (x *DaggerheartCharacterFeature) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartCharacterFeature.ProtoReflect.Descriptor instead.
+func (*DaggerheartCharacterFeature) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DaggerheartCharacterFeature) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DaggerheartCharacterFeature) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DaggerheartCharacterFeature) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DaggerheartCharacterFeature) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *DaggerheartCharacterFeature) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DaggerheartCharacterFeature) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+// DaggerheartLevelUpRecord records one level-up in a character's history.
+type DaggerheartLevelUpRecord struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Level         int32                            `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Advancements  []*DaggerheartLevelUpAdvancement `protobuf:"bytes,2,rep,name=advancements,proto3" json:"advancements,omitempty"`
+	NewExperience string                           `protobuf:"bytes,3,opt,name=new_experience,json=newExperience,proto3" json:"new_experience,omitempty"`
+	DomainCardId  string                           `protobuf:"bytes,4,opt,name=domain_card_id,json=domainCardId,proto3" json:"domain_card_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartLevelUpRecord) Reset() {
+	*x = DaggerheartLevelUpRecord{}
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartLevelUpRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartLevelUpRecord) ProtoMessage() {}
+
+func (x *DaggerheartLevelUpRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartLevelUpRecord.ProtoReflect.Descriptor instead.
+func (*DaggerheartLevelUpRecord) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DaggerheartLevelUpRecord) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *DaggerheartLevelUpRecord) GetAdvancements() []*DaggerheartLevelUpAdvancement {
+	if x != nil {
+		return x.Advancements
+	}
+	return nil
+}
+
+func (x *DaggerheartLevelUpRecord) GetNewExperience() string {
+	if x != nil {
+		return x.NewExperience
+	}
+	return ""
+}
+
+func (x *DaggerheartLevelUpRecord) GetDomainCardId() string {
+	if x != nil {
+		return x.DomainCardId
+	}
+	return ""
+}
+
+// DaggerheartLevelUpAdvancement summarizes an advancement in the level history.
+type DaggerheartLevelUpAdvancement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Traits        []string               `protobuf:"bytes,2,rep,name=traits,proto3" json:"traits,omitempty"`
+	Experiences   []string               `protobuf:"bytes,3,rep,name=experiences,proto3" json:"experiences,omitempty"`
+	DomainCardId  string                 `protobuf:"bytes,4,opt,name=domain_card_id,json=domainCardId,proto3" json:"domain_card_id,omitempty"`
+	SubclassId    string                 `protobuf:"bytes,5,opt,name=subclass_id,json=subclassId,proto3" json:"subclass_id,omitempty"`
+	ClassId       string                 `protobuf:"bytes,6,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	DomainId      string                 `protobuf:"bytes,7,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartLevelUpAdvancement) Reset() {
+	*x = DaggerheartLevelUpAdvancement{}
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartLevelUpAdvancement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartLevelUpAdvancement) ProtoMessage() {}
+
+func (x *DaggerheartLevelUpAdvancement) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartLevelUpAdvancement.ProtoReflect.Descriptor instead.
+func (*DaggerheartLevelUpAdvancement) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DaggerheartLevelUpAdvancement) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DaggerheartLevelUpAdvancement) GetTraits() []string {
+	if x != nil {
+		return x.Traits
+	}
+	return nil
+}
+
+func (x *DaggerheartLevelUpAdvancement) GetExperiences() []string {
+	if x != nil {
+		return x.Experiences
+	}
+	return nil
+}
+
+func (x *DaggerheartLevelUpAdvancement) GetDomainCardId() string {
+	if x != nil {
+		return x.DomainCardId
+	}
+	return ""
+}
+
+func (x *DaggerheartLevelUpAdvancement) GetSubclassId() string {
+	if x != nil {
+		return x.SubclassId
+	}
+	return ""
+}
+
+func (x *DaggerheartLevelUpAdvancement) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+func (x *DaggerheartLevelUpAdvancement) GetDomainId() string {
+	if x != nil {
+		return x.DomainId
+	}
+	return ""
+}
+
+// DaggerheartCharacterState contains Daggerheart-specific mutable character state.
+// This is materialized as a projection derived from the event journal.
+type DaggerheartCharacterState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current hit points (0 to hp_max from profile).
+	Hp int32 `protobuf:"varint,1,opt,name=hp,proto3" json:"hp,omitempty"`
+	// Current hope value (0-6).
+	Hope int32 `protobuf:"varint,2,opt,name=hope,proto3" json:"hope,omitempty"`
+	// Maximum hope slots (reduced by scars).
+	HopeMax int32 `protobuf:"varint,3,opt,name=hope_max,json=hopeMax,proto3" json:"hope_max,omitempty"`
+	// Current stress value (0 to stress_max from profile).
+	Stress int32 `protobuf:"varint,4,opt,name=stress,proto3" json:"stress,omitempty"`
+	// Current armor slots (0 to armor_max from profile).
+	Armor int32 `protobuf:"varint,5,opt,name=armor,proto3" json:"armor,omitempty"`
+	// Active conditions for the character.
+	Conditions []DaggerheartCondition `protobuf:"varint,6,rep,packed,name=conditions,proto3,enum=systems.daggerheart.v1.DaggerheartCondition" json:"conditions,omitempty"`
+	// Current life state.
+	LifeState DaggerheartLifeState `protobuf:"varint,7,opt,name=life_state,json=lifeState,proto3,enum=systems.daggerheart.v1.DaggerheartLifeState" json:"life_state,omitempty"`
+	// Gold carried, in handfuls, bags and chests.
+	Gold *DaggerheartGold `protobuf:"bytes,8,opt,name=gold,proto3" json:"gold,omitempty"`
+	// Item stacks carried by the character.
+	Inventory []*DaggerheartInventoryItem `protobuf:"bytes,9,rep,name=inventory,proto3" json:"inventory,omitempty"`
+	// Catalog armor ID currently worn, empty when unarmored.
+	EquippedArmorId string `protobuf:"bytes,10,opt,name=equipped_armor_id,json=equippedArmorId,proto3" json:"equipped_armor_id,omitempty"`
+	// Catalog weapon ID wielded as the primary weapon.
+	EquippedPrimaryWeaponId string `protobuf:"bytes,11,opt,name=equipped_primary_weapon_id,json=equippedPrimaryWeaponId,proto3" json:"equipped_primary_weapon_id,omitempty"`
+	// Catalog weapon ID wielded as the secondary weapon.
+	EquippedSecondaryWeaponId string `protobuf:"bytes,12,opt,name=equipped_secondary_weapon_id,json=equippedSecondaryWeaponId,proto3" json:"equipped_secondary_weapon_id,omitempty"`
+	// Catalog beastform ID the character is transformed into, empty otherwise.
+	BeastformId string `protobuf:"bytes,13,opt,name=beastform_id,json=beastformId,proto3" json:"beastform_id,omitempty"`
+	// Timed effects currently placed on the character.
+	Effects       []*DaggerheartEffect `protobuf:"bytes,14,rep,name=effects,proto3" json:"effects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartCharacterState) Reset() {
+	*x = DaggerheartCharacterState{}
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartCharacterState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartCharacterState) ProtoMessage() {}
+
+func (x *DaggerheartCharacterState) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
 // Deprecated: Use DaggerheartCharacterState.ProtoReflect.Descriptor instead.
 func (*DaggerheartCharacterState) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{2}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DaggerheartCharacterState) GetHp() int32 {
@@ -680,6 +1656,278 @@ func (x *DaggerheartCharacterState) GetLifeState() DaggerheartLifeState {
 	return DaggerheartLifeState_DAGGERHEART_LIFE_STATE_UNSPECIFIED
 }
 
+func (x *DaggerheartCharacterState) GetGold() *DaggerheartGold {
+	if x != nil {
+		return x.Gold
+	}
+	return nil
+}
+
+func (x *DaggerheartCharacterState) GetInventory() []*DaggerheartInventoryItem {
+	if x != nil {
+		return x.Inventory
+	}
+	return nil
+}
+
+func (x *DaggerheartCharacterState) GetEquippedArmorId() string {
+	if x != nil {
+		return x.EquippedArmorId
+	}
+	return ""
+}
+
+func (x *DaggerheartCharacterState) GetEquippedPrimaryWeaponId() string {
+	if x != nil {
+		return x.EquippedPrimaryWeaponId
+	}
+	return ""
+}
+
+func (x *DaggerheartCharacterState) GetEquippedSecondaryWeaponId() string {
+	if x != nil {
+		return x.EquippedSecondaryWeaponId
+	}
+	return ""
+}
+
+func (x *DaggerheartCharacterState) GetBeastformId() string {
+	if x != nil {
+		return x.BeastformId
+	}
+	return ""
+}
+
+func (x *DaggerheartCharacterState) GetEffects() []*DaggerheartEffect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+// DaggerheartGold tracks coin; 10 handfuls make a bag and 10 bags make a chest.
+type DaggerheartGold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handfuls      int32                  `protobuf:"varint,1,opt,name=handfuls,proto3" json:"handfuls,omitempty"`
+	Bags          int32                  `protobuf:"varint,2,opt,name=bags,proto3" json:"bags,omitempty"`
+	Chests        int32                  `protobuf:"varint,3,opt,name=chests,proto3" json:"chests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartGold) Reset() {
+	*x = DaggerheartGold{}
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartGold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartGold) ProtoMessage() {}
+
+func (x *DaggerheartGold) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartGold.ProtoReflect.Descriptor instead.
+func (*DaggerheartGold) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DaggerheartGold) GetHandfuls() int32 {
+	if x != nil {
+		return x.Handfuls
+	}
+	return 0
+}
+
+func (x *DaggerheartGold) GetBags() int32 {
+	if x != nil {
+		return x.Bags
+	}
+	return 0
+}
+
+func (x *DaggerheartGold) GetChests() int32 {
+	if x != nil {
+		return x.Chests
+	}
+	return 0
+}
+
+// DaggerheartInventoryItem is one stack of a catalog item held by a character.
+type DaggerheartInventoryItem struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	ItemId        string                       `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Kind          DaggerheartInventoryItemKind `protobuf:"varint,2,opt,name=kind,proto3,enum=systems.daggerheart.v1.DaggerheartInventoryItemKind" json:"kind,omitempty"`
+	Quantity      int32                        `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartInventoryItem) Reset() {
+	*x = DaggerheartInventoryItem{}
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartInventoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartInventoryItem) ProtoMessage() {}
+
+func (x *DaggerheartInventoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartInventoryItem.ProtoReflect.Descriptor instead.
+func (*DaggerheartInventoryItem) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DaggerheartInventoryItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *DaggerheartInventoryItem) GetKind() DaggerheartInventoryItemKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_UNSPECIFIED
+}
+
+func (x *DaggerheartInventoryItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// DaggerheartEffect is a temporary modifier on a character or adversary.
+type DaggerheartEffect struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	EffectId      string                      `protobuf:"bytes,1,opt,name=effect_id,json=effectId,proto3" json:"effect_id,omitempty"`
+	TargetType    DaggerheartEffectTargetType `protobuf:"varint,2,opt,name=target_type,json=targetType,proto3,enum=systems.daggerheart.v1.DaggerheartEffectTargetType" json:"target_type,omitempty"`
+	TargetId      string                      `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Source        string                      `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Kind          DaggerheartEffectKind       `protobuf:"varint,5,opt,name=kind,proto3,enum=systems.daggerheart.v1.DaggerheartEffectKind" json:"kind,omitempty"`
+	Value         int32                       `protobuf:"varint,6,opt,name=value,proto3" json:"value,omitempty"`
+	Stacking      DaggerheartEffectStacking   `protobuf:"varint,7,opt,name=stacking,proto3,enum=systems.daggerheart.v1.DaggerheartEffectStacking" json:"stacking,omitempty"`
+	ExpiresOn     DaggerheartEffectExpiry     `protobuf:"varint,8,opt,name=expires_on,json=expiresOn,proto3,enum=systems.daggerheart.v1.DaggerheartEffectExpiry" json:"expires_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DaggerheartEffect) Reset() {
+	*x = DaggerheartEffect{}
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DaggerheartEffect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaggerheartEffect) ProtoMessage() {}
+
+func (x *DaggerheartEffect) ProtoReflect() protoreflect.Message {
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaggerheartEffect.ProtoReflect.Descriptor instead.
+func (*DaggerheartEffect) Descriptor() ([]byte, []int) {
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DaggerheartEffect) GetEffectId() string {
+	if x != nil {
+		return x.EffectId
+	}
+	return ""
+}
+
+func (x *DaggerheartEffect) GetTargetType() DaggerheartEffectTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *DaggerheartEffect) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *DaggerheartEffect) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DaggerheartEffect) GetKind() DaggerheartEffectKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_UNSPECIFIED
+}
+
+func (x *DaggerheartEffect) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *DaggerheartEffect) GetStacking() DaggerheartEffectStacking {
+	if x != nil {
+		return x.Stacking
+	}
+	return DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_UNSPECIFIED
+}
+
+func (x *DaggerheartEffect) GetExpiresOn() DaggerheartEffectExpiry {
+	if x != nil {
+		return x.ExpiresOn
+	}
+	return DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_UNSPECIFIED
+}
+
 // DaggerheartSnapshot contains Daggerheart-specific campaign-level state.
 // This is materialized as a projection derived from the event journal.
 type DaggerheartSnapshot struct {
@@ -688,13 +1936,15 @@ type DaggerheartSnapshot struct {
 	GmFear int32 `protobuf:"varint,1,opt,name=gm_fear,json=gmFear,proto3" json:"gm_fear,omitempty"`
 	// Count of consecutive short rests.
 	ConsecutiveShortRests int32 `protobuf:"varint,2,opt,name=consecutive_short_rests,json=consecutiveShortRests,proto3" json:"consecutive_short_rests,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Timed effects active in the campaign.
+	Effects       []*DaggerheartEffect `protobuf:"bytes,3,rep,name=effects,proto3" json:"effects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DaggerheartSnapshot) Reset() {
 	*x = DaggerheartSnapshot{}
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[3]
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -706,7 +1956,7 @@ func (x *DaggerheartSnapshot) String() string {
 func (*DaggerheartSnapshot) ProtoMessage() {}
 
 func (x *DaggerheartSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[3]
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,7 +1969,7 @@ func (x *DaggerheartSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DaggerheartSnapshot.ProtoReflect.Descriptor instead.
 func (*DaggerheartSnapshot) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{3}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DaggerheartSnapshot) GetGmFear() int32 {
@@ -736,6 +1986,13 @@ func (x *DaggerheartSnapshot) GetConsecutiveShortRests() int32 {
 	return 0
 }
 
+func (x *DaggerheartSnapshot) GetEffects() []*DaggerheartEffect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
 type DaggerheartDamageRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Amount             int32                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
@@ -748,13 +2005,15 @@ type DaggerheartDamageRequest struct {
 	MassiveDamage      bool                   `protobuf:"varint,8,opt,name=massive_damage,json=massiveDamage,proto3" json:"massive_damage,omitempty"`
 	Source             string                 `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
 	SourceCharacterIds []string               `protobuf:"bytes,10,rep,name=source_character_ids,json=sourceCharacterIds,proto3" json:"source_character_ids,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Take the damage without marking an Armor Slot.
+	DeclineArmor  bool `protobuf:"varint,11,opt,name=decline_armor,json=declineArmor,proto3" json:"decline_armor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DaggerheartDamageRequest) Reset() {
 	*x = DaggerheartDamageRequest{}
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[4]
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -766,7 +2025,7 @@ func (x *DaggerheartDamageRequest) String() string {
 func (*DaggerheartDamageRequest) ProtoMessage() {}
 
 func (x *DaggerheartDamageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[4]
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,7 +2038,7 @@ func (x *DaggerheartDamageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DaggerheartDamageRequest.ProtoReflect.Descriptor instead.
 func (*DaggerheartDamageRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{4}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DaggerheartDamageRequest) GetAmount() int32 {
@@ -852,6 +2111,13 @@ func (x *DaggerheartDamageRequest) GetSourceCharacterIds() []string {
 	return nil
 }
 
+func (x *DaggerheartDamageRequest) GetDeclineArmor() bool {
+	if x != nil {
+		return x.DeclineArmor
+	}
+	return false
+}
+
 type DaggerheartRestRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	RestType            DaggerheartRestType    `protobuf:"varint,1,opt,name=rest_type,json=restType,proto3,enum=systems.daggerheart.v1.DaggerheartRestType" json:"rest_type,omitempty"`
@@ -865,7 +2131,7 @@ type DaggerheartRestRequest struct {
 
 func (x *DaggerheartRestRequest) Reset() {
 	*x = DaggerheartRestRequest{}
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[5]
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -877,7 +2143,7 @@ func (x *DaggerheartRestRequest) String() string {
 func (*DaggerheartRestRequest) ProtoMessage() {}
 
 func (x *DaggerheartRestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[5]
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,7 +2156,7 @@ func (x *DaggerheartRestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DaggerheartRestRequest.ProtoReflect.Descriptor instead.
 func (*DaggerheartRestRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{5}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DaggerheartRestRequest) GetRestType() DaggerheartRestType {
@@ -938,7 +2204,7 @@ type DaggerheartDowntimeRequest struct {
 
 func (x *DaggerheartDowntimeRequest) Reset() {
 	*x = DaggerheartDowntimeRequest{}
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[6]
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -950,7 +2216,7 @@ func (x *DaggerheartDowntimeRequest) String() string {
 func (*DaggerheartDowntimeRequest) ProtoMessage() {}
 
 func (x *DaggerheartDowntimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[6]
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -963,7 +2229,7 @@ func (x *DaggerheartDowntimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DaggerheartDowntimeRequest.ProtoReflect.Descriptor instead.
 func (*DaggerheartDowntimeRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{6}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DaggerheartDowntimeRequest) GetMove() DaggerheartDowntimeMove {
@@ -991,7 +2257,7 @@ type DaggerheartLoadoutSwapRequest struct {
 
 func (x *DaggerheartLoadoutSwapRequest) Reset() {
 	*x = DaggerheartLoadoutSwapRequest{}
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[7]
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1003,7 +2269,7 @@ func (x *DaggerheartLoadoutSwapRequest) String() string {
 func (*DaggerheartLoadoutSwapRequest) ProtoMessage() {}
 
 func (x *DaggerheartLoadoutSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[7]
+	mi := &file_systems_daggerheart_v1_state_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,7 +2282,7 @@ func (x *DaggerheartLoadoutSwapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DaggerheartLoadoutSwapRequest.ProtoReflect.Descriptor instead.
 func (*DaggerheartLoadoutSwapRequest) Descriptor() ([]byte, []int) {
-	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{7}
+	return file_systems_daggerheart_v1_state_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DaggerheartLoadoutSwapRequest) GetCardId() string {
@@ -1047,7 +2313,7 @@ const file_systems_daggerheart_v1_state_proto_rawDesc = "" +
 	"\"systems/daggerheart/v1/state.proto\x12\x16systems.daggerheart.v1\x1a\x13common/v1/rng.proto\x1a\x1egoogle/protobuf/wrappers.proto\"G\n" +
 	"\x15DaggerheartExperience\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
-	"\bmodifier\x18\x02 \x01(\x05R\bmodifier\"\x9e\a\n" +
+	"\bmodifier\x18\x02 \x01(\x05R\bmodifier\"\xd9\f\n" +
 	"\x12DaggerheartProfile\x12\x14\n" +
 	"\x05level\x18\x01 \x01(\x05R\x05level\x12\x15\n" +
 	"\x06hp_max\x18\x02 \x01(\x05R\x05hpMax\x12:\n" +
@@ -1067,7 +2333,61 @@ const file_systems_daggerheart_v1_state_proto_rawDesc = "" +
 	"\binstinct\x18\r \x01(\v2\x1b.google.protobuf.Int32ValueR\binstinct\x127\n" +
 	"\bpresence\x18\x0e \x01(\v2\x1b.google.protobuf.Int32ValueR\bpresence\x129\n" +
 	"\tknowledge\x18\x0f \x01(\v2\x1b.google.protobuf.Int32ValueR\tknowledge\x12O\n" +
-	"\vexperiences\x18\x10 \x03(\v2-.systems.daggerheart.v1.DaggerheartExperienceR\vexperiences\"\xa3\x02\n" +
+	"\vexperiences\x18\x10 \x03(\v2-.systems.daggerheart.v1.DaggerheartExperienceR\vexperiences\x12#\n" +
+	"\rmarked_traits\x18\x11 \x03(\tR\fmarkedTraits\x12%\n" +
+	"\x0eloadout_active\x18\x12 \x03(\tR\rloadoutActive\x12#\n" +
+	"\rloadout_vault\x18\x13 \x03(\tR\floadoutVault\x12U\n" +
+	"\rlevel_history\x18\x14 \x03(\v20.systems.daggerheart.v1.DaggerheartLevelUpRecordR\flevelHistory\x12\x19\n" +
+	"\bclass_id\x18\x15 \x01(\tR\aclassId\x12\x1f\n" +
+	"\vsubclass_id\x18\x16 \x01(\tR\n" +
+	"subclassId\x12%\n" +
+	"\x0esubclass_stage\x18\x17 \x01(\tR\rsubclassStage\x12.\n" +
+	"\x13multiclass_class_id\x18\x18 \x01(\tR\x11multiclassClassId\x124\n" +
+	"\x16multiclass_subclass_id\x18\x19 \x01(\tR\x14multiclassSubclassId\x12:\n" +
+	"\x19multiclass_subclass_stage\x18\x1a \x01(\tR\x17multiclassSubclassStage\x120\n" +
+	"\x14multiclass_domain_id\x18\x1b \x01(\tR\x12multiclassDomainId\x12\x1d\n" +
+	"\n" +
+	"domain_ids\x18\x1c \x03(\tR\tdomainIds\x12O\n" +
+	"\bfeatures\x18\x1d \x03(\v23.systems.daggerheart.v1.DaggerheartCharacterFeatureR\bfeatures\x12J\n" +
+	"\tcompanion\x18\x1e \x01(\v2,.systems.daggerheart.v1.DaggerheartCompanionR\tcompanion\"a\n" +
+	"\x1eDaggerheartCompanionExperience\x12#\n" +
+	"\rexperience_id\x18\x01 \x01(\tR\fexperienceId\x12\x1a\n" +
+	"\bmodifier\x18\x02 \x01(\x05R\bmodifier\"\x9e\x03\n" +
+	"\x14DaggerheartCompanion\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aevasion\x18\x02 \x01(\x05R\aevasion\x12\x16\n" +
+	"\x06stress\x18\x03 \x01(\x05R\x06stress\x12\x1d\n" +
+	"\n" +
+	"stress_max\x18\x04 \x01(\x05R\tstressMax\x12(\n" +
+	"\x10damage_die_sides\x18\x05 \x01(\x05R\x0edamageDieSides\x12\x14\n" +
+	"\x05range\x18\x06 \x01(\tR\x05range\x12X\n" +
+	"\vexperiences\x18\a \x03(\v26.systems.daggerheart.v1.DaggerheartCompanionExperienceR\vexperiences\x12\x14\n" +
+	"\x05level\x18\b \x01(\x05R\x05level\x12O\n" +
+	"\bupgrades\x18\t \x03(\x0e23.systems.daggerheart.v1.DaggerheartCompanionUpgradeR\bupgrades\x12 \n" +
+	"\fout_of_scene\x18\n" +
+	" \x01(\bR\n" +
+	"outOfScene\"\xae\x01\n" +
+	"\x1bDaggerheartCharacterFeature\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level\x12\x16\n" +
+	"\x06source\x18\x05 \x01(\tR\x06source\x12\x1b\n" +
+	"\tsource_id\x18\x06 \x01(\tR\bsourceId\"\xd8\x01\n" +
+	"\x18DaggerheartLevelUpRecord\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x05R\x05level\x12Y\n" +
+	"\fadvancements\x18\x02 \x03(\v25.systems.daggerheart.v1.DaggerheartLevelUpAdvancementR\fadvancements\x12%\n" +
+	"\x0enew_experience\x18\x03 \x01(\tR\rnewExperience\x12$\n" +
+	"\x0edomain_card_id\x18\x04 \x01(\tR\fdomainCardId\"\xec\x01\n" +
+	"\x1dDaggerheartLevelUpAdvancement\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06traits\x18\x02 \x03(\tR\x06traits\x12 \n" +
+	"\vexperiences\x18\x03 \x03(\tR\vexperiences\x12$\n" +
+	"\x0edomain_card_id\x18\x04 \x01(\tR\fdomainCardId\x12\x1f\n" +
+	"\vsubclass_id\x18\x05 \x01(\tR\n" +
+	"subclassId\x12\x19\n" +
+	"\bclass_id\x18\x06 \x01(\tR\aclassId\x12\x1b\n" +
+	"\tdomain_id\x18\a \x01(\tR\bdomainId\"\xc2\x05\n" +
 	"\x19DaggerheartCharacterState\x12\x0e\n" +
 	"\x02hp\x18\x01 \x01(\x05R\x02hp\x12\x12\n" +
 	"\x04hope\x18\x02 \x01(\x05R\x04hope\x12\x19\n" +
@@ -1078,10 +2398,38 @@ const file_systems_daggerheart_v1_state_proto_rawDesc = "" +
 	"conditions\x18\x06 \x03(\x0e2,.systems.daggerheart.v1.DaggerheartConditionR\n" +
 	"conditions\x12K\n" +
 	"\n" +
-	"life_state\x18\a \x01(\x0e2,.systems.daggerheart.v1.DaggerheartLifeStateR\tlifeState\"f\n" +
+	"life_state\x18\a \x01(\x0e2,.systems.daggerheart.v1.DaggerheartLifeStateR\tlifeState\x12;\n" +
+	"\x04gold\x18\b \x01(\v2'.systems.daggerheart.v1.DaggerheartGoldR\x04gold\x12N\n" +
+	"\tinventory\x18\t \x03(\v20.systems.daggerheart.v1.DaggerheartInventoryItemR\tinventory\x12*\n" +
+	"\x11equipped_armor_id\x18\n" +
+	" \x01(\tR\x0fequippedArmorId\x12;\n" +
+	"\x1aequipped_primary_weapon_id\x18\v \x01(\tR\x17equippedPrimaryWeaponId\x12?\n" +
+	"\x1cequipped_secondary_weapon_id\x18\f \x01(\tR\x19equippedSecondaryWeaponId\x12!\n" +
+	"\fbeastform_id\x18\r \x01(\tR\vbeastformId\x12C\n" +
+	"\aeffects\x18\x0e \x03(\v2).systems.daggerheart.v1.DaggerheartEffectR\aeffects\"Y\n" +
+	"\x0fDaggerheartGold\x12\x1a\n" +
+	"\bhandfuls\x18\x01 \x01(\x05R\bhandfuls\x12\x12\n" +
+	"\x04bags\x18\x02 \x01(\x05R\x04bags\x12\x16\n" +
+	"\x06chests\x18\x03 \x01(\x05R\x06chests\"\x99\x01\n" +
+	"\x18DaggerheartInventoryItem\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12H\n" +
+	"\x04kind\x18\x02 \x01(\x0e24.systems.daggerheart.v1.DaggerheartInventoryItemKindR\x04kind\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\xb3\x03\n" +
+	"\x11DaggerheartEffect\x12\x1b\n" +
+	"\teffect_id\x18\x01 \x01(\tR\beffectId\x12T\n" +
+	"\vtarget_type\x18\x02 \x01(\x0e23.systems.daggerheart.v1.DaggerheartEffectTargetTypeR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12A\n" +
+	"\x04kind\x18\x05 \x01(\x0e2-.systems.daggerheart.v1.DaggerheartEffectKindR\x04kind\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x05R\x05value\x12M\n" +
+	"\bstacking\x18\a \x01(\x0e21.systems.daggerheart.v1.DaggerheartEffectStackingR\bstacking\x12N\n" +
+	"\n" +
+	"expires_on\x18\b \x01(\x0e2/.systems.daggerheart.v1.DaggerheartEffectExpiryR\texpiresOn\"\xab\x01\n" +
 	"\x13DaggerheartSnapshot\x12\x17\n" +
 	"\agm_fear\x18\x01 \x01(\x05R\x06gmFear\x126\n" +
-	"\x17consecutive_short_rests\x18\x02 \x01(\x05R\x15consecutiveShortRests\"\xa3\x03\n" +
+	"\x17consecutive_short_rests\x18\x02 \x01(\x05R\x15consecutiveShortRests\x12C\n" +
+	"\aeffects\x18\x03 \x03(\v2).systems.daggerheart.v1.DaggerheartEffectR\aeffects\"\xc8\x03\n" +
 	"\x18DaggerheartDamageRequest\x12\x16\n" +
 	"\x06amount\x18\x01 \x01(\x05R\x06amount\x12N\n" +
 	"\vdamage_type\x18\x02 \x01(\x0e2-.systems.daggerheart.v1.DaggerheartDamageTypeR\n" +
@@ -1094,7 +2442,8 @@ const file_systems_daggerheart_v1_state_proto_rawDesc = "" +
 	"\x0emassive_damage\x18\b \x01(\bR\rmassiveDamage\x12\x16\n" +
 	"\x06source\x18\t \x01(\tR\x06source\x120\n" +
 	"\x14source_character_ids\x18\n" +
-	" \x03(\tR\x12sourceCharacterIds\"\x81\x02\n" +
+	" \x03(\tR\x12sourceCharacterIds\x12#\n" +
+	"\rdecline_armor\x18\v \x01(\bR\fdeclineArmor\"\x81\x02\n" +
 	"\x16DaggerheartRestRequest\x12H\n" +
 	"\trest_type\x18\x01 \x01(\x0e2+.systems.daggerheart.v1.DaggerheartRestTypeR\brestType\x12 \n" +
 	"\vinterrupted\x18\x02 \x01(\bR\vinterrupted\x12\x1d\n" +
@@ -1109,7 +2458,17 @@ const file_systems_daggerheart_v1_state_proto_rawDesc = "" +
 	"\acard_id\x18\x01 \x01(\tR\x06cardId\x12\x1f\n" +
 	"\vrecall_cost\x18\x02 \x01(\x05R\n" +
 	"recallCost\x12\x17\n" +
-	"\ain_rest\x18\x03 \x01(\bR\x06inRest*\xab\x01\n" +
+	"\ain_rest\x18\x03 \x01(\bR\x06inRest*\xba\x03\n" +
+	"\x1bDaggerheartCompanionUpgrade\x12-\n" +
+	")DAGGERHEART_COMPANION_UPGRADE_UNSPECIFIED\x10\x00\x12-\n" +
+	")DAGGERHEART_COMPANION_UPGRADE_INTELLIGENT\x10\x01\x123\n" +
+	"/DAGGERHEART_COMPANION_UPGRADE_LIGHT_IN_THE_DARK\x10\x02\x122\n" +
+	".DAGGERHEART_COMPANION_UPGRADE_CREATURE_COMFORT\x10\x03\x12)\n" +
+	"%DAGGERHEART_COMPANION_UPGRADE_ARMORED\x10\x04\x12)\n" +
+	"%DAGGERHEART_COMPANION_UPGRADE_VICIOUS\x10\x05\x12+\n" +
+	"'DAGGERHEART_COMPANION_UPGRADE_RESILIENT\x10\x06\x12(\n" +
+	"$DAGGERHEART_COMPANION_UPGRADE_BONDED\x10\a\x12'\n" +
+	"#DAGGERHEART_COMPANION_UPGRADE_AWARE\x10\b*\xab\x01\n" +
 	"\x14DaggerheartCondition\x12%\n" +
 	"!DAGGERHEART_CONDITION_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cDAGGERHEART_CONDITION_HIDDEN\x10\x01\x12$\n" +
@@ -1125,7 +2484,41 @@ const file_systems_daggerheart_v1_state_proto_rawDesc = "" +
 	"\"DAGGERHEART_DEATH_MOVE_UNSPECIFIED\x10\x00\x12)\n" +
 	"%DAGGERHEART_DEATH_MOVE_BLAZE_OF_GLORY\x10\x01\x12&\n" +
 	"\"DAGGERHEART_DEATH_MOVE_AVOID_DEATH\x10\x02\x12&\n" +
-	"\"DAGGERHEART_DEATH_MOVE_RISK_IT_ALL\x10\x03*}\n" +
+	"\"DAGGERHEART_DEATH_MOVE_RISK_IT_ALL\x10\x03*\xfa\x01\n" +
+	"\x1cDaggerheartInventoryItemKind\x12/\n" +
+	"+DAGGERHEART_INVENTORY_ITEM_KIND_UNSPECIFIED\x10\x00\x12*\n" +
+	"&DAGGERHEART_INVENTORY_ITEM_KIND_WEAPON\x10\x01\x12)\n" +
+	"%DAGGERHEART_INVENTORY_ITEM_KIND_ARMOR\x10\x02\x12(\n" +
+	"$DAGGERHEART_INVENTORY_ITEM_KIND_ITEM\x10\x03\x12(\n" +
+	"$DAGGERHEART_INVENTORY_ITEM_KIND_LOOT\x10\x04*\xb8\x01\n" +
+	"\x14DaggerheartEquipSlot\x12&\n" +
+	"\"DAGGERHEART_EQUIP_SLOT_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cDAGGERHEART_EQUIP_SLOT_ARMOR\x10\x01\x12)\n" +
+	"%DAGGERHEART_EQUIP_SLOT_PRIMARY_WEAPON\x10\x02\x12+\n" +
+	"'DAGGERHEART_EQUIP_SLOT_SECONDARY_WEAPON\x10\x03*\xa9\x01\n" +
+	"\x1bDaggerheartEffectTargetType\x12.\n" +
+	"*DAGGERHEART_EFFECT_TARGET_TYPE_UNSPECIFIED\x10\x00\x12,\n" +
+	"(DAGGERHEART_EFFECT_TARGET_TYPE_CHARACTER\x10\x01\x12,\n" +
+	"(DAGGERHEART_EFFECT_TARGET_TYPE_ADVERSARY\x10\x02*\xf4\x01\n" +
+	"\x15DaggerheartEffectKind\x12'\n" +
+	"#DAGGERHEART_EFFECT_KIND_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dDAGGERHEART_EFFECT_KIND_ARMOR\x10\x01\x12#\n" +
+	"\x1fDAGGERHEART_EFFECT_KIND_EVASION\x10\x02\x12 \n" +
+	"\x1cDAGGERHEART_EFFECT_KIND_ROLL\x10\x03\x12\"\n" +
+	"\x1eDAGGERHEART_EFFECT_KIND_DAMAGE\x10\x04\x12$\n" +
+	" DAGGERHEART_EFFECT_KIND_HOPE_DIE\x10\x05*\xc1\x01\n" +
+	"\x19DaggerheartEffectStacking\x12+\n" +
+	"'DAGGERHEART_EFFECT_STACKING_UNSPECIFIED\x10\x00\x12%\n" +
+	"!DAGGERHEART_EFFECT_STACKING_STACK\x10\x01\x12'\n" +
+	"#DAGGERHEART_EFFECT_STACKING_HIGHEST\x10\x02\x12'\n" +
+	"#DAGGERHEART_EFFECT_STACKING_REPLACE\x10\x03*\x8b\x02\n" +
+	"\x17DaggerheartEffectExpiry\x12)\n" +
+	"%DAGGERHEART_EFFECT_EXPIRY_UNSPECIFIED\x10\x00\x12$\n" +
+	" DAGGERHEART_EFFECT_EXPIRY_MANUAL\x10\x01\x12\"\n" +
+	"\x1eDAGGERHEART_EFFECT_EXPIRY_REST\x10\x02\x12'\n" +
+	"#DAGGERHEART_EFFECT_EXPIRY_LONG_REST\x10\x03\x12'\n" +
+	"#DAGGERHEART_EFFECT_EXPIRY_SCENE_END\x10\x04\x12)\n" +
+	"%DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK\x10\x05*}\n" +
 	"\x13DaggerheartRestType\x12%\n" +
 	"!DAGGERHEART_REST_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bDAGGERHEART_REST_TYPE_SHORT\x10\x01\x12\x1e\n" +
@@ -1154,52 +2547,82 @@ func file_systems_daggerheart_v1_state_proto_rawDescGZIP() []byte {
 	return file_systems_daggerheart_v1_state_proto_rawDescData
 }
 
-var file_systems_daggerheart_v1_state_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_systems_daggerheart_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_systems_daggerheart_v1_state_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_systems_daggerheart_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_systems_daggerheart_v1_state_proto_goTypes = []any{
-	(DaggerheartCondition)(0),             // 0: systems.daggerheart.v1.DaggerheartCondition
-	(DaggerheartLifeState)(0),             // 1: systems.daggerheart.v1.DaggerheartLifeState
-	(DaggerheartDeathMove)(0),             // 2: systems.daggerheart.v1.DaggerheartDeathMove
-	(DaggerheartRestType)(0),              // 3: systems.daggerheart.v1.DaggerheartRestType
-	(DaggerheartDowntimeMove)(0),          // 4: systems.daggerheart.v1.DaggerheartDowntimeMove
-	(DaggerheartDamageType)(0),            // 5: systems.daggerheart.v1.DaggerheartDamageType
-	(*DaggerheartExperience)(nil),         // 6: systems.daggerheart.v1.DaggerheartExperience
-	(*DaggerheartProfile)(nil),            // 7: systems.daggerheart.v1.DaggerheartProfile
-	(*DaggerheartCharacterState)(nil),     // 8: systems.daggerheart.v1.DaggerheartCharacterState
-	(*DaggerheartSnapshot)(nil),           // 9: systems.daggerheart.v1.DaggerheartSnapshot
-	(*DaggerheartDamageRequest)(nil),      // 10: systems.daggerheart.v1.DaggerheartDamageRequest
-	(*DaggerheartRestRequest)(nil),        // 11: systems.daggerheart.v1.DaggerheartRestRequest
-	(*DaggerheartDowntimeRequest)(nil),    // 12: systems.daggerheart.v1.DaggerheartDowntimeRequest
-	(*DaggerheartLoadoutSwapRequest)(nil), // 13: systems.daggerheart.v1.DaggerheartLoadoutSwapRequest
-	(*wrapperspb.Int32Value)(nil),         // 14: google.protobuf.Int32Value
-	(*v1.RngRequest)(nil),                 // 15: common.v1.RngRequest
+	(DaggerheartCompanionUpgrade)(0),       // 0: systems.daggerheart.v1.DaggerheartCompanionUpgrade
+	(DaggerheartCondition)(0),              // 1: systems.daggerheart.v1.DaggerheartCondition
+	(DaggerheartLifeState)(0),              // 2: systems.daggerheart.v1.DaggerheartLifeState
+	(DaggerheartDeathMove)(0),              // 3: systems.daggerheart.v1.DaggerheartDeathMove
+	(DaggerheartInventoryItemKind)(0),      // 4: systems.daggerheart.v1.DaggerheartInventoryItemKind
+	(DaggerheartEquipSlot)(0),              // 5: systems.daggerheart.v1.DaggerheartEquipSlot
+	(DaggerheartEffectTargetType)(0),       // 6: systems.daggerheart.v1.DaggerheartEffectTargetType
+	(DaggerheartEffectKind)(0),             // 7: systems.daggerheart.v1.DaggerheartEffectKind
+	(DaggerheartEffectStacking)(0),         // 8: systems.daggerheart.v1.DaggerheartEffectStacking
+	(DaggerheartEffectExpiry)(0),           // 9: systems.daggerheart.v1.DaggerheartEffectExpiry
+	(DaggerheartRestType)(0),               // 10: systems.daggerheart.v1.DaggerheartRestType
+	(DaggerheartDowntimeMove)(0),           // 11: systems.daggerheart.v1.DaggerheartDowntimeMove
+	(DaggerheartDamageType)(0),             // 12: systems.daggerheart.v1.DaggerheartDamageType
+	(*DaggerheartExperience)(nil),          // 13: systems.daggerheart.v1.DaggerheartExperience
+	(*DaggerheartProfile)(nil),             // 14: systems.daggerheart.v1.DaggerheartProfile
+	(*DaggerheartCompanionExperience)(nil), // 15: systems.daggerheart.v1.DaggerheartCompanionExperience
+	(*DaggerheartCompanion)(nil),           // 16: systems.daggerheart.v1.DaggerheartCompanion
+	(*DaggerheartCharacterFeature)(nil),    // 17: systems.daggerheart.v1.DaggerheartCharacterFeature
+	(*DaggerheartLevelUpRecord)(nil),       // 18: systems.daggerheart.v1.DaggerheartLevelUpRecord
+	(*DaggerheartLevelUpAdvancement)(nil),  // 19: systems.daggerheart.v1.DaggerheartLevelUpAdvancement
+	(*DaggerheartCharacterState)(nil),      // 20: systems.daggerheart.v1.DaggerheartCharacterState
+	(*DaggerheartGold)(nil),                // 21: systems.daggerheart.v1.DaggerheartGold
+	(*DaggerheartInventoryItem)(nil),       // 22: systems.daggerheart.v1.DaggerheartInventoryItem
+	(*DaggerheartEffect)(nil),              // 23: systems.daggerheart.v1.DaggerheartEffect
+	(*DaggerheartSnapshot)(nil),            // 24: systems.daggerheart.v1.DaggerheartSnapshot
+	(*DaggerheartDamageRequest)(nil),       // 25: systems.daggerheart.v1.DaggerheartDamageRequest
+	(*DaggerheartRestRequest)(nil),         // 26: systems.daggerheart.v1.DaggerheartRestRequest
+	(*DaggerheartDowntimeRequest)(nil),     // 27: systems.daggerheart.v1.DaggerheartDowntimeRequest
+	(*DaggerheartLoadoutSwapRequest)(nil),  // 28: systems.daggerheart.v1.DaggerheartLoadoutSwapRequest
+	(*wrapperspb.Int32Value)(nil),          // 29: google.protobuf.Int32Value
+	(*v1.RngRequest)(nil),                  // 30: common.v1.RngRequest
 }
 var file_systems_daggerheart_v1_state_proto_depIdxs = []int32{
-	14, // 0: systems.daggerheart.v1.DaggerheartProfile.stress_max:type_name -> google.protobuf.Int32Value
-	14, // 1: systems.daggerheart.v1.DaggerheartProfile.evasion:type_name -> google.protobuf.Int32Value
-	14, // 2: systems.daggerheart.v1.DaggerheartProfile.major_threshold:type_name -> google.protobuf.Int32Value
-	14, // 3: systems.daggerheart.v1.DaggerheartProfile.severe_threshold:type_name -> google.protobuf.Int32Value
-	14, // 4: systems.daggerheart.v1.DaggerheartProfile.proficiency:type_name -> google.protobuf.Int32Value
-	14, // 5: systems.daggerheart.v1.DaggerheartProfile.armor_score:type_name -> google.protobuf.Int32Value
-	14, // 6: systems.daggerheart.v1.DaggerheartProfile.armor_max:type_name -> google.protobuf.Int32Value
-	14, // 7: systems.daggerheart.v1.DaggerheartProfile.agility:type_name -> google.protobuf.Int32Value
-	14, // 8: systems.daggerheart.v1.DaggerheartProfile.strength:type_name -> google.protobuf.Int32Value
-	14, // 9: systems.daggerheart.v1.DaggerheartProfile.finesse:type_name -> google.protobuf.Int32Value
-	14, // 10: systems.daggerheart.v1.DaggerheartProfile.instinct:type_name -> google.protobuf.Int32Value
-	14, // 11: systems.daggerheart.v1.DaggerheartProfile.presence:type_name -> google.protobuf.Int32Value
-	14, // 12: systems.daggerheart.v1.DaggerheartProfile.knowledge:type_name -> google.protobuf.Int32Value
-	6,  // 13: systems.daggerheart.v1.DaggerheartProfile.experiences:type_name -> systems.daggerheart.v1.DaggerheartExperience
-	0,  // 14: systems.daggerheart.v1.DaggerheartCharacterState.conditions:type_name -> systems.daggerheart.v1.DaggerheartCondition
-	1,  // 15: systems.daggerheart.v1.DaggerheartCharacterState.life_state:type_name -> systems.daggerheart.v1.DaggerheartLifeState
-	5,  // 16: systems.daggerheart.v1.DaggerheartDamageRequest.damage_type:type_name -> systems.daggerheart.v1.DaggerheartDamageType
-	3,  // 17: systems.daggerheart.v1.DaggerheartRestRequest.rest_type:type_name -> systems.daggerheart.v1.DaggerheartRestType
-	15, // 18: systems.daggerheart.v1.DaggerheartRestRequest.rng:type_name -> common.v1.RngRequest
-	4,  // 19: systems.daggerheart.v1.DaggerheartDowntimeRequest.move:type_name -> systems.daggerheart.v1.DaggerheartDowntimeMove
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	29, // 0: systems.daggerheart.v1.DaggerheartProfile.stress_max:type_name -> google.protobuf.Int32Value
+	29, // 1: systems.daggerheart.v1.DaggerheartProfile.evasion:type_name -> google.protobuf.Int32Value
+	29, // 2: systems.daggerheart.v1.DaggerheartProfile.major_threshold:type_name -> google.protobuf.Int32Value
+	29, // 3: systems.daggerheart.v1.DaggerheartProfile.severe_threshold:type_name -> google.protobuf.Int32Value
+	29, // 4: systems.daggerheart.v1.DaggerheartProfile.proficiency:type_name -> google.protobuf.Int32Value
+	29, // 5: systems.daggerheart.v1.DaggerheartProfile.armor_score:type_name -> google.protobuf.Int32Value
+	29, // 6: systems.daggerheart.v1.DaggerheartProfile.armor_max:type_name -> google.protobuf.Int32Value
+	29, // 7: systems.daggerheart.v1.DaggerheartProfile.agility:type_name -> google.protobuf.Int32Value
+	29, // 8: systems.daggerheart.v1.DaggerheartProfile.strength:type_name -> google.protobuf.Int32Value
+	29, // 9: systems.daggerheart.v1.DaggerheartProfile.finesse:type_name -> google.protobuf.Int32Value
+	29, // 10: systems.daggerheart.v1.DaggerheartProfile.instinct:type_name -> google.protobuf.Int32Value
+	29, // 11: systems.daggerheart.v1.DaggerheartProfile.presence:type_name -> google.protobuf.Int32Value
+	29, // 12: systems.daggerheart.v1.DaggerheartProfile.knowledge:type_name -> google.protobuf.Int32Value
+	13, // 13: systems.daggerheart.v1.DaggerheartProfile.experiences:type_name -> systems.daggerheart.v1.DaggerheartExperience
+	18, // 14: systems.daggerheart.v1.DaggerheartProfile.level_history:type_name -> systems.daggerheart.v1.DaggerheartLevelUpRecord
+	17, // 15: systems.daggerheart.v1.DaggerheartProfile.features:type_name -> systems.daggerheart.v1.DaggerheartCharacterFeature
+	16, // 16: systems.daggerheart.v1.DaggerheartProfile.companion:type_name -> systems.daggerheart.v1.DaggerheartCompanion
+	15, // 17: systems.daggerheart.v1.DaggerheartCompanion.experiences:type_name -> systems.daggerheart.v1.DaggerheartCompanionExperience
+	0,  // 18: systems.daggerheart.v1.DaggerheartCompanion.upgrades:type_name -> systems.daggerheart.v1.DaggerheartCompanionUpgrade
+	19, // 19: systems.daggerheart.v1.DaggerheartLevelUpRecord.advancements:type_name -> systems.daggerheart.v1.DaggerheartLevelUpAdvancement
+	1,  // 20: systems.daggerheart.v1.DaggerheartCharacterState.conditions:type_name -> systems.daggerheart.v1.DaggerheartCondition
+	2,  // 21: systems.daggerheart.v1.DaggerheartCharacterState.life_state:type_name -> systems.daggerheart.v1.DaggerheartLifeState
+	21, // 22: systems.daggerheart.v1.DaggerheartCharacterState.gold:type_name -> systems.daggerheart.v1.DaggerheartGold
+	22, // 23: systems.daggerheart.v1.DaggerheartCharacterState.inventory:type_name -> systems.daggerheart.v1.DaggerheartInventoryItem
+	23, // 24: systems.daggerheart.v1.DaggerheartCharacterState.effects:type_name -> systems.daggerheart.v1.DaggerheartEffect
+	4,  // 25: systems.daggerheart.v1.DaggerheartInventoryItem.kind:type_name -> systems.daggerheart.v1.DaggerheartInventoryItemKind
+	6,  // 26: systems.daggerheart.v1.DaggerheartEffect.target_type:type_name -> systems.daggerheart.v1.DaggerheartEffectTargetType
+	7,  // 27: systems.daggerheart.v1.DaggerheartEffect.kind:type_name -> systems.daggerheart.v1.DaggerheartEffectKind
+	8,  // 28: systems.daggerheart.v1.DaggerheartEffect.stacking:type_name -> systems.daggerheart.v1.DaggerheartEffectStacking
+	9,  // 29: systems.daggerheart.v1.DaggerheartEffect.expires_on:type_name -> systems.daggerheart.v1.DaggerheartEffectExpiry
+	23, // 30: systems.daggerheart.v1.DaggerheartSnapshot.effects:type_name -> systems.daggerheart.v1.DaggerheartEffect
+	12, // 31: systems.daggerheart.v1.DaggerheartDamageRequest.damage_type:type_name -> systems.daggerheart.v1.DaggerheartDamageType
+	10, // 32: systems.daggerheart.v1.DaggerheartRestRequest.rest_type:type_name -> systems.daggerheart.v1.DaggerheartRestType
+	30, // 33: systems.daggerheart.v1.DaggerheartRestRequest.rng:type_name -> common.v1.RngRequest
+	11, // 34: systems.daggerheart.v1.DaggerheartDowntimeRequest.move:type_name -> systems.daggerheart.v1.DaggerheartDowntimeMove
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_systems_daggerheart_v1_state_proto_init() }
@@ -1212,8 +2635,8 @@ func file_systems_daggerheart_v1_state_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_systems_daggerheart_v1_state_proto_rawDesc), len(file_systems_daggerheart_v1_state_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   8,
+			NumEnums:      13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
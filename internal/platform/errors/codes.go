@@ -60,6 +60,7 @@ const (
 	// Storage errors
 	CodeNotFound            Code = "NOT_FOUND"
 	CodeActiveSessionExists Code = "ACTIVE_SESSION_EXISTS"
+	CodeEventSeqConflict    Code = "EVENT_SEQ_CONFLICT"
 
 	// Dice/mechanics errors
 	CodeDiceMissing     Code = "DICE_MISSING"
@@ -164,6 +165,10 @@ func (c Code) GRPCCode() codes.Code {
 	case CodeParticipantUserAlreadyClaimed:
 		return codes.AlreadyExists
 
+	// Aborted - concurrent write; re-read and retry
+	case CodeEventSeqConflict:
+		return codes.Aborted
+
 	default:
 		return codes.Internal
 	}
@@ -112,6 +112,7 @@ func TestGRPCCodeMapping(t *testing.T) {
 		{apperrors.CodeCampaignInvalidStatusTransition, codes.FailedPrecondition},
 		{apperrors.CodeNotFound, codes.NotFound},
 		{apperrors.CodeActiveSessionExists, codes.FailedPrecondition},
+		{apperrors.CodeEventSeqConflict, codes.Aborted},
 		{apperrors.CodeUnknown, codes.Internal},
 	}
 
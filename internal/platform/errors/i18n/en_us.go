@@ -36,6 +36,7 @@ const (
 	CodeOutcomeGMFearInvalid            = "OUTCOME_GM_FEAR_INVALID"
 	CodeNotFound                        = "NOT_FOUND"
 	CodeActiveSessionExists             = "ACTIVE_SESSION_EXISTS"
+	CodeEventSeqConflict                = "EVENT_SEQ_CONFLICT"
 	CodeDiceMissing                     = "DICE_MISSING"
 	CodeDiceInvalidSpec                 = "DICE_INVALID_SPEC"
 	CodeSeedOutOfRange                  = "SEED_OUT_OF_RANGE"
@@ -114,6 +115,7 @@ var enUSCatalog = &Catalog{
 		// Storage errors
 		CodeNotFound:            "The requested resource was not found",
 		CodeActiveSessionExists: "An active session already exists for this campaign",
+		CodeEventSeqConflict:    "Campaign events moved past sequence {{.ExpectedLastSeq}}; the last sequence is {{.LastSeq}}",
 
 		// Dice/mechanics errors
 		CodeDiceMissing:     "At least one die must be specified",
@@ -3331,6 +3331,52 @@ func formatEventType(eventType string, loc *message.Printer) string {
 		return loc.Sprintf("event.action_damage_roll_resolved")
 	case "action.adversary_action_resolved":
 		return loc.Sprintf("event.action_adversary_action_resolved")
+	case "action.countdown_triggered":
+		return loc.Sprintf("event.action_countdown_triggered")
+	// Environment events
+	case "environment.activated":
+		return loc.Sprintf("event.environment_activated")
+	case "environment.shifted":
+		return loc.Sprintf("event.environment_shifted")
+	case "environment.cleared":
+		return loc.Sprintf("event.environment_cleared")
+	case "environment.feature_used":
+		return loc.Sprintf("event.environment_feature_used")
+	// Inventory events
+	case "inventory.item_acquired":
+		return loc.Sprintf("event.inventory_item_acquired")
+	case "inventory.item_dropped":
+		return loc.Sprintf("event.inventory_item_dropped")
+	case "inventory.item_transferred":
+		return loc.Sprintf("event.inventory_item_transferred")
+	case "inventory.item_equipped":
+		return loc.Sprintf("event.inventory_item_equipped")
+	case "inventory.item_unequipped":
+		return loc.Sprintf("event.inventory_item_unequipped")
+	case "inventory.gold_changed":
+		return loc.Sprintf("event.inventory_gold_changed")
+	case "companion.created":
+		return loc.Sprintf("event.companion_created")
+	case "companion.leveled_up":
+		return loc.Sprintf("event.companion_leveled_up")
+	case "companion.stress_changed":
+		return loc.Sprintf("event.companion_stress_changed")
+	case "beastform.entered":
+		return loc.Sprintf("event.beastform_entered")
+	case "beastform.exited":
+		return loc.Sprintf("event.beastform_exited")
+	case "effect.applied":
+		return loc.Sprintf("event.effect_applied")
+	case "effect.expired":
+		return loc.Sprintf("event.effect_expired")
+	case "chase.started":
+		return loc.Sprintf("event.chase_started")
+	case "chase.resolved":
+		return loc.Sprintf("event.chase_resolved")
+	case "action.adversary_feature_used":
+		return loc.Sprintf("event.adversary_feature_used")
+	case "action.adversary_feature_refreshed":
+		return loc.Sprintf("event.adversary_feature_refreshed")
 	default:
 		// Fallback: capitalize and format unknown types
 		parts := strings.Split(eventType, ".")
@@ -1611,6 +1611,11 @@ func (c *testEventClient) ListTimelineEntries(ctx context.Context, in *statev1.L
 	return &statev1.ListTimelineEntriesResponse{}, nil
 }
 
+// SubscribeEvents is a stub so the test client satisfies EventServiceClient.
+func (c *testEventClient) SubscribeEvents(ctx context.Context, in *statev1.SubscribeEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[statev1.SubscribeEventsResponse], error) {
+	return nil, status.Error(codes.Unimplemented, "subscribe events not implemented")
+}
+
 type testStatisticsClient struct {
 	response *statev1.GetGameStatisticsResponse
 }
@@ -2314,6 +2319,24 @@ func TestFormatEventType(t *testing.T) {
 		{"action_reaction_resolved", "action.reaction_resolved", loc.Sprintf("event.action_reaction_resolved")},
 		{"action_damage_roll_resolved", "action.damage_roll_resolved", loc.Sprintf("event.action_damage_roll_resolved")},
 		{"action_adversary_action_resolved", "action.adversary_action_resolved", loc.Sprintf("event.action_adversary_action_resolved")},
+		{"action_countdown_triggered", "action.countdown_triggered", loc.Sprintf("event.action_countdown_triggered")},
+		{"environment_activated", "environment.activated", loc.Sprintf("event.environment_activated")},
+		{"environment_shifted", "environment.shifted", loc.Sprintf("event.environment_shifted")},
+		{"environment_cleared", "environment.cleared", loc.Sprintf("event.environment_cleared")},
+		{"environment_feature_used", "environment.feature_used", loc.Sprintf("event.environment_feature_used")},
+		{"inventory_item_acquired", "inventory.item_acquired", loc.Sprintf("event.inventory_item_acquired")},
+		{"inventory_gold_changed", "inventory.gold_changed", loc.Sprintf("event.inventory_gold_changed")},
+		{"companion_created", "companion.created", loc.Sprintf("event.companion_created")},
+		{"companion_leveled_up", "companion.leveled_up", loc.Sprintf("event.companion_leveled_up")},
+		{"companion_stress_changed", "companion.stress_changed", loc.Sprintf("event.companion_stress_changed")},
+		{"beastform_entered", "beastform.entered", loc.Sprintf("event.beastform_entered")},
+		{"beastform_exited", "beastform.exited", loc.Sprintf("event.beastform_exited")},
+		{"effect_applied", "effect.applied", loc.Sprintf("event.effect_applied")},
+		{"effect_expired", "effect.expired", loc.Sprintf("event.effect_expired")},
+		{"chase_started", "chase.started", loc.Sprintf("event.chase_started")},
+		{"chase_resolved", "chase.resolved", loc.Sprintf("event.chase_resolved")},
+		{"adversary_feature_used", "action.adversary_feature_used", loc.Sprintf("event.adversary_feature_used")},
+		{"adversary_feature_refreshed", "action.adversary_feature_refreshed", loc.Sprintf("event.adversary_feature_refreshed")},
 		{"fallback_underscore", "custom.some_event_type", "Some event type"},
 		{"fallback_simple", "custom.hello", "Hello"},
 		{"empty", "", ""},
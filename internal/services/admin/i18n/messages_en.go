@@ -404,6 +404,28 @@ func init() {
 		"event.action_reaction_resolved":            "Reaction Resolved",
 		"event.action_damage_roll_resolved":         "Damage Roll Resolved",
 		"event.action_adversary_action_resolved":    "Adversary Action Resolved",
+		"event.action_countdown_triggered":          "Countdown Triggered",
+		"event.environment_activated":               "Environment Activated",
+		"event.environment_shifted":                 "Environment Shifted",
+		"event.environment_cleared":                 "Environment Cleared",
+		"event.environment_feature_used":            "Environment Feature Used",
+		"event.inventory_item_acquired":             "Item Acquired",
+		"event.inventory_item_dropped":              "Item Dropped",
+		"event.inventory_item_transferred":          "Item Transferred",
+		"event.inventory_item_equipped":             "Item Equipped",
+		"event.inventory_item_unequipped":           "Item Unequipped",
+		"event.inventory_gold_changed":              "Gold Changed",
+		"event.companion_created":                   "Companion Created",
+		"event.companion_leveled_up":                "Companion Leveled Up",
+		"event.companion_stress_changed":            "Companion Stress Changed",
+		"event.beastform_entered":                   "Beastform Entered",
+		"event.beastform_exited":                    "Beastform Exited",
+		"event.effect_applied":                      "Effect Applied",
+		"event.effect_expired":                      "Effect Expired",
+		"event.chase_started":                       "Chase Started",
+		"event.chase_resolved":                      "Chase Resolved",
+		"event.adversary_feature_used":              "Adversary Feature Used",
+		"event.adversary_feature_refreshed":         "Adversary Feature Refreshed",
 	}
 
 	for key, value := range entries {
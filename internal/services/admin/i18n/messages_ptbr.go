@@ -405,6 +405,28 @@ func init() {
 		"event.action_reaction_resolved":            "Reação resolvida",
 		"event.action_damage_roll_resolved":         "Rolagem de dano resolvida",
 		"event.action_adversary_action_resolved":    "Ação do adversário resolvida",
+		"event.action_countdown_triggered":          "Contagem regressiva acionada",
+		"event.environment_activated":               "Ambiente ativado",
+		"event.environment_shifted":                 "Ambiente alterado",
+		"event.environment_cleared":                 "Ambiente encerrado",
+		"event.environment_feature_used":            "Recurso de ambiente usado",
+		"event.inventory_item_acquired":             "Item adquirido",
+		"event.inventory_item_dropped":              "Item descartado",
+		"event.inventory_item_transferred":          "Item transferido",
+		"event.inventory_item_equipped":             "Item equipado",
+		"event.inventory_item_unequipped":           "Item desequipado",
+		"event.inventory_gold_changed":              "Ouro alterado",
+		"event.companion_created":                   "Companheiro criado",
+		"event.companion_leveled_up":                "Companheiro subiu de nível",
+		"event.companion_stress_changed":            "Estresse do companheiro alterado",
+		"event.beastform_entered":                   "Forma bestial assumida",
+		"event.beastform_exited":                    "Forma bestial encerrada",
+		"event.effect_applied":                      "Efeito aplicado",
+		"event.effect_expired":                      "Efeito expirado",
+		"event.chase_started":                       "Perseguição iniciada",
+		"event.chase_resolved":                      "Perseguição resolvida",
+		"event.adversary_feature_used":              "Habilidade de adversário usada",
+		"event.adversary_feature_refreshed":         "Habilidade de adversário renovada",
 	}
 
 	for key, value := range entries {
@@ -3,10 +3,12 @@ package game
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 	"time"
 
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/character"
@@ -448,7 +450,14 @@ func (c characterApplication) PatchCharacterProfile(ctx context.Context, campaig
 			if err := daggerheart.ValidateLevel(int(dhPatch.Level)); err != nil {
 				return "", storage.DaggerheartCharacterProfile{}, err
 			}
-			dhProfile.Level = int(dhPatch.Level)
+			// Levels only change through LevelUp so the level history stays in step.
+			currentLevel := dhProfile.Level
+			if currentLevel == 0 {
+				currentLevel = daggerheart.PCLevelDefault
+			}
+			if int(dhPatch.Level) != currentLevel {
+				return "", storage.DaggerheartCharacterProfile{}, status.Error(codes.FailedPrecondition, "level can only change through LevelUp")
+			}
 		}
 
 		// Validate hp_max (plain int32: 0 is not valid)
@@ -579,6 +588,9 @@ func (c characterApplication) PatchCharacterProfile(ctx context.Context, campaig
 			}
 			dhProfile.Experiences = experiences
 		}
+		if err := c.applyDaggerheartClassPatch(ctx, &dhProfile, dhPatch); err != nil {
+			return "", storage.DaggerheartCharacterProfile{}, err
+		}
 		if dhProfile.Level == 0 {
 			dhProfile.Level = daggerheart.PCLevelDefault
 		}
@@ -648,6 +660,9 @@ func (c characterApplication) PatchCharacterProfile(ctx context.Context, campaig
 				"presence":         dhProfile.Presence,
 				"knowledge":        dhProfile.Knowledge,
 				"experiences":      experiencesPayload,
+				"class_id":         dhProfile.ClassID,
+				"subclass_id":      dhProfile.SubclassID,
+				"subclass_stage":   dhProfile.SubclassStage,
 			},
 		},
 	}
@@ -685,3 +700,47 @@ func (c characterApplication) PatchCharacterProfile(ctx context.Context, campaig
 
 	return characterID, dhProfile, nil
 }
+
+// applyDaggerheartClassPatch validates class and subclass changes against the
+// content catalog. A new subclass starts again from its foundation features.
+func (c characterApplication) applyDaggerheartClassPatch(ctx context.Context, dhProfile *storage.DaggerheartCharacterProfile, dhPatch *daggerheartv1.DaggerheartProfile) error {
+	classID := strings.TrimSpace(dhPatch.GetClassId())
+	subclassID := strings.TrimSpace(dhPatch.GetSubclassId())
+	if classID == "" && subclassID == "" {
+		return nil
+	}
+	if c.stores.DaggerheartContent == nil {
+		return status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	if classID != "" {
+		if classID == dhProfile.MulticlassClassID {
+			return status.Errorf(codes.InvalidArgument, "class %q is already the character's multiclass", classID)
+		}
+		if _, err := c.stores.DaggerheartContent.GetDaggerheartClass(ctx, classID); err != nil {
+			return daggerheartContentLookupError("class", classID, err)
+		}
+		dhProfile.ClassID = classID
+	}
+	if subclassID != "" && subclassID != dhProfile.SubclassID {
+		subclass, err := c.stores.DaggerheartContent.GetDaggerheartSubclass(ctx, subclassID)
+		if err != nil {
+			return daggerheartContentLookupError("subclass", subclassID, err)
+		}
+		if len(subclass.FoundationFeatures) == 0 {
+			return status.Errorf(codes.FailedPrecondition, "subclass %q has no foundation features", subclassID)
+		}
+		dhProfile.SubclassID = subclassID
+		dhProfile.SubclassStage = daggerheart.SubclassStageFoundation
+	}
+	if dhProfile.SubclassID != "" && dhProfile.ClassID == "" {
+		return status.Error(codes.InvalidArgument, "class id is required to choose a subclass")
+	}
+	return nil
+}
+
+func daggerheartContentLookupError(kind, id string, err error) error {
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Errorf(codes.InvalidArgument, "%s %q not found", kind, id)
+	}
+	return status.Errorf(codes.Internal, "get %s: %v", kind, err)
+}
@@ -11,6 +11,7 @@ import (
 	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
 	"github.com/louisbranch/fracturing.space/internal/platform/grpc/pagination"
 	"github.com/louisbranch/fracturing.space/internal/platform/id"
+	daggerheartservice "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 	"google.golang.org/grpc/codes"
@@ -218,10 +219,33 @@ func (s *CharacterService) GetCharacterSheet(ctx context.Context, in *campaignv1
 		return nil, status.Errorf(codes.Internal, "get daggerheart state: %v", err)
 	}
 
+	profile := daggerheartProfileToProto(campaignID, characterID, dhProfile)
+	if s.stores.DaggerheartContent != nil {
+		features, domains, err := daggerheartCharacterFeatures(ctx, s.stores.DaggerheartContent, dhProfile)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "resolve daggerheart features: %v", err)
+		}
+		profile.GetDaggerheart().Features = features
+		profile.GetDaggerheart().DomainIds = domains
+	}
+	companion, err := s.stores.Daggerheart.GetDaggerheartCompanion(ctx, campaignID, characterID)
+	if err == nil {
+		profile.GetDaggerheart().Companion = daggerheartCompanionToProto(companion)
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "get daggerheart companion: %v", err)
+	}
+
+	effects, err := s.stores.Daggerheart.ListDaggerheartEffects(ctx, campaignID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list daggerheart effects: %v", err)
+	}
+	state := daggerheartStateToProto(campaignID, characterID, dhState)
+	state.GetDaggerheart().Effects = daggerheartservice.EffectsToProto(daggerheartCharacterEffects(effects, characterID))
+
 	return &campaignv1.GetCharacterSheetResponse{
 		Character: characterToProto(ch),
-		Profile:   daggerheartProfileToProto(campaignID, characterID, dhProfile),
-		State:     daggerheartStateToProto(campaignID, characterID, dhState),
+		Profile:   profile,
+		State:     state,
 	}, nil
 }
 
@@ -256,22 +280,33 @@ func daggerheartProfileToProto(campaignID, characterID string, dh storage.Dagger
 		CharacterId: characterID,
 		SystemProfile: &campaignv1.CharacterProfile_Daggerheart{
 			Daggerheart: &daggerheartv1.DaggerheartProfile{
-				Level:           int32(dh.Level),
-				HpMax:           int32(dh.HpMax),
-				StressMax:       wrapperspb.Int32(int32(dh.StressMax)),
-				Evasion:         wrapperspb.Int32(int32(dh.Evasion)),
-				MajorThreshold:  wrapperspb.Int32(int32(dh.MajorThreshold)),
-				SevereThreshold: wrapperspb.Int32(int32(dh.SevereThreshold)),
-				Proficiency:     wrapperspb.Int32(int32(dh.Proficiency)),
-				ArmorScore:      wrapperspb.Int32(int32(dh.ArmorScore)),
-				ArmorMax:        wrapperspb.Int32(int32(dh.ArmorMax)),
-				Agility:         wrapperspb.Int32(int32(dh.Agility)),
-				Strength:        wrapperspb.Int32(int32(dh.Strength)),
-				Finesse:         wrapperspb.Int32(int32(dh.Finesse)),
-				Instinct:        wrapperspb.Int32(int32(dh.Instinct)),
-				Presence:        wrapperspb.Int32(int32(dh.Presence)),
-				Knowledge:       wrapperspb.Int32(int32(dh.Knowledge)),
-				Experiences:     daggerheartExperiencesToProto(dh.Experiences),
+				Level:                   int32(dh.Level),
+				HpMax:                   int32(dh.HpMax),
+				StressMax:               wrapperspb.Int32(int32(dh.StressMax)),
+				Evasion:                 wrapperspb.Int32(int32(dh.Evasion)),
+				MajorThreshold:          wrapperspb.Int32(int32(dh.MajorThreshold)),
+				SevereThreshold:         wrapperspb.Int32(int32(dh.SevereThreshold)),
+				Proficiency:             wrapperspb.Int32(int32(dh.Proficiency)),
+				ArmorScore:              wrapperspb.Int32(int32(dh.ArmorScore)),
+				ArmorMax:                wrapperspb.Int32(int32(dh.ArmorMax)),
+				Agility:                 wrapperspb.Int32(int32(dh.Agility)),
+				Strength:                wrapperspb.Int32(int32(dh.Strength)),
+				Finesse:                 wrapperspb.Int32(int32(dh.Finesse)),
+				Instinct:                wrapperspb.Int32(int32(dh.Instinct)),
+				Presence:                wrapperspb.Int32(int32(dh.Presence)),
+				Knowledge:               wrapperspb.Int32(int32(dh.Knowledge)),
+				Experiences:             daggerheartExperiencesToProto(dh.Experiences),
+				MarkedTraits:            dh.MarkedTraits,
+				LoadoutActive:           dh.LoadoutActive,
+				LoadoutVault:            dh.LoadoutVault,
+				LevelHistory:            daggerheartLevelHistoryToProto(dh.LevelHistory),
+				ClassId:                 dh.ClassID,
+				SubclassId:              dh.SubclassID,
+				SubclassStage:           dh.SubclassStage,
+				MulticlassClassId:       dh.MulticlassClassID,
+				MulticlassSubclassId:    dh.MulticlassSubclassID,
+				MulticlassSubclassStage: dh.MulticlassSubclassStage,
+				MulticlassDomainId:      dh.MulticlassDomainID,
 			},
 		},
 	}
@@ -284,13 +319,19 @@ func daggerheartStateToProto(campaignID, characterID string, dh storage.Daggerhe
 		CharacterId: characterID,
 		SystemState: &campaignv1.CharacterState_Daggerheart{
 			Daggerheart: &daggerheartv1.DaggerheartCharacterState{
-				Hp:         int32(dh.Hp),
-				Hope:       int32(dh.Hope),
-				HopeMax:    int32(dh.HopeMax),
-				Stress:     int32(dh.Stress),
-				Armor:      int32(dh.Armor),
-				Conditions: daggerheartConditionsToProto(dh.Conditions),
-				LifeState:  daggerheartLifeStateToProto(dh.LifeState),
+				Hp:                        int32(dh.Hp),
+				Hope:                      int32(dh.Hope),
+				HopeMax:                   int32(dh.HopeMax),
+				Stress:                    int32(dh.Stress),
+				Armor:                     int32(dh.Armor),
+				Conditions:                daggerheartConditionsToProto(dh.Conditions),
+				LifeState:                 daggerheartLifeStateToProto(dh.LifeState),
+				Gold:                      daggerheartGoldToProto(dh),
+				Inventory:                 daggerheartInventoryToProto(dh.Inventory),
+				EquippedArmorId:           dh.EquippedArmorID,
+				EquippedPrimaryWeaponId:   dh.EquippedPrimaryWeaponID,
+				EquippedSecondaryWeaponId: dh.EquippedSecondaryWeaponID,
+				BeastformId:               dh.BeastformID,
 			},
 		},
 	}
@@ -309,3 +350,30 @@ func daggerheartExperiencesToProto(experiences []storage.DaggerheartExperience)
 	}
 	return result
 }
+
+func daggerheartLevelHistoryToProto(history []storage.DaggerheartLevelUp) []*daggerheartv1.DaggerheartLevelUpRecord {
+	if len(history) == 0 {
+		return nil
+	}
+	result := make([]*daggerheartv1.DaggerheartLevelUpRecord, 0, len(history))
+	for _, entry := range history {
+		record := &daggerheartv1.DaggerheartLevelUpRecord{
+			Level:         int32(entry.Level),
+			NewExperience: entry.NewExperience,
+			DomainCardId:  entry.DomainCardID,
+		}
+		for _, advancement := range entry.Advancements {
+			record.Advancements = append(record.Advancements, &daggerheartv1.DaggerheartLevelUpAdvancement{
+				Type:         advancement.Type,
+				Traits:       advancement.Traits,
+				Experiences:  advancement.Experiences,
+				DomainCardId: advancement.DomainCardID,
+				SubclassId:   advancement.SubclassID,
+				ClassId:      advancement.ClassID,
+				DomainId:     advancement.DomainID,
+			})
+		}
+		result = append(result, record)
+	}
+	return result
+}
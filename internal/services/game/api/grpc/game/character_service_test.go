@@ -2,6 +2,7 @@ package game
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -690,6 +691,200 @@ func TestGetCharacterSheet_Success(t *testing.T) {
 	if dh := resp.State.GetDaggerheart(); dh == nil || dh.GetHope() != 3 {
 		t.Errorf("State Hope = %d, want %d", dh.GetHope(), 3)
 	}
+	if companion := resp.Profile.GetDaggerheart().GetCompanion(); companion != nil {
+		t.Errorf("Profile Companion = %v, want none", companion)
+	}
+}
+
+func TestGetCharacterSheet_Companion(t *testing.T) {
+	campaignStore := newFakeCampaignStore()
+	characterStore := newFakeCharacterStore()
+	dhStore := newFakeDaggerheartStore()
+
+	campaignStore.campaigns["c1"] = campaign.Campaign{ID: "c1", Status: campaign.CampaignStatusActive}
+	characterStore.characters["c1"] = map[string]character.Character{
+		"ch1": {ID: "ch1", CampaignID: "c1", Name: "Ranger", Kind: character.CharacterKindPC},
+	}
+	dhStore.profiles["c1"] = map[string]storage.DaggerheartCharacterProfile{
+		"ch1": {CampaignID: "c1", CharacterID: "ch1", Level: 2, HpMax: 6, StressMax: 6},
+	}
+	dhStore.companions = map[string]storage.DaggerheartCompanion{
+		"c1:ch1": {
+			CampaignID: "c1", CharacterID: "ch1", Name: "Ash", Evasion: 10, Stress: 3, StressMax: 3,
+			DamageDieSides: 8, Range: "melee", Level: 2, Upgrades: []string{"vicious"},
+			Experiences: []storage.DaggerheartCompanionExperience{{ExperienceID: "companion-experience.scout", Modifier: 2}},
+		},
+	}
+
+	svc := NewCharacterService(Stores{
+		Campaign:    campaignStore,
+		Character:   characterStore,
+		Daggerheart: dhStore,
+	})
+	resp, err := svc.GetCharacterSheet(context.Background(), &statev1.GetCharacterSheetRequest{CampaignId: "c1", CharacterId: "ch1"})
+	if err != nil {
+		t.Fatalf("GetCharacterSheet returned error: %v", err)
+	}
+	companion := resp.Profile.GetDaggerheart().GetCompanion()
+	if companion.GetName() != "Ash" || companion.GetDamageDieSides() != 8 || !companion.GetOutOfScene() {
+		t.Fatalf("Profile Companion = %v", companion)
+	}
+	if upgrades := companion.GetUpgrades(); len(upgrades) != 1 || upgrades[0] != daggerheartv1.DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_VICIOUS {
+		t.Fatalf("Profile Companion upgrades = %v, want [VICIOUS]", upgrades)
+	}
+}
+
+func newClassContentStore() *fakeDaggerheartContentStore {
+	content := newFakeDaggerheartContentStore()
+	content.classes["class.guardian"] = storage.DaggerheartClass{
+		ID:          "class.guardian",
+		Name:        "Guardian",
+		DomainIDs:   []string{"domain.valor", "domain.blade"},
+		Features:    []storage.DaggerheartFeature{{ID: "feature.unstoppable", Name: "Unstoppable", Level: 1}},
+		HopeFeature: storage.DaggerheartHopeFeature{Name: "Frontline Tank", HopeCost: 3},
+	}
+	content.classes["class.wizard"] = storage.DaggerheartClass{
+		ID:        "class.wizard",
+		Name:      "Wizard",
+		DomainIDs: []string{"domain.codex", "domain.midnight"},
+		Features:  []storage.DaggerheartFeature{{ID: "feature.prestidigitation", Name: "Prestidigitation", Level: 1}},
+	}
+	content.subclasses["subclass.stalwart"] = storage.DaggerheartSubclass{
+		ID:                     "subclass.stalwart",
+		FoundationFeatures:     []storage.DaggerheartFeature{{ID: "feature.unwavering", Name: "Unwavering"}},
+		SpecializationFeatures: []storage.DaggerheartFeature{{ID: "feature.unrelenting", Name: "Unrelenting"}},
+		MasteryFeatures:        []storage.DaggerheartFeature{{ID: "feature.undaunted", Name: "Undaunted"}},
+	}
+	content.subclasses["subclass.school-of-war"] = storage.DaggerheartSubclass{
+		ID:                 "subclass.school-of-war",
+		FoundationFeatures: []storage.DaggerheartFeature{{ID: "feature.battlemage", Name: "Battlemage"}},
+	}
+	return content
+}
+
+func TestGetCharacterSheet_ClassFeatures(t *testing.T) {
+	campaignStore := newFakeCampaignStore()
+	characterStore := newFakeCharacterStore()
+	dhStore := newFakeDaggerheartStore()
+
+	campaignStore.campaigns["c1"] = campaign.Campaign{ID: "c1", Status: campaign.CampaignStatusActive}
+	characterStore.characters["c1"] = map[string]character.Character{
+		"ch1": {ID: "ch1", CampaignID: "c1", Name: "Hero", Kind: character.CharacterKindPC},
+	}
+	dhStore.profiles["c1"] = map[string]storage.DaggerheartCharacterProfile{
+		"ch1": {
+			CampaignID:              "c1",
+			CharacterID:             "ch1",
+			Level:                   5,
+			HpMax:                   7,
+			ClassID:                 "class.guardian",
+			SubclassID:              "subclass.stalwart",
+			SubclassStage:           "specialization",
+			MulticlassClassID:       "class.wizard",
+			MulticlassSubclassID:    "subclass.school-of-war",
+			MulticlassSubclassStage: "foundation",
+			MulticlassDomainID:      "domain.codex",
+		},
+	}
+
+	svc := NewCharacterService(Stores{
+		Campaign:           campaignStore,
+		Character:          characterStore,
+		Daggerheart:        dhStore,
+		DaggerheartContent: newClassContentStore(),
+	})
+	resp, err := svc.GetCharacterSheet(context.Background(), &statev1.GetCharacterSheetRequest{CampaignId: "c1", CharacterId: "ch1"})
+	if err != nil {
+		t.Fatalf("GetCharacterSheet returned error: %v", err)
+	}
+	dh := resp.GetProfile().GetDaggerheart()
+	if dh.GetClassId() != "class.guardian" || dh.GetMulticlassDomainId() != "domain.codex" {
+		t.Fatalf("class fields = %q/%q", dh.GetClassId(), dh.GetMulticlassDomainId())
+	}
+	if got := dh.GetDomainIds(); len(got) != 3 || got[2] != "domain.codex" {
+		t.Fatalf("domain ids = %v", got)
+	}
+	var names []string
+	for _, feature := range dh.GetFeatures() {
+		names = append(names, feature.GetName())
+	}
+	want := []string{"Unstoppable", "Frontline Tank", "Unwavering", "Unrelenting", "Prestidigitation", "Battlemage"}
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Fatalf("features = %v, want %v", names, want)
+	}
+}
+
+func TestPatchCharacterProfile_ClassAndSubclass(t *testing.T) {
+	dhStore := newFakeDaggerheartStore()
+	dhStore.profiles["c1"] = map[string]storage.DaggerheartCharacterProfile{
+		"ch1": {CampaignID: "c1", CharacterID: "ch1", HpMax: 6, StressMax: 6, Evasion: 10},
+	}
+	eventStore := newFakeEventStore()
+	svc := NewCharacterService(Stores{Daggerheart: dhStore, Event: eventStore, DaggerheartContent: newClassContentStore()})
+
+	resp, err := svc.PatchCharacterProfile(context.Background(), &statev1.PatchCharacterProfileRequest{
+		CampaignId:  "c1",
+		CharacterId: "ch1",
+		SystemProfilePatch: &statev1.PatchCharacterProfileRequest_Daggerheart{Daggerheart: &daggerheartv1.DaggerheartProfile{
+			ClassId:    "class.guardian",
+			SubclassId: "subclass.stalwart",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("PatchCharacterProfile returned error: %v", err)
+	}
+	dh := resp.GetProfile().GetDaggerheart()
+	if dh.GetClassId() != "class.guardian" || dh.GetSubclassId() != "subclass.stalwart" || dh.GetSubclassStage() != "foundation" {
+		t.Fatalf("class selection = %q/%q/%q", dh.GetClassId(), dh.GetSubclassId(), dh.GetSubclassStage())
+	}
+	if stored := dhStore.profiles["c1"]["ch1"]; stored.ClassID != "class.guardian" {
+		t.Fatalf("stored class = %q", stored.ClassID)
+	}
+}
+
+func TestPatchCharacterProfile_ClassValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch *daggerheartv1.DaggerheartProfile
+		code  codes.Code
+	}{
+		{name: "unknown class", patch: &daggerheartv1.DaggerheartProfile{ClassId: "class.unknown"}, code: codes.InvalidArgument},
+		{name: "unknown subclass", patch: &daggerheartv1.DaggerheartProfile{ClassId: "class.guardian", SubclassId: "subclass.unknown"}, code: codes.InvalidArgument},
+		{name: "subclass without class", patch: &daggerheartv1.DaggerheartProfile{SubclassId: "subclass.stalwart"}, code: codes.InvalidArgument},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dhStore := newFakeDaggerheartStore()
+			dhStore.profiles["c1"] = map[string]storage.DaggerheartCharacterProfile{
+				"ch1": {CampaignID: "c1", CharacterID: "ch1", HpMax: 6, StressMax: 6, Evasion: 10},
+			}
+			svc := NewCharacterService(Stores{Daggerheart: dhStore, Event: newFakeEventStore(), DaggerheartContent: newClassContentStore()})
+			_, err := svc.PatchCharacterProfile(context.Background(), &statev1.PatchCharacterProfileRequest{
+				CampaignId:         "c1",
+				CharacterId:        "ch1",
+				SystemProfilePatch: &statev1.PatchCharacterProfileRequest_Daggerheart{Daggerheart: tc.patch},
+			})
+			assertStatusCode(t, err, tc.code)
+		})
+	}
+}
+
+func TestPatchCharacterProfile_RejectsLevelChange(t *testing.T) {
+	dhStore := newFakeDaggerheartStore()
+	dhStore.profiles["c1"] = map[string]storage.DaggerheartCharacterProfile{
+		"ch1": {CampaignID: "c1", CharacterID: "ch1", Level: 2, HpMax: 6, StressMax: 6, Evasion: 10},
+	}
+	eventStore := newFakeEventStore()
+	svc := NewCharacterService(Stores{Daggerheart: dhStore, Event: eventStore})
+	_, err := svc.PatchCharacterProfile(context.Background(), &statev1.PatchCharacterProfileRequest{
+		CampaignId:         "c1",
+		CharacterId:        "ch1",
+		SystemProfilePatch: &statev1.PatchCharacterProfileRequest_Daggerheart{Daggerheart: &daggerheartv1.DaggerheartProfile{Level: 5}},
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+	if len(eventStore.events["c1"]) != 0 {
+		t.Fatalf("events = %d, want 0", len(eventStore.events["c1"]))
+	}
 }
 
 func TestPatchCharacterProfile_NilRequest(t *testing.T) {
@@ -0,0 +1,35 @@
+package game
+
+import (
+	"strings"
+
+	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+func daggerheartCompanionToProto(companion storage.DaggerheartCompanion) *daggerheartv1.DaggerheartCompanion {
+	experiences := make([]*daggerheartv1.DaggerheartCompanionExperience, 0, len(companion.Experiences))
+	for _, experience := range companion.Experiences {
+		experiences = append(experiences, &daggerheartv1.DaggerheartCompanionExperience{
+			ExperienceId: experience.ExperienceID,
+			Modifier:     int32(experience.Modifier),
+		})
+	}
+	upgrades := make([]daggerheartv1.DaggerheartCompanionUpgrade, 0, len(companion.Upgrades))
+	for _, upgrade := range companion.Upgrades {
+		upgrades = append(upgrades, daggerheartv1.DaggerheartCompanionUpgrade(daggerheartv1.DaggerheartCompanionUpgrade_value["DAGGERHEART_COMPANION_UPGRADE_"+strings.ToUpper(upgrade)]))
+	}
+	return &daggerheartv1.DaggerheartCompanion{
+		Name:           companion.Name,
+		Evasion:        int32(companion.Evasion),
+		Stress:         int32(companion.Stress),
+		StressMax:      int32(companion.StressMax),
+		DamageDieSides: int32(companion.DamageDieSides),
+		Range:          companion.Range,
+		Experiences:    experiences,
+		Level:          int32(companion.Level),
+		Upgrades:       upgrades,
+		OutOfScene:     daggerheart.CompanionOutOfScene(companion.Stress, companion.StressMax),
+	}
+}
@@ -0,0 +1,35 @@
+package game
+
+import (
+	daggerheartservice "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// daggerheartStores maps the stores the Daggerheart service shares with the
+// game service, so session hooks can run Daggerheart rules.
+func daggerheartStores(stores Stores) daggerheartservice.Stores {
+	return daggerheartservice.Stores{
+		Campaign:           stores.Campaign,
+		Character:          stores.Character,
+		Session:            stores.Session,
+		SessionGate:        stores.SessionGate,
+		SessionSpotlight:   stores.SessionSpotlight,
+		Daggerheart:        stores.Daggerheart,
+		DaggerheartContent: stores.DaggerheartContent,
+		Event:              stores.Event,
+		Checkpoint:         stores.Checkpoint,
+		ProjectionState:    stores.ProjectionState,
+	}
+}
+
+// daggerheartCharacterEffects keeps the effects placed on one character.
+func daggerheartCharacterEffects(effects []storage.DaggerheartEffect, characterID string) []storage.DaggerheartEffect {
+	filtered := make([]storage.DaggerheartEffect, 0, len(effects))
+	for _, effect := range effects {
+		if effect.TargetType == daggerheart.EffectTargetCharacter && effect.TargetID == characterID {
+			filtered = append(filtered, effect)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,134 @@
+package game
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// Feature sources reported on the character sheet.
+const (
+	featureSourceClass = "class"
+	featureSourceHope  = "hope"
+)
+
+// daggerheartCharacterFeatures resolves the class and subclass features a
+// character has unlocked and the domains they may draw cards from. Catalog
+// entries that are missing are skipped so a sheet still renders.
+func daggerheartCharacterFeatures(ctx context.Context, content storage.DaggerheartContentStore, profile storage.DaggerheartCharacterProfile) ([]*daggerheartv1.DaggerheartCharacterFeature, []string, error) {
+	if profile.ClassID == "" {
+		return nil, nil, nil
+	}
+	level := profile.Level
+	if level == 0 {
+		level = daggerheart.PCLevelDefault
+	}
+
+	var features []*daggerheartv1.DaggerheartCharacterFeature
+	var domains []string
+	class, ok, err := lookupDaggerheartClass(ctx, content, profile.ClassID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ok {
+		features = append(features, classFeaturesToProto(class, level)...)
+		if class.HopeFeature.Name != "" {
+			features = append(features, &daggerheartv1.DaggerheartCharacterFeature{
+				Name:        class.HopeFeature.Name,
+				Description: class.HopeFeature.Description,
+				Source:      featureSourceHope,
+				SourceId:    class.ID,
+			})
+		}
+		domains = daggerheart.AccessibleDomains(class.DomainIDs, profile.MulticlassDomainID)
+	}
+	subclassFeatures, err := subclassFeaturesToProto(ctx, content, profile.SubclassID, profile.SubclassStage)
+	if err != nil {
+		return nil, nil, err
+	}
+	features = append(features, subclassFeatures...)
+
+	// A multiclass grants the second class's features, but not its Hope feature.
+	if profile.MulticlassClassID != "" {
+		multiclass, ok, err := lookupDaggerheartClass(ctx, content, profile.MulticlassClassID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			features = append(features, classFeaturesToProto(multiclass, level)...)
+		}
+		subclassFeatures, err := subclassFeaturesToProto(ctx, content, profile.MulticlassSubclassID, profile.MulticlassSubclassStage)
+		if err != nil {
+			return nil, nil, err
+		}
+		features = append(features, subclassFeatures...)
+	}
+	return features, domains, nil
+}
+
+func lookupDaggerheartClass(ctx context.Context, content storage.DaggerheartContentStore, classID string) (storage.DaggerheartClass, bool, error) {
+	class, err := content.GetDaggerheartClass(ctx, classID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.DaggerheartClass{}, false, nil
+		}
+		return storage.DaggerheartClass{}, false, fmt.Errorf("get daggerheart class: %w", err)
+	}
+	return class, true, nil
+}
+
+func classFeaturesToProto(class storage.DaggerheartClass, level int) []*daggerheartv1.DaggerheartCharacterFeature {
+	features := make([]*daggerheartv1.DaggerheartCharacterFeature, 0, len(class.Features))
+	for _, feature := range class.Features {
+		if feature.Level > level {
+			continue
+		}
+		features = append(features, characterFeatureToProto(feature, featureSourceClass, class.ID))
+	}
+	return features
+}
+
+func subclassFeaturesToProto(ctx context.Context, content storage.DaggerheartContentStore, subclassID, stage string) ([]*daggerheartv1.DaggerheartCharacterFeature, error) {
+	if subclassID == "" {
+		return nil, nil
+	}
+	subclass, err := content.GetDaggerheartSubclass(ctx, subclassID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get daggerheart subclass: %w", err)
+	}
+	var features []*daggerheartv1.DaggerheartCharacterFeature
+	for _, group := range []struct {
+		stage    string
+		features []storage.DaggerheartFeature
+	}{
+		{daggerheart.SubclassStageFoundation, subclass.FoundationFeatures},
+		{daggerheart.SubclassStageSpecialization, subclass.SpecializationFeatures},
+		{daggerheart.SubclassStageMastery, subclass.MasteryFeatures},
+	} {
+		if !daggerheart.SubclassStageUnlocks(stage, group.stage) {
+			continue
+		}
+		for _, feature := range group.features {
+			features = append(features, characterFeatureToProto(feature, group.stage, subclass.ID))
+		}
+	}
+	return features, nil
+}
+
+func characterFeatureToProto(feature storage.DaggerheartFeature, source, sourceID string) *daggerheartv1.DaggerheartCharacterFeature {
+	return &daggerheartv1.DaggerheartCharacterFeature{
+		Id:          feature.ID,
+		Name:        feature.Name,
+		Description: feature.Description,
+		Level:       int32(feature.Level),
+		Source:      source,
+		SourceId:    sourceID,
+	}
+}
@@ -0,0 +1,45 @@
+package game
+
+import (
+	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+func daggerheartGoldToProto(dh storage.DaggerheartCharacterState) *daggerheartv1.DaggerheartGold {
+	return &daggerheartv1.DaggerheartGold{
+		Handfuls: int32(dh.GoldHandfuls),
+		Bags:     int32(dh.GoldBags),
+		Chests:   int32(dh.GoldChests),
+	}
+}
+
+func daggerheartInventoryToProto(items []storage.DaggerheartInventoryItem) []*daggerheartv1.DaggerheartInventoryItem {
+	if len(items) == 0 {
+		return nil
+	}
+	result := make([]*daggerheartv1.DaggerheartInventoryItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, &daggerheartv1.DaggerheartInventoryItem{
+			ItemId:   item.ItemID,
+			Kind:     daggerheartInventoryKindToProto(item.Kind),
+			Quantity: int32(item.Quantity),
+		})
+	}
+	return result
+}
+
+func daggerheartInventoryKindToProto(kind string) daggerheartv1.DaggerheartInventoryItemKind {
+	switch kind {
+	case daggerheart.InventoryKindWeapon:
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_WEAPON
+	case daggerheart.InventoryKindArmor:
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ARMOR
+	case daggerheart.InventoryKindItem:
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ITEM
+	case daggerheart.InventoryKindLoot:
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_LOOT
+	default:
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_UNSPECIFIED
+	}
+}
@@ -2,12 +2,14 @@ package game
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"time"
 
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -38,8 +40,14 @@ func (a eventApplication) AppendEvent(ctx context.Context, in *campaignv1.Append
 		return event.Event{}, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if in.ExpectedLastSeq != nil {
+		ctx = storage.WithExpectedLastSeq(ctx, in.GetExpectedLastSeq())
+	}
 	stored, err := a.stores.Event.AppendEvent(ctx, input)
 	if err != nil {
+		if errors.Is(err, storage.ErrEventSeqConflict) {
+			return event.Event{}, handleDomainError(err)
+		}
 		return event.Event{}, status.Errorf(codes.Internal, "append event: %v", err)
 	}
 
@@ -17,12 +17,14 @@ import (
 const (
 	defaultListEventsPageSize = 50
 	maxListEventsPageSize     = 200
+	subscribeEventsPageSize   = 200
 )
 
 // EventService implements the game.v1.EventService gRPC API.
 type EventService struct {
 	campaignv1.UnimplementedEventServiceServer
 	stores Stores
+	broker *event.Broker
 }
 
 // NewEventService creates an EventService with the provided stores.
@@ -32,6 +34,13 @@ func NewEventService(stores Stores) *EventService {
 	}
 }
 
+// NewEventServiceWithBroker creates an EventService that can serve live subscriptions.
+func NewEventServiceWithBroker(stores Stores, broker *event.Broker) *EventService {
+	service := NewEventService(stores)
+	service.broker = broker
+	return service
+}
+
 // AppendEvent appends a new event to the campaign journal.
 func (s *EventService) AppendEvent(ctx context.Context, in *campaignv1.AppendEventRequest) (*campaignv1.AppendEventResponse, error) {
 	if in == nil {
@@ -171,6 +180,74 @@ func (s *EventService) ListEvents(ctx context.Context, in *campaignv1.ListEvents
 	return response, nil
 }
 
+// SubscribeEvents replays committed events after a cursor and then tails new appends.
+func (s *EventService) SubscribeEvents(in *campaignv1.SubscribeEventsRequest, stream campaignv1.EventService_SubscribeEventsServer) error {
+	if in == nil {
+		return status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return status.Error(codes.InvalidArgument, "campaign_id is required")
+	}
+
+	cond, err := filter.ParseEventFilter(strings.TrimSpace(in.GetFilter()))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	if s.broker == nil {
+		return status.Error(codes.Internal, "event broker is not configured")
+	}
+
+	// Subscribe before replaying so appends committed during replay still signal.
+	sub := s.broker.Subscribe(campaignID)
+	defer sub.Close()
+
+	ctx := stream.Context()
+	cursor := in.GetAfterSeq()
+	for {
+		cursor, err = s.sendEventsAfter(ctx, stream, campaignID, cursor, cond)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.C():
+		}
+	}
+}
+
+// sendEventsAfter streams every committed event after the cursor matching the
+// filter and returns the seq of the last event sent.
+func (s *EventService) sendEventsAfter(ctx context.Context, stream campaignv1.EventService_SubscribeEventsServer, campaignID string, cursor uint64, cond filter.SQLCondition) (uint64, error) {
+	for {
+		result, err := s.stores.Event.ListEventsPage(ctx, storage.ListEventsPageRequest{
+			CampaignID:   campaignID,
+			PageSize:     subscribeEventsPageSize,
+			CursorSeq:    cursor,
+			CursorDir:    string(pagination.DirectionForward),
+			FilterClause: cond.Clause,
+			FilterParams: cond.Params,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return cursor, nil
+			}
+			return cursor, status.Errorf(codes.Internal, "list events: %v", err)
+		}
+		for _, evt := range result.Events {
+			if err := stream.Send(&campaignv1.SubscribeEventsResponse{Event: eventToProto(evt)}); err != nil {
+				return cursor, err
+			}
+			cursor = evt.Seq
+		}
+		if !result.HasNextPage || len(result.Events) == 0 {
+			return cursor, nil
+		}
+	}
+}
+
 // eventToProto converts a domain event to a proto Event message.
 func eventToProto(evt event.Event) *campaignv1.Event {
 	return &campaignv1.Event{
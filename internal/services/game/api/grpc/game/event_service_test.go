@@ -2,13 +2,18 @@ package game
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	"github.com/louisbranch/fracturing.space/internal/platform/grpc/pagination"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestListEvents_NilRequest(t *testing.T) {
@@ -298,3 +303,186 @@ func TestListEvents_TokenWithInvalidDirection(t *testing.T) {
 	})
 	assertStatusCode(t, err, codes.InvalidArgument)
 }
+
+// fakeSubscribeEventsStream captures events sent by SubscribeEvents.
+type fakeSubscribeEventsStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *campaignv1.Event
+}
+
+func newFakeSubscribeEventsStream(ctx context.Context) *fakeSubscribeEventsStream {
+	return &fakeSubscribeEventsStream{ctx: ctx, sent: make(chan *campaignv1.Event, 16)}
+}
+
+func (s *fakeSubscribeEventsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSubscribeEventsStream) Send(resp *campaignv1.SubscribeEventsResponse) error {
+	s.sent <- resp.GetEvent()
+	return nil
+}
+
+func (s *fakeSubscribeEventsStream) next(t *testing.T) *campaignv1.Event {
+	t.Helper()
+	select {
+	case evt := <-s.sent:
+		return evt
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for streamed event")
+		return nil
+	}
+}
+
+func TestSubscribeEvents_NilRequest(t *testing.T) {
+	svc := NewEventServiceWithBroker(Stores{}, event.NewBroker())
+	err := svc.SubscribeEvents(nil, newFakeSubscribeEventsStream(context.Background()))
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestSubscribeEvents_MissingCampaignId(t *testing.T) {
+	svc := NewEventServiceWithBroker(Stores{Event: newFakeEventStore()}, event.NewBroker())
+	err := svc.SubscribeEvents(&campaignv1.SubscribeEventsRequest{}, newFakeSubscribeEventsStream(context.Background()))
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestSubscribeEvents_InvalidFilter(t *testing.T) {
+	svc := NewEventServiceWithBroker(Stores{Event: newFakeEventStore()}, event.NewBroker())
+	err := svc.SubscribeEvents(&campaignv1.SubscribeEventsRequest{
+		CampaignId: "c1",
+		Filter:     "invalid filter syntax ===",
+	}, newFakeSubscribeEventsStream(context.Background()))
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestSubscribeEvents_BrokerNotConfigured(t *testing.T) {
+	svc := NewEventService(Stores{Event: newFakeEventStore()})
+	err := svc.SubscribeEvents(&campaignv1.SubscribeEventsRequest{CampaignId: "c1"}, newFakeSubscribeEventsStream(context.Background()))
+	assertStatusCode(t, err, codes.Internal)
+}
+
+func TestSubscribeEvents_ReplaysAfterCursorThenTails(t *testing.T) {
+	eventStore := newFakeEventStore()
+	broker := event.NewBroker()
+	ctx := context.Background()
+	for _, typ := range []event.Type{"e1", "e2", "e3"} {
+		if _, err := eventStore.AppendEvent(ctx, event.Event{CampaignID: "c1", Type: typ}); err != nil {
+			t.Fatalf("seed event: %v", err)
+		}
+	}
+
+	svc := NewEventServiceWithBroker(Stores{Event: eventStore}, broker)
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream := newFakeSubscribeEventsStream(streamCtx)
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.SubscribeEvents(&campaignv1.SubscribeEventsRequest{CampaignId: "c1", AfterSeq: 1}, stream)
+	}()
+
+	if got := stream.next(t).GetSeq(); got != 2 {
+		t.Fatalf("first replayed seq = %d, want 2", got)
+	}
+	if got := stream.next(t).GetSeq(); got != 3 {
+		t.Fatalf("second replayed seq = %d, want 3", got)
+	}
+
+	appended, err := eventStore.AppendEvent(ctx, event.Event{CampaignID: "c1", Type: "e4"})
+	if err != nil {
+		t.Fatalf("append event: %v", err)
+	}
+	broker.Publish(appended)
+
+	tailed := stream.next(t)
+	if tailed.GetSeq() != 4 || tailed.GetType() != "e4" {
+		t.Fatalf("tailed event = seq %d type %s, want seq 4 type e4", tailed.GetSeq(), tailed.GetType())
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("subscribe returned error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("subscription did not stop after cancel")
+	}
+	select {
+	case evt := <-stream.sent:
+		t.Fatalf("unexpected extra event seq %d", evt.GetSeq())
+	default:
+	}
+}
+
+func TestSubscribeEvents_ListError(t *testing.T) {
+	eventStore := newFakeEventStore()
+	eventStore.listErr = errors.New("boom")
+	svc := NewEventServiceWithBroker(Stores{Event: eventStore}, event.NewBroker())
+	err := svc.SubscribeEvents(&campaignv1.SubscribeEventsRequest{CampaignId: "c1"}, newFakeSubscribeEventsStream(context.Background()))
+	assertStatusCode(t, err, codes.Internal)
+}
+
+func TestAppendEvent_ExpectedLastSeq(t *testing.T) {
+	eventStore := newFakeEventStore()
+	svc := NewEventService(Stores{Event: eventStore})
+	ctx := context.Background()
+
+	resp, err := svc.AppendEvent(ctx, &campaignv1.AppendEventRequest{
+		CampaignId:      "c1",
+		Type:            "story.note_added",
+		ExpectedLastSeq: proto.Uint64(0),
+	})
+	if err != nil {
+		t.Fatalf("AppendEvent returned error: %v", err)
+	}
+	if resp.GetEvent().GetSeq() != 1 {
+		t.Fatalf("seq = %d, want 1", resp.GetEvent().GetSeq())
+	}
+
+	_, err = svc.AppendEvent(ctx, &campaignv1.AppendEventRequest{
+		CampaignId:      "c1",
+		Type:            "story.note_added",
+		ExpectedLastSeq: proto.Uint64(0),
+	})
+	assertStatusCode(t, err, codes.Aborted)
+	var info *errdetails.ErrorInfo
+	for _, detail := range status.Convert(err).Details() {
+		if value, ok := detail.(*errdetails.ErrorInfo); ok {
+			info = value
+		}
+	}
+	if info == nil || info.GetMetadata()["LastSeq"] != "1" || info.GetMetadata()["ExpectedLastSeq"] != "0" {
+		t.Fatalf("conflict error info = %+v", info)
+	}
+	if len(eventStore.events["c1"]) != 1 {
+		t.Fatalf("events = %d, want 1", len(eventStore.events["c1"]))
+	}
+
+	// Without an expectation the append is unconditional.
+	if _, err := svc.AppendEvent(ctx, &campaignv1.AppendEventRequest{CampaignId: "c1", Type: "story.note_added"}); err != nil {
+		t.Fatalf("AppendEvent without expectation returned error: %v", err)
+	}
+}
+
+func TestAppendEvent_RejectsPayloadFailingSchema(t *testing.T) {
+	eventStore := newFakeEventStore()
+	svc := NewEventService(Stores{Event: eventStore})
+
+	_, err := svc.AppendEvent(context.Background(), &campaignv1.AppendEventRequest{
+		CampaignId:  "c1",
+		Type:        string(event.TypeNoteAdded),
+		PayloadJson: []byte(`{"content":42}`),
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+	if len(eventStore.events["c1"]) != 0 {
+		t.Fatalf("events = %d, want 0", len(eventStore.events["c1"]))
+	}
+
+	if _, err := svc.AppendEvent(context.Background(), &campaignv1.AppendEventRequest{
+		CampaignId:  "c1",
+		Type:        string(event.TypeNoteAdded),
+		PayloadJson: []byte(`{"content":"Scouted the ridge"}`),
+	}); err != nil {
+		t.Fatalf("AppendEvent returned error: %v", err)
+	}
+}
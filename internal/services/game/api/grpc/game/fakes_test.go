@@ -7,6 +7,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -353,6 +354,10 @@ type fakeDaggerheartStore struct {
 	snapshots   map[string]storage.DaggerheartSnapshot                    // campaignID -> snapshot
 	countdowns  map[string]map[string]storage.DaggerheartCountdown        // campaignID -> countdownID -> countdown
 	adversaries map[string]map[string]storage.DaggerheartAdversary        // campaignID -> adversaryID -> adversary
+	companions  map[string]storage.DaggerheartCompanion                   // campaignID:characterID -> companion
+	effects     map[string]storage.DaggerheartEffect                      // campaignID:effectID -> effect
+	chases      map[string]storage.DaggerheartChase                       // campaignID:chaseID -> chase
+	features    map[string]storage.DaggerheartAdversaryFeatureState       // campaignID:adversaryID:featureID -> state
 	statePuts   map[string]int
 	snapPuts    map[string]int
 	putErr      error
@@ -559,6 +564,140 @@ func (s *fakeDaggerheartStore) DeleteDaggerheartAdversary(_ context.Context, cam
 	return nil
 }
 
+func (s *fakeDaggerheartStore) PutDaggerheartSceneRange(_ context.Context, _ storage.DaggerheartSceneRange) error {
+	return s.putErr
+}
+
+func (s *fakeDaggerheartStore) GetDaggerheartSceneRange(_ context.Context, _, _, _, _ string) (storage.DaggerheartSceneRange, error) {
+	return storage.DaggerheartSceneRange{}, storage.ErrNotFound
+}
+
+func (s *fakeDaggerheartStore) ListDaggerheartSceneRanges(_ context.Context, _, _ string) ([]storage.DaggerheartSceneRange, error) {
+	return nil, nil
+}
+
+func (s *fakeDaggerheartStore) PutDaggerheartSessionEnvironment(_ context.Context, _ storage.DaggerheartSessionEnvironment) error {
+	return s.putErr
+}
+
+func (s *fakeDaggerheartStore) GetDaggerheartSessionEnvironment(_ context.Context, _, _ string) (storage.DaggerheartSessionEnvironment, error) {
+	return storage.DaggerheartSessionEnvironment{}, storage.ErrNotFound
+}
+
+func (s *fakeDaggerheartStore) DeleteDaggerheartSessionEnvironment(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (s *fakeDaggerheartStore) PutDaggerheartCompanion(_ context.Context, companion storage.DaggerheartCompanion) error {
+	if s.putErr != nil {
+		return s.putErr
+	}
+	if s.companions == nil {
+		s.companions = make(map[string]storage.DaggerheartCompanion)
+	}
+	s.companions[companion.CampaignID+":"+companion.CharacterID] = companion
+	return nil
+}
+
+func (s *fakeDaggerheartStore) GetDaggerheartCompanion(_ context.Context, campaignID, characterID string) (storage.DaggerheartCompanion, error) {
+	if s.getErr != nil {
+		return storage.DaggerheartCompanion{}, s.getErr
+	}
+	companion, ok := s.companions[campaignID+":"+characterID]
+	if !ok {
+		return storage.DaggerheartCompanion{}, storage.ErrNotFound
+	}
+	return companion, nil
+}
+
+func (s *fakeDaggerheartStore) PutDaggerheartEffect(_ context.Context, effect storage.DaggerheartEffect) error {
+	if s.effects == nil {
+		s.effects = make(map[string]storage.DaggerheartEffect)
+	}
+	s.effects[effect.CampaignID+":"+effect.EffectID] = effect
+	return nil
+}
+
+func (s *fakeDaggerheartStore) GetDaggerheartEffect(_ context.Context, campaignID, effectID string) (storage.DaggerheartEffect, error) {
+	effect, ok := s.effects[campaignID+":"+effectID]
+	if !ok {
+		return storage.DaggerheartEffect{}, storage.ErrNotFound
+	}
+	return effect, nil
+}
+
+func (s *fakeDaggerheartStore) ListDaggerheartEffects(_ context.Context, campaignID string) ([]storage.DaggerheartEffect, error) {
+	var effects []storage.DaggerheartEffect
+	for _, effect := range s.effects {
+		if effect.CampaignID == campaignID {
+			effects = append(effects, effect)
+		}
+	}
+	sort.Slice(effects, func(i, j int) bool {
+		if effects[i].TargetID != effects[j].TargetID {
+			return effects[i].TargetID < effects[j].TargetID
+		}
+		return effects[i].EffectID < effects[j].EffectID
+	})
+	return effects, nil
+}
+
+func (s *fakeDaggerheartStore) DeleteDaggerheartEffect(_ context.Context, campaignID, effectID string) error {
+	delete(s.effects, campaignID+":"+effectID)
+	return nil
+}
+
+func (s *fakeDaggerheartStore) PutDaggerheartChase(_ context.Context, chase storage.DaggerheartChase) error {
+	if s.chases == nil {
+		s.chases = make(map[string]storage.DaggerheartChase)
+	}
+	s.chases[chase.CampaignID+":"+chase.ChaseID] = chase
+	return nil
+}
+
+func (s *fakeDaggerheartStore) GetDaggerheartChase(_ context.Context, campaignID, chaseID string) (storage.DaggerheartChase, error) {
+	chase, ok := s.chases[campaignID+":"+chaseID]
+	if !ok {
+		return storage.DaggerheartChase{}, storage.ErrNotFound
+	}
+	return chase, nil
+}
+
+func (s *fakeDaggerheartStore) ListDaggerheartChases(_ context.Context, campaignID string) ([]storage.DaggerheartChase, error) {
+	var chases []storage.DaggerheartChase
+	for _, chase := range s.chases {
+		if chase.CampaignID == campaignID {
+			chases = append(chases, chase)
+		}
+	}
+	sort.Slice(chases, func(i, j int) bool { return chases[i].ChaseID < chases[j].ChaseID })
+	return chases, nil
+}
+
+func (s *fakeDaggerheartStore) PutDaggerheartAdversaryFeatureState(_ context.Context, state storage.DaggerheartAdversaryFeatureState) error {
+	if s.features == nil {
+		s.features = make(map[string]storage.DaggerheartAdversaryFeatureState)
+	}
+	s.features[state.CampaignID+":"+state.AdversaryID+":"+state.FeatureID] = state
+	return nil
+}
+
+func (s *fakeDaggerheartStore) ListDaggerheartAdversaryFeatureStates(_ context.Context, campaignID string) ([]storage.DaggerheartAdversaryFeatureState, error) {
+	var states []storage.DaggerheartAdversaryFeatureState
+	for _, state := range s.features {
+		if state.CampaignID == campaignID {
+			states = append(states, state)
+		}
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].AdversaryID != states[j].AdversaryID {
+			return states[i].AdversaryID < states[j].AdversaryID
+		}
+		return states[i].FeatureID < states[j].FeatureID
+	})
+	return states, nil
+}
+
 // fakeSessionGateStore is a test double for storage.SessionGateStore.
 type fakeSessionGateStore struct {
 	gates  map[string]storage.SessionGate
@@ -786,7 +925,7 @@ func newFakeEventStore() *fakeEventStore {
 	}
 }
 
-func (s *fakeEventStore) AppendEvent(_ context.Context, evt event.Event) (event.Event, error) {
+func (s *fakeEventStore) AppendEvent(ctx context.Context, evt event.Event) (event.Event, error) {
 	if s.appendErr != nil {
 		return event.Event{}, s.appendErr
 	}
@@ -794,14 +933,30 @@ func (s *fakeEventStore) AppendEvent(_ context.Context, evt event.Event) (event.
 	if seq == 0 {
 		seq = 1
 	}
+	if err := storage.CheckExpectedLastSeq(ctx, evt.CampaignID, seq-1); err != nil {
+		return event.Event{}, err
+	}
 	evt.Seq = seq
 	evt.Hash = "fakehash-" + evt.CampaignID + "-" + string(rune('0'+seq))
 	s.nextSeq[evt.CampaignID] = seq + 1
 	s.events[evt.CampaignID] = append(s.events[evt.CampaignID], evt)
 	s.byHash[evt.Hash] = evt
+	storage.RecordAppendedEvents(ctx, evt)
 	return evt, nil
 }
 
+func (s *fakeEventStore) AppendEvents(ctx context.Context, evts []event.Event) ([]event.Event, error) {
+	stored := make([]event.Event, 0, len(evts))
+	for _, evt := range evts {
+		appended, err := s.AppendEvent(ctx, evt)
+		if err != nil {
+			return nil, err
+		}
+		stored = append(stored, appended)
+	}
+	return stored, nil
+}
+
 func (s *fakeEventStore) GetEventByHash(_ context.Context, hash string) (event.Event, error) {
 	if s.getErr != nil {
 		return event.Event{}, s.getErr
@@ -1059,6 +1214,37 @@ func (s *fakeCampaignForkStore) SetCampaignForkMetadata(_ context.Context, campa
 	return nil
 }
 
+// fakeDaggerheartContentStore serves classes and subclasses; other content
+// lookups fall through to the embedded nil interface.
+type fakeDaggerheartContentStore struct {
+	storage.DaggerheartContentStore
+	classes    map[string]storage.DaggerheartClass
+	subclasses map[string]storage.DaggerheartSubclass
+}
+
+func newFakeDaggerheartContentStore() *fakeDaggerheartContentStore {
+	return &fakeDaggerheartContentStore{
+		classes:    make(map[string]storage.DaggerheartClass),
+		subclasses: make(map[string]storage.DaggerheartSubclass),
+	}
+}
+
+func (s *fakeDaggerheartContentStore) GetDaggerheartClass(_ context.Context, id string) (storage.DaggerheartClass, error) {
+	class, ok := s.classes[id]
+	if !ok {
+		return storage.DaggerheartClass{}, storage.ErrNotFound
+	}
+	return class, nil
+}
+
+func (s *fakeDaggerheartContentStore) GetDaggerheartSubclass(_ context.Context, id string) (storage.DaggerheartSubclass, error) {
+	subclass, ok := s.subclasses[id]
+	if !ok {
+		return storage.DaggerheartSubclass{}, storage.ErrNotFound
+	}
+	return subclass, nil
+}
+
 // Test helper functions
 
 func fixedClock(t time.Time) func() time.Time {
@@ -146,10 +146,34 @@ func (a forkApplication) ForkCampaign(ctx context.Context, sourceCampaignID stri
 		return campaign.Campaign{}, nil, 0, status.Errorf(codes.Internal, "apply campaign.forked: %v", err)
 	}
 
-	if _, err := a.copyForkEvents(ctx, sourceCampaignID, f.NewCampaignID, forkEventSeq, in.GetCopyParticipants(), applier); err != nil {
+	// Start the fork's projections from the nearest source checkpoint so only
+	// the tail of the copied history is applied. Checkpoints hold the source's
+	// participants, so forks without them apply the whole history.
+	checkpoints := a.stores.Checkpoints()
+	var restoredSeq uint64
+	if in.GetCopyParticipants() && checkpoints.Configured() {
+		restoredSeq, err = checkpoints.RestoreFork(ctx, a.stores.Event, sourceCampaignID, f.NewCampaignID, forkEventSeq)
+		if err != nil {
+			return campaign.Campaign{}, nil, 0, status.Errorf(codes.Internal, "restore source checkpoint: %v", err)
+		}
+	}
+
+	if _, err := a.copyForkEvents(ctx, sourceCampaignID, f.NewCampaignID, forkEventSeq, restoredSeq, in.GetCopyParticipants(), applier); err != nil {
 		return campaign.Campaign{}, nil, 0, status.Errorf(codes.Internal, "copy events: %v", err)
 	}
 
+	// Checkpoint the fork head so rebuilding the fork starts after the copied
+	// history. Nothing else writes to the new campaign yet.
+	if checkpoints.Configured() {
+		headSeq, err := a.stores.Event.GetLatestEventSeq(ctx, f.NewCampaignID)
+		if err != nil {
+			return campaign.Campaign{}, nil, 0, status.Errorf(codes.Internal, "get fork head: %v", err)
+		}
+		if err := checkpoints.Save(ctx, f.NewCampaignID, headSeq); err != nil {
+			return campaign.Campaign{}, nil, 0, status.Errorf(codes.Internal, "checkpoint fork: %v", err)
+		}
+	}
+
 	// Calculate depth by walking the parent chain
 	depth := calculateDepth(ctx, a.stores.CampaignFork, sourceCampaignID) + 1
 
@@ -169,7 +193,10 @@ func (a forkApplication) ForkCampaign(ctx context.Context, sourceCampaignID stri
 	return newCampaign, lineage, forkEventSeq, nil
 }
 
-func (a forkApplication) copyForkEvents(ctx context.Context, sourceCampaignID, forkCampaignID string, forkEventSeq uint64, copyParticipants bool, applier projection.Applier) (time.Time, error) {
+// copyForkEvents copies the source journal through forkEventSeq into the fork
+// and applies the copies of source events after appliedSeq, whose effects the
+// fork's projections already hold.
+func (a forkApplication) copyForkEvents(ctx context.Context, sourceCampaignID, forkCampaignID string, forkEventSeq, appliedSeq uint64, copyParticipants bool, applier projection.Applier) (time.Time, error) {
 	if forkEventSeq == 0 {
 		return time.Time{}, nil
 	}
@@ -189,6 +216,11 @@ func (a forkApplication) copyForkEvents(ctx context.Context, sourceCampaignID, f
 			if evt.Seq > forkEventSeq {
 				return lastEventAt, nil
 			}
+			// Forks start from the current payload shapes.
+			evt, err := event.Upcast(evt)
+			if err != nil {
+				return lastEventAt, fmt.Errorf("upcast forked event: %w", err)
+			}
 			lastEventAt = evt.Timestamp
 			shouldCopy, err := shouldCopyForkEvent(evt, copyParticipants)
 			if err != nil {
@@ -204,8 +236,10 @@ func (a forkApplication) copyForkEvents(ctx context.Context, sourceCampaignID, f
 			if err != nil {
 				return lastEventAt, fmt.Errorf("append forked event: %w", err)
 			}
-			if err := applier.Apply(ctx, stored); err != nil {
-				return lastEventAt, fmt.Errorf("apply forked event: %w", err)
+			if evt.Seq > appliedSeq {
+				if err := applier.Apply(ctx, stored); err != nil {
+					return lastEventAt, fmt.Errorf("apply forked event: %w", err)
+				}
 			}
 			afterSeq = evt.Seq
 		}
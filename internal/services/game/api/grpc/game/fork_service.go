@@ -2,7 +2,6 @@ package game
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -78,7 +77,7 @@ func shouldCopyForkEvent(evt event.Event, copyParticipants bool) (bool, error) {
 			return true, nil
 		}
 		var payload event.CharacterUpdatedPayload
-		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		if err := event.DecodePayload(evt, &payload); err != nil {
 			return false, fmt.Errorf("decode character.updated payload: %w", err)
 		}
 		participantValue, hasParticipant := payload.Fields["participant_id"]
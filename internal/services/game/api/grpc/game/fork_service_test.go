@@ -2,6 +2,8 @@ package game
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"testing"
 	"time"
@@ -748,3 +750,182 @@ func mustJSON(t *testing.T, payload any) []byte {
 func intPtr(value int) *int {
 	return &value
 }
+
+// recordingCheckpointStore records saved checkpoints.
+type recordingCheckpointStore struct {
+	storage.ProjectionCheckpointStore
+	saved []storage.ProjectionCheckpoint
+}
+
+func (s *recordingCheckpointStore) SaveProjectionCheckpoint(_ context.Context, checkpoint storage.ProjectionCheckpoint) (storage.ProjectionCheckpoint, error) {
+	s.saved = append(s.saved, checkpoint)
+	return checkpoint, nil
+}
+
+type exportingProjectionState struct {
+	storage.ProjectionStateStore
+}
+
+func (exportingProjectionState) ExportCampaignProjections(_ context.Context, campaignID string) ([]byte, error) {
+	return []byte("projections of " + campaignID), nil
+}
+
+func TestForkCampaign_CheckpointsForkHead(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	campaignStore := newFakeCampaignStore()
+	eventStore := newFakeEventStore()
+	campaignStore.campaigns["source"] = campaign.Campaign{
+		ID:     "source",
+		Name:   "Source Campaign",
+		Status: campaign.CampaignStatusActive,
+		System: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART,
+		GmMode: campaign.GmModeHuman,
+	}
+	appendEvent(t, eventStore, event.Event{
+		CampaignID: "source",
+		Timestamp:  now.Add(-time.Hour),
+		Type:       event.TypeCampaignCreated,
+		EntityType: "campaign",
+		EntityID:   "source",
+		PayloadJSON: mustJSON(t, event.CampaignCreatedPayload{
+			Name:       "Source Campaign",
+			GameSystem: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART.String(),
+			GmMode:     statev1.GmMode_HUMAN.String(),
+		}),
+	})
+
+	checkpoints := &recordingCheckpointStore{}
+	svc := &ForkService{
+		stores: Stores{
+			Campaign:        campaignStore,
+			Participant:     newFakeParticipantStore(),
+			Event:           eventStore,
+			CampaignFork:    newFakeCampaignForkStore(),
+			Checkpoint:      checkpoints,
+			ProjectionState: exportingProjectionState{},
+		},
+		clock:       fixedClock(now),
+		idGenerator: fixedIDGenerator("fork-1"),
+	}
+
+	if _, err := svc.ForkCampaign(ctx, &statev1.ForkCampaignRequest{SourceCampaignId: "source"}); err != nil {
+		t.Fatalf("ForkCampaign returned error: %v", err)
+	}
+	if len(checkpoints.saved) != 1 {
+		t.Fatalf("checkpoints saved = %d, want 1", len(checkpoints.saved))
+	}
+	saved := checkpoints.saved[0]
+	if saved.CampaignID != "fork-1" || saved.Seq != 2 || string(saved.State) != "projections of fork-1" {
+		t.Fatalf("checkpoint = %+v, want fork-1 at seq 2", saved)
+	}
+}
+
+// sourceCheckpointStore serves one checkpoint of the source campaign.
+type sourceCheckpointStore struct {
+	recordingCheckpointStore
+	checkpoint storage.ProjectionCheckpoint
+}
+
+func (s *sourceCheckpointStore) GetProjectionCheckpoint(_ context.Context, campaignID string, atOrBeforeSeq uint64) (storage.ProjectionCheckpoint, error) {
+	if campaignID != s.checkpoint.CampaignID || (atOrBeforeSeq != 0 && atOrBeforeSeq < s.checkpoint.Seq) {
+		return storage.ProjectionCheckpoint{}, storage.ErrNotFound
+	}
+	return s.checkpoint, nil
+}
+
+// forkImportingProjectionState records the source states imported into forks.
+type forkImportingProjectionState struct {
+	exportingProjectionState
+	imported []string
+}
+
+func (s *forkImportingProjectionState) ImportForkedCampaignProjections(_ context.Context, sourceCampaignID, forkCampaignID string, state []byte) error {
+	s.imported = append(s.imported, sourceCampaignID+"->"+forkCampaignID+": "+string(state))
+	return nil
+}
+
+func TestForkCampaign_RestoresSourceCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	campaignStore := newFakeCampaignStore()
+	participantStore := newFakeParticipantStore()
+	eventStore := newFakeEventStore()
+	campaignStore.campaigns["source"] = campaign.Campaign{
+		ID:     "source",
+		Name:   "Source Campaign",
+		Status: campaign.CampaignStatusActive,
+		System: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART,
+		GmMode: campaign.GmModeHuman,
+	}
+	appendEvent(t, eventStore, event.Event{
+		CampaignID: "source",
+		Timestamp:  now.Add(-time.Hour),
+		Type:       event.TypeCampaignCreated,
+		EntityType: "campaign",
+		EntityID:   "source",
+		PayloadJSON: mustJSON(t, event.CampaignCreatedPayload{
+			Name:       "Source Campaign",
+			GameSystem: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART.String(),
+			GmMode:     statev1.GmMode_HUMAN.String(),
+		}),
+	})
+	for _, participantID := range []string{"part-1", "part-2"} {
+		appendEvent(t, eventStore, event.Event{
+			CampaignID: "source",
+			Timestamp:  now.Add(-time.Hour),
+			Type:       event.TypeParticipantJoined,
+			EntityType: "participant",
+			EntityID:   participantID,
+			PayloadJSON: mustJSON(t, event.ParticipantJoinedPayload{
+				ParticipantID:  participantID,
+				DisplayName:    participantID,
+				Role:           "PLAYER",
+				Controller:     "CONTROLLER_HUMAN",
+				CampaignAccess: "MEMBER",
+			}),
+		})
+	}
+
+	state := []byte("projections of source")
+	sum := sha256.Sum256(state)
+	checkpoints := &sourceCheckpointStore{checkpoint: storage.ProjectionCheckpoint{
+		CampaignID: "source",
+		Seq:        2,
+		StateHash:  hex.EncodeToString(sum[:]),
+		State:      state,
+	}}
+	projectionState := &forkImportingProjectionState{}
+	svc := &ForkService{
+		stores: Stores{
+			Campaign:        campaignStore,
+			Participant:     participantStore,
+			Event:           eventStore,
+			CampaignFork:    newFakeCampaignForkStore(),
+			Checkpoint:      checkpoints,
+			ProjectionState: projectionState,
+		},
+		clock:       fixedClock(now),
+		idGenerator: fixedIDGenerator("fork-1"),
+	}
+
+	if _, err := svc.ForkCampaign(ctx, &statev1.ForkCampaignRequest{SourceCampaignId: "source", CopyParticipants: true}); err != nil {
+		t.Fatalf("ForkCampaign returned error: %v", err)
+	}
+	if len(projectionState.imported) != 1 || projectionState.imported[0] != "source->fork-1: projections of source" {
+		t.Fatalf("imported = %v, want the source checkpoint imported into fork-1", projectionState.imported)
+	}
+	// The journal holds the whole history; only the tail is applied.
+	if got := len(eventStore.events["fork-1"]); got != 4 {
+		t.Fatalf("fork events = %d, want 4", got)
+	}
+	forkParticipants := participantStore.participants["fork-1"]
+	if _, ok := forkParticipants["part-1"]; ok {
+		t.Fatal("part-1 was re-applied over the restored checkpoint")
+	}
+	if _, ok := forkParticipants["part-2"]; !ok {
+		t.Fatalf("fork participants = %+v, want part-2 applied", forkParticipants)
+	}
+}
@@ -201,7 +201,7 @@ func (a inviteApplication) ClaimInvite(ctx context.Context, campaignID string, i
 	}
 	if claimEvent != nil {
 		var payload event.InviteClaimedPayload
-		if err := json.Unmarshal(claimEvent.PayloadJSON, &payload); err != nil {
+		if err := event.DecodePayload(*claimEvent, &payload); err != nil {
 			return invite.Invite{}, participant.Participant{}, status.Errorf(codes.Internal, "decode prior claim: %v", err)
 		}
 		if payload.InviteID != inv.ID || payload.UserID != userID {
@@ -406,7 +406,7 @@ func findInviteClaimByJTI(ctx context.Context, store storage.EventStore, campaig
 				continue
 			}
 			var payload event.InviteClaimedPayload
-			if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			if err := event.DecodePayload(evt, &payload); err != nil {
 				return nil, err
 			}
 			if payload.JWTID == jti {
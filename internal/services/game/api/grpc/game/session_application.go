@@ -9,6 +9,7 @@ import (
 
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	daggerheartservice "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/session"
@@ -147,7 +148,8 @@ func (a sessionApplication) EndSession(ctx context.Context, campaignID string, i
 		return session.Session{}, status.Error(codes.InvalidArgument, "session id is required")
 	}
 
-	if _, err := a.stores.Campaign.Get(ctx, campaignID); err != nil {
+	c, err := a.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
 		return session.Session{}, err
 	}
 
@@ -159,6 +161,11 @@ func (a sessionApplication) EndSession(ctx context.Context, campaignID string, i
 		return current, nil
 	}
 
+	// Ending the session ends its scene too.
+	if err := daggerheartservice.EndScene(ctx, daggerheartStores(a.stores), c, sessionID); err != nil {
+		return session.Session{}, err
+	}
+
 	endedAt := a.clock().UTC()
 	payload := event.SessionEndedPayload{
 		SessionID: sessionID,
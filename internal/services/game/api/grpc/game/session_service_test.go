@@ -2,6 +2,7 @@ package game
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/session"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 	"google.golang.org/grpc/codes"
 )
@@ -496,6 +498,117 @@ func TestEndSession_Success(t *testing.T) {
 	}
 }
 
+func TestEndSession_ExpiresDaggerheartSceneEffects(t *testing.T) {
+	campaignStore := newFakeCampaignStore()
+	sessionStore := newFakeSessionStore()
+	eventStore := newFakeEventStore()
+	dhStore := newFakeDaggerheartStore()
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	campaignStore.campaigns["c1"] = campaign.Campaign{
+		ID:     "c1",
+		Status: campaign.CampaignStatusActive,
+		System: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART,
+		GmMode: campaign.GmModeHuman,
+	}
+	ctx := context.Background()
+	for _, effect := range []storage.DaggerheartEffect{
+		{CampaignID: "c1", EffectID: "fx-scene", TargetType: daggerheart.EffectTargetCharacter, TargetID: "ch1", Kind: daggerheart.EffectKindRoll, Value: -1, Stacking: daggerheart.EffectStackingStack, ExpiresOn: daggerheart.EffectExpiresSceneEnd},
+		{CampaignID: "c1", EffectID: "fx-rest", TargetType: daggerheart.EffectTargetCharacter, TargetID: "ch1", Kind: daggerheart.EffectKindEvasion, Value: 1, Stacking: daggerheart.EffectStackingStack, ExpiresOn: daggerheart.EffectExpiresRest},
+	} {
+		if err := dhStore.PutDaggerheartEffect(ctx, effect); err != nil {
+			t.Fatalf("put effect: %v", err)
+		}
+	}
+
+	svc := &SessionService{
+		stores:      Stores{Campaign: campaignStore, Session: sessionStore, Event: eventStore, Daggerheart: dhStore},
+		clock:       fixedClock(now),
+		idGenerator: fixedIDGenerator("session-123"),
+	}
+
+	if _, err := svc.StartSession(ctx, &statev1.StartSessionRequest{CampaignId: "c1", Name: "No Environment"}); err != nil {
+		t.Fatalf("StartSession returned error: %v", err)
+	}
+	if _, err := svc.EndSession(ctx, &statev1.EndSessionRequest{CampaignId: "c1", SessionId: "session-123"}); err != nil {
+		t.Fatalf("EndSession returned error: %v", err)
+	}
+
+	if _, err := dhStore.GetDaggerheartEffect(ctx, "c1", "fx-scene"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("scene effect lookup error = %v, want not found", err)
+	}
+	if _, err := dhStore.GetDaggerheartEffect(ctx, "c1", "fx-rest"); err != nil {
+		t.Fatalf("rest effect should remain: %v", err)
+	}
+	events := eventStore.events["c1"]
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	if events[1].Type != daggerheart.EventTypeEffectExpired || events[1].EntityID != "fx-scene" {
+		t.Fatalf("event[1] = %s %s, want %s fx-scene", events[1].Type, events[1].EntityID, daggerheart.EventTypeEffectExpired)
+	}
+	if events[1].SessionID != "session-123" {
+		t.Fatalf("effect expiry session id = %q, want %q", events[1].SessionID, "session-123")
+	}
+	if events[2].Type != event.TypeSessionEnded {
+		t.Fatalf("event[2] type = %s, want %s", events[2].Type, event.TypeSessionEnded)
+	}
+}
+
+func TestEndSession_RefreshesDaggerheartSceneFeatures(t *testing.T) {
+	campaignStore := newFakeCampaignStore()
+	sessionStore := newFakeSessionStore()
+	eventStore := newFakeEventStore()
+	dhStore := newFakeDaggerheartStore()
+	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
+
+	campaignStore.campaigns["c1"] = campaign.Campaign{
+		ID:     "c1",
+		Status: campaign.CampaignStatusActive,
+		System: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART,
+		GmMode: campaign.GmModeHuman,
+	}
+	ctx := context.Background()
+	for _, state := range []storage.DaggerheartAdversaryFeatureState{
+		{CampaignID: "c1", AdversaryID: "adv-1", FeatureID: "feat-scene", Status: daggerheart.AdversaryFeatureStatusSpent, RefreshOn: daggerheart.AdversaryFeatureRefreshSceneEnd},
+		{CampaignID: "c1", AdversaryID: "adv-1", FeatureID: "feat-rest", Status: daggerheart.AdversaryFeatureStatusSpent, RefreshOn: daggerheart.AdversaryFeatureRefreshLongRest},
+	} {
+		if err := dhStore.PutDaggerheartAdversaryFeatureState(ctx, state); err != nil {
+			t.Fatalf("put feature state: %v", err)
+		}
+	}
+
+	svc := &SessionService{
+		stores:      Stores{Campaign: campaignStore, Session: sessionStore, Event: eventStore, Daggerheart: dhStore},
+		clock:       fixedClock(now),
+		idGenerator: fixedIDGenerator("session-123"),
+	}
+
+	if _, err := svc.StartSession(ctx, &statev1.StartSessionRequest{CampaignId: "c1", Name: "No Environment"}); err != nil {
+		t.Fatalf("StartSession returned error: %v", err)
+	}
+	if _, err := svc.EndSession(ctx, &statev1.EndSessionRequest{CampaignId: "c1", SessionId: "session-123"}); err != nil {
+		t.Fatalf("EndSession returned error: %v", err)
+	}
+
+	if got := dhStore.features["c1:adv-1:feat-scene"].Status; got != daggerheart.AdversaryFeatureStatusReady {
+		t.Fatalf("scene feature status = %q, want %q", got, daggerheart.AdversaryFeatureStatusReady)
+	}
+	if got := dhStore.features["c1:adv-1:feat-rest"].Status; got != daggerheart.AdversaryFeatureStatusSpent {
+		t.Fatalf("long rest feature status = %q, want %q", got, daggerheart.AdversaryFeatureStatusSpent)
+	}
+	events := eventStore.events["c1"]
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	if events[1].Type != daggerheart.EventTypeAdversaryFeatureRefreshed || events[1].SessionID != "session-123" {
+		t.Fatalf("event[1] = %s in %q, want %s in session-123", events[1].Type, events[1].SessionID, daggerheart.EventTypeAdversaryFeatureRefreshed)
+	}
+	if events[2].Type != event.TypeSessionEnded {
+		t.Fatalf("event[2] type = %s, want %s", events[2].Type, event.TypeSessionEnded)
+	}
+}
+
 func TestAbandonSessionGate_NilRequest(t *testing.T) {
 	svc := NewSessionService(Stores{})
 	_, err := svc.AbandonSessionGate(context.Background(), nil)
@@ -8,6 +8,7 @@ import (
 	campaignv1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
 	daggerheartv1 "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	daggerheartservice "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 	"google.golang.org/grpc/codes"
@@ -72,6 +73,11 @@ func (s *SnapshotService) GetSnapshot(ctx context.Context, in *campaignv1.GetSna
 		characterStates = append(characterStates, daggerheartStateToProto(campaignID, ch.ID, dhState))
 	}
 
+	effects, err := s.stores.Daggerheart.ListDaggerheartEffects(ctx, campaignID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list daggerheart effects: %v", err)
+	}
+
 	return &campaignv1.GetSnapshotResponse{
 		Snapshot: &campaignv1.Snapshot{
 			CampaignId:      campaignID,
@@ -80,6 +86,7 @@ func (s *SnapshotService) GetSnapshot(ctx context.Context, in *campaignv1.GetSna
 				Daggerheart: &daggerheartv1.DaggerheartSnapshot{
 					GmFear:                int32(dhSnapshot.GMFear),
 					ConsecutiveShortRests: int32(dhSnapshot.ConsecutiveShortRests),
+					Effects:               daggerheartservice.EffectsToProto(effects),
 				},
 			},
 		},
@@ -138,6 +138,47 @@ func TestGetSnapshot_Success_WithCharacters(t *testing.T) {
 	}
 }
 
+func TestGetSnapshot_Success_WithEffects(t *testing.T) {
+	campaignStore := newFakeCampaignStore()
+	dhStore := newFakeDaggerheartStore()
+	characterStore := newFakeCharacterStore()
+
+	campaignStore.campaigns["c1"] = campaign.Campaign{ID: "c1", Status: campaign.CampaignStatusActive}
+	if err := dhStore.PutDaggerheartEffect(context.Background(), storage.DaggerheartEffect{
+		CampaignID: "c1",
+		EffectID:   "eff-1",
+		TargetType: daggerheart.EffectTargetCharacter,
+		TargetID:   "ch1",
+		Kind:       daggerheart.EffectKindArmor,
+		Value:      2,
+		Stacking:   daggerheart.EffectStackingStack,
+		ExpiresOn:  daggerheart.EffectExpiresRest,
+	}); err != nil {
+		t.Fatalf("put effect: %v", err)
+	}
+
+	svc := NewSnapshotService(Stores{
+		Campaign:    campaignStore,
+		Daggerheart: dhStore,
+		Character:   characterStore,
+	})
+
+	resp, err := svc.GetSnapshot(context.Background(), &statev1.GetSnapshotRequest{CampaignId: "c1"})
+	if err != nil {
+		t.Fatalf("GetSnapshot returned error: %v", err)
+	}
+	effects := resp.Snapshot.GetDaggerheart().GetEffects()
+	if len(effects) != 1 {
+		t.Fatalf("Snapshot Effects = %d, want 1", len(effects))
+	}
+	if effects[0].GetEffectId() != "eff-1" || effects[0].GetKind() != daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ARMOR {
+		t.Errorf("Snapshot effect = %v, want armor eff-1", effects[0])
+	}
+	if effects[0].GetExpiresOn() != daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_REST {
+		t.Errorf("Snapshot effect expiry = %v, want rest", effects[0].GetExpiresOn())
+	}
+}
+
 func TestGetSnapshot_Success_DefaultGmFear(t *testing.T) {
 	campaignStore := newFakeCampaignStore()
 	dhStore := newFakeDaggerheartStore()
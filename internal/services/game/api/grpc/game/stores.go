@@ -26,6 +26,8 @@ type Stores struct {
 	Snapshot           storage.SnapshotStore
 	CampaignFork       storage.CampaignForkStore
 	DaggerheartContent storage.DaggerheartContentStore
+	Checkpoint         storage.ProjectionCheckpointStore
+	ProjectionState    storage.ProjectionStateStore
 }
 
 // Applier returns a projection Applier wired to the stores in this bundle.
@@ -44,6 +46,17 @@ func (s Stores) Applier() projection.Applier {
 		SessionGate:      s.SessionGate,
 		SessionSpotlight: s.SessionSpotlight,
 		Adapters:         adapterRegistryForStores(s),
+		Checkpoints:      s.Checkpoints(),
+		Event:            s.Event,
+	}
+}
+
+// Checkpoints returns the projection checkpoint configuration for this bundle.
+func (s Stores) Checkpoints() projection.Checkpoints {
+	return projection.Checkpoints{
+		Store:    s.Checkpoint,
+		State:    s.ProjectionState,
+		Interval: projection.DefaultCheckpointInterval,
 	}
 }
 
@@ -99,6 +112,12 @@ func (s Stores) Validate() error {
 	if s.DaggerheartContent == nil {
 		missing = append(missing, "DaggerheartContent")
 	}
+	if s.Checkpoint == nil {
+		missing = append(missing, "Checkpoint")
+	}
+	if s.ProjectionState == nil {
+		missing = append(missing, "ProjectionState")
+	}
 	if len(missing) > 0 {
 		return fmt.Errorf("stores not configured: %s", strings.Join(missing, ", "))
 	}
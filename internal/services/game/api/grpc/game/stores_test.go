@@ -27,6 +27,7 @@ func TestStoresValidate(t *testing.T) {
 			"Character", "Daggerheart", "Session", "SessionGate",
 			"SessionSpotlight", "Event", "Telemetry", "Statistics",
 			"Outcome", "Snapshot", "CampaignFork", "DaggerheartContent",
+			"Checkpoint", "ProjectionState",
 		} {
 			if !strings.Contains(msg, name) {
 				t.Errorf("error should mention %q, got: %s", name, msg)
@@ -66,6 +67,8 @@ func validStores() Stores {
 		Snapshot:           stubSnapshot{},
 		CampaignFork:       &fakeCampaignForkStore{},
 		DaggerheartContent: stubDaggerheartContent{},
+		Checkpoint:         stubCheckpoint{},
+		ProjectionState:    stubProjectionState{},
 	}
 }
 
@@ -118,3 +121,9 @@ type stubSnapshot struct{ storage.SnapshotStore }
 type stubDaggerheartContent struct {
 	storage.DaggerheartContentStore
 }
+type stubCheckpoint struct {
+	storage.ProjectionCheckpointStore
+}
+type stubProjectionState struct {
+	storage.ProjectionStateStore
+}
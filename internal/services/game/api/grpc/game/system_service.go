@@ -7,6 +7,7 @@ import (
 
 	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -83,11 +84,40 @@ func (s *SystemService) GetGameSystem(ctx context.Context, in *gamev1.GetGameSys
 	}
 	defaultVersion := s.registry.DefaultVersion(in.GetId())
 
+	schemas, err := eventSchemasToProto(system.ID())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load event payload schemas: %v", err)
+	}
+
 	return &gamev1.GetGameSystemResponse{
-		System: systemToProto(system, defaultVersion),
+		System:       systemToProto(system, defaultVersion),
+		EventSchemas: schemas,
 	}, nil
 }
 
+// eventSchemasToProto returns the core payload schemas followed by those of
+// the game system, so clients can validate a campaign's events offline.
+func eventSchemasToProto(id commonv1.GameSystem) ([]*gamev1.EventPayloadSchema, error) {
+	core, err := event.PayloadSchemas("")
+	if err != nil {
+		return nil, err
+	}
+	owned, err := event.PayloadSchemas(id.String())
+	if err != nil {
+		return nil, err
+	}
+	schemas := make([]*gamev1.EventPayloadSchema, 0, len(core)+len(owned))
+	for _, schema := range append(core, owned...) {
+		schemas = append(schemas, &gamev1.EventPayloadSchema{
+			EventType:      string(schema.Type),
+			SystemId:       schema.SystemID,
+			PayloadVersion: int32(schema.PayloadVersion),
+			SchemaJson:     schema.Schema,
+		})
+	}
+	return schemas, nil
+}
+
 func systemToProto(system systems.GameSystem, defaultVersion string) *gamev1.GameSystemInfo {
 	metadata := system.RegistryMetadata()
 	version := strings.TrimSpace(system.Version())
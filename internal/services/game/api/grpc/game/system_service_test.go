@@ -6,6 +6,7 @@ import (
 
 	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
 	gamev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems"
 	"google.golang.org/grpc/codes"
 )
@@ -130,3 +131,26 @@ func TestGetGameSystem_NotFound(t *testing.T) {
 	_, err := svc.GetGameSystem(context.Background(), &gamev1.GetGameSystemRequest{Id: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART})
 	assertStatusCode(t, err, codes.NotFound)
 }
+
+func TestGetGameSystem_EventSchemas(t *testing.T) {
+	registry := systems.NewRegistry()
+	registry.Register(&testRegistrySystem{id: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART, version: "1.0.0", name: "Daggerheart"})
+
+	svc := NewSystemService(registry)
+	resp, err := svc.GetGameSystem(context.Background(), &gamev1.GetGameSystemRequest{Id: commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART})
+	if err != nil {
+		t.Fatalf("GetGameSystem returned error: %v", err)
+	}
+	schemas := make(map[string]*gamev1.EventPayloadSchema, len(resp.GetEventSchemas()))
+	for _, schema := range resp.GetEventSchemas() {
+		schemas[schema.GetEventType()] = schema
+	}
+	core := schemas["campaign.created"]
+	if core == nil || core.GetSystemId() != "" || core.GetPayloadVersion() != int32(event.CurrentPayloadVersion(event.TypeCampaignCreated)) || len(core.GetSchemaJson()) == 0 {
+		t.Fatalf("campaign.created schema = %+v", core)
+	}
+	owned := schemas["action.damage_applied"]
+	if owned == nil || owned.GetSystemId() != "GAME_SYSTEM_DAGGERHEART" || len(owned.GetSchemaJson()) == 0 {
+		t.Fatalf("action.damage_applied schema = %+v", owned)
+	}
+}
@@ -200,6 +200,8 @@ func timelineChangeFields(evt event.Event) []*campaignv1.ProjectionField {
 	switch evt.Type {
 	case daggerheart.EventTypeCharacterStatePatched:
 		return daggerheartStateChangeFields(evt.PayloadJSON)
+	case daggerheart.EventTypeEnvironmentShifted:
+		return daggerheartEnvironmentShiftFields(evt.PayloadJSON)
 	default:
 		return nil
 	}
@@ -223,6 +225,22 @@ func daggerheartStateChangeFields(payloadJSON []byte) []*campaignv1.ProjectionFi
 	return fields
 }
 
+func daggerheartEnvironmentShiftFields(payloadJSON []byte) []*campaignv1.ProjectionField {
+	if len(payloadJSON) == 0 {
+		return nil
+	}
+	var payload daggerheart.EnvironmentShiftedPayload
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		return nil
+	}
+	fields := make([]*campaignv1.ProjectionField, 0, 2)
+	appendStringChange(&fields, "Environment", &payload.FromEnvironmentID, &payload.EnvironmentID)
+	if reason := strings.TrimSpace(payload.Reason); reason != "" {
+		fields = append(fields, &campaignv1.ProjectionField{Label: "Reason", Value: reason})
+	}
+	return fields
+}
+
 func appendIntChange(fields *[]*campaignv1.ProjectionField, label string, before, after *int) {
 	if after == nil {
 		return
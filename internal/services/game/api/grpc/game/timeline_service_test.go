@@ -253,6 +253,48 @@ func TestListTimelineEntries_CharacterStateChanges(t *testing.T) {
 	assertField("Life State", "= alive")
 }
 
+func TestListTimelineEntries_EnvironmentShiftChanges(t *testing.T) {
+	eventStore := newFakeEventStore()
+	payloadJSON, err := json.Marshal(daggerheart.EnvironmentShiftedPayload{
+		FromEnvironmentID: "environment.outpost-town",
+		EnvironmentID:     "environment.cliffside-ascent",
+		Reason:            "fled the town",
+	})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	eventStore.events["c1"] = []event.Event{
+		{
+			CampaignID:  "c1",
+			Seq:         1,
+			Type:        daggerheart.EventTypeEnvironmentShifted,
+			EntityType:  "environment",
+			EntityID:    "environment.cliffside-ascent",
+			Timestamp:   time.Now().UTC(),
+			PayloadJSON: payloadJSON,
+		},
+	}
+
+	svc := NewEventService(Stores{Event: eventStore})
+	resp, err := svc.ListTimelineEntries(context.Background(), &campaignv1.ListTimelineEntriesRequest{CampaignId: "c1"})
+	if err != nil {
+		t.Fatalf("list timeline entries: %v", err)
+	}
+	if len(resp.Entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(resp.Entries))
+	}
+	fields := resp.Entries[0].GetProjection().GetFields()
+	if len(fields) != 2 {
+		t.Fatalf("expected 2 change fields, got %d", len(fields))
+	}
+	if fields[0].GetLabel() != "Environment" || fields[0].GetValue() != "environment.outpost-town -> environment.cliffside-ascent" {
+		t.Fatalf("environment field = %v", fields[0])
+	}
+	if fields[1].GetLabel() != "Reason" || fields[1].GetValue() != "fled the town" {
+		t.Fatalf("reason field = %v", fields[1])
+	}
+}
+
 func TestListTimelineEntries_CharacterStateChanges_WithBefore(t *testing.T) {
 	characterStore := newFakeCharacterStore()
 	eventStore := newFakeEventStore()
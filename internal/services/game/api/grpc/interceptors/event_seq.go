@@ -0,0 +1,40 @@
+package interceptors
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EventSeqInterceptor applies the expected last event seq header to every
+// event the request appends. When an append finds the campaign log has moved
+// on, the call fails with ABORTED and the current seq, even if the handler
+// wrapped the store error, so clients can re-read and retry.
+func EventSeqInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		value := strings.TrimSpace(grpcmeta.ExpectedLastSeqFromContext(ctx))
+		if value == "" {
+			return handler(ctx, req)
+		}
+		seq, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %q", grpcmeta.ExpectedLastSeqHeader, value)
+		}
+
+		ctx = storage.WithExpectedLastSeq(ctx, seq)
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if conflict := storage.EventSeqConflictFromContext(ctx); conflict != nil {
+				return nil, apperrors.HandleError(conflict, apperrors.DefaultLocale)
+			}
+		}
+		return resp, err
+	}
+}
@@ -0,0 +1,75 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func expectedSeqContext(value string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcmeta.ExpectedLastSeqHeader, value))
+}
+
+func TestEventSeqInterceptor_NoHeader_PassesThrough(t *testing.T) {
+	interceptor := EventSeqInterceptor()
+	resp, err := interceptor(context.Background(), nil, serverInfo("/game.v1.EventService/AppendEvent"), func(ctx context.Context, req any) (any, error) {
+		if err := storage.CheckExpectedLastSeq(ctx, "camp-1", 7); err != nil {
+			t.Fatalf("expected no expectation without header, got %v", err)
+		}
+		return "success", nil
+	})
+	if err != nil || resp != "success" {
+		t.Fatalf("expected pass through, got %v, %v", resp, err)
+	}
+}
+
+func TestEventSeqInterceptor_InvalidHeader(t *testing.T) {
+	interceptor := EventSeqInterceptor()
+	_, err := interceptor(expectedSeqContext("forty"), nil, serverInfo("/game.v1.EventService/AppendEvent"), fakeHandler)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestEventSeqInterceptor_ConflictReturnsAborted(t *testing.T) {
+	interceptor := EventSeqInterceptor()
+	_, err := interceptor(expectedSeqContext("40"), nil, serverInfo("/systems.daggerheart.v1.DaggerheartService/ApplyRollOutcome"), func(ctx context.Context, req any) (any, error) {
+		conflict := storage.CheckExpectedLastSeq(ctx, "camp-1", 42)
+		if !errors.Is(conflict, storage.ErrEventSeqConflict) {
+			t.Fatalf("expected conflict, got %v", conflict)
+		}
+		// Handlers commonly wrap store errors as internal errors.
+		return nil, status.Errorf(codes.Internal, "append event: %v", conflict)
+	})
+	st := status.Convert(err)
+	if st.Code() != codes.Aborted {
+		t.Fatalf("expected Aborted, got %v", err)
+	}
+	var info *errdetails.ErrorInfo
+	for _, detail := range st.Details() {
+		if value, ok := detail.(*errdetails.ErrorInfo); ok {
+			info = value
+		}
+	}
+	if info == nil || info.GetReason() != string(apperrors.CodeEventSeqConflict) || info.GetMetadata()["LastSeq"] != "42" {
+		t.Fatalf("unexpected error info %+v", info)
+	}
+}
+
+func TestEventSeqInterceptor_OtherErrorsUnchanged(t *testing.T) {
+	interceptor := EventSeqInterceptor()
+	_, err := interceptor(expectedSeqContext("40"), nil, serverInfo("/game.v1.EventService/AppendEvent"), func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.NotFound, "campaign not found")
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
@@ -0,0 +1,243 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"strings"
+	"time"
+
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// DefaultIdempotencyTTL is how long an idempotency key replays its response.
+const DefaultIdempotencyTTL = 24 * time.Hour
+
+// DefaultIdempotencyLease is how long a key stays reserved for a request that
+// has not finished. The lease is renewed every half lease while the handler
+// runs, so a long request keeps its key; a request that never finishes, for
+// example because the server stopped mid-request, frees its key once the
+// lease runs out.
+const DefaultIdempotencyLease = time.Minute
+
+// IdempotencyInterceptor makes requests carrying an idempotency key safe to
+// retry. Keys are scoped to the calling participant or user. The first request
+// with a key runs normally and its response, or the error it failed with after
+// appending events, is recorded with the event seq range it appended.
+// Repeating the key for the same method and request within ttl replays that
+// outcome without running the handler again. Failures that appended nothing
+// release the key so the request can be retried. A running request renews its
+// reservation until it finishes; one abandoned mid-request holds its key for
+// at most lease. Keyed requests without a caller identity are rejected.
+// Read-only methods ignore the key: rerunning them is already safe, and a
+// replayed read would hide changes made since the first call.
+func IdempotencyInterceptor(store storage.IdempotencyStore, ttl, lease time.Duration) grpc.UnaryServerInterceptor {
+	if ttl <= 0 {
+		ttl = DefaultIdempotencyTTL
+	}
+	if lease <= 0 {
+		lease = DefaultIdempotencyLease
+	}
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		key := strings.TrimSpace(grpcmeta.IdempotencyKeyFromContext(ctx))
+		if key == "" || isReadOnlyMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		if store == nil {
+			return nil, status.Error(codes.Internal, "idempotency store is not configured")
+		}
+		message, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		requestHash, err := hashRequest(message)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "hash request: %v", err)
+		}
+
+		caller := idempotencyCaller(ctx)
+		if caller == "" {
+			return nil, status.Errorf(codes.Unauthenticated, "%s requires a participant or user identity", grpcmeta.IdempotencyKeyHeader)
+		}
+		now := time.Now().UTC()
+		existing, reserved, err := store.ReserveIdempotencyKey(ctx, storage.IdempotencyRecord{
+			Caller:      caller,
+			Key:         key,
+			Method:      info.FullMethod,
+			RequestHash: requestHash,
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(lease),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reserve idempotency key: %v", err)
+		}
+		if !reserved {
+			if existing.RequestHash != requestHash {
+				return nil, status.Errorf(codes.InvalidArgument, "%s was already used for a different request", grpcmeta.IdempotencyKeyHeader)
+			}
+			if !existing.Completed {
+				return nil, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+			}
+			return replayIdempotentOutcome(ctx, existing)
+		}
+
+		ctx = storage.WithAppendRange(ctx)
+		record := storage.IdempotencyRecord{Caller: caller, Key: key, Method: info.FullMethod}
+		stopRenewing := renewIdempotencyLease(ctx, store, record, lease)
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			stopRenewing()
+			// A panicking handler must not hold its key until the lease runs
+			// out; record the failure if it already appended events.
+			panicErr := status.Error(codes.Internal, "request failed unexpectedly")
+			finishIdempotentRequest(ctx, store, ttl, record, nil, panicErr, info.FullMethod)
+			panic(recovered)
+		}()
+		resp, err = handler(ctx, req)
+		stopRenewing()
+		finishIdempotentRequest(ctx, store, ttl, record, resp, err, info.FullMethod)
+		return resp, err
+	}
+}
+
+// isReadOnlyMethod reports whether a method only reads state. Every Get and
+// List RPC in the game API is a read.
+func isReadOnlyMethod(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	return strings.HasPrefix(name, "Get") || strings.HasPrefix(name, "List")
+}
+
+// renewIdempotencyLease extends the reservation of record every half lease
+// until the returned function is called. Renewals outlive a cancelled request
+// context because the handler may still be running.
+func renewIdempotencyLease(ctx context.Context, store storage.IdempotencyStore, record storage.IdempotencyRecord, lease time.Duration) (stop func()) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(lease / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				expiresAt := time.Now().UTC().Add(lease)
+				if err := store.RenewIdempotencyKey(ctx, record.Caller, record.Key, record.Method, expiresAt); err != nil && ctx.Err() == nil {
+					log.Printf("idempotency renew %s: %v", record.Method, err)
+				}
+			}
+		}
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// finishIdempotentRequest completes record with the handler's outcome, or
+// releases it when there is nothing safe to replay.
+func finishIdempotentRequest(ctx context.Context, store storage.IdempotencyStore, ttl time.Duration, record storage.IdempotencyRecord, resp any, err error, method string) {
+	record.CampaignID, record.FirstSeq, record.LastSeq = storage.AppendRangeFromContext(ctx)
+	if err == nil {
+		response, ok := resp.(proto.Message)
+		if ok {
+			record.ResponseType = string(response.ProtoReflect().Descriptor().FullName())
+			record.Response, ok = marshalOrLog(method, response)
+		}
+		if !ok {
+			releaseIdempotencyKey(ctx, store, record)
+			return
+		}
+	} else {
+		if record.LastSeq == 0 {
+			releaseIdempotencyKey(ctx, store, record)
+			return
+		}
+		var ok bool
+		record.Status, ok = marshalOrLog(method, status.Convert(err).Proto())
+		if !ok {
+			// Events were appended, so the key stays held until its lease
+			// runs out rather than letting an immediate retry repeat them.
+			return
+		}
+	}
+	record.ExpiresAt = time.Now().UTC().Add(ttl)
+	// Record the outcome even when the caller has gone away: that is
+	// exactly the request it will retry.
+	if completeErr := store.CompleteIdempotencyKey(context.WithoutCancel(ctx), record); completeErr != nil {
+		log.Printf("idempotency complete %s: %v", method, completeErr)
+	}
+}
+
+// idempotencyCaller identifies who sent a request so one caller's keys never
+// match, or reveal, another's. It is empty for anonymous requests.
+func idempotencyCaller(ctx context.Context) string {
+	if participantID := strings.TrimSpace(grpcmeta.ParticipantIDFromContext(ctx)); participantID != "" {
+		return "participant:" + participantID
+	}
+	if userID := strings.TrimSpace(grpcmeta.UserIDFromContext(ctx)); userID != "" {
+		return "user:" + userID
+	}
+	return ""
+}
+
+// hashRequest fingerprints a request so a reused key can be matched to it.
+func hashRequest(message proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// replayIdempotentOutcome rebuilds the recorded response or error.
+func replayIdempotentOutcome(ctx context.Context, record storage.IdempotencyRecord) (any, error) {
+	// The header is best effort; it only fails outside a real server stream.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(grpcmeta.IdempotentReplayHeader, "true"))
+	if len(record.Status) > 0 {
+		var st spb.Status
+		if err := proto.Unmarshal(record.Status, &st); err != nil {
+			return nil, status.Errorf(codes.Internal, "decode idempotent status: %v", err)
+		}
+		return nil, status.FromProto(&st).Err()
+	}
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(record.ResponseType))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "resolve idempotent response %s: %v", record.ResponseType, err)
+	}
+	response := messageType.New().Interface()
+	if err := proto.Unmarshal(record.Response, response); err != nil {
+		return nil, status.Errorf(codes.Internal, "decode idempotent response: %v", err)
+	}
+	return response, nil
+}
+
+func marshalOrLog(method string, message proto.Message) ([]byte, bool) {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		log.Printf("idempotency encode %s: %v", method, err)
+		return nil, false
+	}
+	return data, true
+}
+
+func releaseIdempotencyKey(ctx context.Context, store storage.IdempotencyStore, record storage.IdempotencyRecord) {
+	if err := store.ReleaseIdempotencyKey(context.WithoutCancel(ctx), record.Caller, record.Key, record.Method); err != nil {
+		log.Printf("idempotency release %s: %v", record.Method, err)
+	}
+}
@@ -0,0 +1,400 @@
+package interceptors
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	idempotentMethod = "/systems.daggerheart.v1.DaggerheartService/SessionAttackFlow"
+	// idempotentCaller is the caller idempotencyContext requests come from.
+	idempotentCaller = "user:user-1"
+)
+
+// fakeIdempotencyStore keeps idempotency records in memory.
+type fakeIdempotencyStore struct {
+	mu      sync.Mutex
+	records map[string]storage.IdempotencyRecord
+}
+
+func newFakeIdempotencyStore() *fakeIdempotencyStore {
+	return &fakeIdempotencyStore{records: make(map[string]storage.IdempotencyRecord)}
+}
+
+func idempotencyRecordID(caller, key, method string) string {
+	return caller + "|" + method + ":" + key
+}
+
+func (s *fakeIdempotencyStore) record(id string) storage.IdempotencyRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.records[id]
+}
+
+func (s *fakeIdempotencyStore) ReserveIdempotencyKey(_ context.Context, record storage.IdempotencyRecord) (storage.IdempotencyRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := idempotencyRecordID(record.Caller, record.Key, record.Method)
+	if existing, ok := s.records[id]; ok && existing.ExpiresAt.After(record.CreatedAt) {
+		return existing, false, nil
+	}
+	s.records[id] = record
+	return storage.IdempotencyRecord{}, true, nil
+}
+
+func (s *fakeIdempotencyStore) CompleteIdempotencyKey(_ context.Context, record storage.IdempotencyRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := idempotencyRecordID(record.Caller, record.Key, record.Method)
+	existing := s.records[id]
+	record.RequestHash = existing.RequestHash
+	record.CreatedAt = existing.CreatedAt
+	record.Completed = true
+	s.records[id] = record
+	return nil
+}
+
+func (s *fakeIdempotencyStore) RenewIdempotencyKey(_ context.Context, caller, key, method string, expiresAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := idempotencyRecordID(caller, key, method)
+	if record, ok := s.records[id]; ok && !record.Completed {
+		record.ExpiresAt = expiresAt
+		s.records[id] = record
+	}
+	return nil
+}
+
+func (s *fakeIdempotencyStore) ReleaseIdempotencyKey(_ context.Context, caller, key, method string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, idempotencyRecordID(caller, key, method))
+	return nil
+}
+
+func idempotencyContext(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		grpcmeta.IdempotencyKeyHeader, key,
+		grpcmeta.UserIDHeader, "user-1",
+	))
+}
+
+func participantIdempotencyContext(participantID, key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		grpcmeta.IdempotencyKeyHeader, key,
+		grpcmeta.ParticipantIDHeader, participantID,
+	))
+}
+
+// appendingHandler simulates a handler that appends two events per call.
+func appendingHandler(calls *int) func(ctx context.Context, req any) (any, error) {
+	return func(ctx context.Context, req any) (any, error) {
+		*calls++
+		first := uint64(*calls*2 - 1)
+		storage.RecordAppendedEvents(ctx,
+			event.Event{CampaignID: "camp-1", Seq: first},
+			event.Event{CampaignID: "camp-1", Seq: first + 1},
+		)
+		return wrapperspb.String("damage applied"), nil
+	}
+}
+
+func TestIdempotencyInterceptor_NoHeader_PassesThrough(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	calls := 0
+	for range 2 {
+		if _, err := interceptor(context.Background(), wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if calls != 2 || len(store.records) != 0 {
+		t.Fatalf("calls = %d records = %d, want 2 calls and no records", calls, len(store.records))
+	}
+}
+
+func TestIdempotencyInterceptor_ReadOnlyMethodsIgnoreKey(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	calls := 0
+	ctx := idempotencyContext("key-1")
+	for _, method := range []string{
+		"/game.v1.CampaignService/GetCampaign",
+		"/systems.daggerheart.v1.DaggerheartService/ListEffects",
+	} {
+		for range 2 {
+			if _, err := interceptor(ctx, wrapperspb.String("read"), serverInfo(method), appendingHandler(&calls)); err != nil {
+				t.Fatalf("%s: unexpected error: %v", method, err)
+			}
+		}
+	}
+	if calls != 4 || len(store.records) != 0 {
+		t.Fatalf("calls = %d records = %d, want 4 calls and no records", calls, len(store.records))
+	}
+}
+
+func TestIdempotencyInterceptor_ReplaysResponse(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	calls := 0
+	ctx := idempotencyContext("key-1")
+
+	first, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls))
+	if err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	replayed, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls))
+	if err != nil {
+		t.Fatalf("replayed call: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("handler calls = %d, want 1", calls)
+	}
+	if !proto.Equal(first.(proto.Message), replayed.(proto.Message)) {
+		t.Fatalf("replayed response = %v, want %v", replayed, first)
+	}
+	record := store.records[idempotencyRecordID(idempotentCaller, "key-1", idempotentMethod)]
+	if !record.Completed || record.CampaignID != "camp-1" || record.FirstSeq != 1 || record.LastSeq != 2 {
+		t.Fatalf("record = %+v, want completed camp-1 seq 1-2", record)
+	}
+
+	// The same key on another method is a separate request.
+	if _, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo("/game.v1.EventService/AppendEvent"), appendingHandler(&calls)); err != nil {
+		t.Fatalf("other method: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("handler calls = %d, want 2", calls)
+	}
+}
+
+func TestIdempotencyInterceptor_DifferentRequestRejected(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	calls := 0
+	ctx := idempotencyContext("key-1")
+	if _, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls)); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	_, err := interceptor(ctx, wrapperspb.String("other attack"), serverInfo(idempotentMethod), appendingHandler(&calls))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestIdempotencyInterceptor_InProgress(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	ctx := idempotencyContext("key-1")
+	_, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), func(ctx context.Context, req any) (any, error) {
+		_, err := interceptor(ctx, req, serverInfo(idempotentMethod), fakeHandler)
+		return nil, err
+	})
+	if status.Code(err) != codes.Aborted {
+		t.Fatalf("expected Aborted, got %v", err)
+	}
+}
+
+func TestIdempotencyInterceptor_Errors(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	ctx := idempotencyContext("key-1")
+	calls := 0
+
+	// A failure before any append releases the key.
+	_, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), func(ctx context.Context, req any) (any, error) {
+		calls++
+		return nil, status.Error(codes.Unavailable, "try again")
+	})
+	if status.Code(err) != codes.Unavailable || len(store.records) != 0 {
+		t.Fatalf("err = %v records = %d, want Unavailable and no records", err, len(store.records))
+	}
+
+	// A failure after appending is replayed.
+	failAfterAppend := func(ctx context.Context, req any) (any, error) {
+		calls++
+		storage.RecordAppendedEvents(ctx, event.Event{CampaignID: "camp-1", Seq: 3})
+		return nil, status.Error(codes.Internal, "apply damage")
+	}
+	if _, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), failAfterAppend); status.Code(err) != codes.Internal {
+		t.Fatalf("expected Internal, got %v", err)
+	}
+	_, err = interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), failAfterAppend)
+	if status.Code(err) != codes.Internal || status.Convert(err).Message() != "apply damage" {
+		t.Fatalf("expected replayed Internal error, got %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("handler calls = %d, want 2", calls)
+	}
+}
+
+func TestIdempotencyInterceptor_ExpiredKeyRunsAgain(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	calls := 0
+	ctx := idempotencyContext("key-1")
+	if _, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls)); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	record := store.records[idempotencyRecordID(idempotentCaller, "key-1", idempotentMethod)]
+	record.ExpiresAt = time.Now().Add(-time.Minute)
+	store.records[idempotencyRecordID(idempotentCaller, "key-1", idempotentMethod)] = record
+
+	if _, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls)); err != nil {
+		t.Fatalf("second call: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("handler calls = %d, want 2", calls)
+	}
+}
+
+func TestIdempotencyInterceptor_KeysAreScopedToCaller(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	calls := 0
+
+	if _, err := interceptor(participantIdempotencyContext("part-1", "key-1"), wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls)); err != nil {
+		t.Fatalf("first caller: %v", err)
+	}
+	// Another caller reusing the key with a different request runs it instead
+	// of replaying the first caller's response or learning the key exists.
+	if _, err := interceptor(participantIdempotencyContext("part-2", "key-1"), wrapperspb.String("other attack"), serverInfo(idempotentMethod), appendingHandler(&calls)); err != nil {
+		t.Fatalf("second caller: %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("handler calls = %d, want 2", calls)
+	}
+	first := store.records[idempotencyRecordID("participant:part-1", "key-1", idempotentMethod)]
+	second := store.records[idempotencyRecordID("participant:part-2", "key-1", idempotentMethod)]
+	if first.FirstSeq != 1 || second.FirstSeq != 3 {
+		t.Fatalf("records = %+v / %+v, want one per caller", first, second)
+	}
+	if _, err := interceptor(participantIdempotencyContext("part-1", "key-1"), wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls)); err != nil || calls != 2 {
+		t.Fatalf("first caller retry: err = %v calls = %d, want replay", err, calls)
+	}
+}
+
+func TestIdempotencyInterceptor_PanicReleasesKey(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	ctx := idempotencyContext("key-1")
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected the handler panic to propagate")
+			}
+		}()
+		_, _ = interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), func(ctx context.Context, req any) (any, error) {
+			panic("boom")
+		})
+	}()
+	if len(store.records) != 0 {
+		t.Fatalf("records = %d, want the key released", len(store.records))
+	}
+
+	calls := 0
+	if _, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls)); err != nil || calls != 1 {
+		t.Fatalf("retry: err = %v calls = %d, want the handler to run", err, calls)
+	}
+}
+
+func TestIdempotencyInterceptor_PanicAfterAppendReplaysFailure(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	ctx := idempotencyContext("key-1")
+
+	func() {
+		defer func() { _ = recover() }()
+		_, _ = interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), func(ctx context.Context, req any) (any, error) {
+			storage.RecordAppendedEvents(ctx, event.Event{CampaignID: "camp-1", Seq: 1})
+			panic("boom")
+		})
+	}()
+
+	calls := 0
+	_, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls))
+	if status.Code(err) != codes.Internal || calls != 0 {
+		t.Fatalf("retry: err = %v calls = %d, want replayed Internal", err, calls)
+	}
+}
+
+func TestIdempotencyInterceptor_ReservationLease(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	ctx := idempotencyContext("key-1")
+	id := idempotencyRecordID(idempotentCaller, "key-1", idempotentMethod)
+
+	// A reservation left behind by a request that never finished.
+	_, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), func(ctx context.Context, req any) (any, error) {
+		reservation := store.records[id]
+		if reservation.ExpiresAt.After(time.Now().Add(time.Minute)) {
+			t.Fatalf("reservation expires at %v, want within the lease", reservation.ExpiresAt)
+		}
+		return nil, status.Error(codes.Unavailable, "server stopping")
+	})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected Unavailable, got %v", err)
+	}
+	now := time.Now()
+	store.records[id] = storage.IdempotencyRecord{
+		Caller:    idempotentCaller,
+		Key:       "key-1",
+		Method:    idempotentMethod,
+		CreatedAt: now.Add(-2 * time.Minute),
+		ExpiresAt: now.Add(-time.Minute),
+	}
+
+	calls := 0
+	if _, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls)); err != nil || calls != 1 {
+		t.Fatalf("retry after lease: err = %v calls = %d, want the handler to run", err, calls)
+	}
+	// Completion keeps the outcome for the replay TTL, not the lease.
+	if record := store.records[id]; !record.Completed || record.ExpiresAt.Before(time.Now().Add(50*time.Minute)) {
+		t.Fatalf("record = %+v, want completed for the replay TTL", record)
+	}
+}
+
+func TestIdempotencyInterceptor_RenewsLeaseWhileRunning(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	lease := 40 * time.Millisecond
+	interceptor := IdempotencyInterceptor(store, time.Hour, lease)
+	id := idempotencyRecordID(idempotentCaller, "key-1", idempotentMethod)
+
+	_, err := interceptor(idempotencyContext("key-1"), wrapperspb.String("attack"), serverInfo(idempotentMethod), func(ctx context.Context, req any) (any, error) {
+		reservedUntil := store.record(id).ExpiresAt
+		time.Sleep(3 * lease)
+		// A handler running past its first lease still holds the key.
+		if renewed := store.record(id).ExpiresAt; !renewed.After(reservedUntil) {
+			t.Errorf("reservation expires at %v, want renewed past %v", renewed, reservedUntil)
+		}
+		return wrapperspb.String("damage applied"), nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record := store.record(id); !record.Completed || record.ExpiresAt.Before(time.Now().Add(50*time.Minute)) {
+		t.Fatalf("record = %+v, want completed for the replay TTL", record)
+	}
+}
+
+func TestIdempotencyInterceptor_AnonymousKeyRejected(t *testing.T) {
+	store := newFakeIdempotencyStore()
+	interceptor := IdempotencyInterceptor(store, time.Hour, time.Minute)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcmeta.IdempotencyKeyHeader, "key-1"))
+	calls := 0
+	_, err := interceptor(ctx, wrapperspb.String("attack"), serverInfo(idempotentMethod), appendingHandler(&calls))
+	if status.Code(err) != codes.Unauthenticated || calls != 0 || len(store.records) != 0 {
+		t.Fatalf("err = %v calls = %d records = %d, want Unauthenticated without running", err, calls, len(store.records))
+	}
+}
@@ -8,4 +8,6 @@
 //   - InvocationIDHeader: tracks MCP tool invocations
 //   - ParticipantIDHeader/UserIDHeader: identity hints for callers and impersonation
 //   - CampaignIDHeader/SessionIDHeader: routing and scoping hints
+//   - ExpectedLastSeqHeader: optimistic concurrency check for event appends
+//   - IdempotencyKeyHeader/IdempotentReplayHeader: replay of retried requests
 package metadata
@@ -29,6 +29,18 @@ const CampaignIDHeader = "x-fracturing-space-campaign-id"
 // SessionIDHeader is the gRPC metadata key for session routing hints.
 const SessionIDHeader = "x-fracturing-space-session-id"
 
+// ExpectedLastSeqHeader is the gRPC metadata key for the campaign event seq a
+// caller expects to be last when its request appends events.
+const ExpectedLastSeqHeader = "x-fracturing-space-expected-last-seq"
+
+// IdempotencyKeyHeader is the gRPC metadata key for client-chosen keys that
+// make retried requests replay their original response.
+const IdempotencyKeyHeader = "x-fracturing-space-idempotency-key"
+
+// IdempotentReplayHeader is the gRPC response header set when a response was
+// replayed for a repeated idempotency key.
+const IdempotentReplayHeader = "x-fracturing-space-idempotent-replay"
+
 // contextKey stores metadata values in context.
 type contextKey string
 
@@ -77,6 +89,16 @@ func SessionIDFromContext(ctx context.Context) string {
 	return metadataValueFromIncomingContext(ctx, SessionIDHeader)
 }
 
+// ExpectedLastSeqFromContext returns the expected last event seq from incoming metadata.
+func ExpectedLastSeqFromContext(ctx context.Context) string {
+	return metadataValueFromIncomingContext(ctx, ExpectedLastSeqHeader)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key from incoming metadata.
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	return metadataValueFromIncomingContext(ctx, IdempotencyKeyHeader)
+}
+
 // WithRequestID stores the request ID in context.
 func WithRequestID(ctx context.Context, requestID string) context.Context {
 	if ctx == nil {
@@ -67,6 +67,8 @@ func TestIncomingMetadataAccessors(t *testing.T) {
 		UserIDHeader, "user-1",
 		CampaignIDHeader, "campaign-1",
 		SessionIDHeader, "session-1",
+		ExpectedLastSeqHeader, "40",
+		IdempotencyKeyHeader, "key-1",
 	))
 
 	if ParticipantIDFromContext(ctx) != "participant-1" {
@@ -81,6 +83,12 @@ func TestIncomingMetadataAccessors(t *testing.T) {
 	if SessionIDFromContext(ctx) != "session-1" {
 		t.Fatal("expected session id from metadata")
 	}
+	if ExpectedLastSeqFromContext(ctx) != "40" {
+		t.Fatal("expected last seq from metadata")
+	}
+	if IdempotencyKeyFromContext(ctx) != "key-1" {
+		t.Fatal("expected idempotency key from metadata")
+	}
 }
 
 func TestEnsureRequestMetadata(t *testing.T) {
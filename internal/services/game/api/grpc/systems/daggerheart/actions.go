@@ -12,6 +12,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/platform/id"
 	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/character"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/session"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/snapshot"
@@ -23,6 +24,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func (s *DaggerheartService) ApplyDamage(ctx context.Context, in *pb.DaggerheartApplyDamageRequest) (*pb.DaggerheartApplyDamageResponse, error) {
@@ -114,7 +116,7 @@ func (s *DaggerheartService) ApplyDamage(ctx context.Context, in *pb.Daggerheart
 			return nil, status.Error(codes.InvalidArgument, "roll_seq must reference action.damage_roll_resolved")
 		}
 		var rollPayload daggerheart.DamageRollResolvedPayload
-		if err := json.Unmarshal(rollEvent.PayloadJSON, &rollPayload); err != nil {
+		if err := event.DecodePayload(rollEvent, &rollPayload); err != nil {
 			return nil, status.Errorf(codes.Internal, "decode damage roll payload: %v", err)
 		}
 		if rollPayload.CharacterID != characterID && !containsString(sourceCharacterIDs, rollPayload.CharacterID) {
@@ -139,6 +141,7 @@ func (s *DaggerheartService) ApplyDamage(ctx context.Context, in *pb.Daggerheart
 		Direct:             in.Damage.Direct,
 		MassiveDamage:      in.Damage.MassiveDamage,
 		Mitigated:          mitigated,
+		ArmorDeclined:      in.Damage.DeclineArmor,
 		Source:             in.Damage.Source,
 		SourceCharacterIDs: sourceCharacterIDs,
 	}
@@ -169,6 +172,16 @@ func (s *DaggerheartService) ApplyDamage(ctx context.Context, in *pb.Daggerheart
 	if err := adapter.ApplyEvent(ctx, stored); err != nil {
 		return nil, status.Errorf(codes.Internal, "apply event: %v", err)
 	}
+	if state.BeastformID != "" && daggerheart.DropsBeastform(hpBefore, hpAfter) {
+		if err := s.appendBeastformExited(ctx, c, sessionID, characterID, state.BeastformID, daggerheart.BeastformExitLastHP); err != nil {
+			return nil, err
+		}
+	}
+	if result.Result.Severity >= daggerheart.DamageMajor {
+		if err := s.tickCountdowns(ctx, c, sessionID, daggerheart.CountdownTriggerMajorDamage, ""); err != nil {
+			return nil, err
+		}
+	}
 
 	updated, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
 	if err != nil {
@@ -241,6 +254,38 @@ func (s *DaggerheartService) ApplyAdversaryDamage(ctx context.Context, in *pb.Da
 	if err != nil {
 		return nil, handleDomainError(err)
 	}
+	minionDefeated := adversary.MinionThreshold > 0 && result.HPBefore > 0 && result.HPAfter == 0
+	minionOverflow := 0
+	if adversary.MinionThreshold > 0 {
+		adjusted, _ := resistAdversaryDamage(in.Damage)
+		minionOverflow = daggerheart.MinionOverflow(adjusted, adversary.MinionThreshold)
+	}
+	overflowIDs := normalizeTargets(in.GetMinionOverflowIds())
+	overflowMinions := make([]storage.DaggerheartAdversary, 0, len(overflowIDs))
+	if len(overflowIDs) > 0 {
+		if adversary.MinionThreshold <= 0 {
+			return nil, status.Error(codes.FailedPrecondition, daggerheart.ErrNotMinion.Error())
+		}
+		if len(overflowIDs) > minionOverflow {
+			return nil, status.Errorf(codes.InvalidArgument, "%v: %d allowed", daggerheart.ErrMinionOverflowExceeded, minionOverflow)
+		}
+		for _, overflowID := range overflowIDs {
+			if overflowID == adversaryID {
+				return nil, status.Error(codes.InvalidArgument, "minion overflow ids must not include the target adversary")
+			}
+			minion, err := s.loadAdversaryForSession(ctx, campaignID, sessionID, overflowID)
+			if err != nil {
+				return nil, err
+			}
+			if minion.MinionThreshold <= 0 {
+				return nil, status.Errorf(codes.FailedPrecondition, "minion overflow target %s: %v", overflowID, daggerheart.ErrNotMinion)
+			}
+			if minion.HP <= 0 {
+				return nil, status.Errorf(codes.FailedPrecondition, "minion overflow target %s is already defeated", overflowID)
+			}
+			overflowMinions = append(overflowMinions, minion)
+		}
+	}
 
 	hpBefore := result.HPBefore
 	hpAfter := result.HPAfter
@@ -265,7 +310,7 @@ func (s *DaggerheartService) ApplyAdversaryDamage(ctx context.Context, in *pb.Da
 			return nil, status.Error(codes.InvalidArgument, "roll_seq must reference action.damage_roll_resolved")
 		}
 		var rollPayload daggerheart.DamageRollResolvedPayload
-		if err := json.Unmarshal(rollEvent.PayloadJSON, &rollPayload); err != nil {
+		if err := event.DecodePayload(rollEvent, &rollPayload); err != nil {
 			return nil, status.Errorf(codes.Internal, "decode damage roll payload: %v", err)
 		}
 		if len(sourceCharacterIDs) > 0 && !containsString(sourceCharacterIDs, rollPayload.CharacterID) {
@@ -293,6 +338,7 @@ func (s *DaggerheartService) ApplyAdversaryDamage(ctx context.Context, in *pb.Da
 		Mitigated:          mitigated,
 		Source:             in.Damage.Source,
 		SourceCharacterIDs: sourceCharacterIDs,
+		MinionDefeated:     minionDefeated,
 	}
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
@@ -322,14 +368,63 @@ func (s *DaggerheartService) ApplyAdversaryDamage(ctx context.Context, in *pb.Da
 		return nil, status.Errorf(codes.Internal, "apply event: %v", err)
 	}
 
+	var defeatedIDs []string
+	if minionDefeated {
+		defeatedIDs = append(defeatedIDs, adversaryID)
+	}
+	for _, minion := range overflowMinions {
+		minionHPBefore := minion.HP
+		minionHPAfter := 0
+		overflowPayload := daggerheart.AdversaryDamageAppliedPayload{
+			AdversaryID:        minion.AdversaryID,
+			HpBefore:           &minionHPBefore,
+			HpAfter:            &minionHPAfter,
+			Severity:           daggerheartSeverityToString(daggerheart.DamageMinor),
+			Marks:              minionHPBefore,
+			DamageType:         daggerheartDamageTypeToString(in.Damage.DamageType),
+			RollSeq:            rollSeq,
+			Source:             "minion_overflow",
+			SourceCharacterIDs: sourceCharacterIDs,
+			MinionDefeated:     true,
+			OverflowFromID:     adversaryID,
+		}
+		overflowJSON, err := json.Marshal(overflowPayload)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "encode minion overflow payload: %v", err)
+		}
+		overflowStored, err := s.stores.Event.AppendEvent(ctx, event.Event{
+			CampaignID:    campaignID,
+			Timestamp:     time.Now().UTC(),
+			Type:          daggerheart.EventTypeAdversaryDamageApplied,
+			SessionID:     sessionID,
+			RequestID:     grpcmeta.RequestIDFromContext(ctx),
+			InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
+			ActorType:     event.ActorTypeSystem,
+			EntityType:    "adversary",
+			EntityID:      minion.AdversaryID,
+			SystemID:      c.System.String(),
+			SystemVersion: daggerheart.SystemVersion,
+			PayloadJSON:   overflowJSON,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "append minion overflow event: %v", err)
+		}
+		if err := adapter.ApplyEvent(ctx, overflowStored); err != nil {
+			return nil, status.Errorf(codes.Internal, "apply minion overflow event: %v", err)
+		}
+		defeatedIDs = append(defeatedIDs, minion.AdversaryID)
+	}
+
 	updated, err := s.stores.Daggerheart.GetDaggerheartAdversary(ctx, campaignID, adversaryID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "load daggerheart adversary: %v", err)
 	}
 
 	return &pb.DaggerheartApplyAdversaryDamageResponse{
-		AdversaryId: adversaryID,
-		Adversary:   daggerheartAdversaryToProto(updated),
+		AdversaryId:          adversaryID,
+		Adversary:            daggerheartAdversaryToProto(updated),
+		MinionOverflow:       int32(minionOverflow),
+		DefeatedAdversaryIds: defeatedIDs,
 	}, nil
 }
 
@@ -452,6 +547,24 @@ func (s *DaggerheartService) ApplyRest(ctx context.Context, in *pb.DaggerheartAp
 		return nil, status.Errorf(codes.Internal, "apply event: %v", err)
 	}
 
+	if outcome.RefreshRest {
+		triggers := []string{daggerheart.EffectExpiresRest}
+		if outcome.RefreshLongRest {
+			triggers = append(triggers, daggerheart.EffectExpiresLongRest)
+		}
+		if err := s.expireEffectsOn(ctx, c, sessionID, "", triggers...); err != nil {
+			return nil, err
+		}
+		if outcome.RefreshLongRest {
+			if err := s.refreshAdversaryFeatures(ctx, c, sessionID, daggerheart.AdversaryFeatureRefreshLongRest); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.tickCountdowns(ctx, c, sessionID, daggerheart.CountdownTriggerRest, ""); err != nil {
+			return nil, err
+		}
+	}
+
 	if outcome.AdvanceCountdown && longTermCountdownID != "" {
 		if _, err := s.UpdateCountdown(ctx, &pb.DaggerheartUpdateCountdownRequest{
 			CampaignId:  campaignID,
@@ -706,6 +819,16 @@ func (s *DaggerheartService) SwapLoadout(ctx context.Context, in *pb.Daggerheart
 		LifeState:   current.LifeState,
 	})
 
+	loadout := daggerheart.Loadout{Active: profile.LoadoutActive, Vault: profile.LoadoutVault}
+	if containsString(loadout.Vault, in.Swap.CardId) {
+		if _, err := loadout.MoveToActive(in.Swap.CardId); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+	}
+	if err := s.ensureDomainCardAccess(ctx, profile, in.Swap.CardId); err != nil {
+		return nil, err
+	}
+
 	stressBefore := state.Stress()
 	if !in.Swap.InRest && in.Swap.RecallCost > 0 {
 		if _, _, err := state.SpendResource(daggerheart.ResourceStress, int(in.Swap.RecallCost)); err != nil {
@@ -1573,6 +1696,11 @@ func (s *DaggerheartService) ApplyGmMove(ctx context.Context, in *pb.Daggerheart
 	if err := adapter.ApplyEvent(ctx, stored); err != nil {
 		return nil, status.Errorf(codes.Internal, "apply gm move event: %v", err)
 	}
+	if fearSpent > 0 {
+		if err := s.tickCountdowns(ctx, c, sessionID, daggerheart.CountdownTriggerFearSpent, ""); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pb.DaggerheartApplyGmMoveResponse{
 		CampaignId:   campaignID,
@@ -1627,6 +1755,10 @@ func (s *DaggerheartService) CreateCountdown(ctx context.Context, in *pb.Daggerh
 	if current < 0 || current > max {
 		return nil, status.Errorf(codes.InvalidArgument, "current must be in range 0..%d", max)
 	}
+	tickPolicy, err := daggerheartCountdownTickPolicyFromProto(in.GetTickPolicy())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	c, err := s.stores.Campaign.Get(ctx, campaignID)
 	if err != nil {
@@ -1664,13 +1796,16 @@ func (s *DaggerheartService) CreateCountdown(ctx context.Context, in *pb.Daggerh
 	}
 
 	payload := daggerheart.CountdownCreatedPayload{
-		CountdownID: countdownID,
-		Name:        name,
-		Kind:        kind,
-		Current:     current,
-		Max:         max,
-		Direction:   direction,
-		Looping:     in.GetLooping(),
+		CountdownID:       countdownID,
+		Name:              name,
+		Kind:              kind,
+		Current:           current,
+		Max:               max,
+		Direction:         direction,
+		Looping:           in.GetLooping(),
+		TickTrigger:       tickPolicy.Trigger,
+		TickDelta:         tickPolicy.Delta,
+		TickOutcomeDeltas: tickPolicy.OutcomeDeltas,
 	}
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
@@ -1770,16 +1905,7 @@ func (s *DaggerheartService) UpdateCountdown(ctx context.Context, in *pb.Daggerh
 		return nil, handleDomainError(err)
 	}
 
-	countdown := daggerheart.Countdown{
-		CampaignID: storedCountdown.CampaignID,
-		ID:         storedCountdown.CountdownID,
-		Name:       storedCountdown.Name,
-		Kind:       storedCountdown.Kind,
-		Current:    storedCountdown.Current,
-		Max:        storedCountdown.Max,
-		Direction:  storedCountdown.Direction,
-		Looping:    storedCountdown.Looping,
-	}
+	countdown := countdownFromStorage(storedCountdown)
 	var override *int
 	if in.Current != nil {
 		value := int(in.GetCurrent())
@@ -1824,6 +1950,11 @@ func (s *DaggerheartService) UpdateCountdown(ctx context.Context, in *pb.Daggerh
 	if err := adapter.ApplyEvent(ctx, stored); err != nil {
 		return nil, status.Errorf(codes.Internal, "apply countdown update event: %v", err)
 	}
+	if update.Delta != 0 && update.After == daggerheart.CountdownEnd(countdown) {
+		if err := s.resolveChases(ctx, c, sessionID, map[string]bool{countdownID: true}); err != nil {
+			return nil, err
+		}
+	}
 
 	updatedCountdown, err := s.stores.Daggerheart.GetDaggerheartCountdown(ctx, campaignID, countdownID)
 	if err != nil {
@@ -2114,6 +2245,9 @@ func (s *DaggerheartService) SessionActionRoll(ctx context.Context, in *pb.Sessi
 	if trait == "" {
 		return nil, status.Error(codes.InvalidArgument, "trait is required")
 	}
+	if len(in.GetExperiences()) > 0 && hasExperienceModifier(in.GetModifiers()) {
+		return nil, status.Error(codes.InvalidArgument, "experiences cannot be combined with an experience modifier")
+	}
 
 	c, err := s.stores.Campaign.Get(ctx, campaignID)
 	if err != nil {
@@ -2152,10 +2286,62 @@ func (s *DaggerheartService) SessionActionRoll(ctx context.Context, in *pb.Sessi
 	if disadvantage < 0 {
 		disadvantage = 0
 	}
+	advantageSources, err := advantageSourcesFromProto(in.GetAdvantageSources())
+	if err != nil {
+		return nil, err
+	}
 	if in.GetUnderwater() && rollKind == pb.RollKind_ROLL_KIND_ACTION {
-		disadvantage++
+		advantageSources = append(advantageSources, daggerheartdomain.AdvantageSource{
+			Kind:         daggerheartdomain.AdvantageSourceCondition,
+			Name:         "Underwater",
+			Disadvantage: true,
+		})
 	}
 	hopeSpends := hopeSpendsFromModifiers(in.GetModifiers())
+	experiences, err := s.resolveRollExperiences(ctx, campaignID, characterID, in.GetExperiences())
+	if err != nil {
+		return nil, err
+	}
+	experienceBonus := 0
+	for _, experience := range experiences {
+		hopeSpends = append(hopeSpends, hopeSpend{Source: "experience", Amount: 1})
+		experienceBonus += experience.Modifier
+		modifierList = append(modifierList, map[string]any{
+			"value":      experience.Modifier,
+			"source":     "experience",
+			"experience": experience.Name,
+		})
+	}
+	modifierTotal += experienceBonus
+	form, err := s.activeBeastform(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	if form != nil {
+		if bonus := beastformFromStorage(*form).TraitBonusFor(trait); bonus != 0 {
+			modifierTotal += bonus
+			modifierList = append(modifierList, map[string]any{
+				"value":     bonus,
+				"source":    "beastform",
+				"beastform": form.ID,
+			})
+		}
+	}
+	effectBonus, err := s.effectBonus(ctx, campaignID, daggerheart.EffectTargetCharacter, characterID, daggerheart.EffectKindRoll)
+	if err != nil {
+		return nil, err
+	}
+	if effectBonus != 0 {
+		modifierTotal += effectBonus
+		modifierList = append(modifierList, map[string]any{
+			"value":  effectBonus,
+			"source": "effect",
+		})
+	}
+	hopeDieModifier, err := s.effectBonus(ctx, campaignID, daggerheart.EffectTargetCharacter, characterID, daggerheart.EffectKindHopeDie)
+	if err != nil {
+		return nil, err
+	}
 	spendEventCount := 0
 	totalSpend := 0
 	for _, spend := range hopeSpends {
@@ -2167,6 +2353,23 @@ func (s *DaggerheartService) SessionActionRoll(ctx context.Context, in *pb.Sessi
 	if rollKind == pb.RollKind_ROLL_KIND_REACTION && spendEventCount > 0 {
 		return nil, status.Error(codes.InvalidArgument, "reaction rolls cannot spend hope")
 	}
+	helperIDs, err := normalizeHelperIDs(in.GetHelperCharacterIds(), characterID)
+	if err != nil {
+		return nil, err
+	}
+	if rollKind == pb.RollKind_ROLL_KIND_REACTION && len(helperIDs) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "reaction rolls cannot be helped")
+	}
+	if rollKind == pb.RollKind_ROLL_KIND_REACTION && in.GetExtraFearDie() {
+		return nil, status.Error(codes.InvalidArgument, "reaction rolls cannot roll an extra fear die")
+	}
+	helperHope, err := s.loadHelperHope(ctx, campaignID, helperIDs)
+	if err != nil {
+		return nil, err
+	}
+	if rollKind == pb.RollKind_ROLL_KIND_ACTION && state.Hope < totalSpend {
+		return nil, status.Error(codes.FailedPrecondition, "insufficient hope")
+	}
 	statePatchNeeded := totalSpend > 0
 
 	latestSeq, err := s.stores.Event.GetLatestEventSeq(ctx, campaignID)
@@ -2177,6 +2380,8 @@ func (s *DaggerheartService) SessionActionRoll(ctx context.Context, in *pb.Sessi
 	if statePatchNeeded {
 		preEvents++
 	}
+	// Each helper records a hope spend and a state patch.
+	preEvents += 2 * len(helperIDs)
 	rollSeq := latestSeq + uint64(preEvents) + 1
 
 	seed, seedSource, rollMode, err := random.ResolveSeed(
@@ -2191,83 +2396,23 @@ func (s *DaggerheartService) SessionActionRoll(ctx context.Context, in *pb.Sessi
 		return nil, status.Errorf(codes.Internal, "failed to resolve seed: %v", err)
 	}
 
+	var spendLog []map[string]any
 	if rollKind == pb.RollKind_ROLL_KIND_ACTION && spendEventCount > 0 {
-		hopeBefore := state.Hope
-		hopeAfter := hopeBefore
-		if hopeBefore < totalSpend {
-			return nil, status.Error(codes.FailedPrecondition, "insufficient hope")
+		if err := s.appendHopeSpends(ctx, c.System.String(), campaignID, sessionID, characterID, state.Hope, hopeSpends, rollSeq); err != nil {
+			return nil, err
 		}
-
 		for _, spend := range hopeSpends {
-			if spend.Amount <= 0 {
-				continue
-			}
-			before := hopeAfter
-			after := before - spend.Amount
-			payload := daggerheart.HopeSpentPayload{
-				CharacterID: characterID,
-				Amount:      spend.Amount,
-				Before:      before,
-				After:       after,
-				RollSeq:     &rollSeq,
-				Source:      spend.Source,
-			}
-			payloadJSON, err := json.Marshal(payload)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "encode hope spend payload: %v", err)
-			}
-			if _, err := s.stores.Event.AppendEvent(ctx, event.Event{
-				CampaignID:    campaignID,
-				Timestamp:     time.Now().UTC(),
-				Type:          daggerheart.EventTypeHopeSpent,
-				SessionID:     sessionID,
-				RequestID:     grpcmeta.RequestIDFromContext(ctx),
-				InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
-				ActorType:     event.ActorTypeSystem,
-				EntityType:    "character",
-				EntityID:      characterID,
-				SystemID:      c.System.String(),
-				SystemVersion: daggerheart.SystemVersion,
-				PayloadJSON:   payloadJSON,
-			}); err != nil {
-				return nil, status.Errorf(codes.Internal, "append hope spend event: %v", err)
+			if spend.Amount > 0 {
+				spendLog = append(spendLog, map[string]any{"character_id": characterID, "source": spend.Source, "amount": spend.Amount})
 			}
-			hopeAfter = after
 		}
-
-		if hopeAfter != hopeBefore {
-			statePatchNeeded = true
-			payload := daggerheart.CharacterStatePatchedPayload{
-				CharacterID: characterID,
-				HopeBefore:  &hopeBefore,
-				HopeAfter:   &hopeAfter,
-			}
-			payloadJSON, err := json.Marshal(payload)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "encode character state payload: %v", err)
-			}
-			storedState, err := s.stores.Event.AppendEvent(ctx, event.Event{
-				CampaignID:    campaignID,
-				Timestamp:     time.Now().UTC(),
-				Type:          daggerheart.EventTypeCharacterStatePatched,
-				SessionID:     sessionID,
-				RequestID:     grpcmeta.RequestIDFromContext(ctx),
-				InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
-				ActorType:     event.ActorTypeSystem,
-				EntityType:    "character",
-				EntityID:      characterID,
-				SystemID:      c.System.String(),
-				SystemVersion: daggerheart.SystemVersion,
-				PayloadJSON:   payloadJSON,
-			})
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "append character state event: %v", err)
-			}
-			adapter := daggerheart.NewAdapter(s.stores.Daggerheart)
-			if err := adapter.ApplyEvent(ctx, storedState); err != nil {
-				return nil, status.Errorf(codes.Internal, "apply character state event: %v", err)
-			}
+	}
+	helpSpend := []hopeSpend{{Source: "help", Amount: 1}}
+	for i, helperID := range helperIDs {
+		if err := s.appendHopeSpends(ctx, c.System.String(), campaignID, sessionID, helperID, helperHope[i], helpSpend, rollSeq); err != nil {
+			return nil, err
 		}
+		spendLog = append(spendLog, map[string]any{"character_id": helperID, "source": "help", "amount": 1})
 	}
 
 	difficulty := int(in.GetDifficulty())
@@ -2279,6 +2424,10 @@ func (s *DaggerheartService) SessionActionRoll(ctx context.Context, in *pb.Sessi
 			Seed:         seed,
 			Advantage:    advantage,
 			Disadvantage: disadvantage,
+			Sources:      advantageSources,
+			HelpDice:     len(helperIDs),
+			ExtraFearDie: in.GetExtraFearDie(),
+			HopeDieSides: daggerheart.HopeDieSides(hopeDieModifier),
 		},
 	)
 	if err != nil {
@@ -2295,6 +2444,20 @@ func (s *DaggerheartService) SessionActionRoll(ctx context.Context, in *pb.Sessi
 		flavor = ""
 	}
 
+	diceResults := map[string]any{
+		"hope_die":      result.Hope,
+		"fear_die":      result.Fear,
+		"advantage_die": result.AdvantageDie,
+	}
+	if len(result.HelpDice) > 0 {
+		diceResults["help_dice"] = result.HelpDice
+	}
+	if len(result.FearDice) > 0 {
+		diceResults["fear_dice"] = result.FearDice
+	}
+	if result.HopeDieSides != 12 {
+		diceResults["hope_die_sides"] = result.HopeDieSides
+	}
 	results := map[string]any{
 		"rng": map[string]any{
 			"seed_used":   uint64(seed),
@@ -2302,11 +2465,7 @@ func (s *DaggerheartService) SessionActionRoll(ctx context.Context, in *pb.Sessi
 			"seed_source": seedSource,
 			"roll_mode":   rollModeLabel,
 		},
-		"dice": map[string]any{
-			"hope_die":      result.Hope,
-			"fear_die":      result.Fear,
-			"advantage_die": result.AdvantageDie,
-		},
+		"dice":               diceResults,
 		"modifier":           result.Modifier,
 		"advantage_modifier": result.AdvantageModifier,
 		"total":              result.Total,
@@ -2328,13 +2487,40 @@ func (s *DaggerheartService) SessionActionRoll(ctx context.Context, in *pb.Sessi
 		"hope_fear":    generateHopeFear,
 		"gm_move":      triggerGMMove,
 		"crit_negates": critNegatesEffects,
-		"advantage":    advantage,
-		"disadvantage": disadvantage,
+		"advantage":    result.AdvantagePool.Advantage,
+		"disadvantage": result.AdvantagePool.Disadvantage,
 		"underwater":   in.GetUnderwater(),
 	}
+	if sources := advantageSourcesPayload(result.AdvantagePool.Sources); len(sources) > 0 {
+		systemData["advantage_sources"] = sources
+	}
 	if len(modifierList) > 0 {
 		systemData["modifiers"] = modifierList
 	}
+	if len(experiences) > 0 {
+		entries := make([]map[string]any, 0, len(experiences))
+		for _, experience := range experiences {
+			entries = append(entries, map[string]any{"name": experience.Name, "modifier": experience.Modifier})
+		}
+		systemData["experiences"] = entries
+	}
+	if len(helperIDs) > 0 {
+		entries := make([]map[string]any, 0, len(helperIDs))
+		for i, helperID := range helperIDs {
+			entry := map[string]any{"character_id": helperID}
+			// Helper dice cancelled by disadvantage are never rolled.
+			if i < len(result.HelpDice) {
+				entry["die"] = result.HelpDice[i]
+			} else {
+				entry["cancelled"] = true
+			}
+			entries = append(entries, entry)
+		}
+		systemData["helpers"] = entries
+	}
+	if len(spendLog) > 0 {
+		systemData["hope_spends"] = spendLog
+	}
 	if countdownID := strings.TrimSpace(in.GetBreathCountdownId()); countdownID != "" {
 		systemData["breath_countdown_id"] = countdownID
 	}
@@ -2373,16 +2559,25 @@ func (s *DaggerheartService) SessionActionRoll(ctx context.Context, in *pb.Sessi
 	if err := s.advanceBreathCountdown(ctx, campaignID, sessionID, strings.TrimSpace(in.GetBreathCountdownId()), failed); err != nil {
 		return nil, err
 	}
+	if rollKind == pb.RollKind_ROLL_KIND_ACTION {
+		if err := s.tickCountdowns(ctx, c, sessionID, daggerheart.CountdownTriggerActionRoll, outcomeCode); err != nil {
+			return nil, err
+		}
+	}
 
 	return &pb.SessionActionRollResponse{
-		RollSeq:    stored.Seq,
-		HopeDie:    int32(result.Hope),
-		FearDie:    int32(result.Fear),
-		Total:      int32(result.Total),
-		Difficulty: int32(difficulty),
-		Success:    result.MeetsDifficulty,
-		Flavor:     flavor,
-		Crit:       result.IsCrit,
+		RollSeq:         stored.Seq,
+		HopeDie:         int32(result.Hope),
+		FearDie:         int32(result.Fear),
+		Total:           int32(result.Total),
+		Difficulty:      int32(difficulty),
+		Success:         result.MeetsDifficulty,
+		Flavor:          flavor,
+		Crit:            result.IsCrit,
+		HelperDice:      int32Slice(result.HelpDice),
+		HelpBonus:       int32(helpBonus(result)),
+		ExperienceBonus: int32(experienceBonus),
+		FearDice:        int32Slice(result.FearDice),
 		Rng: &commonv1.RngResponse{
 			SeedUsed:   uint64(seed),
 			RngAlgo:    random.RngAlgoMathRandV1,
@@ -2424,9 +2619,6 @@ func (s *DaggerheartService) SessionDamageRoll(ctx context.Context, in *pb.Sessi
 	if characterID == "" {
 		return nil, status.Error(codes.InvalidArgument, "character id is required")
 	}
-	if len(in.GetDice()) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "dice are required")
-	}
 
 	c, err := s.stores.Campaign.Get(ctx, campaignID)
 	if err != nil {
@@ -2450,10 +2642,31 @@ func (s *DaggerheartService) SessionDamageRoll(ctx context.Context, in *pb.Sessi
 		return nil, err
 	}
 
-	diceSpecs, err := damageDiceFromProto(in.GetDice())
+	weaponID := strings.TrimSpace(in.GetWeaponId())
+	var diceSpecs []daggerheart.DamageDieSpec
+	modifier := int(in.GetModifier())
+	if len(in.GetDice()) == 0 {
+		weapon, err := s.resolveAttackWeapon(ctx, campaignID, characterID, weaponID, pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED)
+		if err != nil {
+			return nil, err
+		}
+		if weapon == nil {
+			return nil, status.Error(codes.InvalidArgument, "dice are required")
+		}
+		weaponID = weapon.id
+		diceSpecs = weapon.dice
+		modifier += weapon.damageBonus
+	} else {
+		diceSpecs, err = damageDiceFromProto(in.GetDice())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	damageBonus, err := s.effectBonus(ctx, campaignID, daggerheart.EffectTargetCharacter, characterID, daggerheart.EffectKindDamage)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
+	modifier += damageBonus
 
 	latestSeq, err := s.stores.Event.GetLatestEventSeq(ctx, campaignID)
 	if err != nil {
@@ -2475,7 +2688,7 @@ func (s *DaggerheartService) SessionDamageRoll(ctx context.Context, in *pb.Sessi
 
 	result, err := daggerheart.RollDamage(daggerheart.DamageRollRequest{
 		Dice:     diceSpecs,
-		Modifier: int(in.GetModifier()),
+		Modifier: modifier,
 		Seed:     seed,
 		Critical: in.GetCritical(),
 	})
@@ -2502,6 +2715,7 @@ func (s *DaggerheartService) SessionDamageRoll(ctx context.Context, in *pb.Sessi
 			SeedSource: seedSource,
 			RollMode:   rollModeLabel,
 		},
+		WeaponID: weaponID,
 	}
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
@@ -2525,6 +2739,15 @@ func (s *DaggerheartService) SessionDamageRoll(ctx context.Context, in *pb.Sessi
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "append event: %v", err)
 	}
+	if damageBonus != 0 {
+		if err := s.expireEffects(ctx, c, sessionID, func(effect storage.DaggerheartEffect) bool {
+			return effect.TargetID == characterID &&
+				effect.Kind == daggerheart.EffectKindDamage &&
+				effect.ExpiresOn == daggerheart.EffectExpiresNextAttack
+		}, daggerheart.EffectExpiresNextAttack); err != nil {
+			return nil, err
+		}
+	}
 
 	response := &pb.SessionDamageRollResponse{
 		RollSeq:       stored.Seq,
@@ -2540,6 +2763,7 @@ func (s *DaggerheartService) SessionDamageRoll(ctx context.Context, in *pb.Sessi
 			SeedSource: seedSource,
 			RollMode:   rollMode,
 		},
+		WeaponId: weaponID,
 	}
 
 	return response, nil
@@ -2578,19 +2802,64 @@ func (s *DaggerheartService) SessionAttackFlow(ctx context.Context, in *pb.Sessi
 		return nil, status.Error(codes.InvalidArgument, "character id is required")
 	}
 	trait := strings.TrimSpace(in.GetTrait())
-	if trait == "" {
-		return nil, status.Error(codes.InvalidArgument, "trait is required")
-	}
 	targetID := strings.TrimSpace(in.GetTargetId())
 	if targetID == "" {
 		return nil, status.Error(codes.InvalidArgument, "target id is required")
 	}
-	if in.GetDamage() == nil {
+	weaponID := strings.TrimSpace(in.GetWeaponId())
+	if in.GetImprovised() && weaponID != "" {
+		return nil, status.Error(codes.InvalidArgument, "improvised attacks cannot use a weapon")
+	}
+
+	// The weapon supplies the trait, damage dice and damage type; explicit
+	// request values override it.
+	var weapon *attackWeapon
+	if !in.GetImprovised() {
+		var err error
+		weapon, err = s.resolveAttackWeapon(ctx, campaignID, attackerID, weaponID, in.GetWeaponSlot())
+		if err != nil {
+			return nil, err
+		}
+	}
+	modifiers := in.GetModifiers()
+	if trait == "" && weapon != nil {
+		trait = weapon.trait
+		source := "weapon_trait"
+		if weapon.beastformID != "" {
+			source = "beastform_trait"
+		}
+		modifiers = append([]*pb.ActionRollModifier{{Value: int32(weapon.traitValue), Source: source}}, modifiers...)
+	}
+	if trait == "" {
+		return nil, status.Error(codes.InvalidArgument, "trait is required")
+	}
+	damageSpec := in.GetDamage()
+	if damageSpec == nil && weapon == nil {
 		return nil, status.Error(codes.InvalidArgument, "damage is required")
 	}
-	if in.GetDamage().GetDamageType() == pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_UNSPECIFIED {
+	damageType := damageSpec.GetDamageType()
+	if damageType == pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_UNSPECIFIED && weapon != nil {
+		damageType = weapon.damageType
+	}
+	if damageType == pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_UNSPECIFIED {
 		return nil, status.Error(codes.InvalidArgument, "damage_type is required")
 	}
+	sourceCharacterIDs := normalizeTargets(damageSpec.GetSourceCharacterIds())
+	if damageSpec == nil {
+		sourceCharacterIDs = []string{attackerID}
+	}
+	damageDice := in.GetDamageDice()
+	damageModifier := in.GetDamageModifier()
+	if weapon != nil {
+		weaponID = weapon.id
+		if len(damageDice) == 0 {
+			damageDice = weapon.diceToProto()
+			damageModifier += int32(weapon.damageBonus)
+		}
+		if err := s.ensureTargetInReach(ctx, campaignID, sessionID, attackerID, targetID, weapon.reach); err != nil {
+			return nil, err
+		}
+	}
 
 	rollResp, err := s.SessionActionRoll(ctx, &pb.SessionActionRollRequest{
 		CampaignId:        campaignID,
@@ -2599,7 +2868,7 @@ func (s *DaggerheartService) SessionAttackFlow(ctx context.Context, in *pb.Sessi
 		Trait:             trait,
 		RollKind:          pb.RollKind_ROLL_KIND_ACTION,
 		Difficulty:        in.GetDifficulty(),
-		Modifiers:         in.GetModifiers(),
+		Modifiers:         modifiers,
 		Underwater:        in.GetUnderwater(),
 		BreathCountdownId: in.GetBreathCountdownId(),
 		Rng:               in.GetActionRng(),
@@ -2621,6 +2890,7 @@ func (s *DaggerheartService) SessionAttackFlow(ctx context.Context, in *pb.Sessi
 		SessionId: sessionID,
 		RollSeq:   rollResp.GetRollSeq(),
 		Targets:   []string{targetID},
+		WeaponId:  weaponID,
 	})
 	if err != nil {
 		return nil, err
@@ -2630,13 +2900,14 @@ func (s *DaggerheartService) SessionAttackFlow(ctx context.Context, in *pb.Sessi
 		ActionRoll:    rollResp,
 		RollOutcome:   rollOutcome,
 		AttackOutcome: attackOutcome,
+		WeaponId:      weaponID,
 	}
 
 	if attackOutcome.GetResult() == nil || !attackOutcome.GetResult().GetSuccess() {
 		return response, nil
 	}
 
-	if len(in.GetDamageDice()) == 0 {
+	if len(damageDice) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "damage_dice are required")
 	}
 
@@ -2645,10 +2916,11 @@ func (s *DaggerheartService) SessionAttackFlow(ctx context.Context, in *pb.Sessi
 		CampaignId:  campaignID,
 		SessionId:   sessionID,
 		CharacterId: attackerID,
-		Dice:        in.GetDamageDice(),
-		Modifier:    in.GetDamageModifier(),
+		Dice:        damageDice,
+		Modifier:    damageModifier,
 		Critical:    critical,
 		Rng:         in.GetDamageRng(),
+		WeaponId:    weaponID,
 	})
 	if err != nil {
 		return nil, err
@@ -2656,15 +2928,15 @@ func (s *DaggerheartService) SessionAttackFlow(ctx context.Context, in *pb.Sessi
 
 	damageReq := &pb.DaggerheartDamageRequest{
 		Amount:             damageRoll.GetTotal(),
-		DamageType:         in.GetDamage().GetDamageType(),
-		ResistPhysical:     in.GetDamage().GetResistPhysical(),
-		ResistMagic:        in.GetDamage().GetResistMagic(),
-		ImmunePhysical:     in.GetDamage().GetImmunePhysical(),
-		ImmuneMagic:        in.GetDamage().GetImmuneMagic(),
-		Direct:             in.GetDamage().GetDirect(),
-		MassiveDamage:      in.GetDamage().GetMassiveDamage(),
-		Source:             in.GetDamage().GetSource(),
-		SourceCharacterIds: normalizeTargets(in.GetDamage().GetSourceCharacterIds()),
+		DamageType:         damageType,
+		ResistPhysical:     damageSpec.GetResistPhysical(),
+		ResistMagic:        damageSpec.GetResistMagic(),
+		ImmunePhysical:     damageSpec.GetImmunePhysical(),
+		ImmuneMagic:        damageSpec.GetImmuneMagic(),
+		Direct:             damageSpec.GetDirect(),
+		MassiveDamage:      damageSpec.GetMassiveDamage(),
+		Source:             damageSpec.GetSource(),
+		SourceCharacterIds: sourceCharacterIDs,
 	}
 
 	applyDamage, err := s.ApplyDamage(ctxWithMeta, &pb.DaggerheartApplyDamageRequest{
@@ -2683,9 +2955,9 @@ func (s *DaggerheartService) SessionAttackFlow(ctx context.Context, in *pb.Sessi
 	return response, nil
 }
 
-func (s *DaggerheartService) SessionReactionFlow(ctx context.Context, in *pb.SessionReactionFlowRequest) (*pb.SessionReactionFlowResponse, error) {
+func (s *DaggerheartService) SessionMultiAttackFlow(ctx context.Context, in *pb.SessionMultiAttackFlowRequest) (*pb.SessionMultiAttackFlowResponse, error) {
 	if in == nil {
-		return nil, status.Error(codes.InvalidArgument, "session reaction flow request is required")
+		return nil, status.Error(codes.InvalidArgument, "session multi attack flow request is required")
 	}
 	if s.stores.Campaign == nil {
 		return nil, status.Error(codes.Internal, "campaign store is not configured")
@@ -2711,24 +2983,51 @@ func (s *DaggerheartService) SessionReactionFlow(ctx context.Context, in *pb.Ses
 	if sessionID == "" {
 		return nil, status.Error(codes.InvalidArgument, "session id is required")
 	}
-	actorID := strings.TrimSpace(in.GetCharacterId())
-	if actorID == "" {
+	attackerID := strings.TrimSpace(in.GetCharacterId())
+	if attackerID == "" {
 		return nil, status.Error(codes.InvalidArgument, "character id is required")
 	}
 	trait := strings.TrimSpace(in.GetTrait())
 	if trait == "" {
 		return nil, status.Error(codes.InvalidArgument, "trait is required")
 	}
+	if err := validateMultiAttackDamage(in.GetDamage(), in.GetDamageDice()); err != nil {
+		return nil, err
+	}
+
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return nil, status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart attacks")
+	}
+
+	targets, err := s.resolveMultiAttackTargets(ctx, campaignID, sessionID, attackerID, in.GetTargets())
+	if err != nil {
+		return nil, err
+	}
+	if weaponID := strings.TrimSpace(in.GetWeaponId()); weaponID != "" {
+		reach, err := s.weaponReach(ctx, weaponID)
+		if err != nil {
+			return nil, err
+		}
+		for _, target := range targets {
+			if err := s.ensureTargetInReach(ctx, campaignID, sessionID, attackerID, target.id, reach); err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	rollResp, err := s.SessionActionRoll(ctx, &pb.SessionActionRollRequest{
 		CampaignId:  campaignID,
 		SessionId:   sessionID,
-		CharacterId: actorID,
+		CharacterId: attackerID,
 		Trait:       trait,
-		RollKind:    pb.RollKind_ROLL_KIND_REACTION,
-		Difficulty:  in.GetDifficulty(),
+		RollKind:    pb.RollKind_ROLL_KIND_ACTION,
+		Difficulty:  int32(daggerheart.MultiAttackRollDifficulty(multiAttackDifficulties(targets))),
 		Modifiers:   in.GetModifiers(),
-		Rng:         in.GetReactionRng(),
+		Rng:         in.GetActionRng(),
 	})
 	if err != nil {
 		return nil, err
@@ -2743,26 +3042,33 @@ func (s *DaggerheartService) SessionReactionFlow(ctx context.Context, in *pb.Ses
 		return nil, err
 	}
 
-	reactionOutcome, err := s.ApplyReactionOutcome(ctxWithMeta, &pb.DaggerheartApplyReactionOutcomeRequest{
-		SessionId: sessionID,
-		RollSeq:   rollResp.GetRollSeq(),
-	})
+	resolution, err := s.resolveMultiAttack(ctx, c, sessionID, multiAttackRoll{
+		attackerID:     attackerID,
+		attackerType:   daggerheart.MultiAttackTargetCharacter,
+		rollSeq:        rollResp.GetRollSeq(),
+		total:          int(rollResp.GetTotal()),
+		crit:           rollResp.GetCrit(),
+		damage:         in.GetDamage(),
+		damageDice:     in.GetDamageDice(),
+		damageModifier: in.GetDamageModifier(),
+		damageCritical: in.GetDamageCritical(),
+		damageRng:      in.GetDamageRng(),
+	}, targets)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &pb.SessionReactionFlowResponse{
-		ActionRoll:      rollResp,
-		RollOutcome:     rollOutcome,
-		ReactionOutcome: reactionOutcome,
-	}
-
-	return response, nil
+	return &pb.SessionMultiAttackFlowResponse{
+		ActionRoll:  rollResp,
+		RollOutcome: rollOutcome,
+		DamageRoll:  resolution.damageRoll,
+		Results:     resolution.results,
+	}, nil
 }
 
-func (s *DaggerheartService) SessionAdversaryAttackRoll(ctx context.Context, in *pb.SessionAdversaryAttackRollRequest) (*pb.SessionAdversaryAttackRollResponse, error) {
+func (s *DaggerheartService) SessionSpellcastFlow(ctx context.Context, in *pb.SessionSpellcastFlowRequest) (*pb.SessionSpellcastFlowResponse, error) {
 	if in == nil {
-		return nil, status.Error(codes.InvalidArgument, "session adversary attack roll request is required")
+		return nil, status.Error(codes.InvalidArgument, "session spellcast flow request is required")
 	}
 	if s.stores.Campaign == nil {
 		return nil, status.Error(codes.Internal, "campaign store is not configured")
@@ -2773,6 +3079,9 @@ func (s *DaggerheartService) SessionAdversaryAttackRoll(ctx context.Context, in
 	if s.stores.Daggerheart == nil {
 		return nil, status.Error(codes.Internal, "daggerheart store is not configured")
 	}
+	if s.stores.DaggerheartContent == nil {
+		return nil, status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
 	if s.stores.Event == nil {
 		return nil, status.Error(codes.Internal, "event store is not configured")
 	}
@@ -2788,9 +3097,284 @@ func (s *DaggerheartService) SessionAdversaryAttackRoll(ctx context.Context, in
 	if sessionID == "" {
 		return nil, status.Error(codes.InvalidArgument, "session id is required")
 	}
-	adversaryID := strings.TrimSpace(in.GetAdversaryId())
-	if adversaryID == "" {
-		return nil, status.Error(codes.InvalidArgument, "adversary id is required")
+	casterID := strings.TrimSpace(in.GetCharacterId())
+	if casterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	cardID := strings.TrimSpace(in.GetDomainCardId())
+	if cardID == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain card id is required")
+	}
+	hopeCost := int(in.GetHopeCost())
+	if hopeCost < 0 {
+		return nil, status.Error(codes.InvalidArgument, daggerheart.ErrInvalidHopeCost.Error())
+	}
+	if in.GetDamageDieSides() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "damage die sides must be non-negative")
+	}
+
+	profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, casterID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if !containsString(profile.LoadoutActive, cardID) {
+		return nil, status.Errorf(codes.FailedPrecondition, "domain card %q is not in the active loadout", cardID)
+	}
+	card, err := s.stores.DaggerheartContent.GetDaggerheartDomainCard(ctx, cardID)
+	if err != nil {
+		return nil, contentLookupError("domain card", cardID, err)
+	}
+	subclassID := daggerheart.SpellcastSubclassID(classSelectionFromProfile(profile), card.DomainID)
+	if subclassID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "character has no subclass")
+	}
+	subclass, err := s.stores.DaggerheartContent.GetDaggerheartSubclass(ctx, subclassID)
+	if err != nil {
+		return nil, contentLookupError("subclass", subclassID, err)
+	}
+	spellcastTrait, err := daggerheart.NormalizeSpellcastTrait(subclass.SpellcastTrait)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	traitValue, _ := daggerheart.TraitValue(progressionFromProfile(profile).Traits, spellcastTrait)
+
+	state, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, casterID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if state.Hope < hopeCost {
+		return nil, status.Error(codes.FailedPrecondition, "insufficient hope")
+	}
+
+	modifiers := append([]*pb.ActionRollModifier{{Value: int32(traitValue), Source: "spellcast_trait"}}, in.GetModifiers()...)
+	rollResp, err := s.SessionActionRoll(ctx, &pb.SessionActionRollRequest{
+		CampaignId:       campaignID,
+		SessionId:        sessionID,
+		CharacterId:      casterID,
+		Trait:            spellcastTrait,
+		RollKind:         pb.RollKind_ROLL_KIND_ACTION,
+		Difficulty:       in.GetDifficulty(),
+		Modifiers:        modifiers,
+		AdvantageSources: in.GetAdvantageSources(),
+		ExtraFearDie:     in.GetExtraFearDie(),
+		Rng:              in.GetActionRng(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctxWithMeta := withCampaignSessionMetadata(ctx, campaignID, sessionID)
+	rollOutcome, err := s.ApplyRollOutcome(ctxWithMeta, &pb.ApplyRollOutcomeRequest{
+		SessionId: sessionID,
+		RollSeq:   rollResp.GetRollSeq(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	rollSeq := rollResp.GetRollSeq()
+	rollEvent, err := s.stores.Event.GetEventBySeq(ctx, campaignID, rollSeq)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	var rollPayload event.RollResolvedPayload
+	if err := event.DecodePayload(rollEvent, &rollPayload); err != nil {
+		return nil, status.Errorf(codes.Internal, "decode roll payload: %v", err)
+	}
+	rollRequestID := strings.TrimSpace(rollPayload.RequestID)
+	if rollRequestID == "" {
+		rollRequestID = strings.TrimSpace(rollEvent.RequestID)
+	}
+	payload := daggerheart.SpellcastResolvedPayload{
+		CharacterID:    casterID,
+		RollSeq:        rollSeq,
+		DomainCardID:   cardID,
+		SubclassID:     subclassID,
+		SpellcastTrait: spellcastTrait,
+		TraitValue:     traitValue,
+		HopeCost:       hopeCost,
+		ExtraFearDie:   in.GetExtraFearDie(),
+		Outcome:        outcomeFromSystemData(rollPayload.SystemData, rollPayload.Outcome),
+		Success:        rollResp.GetSuccess(),
+		Crit:           rollResp.GetCrit(),
+		Flavor:         rollResp.GetFlavor(),
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode spellcast payload: %v", err)
+	}
+	if _, err := s.stores.Event.AppendEvent(ctx, event.Event{
+		CampaignID:    campaignID,
+		Timestamp:     time.Now().UTC(),
+		Type:          daggerheart.EventTypeSpellcastResolved,
+		SessionID:     sessionID,
+		RequestID:     rollRequestID,
+		InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
+		ActorType:     event.ActorTypeSystem,
+		EntityType:    "spellcast",
+		EntityID:      rollRequestID,
+		SystemID:      c.System.String(),
+		SystemVersion: daggerheart.SystemVersion,
+		PayloadJSON:   payloadJSON,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "append spellcast event: %v", err)
+	}
+
+	// The roll outcome may have granted Hope, so the cost is paid from the
+	// post-roll state.
+	if hopeCost > 0 {
+		state, err = s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, casterID)
+		if err != nil {
+			return nil, handleDomainError(err)
+		}
+		if err := s.appendHopeSpends(ctx, c.System.String(), campaignID, sessionID, casterID, state.Hope, []hopeSpend{{Source: "spellcast", Amount: hopeCost}}, rollSeq); err != nil {
+			return nil, err
+		}
+	}
+
+	response := &pb.SessionSpellcastFlowResponse{
+		ActionRoll:     rollResp,
+		RollOutcome:    rollOutcome,
+		SpellcastTrait: spellcastTrait,
+		TraitValue:     int32(traitValue),
+		SubclassId:     subclassID,
+		HopeSpent:      int32(hopeCost),
+	}
+	if !rollResp.GetSuccess() || in.GetDamageDieSides() == 0 {
+		return response, nil
+	}
+
+	damageRoll, err := s.SessionDamageRoll(ctx, &pb.SessionDamageRollRequest{
+		CampaignId:  campaignID,
+		SessionId:   sessionID,
+		CharacterId: casterID,
+		Dice: []*pb.DiceSpec{{
+			Sides: in.GetDamageDieSides(),
+			Count: int32(daggerheart.SpellcastDamageDiceCount(traitValue)),
+		}},
+		Modifier: in.GetDamageModifier(),
+		Critical: rollResp.GetCrit(),
+		Rng:      in.GetDamageRng(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	response.DamageRoll = damageRoll
+	return response, nil
+}
+
+func (s *DaggerheartService) SessionReactionFlow(ctx context.Context, in *pb.SessionReactionFlowRequest) (*pb.SessionReactionFlowResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "session reaction flow request is required")
+	}
+	if s.stores.Campaign == nil {
+		return nil, status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Session == nil {
+		return nil, status.Error(codes.Internal, "session store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return nil, status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	if s.stores.Event == nil {
+		return nil, status.Error(codes.Internal, "event store is not configured")
+	}
+	if s.seedFunc == nil {
+		return nil, status.Error(codes.Internal, "seed generator is not configured")
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	actorID := strings.TrimSpace(in.GetCharacterId())
+	if actorID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	trait := strings.TrimSpace(in.GetTrait())
+	if trait == "" {
+		return nil, status.Error(codes.InvalidArgument, "trait is required")
+	}
+
+	rollResp, err := s.SessionActionRoll(ctx, &pb.SessionActionRollRequest{
+		CampaignId:  campaignID,
+		SessionId:   sessionID,
+		CharacterId: actorID,
+		Trait:       trait,
+		RollKind:    pb.RollKind_ROLL_KIND_REACTION,
+		Difficulty:  in.GetDifficulty(),
+		Modifiers:   in.GetModifiers(),
+		Rng:         in.GetReactionRng(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctxWithMeta := withCampaignSessionMetadata(ctx, campaignID, sessionID)
+	rollOutcome, err := s.ApplyRollOutcome(ctxWithMeta, &pb.ApplyRollOutcomeRequest{
+		SessionId: sessionID,
+		RollSeq:   rollResp.GetRollSeq(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reactionOutcome, err := s.ApplyReactionOutcome(ctxWithMeta, &pb.DaggerheartApplyReactionOutcomeRequest{
+		SessionId: sessionID,
+		RollSeq:   rollResp.GetRollSeq(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.SessionReactionFlowResponse{
+		ActionRoll:      rollResp,
+		RollOutcome:     rollOutcome,
+		ReactionOutcome: reactionOutcome,
+	}
+
+	return response, nil
+}
+
+func (s *DaggerheartService) SessionAdversaryAttackRoll(ctx context.Context, in *pb.SessionAdversaryAttackRollRequest) (*pb.SessionAdversaryAttackRollResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "session adversary attack roll request is required")
+	}
+	if s.stores.Campaign == nil {
+		return nil, status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Session == nil {
+		return nil, status.Error(codes.Internal, "session store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return nil, status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	if s.stores.Event == nil {
+		return nil, status.Error(codes.Internal, "event store is not configured")
+	}
+	if s.seedFunc == nil {
+		return nil, status.Error(codes.Internal, "seed generator is not configured")
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	adversaryID := strings.TrimSpace(in.GetAdversaryId())
+	if adversaryID == "" {
+		return nil, status.Error(codes.InvalidArgument, "adversary id is required")
 	}
 
 	c, err := s.stores.Campaign.Get(ctx, campaignID)
@@ -2862,7 +3446,11 @@ func (s *DaggerheartService) SessionAdversaryAttackRoll(ctx context.Context, in
 			}
 		}
 	}
-	modifier := int(in.GetAttackModifier())
+	rollBonus, err := s.effectBonus(ctx, campaignID, daggerheart.EffectTargetAdversary, adversaryID, daggerheart.EffectKindRoll)
+	if err != nil {
+		return nil, err
+	}
+	modifier := int(in.GetAttackModifier()) + rollBonus
 	total := selected + modifier
 
 	latestSeq, err := s.stores.Event.GetLatestEventSeq(ctx, campaignID)
@@ -3130,6 +3718,11 @@ func (s *DaggerheartService) SessionAdversaryAttackFlow(ctx context.Context, in
 		return nil, status.Error(codes.InvalidArgument, "damage_type is required")
 	}
 
+	groupIDs, err := s.resolveMinionGroup(ctx, campaignID, sessionID, adversaryID, in.GetGroupAdversaryIds())
+	if err != nil {
+		return nil, err
+	}
+
 	rollResp, err := s.SessionAdversaryAttackRoll(ctx, &pb.SessionAdversaryAttackRollRequest{
 		CampaignId:     campaignID,
 		SessionId:      sessionID,
@@ -3155,8 +3748,9 @@ func (s *DaggerheartService) SessionAdversaryAttackFlow(ctx context.Context, in
 	}
 
 	response := &pb.SessionAdversaryAttackFlowResponse{
-		AttackRoll:    rollResp,
-		AttackOutcome: attackOutcome,
+		AttackRoll:        rollResp,
+		AttackOutcome:     attackOutcome,
+		GroupAdversaryIds: groupIDs,
 	}
 
 	if attackOutcome.GetResult() == nil || !attackOutcome.GetResult().GetSuccess() {
@@ -3167,13 +3761,25 @@ func (s *DaggerheartService) SessionAdversaryAttackFlow(ctx context.Context, in
 		return nil, status.Error(codes.InvalidArgument, "damage_dice are required")
 	}
 
+	damageDice := in.GetDamageDice()
+	damageModifier := in.GetDamageModifier()
+	if len(groupIDs) > 1 {
+		// Each Minion in the group adds its damage to a single source.
+		groupSize := int32(len(groupIDs))
+		damageDice = make([]*pb.DiceSpec, 0, len(in.GetDamageDice()))
+		for _, spec := range in.GetDamageDice() {
+			damageDice = append(damageDice, &pb.DiceSpec{Sides: spec.GetSides(), Count: spec.GetCount() * groupSize})
+		}
+		damageModifier *= groupSize
+	}
+
 	critical := attackOutcome.GetResult().GetCrit() || in.GetDamageCritical()
 	damageRoll, err := s.SessionDamageRoll(ctx, &pb.SessionDamageRollRequest{
 		CampaignId:  campaignID,
 		SessionId:   sessionID,
 		CharacterId: adversaryID,
-		Dice:        in.GetDamageDice(),
-		Modifier:    in.GetDamageModifier(),
+		Dice:        damageDice,
+		Modifier:    damageModifier,
 		Critical:    critical,
 		Rng:         in.GetDamageRng(),
 	})
@@ -3183,6 +3789,7 @@ func (s *DaggerheartService) SessionAdversaryAttackFlow(ctx context.Context, in
 
 	sourceCharacterIDs := normalizeTargets(in.GetDamage().GetSourceCharacterIds())
 	sourceCharacterIDs = append(sourceCharacterIDs, adversaryID)
+	sourceCharacterIDs = append(sourceCharacterIDs, groupIDs...)
 	sourceCharacterIDs = normalizeTargets(sourceCharacterIDs)
 
 	damageReq := &pb.DaggerheartDamageRequest{
@@ -3214,6 +3821,401 @@ func (s *DaggerheartService) SessionAdversaryAttackFlow(ctx context.Context, in
 	return response, nil
 }
 
+// resolveMinionGroup validates a Minion group attack and returns its members,
+// leader first. It returns nil when no group members are requested.
+func (s *DaggerheartService) resolveMinionGroup(ctx context.Context, campaignID, sessionID, leaderID string, memberIDs []string) ([]string, error) {
+	members := normalizeTargets(memberIDs)
+	if len(members) == 0 {
+		return nil, nil
+	}
+	groupIDs := make([]string, 0, len(members)+1)
+	groupIDs = append(groupIDs, leaderID)
+	for _, memberID := range members {
+		if memberID != leaderID {
+			groupIDs = append(groupIDs, memberID)
+		}
+	}
+	for _, id := range groupIDs {
+		minion, err := s.loadAdversaryForSession(ctx, campaignID, sessionID, id)
+		if err != nil {
+			return nil, err
+		}
+		if minion.MinionThreshold <= 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "group attack member %s: %v", id, daggerheart.ErrNotMinion)
+		}
+		if minion.HP <= 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "group attack member %s is defeated", id)
+		}
+	}
+	return groupIDs, nil
+}
+
+func (s *DaggerheartService) SessionAdversaryMultiAttackFlow(ctx context.Context, in *pb.SessionAdversaryMultiAttackFlowRequest) (*pb.SessionAdversaryMultiAttackFlowResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "session adversary multi attack flow request is required")
+	}
+	if s.stores.Campaign == nil {
+		return nil, status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Session == nil {
+		return nil, status.Error(codes.Internal, "session store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return nil, status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	if s.stores.Event == nil {
+		return nil, status.Error(codes.Internal, "event store is not configured")
+	}
+	if s.seedFunc == nil {
+		return nil, status.Error(codes.Internal, "seed generator is not configured")
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	adversaryID := strings.TrimSpace(in.GetAdversaryId())
+	if adversaryID == "" {
+		return nil, status.Error(codes.InvalidArgument, "adversary id is required")
+	}
+	if in.GetStressCost() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "stress cost must be non-negative")
+	}
+	if err := validateMultiAttackDamage(in.GetDamage(), in.GetDamageDice()); err != nil {
+		return nil, err
+	}
+
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return nil, status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart attacks")
+	}
+
+	adversary, err := s.loadAdversaryForSession(ctx, campaignID, sessionID, adversaryID)
+	if err != nil {
+		return nil, err
+	}
+	stressCost := int(in.GetStressCost())
+	if adversary.Stress+stressCost > adversary.StressMax {
+		return nil, status.Error(codes.FailedPrecondition, "adversary does not have enough stress available")
+	}
+
+	targets, err := s.resolveMultiAttackTargets(ctx, campaignID, sessionID, adversaryID, in.GetTargets())
+	if err != nil {
+		return nil, err
+	}
+
+	if stressCost > 0 {
+		if _, err := s.UpdateAdversary(ctx, &pb.DaggerheartUpdateAdversaryRequest{
+			CampaignId:  campaignID,
+			AdversaryId: adversaryID,
+			Stress:      wrapperspb.Int32(int32(adversary.Stress + stressCost)),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	rollResp, err := s.SessionAdversaryAttackRoll(ctx, &pb.SessionAdversaryAttackRollRequest{
+		CampaignId:     campaignID,
+		SessionId:      sessionID,
+		AdversaryId:    adversaryID,
+		AttackModifier: in.GetAttackModifier(),
+		Advantage:      in.GetAdvantage(),
+		Disadvantage:   in.GetDisadvantage(),
+		Rng:            in.GetAttackRng(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resolution, err := s.resolveMultiAttack(ctx, c, sessionID, multiAttackRoll{
+		attackerID:     adversaryID,
+		attackerType:   daggerheart.MultiAttackTargetAdversary,
+		rollSeq:        rollResp.GetRollSeq(),
+		total:          int(rollResp.GetTotal()),
+		crit:           rollResp.GetRoll() == 20,
+		stressCost:     stressCost,
+		damage:         in.GetDamage(),
+		damageDice:     in.GetDamageDice(),
+		damageModifier: in.GetDamageModifier(),
+		damageCritical: in.GetDamageCritical(),
+		damageRng:      in.GetDamageRng(),
+	}, targets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SessionAdversaryMultiAttackFlowResponse{
+		AttackRoll: rollResp,
+		DamageRoll: resolution.damageRoll,
+		Results:    resolution.results,
+	}, nil
+}
+
+// multiAttackTarget is a resolved target of a multi-target attack.
+type multiAttackTarget struct {
+	id           string
+	adversary    bool
+	difficulty   int
+	declineArmor bool
+}
+
+// multiAttackRoll carries the shared roll and damage inputs of a multi-target attack.
+type multiAttackRoll struct {
+	attackerID     string
+	attackerType   string
+	rollSeq        uint64
+	total          int
+	crit           bool
+	stressCost     int
+	damage         *pb.DaggerheartAttackDamageSpec
+	damageDice     []*pb.DiceSpec
+	damageModifier int32
+	damageCritical bool
+	damageRng      *commonv1.RngRequest
+}
+
+type multiAttackResolution struct {
+	damageRoll *pb.SessionDamageRollResponse
+	results    []*pb.MultiAttackTargetResult
+}
+
+func validateMultiAttackDamage(damage *pb.DaggerheartAttackDamageSpec, dice []*pb.DiceSpec) error {
+	if damage == nil {
+		return status.Error(codes.InvalidArgument, "damage is required")
+	}
+	if damage.GetDamageType() == pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_UNSPECIFIED {
+		return status.Error(codes.InvalidArgument, "damage_type is required")
+	}
+	if len(dice) == 0 {
+		return status.Error(codes.InvalidArgument, "damage_dice are required")
+	}
+	return nil
+}
+
+// resolveMultiAttackTargets looks up each target as a session adversary or a
+// character and returns its Difficulty (adversary Difficulty or PC Evasion).
+func (s *DaggerheartService) resolveMultiAttackTargets(ctx context.Context, campaignID, sessionID, attackerID string, requested []*pb.MultiAttackTarget) ([]multiAttackTarget, error) {
+	if len(requested) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "targets are required")
+	}
+	targets := make([]multiAttackTarget, 0, len(requested))
+	seen := make(map[string]struct{}, len(requested))
+	for _, entry := range requested {
+		targetID := strings.TrimSpace(entry.GetTargetId())
+		if targetID == "" {
+			return nil, status.Error(codes.InvalidArgument, "target id is required")
+		}
+		if targetID == attackerID {
+			return nil, status.Error(codes.InvalidArgument, "attacker cannot target itself")
+		}
+		if _, ok := seen[targetID]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "target %s is listed more than once", targetID)
+		}
+		seen[targetID] = struct{}{}
+		if entry.GetDifficulty() != nil && entry.GetDifficulty().GetValue() < 0 {
+			return nil, status.Error(codes.InvalidArgument, "difficulty must be non-negative")
+		}
+
+		target := multiAttackTarget{id: targetID, declineArmor: entry.GetDeclineArmor()}
+		if _, err := s.stores.Daggerheart.GetDaggerheartAdversary(ctx, campaignID, targetID); err == nil {
+			adversary, err := s.loadAdversaryForSession(ctx, campaignID, sessionID, targetID)
+			if err != nil {
+				return nil, err
+			}
+			if target.declineArmor {
+				return nil, status.Error(codes.InvalidArgument, "decline_armor applies to character targets only")
+			}
+			target.adversary = true
+			bonus, err := s.effectBonus(ctx, campaignID, daggerheart.EffectTargetAdversary, targetID, daggerheart.EffectKindEvasion)
+			if err != nil {
+				return nil, err
+			}
+			target.difficulty = adversary.Evasion + bonus
+		} else if errors.Is(err, storage.ErrNotFound) {
+			profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, targetID)
+			if err != nil {
+				return nil, handleDomainError(err)
+			}
+			target.difficulty, err = s.characterEvasion(ctx, campaignID, targetID, profile)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, handleDomainError(err)
+		}
+		if entry.GetDifficulty() != nil {
+			target.difficulty = int(entry.GetDifficulty().GetValue())
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+func multiAttackDifficulties(targets []multiAttackTarget) []int {
+	difficulties := make([]int, 0, len(targets))
+	for _, target := range targets {
+		difficulties = append(difficulties, target.difficulty)
+	}
+	return difficulties
+}
+
+// resolveMultiAttack compares the shared roll against each target, rolls
+// damage once when anything is hit, applies it to every hit target, and
+// records the per-target results in one action.multi_attack_resolved event.
+func (s *DaggerheartService) resolveMultiAttack(ctx context.Context, c campaign.Campaign, sessionID string, roll multiAttackRoll, targets []multiAttackTarget) (multiAttackResolution, error) {
+	campaignID := c.ID
+	hits := daggerheart.ResolveMultiAttackHits(roll.total, roll.crit, multiAttackDifficulties(targets))
+	anyHit := false
+	for _, hit := range hits {
+		anyHit = anyHit || hit
+	}
+
+	var resolution multiAttackResolution
+	var damageRollSeq *uint64
+	if anyHit {
+		damageRoll, err := s.SessionDamageRoll(ctx, &pb.SessionDamageRollRequest{
+			CampaignId:  campaignID,
+			SessionId:   sessionID,
+			CharacterId: roll.attackerID,
+			Dice:        roll.damageDice,
+			Modifier:    roll.damageModifier,
+			Critical:    roll.crit || roll.damageCritical,
+			Rng:         roll.damageRng,
+		})
+		if err != nil {
+			return multiAttackResolution{}, err
+		}
+		resolution.damageRoll = damageRoll
+		damageRollSeq = &damageRoll.RollSeq
+	}
+
+	sourceCharacterIDs := normalizeTargets(append(append([]string{}, roll.damage.GetSourceCharacterIds()...), roll.attackerID))
+	ctxWithMeta := withCampaignSessionMetadata(ctx, campaignID, sessionID)
+	payloadTargets := make([]daggerheart.MultiAttackTargetResult, 0, len(targets))
+	for i, target := range targets {
+		result := daggerheart.MultiAttackTargetResult{
+			TargetID:   target.id,
+			TargetType: daggerheart.MultiAttackTargetCharacter,
+			Difficulty: target.difficulty,
+			Hit:        hits[i],
+		}
+		if target.adversary {
+			result.TargetType = daggerheart.MultiAttackTargetAdversary
+		}
+		if hits[i] {
+			damageReq := &pb.DaggerheartDamageRequest{
+				Amount:             resolution.damageRoll.GetTotal(),
+				DamageType:         roll.damage.GetDamageType(),
+				ResistPhysical:     roll.damage.GetResistPhysical(),
+				ResistMagic:        roll.damage.GetResistMagic(),
+				ImmunePhysical:     roll.damage.GetImmunePhysical(),
+				ImmuneMagic:        roll.damage.GetImmuneMagic(),
+				Direct:             roll.damage.GetDirect(),
+				MassiveDamage:      roll.damage.GetMassiveDamage(),
+				Source:             roll.damage.GetSource(),
+				SourceCharacterIds: sourceCharacterIDs,
+				DeclineArmor:       target.declineArmor,
+			}
+			if target.adversary {
+				before, err := s.stores.Daggerheart.GetDaggerheartAdversary(ctx, campaignID, target.id)
+				if err != nil {
+					return multiAttackResolution{}, handleDomainError(err)
+				}
+				applied, err := s.ApplyAdversaryDamage(ctxWithMeta, &pb.DaggerheartApplyAdversaryDamageRequest{
+					CampaignId:        campaignID,
+					AdversaryId:       target.id,
+					Damage:            damageReq,
+					RollSeq:           damageRollSeq,
+					RequireDamageRoll: true,
+				})
+				if err != nil {
+					return multiAttackResolution{}, err
+				}
+				result.HPMarked = max(before.HP-int(applied.GetAdversary().GetHp()), 0)
+				result.ArmorSpent = max(before.Armor-int(applied.GetAdversary().GetArmor()), 0)
+			} else {
+				before, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, target.id)
+				if err != nil {
+					return multiAttackResolution{}, handleDomainError(err)
+				}
+				applied, err := s.ApplyDamage(ctxWithMeta, &pb.DaggerheartApplyDamageRequest{
+					CampaignId:        campaignID,
+					CharacterId:       target.id,
+					Damage:            damageReq,
+					RollSeq:           damageRollSeq,
+					RequireDamageRoll: true,
+				})
+				if err != nil {
+					return multiAttackResolution{}, err
+				}
+				result.HPMarked = max(before.Hp-int(applied.GetState().GetHp()), 0)
+				result.ArmorSpent = max(before.Armor-int(applied.GetState().GetArmor()), 0)
+			}
+		}
+		payloadTargets = append(payloadTargets, result)
+		resolution.results = append(resolution.results, &pb.MultiAttackTargetResult{
+			TargetId:   result.TargetID,
+			TargetType: result.TargetType,
+			Difficulty: int32(result.Difficulty),
+			Hit:        result.Hit,
+			HpMarked:   int32(result.HPMarked),
+			ArmorSpent: int32(result.ArmorSpent),
+		})
+	}
+
+	payload := daggerheart.MultiAttackResolvedPayload{
+		AttackerID:    roll.attackerID,
+		AttackerType:  roll.attackerType,
+		RollSeq:       roll.rollSeq,
+		DamageRollSeq: damageRollSeq,
+		Total:         roll.total,
+		Crit:          roll.crit,
+		StressCost:    roll.stressCost,
+		Targets:       payloadTargets,
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return multiAttackResolution{}, status.Errorf(codes.Internal, "encode multi attack payload: %v", err)
+	}
+	requestID := grpcmeta.RequestIDFromContext(ctx)
+	stored, err := s.stores.Event.AppendEvent(ctx, event.Event{
+		CampaignID:    campaignID,
+		Timestamp:     time.Now().UTC(),
+		Type:          daggerheart.EventTypeMultiAttackResolved,
+		SessionID:     sessionID,
+		RequestID:     requestID,
+		InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
+		ActorType:     event.ActorTypeSystem,
+		EntityType:    "attack",
+		EntityID:      requestID,
+		SystemID:      c.System.String(),
+		SystemVersion: daggerheart.SystemVersion,
+		PayloadJSON:   payloadJSON,
+	})
+	if err != nil {
+		return multiAttackResolution{}, status.Errorf(codes.Internal, "append multi attack event: %v", err)
+	}
+	adapter := daggerheart.NewAdapter(s.stores.Daggerheart)
+	if err := adapter.ApplyEvent(ctx, stored); err != nil {
+		return multiAttackResolution{}, status.Errorf(codes.Internal, "apply multi attack event: %v", err)
+	}
+	targetIDs := make([]string, 0, len(targets))
+	for _, target := range targets {
+		targetIDs = append(targetIDs, target.id)
+	}
+	if err := s.expireAttackEffects(ctx, c, sessionID, roll.attackerID, targetIDs, anyHit); err != nil {
+		return multiAttackResolution{}, err
+	}
+	return resolution, nil
+}
+
 func (s *DaggerheartService) SessionGroupActionFlow(ctx context.Context, in *pb.SessionGroupActionFlowRequest) (*pb.SessionGroupActionFlowResponse, error) {
 	if in == nil {
 		return nil, status.Error(codes.InvalidArgument, "session group action flow request is required")
@@ -3598,7 +4600,7 @@ func (s *DaggerheartService) ApplyRollOutcome(ctx context.Context, in *pb.ApplyR
 	}
 
 	var rollPayload event.RollResolvedPayload
-	if err := json.Unmarshal(rollEvent.PayloadJSON, &rollPayload); err != nil {
+	if err := event.DecodePayload(rollEvent, &rollPayload); err != nil {
 		return nil, status.Errorf(codes.Internal, "decode roll payload: %v", err)
 	}
 
@@ -3984,7 +4986,7 @@ func (s *DaggerheartService) ApplyAttackOutcome(ctx context.Context, in *pb.Dagg
 	}
 
 	var rollPayload event.RollResolvedPayload
-	if err := json.Unmarshal(rollEvent.PayloadJSON, &rollPayload); err != nil {
+	if err := event.DecodePayload(rollEvent, &rollPayload); err != nil {
 		return nil, status.Errorf(codes.Internal, "decode roll payload: %v", err)
 	}
 
@@ -4044,6 +5046,7 @@ func (s *DaggerheartService) ApplyAttackOutcome(ctx context.Context, in *pb.Dagg
 		Success:     rollSuccess,
 		Crit:        crit,
 		Flavor:      flavor,
+		WeaponID:    strings.TrimSpace(in.GetWeaponId()),
 	}
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
@@ -4066,6 +5069,9 @@ func (s *DaggerheartService) ApplyAttackOutcome(ctx context.Context, in *pb.Dagg
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "append attack event: %v", err)
 	}
+	if err := s.expireAttackEffects(ctx, c, sessionID, attackerID, targets, rollSuccess); err != nil {
+		return nil, err
+	}
 
 	return &pb.DaggerheartApplyAttackOutcomeResponse{
 		RollSeq:     in.GetRollSeq(),
@@ -4149,7 +5155,7 @@ func (s *DaggerheartService) ApplyAdversaryAttackOutcome(ctx context.Context, in
 	}
 
 	var rollPayload daggerheart.AdversaryRollResolvedPayload
-	if err := json.Unmarshal(rollEvent.PayloadJSON, &rollPayload); err != nil {
+	if err := event.DecodePayload(rollEvent, &rollPayload); err != nil {
 		return nil, status.Errorf(codes.Internal, "decode adversary roll payload: %v", err)
 	}
 	adversaryID := strings.TrimSpace(rollPayload.AdversaryID)
@@ -4220,6 +5226,9 @@ func (s *DaggerheartService) ApplyAdversaryAttackOutcome(ctx context.Context, in
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "append adversary attack event: %v", err)
 	}
+	if err := s.expireAttackEffects(ctx, c, sessionID, adversaryID, targets, success); err != nil {
+		return nil, err
+	}
 
 	return &pb.DaggerheartApplyAdversaryAttackOutcomeResponse{
 		RollSeq:     in.GetRollSeq(),
@@ -4298,7 +5307,7 @@ func (s *DaggerheartService) ApplyReactionOutcome(ctx context.Context, in *pb.Da
 	}
 
 	var rollPayload event.RollResolvedPayload
-	if err := json.Unmarshal(rollEvent.PayloadJSON, &rollPayload); err != nil {
+	if err := event.DecodePayload(rollEvent, &rollPayload); err != nil {
 		return nil, status.Errorf(codes.Internal, "decode roll payload: %v", err)
 	}
 
@@ -4649,9 +5658,13 @@ func resolveRoll(kind pb.RollKind, request daggerheartdomain.ActionRequest) (dag
 	switch normalizeRollKind(kind) {
 	case pb.RollKind_ROLL_KIND_REACTION:
 		result, err := daggerheartdomain.RollReaction(daggerheartdomain.ReactionRequest{
-			Modifier:   request.Modifier,
-			Difficulty: request.Difficulty,
-			Seed:       request.Seed,
+			Modifier:     request.Modifier,
+			Difficulty:   request.Difficulty,
+			Seed:         request.Seed,
+			Advantage:    request.Advantage,
+			Disadvantage: request.Disadvantage,
+			Sources:      request.Sources,
+			HopeDieSides: request.HopeDieSides,
 		})
 		if err != nil {
 			return daggerheartdomain.ActionResult{}, false, false, false, err
@@ -4700,6 +5713,205 @@ func hopeSpendsFromModifiers(modifiers []*pb.ActionRollModifier) []hopeSpend {
 	return spends
 }
 
+// hasExperienceModifier reports whether modifiers spend Hope on an Experience
+// the legacy way, as a modifier with the "experience" source.
+func hasExperienceModifier(modifiers []*pb.ActionRollModifier) bool {
+	for _, modifier := range modifiers {
+		if modifier != nil && normalizeHopeSpendSource(modifier.GetSource()) == "experience" {
+			return true
+		}
+	}
+	return false
+}
+
+// rollExperience is an Experience utilized on an action roll.
+type rollExperience struct {
+	Name     string
+	Modifier int
+}
+
+// resolveRollExperiences looks up the named Experiences on the roller's
+// profile. Each Experience may only be utilized once per roll.
+func (s *DaggerheartService) resolveRollExperiences(ctx context.Context, campaignID, characterID string, names []string) ([]rollExperience, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	experiences := make([]rollExperience, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		trimmed := strings.TrimSpace(name)
+		if trimmed == "" {
+			return nil, status.Error(codes.InvalidArgument, "experience name is required")
+		}
+		key := strings.ToLower(trimmed)
+		if _, ok := seen[key]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "experience %q is already utilized", trimmed)
+		}
+		seen[key] = struct{}{}
+		found := false
+		for _, experience := range profile.Experiences {
+			if strings.EqualFold(strings.TrimSpace(experience.Name), trimmed) {
+				experiences = append(experiences, rollExperience{Name: experience.Name, Modifier: experience.Modifier})
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.InvalidArgument, "character does not have experience %q", trimmed)
+		}
+	}
+	return experiences, nil
+}
+
+// normalizeHelperIDs validates the characters helping an ally on a roll.
+func normalizeHelperIDs(ids []string, characterID string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	helpers := make([]string, 0, len(ids))
+	for _, id := range ids {
+		trimmed := strings.TrimSpace(id)
+		if trimmed == "" {
+			return nil, status.Error(codes.InvalidArgument, "helper character id is required")
+		}
+		if trimmed == characterID {
+			return nil, status.Error(codes.InvalidArgument, "character cannot help their own roll")
+		}
+		if containsString(helpers, trimmed) {
+			return nil, status.Errorf(codes.InvalidArgument, "helper %s is duplicated", trimmed)
+		}
+		helpers = append(helpers, trimmed)
+	}
+	return helpers, nil
+}
+
+// loadHelperHope checks that every helper is a living PC in the campaign
+// with Hope to spend, returning each helper's current Hope.
+func (s *DaggerheartService) loadHelperHope(ctx context.Context, campaignID string, helperIDs []string) ([]int, error) {
+	if len(helperIDs) == 0 {
+		return nil, nil
+	}
+	if s.stores.Character == nil {
+		return nil, status.Error(codes.Internal, "character store is not configured")
+	}
+	helperHope := make([]int, 0, len(helperIDs))
+	for _, helperID := range helperIDs {
+		helper, err := s.stores.Character.GetCharacter(ctx, campaignID, helperID)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "helper %s not found", helperID)
+			}
+			return nil, status.Errorf(codes.Internal, "get helper character: %v", err)
+		}
+		if helper.Kind != character.CharacterKindPC {
+			return nil, status.Errorf(codes.InvalidArgument, "helper %s is not a player character", helperID)
+		}
+		helperState, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, helperID)
+		if err != nil {
+			return nil, handleDomainError(err)
+		}
+		if lifeState := strings.TrimSpace(helperState.LifeState); lifeState != "" && lifeState != daggerheart.LifeStateAlive {
+			return nil, status.Errorf(codes.FailedPrecondition, "helper %s is %s", helperID, lifeState)
+		}
+		if helperState.Hope < 1 {
+			return nil, status.Errorf(codes.FailedPrecondition, "helper %s has insufficient hope", helperID)
+		}
+		helperHope = append(helperHope, helperState.Hope)
+	}
+	return helperHope, nil
+}
+
+// helpBonus is the advantage die when a helper die is the highest one kept.
+func helpBonus(result daggerheartdomain.ActionResult) int {
+	for _, die := range result.HelpDice {
+		if die == result.AdvantageDie {
+			return result.AdvantageModifier
+		}
+	}
+	return 0
+}
+
+// appendHopeSpends records each hope spend for a character followed by the
+// resulting state patch. Callers must verify the character has enough Hope.
+func (s *DaggerheartService) appendHopeSpends(ctx context.Context, systemID, campaignID, sessionID, characterID string, hopeBefore int, spends []hopeSpend, rollSeq uint64) error {
+	hopeAfter := hopeBefore
+	for _, spend := range spends {
+		if spend.Amount <= 0 {
+			continue
+		}
+		before := hopeAfter
+		after := before - spend.Amount
+		payload := daggerheart.HopeSpentPayload{
+			CharacterID: characterID,
+			Amount:      spend.Amount,
+			Before:      before,
+			After:       after,
+			RollSeq:     &rollSeq,
+			Source:      spend.Source,
+		}
+		payloadJSON, err := json.Marshal(payload)
+		if err != nil {
+			return status.Errorf(codes.Internal, "encode hope spend payload: %v", err)
+		}
+		if _, err := s.stores.Event.AppendEvent(ctx, event.Event{
+			CampaignID:    campaignID,
+			Timestamp:     time.Now().UTC(),
+			Type:          daggerheart.EventTypeHopeSpent,
+			SessionID:     sessionID,
+			RequestID:     grpcmeta.RequestIDFromContext(ctx),
+			InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
+			ActorType:     event.ActorTypeSystem,
+			EntityType:    "character",
+			EntityID:      characterID,
+			SystemID:      systemID,
+			SystemVersion: daggerheart.SystemVersion,
+			PayloadJSON:   payloadJSON,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "append hope spend event: %v", err)
+		}
+		hopeAfter = after
+	}
+	if hopeAfter == hopeBefore {
+		return nil
+	}
+
+	payload := daggerheart.CharacterStatePatchedPayload{
+		CharacterID: characterID,
+		HopeBefore:  &hopeBefore,
+		HopeAfter:   &hopeAfter,
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return status.Errorf(codes.Internal, "encode character state payload: %v", err)
+	}
+	storedState, err := s.stores.Event.AppendEvent(ctx, event.Event{
+		CampaignID:    campaignID,
+		Timestamp:     time.Now().UTC(),
+		Type:          daggerheart.EventTypeCharacterStatePatched,
+		SessionID:     sessionID,
+		RequestID:     grpcmeta.RequestIDFromContext(ctx),
+		InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
+		ActorType:     event.ActorTypeSystem,
+		EntityType:    "character",
+		EntityID:      characterID,
+		SystemID:      systemID,
+		SystemVersion: daggerheart.SystemVersion,
+		PayloadJSON:   payloadJSON,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "append character state event: %v", err)
+	}
+	adapter := daggerheart.NewAdapter(s.stores.Daggerheart)
+	if err := adapter.ApplyEvent(ctx, storedState); err != nil {
+		return status.Errorf(codes.Internal, "apply character state event: %v", err)
+	}
+	return nil
+}
+
 func normalizeHopeSpendSource(value string) string {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
@@ -4894,8 +6106,11 @@ func applyDaggerheartDamage(req *pb.DaggerheartDamageRequest, profile storage.Da
 	if err != nil {
 		return daggerheart.DamageApplication{}, mitigated, err
 	}
-	if req.Direct {
+	if req.Direct || req.DeclineArmor {
 		app, err := daggerheart.ApplyDamage(state.Hp, adjusted, profile.MajorThreshold, profile.SevereThreshold, options)
+		// Armor is untouched when it is bypassed or declined.
+		app.ArmorBefore = state.Armor
+		app.ArmorAfter = state.Armor
 		return app, mitigated, err
 	}
 	app := daggerheart.ApplyDamageWithArmor(state.Hp, state.Armor, result)
@@ -4905,7 +6120,9 @@ func applyDaggerheartDamage(req *pb.DaggerheartDamageRequest, profile storage.Da
 	return app, mitigated, nil
 }
 
-func applyDaggerheartAdversaryDamage(req *pb.DaggerheartDamageRequest, adversary storage.DaggerheartAdversary) (daggerheart.DamageApplication, bool, error) {
+// resistAdversaryDamage returns the damage left after resistances and
+// immunities, and whether anything was mitigated.
+func resistAdversaryDamage(req *pb.DaggerheartDamageRequest) (int, bool) {
 	damageTypes := daggerheart.DamageTypes{}
 	switch req.DamageType {
 	case pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL:
@@ -4924,7 +6141,14 @@ func applyDaggerheartAdversaryDamage(req *pb.DaggerheartDamageRequest, adversary
 		ImmuneMagic:    req.ImmuneMagic,
 	}
 	adjusted := daggerheart.ApplyResistance(int(req.Amount), damageTypes, resistance)
-	mitigated := adjusted < int(req.Amount)
+	return adjusted, adjusted < int(req.Amount)
+}
+
+func applyDaggerheartAdversaryDamage(req *pb.DaggerheartDamageRequest, adversary storage.DaggerheartAdversary) (daggerheart.DamageApplication, bool, error) {
+	adjusted, mitigated := resistAdversaryDamage(req)
+	if adversary.MinionThreshold > 0 {
+		return daggerheart.ApplyMinionDamage(adversary.HP, adversary.Armor, adjusted), mitigated, nil
+	}
 	options := daggerheart.DamageOptions{EnableMassiveDamage: req.MassiveDamage}
 	result, err := daggerheart.EvaluateDamage(adjusted, adversary.Major, adversary.Severe, options)
 	if err != nil {
@@ -5026,6 +6250,16 @@ func daggerheartStateToProto(state storage.DaggerheartCharacterState) *pb.Dagger
 		Armor:      int32(state.Armor),
 		Conditions: daggerheartConditionsToProto(state.Conditions),
 		LifeState:  daggerheartLifeStateToProto(state.LifeState),
+		Gold: &pb.DaggerheartGold{
+			Handfuls: int32(state.GoldHandfuls),
+			Bags:     int32(state.GoldBags),
+			Chests:   int32(state.GoldChests),
+		},
+		Inventory:                 daggerheartInventoryToProto(state.Inventory),
+		EquippedArmorId:           state.EquippedArmorID,
+		EquippedPrimaryWeaponId:   state.EquippedPrimaryWeaponID,
+		EquippedSecondaryWeaponId: state.EquippedSecondaryWeaponID,
+		BeastformId:               state.BeastformID,
 	}
 }
 
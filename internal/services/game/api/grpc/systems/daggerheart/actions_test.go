@@ -3,6 +3,7 @@ package daggerheart
 import (
 	"context"
 	"encoding/json"
+	"sort"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // --- Fake stores for daggerheart action tests ---
@@ -47,18 +49,30 @@ func (s *fakeCampaignStore) List(_ context.Context, _ int, _ string) (storage.Ca
 }
 
 type fakeDaggerheartStore struct {
-	profiles   map[string]storage.DaggerheartCharacterProfile
-	states     map[string]storage.DaggerheartCharacterState
-	snapshots  map[string]storage.DaggerheartSnapshot
-	countdowns map[string]storage.DaggerheartCountdown
+	profiles     map[string]storage.DaggerheartCharacterProfile
+	states       map[string]storage.DaggerheartCharacterState
+	snapshots    map[string]storage.DaggerheartSnapshot
+	countdowns   map[string]storage.DaggerheartCountdown
+	sceneRanges  map[string]storage.DaggerheartSceneRange
+	environments map[string]storage.DaggerheartSessionEnvironment
+	companions   map[string]storage.DaggerheartCompanion
+	effects      map[string]storage.DaggerheartEffect
+	chases       map[string]storage.DaggerheartChase
+	features     map[string]storage.DaggerheartAdversaryFeatureState
 }
 
 func newFakeDaggerheartStore() *fakeDaggerheartStore {
 	return &fakeDaggerheartStore{
-		profiles:   make(map[string]storage.DaggerheartCharacterProfile),
-		states:     make(map[string]storage.DaggerheartCharacterState),
-		snapshots:  make(map[string]storage.DaggerheartSnapshot),
-		countdowns: make(map[string]storage.DaggerheartCountdown),
+		profiles:     make(map[string]storage.DaggerheartCharacterProfile),
+		states:       make(map[string]storage.DaggerheartCharacterState),
+		snapshots:    make(map[string]storage.DaggerheartSnapshot),
+		countdowns:   make(map[string]storage.DaggerheartCountdown),
+		sceneRanges:  make(map[string]storage.DaggerheartSceneRange),
+		environments: make(map[string]storage.DaggerheartSessionEnvironment),
+		companions:   make(map[string]storage.DaggerheartCompanion),
+		effects:      make(map[string]storage.DaggerheartEffect),
+		chases:       make(map[string]storage.DaggerheartChase),
+		features:     make(map[string]storage.DaggerheartAdversaryFeatureState),
 	}
 }
 
@@ -145,6 +159,150 @@ func (s *fakeDaggerheartStore) DeleteDaggerheartAdversary(_ context.Context, _,
 	return nil
 }
 
+func sceneRangeKey(campaignID, sessionID, firstID, secondID string) string {
+	if firstID > secondID {
+		firstID, secondID = secondID, firstID
+	}
+	return campaignID + ":" + sessionID + ":" + firstID + ":" + secondID
+}
+
+func (s *fakeDaggerheartStore) PutDaggerheartSceneRange(_ context.Context, sceneRange storage.DaggerheartSceneRange) error {
+	if sceneRange.FromID > sceneRange.ToID {
+		sceneRange.FromID, sceneRange.ToID = sceneRange.ToID, sceneRange.FromID
+		sceneRange.FromType, sceneRange.ToType = sceneRange.ToType, sceneRange.FromType
+	}
+	s.sceneRanges[sceneRangeKey(sceneRange.CampaignID, sceneRange.SessionID, sceneRange.FromID, sceneRange.ToID)] = sceneRange
+	return nil
+}
+
+func (s *fakeDaggerheartStore) GetDaggerheartSceneRange(_ context.Context, campaignID, sessionID, firstID, secondID string) (storage.DaggerheartSceneRange, error) {
+	sceneRange, ok := s.sceneRanges[sceneRangeKey(campaignID, sessionID, firstID, secondID)]
+	if !ok {
+		return storage.DaggerheartSceneRange{}, storage.ErrNotFound
+	}
+	return sceneRange, nil
+}
+
+func (s *fakeDaggerheartStore) ListDaggerheartSceneRanges(_ context.Context, campaignID, sessionID string) ([]storage.DaggerheartSceneRange, error) {
+	var ranges []storage.DaggerheartSceneRange
+	for _, sceneRange := range s.sceneRanges {
+		if sceneRange.CampaignID == campaignID && sceneRange.SessionID == sessionID {
+			ranges = append(ranges, sceneRange)
+		}
+	}
+	return ranges, nil
+}
+
+func (s *fakeDaggerheartStore) PutDaggerheartSessionEnvironment(_ context.Context, environment storage.DaggerheartSessionEnvironment) error {
+	s.environments[environment.CampaignID+":"+environment.SessionID] = environment
+	return nil
+}
+
+func (s *fakeDaggerheartStore) GetDaggerheartSessionEnvironment(_ context.Context, campaignID, sessionID string) (storage.DaggerheartSessionEnvironment, error) {
+	environment, ok := s.environments[campaignID+":"+sessionID]
+	if !ok {
+		return storage.DaggerheartSessionEnvironment{}, storage.ErrNotFound
+	}
+	return environment, nil
+}
+
+func (s *fakeDaggerheartStore) DeleteDaggerheartSessionEnvironment(_ context.Context, campaignID, sessionID string) error {
+	delete(s.environments, campaignID+":"+sessionID)
+	return nil
+}
+
+func (s *fakeDaggerheartStore) PutDaggerheartCompanion(_ context.Context, companion storage.DaggerheartCompanion) error {
+	s.companions[companion.CampaignID+":"+companion.CharacterID] = companion
+	return nil
+}
+
+func (s *fakeDaggerheartStore) GetDaggerheartCompanion(_ context.Context, campaignID, characterID string) (storage.DaggerheartCompanion, error) {
+	companion, ok := s.companions[campaignID+":"+characterID]
+	if !ok {
+		return storage.DaggerheartCompanion{}, storage.ErrNotFound
+	}
+	return companion, nil
+}
+
+func (s *fakeDaggerheartStore) PutDaggerheartEffect(_ context.Context, effect storage.DaggerheartEffect) error {
+	s.effects[effect.CampaignID+":"+effect.EffectID] = effect
+	return nil
+}
+
+func (s *fakeDaggerheartStore) GetDaggerheartEffect(_ context.Context, campaignID, effectID string) (storage.DaggerheartEffect, error) {
+	effect, ok := s.effects[campaignID+":"+effectID]
+	if !ok {
+		return storage.DaggerheartEffect{}, storage.ErrNotFound
+	}
+	return effect, nil
+}
+
+func (s *fakeDaggerheartStore) ListDaggerheartEffects(_ context.Context, campaignID string) ([]storage.DaggerheartEffect, error) {
+	var effects []storage.DaggerheartEffect
+	for _, effect := range s.effects {
+		if effect.CampaignID == campaignID {
+			effects = append(effects, effect)
+		}
+	}
+	sort.Slice(effects, func(i, j int) bool {
+		if effects[i].TargetID != effects[j].TargetID {
+			return effects[i].TargetID < effects[j].TargetID
+		}
+		return effects[i].EffectID < effects[j].EffectID
+	})
+	return effects, nil
+}
+
+func (s *fakeDaggerheartStore) DeleteDaggerheartEffect(_ context.Context, campaignID, effectID string) error {
+	delete(s.effects, campaignID+":"+effectID)
+	return nil
+}
+
+func (s *fakeDaggerheartStore) PutDaggerheartChase(_ context.Context, chase storage.DaggerheartChase) error {
+	s.chases[chase.CampaignID+":"+chase.ChaseID] = chase
+	return nil
+}
+
+func (s *fakeDaggerheartStore) GetDaggerheartChase(_ context.Context, campaignID, chaseID string) (storage.DaggerheartChase, error) {
+	chase, ok := s.chases[campaignID+":"+chaseID]
+	if !ok {
+		return storage.DaggerheartChase{}, storage.ErrNotFound
+	}
+	return chase, nil
+}
+
+func (s *fakeDaggerheartStore) ListDaggerheartChases(_ context.Context, campaignID string) ([]storage.DaggerheartChase, error) {
+	var chases []storage.DaggerheartChase
+	for _, chase := range s.chases {
+		if chase.CampaignID == campaignID {
+			chases = append(chases, chase)
+		}
+	}
+	sort.Slice(chases, func(i, j int) bool { return chases[i].ChaseID < chases[j].ChaseID })
+	return chases, nil
+}
+
+func (s *fakeDaggerheartStore) PutDaggerheartAdversaryFeatureState(_ context.Context, state storage.DaggerheartAdversaryFeatureState) error {
+	s.features[state.CampaignID+":"+state.AdversaryID+":"+state.FeatureID] = state
+	return nil
+}
+
+func (s *fakeDaggerheartStore) ListDaggerheartAdversaryFeatureStates(_ context.Context, campaignID string) ([]storage.DaggerheartAdversaryFeatureState, error) {
+	var states []storage.DaggerheartAdversaryFeatureState
+	for _, state := range s.features {
+		if state.CampaignID == campaignID {
+			states = append(states, state)
+		}
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].AdversaryID != states[j].AdversaryID {
+			return states[i].AdversaryID < states[j].AdversaryID
+		}
+		return states[i].FeatureID < states[j].FeatureID
+	})
+	return states, nil
+}
+
 type fakeEventStore struct {
 	events  map[string][]event.Event
 	byHash  map[string]event.Event
@@ -159,19 +317,35 @@ func newFakeActionEventStore() *fakeEventStore {
 	}
 }
 
-func (s *fakeEventStore) AppendEvent(_ context.Context, evt event.Event) (event.Event, error) {
+func (s *fakeEventStore) AppendEvent(ctx context.Context, evt event.Event) (event.Event, error) {
 	seq := s.nextSeq[evt.CampaignID]
 	if seq == 0 {
 		seq = 1
 	}
+	if err := storage.CheckExpectedLastSeq(ctx, evt.CampaignID, seq-1); err != nil {
+		return event.Event{}, err
+	}
 	evt.Seq = seq
 	evt.Hash = "fakehash"
 	s.nextSeq[evt.CampaignID] = seq + 1
 	s.events[evt.CampaignID] = append(s.events[evt.CampaignID], evt)
 	s.byHash[evt.Hash] = evt
+	storage.RecordAppendedEvents(ctx, evt)
 	return evt, nil
 }
 
+func (s *fakeEventStore) AppendEvents(ctx context.Context, evts []event.Event) ([]event.Event, error) {
+	stored := make([]event.Event, 0, len(evts))
+	for _, evt := range evts {
+		appended, err := s.AppendEvent(ctx, evt)
+		if err != nil {
+			return nil, err
+		}
+		stored = append(stored, appended)
+	}
+	return stored, nil
+}
+
 func (s *fakeEventStore) GetEventByHash(_ context.Context, hash string) (event.Event, error) {
 	evt, ok := s.byHash[hash]
 	if !ok {
@@ -252,8 +426,15 @@ func (s *fakeCharacterStore) DeleteCharacter(_ context.Context, _, _ string) err
 	return nil
 }
 
-func (s *fakeCharacterStore) ListCharacters(_ context.Context, _ string, _ int, _ string) (storage.CharacterPage, error) {
-	return storage.CharacterPage{}, nil
+func (s *fakeCharacterStore) ListCharacters(_ context.Context, campaignID string, _ int, _ string) (storage.CharacterPage, error) {
+	page := storage.CharacterPage{}
+	for _, c := range s.characters {
+		if c.CampaignID == campaignID {
+			page.Characters = append(page.Characters, c)
+		}
+	}
+	sort.Slice(page.Characters, func(i, j int) bool { return page.Characters[i].ID < page.Characters[j].ID })
+	return page, nil
 }
 
 type fakeSessionGateStore struct{}
@@ -570,6 +751,23 @@ func TestSwapLoadout_Success(t *testing.T) {
 	}
 }
 
+func TestSwapLoadout_ActiveLoadoutFull(t *testing.T) {
+	svc := newActionTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.LoadoutActive = []string{"card-a", "card-b", "card-c", "card-d", "card-e"}
+	profile.LoadoutVault = []string{"card-1"}
+	dhStore.profiles["camp-1:char-1"] = profile
+
+	ctx := contextWithSessionID("sess-1")
+	_, err := svc.SwapLoadout(ctx, &pb.DaggerheartSwapLoadoutRequest{
+		CampaignId:  "camp-1",
+		CharacterId: "char-1",
+		Swap:        &pb.DaggerheartLoadoutSwapRequest{CardId: "card-1"},
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
 func TestSwapLoadout_WithRecallCost(t *testing.T) {
 	svc := newActionTestService()
 	ctx := contextWithSessionID("sess-1")
@@ -1559,6 +1757,102 @@ func TestApplyAdversaryDamage_DirectDamage(t *testing.T) {
 	}
 }
 
+func newMinionDamageTestService() *DaggerheartService {
+	svc := newAdversaryDamageTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	for _, id := range []string{"imp-1", "imp-2", "imp-3"} {
+		dhStore.adversaries["camp-1:"+id] = storage.DaggerheartAdversary{
+			AdversaryID:     id,
+			CampaignID:      "camp-1",
+			SessionID:       "sess-1",
+			Name:            "Imp",
+			HP:              1,
+			HPMax:           1,
+			Major:           1,
+			Severe:          1,
+			MinionThreshold: 3,
+		}
+	}
+	return svc
+}
+
+func TestApplyAdversaryDamage_MinionOverflow(t *testing.T) {
+	svc := newMinionDamageTestService()
+	ctx := contextWithSessionID("sess-1")
+	resp, err := svc.ApplyAdversaryDamage(ctx, &pb.DaggerheartApplyAdversaryDamageRequest{
+		CampaignId:        "camp-1",
+		AdversaryId:       "imp-1",
+		MinionOverflowIds: []string{"imp-2", "imp-3"},
+		Damage: &pb.DaggerheartDamageRequest{
+			Amount:     7,
+			DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL,
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyAdversaryDamage returned error: %v", err)
+	}
+	if resp.GetMinionOverflow() != 2 {
+		t.Fatalf("minion_overflow = %d, want 2", resp.GetMinionOverflow())
+	}
+	if got := resp.GetDefeatedAdversaryIds(); len(got) != 3 || got[0] != "imp-1" {
+		t.Fatalf("defeated_adversary_ids = %v, want [imp-1 imp-2 imp-3]", got)
+	}
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	for _, id := range []string{"imp-1", "imp-2", "imp-3"} {
+		if hp := dhStore.adversaries["camp-1:"+id].HP; hp != 0 {
+			t.Fatalf("%s hp = %d, want 0", id, hp)
+		}
+	}
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	var overflow []daggerheart.AdversaryDamageAppliedPayload
+	for _, evt := range eventStore.events["camp-1"] {
+		if evt.Type != daggerheart.EventTypeAdversaryDamageApplied {
+			continue
+		}
+		var payload daggerheart.AdversaryDamageAppliedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			t.Fatalf("decode damage payload: %v", err)
+		}
+		if payload.OverflowFromID != "" {
+			overflow = append(overflow, payload)
+		}
+	}
+	if len(overflow) != 2 || overflow[0].OverflowFromID != "imp-1" || !overflow[0].MinionDefeated {
+		t.Fatalf("overflow payloads = %+v", overflow)
+	}
+}
+
+func TestApplyAdversaryDamage_MinionOverflowExceeded(t *testing.T) {
+	svc := newMinionDamageTestService()
+	ctx := contextWithSessionID("sess-1")
+	_, err := svc.ApplyAdversaryDamage(ctx, &pb.DaggerheartApplyAdversaryDamageRequest{
+		CampaignId:        "camp-1",
+		AdversaryId:       "imp-1",
+		MinionOverflowIds: []string{"imp-2", "imp-3"},
+		Damage: &pb.DaggerheartDamageRequest{
+			Amount:     5,
+			DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL,
+		},
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestApplyAdversaryDamage_MinionOverflowRequiresMinion(t *testing.T) {
+	svc := newMinionDamageTestService()
+	ctx := contextWithSessionID("sess-1")
+	_, err := svc.ApplyAdversaryDamage(ctx, &pb.DaggerheartApplyAdversaryDamageRequest{
+		CampaignId:        "camp-1",
+		AdversaryId:       "adv-1",
+		MinionOverflowIds: []string{"imp-2"},
+		Damage: &pb.DaggerheartDamageRequest{
+			Amount:     9,
+			DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL,
+		},
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
 // --- ApplyAdversaryConditions tests ---
 
 func TestApplyAdversaryConditions_MissingStores(t *testing.T) {
@@ -1864,6 +2158,152 @@ func TestSessionActionRoll_WithModifiers(t *testing.T) {
 	}
 }
 
+func putHelperCharacter(t *testing.T, svc *DaggerheartService, characterID string, kind character.CharacterKind) {
+	t.Helper()
+	charStore := svc.stores.Character.(*fakeCharacterStore)
+	if err := charStore.PutCharacter(context.Background(), character.Character{ID: characterID, CampaignID: "camp-1", Kind: kind}); err != nil {
+		t.Fatalf("put character: %v", err)
+	}
+}
+
+func TestSessionActionRoll_HelpAndExperience(t *testing.T) {
+	svc := newActionTestService()
+	putHelperCharacter(t, svc, "char-2", character.CharacterKindPC)
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.Experiences = []storage.DaggerheartExperience{{Name: "Wanderer", Modifier: 2}}
+	dhStore.profiles["camp-1:char-1"] = profile
+
+	resp, err := svc.SessionActionRoll(context.Background(), &pb.SessionActionRollRequest{
+		CampaignId:         "camp-1",
+		SessionId:          "sess-1",
+		CharacterId:        "char-1",
+		Trait:              "instinct",
+		Difficulty:         10,
+		HelperCharacterIds: []string{"char-2"},
+		Experiences:        []string{"wanderer"},
+	})
+	if err != nil {
+		t.Fatalf("SessionActionRoll returned error: %v", err)
+	}
+	if len(resp.GetHelperDice()) != 1 || resp.GetHelpBonus() != resp.GetHelperDice()[0] {
+		t.Fatalf("helper dice = %v, help bonus = %d", resp.GetHelperDice(), resp.GetHelpBonus())
+	}
+	if resp.GetExperienceBonus() != 2 {
+		t.Fatalf("experience bonus = %d, want 2", resp.GetExperienceBonus())
+	}
+	if got := dhStore.states["camp-1:char-1"].Hope; got != 1 {
+		t.Fatalf("roller hope = %d, want 1", got)
+	}
+	if got := dhStore.states["camp-1:char-2"].Hope; got != 2 {
+		t.Fatalf("helper hope = %d, want 2", got)
+	}
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	events := eventStore.events["camp-1"]
+	if len(events) != 5 {
+		t.Fatalf("events = %d, want 5", len(events))
+	}
+	rollEvent := events[len(events)-1]
+	if rollEvent.Type != event.TypeRollResolved || rollEvent.Seq != resp.GetRollSeq() {
+		t.Fatalf("roll event = %s seq %d, want roll seq %d", rollEvent.Type, rollEvent.Seq, resp.GetRollSeq())
+	}
+	var payload event.RollResolvedPayload
+	if err := json.Unmarshal(rollEvent.PayloadJSON, &payload); err != nil {
+		t.Fatalf("decode roll payload: %v", err)
+	}
+	for _, key := range []string{"helpers", "experiences", "hope_spends"} {
+		if _, ok := payload.SystemData[key]; !ok {
+			t.Fatalf("roll payload missing %s: %v", key, payload.SystemData)
+		}
+	}
+}
+
+func TestSessionActionRoll_RejectsExperiencesWithExperienceModifier(t *testing.T) {
+	svc := newActionTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.Experiences = []storage.DaggerheartExperience{{Name: "Wanderer", Modifier: 2}}
+	dhStore.profiles["camp-1:char-1"] = profile
+	hopeBefore := dhStore.states["camp-1:char-1"].Hope
+
+	_, err := svc.SessionActionRoll(context.Background(), &pb.SessionActionRollRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Trait:       "instinct",
+		Difficulty:  10,
+		Modifiers:   []*pb.ActionRollModifier{{Value: 2, Source: "experience"}},
+		Experiences: []string{"wanderer"},
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+	if got := dhStore.states["camp-1:char-1"].Hope; got != hopeBefore {
+		t.Fatalf("roller hope = %d, want %d", got, hopeBefore)
+	}
+	if events := svc.stores.Event.(*fakeEventStore).events["camp-1"]; len(events) != 0 {
+		t.Fatalf("events = %d, want 0", len(events))
+	}
+}
+
+func TestSessionActionRoll_HelpValidation(t *testing.T) {
+	svc := newActionTestService()
+	req := &pb.SessionActionRollRequest{
+		CampaignId:         "camp-1",
+		SessionId:          "sess-1",
+		CharacterId:        "char-1",
+		Trait:              "instinct",
+		Difficulty:         10,
+		HelperCharacterIds: []string{"char-1"},
+	}
+	_, err := svc.SessionActionRoll(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	req.HelperCharacterIds = []string{"char-2"}
+	req.RollKind = pb.RollKind_ROLL_KIND_REACTION
+	_, err = svc.SessionActionRoll(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	req.RollKind = pb.RollKind_ROLL_KIND_ACTION
+	_, err = svc.SessionActionRoll(context.Background(), req)
+	assertStatusCode(t, err, codes.NotFound)
+
+	putHelperCharacter(t, svc, "char-2", character.CharacterKindNPC)
+	_, err = svc.SessionActionRoll(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	putHelperCharacter(t, svc, "char-2", character.CharacterKindPC)
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	helper := dhStore.states["camp-1:char-2"]
+	helper.LifeState = daggerheart.LifeStateUnconscious
+	dhStore.states["camp-1:char-2"] = helper
+	_, err = svc.SessionActionRoll(context.Background(), req)
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	helper.LifeState = daggerheart.LifeStateAlive
+	helper.Hope = 0
+	dhStore.states["camp-1:char-2"] = helper
+	_, err = svc.SessionActionRoll(context.Background(), req)
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	if len(eventStore.events["camp-1"]) != 0 {
+		t.Fatalf("expected no events, got %d", len(eventStore.events["camp-1"]))
+	}
+}
+
+func TestSessionActionRoll_UnknownExperience(t *testing.T) {
+	svc := newActionTestService()
+	_, err := svc.SessionActionRoll(context.Background(), &pb.SessionActionRollRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Trait:       "instinct",
+		Difficulty:  10,
+		Experiences: []string{"Sailor"},
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
 // --- SessionDamageRoll tests ---
 
 func TestSessionDamageRoll_MissingStores(t *testing.T) {
@@ -2553,6 +2993,162 @@ func TestSessionAttackFlow_Success(t *testing.T) {
 	}
 }
 
+func TestSessionActionRoll_ExtraFearDie(t *testing.T) {
+	svc := newActionTestService()
+	req := &pb.SessionActionRollRequest{
+		CampaignId:   "camp-1",
+		SessionId:    "sess-1",
+		CharacterId:  "char-1",
+		Trait:        "knowledge",
+		Difficulty:   10,
+		ExtraFearDie: true,
+	}
+	resp, err := svc.SessionActionRoll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("SessionActionRoll returned error: %v", err)
+	}
+	dice := resp.GetFearDice()
+	if len(dice) != 2 || resp.GetFearDie() != max(dice[0], dice[1]) {
+		t.Fatalf("fear dice = %v, fear die = %d", dice, resp.GetFearDie())
+	}
+
+	req.RollKind = pb.RollKind_ROLL_KIND_REACTION
+	_, err = svc.SessionActionRoll(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func newSpellcastTestService() *DaggerheartService {
+	svc := newActionTestService()
+	content := newFakeContentStore()
+	content.domainCards["card.bolt"] = storage.DaggerheartDomainCard{ID: "card.bolt", DomainID: "domain.arcana"}
+	content.domainCards["card.mend"] = storage.DaggerheartDomainCard{ID: "card.mend", DomainID: "domain.sage"}
+	content.subclasses["subclass.warden"] = storage.DaggerheartSubclass{ID: "subclass.warden", SpellcastTrait: "Instinct"}
+	content.subclasses["subclass.elemental"] = storage.DaggerheartSubclass{ID: "subclass.elemental", SpellcastTrait: "Knowledge"}
+	svc.stores.DaggerheartContent = content
+
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.Instinct = 1
+	profile.Knowledge = 2
+	profile.ClassID = "class.druid"
+	profile.SubclassID = "subclass.warden"
+	profile.MulticlassClassID = "class.sorcerer"
+	profile.MulticlassSubclassID = "subclass.elemental"
+	profile.MulticlassDomainID = "domain.arcana"
+	profile.LoadoutActive = []string{"card.bolt", "card.mend"}
+	dhStore.profiles["camp-1:char-1"] = profile
+	return svc
+}
+
+func TestSessionSpellcastFlow_MissingStores(t *testing.T) {
+	svc := &DaggerheartService{}
+	_, err := svc.SessionSpellcastFlow(context.Background(), &pb.SessionSpellcastFlowRequest{
+		CampaignId: "c1",
+	})
+	assertStatusCode(t, err, codes.Internal)
+}
+
+func TestSessionSpellcastFlow_MissingDomainCardId(t *testing.T) {
+	svc := newSpellcastTestService()
+	_, err := svc.SessionSpellcastFlow(context.Background(), &pb.SessionSpellcastFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestSessionSpellcastFlow_Preconditions(t *testing.T) {
+	svc := newSpellcastTestService()
+	req := &pb.SessionSpellcastFlowRequest{
+		CampaignId:   "camp-1",
+		SessionId:    "sess-1",
+		CharacterId:  "char-1",
+		DomainCardId: "card.vault",
+		Difficulty:   10,
+	}
+	_, err := svc.SessionSpellcastFlow(context.Background(), req)
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	req.DomainCardId = "card.mend"
+	req.HopeCost = -1
+	_, err = svc.SessionSpellcastFlow(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	req.HopeCost = 3
+	_, err = svc.SessionSpellcastFlow(context.Background(), req)
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	content := svc.stores.DaggerheartContent.(*fakeContentStore)
+	content.subclasses["subclass.warden"] = storage.DaggerheartSubclass{ID: "subclass.warden"}
+	req.HopeCost = 0
+	_, err = svc.SessionSpellcastFlow(context.Background(), req)
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	if len(eventStore.events["camp-1"]) != 0 {
+		t.Fatalf("expected no events, got %d", len(eventStore.events["camp-1"]))
+	}
+}
+
+func TestSessionSpellcastFlow_Success(t *testing.T) {
+	svc := newSpellcastTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-spell-1")
+	resp, err := svc.SessionSpellcastFlow(ctx, &pb.SessionSpellcastFlowRequest{
+		CampaignId:     "camp-1",
+		SessionId:      "sess-1",
+		CharacterId:    "char-1",
+		DomainCardId:   "card.bolt",
+		Difficulty:     1,
+		HopeCost:       1,
+		ExtraFearDie:   true,
+		DamageDieSides: 8,
+	})
+	if err != nil {
+		t.Fatalf("SessionSpellcastFlow returned error: %v", err)
+	}
+	if resp.GetSpellcastTrait() != "knowledge" || resp.GetTraitValue() != 2 || resp.GetSubclassId() != "subclass.elemental" {
+		t.Fatalf("spellcast = %s %d via %s, want knowledge 2 via subclass.elemental",
+			resp.GetSpellcastTrait(), resp.GetTraitValue(), resp.GetSubclassId())
+	}
+	if len(resp.GetActionRoll().GetFearDice()) != 2 {
+		t.Fatalf("fear dice = %v, want 2 dice", resp.GetActionRoll().GetFearDice())
+	}
+	if resp.GetDamageRoll() == nil {
+		t.Fatal("expected damage roll on a successful cast")
+	}
+	if rolls := resp.GetDamageRoll().GetRolls(); len(rolls) != 1 || len(rolls[0].GetResults()) != 2 {
+		t.Fatalf("damage rolls = %v, want 2d8", rolls)
+	}
+
+	wantHope := 2 - 1
+	if resp.GetActionRoll().GetFlavor() == "HOPE" {
+		wantHope++
+	}
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	if got := dhStore.states["camp-1:char-1"].Hope; got != wantHope {
+		t.Fatalf("hope = %d, want %d", got, wantHope)
+	}
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	var spellcast *event.Event
+	for i, evt := range eventStore.events["camp-1"] {
+		if evt.Type == daggerheart.EventTypeSpellcastResolved {
+			spellcast = &eventStore.events["camp-1"][i]
+		}
+	}
+	if spellcast == nil {
+		t.Fatal("expected spellcast resolved event")
+	}
+	var payload daggerheart.SpellcastResolvedPayload
+	if err := json.Unmarshal(spellcast.PayloadJSON, &payload); err != nil {
+		t.Fatalf("decode spellcast payload: %v", err)
+	}
+	if payload.RollSeq != resp.GetActionRoll().GetRollSeq() || payload.DomainCardID != "card.bolt" || payload.HopeCost != 1 {
+		t.Fatalf("spellcast payload = %+v", payload)
+	}
+}
+
 func TestSessionAdversaryAttackFlow_Success(t *testing.T) {
 	svc := newAdversaryDamageTestService()
 	ctx := grpcmeta.WithRequestID(context.Background(), "req-adv-attack-1")
@@ -2578,6 +3174,251 @@ func TestSessionAdversaryAttackFlow_Success(t *testing.T) {
 	}
 }
 
+func TestSessionAdversaryAttackFlow_GroupRequiresMinions(t *testing.T) {
+	svc := newMinionDamageTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-adv-group-1")
+	_, err := svc.SessionAdversaryAttackFlow(ctx, &pb.SessionAdversaryAttackFlowRequest{
+		CampaignId:        "camp-1",
+		SessionId:         "sess-1",
+		AdversaryId:       "imp-1",
+		TargetId:          "char-1",
+		Difficulty:        10,
+		GroupAdversaryIds: []string{"adv-1"},
+		Damage: &pb.DaggerheartAttackDamageSpec{
+			DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL,
+		},
+		DamageDice: []*pb.DiceSpec{{Sides: 6, Count: 1}},
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestSessionAdversaryAttackFlow_GroupAttack(t *testing.T) {
+	svc := newMinionDamageTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	dhStore.profiles["camp-1:char-1"] = storage.DaggerheartCharacterProfile{
+		CampaignID:      "camp-1",
+		CharacterID:     "char-1",
+		HpMax:           6,
+		StressMax:       6,
+		MajorThreshold:  10,
+		SevereThreshold: 20,
+	}
+	dhStore.states["camp-1:char-1"] = storage.DaggerheartCharacterState{
+		CampaignID:  "camp-1",
+		CharacterID: "char-1",
+		Hp:          6,
+		LifeState:   daggerheart.LifeStateAlive,
+	}
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-adv-group-2")
+	resp, err := svc.SessionAdversaryAttackFlow(ctx, &pb.SessionAdversaryAttackFlowRequest{
+		CampaignId:        "camp-1",
+		SessionId:         "sess-1",
+		AdversaryId:       "imp-1",
+		TargetId:          "char-1",
+		Difficulty:        0,
+		AttackModifier:    20,
+		GroupAdversaryIds: []string{"imp-2", "imp-3"},
+		Damage: &pb.DaggerheartAttackDamageSpec{
+			DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL,
+		},
+		DamageDice:     []*pb.DiceSpec{{Sides: 6, Count: 1}},
+		DamageModifier: 1,
+	})
+	if err != nil {
+		t.Fatalf("SessionAdversaryAttackFlow returned error: %v", err)
+	}
+	if got := resp.GetGroupAdversaryIds(); len(got) != 3 || got[0] != "imp-1" {
+		t.Fatalf("group_adversary_ids = %v, want leader first", got)
+	}
+	if resp.GetDamageRoll() == nil {
+		t.Fatal("expected damage roll in response")
+	}
+	rolls := resp.GetDamageRoll().GetRolls()
+	if len(rolls) != 1 || len(rolls[0].GetResults()) != 3 {
+		t.Fatalf("damage rolls = %v, want three d6", rolls)
+	}
+	if got := resp.GetDamageRoll().GetModifier(); got != 3 {
+		t.Fatalf("damage modifier = %d, want 3", got)
+	}
+}
+
+func newMultiAttackTestService() *DaggerheartService {
+	svc := newActionTestService()
+	base := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	dhStore := newFakeDaggerheartAdversaryStore()
+	for key, profile := range base.profiles {
+		profile.MajorThreshold = 3
+		profile.SevereThreshold = 6
+		dhStore.profiles[key] = profile
+	}
+	for key, state := range base.states {
+		state.Armor = 1
+		dhStore.states[key] = state
+	}
+	for _, id := range []string{"adv-1", "adv-2"} {
+		dhStore.adversaries["camp-1:"+id] = storage.DaggerheartAdversary{
+			AdversaryID: id,
+			CampaignID:  "camp-1",
+			SessionID:   "sess-1",
+			Name:        "Orc",
+			HP:          8,
+			HPMax:       8,
+			StressMax:   3,
+			Evasion:     10,
+			Major:       4,
+			Severe:      7,
+		}
+	}
+	svc.stores.Daggerheart = dhStore
+	return svc
+}
+
+func TestSessionMultiAttackFlow_MissingStores(t *testing.T) {
+	svc := &DaggerheartService{}
+	_, err := svc.SessionMultiAttackFlow(context.Background(), &pb.SessionMultiAttackFlowRequest{})
+	assertStatusCode(t, err, codes.Internal)
+}
+
+func TestSessionMultiAttackFlow_InvalidTargets(t *testing.T) {
+	damage := &pb.DaggerheartAttackDamageSpec{DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_MAGIC}
+	dice := []*pb.DiceSpec{{Sides: 6, Count: 2}}
+	tests := []struct {
+		name    string
+		targets []*pb.MultiAttackTarget
+	}{
+		{name: "no targets"},
+		{name: "duplicate target", targets: []*pb.MultiAttackTarget{{TargetId: "adv-1"}, {TargetId: "adv-1"}}},
+		{name: "self target", targets: []*pb.MultiAttackTarget{{TargetId: "char-1"}}},
+		{name: "decline armor on adversary", targets: []*pb.MultiAttackTarget{{TargetId: "adv-1", DeclineArmor: true}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newMultiAttackTestService()
+			ctx := grpcmeta.WithRequestID(context.Background(), "req-multi-invalid")
+			_, err := svc.SessionMultiAttackFlow(ctx, &pb.SessionMultiAttackFlowRequest{
+				CampaignId:  "camp-1",
+				SessionId:   "sess-1",
+				CharacterId: "char-1",
+				Trait:       "instinct",
+				Targets:     tt.targets,
+				Damage:      damage,
+				DamageDice:  dice,
+			})
+			assertStatusCode(t, err, codes.InvalidArgument)
+		})
+	}
+}
+
+func TestSessionMultiAttackFlow_PerTargetResults(t *testing.T) {
+	svc := newMultiAttackTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-multi-1")
+	seed := uint64(7)
+	resp, err := svc.SessionMultiAttackFlow(ctx, &pb.SessionMultiAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Trait:       "instinct",
+		Targets: []*pb.MultiAttackTarget{
+			{TargetId: "adv-1", Difficulty: wrapperspb.Int32(0)},
+			{TargetId: "adv-2", Difficulty: wrapperspb.Int32(40)},
+		},
+		Damage:     &pb.DaggerheartAttackDamageSpec{DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_MAGIC},
+		DamageDice: []*pb.DiceSpec{{Sides: 6, Count: 2}},
+		ActionRng:  &commonv1.RngRequest{Seed: &seed, RollMode: commonv1.RollMode_REPLAY},
+	})
+	if err != nil {
+		t.Fatalf("SessionMultiAttackFlow returned error: %v", err)
+	}
+	results := resp.GetResults()
+	if len(results) != 2 {
+		t.Fatalf("results = %d, want 2", len(results))
+	}
+	if !results[0].GetHit() || results[0].GetTargetType() != daggerheart.MultiAttackTargetAdversary {
+		t.Fatalf("first result = %+v, want adversary hit", results[0])
+	}
+	if results[1].GetHit() != resp.GetActionRoll().GetCrit() {
+		t.Fatalf("second result hit = %v, want %v", results[1].GetHit(), resp.GetActionRoll().GetCrit())
+	}
+	if resp.GetDamageRoll() == nil {
+		t.Fatal("expected one damage roll")
+	}
+
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	if got := 8 - dhStore.adversaries["camp-1:adv-1"].HP; got != int(results[0].GetHpMarked()) {
+		t.Fatalf("adv-1 hp marked = %d, want %d", got, results[0].GetHpMarked())
+	}
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	var payload daggerheart.MultiAttackResolvedPayload
+	found := false
+	for _, evt := range eventStore.events["camp-1"] {
+		if evt.Type == daggerheart.EventTypeMultiAttackResolved {
+			if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+				t.Fatalf("decode multi attack payload: %v", err)
+			}
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("expected multi attack resolved event")
+	}
+	if payload.RollSeq != resp.GetActionRoll().GetRollSeq() || len(payload.Targets) != 2 || payload.DamageRollSeq == nil {
+		t.Fatalf("multi attack payload = %+v", payload)
+	}
+}
+
+func TestSessionAdversaryMultiAttackFlow_StressAndArmorChoice(t *testing.T) {
+	svc := newMultiAttackTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-adv-multi-1")
+	resp, err := svc.SessionAdversaryMultiAttackFlow(ctx, &pb.SessionAdversaryMultiAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		AdversaryId: "adv-1",
+		Targets: []*pb.MultiAttackTarget{
+			{TargetId: "char-1", DeclineArmor: true},
+			{TargetId: "char-2"},
+		},
+		AttackModifier: 30,
+		StressCost:     1,
+		Damage:         &pb.DaggerheartAttackDamageSpec{DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL},
+		DamageDice:     []*pb.DiceSpec{{Sides: 6, Count: 1}},
+		DamageModifier: 3,
+	})
+	if err != nil {
+		t.Fatalf("SessionAdversaryMultiAttackFlow returned error: %v", err)
+	}
+	for _, result := range resp.GetResults() {
+		if !result.GetHit() || result.GetTargetType() != daggerheart.MultiAttackTargetCharacter {
+			t.Fatalf("result = %+v, want character hit", result)
+		}
+	}
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	if got := dhStore.adversaries["camp-1:adv-1"].Stress; got != 1 {
+		t.Fatalf("adversary stress = %d, want 1", got)
+	}
+	if got := dhStore.states["camp-1:char-1"].Armor; got != 1 {
+		t.Fatalf("char-1 armor = %d, want 1 (declined)", got)
+	}
+	if got := resp.GetResults()[1].GetArmorSpent(); got != 1 {
+		t.Fatalf("char-2 armor spent = %d, want 1", got)
+	}
+}
+
+func TestSessionAdversaryMultiAttackFlow_InsufficientStress(t *testing.T) {
+	svc := newMultiAttackTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-adv-multi-2")
+	_, err := svc.SessionAdversaryMultiAttackFlow(ctx, &pb.SessionAdversaryMultiAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		AdversaryId: "adv-1",
+		Targets:     []*pb.MultiAttackTarget{{TargetId: "char-1"}},
+		StressCost:  4,
+		Damage:      &pb.DaggerheartAttackDamageSpec{DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL},
+		DamageDice:  []*pb.DiceSpec{{Sides: 6, Count: 1}},
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
 func TestSessionGroupActionFlow_Success(t *testing.T) {
 	svc := newActionTestService()
 	ctx := grpcmeta.WithRequestID(context.Background(), "req-group-1")
@@ -40,8 +40,9 @@ func (s *DaggerheartService) CreateAdversary(ctx context.Context, in *pb.Daggerh
 	if campaignID == "" {
 		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
 	}
+	entryID := strings.TrimSpace(in.GetAdversaryEntryId())
 	name := strings.TrimSpace(in.GetName())
-	if name == "" {
+	if name == "" && entryID == "" {
 		return nil, status.Error(codes.InvalidArgument, "name is required")
 	}
 	kind := strings.TrimSpace(in.GetKind())
@@ -50,6 +51,31 @@ func (s *DaggerheartService) CreateAdversary(ctx context.Context, in *pb.Daggerh
 	if in.SessionId != nil {
 		sessionID = strings.TrimSpace(in.SessionId.GetValue())
 	}
+	count, err := normalizeSpawnCount(in.GetCount(), in.GetNames())
+	if err != nil {
+		return nil, err
+	}
+
+	var entry storage.DaggerheartAdversaryEntry
+	var base *adversaryStats
+	if entryID != "" {
+		if s.stores.DaggerheartContent == nil {
+			return nil, status.Error(codes.Internal, "daggerheart content store is not configured")
+		}
+		var err error
+		entry, err = s.stores.DaggerheartContent.GetDaggerheartAdversaryEntry(ctx, entryID)
+		if err != nil {
+			return nil, contentLookupError("adversary entry", entryID, err)
+		}
+		if name == "" {
+			name = entry.Name
+		}
+		if kind == "" {
+			kind = entry.Role
+		}
+		catalogStats := catalogAdversaryStats(entry)
+		base = &catalogStats
+	}
 
 	stats, err := normalizeAdversaryStats(adversaryStatsInput{
 		HP:            in.Hp,
@@ -60,12 +86,21 @@ func (s *DaggerheartService) CreateAdversary(ctx context.Context, in *pb.Daggerh
 		Major:         in.MajorThreshold,
 		Severe:        in.SevereThreshold,
 		Armor:         in.Armor,
+		Minion:        in.MinionThreshold,
 		RequireFields: false,
+		Base:          base,
 	})
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	names := daggerheart.SpawnNames(name, count)
+	for i, override := range in.GetNames() {
+		if override = strings.TrimSpace(override); override != "" {
+			names[i] = override
+		}
+	}
+
 	c, err := s.stores.Campaign.Get(ctx, campaignID)
 	if err != nil {
 		return nil, handleDomainError(err)
@@ -89,62 +124,79 @@ func (s *DaggerheartService) CreateAdversary(ctx context.Context, in *pb.Daggerh
 		}
 	}
 
-	adversaryID, err := id.NewID()
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "generate adversary id: %v", err)
-	}
+	// Build every event first so a group is created in one atomic append.
+	events := make([]event.Event, 0, len(names))
+	adversaryIDs := make([]string, 0, len(names))
+	for _, adversaryName := range names {
+		adversaryID, err := id.NewID()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "generate adversary id: %v", err)
+		}
 
-	payload := daggerheart.AdversaryCreatedPayload{
-		AdversaryID: adversaryID,
-		Name:        name,
-		Kind:        kind,
-		SessionID:   sessionID,
-		Notes:       notes,
-		HP:          stats.HP,
-		HPMax:       stats.HPMax,
-		Stress:      stats.Stress,
-		StressMax:   stats.StressMax,
-		Evasion:     stats.Evasion,
-		Major:       stats.Major,
-		Severe:      stats.Severe,
-		Armor:       stats.Armor,
-	}
-	payloadJSON, err := json.Marshal(payload)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "encode adversary payload: %v", err)
-	}
+		payload := daggerheart.AdversaryCreatedPayload{}
+		if entryID != "" {
+			payload = catalogAdversaryPayload(entry)
+		}
+		payload.AdversaryID = adversaryID
+		payload.Name = adversaryName
+		payload.Kind = kind
+		payload.SessionID = sessionID
+		payload.Notes = notes
+		payload.HP = stats.HP
+		payload.HPMax = stats.HPMax
+		payload.Stress = stats.Stress
+		payload.StressMax = stats.StressMax
+		payload.Evasion = stats.Evasion
+		payload.Major = stats.Major
+		payload.Severe = stats.Severe
+		payload.Armor = stats.Armor
+		payload.MinionThreshold = stats.MinionThreshold
+		payloadJSON, err := json.Marshal(payload)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "encode adversary payload: %v", err)
+		}
 
-	stored, err := s.stores.Event.AppendEvent(ctx, event.Event{
-		CampaignID:    campaignID,
-		Timestamp:     time.Now().UTC(),
-		Type:          daggerheart.EventTypeAdversaryCreated,
-		SessionID:     sessionID,
-		RequestID:     grpcmeta.RequestIDFromContext(ctx),
-		InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
-		ActorType:     event.ActorTypeSystem,
-		EntityType:    "adversary",
-		EntityID:      adversaryID,
-		SystemID:      c.System.String(),
-		SystemVersion: daggerheart.SystemVersion,
-		PayloadJSON:   payloadJSON,
-	})
+		events = append(events, event.Event{
+			CampaignID:    campaignID,
+			Timestamp:     time.Now().UTC(),
+			Type:          daggerheart.EventTypeAdversaryCreated,
+			SessionID:     sessionID,
+			RequestID:     grpcmeta.RequestIDFromContext(ctx),
+			InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
+			ActorType:     event.ActorTypeSystem,
+			EntityType:    "adversary",
+			EntityID:      adversaryID,
+			SystemID:      c.System.String(),
+			SystemVersion: daggerheart.SystemVersion,
+			PayloadJSON:   payloadJSON,
+		})
+		adversaryIDs = append(adversaryIDs, adversaryID)
+	}
+
+	stored, err := s.stores.Event.AppendEvents(ctx, events)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "append adversary created event: %v", err)
+		return nil, status.Errorf(codes.Internal, "append adversary created events: %v", err)
 	}
-
 	adapter := daggerheart.NewAdapter(s.stores.Daggerheart)
-	if err := adapter.ApplyEvent(ctx, stored); err != nil {
-		return nil, status.Errorf(codes.Internal, "apply adversary created event: %v", err)
+	for _, evt := range stored {
+		if err := adapter.ApplyEvent(ctx, evt); err != nil {
+			return nil, status.Errorf(codes.Internal, "apply adversary created event: %v", err)
+		}
 	}
 
-	created, err := s.stores.Daggerheart.GetDaggerheartAdversary(ctx, campaignID, adversaryID)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "load adversary: %v", err)
+	response := &pb.DaggerheartCreateAdversaryResponse{
+		Adversaries: make([]*pb.DaggerheartAdversary, 0, len(adversaryIDs)),
+	}
+	for _, adversaryID := range adversaryIDs {
+		created, err := s.stores.Daggerheart.GetDaggerheartAdversary(ctx, campaignID, adversaryID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load adversary: %v", err)
+		}
+		response.Adversaries = append(response.Adversaries, daggerheartAdversaryToProto(created))
 	}
+	response.Adversary = response.Adversaries[0]
 
-	return &pb.DaggerheartCreateAdversaryResponse{
-		Adversary: daggerheartAdversaryToProto(created),
-	}, nil
+	return response, nil
 }
 
 func (s *DaggerheartService) UpdateAdversary(ctx context.Context, in *pb.DaggerheartUpdateAdversaryRequest) (*pb.DaggerheartUpdateAdversaryResponse, error) {
@@ -170,7 +222,7 @@ func (s *DaggerheartService) UpdateAdversary(ctx context.Context, in *pb.Daggerh
 		return nil, status.Error(codes.InvalidArgument, "adversary id is required")
 	}
 	if in.Name == nil && in.Kind == nil && in.SessionId == nil && in.Notes == nil {
-		if in.Hp == nil && in.HpMax == nil && in.Stress == nil && in.StressMax == nil && in.Evasion == nil && in.MajorThreshold == nil && in.SevereThreshold == nil && in.Armor == nil {
+		if in.Hp == nil && in.HpMax == nil && in.Stress == nil && in.StressMax == nil && in.Evasion == nil && in.MajorThreshold == nil && in.SevereThreshold == nil && in.Armor == nil && in.MinionThreshold == nil {
 			return nil, status.Error(codes.InvalidArgument, "at least one field is required")
 		}
 	}
@@ -227,6 +279,7 @@ func (s *DaggerheartService) UpdateAdversary(ctx context.Context, in *pb.Daggerh
 		Major:         in.MajorThreshold,
 		Severe:        in.SevereThreshold,
 		Armor:         in.Armor,
+		Minion:        in.MinionThreshold,
 		RequireFields: false,
 		Current:       &current,
 	})
@@ -247,19 +300,20 @@ func (s *DaggerheartService) UpdateAdversary(ctx context.Context, in *pb.Daggerh
 	}
 
 	payload := daggerheart.AdversaryUpdatedPayload{
-		AdversaryID: adversaryID,
-		Name:        name,
-		Kind:        kind,
-		SessionID:   sessionID,
-		Notes:       notes,
-		HP:          stats.HP,
-		HPMax:       stats.HPMax,
-		Stress:      stats.Stress,
-		StressMax:   stats.StressMax,
-		Evasion:     stats.Evasion,
-		Major:       stats.Major,
-		Severe:      stats.Severe,
-		Armor:       stats.Armor,
+		AdversaryID:     adversaryID,
+		Name:            name,
+		Kind:            kind,
+		SessionID:       sessionID,
+		Notes:           notes,
+		HP:              stats.HP,
+		HPMax:           stats.HPMax,
+		Stress:          stats.Stress,
+		StressMax:       stats.StressMax,
+		Evasion:         stats.Evasion,
+		Major:           stats.Major,
+		Severe:          stats.Severe,
+		Armor:           stats.Armor,
+		MinionThreshold: stats.MinionThreshold,
 	}
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
@@ -489,7 +543,7 @@ func daggerheartAdversaryToProto(adversary storage.DaggerheartAdversary) *pb.Dag
 	if strings.TrimSpace(adversary.SessionID) != "" {
 		sessionID = wrapperspb.String(adversary.SessionID)
 	}
-	return &pb.DaggerheartAdversary{
+	message := &pb.DaggerheartAdversary{
 		Id:              adversary.AdversaryID,
 		CampaignId:      adversary.CampaignID,
 		Name:            adversary.Name,
@@ -504,10 +558,100 @@ func daggerheartAdversaryToProto(adversary storage.DaggerheartAdversary) *pb.Dag
 		MajorThreshold:  int32(adversary.Major),
 		SevereThreshold: int32(adversary.Severe),
 		Armor:           int32(adversary.Armor),
+		MinionThreshold: int32(adversary.MinionThreshold),
 		Conditions:      daggerheartConditionsToProto(adversary.Conditions),
 		CreatedAt:       timestamppb.New(adversary.CreatedAt),
 		UpdatedAt:       timestamppb.New(adversary.UpdatedAt),
 	}
+	if adversary.AdversaryEntryID != "" {
+		message.AdversaryEntryId = adversary.AdversaryEntryID
+		message.AttackModifier = int32(adversary.AttackModifier)
+		message.StandardAttack = toProtoDaggerheartAdversaryAttack(adversary.StandardAttack)
+		message.Experiences = toProtoDaggerheartAdversaryExperiences(adversary.Experiences)
+		message.Features = toProtoDaggerheartAdversaryFeatures(adversary.Features)
+	}
+	return message
+}
+
+// normalizeSpawnCount resolves how many adversaries a request creates: the
+// requested count, or one per name when unset, capped at MaxSpawnCount.
+func normalizeSpawnCount(requested int32, names []string) (int, error) {
+	if requested < 0 {
+		return 0, status.Error(codes.InvalidArgument, "count must be non-negative")
+	}
+	count := int(requested)
+	if count == 0 {
+		count = max(1, len(names))
+	}
+	if count > daggerheart.MaxSpawnCount {
+		return 0, status.Errorf(codes.InvalidArgument, "count must not exceed %d", daggerheart.MaxSpawnCount)
+	}
+	if len(names) > count {
+		return 0, status.Error(codes.InvalidArgument, "names must not exceed count")
+	}
+	return count, nil
+}
+
+// catalogAdversaryStats returns the stat block of a catalog adversary entry.
+func catalogAdversaryStats(entry storage.DaggerheartAdversaryEntry) adversaryStats {
+	stats := adversaryStats{
+		HP:        entry.HP,
+		HPMax:     entry.HP,
+		StressMax: entry.Stress,
+		Evasion:   entry.Difficulty,
+		Major:     entry.MajorThreshold,
+		Severe:    entry.SevereThreshold,
+		Armor:     entry.Armor,
+	}
+	for _, feature := range entry.Features {
+		if threshold := daggerheart.MinionThresholdFromFeature(feature.Name); threshold > 0 {
+			stats.MinionThreshold = threshold
+			break
+		}
+	}
+	return stats
+}
+
+// catalogAdversaryPayload copies a catalog adversary entry into a created
+// payload: its stat block plus the link, attack, experiences and features.
+// Callers fill in the id, name, kind and session.
+func catalogAdversaryPayload(entry storage.DaggerheartAdversaryEntry) daggerheart.AdversaryCreatedPayload {
+	stats := catalogAdversaryStats(entry)
+	payload := daggerheart.AdversaryCreatedPayload{
+		HP:               stats.HP,
+		HPMax:            stats.HPMax,
+		StressMax:        stats.StressMax,
+		Evasion:          stats.Evasion,
+		Major:            stats.Major,
+		Severe:           stats.Severe,
+		Armor:            stats.Armor,
+		MinionThreshold:  stats.MinionThreshold,
+		AdversaryEntryID: entry.ID,
+		AttackModifier:   entry.AttackModifier,
+		StandardAttack: &daggerheart.AdversaryAttack{
+			Name:        entry.StandardAttack.Name,
+			Range:       entry.StandardAttack.Range,
+			DamageBonus: entry.StandardAttack.DamageBonus,
+			DamageType:  entry.StandardAttack.DamageType,
+		},
+	}
+	for _, die := range entry.StandardAttack.DamageDice {
+		payload.StandardAttack.DamageDice = append(payload.StandardAttack.DamageDice, daggerheart.AdversaryDamageDie{Sides: die.Sides, Count: die.Count})
+	}
+	for _, experience := range entry.Experiences {
+		payload.Experiences = append(payload.Experiences, daggerheart.AdversaryExperience{Name: experience.Name, Modifier: experience.Modifier})
+	}
+	for _, feature := range entry.Features {
+		payload.Features = append(payload.Features, daggerheart.AdversaryFeature{
+			ID:          feature.ID,
+			Name:        feature.Name,
+			Kind:        feature.Kind,
+			Description: feature.Description,
+			CostType:    feature.CostType,
+			Cost:        feature.Cost,
+		})
+	}
+	return payload
 }
 
 type adversaryStatsInput struct {
@@ -519,8 +663,12 @@ type adversaryStatsInput struct {
 	Major         *wrapperspb.Int32Value
 	Severe        *wrapperspb.Int32Value
 	Armor         *wrapperspb.Int32Value
+	Minion        *wrapperspb.Int32Value
 	RequireFields bool
-	Current       *storage.DaggerheartAdversary
+	// Base replaces the default stat block for new adversaries, such as one
+	// copied from a catalog entry.
+	Base    *adversaryStats
+	Current *storage.DaggerheartAdversary
 }
 
 type adversaryStats struct {
@@ -532,6 +680,8 @@ type adversaryStats struct {
 	Major     int
 	Severe    int
 	Armor     int
+	// MinionThreshold is zero for non-minion adversaries.
+	MinionThreshold int
 }
 
 func normalizeAdversaryStats(input adversaryStatsInput) (adversaryStats, error) {
@@ -545,16 +695,20 @@ func normalizeAdversaryStats(input adversaryStatsInput) (adversaryStats, error)
 		Severe:    12,
 		Armor:     0,
 	}
+	if input.Base != nil {
+		stats = *input.Base
+	}
 	if input.Current != nil {
 		stats = adversaryStats{
-			HP:        input.Current.HP,
-			HPMax:     input.Current.HPMax,
-			Stress:    input.Current.Stress,
-			StressMax: input.Current.StressMax,
-			Evasion:   input.Current.Evasion,
-			Major:     input.Current.Major,
-			Severe:    input.Current.Severe,
-			Armor:     input.Current.Armor,
+			HP:              input.Current.HP,
+			HPMax:           input.Current.HPMax,
+			Stress:          input.Current.Stress,
+			StressMax:       input.Current.StressMax,
+			Evasion:         input.Current.Evasion,
+			Major:           input.Current.Major,
+			Severe:          input.Current.Severe,
+			Armor:           input.Current.Armor,
+			MinionThreshold: input.Current.MinionThreshold,
 		}
 	}
 
@@ -592,6 +746,9 @@ func normalizeAdversaryStats(input adversaryStatsInput) (adversaryStats, error)
 	if input.Armor != nil {
 		stats.Armor = int(input.Armor.GetValue())
 	}
+	if input.Minion != nil {
+		stats.MinionThreshold = int(input.Minion.GetValue())
+	}
 
 	if stats.HPMax <= 0 {
 		return adversaryStats{}, fmt.Errorf("hp_max must be positive")
@@ -617,6 +774,9 @@ func normalizeAdversaryStats(input adversaryStatsInput) (adversaryStats, error)
 	if stats.Armor < 0 {
 		return adversaryStats{}, fmt.Errorf("armor must be non-negative")
 	}
+	if stats.MinionThreshold < 0 {
+		return adversaryStats{}, fmt.Errorf("minion_threshold must be non-negative")
+	}
 
 	if input.RequireFields && (input.HP == nil || input.HPMax == nil) {
 		return adversaryStats{}, fmt.Errorf("hp and hp_max are required")
@@ -7,6 +7,7 @@ import (
 	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
 	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/session"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 	"google.golang.org/grpc/codes"
@@ -202,6 +203,140 @@ func TestCreateAdversary_InvalidStats(t *testing.T) {
 	assertStatusCode(t, err, codes.InvalidArgument)
 }
 
+func TestCreateAdversary_FromCatalog(t *testing.T) {
+	svc := newAdversaryFeatureTestService()
+	resp, err := svc.CreateAdversary(context.Background(), &pb.DaggerheartCreateAdversaryRequest{
+		CampaignId:       "camp-1",
+		SessionId:        wrapperspb.String("sess-1"),
+		AdversaryEntryId: "adversary.war-wizard",
+		Count:            3,
+		Names:            []string{"Ysolde", ""},
+		Stress:           wrapperspb.Int32(1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetAdversaries()) != 3 || resp.GetAdversary().GetId() != resp.GetAdversaries()[0].GetId() {
+		t.Fatalf("expected 3 adversaries led by the first, got %+v", resp)
+	}
+	wantNames := []string{"Ysolde", "War Wizard 2", "War Wizard 3"}
+	for i, adversary := range resp.GetAdversaries() {
+		if adversary.GetName() != wantNames[i] {
+			t.Errorf("adversary %d name = %q, want %q", i, adversary.GetName(), wantNames[i])
+		}
+		if adversary.GetAdversaryEntryId() != "adversary.war-wizard" || adversary.GetKind() != "ranged" {
+			t.Errorf("adversary %d link/kind = %q/%q", i, adversary.GetAdversaryEntryId(), adversary.GetKind())
+		}
+		if adversary.GetHpMax() != 5 || adversary.GetStressMax() != 6 || adversary.GetStress() != 1 || adversary.GetEvasion() != 16 {
+			t.Errorf("adversary %d stats = %+v", i, adversary)
+		}
+		if len(adversary.GetFeatures()) != 4 {
+			t.Errorf("adversary %d features = %d, want 4", i, len(adversary.GetFeatures()))
+		}
+	}
+	if got := countEvents(svc, "action.adversary_created"); got != 3 {
+		t.Fatalf("adversary_created events = %d, want 3", got)
+	}
+
+	// Feature activation defaults to the copied catalog features.
+	activated, err := svc.ActivateAdversaryFeature(context.Background(), &pb.DaggerheartActivateAdversaryFeatureRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		AdversaryId: resp.GetAdversary().GetId(),
+		FeatureId:   "feature.flight",
+	})
+	if err != nil {
+		t.Fatalf("ActivateAdversaryFeature returned error: %v", err)
+	}
+	if activated.GetAdversary().GetEvasion() != 19 {
+		t.Fatalf("evasion = %d, want 19", activated.GetAdversary().GetEvasion())
+	}
+}
+
+func TestCreateAdversary_FromCatalogInvalid(t *testing.T) {
+	svc := newAdversaryFeatureTestService()
+	tests := []struct {
+		name string
+		req  *pb.DaggerheartCreateAdversaryRequest
+	}{
+		{"unknown entry", &pb.DaggerheartCreateAdversaryRequest{CampaignId: "camp-1", AdversaryEntryId: "adversary.missing"}},
+		{"negative count", &pb.DaggerheartCreateAdversaryRequest{CampaignId: "camp-1", AdversaryEntryId: "adversary.war-wizard", Count: -1}},
+		{"too many names", &pb.DaggerheartCreateAdversaryRequest{CampaignId: "camp-1", AdversaryEntryId: "adversary.war-wizard", Count: 1, Names: []string{"A", "B"}}},
+		{"count above maximum", &pb.DaggerheartCreateAdversaryRequest{CampaignId: "camp-1", AdversaryEntryId: "adversary.war-wizard", Count: 2147483647}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CreateAdversary(context.Background(), tt.req)
+			assertStatusCode(t, err, codes.InvalidArgument)
+		})
+	}
+}
+
+// batchRecordingEventStore records how events reach the event store.
+type batchRecordingEventStore struct {
+	*fakeEventStore
+	singles int
+	batches []int
+}
+
+func (s *batchRecordingEventStore) AppendEvent(ctx context.Context, evt event.Event) (event.Event, error) {
+	s.singles++
+	return s.fakeEventStore.AppendEvent(ctx, evt)
+}
+
+func (s *batchRecordingEventStore) AppendEvents(ctx context.Context, evts []event.Event) ([]event.Event, error) {
+	s.batches = append(s.batches, len(evts))
+	return s.fakeEventStore.AppendEvents(ctx, evts)
+}
+
+func TestCreateAdversary_GroupAppendsOneBatch(t *testing.T) {
+	svc := newAdversaryFeatureTestService()
+	recorder := &batchRecordingEventStore{fakeEventStore: svc.stores.Event.(*fakeEventStore)}
+	svc.stores.Event = recorder
+
+	resp, err := svc.CreateAdversary(context.Background(), &pb.DaggerheartCreateAdversaryRequest{
+		CampaignId:       "camp-1",
+		AdversaryEntryId: "adversary.war-wizard",
+		Count:            3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.GetAdversaries()) != 3 {
+		t.Fatalf("adversaries = %d, want 3", len(resp.GetAdversaries()))
+	}
+	if recorder.singles != 0 || len(recorder.batches) != 1 || recorder.batches[0] != 3 {
+		t.Fatalf("singles = %d, batches = %v, want one batch of 3", recorder.singles, recorder.batches)
+	}
+}
+
+func TestCreateAdversary_MinionThreshold(t *testing.T) {
+	svc := newAdversaryTestService()
+	resp, err := svc.CreateAdversary(context.Background(), &pb.DaggerheartCreateAdversaryRequest{
+		CampaignId:      "camp-1",
+		Name:            "Imp",
+		Hp:              wrapperspb.Int32(1),
+		HpMax:           wrapperspb.Int32(1),
+		MinionThreshold: wrapperspb.Int32(3),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.Adversary.GetMinionThreshold() != 3 {
+		t.Errorf("minion_threshold = %d, want 3", resp.Adversary.GetMinionThreshold())
+	}
+}
+
+func TestCreateAdversary_NegativeMinionThreshold(t *testing.T) {
+	svc := newAdversaryTestService()
+	_, err := svc.CreateAdversary(context.Background(), &pb.DaggerheartCreateAdversaryRequest{
+		CampaignId:      "camp-1",
+		Name:            "Imp",
+		MinionThreshold: wrapperspb.Int32(-1),
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
 // --- GetAdversary tests ---
 
 func TestGetAdversary_NilRequest(t *testing.T) {
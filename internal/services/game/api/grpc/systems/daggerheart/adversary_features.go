@@ -0,0 +1,323 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/snapshot"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ActivateAdversaryFeature uses a feature from the adversary's catalog entry.
+// Fear and Stress costs, cooldowns and Difficulty bonuses come from the
+// catalog text unless the request overrides them.
+func (s *DaggerheartService) ActivateAdversaryFeature(ctx context.Context, in *pb.DaggerheartActivateAdversaryFeatureRequest) (*pb.DaggerheartActivateAdversaryFeatureResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "activate adversary feature request is required")
+	}
+	if err := s.requireEnvironmentStores(); err != nil {
+		return nil, err
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	adversaryID := strings.TrimSpace(in.GetAdversaryId())
+	if adversaryID == "" {
+		return nil, status.Error(codes.InvalidArgument, "adversary id is required")
+	}
+	entryID := strings.TrimSpace(in.GetAdversaryEntryId())
+	featureID := strings.TrimSpace(in.GetFeatureId())
+	if featureID == "" {
+		return nil, status.Error(codes.InvalidArgument, "feature id is required")
+	}
+	if in.FearCost != nil && in.GetFearCost().GetValue() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "fear_cost must be non-negative")
+	}
+	if in.StressCost != nil && in.GetStressCost().GetValue() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "stress_cost must be non-negative")
+	}
+	if in.DifficultyBonus != nil && in.GetDifficultyBonus().GetValue() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "difficulty_bonus must be non-negative")
+	}
+	targetIDs := make([]string, 0, len(in.GetTargetIds()))
+	for _, targetID := range in.GetTargetIds() {
+		targetID = strings.TrimSpace(targetID)
+		if targetID == "" {
+			return nil, status.Error(codes.InvalidArgument, "target id is required")
+		}
+		targetIDs = append(targetIDs, targetID)
+	}
+
+	c, err := s.loadActiveSession(ctx, campaignID, sessionID, "adversaries")
+	if err != nil {
+		return nil, err
+	}
+	adversary, err := s.loadAdversaryForSession(ctx, campaignID, sessionID, adversaryID)
+	if err != nil {
+		return nil, err
+	}
+	// Adversaries instantiated from the catalog carry their own copy of the
+	// features; an explicit entry id reads the catalog instead.
+	features := adversary.Features
+	if entryID == "" {
+		entryID = adversary.AdversaryEntryID
+	}
+	if entryID == "" {
+		return nil, status.Error(codes.InvalidArgument, "adversary entry id is required")
+	}
+	if entryID != adversary.AdversaryEntryID || len(features) == 0 {
+		entry, err := s.stores.DaggerheartContent.GetDaggerheartAdversaryEntry(ctx, entryID)
+		if err != nil {
+			return nil, contentLookupError("adversary entry", entryID, err)
+		}
+		features = entry.Features
+	}
+	var feature *storage.DaggerheartAdversaryFeature
+	for i := range features {
+		if features[i].ID == featureID {
+			feature = &features[i]
+			break
+		}
+	}
+	if feature == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "feature %q is not part of adversary entry %q", featureID, entryID)
+	}
+
+	rule := daggerheart.AdversaryFeatureRuleFor(feature.Name, feature.Description, feature.CostType, feature.Cost)
+	if in.FearCost != nil {
+		rule.FearCost = int(in.GetFearCost().GetValue())
+	}
+	if in.StressCost != nil {
+		rule.StressCost = int(in.GetStressCost().GetValue())
+	}
+	if in.DifficultyBonus != nil {
+		rule.DifficultyBonus = int(in.GetDifficultyBonus().GetValue())
+	}
+
+	states, err := s.adversaryFeatureStates(ctx, campaignID, adversaryID)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range states {
+		if state.FeatureID == featureID && state.Status == daggerheart.AdversaryFeatureStatusSpent {
+			return nil, status.Errorf(codes.FailedPrecondition, "feature %q is spent until %s", featureID, state.RefreshOn)
+		}
+	}
+	refreshFeatureID := ""
+	if rule.Refreshes != "" {
+		for _, sibling := range features {
+			if !strings.EqualFold(sibling.Name, rule.Refreshes) {
+				continue
+			}
+			for _, state := range states {
+				if state.FeatureID == sibling.ID && state.Status == daggerheart.AdversaryFeatureStatusSpent {
+					refreshFeatureID = sibling.ID
+				}
+			}
+		}
+	}
+
+	stressAfter, err := daggerheart.MarkAdversaryFeatureStress(adversary.Stress, adversary.StressMax, rule.StressCost)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	gmFearBefore := 0
+	if snap, err := s.stores.Daggerheart.GetDaggerheartSnapshot(ctx, campaignID); err == nil {
+		gmFearBefore = snap.GMFear
+	}
+	gmFearAfter := gmFearBefore
+	if rule.FearCost > 0 {
+		_, before, after, err := snapshot.ApplyGmFearSpend(snapshot.GmFear{CampaignID: campaignID, Value: gmFearBefore}, rule.FearCost)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		gmFearBefore = before
+		gmFearAfter = after
+	}
+
+	payload := daggerheart.AdversaryFeatureUsedPayload{
+		AdversaryID: adversaryID,
+		EntryID:     entryID,
+		FeatureID:   feature.ID,
+		FeatureName: feature.Name,
+		Kind:        feature.Kind,
+		FearCost:    rule.FearCost,
+		RefreshOn:   rule.RefreshOn,
+		TargetIDs:   targetIDs,
+		Description: strings.TrimSpace(in.GetDescription()),
+	}
+	if stressAfter != adversary.Stress {
+		stressBefore := adversary.Stress
+		payload.StressBefore = &stressBefore
+		payload.StressAfter = &stressAfter
+	}
+	if rule.DifficultyBonus > 0 {
+		evasionBefore := adversary.Evasion
+		evasionAfter := adversary.Evasion + rule.DifficultyBonus
+		payload.EvasionBefore = &evasionBefore
+		payload.EvasionAfter = &evasionAfter
+	}
+
+	if rule.FearCost > 0 {
+		payloadJSON, err := json.Marshal(daggerheart.GMFearChangedPayload{Before: gmFearBefore, After: gmFearAfter, Reason: "adversary_feature"})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "encode gm fear payload: %v", err)
+		}
+		if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeGMFearChanged, "campaign", campaignID, payloadJSON); err != nil {
+			return nil, err
+		}
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode adversary feature payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeAdversaryFeatureUsed, "adversary", adversaryID, payloadJSON); err != nil {
+		return nil, err
+	}
+	if refreshFeatureID != "" {
+		if err := s.refreshAdversaryFeature(ctx, c, sessionID, adversaryID, refreshFeatureID, feature.ID); err != nil {
+			return nil, err
+		}
+	}
+	if rule.FearCost > 0 {
+		if err := s.tickCountdowns(ctx, c, sessionID, daggerheart.CountdownTriggerFearSpent, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.stores.Daggerheart.GetDaggerheartAdversary(ctx, campaignID, adversaryID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load adversary: %v", err)
+	}
+	states, err = s.adversaryFeatureStates(ctx, campaignID, adversaryID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DaggerheartActivateAdversaryFeatureResponse{
+		Adversary:     daggerheartAdversaryToProto(updated),
+		FeatureId:     feature.ID,
+		GmFearBefore:  int32(gmFearBefore),
+		GmFearAfter:   int32(gmFearAfter),
+		FeatureStates: adversaryFeatureStatesToProto(states),
+	}, nil
+}
+
+// ListAdversaryFeatureStates lists the cooldown state of adversary features.
+func (s *DaggerheartService) ListAdversaryFeatureStates(ctx context.Context, in *pb.DaggerheartListAdversaryFeatureStatesRequest) (*pb.DaggerheartListAdversaryFeatureStatesResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "list adversary feature states request is required")
+	}
+	if s.stores.Campaign == nil {
+		return nil, status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return nil, status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if err := campaign.ValidateCampaignOperation(c.Status, campaign.CampaignOpRead); err != nil {
+		return nil, handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return nil, status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart adversaries")
+	}
+
+	states, err := s.adversaryFeatureStates(ctx, campaignID, strings.TrimSpace(in.GetAdversaryId()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DaggerheartListAdversaryFeatureStatesResponse{FeatureStates: adversaryFeatureStatesToProto(states)}, nil
+}
+
+// adversaryFeatureStates lists feature cooldowns in a campaign, limited to
+// one adversary when adversaryID is set.
+func (s *DaggerheartService) adversaryFeatureStates(ctx context.Context, campaignID, adversaryID string) ([]storage.DaggerheartAdversaryFeatureState, error) {
+	states, err := s.stores.Daggerheart.ListDaggerheartAdversaryFeatureStates(ctx, campaignID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list adversary feature states: %v", err)
+	}
+	if adversaryID == "" {
+		return states, nil
+	}
+	filtered := make([]storage.DaggerheartAdversaryFeatureState, 0, len(states))
+	for _, state := range states {
+		if state.AdversaryID == adversaryID {
+			filtered = append(filtered, state)
+		}
+	}
+	return filtered, nil
+}
+
+// refreshAdversaryFeatures readies every spent adversary feature in the
+// campaign that refreshes on trigger.
+func (s *DaggerheartService) refreshAdversaryFeatures(ctx context.Context, c campaign.Campaign, sessionID, trigger string) error {
+	states, err := s.adversaryFeatureStates(ctx, c.ID, "")
+	if err != nil {
+		return err
+	}
+	for _, state := range states {
+		if state.Status != daggerheart.AdversaryFeatureStatusSpent || state.RefreshOn != trigger {
+			continue
+		}
+		if err := s.refreshAdversaryFeature(ctx, c, sessionID, state.AdversaryID, state.FeatureID, trigger); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *DaggerheartService) refreshAdversaryFeature(ctx context.Context, c campaign.Campaign, sessionID, adversaryID, featureID, reason string) error {
+	payloadJSON, err := json.Marshal(daggerheart.AdversaryFeatureRefreshedPayload{
+		AdversaryID: adversaryID,
+		FeatureID:   featureID,
+		Reason:      reason,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "encode adversary feature refresh payload: %v", err)
+	}
+	return s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeAdversaryFeatureRefreshed, "adversary", adversaryID, payloadJSON)
+}
+
+func adversaryFeatureStatesToProto(states []storage.DaggerheartAdversaryFeatureState) []*pb.DaggerheartAdversaryFeatureState {
+	protos := make([]*pb.DaggerheartAdversaryFeatureState, 0, len(states))
+	for _, state := range states {
+		protos = append(protos, &pb.DaggerheartAdversaryFeatureState{
+			AdversaryId: state.AdversaryID,
+			FeatureId:   state.FeatureID,
+			Status:      daggerheartAdversaryFeatureStatusToProto(state.Status),
+			RefreshOn:   state.RefreshOn,
+		})
+	}
+	return protos
+}
+
+func daggerheartAdversaryFeatureStatusToProto(value string) pb.DaggerheartAdversaryFeatureStatus {
+	switch value {
+	case daggerheart.AdversaryFeatureStatusReady:
+		return pb.DaggerheartAdversaryFeatureStatus_DAGGERHEART_ADVERSARY_FEATURE_STATUS_READY
+	case daggerheart.AdversaryFeatureStatusSpent:
+		return pb.DaggerheartAdversaryFeatureStatus_DAGGERHEART_ADVERSARY_FEATURE_STATUS_SPENT
+	default:
+		return pb.DaggerheartAdversaryFeatureStatus_DAGGERHEART_ADVERSARY_FEATURE_STATUS_UNSPECIFIED
+	}
+}
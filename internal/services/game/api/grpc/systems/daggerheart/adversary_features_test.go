@@ -0,0 +1,199 @@
+package daggerheart
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// newAdversaryFeatureTestService adds a War Wizard catalog entry and a
+// session adversary built from it, with 3 GM Fear in the pool.
+func newAdversaryFeatureTestService() *DaggerheartService {
+	svc := newEnvironmentTestService()
+	content := svc.stores.DaggerheartContent.(*fakeContentStore)
+	content.adversaryEntries["adversary.war-wizard"] = storage.DaggerheartAdversaryEntry{
+		ID:         "adversary.war-wizard",
+		Name:       "War Wizard",
+		Role:       "ranged",
+		Difficulty: 16,
+		HP:         5,
+		Stress:     6,
+		Features: []storage.DaggerheartAdversaryFeature{
+			{
+				ID: "feature.warding-sphere", Name: "Warding Sphere", Kind: "reaction",
+				Description: "When the Wizard takes damage from an attack within Close range, deal 2d6 magic damage to the attacker. This reaction can't be used again until the Wizard refreshes it with their \"Refresh Warding Sphere\" action.",
+			},
+			{
+				ID: "feature.refresh-warding-sphere", Name: "Refresh Warding Sphere", Kind: "action",
+				Description: "Mark a Stress to refresh the Wizard's \"Warding Sphere\" reaction.",
+			},
+			{
+				ID: "feature.adrenaline", Name: "Adrenaline Burst", Kind: "action",
+				Description: "Once per scene, spend a Fear to clear 2 HP and 2 Stress.",
+			},
+			{
+				ID: "feature.flight", Name: "Flight", Kind: "passive",
+				Description: "While flying, the Wizard gains a +3 bonus to their Difficulty.",
+			},
+		},
+	}
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	dhStore.adversaries["camp-1:adv-1"] = storage.DaggerheartAdversary{
+		CampaignID: "camp-1", AdversaryID: "adv-1", Name: "War Wizard", SessionID: "sess-1",
+		HP: 5, HPMax: 5, Stress: 5, StressMax: 6, Evasion: 16, Major: 8, Severe: 14,
+	}
+	return svc
+}
+
+func activateFeature(svc *DaggerheartService, featureID string) (*pb.DaggerheartActivateAdversaryFeatureResponse, error) {
+	return svc.ActivateAdversaryFeature(grpcmeta.WithRequestID(context.Background(), "req-adversary-feature"), &pb.DaggerheartActivateAdversaryFeatureRequest{
+		CampaignId:       "camp-1",
+		SessionId:        "sess-1",
+		AdversaryId:      "adv-1",
+		AdversaryEntryId: "adversary.war-wizard",
+		FeatureId:        featureID,
+	})
+}
+
+func TestActivateAdversaryFeature_ReactionCooldownAndRefresh(t *testing.T) {
+	svc := newAdversaryFeatureTestService()
+
+	resp, err := activateFeature(svc, "feature.warding-sphere")
+	if err != nil {
+		t.Fatalf("ActivateAdversaryFeature returned error: %v", err)
+	}
+	states := resp.GetFeatureStates()
+	if len(states) != 1 || states[0].GetStatus() != pb.DaggerheartAdversaryFeatureStatus_DAGGERHEART_ADVERSARY_FEATURE_STATUS_SPENT ||
+		states[0].GetRefreshOn() != daggerheart.AdversaryFeatureRefreshFeature {
+		t.Fatalf("feature states = %+v", states)
+	}
+	var used daggerheart.AdversaryFeatureUsedPayload
+	lastEventPayload(t, svc, "action.adversary_feature_used", &used)
+	if used.FeatureName != "Warding Sphere" || used.Kind != "reaction" || used.StressAfter != nil {
+		t.Fatalf("feature used = %+v", used)
+	}
+
+	_, err = activateFeature(svc, "feature.warding-sphere")
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	resp, err = activateFeature(svc, "feature.refresh-warding-sphere")
+	if err != nil {
+		t.Fatalf("refresh returned error: %v", err)
+	}
+	if resp.GetAdversary().GetStress() != 6 {
+		t.Fatalf("stress = %d, want 6", resp.GetAdversary().GetStress())
+	}
+	if states := resp.GetFeatureStates(); len(states) != 1 || states[0].GetStatus() != pb.DaggerheartAdversaryFeatureStatus_DAGGERHEART_ADVERSARY_FEATURE_STATUS_READY {
+		t.Fatalf("feature states after refresh = %+v", states)
+	}
+	var refreshed daggerheart.AdversaryFeatureRefreshedPayload
+	lastEventPayload(t, svc, "action.adversary_feature_refreshed", &refreshed)
+	if refreshed.FeatureID != "feature.warding-sphere" || refreshed.Reason != "feature.refresh-warding-sphere" {
+		t.Fatalf("feature refreshed = %+v", refreshed)
+	}
+
+	// The Wizard has no Stress left to mark for another refresh.
+	_, err = activateFeature(svc, "feature.refresh-warding-sphere")
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestActivateAdversaryFeature_SpendsFearUntilSceneEnd(t *testing.T) {
+	svc := newAdversaryFeatureTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-adversary-feature")
+	if _, err := svc.CreateSessionEnvironment(ctx, &pb.DaggerheartCreateSessionEnvironmentRequest{
+		CampaignId: "camp-1", SessionId: "sess-1", EnvironmentId: "environment.outpost",
+	}); err != nil {
+		t.Fatalf("CreateSessionEnvironment returned error: %v", err)
+	}
+
+	resp, err := activateFeature(svc, "feature.adrenaline")
+	if err != nil {
+		t.Fatalf("ActivateAdversaryFeature returned error: %v", err)
+	}
+	if resp.GetGmFearBefore() != 3 || resp.GetGmFearAfter() != 2 {
+		t.Fatalf("gm fear = %d -> %d, want 3 -> 2", resp.GetGmFearBefore(), resp.GetGmFearAfter())
+	}
+	_, err = activateFeature(svc, "feature.adrenaline")
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	if _, err := svc.ShiftSessionEnvironment(ctx, &pb.DaggerheartShiftSessionEnvironmentRequest{
+		CampaignId: "camp-1", SessionId: "sess-1", EnvironmentId: "environment.cliffside",
+	}); err != nil {
+		t.Fatalf("ShiftSessionEnvironment returned error: %v", err)
+	}
+	list, err := svc.ListAdversaryFeatureStates(context.Background(), &pb.DaggerheartListAdversaryFeatureStatesRequest{
+		CampaignId: "camp-1", AdversaryId: "adv-1",
+	})
+	if err != nil {
+		t.Fatalf("ListAdversaryFeatureStates returned error: %v", err)
+	}
+	if states := list.GetFeatureStates(); len(states) != 1 || states[0].GetStatus() != pb.DaggerheartAdversaryFeatureStatus_DAGGERHEART_ADVERSARY_FEATURE_STATUS_READY {
+		t.Fatalf("feature states after scene end = %+v", states)
+	}
+	if _, err := activateFeature(svc, "feature.adrenaline"); err != nil {
+		t.Fatalf("reuse after scene end returned error: %v", err)
+	}
+}
+
+func TestActivateAdversaryFeature_DifficultyBonusAndOverrides(t *testing.T) {
+	svc := newAdversaryFeatureTestService()
+	resp, err := activateFeature(svc, "feature.flight")
+	if err != nil {
+		t.Fatalf("ActivateAdversaryFeature returned error: %v", err)
+	}
+	if resp.GetAdversary().GetEvasion() != 19 {
+		t.Fatalf("evasion = %d, want 19", resp.GetAdversary().GetEvasion())
+	}
+
+	resp, err = svc.ActivateAdversaryFeature(context.Background(), &pb.DaggerheartActivateAdversaryFeatureRequest{
+		CampaignId:       "camp-1",
+		SessionId:        "sess-1",
+		AdversaryId:      "adv-1",
+		AdversaryEntryId: "adversary.war-wizard",
+		FeatureId:        "feature.flight",
+		FearCost:         wrapperspb.Int32(2),
+		DifficultyBonus:  wrapperspb.Int32(0),
+	})
+	if err != nil {
+		t.Fatalf("ActivateAdversaryFeature with overrides returned error: %v", err)
+	}
+	if resp.GetAdversary().GetEvasion() != 19 || resp.GetGmFearAfter() != 1 {
+		t.Fatalf("evasion = %d, gm fear = %d; want 19, 1", resp.GetAdversary().GetEvasion(), resp.GetGmFearAfter())
+	}
+}
+
+func TestActivateAdversaryFeature_InvalidRequests(t *testing.T) {
+	svc := newAdversaryFeatureTestService()
+	base := func() *pb.DaggerheartActivateAdversaryFeatureRequest {
+		return &pb.DaggerheartActivateAdversaryFeatureRequest{
+			CampaignId: "camp-1", SessionId: "sess-1", AdversaryId: "adv-1",
+			AdversaryEntryId: "adversary.war-wizard", FeatureId: "feature.flight",
+		}
+	}
+	tests := []struct {
+		name   string
+		mutate func(*pb.DaggerheartActivateAdversaryFeatureRequest)
+		code   codes.Code
+	}{
+		{"missing entry", func(r *pb.DaggerheartActivateAdversaryFeatureRequest) { r.AdversaryEntryId = "" }, codes.InvalidArgument},
+		{"unknown entry", func(r *pb.DaggerheartActivateAdversaryFeatureRequest) { r.AdversaryEntryId = "adversary.missing" }, codes.InvalidArgument},
+		{"unknown feature", func(r *pb.DaggerheartActivateAdversaryFeatureRequest) { r.FeatureId = "feature.missing" }, codes.InvalidArgument},
+		{"negative fear", func(r *pb.DaggerheartActivateAdversaryFeatureRequest) { r.FearCost = wrapperspb.Int32(-1) }, codes.InvalidArgument},
+		{"unknown adversary", func(r *pb.DaggerheartActivateAdversaryFeatureRequest) { r.AdversaryId = "adv-missing" }, codes.NotFound},
+		{"not enough fear", func(r *pb.DaggerheartActivateAdversaryFeatureRequest) { r.FearCost = wrapperspb.Int32(5) }, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := base()
+			tt.mutate(req)
+			_, err := svc.ActivateAdversaryFeature(context.Background(), req)
+			assertStatusCode(t, err, tt.code)
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EnterBeastform marks a Stress to transform a character into a catalog
+// beastform. While transformed the character rolls with the beastform's trait
+// bonus, Evasion and attack instead of their weapons.
+func (s *DaggerheartService) EnterBeastform(ctx context.Context, in *pb.DaggerheartEnterBeastformRequest) (*pb.DaggerheartEnterBeastformResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "enter beastform request is required")
+	}
+	if err := s.requireBeastformStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	beastformID := strings.TrimSpace(in.GetBeastformId())
+	if beastformID == "" {
+		return nil, status.Error(codes.InvalidArgument, "beastform id is required")
+	}
+	entry, err := s.stores.DaggerheartContent.GetDaggerheartBeastform(ctx, beastformID)
+	if err != nil {
+		return nil, contentLookupError("beastform", beastformID, err)
+	}
+
+	c, sessionID, err := s.loadBeastformCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.loadCompanionProfile(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	canTransform, err := s.hasBeastformFeature(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	if !canTransform {
+		return nil, status.Errorf(codes.FailedPrecondition, "character %q has no beastform feature", characterID)
+	}
+	state, err := s.loadInventoryState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if state.BeastformID != "" {
+		return nil, status.Error(codes.FailedPrecondition, daggerheart.ErrAlreadyInBeastform.Error())
+	}
+	if state.Hp <= 0 || (state.LifeState != "" && state.LifeState != daggerheart.LifeStateAlive) {
+		return nil, status.Error(codes.FailedPrecondition, "character cannot transform while out of hit points")
+	}
+	form := beastformFromStorage(entry)
+	stressAfter, err := daggerheart.BeastformEntryStress(profile.Level, state.Stress, profile.StressMax, form)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.BeastformEnteredPayload{
+		CharacterID:  characterID,
+		BeastformID:  beastformID,
+		StressBefore: state.Stress,
+		StressAfter:  stressAfter,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeBeastformEntered, "character", characterID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load daggerheart state: %v", err)
+	}
+	evasion, err := s.characterEvasion(ctx, campaignID, characterID, profile)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DaggerheartEnterBeastformResponse{
+		CharacterId: characterID,
+		State:       daggerheartStateToProto(updated),
+		Evasion:     int32(evasion),
+	}, nil
+}
+
+// ExitBeastform returns a transformed character to their normal form.
+func (s *DaggerheartService) ExitBeastform(ctx context.Context, in *pb.DaggerheartExitBeastformRequest) (*pb.DaggerheartExitBeastformResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "exit beastform request is required")
+	}
+	if err := s.requireBeastformStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	reason := strings.TrimSpace(in.GetReason())
+	if reason == "" {
+		reason = daggerheart.BeastformExitVoluntary
+	}
+
+	c, sessionID, err := s.loadBeastformCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.loadInventoryState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if state.BeastformID == "" {
+		return nil, status.Error(codes.FailedPrecondition, daggerheart.ErrNotInBeastform.Error())
+	}
+	if err := s.appendBeastformExited(ctx, c, sessionID, characterID, state.BeastformID, reason); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load daggerheart state: %v", err)
+	}
+	return &pb.DaggerheartExitBeastformResponse{
+		CharacterId: characterID,
+		State:       daggerheartStateToProto(updated),
+	}, nil
+}
+
+func (s *DaggerheartService) appendBeastformExited(ctx context.Context, c campaign.Campaign, sessionID, characterID, beastformID, reason string) error {
+	payloadJSON, err := json.Marshal(daggerheart.BeastformExitedPayload{
+		CharacterID: characterID,
+		BeastformID: beastformID,
+		Reason:      reason,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	return s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeBeastformExited, "character", characterID, payloadJSON)
+}
+
+func (s *DaggerheartService) requireBeastformStores() error {
+	if s.stores.Campaign == nil {
+		return status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	if s.stores.DaggerheartContent == nil {
+		return status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	if s.stores.Event == nil {
+		return status.Error(codes.Internal, "event store is not configured")
+	}
+	return nil
+}
+
+// loadBeastformCampaign validates the campaign for beastform changes. A
+// character may stay transformed after a session ends and drop the form later.
+func (s *DaggerheartService) loadBeastformCampaign(ctx context.Context, campaignID string) (campaign.Campaign, string, error) {
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return campaign.Campaign{}, "", handleDomainError(err)
+	}
+	if err := campaign.ValidateCampaignOperation(c.Status, campaign.CampaignOpCampaignMutate); err != nil {
+		return campaign.Campaign{}, "", handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return campaign.Campaign{}, "", status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart beastforms")
+	}
+	sessionID := strings.TrimSpace(grpcmeta.SessionIDFromContext(ctx))
+	if sessionID != "" {
+		if err := s.ensureNoOpenSessionGate(ctx, campaignID, sessionID); err != nil {
+			return campaign.Campaign{}, "", err
+		}
+	}
+	return c, sessionID, nil
+}
+
+// hasBeastformFeature reports whether the character's class or multiclass
+// grants the Beastform feature.
+func (s *DaggerheartService) hasBeastformFeature(ctx context.Context, profile storage.DaggerheartCharacterProfile) (bool, error) {
+	for _, classID := range []string{profile.ClassID, profile.MulticlassClassID} {
+		classID = strings.TrimSpace(classID)
+		if classID == "" {
+			continue
+		}
+		class, err := s.stores.DaggerheartContent.GetDaggerheartClass(ctx, classID)
+		if err != nil {
+			return false, contentLookupError("class", classID, err)
+		}
+		if slices.ContainsFunc(class.Features, func(feature storage.DaggerheartFeature) bool {
+			return feature.ID == daggerheart.BeastformFeatureID
+		}) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// activeBeastform loads the catalog entry of the character's current
+// beastform. It returns nil when the character is not transformed.
+func (s *DaggerheartService) activeBeastform(ctx context.Context, state storage.DaggerheartCharacterState) (*storage.DaggerheartBeastformEntry, error) {
+	if state.BeastformID == "" {
+		return nil, nil
+	}
+	if s.stores.DaggerheartContent == nil {
+		return nil, status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	entry, err := s.stores.DaggerheartContent.GetDaggerheartBeastform(ctx, state.BeastformID)
+	if err != nil {
+		return nil, contentLookupError("beastform", state.BeastformID, err)
+	}
+	return &entry, nil
+}
+
+// characterEvasion returns a character's Evasion including any beastform and
+// timed effect bonuses.
+func (s *DaggerheartService) characterEvasion(ctx context.Context, campaignID, characterID string, profile storage.DaggerheartCharacterProfile) (int, error) {
+	bonus, err := s.effectBonus(ctx, campaignID, daggerheart.EffectTargetCharacter, characterID, daggerheart.EffectKindEvasion)
+	if err != nil {
+		return 0, err
+	}
+	state, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return profile.Evasion + bonus, nil
+		}
+		return 0, handleDomainError(err)
+	}
+	entry, err := s.activeBeastform(ctx, state)
+	if err != nil {
+		return 0, err
+	}
+	if entry == nil {
+		return profile.Evasion + bonus, nil
+	}
+	return beastformFromStorage(*entry).Evasion(profile.Evasion) + bonus, nil
+}
+
+// beastformAttack resolves the attack a transformed character makes in place
+// of a weapon attack.
+func beastformAttack(entry storage.DaggerheartBeastformEntry, profile storage.DaggerheartCharacterProfile) (*attackWeapon, error) {
+	reach, err := daggerheart.NormalizeRangeBand(entry.Attack.Range)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "beastform %q range %q is invalid", entry.ID, entry.Attack.Range)
+	}
+	damageType := damageTypeToProto(entry.Attack.DamageType)
+	if damageType == pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_UNSPECIFIED {
+		return nil, status.Errorf(codes.FailedPrecondition, "beastform %q damage type %q is invalid", entry.ID, entry.Attack.DamageType)
+	}
+	if len(entry.Attack.DamageDice) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "beastform %q has no damage dice", entry.ID)
+	}
+	trait := strings.ToLower(strings.TrimSpace(entry.Attack.Trait))
+	traitValue, _ := daggerheart.TraitValue(progressionFromProfile(profile).Traits, trait)
+	dice := make([]daggerheart.DamageDieSpec, 0, len(entry.Attack.DamageDice))
+	for _, die := range entry.Attack.DamageDice {
+		dice = append(dice, daggerheart.DamageDieSpec{Sides: die.Sides, Count: die.Count})
+	}
+	return &attackWeapon{
+		trait:       trait,
+		traitValue:  traitValue,
+		reach:       reach,
+		dice:        daggerheart.WeaponDamageDice(dice, profile.Proficiency),
+		damageBonus: entry.Attack.DamageBonus,
+		damageType:  damageType,
+		beastformID: entry.ID,
+	}, nil
+}
+
+func beastformFromStorage(entry storage.DaggerheartBeastformEntry) daggerheart.Beastform {
+	return daggerheart.Beastform{
+		ID:           entry.ID,
+		Tier:         entry.Tier,
+		Trait:        strings.ToLower(strings.TrimSpace(entry.Trait)),
+		TraitBonus:   entry.TraitBonus,
+		EvasionBonus: entry.EvasionBonus,
+	}
+}
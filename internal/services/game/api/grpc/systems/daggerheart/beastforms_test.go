@@ -0,0 +1,231 @@
+package daggerheart
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+)
+
+// newBeastformTestService makes char-1 a level 2 Druid with Strength 1 and
+// Proficiency 2 who carries a longsword.
+func newBeastformTestService() *DaggerheartService {
+	svc := newWeaponTestService()
+	content := svc.stores.DaggerheartContent.(*fakeContentStore)
+	content.classes["class.druid"] = storage.DaggerheartClass{
+		ID: "class.druid", Name: "Druid",
+		Features: []storage.DaggerheartFeature{{ID: daggerheart.BeastformFeatureID, Name: "Beastform"}},
+	}
+	content.classes["class.guardian"] = storage.DaggerheartClass{ID: "class.guardian", Name: "Guardian"}
+	content.beastforms["beastform.pack-predator"] = storage.DaggerheartBeastformEntry{
+		ID: "beastform.pack-predator", Name: "Pack Predator", Tier: 1, Trait: "strength", TraitBonus: 2, EvasionBonus: 1,
+		Attack: storage.DaggerheartBeastformAttack{
+			Range: "melee", Trait: "strength", DamageType: "physical", DamageBonus: 2,
+			DamageDice: []storage.DaggerheartDamageDie{{Sides: 8, Count: 1}},
+		},
+	}
+	content.beastforms["beastform.great-predator"] = storage.DaggerheartBeastformEntry{ID: "beastform.great-predator", Name: "Great Predator", Tier: 3}
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.Level = 2
+	profile.Strength = 1
+	profile.ClassID = "class.druid"
+	dhStore.profiles["camp-1:char-1"] = profile
+	return svc
+}
+
+func enterBeastform(t *testing.T, svc *DaggerheartService) *pb.DaggerheartEnterBeastformResponse {
+	t.Helper()
+	resp, err := svc.EnterBeastform(context.Background(), &pb.DaggerheartEnterBeastformRequest{
+		CampaignId:  "camp-1",
+		CharacterId: "char-1",
+		BeastformId: "beastform.pack-predator",
+	})
+	if err != nil {
+		t.Fatalf("EnterBeastform returned error: %v", err)
+	}
+	return resp
+}
+
+func TestBeastform_MissingStores(t *testing.T) {
+	svc := &DaggerheartService{}
+	_, err := svc.EnterBeastform(context.Background(), &pb.DaggerheartEnterBeastformRequest{})
+	assertStatusCode(t, err, codes.Internal)
+	_, err = svc.ExitBeastform(context.Background(), &pb.DaggerheartExitBeastformRequest{})
+	assertStatusCode(t, err, codes.Internal)
+}
+
+func TestEnterBeastform(t *testing.T) {
+	svc := newBeastformTestService()
+	stressBefore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore).states["camp-1:char-1"].Stress
+	profile := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore).profiles["camp-1:char-1"]
+
+	resp := enterBeastform(t, svc)
+	if resp.GetState().GetBeastformId() != "beastform.pack-predator" {
+		t.Fatalf("beastform id = %q, want beastform.pack-predator", resp.GetState().GetBeastformId())
+	}
+	if int(resp.GetState().GetStress()) != stressBefore+1 {
+		t.Fatalf("stress = %d, want %d", resp.GetState().GetStress(), stressBefore+1)
+	}
+	if int(resp.GetEvasion()) != profile.Evasion+1 {
+		t.Fatalf("evasion = %d, want %d", resp.GetEvasion(), profile.Evasion+1)
+	}
+
+	var payload daggerheart.BeastformEnteredPayload
+	lastEventPayload(t, svc, string(daggerheart.EventTypeBeastformEntered), &payload)
+	if payload.StressBefore != stressBefore || payload.StressAfter != stressBefore+1 {
+		t.Fatalf("payload stress = %d -> %d", payload.StressBefore, payload.StressAfter)
+	}
+}
+
+func TestEnterBeastform_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(*DaggerheartService)
+		formID  string
+		wantErr codes.Code
+	}{
+		{name: "unknown beastform", formID: "beastform.missing", wantErr: codes.InvalidArgument},
+		{name: "tier too high", formID: "beastform.great-predator", wantErr: codes.FailedPrecondition},
+		{
+			name:   "no beastform feature",
+			formID: "beastform.pack-predator",
+			mutate: func(svc *DaggerheartService) {
+				dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+				profile := dhStore.profiles["camp-1:char-1"]
+				profile.ClassID = "class.guardian"
+				dhStore.profiles["camp-1:char-1"] = profile
+			},
+			wantErr: codes.FailedPrecondition,
+		},
+		{
+			name:   "stress full",
+			formID: "beastform.pack-predator",
+			mutate: func(svc *DaggerheartService) {
+				dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+				state := dhStore.states["camp-1:char-1"]
+				state.Stress = dhStore.profiles["camp-1:char-1"].StressMax
+				dhStore.states["camp-1:char-1"] = state
+			},
+			wantErr: codes.FailedPrecondition,
+		},
+		{
+			name:   "already transformed",
+			formID: "beastform.pack-predator",
+			mutate: func(svc *DaggerheartService) {
+				dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+				state := dhStore.states["camp-1:char-1"]
+				state.BeastformID = "beastform.pack-predator"
+				dhStore.states["camp-1:char-1"] = state
+			},
+			wantErr: codes.FailedPrecondition,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := newBeastformTestService()
+			if tc.mutate != nil {
+				tc.mutate(svc)
+			}
+			_, err := svc.EnterBeastform(context.Background(), &pb.DaggerheartEnterBeastformRequest{
+				CampaignId:  "camp-1",
+				CharacterId: "char-1",
+				BeastformId: tc.formID,
+			})
+			assertStatusCode(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestExitBeastform(t *testing.T) {
+	svc := newBeastformTestService()
+	_, err := svc.ExitBeastform(context.Background(), &pb.DaggerheartExitBeastformRequest{CampaignId: "camp-1", CharacterId: "char-1"})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	enterBeastform(t, svc)
+	resp, err := svc.ExitBeastform(context.Background(), &pb.DaggerheartExitBeastformRequest{CampaignId: "camp-1", CharacterId: "char-1"})
+	if err != nil {
+		t.Fatalf("ExitBeastform returned error: %v", err)
+	}
+	if resp.GetState().GetBeastformId() != "" {
+		t.Fatalf("beastform id = %q, want none", resp.GetState().GetBeastformId())
+	}
+	var payload daggerheart.BeastformExitedPayload
+	lastEventPayload(t, svc, string(daggerheart.EventTypeBeastformExited), &payload)
+	if payload.Reason != daggerheart.BeastformExitVoluntary || payload.BeastformID != "beastform.pack-predator" {
+		t.Fatalf("payload = %+v", payload)
+	}
+}
+
+func TestSessionAttackFlow_Beastform(t *testing.T) {
+	svc := newBeastformTestService()
+	enterBeastform(t, svc)
+
+	resp, err := svc.SessionAttackFlow(attackContext(), &pb.SessionAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Difficulty:  1,
+		TargetId:    "char-2",
+	})
+	if err != nil {
+		t.Fatalf("SessionAttackFlow returned error: %v", err)
+	}
+	if resp.GetWeaponId() != "" {
+		t.Fatalf("weapon id = %q, want none in beastform", resp.GetWeaponId())
+	}
+	roll := resp.GetActionRoll()
+	if roll.GetTotal() != roll.GetHopeDie()+roll.GetFearDie()+3 {
+		t.Fatalf("roll total = %d, want dice plus Strength 1 and beastform bonus 2", roll.GetTotal())
+	}
+	rolls := resp.GetDamageRoll().GetRolls()
+	if len(rolls) != 1 || rolls[0].GetSides() != 8 || len(rolls[0].GetResults()) != 2 {
+		t.Fatalf("damage rolls = %v, want 2d8", rolls)
+	}
+	if resp.GetDamageRoll().GetModifier() != 2 {
+		t.Fatalf("damage modifier = %d, want 2", resp.GetDamageRoll().GetModifier())
+	}
+
+	_, err = svc.SessionAttackFlow(attackContext(), &pb.SessionAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Difficulty:  1,
+		TargetId:    "char-2",
+		WeaponId:    "weapon.longsword",
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestApplyDamage_DropsBeastformAtLastHP(t *testing.T) {
+	svc := newBeastformTestService()
+	enterBeastform(t, svc)
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	state := dhStore.states["camp-1:char-1"]
+	state.Hp = 1
+	state.Armor = 0
+	dhStore.states["camp-1:char-1"] = state
+
+	resp, err := svc.ApplyDamage(contextWithSessionID("sess-1"), &pb.DaggerheartApplyDamageRequest{
+		CampaignId:  "camp-1",
+		CharacterId: "char-1",
+		Damage: &pb.DaggerheartDamageRequest{
+			Amount:     10,
+			DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL,
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyDamage returned error: %v", err)
+	}
+	if resp.GetState().GetHp() != 0 || resp.GetState().GetBeastformId() != "" {
+		t.Fatalf("state = %v, want 0 HP and no beastform", resp.GetState())
+	}
+	var payload daggerheart.BeastformExitedPayload
+	lastEventPayload(t, svc, string(daggerheart.EventTypeBeastformExited), &payload)
+	if payload.Reason != daggerheart.BeastformExitLastHP {
+		t.Fatalf("exit reason = %q, want %q", payload.Reason, daggerheart.BeastformExitLastHP)
+	}
+}
@@ -0,0 +1,380 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/id"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/session"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartChase creates a progress countdown and a consequence countdown that
+// both tick down on every action roll, and links them as a chase. The chase
+// resolves as soon as either countdown triggers.
+func (s *DaggerheartService) StartChase(ctx context.Context, in *pb.DaggerheartStartChaseRequest) (*pb.DaggerheartStartChaseResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "start chase request is required")
+	}
+	if s.stores.Campaign == nil {
+		return nil, status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Session == nil {
+		return nil, status.Error(codes.Internal, "session store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return nil, status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	if s.stores.Event == nil {
+		return nil, status.Error(codes.Internal, "event store is not configured")
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	name := strings.TrimSpace(in.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if in.GetProgress() == nil {
+		return nil, status.Error(codes.InvalidArgument, "progress countdown is required")
+	}
+	if in.GetConsequence() == nil {
+		return nil, status.Error(codes.InvalidArgument, "consequence countdown is required")
+	}
+
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if err := campaign.ValidateCampaignOperation(c.Status, campaign.CampaignOpSessionAction); err != nil {
+		return nil, handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return nil, status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart chases")
+	}
+
+	sess, err := s.stores.Session.GetSession(ctx, campaignID, sessionID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if sess.Status != session.SessionStatusActive {
+		return nil, status.Error(codes.FailedPrecondition, "session is not active")
+	}
+	if err := s.ensureNoOpenSessionGate(ctx, campaignID, sessionID); err != nil {
+		return nil, err
+	}
+
+	chaseID := strings.TrimSpace(in.GetChaseId())
+	if chaseID == "" {
+		chaseID, err = id.NewID()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "generate chase id: %v", err)
+		}
+	}
+	if _, err := s.stores.Daggerheart.GetDaggerheartChase(ctx, campaignID, chaseID); err == nil {
+		return nil, status.Error(codes.FailedPrecondition, "chase already exists")
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, handleDomainError(err)
+	}
+
+	progress, err := chaseCountdownPayload(in.GetProgress(), chaseID, name, daggerheart.CountdownKindProgress, daggerheart.DefaultChaseProgressOutcomeDeltas())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	consequence, err := chaseCountdownPayload(in.GetConsequence(), chaseID, name, daggerheart.CountdownKindConsequence, daggerheart.DefaultChaseConsequenceOutcomeDeltas())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if progress.CountdownID == consequence.CountdownID {
+		return nil, status.Error(codes.InvalidArgument, "chase countdown ids must differ")
+	}
+	for _, countdownID := range []string{progress.CountdownID, consequence.CountdownID} {
+		if _, err := s.stores.Daggerheart.GetDaggerheartCountdown(ctx, campaignID, countdownID); err == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "countdown %s already exists", countdownID)
+		} else if !errors.Is(err, storage.ErrNotFound) {
+			return nil, handleDomainError(err)
+		}
+	}
+
+	for _, payload := range []daggerheart.CountdownCreatedPayload{progress, consequence} {
+		payloadJSON, err := json.Marshal(payload)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "encode countdown payload: %v", err)
+		}
+		if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeCountdownCreated, "countdown", payload.CountdownID, payloadJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.ChaseStartedPayload{
+		ChaseID:                chaseID,
+		Name:                   name,
+		ProgressCountdownID:    progress.CountdownID,
+		ConsequenceCountdownID: consequence.CountdownID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode chase payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeChaseStarted, "chase", chaseID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	chase, err := s.loadChase(ctx, campaignID, chaseID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DaggerheartStartChaseResponse{Chase: chase}, nil
+}
+
+// GetChase returns a chase with its linked countdowns.
+func (s *DaggerheartService) GetChase(ctx context.Context, in *pb.DaggerheartGetChaseRequest) (*pb.DaggerheartGetChaseResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "get chase request is required")
+	}
+	if err := s.requireChaseReadStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	chaseID := strings.TrimSpace(in.GetChaseId())
+	if chaseID == "" {
+		return nil, status.Error(codes.InvalidArgument, "chase id is required")
+	}
+	if err := s.ensureChaseCampaign(ctx, campaignID); err != nil {
+		return nil, err
+	}
+
+	chase, err := s.loadChase(ctx, campaignID, chaseID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DaggerheartGetChaseResponse{Chase: chase}, nil
+}
+
+// ListChases lists the chases in a campaign, resolved ones included.
+func (s *DaggerheartService) ListChases(ctx context.Context, in *pb.DaggerheartListChasesRequest) (*pb.DaggerheartListChasesResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "list chases request is required")
+	}
+	if err := s.requireChaseReadStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	if err := s.ensureChaseCampaign(ctx, campaignID); err != nil {
+		return nil, err
+	}
+
+	chases, err := s.stores.Daggerheart.ListDaggerheartChases(ctx, campaignID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list chases: %v", err)
+	}
+	resp := &pb.DaggerheartListChasesResponse{Chases: make([]*pb.DaggerheartChase, 0, len(chases))}
+	for _, chase := range chases {
+		proto, err := s.chaseToProto(ctx, chase)
+		if err != nil {
+			return nil, err
+		}
+		resp.Chases = append(resp.Chases, proto)
+	}
+	return resp, nil
+}
+
+func (s *DaggerheartService) requireChaseReadStores() error {
+	if s.stores.Campaign == nil {
+		return status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	return nil
+}
+
+func (s *DaggerheartService) ensureChaseCampaign(ctx context.Context, campaignID string) error {
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart chases")
+	}
+	return nil
+}
+
+func (s *DaggerheartService) loadChase(ctx context.Context, campaignID, chaseID string) (*pb.DaggerheartChase, error) {
+	chase, err := s.stores.Daggerheart.GetDaggerheartChase(ctx, campaignID, chaseID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "chase %q not found", chaseID)
+		}
+		return nil, status.Errorf(codes.Internal, "load chase: %v", err)
+	}
+	return s.chaseToProto(ctx, chase)
+}
+
+// chaseToProto attaches the linked countdowns to a chase. A countdown that
+// was deleted after the chase started is left out.
+func (s *DaggerheartService) chaseToProto(ctx context.Context, chase storage.DaggerheartChase) (*pb.DaggerheartChase, error) {
+	proto := &pb.DaggerheartChase{
+		ChaseId: chase.ChaseID,
+		Name:    chase.Name,
+		Status:  daggerheartChaseStatusToProto(chase.Status),
+		Winner:  daggerheartChaseWinnerToProto(chase.Winner),
+	}
+	for _, link := range []struct {
+		countdownID string
+		target      **pb.DaggerheartCountdown
+	}{
+		{countdownID: chase.ProgressCountdownID, target: &proto.Progress},
+		{countdownID: chase.ConsequenceCountdownID, target: &proto.Consequence},
+	} {
+		countdown, err := s.stores.Daggerheart.GetDaggerheartCountdown(ctx, chase.CampaignID, link.countdownID)
+		if errors.Is(err, storage.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load chase countdown: %v", err)
+		}
+		*link.target = daggerheartCountdownToProto(countdown)
+	}
+	return proto, nil
+}
+
+// chaseCountdownPayload builds the countdown_created payload for one side of a
+// chase. Requested outcome deltas replace the side's default dynamic ticks.
+func chaseCountdownPayload(spec *pb.DaggerheartChaseCountdownSpec, chaseID, chaseName, kind string, defaults map[string]int) (daggerheart.CountdownCreatedPayload, error) {
+	max := int(spec.GetMax())
+	if max <= 0 {
+		return daggerheart.CountdownCreatedPayload{}, fmt.Errorf("%s max must be positive", kind)
+	}
+	countdownID := strings.TrimSpace(spec.GetCountdownId())
+	if countdownID == "" {
+		countdownID = chaseID + "-" + kind
+	}
+	name := strings.TrimSpace(spec.GetName())
+	if name == "" {
+		name = chaseName + " " + kind
+	}
+
+	outcomeDeltas := defaults
+	for _, entry := range spec.GetOutcomeDeltas() {
+		if entry.GetOutcome() == pb.Outcome_OUTCOME_UNSPECIFIED {
+			return daggerheart.CountdownCreatedPayload{}, fmt.Errorf("countdown outcome is required")
+		}
+		outcomeDeltas[strings.ToLower(entry.GetOutcome().String())] = int(entry.GetDelta())
+	}
+	policy, err := daggerheart.NormalizeCountdownTickPolicy(daggerheart.CountdownTickPolicy{
+		Trigger:       daggerheart.CountdownTriggerActionRoll,
+		OutcomeDeltas: outcomeDeltas,
+	})
+	if err != nil {
+		return daggerheart.CountdownCreatedPayload{}, err
+	}
+
+	return daggerheart.CountdownCreatedPayload{
+		CountdownID:       countdownID,
+		Name:              name,
+		Kind:              kind,
+		Current:           max,
+		Max:               max,
+		Direction:         daggerheart.CountdownDirectionDecrease,
+		TickTrigger:       policy.Trigger,
+		TickDelta:         policy.Delta,
+		TickOutcomeDeltas: policy.OutcomeDeltas,
+	}, nil
+}
+
+// resolvedChaseCountdowns returns the countdown ids linked to resolved chases;
+// they stop ticking once their chase has a winner.
+func (s *DaggerheartService) resolvedChaseCountdowns(ctx context.Context, campaignID string) (map[string]bool, error) {
+	chases, err := s.stores.Daggerheart.ListDaggerheartChases(ctx, campaignID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list chases: %v", err)
+	}
+	resolved := make(map[string]bool)
+	for _, chase := range chases {
+		if chase.Status == daggerheart.ChaseStatusResolved {
+			resolved[chase.ProgressCountdownID] = true
+			resolved[chase.ConsequenceCountdownID] = true
+		}
+	}
+	return resolved, nil
+}
+
+// resolveChases records a winner for every active chase whose progress or
+// consequence countdown is in triggered.
+func (s *DaggerheartService) resolveChases(ctx context.Context, c campaign.Campaign, sessionID string, triggered map[string]bool) error {
+	if len(triggered) == 0 {
+		return nil
+	}
+	chases, err := s.stores.Daggerheart.ListDaggerheartChases(ctx, c.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "list chases: %v", err)
+	}
+	for _, chase := range chases {
+		if chase.Status != daggerheart.ChaseStatusActive {
+			continue
+		}
+		winner, ok := daggerheart.ResolveChase(triggered[chase.ProgressCountdownID], triggered[chase.ConsequenceCountdownID])
+		if !ok {
+			continue
+		}
+		countdownID := chase.ProgressCountdownID
+		if winner == daggerheart.ChaseWinnerConsequence {
+			countdownID = chase.ConsequenceCountdownID
+		}
+		payloadJSON, err := json.Marshal(daggerheart.ChaseResolvedPayload{
+			ChaseID:     chase.ChaseID,
+			Winner:      winner,
+			CountdownID: countdownID,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "encode chase resolved payload: %v", err)
+		}
+		if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeChaseResolved, "chase", chase.ChaseID, payloadJSON); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func daggerheartChaseStatusToProto(value string) pb.DaggerheartChaseStatus {
+	switch value {
+	case daggerheart.ChaseStatusActive:
+		return pb.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_ACTIVE
+	case daggerheart.ChaseStatusResolved:
+		return pb.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_RESOLVED
+	default:
+		return pb.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_UNSPECIFIED
+	}
+}
+
+func daggerheartChaseWinnerToProto(value string) pb.DaggerheartChaseWinner {
+	switch value {
+	case daggerheart.ChaseWinnerProgress:
+		return pb.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_PROGRESS
+	case daggerheart.ChaseWinnerConsequence:
+		return pb.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_CONSEQUENCE
+	default:
+		return pb.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_UNSPECIFIED
+	}
+}
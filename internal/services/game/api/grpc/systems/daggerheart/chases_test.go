@@ -0,0 +1,209 @@
+package daggerheart
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"google.golang.org/grpc/codes"
+)
+
+// flatOutcomeDeltas ticks the same number of steps on every graded outcome.
+func flatOutcomeDeltas(delta int32) []*pb.DaggerheartCountdownOutcomeDelta {
+	outcomes := []pb.Outcome{
+		pb.Outcome_CRITICAL_SUCCESS,
+		pb.Outcome_SUCCESS_WITH_HOPE,
+		pb.Outcome_SUCCESS_WITH_FEAR,
+		pb.Outcome_FAILURE_WITH_HOPE,
+		pb.Outcome_FAILURE_WITH_FEAR,
+	}
+	deltas := make([]*pb.DaggerheartCountdownOutcomeDelta, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		deltas = append(deltas, &pb.DaggerheartCountdownOutcomeDelta{Outcome: outcome, Delta: delta})
+	}
+	return deltas
+}
+
+func startTestChase(t *testing.T, svc *DaggerheartService, progress, consequence *pb.DaggerheartChaseCountdownSpec) *pb.DaggerheartChase {
+	t.Helper()
+	resp, err := svc.StartChase(context.Background(), &pb.DaggerheartStartChaseRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		ChaseId:     "chase-1",
+		Name:        "Thief",
+		Progress:    progress,
+		Consequence: consequence,
+	})
+	if err != nil {
+		t.Fatalf("StartChase returned error: %v", err)
+	}
+	return resp.GetChase()
+}
+
+func TestStartChase_CreatesLinkedCountdowns(t *testing.T) {
+	svc := newActionTestService()
+	chase := startTestChase(t, svc,
+		&pb.DaggerheartChaseCountdownSpec{Max: 6, OutcomeDeltas: []*pb.DaggerheartCountdownOutcomeDelta{
+			{Outcome: pb.Outcome_FAILURE_WITH_HOPE, Delta: 1},
+		}},
+		&pb.DaggerheartChaseCountdownSpec{CountdownId: "escape", Name: "Escape", Max: 4},
+	)
+
+	if chase.GetStatus() != pb.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_ACTIVE {
+		t.Fatalf("status = %v, want active", chase.GetStatus())
+	}
+	progress := chase.GetProgress()
+	if progress.GetCountdownId() != "chase-1-progress" || progress.GetName() != "Thief progress" {
+		t.Fatalf("progress = %+v", progress)
+	}
+	if progress.GetKind() != pb.DaggerheartCountdownKind_DAGGERHEART_COUNTDOWN_KIND_PROGRESS ||
+		progress.GetDirection() != pb.DaggerheartCountdownDirection_DAGGERHEART_COUNTDOWN_DIRECTION_DECREASE ||
+		progress.GetCurrent() != 6 {
+		t.Fatalf("progress countdown = %+v", progress)
+	}
+	consequence := chase.GetConsequence()
+	if consequence.GetCountdownId() != "escape" || consequence.GetCurrent() != 4 ||
+		consequence.GetKind() != pb.DaggerheartCountdownKind_DAGGERHEART_COUNTDOWN_KIND_CONSEQUENCE {
+		t.Fatalf("consequence countdown = %+v", consequence)
+	}
+
+	stored, err := svc.stores.Daggerheart.GetDaggerheartCountdown(context.Background(), "camp-1", "chase-1-progress")
+	if err != nil {
+		t.Fatalf("load progress countdown: %v", err)
+	}
+	if stored.TickTrigger != daggerheart.CountdownTriggerActionRoll {
+		t.Fatalf("tick trigger = %q", stored.TickTrigger)
+	}
+	if got := stored.TickOutcomeDeltas[daggerheart.CountdownOutcomeFailureWithHope]; got != 1 {
+		t.Fatalf("failure with hope delta = %d, want override 1", got)
+	}
+	if got := stored.TickOutcomeDeltas[daggerheart.CountdownOutcomeSuccessWithHope]; got != 2 {
+		t.Fatalf("success with hope delta = %d, want default 2", got)
+	}
+	if got := countEvents(svc, "action.countdown_created"); got != 2 {
+		t.Fatalf("countdown_created events = %d, want 2", got)
+	}
+	var started daggerheart.ChaseStartedPayload
+	lastEventPayload(t, svc, "chase.started", &started)
+	if started.ProgressCountdownID != "chase-1-progress" || started.ConsequenceCountdownID != "escape" {
+		t.Fatalf("chase started = %+v", started)
+	}
+}
+
+func TestStartChase_Validation(t *testing.T) {
+	svc := newActionTestService()
+	spec := &pb.DaggerheartChaseCountdownSpec{Max: 4}
+
+	_, err := svc.StartChase(context.Background(), &pb.DaggerheartStartChaseRequest{
+		CampaignId: "camp-1", SessionId: "sess-1", Name: "Thief", Progress: spec,
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	_, err = svc.StartChase(context.Background(), &pb.DaggerheartStartChaseRequest{
+		CampaignId: "camp-1", SessionId: "sess-1", Name: "Thief", Progress: spec,
+		Consequence: &pb.DaggerheartChaseCountdownSpec{},
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	_, err = svc.StartChase(context.Background(), &pb.DaggerheartStartChaseRequest{
+		CampaignId: "camp-1", SessionId: "sess-1", Name: "Thief",
+		Progress:    &pb.DaggerheartChaseCountdownSpec{CountdownId: "same", Max: 4},
+		Consequence: &pb.DaggerheartChaseCountdownSpec{CountdownId: "same", Max: 4},
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	startTestChase(t, svc, spec, spec)
+	_, err = svc.StartChase(context.Background(), &pb.DaggerheartStartChaseRequest{
+		CampaignId: "camp-1", SessionId: "sess-1", ChaseId: "chase-1", Name: "Again", Progress: spec, Consequence: spec,
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestSessionActionRoll_ResolvesChaseAndStopsTicking(t *testing.T) {
+	svc := newActionTestService()
+	startTestChase(t, svc,
+		&pb.DaggerheartChaseCountdownSpec{Max: 5, OutcomeDeltas: flatOutcomeDeltas(1)},
+		&pb.DaggerheartChaseCountdownSpec{Max: 1, OutcomeDeltas: flatOutcomeDeltas(1)},
+	)
+
+	roll := func() {
+		t.Helper()
+		_, err := svc.SessionActionRoll(context.Background(), &pb.SessionActionRollRequest{
+			CampaignId:  "camp-1",
+			SessionId:   "sess-1",
+			CharacterId: "char-1",
+			Trait:       "agility",
+			Difficulty:  10,
+		})
+		if err != nil {
+			t.Fatalf("SessionActionRoll returned error: %v", err)
+		}
+	}
+
+	roll()
+	if got := countdownCurrent(t, svc, "chase-1-progress"); got != 4 {
+		t.Fatalf("progress after roll = %d, want 4", got)
+	}
+	var resolved daggerheart.ChaseResolvedPayload
+	lastEventPayload(t, svc, "chase.resolved", &resolved)
+	if resolved.Winner != daggerheart.ChaseWinnerConsequence || resolved.CountdownID != "chase-1-consequence" {
+		t.Fatalf("chase resolved = %+v", resolved)
+	}
+
+	resp, err := svc.GetChase(context.Background(), &pb.DaggerheartGetChaseRequest{CampaignId: "camp-1", ChaseId: "chase-1"})
+	if err != nil {
+		t.Fatalf("GetChase returned error: %v", err)
+	}
+	if resp.GetChase().GetStatus() != pb.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_RESOLVED ||
+		resp.GetChase().GetWinner() != pb.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_CONSEQUENCE {
+		t.Fatalf("chase = %+v", resp.GetChase())
+	}
+
+	roll()
+	if got := countdownCurrent(t, svc, "chase-1-progress"); got != 4 {
+		t.Fatalf("progress after resolution = %d, want 4", got)
+	}
+	if got := countEvents(svc, "chase.resolved"); got != 1 {
+		t.Fatalf("chase.resolved events = %d, want 1", got)
+	}
+}
+
+func TestUpdateCountdown_ResolvesChaseForProgress(t *testing.T) {
+	svc := newActionTestService()
+	startTestChase(t, svc,
+		&pb.DaggerheartChaseCountdownSpec{Max: 3},
+		&pb.DaggerheartChaseCountdownSpec{Max: 3},
+	)
+
+	_, err := svc.UpdateCountdown(context.Background(), &pb.DaggerheartUpdateCountdownRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CountdownId: "chase-1-progress",
+		Delta:       -3,
+		Reason:      "shortcut",
+	})
+	if err != nil {
+		t.Fatalf("UpdateCountdown returned error: %v", err)
+	}
+
+	var resolved daggerheart.ChaseResolvedPayload
+	lastEventPayload(t, svc, "chase.resolved", &resolved)
+	if resolved.Winner != daggerheart.ChaseWinnerProgress {
+		t.Fatalf("winner = %q, want progress", resolved.Winner)
+	}
+
+	list, err := svc.ListChases(context.Background(), &pb.DaggerheartListChasesRequest{CampaignId: "camp-1"})
+	if err != nil {
+		t.Fatalf("ListChases returned error: %v", err)
+	}
+	if len(list.GetChases()) != 1 || list.GetChases()[0].GetWinner() != pb.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_PROGRESS {
+		t.Fatalf("chases = %+v", list.GetChases())
+	}
+}
+
+func TestGetChase_NotFound(t *testing.T) {
+	svc := newActionTestService()
+	_, err := svc.GetChase(context.Background(), &pb.DaggerheartGetChaseRequest{CampaignId: "camp-1", ChaseId: "missing"})
+	assertStatusCode(t, err, codes.NotFound)
+}
@@ -0,0 +1,464 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const companionUpgradeEnumPrefix = "DAGGERHEART_COMPANION_UPGRADE_"
+
+// CreateCompanion bonds an animal companion to a character.
+func (s *DaggerheartService) CreateCompanion(ctx context.Context, in *pb.DaggerheartCreateCompanionRequest) (*pb.DaggerheartCreateCompanionResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "create companion request is required")
+	}
+	if err := s.requireCompanionStores(); err != nil {
+		return nil, err
+	}
+	if s.stores.DaggerheartContent == nil {
+		return nil, status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	name := strings.TrimSpace(in.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "companion name is required")
+	}
+	experienceIDs := make([]string, 0, len(in.GetExperienceIds()))
+	for _, id := range in.GetExperienceIds() {
+		experienceIDs = append(experienceIDs, strings.TrimSpace(id))
+	}
+	stats, err := daggerheart.NewCompanionStats(experienceIDs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	for _, id := range experienceIDs {
+		if _, err := s.stores.DaggerheartContent.GetDaggerheartCompanionExperience(ctx, id); err != nil {
+			return nil, contentLookupError("companion experience", id, err)
+		}
+	}
+
+	c, sessionID, err := s.loadCompanionCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.loadCompanionProfile(ctx, campaignID, characterID); err != nil {
+		return nil, err
+	}
+	if _, err := s.stores.Daggerheart.GetDaggerheartCompanion(ctx, campaignID, characterID); err == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "character %q already has a companion", characterID)
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "load daggerheart companion: %v", err)
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.CompanionCreatedPayload{
+		CharacterID:    characterID,
+		Name:           name,
+		Evasion:        stats.Evasion,
+		StressMax:      stats.StressMax,
+		DamageDieSides: stats.DamageDieSides,
+		Range:          stats.Range,
+		Experiences:    stats.Experiences,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeCompanionCreated, "character", characterID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	companion, err := s.loadCompanion(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DaggerheartCreateCompanionResponse{CharacterId: characterID, Companion: companionToProto(companion)}, nil
+}
+
+// LevelUpCompanion applies one upgrade to a character's companion. A companion
+// levels up alongside its character and cannot outpace the character's level.
+func (s *DaggerheartService) LevelUpCompanion(ctx context.Context, in *pb.DaggerheartLevelUpCompanionRequest) (*pb.DaggerheartLevelUpCompanionResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "level up companion request is required")
+	}
+	if err := s.requireCompanionStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	upgrade := companionUpgradeFromProto(in.GetUpgrade())
+	if !daggerheart.IsCompanionUpgrade(upgrade) {
+		return nil, status.Error(codes.InvalidArgument, "companion upgrade is required")
+	}
+	experienceID := strings.TrimSpace(in.GetExperienceId())
+	vicious := ""
+	if upgrade == daggerheart.CompanionUpgradeVicious {
+		vicious = daggerheart.CompanionViciousDamage
+		if in.GetViciousRange() {
+			vicious = daggerheart.CompanionViciousRange
+		}
+	}
+
+	c, sessionID, err := s.loadCompanionCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.loadCompanionProfile(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	companion, err := s.loadCompanion(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	levelAfter := companion.Level + 1
+	if levelAfter > profile.Level {
+		return nil, status.Errorf(codes.FailedPrecondition, "companion level %d would exceed character level %d", levelAfter, profile.Level)
+	}
+	stats, err := daggerheart.ApplyCompanionUpgrade(daggerheart.CompanionStatsFromStorage(companion), upgrade, experienceID, vicious)
+	if err != nil {
+		if errors.Is(err, daggerheart.ErrInvalidCompanionUpgrade) || errors.Is(err, daggerheart.ErrCompanionExperienceMissing) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	payload := daggerheart.CompanionLeveledUpPayload{
+		CharacterID:         characterID,
+		Upgrade:             upgrade,
+		Vicious:             vicious,
+		LevelAfter:          levelAfter,
+		EvasionAfter:        stats.Evasion,
+		StressMaxAfter:      stats.StressMax,
+		DamageDieSidesAfter: stats.DamageDieSides,
+		RangeAfter:          stats.Range,
+		ExperiencesAfter:    stats.Experiences,
+	}
+	if upgrade == daggerheart.CompanionUpgradeIntelligent {
+		payload.ExperienceID = experienceID
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeCompanionLeveledUp, "character", characterID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.loadCompanion(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DaggerheartLevelUpCompanionResponse{CharacterId: characterID, Companion: companionToProto(updated)}, nil
+}
+
+// UpdateCompanionStress marks or clears companion Stress. A companion that
+// marks its last Stress drops out of the scene until it clears one.
+func (s *DaggerheartService) UpdateCompanionStress(ctx context.Context, in *pb.DaggerheartUpdateCompanionStressRequest) (*pb.DaggerheartUpdateCompanionStressResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "update companion stress request is required")
+	}
+	if err := s.requireCompanionStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	if in.GetDelta() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "stress delta is required")
+	}
+
+	c, sessionID, err := s.loadCompanionCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	companion, err := s.loadCompanion(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	after := daggerheart.CompanionStressAfter(companion.Stress, companion.StressMax, int(in.GetDelta()))
+	if after != companion.Stress {
+		payloadJSON, err := json.Marshal(daggerheart.CompanionStressChangedPayload{
+			CharacterID:  characterID,
+			StressBefore: companion.Stress,
+			StressAfter:  after,
+			Reason:       strings.TrimSpace(in.GetReason()),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+		}
+		if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeCompanionStressChanged, "character", characterID, payloadJSON); err != nil {
+			return nil, err
+		}
+		if companion, err = s.loadCompanion(ctx, campaignID, characterID); err != nil {
+			return nil, err
+		}
+	}
+	return &pb.DaggerheartUpdateCompanionStressResponse{CharacterId: characterID, Companion: companionToProto(companion)}, nil
+}
+
+// SessionCompanionAttackFlow attacks with a character's companion. The
+// character makes a Spellcast Roll against the target; on a hit the companion
+// deals its damage die times the character's Proficiency in physical damage.
+// Range is measured from the character, who the companion stays beside.
+func (s *DaggerheartService) SessionCompanionAttackFlow(ctx context.Context, in *pb.SessionCompanionAttackFlowRequest) (*pb.SessionCompanionAttackFlowResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "session companion attack flow request is required")
+	}
+	if err := s.requireCompanionStores(); err != nil {
+		return nil, err
+	}
+	if s.stores.Session == nil {
+		return nil, status.Error(codes.Internal, "session store is not configured")
+	}
+	if s.seedFunc == nil {
+		return nil, status.Error(codes.Internal, "seed generator is not configured")
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	if in.GetTarget() == nil {
+		return nil, status.Error(codes.InvalidArgument, "target is required")
+	}
+
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return nil, status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart attacks")
+	}
+	companion, err := s.loadCompanion(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if daggerheart.CompanionOutOfScene(companion.Stress, companion.StressMax) {
+		return nil, status.Error(codes.FailedPrecondition, daggerheart.ErrCompanionOutOfScene.Error())
+	}
+	profile, err := s.loadCompanionProfile(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	trait := strings.TrimSpace(in.GetTrait())
+	modifiers := in.GetModifiers()
+	if trait == "" {
+		trait, err = s.companionSpellcastTrait(ctx, profile)
+		if err != nil {
+			return nil, err
+		}
+		traitValue, _ := daggerheart.TraitValue(progressionFromProfile(profile).Traits, trait)
+		modifiers = append([]*pb.ActionRollModifier{{Value: int32(traitValue), Source: "spellcast_trait"}}, modifiers...)
+	}
+
+	targets, err := s.resolveMultiAttackTargets(ctx, campaignID, sessionID, characterID, []*pb.MultiAttackTarget{in.GetTarget()})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureTargetInReach(ctx, campaignID, sessionID, characterID, targets[0].id, companion.Range); err != nil {
+		return nil, err
+	}
+
+	rollResp, err := s.SessionActionRoll(ctx, &pb.SessionActionRollRequest{
+		CampaignId:  campaignID,
+		SessionId:   sessionID,
+		CharacterId: characterID,
+		Trait:       trait,
+		RollKind:    pb.RollKind_ROLL_KIND_ACTION,
+		Difficulty:  int32(targets[0].difficulty),
+		Modifiers:   modifiers,
+		Rng:         in.GetActionRng(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctxWithMeta := withCampaignSessionMetadata(ctx, campaignID, sessionID)
+	rollOutcome, err := s.ApplyRollOutcome(ctxWithMeta, &pb.ApplyRollOutcomeRequest{
+		SessionId: sessionID,
+		RollSeq:   rollResp.GetRollSeq(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dice := daggerheart.WeaponDamageDice([]daggerheart.DamageDieSpec{{Sides: companion.DamageDieSides, Count: 1}}, profile.Proficiency)
+	damageDice := make([]*pb.DiceSpec, 0, len(dice))
+	for _, die := range dice {
+		damageDice = append(damageDice, &pb.DiceSpec{Sides: int32(die.Sides), Count: int32(die.Count)})
+	}
+	resolution, err := s.resolveMultiAttack(ctx, c, sessionID, multiAttackRoll{
+		attackerID:   characterID,
+		attackerType: daggerheart.MultiAttackAttackerCompanion,
+		rollSeq:      rollResp.GetRollSeq(),
+		total:        int(rollResp.GetTotal()),
+		crit:         rollResp.GetCrit(),
+		damage: &pb.DaggerheartAttackDamageSpec{
+			DamageType:         pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL,
+			Source:             "companion",
+			SourceCharacterIds: []string{characterID},
+		},
+		damageDice:     damageDice,
+		damageModifier: in.GetDamageModifier(),
+		damageCritical: in.GetDamageCritical(),
+		damageRng:      in.GetDamageRng(),
+	}, targets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SessionCompanionAttackFlowResponse{
+		ActionRoll:  rollResp,
+		RollOutcome: rollOutcome,
+		DamageRoll:  resolution.damageRoll,
+		Result:      resolution.results[0],
+	}, nil
+}
+
+func (s *DaggerheartService) requireCompanionStores() error {
+	if s.stores.Campaign == nil {
+		return status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	if s.stores.Event == nil {
+		return status.Error(codes.Internal, "event store is not configured")
+	}
+	return nil
+}
+
+// loadCompanionCampaign validates the campaign for companion changes. Like
+// inventory, companions are managed between sessions too.
+func (s *DaggerheartService) loadCompanionCampaign(ctx context.Context, campaignID string) (campaign.Campaign, string, error) {
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return campaign.Campaign{}, "", handleDomainError(err)
+	}
+	if err := campaign.ValidateCampaignOperation(c.Status, campaign.CampaignOpCampaignMutate); err != nil {
+		return campaign.Campaign{}, "", handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return campaign.Campaign{}, "", status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart companions")
+	}
+	sessionID := strings.TrimSpace(grpcmeta.SessionIDFromContext(ctx))
+	if sessionID != "" {
+		if err := s.ensureNoOpenSessionGate(ctx, campaignID, sessionID); err != nil {
+			return campaign.Campaign{}, "", err
+		}
+	}
+	return c, sessionID, nil
+}
+
+func (s *DaggerheartService) loadCompanionProfile(ctx context.Context, campaignID, characterID string) (storage.DaggerheartCharacterProfile, error) {
+	profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.DaggerheartCharacterProfile{}, status.Errorf(codes.NotFound, "character %q profile not found", characterID)
+		}
+		return storage.DaggerheartCharacterProfile{}, status.Errorf(codes.Internal, "load daggerheart profile: %v", err)
+	}
+	return profile, nil
+}
+
+func (s *DaggerheartService) loadCompanion(ctx context.Context, campaignID, characterID string) (storage.DaggerheartCompanion, error) {
+	companion, err := s.stores.Daggerheart.GetDaggerheartCompanion(ctx, campaignID, characterID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.DaggerheartCompanion{}, status.Errorf(codes.NotFound, "character %q has no companion", characterID)
+		}
+		return storage.DaggerheartCompanion{}, status.Errorf(codes.Internal, "load daggerheart companion: %v", err)
+	}
+	return companion, nil
+}
+
+// companionSpellcastTrait returns the Spellcast trait of the character's
+// subclass, which companion attacks roll with.
+func (s *DaggerheartService) companionSpellcastTrait(ctx context.Context, profile storage.DaggerheartCharacterProfile) (string, error) {
+	if s.stores.DaggerheartContent == nil {
+		return "", status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	subclassID := strings.TrimSpace(profile.SubclassID)
+	if subclassID == "" {
+		return "", status.Error(codes.FailedPrecondition, "character has no subclass")
+	}
+	subclass, err := s.stores.DaggerheartContent.GetDaggerheartSubclass(ctx, subclassID)
+	if err != nil {
+		return "", contentLookupError("subclass", subclassID, err)
+	}
+	trait, err := daggerheart.NormalizeSpellcastTrait(subclass.SpellcastTrait)
+	if err != nil {
+		return "", status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return trait, nil
+}
+
+func companionUpgradeFromProto(upgrade pb.DaggerheartCompanionUpgrade) string {
+	if upgrade == pb.DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(upgrade.String(), companionUpgradeEnumPrefix))
+}
+
+func companionToProto(companion storage.DaggerheartCompanion) *pb.DaggerheartCompanion {
+	experiences := make([]*pb.DaggerheartCompanionExperience, 0, len(companion.Experiences))
+	for _, experience := range companion.Experiences {
+		experiences = append(experiences, &pb.DaggerheartCompanionExperience{
+			ExperienceId: experience.ExperienceID,
+			Modifier:     int32(experience.Modifier),
+		})
+	}
+	upgrades := make([]pb.DaggerheartCompanionUpgrade, 0, len(companion.Upgrades))
+	for _, upgrade := range companion.Upgrades {
+		upgrades = append(upgrades, pb.DaggerheartCompanionUpgrade(pb.DaggerheartCompanionUpgrade_value[companionUpgradeEnumPrefix+strings.ToUpper(upgrade)]))
+	}
+	return &pb.DaggerheartCompanion{
+		Name:           companion.Name,
+		Evasion:        int32(companion.Evasion),
+		Stress:         int32(companion.Stress),
+		StressMax:      int32(companion.StressMax),
+		DamageDieSides: int32(companion.DamageDieSides),
+		Range:          companion.Range,
+		Experiences:    experiences,
+		Level:          int32(companion.Level),
+		Upgrades:       upgrades,
+		OutOfScene:     daggerheart.CompanionOutOfScene(companion.Stress, companion.StressMax),
+	}
+}
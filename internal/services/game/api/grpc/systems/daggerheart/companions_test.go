@@ -0,0 +1,242 @@
+package daggerheart
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// newCompanionTestService makes char-1 a level 2 Beastbound ranger with
+// Agility 2 and Proficiency 2.
+func newCompanionTestService() *DaggerheartService {
+	svc := newSceneTestService()
+	content := svc.stores.DaggerheartContent.(*fakeContentStore)
+	for _, id := range []string{"companion-experience.scout", "companion-experience.vigilant", "companion-experience.expert-climber"} {
+		content.companionExperiences[id] = storage.DaggerheartCompanionExperienceEntry{ID: id, Name: id}
+	}
+	content.subclasses["subclass.beastbound"] = storage.DaggerheartSubclass{ID: "subclass.beastbound", Name: "Beastbound", SpellcastTrait: "Agility"}
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.Level = 2
+	profile.Proficiency = 2
+	profile.Agility = 2
+	profile.SubclassID = "subclass.beastbound"
+	dhStore.profiles["camp-1:char-1"] = profile
+	return svc
+}
+
+func createCompanion(t *testing.T, svc *DaggerheartService) {
+	t.Helper()
+	if _, err := svc.CreateCompanion(context.Background(), &pb.DaggerheartCreateCompanionRequest{
+		CampaignId:    "camp-1",
+		CharacterId:   "char-1",
+		Name:          "Ash",
+		ExperienceIds: []string{"companion-experience.scout", "companion-experience.vigilant"},
+	}); err != nil {
+		t.Fatalf("CreateCompanion returned error: %v", err)
+	}
+}
+
+func TestCompanion_MissingStores(t *testing.T) {
+	svc := &DaggerheartService{}
+	_, err := svc.CreateCompanion(context.Background(), &pb.DaggerheartCreateCompanionRequest{})
+	assertStatusCode(t, err, codes.Internal)
+	_, err = svc.SessionCompanionAttackFlow(context.Background(), &pb.SessionCompanionAttackFlowRequest{})
+	assertStatusCode(t, err, codes.Internal)
+}
+
+func TestCreateCompanion(t *testing.T) {
+	svc := newCompanionTestService()
+	resp, err := svc.CreateCompanion(context.Background(), &pb.DaggerheartCreateCompanionRequest{
+		CampaignId:    "camp-1",
+		CharacterId:   "char-1",
+		Name:          "Ash",
+		ExperienceIds: []string{"companion-experience.scout", "companion-experience.vigilant"},
+	})
+	if err != nil {
+		t.Fatalf("CreateCompanion returned error: %v", err)
+	}
+	companion := resp.GetCompanion()
+	if companion.GetName() != "Ash" || companion.GetEvasion() != 10 || companion.GetStressMax() != 3 ||
+		companion.GetDamageDieSides() != 6 || companion.GetRange() != daggerheart.RangeMelee || companion.GetLevel() != 1 {
+		t.Fatalf("companion = %v", companion)
+	}
+	if len(companion.GetExperiences()) != 2 || companion.GetExperiences()[0].GetModifier() != 2 {
+		t.Fatalf("experiences = %v", companion.GetExperiences())
+	}
+
+	_, err = svc.CreateCompanion(context.Background(), &pb.DaggerheartCreateCompanionRequest{
+		CampaignId:    "camp-1",
+		CharacterId:   "char-1",
+		Name:          "Bramble",
+		ExperienceIds: []string{"companion-experience.scout", "companion-experience.vigilant"},
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestCreateCompanion_InvalidRequests(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.DaggerheartCreateCompanionRequest
+		code codes.Code
+	}{
+		{name: "missing name", req: &pb.DaggerheartCreateCompanionRequest{CampaignId: "camp-1", CharacterId: "char-1", ExperienceIds: []string{"companion-experience.scout", "companion-experience.vigilant"}}, code: codes.InvalidArgument},
+		{name: "one experience", req: &pb.DaggerheartCreateCompanionRequest{CampaignId: "camp-1", CharacterId: "char-1", Name: "Ash", ExperienceIds: []string{"companion-experience.scout"}}, code: codes.InvalidArgument},
+		{name: "unknown experience", req: &pb.DaggerheartCreateCompanionRequest{CampaignId: "camp-1", CharacterId: "char-1", Name: "Ash", ExperienceIds: []string{"companion-experience.scout", "companion-experience.missing"}}, code: codes.InvalidArgument},
+		{name: "unknown character", req: &pb.DaggerheartCreateCompanionRequest{CampaignId: "camp-1", CharacterId: "char-9", Name: "Ash", ExperienceIds: []string{"companion-experience.scout", "companion-experience.vigilant"}}, code: codes.NotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newCompanionTestService().CreateCompanion(context.Background(), tc.req)
+			assertStatusCode(t, err, tc.code)
+		})
+	}
+}
+
+func TestLevelUpCompanion(t *testing.T) {
+	svc := newCompanionTestService()
+	createCompanion(t, svc)
+
+	resp, err := svc.LevelUpCompanion(context.Background(), &pb.DaggerheartLevelUpCompanionRequest{
+		CampaignId:  "camp-1",
+		CharacterId: "char-1",
+		Upgrade:     pb.DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_VICIOUS,
+	})
+	if err != nil {
+		t.Fatalf("LevelUpCompanion returned error: %v", err)
+	}
+	companion := resp.GetCompanion()
+	if companion.GetLevel() != 2 || companion.GetDamageDieSides() != 8 {
+		t.Fatalf("companion = %v, want level 2 with a d8", companion)
+	}
+	if upgrades := companion.GetUpgrades(); len(upgrades) != 1 || upgrades[0] != pb.DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_VICIOUS {
+		t.Fatalf("upgrades = %v, want [VICIOUS]", upgrades)
+	}
+
+	_, err = svc.LevelUpCompanion(context.Background(), &pb.DaggerheartLevelUpCompanionRequest{
+		CampaignId:  "camp-1",
+		CharacterId: "char-1",
+		Upgrade:     pb.DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_AWARE,
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestLevelUpCompanion_InvalidRequests(t *testing.T) {
+	svc := newCompanionTestService()
+	_, err := svc.LevelUpCompanion(context.Background(), &pb.DaggerheartLevelUpCompanionRequest{
+		CampaignId:  "camp-1",
+		CharacterId: "char-1",
+		Upgrade:     pb.DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_AWARE,
+	})
+	assertStatusCode(t, err, codes.NotFound)
+
+	createCompanion(t, svc)
+	_, err = svc.LevelUpCompanion(context.Background(), &pb.DaggerheartLevelUpCompanionRequest{CampaignId: "camp-1", CharacterId: "char-1"})
+	assertStatusCode(t, err, codes.InvalidArgument)
+	_, err = svc.LevelUpCompanion(context.Background(), &pb.DaggerheartLevelUpCompanionRequest{
+		CampaignId:   "camp-1",
+		CharacterId:  "char-1",
+		Upgrade:      pb.DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_INTELLIGENT,
+		ExperienceId: "companion-experience.expert-climber",
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestUpdateCompanionStress(t *testing.T) {
+	svc := newCompanionTestService()
+	createCompanion(t, svc)
+
+	resp, err := svc.UpdateCompanionStress(context.Background(), &pb.DaggerheartUpdateCompanionStressRequest{
+		CampaignId: "camp-1", CharacterId: "char-1", Delta: 5, Reason: "took_damage",
+	})
+	if err != nil {
+		t.Fatalf("UpdateCompanionStress returned error: %v", err)
+	}
+	if resp.GetCompanion().GetStress() != 3 || !resp.GetCompanion().GetOutOfScene() {
+		t.Fatalf("companion = %v, want 3 stress and out of scene", resp.GetCompanion())
+	}
+	var payload daggerheart.CompanionStressChangedPayload
+	lastEventPayload(t, svc, string(daggerheart.EventTypeCompanionStressChanged), &payload)
+	if payload.StressBefore != 0 || payload.StressAfter != 3 || payload.Reason != "took_damage" {
+		t.Fatalf("payload = %+v", payload)
+	}
+
+	_, err = svc.SessionCompanionAttackFlow(attackContext(), &pb.SessionCompanionAttackFlowRequest{
+		CampaignId: "camp-1", SessionId: "sess-1", CharacterId: "char-1",
+		Target: &pb.MultiAttackTarget{TargetId: "adv-1"},
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	resp, err = svc.UpdateCompanionStress(context.Background(), &pb.DaggerheartUpdateCompanionStressRequest{
+		CampaignId: "camp-1", CharacterId: "char-1", Delta: -1,
+	})
+	if err != nil {
+		t.Fatalf("UpdateCompanionStress returned error: %v", err)
+	}
+	if resp.GetCompanion().GetStress() != 2 || resp.GetCompanion().GetOutOfScene() {
+		t.Fatalf("companion = %v, want 2 stress and back in the scene", resp.GetCompanion())
+	}
+
+	_, err = svc.UpdateCompanionStress(context.Background(), &pb.DaggerheartUpdateCompanionStressRequest{CampaignId: "camp-1", CharacterId: "char-1"})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestSessionCompanionAttackFlow(t *testing.T) {
+	svc := newCompanionTestService()
+	createCompanion(t, svc)
+
+	resp, err := svc.SessionCompanionAttackFlow(attackContext(), &pb.SessionCompanionAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Target:      &pb.MultiAttackTarget{TargetId: "adv-1", Difficulty: wrapperspb.Int32(0)},
+	})
+	if err != nil {
+		t.Fatalf("SessionCompanionAttackFlow returned error: %v", err)
+	}
+	if !resp.GetResult().GetHit() {
+		t.Fatalf("result = %v, want a hit", resp.GetResult())
+	}
+	rolls := resp.GetDamageRoll().GetRolls()
+	if len(rolls) != 1 || rolls[0].GetSides() != 6 || len(rolls[0].GetResults()) != 2 {
+		t.Fatalf("damage rolls = %v, want 2d6", rolls)
+	}
+	roll := resp.GetActionRoll()
+	if roll.GetTotal() != roll.GetHopeDie()+roll.GetFearDie()+2 {
+		t.Fatalf("roll total = %d, want dice plus Agility 2", roll.GetTotal())
+	}
+
+	var payload daggerheart.MultiAttackResolvedPayload
+	lastEventPayload(t, svc, string(daggerheart.EventTypeMultiAttackResolved), &payload)
+	if payload.AttackerType != daggerheart.MultiAttackAttackerCompanion || payload.AttackerID != "char-1" {
+		t.Fatalf("payload attacker = %s/%s, want companion/char-1", payload.AttackerType, payload.AttackerID)
+	}
+}
+
+func TestSessionCompanionAttackFlow_OutOfReach(t *testing.T) {
+	svc := newCompanionTestService()
+	createCompanion(t, svc)
+	if _, err := svc.SetSceneRanges(context.Background(), &pb.DaggerheartSetSceneRangesRequest{
+		CampaignId: "camp-1",
+		SessionId:  "sess-1",
+		Ranges: []*pb.DaggerheartSceneRange{{
+			From: sceneCharacter("char-1"),
+			To:   sceneAdversary("adv-1"),
+			Band: pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_CLOSE,
+		}},
+	}); err != nil {
+		t.Fatalf("SetSceneRanges returned error: %v", err)
+	}
+	_, err := svc.SessionCompanionAttackFlow(attackContext(), &pb.SessionCompanionAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Target:      &pb.MultiAttackTarget{TargetId: "adv-1"},
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
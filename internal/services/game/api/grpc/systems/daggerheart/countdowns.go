@@ -1,11 +1,18 @@
 package daggerheart
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func daggerheartCountdownKindFromProto(kind pb.DaggerheartCountdownKind) (string, error) {
@@ -65,5 +72,177 @@ func daggerheartCountdownToProto(countdown storage.DaggerheartCountdown) *pb.Dag
 		Max:         int32(countdown.Max),
 		Direction:   daggerheartCountdownDirectionToProto(countdown.Direction),
 		Looping:     countdown.Looping,
+		TickPolicy:  daggerheartCountdownTickPolicyToProto(countdown),
 	}
 }
+
+func daggerheartCountdownTriggerFromProto(trigger pb.DaggerheartCountdownTrigger) (string, error) {
+	switch trigger {
+	case pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_UNSPECIFIED:
+		return "", nil
+	case pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL:
+		return daggerheart.CountdownTriggerActionRoll, nil
+	case pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_REST:
+		return daggerheart.CountdownTriggerRest, nil
+	case pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_FEAR_SPENT:
+		return daggerheart.CountdownTriggerFearSpent, nil
+	case pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_MAJOR_DAMAGE:
+		return daggerheart.CountdownTriggerMajorDamage, nil
+	default:
+		return "", fmt.Errorf("countdown trigger %v is invalid", trigger)
+	}
+}
+
+func daggerheartCountdownTriggerToProto(trigger string) pb.DaggerheartCountdownTrigger {
+	switch trigger {
+	case daggerheart.CountdownTriggerActionRoll:
+		return pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL
+	case daggerheart.CountdownTriggerRest:
+		return pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_REST
+	case daggerheart.CountdownTriggerFearSpent:
+		return pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_FEAR_SPENT
+	case daggerheart.CountdownTriggerMajorDamage:
+		return pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_MAJOR_DAMAGE
+	default:
+		return pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_UNSPECIFIED
+	}
+}
+
+// daggerheartCountdownTickPolicyFromProto converts and validates a requested
+// tick policy. Outcome tiers use the lower-case duality outcome names.
+func daggerheartCountdownTickPolicyFromProto(policy *pb.DaggerheartCountdownTickPolicy) (daggerheart.CountdownTickPolicy, error) {
+	if policy == nil {
+		return daggerheart.CountdownTickPolicy{}, nil
+	}
+	trigger, err := daggerheartCountdownTriggerFromProto(policy.GetTrigger())
+	if err != nil {
+		return daggerheart.CountdownTickPolicy{}, err
+	}
+	var outcomeDeltas map[string]int
+	for _, entry := range policy.GetOutcomeDeltas() {
+		if entry.GetOutcome() == pb.Outcome_OUTCOME_UNSPECIFIED {
+			return daggerheart.CountdownTickPolicy{}, fmt.Errorf("countdown outcome is required")
+		}
+		if outcomeDeltas == nil {
+			outcomeDeltas = make(map[string]int, len(policy.GetOutcomeDeltas()))
+		}
+		outcomeDeltas[strings.ToLower(entry.GetOutcome().String())] = int(entry.GetDelta())
+	}
+	return daggerheart.NormalizeCountdownTickPolicy(daggerheart.CountdownTickPolicy{
+		Trigger:       trigger,
+		Delta:         int(policy.GetDelta()),
+		OutcomeDeltas: outcomeDeltas,
+	})
+}
+
+func daggerheartCountdownTickPolicyToProto(countdown storage.DaggerheartCountdown) *pb.DaggerheartCountdownTickPolicy {
+	if countdown.TickTrigger == "" {
+		return nil
+	}
+	outcomes := make([]string, 0, len(countdown.TickOutcomeDeltas))
+	for outcome := range countdown.TickOutcomeDeltas {
+		outcomes = append(outcomes, outcome)
+	}
+	sort.Strings(outcomes)
+	outcomeDeltas := make([]*pb.DaggerheartCountdownOutcomeDelta, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		outcomeDeltas = append(outcomeDeltas, &pb.DaggerheartCountdownOutcomeDelta{
+			Outcome: pb.Outcome(pb.Outcome_value[strings.ToUpper(outcome)]),
+			Delta:   int32(countdown.TickOutcomeDeltas[outcome]),
+		})
+	}
+	return &pb.DaggerheartCountdownTickPolicy{
+		Trigger:       daggerheartCountdownTriggerToProto(countdown.TickTrigger),
+		Delta:         int32(countdown.TickDelta),
+		OutcomeDeltas: outcomeDeltas,
+	}
+}
+
+func countdownFromStorage(countdown storage.DaggerheartCountdown) daggerheart.Countdown {
+	return daggerheart.Countdown{
+		CampaignID: countdown.CampaignID,
+		ID:         countdown.CountdownID,
+		Name:       countdown.Name,
+		Kind:       countdown.Kind,
+		Current:    countdown.Current,
+		Max:        countdown.Max,
+		Direction:  countdown.Direction,
+		Looping:    countdown.Looping,
+		TickPolicy: daggerheart.CountdownTickPolicy{
+			Trigger:       countdown.TickTrigger,
+			Delta:         countdown.TickDelta,
+			OutcomeDeltas: countdown.TickOutcomeDeltas,
+		},
+	}
+}
+
+// tickCountdowns advances every countdown whose tick policy listens to
+// trigger. The outcome is the action roll outcome code and is ignored by the
+// other triggers. Countdowns that reach their end also record a
+// countdown_triggered event after the update, and any chase they belong to is
+// resolved once every countdown has ticked.
+func (s *DaggerheartService) tickCountdowns(ctx context.Context, c campaign.Campaign, sessionID, trigger, outcome string) error {
+	countdowns, err := s.stores.Daggerheart.ListDaggerheartCountdowns(ctx, c.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "list countdowns: %v", err)
+	}
+	sort.Slice(countdowns, func(i, j int) bool {
+		return countdowns[i].CountdownID < countdowns[j].CountdownID
+	})
+	finished, err := s.resolvedChaseCountdowns(ctx, c.ID)
+	if err != nil {
+		return err
+	}
+
+	triggered := make(map[string]bool)
+	for _, stored := range countdowns {
+		if finished[stored.CountdownID] {
+			continue
+		}
+		countdown := countdownFromStorage(stored)
+		steps := countdown.TickPolicy.Steps(trigger, outcome)
+		if steps == 0 {
+			continue
+		}
+		update, err := daggerheart.TickCountdown(countdown, steps)
+		if err != nil {
+			return status.Errorf(codes.Internal, "tick countdown %s: %v", countdown.ID, err)
+		}
+		if update.Delta == 0 && !update.Looped {
+			continue
+		}
+
+		payloadJSON, err := json.Marshal(daggerheart.CountdownUpdatedPayload{
+			CountdownID: countdown.ID,
+			Before:      update.Before,
+			After:       update.After,
+			Delta:       update.Delta,
+			Looped:      update.Looped,
+			Reason:      trigger,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "encode countdown update payload: %v", err)
+		}
+		if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeCountdownUpdated, "countdown", countdown.ID, payloadJSON); err != nil {
+			return err
+		}
+		if !update.Triggered {
+			continue
+		}
+		triggered[countdown.ID] = true
+
+		triggeredJSON, err := json.Marshal(daggerheart.CountdownTriggeredPayload{
+			CountdownID: countdown.ID,
+			Trigger:     trigger,
+			Value:       update.After,
+			Looped:      update.Looped,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "encode countdown triggered payload: %v", err)
+		}
+		if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeCountdownTriggered, "countdown", countdown.ID, triggeredJSON); err != nil {
+			return err
+		}
+	}
+	return s.resolveChases(ctx, c, sessionID, triggered)
+}
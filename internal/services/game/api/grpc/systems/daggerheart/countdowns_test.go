@@ -1,11 +1,13 @@
 package daggerheart
 
 import (
+	"context"
 	"testing"
 
 	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
 )
 
 func TestDaggerheartCountdownKindFromProto(t *testing.T) {
@@ -89,3 +91,271 @@ func TestDaggerheartCountdownToProto(t *testing.T) {
 		t.Fatal("expected looping to map")
 	}
 }
+
+func TestDaggerheartCountdownTickPolicyFromProto(t *testing.T) {
+	policy, err := daggerheartCountdownTickPolicyFromProto(&pb.DaggerheartCountdownTickPolicy{
+		Trigger: pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL,
+		OutcomeDeltas: []*pb.DaggerheartCountdownOutcomeDelta{
+			{Outcome: pb.Outcome_FAILURE_WITH_FEAR, Delta: 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.Trigger != daggerheart.CountdownTriggerActionRoll || policy.Delta != 0 {
+		t.Fatalf("policy = %+v", policy)
+	}
+	if policy.OutcomeDeltas[daggerheart.CountdownOutcomeFailureWithFear] != 2 {
+		t.Fatalf("outcome deltas = %v", policy.OutcomeDeltas)
+	}
+
+	back := daggerheartCountdownTickPolicyToProto(storage.DaggerheartCountdown{
+		TickTrigger:       policy.Trigger,
+		TickOutcomeDeltas: policy.OutcomeDeltas,
+	})
+	if back.GetTrigger() != pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL {
+		t.Fatalf("trigger = %v", back.GetTrigger())
+	}
+	if len(back.GetOutcomeDeltas()) != 1 || back.GetOutcomeDeltas()[0].GetOutcome() != pb.Outcome_FAILURE_WITH_FEAR {
+		t.Fatalf("outcome deltas = %v", back.GetOutcomeDeltas())
+	}
+
+	if _, err := daggerheartCountdownTickPolicyFromProto(&pb.DaggerheartCountdownTickPolicy{
+		Trigger:       pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_REST,
+		OutcomeDeltas: []*pb.DaggerheartCountdownOutcomeDelta{{Outcome: pb.Outcome_CRITICAL_SUCCESS, Delta: 1}},
+	}); err == nil {
+		t.Fatal("expected error for outcome deltas on rest trigger")
+	}
+	if daggerheartCountdownTickPolicyToProto(storage.DaggerheartCountdown{}) != nil {
+		t.Fatal("expected nil policy for manual countdown")
+	}
+}
+
+func createTickingCountdown(t *testing.T, svc *DaggerheartService, id string, direction pb.DaggerheartCountdownDirection, current, max int32, looping bool, policy *pb.DaggerheartCountdownTickPolicy) {
+	t.Helper()
+	_, err := svc.CreateCountdown(context.Background(), &pb.DaggerheartCreateCountdownRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CountdownId: id,
+		Name:        id,
+		Kind:        pb.DaggerheartCountdownKind_DAGGERHEART_COUNTDOWN_KIND_CONSEQUENCE,
+		Direction:   direction,
+		Current:     current,
+		Max:         max,
+		Looping:     looping,
+		TickPolicy:  policy,
+	})
+	if err != nil {
+		t.Fatalf("CreateCountdown returned error: %v", err)
+	}
+}
+
+func countdownCurrent(t *testing.T, svc *DaggerheartService, id string) int {
+	t.Helper()
+	countdown, err := svc.stores.Daggerheart.GetDaggerheartCountdown(context.Background(), "camp-1", id)
+	if err != nil {
+		t.Fatalf("load countdown %s: %v", id, err)
+	}
+	return countdown.Current
+}
+
+func countEvents(svc *DaggerheartService, eventType string) int {
+	count := 0
+	for _, evt := range svc.stores.Event.(*fakeEventStore).events["camp-1"] {
+		if string(evt.Type) == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func TestCreateCountdown_InvalidTickPolicy(t *testing.T) {
+	svc := newActionTestService()
+	_, err := svc.CreateCountdown(context.Background(), &pb.DaggerheartCreateCountdownRequest{
+		CampaignId: "camp-1",
+		SessionId:  "sess-1",
+		Name:       "Bad Policy",
+		Kind:       pb.DaggerheartCountdownKind_DAGGERHEART_COUNTDOWN_KIND_PROGRESS,
+		Direction:  pb.DaggerheartCountdownDirection_DAGGERHEART_COUNTDOWN_DIRECTION_INCREASE,
+		Max:        4,
+		TickPolicy: &pb.DaggerheartCountdownTickPolicy{Delta: 2},
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestSessionActionRoll_TicksCountdownByOutcome(t *testing.T) {
+	svc := newActionTestService()
+	increase := pb.DaggerheartCountdownDirection_DAGGERHEART_COUNTDOWN_DIRECTION_INCREASE
+	createTickingCountdown(t, svc, "cd-outcome", increase, 0, 10, false, &pb.DaggerheartCountdownTickPolicy{
+		Trigger: pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL,
+		OutcomeDeltas: []*pb.DaggerheartCountdownOutcomeDelta{
+			{Outcome: pb.Outcome_CRITICAL_SUCCESS, Delta: 1},
+			{Outcome: pb.Outcome_SUCCESS_WITH_HOPE, Delta: 2},
+			{Outcome: pb.Outcome_SUCCESS_WITH_FEAR, Delta: 3},
+			{Outcome: pb.Outcome_FAILURE_WITH_HOPE, Delta: 4},
+			{Outcome: pb.Outcome_FAILURE_WITH_FEAR, Delta: 5},
+		},
+	})
+	createTickingCountdown(t, svc, "cd-rest", increase, 0, 4, false, &pb.DaggerheartCountdownTickPolicy{
+		Trigger: pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_REST,
+	})
+
+	_, err := svc.SessionActionRoll(context.Background(), &pb.SessionActionRollRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Trait:       "agility",
+		Difficulty:  10,
+	})
+	if err != nil {
+		t.Fatalf("SessionActionRoll returned error: %v", err)
+	}
+
+	var roll struct {
+		Outcome string `json:"outcome"`
+	}
+	lastEventPayload(t, svc, "action.roll_resolved", &roll)
+	want := map[string]int{
+		"CRITICAL_SUCCESS":  1,
+		"SUCCESS_WITH_HOPE": 2,
+		"SUCCESS_WITH_FEAR": 3,
+		"FAILURE_WITH_HOPE": 4,
+		"FAILURE_WITH_FEAR": 5,
+	}[roll.Outcome]
+	if got := countdownCurrent(t, svc, "cd-outcome"); got != want {
+		t.Fatalf("countdown after %s = %d, want %d", roll.Outcome, got, want)
+	}
+	if got := countdownCurrent(t, svc, "cd-rest"); got != 0 {
+		t.Fatalf("rest countdown = %d, want 0", got)
+	}
+
+	var update daggerheart.CountdownUpdatedPayload
+	lastEventPayload(t, svc, "action.countdown_updated", &update)
+	if update.CountdownID != "cd-outcome" || update.Reason != daggerheart.CountdownTriggerActionRoll {
+		t.Fatalf("update = %+v", update)
+	}
+}
+
+func TestSessionActionRoll_ReactionDoesNotTickCountdown(t *testing.T) {
+	svc := newActionTestService()
+	createTickingCountdown(t, svc, "cd-roll", pb.DaggerheartCountdownDirection_DAGGERHEART_COUNTDOWN_DIRECTION_INCREASE, 0, 4, false, &pb.DaggerheartCountdownTickPolicy{
+		Trigger: pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL,
+	})
+
+	_, err := svc.SessionActionRoll(context.Background(), &pb.SessionActionRollRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Trait:       "agility",
+		Difficulty:  10,
+		RollKind:    pb.RollKind_ROLL_KIND_REACTION,
+	})
+	if err != nil {
+		t.Fatalf("SessionActionRoll returned error: %v", err)
+	}
+	if got := countdownCurrent(t, svc, "cd-roll"); got != 0 {
+		t.Fatalf("countdown = %d, want 0", got)
+	}
+}
+
+func TestApplyRest_TicksLoopingCountdownAndTriggers(t *testing.T) {
+	svc := newActionTestService()
+	createTickingCountdown(t, svc, "cd-watch", pb.DaggerheartCountdownDirection_DAGGERHEART_COUNTDOWN_DIRECTION_DECREASE, 1, 3, true, &pb.DaggerheartCountdownTickPolicy{
+		Trigger: pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_REST,
+	})
+
+	_, err := svc.ApplyRest(contextWithSessionID("sess-1"), &pb.DaggerheartApplyRestRequest{
+		CampaignId:   "camp-1",
+		CharacterIds: []string{"char-1"},
+		Rest: &pb.DaggerheartRestRequest{
+			RestType:  pb.DaggerheartRestType_DAGGERHEART_REST_TYPE_SHORT,
+			PartySize: 3,
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyRest returned error: %v", err)
+	}
+	if got := countdownCurrent(t, svc, "cd-watch"); got != 3 {
+		t.Fatalf("countdown = %d, want reset to 3", got)
+	}
+
+	var triggered daggerheart.CountdownTriggeredPayload
+	lastEventPayload(t, svc, "action.countdown_triggered", &triggered)
+	if triggered.CountdownID != "cd-watch" || triggered.Trigger != daggerheart.CountdownTriggerRest || !triggered.Looped {
+		t.Fatalf("triggered = %+v", triggered)
+	}
+}
+
+func TestApplyGmMove_FearSpendTicksCountdownToEnd(t *testing.T) {
+	svc := newActionTestService()
+	svc.stores.Daggerheart.(*fakeDaggerheartStore).snapshots["camp-1"] = storage.DaggerheartSnapshot{CampaignID: "camp-1", GMFear: 3}
+	createTickingCountdown(t, svc, "cd-ritual", pb.DaggerheartCountdownDirection_DAGGERHEART_COUNTDOWN_DIRECTION_INCREASE, 2, 4, false, &pb.DaggerheartCountdownTickPolicy{
+		Trigger: pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_FEAR_SPENT,
+		Delta:   2,
+	})
+
+	move := &pb.DaggerheartApplyGmMoveRequest{CampaignId: "camp-1", SessionId: "sess-1", Move: "ritual_advance", FearSpent: 1}
+	if _, err := svc.ApplyGmMove(context.Background(), move); err != nil {
+		t.Fatalf("ApplyGmMove returned error: %v", err)
+	}
+	if got := countdownCurrent(t, svc, "cd-ritual"); got != 4 {
+		t.Fatalf("countdown = %d, want 4", got)
+	}
+	if got := countEvents(svc, "action.countdown_triggered"); got != 1 {
+		t.Fatalf("triggered events = %d, want 1", got)
+	}
+
+	// A finished, non-looping countdown stays put on later spends.
+	if _, err := svc.ApplyGmMove(context.Background(), move); err != nil {
+		t.Fatalf("ApplyGmMove returned error: %v", err)
+	}
+	if got := countEvents(svc, "action.countdown_triggered"); got != 1 {
+		t.Fatalf("triggered events = %d, want 1", got)
+	}
+
+	// Moves without a Fear spend do not tick.
+	updates := countEvents(svc, "action.countdown_updated")
+	if _, err := svc.ApplyGmMove(context.Background(), &pb.DaggerheartApplyGmMoveRequest{CampaignId: "camp-1", SessionId: "sess-1", Move: "look"}); err != nil {
+		t.Fatalf("ApplyGmMove returned error: %v", err)
+	}
+	if got := countEvents(svc, "action.countdown_updated"); got != updates {
+		t.Fatalf("countdown updates = %d, want %d", got, updates)
+	}
+}
+
+func TestApplyDamage_MajorDamageTicksCountdown(t *testing.T) {
+	svc := newActionTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	profile := dhStore.profiles["camp-1:char-2"]
+	profile.MajorThreshold = 4
+	profile.SevereThreshold = 8
+	dhStore.profiles["camp-1:char-2"] = profile
+	createTickingCountdown(t, svc, "cd-wounds", pb.DaggerheartCountdownDirection_DAGGERHEART_COUNTDOWN_DIRECTION_INCREASE, 0, 3, false, &pb.DaggerheartCountdownTickPolicy{
+		Trigger: pb.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_MAJOR_DAMAGE,
+	})
+
+	damage := func(amount int32) {
+		t.Helper()
+		_, err := svc.ApplyDamage(contextWithSessionID("sess-1"), &pb.DaggerheartApplyDamageRequest{
+			CampaignId:  "camp-1",
+			CharacterId: "char-2",
+			Damage: &pb.DaggerheartDamageRequest{
+				Amount:       amount,
+				DamageType:   pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL,
+				DeclineArmor: true,
+			},
+		})
+		if err != nil {
+			t.Fatalf("ApplyDamage returned error: %v", err)
+		}
+	}
+
+	damage(1)
+	if got := countdownCurrent(t, svc, "cd-wounds"); got != 0 {
+		t.Fatalf("countdown after minor damage = %d, want 0", got)
+	}
+	damage(20)
+	if got := countdownCurrent(t, svc, "cd-wounds"); got != 1 {
+		t.Fatalf("countdown after severe damage = %d, want 1", got)
+	}
+}
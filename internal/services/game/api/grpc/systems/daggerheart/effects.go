@@ -0,0 +1,569 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/id"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ApplyEffect places a timed effect on a character or adversary. Armor
+// effects grant their slots immediately; the other kinds are read by the
+// roll, damage, Evasion and Hope Die checks for as long as the effect lasts.
+func (s *DaggerheartService) ApplyEffect(ctx context.Context, in *pb.DaggerheartApplyEffectRequest) (*pb.DaggerheartApplyEffectResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "apply effect request is required")
+	}
+	if err := s.requireEffectStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	targetID := strings.TrimSpace(in.GetTargetId())
+	if targetID == "" {
+		return nil, status.Error(codes.InvalidArgument, "target id is required")
+	}
+	targetType, err := effectTargetTypeFromProto(in.GetTargetType())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	kind, err := effectKindFromProto(in.GetKind())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if kind == daggerheart.EffectKindArmor && targetType != daggerheart.EffectTargetCharacter {
+		return nil, status.Error(codes.InvalidArgument, "armor effects apply to characters only")
+	}
+	if kind == daggerheart.EffectKindArmor && in.GetValue() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "armor effect value must be positive")
+	}
+	if in.GetValue() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "effect value must not be zero")
+	}
+	if kind == daggerheart.EffectKindArmor && in.GetExpiresOn() == pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK {
+		return nil, status.Error(codes.InvalidArgument, "armor effects cannot expire on the next attack")
+	}
+	if kind == daggerheart.EffectKindHopeDie && targetType != daggerheart.EffectTargetCharacter {
+		return nil, status.Error(codes.InvalidArgument, "hope die effects apply to characters only")
+	}
+	if kind == daggerheart.EffectKindHopeDie && in.GetExpiresOn() == pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK {
+		return nil, status.Error(codes.InvalidArgument, "hope die effects cannot expire on the next attack")
+	}
+
+	c, sessionID, err := s.loadEffectCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureEffectTarget(ctx, campaignID, targetType, targetID); err != nil {
+		return nil, err
+	}
+	effectID := strings.TrimSpace(in.GetEffectId())
+	if effectID == "" {
+		effectID, err = id.NewID()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "generate effect id: %v", err)
+		}
+	}
+	if _, err := s.stores.Daggerheart.GetDaggerheartEffect(ctx, campaignID, effectID); err == nil {
+		return nil, status.Error(codes.FailedPrecondition, "effect already exists")
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, handleDomainError(err)
+	}
+
+	next := daggerheart.Effect{
+		ID:         effectID,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Source:     strings.TrimSpace(in.GetSource()),
+		Kind:       kind,
+		Value:      int(in.GetValue()),
+		Stacking:   effectStackingFromProto(in.GetStacking()),
+		ExpiresOn:  effectExpiryFromProto(in.GetExpiresOn()),
+	}
+	current, err := s.targetEffects(ctx, campaignID, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	replaced := make([]string, 0)
+	for _, effect := range current {
+		if !daggerheart.EffectReplaces(next, effectFromStorage(effect)) {
+			continue
+		}
+		if err := s.expireEffect(ctx, c, sessionID, effect, daggerheart.EffectExpiredReplaced); err != nil {
+			return nil, err
+		}
+		replaced = append(replaced, effect.EffectID)
+	}
+
+	payload := daggerheart.EffectAppliedPayload{
+		EffectID:   next.ID,
+		TargetType: next.TargetType,
+		TargetID:   next.TargetID,
+		Source:     next.Source,
+		Kind:       next.Kind,
+		Value:      next.Value,
+		Stacking:   next.Stacking,
+		ExpiresOn:  next.ExpiresOn,
+	}
+	if next.Kind == daggerheart.EffectKindArmor {
+		remaining, err := s.targetEffects(ctx, campaignID, targetType, targetID)
+		if err != nil {
+			return nil, err
+		}
+		before := effectsFromStorage(remaining)
+		payload.ArmorBefore, payload.ArmorAfter, err = s.effectArmor(ctx, campaignID, targetID, before, append(before, next))
+		if err != nil {
+			return nil, err
+		}
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeEffectApplied, "effect", effectID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	stored, err := s.stores.Daggerheart.GetDaggerheartEffect(ctx, campaignID, effectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load effect: %v", err)
+	}
+	return &pb.DaggerheartApplyEffectResponse{
+		Effect:            daggerheartEffectToProto(stored),
+		ReplacedEffectIds: replaced,
+	}, nil
+}
+
+// RemoveEffect ends a timed effect before its expiry trigger fires.
+func (s *DaggerheartService) RemoveEffect(ctx context.Context, in *pb.DaggerheartRemoveEffectRequest) (*pb.DaggerheartRemoveEffectResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "remove effect request is required")
+	}
+	if err := s.requireEffectStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	effectID := strings.TrimSpace(in.GetEffectId())
+	if effectID == "" {
+		return nil, status.Error(codes.InvalidArgument, "effect id is required")
+	}
+
+	c, sessionID, err := s.loadEffectCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	effect, err := s.stores.Daggerheart.GetDaggerheartEffect(ctx, campaignID, effectID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "effect %q not found", effectID)
+		}
+		return nil, handleDomainError(err)
+	}
+	if err := s.expireEffect(ctx, c, sessionID, effect, daggerheart.EffectExpiredRemoved); err != nil {
+		return nil, err
+	}
+	return &pb.DaggerheartRemoveEffectResponse{EffectId: effectID}, nil
+}
+
+// ListEffects lists the timed effects in a campaign, optionally for one target.
+func (s *DaggerheartService) ListEffects(ctx context.Context, in *pb.DaggerheartListEffectsRequest) (*pb.DaggerheartListEffectsResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "list effects request is required")
+	}
+	if s.stores.Campaign == nil {
+		return nil, status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return nil, status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return nil, status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart effects")
+	}
+
+	effects, err := s.stores.Daggerheart.ListDaggerheartEffects(ctx, campaignID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list effects: %v", err)
+	}
+	if targetID := strings.TrimSpace(in.GetTargetId()); targetID != "" {
+		filtered := make([]storage.DaggerheartEffect, 0, len(effects))
+		for _, effect := range effects {
+			if effect.TargetID == targetID {
+				filtered = append(filtered, effect)
+			}
+		}
+		effects = filtered
+	}
+	return &pb.DaggerheartListEffectsResponse{Effects: EffectsToProto(effects)}, nil
+}
+
+func (s *DaggerheartService) requireEffectStores() error {
+	if s.stores.Campaign == nil {
+		return status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	if s.stores.Event == nil {
+		return status.Error(codes.Internal, "event store is not configured")
+	}
+	return nil
+}
+
+// loadEffectCampaign validates the campaign for effect changes. Effects that
+// last until a rest can be placed or removed between sessions.
+func (s *DaggerheartService) loadEffectCampaign(ctx context.Context, campaignID string) (campaign.Campaign, string, error) {
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return campaign.Campaign{}, "", handleDomainError(err)
+	}
+	if err := campaign.ValidateCampaignOperation(c.Status, campaign.CampaignOpCampaignMutate); err != nil {
+		return campaign.Campaign{}, "", handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return campaign.Campaign{}, "", status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart effects")
+	}
+	sessionID := strings.TrimSpace(grpcmeta.SessionIDFromContext(ctx))
+	if sessionID != "" {
+		if err := s.ensureNoOpenSessionGate(ctx, campaignID, sessionID); err != nil {
+			return campaign.Campaign{}, "", err
+		}
+	}
+	return c, sessionID, nil
+}
+
+func (s *DaggerheartService) ensureEffectTarget(ctx context.Context, campaignID, targetType, targetID string) error {
+	var err error
+	if targetType == daggerheart.EffectTargetAdversary {
+		_, err = s.stores.Daggerheart.GetDaggerheartAdversary(ctx, campaignID, targetID)
+	} else {
+		_, err = s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, targetID)
+	}
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "%s %q not found", targetType, targetID)
+	}
+	if err != nil {
+		return handleDomainError(err)
+	}
+	return nil
+}
+
+// targetEffects lists the effects placed on one character or adversary.
+func (s *DaggerheartService) targetEffects(ctx context.Context, campaignID, targetType, targetID string) ([]storage.DaggerheartEffect, error) {
+	effects, err := s.stores.Daggerheart.ListDaggerheartEffects(ctx, campaignID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list effects: %v", err)
+	}
+	filtered := make([]storage.DaggerheartEffect, 0, len(effects))
+	for _, effect := range effects {
+		if effect.TargetType == targetType && effect.TargetID == targetID {
+			filtered = append(filtered, effect)
+		}
+	}
+	return filtered, nil
+}
+
+// effectBonus returns what the effects of kind on the target add.
+func (s *DaggerheartService) effectBonus(ctx context.Context, campaignID, targetType, targetID, kind string) (int, error) {
+	effects, err := s.targetEffects(ctx, campaignID, targetType, targetID)
+	if err != nil {
+		return 0, err
+	}
+	return daggerheart.EffectTotal(effectsFromStorage(effects), kind), nil
+}
+
+// expireEffects ends every effect in the campaign that match selects.
+func (s *DaggerheartService) expireEffects(ctx context.Context, c campaign.Campaign, sessionID string, match func(storage.DaggerheartEffect) bool, reason string) error {
+	effects, err := s.stores.Daggerheart.ListDaggerheartEffects(ctx, c.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "list effects: %v", err)
+	}
+	for _, effect := range effects {
+		if !match(effect) {
+			continue
+		}
+		if err := s.expireEffect(ctx, c, sessionID, effect, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expireEffectsOn ends every effect whose expiry trigger is one of triggers.
+// An empty targetID matches every target.
+func (s *DaggerheartService) expireEffectsOn(ctx context.Context, c campaign.Campaign, sessionID, targetID string, triggers ...string) error {
+	for _, trigger := range triggers {
+		err := s.expireEffects(ctx, c, sessionID, func(effect storage.DaggerheartEffect) bool {
+			return effect.ExpiresOn == trigger && (targetID == "" || effect.TargetID == targetID)
+		}, trigger)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *DaggerheartService) expireEffect(ctx context.Context, c campaign.Campaign, sessionID string, effect storage.DaggerheartEffect, reason string) error {
+	payload := daggerheart.EffectExpiredPayload{
+		EffectID: effect.EffectID,
+		TargetID: effect.TargetID,
+		Reason:   reason,
+	}
+	if effect.Kind == daggerheart.EffectKindArmor && effect.TargetType == daggerheart.EffectTargetCharacter {
+		current, err := s.targetEffects(ctx, c.ID, effect.TargetType, effect.TargetID)
+		if err != nil {
+			return err
+		}
+		armor, armorMax, err := s.characterArmor(ctx, c.ID, effect.TargetID)
+		if err != nil {
+			return err
+		}
+		armorAfter := daggerheart.ArmorAfterExpiry(armor, armorMax, effectsFromStorage(current), effect.EffectID)
+		payload.ArmorBefore, payload.ArmorAfter = &armor, &armorAfter
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	return s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeEffectExpired, "effect", effect.EffectID, payloadJSON)
+}
+
+// effectArmor returns a character's Armor Slots before and after their armor
+// effects change from before to after.
+func (s *DaggerheartService) effectArmor(ctx context.Context, campaignID, characterID string, before, after []daggerheart.Effect) (*int, *int, error) {
+	armorBefore, armorMax, err := s.characterArmor(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, nil, err
+	}
+	armorAfter := daggerheart.ArmorWithEffects(
+		armorBefore,
+		armorMax,
+		daggerheart.EffectTotal(before, daggerheart.EffectKindArmor),
+		daggerheart.EffectTotal(after, daggerheart.EffectKindArmor),
+	)
+	return &armorBefore, &armorAfter, nil
+}
+
+// characterArmor returns a character's current Armor Slots and armor max.
+func (s *DaggerheartService) characterArmor(ctx context.Context, campaignID, characterID string) (int, int, error) {
+	profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+	if err != nil {
+		return 0, 0, handleDomainError(err)
+	}
+	state, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		return 0, 0, handleDomainError(err)
+	}
+	return state.Armor, profile.ArmorMax, nil
+}
+
+func effectFromStorage(effect storage.DaggerheartEffect) daggerheart.Effect {
+	return daggerheart.Effect{
+		ID:         effect.EffectID,
+		TargetType: effect.TargetType,
+		TargetID:   effect.TargetID,
+		Source:     effect.Source,
+		Kind:       effect.Kind,
+		Value:      effect.Value,
+		Stacking:   effect.Stacking,
+		ExpiresOn:  effect.ExpiresOn,
+	}
+}
+
+func effectsFromStorage(effects []storage.DaggerheartEffect) []daggerheart.Effect {
+	converted := make([]daggerheart.Effect, 0, len(effects))
+	for _, effect := range effects {
+		converted = append(converted, effectFromStorage(effect))
+	}
+	return converted
+}
+
+func effectTargetTypeFromProto(targetType pb.DaggerheartEffectTargetType) (string, error) {
+	switch targetType {
+	case pb.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_CHARACTER:
+		return daggerheart.EffectTargetCharacter, nil
+	case pb.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_ADVERSARY:
+		return daggerheart.EffectTargetAdversary, nil
+	case pb.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_UNSPECIFIED:
+		return "", errors.New("effect target type is required")
+	default:
+		return "", errors.New("effect target type is invalid")
+	}
+}
+
+func effectKindFromProto(kind pb.DaggerheartEffectKind) (string, error) {
+	switch kind {
+	case pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ARMOR:
+		return daggerheart.EffectKindArmor, nil
+	case pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_EVASION:
+		return daggerheart.EffectKindEvasion, nil
+	case pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ROLL:
+		return daggerheart.EffectKindRoll, nil
+	case pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_DAMAGE:
+		return daggerheart.EffectKindDamage, nil
+	case pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_HOPE_DIE:
+		return daggerheart.EffectKindHopeDie, nil
+	case pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_UNSPECIFIED:
+		return "", errors.New("effect kind is required")
+	default:
+		return "", errors.New("effect kind is invalid")
+	}
+}
+
+func effectStackingFromProto(stacking pb.DaggerheartEffectStacking) string {
+	switch stacking {
+	case pb.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_HIGHEST:
+		return daggerheart.EffectStackingHighest
+	case pb.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_REPLACE:
+		return daggerheart.EffectStackingReplace
+	default:
+		return daggerheart.EffectStackingStack
+	}
+}
+
+func effectExpiryFromProto(expiry pb.DaggerheartEffectExpiry) string {
+	switch expiry {
+	case pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_REST:
+		return daggerheart.EffectExpiresRest
+	case pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_LONG_REST:
+		return daggerheart.EffectExpiresLongRest
+	case pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_SCENE_END:
+		return daggerheart.EffectExpiresSceneEnd
+	case pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK:
+		return daggerheart.EffectExpiresNextAttack
+	default:
+		return daggerheart.EffectExpiresManual
+	}
+}
+
+func effectTargetTypeToProto(targetType string) pb.DaggerheartEffectTargetType {
+	switch targetType {
+	case daggerheart.EffectTargetCharacter:
+		return pb.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_CHARACTER
+	case daggerheart.EffectTargetAdversary:
+		return pb.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_ADVERSARY
+	default:
+		return pb.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_UNSPECIFIED
+	}
+}
+
+func effectKindToProto(kind string) pb.DaggerheartEffectKind {
+	switch kind {
+	case daggerheart.EffectKindArmor:
+		return pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ARMOR
+	case daggerheart.EffectKindEvasion:
+		return pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_EVASION
+	case daggerheart.EffectKindRoll:
+		return pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ROLL
+	case daggerheart.EffectKindDamage:
+		return pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_DAMAGE
+	case daggerheart.EffectKindHopeDie:
+		return pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_HOPE_DIE
+	default:
+		return pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_UNSPECIFIED
+	}
+}
+
+func effectStackingToProto(stacking string) pb.DaggerheartEffectStacking {
+	switch stacking {
+	case daggerheart.EffectStackingStack:
+		return pb.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_STACK
+	case daggerheart.EffectStackingHighest:
+		return pb.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_HIGHEST
+	case daggerheart.EffectStackingReplace:
+		return pb.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_REPLACE
+	default:
+		return pb.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_UNSPECIFIED
+	}
+}
+
+func effectExpiryToProto(expiry string) pb.DaggerheartEffectExpiry {
+	switch expiry {
+	case daggerheart.EffectExpiresManual:
+		return pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_MANUAL
+	case daggerheart.EffectExpiresRest:
+		return pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_REST
+	case daggerheart.EffectExpiresLongRest:
+		return pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_LONG_REST
+	case daggerheart.EffectExpiresSceneEnd:
+		return pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_SCENE_END
+	case daggerheart.EffectExpiresNextAttack:
+		return pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK
+	default:
+		return pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_UNSPECIFIED
+	}
+}
+
+func daggerheartEffectToProto(effect storage.DaggerheartEffect) *pb.DaggerheartEffect {
+	return &pb.DaggerheartEffect{
+		EffectId:   effect.EffectID,
+		TargetType: effectTargetTypeToProto(effect.TargetType),
+		TargetId:   effect.TargetID,
+		Source:     effect.Source,
+		Kind:       effectKindToProto(effect.Kind),
+		Value:      int32(effect.Value),
+		Stacking:   effectStackingToProto(effect.Stacking),
+		ExpiresOn:  effectExpiryToProto(effect.ExpiresOn),
+	}
+}
+
+// EffectsToProto converts stored Daggerheart effects to their API form.
+func EffectsToProto(effects []storage.DaggerheartEffect) []*pb.DaggerheartEffect {
+	if len(effects) == 0 {
+		return nil
+	}
+	result := make([]*pb.DaggerheartEffect, 0, len(effects))
+	for _, effect := range effects {
+		result = append(result, daggerheartEffectToProto(effect))
+	}
+	return result
+}
+
+// expireAttackEffects ends the next_attack effects an attack has used up once
+// it resolves: the attacker's roll effects, the defenders' Evasion effects, and
+// on a miss the attacker's damage effects too. Damage effects on a hit last
+// until the damage roll that reads them.
+func (s *DaggerheartService) expireAttackEffects(ctx context.Context, c campaign.Campaign, sessionID, attackerID string, targetIDs []string, hit bool) error {
+	defenders := make(map[string]struct{}, len(targetIDs))
+	for _, targetID := range targetIDs {
+		defenders[targetID] = struct{}{}
+	}
+	return s.expireEffects(ctx, c, sessionID, func(effect storage.DaggerheartEffect) bool {
+		if effect.ExpiresOn != daggerheart.EffectExpiresNextAttack {
+			return false
+		}
+		switch effect.Kind {
+		case daggerheart.EffectKindRoll:
+			return effect.TargetID == attackerID
+		case daggerheart.EffectKindDamage:
+			return effect.TargetID == attackerID && !hit
+		case daggerheart.EffectKindEvasion:
+			_, defending := defenders[effect.TargetID]
+			return defending
+		default:
+			return false
+		}
+	}, daggerheart.EffectExpiresNextAttack)
+}
@@ -0,0 +1,323 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+)
+
+func applyEffect(t *testing.T, svc *DaggerheartService, ctx context.Context, req *pb.DaggerheartApplyEffectRequest) *pb.DaggerheartApplyEffectResponse {
+	t.Helper()
+	req.CampaignId = "camp-1"
+	resp, err := svc.ApplyEffect(ctx, req)
+	if err != nil {
+		t.Fatalf("ApplyEffect returned error: %v", err)
+	}
+	return resp
+}
+
+func characterEffect(id string, kind pb.DaggerheartEffectKind, value int32, expiry pb.DaggerheartEffectExpiry) *pb.DaggerheartApplyEffectRequest {
+	return &pb.DaggerheartApplyEffectRequest{
+		EffectId:   id,
+		TargetType: pb.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_CHARACTER,
+		TargetId:   "char-1",
+		Kind:       kind,
+		Value:      value,
+		ExpiresOn:  expiry,
+	}
+}
+
+func TestEffects_MissingStores(t *testing.T) {
+	svc := &DaggerheartService{}
+	_, err := svc.ApplyEffect(context.Background(), &pb.DaggerheartApplyEffectRequest{})
+	assertStatusCode(t, err, codes.Internal)
+	_, err = svc.RemoveEffect(context.Background(), &pb.DaggerheartRemoveEffectRequest{})
+	assertStatusCode(t, err, codes.Internal)
+	_, err = svc.ListEffects(context.Background(), &pb.DaggerheartListEffectsRequest{})
+	assertStatusCode(t, err, codes.Internal)
+}
+
+func TestApplyEffect_InvalidRequests(t *testing.T) {
+	character := pb.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_CHARACTER
+	adversary := pb.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_ADVERSARY
+	armor := pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ARMOR
+	damage := pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_DAMAGE
+	hopeDie := pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_HOPE_DIE
+	tests := []struct {
+		name string
+		req  *pb.DaggerheartApplyEffectRequest
+		code codes.Code
+	}{
+		{name: "missing target", req: &pb.DaggerheartApplyEffectRequest{CampaignId: "camp-1", TargetType: character, Kind: damage, Value: 1}, code: codes.InvalidArgument},
+		{name: "missing target type", req: &pb.DaggerheartApplyEffectRequest{CampaignId: "camp-1", TargetId: "char-1", Kind: damage, Value: 1}, code: codes.InvalidArgument},
+		{name: "missing kind", req: &pb.DaggerheartApplyEffectRequest{CampaignId: "camp-1", TargetType: character, TargetId: "char-1", Value: 1}, code: codes.InvalidArgument},
+		{name: "zero value", req: &pb.DaggerheartApplyEffectRequest{CampaignId: "camp-1", TargetType: character, TargetId: "char-1", Kind: damage}, code: codes.InvalidArgument},
+		{name: "armor on adversary", req: &pb.DaggerheartApplyEffectRequest{CampaignId: "camp-1", TargetType: adversary, TargetId: "adv-1", Kind: armor, Value: 1}, code: codes.InvalidArgument},
+		{name: "armor until next attack", req: &pb.DaggerheartApplyEffectRequest{CampaignId: "camp-1", TargetType: character, TargetId: "char-1", Kind: armor, Value: 1, ExpiresOn: pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK}, code: codes.InvalidArgument},
+		{name: "hope die on adversary", req: &pb.DaggerheartApplyEffectRequest{CampaignId: "camp-1", TargetType: adversary, TargetId: "adv-1", Kind: hopeDie, Value: -2}, code: codes.InvalidArgument},
+		{name: "hope die until next attack", req: &pb.DaggerheartApplyEffectRequest{CampaignId: "camp-1", TargetType: character, TargetId: "char-1", Kind: hopeDie, Value: -2, ExpiresOn: pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK}, code: codes.InvalidArgument},
+		{name: "negative armor", req: &pb.DaggerheartApplyEffectRequest{CampaignId: "camp-1", TargetType: character, TargetId: "char-1", Kind: armor, Value: -1}, code: codes.InvalidArgument},
+		{name: "unknown character", req: &pb.DaggerheartApplyEffectRequest{CampaignId: "camp-1", TargetType: character, TargetId: "char-9", Kind: damage, Value: 1}, code: codes.NotFound},
+		{name: "unknown adversary", req: &pb.DaggerheartApplyEffectRequest{CampaignId: "camp-1", TargetType: adversary, TargetId: "adv-9", Kind: damage, Value: 1}, code: codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newMultiAttackTestService()
+			_, err := svc.ApplyEffect(context.Background(), tt.req)
+			assertStatusCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestApplyEffect_DuplicateID(t *testing.T) {
+	svc := newMultiAttackTestService()
+	req := characterEffect("eff-1", pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ROLL, 1, 0)
+	applyEffect(t, svc, context.Background(), req)
+	_, err := svc.ApplyEffect(context.Background(), req)
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestApplyEffect_ArmorExpiresOnRest(t *testing.T) {
+	svc := newMultiAttackTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	ctx := contextWithSessionID("sess-1")
+
+	resp := applyEffect(t, svc, ctx, characterEffect("eff-armor", pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ARMOR, 2, pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_REST))
+	if resp.GetEffect().GetStacking() != pb.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_STACK {
+		t.Fatalf("stacking = %v, want stack", resp.GetEffect().GetStacking())
+	}
+	if got := dhStore.states["camp-1:char-1"].Armor; got != 3 {
+		t.Fatalf("armor = %d, want 3", got)
+	}
+
+	if _, err := svc.ApplyRest(ctx, &pb.DaggerheartApplyRestRequest{
+		CampaignId: "camp-1",
+		Rest: &pb.DaggerheartRestRequest{
+			RestType:  pb.DaggerheartRestType_DAGGERHEART_REST_TYPE_SHORT,
+			PartySize: 3,
+		},
+	}); err != nil {
+		t.Fatalf("ApplyRest returned error: %v", err)
+	}
+	if _, ok := dhStore.effects["camp-1:eff-armor"]; ok {
+		t.Fatal("expected armor effect to expire on rest")
+	}
+	if got := dhStore.states["camp-1:char-1"].Armor; got != 2 {
+		t.Fatalf("armor = %d, want 2", got)
+	}
+	var payload daggerheart.EffectExpiredPayload
+	lastEventPayload(t, svc, string(daggerheart.EventTypeEffectExpired), &payload)
+	if payload.Reason != daggerheart.EffectExpiresRest {
+		t.Fatalf("reason = %q, want rest", payload.Reason)
+	}
+}
+
+func TestApplyEffect_LongRestEffectSurvivesShortRest(t *testing.T) {
+	svc := newMultiAttackTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	ctx := contextWithSessionID("sess-1")
+	applyEffect(t, svc, ctx, characterEffect("eff-roll", pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ROLL, 1, pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_LONG_REST))
+
+	if _, err := svc.ApplyRest(ctx, &pb.DaggerheartApplyRestRequest{
+		CampaignId: "camp-1",
+		Rest:       &pb.DaggerheartRestRequest{RestType: pb.DaggerheartRestType_DAGGERHEART_REST_TYPE_SHORT, PartySize: 3},
+	}); err != nil {
+		t.Fatalf("ApplyRest returned error: %v", err)
+	}
+	if _, ok := dhStore.effects["camp-1:eff-roll"]; !ok {
+		t.Fatal("expected long rest effect to survive a short rest")
+	}
+}
+
+func TestApplyEffect_ReplacesSameSource(t *testing.T) {
+	svc := newMultiAttackTestService()
+	first := characterEffect("eff-1", pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_DAMAGE, 1, 0)
+	first.Source = "rage"
+	first.Stacking = pb.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_REPLACE
+	applyEffect(t, svc, context.Background(), first)
+
+	second := characterEffect("eff-2", pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_DAMAGE, 2, 0)
+	second.Source = "rage"
+	second.Stacking = pb.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_REPLACE
+	resp := applyEffect(t, svc, context.Background(), second)
+	if ids := resp.GetReplacedEffectIds(); len(ids) != 1 || ids[0] != "eff-1" {
+		t.Fatalf("replaced ids = %v, want [eff-1]", ids)
+	}
+
+	list, err := svc.ListEffects(context.Background(), &pb.DaggerheartListEffectsRequest{CampaignId: "camp-1", TargetId: "char-1"})
+	if err != nil {
+		t.Fatalf("ListEffects returned error: %v", err)
+	}
+	if len(list.GetEffects()) != 1 || list.GetEffects()[0].GetValue() != 2 {
+		t.Fatalf("effects = %v, want only eff-2", list.GetEffects())
+	}
+}
+
+func TestRemoveEffect(t *testing.T) {
+	svc := newMultiAttackTestService()
+	applyEffect(t, svc, context.Background(), characterEffect("eff-1", pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_EVASION, 2, 0))
+
+	_, err := svc.RemoveEffect(context.Background(), &pb.DaggerheartRemoveEffectRequest{CampaignId: "camp-1", EffectId: "eff-9"})
+	assertStatusCode(t, err, codes.NotFound)
+
+	resp, err := svc.RemoveEffect(context.Background(), &pb.DaggerheartRemoveEffectRequest{CampaignId: "camp-1", EffectId: "eff-1"})
+	if err != nil {
+		t.Fatalf("RemoveEffect returned error: %v", err)
+	}
+	if resp.GetEffectId() != "eff-1" {
+		t.Fatalf("effect id = %q, want eff-1", resp.GetEffectId())
+	}
+	var payload daggerheart.EffectExpiredPayload
+	lastEventPayload(t, svc, string(daggerheart.EventTypeEffectExpired), &payload)
+	if payload.Reason != daggerheart.EffectExpiredRemoved {
+		t.Fatalf("reason = %q, want removed", payload.Reason)
+	}
+}
+
+func TestSessionDamageRoll_AddsDamageEffect(t *testing.T) {
+	svc := newMultiAttackTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-effect-damage")
+	applyEffect(t, svc, ctx, characterEffect("eff-dmg", pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_DAMAGE, 3, pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK))
+
+	resp, err := svc.SessionDamageRoll(ctx, &pb.SessionDamageRollRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Dice:        []*pb.DiceSpec{{Sides: 6, Count: 1}},
+		Modifier:    1,
+	})
+	if err != nil {
+		t.Fatalf("SessionDamageRoll returned error: %v", err)
+	}
+	if resp.GetModifier() != 4 {
+		t.Fatalf("modifier = %d, want 4", resp.GetModifier())
+	}
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	if _, ok := dhStore.effects["camp-1:eff-dmg"]; ok {
+		t.Fatal("expected next attack damage effect to expire after the damage roll")
+	}
+}
+
+func TestSessionActionRoll_HopeDieEffectShrinksHopeDie(t *testing.T) {
+	svc := newActionTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	dhStore.effects["camp-1:eff-hope"] = storage.DaggerheartEffect{
+		CampaignID: "camp-1",
+		EffectID:   "eff-hope",
+		TargetType: daggerheart.EffectTargetCharacter,
+		TargetID:   "char-1",
+		Source:     "desecrated ground",
+		Kind:       daggerheart.EffectKindHopeDie,
+		Value:      -8,
+		Stacking:   daggerheart.EffectStackingStack,
+		ExpiresOn:  daggerheart.EffectExpiresSceneEnd,
+	}
+
+	resp, err := svc.SessionActionRoll(context.Background(), &pb.SessionActionRollRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Trait:       "instinct",
+		Difficulty:  10,
+	})
+	if err != nil {
+		t.Fatalf("SessionActionRoll returned error: %v", err)
+	}
+	if resp.GetHopeDie() < 1 || resp.GetHopeDie() > 4 {
+		t.Fatalf("hope die = %d, want a d4 result", resp.GetHopeDie())
+	}
+
+	events := svc.stores.Event.(*fakeEventStore).events["camp-1"]
+	rollEvent := events[len(events)-1]
+	if rollEvent.Type != event.TypeRollResolved {
+		t.Fatalf("last event = %s, want %s", rollEvent.Type, event.TypeRollResolved)
+	}
+	var payload event.RollResolvedPayload
+	if err := json.Unmarshal(rollEvent.PayloadJSON, &payload); err != nil {
+		t.Fatalf("decode roll payload: %v", err)
+	}
+	dice, ok := payload.Results["dice"].(map[string]any)
+	if !ok {
+		t.Fatalf("roll payload dice = %v", payload.Results["dice"])
+	}
+	if dice["hope_die_sides"] != float64(4) {
+		t.Fatalf("hope die sides = %v, want 4", dice["hope_die_sides"])
+	}
+}
+
+func TestCharacterEvasion_AddsEvasionEffect(t *testing.T) {
+	svc := newMultiAttackTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.Evasion = 10
+	applyEffect(t, svc, context.Background(), characterEffect("eff-1", pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_EVASION, 2, 0))
+	highest := characterEffect("eff-2", pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_EVASION, 1, 0)
+	highest.Stacking = pb.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_HIGHEST
+	applyEffect(t, svc, context.Background(), highest)
+
+	evasion, err := svc.characterEvasion(context.Background(), "camp-1", "char-1", profile)
+	if err != nil {
+		t.Fatalf("characterEvasion returned error: %v", err)
+	}
+	if evasion != 13 {
+		t.Fatalf("evasion = %d, want 13", evasion)
+	}
+}
+
+func TestEffectBonus_MatchesTargetType(t *testing.T) {
+	svc := newActionTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	for _, effect := range []storage.DaggerheartEffect{
+		{CampaignID: "camp-1", EffectID: "eff-char", TargetType: daggerheart.EffectTargetCharacter, TargetID: "char-1", Kind: daggerheart.EffectKindRoll, Value: 1, Stacking: daggerheart.EffectStackingStack},
+		{CampaignID: "camp-1", EffectID: "eff-adv", TargetType: daggerheart.EffectTargetAdversary, TargetID: "char-1", Kind: daggerheart.EffectKindRoll, Value: 4, Stacking: daggerheart.EffectStackingStack},
+	} {
+		dhStore.effects["camp-1:"+effect.EffectID] = effect
+	}
+
+	bonus, err := svc.effectBonus(context.Background(), "camp-1", daggerheart.EffectTargetCharacter, "char-1", daggerheart.EffectKindRoll)
+	if err != nil {
+		t.Fatalf("effectBonus returned error: %v", err)
+	}
+	if bonus != 1 {
+		t.Fatalf("character bonus = %d, want 1", bonus)
+	}
+	bonus, err = svc.effectBonus(context.Background(), "camp-1", daggerheart.EffectTargetAdversary, "char-1", daggerheart.EffectKindRoll)
+	if err != nil {
+		t.Fatalf("effectBonus returned error: %v", err)
+	}
+	if bonus != 4 {
+		t.Fatalf("adversary bonus = %d, want 4", bonus)
+	}
+}
+
+func TestClearSessionEnvironment_ExpiresSceneEffects(t *testing.T) {
+	svc := newEnvironmentTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-environment-effects")
+	if _, err := svc.CreateSessionEnvironment(ctx, &pb.DaggerheartCreateSessionEnvironmentRequest{
+		CampaignId:    "camp-1",
+		SessionId:     "sess-1",
+		EnvironmentId: "environment.outpost",
+	}); err != nil {
+		t.Fatalf("CreateSessionEnvironment returned error: %v", err)
+	}
+	applyEffect(t, svc, ctx, characterEffect("eff-scene", pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ROLL, 1, pb.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_SCENE_END))
+	applyEffect(t, svc, ctx, characterEffect("eff-manual", pb.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ROLL, 1, 0))
+
+	if _, err := svc.ClearSessionEnvironment(ctx, &pb.DaggerheartClearSessionEnvironmentRequest{CampaignId: "camp-1", SessionId: "sess-1"}); err != nil {
+		t.Fatalf("ClearSessionEnvironment returned error: %v", err)
+	}
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	if _, ok := dhStore.effects["camp-1:eff-scene"]; ok {
+		t.Fatal("expected scene effect to expire when the environment clears")
+	}
+	if _, ok := dhStore.effects["camp-1:eff-manual"]; !ok {
+		t.Fatal("expected manual effect to remain")
+	}
+}
@@ -0,0 +1,300 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/id"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/character"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// encounterGroup is a requested group resolved against the catalog.
+type encounterGroup struct {
+	entry storage.DaggerheartAdversaryEntry
+	role  string
+	count int
+	names []string
+}
+
+func (s *DaggerheartService) PlanEncounter(ctx context.Context, in *pb.DaggerheartPlanEncounterRequest) (*pb.DaggerheartPlanEncounterResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "plan encounter request is required")
+	}
+	if err := s.requireEncounterStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if err := campaign.ValidateCampaignOperation(c.Status, campaign.CampaignOpRead); err != nil {
+		return nil, handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return nil, status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart encounters")
+	}
+
+	_, budget, err := s.priceEncounter(ctx, campaignID, in.GetGroups(), in.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DaggerheartPlanEncounterResponse{Budget: budget}, nil
+}
+
+func (s *DaggerheartService) CommitEncounter(ctx context.Context, in *pb.DaggerheartCommitEncounterRequest) (*pb.DaggerheartCommitEncounterResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "commit encounter request is required")
+	}
+	if err := s.requireEncounterStores(); err != nil {
+		return nil, err
+	}
+	if s.stores.Session == nil {
+		return nil, status.Error(codes.Internal, "session store is not configured")
+	}
+	if s.stores.Event == nil {
+		return nil, status.Error(codes.Internal, "event store is not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	if len(in.GetGroups()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one adversary group is required")
+	}
+
+	c, err := s.loadActiveSession(ctx, campaignID, sessionID, "encounters")
+	if err != nil {
+		return nil, err
+	}
+	groups, budget, err := s.priceEncounter(ctx, campaignID, in.GetGroups(), in.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	var events []event.Event
+	var adversaryIDs []string
+	for _, group := range groups {
+		for _, name := range group.names {
+			adversaryID, err := id.NewID()
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "generate adversary id: %v", err)
+			}
+			payload := catalogAdversaryPayload(group.entry)
+			payload.AdversaryID = adversaryID
+			payload.Name = name
+			payload.Kind = group.role
+			payload.SessionID = sessionID
+			payloadJSON, err := json.Marshal(payload)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "encode adversary payload: %v", err)
+			}
+			events = append(events, event.Event{
+				CampaignID:    campaignID,
+				Timestamp:     time.Now().UTC(),
+				Type:          daggerheart.EventTypeAdversaryCreated,
+				SessionID:     sessionID,
+				RequestID:     grpcmeta.RequestIDFromContext(ctx),
+				InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
+				ActorType:     event.ActorTypeSystem,
+				EntityType:    "adversary",
+				EntityID:      adversaryID,
+				SystemID:      c.System.String(),
+				SystemVersion: daggerheart.SystemVersion,
+				PayloadJSON:   payloadJSON,
+			})
+			adversaryIDs = append(adversaryIDs, adversaryID)
+		}
+	}
+
+	stored, err := s.stores.Event.AppendEvents(ctx, events)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "append encounter events: %v", err)
+	}
+	adapter := daggerheart.NewAdapter(s.stores.Daggerheart)
+	for _, evt := range stored {
+		if err := adapter.ApplyEvent(ctx, evt); err != nil {
+			return nil, status.Errorf(codes.Internal, "apply adversary created event: %v", err)
+		}
+	}
+
+	response := &pb.DaggerheartCommitEncounterResponse{
+		Budget:      budget,
+		Adversaries: make([]*pb.DaggerheartAdversary, 0, len(adversaryIDs)),
+	}
+	for _, adversaryID := range adversaryIDs {
+		created, err := s.stores.Daggerheart.GetDaggerheartAdversary(ctx, campaignID, adversaryID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load adversary: %v", err)
+		}
+		response.Adversaries = append(response.Adversaries, daggerheartAdversaryToProto(created))
+	}
+	return response, nil
+}
+
+func (s *DaggerheartService) requireEncounterStores() error {
+	if s.stores.Campaign == nil {
+		return status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Character == nil {
+		return status.Error(codes.Internal, "character store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	if s.stores.DaggerheartContent == nil {
+		return status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	return nil
+}
+
+// priceEncounter resolves the requested groups against the catalog and prices
+// them against the battle point budget of the campaign's party.
+func (s *DaggerheartService) priceEncounter(ctx context.Context, campaignID string, requested []*pb.DaggerheartEncounterGroup, options *pb.DaggerheartEncounterOptions) ([]encounterGroup, *pb.DaggerheartEncounterBudget, error) {
+	partySize, partyTier, err := s.loadParty(ctx, campaignID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if options.GetPartySize() != nil {
+		partySize = int(options.GetPartySize().GetValue())
+		if partySize <= 0 {
+			return nil, nil, status.Error(codes.InvalidArgument, "party size must be positive")
+		}
+	}
+	if partySize == 0 {
+		return nil, nil, status.Error(codes.FailedPrecondition, "campaign has no player characters; set a party size")
+	}
+
+	groups := make([]encounterGroup, 0, len(requested))
+	domainGroups := make([]daggerheart.EncounterGroup, 0, len(requested))
+	for _, in := range requested {
+		group, err := s.resolveEncounterGroup(ctx, in)
+		if err != nil {
+			return nil, nil, err
+		}
+		groups = append(groups, group)
+		domainGroups = append(domainGroups, daggerheart.EncounterGroup{Role: group.role, Tier: group.entry.Tier, Count: group.count})
+	}
+
+	budget, err := daggerheart.PlanEncounter(partySize, partyTier, domainGroups, daggerheart.EncounterOptions{
+		Easier:      options.GetEasierFight(),
+		Harder:      options.GetHarderFight(),
+		BonusDamage: options.GetBonusDamage(),
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	message := &pb.DaggerheartEncounterBudget{
+		PartySize: int32(budget.PartySize),
+		PartyTier: int32(budget.PartyTier),
+		Base:      int32(budget.Base),
+		Total:     int32(budget.Total),
+		Spent:     int32(budget.Spent),
+		Remaining: int32(budget.Remaining),
+	}
+	for _, adjustment := range budget.Adjustments {
+		message.Adjustments = append(message.Adjustments, &pb.DaggerheartEncounterAdjustment{
+			Reason: adjustment.Reason,
+			Points: int32(adjustment.Points),
+		})
+	}
+	for _, group := range groups {
+		cost, err := daggerheart.BattlePointCost(group.role, group.count, partySize)
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		message.Groups = append(message.Groups, &pb.DaggerheartEncounterGroupCost{
+			AdversaryEntryId: group.entry.ID,
+			Name:             group.entry.Name,
+			Role:             group.role,
+			Tier:             int32(group.entry.Tier),
+			Count:            int32(group.count),
+			BattlePoints:     int32(cost),
+		})
+	}
+	return groups, message, nil
+}
+
+func (s *DaggerheartService) resolveEncounterGroup(ctx context.Context, in *pb.DaggerheartEncounterGroup) (encounterGroup, error) {
+	entryID := strings.TrimSpace(in.GetAdversaryEntryId())
+	if entryID == "" {
+		return encounterGroup{}, status.Error(codes.InvalidArgument, "adversary entry id is required")
+	}
+	count, err := normalizeSpawnCount(in.GetCount(), in.GetNames())
+	if err != nil {
+		return encounterGroup{}, err
+	}
+
+	entry, err := s.stores.DaggerheartContent.GetDaggerheartAdversaryEntry(ctx, entryID)
+	if err != nil {
+		return encounterGroup{}, contentLookupError("adversary entry", entryID, err)
+	}
+	role := strings.TrimSpace(in.GetRole())
+	if role == "" {
+		role = entry.Role
+	}
+	role, err = daggerheart.NormalizeAdversaryRole(role)
+	if err != nil {
+		return encounterGroup{}, status.Errorf(codes.InvalidArgument, "%s: %v; set a role for the group", entryID, err)
+	}
+
+	names := daggerheart.SpawnNames(entry.Name, count)
+	for i, override := range in.GetNames() {
+		if override = strings.TrimSpace(override); override != "" {
+			names[i] = override
+		}
+	}
+	return encounterGroup{entry: entry, role: role, count: count, names: names}, nil
+}
+
+// loadParty counts the campaign's player characters and derives the party
+// tier from their Daggerheart levels.
+func (s *DaggerheartService) loadParty(ctx context.Context, campaignID string) (int, int, error) {
+	var levels []int
+	pageToken := ""
+	for {
+		page, err := s.stores.Character.ListCharacters(ctx, campaignID, 100, pageToken)
+		if err != nil {
+			return 0, 0, status.Errorf(codes.Internal, "list characters: %v", err)
+		}
+		for _, ch := range page.Characters {
+			if ch.Kind != character.CharacterKindPC {
+				continue
+			}
+			level := 1
+			profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, ch.ID)
+			if err == nil {
+				level = profile.Level
+			} else if !errors.Is(err, storage.ErrNotFound) {
+				return 0, 0, status.Errorf(codes.Internal, "load character profile: %v", err)
+			}
+			levels = append(levels, level)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+	return len(levels), daggerheart.PartyTier(levels), nil
+}
@@ -0,0 +1,171 @@
+package daggerheart
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/character"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// newEncounterTestService adds a party of three player characters, one of
+// them level 2, and an NPC that does not count towards the party.
+func newEncounterTestService() *DaggerheartService {
+	svc := newEnvironmentTestService()
+	characters := svc.stores.Character.(*fakeCharacterStore)
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	for i, id := range []string{"pc-1", "pc-2", "pc-3"} {
+		characters.characters["camp-1:"+id] = character.Character{ID: id, CampaignID: "camp-1", Kind: character.CharacterKindPC}
+		dhStore.profiles["camp-1:"+id] = storage.DaggerheartCharacterProfile{CampaignID: "camp-1", CharacterID: id, Level: 1 + i/2}
+	}
+	characters.characters["camp-1:npc-1"] = character.Character{ID: "npc-1", CampaignID: "camp-1", Kind: character.CharacterKindNPC}
+	svc.stores.DaggerheartContent.(*fakeContentStore).adversaryEntries["adversary.obsidian-knight"] = storage.DaggerheartAdversaryEntry{
+		ID:   "adversary.obsidian-knight",
+		Name: "Obsidian Knight",
+		Tier: 2,
+		Role: "obsidian",
+		HP:   7,
+	}
+	return svc
+}
+
+func TestPlanEncounter_BudgetFromParty(t *testing.T) {
+	svc := newEncounterTestService()
+	resp, err := svc.PlanEncounter(context.Background(), &pb.DaggerheartPlanEncounterRequest{
+		CampaignId: "camp-1",
+		Groups: []*pb.DaggerheartEncounterGroup{
+			{AdversaryEntryId: "adversary.jagged-knife-bandit", Count: 2},
+			{AdversaryEntryId: "adversary.rotted-zombie", Count: 4},
+		},
+		Options: &pb.DaggerheartEncounterOptions{HarderFight: true},
+	})
+	if err != nil {
+		t.Fatalf("PlanEncounter returned error: %v", err)
+	}
+	budget := resp.GetBudget()
+	if budget.GetPartySize() != 3 || budget.GetPartyTier() != 2 || budget.GetBase() != 11 {
+		t.Fatalf("party = %d tier %d base %d, want 3 tier 2 base 11", budget.GetPartySize(), budget.GetPartyTier(), budget.GetBase())
+	}
+	// No heavy adversaries (+1) and a harder fight (+2).
+	if budget.GetTotal() != 14 || budget.GetSpent() != 6 || budget.GetRemaining() != 8 {
+		t.Fatalf("budget = %+v", budget)
+	}
+	groups := budget.GetGroups()
+	if len(groups) != 2 || groups[0].GetBattlePoints() != 4 || groups[1].GetRole() != "minion" || groups[1].GetBattlePoints() != 2 {
+		t.Fatalf("groups = %+v", groups)
+	}
+	if countEvents(svc, "action.adversary_created") != 0 {
+		t.Fatal("planning must not create adversaries")
+	}
+}
+
+func TestPlanEncounter_PartySizeOverrideAndRole(t *testing.T) {
+	svc := newEncounterTestService()
+	req := &pb.DaggerheartPlanEncounterRequest{
+		CampaignId: "camp-1",
+		Groups:     []*pb.DaggerheartEncounterGroup{{AdversaryEntryId: "adversary.obsidian-knight"}},
+		Options:    &pb.DaggerheartEncounterOptions{PartySize: wrapperspb.Int32(5)},
+	}
+	_, err := svc.PlanEncounter(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	req.Groups[0].Role = "Solo"
+	resp, err := svc.PlanEncounter(context.Background(), req)
+	if err != nil {
+		t.Fatalf("PlanEncounter returned error: %v", err)
+	}
+	if budget := resp.GetBudget(); budget.GetPartySize() != 5 || budget.GetTotal() != 17 || budget.GetRemaining() != 12 {
+		t.Fatalf("budget = %+v", budget)
+	}
+}
+
+func TestCommitEncounter_CreatesAdversariesInOneBatch(t *testing.T) {
+	svc := newEncounterTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-encounter")
+	resp, err := svc.CommitEncounter(ctx, &pb.DaggerheartCommitEncounterRequest{
+		CampaignId: "camp-1",
+		SessionId:  "sess-1",
+		Groups: []*pb.DaggerheartEncounterGroup{
+			{AdversaryEntryId: "adversary.jagged-knife-bandit", Count: 2, Names: []string{"Kira"}},
+			{AdversaryEntryId: "adversary.rotted-zombie", Count: 3},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CommitEncounter returned error: %v", err)
+	}
+	adversaries := resp.GetAdversaries()
+	if len(adversaries) != 5 {
+		t.Fatalf("adversaries = %d, want 5", len(adversaries))
+	}
+	if adversaries[0].GetName() != "Kira" || adversaries[1].GetName() != "Jagged Knife Bandit 2" ||
+		adversaries[2].GetAdversaryEntryId() != "adversary.rotted-zombie" || adversaries[2].GetMinionThreshold() != 3 {
+		t.Fatalf("adversaries = %+v", adversaries)
+	}
+	for _, adversary := range adversaries {
+		if adversary.GetSessionId().GetValue() != "sess-1" {
+			t.Fatalf("adversary session = %q, want sess-1", adversary.GetSessionId().GetValue())
+		}
+	}
+	if got := countEvents(svc, "action.adversary_created"); got != 5 {
+		t.Fatalf("adversary created events = %d, want 5", got)
+	}
+	if resp.GetBudget().GetSpent() != 5 {
+		t.Fatalf("spent = %d, want 5", resp.GetBudget().GetSpent())
+	}
+}
+
+func TestCommitEncounter_InvalidRequests(t *testing.T) {
+	svc := newEncounterTestService()
+	tests := []struct {
+		name string
+		req  *pb.DaggerheartCommitEncounterRequest
+		code codes.Code
+	}{
+		{"missing session", &pb.DaggerheartCommitEncounterRequest{CampaignId: "camp-1", Groups: []*pb.DaggerheartEncounterGroup{{AdversaryEntryId: "adversary.rotted-zombie"}}}, codes.InvalidArgument},
+		{"no groups", &pb.DaggerheartCommitEncounterRequest{CampaignId: "camp-1", SessionId: "sess-1"}, codes.InvalidArgument},
+		{"unknown entry", &pb.DaggerheartCommitEncounterRequest{CampaignId: "camp-1", SessionId: "sess-1", Groups: []*pb.DaggerheartEncounterGroup{{AdversaryEntryId: "adversary.missing"}}}, codes.InvalidArgument},
+		{"too many names", &pb.DaggerheartCommitEncounterRequest{CampaignId: "camp-1", SessionId: "sess-1", Groups: []*pb.DaggerheartEncounterGroup{{AdversaryEntryId: "adversary.rotted-zombie", Count: 1, Names: []string{"a", "b"}}}}, codes.InvalidArgument},
+		{"count above maximum", &pb.DaggerheartCommitEncounterRequest{CampaignId: "camp-1", SessionId: "sess-1", Groups: []*pb.DaggerheartEncounterGroup{{AdversaryEntryId: "adversary.rotted-zombie", Count: 2147483647}}}, codes.InvalidArgument},
+		{"easier and harder", &pb.DaggerheartCommitEncounterRequest{CampaignId: "camp-1", SessionId: "sess-1", Groups: []*pb.DaggerheartEncounterGroup{{AdversaryEntryId: "adversary.rotted-zombie"}}, Options: &pb.DaggerheartEncounterOptions{EasierFight: true, HarderFight: true}}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.CommitEncounter(context.Background(), tt.req)
+			assertStatusCode(t, err, tt.code)
+		})
+	}
+	if got := countEvents(svc, "action.adversary_created"); got != 0 {
+		t.Fatalf("adversary created events = %d, want 0", got)
+	}
+}
+
+func TestCommitEncounter_ExpectedLastSeq(t *testing.T) {
+	svc := newEncounterTestService()
+	req := &pb.DaggerheartCommitEncounterRequest{
+		CampaignId: "camp-1",
+		SessionId:  "sess-1",
+		Groups:     []*pb.DaggerheartEncounterGroup{{AdversaryEntryId: "adversary.rotted-zombie", Count: 2}},
+	}
+
+	stale := storage.WithExpectedLastSeq(context.Background(), 5)
+	_, err := svc.CommitEncounter(stale, req)
+	if !errors.Is(storage.EventSeqConflictFromContext(stale), storage.ErrEventSeqConflict) {
+		t.Fatalf("expected sequence conflict, got %v", err)
+	}
+	if got := countEvents(svc, "action.adversary_created"); got != 0 {
+		t.Fatalf("adversary created events = %d, want 0", got)
+	}
+
+	ctx := storage.WithExpectedLastSeq(context.Background(), 0)
+	if _, err := svc.CommitEncounter(ctx, req); err != nil {
+		t.Fatalf("CommitEncounter returned error: %v", err)
+	}
+	if got := countEvents(svc, "action.adversary_created"); got != 2 {
+		t.Fatalf("adversary created events = %d, want 2", got)
+	}
+}
@@ -0,0 +1,470 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/platform/id"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/snapshot"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func (s *DaggerheartService) CreateSessionEnvironment(ctx context.Context, in *pb.DaggerheartCreateSessionEnvironmentRequest) (*pb.DaggerheartCreateSessionEnvironmentResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "create session environment request is required")
+	}
+	if err := s.requireEnvironmentStores(); err != nil {
+		return nil, err
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	environmentID := strings.TrimSpace(in.GetEnvironmentId())
+	if environmentID == "" {
+		return nil, status.Error(codes.InvalidArgument, "environment id is required")
+	}
+
+	c, err := s.loadActiveSession(ctx, campaignID, sessionID, "environments")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.stores.Daggerheart.GetDaggerheartSessionEnvironment(ctx, campaignID, sessionID); err == nil {
+		return nil, status.Error(codes.FailedPrecondition, "session already has an active environment; shift or clear it first")
+	} else if !errors.Is(err, storage.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, "load session environment: %v", err)
+	}
+	environment, err := s.catalogSessionEnvironment(ctx, campaignID, sessionID, environmentID, in.GetDifficulty())
+	if err != nil {
+		return nil, err
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.EnvironmentActivatedPayload{
+		EnvironmentID: environment.EnvironmentID,
+		Name:          environment.Name,
+		Tier:          environment.Tier,
+		Type:          environment.Type,
+		Difficulty:    environment.Difficulty,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode environment payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeEnvironmentActivated, "environment", environment.EnvironmentID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	return &pb.DaggerheartCreateSessionEnvironmentResponse{Environment: daggerheartSessionEnvironmentToProto(environment)}, nil
+}
+
+func (s *DaggerheartService) ShiftSessionEnvironment(ctx context.Context, in *pb.DaggerheartShiftSessionEnvironmentRequest) (*pb.DaggerheartShiftSessionEnvironmentResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "shift session environment request is required")
+	}
+	if err := s.requireEnvironmentStores(); err != nil {
+		return nil, err
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	environmentID := strings.TrimSpace(in.GetEnvironmentId())
+	if environmentID == "" {
+		return nil, status.Error(codes.InvalidArgument, "environment id is required")
+	}
+
+	c, err := s.loadActiveSession(ctx, campaignID, sessionID, "environments")
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.loadSessionEnvironment(ctx, campaignID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	environment, err := s.catalogSessionEnvironment(ctx, campaignID, sessionID, environmentID, in.GetDifficulty())
+	if err != nil {
+		return nil, err
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.EnvironmentShiftedPayload{
+		FromEnvironmentID: current.EnvironmentID,
+		EnvironmentID:     environment.EnvironmentID,
+		Name:              environment.Name,
+		Tier:              environment.Tier,
+		Type:              environment.Type,
+		Difficulty:        environment.Difficulty,
+		Reason:            strings.TrimSpace(in.GetReason()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode environment shift payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeEnvironmentShifted, "environment", environment.EnvironmentID, payloadJSON); err != nil {
+		return nil, err
+	}
+	if err := s.endScene(ctx, c, sessionID); err != nil {
+		return nil, err
+	}
+
+	return &pb.DaggerheartShiftSessionEnvironmentResponse{
+		Environment:           daggerheartSessionEnvironmentToProto(environment),
+		PreviousEnvironmentId: current.EnvironmentID,
+	}, nil
+}
+
+func (s *DaggerheartService) ClearSessionEnvironment(ctx context.Context, in *pb.DaggerheartClearSessionEnvironmentRequest) (*pb.DaggerheartClearSessionEnvironmentResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "clear session environment request is required")
+	}
+	if err := s.requireSessionStores(); err != nil {
+		return nil, err
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+
+	c, err := s.loadActiveSession(ctx, campaignID, sessionID, "environments")
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.loadSessionEnvironment(ctx, campaignID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.EnvironmentClearedPayload{
+		EnvironmentID: current.EnvironmentID,
+		Reason:        strings.TrimSpace(in.GetReason()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode environment clear payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeEnvironmentCleared, "environment", current.EnvironmentID, payloadJSON); err != nil {
+		return nil, err
+	}
+	if err := s.endScene(ctx, c, sessionID); err != nil {
+		return nil, err
+	}
+
+	return &pb.DaggerheartClearSessionEnvironmentResponse{EnvironmentId: current.EnvironmentID}, nil
+}
+
+func (s *DaggerheartService) GetSessionEnvironment(ctx context.Context, in *pb.DaggerheartGetSessionEnvironmentRequest) (*pb.DaggerheartGetSessionEnvironmentResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "get session environment request is required")
+	}
+	if s.stores.Daggerheart == nil {
+		return nil, status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+
+	environment, err := s.stores.Daggerheart.GetDaggerheartSessionEnvironment(ctx, campaignID, sessionID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "session has no active environment")
+		}
+		return nil, status.Errorf(codes.Internal, "load session environment: %v", err)
+	}
+	return &pb.DaggerheartGetSessionEnvironmentResponse{Environment: daggerheartSessionEnvironmentToProto(environment)}, nil
+}
+
+func (s *DaggerheartService) TriggerEnvironmentFeature(ctx context.Context, in *pb.DaggerheartTriggerEnvironmentFeatureRequest) (*pb.DaggerheartTriggerEnvironmentFeatureResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "trigger environment feature request is required")
+	}
+	if err := s.requireEnvironmentStores(); err != nil {
+		return nil, err
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	featureID := strings.TrimSpace(in.GetFeatureId())
+	if featureID == "" {
+		return nil, status.Error(codes.InvalidArgument, "feature id is required")
+	}
+	kind, err := daggerheartEnvironmentFeatureKindFromProto(in.GetKind())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	fearCost := int(in.GetFearCost())
+	if fearCost < 0 {
+		return nil, status.Error(codes.InvalidArgument, "fear_cost must be non-negative")
+	}
+	for _, spawn := range in.GetSpawns() {
+		if strings.TrimSpace(spawn.GetAdversaryEntryId()) == "" {
+			return nil, status.Error(codes.InvalidArgument, "spawn adversary entry id is required")
+		}
+		if spawn.GetCount() < 0 {
+			return nil, status.Error(codes.InvalidArgument, "spawn count must be non-negative")
+		}
+	}
+
+	c, err := s.loadActiveSession(ctx, campaignID, sessionID, "environments")
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.loadSessionEnvironment(ctx, campaignID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := s.stores.DaggerheartContent.GetDaggerheartEnvironment(ctx, current.EnvironmentID)
+	if err != nil {
+		return nil, contentLookupError("environment", current.EnvironmentID, err)
+	}
+	var feature *storage.DaggerheartFeature
+	for i := range entry.Features {
+		if entry.Features[i].ID == featureID {
+			feature = &entry.Features[i]
+			break
+		}
+	}
+	if feature == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "feature %q is not part of environment %q", featureID, current.EnvironmentID)
+	}
+
+	gmFearBefore := 0
+	if snap, err := s.stores.Daggerheart.GetDaggerheartSnapshot(ctx, campaignID); err == nil {
+		gmFearBefore = snap.GMFear
+	}
+	gmFearAfter := gmFearBefore
+	if fearCost > 0 {
+		_, before, after, err := snapshot.ApplyGmFearSpend(snapshot.GmFear{CampaignID: campaignID, Value: gmFearBefore}, fearCost)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		gmFearBefore = before
+		gmFearAfter = after
+	}
+
+	// Resolve every spawn before appending so a bad catalog id leaves no events.
+	type plannedSpawn struct {
+		entry storage.DaggerheartAdversaryEntry
+		names []string
+	}
+	spawns := make([]plannedSpawn, 0, len(in.GetSpawns()))
+	for _, spawn := range in.GetSpawns() {
+		entryID := strings.TrimSpace(spawn.GetAdversaryEntryId())
+		adversaryEntry, err := s.stores.DaggerheartContent.GetDaggerheartAdversaryEntry(ctx, entryID)
+		if err != nil {
+			return nil, contentLookupError("adversary entry", entryID, err)
+		}
+		name := strings.TrimSpace(spawn.GetName())
+		if name == "" {
+			name = adversaryEntry.Name
+		}
+		count, err := normalizeSpawnCount(spawn.GetCount(), nil)
+		if err != nil {
+			return nil, err
+		}
+		spawns = append(spawns, plannedSpawn{entry: adversaryEntry, names: daggerheart.SpawnNames(name, count)})
+	}
+
+	if fearCost > 0 {
+		payloadJSON, err := json.Marshal(daggerheart.GMFearChangedPayload{Before: gmFearBefore, After: gmFearAfter, Reason: "environment_feature"})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "encode gm fear payload: %v", err)
+		}
+		if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeGMFearChanged, "campaign", campaignID, payloadJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	spawnedIDs := make([]string, 0)
+	for _, spawn := range spawns {
+		for _, name := range spawn.names {
+			adversaryID, err := s.spawnAdversaryFromEntry(ctx, c, sessionID, spawn.entry, name)
+			if err != nil {
+				return nil, err
+			}
+			spawnedIDs = append(spawnedIDs, adversaryID)
+		}
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.EnvironmentFeatureUsedPayload{
+		EnvironmentID:       current.EnvironmentID,
+		FeatureID:           feature.ID,
+		FeatureName:         feature.Name,
+		Kind:                kind,
+		FearCost:            fearCost,
+		SpawnedAdversaryIDs: spawnedIDs,
+		Description:         strings.TrimSpace(in.GetDescription()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode environment feature payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeEnvironmentFeatureUsed, "environment", current.EnvironmentID, payloadJSON); err != nil {
+		return nil, err
+	}
+	if fearCost > 0 {
+		if err := s.tickCountdowns(ctx, c, sessionID, daggerheart.CountdownTriggerFearSpent, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	adversaries := make([]*pb.DaggerheartAdversary, 0, len(spawnedIDs))
+	for _, adversaryID := range spawnedIDs {
+		adversary, err := s.stores.Daggerheart.GetDaggerheartAdversary(ctx, campaignID, adversaryID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load adversary: %v", err)
+		}
+		adversaries = append(adversaries, daggerheartAdversaryToProto(adversary))
+	}
+
+	return &pb.DaggerheartTriggerEnvironmentFeatureResponse{
+		EnvironmentId: current.EnvironmentID,
+		FeatureId:     feature.ID,
+		GmFearBefore:  int32(gmFearBefore),
+		GmFearAfter:   int32(gmFearAfter),
+		Adversaries:   adversaries,
+	}, nil
+}
+
+func (s *DaggerheartService) requireEnvironmentStores() error {
+	if err := s.requireSessionStores(); err != nil {
+		return err
+	}
+	if s.stores.DaggerheartContent == nil {
+		return status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	return nil
+}
+
+func (s *DaggerheartService) loadSessionEnvironment(ctx context.Context, campaignID, sessionID string) (storage.DaggerheartSessionEnvironment, error) {
+	environment, err := s.stores.Daggerheart.GetDaggerheartSessionEnvironment(ctx, campaignID, sessionID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.DaggerheartSessionEnvironment{}, status.Error(codes.FailedPrecondition, "session has no active environment")
+		}
+		return storage.DaggerheartSessionEnvironment{}, status.Errorf(codes.Internal, "load session environment: %v", err)
+	}
+	return environment, nil
+}
+
+// catalogSessionEnvironment copies a catalog environment into session state,
+// applying an optional Difficulty override.
+func (s *DaggerheartService) catalogSessionEnvironment(ctx context.Context, campaignID, sessionID, environmentID string, difficulty *wrapperspb.Int32Value) (storage.DaggerheartSessionEnvironment, error) {
+	entry, err := s.stores.DaggerheartContent.GetDaggerheartEnvironment(ctx, environmentID)
+	if err != nil {
+		return storage.DaggerheartSessionEnvironment{}, contentLookupError("environment", environmentID, err)
+	}
+	environment := storage.DaggerheartSessionEnvironment{
+		CampaignID:    campaignID,
+		SessionID:     sessionID,
+		EnvironmentID: entry.ID,
+		Name:          entry.Name,
+		Tier:          entry.Tier,
+		Type:          entry.Type,
+		Difficulty:    entry.Difficulty,
+	}
+	if difficulty != nil {
+		if difficulty.GetValue() < 0 {
+			return storage.DaggerheartSessionEnvironment{}, status.Error(codes.InvalidArgument, "difficulty must be non-negative")
+		}
+		environment.Difficulty = int(difficulty.GetValue())
+	}
+	return environment, nil
+}
+
+// spawnAdversaryFromEntry creates a session adversary with the stat block of a
+// catalog entry and returns its id.
+func (s *DaggerheartService) spawnAdversaryFromEntry(ctx context.Context, c campaign.Campaign, sessionID string, entry storage.DaggerheartAdversaryEntry, name string) (string, error) {
+	adversaryID, err := id.NewID()
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "generate adversary id: %v", err)
+	}
+	payload := catalogAdversaryPayload(entry)
+	payload.AdversaryID = adversaryID
+	payload.Name = name
+	payload.Kind = entry.Role
+	payload.SessionID = sessionID
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "encode adversary payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeAdversaryCreated, "adversary", adversaryID, payloadJSON); err != nil {
+		return "", err
+	}
+	return adversaryID, nil
+}
+
+func daggerheartSessionEnvironmentToProto(environment storage.DaggerheartSessionEnvironment) *pb.DaggerheartSessionEnvironment {
+	return &pb.DaggerheartSessionEnvironment{
+		CampaignId:    environment.CampaignID,
+		SessionId:     environment.SessionID,
+		EnvironmentId: environment.EnvironmentID,
+		Name:          environment.Name,
+		Tier:          int32(environment.Tier),
+		Type:          environment.Type,
+		Difficulty:    int32(environment.Difficulty),
+	}
+}
+
+func daggerheartEnvironmentFeatureKindFromProto(kind pb.DaggerheartEnvironmentFeatureKind) (string, error) {
+	switch kind {
+	case pb.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_ACTION:
+		return daggerheart.EnvironmentFeatureKindAction, nil
+	case pb.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_REACTION:
+		return daggerheart.EnvironmentFeatureKindReaction, nil
+	case pb.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_UNSPECIFIED:
+		return "", fmt.Errorf("environment feature kind is required")
+	default:
+		return "", fmt.Errorf("environment feature kind %v is invalid", kind)
+	}
+}
+
+// EndScene closes a Daggerheart campaign's current scene: "until end of
+// scene" effects expire and "once per scene" adversary features are ready
+// again. The session service calls it when a session ends, so neither
+// outlives the session even when no environment shift or clear closed the
+// scene.
+func EndScene(ctx context.Context, stores Stores, c campaign.Campaign, sessionID string) error {
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART || stores.Daggerheart == nil {
+		return nil
+	}
+	s := &DaggerheartService{stores: stores}
+	return s.endScene(ctx, c, sessionID)
+}
+
+func (s *DaggerheartService) endScene(ctx context.Context, c campaign.Campaign, sessionID string) error {
+	if err := s.expireEffectsOn(ctx, c, sessionID, "", daggerheart.EffectExpiresSceneEnd); err != nil {
+		return err
+	}
+	return s.refreshAdversaryFeatures(ctx, c, sessionID, daggerheart.AdversaryFeatureRefreshSceneEnd)
+}
@@ -0,0 +1,315 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newEnvironmentTestService() *DaggerheartService {
+	svc := newSceneTestService()
+	content := svc.stores.DaggerheartContent.(*fakeContentStore)
+	content.environments["environment.outpost"] = storage.DaggerheartEnvironment{
+		ID:         "environment.outpost",
+		Name:       "Outpost Town",
+		Tier:       1,
+		Type:       "Social",
+		Difficulty: 12,
+		Features: []storage.DaggerheartFeature{
+			{ID: "feature.outpost-wrong-place", Name: "Wrong Place, Wrong Time"},
+		},
+	}
+	content.environments["environment.cliffside"] = storage.DaggerheartEnvironment{
+		ID:         "environment.cliffside",
+		Name:       "Cliffside Ascent",
+		Tier:       1,
+		Type:       "Traversal",
+		Difficulty: 12,
+	}
+	content.adversaryEntries["adversary.jagged-knife-bandit"] = storage.DaggerheartAdversaryEntry{
+		ID:              "adversary.jagged-knife-bandit",
+		Name:            "Jagged Knife Bandit",
+		Role:            "standard",
+		Difficulty:      12,
+		MajorThreshold:  8,
+		SevereThreshold: 14,
+		HP:              5,
+		Stress:          3,
+	}
+	content.adversaryEntries["adversary.rotted-zombie"] = storage.DaggerheartAdversaryEntry{
+		ID:         "adversary.rotted-zombie",
+		Name:       "Rotted Zombie",
+		Role:       "minion",
+		Difficulty: 8,
+		HP:         1,
+		Stress:     1,
+		Features:   []storage.DaggerheartAdversaryFeature{{ID: "feature.minion", Name: "Minion (3)"}},
+	}
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	dhStore.snapshots["camp-1"] = storage.DaggerheartSnapshot{CampaignID: "camp-1", GMFear: 3}
+	return svc
+}
+
+func TestCreateSessionEnvironment_MissingStores(t *testing.T) {
+	svc := &DaggerheartService{}
+	_, err := svc.CreateSessionEnvironment(context.Background(), &pb.DaggerheartCreateSessionEnvironmentRequest{})
+	assertStatusCode(t, err, codes.Internal)
+}
+
+func TestCreateSessionEnvironment_InvalidRequests(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.DaggerheartCreateSessionEnvironmentRequest
+		code codes.Code
+	}{
+		{name: "missing campaign", req: &pb.DaggerheartCreateSessionEnvironmentRequest{SessionId: "sess-1", EnvironmentId: "environment.outpost"}, code: codes.InvalidArgument},
+		{name: "missing session", req: &pb.DaggerheartCreateSessionEnvironmentRequest{CampaignId: "camp-1", EnvironmentId: "environment.outpost"}, code: codes.InvalidArgument},
+		{name: "missing environment", req: &pb.DaggerheartCreateSessionEnvironmentRequest{CampaignId: "camp-1", SessionId: "sess-1"}, code: codes.InvalidArgument},
+		{name: "unknown environment", req: &pb.DaggerheartCreateSessionEnvironmentRequest{CampaignId: "camp-1", SessionId: "sess-1", EnvironmentId: "environment.missing"}, code: codes.InvalidArgument},
+		{name: "negative difficulty", req: &pb.DaggerheartCreateSessionEnvironmentRequest{CampaignId: "camp-1", SessionId: "sess-1", EnvironmentId: "environment.outpost", Difficulty: wrapperspb.Int32(-1)}, code: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newEnvironmentTestService()
+			_, err := svc.CreateSessionEnvironment(context.Background(), tt.req)
+			assertStatusCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestSessionEnvironment_Lifecycle(t *testing.T) {
+	svc := newEnvironmentTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-environment")
+
+	created, err := svc.CreateSessionEnvironment(ctx, &pb.DaggerheartCreateSessionEnvironmentRequest{
+		CampaignId:    "camp-1",
+		SessionId:     "sess-1",
+		EnvironmentId: "environment.outpost",
+		Difficulty:    wrapperspb.Int32(14),
+	})
+	if err != nil {
+		t.Fatalf("CreateSessionEnvironment returned error: %v", err)
+	}
+	if created.GetEnvironment().GetName() != "Outpost Town" || created.GetEnvironment().GetDifficulty() != 14 {
+		t.Fatalf("environment = %v, want Outpost Town at difficulty 14", created.GetEnvironment())
+	}
+
+	_, err = svc.CreateSessionEnvironment(ctx, &pb.DaggerheartCreateSessionEnvironmentRequest{
+		CampaignId:    "camp-1",
+		SessionId:     "sess-1",
+		EnvironmentId: "environment.cliffside",
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	shifted, err := svc.ShiftSessionEnvironment(ctx, &pb.DaggerheartShiftSessionEnvironmentRequest{
+		CampaignId:    "camp-1",
+		SessionId:     "sess-1",
+		EnvironmentId: "environment.cliffside",
+		Reason:        "the party flees up the cliffs",
+	})
+	if err != nil {
+		t.Fatalf("ShiftSessionEnvironment returned error: %v", err)
+	}
+	if shifted.GetPreviousEnvironmentId() != "environment.outpost" || shifted.GetEnvironment().GetEnvironmentId() != "environment.cliffside" {
+		t.Fatalf("shift response = %v, want outpost -> cliffside", shifted)
+	}
+
+	got, err := svc.GetSessionEnvironment(ctx, &pb.DaggerheartGetSessionEnvironmentRequest{CampaignId: "camp-1", SessionId: "sess-1"})
+	if err != nil {
+		t.Fatalf("GetSessionEnvironment returned error: %v", err)
+	}
+	if got.GetEnvironment().GetEnvironmentId() != "environment.cliffside" || got.GetEnvironment().GetDifficulty() != 12 {
+		t.Fatalf("environment = %v, want cliffside at difficulty 12", got.GetEnvironment())
+	}
+
+	cleared, err := svc.ClearSessionEnvironment(ctx, &pb.DaggerheartClearSessionEnvironmentRequest{CampaignId: "camp-1", SessionId: "sess-1", Reason: "scene over"})
+	if err != nil {
+		t.Fatalf("ClearSessionEnvironment returned error: %v", err)
+	}
+	if cleared.GetEnvironmentId() != "environment.cliffside" {
+		t.Fatalf("cleared environment = %q, want cliffside", cleared.GetEnvironmentId())
+	}
+	_, err = svc.GetSessionEnvironment(ctx, &pb.DaggerheartGetSessionEnvironmentRequest{CampaignId: "camp-1", SessionId: "sess-1"})
+	assertStatusCode(t, err, codes.NotFound)
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	events := eventStore.events["camp-1"]
+	wantTypes := []event.Type{
+		daggerheart.EventTypeEnvironmentActivated,
+		daggerheart.EventTypeEnvironmentShifted,
+		daggerheart.EventTypeEnvironmentCleared,
+	}
+	if len(events) != len(wantTypes) {
+		t.Fatalf("events = %d, want %d", len(events), len(wantTypes))
+	}
+	for i, want := range wantTypes {
+		if events[i].Type != want {
+			t.Fatalf("event %d type = %s, want %s", i, events[i].Type, want)
+		}
+	}
+	var payload daggerheart.EnvironmentShiftedPayload
+	if err := json.Unmarshal(events[1].PayloadJSON, &payload); err != nil {
+		t.Fatalf("decode shift payload: %v", err)
+	}
+	if payload.FromEnvironmentID != "environment.outpost" || payload.Reason != "the party flees up the cliffs" {
+		t.Fatalf("shift payload = %+v", payload)
+	}
+}
+
+func TestShiftSessionEnvironment_RequiresActiveEnvironment(t *testing.T) {
+	svc := newEnvironmentTestService()
+	_, err := svc.ShiftSessionEnvironment(context.Background(), &pb.DaggerheartShiftSessionEnvironmentRequest{
+		CampaignId:    "camp-1",
+		SessionId:     "sess-1",
+		EnvironmentId: "environment.cliffside",
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	_, err = svc.ClearSessionEnvironment(context.Background(), &pb.DaggerheartClearSessionEnvironmentRequest{CampaignId: "camp-1", SessionId: "sess-1"})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestTriggerEnvironmentFeature_SpendsFearAndSpawns(t *testing.T) {
+	svc := newEnvironmentTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-environment-feature")
+	if _, err := svc.CreateSessionEnvironment(ctx, &pb.DaggerheartCreateSessionEnvironmentRequest{
+		CampaignId:    "camp-1",
+		SessionId:     "sess-1",
+		EnvironmentId: "environment.outpost",
+	}); err != nil {
+		t.Fatalf("CreateSessionEnvironment returned error: %v", err)
+	}
+
+	resp, err := svc.TriggerEnvironmentFeature(ctx, &pb.DaggerheartTriggerEnvironmentFeatureRequest{
+		CampaignId: "camp-1",
+		SessionId:  "sess-1",
+		FeatureId:  "feature.outpost-wrong-place",
+		Kind:       pb.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_ACTION,
+		FearCost:   1,
+		Spawns: []*pb.DaggerheartEnvironmentSpawn{
+			{AdversaryEntryId: "adversary.jagged-knife-bandit", Count: 2, Name: "Bandit"},
+			{AdversaryEntryId: "adversary.rotted-zombie"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("TriggerEnvironmentFeature returned error: %v", err)
+	}
+	if resp.GetGmFearBefore() != 3 || resp.GetGmFearAfter() != 2 {
+		t.Fatalf("gm fear = %d -> %d, want 3 -> 2", resp.GetGmFearBefore(), resp.GetGmFearAfter())
+	}
+	adversaries := resp.GetAdversaries()
+	if len(adversaries) != 3 {
+		t.Fatalf("spawned adversaries = %d, want 3", len(adversaries))
+	}
+	if adversaries[0].GetName() != "Bandit 1" || adversaries[1].GetName() != "Bandit 2" || adversaries[2].GetName() != "Rotted Zombie" {
+		t.Fatalf("spawned names = %q, %q, %q", adversaries[0].GetName(), adversaries[1].GetName(), adversaries[2].GetName())
+	}
+	if adversaries[0].GetHp() != 5 || adversaries[0].GetEvasion() != 12 || adversaries[0].GetMajorThreshold() != 8 {
+		t.Fatalf("bandit stat block = %v", adversaries[0])
+	}
+	if adversaries[2].GetSessionId().GetValue() != "sess-1" {
+		t.Fatalf("spawned session = %q, want sess-1", adversaries[2].GetSessionId().GetValue())
+	}
+
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	zombie, err := dhStore.GetDaggerheartAdversary(context.Background(), "camp-1", adversaries[2].GetId())
+	if err != nil {
+		t.Fatalf("load zombie: %v", err)
+	}
+	if zombie.MinionThreshold != 3 {
+		t.Fatalf("zombie minion threshold = %d, want 3", zombie.MinionThreshold)
+	}
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	events := eventStore.events["camp-1"]
+	last := events[len(events)-1]
+	if last.Type != daggerheart.EventTypeEnvironmentFeatureUsed {
+		t.Fatalf("last event = %s, want environment.feature_used", last.Type)
+	}
+	var payload daggerheart.EnvironmentFeatureUsedPayload
+	if err := json.Unmarshal(last.PayloadJSON, &payload); err != nil {
+		t.Fatalf("decode feature payload: %v", err)
+	}
+	if payload.Kind != daggerheart.EnvironmentFeatureKindAction || payload.FearCost != 1 || len(payload.SpawnedAdversaryIDs) != 3 {
+		t.Fatalf("feature payload = %+v", payload)
+	}
+	if events[1].Type != daggerheart.EventTypeGMFearChanged {
+		t.Fatalf("event 1 = %s, want gm fear change", events[1].Type)
+	}
+}
+
+func TestTriggerEnvironmentFeature_InvalidRequests(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.DaggerheartTriggerEnvironmentFeatureRequest
+		code codes.Code
+	}{
+		{
+			name: "missing kind",
+			req:  &pb.DaggerheartTriggerEnvironmentFeatureRequest{CampaignId: "camp-1", SessionId: "sess-1", FeatureId: "feature.outpost-wrong-place"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown feature",
+			req: &pb.DaggerheartTriggerEnvironmentFeatureRequest{
+				CampaignId: "camp-1", SessionId: "sess-1", FeatureId: "feature.missing",
+				Kind: pb.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_REACTION,
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "insufficient fear",
+			req: &pb.DaggerheartTriggerEnvironmentFeatureRequest{
+				CampaignId: "camp-1", SessionId: "sess-1", FeatureId: "feature.outpost-wrong-place",
+				Kind:     pb.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_ACTION,
+				FearCost: 4,
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown spawn entry",
+			req: &pb.DaggerheartTriggerEnvironmentFeatureRequest{
+				CampaignId: "camp-1", SessionId: "sess-1", FeatureId: "feature.outpost-wrong-place",
+				Kind:   pb.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_ACTION,
+				Spawns: []*pb.DaggerheartEnvironmentSpawn{{AdversaryEntryId: "adversary.missing"}},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "spawn count above maximum",
+			req: &pb.DaggerheartTriggerEnvironmentFeatureRequest{
+				CampaignId: "camp-1", SessionId: "sess-1", FeatureId: "feature.outpost-wrong-place",
+				Kind:   pb.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_ACTION,
+				Spawns: []*pb.DaggerheartEnvironmentSpawn{{AdversaryEntryId: "adversary.jagged-knife-bandit", Count: 2147483647}},
+			},
+			code: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newEnvironmentTestService()
+			if _, err := svc.CreateSessionEnvironment(context.Background(), &pb.DaggerheartCreateSessionEnvironmentRequest{
+				CampaignId:    "camp-1",
+				SessionId:     "sess-1",
+				EnvironmentId: "environment.outpost",
+			}); err != nil {
+				t.Fatalf("CreateSessionEnvironment returned error: %v", err)
+			}
+			eventStore := svc.stores.Event.(*fakeEventStore)
+			before := len(eventStore.events["camp-1"])
+			_, err := svc.TriggerEnvironmentFeature(context.Background(), tt.req)
+			assertStatusCode(t, err, tt.code)
+			if after := len(eventStore.events["camp-1"]); after != before {
+				t.Fatalf("events appended on failure: %d -> %d", before, after)
+			}
+		})
+	}
+}
@@ -0,0 +1,708 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AcquireItem adds a catalog weapon, armor, item or loot entry to a character's inventory.
+func (s *DaggerheartService) AcquireItem(ctx context.Context, in *pb.DaggerheartAcquireItemRequest) (*pb.DaggerheartAcquireItemResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "acquire item request is required")
+	}
+	if err := s.requireInventoryStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	itemID := strings.TrimSpace(in.GetItemId())
+	if itemID == "" {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+	kind, err := daggerheartInventoryKindFromProto(in.GetKind())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	quantity, err := inventoryRequestQuantity(in.GetQuantity())
+	if err != nil {
+		return nil, err
+	}
+
+	c, sessionID, err := s.loadInventoryCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.loadInventoryState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	stackMax, err := s.catalogInventoryStackMax(ctx, itemID, kind)
+	if err != nil {
+		return nil, err
+	}
+	quantityAfter, err := daggerheart.AcquireQuantity(daggerheart.InventoryFromStorage(state.Inventory), itemID, quantity, stackMax)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.ItemAcquiredPayload{
+		CharacterID:   characterID,
+		ItemID:        itemID,
+		Kind:          kind,
+		Quantity:      quantity,
+		QuantityAfter: quantityAfter,
+		Source:        strings.TrimSpace(in.GetSource()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeItemAcquired, "character", characterID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load daggerheart state: %v", err)
+	}
+	return &pb.DaggerheartAcquireItemResponse{CharacterId: characterID, State: daggerheartStateToProto(updated)}, nil
+}
+
+// DropItem removes items from a character's inventory. Equipped items must be
+// unequipped before the last one is dropped.
+func (s *DaggerheartService) DropItem(ctx context.Context, in *pb.DaggerheartDropItemRequest) (*pb.DaggerheartDropItemResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "drop item request is required")
+	}
+	if err := s.requireInventoryStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	itemID := strings.TrimSpace(in.GetItemId())
+	if itemID == "" {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+	quantity, err := inventoryRequestQuantity(in.GetQuantity())
+	if err != nil {
+		return nil, err
+	}
+
+	c, sessionID, err := s.loadInventoryCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.loadInventoryState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	kind, quantityAfter, err := releaseInventoryItem(state, itemID, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.ItemDroppedPayload{
+		CharacterID:   characterID,
+		ItemID:        itemID,
+		Kind:          kind,
+		Quantity:      quantity,
+		QuantityAfter: quantityAfter,
+		Reason:        strings.TrimSpace(in.GetReason()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeItemDropped, "character", characterID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load daggerheart state: %v", err)
+	}
+	return &pb.DaggerheartDropItemResponse{CharacterId: characterID, State: daggerheartStateToProto(updated)}, nil
+}
+
+// TransferItem moves items between two characters' inventories.
+func (s *DaggerheartService) TransferItem(ctx context.Context, in *pb.DaggerheartTransferItemRequest) (*pb.DaggerheartTransferItemResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "transfer item request is required")
+	}
+	if err := s.requireInventoryStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	fromID := strings.TrimSpace(in.GetFromCharacterId())
+	if fromID == "" {
+		return nil, status.Error(codes.InvalidArgument, "from character id is required")
+	}
+	toID := strings.TrimSpace(in.GetToCharacterId())
+	if toID == "" {
+		return nil, status.Error(codes.InvalidArgument, "to character id is required")
+	}
+	if fromID == toID {
+		return nil, status.Error(codes.InvalidArgument, "transfer requires two different characters")
+	}
+	itemID := strings.TrimSpace(in.GetItemId())
+	if itemID == "" {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+	quantity, err := inventoryRequestQuantity(in.GetQuantity())
+	if err != nil {
+		return nil, err
+	}
+
+	c, sessionID, err := s.loadInventoryCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	fromState, err := s.loadInventoryState(ctx, campaignID, fromID)
+	if err != nil {
+		return nil, err
+	}
+	toState, err := s.loadInventoryState(ctx, campaignID, toID)
+	if err != nil {
+		return nil, err
+	}
+	kind, fromAfter, err := releaseInventoryItem(fromState, itemID, quantity)
+	if err != nil {
+		return nil, err
+	}
+	stackMax, err := s.catalogInventoryStackMax(ctx, itemID, kind)
+	if err != nil {
+		return nil, err
+	}
+	toAfter, err := daggerheart.AcquireQuantity(daggerheart.InventoryFromStorage(toState.Inventory), itemID, quantity, stackMax)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.ItemTransferredPayload{
+		FromCharacterID:   fromID,
+		ToCharacterID:     toID,
+		ItemID:            itemID,
+		Kind:              kind,
+		Quantity:          quantity,
+		FromQuantityAfter: fromAfter,
+		ToQuantityAfter:   toAfter,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeItemTransferred, "character", fromID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	updatedFrom, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, fromID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load daggerheart state: %v", err)
+	}
+	updatedTo, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, toID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load daggerheart state: %v", err)
+	}
+	return &pb.DaggerheartTransferItemResponse{
+		FromState: daggerheartStateToProto(updatedFrom),
+		ToState:   daggerheartStateToProto(updatedTo),
+	}, nil
+}
+
+// EquipItem equips a held weapon or armor. Armor replaces the character's armor
+// score and damage thresholds with values derived from the catalog entry.
+func (s *DaggerheartService) EquipItem(ctx context.Context, in *pb.DaggerheartEquipItemRequest) (*pb.DaggerheartEquipItemResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "equip item request is required")
+	}
+	if err := s.requireInventoryStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	itemID := strings.TrimSpace(in.GetItemId())
+	if itemID == "" {
+		return nil, status.Error(codes.InvalidArgument, "item id is required")
+	}
+	requestedSlot := ""
+	if in.GetSlot() != pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED {
+		slot, err := daggerheartEquipSlotFromProto(in.GetSlot())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		requestedSlot = slot
+	}
+
+	c, sessionID, err := s.loadInventoryCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.loadInventoryState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	held := daggerheart.InventoryFromStorage(state.Inventory)
+	kind := ""
+	for _, item := range held {
+		if item.ItemID == itemID {
+			kind = item.Kind
+		}
+	}
+	if kind == "" {
+		return nil, status.Error(codes.FailedPrecondition, daggerheart.ErrItemNotHeld.Error())
+	}
+
+	payload := daggerheart.ItemEquippedPayload{CharacterID: characterID, ItemID: itemID}
+	switch kind {
+	case daggerheart.InventoryKindArmor:
+		if requestedSlot != "" && requestedSlot != daggerheart.EquipSlotArmor {
+			return nil, status.Error(codes.InvalidArgument, daggerheart.ErrInvalidEquipSlot.Error())
+		}
+		armor, err := s.stores.DaggerheartContent.GetDaggerheartArmor(ctx, itemID)
+		if err != nil {
+			return nil, contentLookupError("armor", itemID, err)
+		}
+		payload.Slot = daggerheart.EquipSlotArmor
+		payload.Armor = wornArmorChange(profile.Level, armor)
+	case daggerheart.InventoryKindWeapon:
+		weapon, err := s.stores.DaggerheartContent.GetDaggerheartWeapon(ctx, itemID)
+		if err != nil {
+			return nil, contentLookupError("weapon", itemID, err)
+		}
+		slot, err := daggerheart.WeaponSlotForCategory(weapon.Category)
+		if err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if requestedSlot != "" && requestedSlot != slot {
+			return nil, status.Error(codes.InvalidArgument, daggerheart.ErrInvalidEquipSlot.Error())
+		}
+		if err := s.checkWeaponHands(ctx, state, slot, weapon); err != nil {
+			return nil, err
+		}
+		payload.Slot = slot
+	default:
+		return nil, status.Error(codes.InvalidArgument, daggerheart.ErrInvalidEquipSlot.Error())
+	}
+	payload.PreviousItemID = equippedItemID(state, payload.Slot)
+	if payload.PreviousItemID == itemID {
+		return nil, status.Error(codes.FailedPrecondition, "item is already equipped")
+	}
+
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeItemEquipped, "character", characterID, payloadJSON); err != nil {
+		return nil, err
+	}
+	return s.equipmentResponse(ctx, campaignID, characterID)
+}
+
+// UnequipItem clears an equipment slot. Removing armor restores the unarmored
+// thresholds for the character's level.
+func (s *DaggerheartService) UnequipItem(ctx context.Context, in *pb.DaggerheartUnequipItemRequest) (*pb.DaggerheartUnequipItemResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "unequip item request is required")
+	}
+	if err := s.requireInventoryStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	slot, err := daggerheartEquipSlotFromProto(in.GetSlot())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	c, sessionID, err := s.loadInventoryCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.loadInventoryState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	itemID := equippedItemID(state, slot)
+	if itemID == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "slot %s is empty", slot)
+	}
+
+	payload := daggerheart.ItemUnequippedPayload{
+		CharacterID: characterID,
+		ItemID:      itemID,
+		Slot:        slot,
+		Reason:      strings.TrimSpace(in.GetReason()),
+	}
+	if slot == daggerheart.EquipSlotArmor {
+		profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+		if err != nil {
+			return nil, handleDomainError(err)
+		}
+		major, severe := daggerheart.UnarmoredThresholds(characterLevel(profile.Level))
+		payload.Armor = &daggerheart.EquipmentChange{MajorThresholdAfter: major, SevereThresholdAfter: severe}
+	}
+
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeItemUnequipped, "character", characterID, payloadJSON); err != nil {
+		return nil, err
+	}
+	resp, err := s.equipmentResponse(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DaggerheartUnequipItemResponse{CharacterId: resp.CharacterId, Profile: resp.Profile, State: resp.State}, nil
+}
+
+// UpdateGold gains or spends gold, trading coin up into bags and chests.
+func (s *DaggerheartService) UpdateGold(ctx context.Context, in *pb.DaggerheartUpdateGoldRequest) (*pb.DaggerheartUpdateGoldResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "update gold request is required")
+	}
+	if err := s.requireInventoryStores(); err != nil {
+		return nil, err
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+	delta := daggerheart.Gold{
+		Handfuls: int(in.GetHandfuls()),
+		Bags:     int(in.GetBags()),
+		Chests:   int(in.GetChests()),
+	}.TotalHandfuls()
+	if delta == 0 {
+		return nil, status.Error(codes.InvalidArgument, "gold change is required")
+	}
+
+	c, sessionID, err := s.loadInventoryCampaign(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.loadInventoryState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	before := daggerheart.Gold{Handfuls: state.GoldHandfuls, Bags: state.GoldBags, Chests: state.GoldChests}
+	after, err := before.Apply(delta)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.GoldChangedPayload{
+		CharacterID:    characterID,
+		HandfulsBefore: before.Handfuls,
+		BagsBefore:     before.Bags,
+		ChestsBefore:   before.Chests,
+		HandfulsAfter:  after.Handfuls,
+		BagsAfter:      after.Bags,
+		ChestsAfter:    after.Chests,
+		Reason:         strings.TrimSpace(in.GetReason()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeGoldChanged, "character", characterID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load daggerheart state: %v", err)
+	}
+	return &pb.DaggerheartUpdateGoldResponse{CharacterId: characterID, State: daggerheartStateToProto(updated)}, nil
+}
+
+func (s *DaggerheartService) requireInventoryStores() error {
+	if s.stores.Campaign == nil {
+		return status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	if s.stores.DaggerheartContent == nil {
+		return status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	if s.stores.Event == nil {
+		return status.Error(codes.Internal, "event store is not configured")
+	}
+	return nil
+}
+
+// loadInventoryCampaign validates the campaign for inventory changes. Gear and
+// gold change hands between sessions too, so the session is optional.
+func (s *DaggerheartService) loadInventoryCampaign(ctx context.Context, campaignID string) (campaign.Campaign, string, error) {
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return campaign.Campaign{}, "", handleDomainError(err)
+	}
+	if err := campaign.ValidateCampaignOperation(c.Status, campaign.CampaignOpCampaignMutate); err != nil {
+		return campaign.Campaign{}, "", handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return campaign.Campaign{}, "", status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart inventory")
+	}
+	sessionID := strings.TrimSpace(grpcmeta.SessionIDFromContext(ctx))
+	if sessionID != "" {
+		if err := s.ensureNoOpenSessionGate(ctx, campaignID, sessionID); err != nil {
+			return campaign.Campaign{}, "", err
+		}
+	}
+	return c, sessionID, nil
+}
+
+func (s *DaggerheartService) loadInventoryState(ctx context.Context, campaignID, characterID string) (storage.DaggerheartCharacterState, error) {
+	state, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.DaggerheartCharacterState{}, status.Errorf(codes.NotFound, "character %q state not found", characterID)
+		}
+		return storage.DaggerheartCharacterState{}, status.Errorf(codes.Internal, "load daggerheart state: %v", err)
+	}
+	return state, nil
+}
+
+// catalogInventoryStackMax confirms the item exists in the catalog for its kind
+// and returns its stack limit; zero means unlimited.
+func (s *DaggerheartService) catalogInventoryStackMax(ctx context.Context, itemID, kind string) (int, error) {
+	switch kind {
+	case daggerheart.InventoryKindWeapon:
+		if _, err := s.stores.DaggerheartContent.GetDaggerheartWeapon(ctx, itemID); err != nil {
+			return 0, contentLookupError("weapon", itemID, err)
+		}
+	case daggerheart.InventoryKindArmor:
+		if _, err := s.stores.DaggerheartContent.GetDaggerheartArmor(ctx, itemID); err != nil {
+			return 0, contentLookupError("armor", itemID, err)
+		}
+	case daggerheart.InventoryKindItem:
+		item, err := s.stores.DaggerheartContent.GetDaggerheartItem(ctx, itemID)
+		if err != nil {
+			return 0, contentLookupError("item", itemID, err)
+		}
+		return item.StackMax, nil
+	case daggerheart.InventoryKindLoot:
+		if _, err := s.stores.DaggerheartContent.GetDaggerheartLootEntry(ctx, itemID); err != nil {
+			return 0, contentLookupError("loot entry", itemID, err)
+		}
+	default:
+		return 0, status.Errorf(codes.InvalidArgument, "inventory kind %q is invalid", kind)
+	}
+	return 0, nil
+}
+
+// checkWeaponHands rejects weapon combinations that need more than two hands.
+func (s *DaggerheartService) checkWeaponHands(ctx context.Context, state storage.DaggerheartCharacterState, slot string, weapon storage.DaggerheartWeapon) error {
+	switch slot {
+	case daggerheart.EquipSlotPrimaryWeapon:
+		if weapon.Burden >= daggerheart.WeaponBurdenTwoHanded && state.EquippedSecondaryWeaponID != "" {
+			return status.Error(codes.FailedPrecondition, daggerheart.ErrTwoHandedConflict.Error())
+		}
+	case daggerheart.EquipSlotSecondaryWeapon:
+		if state.EquippedPrimaryWeaponID == "" {
+			return nil
+		}
+		primary, err := s.stores.DaggerheartContent.GetDaggerheartWeapon(ctx, state.EquippedPrimaryWeaponID)
+		if err != nil {
+			return contentLookupError("weapon", state.EquippedPrimaryWeaponID, err)
+		}
+		if primary.Burden >= daggerheart.WeaponBurdenTwoHanded {
+			return status.Error(codes.FailedPrecondition, daggerheart.ErrTwoHandedConflict.Error())
+		}
+	}
+	return nil
+}
+
+func (s *DaggerheartService) equipmentResponse(ctx context.Context, campaignID, characterID string) (*pb.DaggerheartEquipItemResponse, error) {
+	profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load daggerheart profile: %v", err)
+	}
+	state, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load daggerheart state: %v", err)
+	}
+	return &pb.DaggerheartEquipItemResponse{
+		CharacterId: characterID,
+		Profile:     daggerheartProfileToProto(profile),
+		State:       daggerheartStateToProto(state),
+	}, nil
+}
+
+// releaseInventoryItem computes the stack left after removing items, refusing to
+// remove the last copy of an equipped item.
+func releaseInventoryItem(state storage.DaggerheartCharacterState, itemID string, quantity int) (string, int, error) {
+	held := daggerheart.InventoryFromStorage(state.Inventory)
+	after, err := daggerheart.ReleaseQuantity(held, itemID, quantity)
+	if err != nil {
+		return "", 0, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if after == 0 && isEquipped(state, itemID) {
+		return "", 0, status.Error(codes.FailedPrecondition, daggerheart.ErrItemEquipped.Error())
+	}
+	kind := ""
+	for _, item := range held {
+		if item.ItemID == itemID {
+			kind = item.Kind
+		}
+	}
+	return kind, after, nil
+}
+
+// wornArmorChange derives armor score and thresholds for newly worn armor. Its
+// Armor Slots start unmarked.
+func wornArmorChange(level int, armor storage.DaggerheartArmor) *daggerheart.EquipmentChange {
+	score := armor.ArmorScore
+	if score > daggerheart.ArmorMaxCap {
+		score = daggerheart.ArmorMaxCap
+	}
+	major, severe := daggerheart.ArmoredThresholds(characterLevel(level), armor.BaseMajorThreshold, armor.BaseSevereThreshold)
+	return &daggerheart.EquipmentChange{
+		ArmorScoreAfter:      score,
+		ArmorMaxAfter:        score,
+		ArmorAfter:           score,
+		MajorThresholdAfter:  major,
+		SevereThresholdAfter: severe,
+	}
+}
+
+func characterLevel(level int) int {
+	if level == 0 {
+		return daggerheart.PCLevelDefault
+	}
+	return level
+}
+
+func equippedItemID(state storage.DaggerheartCharacterState, slot string) string {
+	switch slot {
+	case daggerheart.EquipSlotArmor:
+		return state.EquippedArmorID
+	case daggerheart.EquipSlotPrimaryWeapon:
+		return state.EquippedPrimaryWeaponID
+	case daggerheart.EquipSlotSecondaryWeapon:
+		return state.EquippedSecondaryWeaponID
+	default:
+		return ""
+	}
+}
+
+func isEquipped(state storage.DaggerheartCharacterState, itemID string) bool {
+	return itemID == state.EquippedArmorID || itemID == state.EquippedPrimaryWeaponID || itemID == state.EquippedSecondaryWeaponID
+}
+
+func inventoryRequestQuantity(quantity int32) (int, error) {
+	if quantity < 0 {
+		return 0, status.Error(codes.InvalidArgument, "quantity must be non-negative")
+	}
+	if quantity == 0 {
+		return 1, nil
+	}
+	return int(quantity), nil
+}
+
+func daggerheartInventoryKindFromProto(kind pb.DaggerheartInventoryItemKind) (string, error) {
+	switch kind {
+	case pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_UNSPECIFIED:
+		return "", fmt.Errorf("inventory kind is required")
+	case pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_WEAPON:
+		return daggerheart.InventoryKindWeapon, nil
+	case pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ARMOR:
+		return daggerheart.InventoryKindArmor, nil
+	case pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ITEM:
+		return daggerheart.InventoryKindItem, nil
+	case pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_LOOT:
+		return daggerheart.InventoryKindLoot, nil
+	default:
+		return "", fmt.Errorf("inventory kind %v is invalid", kind)
+	}
+}
+
+func daggerheartInventoryKindToProto(kind string) pb.DaggerheartInventoryItemKind {
+	switch kind {
+	case daggerheart.InventoryKindWeapon:
+		return pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_WEAPON
+	case daggerheart.InventoryKindArmor:
+		return pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ARMOR
+	case daggerheart.InventoryKindItem:
+		return pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ITEM
+	case daggerheart.InventoryKindLoot:
+		return pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_LOOT
+	default:
+		return pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_UNSPECIFIED
+	}
+}
+
+func daggerheartEquipSlotFromProto(slot pb.DaggerheartEquipSlot) (string, error) {
+	switch slot {
+	case pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED:
+		return "", fmt.Errorf("equip slot is required")
+	case pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_ARMOR:
+		return daggerheart.EquipSlotArmor, nil
+	case pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_PRIMARY_WEAPON:
+		return daggerheart.EquipSlotPrimaryWeapon, nil
+	case pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_SECONDARY_WEAPON:
+		return daggerheart.EquipSlotSecondaryWeapon, nil
+	default:
+		return "", fmt.Errorf("equip slot %v is invalid", slot)
+	}
+}
+
+func daggerheartInventoryToProto(items []storage.DaggerheartInventoryItem) []*pb.DaggerheartInventoryItem {
+	if len(items) == 0 {
+		return nil
+	}
+	result := make([]*pb.DaggerheartInventoryItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, &pb.DaggerheartInventoryItem{
+			ItemId:   item.ItemID,
+			Kind:     daggerheartInventoryKindToProto(item.Kind),
+			Quantity: int32(item.Quantity),
+		})
+	}
+	return result
+}
@@ -0,0 +1,195 @@
+package daggerheart
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+)
+
+func newInventoryTestService() *DaggerheartService {
+	svc := newSceneTestService()
+	content := svc.stores.DaggerheartContent.(*fakeContentStore)
+	content.weapons["weapon.longsword"] = storage.DaggerheartWeapon{ID: "weapon.longsword", Name: "Longsword", Category: "primary", Burden: 2}
+	content.weapons["weapon.round-shield"] = storage.DaggerheartWeapon{ID: "weapon.round-shield", Name: "Round Shield", Category: "secondary", Burden: 1}
+	content.armor["armor.gambeson-armor"] = storage.DaggerheartArmor{
+		ID: "armor.gambeson-armor", Name: "Gambeson Armor", BaseMajorThreshold: 5, BaseSevereThreshold: 11, ArmorScore: 3,
+	}
+	content.items["item.minor-health-potion"] = storage.DaggerheartItem{ID: "item.minor-health-potion", Name: "Minor Health Potion", StackMax: 3}
+	return svc
+}
+
+func acquire(t *testing.T, svc *DaggerheartService, characterID, itemID string, kind pb.DaggerheartInventoryItemKind, quantity int32) {
+	t.Helper()
+	if _, err := svc.AcquireItem(context.Background(), &pb.DaggerheartAcquireItemRequest{
+		CampaignId: "camp-1", CharacterId: characterID, ItemId: itemID, Kind: kind, Quantity: quantity,
+	}); err != nil {
+		t.Fatalf("AcquireItem(%s) returned error: %v", itemID, err)
+	}
+}
+
+func TestInventory_MissingStores(t *testing.T) {
+	svc := &DaggerheartService{}
+	_, err := svc.AcquireItem(context.Background(), &pb.DaggerheartAcquireItemRequest{})
+	assertStatusCode(t, err, codes.Internal)
+	_, err = svc.UpdateGold(context.Background(), &pb.DaggerheartUpdateGoldRequest{})
+	assertStatusCode(t, err, codes.Internal)
+}
+
+func TestAcquireItem_InvalidRequests(t *testing.T) {
+	potion := pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ITEM
+	tests := []struct {
+		name string
+		req  *pb.DaggerheartAcquireItemRequest
+		code codes.Code
+	}{
+		{name: "missing kind", req: &pb.DaggerheartAcquireItemRequest{CampaignId: "camp-1", CharacterId: "char-1", ItemId: "item.minor-health-potion"}, code: codes.InvalidArgument},
+		{name: "negative quantity", req: &pb.DaggerheartAcquireItemRequest{CampaignId: "camp-1", CharacterId: "char-1", ItemId: "item.minor-health-potion", Kind: potion, Quantity: -1}, code: codes.InvalidArgument},
+		{name: "unknown item", req: &pb.DaggerheartAcquireItemRequest{CampaignId: "camp-1", CharacterId: "char-1", ItemId: "item.missing", Kind: potion}, code: codes.InvalidArgument},
+		{name: "stack limit", req: &pb.DaggerheartAcquireItemRequest{CampaignId: "camp-1", CharacterId: "char-1", ItemId: "item.minor-health-potion", Kind: potion, Quantity: 4}, code: codes.FailedPrecondition},
+		{name: "unknown character", req: &pb.DaggerheartAcquireItemRequest{CampaignId: "camp-1", CharacterId: "char-9", ItemId: "item.minor-health-potion", Kind: potion}, code: codes.NotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newInventoryTestService().AcquireItem(context.Background(), tc.req)
+			assertStatusCode(t, err, tc.code)
+		})
+	}
+}
+
+func TestEquipArmor_DerivesArmorAndThresholds(t *testing.T) {
+	svc := newInventoryTestService()
+	ctx := context.Background()
+	acquire(t, svc, "char-1", "armor.gambeson-armor", pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ARMOR, 1)
+
+	resp, err := svc.EquipItem(ctx, &pb.DaggerheartEquipItemRequest{CampaignId: "camp-1", CharacterId: "char-1", ItemId: "armor.gambeson-armor"})
+	if err != nil {
+		t.Fatalf("EquipItem returned error: %v", err)
+	}
+	if resp.GetState().GetEquippedArmorId() != "armor.gambeson-armor" || resp.GetState().GetArmor() != 3 {
+		t.Fatalf("state = %v, want gambeson worn with 3 armor", resp.GetState())
+	}
+	// Level 1 character: base thresholds 5/11 plus level.
+	profile := resp.GetProfile()
+	if profile.GetArmorScore().GetValue() != 3 || profile.GetArmorMax().GetValue() != 3 {
+		t.Fatalf("armor score/max = %d/%d, want 3/3", profile.GetArmorScore().GetValue(), profile.GetArmorMax().GetValue())
+	}
+	if profile.GetMajorThreshold().GetValue() != 6 || profile.GetSevereThreshold().GetValue() != 12 {
+		t.Fatalf("thresholds = %d/%d, want 6/12", profile.GetMajorThreshold().GetValue(), profile.GetSevereThreshold().GetValue())
+	}
+
+	_, err = svc.DropItem(ctx, &pb.DaggerheartDropItemRequest{CampaignId: "camp-1", CharacterId: "char-1", ItemId: "armor.gambeson-armor"})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	unequipped, err := svc.UnequipItem(ctx, &pb.DaggerheartUnequipItemRequest{
+		CampaignId: "camp-1", CharacterId: "char-1", Slot: pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_ARMOR,
+	})
+	if err != nil {
+		t.Fatalf("UnequipItem returned error: %v", err)
+	}
+	if unequipped.GetProfile().GetArmorScore().GetValue() != 0 || unequipped.GetProfile().GetMajorThreshold().GetValue() != 1 {
+		t.Fatalf("unarmored profile = %v", unequipped.GetProfile())
+	}
+	if _, err := svc.DropItem(ctx, &pb.DaggerheartDropItemRequest{CampaignId: "camp-1", CharacterId: "char-1", ItemId: "armor.gambeson-armor"}); err != nil {
+		t.Fatalf("DropItem returned error: %v", err)
+	}
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	var types []event.Type
+	for _, evt := range eventStore.events["camp-1"] {
+		types = append(types, evt.Type)
+	}
+	want := []event.Type{
+		daggerheart.EventTypeItemAcquired,
+		daggerheart.EventTypeItemEquipped,
+		daggerheart.EventTypeItemUnequipped,
+		daggerheart.EventTypeItemDropped,
+	}
+	if len(types) != len(want) {
+		t.Fatalf("events = %v, want %v", types, want)
+	}
+	for i := range want {
+		if types[i] != want[i] {
+			t.Fatalf("events = %v, want %v", types, want)
+		}
+	}
+}
+
+func TestEquipWeapon_TwoHandedConflict(t *testing.T) {
+	svc := newInventoryTestService()
+	ctx := context.Background()
+	weapon := pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_WEAPON
+	acquire(t, svc, "char-1", "weapon.longsword", weapon, 1)
+	acquire(t, svc, "char-1", "weapon.round-shield", weapon, 1)
+
+	resp, err := svc.EquipItem(ctx, &pb.DaggerheartEquipItemRequest{CampaignId: "camp-1", CharacterId: "char-1", ItemId: "weapon.longsword"})
+	if err != nil {
+		t.Fatalf("EquipItem returned error: %v", err)
+	}
+	if resp.GetState().GetEquippedPrimaryWeaponId() != "weapon.longsword" {
+		t.Fatalf("primary weapon = %q, want longsword", resp.GetState().GetEquippedPrimaryWeaponId())
+	}
+	_, err = svc.EquipItem(ctx, &pb.DaggerheartEquipItemRequest{CampaignId: "camp-1", CharacterId: "char-1", ItemId: "weapon.round-shield"})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+	_, err = svc.EquipItem(ctx, &pb.DaggerheartEquipItemRequest{
+		CampaignId: "camp-1", CharacterId: "char-1", ItemId: "weapon.longsword", Slot: pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_SECONDARY_WEAPON,
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+	_, err = svc.EquipItem(ctx, &pb.DaggerheartEquipItemRequest{CampaignId: "camp-1", CharacterId: "char-1", ItemId: "weapon.dagger"})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestTransferItem_MovesStack(t *testing.T) {
+	svc := newInventoryTestService()
+	acquire(t, svc, "char-1", "item.minor-health-potion", pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ITEM, 2)
+
+	resp, err := svc.TransferItem(context.Background(), &pb.DaggerheartTransferItemRequest{
+		CampaignId: "camp-1", FromCharacterId: "char-1", ToCharacterId: "char-2", ItemId: "item.minor-health-potion",
+	})
+	if err != nil {
+		t.Fatalf("TransferItem returned error: %v", err)
+	}
+	if got := resp.GetFromState().GetInventory(); len(got) != 1 || got[0].GetQuantity() != 1 {
+		t.Fatalf("from inventory = %v, want one potion", got)
+	}
+	if got := resp.GetToState().GetInventory(); len(got) != 1 || got[0].GetQuantity() != 1 ||
+		got[0].GetKind() != pb.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ITEM {
+		t.Fatalf("to inventory = %v, want one potion", got)
+	}
+
+	_, err = svc.TransferItem(context.Background(), &pb.DaggerheartTransferItemRequest{
+		CampaignId: "camp-1", FromCharacterId: "char-1", ToCharacterId: "char-2", ItemId: "item.minor-health-potion", Quantity: 2,
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestUpdateGold_TradesUpAndRejectsOverspend(t *testing.T) {
+	svc := newInventoryTestService()
+	ctx := context.Background()
+
+	resp, err := svc.UpdateGold(ctx, &pb.DaggerheartUpdateGoldRequest{CampaignId: "camp-1", CharacterId: "char-1", Handfuls: 12, Reason: "loot"})
+	if err != nil {
+		t.Fatalf("UpdateGold returned error: %v", err)
+	}
+	gold := resp.GetState().GetGold()
+	if gold.GetHandfuls() != 2 || gold.GetBags() != 1 || gold.GetChests() != 0 {
+		t.Fatalf("gold = %v, want 2 handfuls and 1 bag", gold)
+	}
+
+	resp, err = svc.UpdateGold(ctx, &pb.DaggerheartUpdateGoldRequest{CampaignId: "camp-1", CharacterId: "char-1", Handfuls: -3})
+	if err != nil {
+		t.Fatalf("UpdateGold spend returned error: %v", err)
+	}
+	if gold := resp.GetState().GetGold(); gold.GetHandfuls() != 9 || gold.GetBags() != 0 {
+		t.Fatalf("gold = %v, want 9 handfuls", gold)
+	}
+
+	_, err = svc.UpdateGold(ctx, &pb.DaggerheartUpdateGoldRequest{CampaignId: "camp-1", CharacterId: "char-1", Bags: -1})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+	_, err = svc.UpdateGold(ctx, &pb.DaggerheartUpdateGoldRequest{CampaignId: "camp-1", CharacterId: "char-1"})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
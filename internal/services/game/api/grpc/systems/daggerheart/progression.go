@@ -0,0 +1,502 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// LevelUp advances a character one level with the chosen advancements.
+func (s *DaggerheartService) LevelUp(ctx context.Context, in *pb.DaggerheartLevelUpRequest) (*pb.DaggerheartLevelUpResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "level up request is required")
+	}
+	if s.stores.Campaign == nil {
+		return nil, status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return nil, status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	if s.stores.DaggerheartContent == nil {
+		return nil, status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	if s.stores.Event == nil {
+		return nil, status.Error(codes.Internal, "event store is not configured")
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	characterID := strings.TrimSpace(in.GetCharacterId())
+	if characterID == "" {
+		return nil, status.Error(codes.InvalidArgument, "character id is required")
+	}
+
+	advancements := make([]daggerheart.Advancement, 0, len(in.GetAdvancements()))
+	for _, advancement := range in.GetAdvancements() {
+		advancementType, err := daggerheartAdvancementTypeFromProto(advancement.GetType())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		advancements = append(advancements, daggerheart.Advancement{
+			Type:         advancementType,
+			Traits:       advancement.GetTraits(),
+			Experiences:  advancement.GetExperiences(),
+			DomainCardID: advancement.GetDomainCardId(),
+			SubclassID:   advancement.GetSubclassId(),
+			ClassID:      advancement.GetClassId(),
+			DomainID:     advancement.GetDomainId(),
+		})
+	}
+
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	if err := campaign.ValidateCampaignOperation(c.Status, campaign.CampaignOpCampaignMutate); err != nil {
+		return nil, handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return nil, status.Error(codes.FailedPrecondition, "campaign system does not support daggerheart level up")
+	}
+
+	sessionID := strings.TrimSpace(grpcmeta.SessionIDFromContext(ctx))
+	if sessionID != "" {
+		if err := s.ensureNoOpenSessionGate(ctx, campaignID, sessionID); err != nil {
+			return nil, err
+		}
+	}
+
+	profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	current, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	hasState := err == nil
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, handleDomainError(err)
+	}
+
+	base := progressionFromProfile(profile)
+	next, err := daggerheart.ApplyLevelUp(base, daggerheart.LevelUpChoice{
+		Advancements:  advancements,
+		NewExperience: in.GetNewExperience(),
+		DomainCardID:  in.GetDomainCardId(),
+	})
+	if err != nil {
+		if errors.Is(err, daggerheart.ErrMaxLevel) || errors.Is(err, daggerheart.ErrAlreadyMulticlassed) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.validateLevelUpContent(ctx, next); err != nil {
+		return nil, err
+	}
+	record := next.History[len(next.History)-1]
+
+	payload := daggerheart.CharacterLeveledUpPayload{
+		CharacterID:          characterID,
+		LevelBefore:          base.Level,
+		LevelAfter:           next.Level,
+		Tier:                 daggerheart.TierForLevel(next.Level),
+		Advancements:         levelUpAdvancementsToPayload(record.Advancements),
+		NewExperience:        record.NewExperience,
+		DomainCardID:         record.DomainCardID,
+		HpMaxAfter:           next.HpMax,
+		StressMaxAfter:       next.StressMax,
+		EvasionAfter:         next.Evasion,
+		ProficiencyAfter:     next.Proficiency,
+		MajorThresholdAfter:  next.MajorThreshold,
+		SevereThresholdAfter: next.SevereThreshold,
+		TraitsAfter: map[string]int{
+			"agility":   next.Traits.Agility,
+			"strength":  next.Traits.Strength,
+			"finesse":   next.Traits.Finesse,
+			"instinct":  next.Traits.Instinct,
+			"presence":  next.Traits.Presence,
+			"knowledge": next.Traits.Knowledge,
+		},
+		MarkedTraitsAfter:  next.MarkedTraits,
+		LoadoutActiveAfter: next.Loadout.Active,
+		LoadoutVaultAfter:  next.Loadout.Vault,
+	}
+	if next.Classes.SubclassStage != base.Classes.SubclassStage {
+		payload.SubclassStageAfter = next.Classes.SubclassStage
+	}
+	if next.Classes.MulticlassClassID != base.Classes.MulticlassClassID {
+		payload.MulticlassClassIDAfter = next.Classes.MulticlassClassID
+		payload.MulticlassSubclassIDAfter = next.Classes.MulticlassSubclassID
+		payload.MulticlassDomainIDAfter = next.Classes.MulticlassDomainID
+	}
+	if next.Classes.MulticlassSubclassStage != base.Classes.MulticlassSubclassStage {
+		payload.MulticlassSubclassStageAfter = next.Classes.MulticlassSubclassStage
+	}
+	for _, experience := range next.Experiences {
+		payload.ExperiencesAfter = append(payload.ExperiencesAfter, daggerheart.LevelUpExperiencePayload{
+			Name:     experience.Name,
+			Modifier: experience.Modifier,
+		})
+	}
+	// New Hit Point slots start unmarked, so remaining HP grows with them.
+	if gained := next.HpMax - profile.HpMax; gained > 0 && hasState {
+		hpBefore := current.Hp
+		hpAfter := hpBefore + gained
+		if hpAfter > next.HpMax {
+			hpAfter = next.HpMax
+		}
+		payload.HpBefore = &hpBefore
+		payload.HpAfter = &hpAfter
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode payload: %v", err)
+	}
+
+	stored, err := s.stores.Event.AppendEvent(ctx, event.Event{
+		CampaignID:    campaignID,
+		Timestamp:     time.Now().UTC(),
+		Type:          daggerheart.EventTypeCharacterLeveledUp,
+		SessionID:     sessionID,
+		RequestID:     grpcmeta.RequestIDFromContext(ctx),
+		InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
+		ActorType:     event.ActorTypeSystem,
+		EntityType:    "character",
+		EntityID:      characterID,
+		SystemID:      c.System.String(),
+		SystemVersion: daggerheart.SystemVersion,
+		PayloadJSON:   payloadJSON,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "append event: %v", err)
+	}
+
+	adapter := daggerheart.NewAdapter(s.stores.Daggerheart)
+	if err := adapter.ApplyEvent(ctx, stored); err != nil {
+		return nil, status.Errorf(codes.Internal, "apply event: %v", err)
+	}
+
+	updatedProfile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "load daggerheart profile: %v", err)
+	}
+	response := &pb.DaggerheartLevelUpResponse{
+		CharacterId: characterID,
+		Level:       int32(updatedProfile.Level),
+		Tier:        int32(daggerheart.TierForLevel(updatedProfile.Level)),
+		Profile:     daggerheartProfileToProto(updatedProfile),
+	}
+	if hasState {
+		updatedState, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "load daggerheart state: %v", err)
+		}
+		response.State = daggerheartStateToProto(updatedState)
+	}
+	return response, nil
+}
+
+// validateLevelUpContent checks level-up selections against the content catalog.
+func (s *DaggerheartService) validateLevelUpContent(ctx context.Context, next daggerheart.Progression) error {
+	record := next.History[len(next.History)-1]
+	cardIDs := make([]string, 0, 2)
+	if record.DomainCardID != "" {
+		cardIDs = append(cardIDs, record.DomainCardID)
+	}
+	for _, advancement := range record.Advancements {
+		switch advancement.Type {
+		case daggerheart.AdvancementDomainCard:
+			cardIDs = append(cardIDs, advancement.DomainCardID)
+		case daggerheart.AdvancementSubclassUpgrade:
+			stage := next.Classes.SubclassStage
+			if advancement.SubclassID == next.Classes.MulticlassSubclassID {
+				stage = next.Classes.MulticlassSubclassStage
+			}
+			subclass, err := s.stores.DaggerheartContent.GetDaggerheartSubclass(ctx, advancement.SubclassID)
+			if err != nil {
+				return contentLookupError("subclass", advancement.SubclassID, err)
+			}
+			if len(subclassStageFeatures(subclass, stage)) == 0 {
+				return status.Errorf(codes.FailedPrecondition, "subclass %q has no %s features", advancement.SubclassID, stage)
+			}
+		case daggerheart.AdvancementMulticlass:
+			class, err := s.stores.DaggerheartContent.GetDaggerheartClass(ctx, advancement.ClassID)
+			if err != nil {
+				return contentLookupError("class", advancement.ClassID, err)
+			}
+			if err := daggerheart.ValidateDomainAccess(advancement.DomainID, class.DomainIDs); err != nil {
+				return status.Errorf(codes.InvalidArgument, "domain %q is not a %s domain", advancement.DomainID, class.Name)
+			}
+			if _, err := s.stores.DaggerheartContent.GetDaggerheartSubclass(ctx, advancement.SubclassID); err != nil {
+				return contentLookupError("subclass", advancement.SubclassID, err)
+			}
+		}
+	}
+
+	domains, err := s.accessibleDomains(ctx, next.Classes)
+	if err != nil {
+		return err
+	}
+	for _, cardID := range cardIDs {
+		card, err := s.stores.DaggerheartContent.GetDaggerheartDomainCard(ctx, cardID)
+		if err != nil {
+			return contentLookupError("domain card", cardID, err)
+		}
+		if card.Level > record.Level {
+			return status.Errorf(codes.InvalidArgument, "domain card %q is level %d, above character level %d", cardID, card.Level, record.Level)
+		}
+		if domains != nil {
+			if err := daggerheart.ValidateDomainAccess(card.DomainID, domains); err != nil {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// accessibleDomains returns the domains a character may draw cards from, or
+// nil when no class has been chosen and domain access is unrestricted.
+func (s *DaggerheartService) accessibleDomains(ctx context.Context, classes daggerheart.ClassSelection) ([]string, error) {
+	if classes.ClassID == "" {
+		return nil, nil
+	}
+	class, err := s.stores.DaggerheartContent.GetDaggerheartClass(ctx, classes.ClassID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "class %q not found", classes.ClassID)
+		}
+		return nil, status.Errorf(codes.Internal, "get class: %v", err)
+	}
+	return daggerheart.AccessibleDomains(class.DomainIDs, classes.MulticlassDomainID), nil
+}
+
+// ensureDomainCardAccess rejects domain cards outside the character's class
+// domains. Characters without a class are not restricted.
+func (s *DaggerheartService) ensureDomainCardAccess(ctx context.Context, profile storage.DaggerheartCharacterProfile, cardID string) error {
+	if profile.ClassID == "" {
+		return nil
+	}
+	if s.stores.DaggerheartContent == nil {
+		return status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	domains, err := s.accessibleDomains(ctx, classSelectionFromProfile(profile))
+	if err != nil {
+		return err
+	}
+	card, err := s.stores.DaggerheartContent.GetDaggerheartDomainCard(ctx, cardID)
+	if err != nil {
+		return contentLookupError("domain card", cardID, err)
+	}
+	if err := daggerheart.ValidateDomainAccess(card.DomainID, domains); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func subclassStageFeatures(subclass storage.DaggerheartSubclass, stage string) []storage.DaggerheartFeature {
+	switch stage {
+	case daggerheart.SubclassStageSpecialization:
+		return subclass.SpecializationFeatures
+	case daggerheart.SubclassStageMastery:
+		return subclass.MasteryFeatures
+	default:
+		return subclass.FoundationFeatures
+	}
+}
+
+func contentLookupError(kind, id string, err error) error {
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Errorf(codes.InvalidArgument, "%s %q not found", kind, id)
+	}
+	return status.Errorf(codes.Internal, "get %s: %v", kind, err)
+}
+
+func progressionFromProfile(profile storage.DaggerheartCharacterProfile) daggerheart.Progression {
+	experiences := make([]daggerheart.Experience, 0, len(profile.Experiences))
+	for _, experience := range profile.Experiences {
+		experiences = append(experiences, daggerheart.Experience{Name: experience.Name, Modifier: experience.Modifier})
+	}
+	history := make([]daggerheart.LevelUpRecord, 0, len(profile.LevelHistory))
+	for _, entry := range profile.LevelHistory {
+		advancements := make([]daggerheart.Advancement, 0, len(entry.Advancements))
+		for _, advancement := range entry.Advancements {
+			advancements = append(advancements, daggerheart.Advancement{
+				Type:         advancement.Type,
+				Traits:       advancement.Traits,
+				Experiences:  advancement.Experiences,
+				DomainCardID: advancement.DomainCardID,
+				SubclassID:   advancement.SubclassID,
+				ClassID:      advancement.ClassID,
+				DomainID:     advancement.DomainID,
+			})
+		}
+		history = append(history, daggerheart.LevelUpRecord{
+			Level:         entry.Level,
+			Advancements:  advancements,
+			NewExperience: entry.NewExperience,
+			DomainCardID:  entry.DomainCardID,
+		})
+	}
+	level := profile.Level
+	if level == 0 {
+		level = daggerheart.PCLevelDefault
+	}
+	return daggerheart.Progression{
+		Level:           level,
+		HpMax:           profile.HpMax,
+		StressMax:       profile.StressMax,
+		Evasion:         profile.Evasion,
+		MajorThreshold:  profile.MajorThreshold,
+		SevereThreshold: profile.SevereThreshold,
+		Proficiency:     profile.Proficiency,
+		ArmorScore:      profile.ArmorScore,
+		Traits: daggerheart.Traits{
+			Agility:   profile.Agility,
+			Strength:  profile.Strength,
+			Finesse:   profile.Finesse,
+			Instinct:  profile.Instinct,
+			Presence:  profile.Presence,
+			Knowledge: profile.Knowledge,
+		},
+		Experiences:  experiences,
+		MarkedTraits: profile.MarkedTraits,
+		Loadout:      daggerheart.Loadout{Active: profile.LoadoutActive, Vault: profile.LoadoutVault},
+		Classes:      classSelectionFromProfile(profile),
+		History:      history,
+	}
+}
+
+func classSelectionFromProfile(profile storage.DaggerheartCharacterProfile) daggerheart.ClassSelection {
+	return daggerheart.ClassSelection{
+		ClassID:                 profile.ClassID,
+		SubclassID:              profile.SubclassID,
+		SubclassStage:           profile.SubclassStage,
+		MulticlassClassID:       profile.MulticlassClassID,
+		MulticlassSubclassID:    profile.MulticlassSubclassID,
+		MulticlassSubclassStage: profile.MulticlassSubclassStage,
+		MulticlassDomainID:      profile.MulticlassDomainID,
+	}
+}
+
+func levelUpAdvancementsToPayload(advancements []daggerheart.Advancement) []daggerheart.LevelUpAdvancementPayload {
+	result := make([]daggerheart.LevelUpAdvancementPayload, 0, len(advancements))
+	for _, advancement := range advancements {
+		result = append(result, daggerheart.LevelUpAdvancementPayload{
+			Type:         advancement.Type,
+			Traits:       advancement.Traits,
+			Experiences:  advancement.Experiences,
+			DomainCardID: advancement.DomainCardID,
+			SubclassID:   advancement.SubclassID,
+			ClassID:      advancement.ClassID,
+			DomainID:     advancement.DomainID,
+		})
+	}
+	return result
+}
+
+func daggerheartAdvancementTypeFromProto(advancementType pb.DaggerheartAdvancementType) (string, error) {
+	switch advancementType {
+	case pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_TRAITS:
+		return daggerheart.AdvancementTraits, nil
+	case pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_HP_SLOT:
+		return daggerheart.AdvancementHPSlot, nil
+	case pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_STRESS_SLOT:
+		return daggerheart.AdvancementStressSlot, nil
+	case pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_EXPERIENCES:
+		return daggerheart.AdvancementExperiences, nil
+	case pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_DOMAIN_CARD:
+		return daggerheart.AdvancementDomainCard, nil
+	case pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_EVASION:
+		return daggerheart.AdvancementEvasion, nil
+	case pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_SUBCLASS_UPGRADE:
+		return daggerheart.AdvancementSubclassUpgrade, nil
+	case pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_PROFICIENCY:
+		return daggerheart.AdvancementProficiency, nil
+	case pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_MULTICLASS:
+		return daggerheart.AdvancementMulticlass, nil
+	case pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_UNSPECIFIED:
+		return "", fmt.Errorf("advancement type is required")
+	default:
+		return "", fmt.Errorf("advancement type %v is invalid", advancementType)
+	}
+}
+
+func daggerheartProfileToProto(profile storage.DaggerheartCharacterProfile) *pb.DaggerheartProfile {
+	result := &pb.DaggerheartProfile{
+		Level:                   int32(profile.Level),
+		HpMax:                   int32(profile.HpMax),
+		StressMax:               wrapperspb.Int32(int32(profile.StressMax)),
+		Evasion:                 wrapperspb.Int32(int32(profile.Evasion)),
+		MajorThreshold:          wrapperspb.Int32(int32(profile.MajorThreshold)),
+		SevereThreshold:         wrapperspb.Int32(int32(profile.SevereThreshold)),
+		Proficiency:             wrapperspb.Int32(int32(profile.Proficiency)),
+		ArmorScore:              wrapperspb.Int32(int32(profile.ArmorScore)),
+		ArmorMax:                wrapperspb.Int32(int32(profile.ArmorMax)),
+		Agility:                 wrapperspb.Int32(int32(profile.Agility)),
+		Strength:                wrapperspb.Int32(int32(profile.Strength)),
+		Finesse:                 wrapperspb.Int32(int32(profile.Finesse)),
+		Instinct:                wrapperspb.Int32(int32(profile.Instinct)),
+		Presence:                wrapperspb.Int32(int32(profile.Presence)),
+		Knowledge:               wrapperspb.Int32(int32(profile.Knowledge)),
+		MarkedTraits:            profile.MarkedTraits,
+		LoadoutActive:           profile.LoadoutActive,
+		LoadoutVault:            profile.LoadoutVault,
+		ClassId:                 profile.ClassID,
+		SubclassId:              profile.SubclassID,
+		SubclassStage:           profile.SubclassStage,
+		MulticlassClassId:       profile.MulticlassClassID,
+		MulticlassSubclassId:    profile.MulticlassSubclassID,
+		MulticlassSubclassStage: profile.MulticlassSubclassStage,
+		MulticlassDomainId:      profile.MulticlassDomainID,
+	}
+	for _, experience := range profile.Experiences {
+		result.Experiences = append(result.Experiences, &pb.DaggerheartExperience{
+			Name:     experience.Name,
+			Modifier: int32(experience.Modifier),
+		})
+	}
+	result.LevelHistory = daggerheartLevelHistoryToProto(profile.LevelHistory)
+	return result
+}
+
+// daggerheartLevelHistoryToProto converts a stored level history to proto.
+func daggerheartLevelHistoryToProto(history []storage.DaggerheartLevelUp) []*pb.DaggerheartLevelUpRecord {
+	if len(history) == 0 {
+		return nil
+	}
+	result := make([]*pb.DaggerheartLevelUpRecord, 0, len(history))
+	for _, entry := range history {
+		record := &pb.DaggerheartLevelUpRecord{
+			Level:         int32(entry.Level),
+			NewExperience: entry.NewExperience,
+			DomainCardId:  entry.DomainCardID,
+		}
+		for _, advancement := range entry.Advancements {
+			record.Advancements = append(record.Advancements, &pb.DaggerheartLevelUpAdvancement{
+				Type:         advancement.Type,
+				Traits:       advancement.Traits,
+				Experiences:  advancement.Experiences,
+				DomainCardId: advancement.DomainCardID,
+				SubclassId:   advancement.SubclassID,
+				ClassId:      advancement.ClassID,
+				DomainId:     advancement.DomainID,
+			})
+		}
+		result = append(result, record)
+	}
+	return result
+}
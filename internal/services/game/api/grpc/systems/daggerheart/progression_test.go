@@ -0,0 +1,244 @@
+package daggerheart
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+)
+
+func newLevelUpTestService() *DaggerheartService {
+	svc := newActionTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.Level = 1
+	profile.Evasion = 10
+	profile.MajorThreshold = 1
+	profile.SevereThreshold = 2
+	profile.Proficiency = 1
+	profile.Agility = 2
+	profile.Experiences = []storage.DaggerheartExperience{{Name: "Scout", Modifier: 2}}
+	profile.ClassID = "class.guardian"
+	profile.SubclassID = "subclass.stalwart"
+	profile.SubclassStage = daggerheart.SubclassStageFoundation
+	dhStore.profiles["camp-1:char-1"] = profile
+
+	content := newFakeContentStore()
+	content.classes["class.guardian"] = storage.DaggerheartClass{ID: "class.guardian", Name: "Guardian", DomainIDs: []string{"domain.valor", "domain.blade"}}
+	content.classes["class.wizard"] = storage.DaggerheartClass{ID: "class.wizard", Name: "Wizard", DomainIDs: []string{"domain.codex", "domain.midnight"}}
+	content.subclasses["subclass.stalwart"] = storage.DaggerheartSubclass{
+		ID:                     "subclass.stalwart",
+		Name:                   "Stalwart",
+		FoundationFeatures:     []storage.DaggerheartFeature{{ID: "feature.unwavering", Name: "Unwavering"}},
+		SpecializationFeatures: []storage.DaggerheartFeature{{ID: "feature.unrelenting", Name: "Unrelenting"}},
+	}
+	content.subclasses["subclass.school-of-war"] = storage.DaggerheartSubclass{
+		ID:                 "subclass.school-of-war",
+		Name:               "School of War",
+		FoundationFeatures: []storage.DaggerheartFeature{{ID: "feature.battlemage", Name: "Battlemage"}},
+	}
+	content.domainCards["card.valor-1"] = storage.DaggerheartDomainCard{ID: "card.valor-1", DomainID: "domain.valor", Level: 1}
+	content.domainCards["card.valor-7"] = storage.DaggerheartDomainCard{ID: "card.valor-7", DomainID: "domain.valor", Level: 7}
+	content.domainCards["card.codex-1"] = storage.DaggerheartDomainCard{ID: "card.codex-1", DomainID: "domain.codex", Level: 1}
+	svc.stores.DaggerheartContent = content
+	return svc
+}
+
+func newLevelUpRequest() *pb.DaggerheartLevelUpRequest {
+	return &pb.DaggerheartLevelUpRequest{
+		CampaignId:    "camp-1",
+		CharacterId:   "char-1",
+		NewExperience: "Cartographer",
+		DomainCardId:  "card.valor-1",
+		Advancements: []*pb.DaggerheartAdvancement{
+			{Type: pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_TRAITS, Traits: []string{"agility", "strength"}},
+			{Type: pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_HP_SLOT},
+		},
+	}
+}
+
+func TestLevelUp_MissingStores(t *testing.T) {
+	svc := &DaggerheartService{}
+	_, err := svc.LevelUp(context.Background(), newLevelUpRequest())
+	assertStatusCode(t, err, codes.Internal)
+}
+
+func TestLevelUp_MissingIDs(t *testing.T) {
+	svc := newLevelUpTestService()
+	req := newLevelUpRequest()
+	req.CampaignId = ""
+	_, err := svc.LevelUp(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	req = newLevelUpRequest()
+	req.CharacterId = " "
+	_, err = svc.LevelUp(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestLevelUp_UnspecifiedAdvancement(t *testing.T) {
+	svc := newLevelUpTestService()
+	req := newLevelUpRequest()
+	req.Advancements[1].Type = pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_UNSPECIFIED
+	_, err := svc.LevelUp(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestLevelUp_InvalidChoice(t *testing.T) {
+	svc := newLevelUpTestService()
+	req := newLevelUpRequest()
+	req.NewExperience = ""
+	_, err := svc.LevelUp(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestLevelUp_DomainCardAboveLevel(t *testing.T) {
+	svc := newLevelUpTestService()
+	req := newLevelUpRequest()
+	req.DomainCardId = "card.valor-7"
+	_, err := svc.LevelUp(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestLevelUp_UnknownDomainCard(t *testing.T) {
+	svc := newLevelUpTestService()
+	req := newLevelUpRequest()
+	req.DomainCardId = "card.missing"
+	_, err := svc.LevelUp(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestLevelUp_MaxLevel(t *testing.T) {
+	svc := newLevelUpTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.Level = daggerheart.LevelMax
+	dhStore.profiles["camp-1:char-1"] = profile
+
+	_, err := svc.LevelUp(context.Background(), newLevelUpRequest())
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestLevelUp_Success(t *testing.T) {
+	svc := newLevelUpTestService()
+	resp, err := svc.LevelUp(context.Background(), newLevelUpRequest())
+	if err != nil {
+		t.Fatalf("LevelUp returned error: %v", err)
+	}
+	if resp.GetLevel() != 2 || resp.GetTier() != 2 {
+		t.Fatalf("level/tier = %d/%d, want 2/2", resp.GetLevel(), resp.GetTier())
+	}
+	profile := resp.GetProfile()
+	if profile.GetHpMax() != 7 || profile.GetProficiency().GetValue() != 2 {
+		t.Fatalf("profile hp_max=%d proficiency=%d", profile.GetHpMax(), profile.GetProficiency().GetValue())
+	}
+	if profile.GetAgility().GetValue() != 3 || profile.GetStrength().GetValue() != 1 {
+		t.Fatalf("traits agility=%d strength=%d", profile.GetAgility().GetValue(), profile.GetStrength().GetValue())
+	}
+	if len(profile.GetMarkedTraits()) != 2 || len(profile.GetLoadoutActive()) != 1 || len(profile.GetLevelHistory()) != 1 {
+		t.Fatalf("progression = marked %v, loadout %v, history %v", profile.GetMarkedTraits(), profile.GetLoadoutActive(), profile.GetLevelHistory())
+	}
+	if resp.GetState().GetHp() != 7 {
+		t.Fatalf("hp = %d, want 7", resp.GetState().GetHp())
+	}
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	events := eventStore.events["camp-1"]
+	if len(events) != 1 || events[0].Type != daggerheart.EventTypeCharacterLeveledUp {
+		t.Fatalf("events = %+v", events)
+	}
+}
+
+func TestLevelUp_DomainCardOutsideClassDomains(t *testing.T) {
+	svc := newLevelUpTestService()
+	req := newLevelUpRequest()
+	req.DomainCardId = "card.codex-1"
+	_, err := svc.LevelUp(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestLevelUp_SubclassUpgrade(t *testing.T) {
+	svc := newLevelUpTestService()
+	req := newLevelUpRequest()
+	req.Advancements[1] = &pb.DaggerheartAdvancement{Type: pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_SUBCLASS_UPGRADE}
+	resp, err := svc.LevelUp(context.Background(), req)
+	if err != nil {
+		t.Fatalf("LevelUp returned error: %v", err)
+	}
+	if got := resp.GetProfile().GetSubclassStage(); got != daggerheart.SubclassStageSpecialization {
+		t.Fatalf("subclass stage = %q, want specialization", got)
+	}
+}
+
+func TestLevelUp_SubclassUpgradeWithoutFeatures(t *testing.T) {
+	svc := newLevelUpTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.SubclassStage = daggerheart.SubclassStageSpecialization
+	dhStore.profiles["camp-1:char-1"] = profile
+
+	req := newLevelUpRequest()
+	req.Advancements[1] = &pb.DaggerheartAdvancement{Type: pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_SUBCLASS_UPGRADE}
+	_, err := svc.LevelUp(context.Background(), req)
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestLevelUp_Multiclass(t *testing.T) {
+	svc := newLevelUpTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.Level = 4
+	dhStore.profiles["camp-1:char-1"] = profile
+
+	multiclass := &pb.DaggerheartAdvancement{
+		Type:       pb.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_MULTICLASS,
+		ClassId:    "class.wizard",
+		SubclassId: "subclass.school-of-war",
+		DomainId:   "domain.valor",
+	}
+	req := &pb.DaggerheartLevelUpRequest{
+		CampaignId:    "camp-1",
+		CharacterId:   "char-1",
+		NewExperience: "Battlemage",
+		DomainCardId:  "card.codex-1",
+		Advancements:  []*pb.DaggerheartAdvancement{multiclass},
+	}
+	_, err := svc.LevelUp(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	multiclass.DomainId = "domain.codex"
+	resp, err := svc.LevelUp(context.Background(), req)
+	if err != nil {
+		t.Fatalf("LevelUp returned error: %v", err)
+	}
+	got := resp.GetProfile()
+	if got.GetMulticlassClassId() != "class.wizard" || got.GetMulticlassDomainId() != "domain.codex" {
+		t.Fatalf("multiclass = %q/%q", got.GetMulticlassClassId(), got.GetMulticlassDomainId())
+	}
+	if got.GetMulticlassSubclassStage() != daggerheart.SubclassStageFoundation {
+		t.Fatalf("multiclass subclass stage = %q, want foundation", got.GetMulticlassSubclassStage())
+	}
+	if len(got.GetLoadoutActive()) != 1 || got.GetLoadoutActive()[0] != "card.codex-1" {
+		t.Fatalf("loadout = %v", got.GetLoadoutActive())
+	}
+}
+
+func TestSwapLoadout_DomainRestriction(t *testing.T) {
+	svc := newLevelUpTestService()
+	ctx := contextWithSessionID("sess-1")
+	req := &pb.DaggerheartSwapLoadoutRequest{
+		CampaignId:  "camp-1",
+		CharacterId: "char-1",
+		Swap:        &pb.DaggerheartLoadoutSwapRequest{CardId: "card.codex-1"},
+	}
+	_, err := svc.SwapLoadout(ctx, req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	req.Swap.CardId = "card.valor-1"
+	if _, err := svc.SwapLoadout(ctx, req); err != nil {
+		t.Fatalf("SwapLoadout returned error: %v", err)
+	}
+}
@@ -0,0 +1,487 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/session"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *DaggerheartService) SetSceneRanges(ctx context.Context, in *pb.DaggerheartSetSceneRangesRequest) (*pb.DaggerheartSetSceneRangesResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "set scene ranges request is required")
+	}
+	if err := s.requireSessionStores(); err != nil {
+		return nil, err
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	if len(in.GetRanges()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ranges are required")
+	}
+
+	c, err := s.loadActiveSession(ctx, campaignID, sessionID, "scene positions")
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := make([]daggerheart.SceneRange, 0, len(in.GetRanges()))
+	for _, entry := range in.GetRanges() {
+		fromID, fromType, err := sceneEntityFromProto(entry.GetFrom())
+		if err != nil {
+			return nil, err
+		}
+		toID, toType, err := sceneEntityFromProto(entry.GetTo())
+		if err != nil {
+			return nil, err
+		}
+		if fromID == toID {
+			return nil, status.Error(codes.InvalidArgument, "scene range must join two different entities")
+		}
+		band, err := daggerheartRangeBandFromProto(entry.GetBand())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := s.ensureSceneEntity(ctx, campaignID, sessionID, fromID, fromType); err != nil {
+			return nil, err
+		}
+		if err := s.ensureSceneEntity(ctx, campaignID, sessionID, toID, toType); err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, daggerheart.SceneRange{
+			FromID:   fromID,
+			FromType: fromType,
+			ToID:     toID,
+			ToType:   toType,
+			Band:     band,
+		})
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.SceneRangesSetPayload{Ranges: ranges})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode scene ranges payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeSceneRangesSet, "scene", sessionID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	return &pb.DaggerheartSetSceneRangesResponse{Ranges: sceneRangesToProto(ranges)}, nil
+}
+
+func (s *DaggerheartService) MoveSceneEntity(ctx context.Context, in *pb.DaggerheartMoveSceneEntityRequest) (*pb.DaggerheartMoveSceneEntityResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "move scene entity request is required")
+	}
+	if err := s.requireSessionStores(); err != nil {
+		return nil, err
+	}
+
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	entityID, entityType, err := sceneEntityFromProto(in.GetEntity())
+	if err != nil {
+		return nil, err
+	}
+	kind, err := daggerheartMovementKindFromProto(in.GetKind())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pushBands := int(in.GetPushBands())
+	if pushBands < 0 {
+		return nil, status.Error(codes.InvalidArgument, "push_bands must be non-negative")
+	}
+	if pushBands > 0 && kind != daggerheart.MovementKindKnockback {
+		return nil, status.Error(codes.InvalidArgument, "push_bands applies to knockback only")
+	}
+	var sourceID, sourceType string
+	if in.GetSource() != nil {
+		sourceID, sourceType, err = sceneEntityFromProto(in.GetSource())
+		if err != nil {
+			return nil, err
+		}
+		if sourceID == entityID {
+			return nil, status.Error(codes.InvalidArgument, "source must differ from the moved entity")
+		}
+	}
+	if (kind == daggerheart.MovementKindKnockback || kind == daggerheart.MovementKindForced) && sourceID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "%s movement requires a source", kind)
+	}
+	if len(in.GetRanges()) == 0 && pushBands == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ranges or push_bands are required")
+	}
+
+	c, err := s.loadActiveSession(ctx, campaignID, sessionID, "scene positions")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureSceneEntity(ctx, campaignID, sessionID, entityID, entityType); err != nil {
+		return nil, err
+	}
+	if sourceID != "" {
+		if err := s.ensureSceneEntity(ctx, campaignID, sessionID, sourceID, sourceType); err != nil {
+			return nil, err
+		}
+	}
+
+	ranges := make([]daggerheart.SceneRange, 0, len(in.GetRanges())+1)
+	seen := make(map[string]struct{}, len(in.GetRanges()))
+	for _, entry := range in.GetRanges() {
+		targetID, targetType, err := sceneEntityFromProto(entry.GetTarget())
+		if err != nil {
+			return nil, err
+		}
+		if targetID == entityID {
+			return nil, status.Error(codes.InvalidArgument, "range target must differ from the moved entity")
+		}
+		if _, ok := seen[targetID]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "range target %q is duplicated", targetID)
+		}
+		seen[targetID] = struct{}{}
+		band, err := daggerheartRangeBandFromProto(entry.GetBand())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := s.ensureSceneEntity(ctx, campaignID, sessionID, targetID, targetType); err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, daggerheart.SceneRange{
+			FromID:   entityID,
+			FromType: entityType,
+			ToID:     targetID,
+			ToType:   targetType,
+			Band:     band,
+		})
+	}
+	if _, ok := seen[sourceID]; pushBands > 0 && !ok {
+		current, err := s.stores.Daggerheart.GetDaggerheartSceneRange(ctx, campaignID, sessionID, entityID, sourceID)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, status.Error(codes.FailedPrecondition, "knockback requires a known range to the source")
+			}
+			return nil, status.Errorf(codes.Internal, "load scene range: %v", err)
+		}
+		ranges = append(ranges, daggerheart.SceneRange{
+			FromID:   entityID,
+			FromType: entityType,
+			ToID:     sourceID,
+			ToType:   sourceType,
+			Band:     daggerheart.PushRangeBand(current.Band, pushBands),
+		})
+	}
+
+	payloadJSON, err := json.Marshal(daggerheart.SceneEntityMovedPayload{
+		EntityID:   entityID,
+		EntityType: entityType,
+		Kind:       kind,
+		SourceID:   sourceID,
+		SourceType: sourceType,
+		Reason:     strings.TrimSpace(in.GetReason()),
+		Ranges:     ranges,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode scene movement payload: %v", err)
+	}
+	if err := s.appendSessionEvent(ctx, c, sessionID, daggerheart.EventTypeSceneEntityMoved, entityType, entityID, payloadJSON); err != nil {
+		return nil, err
+	}
+
+	return &pb.DaggerheartMoveSceneEntityResponse{Ranges: sceneRangesToProto(ranges)}, nil
+}
+
+func (s *DaggerheartService) ListSceneRanges(ctx context.Context, in *pb.DaggerheartListSceneRangesRequest) (*pb.DaggerheartListSceneRangesResponse, error) {
+	if in == nil {
+		return nil, status.Error(codes.InvalidArgument, "list scene ranges request is required")
+	}
+	if s.stores.Daggerheart == nil {
+		return nil, status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	campaignID := strings.TrimSpace(in.GetCampaignId())
+	if campaignID == "" {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+	sessionID := strings.TrimSpace(in.GetSessionId())
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+	entityID := strings.TrimSpace(in.GetEntityId())
+
+	records, err := s.stores.Daggerheart.ListDaggerheartSceneRanges(ctx, campaignID, sessionID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list scene ranges: %v", err)
+	}
+	ranges := make([]*pb.DaggerheartSceneRange, 0, len(records))
+	for _, record := range records {
+		if entityID != "" && record.FromID != entityID && record.ToID != entityID {
+			continue
+		}
+		ranges = append(ranges, daggerheartSceneRangeToProto(daggerheart.SceneRange{
+			FromID:   record.FromID,
+			FromType: record.FromType,
+			ToID:     record.ToID,
+			ToType:   record.ToType,
+			Band:     record.Band,
+		}))
+	}
+	return &pb.DaggerheartListSceneRangesResponse{Ranges: ranges}, nil
+}
+
+func (s *DaggerheartService) requireSessionStores() error {
+	if s.stores.Campaign == nil {
+		return status.Error(codes.Internal, "campaign store is not configured")
+	}
+	if s.stores.Session == nil {
+		return status.Error(codes.Internal, "session store is not configured")
+	}
+	if s.stores.Daggerheart == nil {
+		return status.Error(codes.Internal, "daggerheart store is not configured")
+	}
+	if s.stores.Event == nil {
+		return status.Error(codes.Internal, "event store is not configured")
+	}
+	return nil
+}
+
+// loadActiveSession checks that session state can change: the campaign runs
+// Daggerheart, the session is active and no gate is open. The subject names
+// the mechanic in the unsupported-system error.
+func (s *DaggerheartService) loadActiveSession(ctx context.Context, campaignID, sessionID, subject string) (campaign.Campaign, error) {
+	c, err := s.stores.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return campaign.Campaign{}, handleDomainError(err)
+	}
+	if err := campaign.ValidateCampaignOperation(c.Status, campaign.CampaignOpSessionAction); err != nil {
+		return campaign.Campaign{}, handleDomainError(err)
+	}
+	if c.System != commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART {
+		return campaign.Campaign{}, status.Errorf(codes.FailedPrecondition, "campaign system does not support daggerheart %s", subject)
+	}
+	sess, err := s.stores.Session.GetSession(ctx, campaignID, sessionID)
+	if err != nil {
+		return campaign.Campaign{}, handleDomainError(err)
+	}
+	if sess.Status != session.SessionStatusActive {
+		return campaign.Campaign{}, status.Error(codes.FailedPrecondition, "session is not active")
+	}
+	if err := s.ensureNoOpenSessionGate(ctx, campaignID, sessionID); err != nil {
+		return campaign.Campaign{}, err
+	}
+	return c, nil
+}
+
+// ensureSceneEntity checks that a positioned character or adversary exists in
+// the session. Zones are free-form and need no lookup.
+func (s *DaggerheartService) ensureSceneEntity(ctx context.Context, campaignID, sessionID, entityID, entityType string) error {
+	switch entityType {
+	case daggerheart.SceneEntityCharacter:
+		if _, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, entityID); err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return status.Errorf(codes.NotFound, "character %q not found", entityID)
+			}
+			return status.Errorf(codes.Internal, "load character profile: %v", err)
+		}
+	case daggerheart.SceneEntityAdversary:
+		if _, err := s.loadAdversaryForSession(ctx, campaignID, sessionID, entityID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *DaggerheartService) appendSessionEvent(ctx context.Context, c campaign.Campaign, sessionID string, eventType event.Type, entityType, entityID string, payloadJSON []byte) error {
+	stored, err := s.stores.Event.AppendEvent(ctx, event.Event{
+		CampaignID:    c.ID,
+		Timestamp:     time.Now().UTC(),
+		Type:          eventType,
+		SessionID:     sessionID,
+		RequestID:     grpcmeta.RequestIDFromContext(ctx),
+		InvocationID:  grpcmeta.InvocationIDFromContext(ctx),
+		ActorType:     event.ActorTypeSystem,
+		EntityType:    entityType,
+		EntityID:      entityID,
+		SystemID:      c.System.String(),
+		SystemVersion: daggerheart.SystemVersion,
+		PayloadJSON:   payloadJSON,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "append %s event: %v", eventType, err)
+	}
+	adapter := daggerheart.NewAdapter(s.stores.Daggerheart)
+	if err := adapter.ApplyEvent(ctx, stored); err != nil {
+		return status.Errorf(codes.Internal, "apply %s event: %v", eventType, err)
+	}
+	return nil
+}
+
+// weaponReach returns the normalized Range of a catalog weapon.
+func (s *DaggerheartService) weaponReach(ctx context.Context, weaponID string) (string, error) {
+	if s.stores.DaggerheartContent == nil {
+		return "", status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	weapon, err := s.stores.DaggerheartContent.GetDaggerheartWeapon(ctx, weaponID)
+	if err != nil {
+		return "", contentLookupError("weapon", weaponID, err)
+	}
+	reach, err := daggerheart.NormalizeRangeBand(weapon.Range)
+	if err != nil {
+		return "", status.Errorf(codes.FailedPrecondition, "weapon %q range %q is invalid", weaponID, weapon.Range)
+	}
+	return reach, nil
+}
+
+// ensureTargetInReach rejects attacks on targets positioned beyond the reach.
+// Unpositioned pairs are allowed so theater-of-the-mind play keeps working.
+func (s *DaggerheartService) ensureTargetInReach(ctx context.Context, campaignID, sessionID, attackerID, targetID, reach string) error {
+	current, err := s.stores.Daggerheart.GetDaggerheartSceneRange(ctx, campaignID, sessionID, attackerID, targetID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil
+		}
+		return status.Errorf(codes.Internal, "load scene range: %v", err)
+	}
+	if !daggerheart.RangeReaches(reach, current.Band) {
+		return status.Errorf(codes.FailedPrecondition, "target %q is at %s range, beyond %s reach", targetID, current.Band, reach)
+	}
+	return nil
+}
+
+func sceneEntityFromProto(entity *pb.DaggerheartSceneEntity) (string, string, error) {
+	if entity == nil {
+		return "", "", status.Error(codes.InvalidArgument, "scene entity is required")
+	}
+	entityID := strings.TrimSpace(entity.GetId())
+	if entityID == "" {
+		return "", "", status.Error(codes.InvalidArgument, "scene entity id is required")
+	}
+	entityType, err := daggerheartSceneEntityTypeFromProto(entity.GetType())
+	if err != nil {
+		return "", "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	return entityID, entityType, nil
+}
+
+func sceneRangesToProto(ranges []daggerheart.SceneRange) []*pb.DaggerheartSceneRange {
+	result := make([]*pb.DaggerheartSceneRange, 0, len(ranges))
+	for _, sceneRange := range ranges {
+		result = append(result, daggerheartSceneRangeToProto(sceneRange))
+	}
+	return result
+}
+
+func daggerheartSceneRangeToProto(sceneRange daggerheart.SceneRange) *pb.DaggerheartSceneRange {
+	return &pb.DaggerheartSceneRange{
+		From: &pb.DaggerheartSceneEntity{Id: sceneRange.FromID, Type: daggerheartSceneEntityTypeToProto(sceneRange.FromType)},
+		To:   &pb.DaggerheartSceneEntity{Id: sceneRange.ToID, Type: daggerheartSceneEntityTypeToProto(sceneRange.ToType)},
+		Band: daggerheartRangeBandToProto(sceneRange.Band),
+	}
+}
+
+func daggerheartRangeBandFromProto(band pb.DaggerheartRangeBand) (string, error) {
+	switch band {
+	case pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_MELEE:
+		return daggerheart.RangeMelee, nil
+	case pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_VERY_CLOSE:
+		return daggerheart.RangeVeryClose, nil
+	case pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_CLOSE:
+		return daggerheart.RangeClose, nil
+	case pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_FAR:
+		return daggerheart.RangeFar, nil
+	case pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_VERY_FAR:
+		return daggerheart.RangeVeryFar, nil
+	case pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_OUT_OF_RANGE:
+		return daggerheart.RangeOutOfRange, nil
+	case pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_UNSPECIFIED:
+		return "", fmt.Errorf("range band is required")
+	default:
+		return "", fmt.Errorf("range band %v is invalid", band)
+	}
+}
+
+func daggerheartRangeBandToProto(band string) pb.DaggerheartRangeBand {
+	switch band {
+	case daggerheart.RangeMelee:
+		return pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_MELEE
+	case daggerheart.RangeVeryClose:
+		return pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_VERY_CLOSE
+	case daggerheart.RangeClose:
+		return pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_CLOSE
+	case daggerheart.RangeFar:
+		return pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_FAR
+	case daggerheart.RangeVeryFar:
+		return pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_VERY_FAR
+	case daggerheart.RangeOutOfRange:
+		return pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_OUT_OF_RANGE
+	default:
+		return pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_UNSPECIFIED
+	}
+}
+
+func daggerheartSceneEntityTypeFromProto(entityType pb.DaggerheartSceneEntityType) (string, error) {
+	switch entityType {
+	case pb.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_CHARACTER:
+		return daggerheart.SceneEntityCharacter, nil
+	case pb.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ADVERSARY:
+		return daggerheart.SceneEntityAdversary, nil
+	case pb.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ZONE:
+		return daggerheart.SceneEntityZone, nil
+	case pb.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_UNSPECIFIED:
+		return "", fmt.Errorf("scene entity type is required")
+	default:
+		return "", fmt.Errorf("scene entity type %v is invalid", entityType)
+	}
+}
+
+func daggerheartSceneEntityTypeToProto(entityType string) pb.DaggerheartSceneEntityType {
+	switch entityType {
+	case daggerheart.SceneEntityCharacter:
+		return pb.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_CHARACTER
+	case daggerheart.SceneEntityAdversary:
+		return pb.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ADVERSARY
+	case daggerheart.SceneEntityZone:
+		return pb.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ZONE
+	default:
+		return pb.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_UNSPECIFIED
+	}
+}
+
+func daggerheartMovementKindFromProto(kind pb.DaggerheartMovementKind) (string, error) {
+	switch kind {
+	case pb.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_MOVE:
+		return daggerheart.MovementKindMove, nil
+	case pb.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_KNOCKBACK:
+		return daggerheart.MovementKindKnockback, nil
+	case pb.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_TELEPORT:
+		return daggerheart.MovementKindTeleport, nil
+	case pb.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_FORCED:
+		return daggerheart.MovementKindForced, nil
+	case pb.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_UNSPECIFIED:
+		return "", fmt.Errorf("movement kind is required")
+	default:
+		return "", fmt.Errorf("movement kind %v is invalid", kind)
+	}
+}
@@ -0,0 +1,267 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+)
+
+func newSceneTestService() *DaggerheartService {
+	svc := newMultiAttackTestService()
+	content := newFakeContentStore()
+	content.weapons["weapon.dagger"] = storage.DaggerheartWeapon{ID: "weapon.dagger", Name: "Dagger", Range: "melee"}
+	content.weapons["weapon.longbow"] = storage.DaggerheartWeapon{ID: "weapon.longbow", Name: "Longbow", Range: "Very Far"}
+	svc.stores.DaggerheartContent = content
+	return svc
+}
+
+func sceneCharacter(id string) *pb.DaggerheartSceneEntity {
+	return &pb.DaggerheartSceneEntity{Id: id, Type: pb.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_CHARACTER}
+}
+
+func sceneAdversary(id string) *pb.DaggerheartSceneEntity {
+	return &pb.DaggerheartSceneEntity{Id: id, Type: pb.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ADVERSARY}
+}
+
+func TestDaggerheartRangeBandFromProto(t *testing.T) {
+	if _, err := daggerheartRangeBandFromProto(pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_UNSPECIFIED); err == nil {
+		t.Fatal("expected error for unspecified band")
+	}
+	band, err := daggerheartRangeBandFromProto(pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_VERY_CLOSE)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if band != daggerheart.RangeVeryClose {
+		t.Fatalf("expected very_close, got %s", band)
+	}
+	if daggerheartRangeBandToProto("unknown") != pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_UNSPECIFIED {
+		t.Fatal("expected unspecified for unknown band")
+	}
+}
+
+func TestSetSceneRanges_MissingStores(t *testing.T) {
+	svc := &DaggerheartService{}
+	_, err := svc.SetSceneRanges(context.Background(), &pb.DaggerheartSetSceneRangesRequest{})
+	assertStatusCode(t, err, codes.Internal)
+}
+
+func TestSetSceneRanges_InvalidRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []*pb.DaggerheartSceneRange
+		code   codes.Code
+	}{
+		{name: "no ranges", code: codes.InvalidArgument},
+		{
+			name:   "same entity",
+			ranges: []*pb.DaggerheartSceneRange{{From: sceneCharacter("char-1"), To: sceneCharacter("char-1"), Band: pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_CLOSE}},
+			code:   codes.InvalidArgument,
+		},
+		{
+			name:   "missing band",
+			ranges: []*pb.DaggerheartSceneRange{{From: sceneCharacter("char-1"), To: sceneAdversary("adv-1")}},
+			code:   codes.InvalidArgument,
+		},
+		{
+			name:   "unknown adversary",
+			ranges: []*pb.DaggerheartSceneRange{{From: sceneCharacter("char-1"), To: sceneAdversary("adv-9"), Band: pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_CLOSE}},
+			code:   codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newSceneTestService()
+			_, err := svc.SetSceneRanges(context.Background(), &pb.DaggerheartSetSceneRangesRequest{
+				CampaignId: "camp-1",
+				SessionId:  "sess-1",
+				Ranges:     tt.ranges,
+			})
+			assertStatusCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestSetSceneRanges_StoresRanges(t *testing.T) {
+	svc := newSceneTestService()
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-scene-1")
+	_, err := svc.SetSceneRanges(ctx, &pb.DaggerheartSetSceneRangesRequest{
+		CampaignId: "camp-1",
+		SessionId:  "sess-1",
+		Ranges: []*pb.DaggerheartSceneRange{
+			{From: sceneCharacter("char-1"), To: sceneAdversary("adv-1"), Band: pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_MELEE},
+			{From: sceneCharacter("char-1"), To: &pb.DaggerheartSceneEntity{Id: "bridge", Type: pb.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ZONE}, Band: pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_FAR},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SetSceneRanges returned error: %v", err)
+	}
+
+	listed, err := svc.ListSceneRanges(ctx, &pb.DaggerheartListSceneRangesRequest{CampaignId: "camp-1", SessionId: "sess-1", EntityId: "adv-1"})
+	if err != nil {
+		t.Fatalf("ListSceneRanges returned error: %v", err)
+	}
+	if len(listed.GetRanges()) != 1 || listed.GetRanges()[0].GetBand() != pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_MELEE {
+		t.Fatalf("listed ranges = %v, want one melee range", listed.GetRanges())
+	}
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	events := eventStore.events["camp-1"]
+	if len(events) != 1 || events[0].Type != daggerheart.EventTypeSceneRangesSet {
+		t.Fatalf("events = %v, want one scene.ranges_set", events)
+	}
+}
+
+func TestMoveSceneEntity_KnockbackPushesBands(t *testing.T) {
+	svc := newSceneTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	if err := dhStore.PutDaggerheartSceneRange(context.Background(), storage.DaggerheartSceneRange{
+		CampaignID: "camp-1", SessionID: "sess-1",
+		FromID: "adv-1", FromType: daggerheart.SceneEntityAdversary,
+		ToID: "char-1", ToType: daggerheart.SceneEntityCharacter,
+		Band: daggerheart.RangeMelee,
+	}); err != nil {
+		t.Fatalf("seed scene range: %v", err)
+	}
+
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-scene-move")
+	resp, err := svc.MoveSceneEntity(ctx, &pb.DaggerheartMoveSceneEntityRequest{
+		CampaignId: "camp-1",
+		SessionId:  "sess-1",
+		Entity:     sceneAdversary("adv-1"),
+		Kind:       pb.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_KNOCKBACK,
+		Source:     sceneCharacter("char-1"),
+		PushBands:  2,
+		Reason:     "shield bash",
+	})
+	if err != nil {
+		t.Fatalf("MoveSceneEntity returned error: %v", err)
+	}
+	if len(resp.GetRanges()) != 1 || resp.GetRanges()[0].GetBand() != pb.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_CLOSE {
+		t.Fatalf("ranges = %v, want close", resp.GetRanges())
+	}
+	stored, err := dhStore.GetDaggerheartSceneRange(context.Background(), "camp-1", "sess-1", "char-1", "adv-1")
+	if err != nil {
+		t.Fatalf("load scene range: %v", err)
+	}
+	if stored.Band != daggerheart.RangeClose {
+		t.Fatalf("stored band = %s, want close", stored.Band)
+	}
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	events := eventStore.events["camp-1"]
+	if len(events) != 1 || events[0].Type != daggerheart.EventTypeSceneEntityMoved {
+		t.Fatalf("events = %v, want one scene.entity_moved", events)
+	}
+	var payload daggerheart.SceneEntityMovedPayload
+	if err := json.Unmarshal(events[0].PayloadJSON, &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if payload.Kind != daggerheart.MovementKindKnockback || payload.SourceID != "char-1" || payload.Reason != "shield bash" {
+		t.Fatalf("payload = %+v, want knockback from char-1", payload)
+	}
+}
+
+func TestMoveSceneEntity_InvalidRequests(t *testing.T) {
+	svc := newSceneTestService()
+	_, err := svc.MoveSceneEntity(context.Background(), &pb.DaggerheartMoveSceneEntityRequest{
+		CampaignId: "camp-1",
+		SessionId:  "sess-1",
+		Entity:     sceneAdversary("adv-1"),
+		Kind:       pb.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_KNOCKBACK,
+		PushBands:  1,
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	_, err = svc.MoveSceneEntity(context.Background(), &pb.DaggerheartMoveSceneEntityRequest{
+		CampaignId: "camp-1",
+		SessionId:  "sess-1",
+		Entity:     sceneAdversary("adv-1"),
+		Kind:       pb.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_MOVE,
+		PushBands:  1,
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	_, err = svc.MoveSceneEntity(context.Background(), &pb.DaggerheartMoveSceneEntityRequest{
+		CampaignId: "camp-1",
+		SessionId:  "sess-1",
+		Entity:     sceneAdversary("adv-1"),
+		Kind:       pb.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_KNOCKBACK,
+		Source:     sceneCharacter("char-1"),
+		PushBands:  1,
+	})
+	assertStatusCode(t, err, codes.FailedPrecondition)
+}
+
+func TestSessionAttackFlow_WeaponOutOfReach(t *testing.T) {
+	svc := newSceneTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	if err := dhStore.PutDaggerheartSceneRange(context.Background(), storage.DaggerheartSceneRange{
+		CampaignID: "camp-1", SessionID: "sess-1",
+		FromID: "char-1", FromType: daggerheart.SceneEntityCharacter,
+		ToID: "char-2", ToType: daggerheart.SceneEntityCharacter,
+		Band: daggerheart.RangeFar,
+	}); err != nil {
+		t.Fatalf("seed scene range: %v", err)
+	}
+
+	req := &pb.SessionAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Trait:       "finesse",
+		Difficulty:  10,
+		TargetId:    "char-2",
+		WeaponId:    "weapon.dagger",
+		Damage:      &pb.DaggerheartAttackDamageSpec{DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL},
+		DamageDice:  []*pb.DiceSpec{{Sides: 6, Count: 1}},
+	}
+	_, err := svc.SessionAttackFlow(context.Background(), req)
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	req.WeaponId = "weapon.missing"
+	_, err = svc.SessionAttackFlow(context.Background(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	eventStore := svc.stores.Event.(*fakeEventStore)
+	if len(eventStore.events["camp-1"]) != 0 {
+		t.Fatalf("expected no events, got %d", len(eventStore.events["camp-1"]))
+	}
+}
+
+func TestSessionMultiAttackFlow_WeaponReach(t *testing.T) {
+	svc := newSceneTestService()
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	if err := dhStore.PutDaggerheartSceneRange(context.Background(), storage.DaggerheartSceneRange{
+		CampaignID: "camp-1", SessionID: "sess-1",
+		FromID: "adv-2", FromType: daggerheart.SceneEntityAdversary,
+		ToID: "char-1", ToType: daggerheart.SceneEntityCharacter,
+		Band: daggerheart.RangeFar,
+	}); err != nil {
+		t.Fatalf("seed scene range: %v", err)
+	}
+
+	req := &pb.SessionMultiAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Trait:       "agility",
+		Targets:     []*pb.MultiAttackTarget{{TargetId: "adv-1"}, {TargetId: "adv-2"}},
+		WeaponId:    "weapon.dagger",
+		Damage:      &pb.DaggerheartAttackDamageSpec{DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL},
+		DamageDice:  []*pb.DiceSpec{{Sides: 6, Count: 1}},
+	}
+	_, err := svc.SessionMultiAttackFlow(context.Background(), req)
+	assertStatusCode(t, err, codes.FailedPrecondition)
+
+	req.WeaponId = "weapon.longbow"
+	ctx := grpcmeta.WithRequestID(context.Background(), "req-multi-reach")
+	if _, err := svc.SessionMultiAttackFlow(ctx, req); err != nil {
+		t.Fatalf("SessionMultiAttackFlow returned error: %v", err)
+	}
+}
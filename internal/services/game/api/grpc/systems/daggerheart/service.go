@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
 	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
@@ -56,12 +57,18 @@ func (s *DaggerheartService) ActionRoll(ctx context.Context, in *pb.ActionRollRe
 		difficulty = &value
 	}
 
+	sources, err := advantageSourcesFromProto(in.GetAdvantageSources())
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := daggerheartdomain.RollAction(daggerheartdomain.ActionRequest{
 		Modifier:     int(in.GetModifier()),
 		Difficulty:   difficulty,
 		Seed:         seed,
 		Advantage:    int(in.GetAdvantage()),
 		Disadvantage: int(in.GetDisadvantage()),
+		Sources:      sources,
 	})
 	if err != nil {
 		if errors.Is(err, daggerheartdomain.ErrInvalidDifficulty) {
@@ -149,11 +156,27 @@ func (s *DaggerheartService) DualityExplain(ctx context.Context, in *pb.DualityE
 		difficulty = &value
 	}
 
+	sources, err := advantageSourcesFromProto(in.GetAdvantageSources())
+	if err != nil {
+		return nil, err
+	}
+	helpDice, err := helpDiceFromProto(in.GetHelpDice())
+	if err != nil {
+		return nil, err
+	}
+	experiences, err := rollExperiencesFromProto(in.GetExperiences())
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := daggerheartdomain.ExplainOutcome(daggerheartdomain.OutcomeRequest{
-		Hope:       int(in.GetHope()),
-		Fear:       int(in.GetFear()),
-		Modifier:   int(in.GetModifier()),
-		Difficulty: difficulty,
+		Hope:             int(in.GetHope()),
+		Fear:             int(in.GetFear()),
+		Modifier:         int(in.GetModifier()),
+		Difficulty:       difficulty,
+		AdvantageSources: sources,
+		HelpDice:         helpDice,
+		Experiences:      experiences,
 	})
 	if err != nil {
 		if errors.Is(err, daggerheartdomain.ErrInvalidDifficulty) || errors.Is(err, daggerheartdomain.ErrInvalidDualityDie) {
@@ -402,6 +425,95 @@ func outcomeToProto(outcome daggerheartdomain.Outcome) pb.Outcome {
 	}
 }
 
+// advantageSourcesFromProto maps named advantage sources to the domain.
+func advantageSourcesFromProto(sources []*pb.AdvantageSource) ([]daggerheartdomain.AdvantageSource, error) {
+	if len(sources) == 0 {
+		return nil, nil
+	}
+	converted := make([]daggerheartdomain.AdvantageSource, 0, len(sources))
+	for _, source := range sources {
+		if source == nil {
+			continue
+		}
+		name := strings.TrimSpace(source.GetName())
+		if name == "" {
+			return nil, status.Error(codes.InvalidArgument, "advantage source name is required")
+		}
+		var kind daggerheartdomain.AdvantageSourceKind
+		switch source.GetKind() {
+		case pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_CONDITION:
+			kind = daggerheartdomain.AdvantageSourceCondition
+		case pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_TARGET:
+			kind = daggerheartdomain.AdvantageSourceTarget
+		case pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_FEATURE:
+			kind = daggerheartdomain.AdvantageSourceFeature
+		case pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_GM_RULING:
+			kind = daggerheartdomain.AdvantageSourceGMRuling
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "advantage source %q kind is required", name)
+		}
+		converted = append(converted, daggerheartdomain.AdvantageSource{
+			Kind:         kind,
+			Name:         name,
+			Disadvantage: source.GetDisadvantage(),
+		})
+	}
+	return converted, nil
+}
+
+// helpDiceFromProto validates Help an Ally d6 results for explanation.
+func helpDiceFromProto(dice []int32) ([]int, error) {
+	if len(dice) == 0 {
+		return nil, nil
+	}
+	converted := make([]int, 0, len(dice))
+	for _, die := range dice {
+		if die < 1 || die > 6 {
+			return nil, status.Errorf(codes.InvalidArgument, "help die %d must be between 1 and 6", die)
+		}
+		converted = append(converted, int(die))
+	}
+	return converted, nil
+}
+
+// rollExperiencesFromProto converts the Experiences spent on a roll.
+func rollExperiencesFromProto(experiences []*pb.DaggerheartExperience) ([]daggerheartdomain.RollExperience, error) {
+	if len(experiences) == 0 {
+		return nil, nil
+	}
+	converted := make([]daggerheartdomain.RollExperience, 0, len(experiences))
+	for _, experience := range experiences {
+		if experience == nil {
+			continue
+		}
+		name := strings.TrimSpace(experience.GetName())
+		if name == "" {
+			return nil, status.Error(codes.InvalidArgument, "experience name is required")
+		}
+		converted = append(converted, daggerheartdomain.RollExperience{
+			Name:     name,
+			Modifier: int(experience.GetModifier()),
+		})
+	}
+	return converted, nil
+}
+
+// advantageSourcesPayload encodes pooled sources for roll event payloads.
+func advantageSourcesPayload(sources []daggerheartdomain.AdvantageSource) []map[string]any {
+	if len(sources) == 0 {
+		return nil
+	}
+	entries := make([]map[string]any, 0, len(sources))
+	for _, source := range sources {
+		entries = append(entries, map[string]any{
+			"kind":         string(source.Kind),
+			"name":         source.Name,
+			"disadvantage": source.Disadvantage,
+		})
+	}
+	return entries
+}
+
 // int32Slice converts a slice of ints to a slice of int32.
 func int32Slice(values []int) []int32 {
 	if len(values) == 0 {
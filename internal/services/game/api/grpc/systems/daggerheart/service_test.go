@@ -159,6 +159,34 @@ func TestDualityOutcomeReturnsResults(t *testing.T) {
 	})
 }
 
+func TestActionRollAdvantageSourcesCancel(t *testing.T) {
+	seed := uint64(77)
+	server := newTestService(11)
+
+	response, err := server.ActionRoll(context.Background(), &pb.ActionRollRequest{
+		Modifier:     1,
+		Disadvantage: 1,
+		AdvantageSources: []*pb.AdvantageSource{
+			{Kind: pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_CONDITION, Name: "Hidden"},
+		},
+		Rng: &commonv1.RngRequest{
+			Seed:     &seed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+	})
+	if err != nil {
+		t.Fatalf("ActionRoll returned error: %v", err)
+	}
+	if response.GetAdvantageDie() != 0 {
+		t.Fatalf("advantage_die = %d, want 0 after cancellation", response.GetAdvantageDie())
+	}
+
+	_, err = server.ActionRoll(context.Background(), &pb.ActionRollRequest{
+		AdvantageSources: []*pb.AdvantageSource{{Name: "Hidden"}},
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
 func TestDualityExplainRejectsNilRequest(t *testing.T) {
 	server := newTestService(42)
 
@@ -188,6 +216,70 @@ func TestDualityExplainReturnsExplanation(t *testing.T) {
 	})
 }
 
+func TestDualityExplainAdvantageSources(t *testing.T) {
+	server := newTestService(42)
+
+	response, err := server.DualityExplain(context.Background(), &pb.DualityExplainRequest{
+		Hope:     6,
+		Fear:     3,
+		Modifier: 4,
+		AdvantageSources: []*pb.AdvantageSource{
+			{Kind: pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_TARGET, Name: "Vulnerable"},
+			{Kind: pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_GM_RULING, Name: "Darkness", Disadvantage: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("DualityExplain returned error: %v", err)
+	}
+	stepCodes := make([]string, 0, len(response.GetSteps()))
+	for _, step := range response.GetSteps() {
+		stepCodes = append(stepCodes, step.GetCode())
+	}
+	if len(stepCodes) != 8 || stepCodes[1] != "ADVANTAGE_SOURCE" || stepCodes[3] != "POOL_ADVANTAGE" {
+		t.Fatalf("steps = %v", stepCodes)
+	}
+	if name := response.GetSteps()[1].GetData().GetFields()["name"].GetStringValue(); name != "Vulnerable" {
+		t.Fatalf("first source name = %q, want Vulnerable", name)
+	}
+}
+
+func TestDualityExplainHelpDiceAndExperiences(t *testing.T) {
+	server := newTestService(42)
+
+	response, err := server.DualityExplain(context.Background(), &pb.DualityExplainRequest{
+		Hope:        6,
+		Fear:        3,
+		Modifier:    7,
+		HelpDice:    []int32{5},
+		Experiences: []*pb.DaggerheartExperience{{Name: "Stealth", Modifier: 2}},
+	})
+	if err != nil {
+		t.Fatalf("DualityExplain returned error: %v", err)
+	}
+	stepCodes := make([]string, 0, len(response.GetSteps()))
+	for _, step := range response.GetSteps() {
+		stepCodes = append(stepCodes, step.GetCode())
+	}
+	if len(stepCodes) != 9 || stepCodes[1] != "SPEND_EXPERIENCE" || stepCodes[2] != "ADVANTAGE_SOURCE" || stepCodes[4] != "HELP_DIE" {
+		t.Fatalf("steps = %v", stepCodes)
+	}
+	if modifier := response.GetSteps()[1].GetData().GetFields()["modifier"].GetNumberValue(); modifier != 2 {
+		t.Fatalf("experience modifier = %v, want 2", modifier)
+	}
+	if message := response.GetSteps()[4].GetMessage(); message != "Help die kept as advantage" {
+		t.Fatalf("help die message = %q", message)
+	}
+
+	_, err = server.DualityExplain(context.Background(), &pb.DualityExplainRequest{Hope: 6, Fear: 3, HelpDice: []int32{7}})
+	assertStatusCode(t, err, codes.InvalidArgument)
+	_, err = server.DualityExplain(context.Background(), &pb.DualityExplainRequest{
+		Hope:        6,
+		Fear:        3,
+		Experiences: []*pb.DaggerheartExperience{{Modifier: 2}},
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
 func TestMechanicsOutcomeConsistency(t *testing.T) {
 	server := newTestService(42)
 
@@ -15,6 +15,10 @@ type Stores struct {
 	Daggerheart        storage.DaggerheartStore
 	DaggerheartContent storage.DaggerheartContentStore
 	Event              storage.EventStore
+	// Checkpoint and ProjectionState, when both set, let applied events save
+	// projection checkpoints at the checkpoint interval.
+	Checkpoint      storage.ProjectionCheckpointStore
+	ProjectionState storage.ProjectionStateStore
 }
 
 // Applier returns a projection Applier wired to the stores in this bundle.
@@ -28,5 +32,11 @@ func (s Stores) Applier() projection.Applier {
 		SessionGate:      s.SessionGate,
 		SessionSpotlight: s.SessionSpotlight,
 		Daggerheart:      s.Daggerheart,
+		Checkpoints: projection.Checkpoints{
+			Store:    s.Checkpoint,
+			State:    s.ProjectionState,
+			Interval: projection.DefaultCheckpointInterval,
+		},
+		Event: s.Event,
 	}
 }
@@ -0,0 +1,141 @@
+package daggerheart
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// attackWeapon is a catalog weapon resolved for the character wielding it, or
+// the natural attack of the beastform the character is transformed into.
+type attackWeapon struct {
+	id          string
+	trait       string
+	traitValue  int
+	reach       string
+	dice        []daggerheart.DamageDieSpec
+	damageBonus int
+	damageType  pb.DaggerheartDamageType
+	beastformID string
+}
+
+// diceToProto returns the weapon's Proficiency-scaled damage dice.
+func (w *attackWeapon) diceToProto() []*pb.DiceSpec {
+	specs := make([]*pb.DiceSpec, 0, len(w.dice))
+	for _, spec := range w.dice {
+		specs = append(specs, &pb.DiceSpec{Sides: int32(spec.Sides), Count: int32(spec.Count)})
+	}
+	return specs
+}
+
+// resolveAttackWeapon loads the weapon a character attacks with: the requested
+// catalog weapon, or else the weapon equipped in slot. It returns nil when no
+// weapon is requested and the slot is empty. A character in beastform cannot
+// use weapons and attacks with the beastform instead.
+func (s *DaggerheartService) resolveAttackWeapon(ctx context.Context, campaignID, characterID, weaponID string, slot pb.DaggerheartEquipSlot) (*attackWeapon, error) {
+	form, err := s.beastformForAttack(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	if form != nil {
+		if weaponID != "" {
+			return nil, status.Error(codes.FailedPrecondition, "characters in beastform cannot attack with weapons")
+		}
+		return form, nil
+	}
+	if weaponID == "" {
+		equipped, err := s.equippedWeaponID(ctx, campaignID, characterID, slot)
+		if err != nil {
+			return nil, err
+		}
+		if equipped == "" {
+			return nil, nil
+		}
+		weaponID = equipped
+	}
+	if s.stores.DaggerheartContent == nil {
+		return nil, status.Error(codes.Internal, "daggerheart content store is not configured")
+	}
+	weapon, err := s.stores.DaggerheartContent.GetDaggerheartWeapon(ctx, weaponID)
+	if err != nil {
+		return nil, contentLookupError("weapon", weaponID, err)
+	}
+	reach, err := daggerheart.NormalizeRangeBand(weapon.Range)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "weapon %q range %q is invalid", weaponID, weapon.Range)
+	}
+	damageType := damageTypeToProto(weapon.DamageType)
+	if damageType == pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_UNSPECIFIED {
+		return nil, status.Errorf(codes.FailedPrecondition, "weapon %q damage type %q is invalid", weaponID, weapon.DamageType)
+	}
+	if len(weapon.DamageDice) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "weapon %q has no damage dice", weaponID)
+	}
+
+	profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	trait := strings.ToLower(strings.TrimSpace(weapon.Trait))
+	traitValue, _ := daggerheart.TraitValue(progressionFromProfile(profile).Traits, trait)
+	weaponDice := make([]daggerheart.DamageDieSpec, 0, len(weapon.DamageDice))
+	for _, die := range weapon.DamageDice {
+		weaponDice = append(weaponDice, daggerheart.DamageDieSpec{Sides: die.Sides, Count: die.Count})
+	}
+
+	return &attackWeapon{
+		id:         weapon.ID,
+		trait:      trait,
+		traitValue: traitValue,
+		reach:      reach,
+		dice:       daggerheart.WeaponDamageDice(weaponDice, profile.Proficiency),
+		damageType: damageType,
+	}, nil
+}
+
+// equippedWeaponID returns the weapon equipped in a weapon slot, defaulting to
+// the primary weapon. Characters without state wield nothing.
+func (s *DaggerheartService) equippedWeaponID(ctx context.Context, campaignID, characterID string, slot pb.DaggerheartEquipSlot) (string, error) {
+	state, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return "", nil
+		}
+		return "", status.Errorf(codes.Internal, "load character state: %v", err)
+	}
+	switch slot {
+	case pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED, pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_PRIMARY_WEAPON:
+		return state.EquippedPrimaryWeaponID, nil
+	case pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_SECONDARY_WEAPON:
+		return state.EquippedSecondaryWeaponID, nil
+	default:
+		return "", status.Error(codes.InvalidArgument, "weapon slot must be a weapon slot")
+	}
+}
+
+// beastformForAttack returns the beastform attack of a transformed character,
+// or nil when the character is not in beastform.
+func (s *DaggerheartService) beastformForAttack(ctx context.Context, campaignID, characterID string) (*attackWeapon, error) {
+	state, err := s.stores.Daggerheart.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, status.Errorf(codes.Internal, "load character state: %v", err)
+	}
+	entry, err := s.activeBeastform(ctx, state)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	profile, err := s.stores.Daggerheart.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+	if err != nil {
+		return nil, handleDomainError(err)
+	}
+	return beastformAttack(*entry, profile)
+}
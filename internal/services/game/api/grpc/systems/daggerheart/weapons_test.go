@@ -0,0 +1,178 @@
+package daggerheart
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+	"google.golang.org/grpc/codes"
+)
+
+// newWeaponTestService arms char-1 with a longsword at Proficiency 2.
+func newWeaponTestService() *DaggerheartService {
+	svc := newInventoryTestService()
+	content := svc.stores.DaggerheartContent.(*fakeContentStore)
+	content.weapons["weapon.longsword"] = storage.DaggerheartWeapon{
+		ID: "weapon.longsword", Name: "Longsword", Category: "primary", Trait: "Agility", Range: "melee",
+		DamageDice: []storage.DaggerheartDamageDie{{Sides: 10, Count: 1}}, DamageType: "physical", Burden: 2,
+	}
+	dhStore := svc.stores.Daggerheart.(*fakeDaggerheartAdversaryStore)
+	profile := dhStore.profiles["camp-1:char-1"]
+	profile.Proficiency = 2
+	profile.Agility = 2
+	dhStore.profiles["camp-1:char-1"] = profile
+	state := dhStore.states["camp-1:char-1"]
+	state.EquippedPrimaryWeaponID = "weapon.longsword"
+	dhStore.states["camp-1:char-1"] = state
+	return svc
+}
+
+func attackContext() context.Context {
+	return grpcmeta.WithRequestID(context.Background(), "req-weapon-attack")
+}
+
+func lastEventPayload(t *testing.T, svc *DaggerheartService, eventType string, payload any) {
+	t.Helper()
+	events := svc.stores.Event.(*fakeEventStore).events["camp-1"]
+	for i := len(events) - 1; i >= 0; i-- {
+		if string(events[i].Type) != eventType {
+			continue
+		}
+		if err := json.Unmarshal(events[i].PayloadJSON, payload); err != nil {
+			t.Fatalf("decode %s payload: %v", eventType, err)
+		}
+		return
+	}
+	t.Fatalf("expected %s event", eventType)
+}
+
+func TestSessionAttackFlow_UsesEquippedWeapon(t *testing.T) {
+	svc := newWeaponTestService()
+	resp, err := svc.SessionAttackFlow(attackContext(), &pb.SessionAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Difficulty:  1,
+		TargetId:    "char-2",
+	})
+	if err != nil {
+		t.Fatalf("SessionAttackFlow returned error: %v", err)
+	}
+	if resp.GetWeaponId() != "weapon.longsword" {
+		t.Fatalf("weapon id = %q, want weapon.longsword", resp.GetWeaponId())
+	}
+	rolls := resp.GetDamageRoll().GetRolls()
+	if len(rolls) != 1 || rolls[0].GetSides() != 10 || len(rolls[0].GetResults()) != 2 {
+		t.Fatalf("damage rolls = %v, want 2d10", rolls)
+	}
+	if resp.GetDamageRoll().GetWeaponId() != "weapon.longsword" {
+		t.Fatalf("damage roll weapon id = %q, want weapon.longsword", resp.GetDamageRoll().GetWeaponId())
+	}
+
+	var attack daggerheart.AttackResolvedPayload
+	lastEventPayload(t, svc, string(daggerheart.EventTypeAttackResolved), &attack)
+	if attack.WeaponID != "weapon.longsword" {
+		t.Fatalf("attack weapon id = %q, want weapon.longsword", attack.WeaponID)
+	}
+	var damage daggerheart.DamageRollResolvedPayload
+	lastEventPayload(t, svc, string(daggerheart.EventTypeDamageRollResolved), &damage)
+	if damage.WeaponID != "weapon.longsword" {
+		t.Fatalf("damage roll weapon id = %q, want weapon.longsword", damage.WeaponID)
+	}
+}
+
+func TestSessionAttackFlow_OverridesWeapon(t *testing.T) {
+	svc := newWeaponTestService()
+	resp, err := svc.SessionAttackFlow(attackContext(), &pb.SessionAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Trait:       "strength",
+		Difficulty:  1,
+		TargetId:    "char-2",
+		DamageDice:  []*pb.DiceSpec{{Sides: 4, Count: 1}},
+		Damage:      &pb.DaggerheartAttackDamageSpec{DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_MAGIC, SourceCharacterIds: []string{"char-1"}},
+	})
+	if err != nil {
+		t.Fatalf("SessionAttackFlow returned error: %v", err)
+	}
+	rolls := resp.GetDamageRoll().GetRolls()
+	if len(rolls) != 1 || rolls[0].GetSides() != 4 {
+		t.Fatalf("damage rolls = %v, want 1d4", rolls)
+	}
+	if resp.GetWeaponId() != "weapon.longsword" {
+		t.Fatalf("weapon id = %q, want weapon.longsword", resp.GetWeaponId())
+	}
+}
+
+func TestSessionAttackFlow_Improvised(t *testing.T) {
+	svc := newWeaponTestService()
+	req := &pb.SessionAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		Difficulty:  1,
+		TargetId:    "char-2",
+		Improvised:  true,
+	}
+	_, err := svc.SessionAttackFlow(attackContext(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	req.WeaponId = "weapon.longsword"
+	_, err = svc.SessionAttackFlow(attackContext(), req)
+	assertStatusCode(t, err, codes.InvalidArgument)
+
+	req.WeaponId = ""
+	req.Trait = "strength"
+	req.DamageDice = []*pb.DiceSpec{{Sides: 4, Count: 1}}
+	req.Damage = &pb.DaggerheartAttackDamageSpec{DamageType: pb.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_PHYSICAL, SourceCharacterIds: []string{"char-1"}}
+	resp, err := svc.SessionAttackFlow(attackContext(), req)
+	if err != nil {
+		t.Fatalf("SessionAttackFlow returned error: %v", err)
+	}
+	if resp.GetWeaponId() != "" {
+		t.Fatalf("weapon id = %q, want none", resp.GetWeaponId())
+	}
+}
+
+func TestSessionAttackFlow_InvalidWeaponSlot(t *testing.T) {
+	svc := newWeaponTestService()
+	_, err := svc.SessionAttackFlow(attackContext(), &pb.SessionAttackFlowRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+		TargetId:    "char-2",
+		WeaponSlot:  pb.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_ARMOR,
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
+
+func TestSessionDamageRoll_UsesEquippedWeapon(t *testing.T) {
+	svc := newWeaponTestService()
+	resp, err := svc.SessionDamageRoll(context.Background(), &pb.SessionDamageRollRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-1",
+	})
+	if err != nil {
+		t.Fatalf("SessionDamageRoll returned error: %v", err)
+	}
+	if resp.GetWeaponId() != "weapon.longsword" {
+		t.Fatalf("weapon id = %q, want weapon.longsword", resp.GetWeaponId())
+	}
+	rolls := resp.GetRolls()
+	if len(rolls) != 1 || rolls[0].GetSides() != 10 || len(rolls[0].GetResults()) != 2 {
+		t.Fatalf("damage rolls = %v, want 2d10", rolls)
+	}
+
+	_, err = svc.SessionDamageRoll(context.Background(), &pb.SessionDamageRollRequest{
+		CampaignId:  "camp-1",
+		SessionId:   "sess-1",
+		CharacterId: "char-2",
+	})
+	assertStatusCode(t, err, codes.InvalidArgument)
+}
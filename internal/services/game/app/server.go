@@ -8,6 +8,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"time"
 
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
 	statev1 "github.com/louisbranch/fracturing.space/api/gen/go/game/v1"
@@ -19,7 +20,10 @@ import (
 	"github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/interceptors"
 	grpcmeta "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/metadata"
 	daggerheartservice "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/systems/daggerheart"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/projection"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/core/random"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage/integrity"
 	storagesqlite "github.com/louisbranch/fracturing.space/internal/services/game/storage/sqlite"
 	"google.golang.org/grpc"
@@ -29,10 +33,12 @@ import (
 
 // serverEnv holds env-parsed configuration for the game server.
 type serverEnv struct {
-	AuthAddr          string `env:"FRACTURING_SPACE_AUTH_ADDR"                 envDefault:"localhost:8083"`
-	EventsDBPath      string `env:"FRACTURING_SPACE_GAME_EVENTS_DB_PATH"`
-	ProjectionsDBPath string `env:"FRACTURING_SPACE_GAME_PROJECTIONS_DB_PATH"`
-	ContentDBPath     string `env:"FRACTURING_SPACE_GAME_CONTENT_DB_PATH"`
+	AuthAddr          string        `env:"FRACTURING_SPACE_AUTH_ADDR"                 envDefault:"localhost:8083"`
+	EventsDBPath      string        `env:"FRACTURING_SPACE_GAME_EVENTS_DB_PATH"`
+	ProjectionsDBPath string        `env:"FRACTURING_SPACE_GAME_PROJECTIONS_DB_PATH"`
+	ContentDBPath     string        `env:"FRACTURING_SPACE_GAME_CONTENT_DB_PATH"`
+	IdempotencyTTL    time.Duration `env:"FRACTURING_SPACE_GAME_IDEMPOTENCY_TTL"      envDefault:"24h"`
+	IdempotencyLease  time.Duration `env:"FRACTURING_SPACE_GAME_IDEMPOTENCY_LEASE"    envDefault:"1m"`
 }
 
 func loadServerEnv() serverEnv {
@@ -105,6 +111,8 @@ func NewWithAddr(addr string) (*Server, error) {
 		_ = listener.Close()
 		return nil, err
 	}
+	eventBroker := event.NewBroker()
+	bundle.events.SetEventPublisher(eventBroker)
 	stores := gamegrpc.Stores{
 		Campaign:           bundle.projections,
 		Participant:        bundle.projections,
@@ -122,12 +130,19 @@ func NewWithAddr(addr string) (*Server, error) {
 		Snapshot:           bundle.projections,
 		CampaignFork:       bundle.projections,
 		DaggerheartContent: bundle.content,
+		Checkpoint:         bundle.events,
+		ProjectionState:    bundle.projections,
 	}
 	if err := stores.Validate(); err != nil {
 		_ = listener.Close()
 		bundle.Close()
 		return nil, fmt.Errorf("validate stores: %w", err)
 	}
+	if err := healProjections(context.Background(), bundle.events, stores); err != nil {
+		_ = listener.Close()
+		bundle.Close()
+		return nil, fmt.Errorf("heal projections: %w", err)
+	}
 
 	authConn, authClient, err := dialAuthGRPC(context.Background(), srvEnv.AuthAddr)
 	if err != nil {
@@ -140,7 +155,9 @@ func NewWithAddr(addr string) (*Server, error) {
 		grpc.ChainUnaryInterceptor(
 			grpcmeta.UnaryServerInterceptor(nil),
 			interceptors.TelemetryInterceptor(bundle.events),
+			interceptors.IdempotencyInterceptor(bundle.events, srvEnv.IdempotencyTTL, srvEnv.IdempotencyLease),
 			interceptors.SessionLockInterceptor(bundle.projections),
+			interceptors.EventSeqInterceptor(),
 		),
 		grpc.StreamInterceptor(grpcmeta.StreamServerInterceptor(nil)),
 	)
@@ -153,6 +170,8 @@ func NewWithAddr(addr string) (*Server, error) {
 		Daggerheart:        bundle.projections,
 		DaggerheartContent: bundle.content,
 		Event:              bundle.events,
+		Checkpoint:         bundle.events,
+		ProjectionState:    bundle.projections,
 	}
 	daggerheartService := daggerheartservice.NewDaggerheartService(daggerheartStores, random.NewSeed)
 	contentService := daggerheartservice.NewDaggerheartContentService(daggerheartStores)
@@ -163,7 +182,7 @@ func NewWithAddr(addr string) (*Server, error) {
 	snapshotService := gamegrpc.NewSnapshotService(stores)
 	sessionService := gamegrpc.NewSessionService(stores)
 	forkService := gamegrpc.NewForkService(stores)
-	eventService := gamegrpc.NewEventService(stores)
+	eventService := gamegrpc.NewEventServiceWithBroker(stores, eventBroker)
 	statisticsService := gamegrpc.NewStatisticsService(stores)
 	systemService := gamegrpc.NewSystemService(nil)
 	healthServer := health.NewServer()
@@ -355,6 +374,54 @@ func openContentStore(path string) (*storagesqlite.Store, error) {
 	return store, nil
 }
 
+// healProjections runs before the server accepts requests, while nothing else
+// writes to the stores. Campaigns with journal events but no projections are
+// rebuilt from their nearest checkpoint, and campaigns whose newest checkpoint
+// is a full interval behind the journal are checkpointed at their head.
+func healProjections(ctx context.Context, events *storagesqlite.Store, stores gamegrpc.Stores) error {
+	campaignIDs, err := events.ListEventCampaignIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("list event campaigns: %w", err)
+	}
+	checkpoints := stores.Checkpoints()
+	applier := stores.Applier()
+	for _, campaignID := range campaignIDs {
+		_, err := stores.Campaign.Get(ctx, campaignID)
+		if errors.Is(err, storage.ErrNotFound) {
+			result, err := projection.RebuildCampaign(ctx, stores.Event, applier, checkpoints, campaignID, 0)
+			if err != nil {
+				return fmt.Errorf("rebuild campaign %s: %w", campaignID, err)
+			}
+			log.Printf("rebuilt projections for campaign %s from seq %d through %d", campaignID, result.CheckpointSeq, result.LastSeq)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("get campaign %s: %w", campaignID, err)
+		}
+
+		headSeq, err := stores.Event.GetLatestEventSeq(ctx, campaignID)
+		if err != nil {
+			return fmt.Errorf("get latest seq for campaign %s: %w", campaignID, err)
+		}
+		var checkpointSeq uint64
+		checkpoint, err := checkpoints.Store.GetProjectionCheckpoint(ctx, campaignID, 0)
+		switch {
+		case err == nil:
+			checkpointSeq = checkpoint.Seq
+		case !errors.Is(err, storage.ErrNotFound):
+			return fmt.Errorf("get checkpoint for campaign %s: %w", campaignID, err)
+		}
+		if headSeq-checkpointSeq < checkpoints.Interval {
+			continue
+		}
+		// A missed checkpoint only slows the next rebuild down.
+		if err := checkpoints.Save(ctx, campaignID, headSeq); err != nil {
+			log.Printf("checkpoint campaign %s: %v", campaignID, err)
+		}
+	}
+	return nil
+}
+
 func ensureDir(path string) error {
 	if dir := filepath.Dir(path); dir != "." {
 		if err := os.MkdirAll(dir, 0o755); err != nil {
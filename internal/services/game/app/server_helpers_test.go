@@ -6,6 +6,9 @@ import (
 	"path/filepath"
 	"testing"
 	"time"
+
+	gamegrpc "github.com/louisbranch/fracturing.space/internal/services/game/api/grpc/game"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
 )
 
 func TestEnsureDirCreatesParent(t *testing.T) {
@@ -103,6 +106,60 @@ func TestOpenStorageBundleProjectionFailure(t *testing.T) {
 	}
 }
 
+func TestHealProjectionsRebuildsMissingCampaigns(t *testing.T) {
+	base := t.TempDir()
+	t.Setenv("FRACTURING_SPACE_GAME_EVENT_HMAC_KEY", "test-key")
+	events, err := openEventStore(filepath.Join(base, "events.db"))
+	if err != nil {
+		t.Fatalf("open event store: %v", err)
+	}
+	defer events.Close()
+	projections, err := openProjectionStore(filepath.Join(base, "projections.db"))
+	if err != nil {
+		t.Fatalf("open projection store: %v", err)
+	}
+	defer projections.Close()
+
+	ctx := context.Background()
+	if _, err := events.AppendEvent(ctx, event.Event{
+		CampaignID:  "camp-1",
+		Timestamp:   time.Date(2026, 2, 3, 12, 0, 0, 0, time.UTC),
+		Type:        event.TypeCampaignCreated,
+		ActorType:   event.ActorTypeSystem,
+		EntityType:  "campaign",
+		EntityID:    "camp-1",
+		PayloadJSON: []byte(`{"name":"Healed","locale":"en-US","game_system":"GAME_SYSTEM_DAGGERHEART","gm_mode":"HUMAN"}`),
+	}); err != nil {
+		t.Fatalf("append event: %v", err)
+	}
+
+	stores := gamegrpc.Stores{
+		Campaign:         projections,
+		Participant:      projections,
+		ClaimIndex:       projections,
+		Invite:           projections,
+		Character:        projections,
+		Daggerheart:      projections,
+		Session:          projections,
+		SessionGate:      projections,
+		SessionSpotlight: projections,
+		Event:            events,
+		CampaignFork:     projections,
+		Checkpoint:       events,
+		ProjectionState:  projections,
+	}
+	if err := healProjections(ctx, events, stores); err != nil {
+		t.Fatalf("heal projections: %v", err)
+	}
+	healed, err := projections.Get(ctx, "camp-1")
+	if err != nil {
+		t.Fatalf("campaign not rebuilt: %v", err)
+	}
+	if healed.Name != "Healed" {
+		t.Fatalf("campaign name = %q, want Healed", healed.Name)
+	}
+}
+
 func TestDialAuthGRPCTimeout(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
 	defer cancel()
@@ -0,0 +1,86 @@
+package event
+
+import "sync"
+
+// Publisher receives events after they are durably committed to the journal.
+type Publisher interface {
+	Publish(evt Event)
+}
+
+// Broker fans out commit notifications to live campaign subscribers.
+//
+// Subscribers are only told that new events exist; they read the events back
+// from the journal so live delivery always observes committed state and uses
+// the same ordering and filtering as paginated reads.
+type Broker struct {
+	mu   sync.Mutex
+	subs map[string]map[*Subscription]struct{}
+}
+
+// NewBroker creates an empty broker.
+func NewBroker() *Broker {
+	return &Broker{subs: make(map[string]map[*Subscription]struct{})}
+}
+
+// Subscription receives commit notifications for a single campaign.
+type Subscription struct {
+	broker     *Broker
+	campaignID string
+	notify     chan struct{}
+	once       sync.Once
+}
+
+// Subscribe registers a subscriber for committed events in a campaign.
+// Callers must Close the subscription when done.
+func (b *Broker) Subscribe(campaignID string) *Subscription {
+	sub := &Subscription{
+		broker:     b,
+		campaignID: campaignID,
+		// A single buffered slot coalesces bursts so Publish never blocks.
+		notify: make(chan struct{}, 1),
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	campaignSubs, ok := b.subs[campaignID]
+	if !ok {
+		campaignSubs = make(map[*Subscription]struct{})
+		b.subs[campaignID] = campaignSubs
+	}
+	campaignSubs[sub] = struct{}{}
+	return sub
+}
+
+// Publish notifies campaign subscribers that a committed event is available.
+func (b *Broker) Publish(evt Event) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subs[evt.CampaignID] {
+		select {
+		case sub.notify <- struct{}{}:
+		default:
+			// A notification is already pending; the subscriber will catch up.
+		}
+	}
+}
+
+// C returns the channel signaled when new events are committed.
+func (s *Subscription) C() <-chan struct{} {
+	return s.notify
+}
+
+// Close unregisters the subscription. It is safe to call more than once.
+func (s *Subscription) Close() {
+	s.once.Do(func() {
+		b := s.broker
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		campaignSubs := b.subs[s.campaignID]
+		delete(campaignSubs, s)
+		if len(campaignSubs) == 0 {
+			delete(b.subs, s.campaignID)
+		}
+	})
+}
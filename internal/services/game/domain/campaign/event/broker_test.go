@@ -0,0 +1,64 @@
+package event
+
+import "testing"
+
+func TestBrokerPublishNotifiesCampaignSubscribers(t *testing.T) {
+	broker := NewBroker()
+	sub := broker.Subscribe("camp-1")
+	defer sub.Close()
+	other := broker.Subscribe("camp-2")
+	defer other.Close()
+
+	broker.Publish(Event{CampaignID: "camp-1", Seq: 1})
+
+	select {
+	case <-sub.C():
+	default:
+		t.Fatal("expected notification for camp-1 subscriber")
+	}
+	select {
+	case <-other.C():
+		t.Fatal("unexpected notification for camp-2 subscriber")
+	default:
+	}
+}
+
+func TestBrokerPublishCoalescesPendingNotifications(t *testing.T) {
+	broker := NewBroker()
+	sub := broker.Subscribe("camp-1")
+	defer sub.Close()
+
+	for seq := uint64(1); seq <= 5; seq++ {
+		broker.Publish(Event{CampaignID: "camp-1", Seq: seq})
+	}
+
+	<-sub.C()
+	select {
+	case <-sub.C():
+		t.Fatal("expected a single coalesced notification")
+	default:
+	}
+}
+
+func TestBrokerCloseUnregisters(t *testing.T) {
+	broker := NewBroker()
+	sub := broker.Subscribe("camp-1")
+	sub.Close()
+	sub.Close()
+
+	broker.Publish(Event{CampaignID: "camp-1", Seq: 1})
+
+	select {
+	case <-sub.C():
+		t.Fatal("unexpected notification after close")
+	default:
+	}
+	if len(broker.subs) != 0 {
+		t.Fatalf("expected no campaign subscriptions, got %d", len(broker.subs))
+	}
+}
+
+func TestBrokerPublishNilBroker(t *testing.T) {
+	var broker *Broker
+	broker.Publish(Event{CampaignID: "camp-1"})
+}
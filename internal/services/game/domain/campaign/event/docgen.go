@@ -1,3 +1,3 @@
-//go:generate go run ../../../../../../internal/tools/eventdocgen -out docs/events/event-catalog.md
+//go:generate go run ../../../../../../internal/tools/eventdocgen -out docs/events/event-catalog.md -schemas internal/services/game/domain/campaign/event/payload_schemas.json
 
 package event
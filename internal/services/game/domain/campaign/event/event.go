@@ -147,6 +147,9 @@ type Event struct {
 	SystemVersion string
 	// PayloadJSON holds event-specific data as JSON.
 	PayloadJSON []byte
+	// PayloadVersion is the schema version of PayloadJSON. Zero on append means
+	// the current version of the event type; see Upcast.
+	PayloadVersion int
 }
 
 // IsValid reports whether the event type is usable.
@@ -59,6 +59,21 @@ func NormalizeForAppend(evt Event) (Event, error) {
 	if !json.Valid(evt.PayloadJSON) {
 		return Event{}, fmt.Errorf("payload json must be valid JSON")
 	}
+	current := CurrentPayloadVersion(evt.Type)
+	if evt.PayloadVersion == 0 {
+		evt.PayloadVersion = current
+	}
+	if evt.PayloadVersion < InitialPayloadVersion || evt.PayloadVersion > current {
+		return Event{}, fmt.Errorf("payload version must be between %d and %d", InitialPayloadVersion, current)
+	}
+	// Schemas describe current payloads, so older ones are checked upcasted.
+	upcasted, err := Upcast(evt)
+	if err != nil {
+		return Event{}, err
+	}
+	if err := ValidatePayload(evt.Type, upcasted.PayloadJSON); err != nil {
+		return Event{}, err
+	}
 
 	return evt, nil
 }
@@ -13,7 +13,7 @@ func TestNormalizeForAppend(t *testing.T) {
 			name: "defaults actor type and payload",
 			input: Event{
 				CampaignID:  "camp-1",
-				Type:        TypeCampaignCreated,
+				Type:        TypeSessionSpotlightCleared,
 				PayloadJSON: nil,
 			},
 			wantErr: false,
@@ -24,7 +24,20 @@ func TestNormalizeForAppend(t *testing.T) {
 				if string(evt.PayloadJSON) != "{}" {
 					t.Fatalf("PayloadJSON = %s, want {}", string(evt.PayloadJSON))
 				}
+				if evt.PayloadVersion != InitialPayloadVersion {
+					t.Fatalf("PayloadVersion = %d, want %d", evt.PayloadVersion, InitialPayloadVersion)
+				}
+			},
+		},
+		{
+			name: "rejects unknown payload version",
+			input: Event{
+				CampaignID:     "camp-1",
+				Type:           TypeCampaignCreated,
+				PayloadJSON:    []byte("{}"),
+				PayloadVersion: InitialPayloadVersion + 1,
 			},
+			wantErr: true,
 		},
 		{
 			name: "rejects invalid actor type",
@@ -115,6 +128,24 @@ func TestNormalizeForAppend(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "rejects payload missing a required field",
+			input: Event{
+				CampaignID:  "camp-1",
+				Type:        TypeCampaignCreated,
+				PayloadJSON: []byte(`{"name":"Camp","locale":"en-US","game_system":"GAME_SYSTEM_DAGGERHEART"}`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejects payload field of the wrong type",
+			input: Event{
+				CampaignID:  "camp-1",
+				Type:        TypeCharacterDeleted,
+				PayloadJSON: []byte(`{"character_id":7}`),
+			},
+			wantErr: true,
+		},
 		{
 			name: "accepts gm actor with actor id",
 			input: Event{
@@ -122,7 +153,7 @@ func TestNormalizeForAppend(t *testing.T) {
 				Type:        TypeCampaignCreated,
 				ActorType:   ActorTypeGM,
 				ActorID:     "gm-1",
-				PayloadJSON: []byte("{}"),
+				PayloadJSON: []byte(`{"name":"Camp","locale":"en-US","game_system":"GAME_SYSTEM_DAGGERHEART","gm_mode":"HUMAN"}`),
 			},
 			wantErr: false,
 			assertion: func(t *testing.T, evt Event) {
@@ -0,0 +1,219 @@
+package event
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// payloadSchemasJSON holds the JSON Schema of every core and game system
+// payload, generated from the payload structs by eventdocgen.
+//
+//go:embed payload_schemas.json
+var payloadSchemasJSON []byte
+
+// PayloadSchema is the JSON Schema of the current payload version of an event
+// type.
+type PayloadSchema struct {
+	Type Type
+	// SystemID is the game system that owns the event type; empty for core
+	// events.
+	SystemID       string
+	PayloadVersion int
+	Schema         json.RawMessage
+}
+
+// schemaNode is the JSON Schema subset eventdocgen emits.
+type schemaNode struct {
+	Type                 schemaTypes            `json:"type"`
+	Properties           map[string]*schemaNode `json:"properties"`
+	Required             []string               `json:"required"`
+	Items                *schemaNode            `json:"items"`
+	AdditionalProperties *schemaNode            `json:"additionalProperties"`
+}
+
+// schemaTypes accepts both the single and the list form of "type".
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = schemaTypes{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*t = list
+	return nil
+}
+
+type payloadSchemaEntry struct {
+	Type     Type            `json:"type"`
+	SystemID string          `json:"system_id"`
+	Schema   json.RawMessage `json:"schema"`
+	node     *schemaNode
+}
+
+var (
+	payloadSchemasOnce sync.Once
+	payloadSchemas     []payloadSchemaEntry
+	payloadSchemaIndex map[Type]*schemaNode
+	payloadSchemasErr  error
+)
+
+func loadPayloadSchemas() ([]payloadSchemaEntry, map[Type]*schemaNode, error) {
+	payloadSchemasOnce.Do(func() {
+		var file struct {
+			Events []payloadSchemaEntry `json:"events"`
+		}
+		if err := json.Unmarshal(payloadSchemasJSON, &file); err != nil {
+			payloadSchemasErr = fmt.Errorf("decode payload schemas: %w", err)
+			return
+		}
+		index := make(map[Type]*schemaNode, len(file.Events))
+		for i := range file.Events {
+			entry := &file.Events[i]
+			var node schemaNode
+			if err := json.Unmarshal(entry.Schema, &node); err != nil {
+				payloadSchemasErr = fmt.Errorf("decode %s payload schema: %w", entry.Type, err)
+				return
+			}
+			entry.node = &node
+			index[entry.Type] = &node
+		}
+		payloadSchemas = file.Events
+		payloadSchemaIndex = index
+	})
+	return payloadSchemas, payloadSchemaIndex, payloadSchemasErr
+}
+
+// PayloadSchemas returns the payload schemas of the event types owned by
+// systemID, sorted by type. An empty systemID selects the core events.
+func PayloadSchemas(systemID string) ([]PayloadSchema, error) {
+	entries, _, err := loadPayloadSchemas()
+	if err != nil {
+		return nil, err
+	}
+	systemID = strings.TrimSpace(systemID)
+	schemas := make([]PayloadSchema, 0)
+	for _, entry := range entries {
+		if entry.SystemID != systemID {
+			continue
+		}
+		schemas = append(schemas, PayloadSchema{
+			Type:           entry.Type,
+			SystemID:       entry.SystemID,
+			PayloadVersion: CurrentPayloadVersion(entry.Type),
+			Schema:         append(json.RawMessage(nil), entry.Schema...),
+		})
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Type < schemas[j].Type })
+	return schemas, nil
+}
+
+// ValidatePayload checks a payload at the current version of t against the
+// schema of t. Types without a schema accept any payload.
+func ValidatePayload(t Type, payload []byte) error {
+	_, index, err := loadPayloadSchemas()
+	if err != nil {
+		return err
+	}
+	node, ok := index[t]
+	if !ok {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return fmt.Errorf("payload json must be valid JSON")
+	}
+	if err := node.validate(value, "payload"); err != nil {
+		return fmt.Errorf("%s %w", t, err)
+	}
+	return nil
+}
+
+func (n *schemaNode) validate(value any, path string) error {
+	if len(n.Type) > 0 && !n.allows(value) {
+		return fmt.Errorf("%s must be %s", path, strings.Join(n.Type, " or "))
+	}
+	switch typed := value.(type) {
+	case map[string]any:
+		for _, name := range n.Required {
+			if _, ok := typed[name]; !ok {
+				return fmt.Errorf("%s.%s is required", path, name)
+			}
+		}
+		names := make([]string, 0, len(typed))
+		for name := range typed {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			child := n.Properties[name]
+			if child == nil {
+				child = n.AdditionalProperties
+			}
+			if child == nil {
+				continue
+			}
+			if err := child.validate(typed[name], path+"."+name); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if n.Items == nil {
+			return nil
+		}
+		for i, item := range typed {
+			if err := n.Items.validate(item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (n *schemaNode) allows(value any) bool {
+	for _, name := range n.Type {
+		switch name {
+		case "null":
+			if value == nil {
+				return true
+			}
+		case "string":
+			if _, ok := value.(string); ok {
+				return true
+			}
+		case "boolean":
+			if _, ok := value.(bool); ok {
+				return true
+			}
+		case "number":
+			if _, ok := value.(json.Number); ok {
+				return true
+			}
+		case "integer":
+			// Go decodes integer fields only from plain integer literals.
+			if number, ok := value.(json.Number); ok && !strings.ContainsAny(number.String(), ".eE") {
+				return true
+			}
+		case "object":
+			if _, ok := value.(map[string]any); ok {
+				return true
+			}
+		case "array":
+			if _, ok := value.([]any); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package event
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestValidatePayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     Type
+		payload string
+		wantErr string
+	}{
+		{"valid payload", TypeCharacterCreated, `{"character_id":"c1","name":"Aria","kind":"PC"}`, ""},
+		{"unknown properties allowed", TypeCharacterDeleted, `{"character_id":"c1","extra":true}`, ""},
+		{"optional field omitted", TypeSessionStarted, `{"session_id":"s1"}`, ""},
+		{"nullable slice", TypeRollResolved, `{"request_id":"r1","roll_seq":1,"results":null}`, ""},
+		{"type without schema", Type("test.unschematized"), `[1,2]`, ""},
+		{"missing required field", TypeCharacterCreated, `{"character_id":"c1","name":"Aria"}`, "character.created payload.kind is required"},
+		{"wrong scalar type", TypeSessionStarted, `{"session_id":true}`, "session.started payload.session_id must be string"},
+		{"fractional integer", TypeCampaignForked, `{"parent_campaign_id":"p","fork_event_seq":1.5,"origin_campaign_id":"o","copy_participants":false}`, "campaign.forked payload.fork_event_seq must be integer"},
+		{"nested item", TypeOutcomeApplied, `{"request_id":"r1","roll_seq":1,"targets":[],"requires_complication":false,"applied_changes":[{"field":"hope","before":"1","after":2}]}`, "action.outcome_applied payload.applied_changes[0].before must be integer"},
+		{"map values", TypeCampaignUpdated, `{"fields":{"name":"x"}}`, ""},
+		{"payload not an object", TypeCharacterDeleted, `"c1"`, "character.deleted payload must be object"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePayload(tt.typ, []byte(tt.payload))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidatePayload returned error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("ValidatePayload error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPayloadSchemas(t *testing.T) {
+	core, err := PayloadSchemas("")
+	if err != nil {
+		t.Fatalf("PayloadSchemas returned error: %v", err)
+	}
+	var created *PayloadSchema
+	for i := range core {
+		if core[i].SystemID != "" {
+			t.Fatalf("core schema %s has system id %s", core[i].Type, core[i].SystemID)
+		}
+		if core[i].Type == TypeCampaignCreated {
+			created = &core[i]
+		}
+	}
+	if created == nil {
+		t.Fatal("expected campaign.created schema")
+	}
+	if created.PayloadVersion != CurrentPayloadVersion(TypeCampaignCreated) {
+		t.Fatalf("payload version = %d, want %d", created.PayloadVersion, CurrentPayloadVersion(TypeCampaignCreated))
+	}
+	var schema struct {
+		Title    string   `json:"title"`
+		Required []string `json:"required"`
+	}
+	if err := json.Unmarshal(created.Schema, &schema); err != nil {
+		t.Fatalf("decode schema: %v", err)
+	}
+	if schema.Title != "CampaignCreatedPayload" || strings.Join(schema.Required, ",") != "game_system,gm_mode,locale,name" {
+		t.Fatalf("schema = %+v", schema)
+	}
+
+	system, err := PayloadSchemas("GAME_SYSTEM_DAGGERHEART")
+	if err != nil {
+		t.Fatalf("PayloadSchemas returned error: %v", err)
+	}
+	if len(system) == 0 {
+		t.Fatal("expected daggerheart schemas")
+	}
+	for _, entry := range system {
+		if entry.SystemID != "GAME_SYSTEM_DAGGERHEART" {
+			t.Fatalf("system schema %s has system id %q", entry.Type, entry.SystemID)
+		}
+	}
+}
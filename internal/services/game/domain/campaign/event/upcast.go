@@ -0,0 +1,132 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// InitialPayloadVersion is the payload schema version of every event type
+// before its first upcaster is registered. Events journaled before payloads
+// were versioned read as this version.
+const InitialPayloadVersion = 1
+
+// Upcaster rewrites a payload from one schema version to the next.
+type Upcaster func(payload []byte) ([]byte, error)
+
+// UpcasterRegistry holds the upcasters of each event type. The current payload
+// version of a type is one past its last registered upcaster.
+type UpcasterRegistry struct {
+	mu    sync.RWMutex
+	steps map[Type][]Upcaster
+}
+
+// NewUpcasterRegistry returns an empty registry: every type is at
+// InitialPayloadVersion.
+func NewUpcasterRegistry() *UpcasterRegistry {
+	return &UpcasterRegistry{steps: make(map[Type][]Upcaster)}
+}
+
+// Register adds the upcaster that rewrites payloads of type t from version
+// from to from+1. Upcasters must be registered in version order, starting at
+// InitialPayloadVersion.
+func (r *UpcasterRegistry) Register(t Type, from int, upcaster Upcaster) error {
+	if !t.IsValid() {
+		return fmt.Errorf("event type is required")
+	}
+	if upcaster == nil {
+		return fmt.Errorf("upcaster for %s is required", t)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if current := InitialPayloadVersion + len(r.steps[t]); from != current {
+		return fmt.Errorf("upcaster for %s must start at version %d, got %d", t, current, from)
+	}
+	r.steps[t] = append(r.steps[t], upcaster)
+	return nil
+}
+
+// CurrentVersion returns the payload version new events of type t are written
+// with.
+func (r *UpcasterRegistry) CurrentVersion(t Type) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return InitialPayloadVersion + len(r.steps[t])
+}
+
+// Types returns the event types with registered upcasters, sorted.
+func (r *UpcasterRegistry) Types() []Type {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	types := make([]Type, 0, len(r.steps))
+	for t := range r.steps {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// Upcast rewrites the payload of evt to the current version of its type. The
+// hashes and signature of evt keep describing the journaled payload.
+func (r *UpcasterRegistry) Upcast(evt Event) (Event, error) {
+	version := evt.PayloadVersion
+	if version == 0 {
+		version = InitialPayloadVersion
+	}
+	r.mu.RLock()
+	steps := r.steps[evt.Type]
+	r.mu.RUnlock()
+	current := InitialPayloadVersion + len(steps)
+	if version < InitialPayloadVersion || version > current {
+		return Event{}, fmt.Errorf("%s payload version %d is not supported (current %d)", evt.Type, version, current)
+	}
+	payload := evt.PayloadJSON
+	for ; version < current; version++ {
+		upcasted, err := steps[version-InitialPayloadVersion](payload)
+		if err != nil {
+			return Event{}, fmt.Errorf("upcast %s payload from version %d: %w", evt.Type, version, err)
+		}
+		payload = upcasted
+	}
+	evt.PayloadJSON = payload
+	evt.PayloadVersion = current
+	return evt, nil
+}
+
+var defaultUpcasters = NewUpcasterRegistry()
+
+// RegisterUpcaster adds an upcaster to the default registry. It is meant to be
+// called from init functions and panics on an out-of-order registration.
+func RegisterUpcaster(t Type, from int, upcaster Upcaster) {
+	if err := defaultUpcasters.Register(t, from, upcaster); err != nil {
+		panic(err)
+	}
+}
+
+// CurrentPayloadVersion returns the current payload version of t in the
+// default registry.
+func CurrentPayloadVersion(t Type) int {
+	return defaultUpcasters.CurrentVersion(t)
+}
+
+// UpcastedTypes returns the event types with upcasters in the default
+// registry.
+func UpcastedTypes() []Type {
+	return defaultUpcasters.Types()
+}
+
+// Upcast rewrites the payload of evt to its current version using the default
+// registry. Code that decodes journaled payloads calls it first.
+func Upcast(evt Event) (Event, error) {
+	return defaultUpcasters.Upcast(evt)
+}
+
+// DecodePayload upcasts a journaled event and decodes its payload into v.
+func DecodePayload(evt Event, v any) error {
+	upcasted, err := Upcast(evt)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(upcasted.PayloadJSON, v)
+}
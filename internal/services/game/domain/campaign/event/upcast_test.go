@@ -0,0 +1,81 @@
+package event
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+const typeTestRenamed Type = "test.renamed"
+
+// renameField upcasts by renaming a JSON key.
+func renameField(from, to string) Upcaster {
+	return func(payload []byte) ([]byte, error) {
+		return bytes.Replace(payload, []byte(`"`+from+`"`), []byte(`"`+to+`"`), 1), nil
+	}
+}
+
+func TestUpcasterRegistry_UpcastsToCurrentVersion(t *testing.T) {
+	registry := NewUpcasterRegistry()
+	if err := registry.Register(typeTestRenamed, 1, renameField("nick", "display_name")); err != nil {
+		t.Fatalf("register v1: %v", err)
+	}
+	if err := registry.Register(typeTestRenamed, 2, renameField("display_name", "name")); err != nil {
+		t.Fatalf("register v2: %v", err)
+	}
+	if got := registry.CurrentVersion(typeTestRenamed); got != 3 {
+		t.Fatalf("current version = %d, want 3", got)
+	}
+	if got := registry.CurrentVersion(TypeCampaignCreated); got != InitialPayloadVersion {
+		t.Fatalf("unversioned type = %d, want %d", got, InitialPayloadVersion)
+	}
+
+	tests := []struct {
+		name    string
+		version int
+		payload string
+	}{
+		{"unversioned legacy event", 0, `{"nick":"Kira"}`},
+		{"version 1", 1, `{"nick":"Kira"}`},
+		{"version 2", 2, `{"display_name":"Kira"}`},
+		{"current version", 3, `{"name":"Kira"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upcasted, err := registry.Upcast(Event{Type: typeTestRenamed, Hash: "hash", PayloadVersion: tt.version, PayloadJSON: []byte(tt.payload)})
+			if err != nil {
+				t.Fatalf("upcast: %v", err)
+			}
+			if string(upcasted.PayloadJSON) != `{"name":"Kira"}` || upcasted.PayloadVersion != 3 {
+				t.Fatalf("upcasted = %s v%d, want current payload", upcasted.PayloadJSON, upcasted.PayloadVersion)
+			}
+			if upcasted.Hash != "hash" {
+				t.Fatalf("hash = %q, want journaled hash kept", upcasted.Hash)
+			}
+		})
+	}
+}
+
+func TestUpcasterRegistry_Errors(t *testing.T) {
+	registry := NewUpcasterRegistry()
+	if err := registry.Register(typeTestRenamed, 2, renameField("a", "b")); err == nil {
+		t.Fatal("expected error for an upcaster registered out of order")
+	}
+	if err := registry.Register(typeTestRenamed, 1, nil); err == nil {
+		t.Fatal("expected error for a nil upcaster")
+	}
+	if err := registry.Register(typeTestRenamed, 1, func([]byte) ([]byte, error) {
+		return nil, fmt.Errorf("boom")
+	}); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if _, err := registry.Upcast(Event{Type: typeTestRenamed, PayloadVersion: 1, PayloadJSON: []byte(`{}`)}); err == nil {
+		t.Fatal("expected error from failing upcaster")
+	}
+	if _, err := registry.Upcast(Event{Type: typeTestRenamed, PayloadVersion: 3, PayloadJSON: []byte(`{}`)}); err == nil {
+		t.Fatal("expected error for a version newer than current")
+	}
+	if types := registry.Types(); len(types) != 1 || types[0] != typeTestRenamed {
+		t.Fatalf("types = %v, want [%s]", types, typeTestRenamed)
+	}
+}
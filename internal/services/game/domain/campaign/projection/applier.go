@@ -3,6 +3,7 @@ package projection
 import (
 	"context"
 	"encoding/json"
+	"log"
 	"strings"
 	"time"
 
@@ -24,10 +25,58 @@ type Applier struct {
 	SessionGate      storage.SessionGateStore
 	SessionSpotlight storage.SessionSpotlightStore
 	Adapters         *systems.AdapterRegistry
+	// Checkpoints, when configured, checkpoints a campaign after each applied
+	// event whose sequence is a multiple of the checkpoint interval.
+	Checkpoints Checkpoints
+	// Event lets interval checkpoints be skipped once a later event of the
+	// campaign is journaled, since that event may already be applied.
+	Event storage.EventStore
 }
 
-// Apply applies an event to projection stores.
+// Apply applies an event to projection stores. Journaled payloads are
+// upcast to the current version of their type first.
 func (a Applier) Apply(ctx context.Context, evt event.Event) error {
+	if err := a.apply(ctx, evt); err != nil {
+		return err
+	}
+	a.saveIntervalCheckpoint(ctx, evt)
+	return nil
+}
+
+// saveIntervalCheckpoint checkpoints the event's campaign when the event lands
+// on the checkpoint interval and is still the campaign's newest event. A
+// missed checkpoint only slows the next rebuild down.
+func (a Applier) saveIntervalCheckpoint(ctx context.Context, evt event.Event) {
+	if !a.Checkpoints.Configured() || evt.Seq == 0 {
+		return
+	}
+	interval := a.Checkpoints.Interval
+	if interval == 0 {
+		interval = DefaultCheckpointInterval
+	}
+	if evt.Seq%interval != 0 {
+		return
+	}
+	if a.Event != nil {
+		latestSeq, err := a.Event.GetLatestEventSeq(ctx, evt.CampaignID)
+		if err != nil {
+			log.Printf("checkpoint campaign %s: get latest seq: %v", evt.CampaignID, err)
+			return
+		}
+		if latestSeq != evt.Seq {
+			return
+		}
+	}
+	if err := a.Checkpoints.Save(ctx, evt.CampaignID, evt.Seq); err != nil {
+		log.Printf("checkpoint campaign %s at seq %d: %v", evt.CampaignID, evt.Seq, err)
+	}
+}
+
+func (a Applier) apply(ctx context.Context, evt event.Event) error {
+	evt, err := event.Upcast(evt)
+	if err != nil {
+		return err
+	}
 	switch evt.Type {
 	case event.TypeCampaignCreated:
 		return a.applyCampaignCreated(ctx, evt)
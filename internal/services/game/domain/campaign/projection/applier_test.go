@@ -176,6 +176,25 @@ func TestApplyCampaignUpdated_StatusAndName(t *testing.T) {
 	}
 }
 
+func TestApply_RejectsUnsupportedPayloadVersion(t *testing.T) {
+	store := newProjectionCampaignStore()
+	store.campaigns["camp-1"] = campaign.Campaign{ID: "camp-1", Name: "Old"}
+	applier := Applier{Campaign: store}
+
+	evt := event.Event{
+		CampaignID:     "camp-1",
+		Type:           event.TypeCampaignUpdated,
+		PayloadJSON:    []byte(`{"fields":{"name":"New"}}`),
+		PayloadVersion: event.CurrentPayloadVersion(event.TypeCampaignUpdated) + 1,
+	}
+	if err := applier.Apply(context.Background(), evt); err == nil {
+		t.Fatal("expected error for a payload version newer than current")
+	}
+	if store.campaigns["camp-1"].Name != "Old" {
+		t.Fatal("campaign must not change")
+	}
+}
+
 func TestApplyParticipantUnbound_RejectsMismatch(t *testing.T) {
 	ctx := context.Background()
 	participantStore := newProjectionParticipantStore()
@@ -1486,6 +1505,87 @@ func TestApplyProfileUpdated(t *testing.T) {
 	}
 }
 
+func TestApplyProfileUpdated_KeepsLevelOwnedByLevelUps(t *testing.T) {
+	ctx := context.Background()
+	dhStore := newProjectionDaggerheartStore()
+	if err := dhStore.PutDaggerheartCharacterProfile(ctx, storage.DaggerheartCharacterProfile{
+		CampaignID: "camp-1", CharacterID: "char-1", Level: 3, HpMax: 7, StressMax: 6, Evasion: 10,
+		LevelHistory: []storage.DaggerheartLevelUp{{Level: 2}, {Level: 3}},
+	}); err != nil {
+		t.Fatalf("seed profile: %v", err)
+	}
+	applier := Applier{Daggerheart: dhStore}
+
+	payload := event.ProfileUpdatedPayload{
+		SystemProfile: map[string]any{
+			"daggerheart": map[string]any{
+				"level":            7,
+				"hp_max":           7,
+				"stress_max":       6,
+				"evasion":          11,
+				"major_threshold":  10,
+				"severe_threshold": 20,
+				"proficiency":      1,
+			},
+		},
+	}
+	data, _ := json.Marshal(payload)
+	evt := event.Event{CampaignID: "camp-1", EntityID: "char-1", Type: event.TypeProfileUpdated, PayloadJSON: data}
+	if err := applier.Apply(ctx, evt); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	profile, err := dhStore.GetDaggerheartCharacterProfile(ctx, "camp-1", "char-1")
+	if err != nil {
+		t.Fatalf("get profile: %v", err)
+	}
+	if profile.Level != 3 || len(profile.LevelHistory) != 2 || profile.Evasion != 11 {
+		t.Fatalf("profile level=%d history=%d evasion=%d, want 3/2/11", profile.Level, len(profile.LevelHistory), profile.Evasion)
+	}
+}
+
+func TestApplyProfileUpdated_ClassSelection(t *testing.T) {
+	ctx := context.Background()
+	dhStore := newProjectionDaggerheartStore()
+	dhStore.profiles["camp-1:char-1"] = storage.DaggerheartCharacterProfile{
+		CampaignID:         "camp-1",
+		CharacterID:        "char-1",
+		MulticlassClassID:  "class.wizard",
+		MulticlassDomainID: "domain.codex",
+	}
+	applier := Applier{Daggerheart: dhStore}
+
+	payload := event.ProfileUpdatedPayload{
+		SystemProfile: map[string]any{
+			"daggerheart": map[string]any{
+				"level":            1,
+				"hp_max":           6,
+				"stress_max":       6,
+				"evasion":          10,
+				"major_threshold":  4,
+				"severe_threshold": 8,
+				"class_id":         "class.guardian",
+				"subclass_id":      "subclass.stalwart",
+			},
+		},
+	}
+	data, _ := json.Marshal(payload)
+	evt := event.Event{CampaignID: "camp-1", EntityID: "char-1", Type: event.TypeProfileUpdated, PayloadJSON: data}
+
+	if err := applier.Apply(ctx, evt); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	profile := dhStore.profiles["camp-1:char-1"]
+	if profile.ClassID != "class.guardian" || profile.SubclassID != "subclass.stalwart" {
+		t.Fatalf("class=%q subclass=%q", profile.ClassID, profile.SubclassID)
+	}
+	if profile.SubclassStage != "foundation" {
+		t.Fatalf("subclass stage = %q, want foundation", profile.SubclassStage)
+	}
+	if profile.MulticlassClassID != "class.wizard" || profile.MulticlassDomainID != "domain.codex" {
+		t.Fatalf("multiclass should be carried forward, got %+v", profile)
+	}
+}
+
 func TestApplyProfileUpdated_NilSystemProfile(t *testing.T) {
 	ctx := context.Background()
 	dhStore := newProjectionDaggerheartStore()
@@ -3,6 +3,7 @@ package projection
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -250,6 +251,23 @@ func (a Applier) applyProfileUpdated(ctx context.Context, evt event.Event) error
 	); err != nil {
 		return fmt.Errorf("validate daggerheart profile payload: %w", err)
 	}
+	subclassStage := ""
+	if strings.TrimSpace(dhProfile.SubclassID) != "" {
+		subclassStage, err = daggerheart.NormalizeSubclassStage(dhProfile.SubclassStage)
+		if err != nil {
+			return fmt.Errorf("validate daggerheart profile payload: %w", err)
+		}
+	}
+
+	// Level, multiclass and progression state are owned by level-up events, so
+	// carry them forward once the profile exists.
+	existing, err := a.Daggerheart.GetDaggerheartCharacterProfile(ctx, evt.CampaignID, characterID)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return fmt.Errorf("get daggerheart profile: %w", err)
+	}
+	if err == nil && existing.Level > 0 {
+		level = existing.Level
+	}
 
 	experienceStorage := make([]storage.DaggerheartExperience, 0, len(dhProfile.Experiences))
 	for _, experience := range dhProfile.Experiences {
@@ -259,24 +277,35 @@ func (a Applier) applyProfileUpdated(ctx context.Context, evt event.Event) error
 		})
 	}
 	return a.Daggerheart.PutDaggerheartCharacterProfile(ctx, storage.DaggerheartCharacterProfile{
-		CampaignID:      evt.CampaignID,
-		CharacterID:     characterID,
-		Level:           level,
-		HpMax:           dhProfile.HpMax,
-		StressMax:       dhProfile.StressMax,
-		Evasion:         dhProfile.Evasion,
-		MajorThreshold:  dhProfile.MajorThreshold,
-		SevereThreshold: dhProfile.SevereThreshold,
-		Proficiency:     dhProfile.Proficiency,
-		ArmorScore:      dhProfile.ArmorScore,
-		ArmorMax:        dhProfile.ArmorMax,
-		Experiences:     experienceStorage,
-		Agility:         dhProfile.Agility,
-		Strength:        dhProfile.Strength,
-		Finesse:         dhProfile.Finesse,
-		Instinct:        dhProfile.Instinct,
-		Presence:        dhProfile.Presence,
-		Knowledge:       dhProfile.Knowledge,
+		CampaignID:              evt.CampaignID,
+		CharacterID:             characterID,
+		Level:                   level,
+		HpMax:                   dhProfile.HpMax,
+		StressMax:               dhProfile.StressMax,
+		Evasion:                 dhProfile.Evasion,
+		MajorThreshold:          dhProfile.MajorThreshold,
+		SevereThreshold:         dhProfile.SevereThreshold,
+		Proficiency:             dhProfile.Proficiency,
+		ArmorScore:              dhProfile.ArmorScore,
+		ArmorMax:                dhProfile.ArmorMax,
+		Experiences:             experienceStorage,
+		Agility:                 dhProfile.Agility,
+		Strength:                dhProfile.Strength,
+		Finesse:                 dhProfile.Finesse,
+		Instinct:                dhProfile.Instinct,
+		Presence:                dhProfile.Presence,
+		Knowledge:               dhProfile.Knowledge,
+		ClassID:                 strings.TrimSpace(dhProfile.ClassID),
+		SubclassID:              strings.TrimSpace(dhProfile.SubclassID),
+		SubclassStage:           subclassStage,
+		MulticlassClassID:       existing.MulticlassClassID,
+		MulticlassSubclassID:    existing.MulticlassSubclassID,
+		MulticlassSubclassStage: existing.MulticlassSubclassStage,
+		MulticlassDomainID:      existing.MulticlassDomainID,
+		MarkedTraits:            existing.MarkedTraits,
+		LoadoutActive:           existing.LoadoutActive,
+		LoadoutVault:            existing.LoadoutVault,
+		LevelHistory:            existing.LevelHistory,
 	})
 }
 
@@ -297,6 +326,9 @@ type daggerheartProfilePayload struct {
 	Instinct        int                            `json:"instinct"`
 	Presence        int                            `json:"presence"`
 	Knowledge       int                            `json:"knowledge"`
+	ClassID         string                         `json:"class_id"`
+	SubclassID      string                         `json:"subclass_id"`
+	SubclassStage   string                         `json:"subclass_stage"`
 }
 
 type daggerheartExperiencePayload struct {
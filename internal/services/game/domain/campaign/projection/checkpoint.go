@@ -0,0 +1,201 @@
+package projection
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// DefaultCheckpointInterval is how many events apart a rebuild saves
+// projection checkpoints.
+const DefaultCheckpointInterval = 500
+
+// Checkpoints configures projection checkpoints: where they are stored, how a
+// campaign's projections are exported and restored, and how often a rebuild
+// saves one.
+type Checkpoints struct {
+	Store storage.ProjectionCheckpointStore
+	State storage.ProjectionStateStore
+	// Interval saves a checkpoint at every multiple of Interval the rebuild
+	// reaches (0 = DefaultCheckpointInterval).
+	Interval uint64
+}
+
+// RebuildResult reports how a campaign's projections were rebuilt.
+type RebuildResult struct {
+	// CheckpointSeq is the sequence of the restored checkpoint, or 0 when the
+	// rebuild replayed the journal from the start.
+	CheckpointSeq uint64
+	// LastSeq is the last event sequence applied.
+	LastSeq uint64
+	// Applied counts the events replayed after the checkpoint.
+	Applied int
+	// Saved counts the checkpoints saved during the rebuild.
+	Saved int
+}
+
+// Configured reports whether both checkpoint stores are set.
+func (c Checkpoints) Configured() bool {
+	return c.Store != nil && c.State != nil
+}
+
+// Save checkpoints the campaign's current projections as of seq. Callers must
+// only save once every event up to seq has been applied.
+func (c Checkpoints) Save(ctx context.Context, campaignID string, seq uint64) error {
+	if !c.Configured() {
+		return fmt.Errorf("checkpoint stores are not configured")
+	}
+	state, err := c.State.ExportCampaignProjections(ctx, campaignID)
+	if err != nil {
+		return fmt.Errorf("export projections: %w", err)
+	}
+	if _, err := c.Store.SaveProjectionCheckpoint(ctx, storage.ProjectionCheckpoint{
+		CampaignID: campaignID,
+		Seq:        seq,
+		State:      state,
+	}); err != nil {
+		return fmt.Errorf("save checkpoint: %w", err)
+	}
+	return nil
+}
+
+// RebuildCampaign rebuilds every projection of a campaign up to untilSeq
+// (0 = latest). It restores the nearest checkpoint at or before untilSeq that
+// is still linked to the journal, replays only the events after it, and saves
+// a checkpoint at every interval along the way. Without a usable checkpoint
+// the campaign's projections are cleared and the whole journal is replayed.
+func RebuildCampaign(ctx context.Context, eventStore storage.EventStore, applier Applier, checkpoints Checkpoints, campaignID string, untilSeq uint64) (RebuildResult, error) {
+	if eventStore == nil {
+		return RebuildResult{}, fmt.Errorf("event store is not configured")
+	}
+	if !checkpoints.Configured() {
+		return RebuildResult{}, fmt.Errorf("checkpoint stores are not configured")
+	}
+	if strings.TrimSpace(campaignID) == "" {
+		return RebuildResult{}, fmt.Errorf("campaign id is required")
+	}
+	interval := checkpoints.Interval
+	if interval == 0 {
+		interval = DefaultCheckpointInterval
+	}
+
+	checkpointSeq, err := checkpoints.restore(ctx, eventStore, campaignID, untilSeq)
+	if err != nil {
+		return RebuildResult{}, err
+	}
+	result := RebuildResult{CheckpointSeq: checkpointSeq, LastSeq: checkpointSeq}
+	// The rebuild saves its own checkpoints below.
+	applier.Checkpoints = Checkpoints{}
+	lastSeq, err := ReplayCampaignWith(ctx, eventStore, applier, campaignID, ReplayOptions{
+		AfterSeq: checkpointSeq,
+		UntilSeq: untilSeq,
+		AfterApply: func(ctx context.Context, evt event.Event) error {
+			result.Applied++
+			if evt.Seq%interval != 0 {
+				return nil
+			}
+			if err := checkpoints.Save(ctx, campaignID, evt.Seq); err != nil {
+				return err
+			}
+			result.Saved++
+			return nil
+		},
+	})
+	result.LastSeq = lastSeq
+	return result, err
+}
+
+// RestoreFork imports the newest trusted checkpoint of the source campaign at
+// or before untilSeq as the fork's projections and returns its sequence. The
+// fork must already be projected and keeps its own name and lineage. It
+// returns 0 and leaves the fork untouched when no checkpoint is usable.
+func (c Checkpoints) RestoreFork(ctx context.Context, eventStore storage.EventStore, sourceCampaignID, forkCampaignID string, untilSeq uint64) (uint64, error) {
+	if eventStore == nil {
+		return 0, fmt.Errorf("event store is not configured")
+	}
+	if !c.Configured() {
+		return 0, fmt.Errorf("checkpoint stores are not configured")
+	}
+	if strings.TrimSpace(sourceCampaignID) == "" || strings.TrimSpace(forkCampaignID) == "" {
+		return 0, fmt.Errorf("campaign id is required")
+	}
+	if untilSeq == 0 {
+		return 0, nil
+	}
+	return c.importNearest(ctx, eventStore, sourceCampaignID, untilSeq, func(state []byte) error {
+		return c.State.ImportForkedCampaignProjections(ctx, sourceCampaignID, forkCampaignID, state)
+	})
+}
+
+// restore imports the newest trusted checkpoint at or before untilSeq and
+// returns its sequence. Without one the campaign's projections are cleared.
+func (c Checkpoints) restore(ctx context.Context, eventStore storage.EventStore, campaignID string, untilSeq uint64) (uint64, error) {
+	seq, err := c.importNearest(ctx, eventStore, campaignID, untilSeq, func(state []byte) error {
+		return c.State.ImportCampaignProjections(ctx, campaignID, state)
+	})
+	if err != nil || seq > 0 {
+		return seq, err
+	}
+	if err := c.State.ImportCampaignProjections(ctx, campaignID, nil); err != nil {
+		return 0, fmt.Errorf("clear projections: %w", err)
+	}
+	return 0, nil
+}
+
+// importNearest hands the newest trusted checkpoint at or before untilSeq to
+// importState and returns its sequence, or 0 when none imports. Checkpoints
+// whose state or chain link no longer verifies, or whose state no longer
+// decodes or fits the projection schema, are skipped; any other import
+// failure is returned.
+func (c Checkpoints) importNearest(ctx context.Context, eventStore storage.EventStore, campaignID string, untilSeq uint64, importState func([]byte) error) (uint64, error) {
+	seq := untilSeq
+	for {
+		checkpoint, err := c.Store.GetProjectionCheckpoint(ctx, campaignID, seq)
+		if errors.Is(err, storage.ErrNotFound) {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("get checkpoint: %w", err)
+		}
+		trusted, err := checkpointTrusted(ctx, eventStore, checkpoint)
+		if err != nil {
+			return 0, err
+		}
+		if trusted {
+			err := importState(checkpoint.State)
+			if err == nil {
+				return checkpoint.Seq, nil
+			}
+			if !errors.Is(err, storage.ErrIncompatibleProjectionState) {
+				return 0, fmt.Errorf("import checkpoint %d: %w", checkpoint.Seq, err)
+			}
+		}
+		if checkpoint.Seq <= 1 {
+			return 0, nil
+		}
+		seq = checkpoint.Seq - 1
+	}
+}
+
+// checkpointTrusted verifies a checkpoint's state hash and that the journal
+// still holds the event it was linked to.
+func checkpointTrusted(ctx context.Context, eventStore storage.EventStore, checkpoint storage.ProjectionCheckpoint) (bool, error) {
+	sum := sha256.Sum256(checkpoint.State)
+	if hex.EncodeToString(sum[:]) != checkpoint.StateHash {
+		return false, nil
+	}
+	evt, err := eventStore.GetEventBySeq(ctx, checkpoint.CampaignID, checkpoint.Seq)
+	if errors.Is(err, storage.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get checkpoint event: %w", err)
+	}
+	return evt.ChainHash == checkpoint.ChainHash, nil
+}
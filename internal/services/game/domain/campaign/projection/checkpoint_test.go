@@ -0,0 +1,352 @@
+package projection
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/participant"
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+// projectionCheckpointStore keeps checkpoints in memory, linked to the fake
+// journal the way the events database links them.
+type projectionCheckpointStore struct {
+	events      *projectionEventStore
+	checkpoints map[uint64]storage.ProjectionCheckpoint
+}
+
+func (s *projectionCheckpointStore) SaveProjectionCheckpoint(ctx context.Context, checkpoint storage.ProjectionCheckpoint) (storage.ProjectionCheckpoint, error) {
+	evt, err := s.events.GetEventBySeq(ctx, checkpoint.CampaignID, checkpoint.Seq)
+	if err != nil {
+		return storage.ProjectionCheckpoint{}, err
+	}
+	sum := sha256.Sum256(checkpoint.State)
+	checkpoint.ChainHash = evt.ChainHash
+	checkpoint.StateHash = hex.EncodeToString(sum[:])
+	s.checkpoints[checkpoint.Seq] = checkpoint
+	return checkpoint, nil
+}
+
+func (s *projectionCheckpointStore) GetProjectionCheckpoint(_ context.Context, _ string, atOrBeforeSeq uint64) (storage.ProjectionCheckpoint, error) {
+	var best storage.ProjectionCheckpoint
+	found := false
+	for seq, checkpoint := range s.checkpoints {
+		if atOrBeforeSeq > 0 && seq > atOrBeforeSeq {
+			continue
+		}
+		if !found || seq > best.Seq {
+			best, found = checkpoint, true
+		}
+	}
+	if !found {
+		return storage.ProjectionCheckpoint{}, storage.ErrNotFound
+	}
+	return best, nil
+}
+
+// projectionStateStore exports the fake campaign and participant projections.
+type projectionStateStore struct {
+	campaigns    *projectionCampaignStore
+	participants *projectionParticipantStore
+}
+
+type projectionStateExport struct {
+	Campaign     campaign.Campaign         `json:"campaign"`
+	Participants []participant.Participant `json:"participants"`
+}
+
+func (s *projectionStateStore) ExportCampaignProjections(_ context.Context, campaignID string) ([]byte, error) {
+	export := projectionStateExport{Campaign: s.campaigns.campaigns[campaignID]}
+	for _, p := range s.participants.participants {
+		if p.CampaignID == campaignID {
+			export.Participants = append(export.Participants, p)
+		}
+	}
+	sort.Slice(export.Participants, func(i, j int) bool { return export.Participants[i].ID < export.Participants[j].ID })
+	return json.Marshal(export)
+}
+
+func (s *projectionStateStore) ImportCampaignProjections(_ context.Context, campaignID string, state []byte) error {
+	delete(s.campaigns.campaigns, campaignID)
+	for key := range s.participants.participants {
+		if strings.HasPrefix(key, campaignID+":") {
+			delete(s.participants.participants, key)
+		}
+	}
+	if len(state) == 0 {
+		return nil
+	}
+	var export projectionStateExport
+	if err := json.Unmarshal(state, &export); err != nil {
+		return fmt.Errorf("%w: %w", storage.ErrIncompatibleProjectionState, err)
+	}
+	s.campaigns.campaigns[campaignID] = export.Campaign
+	for _, p := range export.Participants {
+		s.participants.participants[p.CampaignID+":"+p.ID] = p
+	}
+	return nil
+}
+
+func (s *projectionStateStore) ImportForkedCampaignProjections(_ context.Context, sourceCampaignID, forkCampaignID string, state []byte) error {
+	fork, ok := s.campaigns.campaigns[forkCampaignID]
+	if !ok {
+		return storage.ErrNotFound
+	}
+	var export projectionStateExport
+	if err := json.Unmarshal(state, &export); err != nil {
+		return fmt.Errorf("%w: %w", storage.ErrIncompatibleProjectionState, err)
+	}
+	if export.Campaign.ID != sourceCampaignID {
+		return fmt.Errorf("state belongs to campaign %s", export.Campaign.ID)
+	}
+	for key := range s.participants.participants {
+		if strings.HasPrefix(key, forkCampaignID+":") {
+			delete(s.participants.participants, key)
+		}
+	}
+	restored := export.Campaign
+	restored.ID = forkCampaignID
+	restored.Name = fork.Name
+	restored.CreatedAt = fork.CreatedAt
+	s.campaigns.campaigns[forkCampaignID] = restored
+	for _, p := range export.Participants {
+		p.CampaignID = forkCampaignID
+		s.participants.participants[forkCampaignID+":"+p.ID] = p
+	}
+	return nil
+}
+
+type checkpointFixture struct {
+	events       *projectionEventStore
+	campaigns    *projectionCampaignStore
+	participants *projectionParticipantStore
+	store        *projectionCheckpointStore
+	applier      Applier
+	checkpoints  Checkpoints
+}
+
+// newCheckpointFixture journals a campaign and four participants joining,
+// checkpointed every two events.
+func newCheckpointFixture() checkpointFixture {
+	events := &projectionEventStore{events: []event.Event{newCampaignCreatedEvent("camp-1", 1)}}
+	for i := 1; i <= 4; i++ {
+		events.events = append(events.events, newParticipantJoinedEvent("camp-1", fmt.Sprintf("part-%d", i), uint64(i+1)))
+	}
+	for i := range events.events {
+		events.events[i].ChainHash = fmt.Sprintf("chain-%d", events.events[i].Seq)
+	}
+	campaigns := newProjectionCampaignStore()
+	participants := newProjectionParticipantStore()
+	store := &projectionCheckpointStore{events: events, checkpoints: make(map[uint64]storage.ProjectionCheckpoint)}
+	return checkpointFixture{
+		events:       events,
+		campaigns:    campaigns,
+		participants: participants,
+		store:        store,
+		applier:      Applier{Campaign: campaigns, Participant: participants},
+		checkpoints: Checkpoints{
+			Store:    store,
+			State:    &projectionStateStore{campaigns: campaigns, participants: participants},
+			Interval: 2,
+		},
+	}
+}
+
+func (f checkpointFixture) rebuild(t *testing.T, untilSeq uint64) RebuildResult {
+	t.Helper()
+	result, err := RebuildCampaign(context.Background(), f.events, f.applier, f.checkpoints, "camp-1", untilSeq)
+	if err != nil {
+		t.Fatalf("RebuildCampaign returned error: %v", err)
+	}
+	return result
+}
+
+func TestRebuildCampaign_SavesCheckpointsAndReplaysTail(t *testing.T) {
+	f := newCheckpointFixture()
+
+	first := f.rebuild(t, 0)
+	if first != (RebuildResult{CheckpointSeq: 0, LastSeq: 5, Applied: 5, Saved: 2}) {
+		t.Fatalf("first rebuild = %+v", first)
+	}
+	if len(f.store.checkpoints) != 2 || f.store.checkpoints[4].ChainHash != "chain-4" {
+		t.Fatalf("checkpoints = %+v", f.store.checkpoints)
+	}
+
+	second := f.rebuild(t, 0)
+	if second != (RebuildResult{CheckpointSeq: 4, LastSeq: 5, Applied: 1}) {
+		t.Fatalf("second rebuild = %+v", second)
+	}
+	stored, err := f.campaigns.Get(context.Background(), "camp-1")
+	if err != nil {
+		t.Fatalf("campaign not stored: %v", err)
+	}
+	if stored.ParticipantCount != 4 || len(f.participants.participants) != 4 {
+		t.Fatalf("participant count = %d, participants = %d, want 4", stored.ParticipantCount, len(f.participants.participants))
+	}
+}
+
+func TestRebuildCampaign_UntilSeq(t *testing.T) {
+	f := newCheckpointFixture()
+	f.rebuild(t, 0)
+
+	result := f.rebuild(t, 3)
+	if result.CheckpointSeq != 2 || result.LastSeq != 3 || result.Applied != 1 {
+		t.Fatalf("rebuild = %+v, want checkpoint 2 through seq 3", result)
+	}
+	if len(f.participants.participants) != 2 {
+		t.Fatalf("participants = %d, want 2", len(f.participants.participants))
+	}
+}
+
+func TestRebuildCampaign_SkipsUntrustedCheckpoints(t *testing.T) {
+	f := newCheckpointFixture()
+	f.rebuild(t, 0)
+
+	// A rewritten journal no longer matches the newest checkpoint.
+	f.events.events[3].ChainHash = "rewritten"
+	result := f.rebuild(t, 0)
+	if result.CheckpointSeq != 2 || result.Applied != 3 {
+		t.Fatalf("rebuild = %+v, want checkpoint 2 and three events replayed", result)
+	}
+
+	// A corrupted state falls back to a full replay.
+	checkpoint := f.store.checkpoints[2]
+	checkpoint.State = []byte(`{"campaign":{}}`)
+	f.store.checkpoints[2] = checkpoint
+	delete(f.store.checkpoints, 4)
+	result = f.rebuild(t, 0)
+	if result.CheckpointSeq != 0 || result.Applied != 5 {
+		t.Fatalf("rebuild = %+v, want full replay", result)
+	}
+	if len(f.participants.participants) != 4 {
+		t.Fatalf("participants = %d, want 4", len(f.participants.participants))
+	}
+}
+
+func TestRebuildCampaign_SkipsIncompatibleCheckpoints(t *testing.T) {
+	f := newCheckpointFixture()
+	f.rebuild(t, 0)
+
+	// A checkpoint whose verified state no longer decodes is skipped.
+	if _, err := f.store.SaveProjectionCheckpoint(context.Background(), storage.ProjectionCheckpoint{
+		CampaignID: "camp-1",
+		Seq:        4,
+		State:      []byte("not a projection state"),
+	}); err != nil {
+		t.Fatalf("save checkpoint: %v", err)
+	}
+	result := f.rebuild(t, 0)
+	if result.CheckpointSeq != 2 || result.Applied != 3 {
+		t.Fatalf("rebuild = %+v, want checkpoint 2 and three events replayed", result)
+	}
+}
+
+// failingProjectionStateStore fails every import with err.
+type failingProjectionStateStore struct {
+	*projectionStateStore
+	err error
+}
+
+func (s failingProjectionStateStore) ImportCampaignProjections(context.Context, string, []byte) error {
+	return s.err
+}
+
+func (s failingProjectionStateStore) ImportForkedCampaignProjections(context.Context, string, string, []byte) error {
+	return s.err
+}
+
+func TestRebuildCampaign_SurfacesImportErrors(t *testing.T) {
+	f := newCheckpointFixture()
+	f.rebuild(t, 0)
+	importErr := errors.New("database is locked")
+	f.checkpoints.State = failingProjectionStateStore{
+		projectionStateStore: f.checkpoints.State.(*projectionStateStore),
+		err:                  importErr,
+	}
+
+	_, err := RebuildCampaign(context.Background(), f.events, f.applier, f.checkpoints, "camp-1", 0)
+	if !errors.Is(err, importErr) || !strings.Contains(err.Error(), "import checkpoint 4") {
+		t.Fatalf("RebuildCampaign error = %v, want the checkpoint 4 import error", err)
+	}
+	seq, err := f.checkpoints.RestoreFork(context.Background(), f.events, "camp-1", "fork-1", 3)
+	if !errors.Is(err, importErr) || seq != 0 {
+		t.Fatalf("RestoreFork = %d, %v, want the import error", seq, err)
+	}
+}
+
+func TestRebuildCampaign_RequiresCheckpointStores(t *testing.T) {
+	_, err := RebuildCampaign(context.Background(), &projectionEventStore{}, Applier{}, Checkpoints{}, "camp-1", 0)
+	if err == nil {
+		t.Fatal("expected error for missing checkpoint stores")
+	}
+}
+
+func TestCheckpoints_RestoreFork(t *testing.T) {
+	f := newCheckpointFixture()
+	f.rebuild(t, 0)
+	ctx := context.Background()
+	f.campaigns.campaigns["fork-1"] = campaign.Campaign{ID: "fork-1", Name: "Fork"}
+
+	seq, err := f.checkpoints.RestoreFork(ctx, f.events, "camp-1", "fork-1", 3)
+	if err != nil {
+		t.Fatalf("RestoreFork returned error: %v", err)
+	}
+	if seq != 2 {
+		t.Fatalf("restored seq = %d, want 2", seq)
+	}
+	fork := f.campaigns.campaigns["fork-1"]
+	if fork.Name != "Fork" || fork.ParticipantCount != 1 {
+		t.Fatalf("fork = %+v, want name Fork and one participant", fork)
+	}
+	if _, ok := f.participants.participants["fork-1:part-1"]; !ok {
+		t.Fatalf("participants = %+v, want part-1 in the fork", f.participants.participants)
+	}
+
+	// Before the first checkpoint the fork is left to replay everything.
+	f.campaigns.campaigns["fork-2"] = campaign.Campaign{ID: "fork-2", Name: "Fork"}
+	seq, err = f.checkpoints.RestoreFork(ctx, f.events, "camp-1", "fork-2", 1)
+	if err != nil {
+		t.Fatalf("RestoreFork returned error: %v", err)
+	}
+	if seq != 0 || f.campaigns.campaigns["fork-2"].Name != "Fork" {
+		t.Fatalf("restored seq = %d, fork = %+v, want untouched fork", seq, f.campaigns.campaigns["fork-2"])
+	}
+}
+
+func TestApplier_SavesIntervalCheckpoints(t *testing.T) {
+	f := newCheckpointFixture()
+	ctx := context.Background()
+	applier := f.applier
+	applier.Checkpoints = f.checkpoints
+	applier.Event = f.events
+
+	journal := f.events.events
+	for i, evt := range journal {
+		// Events are applied as they are appended.
+		f.events.events = journal[:i+1]
+		if err := applier.Apply(ctx, evt); err != nil {
+			t.Fatalf("apply seq %d: %v", evt.Seq, err)
+		}
+	}
+	if len(f.store.checkpoints) != 2 || f.store.checkpoints[2].Seq != 2 || f.store.checkpoints[4].Seq != 4 {
+		t.Fatalf("checkpoints = %+v, want seqs 2 and 4", f.store.checkpoints)
+	}
+
+	// A checkpoint is skipped once a later event is journaled.
+	f.store.checkpoints = make(map[uint64]storage.ProjectionCheckpoint)
+	if err := applier.Apply(ctx, journal[3]); err != nil {
+		t.Fatalf("apply seq 4: %v", err)
+	}
+	if len(f.store.checkpoints) != 0 {
+		t.Fatalf("checkpoints = %+v, want none behind the journal head", f.store.checkpoints)
+	}
+}
@@ -16,6 +16,8 @@ type ReplayOptions struct {
 	AfterSeq uint64
 	UntilSeq uint64
 	Filter   func(event.Event) bool
+	// AfterApply, when set, runs after each applied event.
+	AfterApply func(context.Context, event.Event) error
 }
 
 // ReplayCampaign replays events for a campaign and applies projections in order.
@@ -62,6 +64,11 @@ func ReplayCampaignWith(ctx context.Context, eventStore storage.EventStore, appl
 			if err := applier.Apply(ctx, evt); err != nil {
 				return lastSeq, err
 			}
+			if options.AfterApply != nil {
+				if err := options.AfterApply(ctx, evt); err != nil {
+					return lastSeq, err
+				}
+			}
 		}
 	}
 }
@@ -271,12 +271,21 @@ func (s *projectionEventStore) AppendEvent(context.Context, event.Event) (event.
 	return event.Event{}, nil
 }
 
+func (s *projectionEventStore) AppendEvents(context.Context, []event.Event) ([]event.Event, error) {
+	return nil, nil
+}
+
 func (s *projectionEventStore) GetEventByHash(context.Context, string) (event.Event, error) {
 	return event.Event{}, nil
 }
 
-func (s *projectionEventStore) GetEventBySeq(context.Context, string, uint64) (event.Event, error) {
-	return event.Event{}, nil
+func (s *projectionEventStore) GetEventBySeq(_ context.Context, campaignID string, seq uint64) (event.Event, error) {
+	for _, evt := range s.events {
+		if evt.CampaignID == campaignID && evt.Seq == seq {
+			return evt, nil
+		}
+	}
+	return event.Event{}, storage.ErrNotFound
 }
 
 func (s *projectionEventStore) ListEvents(_ context.Context, campaignID string, afterSeq uint64, limit int) ([]event.Event, error) {
@@ -300,8 +309,14 @@ func (s *projectionEventStore) ListEventsBySession(context.Context, string, stri
 	return nil, nil
 }
 
-func (s *projectionEventStore) GetLatestEventSeq(context.Context, string) (uint64, error) {
-	return 0, nil
+func (s *projectionEventStore) GetLatestEventSeq(_ context.Context, campaignID string) (uint64, error) {
+	var latest uint64
+	for _, evt := range s.events {
+		if evt.CampaignID == campaignID && evt.Seq > latest {
+			latest = evt.Seq
+		}
+	}
+	return latest, nil
 }
 
 func (s *projectionEventStore) ListEventsPage(context.Context, storage.ListEventsPageRequest) (storage.ListEventsPageResult, error) {
@@ -406,7 +421,7 @@ func (s *projectionDaggerheartStore) GetDaggerheartCharacterProfile(_ context.Co
 	key := campaignID + ":" + characterID
 	profile, ok := s.profiles[key]
 	if !ok {
-		return storage.DaggerheartCharacterProfile{}, fmt.Errorf("not found")
+		return storage.DaggerheartCharacterProfile{}, storage.ErrNotFound
 	}
 	return profile, nil
 }
@@ -511,6 +526,74 @@ func (s *projectionDaggerheartStore) DeleteDaggerheartAdversary(_ context.Contex
 	return nil
 }
 
+func (s *projectionDaggerheartStore) PutDaggerheartSceneRange(_ context.Context, _ storage.DaggerheartSceneRange) error {
+	return nil
+}
+
+func (s *projectionDaggerheartStore) GetDaggerheartSceneRange(_ context.Context, _, _, _, _ string) (storage.DaggerheartSceneRange, error) {
+	return storage.DaggerheartSceneRange{}, storage.ErrNotFound
+}
+
+func (s *projectionDaggerheartStore) ListDaggerheartSceneRanges(_ context.Context, _, _ string) ([]storage.DaggerheartSceneRange, error) {
+	return nil, nil
+}
+
+func (s *projectionDaggerheartStore) PutDaggerheartSessionEnvironment(_ context.Context, _ storage.DaggerheartSessionEnvironment) error {
+	return nil
+}
+
+func (s *projectionDaggerheartStore) GetDaggerheartSessionEnvironment(_ context.Context, _, _ string) (storage.DaggerheartSessionEnvironment, error) {
+	return storage.DaggerheartSessionEnvironment{}, storage.ErrNotFound
+}
+
+func (s *projectionDaggerheartStore) DeleteDaggerheartSessionEnvironment(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (s *projectionDaggerheartStore) PutDaggerheartCompanion(_ context.Context, _ storage.DaggerheartCompanion) error {
+	return nil
+}
+
+func (s *projectionDaggerheartStore) GetDaggerheartCompanion(_ context.Context, _, _ string) (storage.DaggerheartCompanion, error) {
+	return storage.DaggerheartCompanion{}, storage.ErrNotFound
+}
+
+func (s *projectionDaggerheartStore) PutDaggerheartEffect(_ context.Context, _ storage.DaggerheartEffect) error {
+	return nil
+}
+
+func (s *projectionDaggerheartStore) GetDaggerheartEffect(_ context.Context, _, _ string) (storage.DaggerheartEffect, error) {
+	return storage.DaggerheartEffect{}, storage.ErrNotFound
+}
+
+func (s *projectionDaggerheartStore) ListDaggerheartEffects(_ context.Context, _ string) ([]storage.DaggerheartEffect, error) {
+	return nil, nil
+}
+
+func (s *projectionDaggerheartStore) DeleteDaggerheartEffect(_ context.Context, _, _ string) error {
+	return nil
+}
+
+func (s *projectionDaggerheartStore) PutDaggerheartChase(_ context.Context, _ storage.DaggerheartChase) error {
+	return nil
+}
+
+func (s *projectionDaggerheartStore) GetDaggerheartChase(_ context.Context, _, _ string) (storage.DaggerheartChase, error) {
+	return storage.DaggerheartChase{}, storage.ErrNotFound
+}
+
+func (s *projectionDaggerheartStore) ListDaggerheartChases(_ context.Context, _ string) ([]storage.DaggerheartChase, error) {
+	return nil, nil
+}
+
+func (s *projectionDaggerheartStore) PutDaggerheartAdversaryFeatureState(_ context.Context, _ storage.DaggerheartAdversaryFeatureState) error {
+	return nil
+}
+
+func (s *projectionDaggerheartStore) ListDaggerheartAdversaryFeatureStates(_ context.Context, _ string) ([]storage.DaggerheartAdversaryFeatureState, error) {
+	return nil, nil
+}
+
 func newCampaignCreatedEvent(campaignID string, seq uint64) event.Event {
 	payload := event.CampaignCreatedPayload{
 		Name:       "Test Campaign",
@@ -695,6 +778,10 @@ func (s *errorEventStore) AppendEvent(context.Context, event.Event) (event.Event
 	return event.Event{}, s.err
 }
 
+func (s *errorEventStore) AppendEvents(context.Context, []event.Event) ([]event.Event, error) {
+	return nil, s.err
+}
+
 func (s *errorEventStore) GetEventByHash(context.Context, string) (event.Event, error) {
 	return event.Event{}, s.err
 }
@@ -38,6 +38,10 @@ func (a *Adapter) ApplyEvent(ctx context.Context, evt event.Event) error {
 	if a == nil || a.store == nil {
 		return fmt.Errorf("daggerheart store is not configured")
 	}
+	evt, err := event.Upcast(evt)
+	if err != nil {
+		return err
+	}
 	switch evt.Type {
 	case EventTypeDamageApplied:
 		return a.applyDamageApplied(ctx, evt)
@@ -63,8 +67,12 @@ func (a *Adapter) ApplyEvent(ctx context.Context, evt event.Event) error {
 		return a.applyBlazeOfGloryResolved(ctx, evt)
 	case EventTypeAttackResolved:
 		return a.applyAttackResolved(ctx, evt)
+	case EventTypeMultiAttackResolved:
+		return a.applyMultiAttackResolved(ctx, evt)
 	case EventTypeReactionResolved:
 		return a.applyReactionResolved(ctx, evt)
+	case EventTypeSpellcastResolved:
+		return a.applySpellcastResolved(ctx, evt)
 	case EventTypeDamageRollResolved:
 		return a.applyDamageRollResolved(ctx, evt)
 	case EventTypeGroupActionResolved:
@@ -77,6 +85,8 @@ func (a *Adapter) ApplyEvent(ctx context.Context, evt event.Event) error {
 		return a.applyCountdownUpdated(ctx, evt)
 	case EventTypeCountdownDeleted:
 		return a.applyCountdownDeleted(ctx, evt)
+	case EventTypeCountdownTriggered:
+		return a.applyCountdownTriggered(ctx, evt)
 	case EventTypeAdversaryRollResolved:
 		return a.applyAdversaryRollResolved(ctx, evt)
 	case EventTypeAdversaryAttackResolved:
@@ -89,6 +99,54 @@ func (a *Adapter) ApplyEvent(ctx context.Context, evt event.Event) error {
 		return a.applyAdversaryUpdated(ctx, evt)
 	case EventTypeAdversaryDeleted:
 		return a.applyAdversaryDeleted(ctx, evt)
+	case EventTypeAdversaryFeatureUsed:
+		return a.applyAdversaryFeatureUsed(ctx, evt)
+	case EventTypeAdversaryFeatureRefreshed:
+		return a.applyAdversaryFeatureRefreshed(ctx, evt)
+	case EventTypeCharacterLeveledUp:
+		return a.applyCharacterLeveledUp(ctx, evt)
+	case EventTypeSceneRangesSet:
+		return a.applySceneRangesSet(ctx, evt)
+	case EventTypeSceneEntityMoved:
+		return a.applySceneEntityMoved(ctx, evt)
+	case EventTypeEnvironmentActivated:
+		return a.applyEnvironmentActivated(ctx, evt)
+	case EventTypeEnvironmentShifted:
+		return a.applyEnvironmentShifted(ctx, evt)
+	case EventTypeEnvironmentCleared:
+		return a.applyEnvironmentCleared(ctx, evt)
+	case EventTypeEnvironmentFeatureUsed:
+		return a.applyEnvironmentFeatureUsed(ctx, evt)
+	case EventTypeItemAcquired:
+		return a.applyItemAcquired(ctx, evt)
+	case EventTypeItemDropped:
+		return a.applyItemDropped(ctx, evt)
+	case EventTypeItemTransferred:
+		return a.applyItemTransferred(ctx, evt)
+	case EventTypeItemEquipped:
+		return a.applyItemEquipped(ctx, evt)
+	case EventTypeItemUnequipped:
+		return a.applyItemUnequipped(ctx, evt)
+	case EventTypeGoldChanged:
+		return a.applyGoldChanged(ctx, evt)
+	case EventTypeCompanionCreated:
+		return a.applyCompanionCreated(ctx, evt)
+	case EventTypeCompanionLeveledUp:
+		return a.applyCompanionLeveledUp(ctx, evt)
+	case EventTypeCompanionStressChanged:
+		return a.applyCompanionStressChanged(ctx, evt)
+	case EventTypeBeastformEntered:
+		return a.applyBeastformEntered(ctx, evt)
+	case EventTypeBeastformExited:
+		return a.applyBeastformExited(ctx, evt)
+	case EventTypeEffectApplied:
+		return a.applyEffectApplied(ctx, evt)
+	case EventTypeEffectExpired:
+		return a.applyEffectExpired(ctx, evt)
+	case EventTypeChaseStarted:
+		return a.applyChaseStarted(ctx, evt)
+	case EventTypeChaseResolved:
+		return a.applyChaseResolved(ctx, evt)
 	default:
 		return nil
 	}
@@ -186,7 +244,38 @@ func (a *Adapter) applyLoadoutSwapped(ctx context.Context, evt event.Event) erro
 	if strings.TrimSpace(payload.CharacterID) == "" {
 		return fmt.Errorf("character_id is required")
 	}
-	return a.applyStatePatch(ctx, evt.CampaignID, payload.CharacterID, nil, nil, nil, payload.StressAfter, nil, nil)
+	if err := a.applyStatePatch(ctx, evt.CampaignID, payload.CharacterID, nil, nil, nil, payload.StressAfter, nil, nil); err != nil {
+		return err
+	}
+	return a.applyLoadoutMove(ctx, evt.CampaignID, payload.CharacterID, payload.CardID, payload.From, payload.To)
+}
+
+// applyLoadoutMove keeps the tracked loadout in sync with swaps. Cards that were
+// never acquired through progression are not tracked and are left alone.
+func (a *Adapter) applyLoadoutMove(ctx context.Context, campaignID, characterID, cardID, from, to string) error {
+	profile, err := a.store.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("get daggerheart character profile: %w", err)
+	}
+	loadout := Loadout{Active: profile.LoadoutActive, Vault: profile.LoadoutVault}
+	var updated Loadout
+	switch {
+	case from == "vault" && to == "active" && indexOf(loadout.Vault, cardID) != -1:
+		updated, err = loadout.MoveToActive(cardID)
+	case from == "active" && to == "vault" && indexOf(loadout.Active, cardID) != -1:
+		updated, err = loadout.MoveToVault(cardID)
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("loadout_swapped: %w", err)
+	}
+	profile.LoadoutActive = updated.Active
+	profile.LoadoutVault = updated.Vault
+	return a.store.PutDaggerheartCharacterProfile(ctx, profile)
 }
 
 func (a *Adapter) applyCharacterStatePatched(ctx context.Context, evt event.Event) error {
@@ -394,6 +483,39 @@ func (a *Adapter) applyAttackResolved(ctx context.Context, evt event.Event) erro
 	return nil
 }
 
+func (a *Adapter) applyMultiAttackResolved(ctx context.Context, evt event.Event) error {
+	var payload MultiAttackResolvedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode action.multi_attack_resolved payload: %w", err)
+	}
+	if strings.TrimSpace(payload.AttackerID) == "" {
+		return fmt.Errorf("attacker_id is required")
+	}
+	switch payload.AttackerType {
+	case MultiAttackTargetCharacter, MultiAttackTargetAdversary, MultiAttackAttackerCompanion:
+	default:
+		return fmt.Errorf("attacker_type must be character, adversary or companion")
+	}
+	if payload.RollSeq == 0 {
+		return fmt.Errorf("roll_seq is required")
+	}
+	if len(payload.Targets) == 0 {
+		return fmt.Errorf("targets are required")
+	}
+	for _, target := range payload.Targets {
+		if strings.TrimSpace(target.TargetID) == "" {
+			return fmt.Errorf("targets must not contain empty values")
+		}
+		if target.TargetType != MultiAttackTargetCharacter && target.TargetType != MultiAttackTargetAdversary {
+			return fmt.Errorf("target_type must be character or adversary")
+		}
+		if target.HPMarked < 0 || target.ArmorSpent < 0 {
+			return fmt.Errorf("hp_marked and armor_spent must be non-negative")
+		}
+	}
+	return nil
+}
+
 func (a *Adapter) applyReactionResolved(ctx context.Context, evt event.Event) error {
 	var payload ReactionResolvedPayload
 	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
@@ -411,6 +533,29 @@ func (a *Adapter) applyReactionResolved(ctx context.Context, evt event.Event) er
 	return nil
 }
 
+func (a *Adapter) applySpellcastResolved(ctx context.Context, evt event.Event) error {
+	var payload SpellcastResolvedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode action.spellcast_resolved payload: %w", err)
+	}
+	if strings.TrimSpace(payload.CharacterID) == "" {
+		return fmt.Errorf("character_id is required")
+	}
+	if payload.RollSeq == 0 {
+		return fmt.Errorf("roll_seq is required")
+	}
+	if strings.TrimSpace(payload.DomainCardID) == "" {
+		return fmt.Errorf("domain_card_id is required")
+	}
+	if _, err := NormalizeSpellcastTrait(payload.SpellcastTrait); err != nil {
+		return err
+	}
+	if strings.TrimSpace(payload.Outcome) == "" {
+		return fmt.Errorf("outcome is required")
+	}
+	return nil
+}
+
 func (a *Adapter) applyDamageRollResolved(ctx context.Context, evt event.Event) error {
 	var payload DamageRollResolvedPayload
 	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
@@ -508,16 +653,27 @@ func (a *Adapter) applyCountdownCreated(ctx context.Context, evt event.Event) er
 	if _, err := NormalizeCountdownDirection(payload.Direction); err != nil {
 		return err
 	}
+	policy, err := NormalizeCountdownTickPolicy(CountdownTickPolicy{
+		Trigger:       payload.TickTrigger,
+		Delta:         payload.TickDelta,
+		OutcomeDeltas: payload.TickOutcomeDeltas,
+	})
+	if err != nil {
+		return err
+	}
 
 	return a.store.PutDaggerheartCountdown(ctx, storage.DaggerheartCountdown{
-		CampaignID:  evt.CampaignID,
-		CountdownID: payload.CountdownID,
-		Name:        payload.Name,
-		Kind:        payload.Kind,
-		Current:     payload.Current,
-		Max:         payload.Max,
-		Direction:   payload.Direction,
-		Looping:     payload.Looping,
+		CampaignID:        evt.CampaignID,
+		CountdownID:       payload.CountdownID,
+		Name:              payload.Name,
+		Kind:              payload.Kind,
+		Current:           payload.Current,
+		Max:               payload.Max,
+		Direction:         payload.Direction,
+		Looping:           payload.Looping,
+		TickTrigger:       policy.Trigger,
+		TickDelta:         policy.Delta,
+		TickOutcomeDeltas: policy.OutcomeDeltas,
 	})
 }
 
@@ -547,6 +703,20 @@ func (a *Adapter) applyCountdownUpdated(ctx context.Context, evt event.Event) er
 	return a.store.PutDaggerheartCountdown(ctx, countdown)
 }
 
+func (a *Adapter) applyCountdownTriggered(ctx context.Context, evt event.Event) error {
+	var payload CountdownTriggeredPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode action.countdown_triggered payload: %w", err)
+	}
+	if strings.TrimSpace(payload.CountdownID) == "" {
+		return fmt.Errorf("countdown_id is required")
+	}
+	if _, err := NormalizeCountdownTrigger(payload.Trigger); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (a *Adapter) applyCountdownDeleted(ctx context.Context, evt event.Event) error {
 	var payload CountdownDeletedPayload
 	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
@@ -622,27 +792,76 @@ func (a *Adapter) applyAdversaryCreated(ctx context.Context, evt event.Event) er
 	if err := validateAdversaryStats(payload.HP, payload.HPMax, payload.Stress, payload.StressMax, payload.Evasion, payload.Major, payload.Severe, payload.Armor); err != nil {
 		return err
 	}
+	if payload.MinionThreshold < 0 {
+		return fmt.Errorf("minion_threshold must be non-negative")
+	}
 	createdAt := evt.Timestamp.UTC()
 	return a.store.PutDaggerheartAdversary(ctx, storage.DaggerheartAdversary{
-		CampaignID:  evt.CampaignID,
-		AdversaryID: adversaryID,
-		Name:        name,
-		Kind:        strings.TrimSpace(payload.Kind),
-		SessionID:   strings.TrimSpace(payload.SessionID),
-		Notes:       strings.TrimSpace(payload.Notes),
-		HP:          payload.HP,
-		HPMax:       payload.HPMax,
-		Stress:      payload.Stress,
-		StressMax:   payload.StressMax,
-		Evasion:     payload.Evasion,
-		Major:       payload.Major,
-		Severe:      payload.Severe,
-		Armor:       payload.Armor,
-		CreatedAt:   createdAt,
-		UpdatedAt:   createdAt,
+		CampaignID:       evt.CampaignID,
+		AdversaryID:      adversaryID,
+		Name:             name,
+		Kind:             strings.TrimSpace(payload.Kind),
+		SessionID:        strings.TrimSpace(payload.SessionID),
+		Notes:            strings.TrimSpace(payload.Notes),
+		HP:               payload.HP,
+		HPMax:            payload.HPMax,
+		Stress:           payload.Stress,
+		StressMax:        payload.StressMax,
+		Evasion:          payload.Evasion,
+		Major:            payload.Major,
+		Severe:           payload.Severe,
+		Armor:            payload.Armor,
+		MinionThreshold:  payload.MinionThreshold,
+		AdversaryEntryID: strings.TrimSpace(payload.AdversaryEntryID),
+		AttackModifier:   payload.AttackModifier,
+		StandardAttack:   adversaryAttackToStorage(payload.StandardAttack),
+		Experiences:      adversaryExperiencesToStorage(payload.Experiences),
+		Features:         adversaryFeaturesToStorage(payload.Features),
+		CreatedAt:        createdAt,
+		UpdatedAt:        createdAt,
 	})
 }
 
+func adversaryAttackToStorage(attack *AdversaryAttack) storage.DaggerheartAdversaryAttack {
+	if attack == nil {
+		return storage.DaggerheartAdversaryAttack{}
+	}
+	var dice []storage.DaggerheartDamageDie
+	for _, die := range attack.DamageDice {
+		dice = append(dice, storage.DaggerheartDamageDie{Sides: die.Sides, Count: die.Count})
+	}
+	return storage.DaggerheartAdversaryAttack{
+		Name:        attack.Name,
+		Range:       attack.Range,
+		DamageDice:  dice,
+		DamageBonus: attack.DamageBonus,
+		DamageType:  attack.DamageType,
+	}
+}
+
+func adversaryExperiencesToStorage(experiences []AdversaryExperience) []storage.DaggerheartAdversaryExperience {
+	var items []storage.DaggerheartAdversaryExperience
+	for _, experience := range experiences {
+		items = append(items, storage.DaggerheartAdversaryExperience{Name: experience.Name, Modifier: experience.Modifier})
+	}
+	return items
+}
+
+func adversaryFeaturesToStorage(features []AdversaryFeature) []storage.DaggerheartAdversaryFeature {
+	var items []storage.DaggerheartAdversaryFeature
+	for _, feature := range features {
+		items = append(items, storage.DaggerheartAdversaryFeature{
+			ID:          feature.ID,
+			Name:        feature.Name,
+			Kind:        feature.Kind,
+			Description: feature.Description,
+			CostType:    feature.CostType,
+			Cost:        feature.Cost,
+		})
+	}
+	return items
+}
+
 func (a *Adapter) applyAdversaryUpdated(ctx context.Context, evt event.Event) error {
 	var payload AdversaryUpdatedPayload
 	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
@@ -659,29 +878,38 @@ func (a *Adapter) applyAdversaryUpdated(ctx context.Context, evt event.Event) er
 	if err := validateAdversaryStats(payload.HP, payload.HPMax, payload.Stress, payload.StressMax, payload.Evasion, payload.Major, payload.Severe, payload.Armor); err != nil {
 		return err
 	}
+	if payload.MinionThreshold < 0 {
+		return fmt.Errorf("minion_threshold must be non-negative")
+	}
 	current, err := a.store.GetDaggerheartAdversary(ctx, evt.CampaignID, adversaryID)
 	if err != nil {
 		return err
 	}
 	updatedAt := evt.Timestamp.UTC()
 	return a.store.PutDaggerheartAdversary(ctx, storage.DaggerheartAdversary{
-		CampaignID:  evt.CampaignID,
-		AdversaryID: adversaryID,
-		Name:        name,
-		Kind:        strings.TrimSpace(payload.Kind),
-		SessionID:   strings.TrimSpace(payload.SessionID),
-		Notes:       strings.TrimSpace(payload.Notes),
-		HP:          payload.HP,
-		HPMax:       payload.HPMax,
-		Stress:      payload.Stress,
-		StressMax:   payload.StressMax,
-		Evasion:     payload.Evasion,
-		Major:       payload.Major,
-		Severe:      payload.Severe,
-		Armor:       payload.Armor,
-		Conditions:  current.Conditions,
-		CreatedAt:   current.CreatedAt,
-		UpdatedAt:   updatedAt,
+		CampaignID:       evt.CampaignID,
+		AdversaryID:      adversaryID,
+		Name:             name,
+		Kind:             strings.TrimSpace(payload.Kind),
+		SessionID:        strings.TrimSpace(payload.SessionID),
+		Notes:            strings.TrimSpace(payload.Notes),
+		HP:               payload.HP,
+		HPMax:            payload.HPMax,
+		Stress:           payload.Stress,
+		StressMax:        payload.StressMax,
+		Evasion:          payload.Evasion,
+		Major:            payload.Major,
+		Severe:           payload.Severe,
+		Armor:            payload.Armor,
+		Conditions:       current.Conditions,
+		MinionThreshold:  payload.MinionThreshold,
+		AdversaryEntryID: current.AdversaryEntryID,
+		AttackModifier:   current.AttackModifier,
+		StandardAttack:   current.StandardAttack,
+		Experiences:      current.Experiences,
+		Features:         current.Features,
+		CreatedAt:        current.CreatedAt,
+		UpdatedAt:        updatedAt,
 	})
 }
 
@@ -714,23 +942,29 @@ func (a *Adapter) applyAdversaryDamageApplied(ctx context.Context, evt event.Eve
 	}
 	updatedAt := evt.Timestamp.UTC()
 	return a.store.PutDaggerheartAdversary(ctx, storage.DaggerheartAdversary{
-		CampaignID:  evt.CampaignID,
-		AdversaryID: adversaryID,
-		Name:        current.Name,
-		Kind:        current.Kind,
-		SessionID:   current.SessionID,
-		Notes:       current.Notes,
-		HP:          hp,
-		HPMax:       current.HPMax,
-		Stress:      current.Stress,
-		StressMax:   current.StressMax,
-		Evasion:     current.Evasion,
-		Major:       current.Major,
-		Severe:      current.Severe,
-		Armor:       armor,
-		Conditions:  current.Conditions,
-		CreatedAt:   current.CreatedAt,
-		UpdatedAt:   updatedAt,
+		CampaignID:       evt.CampaignID,
+		AdversaryID:      adversaryID,
+		Name:             current.Name,
+		Kind:             current.Kind,
+		SessionID:        current.SessionID,
+		Notes:            current.Notes,
+		HP:               hp,
+		HPMax:            current.HPMax,
+		Stress:           current.Stress,
+		StressMax:        current.StressMax,
+		Evasion:          current.Evasion,
+		Major:            current.Major,
+		Severe:           current.Severe,
+		Armor:            armor,
+		Conditions:       current.Conditions,
+		MinionThreshold:  current.MinionThreshold,
+		AdversaryEntryID: current.AdversaryEntryID,
+		AttackModifier:   current.AttackModifier,
+		StandardAttack:   current.StandardAttack,
+		Experiences:      current.Experiences,
+		Features:         current.Features,
+		CreatedAt:        current.CreatedAt,
+		UpdatedAt:        updatedAt,
 	})
 }
 
@@ -774,6 +1008,209 @@ func (a *Adapter) applyAdversaryDeleted(ctx context.Context, evt event.Event) er
 	return a.store.DeleteDaggerheartAdversary(ctx, evt.CampaignID, adversaryID)
 }
 
+func (a *Adapter) applyAdversaryFeatureUsed(ctx context.Context, evt event.Event) error {
+	var payload AdversaryFeatureUsedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode action.adversary_feature_used payload: %w", err)
+	}
+	adversaryID := strings.TrimSpace(payload.AdversaryID)
+	if adversaryID == "" {
+		return fmt.Errorf("adversary_id is required")
+	}
+	featureID := strings.TrimSpace(payload.FeatureID)
+	if featureID == "" {
+		return fmt.Errorf("feature_id is required")
+	}
+	if payload.StressAfter != nil || payload.EvasionAfter != nil {
+		adversary, err := a.store.GetDaggerheartAdversary(ctx, evt.CampaignID, adversaryID)
+		if err != nil {
+			return err
+		}
+		if payload.StressAfter != nil {
+			adversary.Stress = *payload.StressAfter
+		}
+		if payload.EvasionAfter != nil {
+			adversary.Evasion = *payload.EvasionAfter
+		}
+		if err := validateAdversaryStats(adversary.HP, adversary.HPMax, adversary.Stress, adversary.StressMax, adversary.Evasion, adversary.Major, adversary.Severe, adversary.Armor); err != nil {
+			return err
+		}
+		adversary.UpdatedAt = evt.Timestamp.UTC()
+		if err := a.store.PutDaggerheartAdversary(ctx, adversary); err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(payload.RefreshOn) == "" {
+		return nil
+	}
+	return a.store.PutDaggerheartAdversaryFeatureState(ctx, storage.DaggerheartAdversaryFeatureState{
+		CampaignID:  evt.CampaignID,
+		AdversaryID: adversaryID,
+		FeatureID:   featureID,
+		Status:      AdversaryFeatureStatusSpent,
+		RefreshOn:   strings.TrimSpace(payload.RefreshOn),
+	})
+}
+
+func (a *Adapter) applyAdversaryFeatureRefreshed(ctx context.Context, evt event.Event) error {
+	var payload AdversaryFeatureRefreshedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode action.adversary_feature_refreshed payload: %w", err)
+	}
+	adversaryID := strings.TrimSpace(payload.AdversaryID)
+	if adversaryID == "" {
+		return fmt.Errorf("adversary_id is required")
+	}
+	featureID := strings.TrimSpace(payload.FeatureID)
+	if featureID == "" {
+		return fmt.Errorf("feature_id is required")
+	}
+	states, err := a.store.ListDaggerheartAdversaryFeatureStates(ctx, evt.CampaignID)
+	if err != nil {
+		return err
+	}
+	for _, state := range states {
+		if state.AdversaryID == adversaryID && state.FeatureID == featureID {
+			state.Status = AdversaryFeatureStatusReady
+			return a.store.PutDaggerheartAdversaryFeatureState(ctx, state)
+		}
+	}
+	return nil
+}
+
+func (a *Adapter) applyCharacterLeveledUp(ctx context.Context, evt event.Event) error {
+	var payload CharacterLeveledUpPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode character.leveled_up payload: %w", err)
+	}
+	characterID := strings.TrimSpace(payload.CharacterID)
+	if characterID == "" {
+		return fmt.Errorf("character_id is required")
+	}
+	if payload.LevelAfter != payload.LevelBefore+1 {
+		return fmt.Errorf("leveled_up level_after must be level_before + 1")
+	}
+	if err := ValidateLevel(payload.LevelAfter); err != nil {
+		return fmt.Errorf("leveled_up level_after: %w", err)
+	}
+	if payload.Tier != TierForLevel(payload.LevelAfter) {
+		return fmt.Errorf("leveled_up tier must match level_after")
+	}
+	for _, advancement := range payload.Advancements {
+		if _, err := NormalizeAdvancementType(advancement.Type); err != nil {
+			return fmt.Errorf("leveled_up advancements: %w", err)
+		}
+	}
+	if len(payload.LoadoutActiveAfter) > LoadoutMaxCards {
+		return fmt.Errorf("leveled_up loadout_active_after: %w", ErrLoadoutFull)
+	}
+
+	profile, err := a.store.GetDaggerheartCharacterProfile(ctx, evt.CampaignID, characterID)
+	if err != nil {
+		return fmt.Errorf("get daggerheart character profile: %w", err)
+	}
+	levelBefore := profile.Level
+	if levelBefore == 0 {
+		levelBefore = PCLevelDefault
+	}
+	if levelBefore != payload.LevelBefore {
+		return fmt.Errorf("leveled_up level_before mismatch")
+	}
+
+	experiences := make([]Experience, 0, len(payload.ExperiencesAfter))
+	experienceStorage := make([]storage.DaggerheartExperience, 0, len(payload.ExperiencesAfter))
+	for _, experience := range payload.ExperiencesAfter {
+		experiences = append(experiences, Experience{Name: experience.Name, Modifier: experience.Modifier})
+		experienceStorage = append(experienceStorage, storage.DaggerheartExperience{Name: experience.Name, Modifier: experience.Modifier})
+	}
+	traits := Traits{
+		Agility:   payload.TraitsAfter["agility"],
+		Strength:  payload.TraitsAfter["strength"],
+		Finesse:   payload.TraitsAfter["finesse"],
+		Instinct:  payload.TraitsAfter["instinct"],
+		Presence:  payload.TraitsAfter["presence"],
+		Knowledge: payload.TraitsAfter["knowledge"],
+	}
+	if err := ValidateProfile(
+		payload.LevelAfter,
+		payload.HpMaxAfter,
+		payload.StressMaxAfter,
+		payload.EvasionAfter,
+		payload.MajorThresholdAfter,
+		payload.SevereThresholdAfter,
+		payload.ProficiencyAfter,
+		profile.ArmorScore,
+		profile.ArmorMax,
+		traits,
+		experiences,
+	); err != nil {
+		return fmt.Errorf("validate leveled_up payload: %w", err)
+	}
+
+	advancements := make([]storage.DaggerheartAdvancement, 0, len(payload.Advancements))
+	for _, advancement := range payload.Advancements {
+		advancements = append(advancements, storage.DaggerheartAdvancement{
+			Type:         advancement.Type,
+			Traits:       advancement.Traits,
+			Experiences:  advancement.Experiences,
+			DomainCardID: advancement.DomainCardID,
+			SubclassID:   advancement.SubclassID,
+			ClassID:      advancement.ClassID,
+			DomainID:     advancement.DomainID,
+		})
+	}
+	for _, stage := range []string{payload.SubclassStageAfter, payload.MulticlassSubclassStageAfter} {
+		if stage == "" {
+			continue
+		}
+		if _, err := NormalizeSubclassStage(stage); err != nil {
+			return fmt.Errorf("leveled_up: %w", err)
+		}
+	}
+
+	profile.Level = payload.LevelAfter
+	profile.HpMax = payload.HpMaxAfter
+	profile.StressMax = payload.StressMaxAfter
+	profile.Evasion = payload.EvasionAfter
+	profile.Proficiency = payload.ProficiencyAfter
+	profile.MajorThreshold = payload.MajorThresholdAfter
+	profile.SevereThreshold = payload.SevereThresholdAfter
+	profile.Agility = traits.Agility
+	profile.Strength = traits.Strength
+	profile.Finesse = traits.Finesse
+	profile.Instinct = traits.Instinct
+	profile.Presence = traits.Presence
+	profile.Knowledge = traits.Knowledge
+	profile.Experiences = experienceStorage
+	profile.MarkedTraits = payload.MarkedTraitsAfter
+	profile.LoadoutActive = payload.LoadoutActiveAfter
+	profile.LoadoutVault = payload.LoadoutVaultAfter
+	if payload.SubclassStageAfter != "" {
+		profile.SubclassStage = payload.SubclassStageAfter
+	}
+	if payload.MulticlassClassIDAfter != "" {
+		profile.MulticlassClassID = payload.MulticlassClassIDAfter
+		profile.MulticlassSubclassID = payload.MulticlassSubclassIDAfter
+		profile.MulticlassDomainID = payload.MulticlassDomainIDAfter
+	}
+	if payload.MulticlassSubclassStageAfter != "" {
+		profile.MulticlassSubclassStage = payload.MulticlassSubclassStageAfter
+	}
+	profile.LevelHistory = append(profile.LevelHistory, storage.DaggerheartLevelUp{
+		Level:         payload.LevelAfter,
+		Advancements:  advancements,
+		NewExperience: payload.NewExperience,
+		DomainCardID:  payload.DomainCardID,
+	})
+	if err := a.store.PutDaggerheartCharacterProfile(ctx, profile); err != nil {
+		return fmt.Errorf("put daggerheart character profile: %w", err)
+	}
+	if payload.HpAfter != nil {
+		return a.applyStatePatch(ctx, evt.CampaignID, characterID, payload.HpAfter, nil, nil, nil, nil, nil)
+	}
+	return nil
+}
+
 func (a *Adapter) applyStatePatch(ctx context.Context, campaignID, characterID string, hpAfter, hopeAfter, hopeMaxAfter, stressAfter, armorAfter *int, lifeStateAfter *string) error {
 	state, err := a.store.GetDaggerheartCharacterState(ctx, campaignID, characterID)
 	if err != nil {
@@ -859,3 +1296,595 @@ func (a *Adapter) applyAdversaryConditionPatch(ctx context.Context, campaignID,
 }
 
 var _ systems.Adapter = (*Adapter)(nil)
+
+func (a *Adapter) applySceneRangesSet(ctx context.Context, evt event.Event) error {
+	var payload SceneRangesSetPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode scene.ranges_set payload: %w", err)
+	}
+	if len(payload.Ranges) == 0 {
+		return fmt.Errorf("ranges are required")
+	}
+	return a.putSceneRanges(ctx, evt, payload.Ranges)
+}
+
+func (a *Adapter) applySceneEntityMoved(ctx context.Context, evt event.Event) error {
+	var payload SceneEntityMovedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode scene.entity_moved payload: %w", err)
+	}
+	if strings.TrimSpace(payload.EntityID) == "" {
+		return fmt.Errorf("entity_id is required")
+	}
+	if !IsSceneEntityType(payload.EntityType) {
+		return fmt.Errorf("entity_type %q is invalid", payload.EntityType)
+	}
+	if !IsMovementKind(payload.Kind) {
+		return fmt.Errorf("movement kind %q is invalid", payload.Kind)
+	}
+	if payload.SourceType != "" && !IsSceneEntityType(payload.SourceType) {
+		return fmt.Errorf("source_type %q is invalid", payload.SourceType)
+	}
+	if len(payload.Ranges) == 0 {
+		return fmt.Errorf("ranges are required")
+	}
+	for _, sceneRange := range payload.Ranges {
+		if sceneRange.FromID != payload.EntityID && sceneRange.ToID != payload.EntityID {
+			return fmt.Errorf("ranges must involve the moved entity")
+		}
+	}
+	return a.putSceneRanges(ctx, evt, payload.Ranges)
+}
+
+// putSceneRanges validates and stores ranges with the pair ordered by id, so
+// each unordered pair has a single row.
+func (a *Adapter) putSceneRanges(ctx context.Context, evt event.Event, ranges []SceneRange) error {
+	if strings.TrimSpace(evt.SessionID) == "" {
+		return fmt.Errorf("session id is required for scene ranges")
+	}
+	for _, sceneRange := range ranges {
+		if strings.TrimSpace(sceneRange.FromID) == "" || strings.TrimSpace(sceneRange.ToID) == "" {
+			return fmt.Errorf("scene range ids are required")
+		}
+		if sceneRange.FromID == sceneRange.ToID {
+			return fmt.Errorf("scene range must join two different entities")
+		}
+		if !IsSceneEntityType(sceneRange.FromType) || !IsSceneEntityType(sceneRange.ToType) {
+			return fmt.Errorf("scene range entity types are invalid")
+		}
+		if RangeBandIndex(sceneRange.Band) < 0 {
+			return fmt.Errorf("scene range band %q is invalid", sceneRange.Band)
+		}
+	}
+	for _, sceneRange := range ranges {
+		record := storage.DaggerheartSceneRange{
+			CampaignID: evt.CampaignID,
+			SessionID:  evt.SessionID,
+			FromID:     sceneRange.FromID,
+			FromType:   sceneRange.FromType,
+			ToID:       sceneRange.ToID,
+			ToType:     sceneRange.ToType,
+			Band:       sceneRange.Band,
+		}
+		if record.FromID > record.ToID {
+			record.FromID, record.ToID = record.ToID, record.FromID
+			record.FromType, record.ToType = record.ToType, record.FromType
+		}
+		if err := a.store.PutDaggerheartSceneRange(ctx, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *Adapter) applyEnvironmentActivated(ctx context.Context, evt event.Event) error {
+	var payload EnvironmentActivatedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode environment.activated payload: %w", err)
+	}
+	return a.putSessionEnvironment(ctx, evt, payload.EnvironmentID, payload.Name, payload.Tier, payload.Type, payload.Difficulty)
+}
+
+func (a *Adapter) applyEnvironmentShifted(ctx context.Context, evt event.Event) error {
+	var payload EnvironmentShiftedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode environment.shifted payload: %w", err)
+	}
+	if strings.TrimSpace(payload.FromEnvironmentID) == "" {
+		return fmt.Errorf("from_environment_id is required")
+	}
+	return a.putSessionEnvironment(ctx, evt, payload.EnvironmentID, payload.Name, payload.Tier, payload.Type, payload.Difficulty)
+}
+
+func (a *Adapter) applyEnvironmentCleared(ctx context.Context, evt event.Event) error {
+	var payload EnvironmentClearedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode environment.cleared payload: %w", err)
+	}
+	if strings.TrimSpace(payload.EnvironmentID) == "" {
+		return fmt.Errorf("environment_id is required")
+	}
+	if strings.TrimSpace(evt.SessionID) == "" {
+		return fmt.Errorf("session id is required for session environments")
+	}
+	return a.store.DeleteDaggerheartSessionEnvironment(ctx, evt.CampaignID, evt.SessionID)
+}
+
+func (a *Adapter) applyEnvironmentFeatureUsed(ctx context.Context, evt event.Event) error {
+	var payload EnvironmentFeatureUsedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode environment.feature_used payload: %w", err)
+	}
+	if strings.TrimSpace(payload.EnvironmentID) == "" {
+		return fmt.Errorf("environment_id is required")
+	}
+	if strings.TrimSpace(payload.FeatureID) == "" {
+		return fmt.Errorf("feature_id is required")
+	}
+	if !IsEnvironmentFeatureKind(payload.Kind) {
+		return fmt.Errorf("environment feature kind %q is invalid", payload.Kind)
+	}
+	if payload.FearCost < 0 {
+		return fmt.Errorf("environment feature fear_cost must be non-negative")
+	}
+	return nil
+}
+
+func (a *Adapter) putSessionEnvironment(ctx context.Context, evt event.Event, environmentID, name string, tier int, envType string, difficulty int) error {
+	if strings.TrimSpace(evt.SessionID) == "" {
+		return fmt.Errorf("session id is required for session environments")
+	}
+	if strings.TrimSpace(environmentID) == "" {
+		return fmt.Errorf("environment_id is required")
+	}
+	if difficulty < 0 {
+		return fmt.Errorf("environment difficulty must be non-negative")
+	}
+	return a.store.PutDaggerheartSessionEnvironment(ctx, storage.DaggerheartSessionEnvironment{
+		CampaignID:    evt.CampaignID,
+		SessionID:     evt.SessionID,
+		EnvironmentID: environmentID,
+		Name:          name,
+		Tier:          tier,
+		Type:          envType,
+		Difficulty:    difficulty,
+	})
+}
+
+func (a *Adapter) applyItemAcquired(ctx context.Context, evt event.Event) error {
+	var payload ItemAcquiredPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode inventory.item_acquired payload: %w", err)
+	}
+	if payload.Quantity <= 0 {
+		return fmt.Errorf("item_acquired quantity must be positive")
+	}
+	if payload.QuantityAfter < payload.Quantity {
+		return fmt.Errorf("item_acquired quantity_after must include the acquired quantity")
+	}
+	return a.putInventoryQuantity(ctx, evt.CampaignID, payload.CharacterID, payload.ItemID, payload.Kind, payload.QuantityAfter)
+}
+
+func (a *Adapter) applyItemDropped(ctx context.Context, evt event.Event) error {
+	var payload ItemDroppedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode inventory.item_dropped payload: %w", err)
+	}
+	if payload.Quantity <= 0 {
+		return fmt.Errorf("item_dropped quantity must be positive")
+	}
+	return a.putInventoryQuantity(ctx, evt.CampaignID, payload.CharacterID, payload.ItemID, payload.Kind, payload.QuantityAfter)
+}
+
+func (a *Adapter) applyItemTransferred(ctx context.Context, evt event.Event) error {
+	var payload ItemTransferredPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode inventory.item_transferred payload: %w", err)
+	}
+	if payload.Quantity <= 0 {
+		return fmt.Errorf("item_transferred quantity must be positive")
+	}
+	if strings.TrimSpace(payload.FromCharacterID) == strings.TrimSpace(payload.ToCharacterID) {
+		return fmt.Errorf("item_transferred requires two different characters")
+	}
+	if payload.ToQuantityAfter < payload.Quantity {
+		return fmt.Errorf("item_transferred to_quantity_after must include the transferred quantity")
+	}
+	if err := a.putInventoryQuantity(ctx, evt.CampaignID, payload.FromCharacterID, payload.ItemID, payload.Kind, payload.FromQuantityAfter); err != nil {
+		return err
+	}
+	return a.putInventoryQuantity(ctx, evt.CampaignID, payload.ToCharacterID, payload.ItemID, payload.Kind, payload.ToQuantityAfter)
+}
+
+func (a *Adapter) applyItemEquipped(ctx context.Context, evt event.Event) error {
+	var payload ItemEquippedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode inventory.item_equipped payload: %w", err)
+	}
+	if strings.TrimSpace(payload.ItemID) == "" {
+		return fmt.Errorf("item_id is required")
+	}
+	return a.putEquipmentSlot(ctx, evt.CampaignID, payload.CharacterID, payload.Slot, payload.ItemID, payload.Armor)
+}
+
+func (a *Adapter) applyItemUnequipped(ctx context.Context, evt event.Event) error {
+	var payload ItemUnequippedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode inventory.item_unequipped payload: %w", err)
+	}
+	if strings.TrimSpace(payload.ItemID) == "" {
+		return fmt.Errorf("item_id is required")
+	}
+	return a.putEquipmentSlot(ctx, evt.CampaignID, payload.CharacterID, payload.Slot, "", payload.Armor)
+}
+
+func (a *Adapter) applyGoldChanged(ctx context.Context, evt event.Event) error {
+	var payload GoldChangedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode inventory.gold_changed payload: %w", err)
+	}
+	if strings.TrimSpace(payload.CharacterID) == "" {
+		return fmt.Errorf("character_id is required")
+	}
+	if payload.HandfulsAfter < 0 || payload.BagsAfter < 0 || payload.ChestsAfter < 0 {
+		return fmt.Errorf("gold_changed amounts must be non-negative")
+	}
+	state, err := a.loadCharacterState(ctx, evt.CampaignID, payload.CharacterID)
+	if err != nil {
+		return err
+	}
+	state.GoldHandfuls = payload.HandfulsAfter
+	state.GoldBags = payload.BagsAfter
+	state.GoldChests = payload.ChestsAfter
+	return a.store.PutDaggerheartCharacterState(ctx, state)
+}
+
+func (a *Adapter) applyCompanionCreated(ctx context.Context, evt event.Event) error {
+	var payload CompanionCreatedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode companion.created payload: %w", err)
+	}
+	if strings.TrimSpace(payload.CharacterID) == "" {
+		return fmt.Errorf("character_id is required")
+	}
+	if strings.TrimSpace(payload.Name) == "" {
+		return fmt.Errorf("companion name is required")
+	}
+	return a.store.PutDaggerheartCompanion(ctx, storage.DaggerheartCompanion{
+		CampaignID:     evt.CampaignID,
+		CharacterID:    payload.CharacterID,
+		Name:           payload.Name,
+		Evasion:        payload.Evasion,
+		StressMax:      payload.StressMax,
+		DamageDieSides: payload.DamageDieSides,
+		Range:          payload.Range,
+		Experiences:    CompanionExperiencesToStorage(payload.Experiences),
+		Level:          1,
+	})
+}
+
+func (a *Adapter) applyCompanionLeveledUp(ctx context.Context, evt event.Event) error {
+	var payload CompanionLeveledUpPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode companion.leveled_up payload: %w", err)
+	}
+	if !IsCompanionUpgrade(payload.Upgrade) {
+		return fmt.Errorf("companion upgrade %q is invalid", payload.Upgrade)
+	}
+	companion, err := a.store.GetDaggerheartCompanion(ctx, evt.CampaignID, payload.CharacterID)
+	if err != nil {
+		return fmt.Errorf("get daggerheart companion: %w", err)
+	}
+	companion.Level = payload.LevelAfter
+	companion.Evasion = payload.EvasionAfter
+	companion.StressMax = payload.StressMaxAfter
+	companion.Stress = min(companion.Stress, companion.StressMax)
+	companion.DamageDieSides = payload.DamageDieSidesAfter
+	companion.Range = payload.RangeAfter
+	companion.Experiences = CompanionExperiencesToStorage(payload.ExperiencesAfter)
+	companion.Upgrades = append(companion.Upgrades, payload.Upgrade)
+	return a.store.PutDaggerheartCompanion(ctx, companion)
+}
+
+func (a *Adapter) applyCompanionStressChanged(ctx context.Context, evt event.Event) error {
+	var payload CompanionStressChangedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode companion.stress_changed payload: %w", err)
+	}
+	companion, err := a.store.GetDaggerheartCompanion(ctx, evt.CampaignID, payload.CharacterID)
+	if err != nil {
+		return fmt.Errorf("get daggerheart companion: %w", err)
+	}
+	if payload.StressAfter < 0 || payload.StressAfter > companion.StressMax {
+		return fmt.Errorf("companion stress_after must be in range 0..%d", companion.StressMax)
+	}
+	companion.Stress = payload.StressAfter
+	return a.store.PutDaggerheartCompanion(ctx, companion)
+}
+
+func (a *Adapter) applyBeastformEntered(ctx context.Context, evt event.Event) error {
+	var payload BeastformEnteredPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode beastform.entered payload: %w", err)
+	}
+	if strings.TrimSpace(payload.CharacterID) == "" {
+		return fmt.Errorf("character_id is required")
+	}
+	if strings.TrimSpace(payload.BeastformID) == "" {
+		return fmt.Errorf("beastform_id is required")
+	}
+	if payload.StressAfter < StressMin || payload.StressAfter > StressMaxCap {
+		return fmt.Errorf("beastform stress_after must be in range %d..%d", StressMin, StressMaxCap)
+	}
+	state, err := a.loadCharacterState(ctx, evt.CampaignID, payload.CharacterID)
+	if err != nil {
+		return err
+	}
+	state.BeastformID = payload.BeastformID
+	state.Stress = payload.StressAfter
+	return a.store.PutDaggerheartCharacterState(ctx, state)
+}
+
+func (a *Adapter) applyBeastformExited(ctx context.Context, evt event.Event) error {
+	var payload BeastformExitedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode beastform.exited payload: %w", err)
+	}
+	if strings.TrimSpace(payload.CharacterID) == "" {
+		return fmt.Errorf("character_id is required")
+	}
+	state, err := a.loadCharacterState(ctx, evt.CampaignID, payload.CharacterID)
+	if err != nil {
+		return err
+	}
+	state.BeastformID = ""
+	return a.store.PutDaggerheartCharacterState(ctx, state)
+}
+
+func (a *Adapter) applyEffectApplied(ctx context.Context, evt event.Event) error {
+	var payload EffectAppliedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode effect.applied payload: %w", err)
+	}
+	if strings.TrimSpace(payload.EffectID) == "" {
+		return fmt.Errorf("effect_id is required")
+	}
+	if strings.TrimSpace(payload.TargetID) == "" {
+		return fmt.Errorf("target_id is required")
+	}
+	targetType, err := NormalizeEffectTargetType(payload.TargetType)
+	if err != nil {
+		return err
+	}
+	kind, err := NormalizeEffectKind(payload.Kind)
+	if err != nil {
+		return err
+	}
+	stacking, err := NormalizeEffectStacking(payload.Stacking)
+	if err != nil {
+		return err
+	}
+	expiresOn, err := NormalizeEffectExpiry(payload.ExpiresOn)
+	if err != nil {
+		return err
+	}
+	if err := a.store.PutDaggerheartEffect(ctx, storage.DaggerheartEffect{
+		CampaignID: evt.CampaignID,
+		EffectID:   payload.EffectID,
+		SessionID:  evt.SessionID,
+		TargetType: targetType,
+		TargetID:   payload.TargetID,
+		Source:     payload.Source,
+		Kind:       kind,
+		Value:      payload.Value,
+		Stacking:   stacking,
+		ExpiresOn:  expiresOn,
+	}); err != nil {
+		return err
+	}
+	return a.applyEffectArmor(ctx, evt.CampaignID, payload.TargetID, payload.ArmorAfter)
+}
+
+func (a *Adapter) applyEffectExpired(ctx context.Context, evt event.Event) error {
+	var payload EffectExpiredPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode effect.expired payload: %w", err)
+	}
+	if strings.TrimSpace(payload.EffectID) == "" {
+		return fmt.Errorf("effect_id is required")
+	}
+	if err := a.store.DeleteDaggerheartEffect(ctx, evt.CampaignID, payload.EffectID); err != nil {
+		return err
+	}
+	return a.applyEffectArmor(ctx, evt.CampaignID, payload.TargetID, payload.ArmorAfter)
+}
+
+func (a *Adapter) applyChaseStarted(ctx context.Context, evt event.Event) error {
+	var payload ChaseStartedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode chase.started payload: %w", err)
+	}
+	if strings.TrimSpace(payload.ChaseID) == "" {
+		return fmt.Errorf("chase_id is required")
+	}
+	if strings.TrimSpace(payload.ProgressCountdownID) == "" {
+		return fmt.Errorf("progress_countdown_id is required")
+	}
+	if strings.TrimSpace(payload.ConsequenceCountdownID) == "" {
+		return fmt.Errorf("consequence_countdown_id is required")
+	}
+	if payload.ProgressCountdownID == payload.ConsequenceCountdownID {
+		return fmt.Errorf("chase countdowns must differ")
+	}
+	return a.store.PutDaggerheartChase(ctx, storage.DaggerheartChase{
+		CampaignID:             evt.CampaignID,
+		ChaseID:                payload.ChaseID,
+		Name:                   payload.Name,
+		ProgressCountdownID:    payload.ProgressCountdownID,
+		ConsequenceCountdownID: payload.ConsequenceCountdownID,
+		Status:                 ChaseStatusActive,
+	})
+}
+
+func (a *Adapter) applyChaseResolved(ctx context.Context, evt event.Event) error {
+	var payload ChaseResolvedPayload
+	if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+		return fmt.Errorf("decode chase.resolved payload: %w", err)
+	}
+	if strings.TrimSpace(payload.ChaseID) == "" {
+		return fmt.Errorf("chase_id is required")
+	}
+	winner, err := NormalizeChaseWinner(payload.Winner)
+	if err != nil {
+		return err
+	}
+	chase, err := a.store.GetDaggerheartChase(ctx, evt.CampaignID, payload.ChaseID)
+	if err != nil {
+		return err
+	}
+	chase.Status = ChaseStatusResolved
+	chase.Winner = winner
+	return a.store.PutDaggerheartChase(ctx, chase)
+}
+
+// applyEffectArmor stores the Armor Slots an armor effect left the character with.
+func (a *Adapter) applyEffectArmor(ctx context.Context, campaignID, characterID string, armorAfter *int) error {
+	if armorAfter == nil {
+		return nil
+	}
+	if *armorAfter < 0 {
+		return fmt.Errorf("effect armor_after must be non-negative")
+	}
+	state, err := a.loadCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		return err
+	}
+	state.Armor = *armorAfter
+	return a.store.PutDaggerheartCharacterState(ctx, state)
+}
+
+// putInventoryQuantity sets the stack size of one item in a character's inventory.
+func (a *Adapter) putInventoryQuantity(ctx context.Context, campaignID, characterID, itemID, kind string, quantity int) error {
+	if strings.TrimSpace(characterID) == "" {
+		return fmt.Errorf("character_id is required")
+	}
+	if strings.TrimSpace(itemID) == "" {
+		return fmt.Errorf("item_id is required")
+	}
+	if !IsInventoryKind(kind) {
+		return fmt.Errorf("inventory kind %q is invalid", kind)
+	}
+	if quantity < 0 {
+		return fmt.Errorf("inventory quantity must be non-negative")
+	}
+	state, err := a.loadCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		return err
+	}
+	inventory := SetInventoryQuantity(InventoryFromStorage(state.Inventory), itemID, kind, quantity)
+	state.Inventory = InventoryToStorage(inventory)
+	return a.store.PutDaggerheartCharacterState(ctx, state)
+}
+
+// putEquipmentSlot fills or clears an equipment slot. Armor slot changes also
+// carry the armor-derived profile values so replays do not consult the catalog.
+func (a *Adapter) putEquipmentSlot(ctx context.Context, campaignID, characterID, slot, itemID string, armor *EquipmentChange) error {
+	if strings.TrimSpace(characterID) == "" {
+		return fmt.Errorf("character_id is required")
+	}
+	if !IsEquipSlot(slot) {
+		return fmt.Errorf("equip slot %q is invalid", slot)
+	}
+	if (slot == EquipSlotArmor) != (armor != nil) {
+		return fmt.Errorf("armor changes are required for the armor slot only")
+	}
+	state, err := a.loadCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		return err
+	}
+	switch slot {
+	case EquipSlotArmor:
+		state.EquippedArmorID = itemID
+	case EquipSlotPrimaryWeapon:
+		state.EquippedPrimaryWeaponID = itemID
+	case EquipSlotSecondaryWeapon:
+		state.EquippedSecondaryWeaponID = itemID
+	}
+	if armor != nil {
+		if armor.ArmorMaxAfter < ArmorMin || armor.ArmorMaxAfter > ArmorMaxCap {
+			return fmt.Errorf("armor_max_after must be in range %d..%d", ArmorMin, ArmorMaxCap)
+		}
+		if armor.ArmorAfter < ArmorMin || armor.ArmorAfter > armor.ArmorMaxAfter {
+			return fmt.Errorf("armor_after must be in range %d..%d", ArmorMin, armor.ArmorMaxAfter)
+		}
+		if armor.MajorThresholdAfter <= 0 || armor.SevereThresholdAfter < armor.MajorThresholdAfter {
+			return fmt.Errorf("armor thresholds are invalid")
+		}
+		profile, err := a.store.GetDaggerheartCharacterProfile(ctx, campaignID, characterID)
+		if err != nil {
+			return fmt.Errorf("get daggerheart character profile: %w", err)
+		}
+		profile.ArmorScore = armor.ArmorScoreAfter
+		profile.ArmorMax = armor.ArmorMaxAfter
+		profile.MajorThreshold = armor.MajorThresholdAfter
+		profile.SevereThreshold = armor.SevereThresholdAfter
+		if err := a.store.PutDaggerheartCharacterProfile(ctx, profile); err != nil {
+			return err
+		}
+		state.Armor = armor.ArmorAfter
+	}
+	return a.store.PutDaggerheartCharacterState(ctx, state)
+}
+
+func (a *Adapter) loadCharacterState(ctx context.Context, campaignID, characterID string) (storage.DaggerheartCharacterState, error) {
+	state, err := a.store.GetDaggerheartCharacterState(ctx, campaignID, characterID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return storage.DaggerheartCharacterState{CampaignID: campaignID, CharacterID: characterID}, nil
+		}
+		return storage.DaggerheartCharacterState{}, fmt.Errorf("get daggerheart character state: %w", err)
+	}
+	return state, nil
+}
+
+// InventoryFromStorage converts stored inventory stacks into domain items.
+func InventoryFromStorage(items []storage.DaggerheartInventoryItem) []InventoryItem {
+	converted := make([]InventoryItem, 0, len(items))
+	for _, item := range items {
+		converted = append(converted, InventoryItem{ItemID: item.ItemID, Kind: item.Kind, Quantity: item.Quantity})
+	}
+	return converted
+}
+
+// InventoryToStorage converts domain inventory items into stored stacks.
+func InventoryToStorage(items []InventoryItem) []storage.DaggerheartInventoryItem {
+	converted := make([]storage.DaggerheartInventoryItem, 0, len(items))
+	for _, item := range items {
+		converted = append(converted, storage.DaggerheartInventoryItem{ItemID: item.ItemID, Kind: item.Kind, Quantity: item.Quantity})
+	}
+	return converted
+}
+
+// CompanionStatsFromStorage returns the level-up stats of a stored companion.
+func CompanionStatsFromStorage(companion storage.DaggerheartCompanion) CompanionStats {
+	experiences := make([]CompanionExperience, 0, len(companion.Experiences))
+	for _, experience := range companion.Experiences {
+		experiences = append(experiences, CompanionExperience{ExperienceID: experience.ExperienceID, Modifier: experience.Modifier})
+	}
+	return CompanionStats{
+		Evasion:        companion.Evasion,
+		StressMax:      companion.StressMax,
+		DamageDieSides: companion.DamageDieSides,
+		Range:          companion.Range,
+		Experiences:    experiences,
+	}
+}
+
+// CompanionExperiencesToStorage converts companion Experiences into stored rows.
+func CompanionExperiencesToStorage(experiences []CompanionExperience) []storage.DaggerheartCompanionExperience {
+	converted := make([]storage.DaggerheartCompanionExperience, 0, len(experiences))
+	for _, experience := range experiences {
+		converted = append(converted, storage.DaggerheartCompanionExperience{ExperienceID: experience.ExperienceID, Modifier: experience.Modifier})
+	}
+	return converted
+}
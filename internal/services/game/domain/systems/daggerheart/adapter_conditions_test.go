@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"testing"
 
@@ -12,11 +13,17 @@ import (
 )
 
 type memoryDaggerheartStore struct {
-	states      map[string]storage.DaggerheartCharacterState
-	profiles    map[string]storage.DaggerheartCharacterProfile
-	snaps       map[string]storage.DaggerheartSnapshot
-	countdowns  map[string]storage.DaggerheartCountdown
-	adversaries map[string]storage.DaggerheartAdversary
+	states       map[string]storage.DaggerheartCharacterState
+	profiles     map[string]storage.DaggerheartCharacterProfile
+	snaps        map[string]storage.DaggerheartSnapshot
+	countdowns   map[string]storage.DaggerheartCountdown
+	adversaries  map[string]storage.DaggerheartAdversary
+	sceneRanges  map[string]storage.DaggerheartSceneRange
+	environments map[string]storage.DaggerheartSessionEnvironment
+	companions   map[string]storage.DaggerheartCompanion
+	effects      map[string]storage.DaggerheartEffect
+	chases       map[string]storage.DaggerheartChase
+	features     map[string]storage.DaggerheartAdversaryFeatureState
 
 	// Error injection fields for simulating storage failures.
 	putStateErr     error
@@ -27,11 +34,16 @@ type memoryDaggerheartStore struct {
 
 func newMemoryDaggerheartStore() *memoryDaggerheartStore {
 	return &memoryDaggerheartStore{
-		states:      make(map[string]storage.DaggerheartCharacterState),
-		profiles:    make(map[string]storage.DaggerheartCharacterProfile),
-		snaps:       make(map[string]storage.DaggerheartSnapshot),
-		countdowns:  make(map[string]storage.DaggerheartCountdown),
-		adversaries: make(map[string]storage.DaggerheartAdversary),
+		states:       make(map[string]storage.DaggerheartCharacterState),
+		profiles:     make(map[string]storage.DaggerheartCharacterProfile),
+		snaps:        make(map[string]storage.DaggerheartSnapshot),
+		countdowns:   make(map[string]storage.DaggerheartCountdown),
+		adversaries:  make(map[string]storage.DaggerheartAdversary),
+		environments: make(map[string]storage.DaggerheartSessionEnvironment),
+		companions:   make(map[string]storage.DaggerheartCompanion),
+		effects:      make(map[string]storage.DaggerheartEffect),
+		chases:       make(map[string]storage.DaggerheartChase),
+		features:     make(map[string]storage.DaggerheartAdversaryFeatureState),
 	}
 }
 
@@ -162,6 +174,145 @@ func (m *memoryDaggerheartStore) DeleteDaggerheartAdversary(ctx context.Context,
 	return nil
 }
 
+func (m *memoryDaggerheartStore) PutDaggerheartSceneRange(_ context.Context, sceneRange storage.DaggerheartSceneRange) error {
+	if m.sceneRanges == nil {
+		m.sceneRanges = make(map[string]storage.DaggerheartSceneRange)
+	}
+	m.sceneRanges[sceneRange.CampaignID+":"+sceneRange.SessionID+":"+sceneRange.FromID+":"+sceneRange.ToID] = sceneRange
+	return nil
+}
+
+func (m *memoryDaggerheartStore) GetDaggerheartSceneRange(_ context.Context, campaignID, sessionID, firstID, secondID string) (storage.DaggerheartSceneRange, error) {
+	if firstID > secondID {
+		firstID, secondID = secondID, firstID
+	}
+	sceneRange, ok := m.sceneRanges[campaignID+":"+sessionID+":"+firstID+":"+secondID]
+	if !ok {
+		return storage.DaggerheartSceneRange{}, storage.ErrNotFound
+	}
+	return sceneRange, nil
+}
+
+func (m *memoryDaggerheartStore) ListDaggerheartSceneRanges(_ context.Context, campaignID, sessionID string) ([]storage.DaggerheartSceneRange, error) {
+	var ranges []storage.DaggerheartSceneRange
+	for _, sceneRange := range m.sceneRanges {
+		if sceneRange.CampaignID == campaignID && sceneRange.SessionID == sessionID {
+			ranges = append(ranges, sceneRange)
+		}
+	}
+	return ranges, nil
+}
+
+func (m *memoryDaggerheartStore) PutDaggerheartSessionEnvironment(_ context.Context, environment storage.DaggerheartSessionEnvironment) error {
+	m.environments[environment.CampaignID+":"+environment.SessionID] = environment
+	return nil
+}
+
+func (m *memoryDaggerheartStore) GetDaggerheartSessionEnvironment(_ context.Context, campaignID, sessionID string) (storage.DaggerheartSessionEnvironment, error) {
+	environment, ok := m.environments[campaignID+":"+sessionID]
+	if !ok {
+		return storage.DaggerheartSessionEnvironment{}, storage.ErrNotFound
+	}
+	return environment, nil
+}
+
+func (m *memoryDaggerheartStore) DeleteDaggerheartSessionEnvironment(_ context.Context, campaignID, sessionID string) error {
+	delete(m.environments, campaignID+":"+sessionID)
+	return nil
+}
+
+func (m *memoryDaggerheartStore) PutDaggerheartCompanion(_ context.Context, companion storage.DaggerheartCompanion) error {
+	m.companions[companion.CampaignID+":"+companion.CharacterID] = companion
+	return nil
+}
+
+func (m *memoryDaggerheartStore) GetDaggerheartCompanion(_ context.Context, campaignID, characterID string) (storage.DaggerheartCompanion, error) {
+	companion, ok := m.companions[campaignID+":"+characterID]
+	if !ok {
+		return storage.DaggerheartCompanion{}, storage.ErrNotFound
+	}
+	return companion, nil
+}
+
+func (m *memoryDaggerheartStore) PutDaggerheartEffect(_ context.Context, effect storage.DaggerheartEffect) error {
+	m.effects[effect.CampaignID+":"+effect.EffectID] = effect
+	return nil
+}
+
+func (m *memoryDaggerheartStore) GetDaggerheartEffect(_ context.Context, campaignID, effectID string) (storage.DaggerheartEffect, error) {
+	effect, ok := m.effects[campaignID+":"+effectID]
+	if !ok {
+		return storage.DaggerheartEffect{}, storage.ErrNotFound
+	}
+	return effect, nil
+}
+
+func (m *memoryDaggerheartStore) ListDaggerheartEffects(_ context.Context, campaignID string) ([]storage.DaggerheartEffect, error) {
+	var effects []storage.DaggerheartEffect
+	for _, effect := range m.effects {
+		if effect.CampaignID == campaignID {
+			effects = append(effects, effect)
+		}
+	}
+	sort.Slice(effects, func(i, j int) bool {
+		if effects[i].TargetID != effects[j].TargetID {
+			return effects[i].TargetID < effects[j].TargetID
+		}
+		return effects[i].EffectID < effects[j].EffectID
+	})
+	return effects, nil
+}
+
+func (m *memoryDaggerheartStore) DeleteDaggerheartEffect(_ context.Context, campaignID, effectID string) error {
+	delete(m.effects, campaignID+":"+effectID)
+	return nil
+}
+
+func (m *memoryDaggerheartStore) PutDaggerheartChase(_ context.Context, chase storage.DaggerheartChase) error {
+	m.chases[chase.CampaignID+":"+chase.ChaseID] = chase
+	return nil
+}
+
+func (m *memoryDaggerheartStore) GetDaggerheartChase(_ context.Context, campaignID, chaseID string) (storage.DaggerheartChase, error) {
+	chase, ok := m.chases[campaignID+":"+chaseID]
+	if !ok {
+		return storage.DaggerheartChase{}, storage.ErrNotFound
+	}
+	return chase, nil
+}
+
+func (m *memoryDaggerheartStore) ListDaggerheartChases(_ context.Context, campaignID string) ([]storage.DaggerheartChase, error) {
+	var chases []storage.DaggerheartChase
+	for _, chase := range m.chases {
+		if chase.CampaignID == campaignID {
+			chases = append(chases, chase)
+		}
+	}
+	sort.Slice(chases, func(i, j int) bool { return chases[i].ChaseID < chases[j].ChaseID })
+	return chases, nil
+}
+
+func (m *memoryDaggerheartStore) PutDaggerheartAdversaryFeatureState(_ context.Context, state storage.DaggerheartAdversaryFeatureState) error {
+	m.features[state.CampaignID+":"+state.AdversaryID+":"+state.FeatureID] = state
+	return nil
+}
+
+func (m *memoryDaggerheartStore) ListDaggerheartAdversaryFeatureStates(_ context.Context, campaignID string) ([]storage.DaggerheartAdversaryFeatureState, error) {
+	var states []storage.DaggerheartAdversaryFeatureState
+	for _, state := range m.features {
+		if state.CampaignID == campaignID {
+			states = append(states, state)
+		}
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].AdversaryID != states[j].AdversaryID {
+			return states[i].AdversaryID < states[j].AdversaryID
+		}
+		return states[i].FeatureID < states[j].FeatureID
+	})
+	return states, nil
+}
+
 func TestAdapterApplyConditionChanged(t *testing.T) {
 	store := newMemoryDaggerheartStore()
 	store.states["camp-1:char-1"] = storage.DaggerheartCharacterState{
@@ -0,0 +1,174 @@
+package daggerheart
+
+import (
+	"testing"
+
+	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
+)
+
+func newLeveledUpPayload() CharacterLeveledUpPayload {
+	return CharacterLeveledUpPayload{
+		CharacterID: "char-1",
+		LevelBefore: 1,
+		LevelAfter:  2,
+		Tier:        2,
+		Advancements: []LevelUpAdvancementPayload{
+			{Type: AdvancementTraits, Traits: []string{"agility", "strength"}},
+			{Type: AdvancementHPSlot},
+		},
+		NewExperience:        "Cartographer",
+		DomainCardID:         "card-1",
+		HpMaxAfter:           7,
+		StressMaxAfter:       6,
+		EvasionAfter:         10,
+		ProficiencyAfter:     2,
+		MajorThresholdAfter:  2,
+		SevereThresholdAfter: 4,
+		TraitsAfter:          map[string]int{"agility": 3, "strength": 2},
+		ExperiencesAfter:     []LevelUpExperiencePayload{{Name: "Cartographer", Modifier: 2}},
+		MarkedTraitsAfter:    []string{"agility", "strength"},
+		LoadoutActiveAfter:   []string{"card-1"},
+		HpBefore:             intPtr(6),
+		HpAfter:              intPtr(7),
+	}
+}
+
+func newLevelingStore() *memoryDaggerheartStore {
+	store := newMemoryDaggerheartStore()
+	store.profiles["camp-1:char-1"] = storage.DaggerheartCharacterProfile{
+		CampaignID: "camp-1", CharacterID: "char-1",
+		Level: 1, HpMax: 6, StressMax: 6, Evasion: 10,
+		MajorThreshold: 1, SevereThreshold: 2, Proficiency: 1,
+		Agility: 2, Strength: 1,
+	}
+	store.states["camp-1:char-1"] = storage.DaggerheartCharacterState{
+		CampaignID: "camp-1", CharacterID: "char-1",
+		Hp: 6, Hope: 2, HopeMax: HopeMax,
+	}
+	return store
+}
+
+func TestApplyCharacterLeveledUp(t *testing.T) {
+	store := newLevelingStore()
+	a := NewAdapter(store)
+
+	if err := applyEvent(t, a, "camp-1", EventTypeCharacterLeveledUp, newLeveledUpPayload()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	profile := store.profiles["camp-1:char-1"]
+	if profile.Level != 2 || profile.HpMax != 7 || profile.Proficiency != 2 {
+		t.Fatalf("profile = %+v", profile)
+	}
+	if profile.MajorThreshold != 2 || profile.SevereThreshold != 4 {
+		t.Fatalf("thresholds = %d/%d, want 2/4", profile.MajorThreshold, profile.SevereThreshold)
+	}
+	if profile.Agility != 3 || profile.Strength != 2 {
+		t.Fatalf("traits agility=%d strength=%d, want 3/2", profile.Agility, profile.Strength)
+	}
+	if len(profile.MarkedTraits) != 2 || len(profile.LoadoutActive) != 1 {
+		t.Fatalf("marked=%v loadout=%v", profile.MarkedTraits, profile.LoadoutActive)
+	}
+	if len(profile.LevelHistory) != 1 || profile.LevelHistory[0].Level != 2 || len(profile.LevelHistory[0].Advancements) != 2 {
+		t.Fatalf("level history = %+v", profile.LevelHistory)
+	}
+	if state := store.states["camp-1:char-1"]; state.Hp != 7 {
+		t.Fatalf("hp = %d, want 7", state.Hp)
+	}
+}
+
+func TestApplyCharacterLeveledUpClassSelection(t *testing.T) {
+	store := newLevelingStore()
+	profile := store.profiles["camp-1:char-1"]
+	profile.ClassID = "class.guardian"
+	profile.SubclassID = "subclass.stalwart"
+	profile.SubclassStage = SubclassStageFoundation
+	store.profiles["camp-1:char-1"] = profile
+
+	payload := newLeveledUpPayload()
+	payload.SubclassStageAfter = SubclassStageSpecialization
+	payload.MulticlassClassIDAfter = "class.wizard"
+	payload.MulticlassSubclassIDAfter = "subclass.school-of-war"
+	payload.MulticlassSubclassStageAfter = SubclassStageFoundation
+	payload.MulticlassDomainIDAfter = "domain.codex"
+	if err := applyEvent(t, NewAdapter(store), "camp-1", EventTypeCharacterLeveledUp, payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := store.profiles["camp-1:char-1"]
+	if got.ClassID != "class.guardian" || got.SubclassStage != SubclassStageSpecialization {
+		t.Fatalf("class = %q stage = %q", got.ClassID, got.SubclassStage)
+	}
+	if got.MulticlassClassID != "class.wizard" || got.MulticlassSubclassID != "subclass.school-of-war" ||
+		got.MulticlassSubclassStage != SubclassStageFoundation || got.MulticlassDomainID != "domain.codex" {
+		t.Fatalf("multiclass = %+v", got)
+	}
+}
+
+func TestApplyCharacterLeveledUpValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(*CharacterLeveledUpPayload)
+	}{
+		{name: "missing character", mutate: func(p *CharacterLeveledUpPayload) { p.CharacterID = "" }},
+		{name: "level skip", mutate: func(p *CharacterLeveledUpPayload) { p.LevelAfter = 3 }},
+		{name: "tier mismatch", mutate: func(p *CharacterLeveledUpPayload) { p.Tier = 3 }},
+		{name: "level before mismatch", mutate: func(p *CharacterLeveledUpPayload) { p.LevelBefore, p.LevelAfter = 2, 3 }},
+		{name: "unknown advancement", mutate: func(p *CharacterLeveledUpPayload) { p.Advancements[0].Type = "wish" }},
+		{name: "trait out of range", mutate: func(p *CharacterLeveledUpPayload) { p.TraitsAfter["agility"] = 5 }},
+		{name: "invalid subclass stage", mutate: func(p *CharacterLeveledUpPayload) { p.SubclassStageAfter = "legendary" }},
+		{name: "loadout overflow", mutate: func(p *CharacterLeveledUpPayload) {
+			p.LoadoutActiveAfter = []string{"a", "b", "c", "d", "e", "f"}
+		}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newLevelingStore()
+			payload := newLeveledUpPayload()
+			tc.mutate(&payload)
+			if err := applyEvent(t, NewAdapter(store), "camp-1", EventTypeCharacterLeveledUp, payload); err == nil {
+				t.Fatal("expected error")
+			}
+			if store.profiles["camp-1:char-1"].Level != 1 {
+				t.Fatal("expected profile to be unchanged")
+			}
+		})
+	}
+}
+
+func TestApplyLoadoutSwappedMovesTrackedCard(t *testing.T) {
+	store := newLevelingStore()
+	profile := store.profiles["camp-1:char-1"]
+	profile.LoadoutActive = []string{"card-1"}
+	profile.LoadoutVault = []string{"card-2"}
+	store.profiles["camp-1:char-1"] = profile
+	a := NewAdapter(store)
+
+	err := applyEvent(t, a, "camp-1", EventTypeLoadoutSwapped, LoadoutSwappedPayload{
+		CharacterID: "char-1",
+		CardID:      "card-2",
+		From:        "vault",
+		To:          "active",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	updated := store.profiles["camp-1:char-1"]
+	if len(updated.LoadoutActive) != 2 || len(updated.LoadoutVault) != 0 {
+		t.Fatalf("loadout active=%v vault=%v", updated.LoadoutActive, updated.LoadoutVault)
+	}
+
+	// Untracked cards leave the loadout untouched.
+	err = applyEvent(t, a, "camp-1", EventTypeLoadoutSwapped, LoadoutSwappedPayload{
+		CharacterID: "char-1",
+		CardID:      "card-9",
+		From:        "vault",
+		To:          "active",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := store.profiles["camp-1:char-1"]; len(got.LoadoutActive) != 2 {
+		t.Fatalf("loadout active=%v", got.LoadoutActive)
+	}
+}
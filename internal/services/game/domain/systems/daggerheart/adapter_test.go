@@ -3,7 +3,9 @@ package daggerheart
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -800,6 +802,44 @@ func TestApplyAttackResolvedEmptyOutcome(t *testing.T) {
 	}
 }
 
+// --- MultiAttackResolved tests ---
+
+func TestApplyMultiAttackResolved(t *testing.T) {
+	a := NewAdapter(newMemoryDaggerheartStore())
+	err := applyEvent(t, a, "camp-1", EventTypeMultiAttackResolved, MultiAttackResolvedPayload{
+		AttackerID: "char-1", AttackerType: MultiAttackTargetCharacter, RollSeq: 1, Total: 14,
+		Targets: []MultiAttackTargetResult{
+			{TargetID: "adv-1", TargetType: MultiAttackTargetAdversary, Difficulty: 12, Hit: true, HPMarked: 2},
+			{TargetID: "adv-2", TargetType: MultiAttackTargetAdversary, Difficulty: 15},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestApplyMultiAttackResolvedInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload MultiAttackResolvedPayload
+	}{
+		{name: "missing attacker", payload: MultiAttackResolvedPayload{AttackerType: MultiAttackTargetCharacter, RollSeq: 1, Targets: []MultiAttackTargetResult{{TargetID: "t", TargetType: MultiAttackTargetAdversary}}}},
+		{name: "unknown attacker type", payload: MultiAttackResolvedPayload{AttackerID: "a", AttackerType: "npc", RollSeq: 1, Targets: []MultiAttackTargetResult{{TargetID: "t", TargetType: MultiAttackTargetAdversary}}}},
+		{name: "zero roll seq", payload: MultiAttackResolvedPayload{AttackerID: "a", AttackerType: MultiAttackTargetCharacter, Targets: []MultiAttackTargetResult{{TargetID: "t", TargetType: MultiAttackTargetAdversary}}}},
+		{name: "no targets", payload: MultiAttackResolvedPayload{AttackerID: "a", AttackerType: MultiAttackTargetCharacter, RollSeq: 1}},
+		{name: "blank target", payload: MultiAttackResolvedPayload{AttackerID: "a", AttackerType: MultiAttackTargetCharacter, RollSeq: 1, Targets: []MultiAttackTargetResult{{TargetID: " ", TargetType: MultiAttackTargetAdversary}}}},
+		{name: "negative hp marked", payload: MultiAttackResolvedPayload{AttackerID: "a", AttackerType: MultiAttackTargetCharacter, RollSeq: 1, Targets: []MultiAttackTargetResult{{TargetID: "t", TargetType: MultiAttackTargetAdversary, HPMarked: -1}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := NewAdapter(newMemoryDaggerheartStore())
+			if err := applyEvent(t, a, "camp-1", EventTypeMultiAttackResolved, tt.payload); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
 // --- ReactionResolved tests ---
 
 func TestApplyReactionResolved(t *testing.T) {
@@ -1202,6 +1242,44 @@ func TestApplyCountdownCreatedInvalidDirection(t *testing.T) {
 
 // --- CountdownUpdated tests ---
 
+func TestApplyCountdownCreatedTickPolicy(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	a := NewAdapter(store)
+	err := applyEvent(t, a, "camp-1", EventTypeCountdownCreated, CountdownCreatedPayload{
+		CountdownID: "cd-1", Name: "Doom", Kind: CountdownKindConsequence,
+		Max: 4, Direction: CountdownDirectionIncrease, TickTrigger: CountdownTriggerRest,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cd := store.countdowns["camp-1:cd-1"]
+	if cd.TickTrigger != CountdownTriggerRest || cd.TickDelta != 1 {
+		t.Fatalf("unexpected tick policy: %+v", cd)
+	}
+
+	err = applyEvent(t, a, "camp-1", EventTypeCountdownCreated, CountdownCreatedPayload{
+		CountdownID: "cd-2", Name: "Doom", Kind: CountdownKindConsequence,
+		Max: 4, Direction: CountdownDirectionIncrease, TickTrigger: "eclipse",
+	})
+	if err == nil {
+		t.Fatal("expected error for invalid tick trigger")
+	}
+}
+
+func TestApplyCountdownTriggered(t *testing.T) {
+	a := NewAdapter(newMemoryDaggerheartStore())
+	if err := applyEvent(t, a, "camp-1", EventTypeCountdownTriggered, CountdownTriggeredPayload{
+		CountdownID: "cd-1", Trigger: CountdownTriggerRest, Value: 4,
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := applyEvent(t, a, "camp-1", EventTypeCountdownTriggered, CountdownTriggeredPayload{
+		Trigger: CountdownTriggerRest,
+	}); err == nil {
+		t.Fatal("expected error for empty countdown_id")
+	}
+}
+
 func TestApplyCountdownUpdated(t *testing.T) {
 	store := newMemoryDaggerheartStore()
 	store.countdowns["camp-1:cd-1"] = storage.DaggerheartCountdown{
@@ -1484,6 +1562,75 @@ func TestApplyAdversaryCreated(t *testing.T) {
 	}
 }
 
+func TestApplyAdversaryCreatedMinionThreshold(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	a := NewAdapter(store)
+	err := applyEvent(t, a, "camp-1", EventTypeAdversaryCreated, AdversaryCreatedPayload{
+		AdversaryID: "adv-1", Name: "Imp", HP: 1, HPMax: 1,
+		Evasion: 10, Major: 1, Severe: 1, MinionThreshold: 3,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := store.adversaries["camp-1:adv-1"].MinionThreshold; got != 3 {
+		t.Fatalf("minion threshold = %d, want 3", got)
+	}
+}
+
+func TestApplyAdversaryCreatedFromCatalog(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	a := NewAdapter(store)
+	err := applyEvent(t, a, "camp-1", EventTypeAdversaryCreated, AdversaryCreatedPayload{
+		AdversaryID: "adv-1", Name: "War Wizard", HP: 5, HPMax: 5,
+		StressMax: 6, Evasion: 16, Major: 8, Severe: 14,
+		AdversaryEntryID: "adversary.war-wizard",
+		AttackModifier:   4,
+		StandardAttack: &AdversaryAttack{
+			Name: "Staff", Range: "far",
+			DamageDice: []AdversaryDamageDie{{Sides: 10, Count: 2}}, DamageType: "magic",
+		},
+		Experiences: []AdversaryExperience{{Name: "Magical Knowledge", Modifier: 2}},
+		Features:    []AdversaryFeature{{ID: "feature.warding-sphere", Name: "Warding Sphere", Kind: "reaction"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	adv := store.adversaries["camp-1:adv-1"]
+	if adv.AdversaryEntryID != "adversary.war-wizard" || adv.AttackModifier != 4 {
+		t.Fatalf("unexpected catalog link: %+v", adv)
+	}
+	if adv.StandardAttack.Name != "Staff" || len(adv.StandardAttack.DamageDice) != 1 || adv.StandardAttack.DamageDice[0].Sides != 10 {
+		t.Fatalf("unexpected standard attack: %+v", adv.StandardAttack)
+	}
+	if len(adv.Experiences) != 1 || len(adv.Features) != 1 || adv.Features[0].ID != "feature.warding-sphere" {
+		t.Fatalf("unexpected experiences/features: %+v", adv)
+	}
+
+	// Stat edits keep the catalog copy.
+	err = applyEvent(t, a, "camp-1", EventTypeAdversaryUpdated, AdversaryUpdatedPayload{
+		AdversaryID: "adv-1", Name: "Archmage", HP: 5, HPMax: 5,
+		StressMax: 6, Evasion: 16, Major: 8, Severe: 14,
+	})
+	if err != nil {
+		t.Fatalf("unexpected update error: %v", err)
+	}
+	adv = store.adversaries["camp-1:adv-1"]
+	if adv.AdversaryEntryID != "adversary.war-wizard" || len(adv.Features) != 1 {
+		t.Fatalf("update dropped catalog copy: %+v", adv)
+	}
+}
+
+func TestApplyAdversaryCreatedNegativeMinionThreshold(t *testing.T) {
+	a := NewAdapter(newMemoryDaggerheartStore())
+	err := applyEvent(t, a, "camp-1", EventTypeAdversaryCreated, AdversaryCreatedPayload{
+		AdversaryID: "adv-1", Name: "Imp", HP: 1, HPMax: 1,
+		Evasion: 10, Major: 1, Severe: 1, MinionThreshold: -1,
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
 func TestApplyAdversaryCreatedInvalidJSON(t *testing.T) {
 	a := NewAdapter(newMemoryDaggerheartStore())
 	err := a.ApplyEvent(context.Background(), event.Event{
@@ -1601,6 +1748,29 @@ func TestApplyAdversaryDamageApplied(t *testing.T) {
 	}
 }
 
+func TestApplyAdversaryDamageAppliedPreservesMinionThreshold(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	store.adversaries["camp-1:adv-1"] = storage.DaggerheartAdversary{
+		CampaignID: "camp-1", AdversaryID: "adv-1", Name: "Imp",
+		HP: 1, HPMax: 1, Evasion: 10, Major: 1, Severe: 1, MinionThreshold: 3,
+	}
+
+	a := NewAdapter(store)
+	err := applyEvent(t, a, "camp-1", EventTypeAdversaryDamageApplied, AdversaryDamageAppliedPayload{
+		AdversaryID:    "adv-1",
+		HpAfter:        intPtr(0),
+		MinionDefeated: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	adv := store.adversaries["camp-1:adv-1"]
+	if adv.HP != 0 || adv.MinionThreshold != 3 {
+		t.Fatalf("adversary hp/threshold = %d/%d, want 0/3", adv.HP, adv.MinionThreshold)
+	}
+}
+
 func TestApplyAdversaryDamageAppliedInvalidJSON(t *testing.T) {
 	a := NewAdapter(newMemoryDaggerheartStore())
 	err := a.ApplyEvent(context.Background(), event.Event{
@@ -2093,3 +2263,426 @@ func TestApplyRestTakenCharacterPatchFails(t *testing.T) {
 		t.Fatal("expected error when character state patch has out-of-range hope")
 	}
 }
+
+func TestApplySceneEntityMoved(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	a := NewAdapter(store)
+	evt := event.Event{
+		CampaignID: "camp-1",
+		SessionID:  "sess-1",
+		Type:       EventTypeSceneEntityMoved,
+		Timestamp:  time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC),
+		PayloadJSON: mustJSON(t, SceneEntityMovedPayload{
+			EntityID:   "adv-1",
+			EntityType: SceneEntityAdversary,
+			Kind:       MovementKindKnockback,
+			SourceID:   "char-1",
+			SourceType: SceneEntityCharacter,
+			Ranges: []SceneRange{{
+				FromID: "adv-1", FromType: SceneEntityAdversary,
+				ToID: "char-1", ToType: SceneEntityCharacter,
+				Band: RangeClose,
+			}},
+		}),
+	}
+	if err := a.ApplyEvent(context.Background(), evt); err != nil {
+		t.Fatalf("apply scene.entity_moved: %v", err)
+	}
+	got, err := store.GetDaggerheartSceneRange(context.Background(), "camp-1", "sess-1", "char-1", "adv-1")
+	if err != nil {
+		t.Fatalf("get scene range: %v", err)
+	}
+	if got.Band != RangeClose || got.FromID != "adv-1" {
+		t.Fatalf("scene range = %+v, want close from adv-1", got)
+	}
+}
+
+func TestApplySceneRangesSetRejectsInvalidRanges(t *testing.T) {
+	a := NewAdapter(newMemoryDaggerheartStore())
+	ranges := []SceneRange{{FromID: "char-1", FromType: SceneEntityCharacter, ToID: "adv-1", ToType: SceneEntityAdversary, Band: "nearby"}}
+	err := a.ApplyEvent(context.Background(), event.Event{
+		CampaignID:  "camp-1",
+		SessionID:   "sess-1",
+		Type:        EventTypeSceneRangesSet,
+		PayloadJSON: mustJSON(t, SceneRangesSetPayload{Ranges: ranges}),
+	})
+	if err == nil {
+		t.Fatal("expected error for invalid range band")
+	}
+
+	ranges[0].Band = RangeMelee
+	err = applyEvent(t, a, "camp-1", EventTypeSceneRangesSet, SceneRangesSetPayload{Ranges: ranges})
+	if err == nil {
+		t.Fatal("expected error when session id is missing")
+	}
+}
+
+func TestApplyEnvironmentLifecycle(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	a := NewAdapter(store)
+	ctx := context.Background()
+	apply := func(eventType event.Type, payload any) {
+		t.Helper()
+		if err := a.ApplyEvent(ctx, event.Event{
+			CampaignID:  "camp-1",
+			SessionID:   "sess-1",
+			Type:        eventType,
+			PayloadJSON: mustJSON(t, payload),
+		}); err != nil {
+			t.Fatalf("apply %s: %v", eventType, err)
+		}
+	}
+
+	apply(EventTypeEnvironmentActivated, EnvironmentActivatedPayload{
+		EnvironmentID: "environment.outpost",
+		Name:          "Outpost Town",
+		Tier:          1,
+		Type:          "Social",
+		Difficulty:    12,
+	})
+	got, err := store.GetDaggerheartSessionEnvironment(ctx, "camp-1", "sess-1")
+	if err != nil {
+		t.Fatalf("get session environment: %v", err)
+	}
+	if got.EnvironmentID != "environment.outpost" || got.Difficulty != 12 {
+		t.Fatalf("environment = %+v, want outpost at difficulty 12", got)
+	}
+
+	apply(EventTypeEnvironmentShifted, EnvironmentShiftedPayload{
+		FromEnvironmentID: "environment.outpost",
+		EnvironmentID:     "environment.cliffside",
+		Name:              "Cliffside Ascent",
+		Tier:              1,
+		Type:              "Traversal",
+		Difficulty:        14,
+	})
+	got, err = store.GetDaggerheartSessionEnvironment(ctx, "camp-1", "sess-1")
+	if err != nil {
+		t.Fatalf("get shifted environment: %v", err)
+	}
+	if got.EnvironmentID != "environment.cliffside" || got.Difficulty != 14 {
+		t.Fatalf("environment = %+v, want cliffside at difficulty 14", got)
+	}
+
+	apply(EventTypeEnvironmentFeatureUsed, EnvironmentFeatureUsedPayload{
+		EnvironmentID: "environment.cliffside",
+		FeatureID:     "feature.cliffside-fall",
+		Kind:          EnvironmentFeatureKindReaction,
+	})
+	apply(EventTypeEnvironmentCleared, EnvironmentClearedPayload{EnvironmentID: "environment.cliffside"})
+	if _, err := store.GetDaggerheartSessionEnvironment(ctx, "camp-1", "sess-1"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected cleared environment, got %v", err)
+	}
+}
+
+func TestApplyEnvironmentEventsRejectInvalidPayloads(t *testing.T) {
+	a := NewAdapter(newMemoryDaggerheartStore())
+	if err := applyEvent(t, a, "camp-1", EventTypeEnvironmentActivated, EnvironmentActivatedPayload{EnvironmentID: "environment.outpost"}); err == nil {
+		t.Fatal("expected error when session id is missing")
+	}
+	err := a.ApplyEvent(context.Background(), event.Event{
+		CampaignID:  "camp-1",
+		SessionID:   "sess-1",
+		Type:        EventTypeEnvironmentShifted,
+		PayloadJSON: mustJSON(t, EnvironmentShiftedPayload{EnvironmentID: "environment.cliffside"}),
+	})
+	if err == nil {
+		t.Fatal("expected error when shift source is missing")
+	}
+	err = a.ApplyEvent(context.Background(), event.Event{
+		CampaignID:  "camp-1",
+		SessionID:   "sess-1",
+		Type:        EventTypeEnvironmentFeatureUsed,
+		PayloadJSON: mustJSON(t, EnvironmentFeatureUsedPayload{EnvironmentID: "environment.outpost", FeatureID: "feature.x", Kind: "passive"}),
+	})
+	if err == nil {
+		t.Fatal("expected error for passive feature kind")
+	}
+}
+
+func TestApplyInventoryEvents(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	a := NewAdapter(store)
+	ctx := context.Background()
+	store.profiles["camp-1:char-1"] = storage.DaggerheartCharacterProfile{
+		CampaignID: "camp-1", CharacterID: "char-1", Level: 2, MajorThreshold: 2, SevereThreshold: 4,
+	}
+	store.states["camp-1:char-1"] = storage.DaggerheartCharacterState{CampaignID: "camp-1", CharacterID: "char-1", Hp: 6, Hope: 2}
+	store.states["camp-1:char-2"] = storage.DaggerheartCharacterState{CampaignID: "camp-1", CharacterID: "char-2", Hp: 6, Hope: 2}
+	apply := func(eventType event.Type, payload any) {
+		t.Helper()
+		if err := applyEvent(t, a, "camp-1", eventType, payload); err != nil {
+			t.Fatalf("apply %s: %v", eventType, err)
+		}
+	}
+
+	apply(EventTypeItemAcquired, ItemAcquiredPayload{CharacterID: "char-1", ItemID: "armor.gambeson-armor", Kind: InventoryKindArmor, Quantity: 1, QuantityAfter: 1})
+	apply(EventTypeItemAcquired, ItemAcquiredPayload{CharacterID: "char-1", ItemID: "item.rope", Kind: InventoryKindItem, Quantity: 2, QuantityAfter: 2})
+	apply(EventTypeItemTransferred, ItemTransferredPayload{FromCharacterID: "char-1", ToCharacterID: "char-2", ItemID: "item.rope", Kind: InventoryKindItem, Quantity: 1, FromQuantityAfter: 1, ToQuantityAfter: 1})
+	apply(EventTypeItemDropped, ItemDroppedPayload{CharacterID: "char-1", ItemID: "item.rope", Kind: InventoryKindItem, Quantity: 1})
+	apply(EventTypeGoldChanged, GoldChangedPayload{CharacterID: "char-1", HandfulsAfter: 3, BagsAfter: 1})
+	apply(EventTypeItemEquipped, ItemEquippedPayload{
+		CharacterID: "char-1",
+		ItemID:      "armor.gambeson-armor",
+		Slot:        EquipSlotArmor,
+		Armor:       &EquipmentChange{ArmorScoreAfter: 3, ArmorMaxAfter: 3, ArmorAfter: 3, MajorThresholdAfter: 7, SevereThresholdAfter: 13},
+	})
+
+	state, err := store.GetDaggerheartCharacterState(ctx, "camp-1", "char-1")
+	if err != nil {
+		t.Fatalf("get state: %v", err)
+	}
+	wantInventory := []storage.DaggerheartInventoryItem{{ItemID: "armor.gambeson-armor", Kind: InventoryKindArmor, Quantity: 1}}
+	if !reflect.DeepEqual(state.Inventory, wantInventory) {
+		t.Fatalf("inventory = %+v, want %+v", state.Inventory, wantInventory)
+	}
+	if state.GoldHandfuls != 3 || state.GoldBags != 1 {
+		t.Fatalf("gold = %d handfuls %d bags, want 3 and 1", state.GoldHandfuls, state.GoldBags)
+	}
+	if state.EquippedArmorID != "armor.gambeson-armor" || state.Armor != 3 {
+		t.Fatalf("state = %+v, want gambeson worn with 3 armor", state)
+	}
+	profile, err := store.GetDaggerheartCharacterProfile(ctx, "camp-1", "char-1")
+	if err != nil {
+		t.Fatalf("get profile: %v", err)
+	}
+	if profile.ArmorScore != 3 || profile.ArmorMax != 3 || profile.MajorThreshold != 7 || profile.SevereThreshold != 13 {
+		t.Fatalf("profile = %+v, want armor 3 and thresholds 7/13", profile)
+	}
+	other, err := store.GetDaggerheartCharacterState(ctx, "camp-1", "char-2")
+	if err != nil {
+		t.Fatalf("get recipient state: %v", err)
+	}
+	if InventoryQuantity(InventoryFromStorage(other.Inventory), "item.rope") != 1 {
+		t.Fatalf("recipient inventory = %+v, want one rope", other.Inventory)
+	}
+
+	apply(EventTypeItemUnequipped, ItemUnequippedPayload{
+		CharacterID: "char-1",
+		ItemID:      "armor.gambeson-armor",
+		Slot:        EquipSlotArmor,
+		Armor:       &EquipmentChange{MajorThresholdAfter: 2, SevereThresholdAfter: 4},
+	})
+	state, _ = store.GetDaggerheartCharacterState(ctx, "camp-1", "char-1")
+	profile, _ = store.GetDaggerheartCharacterProfile(ctx, "camp-1", "char-1")
+	if state.EquippedArmorID != "" || state.Armor != 0 || profile.ArmorScore != 0 || profile.MajorThreshold != 2 {
+		t.Fatalf("unequip left state %+v profile %+v", state, profile)
+	}
+}
+
+func TestApplyInventoryEventsRejectInvalidPayloads(t *testing.T) {
+	a := NewAdapter(newMemoryDaggerheartStore())
+	if err := applyEvent(t, a, "camp-1", EventTypeItemAcquired, ItemAcquiredPayload{CharacterID: "char-1", ItemID: "item.rope", Kind: "trinket", Quantity: 1, QuantityAfter: 1}); err == nil {
+		t.Fatal("expected unknown inventory kind to fail")
+	}
+	if err := applyEvent(t, a, "camp-1", EventTypeItemDropped, ItemDroppedPayload{CharacterID: "char-1", ItemID: "item.rope", Kind: InventoryKindItem}); err == nil {
+		t.Fatal("expected zero drop quantity to fail")
+	}
+	if err := applyEvent(t, a, "camp-1", EventTypeItemEquipped, ItemEquippedPayload{CharacterID: "char-1", ItemID: "weapon.dagger", Slot: EquipSlotPrimaryWeapon, Armor: &EquipmentChange{}}); err == nil {
+		t.Fatal("expected armor changes on a weapon slot to fail")
+	}
+	if err := applyEvent(t, a, "camp-1", EventTypeItemEquipped, ItemEquippedPayload{CharacterID: "char-1", ItemID: "armor.leather", Slot: EquipSlotArmor}); err == nil {
+		t.Fatal("expected armor slot without armor changes to fail")
+	}
+	if err := applyEvent(t, a, "camp-1", EventTypeGoldChanged, GoldChangedPayload{CharacterID: "char-1", HandfulsAfter: -1}); err == nil {
+		t.Fatal("expected negative gold to fail")
+	}
+}
+
+func TestApplyCompanionEvents(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	a := NewAdapter(store)
+	experiences := []CompanionExperience{{ExperienceID: "exp.a", Modifier: 2}, {ExperienceID: "exp.b", Modifier: 2}}
+	if err := applyEvent(t, a, "camp-1", EventTypeCompanionCreated, CompanionCreatedPayload{
+		CharacterID: "char-1", Name: "Ash", Evasion: 10, StressMax: 3, DamageDieSides: 6, Range: RangeMelee, Experiences: experiences,
+	}); err != nil {
+		t.Fatalf("apply companion.created: %v", err)
+	}
+	if err := applyEvent(t, a, "camp-1", EventTypeCompanionStressChanged, CompanionStressChangedPayload{
+		CharacterID: "char-1", StressBefore: 0, StressAfter: 3,
+	}); err != nil {
+		t.Fatalf("apply companion.stress_changed: %v", err)
+	}
+	if err := applyEvent(t, a, "camp-1", EventTypeCompanionLeveledUp, CompanionLeveledUpPayload{
+		CharacterID: "char-1", Upgrade: CompanionUpgradeResilient, LevelAfter: 2,
+		EvasionAfter: 10, StressMaxAfter: 4, DamageDieSidesAfter: 6, RangeAfter: RangeMelee, ExperiencesAfter: experiences,
+	}); err != nil {
+		t.Fatalf("apply companion.leveled_up: %v", err)
+	}
+
+	companion := store.companions["camp-1:char-1"]
+	if companion.Name != "Ash" || companion.Level != 2 || companion.Stress != 3 || companion.StressMax != 4 {
+		t.Fatalf("companion = %+v", companion)
+	}
+	if len(companion.Upgrades) != 1 || companion.Upgrades[0] != CompanionUpgradeResilient {
+		t.Fatalf("upgrades = %v, want [resilient]", companion.Upgrades)
+	}
+	if len(companion.Experiences) != 2 || companion.Experiences[1].ExperienceID != "exp.b" {
+		t.Fatalf("experiences = %v", companion.Experiences)
+	}
+
+	if err := applyEvent(t, a, "camp-1", EventTypeCompanionStressChanged, CompanionStressChangedPayload{
+		CharacterID: "char-1", StressAfter: 5,
+	}); err == nil {
+		t.Fatal("expected error for stress past the companion's track")
+	}
+	if err := applyEvent(t, a, "camp-1", EventTypeCompanionLeveledUp, CompanionLeveledUpPayload{
+		CharacterID: "char-1", Upgrade: "wings",
+	}); err == nil {
+		t.Fatal("expected error for unknown companion upgrade")
+	}
+}
+
+func TestApplyBeastformEvents(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	store.states["camp-1:char-1"] = storage.DaggerheartCharacterState{
+		CampaignID: "camp-1", CharacterID: "char-1", Hp: 6, Hope: 2, HopeMax: 6, Stress: 1, LifeState: LifeStateAlive,
+	}
+	a := NewAdapter(store)
+	if err := applyEvent(t, a, "camp-1", EventTypeBeastformEntered, BeastformEnteredPayload{
+		CharacterID: "char-1", BeastformID: "beastform.pack-predator", StressBefore: 1, StressAfter: 2,
+	}); err != nil {
+		t.Fatalf("apply beastform.entered: %v", err)
+	}
+	state := store.states["camp-1:char-1"]
+	if state.BeastformID != "beastform.pack-predator" || state.Stress != 2 || state.Hp != 6 {
+		t.Fatalf("state after entering = %+v", state)
+	}
+
+	if err := applyEvent(t, a, "camp-1", EventTypeBeastformExited, BeastformExitedPayload{
+		CharacterID: "char-1", BeastformID: "beastform.pack-predator", Reason: BeastformExitLastHP,
+	}); err != nil {
+		t.Fatalf("apply beastform.exited: %v", err)
+	}
+	if state := store.states["camp-1:char-1"]; state.BeastformID != "" || state.Stress != 2 {
+		t.Fatalf("state after exiting = %+v", state)
+	}
+
+	if err := applyEvent(t, a, "camp-1", EventTypeBeastformEntered, BeastformEnteredPayload{CharacterID: "char-1"}); err == nil {
+		t.Fatal("expected error for missing beastform id")
+	}
+}
+
+func TestApplyEffectEvents(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	store.states["camp-1:char-1"] = storage.DaggerheartCharacterState{
+		CampaignID: "camp-1", CharacterID: "char-1", Hp: 6, Hope: 2, HopeMax: 6, Armor: 3, LifeState: LifeStateAlive,
+	}
+	a := NewAdapter(store)
+	armorBefore, armorAfter := 3, 5
+	if err := applyEvent(t, a, "camp-1", EventTypeEffectApplied, EffectAppliedPayload{
+		EffectID: "eff-1", TargetType: EffectTargetCharacter, TargetID: "char-1", Source: "shield",
+		Kind: EffectKindArmor, Value: 2, Stacking: EffectStackingStack, ExpiresOn: EffectExpiresRest,
+		ArmorBefore: &armorBefore, ArmorAfter: &armorAfter,
+	}); err != nil {
+		t.Fatalf("apply effect.applied: %v", err)
+	}
+	effect, ok := store.effects["camp-1:eff-1"]
+	if !ok || effect.Kind != EffectKindArmor || effect.Value != 2 || effect.ExpiresOn != EffectExpiresRest {
+		t.Fatalf("effect = %+v", effect)
+	}
+	if state := store.states["camp-1:char-1"]; state.Armor != 5 {
+		t.Fatalf("armor after effect = %d, want 5", state.Armor)
+	}
+
+	armorBefore, armorAfter = 5, 3
+	if err := applyEvent(t, a, "camp-1", EventTypeEffectExpired, EffectExpiredPayload{
+		EffectID: "eff-1", TargetID: "char-1", Reason: EffectExpiresRest,
+		ArmorBefore: &armorBefore, ArmorAfter: &armorAfter,
+	}); err != nil {
+		t.Fatalf("apply effect.expired: %v", err)
+	}
+	if _, ok := store.effects["camp-1:eff-1"]; ok {
+		t.Fatal("expected effect to be removed")
+	}
+	if state := store.states["camp-1:char-1"]; state.Armor != 3 {
+		t.Fatalf("armor after expiry = %d, want 3", state.Armor)
+	}
+
+	if err := applyEvent(t, a, "camp-1", EventTypeEffectApplied, EffectAppliedPayload{
+		EffectID: "eff-2", TargetType: EffectTargetAdversary, TargetID: "adv-1", Kind: "stress",
+	}); err == nil {
+		t.Fatal("expected error for unsupported effect kind")
+	}
+}
+
+func TestApplyChaseEvents(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	a := NewAdapter(store)
+	if err := applyEvent(t, a, "camp-1", EventTypeChaseStarted, ChaseStartedPayload{
+		ChaseID: "chase-1", Name: "Thief", ProgressCountdownID: "cd-progress", ConsequenceCountdownID: "cd-consequence",
+	}); err != nil {
+		t.Fatalf("apply chase.started: %v", err)
+	}
+	chase, ok := store.chases["camp-1:chase-1"]
+	if !ok || chase.Status != ChaseStatusActive || chase.ProgressCountdownID != "cd-progress" || chase.Winner != "" {
+		t.Fatalf("chase = %+v", chase)
+	}
+
+	if err := applyEvent(t, a, "camp-1", EventTypeChaseResolved, ChaseResolvedPayload{
+		ChaseID: "chase-1", Winner: ChaseWinnerConsequence, CountdownID: "cd-consequence",
+	}); err != nil {
+		t.Fatalf("apply chase.resolved: %v", err)
+	}
+	chase = store.chases["camp-1:chase-1"]
+	if chase.Status != ChaseStatusResolved || chase.Winner != ChaseWinnerConsequence {
+		t.Fatalf("resolved chase = %+v", chase)
+	}
+
+	if err := applyEvent(t, a, "camp-1", EventTypeChaseStarted, ChaseStartedPayload{
+		ChaseID: "chase-2", ProgressCountdownID: "cd-1", ConsequenceCountdownID: "cd-1",
+	}); err == nil {
+		t.Fatal("expected error for shared countdown")
+	}
+	if err := applyEvent(t, a, "camp-1", EventTypeChaseResolved, ChaseResolvedPayload{
+		ChaseID: "chase-1", Winner: "draw",
+	}); err == nil {
+		t.Fatal("expected error for invalid winner")
+	}
+}
+
+func TestApplyAdversaryFeatureEvents(t *testing.T) {
+	store := newMemoryDaggerheartStore()
+	store.adversaries["camp-1:adv-1"] = storage.DaggerheartAdversary{
+		CampaignID: "camp-1", AdversaryID: "adv-1", Name: "War Wizard",
+		HP: 5, HPMax: 5, Stress: 1, StressMax: 6, Evasion: 16, Major: 10, Severe: 20,
+	}
+	a := NewAdapter(store)
+	stressBefore, stressAfter := 1, 2
+	evasionBefore, evasionAfter := 16, 18
+	if err := applyEvent(t, a, "camp-1", EventTypeAdversaryFeatureUsed, AdversaryFeatureUsedPayload{
+		AdversaryID:   "adv-1",
+		FeatureID:     "feature-sphere",
+		StressBefore:  &stressBefore,
+		StressAfter:   &stressAfter,
+		EvasionBefore: &evasionBefore,
+		EvasionAfter:  &evasionAfter,
+		RefreshOn:     AdversaryFeatureRefreshFeature,
+	}); err != nil {
+		t.Fatalf("apply adversary_feature_used: %v", err)
+	}
+	adversary := store.adversaries["camp-1:adv-1"]
+	if adversary.Stress != 2 || adversary.Evasion != 18 {
+		t.Fatalf("adversary = %+v", adversary)
+	}
+	state, ok := store.features["camp-1:adv-1:feature-sphere"]
+	if !ok || state.Status != AdversaryFeatureStatusSpent || state.RefreshOn != AdversaryFeatureRefreshFeature {
+		t.Fatalf("feature state = %+v", state)
+	}
+
+	if err := applyEvent(t, a, "camp-1", EventTypeAdversaryFeatureRefreshed, AdversaryFeatureRefreshedPayload{
+		AdversaryID: "adv-1", FeatureID: "feature-sphere",
+	}); err != nil {
+		t.Fatalf("apply adversary_feature_refreshed: %v", err)
+	}
+	if state := store.features["camp-1:adv-1:feature-sphere"]; state.Status != AdversaryFeatureStatusReady {
+		t.Fatalf("refreshed feature state = %+v", state)
+	}
+
+	overStress := 7
+	if err := applyEvent(t, a, "camp-1", EventTypeAdversaryFeatureUsed, AdversaryFeatureUsedPayload{
+		AdversaryID: "adv-1", FeatureID: "feature-other", StressAfter: &overStress,
+	}); err == nil {
+		t.Fatal("expected error for stress above max")
+	}
+}
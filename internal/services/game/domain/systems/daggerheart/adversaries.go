@@ -0,0 +1,32 @@
+package daggerheart
+
+// AdversaryDamageDie is one dice group of an adversary's standard attack.
+type AdversaryDamageDie struct {
+	Sides int `json:"sides"`
+	Count int `json:"count"`
+}
+
+// AdversaryAttack is the standard attack copied from a catalog adversary.
+type AdversaryAttack struct {
+	Name        string               `json:"name"`
+	Range       string               `json:"range,omitempty"`
+	DamageDice  []AdversaryDamageDie `json:"damage_dice,omitempty"`
+	DamageBonus int                  `json:"damage_bonus,omitempty"`
+	DamageType  string               `json:"damage_type,omitempty"`
+}
+
+// AdversaryExperience is an experience copied from a catalog adversary.
+type AdversaryExperience struct {
+	Name     string `json:"name"`
+	Modifier int    `json:"modifier"`
+}
+
+// AdversaryFeature is a feature copied from a catalog adversary.
+type AdversaryFeature struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Kind        string `json:"kind,omitempty"`
+	Description string `json:"description,omitempty"`
+	CostType    string `json:"cost_type,omitempty"`
+	Cost        int    `json:"cost,omitempty"`
+}
@@ -0,0 +1,117 @@
+package daggerheart
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Adversary feature statuses track whether a feature with a cooldown can be
+// used again.
+const (
+	AdversaryFeatureStatusReady = "ready"
+	AdversaryFeatureStatusSpent = "spent"
+)
+
+// Adversary feature refresh triggers name what readies a spent feature.
+const (
+	// AdversaryFeatureRefreshFeature waits for another of the adversary's
+	// features, such as the War Wizard's "Refresh Warding Sphere".
+	AdversaryFeatureRefreshFeature = "feature"
+	// AdversaryFeatureRefreshSceneEnd covers "once per scene" features.
+	AdversaryFeatureRefreshSceneEnd = "scene_end"
+	// AdversaryFeatureRefreshLongRest covers "once per day" features.
+	AdversaryFeatureRefreshLongRest = "long_rest"
+)
+
+// ErrAdversaryFeatureStress reports that an adversary has no Stress left to
+// mark for a feature.
+var ErrAdversaryFeatureStress = errors.New("adversary cannot mark enough stress")
+
+// AdversaryFeatureRule is what using a catalog adversary feature costs and
+// changes.
+type AdversaryFeatureRule struct {
+	FearCost   int
+	StressCost int
+	// RefreshOn is empty when the feature can be used freely.
+	RefreshOn string
+	// Refreshes names the sibling feature this one readies.
+	Refreshes string
+	// DifficultyBonus is added to the adversary's Difficulty on use.
+	DifficultyBonus int
+}
+
+var (
+	adversaryFeatureFearCost   = regexp.MustCompile(`(?i)\bspend (a|an|\d+) fear\b`)
+	adversaryFeatureStressCost = regexp.MustCompile(`(?i)\bmark (a|an|\d+) stress to\b`)
+	adversaryFeatureDifficulty = regexp.MustCompile(`(?i)\+(\d+) bonus to (?:their|its) difficulty`)
+)
+
+// AdversaryFeatureRuleFor derives a feature's rule from its catalog entry.
+// Costs are read from the first sentence of the description ("Spend 2 Fear
+// to...", "Mark a Stress to..."), where the SRD states what the adversary
+// pays; later sentences describe what targets mark. A catalog Fear cost is
+// used when the description names none.
+func AdversaryFeatureRuleFor(name, description, costType string, cost int) AdversaryFeatureRule {
+	rule := AdversaryFeatureRule{}
+	lead := firstSentence(description)
+	if match := adversaryFeatureFearCost.FindStringSubmatch(lead); match != nil {
+		rule.FearCost = featureCount(match[1])
+	} else if strings.EqualFold(strings.TrimSpace(costType), "fear") && cost > 0 {
+		rule.FearCost = cost
+	}
+	if match := adversaryFeatureStressCost.FindStringSubmatch(lead); match != nil {
+		rule.StressCost = featureCount(match[1])
+	}
+
+	lower := strings.ToLower(strings.ReplaceAll(description, "’", "'"))
+	switch {
+	case strings.Contains(lower, "can't be used again until"):
+		rule.RefreshOn = AdversaryFeatureRefreshFeature
+	case strings.Contains(lower, "once per scene"):
+		rule.RefreshOn = AdversaryFeatureRefreshSceneEnd
+	case strings.Contains(lower, "once per day"):
+		rule.RefreshOn = AdversaryFeatureRefreshLongRest
+	}
+
+	trimmedName := strings.TrimSpace(name)
+	if strings.HasPrefix(trimmedName, "Refresh ") {
+		rule.Refreshes = strings.TrimSpace(strings.TrimPrefix(trimmedName, "Refresh "))
+	}
+	if match := adversaryFeatureDifficulty.FindStringSubmatch(description); match != nil {
+		rule.DifficultyBonus, _ = strconv.Atoi(match[1])
+	}
+	return rule
+}
+
+// MarkAdversaryFeatureStress returns the adversary's Stress after marking
+// cost for a feature.
+func MarkAdversaryFeatureStress(stress, stressMax, cost int) (int, error) {
+	if cost <= 0 {
+		return stress, nil
+	}
+	if stress+cost > stressMax {
+		return stress, ErrAdversaryFeatureStress
+	}
+	return stress + cost, nil
+}
+
+func firstSentence(text string) string {
+	if index := strings.Index(text, ". "); index >= 0 {
+		return text[:index]
+	}
+	return text
+}
+
+func featureCount(value string) int {
+	switch strings.ToLower(value) {
+	case "a", "an":
+		return 1
+	}
+	count, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
+	}
+	return count
+}
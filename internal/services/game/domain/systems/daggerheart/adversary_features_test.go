@@ -0,0 +1,101 @@
+package daggerheart
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAdversaryFeatureRuleFor(t *testing.T) {
+	tests := []struct {
+		name        string
+		feature     string
+		description string
+		costType    string
+		cost        int
+		want        AdversaryFeatureRule
+	}{
+		{
+			name:        "fear cost from description",
+			feature:     "Blizzard Breath",
+			description: "Spend 2 Fear to release an icy whorl in front of the Dragon within Close range. All targets must mark 2 Stress.",
+			costType:    "fear",
+			cost:        2,
+			want:        AdversaryFeatureRule{FearCost: 2},
+		},
+		{
+			name:        "stress cost ignores target stress",
+			feature:     "Mockery",
+			description: "Mark a Stress to say something mocking. On a failure, the target must mark 2 Stress.",
+			costType:    "stress",
+			cost:        2,
+			want:        AdversaryFeatureRule{StressCost: 1},
+		},
+		{
+			name:        "catalog fear cost fallback",
+			feature:     "Relentless (2)",
+			description: "The Box can be spotlighted up to two times per GM turn. Spend Fear as usual to spotlight them.",
+			costType:    "fear",
+			cost:        1,
+			want:        AdversaryFeatureRule{FearCost: 1},
+		},
+		{
+			name:        "catalog stress cost ignored",
+			feature:     "Envelop",
+			description: "Make a standard attack against a target within Melee range. On a success, the target must mark 2 Stress.",
+			costType:    "stress",
+			cost:        2,
+			want:        AdversaryFeatureRule{},
+		},
+		{
+			name:        "refreshed by another feature",
+			feature:     "Warding Sphere",
+			description: "When the Wizard takes damage from an attack within Close range, deal 2d6 magic damage to the attacker. This reaction can't be used again until the Wizard refreshes it.",
+			want:        AdversaryFeatureRule{RefreshOn: AdversaryFeatureRefreshFeature},
+		},
+		{
+			name:        "refresh action",
+			feature:     "Refresh Warding Sphere",
+			description: "Mark a Stress to refresh the Wizard's \"Warding Sphere\" reaction.",
+			want:        AdversaryFeatureRule{StressCost: 1, Refreshes: "Warding Sphere"},
+		},
+		{
+			name:        "once per scene",
+			feature:     "Adrenaline Burst",
+			description: "Once per scene, spend a Fear to clear 2 HP and 2 Stress.",
+			want:        AdversaryFeatureRule{FearCost: 1, RefreshOn: AdversaryFeatureRefreshSceneEnd},
+		},
+		{
+			name:        "once per day",
+			feature:     "Summon Tormentors",
+			description: "Once per day, spend 2 Fear to summon 2d4 Tier 2 or below Minions.",
+			want:        AdversaryFeatureRule{FearCost: 2, RefreshOn: AdversaryFeatureRefreshLongRest},
+		},
+		{
+			name:        "difficulty bonus",
+			feature:     "Flight",
+			description: "While flying, the Eagle gains a +3 bonus to their Difficulty.",
+			want:        AdversaryFeatureRule{DifficultyBonus: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AdversaryFeatureRuleFor(tt.feature, tt.description, tt.costType, tt.cost)
+			if got != tt.want {
+				t.Fatalf("rule = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkAdversaryFeatureStress(t *testing.T) {
+	after, err := MarkAdversaryFeatureStress(1, 3, 2)
+	if err != nil || after != 3 {
+		t.Fatalf("mark = %d, %v; want 3", after, err)
+	}
+	if _, err := MarkAdversaryFeatureStress(2, 3, 2); !errors.Is(err, ErrAdversaryFeatureStress) {
+		t.Fatalf("expected ErrAdversaryFeatureStress, got %v", err)
+	}
+	if after, err := MarkAdversaryFeatureStress(3, 3, 0); err != nil || after != 3 {
+		t.Fatalf("zero cost = %d, %v; want 3", after, err)
+	}
+}
@@ -0,0 +1,66 @@
+package daggerheart
+
+import (
+	"errors"
+	"strings"
+)
+
+// BeastformFeatureID is the class feature that lets a character transform.
+const BeastformFeatureID = "feature.druid-beastform"
+
+// BeastformStressCost is the Stress marked to take on a beastform.
+const BeastformStressCost = 1
+
+// Reasons recorded when a character leaves a beastform.
+const (
+	BeastformExitVoluntary = "voluntary"
+	BeastformExitLastHP    = "last_hp"
+)
+
+var (
+	ErrAlreadyInBeastform   = errors.New("character is already in beastform")
+	ErrNotInBeastform       = errors.New("character is not in beastform")
+	ErrBeastformTierTooHigh = errors.New("beastform tier is above the character's tier")
+	ErrBeastformStressFull  = errors.New("character cannot mark stress to transform")
+)
+
+// Beastform is the part of a beastform catalog entry that changes a
+// character's rolls while transformed.
+type Beastform struct {
+	ID           string
+	Tier         int
+	Trait        string
+	TraitBonus   int
+	EvasionBonus int
+}
+
+// BeastformEntryStress validates a transformation and returns the Stress the
+// character has after paying for it.
+func BeastformEntryStress(level, stress, stressMax int, form Beastform) (int, error) {
+	if form.Tier > TierForLevel(level) {
+		return 0, ErrBeastformTierTooHigh
+	}
+	if stress+BeastformStressCost > stressMax {
+		return 0, ErrBeastformStressFull
+	}
+	return stress + BeastformStressCost, nil
+}
+
+// TraitBonusFor returns the bonus the beastform adds to rolls with trait.
+func (f Beastform) TraitBonusFor(trait string) int {
+	if f.Trait == "" || !strings.EqualFold(strings.TrimSpace(trait), f.Trait) {
+		return 0
+	}
+	return f.TraitBonus
+}
+
+// Evasion returns the character's Evasion while in the beastform.
+func (f Beastform) Evasion(evasion int) int {
+	return evasion + f.EvasionBonus
+}
+
+// DropsBeastform reports whether damage marked the character's last Hit
+// Point, which forces them out of beastform.
+func DropsBeastform(hpBefore, hpAfter int) bool {
+	return hpBefore > 0 && hpAfter <= 0
+}
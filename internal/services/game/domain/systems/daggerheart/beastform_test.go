@@ -0,0 +1,39 @@
+package daggerheart
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBeastformEntryStress(t *testing.T) {
+	form := Beastform{ID: "beastform.pack-predator", Tier: 2}
+	stress, err := BeastformEntryStress(2, 1, 6, form)
+	if err != nil {
+		t.Fatalf("BeastformEntryStress returned error: %v", err)
+	}
+	if stress != 2 {
+		t.Fatalf("stress = %d, want 2", stress)
+	}
+	if _, err := BeastformEntryStress(1, 0, 6, form); !errors.Is(err, ErrBeastformTierTooHigh) {
+		t.Fatalf("error = %v, want %v", err, ErrBeastformTierTooHigh)
+	}
+	if _, err := BeastformEntryStress(2, 6, 6, form); !errors.Is(err, ErrBeastformStressFull) {
+		t.Fatalf("error = %v, want %v", err, ErrBeastformStressFull)
+	}
+}
+
+func TestBeastformModifiers(t *testing.T) {
+	form := Beastform{Trait: "strength", TraitBonus: 2, EvasionBonus: 1}
+	if got := form.TraitBonusFor("Strength"); got != 2 {
+		t.Fatalf("strength bonus = %d, want 2", got)
+	}
+	if got := form.TraitBonusFor("agility"); got != 0 {
+		t.Fatalf("agility bonus = %d, want 0", got)
+	}
+	if got := form.Evasion(10); got != 11 {
+		t.Fatalf("evasion = %d, want 11", got)
+	}
+	if !DropsBeastform(2, 0) || DropsBeastform(2, 1) || DropsBeastform(0, 0) {
+		t.Fatal("expected beastform to drop only when the last hit point is marked")
+	}
+}
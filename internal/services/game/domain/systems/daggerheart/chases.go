@@ -0,0 +1,72 @@
+package daggerheart
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Chase statuses track whether a chase is still running.
+const (
+	ChaseStatusActive   = "active"
+	ChaseStatusResolved = "resolved"
+)
+
+// Chase winners name the countdown that triggered first.
+const (
+	// ChaseWinnerProgress means the PCs reached their goal.
+	ChaseWinnerProgress = "progress"
+	// ChaseWinnerConsequence means the opposition got away or caught up.
+	ChaseWinnerConsequence = "consequence"
+)
+
+// DefaultChaseProgressOutcomeDeltas returns the dynamic progress countdown
+// ticks per action roll outcome: successes advance the PCs, failures do not.
+func DefaultChaseProgressOutcomeDeltas() map[string]int {
+	return map[string]int{
+		CountdownOutcomeCriticalSuccess: 3,
+		CountdownOutcomeSuccessWithHope: 2,
+		CountdownOutcomeSuccessWithFear: 1,
+		CountdownOutcomeFailureWithHope: 0,
+		CountdownOutcomeFailureWithFear: 0,
+	}
+}
+
+// DefaultChaseConsequenceOutcomeDeltas returns the dynamic consequence
+// countdown ticks per action roll outcome: the worse the roll, the further the
+// opposition advances.
+func DefaultChaseConsequenceOutcomeDeltas() map[string]int {
+	return map[string]int{
+		CountdownOutcomeCriticalSuccess: 0,
+		CountdownOutcomeSuccessWithHope: 0,
+		CountdownOutcomeSuccessWithFear: 1,
+		CountdownOutcomeFailureWithHope: 2,
+		CountdownOutcomeFailureWithFear: 3,
+	}
+}
+
+// NormalizeChaseWinner validates a chase winner.
+func NormalizeChaseWinner(value string) (string, error) {
+	trimmed := strings.TrimSpace(strings.ToLower(value))
+	if trimmed == "" {
+		return "", fmt.Errorf("chase winner is required")
+	}
+	switch trimmed {
+	case ChaseWinnerProgress, ChaseWinnerConsequence:
+		return trimmed, nil
+	default:
+		return "", fmt.Errorf("chase winner %q is invalid", value)
+	}
+}
+
+// ResolveChase reports which side wins once either countdown triggers. When
+// a single roll triggers both, the PCs' progress wins.
+func ResolveChase(progressTriggered, consequenceTriggered bool) (string, bool) {
+	switch {
+	case progressTriggered:
+		return ChaseWinnerProgress, true
+	case consequenceTriggered:
+		return ChaseWinnerConsequence, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,53 @@
+package daggerheart
+
+import "testing"
+
+func TestDefaultChaseOutcomeDeltasAreValidPolicies(t *testing.T) {
+	for name, deltas := range map[string]map[string]int{
+		"progress":    DefaultChaseProgressOutcomeDeltas(),
+		"consequence": DefaultChaseConsequenceOutcomeDeltas(),
+	} {
+		if _, err := NormalizeCountdownTickPolicy(CountdownTickPolicy{Trigger: CountdownTriggerActionRoll, OutcomeDeltas: deltas}); err != nil {
+			t.Fatalf("%s deltas: %v", name, err)
+		}
+	}
+	if got := DefaultChaseConsequenceOutcomeDeltas()[CountdownOutcomeFailureWithFear]; got != 3 {
+		t.Fatalf("consequence failure with fear = %d, want 3", got)
+	}
+	if got := DefaultChaseProgressOutcomeDeltas()[CountdownOutcomeCriticalSuccess]; got != 3 {
+		t.Fatalf("progress critical = %d, want 3", got)
+	}
+}
+
+func TestResolveChase(t *testing.T) {
+	tests := []struct {
+		name                          string
+		progress, consequence, wantOK bool
+		want                          string
+	}{
+		{name: "neither", wantOK: false},
+		{name: "progress", progress: true, wantOK: true, want: ChaseWinnerProgress},
+		{name: "consequence", consequence: true, wantOK: true, want: ChaseWinnerConsequence},
+		{name: "both favors progress", progress: true, consequence: true, wantOK: true, want: ChaseWinnerProgress},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ResolveChase(tt.progress, tt.consequence)
+			if ok != tt.wantOK || got != tt.want {
+				t.Fatalf("ResolveChase = %q, %v; want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNormalizeChaseWinner(t *testing.T) {
+	if winner, err := NormalizeChaseWinner(" Progress "); err != nil || winner != ChaseWinnerProgress {
+		t.Fatalf("winner = %q, %v", winner, err)
+	}
+	if _, err := NormalizeChaseWinner(""); err == nil {
+		t.Fatal("expected error for empty winner")
+	}
+	if _, err := NormalizeChaseWinner("draw"); err == nil {
+		t.Fatal("expected error for unsupported winner")
+	}
+}
@@ -0,0 +1,104 @@
+package daggerheart
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Subclass stages, in the order their feature cards are unlocked.
+const (
+	SubclassStageFoundation     = "foundation"
+	SubclassStageSpecialization = "specialization"
+	SubclassStageMastery        = "mastery"
+)
+
+var subclassStageRank = map[string]int{
+	SubclassStageFoundation:     1,
+	SubclassStageSpecialization: 2,
+	SubclassStageMastery:        3,
+}
+
+var (
+	// ErrSubclassMastered indicates a subclass has no further upgrades.
+	ErrSubclassMastered = errors.New("subclass is already at mastery")
+	// ErrSubclassRequired indicates a subclass upgrade was taken without a subclass.
+	ErrSubclassRequired = errors.New("subclass upgrade requires a subclass")
+	// ErrMulticlassSameClass indicates a multiclass into the character's own class.
+	ErrMulticlassSameClass = errors.New("multiclass must choose a different class")
+	// ErrDomainNotAvailable indicates a domain card outside the character's domains.
+	ErrDomainNotAvailable = errors.New("domain card is not in the character's domains")
+)
+
+// ClassSelection captures a character's class, subclass, and multiclass picks.
+type ClassSelection struct {
+	ClassID       string
+	SubclassID    string
+	SubclassStage string
+	// Multiclass fields are set once by the multiclass advancement.
+	MulticlassClassID       string
+	MulticlassSubclassID    string
+	MulticlassSubclassStage string
+	MulticlassDomainID      string
+}
+
+// NormalizeSubclassStage validates a subclass stage, defaulting to foundation.
+func NormalizeSubclassStage(stage string) (string, error) {
+	trimmed := strings.TrimSpace(strings.ToLower(stage))
+	if trimmed == "" {
+		return SubclassStageFoundation, nil
+	}
+	if _, ok := subclassStageRank[trimmed]; !ok {
+		return "", fmt.Errorf("subclass stage %q is invalid", stage)
+	}
+	return trimmed, nil
+}
+
+// NextSubclassStage returns the stage unlocked by a subclass upgrade.
+func NextSubclassStage(stage string) (string, error) {
+	current, err := NormalizeSubclassStage(stage)
+	if err != nil {
+		return "", err
+	}
+	switch current {
+	case SubclassStageFoundation:
+		return SubclassStageSpecialization, nil
+	case SubclassStageSpecialization:
+		return SubclassStageMastery, nil
+	default:
+		return "", ErrSubclassMastered
+	}
+}
+
+// SubclassStageUnlocks reports whether reaching stage grants the features of
+// the feature stage.
+func SubclassStageUnlocks(stage, feature string) bool {
+	current, err := NormalizeSubclassStage(stage)
+	if err != nil {
+		return false
+	}
+	return subclassStageRank[current] >= subclassStageRank[feature]
+}
+
+// AccessibleDomains returns the domains a character may draw cards from: the
+// primary class domains plus the single domain chosen when multiclassing.
+func AccessibleDomains(classDomainIDs []string, multiclassDomainID string) []string {
+	domains := make([]string, 0, len(classDomainIDs)+1)
+	for _, domainID := range classDomainIDs {
+		if domainID = strings.TrimSpace(domainID); domainID != "" && indexOf(domains, domainID) == -1 {
+			domains = append(domains, domainID)
+		}
+	}
+	if domainID := strings.TrimSpace(multiclassDomainID); domainID != "" && indexOf(domains, domainID) == -1 {
+		domains = append(domains, domainID)
+	}
+	return domains
+}
+
+// ValidateDomainAccess checks that a card's domain is one the character can use.
+func ValidateDomainAccess(domainID string, accessible []string) error {
+	if indexOf(accessible, strings.TrimSpace(domainID)) == -1 {
+		return fmt.Errorf("%w: %q", ErrDomainNotAvailable, domainID)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package daggerheart
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNextSubclassStage(t *testing.T) {
+	tests := []struct {
+		stage string
+		want  string
+	}{
+		{"", SubclassStageSpecialization},
+		{SubclassStageFoundation, SubclassStageSpecialization},
+		{SubclassStageSpecialization, SubclassStageMastery},
+	}
+	for _, tc := range tests {
+		got, err := NextSubclassStage(tc.stage)
+		if err != nil {
+			t.Fatalf("NextSubclassStage(%q) returned error: %v", tc.stage, err)
+		}
+		if got != tc.want {
+			t.Fatalf("NextSubclassStage(%q) = %q, want %q", tc.stage, got, tc.want)
+		}
+	}
+	if _, err := NextSubclassStage(SubclassStageMastery); !errors.Is(err, ErrSubclassMastered) {
+		t.Fatalf("expected ErrSubclassMastered, got %v", err)
+	}
+	if _, err := NextSubclassStage("legendary"); err == nil {
+		t.Fatal("expected invalid stage error")
+	}
+}
+
+func TestSubclassStageUnlocks(t *testing.T) {
+	if !SubclassStageUnlocks(SubclassStageSpecialization, SubclassStageFoundation) {
+		t.Fatal("specialization should include foundation features")
+	}
+	if SubclassStageUnlocks(SubclassStageSpecialization, SubclassStageMastery) {
+		t.Fatal("specialization should not include mastery features")
+	}
+	if !SubclassStageUnlocks("", SubclassStageFoundation) {
+		t.Fatal("empty stage should default to foundation")
+	}
+}
+
+func TestAccessibleDomains(t *testing.T) {
+	domains := AccessibleDomains([]string{"domain.valor", "domain.blade", "domain.valor"}, "domain.codex")
+	if len(domains) != 3 || domains[2] != "domain.codex" {
+		t.Fatalf("domains = %v", domains)
+	}
+	if err := ValidateDomainAccess("domain.blade", domains); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ValidateDomainAccess("domain.grace", domains); !errors.Is(err, ErrDomainNotAvailable) {
+		t.Fatalf("expected ErrDomainNotAvailable, got %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+package daggerheart
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Starting values for a new animal companion.
+const (
+	CompanionStartingEvasion        = 10
+	CompanionStartingStressMax      = 3
+	CompanionStartingDamageDieSides = 6
+	CompanionStartingRange          = RangeMelee
+	CompanionStartingExperiences    = 2
+	CompanionExperienceModifier     = 2
+)
+
+// Companion upgrades chosen each time the companion levels up.
+const (
+	CompanionUpgradeIntelligent     = "intelligent"
+	CompanionUpgradeLightInTheDark  = "light_in_the_dark"
+	CompanionUpgradeCreatureComfort = "creature_comfort"
+	CompanionUpgradeArmored         = "armored"
+	CompanionUpgradeVicious         = "vicious"
+	CompanionUpgradeResilient       = "resilient"
+	CompanionUpgradeBonded          = "bonded"
+	CompanionUpgradeAware           = "aware"
+)
+
+// A Vicious upgrade improves either the damage die or the attack range.
+const (
+	CompanionViciousDamage = "damage"
+	CompanionViciousRange  = "range"
+)
+
+// CompanionAwareEvasionBonus is the Evasion an Aware upgrade adds.
+const CompanionAwareEvasionBonus = 2
+
+var (
+	ErrCompanionOutOfScene        = errors.New("companion is out of the scene")
+	ErrCompanionExperienceCount   = fmt.Errorf("companion requires %d distinct experiences", CompanionStartingExperiences)
+	ErrCompanionExperienceMissing = errors.New("companion does not have the experience")
+	ErrCompanionDamageDieMaxed    = errors.New("companion damage die is already a d12")
+	ErrCompanionRangeMaxed        = errors.New("companion range cannot increase further")
+	ErrInvalidCompanionUpgrade    = errors.New("companion upgrade is invalid")
+)
+
+var companionDamageDice = []int{6, 8, 10, 12}
+
+// CompanionExperience is a companion Experience and its modifier.
+type CompanionExperience struct {
+	ExperienceID string `json:"experience_id"`
+	Modifier     int    `json:"modifier"`
+}
+
+// CompanionStats are the companion values that level-ups change.
+type CompanionStats struct {
+	Evasion        int
+	StressMax      int
+	DamageDieSides int
+	Range          string
+	Experiences    []CompanionExperience
+}
+
+// NewCompanionStats returns the starting stats for a companion with the given
+// Experiences.
+func NewCompanionStats(experienceIDs []string) (CompanionStats, error) {
+	if len(experienceIDs) != CompanionStartingExperiences {
+		return CompanionStats{}, ErrCompanionExperienceCount
+	}
+	experiences := make([]CompanionExperience, 0, len(experienceIDs))
+	for _, id := range experienceIDs {
+		if id == "" || slices.ContainsFunc(experiences, func(e CompanionExperience) bool { return e.ExperienceID == id }) {
+			return CompanionStats{}, ErrCompanionExperienceCount
+		}
+		experiences = append(experiences, CompanionExperience{ExperienceID: id, Modifier: CompanionExperienceModifier})
+	}
+	return CompanionStats{
+		Evasion:        CompanionStartingEvasion,
+		StressMax:      CompanionStartingStressMax,
+		DamageDieSides: CompanionStartingDamageDieSides,
+		Range:          CompanionStartingRange,
+		Experiences:    experiences,
+	}, nil
+}
+
+// IsCompanionUpgrade reports whether the value is a known companion upgrade.
+func IsCompanionUpgrade(value string) bool {
+	switch value {
+	case CompanionUpgradeIntelligent, CompanionUpgradeLightInTheDark, CompanionUpgradeCreatureComfort,
+		CompanionUpgradeArmored, CompanionUpgradeVicious, CompanionUpgradeResilient,
+		CompanionUpgradeBonded, CompanionUpgradeAware:
+		return true
+	default:
+		return false
+	}
+}
+
+// ApplyCompanionUpgrade returns the stats after a level-up upgrade.
+// Intelligent raises the named Experience by 1, Vicious steps the damage die
+// or range, Resilient adds a Stress slot and Aware adds Evasion. The other
+// upgrades are narrative and leave the stats unchanged.
+func ApplyCompanionUpgrade(stats CompanionStats, upgrade, experienceID, vicious string) (CompanionStats, error) {
+	next := stats
+	next.Experiences = slices.Clone(stats.Experiences)
+	switch upgrade {
+	case CompanionUpgradeIntelligent:
+		index := slices.IndexFunc(next.Experiences, func(e CompanionExperience) bool { return e.ExperienceID == experienceID })
+		if index < 0 {
+			return CompanionStats{}, ErrCompanionExperienceMissing
+		}
+		next.Experiences[index].Modifier++
+	case CompanionUpgradeVicious:
+		switch vicious {
+		case CompanionViciousDamage:
+			index := slices.Index(companionDamageDice, next.DamageDieSides)
+			if index < 0 || index == len(companionDamageDice)-1 {
+				return CompanionStats{}, ErrCompanionDamageDieMaxed
+			}
+			next.DamageDieSides = companionDamageDice[index+1]
+		case CompanionViciousRange:
+			farther := PushRangeBand(next.Range, 1)
+			if farther == next.Range {
+				return CompanionStats{}, ErrCompanionRangeMaxed
+			}
+			next.Range = farther
+		default:
+			return CompanionStats{}, ErrInvalidCompanionUpgrade
+		}
+	case CompanionUpgradeResilient:
+		next.StressMax++
+	case CompanionUpgradeAware:
+		next.Evasion += CompanionAwareEvasionBonus
+	case CompanionUpgradeLightInTheDark, CompanionUpgradeCreatureComfort, CompanionUpgradeArmored, CompanionUpgradeBonded:
+	default:
+		return CompanionStats{}, ErrInvalidCompanionUpgrade
+	}
+	return next, nil
+}
+
+// CompanionStressAfter applies a Stress delta to a companion, clamped to its
+// Stress track. A companion that would take damage marks Stress instead.
+func CompanionStressAfter(stress, stressMax, delta int) int {
+	return min(max(stress+delta, 0), stressMax)
+}
+
+// CompanionOutOfScene reports whether the companion has marked its last
+// Stress and dropped out of the scene until the Stress is cleared.
+func CompanionOutOfScene(stress, stressMax int) bool {
+	return stressMax > 0 && stress >= stressMax
+}
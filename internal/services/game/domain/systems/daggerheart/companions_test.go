@@ -0,0 +1,121 @@
+package daggerheart
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNewCompanionStats(t *testing.T) {
+	stats, err := NewCompanionStats([]string{"companion-experience.scout", "companion-experience.loyal-until-the-end"})
+	if err != nil {
+		t.Fatalf("NewCompanionStats returned error: %v", err)
+	}
+	want := CompanionStats{
+		Evasion:        10,
+		StressMax:      3,
+		DamageDieSides: 6,
+		Range:          RangeMelee,
+		Experiences: []CompanionExperience{
+			{ExperienceID: "companion-experience.scout", Modifier: 2},
+			{ExperienceID: "companion-experience.loyal-until-the-end", Modifier: 2},
+		},
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Fatalf("stats = %+v, want %+v", stats, want)
+	}
+
+	for _, ids := range [][]string{
+		{"companion-experience.scout"},
+		{"companion-experience.scout", "companion-experience.scout"},
+		{"companion-experience.scout", ""},
+	} {
+		if _, err := NewCompanionStats(ids); !errors.Is(err, ErrCompanionExperienceCount) {
+			t.Fatalf("NewCompanionStats(%v) error = %v, want %v", ids, err, ErrCompanionExperienceCount)
+		}
+	}
+}
+
+func TestApplyCompanionUpgrade(t *testing.T) {
+	base, err := NewCompanionStats([]string{"exp.a", "exp.b"})
+	if err != nil {
+		t.Fatalf("NewCompanionStats returned error: %v", err)
+	}
+
+	intelligent, err := ApplyCompanionUpgrade(base, CompanionUpgradeIntelligent, "exp.b", "")
+	if err != nil {
+		t.Fatalf("intelligent returned error: %v", err)
+	}
+	if intelligent.Experiences[1].Modifier != 3 || base.Experiences[1].Modifier != 2 {
+		t.Fatalf("intelligent experiences = %v, base = %v", intelligent.Experiences, base.Experiences)
+	}
+
+	tests := []struct {
+		name    string
+		upgrade string
+		vicious string
+		check   func(CompanionStats) bool
+	}{
+		{name: "vicious damage", upgrade: CompanionUpgradeVicious, vicious: CompanionViciousDamage, check: func(s CompanionStats) bool { return s.DamageDieSides == 8 }},
+		{name: "vicious range", upgrade: CompanionUpgradeVicious, vicious: CompanionViciousRange, check: func(s CompanionStats) bool { return s.Range == RangeVeryClose }},
+		{name: "resilient", upgrade: CompanionUpgradeResilient, check: func(s CompanionStats) bool { return s.StressMax == 4 }},
+		{name: "aware", upgrade: CompanionUpgradeAware, check: func(s CompanionStats) bool { return s.Evasion == 12 }},
+		{name: "bonded", upgrade: CompanionUpgradeBonded, check: func(s CompanionStats) bool { return reflect.DeepEqual(s, base) }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ApplyCompanionUpgrade(base, tc.upgrade, "", tc.vicious)
+			if err != nil {
+				t.Fatalf("ApplyCompanionUpgrade returned error: %v", err)
+			}
+			if !tc.check(got) {
+				t.Fatalf("stats after %s = %+v", tc.upgrade, got)
+			}
+		})
+	}
+}
+
+func TestApplyCompanionUpgradeRejects(t *testing.T) {
+	base, err := NewCompanionStats([]string{"exp.a", "exp.b"})
+	if err != nil {
+		t.Fatalf("NewCompanionStats returned error: %v", err)
+	}
+	maxedDie := base
+	maxedDie.DamageDieSides = 12
+	maxedRange := base
+	maxedRange.Range = RangeVeryFar
+
+	tests := []struct {
+		name         string
+		stats        CompanionStats
+		upgrade      string
+		experienceID string
+		vicious      string
+		want         error
+	}{
+		{name: "unknown upgrade", stats: base, upgrade: "wings", want: ErrInvalidCompanionUpgrade},
+		{name: "vicious without choice", stats: base, upgrade: CompanionUpgradeVicious, want: ErrInvalidCompanionUpgrade},
+		{name: "missing experience", stats: base, upgrade: CompanionUpgradeIntelligent, experienceID: "exp.c", want: ErrCompanionExperienceMissing},
+		{name: "maxed die", stats: maxedDie, upgrade: CompanionUpgradeVicious, vicious: CompanionViciousDamage, want: ErrCompanionDamageDieMaxed},
+		{name: "maxed range", stats: maxedRange, upgrade: CompanionUpgradeVicious, vicious: CompanionViciousRange, want: ErrCompanionRangeMaxed},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := ApplyCompanionUpgrade(tc.stats, tc.upgrade, tc.experienceID, tc.vicious); !errors.Is(err, tc.want) {
+				t.Fatalf("error = %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestCompanionStress(t *testing.T) {
+	if got := CompanionStressAfter(2, 3, 4); got != 3 {
+		t.Fatalf("stress after marking past max = %d, want 3", got)
+	}
+	if got := CompanionStressAfter(1, 3, -2); got != 0 {
+		t.Fatalf("stress after clearing past zero = %d, want 0", got)
+	}
+	if !CompanionOutOfScene(3, 3) || CompanionOutOfScene(2, 3) {
+		t.Fatal("expected a companion to drop out only at max stress")
+	}
+}
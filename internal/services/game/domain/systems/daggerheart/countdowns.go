@@ -15,15 +15,48 @@ const (
 	CountdownDirectionDecrease = "decrease"
 )
 
+// Countdown tick triggers name the game flows that advance a countdown
+// automatically.
+const (
+	CountdownTriggerActionRoll  = "action_roll"
+	CountdownTriggerRest        = "rest"
+	CountdownTriggerFearSpent   = "fear_spent"
+	CountdownTriggerMajorDamage = "major_damage"
+)
+
+// Countdown outcome tiers key per-outcome deltas for action roll ticks. They
+// mirror the duality roll outcome names in lower snake case.
+const (
+	CountdownOutcomeRollWithHope    = "roll_with_hope"
+	CountdownOutcomeRollWithFear    = "roll_with_fear"
+	CountdownOutcomeSuccessWithHope = "success_with_hope"
+	CountdownOutcomeSuccessWithFear = "success_with_fear"
+	CountdownOutcomeFailureWithHope = "failure_with_hope"
+	CountdownOutcomeFailureWithFear = "failure_with_fear"
+	CountdownOutcomeCriticalSuccess = "critical_success"
+)
+
 type CountdownUpdate struct {
 	Before    int
 	After     int
 	Delta     int
 	Looped    bool
+	Triggered bool
 	Reason    string
 	Countdown Countdown
 }
 
+// CountdownTickPolicy declares when a countdown advances on its own.
+//
+// Delta is the number of steps taken toward the countdown's end each time the
+// trigger fires; negative values step back. For action roll triggers,
+// OutcomeDeltas overrides Delta for the listed outcome tiers.
+type CountdownTickPolicy struct {
+	Trigger       string
+	Delta         int
+	OutcomeDeltas map[string]int
+}
+
 type Countdown struct {
 	CampaignID string
 	ID         string
@@ -33,6 +66,7 @@ type Countdown struct {
 	Max        int
 	Direction  string
 	Looping    bool
+	TickPolicy CountdownTickPolicy
 }
 
 func NormalizeCountdownKind(value string) (string, error) {
@@ -109,3 +143,137 @@ func ApplyCountdownUpdate(countdown Countdown, delta int, override *int) (Countd
 	update.Countdown.Current = after
 	return update, nil
 }
+
+// NormalizeCountdownTrigger validates a tick trigger. An empty trigger means
+// the countdown only changes through explicit updates.
+func NormalizeCountdownTrigger(value string) (string, error) {
+	trimmed := strings.TrimSpace(strings.ToLower(value))
+	switch trimmed {
+	case "", CountdownTriggerActionRoll, CountdownTriggerRest, CountdownTriggerFearSpent, CountdownTriggerMajorDamage:
+		return trimmed, nil
+	default:
+		return "", fmt.Errorf("countdown trigger %q is invalid", value)
+	}
+}
+
+// NormalizeCountdownOutcome validates an action roll outcome tier.
+func NormalizeCountdownOutcome(value string) (string, error) {
+	trimmed := strings.TrimSpace(strings.ToLower(value))
+	if trimmed == "" {
+		return "", fmt.Errorf("countdown outcome is required")
+	}
+	switch trimmed {
+	case CountdownOutcomeRollWithHope, CountdownOutcomeRollWithFear,
+		CountdownOutcomeSuccessWithHope, CountdownOutcomeSuccessWithFear,
+		CountdownOutcomeFailureWithHope, CountdownOutcomeFailureWithFear,
+		CountdownOutcomeCriticalSuccess:
+		return trimmed, nil
+	default:
+		return "", fmt.Errorf("countdown outcome %q is invalid", value)
+	}
+}
+
+// NormalizeCountdownTickPolicy validates a tick policy and fills defaults.
+// A policy with a trigger but no deltas ticks one step per trigger.
+func NormalizeCountdownTickPolicy(policy CountdownTickPolicy) (CountdownTickPolicy, error) {
+	trigger, err := NormalizeCountdownTrigger(policy.Trigger)
+	if err != nil {
+		return CountdownTickPolicy{}, err
+	}
+	if trigger == "" {
+		if policy.Delta != 0 || len(policy.OutcomeDeltas) > 0 {
+			return CountdownTickPolicy{}, fmt.Errorf("countdown tick deltas require a trigger")
+		}
+		return CountdownTickPolicy{}, nil
+	}
+	if len(policy.OutcomeDeltas) > 0 && trigger != CountdownTriggerActionRoll {
+		return CountdownTickPolicy{}, fmt.Errorf("countdown outcome deltas require the %s trigger", CountdownTriggerActionRoll)
+	}
+
+	normalized := CountdownTickPolicy{Trigger: trigger, Delta: policy.Delta}
+	if len(policy.OutcomeDeltas) > 0 {
+		normalized.OutcomeDeltas = make(map[string]int, len(policy.OutcomeDeltas))
+		for outcome, delta := range policy.OutcomeDeltas {
+			key, err := NormalizeCountdownOutcome(outcome)
+			if err != nil {
+				return CountdownTickPolicy{}, err
+			}
+			normalized.OutcomeDeltas[key] = delta
+		}
+	}
+	if normalized.Delta == 0 && len(normalized.OutcomeDeltas) == 0 {
+		normalized.Delta = 1
+	}
+	return normalized, nil
+}
+
+// Steps reports how many steps the policy advances for a trigger. The outcome
+// is only consulted for action roll triggers.
+func (p CountdownTickPolicy) Steps(trigger, outcome string) int {
+	if p.Trigger == "" || p.Trigger != trigger {
+		return 0
+	}
+	if trigger == CountdownTriggerActionRoll {
+		if delta, ok := p.OutcomeDeltas[strings.ToLower(outcome)]; ok {
+			return delta
+		}
+	}
+	return p.Delta
+}
+
+// CountdownEnd returns the value a countdown triggers at: Max for increasing
+// countdowns and zero for decreasing ones.
+func CountdownEnd(countdown Countdown) int {
+	if countdown.Direction == CountdownDirectionDecrease {
+		return 0
+	}
+	return countdown.Max
+}
+
+// TickCountdown advances a countdown by steps toward its end: up to Max for
+// increasing countdowns and down to zero for decreasing ones. Reaching the end
+// marks the update as triggered; looping countdowns then reset to their start.
+// A non-looping countdown already at its end does not move.
+func TickCountdown(countdown Countdown, steps int) (CountdownUpdate, error) {
+	if countdown.Max <= 0 {
+		return CountdownUpdate{}, fmt.Errorf("countdown max must be positive")
+	}
+	if countdown.Current < 0 || countdown.Current > countdown.Max {
+		return CountdownUpdate{}, fmt.Errorf("countdown current must be in range 0..%d", countdown.Max)
+	}
+	if steps == 0 {
+		return CountdownUpdate{}, fmt.Errorf("countdown tick requires non-zero steps")
+	}
+
+	start, end, signed := 0, countdown.Max, steps
+	if countdown.Direction == CountdownDirectionDecrease {
+		start, end, signed = countdown.Max, 0, -steps
+	}
+
+	before := countdown.Current
+	update := CountdownUpdate{Before: before, After: before, Countdown: countdown}
+	if steps > 0 && before == end && !countdown.Looping {
+		return update, nil
+	}
+
+	after := before + signed
+	reached := steps > 0 && ((signed > 0 && after >= end) || (signed < 0 && after <= end))
+	switch {
+	case reached && countdown.Looping:
+		after = start
+		update.Looped = true
+		update.Triggered = true
+	case reached:
+		after = end
+		update.Triggered = true
+	case after > countdown.Max:
+		after = countdown.Max
+	case after < 0:
+		after = 0
+	}
+
+	update.After = after
+	update.Delta = after - before
+	update.Countdown.Current = after
+	return update, nil
+}
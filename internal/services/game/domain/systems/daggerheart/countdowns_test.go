@@ -181,6 +181,180 @@ func TestApplyCountdownUpdateClampNoLoop(t *testing.T) {
 	}
 }
 
+func TestNormalizeCountdownTickPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  CountdownTickPolicy
+		want    CountdownTickPolicy
+		wantErr bool
+	}{
+		{"none", CountdownTickPolicy{}, CountdownTickPolicy{}, false},
+		{"default delta", CountdownTickPolicy{Trigger: " REST "}, CountdownTickPolicy{Trigger: CountdownTriggerRest, Delta: 1}, false},
+		{"explicit delta", CountdownTickPolicy{Trigger: "fear_spent", Delta: 2}, CountdownTickPolicy{Trigger: CountdownTriggerFearSpent, Delta: 2}, false},
+		{
+			"outcome deltas",
+			CountdownTickPolicy{Trigger: "action_roll", OutcomeDeltas: map[string]int{"FAILURE_WITH_FEAR": 2}},
+			CountdownTickPolicy{Trigger: CountdownTriggerActionRoll, OutcomeDeltas: map[string]int{CountdownOutcomeFailureWithFear: 2}},
+			false,
+		},
+		{"delta without trigger", CountdownTickPolicy{Delta: 1}, CountdownTickPolicy{}, true},
+		{"invalid trigger", CountdownTickPolicy{Trigger: "moon_phase"}, CountdownTickPolicy{}, true},
+		{"outcome deltas on rest", CountdownTickPolicy{Trigger: "rest", OutcomeDeltas: map[string]int{"critical_success": 1}}, CountdownTickPolicy{}, true},
+		{"invalid outcome", CountdownTickPolicy{Trigger: "action_roll", OutcomeDeltas: map[string]int{"tie": 1}}, CountdownTickPolicy{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeCountdownTickPolicy(tt.policy)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Trigger != tt.want.Trigger || got.Delta != tt.want.Delta || len(got.OutcomeDeltas) != len(tt.want.OutcomeDeltas) {
+				t.Fatalf("policy = %+v, want %+v", got, tt.want)
+			}
+			for outcome, delta := range tt.want.OutcomeDeltas {
+				if got.OutcomeDeltas[outcome] != delta {
+					t.Fatalf("outcome %s delta = %d, want %d", outcome, got.OutcomeDeltas[outcome], delta)
+				}
+			}
+		})
+	}
+}
+
+func TestCountdownTickPolicySteps(t *testing.T) {
+	policy := CountdownTickPolicy{
+		Trigger:       CountdownTriggerActionRoll,
+		Delta:         1,
+		OutcomeDeltas: map[string]int{CountdownOutcomeFailureWithFear: 3, CountdownOutcomeCriticalSuccess: -1},
+	}
+	if got := policy.Steps(CountdownTriggerActionRoll, CountdownOutcomeSuccessWithHope); got != 1 {
+		t.Fatalf("success with hope steps = %d, want 1", got)
+	}
+	if got := policy.Steps(CountdownTriggerActionRoll, "FAILURE_WITH_FEAR"); got != 3 {
+		t.Fatalf("failure with fear steps = %d, want 3", got)
+	}
+	if got := policy.Steps(CountdownTriggerActionRoll, CountdownOutcomeCriticalSuccess); got != -1 {
+		t.Fatalf("critical success steps = %d, want -1", got)
+	}
+	if got := policy.Steps(CountdownTriggerRest, ""); got != 0 {
+		t.Fatalf("rest steps = %d, want 0", got)
+	}
+}
+
+func TestTickCountdown(t *testing.T) {
+	tests := []struct {
+		name          string
+		countdown     Countdown
+		steps         int
+		wantAfter     int
+		wantDelta     int
+		wantLooped    bool
+		wantTriggered bool
+		wantErr       bool
+	}{
+		{
+			name:      "increase",
+			countdown: Countdown{Current: 1, Max: 4, Direction: CountdownDirectionIncrease},
+			steps:     2,
+			wantAfter: 3,
+			wantDelta: 2,
+		},
+		{
+			name:      "decrease",
+			countdown: Countdown{Current: 4, Max: 4, Direction: CountdownDirectionDecrease},
+			steps:     1,
+			wantAfter: 3,
+			wantDelta: -1,
+		},
+		{
+			name:          "increase reaches end",
+			countdown:     Countdown{Current: 3, Max: 4, Direction: CountdownDirectionIncrease},
+			steps:         2,
+			wantAfter:     4,
+			wantDelta:     1,
+			wantTriggered: true,
+		},
+		{
+			name:          "decrease reaches end and loops",
+			countdown:     Countdown{Current: 1, Max: 4, Direction: CountdownDirectionDecrease, Looping: true},
+			steps:         1,
+			wantAfter:     4,
+			wantDelta:     3,
+			wantLooped:    true,
+			wantTriggered: true,
+		},
+		{
+			name:          "increase loops to zero",
+			countdown:     Countdown{Current: 3, Max: 4, Direction: CountdownDirectionIncrease, Looping: true},
+			steps:         1,
+			wantAfter:     0,
+			wantDelta:     -3,
+			wantLooped:    true,
+			wantTriggered: true,
+		},
+		{
+			name:      "already at end",
+			countdown: Countdown{Current: 4, Max: 4, Direction: CountdownDirectionIncrease},
+			steps:     1,
+			wantAfter: 4,
+		},
+		{
+			name:      "negative steps move away from end",
+			countdown: Countdown{Current: 1, Max: 4, Direction: CountdownDirectionDecrease},
+			steps:     -5,
+			wantAfter: 4,
+			wantDelta: 3,
+		},
+		{
+			name:      "zero steps",
+			countdown: Countdown{Current: 1, Max: 4},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid max",
+			countdown: Countdown{Max: 0},
+			steps:     1,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			update, err := TickCountdown(tt.countdown, tt.steps)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if update.After != tt.wantAfter {
+				t.Fatalf("after = %d, want %d", update.After, tt.wantAfter)
+			}
+			if update.Delta != tt.wantDelta {
+				t.Fatalf("delta = %d, want %d", update.Delta, tt.wantDelta)
+			}
+			if update.Looped != tt.wantLooped {
+				t.Fatalf("looped = %v, want %v", update.Looped, tt.wantLooped)
+			}
+			if update.Triggered != tt.wantTriggered {
+				t.Fatalf("triggered = %v, want %v", update.Triggered, tt.wantTriggered)
+			}
+			if update.Countdown.Current != tt.wantAfter {
+				t.Fatalf("countdown current = %d, want %d", update.Countdown.Current, tt.wantAfter)
+			}
+		})
+	}
+}
+
 func intPointer(value int) *int {
 	return &value
 }
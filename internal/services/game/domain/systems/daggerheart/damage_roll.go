@@ -58,3 +58,14 @@ func RollDamage(request DamageRollRequest) (DamageRollResult, error) {
 		Total:         total,
 	}, nil
 }
+
+// WeaponDamageDice scales a weapon's damage dice by the wielder's Proficiency,
+// so a d8 weapon at Proficiency 2 rolls 2d8. Proficiency is at least 1.
+func WeaponDamageDice(weaponDice []DamageDieSpec, proficiency int) []DamageDieSpec {
+	proficiency = max(proficiency, 1)
+	scaled := make([]DamageDieSpec, 0, len(weaponDice))
+	for _, spec := range weaponDice {
+		scaled = append(scaled, DamageDieSpec{Sides: spec.Sides, Count: spec.Count * proficiency})
+	}
+	return scaled
+}
@@ -47,3 +47,20 @@ func TestRollDamageNoDice(t *testing.T) {
 		t.Fatal("expected error for no dice")
 	}
 }
+
+func TestWeaponDamageDiceScalesByProficiency(t *testing.T) {
+	weaponDice := []DamageDieSpec{{Sides: 8, Count: 1}}
+
+	scaled := WeaponDamageDice(weaponDice, 3)
+	if len(scaled) != 1 || scaled[0].Sides != 8 || scaled[0].Count != 3 {
+		t.Fatalf("scaled dice = %v, want 3d8", scaled)
+	}
+	if weaponDice[0].Count != 1 {
+		t.Fatalf("weapon dice mutated: %v", weaponDice)
+	}
+
+	scaled = WeaponDamageDice(weaponDice, 0)
+	if scaled[0].Count != 1 {
+		t.Fatalf("count at proficiency 0 = %d, want 1", scaled[0].Count)
+	}
+}
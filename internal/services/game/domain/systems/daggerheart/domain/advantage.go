@@ -0,0 +1,132 @@
+package domain
+
+// AdvantageSourceKind classifies why a roll gains advantage or disadvantage.
+type AdvantageSourceKind string
+
+const (
+	AdvantageSourceCondition AdvantageSourceKind = "condition"
+	AdvantageSourceTarget    AdvantageSourceKind = "target"
+	AdvantageSourceFeature   AdvantageSourceKind = "feature"
+	AdvantageSourceGMRuling  AdvantageSourceKind = "gm_ruling"
+	AdvantageSourceHelp      AdvantageSourceKind = "help"
+)
+
+// AdvantageSource is a named reason a roll gains advantage or disadvantage,
+// such as the Hidden condition, a Vulnerable target, or a GM ruling.
+type AdvantageSource struct {
+	Kind         AdvantageSourceKind
+	Name         string
+	Disadvantage bool
+}
+
+// AdvantagePool captures every advantage and disadvantage applied to a roll.
+type AdvantagePool struct {
+	Advantage    int
+	Disadvantage int
+	Sources      []AdvantageSource
+}
+
+// Net returns 1 when advantage remains after cancelling one-for-one against
+// disadvantage, -1 when disadvantage remains, and 0 when they cancel out.
+// A roll never adds or subtracts more than a single d6.
+func (p AdvantagePool) Net() int {
+	switch {
+	case p.Advantage > p.Disadvantage:
+		return 1
+	case p.Disadvantage > p.Advantage:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// PoolAdvantage combines unnamed advantage/disadvantage counts with named
+// sources into a single pool. Negative counts are ignored.
+func PoolAdvantage(advantage, disadvantage int, sources []AdvantageSource) AdvantagePool {
+	pool := AdvantagePool{
+		Advantage:    max(advantage, 0),
+		Disadvantage: max(disadvantage, 0),
+	}
+	for _, source := range sources {
+		if source.Disadvantage {
+			pool.Disadvantage++
+		} else {
+			pool.Advantage++
+		}
+		pool.Sources = append(pool.Sources, source)
+	}
+	return pool
+}
+
+// withHelpSources returns sources with one Help an Ally advantage source per
+// helper appended.
+func withHelpSources(sources []AdvantageSource, helpers int) []AdvantageSource {
+	pooled := append([]AdvantageSource(nil), sources...)
+	for i := 0; i < helpers; i++ {
+		pooled = append(pooled, AdvantageSource{Kind: AdvantageSourceHelp, Name: "Help an Ally"})
+	}
+	return pooled
+}
+
+// advantageSteps records each named source and the resulting net pool.
+func advantageSteps(pool AdvantagePool) []ExplainStep {
+	if len(pool.Sources) == 0 {
+		return nil
+	}
+	steps := make([]ExplainStep, 0, len(pool.Sources)+1)
+	for _, source := range pool.Sources {
+		effect := "advantage"
+		if source.Disadvantage {
+			effect = "disadvantage"
+		}
+		steps = append(steps, ExplainStep{
+			Code:    "ADVANTAGE_SOURCE",
+			Message: "Apply " + effect + " from " + source.Name,
+			Data: map[string]any{
+				"kind":   string(source.Kind),
+				"name":   source.Name,
+				"effect": effect,
+			},
+		})
+	}
+	steps = append(steps, ExplainStep{
+		Code:    "POOL_ADVANTAGE",
+		Message: "Cancel advantage against disadvantage one-for-one",
+		Data: map[string]any{
+			"advantage":    pool.Advantage,
+			"disadvantage": pool.Disadvantage,
+			"net":          pool.Net(),
+		},
+	})
+	return steps
+}
+
+// helpDiceSteps records each Help an Ally die and whether it was kept. Help
+// dice are advantage dice, so only the highest of them applies.
+func helpDiceSteps(dice []int) []ExplainStep {
+	if len(dice) == 0 {
+		return nil
+	}
+	kept := 0
+	for i, die := range dice {
+		if die > dice[kept] {
+			kept = i
+		}
+	}
+	steps := make([]ExplainStep, 0, len(dice))
+	for i, die := range dice {
+		message := "Help die discarded; only the highest advantage die applies"
+		if i == kept {
+			message = "Help die kept as advantage"
+		}
+		steps = append(steps, ExplainStep{
+			Code:    "HELP_DIE",
+			Message: message,
+			Data: map[string]any{
+				"die":  die,
+				"kept": i == kept,
+			},
+		})
+	}
+	return steps
+}
@@ -181,6 +181,130 @@ func TestRollActionAdvantageModifier(t *testing.T) {
 	}
 }
 
+func TestRollActionHelpDice(t *testing.T) {
+	base, err := RollAction(ActionRequest{Modifier: 1, Seed: 9})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+
+	helped, err := RollAction(ActionRequest{Modifier: 1, Seed: 9, HelpDice: 2})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+	if helped.Hope != base.Hope || helped.Fear != base.Fear {
+		t.Fatalf("expected hope/fear to match without help")
+	}
+	if len(helped.HelpDice) != 2 {
+		t.Fatalf("help dice = %v, want 2 dice", helped.HelpDice)
+	}
+	highest := 0
+	for _, die := range helped.HelpDice {
+		if die < 1 || die > 6 {
+			t.Fatalf("help die out of range: %d", die)
+		}
+		highest = max(highest, die)
+	}
+	if helped.AdvantageDie != highest || helped.AdvantageModifier != highest {
+		t.Fatalf("advantage die = %d, modifier = %d, want %d", helped.AdvantageDie, helped.AdvantageModifier, highest)
+	}
+	if helped.Total != base.Total+highest {
+		t.Fatalf("total = %d, want %d", helped.Total, base.Total+highest)
+	}
+	if helped.AdvantagePool.Advantage != 2 || len(helped.AdvantagePool.Sources) != 2 || helped.AdvantagePool.Sources[0].Kind != AdvantageSourceHelp {
+		t.Fatalf("advantage pool = %+v, want two help sources", helped.AdvantagePool)
+	}
+}
+
+func TestRollActionHelpDiceShareTheAdvantageDie(t *testing.T) {
+	helped, err := RollAction(ActionRequest{Seed: 9, Advantage: 1, HelpDice: 1})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+	if len(helped.HelpDice) != 1 {
+		t.Fatalf("help dice = %v, want 1 die", helped.HelpDice)
+	}
+	if helped.AdvantageModifier != helped.AdvantageDie || helped.AdvantageDie < helped.HelpDice[0] {
+		t.Fatalf("advantage die = %d, modifier = %d, help dice = %v", helped.AdvantageDie, helped.AdvantageModifier, helped.HelpDice)
+	}
+	if helped.Total != helped.Hope+helped.Fear+helped.AdvantageDie {
+		t.Fatalf("total = %d, want a single advantage die added", helped.Total)
+	}
+}
+
+func TestRollActionDisadvantageCancelsHelpDice(t *testing.T) {
+	base, err := RollAction(ActionRequest{Seed: 9})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+
+	cancelled, err := RollAction(ActionRequest{Seed: 9, Disadvantage: 1, HelpDice: 1})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+	if len(cancelled.HelpDice) != 0 || cancelled.AdvantageDie != 0 || cancelled.Total != base.Total {
+		t.Fatalf("expected help to cancel disadvantage, got %+v", cancelled)
+	}
+
+	// Disadvantage cancels the roller's advantage before the helper's die.
+	partial, err := RollAction(ActionRequest{Seed: 9, Advantage: 1, Disadvantage: 1, HelpDice: 1})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+	if len(partial.HelpDice) != 1 || partial.AdvantageDie != partial.HelpDice[0] {
+		t.Fatalf("help dice = %v, advantage die = %d", partial.HelpDice, partial.AdvantageDie)
+	}
+
+	outweighed, err := RollAction(ActionRequest{Seed: 9, Disadvantage: 2, HelpDice: 1})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+	if len(outweighed.HelpDice) != 0 || outweighed.AdvantageModifier != -outweighed.AdvantageDie || outweighed.AdvantageDie == 0 {
+		t.Fatalf("expected net disadvantage, got die %d modifier %d help %v", outweighed.AdvantageDie, outweighed.AdvantageModifier, outweighed.HelpDice)
+	}
+}
+
+func TestRollActionExtraFearDie(t *testing.T) {
+	base, err := RollAction(ActionRequest{Seed: 9, Advantage: 1, HelpDice: 1})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+	chaos, err := RollAction(ActionRequest{Seed: 9, Advantage: 1, HelpDice: 1, ExtraFearDie: true})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+	if chaos.Hope != base.Hope || chaos.AdvantageDie != base.AdvantageDie || len(chaos.HelpDice) != len(base.HelpDice) {
+		t.Fatalf("expected extra fear die to leave other dice unchanged")
+	}
+	if len(chaos.FearDice) != 2 || chaos.FearDice[0] != base.Fear {
+		t.Fatalf("fear dice = %v, base fear %d", chaos.FearDice, base.Fear)
+	}
+	if chaos.Fear != max(chaos.FearDice[0], chaos.FearDice[1]) {
+		t.Fatalf("fear = %d, want higher of %v", chaos.Fear, chaos.FearDice)
+	}
+}
+
+func TestRollActionHopeDieSides(t *testing.T) {
+	base, err := RollAction(ActionRequest{Seed: 9})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+	if base.HopeDieSides != 12 {
+		t.Fatalf("default hope die sides = %d, want 12", base.HopeDieSides)
+	}
+	for seed := int64(0); seed < 50; seed++ {
+		result, err := RollAction(ActionRequest{Seed: seed, HopeDieSides: 4})
+		if err != nil {
+			t.Fatalf("RollAction returned error: %v", err)
+		}
+		if result.HopeDieSides != 4 || result.Hope < 1 || result.Hope > 4 {
+			t.Fatalf("seed %d: hope %d on d%d, want a d4 result", seed, result.Hope, result.HopeDieSides)
+		}
+	}
+	if _, err := RollAction(ActionRequest{Seed: 9, HopeDieSides: 20}); !errors.Is(err, ErrInvalidDualityDie) {
+		t.Fatalf("RollAction error = %v, want %v", err, ErrInvalidDualityDie)
+	}
+}
+
 func TestRollReactionSemantics(t *testing.T) {
 	result, err := RollReaction(ReactionRequest{
 		Modifier:   1,
@@ -366,6 +490,109 @@ func TestExplainOutcomeProvidesSteps(t *testing.T) {
 	}
 }
 
+func TestPoolAdvantageCancelsOneForOne(t *testing.T) {
+	sources := []AdvantageSource{
+		{Kind: AdvantageSourceCondition, Name: "Hidden"},
+		{Kind: AdvantageSourceTarget, Name: "Vulnerable"},
+		{Kind: AdvantageSourceGMRuling, Name: "Slippery footing", Disadvantage: true},
+	}
+	pool := PoolAdvantage(0, 0, sources)
+	if pool.Advantage != 2 || pool.Disadvantage != 1 || pool.Net() != 1 {
+		t.Fatalf("pool = %+v, net %d", pool, pool.Net())
+	}
+
+	pool = PoolAdvantage(-1, 1, sources)
+	if pool.Advantage != 2 || pool.Disadvantage != 2 || pool.Net() != 0 {
+		t.Fatalf("pool = %+v, net %d", pool, pool.Net())
+	}
+
+	base, err := RollAction(ActionRequest{Seed: 9})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+	cancelled, err := RollAction(ActionRequest{Seed: 9, Disadvantage: 1, Sources: sources[:1]})
+	if err != nil {
+		t.Fatalf("RollAction returned error: %v", err)
+	}
+	if cancelled.AdvantageDie != 0 || cancelled.Total != base.Total {
+		t.Fatalf("expected cancelled pool to roll no d6, got die %d total %d", cancelled.AdvantageDie, cancelled.Total)
+	}
+	if len(cancelled.AdvantagePool.Sources) != 1 {
+		t.Fatalf("pool sources = %v", cancelled.AdvantagePool.Sources)
+	}
+}
+
+func TestExplainOutcomeAdvantageSources(t *testing.T) {
+	result, err := ExplainOutcome(OutcomeRequest{
+		Hope:     6,
+		Fear:     3,
+		Modifier: 4,
+		AdvantageSources: []AdvantageSource{
+			{Kind: AdvantageSourceCondition, Name: "Hidden"},
+			{Kind: AdvantageSourceFeature, Name: "Rally", Disadvantage: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ExplainOutcome returned error: %v", err)
+	}
+	wantCodes := []string{"SUM_DICE", "ADVANTAGE_SOURCE", "ADVANTAGE_SOURCE", "POOL_ADVANTAGE", "APPLY_MODIFIER", "CHECK_CRIT", "CHECK_DIFFICULTY", "SELECT_OUTCOME"}
+	if len(result.Steps) != len(wantCodes) {
+		t.Fatalf("ExplainOutcome steps = %d, want %d", len(result.Steps), len(wantCodes))
+	}
+	for i, code := range wantCodes {
+		if result.Steps[i].Code != code {
+			t.Fatalf("ExplainOutcome step %d code = %q, want %q", i, result.Steps[i].Code, code)
+		}
+	}
+	if got := result.Steps[2].Data["effect"]; got != "disadvantage" {
+		t.Fatalf("second source effect = %v, want disadvantage", got)
+	}
+	if got := structInt(t, result.Steps[3].Data, "net"); got != 0 {
+		t.Fatalf("POOL_ADVANTAGE net = %d, want 0", got)
+	}
+}
+
+func TestExplainOutcomeHelpDiceAndExperiences(t *testing.T) {
+	// Modifier 7 = trait 1 + Stealth 2 + the kept help die 4.
+	result, err := ExplainOutcome(OutcomeRequest{
+		Hope:        6,
+		Fear:        3,
+		Modifier:    7,
+		HelpDice:    []int{2, 4},
+		Experiences: []RollExperience{{Name: "Stealth", Modifier: 2}},
+	})
+	if err != nil {
+		t.Fatalf("ExplainOutcome returned error: %v", err)
+	}
+	wantCodes := []string{"SUM_DICE", "SPEND_EXPERIENCE", "ADVANTAGE_SOURCE", "ADVANTAGE_SOURCE", "POOL_ADVANTAGE", "HELP_DIE", "HELP_DIE", "APPLY_MODIFIER", "CHECK_CRIT", "CHECK_DIFFICULTY", "SELECT_OUTCOME"}
+	if len(result.Steps) != len(wantCodes) {
+		t.Fatalf("ExplainOutcome steps = %d, want %d", len(result.Steps), len(wantCodes))
+	}
+	for i, code := range wantCodes {
+		if result.Steps[i].Code != code {
+			t.Fatalf("ExplainOutcome step %d code = %q, want %q", i, result.Steps[i].Code, code)
+		}
+	}
+	if got := result.Steps[1].Message; got != "Spend 1 Hope on experience Stealth +2" {
+		t.Fatalf("SPEND_EXPERIENCE message = %q", got)
+	}
+	if got := result.Steps[2].Data["kind"]; got != string(AdvantageSourceHelp) {
+		t.Fatalf("helper source kind = %v, want help", got)
+	}
+	if got := structInt(t, result.Steps[4].Data, "advantage"); got != 2 {
+		t.Fatalf("POOL_ADVANTAGE advantage = %d, want 2", got)
+	}
+	if result.Steps[5].Data["kept"] != false || result.Steps[6].Data["kept"] != true {
+		t.Fatalf("HELP_DIE kept = %v / %v, want only the 4 kept", result.Steps[5].Data["kept"], result.Steps[6].Data["kept"])
+	}
+	if got := result.Steps[6].Message; got != "Help die kept as advantage" {
+		t.Fatalf("kept HELP_DIE message = %q", got)
+	}
+	if result.Total != 16 {
+		t.Fatalf("ExplainOutcome total = %d, want 16", result.Total)
+	}
+}
+
 func TestDualityProbabilityCounts(t *testing.T) {
 	result, err := DualityProbability(ProbabilityRequest{Modifier: 0, Difficulty: 10})
 	if err != nil {
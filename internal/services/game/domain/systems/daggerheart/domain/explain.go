@@ -1,5 +1,7 @@
 package domain
 
+import "fmt"
+
 // ExplainOutcome returns a deterministic explanation for the provided outcome request.
 func ExplainOutcome(request OutcomeRequest) (ExplainResult, error) {
 	result, err := EvaluateOutcome(request)
@@ -38,6 +40,11 @@ func ExplainOutcome(request OutcomeRequest) (ExplainResult, error) {
 				"base_total": baseTotal,
 			},
 		},
+	}
+	steps = append(steps, experienceSteps(request.Experiences)...)
+	steps = append(steps, advantageSteps(PoolAdvantage(0, 0, withHelpSources(request.AdvantageSources, len(request.HelpDice))))...)
+	steps = append(steps, helpDiceSteps(request.HelpDice)...)
+	steps = append(steps, []ExplainStep{
 		{
 			Code:    "APPLY_MODIFIER",
 			Message: "Apply modifier to base total",
@@ -69,7 +76,7 @@ func ExplainOutcome(request OutcomeRequest) (ExplainResult, error) {
 				"outcome_label": result.Outcome.String(),
 			},
 		},
-	}
+	}...)
 
 	return ExplainResult{
 		OutcomeResult: result,
@@ -78,3 +85,23 @@ func ExplainOutcome(request OutcomeRequest) (ExplainResult, error) {
 		Steps:         steps,
 	}, nil
 }
+
+// experienceSteps records the Hope spent on each Experience and its modifier.
+func experienceSteps(experiences []RollExperience) []ExplainStep {
+	if len(experiences) == 0 {
+		return nil
+	}
+	steps := make([]ExplainStep, 0, len(experiences))
+	for _, experience := range experiences {
+		steps = append(steps, ExplainStep{
+			Code:    "SPEND_EXPERIENCE",
+			Message: fmt.Sprintf("Spend 1 Hope on experience %s %+d", experience.Name, experience.Modifier),
+			Data: map[string]any{
+				"name":       experience.Name,
+				"modifier":   experience.Modifier,
+				"hope_spent": 1,
+			},
+		})
+	}
+	return steps
+}
@@ -9,6 +9,8 @@ func RollReaction(request ReactionRequest) (ReactionResult, error) {
 		Seed:         request.Seed,
 		Advantage:    request.Advantage,
 		Disadvantage: request.Disadvantage,
+		Sources:      request.Sources,
+		HopeDieSides: request.HopeDieSides,
 	})
 	if err != nil {
 		return ReactionResult{}, err
@@ -7,19 +7,37 @@ import (
 // RollAction performs an action roll from the provided request.
 // It uses the core dice package for deterministic rolling.
 func RollAction(request ActionRequest) (ActionResult, error) {
-	advantage := request.Advantage
-	disadvantage := request.Disadvantage
-	netAdvantage := 0
-	if advantage > disadvantage {
-		netAdvantage = 1
-	} else if disadvantage > advantage {
-		netAdvantage = -1
+	pool := PoolAdvantage(request.Advantage, request.Disadvantage, withHelpSources(request.Sources, request.HelpDice))
+	netAdvantage := pool.Net()
+
+	// Disadvantage cancels the roller's own advantage before helper dice, so
+	// the dice helpers paid Hope for are the last to be cancelled.
+	helpKept := 0
+	advantageRolled := netAdvantage != 0
+	if netAdvantage > 0 {
+		excess := pool.Advantage - pool.Disadvantage
+		helpKept = min(max(request.HelpDice, 0), excess)
+		advantageRolled = excess > helpKept
+	}
+
+	hopeSides := request.HopeDieSides
+	if hopeSides == 0 {
+		hopeSides = 12
+	}
+	if hopeSides < 1 || hopeSides > 12 {
+		return ActionResult{}, ErrInvalidDualityDie
 	}
 
-	rollSpecs := []dice.Spec{{Sides: 12, Count: 2}}
-	if netAdvantage != 0 {
+	rollSpecs := []dice.Spec{{Sides: hopeSides, Count: 1}, {Sides: 12, Count: 1}}
+	if advantageRolled {
 		rollSpecs = append(rollSpecs, dice.Spec{Sides: 6, Count: 1})
 	}
+	if helpKept > 0 {
+		rollSpecs = append(rollSpecs, dice.Spec{Sides: 6, Count: helpKept})
+	}
+	if request.ExtraFearDie {
+		rollSpecs = append(rollSpecs, dice.Spec{Sides: 12, Count: 1})
+	}
 
 	rollResult, err := dice.RollDice(dice.Request{
 		Dice: rollSpecs,
@@ -31,16 +49,32 @@ func RollAction(request ActionRequest) (ActionResult, error) {
 	}
 
 	hope := rollResult.Rolls[0].Results[0]
-	fear := rollResult.Rolls[0].Results[1]
+	fear := rollResult.Rolls[1].Results[0]
+	// Optional dice follow the duality dice in the order they were requested.
+	extra := rollResult.Rolls[2:]
 	advantageDie := 0
-	advantageModifier := 0
-	if netAdvantage != 0 {
-		advantageDie = rollResult.Rolls[1].Results[0]
-		if netAdvantage > 0 {
-			advantageModifier = advantageDie
-		} else {
-			advantageModifier = -advantageDie
-		}
+	if advantageRolled {
+		advantageDie = extra[0].Results[0]
+		extra = extra[1:]
+	}
+	var helpDice []int
+	if helpKept > 0 {
+		helpDice = extra[0].Results
+		extra = extra[1:]
+	}
+	// Help dice are advantage dice: only the highest of them applies.
+	for _, die := range helpDice {
+		advantageDie = max(advantageDie, die)
+	}
+	advantageModifier := advantageDie
+	if netAdvantage < 0 {
+		advantageModifier = -advantageDie
+	}
+
+	var fearDice []int
+	if request.ExtraFearDie {
+		fearDice = []int{fear, extra[0].Results[0]}
+		fear = max(fearDice[0], fearDice[1])
 	}
 
 	outcome, err := EvaluateOutcome(OutcomeRequest{
@@ -59,6 +93,10 @@ func RollAction(request ActionRequest) (ActionResult, error) {
 		Modifier:          outcome.Modifier,
 		AdvantageDie:      advantageDie,
 		AdvantageModifier: advantageModifier,
+		AdvantagePool:     pool,
+		HelpDice:          helpDice,
+		FearDice:          fearDice,
+		HopeDieSides:      hopeSides,
 		Difficulty:        outcome.Difficulty,
 		Total:             outcome.Total,
 		IsCrit:            outcome.IsCrit,
@@ -89,6 +89,15 @@ type ActionRequest struct {
 	Seed         int64
 	Advantage    int
 	Disadvantage int
+	// Sources are named advantage/disadvantage sources pooled with the counts.
+	Sources []AdvantageSource
+	// HelpDice is the number of Help an Ally d6s. Each counts as an advantage
+	// source, so disadvantage cancels them one-for-one.
+	HelpDice int
+	// ExtraFearDie rolls a second Fear die and keeps the higher (Chaos Magic).
+	ExtraFearDie bool
+	// HopeDieSides is the size of the Hope die; zero rolls the usual d12.
+	HopeDieSides int
 }
 
 // ActionResult contains the outcome of an action roll.
@@ -98,11 +107,18 @@ type ActionResult struct {
 	Modifier          int
 	AdvantageDie      int
 	AdvantageModifier int
-	Difficulty        *int
-	Total             int
-	IsCrit            bool
-	MeetsDifficulty   bool
-	Outcome           Outcome
+	AdvantagePool     AdvantagePool
+	// HelpDice are the Help an Ally d6s left after cancellation, in helper
+	// order. They compete with the advantage die; only the highest applies.
+	HelpDice []int
+	FearDice []int
+	// HopeDieSides is the size of the Hope die that was rolled.
+	HopeDieSides    int
+	Difficulty      *int
+	Total           int
+	IsCrit          bool
+	MeetsDifficulty bool
+	Outcome         Outcome
 }
 
 // ReactionRequest describes a reaction roll request.
@@ -112,6 +128,8 @@ type ReactionRequest struct {
 	Seed         int64
 	Advantage    int
 	Disadvantage int
+	Sources      []AdvantageSource
+	HopeDieSides int
 }
 
 // ReactionResult contains the outcome of a reaction roll.
@@ -129,6 +147,22 @@ type OutcomeRequest struct {
 	Fear       int
 	Modifier   int
 	Difficulty *int
+	// AdvantageSources are explained alongside the outcome; the advantage die
+	// itself is expected to be folded into Modifier.
+	AdvantageSources []AdvantageSource
+	// HelpDice are the Help an Ally d6s left after cancellation. Each helper
+	// pools as an advantage source; the highest die kept is expected to be
+	// folded into Modifier.
+	HelpDice []int
+	// Experiences are spent on the roll for 1 Hope each; their modifiers are
+	// expected to be folded into Modifier.
+	Experiences []RollExperience
+}
+
+// RollExperience is an Experience spent on a roll.
+type RollExperience struct {
+	Name     string
+	Modifier int
 }
 
 // OutcomeResult captures the deterministic outcome evaluation.
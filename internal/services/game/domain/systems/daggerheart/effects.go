@@ -0,0 +1,185 @@
+package daggerheart
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Targets a timed effect can be placed on.
+const (
+	EffectTargetCharacter = "character"
+	EffectTargetAdversary = "adversary"
+)
+
+// Effect kinds describe what a timed effect modifies.
+const (
+	// EffectKindArmor adds temporary Armor Slots to a character.
+	EffectKindArmor = "armor"
+	// EffectKindEvasion adds to the target's Evasion.
+	EffectKindEvasion = "evasion"
+	// EffectKindRoll adds to the target's action and attack rolls.
+	EffectKindRoll = "roll"
+	// EffectKindDamage adds to the damage the target deals.
+	EffectKindDamage = "damage"
+	// EffectKindHopeDie changes the sides of a character's Hope Die.
+	EffectKindHopeDie = "hope_die"
+)
+
+// Stacking rules decide how effects of the same kind on one target combine.
+const (
+	// EffectStackingStack adds the effect to every other effect of its kind.
+	EffectStackingStack = "stack"
+	// EffectStackingHighest applies only the largest of its kind.
+	EffectStackingHighest = "highest"
+	// EffectStackingReplace expires earlier effects from the same source.
+	EffectStackingReplace = "replace"
+)
+
+// Expiry triggers end an effect when the matching event happens.
+const (
+	EffectExpiresManual     = "manual"
+	EffectExpiresRest       = "rest"
+	EffectExpiresLongRest   = "long_rest"
+	EffectExpiresSceneEnd   = "scene_end"
+	EffectExpiresNextAttack = "next_attack"
+)
+
+// Reasons recorded when an effect ends outside its expiry trigger.
+const (
+	EffectExpiredRemoved  = "removed"
+	EffectExpiredReplaced = "replaced"
+)
+
+// Effect is a temporary modifier placed on a character or adversary.
+type Effect struct {
+	ID         string
+	TargetType string
+	TargetID   string
+	Source     string
+	Kind       string
+	Value      int
+	Stacking   string
+	ExpiresOn  string
+}
+
+// NormalizeEffectTargetType validates an effect target type.
+func NormalizeEffectTargetType(value string) (string, error) {
+	trimmed := strings.TrimSpace(strings.ToLower(value))
+	switch trimmed {
+	case "":
+		return "", fmt.Errorf("effect target type is required")
+	case EffectTargetCharacter, EffectTargetAdversary:
+		return trimmed, nil
+	default:
+		return "", fmt.Errorf("effect target type %q is invalid", value)
+	}
+}
+
+// NormalizeEffectKind validates an effect kind.
+func NormalizeEffectKind(value string) (string, error) {
+	trimmed := strings.TrimSpace(strings.ToLower(value))
+	switch trimmed {
+	case "":
+		return "", fmt.Errorf("effect kind is required")
+	case EffectKindArmor, EffectKindEvasion, EffectKindRoll, EffectKindDamage, EffectKindHopeDie:
+		return trimmed, nil
+	default:
+		return "", fmt.Errorf("effect kind %q is invalid", value)
+	}
+}
+
+// NormalizeEffectStacking validates a stacking rule, defaulting to stack.
+func NormalizeEffectStacking(value string) (string, error) {
+	trimmed := strings.TrimSpace(strings.ToLower(value))
+	switch trimmed {
+	case "":
+		return EffectStackingStack, nil
+	case EffectStackingStack, EffectStackingHighest, EffectStackingReplace:
+		return trimmed, nil
+	default:
+		return "", fmt.Errorf("effect stacking %q is invalid", value)
+	}
+}
+
+// NormalizeEffectExpiry validates an expiry trigger, defaulting to manual.
+func NormalizeEffectExpiry(value string) (string, error) {
+	trimmed := strings.TrimSpace(strings.ToLower(value))
+	switch trimmed {
+	case "":
+		return EffectExpiresManual, nil
+	case EffectExpiresManual, EffectExpiresRest, EffectExpiresLongRest, EffectExpiresSceneEnd, EffectExpiresNextAttack:
+		return trimmed, nil
+	default:
+		return "", fmt.Errorf("effect expiry %q is invalid", value)
+	}
+}
+
+// EffectTotal returns the modifier effects of kind add together. Stacking
+// effects add up; of the highest-only effects just the largest counts.
+func EffectTotal(effects []Effect, kind string) int {
+	total := 0
+	highest, seenHighest := 0, false
+	for _, effect := range effects {
+		if effect.Kind != kind {
+			continue
+		}
+		if effect.Stacking == EffectStackingHighest {
+			if !seenHighest || effect.Value > highest {
+				highest, seenHighest = effect.Value, true
+			}
+			continue
+		}
+		total += effect.Value
+	}
+	return total + highest
+}
+
+// HopeDieSides returns the size of the Hope Die once the hope_die effects
+// total modifier is applied, kept between a d4 and the usual d12.
+func HopeDieSides(modifier int) int {
+	return min(max(12+modifier, 4), 12)
+}
+
+// EffectReplaces reports whether applying next expires existing.
+func EffectReplaces(next, existing Effect) bool {
+	return next.Stacking == EffectStackingReplace &&
+		existing.TargetType == next.TargetType &&
+		existing.TargetID == next.TargetID &&
+		existing.Kind == next.Kind &&
+		existing.Source == next.Source
+}
+
+// ArmorWithEffects returns a character's Armor Slots after the armor effect
+// bonus changes from before to after. New bonus slots are added; when the
+// bonus shrinks, slots above the armor max plus the remaining bonus are lost,
+// so temporary slots are spent first.
+func ArmorWithEffects(armor, armorMax, before, after int) int {
+	if after > before {
+		return armor + after - before
+	}
+	limit := armorMax + after
+	if limit < 0 {
+		limit = 0
+	}
+	if armor > limit {
+		return limit
+	}
+	return armor
+}
+
+// ArmorAfterExpiry returns a character's Armor Slots once the effect with
+// expiredID ends, given the effects currently placed on them.
+func ArmorAfterExpiry(armor, armorMax int, effects []Effect, expiredID string) int {
+	remaining := make([]Effect, 0, len(effects))
+	for _, effect := range effects {
+		if effect.ID != expiredID {
+			remaining = append(remaining, effect)
+		}
+	}
+	return ArmorWithEffects(
+		armor,
+		armorMax,
+		EffectTotal(effects, EffectKindArmor),
+		EffectTotal(remaining, EffectKindArmor),
+	)
+}
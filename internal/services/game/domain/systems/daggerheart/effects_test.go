@@ -0,0 +1,120 @@
+package daggerheart
+
+import "testing"
+
+func TestNormalizeEffectFields(t *testing.T) {
+	if kind, err := NormalizeEffectKind(" Armor "); err != nil || kind != EffectKindArmor {
+		t.Fatalf("kind = %q, %v", kind, err)
+	}
+	if kind, err := NormalizeEffectKind("hope_die"); err != nil || kind != EffectKindHopeDie {
+		t.Fatalf("kind = %q, %v", kind, err)
+	}
+	if _, err := NormalizeEffectKind("stress"); err == nil {
+		t.Fatal("expected error for unsupported kind")
+	}
+	if stacking, err := NormalizeEffectStacking(""); err != nil || stacking != EffectStackingStack {
+		t.Fatalf("stacking = %q, %v", stacking, err)
+	}
+	if expiry, err := NormalizeEffectExpiry(""); err != nil || expiry != EffectExpiresManual {
+		t.Fatalf("expiry = %q, %v", expiry, err)
+	}
+	if _, err := NormalizeEffectExpiry("forever"); err == nil {
+		t.Fatal("expected error for unsupported expiry")
+	}
+	if _, err := NormalizeEffectTargetType("npc"); err == nil {
+		t.Fatal("expected error for unsupported target type")
+	}
+}
+
+func TestEffectTotal(t *testing.T) {
+	effects := []Effect{
+		{Kind: EffectKindDamage, Value: 2, Stacking: EffectStackingStack},
+		{Kind: EffectKindDamage, Value: 1, Stacking: EffectStackingStack},
+		{Kind: EffectKindDamage, Value: 3, Stacking: EffectStackingHighest},
+		{Kind: EffectKindDamage, Value: 2, Stacking: EffectStackingHighest},
+		{Kind: EffectKindEvasion, Value: 5, Stacking: EffectStackingStack},
+	}
+	if got := EffectTotal(effects, EffectKindDamage); got != 6 {
+		t.Fatalf("damage total = %d, want 6", got)
+	}
+	if got := EffectTotal(effects, EffectKindRoll); got != 0 {
+		t.Fatalf("roll total = %d, want 0", got)
+	}
+}
+
+func TestEffectTotalNegativeHighest(t *testing.T) {
+	effects := []Effect{
+		{Kind: EffectKindRoll, Value: -3, Stacking: EffectStackingHighest},
+		{Kind: EffectKindRoll, Value: -1, Stacking: EffectStackingHighest},
+	}
+	if got := EffectTotal(effects, EffectKindRoll); got != -1 {
+		t.Fatalf("roll total = %d, want -1", got)
+	}
+}
+
+func TestHopeDieSides(t *testing.T) {
+	tests := []struct {
+		modifier, want int
+	}{
+		{modifier: 0, want: 12},
+		{modifier: -2, want: 10},
+		{modifier: -10, want: 4},
+		{modifier: 2, want: 12},
+	}
+	for _, tc := range tests {
+		if got := HopeDieSides(tc.modifier); got != tc.want {
+			t.Fatalf("HopeDieSides(%d) = %d, want %d", tc.modifier, got, tc.want)
+		}
+	}
+}
+
+func TestEffectReplaces(t *testing.T) {
+	existing := Effect{TargetType: EffectTargetAdversary, TargetID: "adv-1", Kind: EffectKindDamage, Source: "rage"}
+	next := Effect{TargetType: EffectTargetAdversary, TargetID: "adv-1", Kind: EffectKindDamage, Source: "rage", Stacking: EffectStackingReplace}
+	if !EffectReplaces(next, existing) {
+		t.Fatal("expected same-source effect to be replaced")
+	}
+	next.Source = "blessing"
+	if EffectReplaces(next, existing) {
+		t.Fatal("expected other sources to be kept")
+	}
+	next.Source = "rage"
+	next.TargetType = EffectTargetCharacter
+	if EffectReplaces(next, existing) {
+		t.Fatal("expected effects on other target types to be kept")
+	}
+}
+
+func TestArmorWithEffects(t *testing.T) {
+	tests := []struct {
+		name                           string
+		armor, armorMax, before, after int
+		want                           int
+	}{
+		{name: "bonus added", armor: 3, armorMax: 3, before: 0, after: 2, want: 5},
+		{name: "unspent bonus lost", armor: 5, armorMax: 3, before: 2, after: 0, want: 3},
+		{name: "bonus spent first", armor: 2, armorMax: 3, before: 2, after: 0, want: 2},
+		{name: "partial bonus kept", armor: 6, armorMax: 3, before: 3, after: 1, want: 4},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ArmorWithEffects(tc.armor, tc.armorMax, tc.before, tc.after); got != tc.want {
+				t.Fatalf("armor = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestArmorAfterExpiry(t *testing.T) {
+	effects := []Effect{
+		{ID: "fx-1", Kind: EffectKindArmor, Value: 2, Stacking: EffectStackingStack},
+		{ID: "fx-2", Kind: EffectKindArmor, Value: 1, Stacking: EffectStackingStack},
+		{ID: "fx-3", Kind: EffectKindEvasion, Value: 2, Stacking: EffectStackingStack},
+	}
+	if got := ArmorAfterExpiry(6, 3, effects, "fx-1"); got != 4 {
+		t.Fatalf("armor = %d, want 4", got)
+	}
+	if got := ArmorAfterExpiry(6, 3, effects, "fx-3"); got != 6 {
+		t.Fatalf("armor = %d, want 6", got)
+	}
+}
@@ -0,0 +1,189 @@
+package daggerheart
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Adversary roles price an adversary in battle points.
+const (
+	AdversaryRoleBruiser  = "bruiser"
+	AdversaryRoleHorde    = "horde"
+	AdversaryRoleLeader   = "leader"
+	AdversaryRoleMinion   = "minion"
+	AdversaryRoleRanged   = "ranged"
+	AdversaryRoleSkulk    = "skulk"
+	AdversaryRoleSocial   = "social"
+	AdversaryRoleSolo     = "solo"
+	AdversaryRoleStandard = "standard"
+	AdversaryRoleSupport  = "support"
+)
+
+// Encounter adjustments are the SRD modifiers to the battle point budget.
+const (
+	EncounterAdjustmentEasierFight      = "easier_fight"
+	EncounterAdjustmentMultipleSolos    = "multiple_solos"
+	EncounterAdjustmentBonusDamage      = "bonus_damage"
+	EncounterAdjustmentLowerTier        = "lower_tier_adversary"
+	EncounterAdjustmentNoHeavyAdversary = "no_heavy_adversaries"
+	EncounterAdjustmentHarderFight      = "harder_fight"
+)
+
+// adversaryRoleBattlePoints is the SRD battle point cost of one adversary per
+// role; Minions cost this much per group the size of the party.
+var adversaryRoleBattlePoints = map[string]int{
+	AdversaryRoleMinion:   1,
+	AdversaryRoleSocial:   1,
+	AdversaryRoleSupport:  1,
+	AdversaryRoleHorde:    2,
+	AdversaryRoleRanged:   2,
+	AdversaryRoleSkulk:    2,
+	AdversaryRoleStandard: 2,
+	AdversaryRoleLeader:   3,
+	AdversaryRoleBruiser:  4,
+	AdversaryRoleSolo:     5,
+}
+
+// EncounterGroup is a set of adversaries of one role and tier.
+type EncounterGroup struct {
+	Role  string
+	Tier  int
+	Count int
+}
+
+// EncounterOptions are the adjustments the GM chooses for the fight.
+type EncounterOptions struct {
+	// Easier makes the fight easier or shorter.
+	Easier bool
+	// Harder makes the fight harder or longer.
+	Harder bool
+	// BonusDamage adds +1d4 (or a static +2) to all adversaries' damage.
+	BonusDamage bool
+}
+
+// EncounterAdjustment is one modifier applied to the battle point budget.
+type EncounterAdjustment struct {
+	Reason string
+	Points int
+}
+
+// EncounterBudget compares an encounter's battle point cost with the budget
+// for the party.
+type EncounterBudget struct {
+	PartySize   int
+	PartyTier   int
+	Base        int
+	Adjustments []EncounterAdjustment
+	// Total is the budget after adjustments.
+	Total     int
+	Spent     int
+	Remaining int
+}
+
+// NormalizeAdversaryRole validates an adversary role that has a battle point
+// cost.
+func NormalizeAdversaryRole(value string) (string, error) {
+	trimmed := strings.TrimSpace(strings.ToLower(value))
+	if trimmed == "" {
+		return "", fmt.Errorf("adversary role is required")
+	}
+	if _, ok := adversaryRoleBattlePoints[trimmed]; !ok {
+		return "", fmt.Errorf("adversary role %q is invalid", value)
+	}
+	return trimmed, nil
+}
+
+// BattlePointCost returns the battle points for count adversaries of a role.
+// Minions are bought in groups the size of the party.
+func BattlePointCost(role string, count, partySize int) (int, error) {
+	normalized, err := NormalizeAdversaryRole(role)
+	if err != nil {
+		return 0, err
+	}
+	if count < 0 {
+		return 0, fmt.Errorf("adversary count must be non-negative")
+	}
+	if normalized == AdversaryRoleMinion {
+		if partySize <= 0 {
+			return 0, fmt.Errorf("party size must be positive")
+		}
+		count = (count + partySize - 1) / partySize
+	}
+	return count * adversaryRoleBattlePoints[normalized], nil
+}
+
+// PlanEncounter prices groups of adversaries against the SRD budget of
+// (3 x party size) + 2 battle points, applying the GM's options and the
+// adjustments implied by the chosen adversaries.
+func PlanEncounter(partySize, partyTier int, groups []EncounterGroup, options EncounterOptions) (EncounterBudget, error) {
+	if partySize <= 0 {
+		return EncounterBudget{}, fmt.Errorf("party size must be positive")
+	}
+	if options.Easier && options.Harder {
+		return EncounterBudget{}, fmt.Errorf("encounter cannot be both easier and harder")
+	}
+	budget := EncounterBudget{
+		PartySize: partySize,
+		PartyTier: partyTier,
+		Base:      3*partySize + 2,
+	}
+
+	solos := 0
+	heavy := false
+	lowerTier := false
+	for _, group := range groups {
+		cost, err := BattlePointCost(group.Role, group.Count, partySize)
+		if err != nil {
+			return EncounterBudget{}, err
+		}
+		budget.Spent += cost
+		switch strings.TrimSpace(strings.ToLower(group.Role)) {
+		case AdversaryRoleSolo:
+			solos += group.Count
+			heavy = true
+		case AdversaryRoleBruiser, AdversaryRoleHorde, AdversaryRoleLeader:
+			heavy = true
+		}
+		if group.Tier > 0 && group.Tier < partyTier {
+			lowerTier = true
+		}
+	}
+
+	if options.Easier {
+		budget.Adjustments = append(budget.Adjustments, EncounterAdjustment{Reason: EncounterAdjustmentEasierFight, Points: -1})
+	}
+	if solos >= 2 {
+		budget.Adjustments = append(budget.Adjustments, EncounterAdjustment{Reason: EncounterAdjustmentMultipleSolos, Points: -2})
+	}
+	if options.BonusDamage {
+		budget.Adjustments = append(budget.Adjustments, EncounterAdjustment{Reason: EncounterAdjustmentBonusDamage, Points: -2})
+	}
+	if lowerTier {
+		budget.Adjustments = append(budget.Adjustments, EncounterAdjustment{Reason: EncounterAdjustmentLowerTier, Points: 1})
+	}
+	if len(groups) > 0 && !heavy {
+		budget.Adjustments = append(budget.Adjustments, EncounterAdjustment{Reason: EncounterAdjustmentNoHeavyAdversary, Points: 1})
+	}
+	if options.Harder {
+		budget.Adjustments = append(budget.Adjustments, EncounterAdjustment{Reason: EncounterAdjustmentHarderFight, Points: 2})
+	}
+
+	budget.Total = budget.Base
+	for _, adjustment := range budget.Adjustments {
+		budget.Total += adjustment.Points
+	}
+	budget.Remaining = budget.Total - budget.Spent
+	return budget, nil
+}
+
+// PartyTier returns the tier of a party from its characters' levels, using
+// the highest level so the budget is not undersized for a mixed party.
+func PartyTier(levels []int) int {
+	highest := 1
+	for _, level := range levels {
+		if level > highest {
+			highest = level
+		}
+	}
+	return TierForLevel(highest)
+}
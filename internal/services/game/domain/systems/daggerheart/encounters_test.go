@@ -0,0 +1,88 @@
+package daggerheart
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBattlePointCost(t *testing.T) {
+	tests := []struct {
+		role  string
+		count int
+		want  int
+	}{
+		{AdversaryRoleMinion, 4, 1},
+		{AdversaryRoleMinion, 5, 2},
+		{AdversaryRoleSupport, 2, 2},
+		{AdversaryRoleStandard, 2, 4},
+		{"Leader", 1, 3},
+		{AdversaryRoleBruiser, 1, 4},
+		{AdversaryRoleSolo, 1, 5},
+	}
+	for _, tt := range tests {
+		got, err := BattlePointCost(tt.role, tt.count, 4)
+		if err != nil {
+			t.Fatalf("BattlePointCost(%q, %d): %v", tt.role, tt.count, err)
+		}
+		if got != tt.want {
+			t.Fatalf("BattlePointCost(%q, %d) = %d, want %d", tt.role, tt.count, got, tt.want)
+		}
+	}
+	if _, err := BattlePointCost("realm-breaker", 1, 4); err == nil {
+		t.Fatal("expected error for unknown role")
+	}
+}
+
+func TestPlanEncounter(t *testing.T) {
+	budget, err := PlanEncounter(4, 2, []EncounterGroup{
+		{Role: AdversaryRoleSolo, Tier: 2, Count: 2},
+		{Role: AdversaryRoleMinion, Tier: 1, Count: 4},
+	}, EncounterOptions{Harder: true})
+	if err != nil {
+		t.Fatalf("PlanEncounter: %v", err)
+	}
+	want := EncounterBudget{
+		PartySize: 4,
+		PartyTier: 2,
+		Base:      14,
+		Adjustments: []EncounterAdjustment{
+			{Reason: EncounterAdjustmentMultipleSolos, Points: -2},
+			{Reason: EncounterAdjustmentLowerTier, Points: 1},
+			{Reason: EncounterAdjustmentHarderFight, Points: 2},
+		},
+		Total:     15,
+		Spent:     11,
+		Remaining: 4,
+	}
+	if !reflect.DeepEqual(budget, want) {
+		t.Fatalf("budget = %+v, want %+v", budget, want)
+	}
+}
+
+func TestPlanEncounterNoHeavyAdversaries(t *testing.T) {
+	budget, err := PlanEncounter(3, 1, []EncounterGroup{
+		{Role: AdversaryRoleStandard, Tier: 1, Count: 3},
+		{Role: AdversaryRoleSupport, Tier: 1, Count: 1},
+	}, EncounterOptions{Easier: true, BonusDamage: true})
+	if err != nil {
+		t.Fatalf("PlanEncounter: %v", err)
+	}
+	if budget.Total != 9 || budget.Spent != 7 || budget.Remaining != 2 {
+		t.Fatalf("budget = %+v, want total 9 spent 7 remaining 2", budget)
+	}
+	if _, err := PlanEncounter(3, 1, nil, EncounterOptions{Easier: true, Harder: true}); err == nil {
+		t.Fatal("expected error for easier and harder fight")
+	}
+	if _, err := PlanEncounter(0, 1, nil, EncounterOptions{}); err == nil {
+		t.Fatal("expected error for empty party")
+	}
+}
+
+func TestPartyTier(t *testing.T) {
+	if got := PartyTier(nil); got != 1 {
+		t.Fatalf("PartyTier(nil) = %d, want 1", got)
+	}
+	if got := PartyTier([]int{3, 5, 4}); got != 3 {
+		t.Fatalf("PartyTier = %d, want 3", got)
+	}
+}
@@ -0,0 +1,36 @@
+package daggerheart
+
+import "fmt"
+
+// Environment feature kinds that can be triggered during a session. Passive
+// features apply continuously and are not triggered.
+const (
+	EnvironmentFeatureKindAction   = "action"
+	EnvironmentFeatureKindReaction = "reaction"
+)
+
+// IsEnvironmentFeatureKind reports whether the value is a triggerable feature kind.
+func IsEnvironmentFeatureKind(value string) bool {
+	switch value {
+	case EnvironmentFeatureKindAction, EnvironmentFeatureKindReaction:
+		return true
+	default:
+		return false
+	}
+}
+
+// MaxSpawnCount caps how many adversaries a single request may create.
+const MaxSpawnCount = 50
+
+// SpawnNames returns names for adversaries spawned together. A single spawn
+// keeps the base name; groups are numbered so each adversary is addressable.
+func SpawnNames(base string, count int) []string {
+	if count <= 1 {
+		return []string{base}
+	}
+	names := make([]string, 0, count)
+	for i := 1; i <= count; i++ {
+		names = append(names, fmt.Sprintf("%s %d", base, i))
+	}
+	return names
+}
@@ -0,0 +1,25 @@
+package daggerheart
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsEnvironmentFeatureKind(t *testing.T) {
+	if !IsEnvironmentFeatureKind(EnvironmentFeatureKindAction) || !IsEnvironmentFeatureKind(EnvironmentFeatureKindReaction) {
+		t.Fatal("expected action and reaction to be triggerable")
+	}
+	if IsEnvironmentFeatureKind("passive") {
+		t.Fatal("expected passive features to be rejected")
+	}
+}
+
+func TestSpawnNames(t *testing.T) {
+	if got := SpawnNames("Orc", 1); !reflect.DeepEqual(got, []string{"Orc"}) {
+		t.Fatalf("SpawnNames(Orc, 1) = %v", got)
+	}
+	want := []string{"Thug 1", "Thug 2", "Thug 3"}
+	if got := SpawnNames("Thug", 3); !reflect.DeepEqual(got, want) {
+		t.Fatalf("SpawnNames(Thug, 3) = %v, want %v", got, want)
+	}
+}
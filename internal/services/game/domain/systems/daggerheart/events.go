@@ -19,13 +19,16 @@ const (
 	EventTypeDeathMoveResolved         event.Type = "action.death_move_resolved"
 	EventTypeBlazeOfGloryResolved      event.Type = "action.blaze_of_glory_resolved"
 	EventTypeAttackResolved            event.Type = "action.attack_resolved"
+	EventTypeMultiAttackResolved       event.Type = "action.multi_attack_resolved"
 	EventTypeReactionResolved          event.Type = "action.reaction_resolved"
+	EventTypeSpellcastResolved         event.Type = "action.spellcast_resolved"
 	EventTypeDamageRollResolved        event.Type = "action.damage_roll_resolved"
 	EventTypeGroupActionResolved       event.Type = "action.group_action_resolved"
 	EventTypeTagTeamResolved           event.Type = "action.tag_team_resolved"
 	EventTypeCountdownCreated          event.Type = "action.countdown_created"
 	EventTypeCountdownUpdated          event.Type = "action.countdown_updated"
 	EventTypeCountdownDeleted          event.Type = "action.countdown_deleted"
+	EventTypeCountdownTriggered        event.Type = "action.countdown_triggered"
 	EventTypeAdversaryRollResolved     event.Type = "action.adversary_roll_resolved"
 	EventTypeAdversaryActionResolved   event.Type = "action.adversary_action_resolved"
 	EventTypeAdversaryAttackResolved   event.Type = "action.adversary_attack_resolved"
@@ -34,6 +37,30 @@ const (
 	EventTypeAdversaryDamageApplied    event.Type = "action.adversary_damage_applied"
 	EventTypeAdversaryUpdated          event.Type = "action.adversary_updated"
 	EventTypeAdversaryDeleted          event.Type = "action.adversary_deleted"
+	EventTypeAdversaryFeatureUsed      event.Type = "action.adversary_feature_used"
+	EventTypeAdversaryFeatureRefreshed event.Type = "action.adversary_feature_refreshed"
+	EventTypeCharacterLeveledUp        event.Type = "character.leveled_up"
+	EventTypeSceneRangesSet            event.Type = "scene.ranges_set"
+	EventTypeSceneEntityMoved          event.Type = "scene.entity_moved"
+	EventTypeEnvironmentActivated      event.Type = "environment.activated"
+	EventTypeEnvironmentShifted        event.Type = "environment.shifted"
+	EventTypeEnvironmentCleared        event.Type = "environment.cleared"
+	EventTypeEnvironmentFeatureUsed    event.Type = "environment.feature_used"
+	EventTypeItemAcquired              event.Type = "inventory.item_acquired"
+	EventTypeItemDropped               event.Type = "inventory.item_dropped"
+	EventTypeItemTransferred           event.Type = "inventory.item_transferred"
+	EventTypeItemEquipped              event.Type = "inventory.item_equipped"
+	EventTypeItemUnequipped            event.Type = "inventory.item_unequipped"
+	EventTypeGoldChanged               event.Type = "inventory.gold_changed"
+	EventTypeCompanionCreated          event.Type = "companion.created"
+	EventTypeCompanionLeveledUp        event.Type = "companion.leveled_up"
+	EventTypeCompanionStressChanged    event.Type = "companion.stress_changed"
+	EventTypeBeastformEntered          event.Type = "beastform.entered"
+	EventTypeBeastformExited           event.Type = "beastform.exited"
+	EventTypeEffectApplied             event.Type = "effect.applied"
+	EventTypeEffectExpired             event.Type = "effect.expired"
+	EventTypeChaseStarted              event.Type = "chase.started"
+	EventTypeChaseResolved             event.Type = "chase.resolved"
 )
 
 // DamageAppliedPayload captures the payload for action.damage_applied events.
@@ -55,6 +82,7 @@ type DamageAppliedPayload struct {
 	Direct             bool     `json:"direct,omitempty"`
 	MassiveDamage      bool     `json:"massive_damage,omitempty"`
 	Mitigated          bool     `json:"mitigated,omitempty"`
+	ArmorDeclined      bool     `json:"armor_declined,omitempty"`
 	Source             string   `json:"source,omitempty"`
 	SourceCharacterIDs []string `json:"source_character_ids,omitempty"`
 }
@@ -182,6 +210,10 @@ type AdversaryDamageAppliedPayload struct {
 	Mitigated          bool     `json:"mitigated,omitempty"`
 	Source             string   `json:"source,omitempty"`
 	SourceCharacterIDs []string `json:"source_character_ids,omitempty"`
+	// MinionDefeated marks a Minion defeated by any damage.
+	MinionDefeated bool `json:"minion_defeated,omitempty"`
+	// OverflowFromID names the damaged Minion whose overflow defeated this one.
+	OverflowFromID string `json:"overflow_from_id,omitempty"`
 }
 
 // HopeSpentPayload captures the payload for action.hope_spent events.
@@ -241,6 +273,45 @@ type AttackResolvedPayload struct {
 	Success     bool     `json:"success"`
 	Crit        bool     `json:"crit"`
 	Flavor      string   `json:"flavor,omitempty"`
+	WeaponID    string   `json:"weapon_id,omitempty"`
+}
+
+// MultiAttackTargetResult records how a shared attack roll resolved against one target.
+type MultiAttackTargetResult struct {
+	TargetID   string `json:"target_id"`
+	TargetType string `json:"target_type"`
+	Difficulty int    `json:"difficulty"`
+	Hit        bool   `json:"hit"`
+	HPMarked   int    `json:"hp_marked,omitempty"`
+	ArmorSpent int    `json:"armor_spent,omitempty"`
+}
+
+// MultiAttackResolvedPayload captures the payload for action.multi_attack_resolved events.
+type MultiAttackResolvedPayload struct {
+	AttackerID    string                    `json:"attacker_id"`
+	AttackerType  string                    `json:"attacker_type"`
+	RollSeq       uint64                    `json:"roll_seq"`
+	DamageRollSeq *uint64                   `json:"damage_roll_seq,omitempty"`
+	Total         int                       `json:"total"`
+	Crit          bool                      `json:"crit"`
+	StressCost    int                       `json:"stress_cost,omitempty"`
+	Targets       []MultiAttackTargetResult `json:"targets"`
+}
+
+// SpellcastResolvedPayload captures the payload for action.spellcast_resolved events.
+type SpellcastResolvedPayload struct {
+	CharacterID    string `json:"character_id"`
+	RollSeq        uint64 `json:"roll_seq"`
+	DomainCardID   string `json:"domain_card_id"`
+	SubclassID     string `json:"subclass_id,omitempty"`
+	SpellcastTrait string `json:"spellcast_trait"`
+	TraitValue     int    `json:"trait_value"`
+	HopeCost       int    `json:"hope_cost,omitempty"`
+	ExtraFearDie   bool   `json:"extra_fear_die,omitempty"`
+	Outcome        string `json:"outcome"`
+	Success        bool   `json:"success"`
+	Crit           bool   `json:"crit"`
+	Flavor         string `json:"flavor,omitempty"`
 }
 
 // ReactionResolvedPayload captures the payload for action.reaction_resolved events.
@@ -290,6 +361,10 @@ type CountdownCreatedPayload struct {
 	Max         int    `json:"max"`
 	Direction   string `json:"direction"`
 	Looping     bool   `json:"looping"`
+	// TickTrigger names the flow that advances the countdown automatically.
+	TickTrigger       string         `json:"tick_trigger,omitempty"`
+	TickDelta         int            `json:"tick_delta,omitempty"`
+	TickOutcomeDeltas map[string]int `json:"tick_outcome_deltas,omitempty"`
 }
 
 // CountdownUpdatedPayload captures the payload for action.countdown_updated events.
@@ -302,6 +377,14 @@ type CountdownUpdatedPayload struct {
 	Reason      string `json:"reason,omitempty"`
 }
 
+// CountdownTriggeredPayload captures the payload for action.countdown_triggered events.
+type CountdownTriggeredPayload struct {
+	CountdownID string `json:"countdown_id"`
+	Trigger     string `json:"trigger"`
+	Value       int    `json:"value"`
+	Looped      bool   `json:"looped"`
+}
+
 // CountdownDeletedPayload captures the payload for action.countdown_deleted events.
 type CountdownDeletedPayload struct {
 	CountdownID string `json:"countdown_id"`
@@ -362,6 +445,15 @@ type AdversaryCreatedPayload struct {
 	Major       int    `json:"major_threshold"`
 	Severe      int    `json:"severe_threshold"`
 	Armor       int    `json:"armor"`
+	// MinionThreshold is the Minion (N) overflow threshold; zero for non-minions.
+	MinionThreshold int `json:"minion_threshold,omitempty"`
+	// AdversaryEntryID and the fields after it are set when the adversary is
+	// instantiated from a catalog entry.
+	AdversaryEntryID string                `json:"adversary_entry_id,omitempty"`
+	AttackModifier   int                   `json:"attack_modifier,omitempty"`
+	StandardAttack   *AdversaryAttack      `json:"standard_attack,omitempty"`
+	Experiences      []AdversaryExperience `json:"experiences,omitempty"`
+	Features         []AdversaryFeature    `json:"features,omitempty"`
 }
 
 // AdversaryUpdatedPayload captures the payload for action.adversary_updated events.
@@ -379,6 +471,36 @@ type AdversaryUpdatedPayload struct {
 	Major       int    `json:"major_threshold"`
 	Severe      int    `json:"severe_threshold"`
 	Armor       int    `json:"armor"`
+	// MinionThreshold is the Minion (N) overflow threshold; zero for non-minions.
+	MinionThreshold int `json:"minion_threshold,omitempty"`
+}
+
+// AdversaryFeatureUsedPayload captures the payload for
+// action.adversary_feature_used events. Stress and Evasion pairs are set when
+// the feature marked Stress or raised the adversary's Difficulty; RefreshOn is
+// set when the feature is spent until refreshed.
+type AdversaryFeatureUsedPayload struct {
+	AdversaryID   string   `json:"adversary_id"`
+	EntryID       string   `json:"adversary_entry_id,omitempty"`
+	FeatureID     string   `json:"feature_id"`
+	FeatureName   string   `json:"feature_name,omitempty"`
+	Kind          string   `json:"kind,omitempty"`
+	FearCost      int      `json:"fear_cost,omitempty"`
+	StressBefore  *int     `json:"stress_before,omitempty"`
+	StressAfter   *int     `json:"stress_after,omitempty"`
+	EvasionBefore *int     `json:"evasion_before,omitempty"`
+	EvasionAfter  *int     `json:"evasion_after,omitempty"`
+	RefreshOn     string   `json:"refresh_on,omitempty"`
+	TargetIDs     []string `json:"target_ids,omitempty"`
+	Description   string   `json:"description,omitempty"`
+}
+
+// AdversaryFeatureRefreshedPayload captures the payload for
+// action.adversary_feature_refreshed events.
+type AdversaryFeatureRefreshedPayload struct {
+	AdversaryID string `json:"adversary_id"`
+	FeatureID   string `json:"feature_id"`
+	Reason      string `json:"reason,omitempty"`
 }
 
 // AdversaryDeletedPayload captures the payload for action.adversary_deleted events.
@@ -406,4 +528,271 @@ type DamageRollResolvedPayload struct {
 	Total         int         `json:"total"`
 	Critical      bool        `json:"critical"`
 	Rng           RollRngInfo `json:"rng"`
+	WeaponID      string      `json:"weapon_id,omitempty"`
+}
+
+// LevelUpAdvancementPayload captures a single advancement chosen during a level-up.
+type LevelUpAdvancementPayload struct {
+	Type         string   `json:"type"`
+	Traits       []string `json:"traits,omitempty"`
+	Experiences  []string `json:"experiences,omitempty"`
+	DomainCardID string   `json:"domain_card_id,omitempty"`
+	SubclassID   string   `json:"subclass_id,omitempty"`
+	ClassID      string   `json:"class_id,omitempty"`
+	DomainID     string   `json:"domain_id,omitempty"`
+}
+
+// LevelUpExperiencePayload captures an experience after a level-up.
+type LevelUpExperiencePayload struct {
+	Name     string `json:"name"`
+	Modifier int    `json:"modifier"`
+}
+
+// CharacterLeveledUpPayload captures the payload for character.leveled_up events.
+type CharacterLeveledUpPayload struct {
+	CharacterID          string                      `json:"character_id"`
+	LevelBefore          int                         `json:"level_before"`
+	LevelAfter           int                         `json:"level_after"`
+	Tier                 int                         `json:"tier"`
+	Advancements         []LevelUpAdvancementPayload `json:"advancements"`
+	NewExperience        string                      `json:"new_experience,omitempty"`
+	DomainCardID         string                      `json:"domain_card_id,omitempty"`
+	HpMaxAfter           int                         `json:"hp_max_after"`
+	StressMaxAfter       int                         `json:"stress_max_after"`
+	EvasionAfter         int                         `json:"evasion_after"`
+	ProficiencyAfter     int                         `json:"proficiency_after"`
+	MajorThresholdAfter  int                         `json:"major_threshold_after"`
+	SevereThresholdAfter int                         `json:"severe_threshold_after"`
+	TraitsAfter          map[string]int              `json:"traits_after"`
+	ExperiencesAfter     []LevelUpExperiencePayload  `json:"experiences_after,omitempty"`
+	MarkedTraitsAfter    []string                    `json:"marked_traits_after,omitempty"`
+	LoadoutActiveAfter   []string                    `json:"loadout_active_after,omitempty"`
+	LoadoutVaultAfter    []string                    `json:"loadout_vault_after,omitempty"`
+	HpBefore             *int                        `json:"hp_before,omitempty"`
+	HpAfter              *int                        `json:"hp_after,omitempty"`
+	// Class fields are only set when a subclass upgrade or multiclass changed them.
+	SubclassStageAfter           string `json:"subclass_stage_after,omitempty"`
+	MulticlassClassIDAfter       string `json:"multiclass_class_id_after,omitempty"`
+	MulticlassSubclassIDAfter    string `json:"multiclass_subclass_id_after,omitempty"`
+	MulticlassSubclassStageAfter string `json:"multiclass_subclass_stage_after,omitempty"`
+	MulticlassDomainIDAfter      string `json:"multiclass_domain_id_after,omitempty"`
+}
+
+// SceneRange records the range band between two scene entities.
+type SceneRange struct {
+	FromID   string `json:"from_id"`
+	FromType string `json:"from_type"`
+	ToID     string `json:"to_id"`
+	ToType   string `json:"to_type"`
+	Band     string `json:"band"`
+}
+
+// SceneRangesSetPayload captures the payload for scene.ranges_set events.
+type SceneRangesSetPayload struct {
+	Ranges []SceneRange `json:"ranges"`
+}
+
+// SceneEntityMovedPayload captures the payload for scene.entity_moved events.
+type SceneEntityMovedPayload struct {
+	EntityID   string       `json:"entity_id"`
+	EntityType string       `json:"entity_type"`
+	Kind       string       `json:"kind"`
+	SourceID   string       `json:"source_id,omitempty"`
+	SourceType string       `json:"source_type,omitempty"`
+	Reason     string       `json:"reason,omitempty"`
+	Ranges     []SceneRange `json:"ranges"`
+}
+
+// EnvironmentActivatedPayload captures the payload for environment.activated events.
+type EnvironmentActivatedPayload struct {
+	EnvironmentID string `json:"environment_id"`
+	Name          string `json:"name"`
+	Tier          int    `json:"tier"`
+	Type          string `json:"type"`
+	Difficulty    int    `json:"difficulty"`
+}
+
+// EnvironmentShiftedPayload captures the payload for environment.shifted events.
+type EnvironmentShiftedPayload struct {
+	FromEnvironmentID string `json:"from_environment_id"`
+	EnvironmentID     string `json:"environment_id"`
+	Name              string `json:"name"`
+	Tier              int    `json:"tier"`
+	Type              string `json:"type"`
+	Difficulty        int    `json:"difficulty"`
+	Reason            string `json:"reason,omitempty"`
+}
+
+// EnvironmentClearedPayload captures the payload for environment.cleared events.
+type EnvironmentClearedPayload struct {
+	EnvironmentID string `json:"environment_id"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// EnvironmentFeatureUsedPayload captures the payload for environment.feature_used events.
+type EnvironmentFeatureUsedPayload struct {
+	EnvironmentID       string   `json:"environment_id"`
+	FeatureID           string   `json:"feature_id"`
+	FeatureName         string   `json:"feature_name,omitempty"`
+	Kind                string   `json:"kind"`
+	FearCost            int      `json:"fear_cost"`
+	SpawnedAdversaryIDs []string `json:"spawned_adversary_ids,omitempty"`
+	Description         string   `json:"description,omitempty"`
+}
+
+// ItemAcquiredPayload captures the payload for inventory.item_acquired events.
+type ItemAcquiredPayload struct {
+	CharacterID   string `json:"character_id"`
+	ItemID        string `json:"item_id"`
+	Kind          string `json:"kind"`
+	Quantity      int    `json:"quantity"`
+	QuantityAfter int    `json:"quantity_after"`
+	Source        string `json:"source,omitempty"`
+}
+
+// ItemDroppedPayload captures the payload for inventory.item_dropped events.
+type ItemDroppedPayload struct {
+	CharacterID   string `json:"character_id"`
+	ItemID        string `json:"item_id"`
+	Kind          string `json:"kind"`
+	Quantity      int    `json:"quantity"`
+	QuantityAfter int    `json:"quantity_after"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// ItemTransferredPayload captures the payload for inventory.item_transferred events.
+type ItemTransferredPayload struct {
+	FromCharacterID   string `json:"from_character_id"`
+	ToCharacterID     string `json:"to_character_id"`
+	ItemID            string `json:"item_id"`
+	Kind              string `json:"kind"`
+	Quantity          int    `json:"quantity"`
+	FromQuantityAfter int    `json:"from_quantity_after"`
+	ToQuantityAfter   int    `json:"to_quantity_after"`
+}
+
+// EquipmentChange carries the armor-derived profile and state values after an
+// armor slot changes. Weapon slots leave it unset.
+type EquipmentChange struct {
+	ArmorScoreAfter      int `json:"armor_score_after"`
+	ArmorMaxAfter        int `json:"armor_max_after"`
+	ArmorAfter           int `json:"armor_after"`
+	MajorThresholdAfter  int `json:"major_threshold_after"`
+	SevereThresholdAfter int `json:"severe_threshold_after"`
+}
+
+// ItemEquippedPayload captures the payload for inventory.item_equipped events.
+type ItemEquippedPayload struct {
+	CharacterID    string           `json:"character_id"`
+	ItemID         string           `json:"item_id"`
+	Slot           string           `json:"slot"`
+	PreviousItemID string           `json:"previous_item_id,omitempty"`
+	Armor          *EquipmentChange `json:"armor,omitempty"`
+}
+
+// ItemUnequippedPayload captures the payload for inventory.item_unequipped events.
+type ItemUnequippedPayload struct {
+	CharacterID string           `json:"character_id"`
+	ItemID      string           `json:"item_id"`
+	Slot        string           `json:"slot"`
+	Reason      string           `json:"reason,omitempty"`
+	Armor       *EquipmentChange `json:"armor,omitempty"`
+}
+
+// GoldChangedPayload captures the payload for inventory.gold_changed events.
+type GoldChangedPayload struct {
+	CharacterID    string `json:"character_id"`
+	HandfulsBefore int    `json:"handfuls_before"`
+	BagsBefore     int    `json:"bags_before"`
+	ChestsBefore   int    `json:"chests_before"`
+	HandfulsAfter  int    `json:"handfuls_after"`
+	BagsAfter      int    `json:"bags_after"`
+	ChestsAfter    int    `json:"chests_after"`
+	Reason         string `json:"reason,omitempty"`
+}
+
+// CompanionCreatedPayload captures the payload for companion.created events.
+type CompanionCreatedPayload struct {
+	CharacterID    string                `json:"character_id"`
+	Name           string                `json:"name"`
+	Evasion        int                   `json:"evasion"`
+	StressMax      int                   `json:"stress_max"`
+	DamageDieSides int                   `json:"damage_die_sides"`
+	Range          string                `json:"range"`
+	Experiences    []CompanionExperience `json:"experiences"`
+}
+
+// CompanionLeveledUpPayload captures the payload for companion.leveled_up events.
+type CompanionLeveledUpPayload struct {
+	CharacterID         string                `json:"character_id"`
+	Upgrade             string                `json:"upgrade"`
+	ExperienceID        string                `json:"experience_id,omitempty"`
+	Vicious             string                `json:"vicious,omitempty"`
+	LevelAfter          int                   `json:"level_after"`
+	EvasionAfter        int                   `json:"evasion_after"`
+	StressMaxAfter      int                   `json:"stress_max_after"`
+	DamageDieSidesAfter int                   `json:"damage_die_sides_after"`
+	RangeAfter          string                `json:"range_after"`
+	ExperiencesAfter    []CompanionExperience `json:"experiences_after"`
+}
+
+// CompanionStressChangedPayload captures the payload for companion.stress_changed events.
+type CompanionStressChangedPayload struct {
+	CharacterID  string `json:"character_id"`
+	StressBefore int    `json:"stress_before"`
+	StressAfter  int    `json:"stress_after"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+// BeastformEnteredPayload captures the payload for beastform.entered events.
+type BeastformEnteredPayload struct {
+	CharacterID  string `json:"character_id"`
+	BeastformID  string `json:"beastform_id"`
+	StressBefore int    `json:"stress_before"`
+	StressAfter  int    `json:"stress_after"`
+}
+
+// BeastformExitedPayload captures the payload for beastform.exited events.
+type BeastformExitedPayload struct {
+	CharacterID string `json:"character_id"`
+	BeastformID string `json:"beastform_id"`
+	Reason      string `json:"reason"`
+}
+
+// EffectAppliedPayload captures the payload for effect.applied events.
+type EffectAppliedPayload struct {
+	EffectID    string `json:"effect_id"`
+	TargetType  string `json:"target_type"`
+	TargetID    string `json:"target_id"`
+	Source      string `json:"source,omitempty"`
+	Kind        string `json:"kind"`
+	Value       int    `json:"value"`
+	Stacking    string `json:"stacking"`
+	ExpiresOn   string `json:"expires_on"`
+	ArmorBefore *int   `json:"armor_before,omitempty"`
+	ArmorAfter  *int   `json:"armor_after,omitempty"`
+}
+
+// EffectExpiredPayload captures the payload for effect.expired events.
+type EffectExpiredPayload struct {
+	EffectID    string `json:"effect_id"`
+	TargetID    string `json:"target_id"`
+	Reason      string `json:"reason"`
+	ArmorBefore *int   `json:"armor_before,omitempty"`
+	ArmorAfter  *int   `json:"armor_after,omitempty"`
+}
+
+// ChaseStartedPayload captures the payload for chase.started events.
+type ChaseStartedPayload struct {
+	ChaseID                string `json:"chase_id"`
+	Name                   string `json:"name"`
+	ProgressCountdownID    string `json:"progress_countdown_id"`
+	ConsequenceCountdownID string `json:"consequence_countdown_id"`
+}
+
+// ChaseResolvedPayload captures the payload for chase.resolved events.
+type ChaseResolvedPayload struct {
+	ChaseID     string `json:"chase_id"`
+	Winner      string `json:"winner"`
+	CountdownID string `json:"countdown_id,omitempty"`
 }
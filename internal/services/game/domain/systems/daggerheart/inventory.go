@@ -0,0 +1,191 @@
+package daggerheart
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Inventory item kinds map to the catalog a held item is drawn from.
+const (
+	InventoryKindWeapon = "weapon"
+	InventoryKindArmor  = "armor"
+	InventoryKindItem   = "item"
+	InventoryKindLoot   = "loot"
+)
+
+// Equipment slots a character can fill from their inventory.
+const (
+	EquipSlotArmor           = "armor"
+	EquipSlotPrimaryWeapon   = "primary_weapon"
+	EquipSlotSecondaryWeapon = "secondary_weapon"
+)
+
+// Gold is abstracted into handfuls, bags and chests.
+const (
+	GoldHandfulsPerBag = 10
+	GoldBagsPerChest   = 10
+)
+
+// Weapon categories and the burden of a two-handed weapon.
+const (
+	WeaponCategoryPrimary   = "primary"
+	WeaponCategorySecondary = "secondary"
+	WeaponBurdenTwoHanded   = 2
+)
+
+var (
+	ErrInsufficientGold    = errors.New("insufficient gold")
+	ErrItemNotHeld         = errors.New("item is not in inventory")
+	ErrInsufficientItems   = errors.New("not enough items in inventory")
+	ErrItemEquipped        = errors.New("item is equipped")
+	ErrStackFull           = errors.New("item stack is full")
+	ErrInvalidEquipSlot    = errors.New("item cannot be equipped in slot")
+	ErrTwoHandedConflict   = errors.New("two-handed primary weapon leaves no hand for a secondary weapon")
+	ErrInvalidItemQuantity = errors.New("item quantity must be positive")
+)
+
+// IsInventoryKind reports whether the value is a supported inventory kind.
+func IsInventoryKind(value string) bool {
+	switch value {
+	case InventoryKindWeapon, InventoryKindArmor, InventoryKindItem, InventoryKindLoot:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsEquipSlot reports whether the value is a supported equipment slot.
+func IsEquipSlot(value string) bool {
+	switch value {
+	case EquipSlotArmor, EquipSlotPrimaryWeapon, EquipSlotSecondaryWeapon:
+		return true
+	default:
+		return false
+	}
+}
+
+// EquipSlotKind returns the inventory kind a slot accepts.
+func EquipSlotKind(slot string) string {
+	if slot == EquipSlotArmor {
+		return InventoryKindArmor
+	}
+	return InventoryKindWeapon
+}
+
+// WeaponSlotForCategory returns the slot a weapon category is wielded in.
+func WeaponSlotForCategory(category string) (string, error) {
+	switch category {
+	case WeaponCategoryPrimary:
+		return EquipSlotPrimaryWeapon, nil
+	case WeaponCategorySecondary:
+		return EquipSlotSecondaryWeapon, nil
+	default:
+		return "", fmt.Errorf("weapon category %q is invalid", category)
+	}
+}
+
+// Gold tracks a character's coin in handfuls, bags and chests.
+type Gold struct {
+	Handfuls int
+	Bags     int
+	Chests   int
+}
+
+// GoldFromHandfuls converts a handful total into the largest denominations.
+func GoldFromHandfuls(total int) Gold {
+	if total < 0 {
+		total = 0
+	}
+	perChest := GoldHandfulsPerBag * GoldBagsPerChest
+	chests := total / perChest
+	total -= chests * perChest
+	bags := total / GoldHandfulsPerBag
+	return Gold{Handfuls: total - bags*GoldHandfulsPerBag, Bags: bags, Chests: chests}
+}
+
+// TotalHandfuls returns the gold value expressed in handfuls.
+func (g Gold) TotalHandfuls() int {
+	return g.Handfuls + g.Bags*GoldHandfulsPerBag + g.Chests*GoldHandfulsPerBag*GoldBagsPerChest
+}
+
+// Apply adds a handful delta, trading coin up or breaking it down as needed.
+func (g Gold) Apply(deltaHandfuls int) (Gold, error) {
+	total := g.TotalHandfuls() + deltaHandfuls
+	if total < 0 {
+		return Gold{}, ErrInsufficientGold
+	}
+	return GoldFromHandfuls(total), nil
+}
+
+// InventoryItem is one stack of a catalog item held by a character.
+type InventoryItem struct {
+	ItemID   string
+	Kind     string
+	Quantity int
+}
+
+// InventoryQuantity returns how many of an item the inventory holds.
+func InventoryQuantity(items []InventoryItem, itemID string) int {
+	for _, item := range items {
+		if item.ItemID == itemID {
+			return item.Quantity
+		}
+	}
+	return 0
+}
+
+// SetInventoryQuantity returns a copy of the inventory with the item stack set
+// to quantity. A quantity of zero removes the stack.
+func SetInventoryQuantity(items []InventoryItem, itemID, kind string, quantity int) []InventoryItem {
+	updated := make([]InventoryItem, 0, len(items)+1)
+	found := false
+	for _, item := range items {
+		if item.ItemID != itemID {
+			updated = append(updated, item)
+			continue
+		}
+		found = true
+		if quantity > 0 {
+			item.Quantity = quantity
+			updated = append(updated, item)
+		}
+	}
+	if !found && quantity > 0 {
+		updated = append(updated, InventoryItem{ItemID: itemID, Kind: kind, Quantity: quantity})
+	}
+	return updated
+}
+
+// AcquireQuantity returns the stack size after adding quantity items, enforcing
+// the catalog stack limit when one is set.
+func AcquireQuantity(items []InventoryItem, itemID string, quantity, stackMax int) (int, error) {
+	if quantity <= 0 {
+		return 0, ErrInvalidItemQuantity
+	}
+	after := InventoryQuantity(items, itemID) + quantity
+	if stackMax > 0 && after > stackMax {
+		return 0, ErrStackFull
+	}
+	return after, nil
+}
+
+// ReleaseQuantity returns the stack size after removing quantity items.
+func ReleaseQuantity(items []InventoryItem, itemID string, quantity int) (int, error) {
+	if quantity <= 0 {
+		return 0, ErrInvalidItemQuantity
+	}
+	held := InventoryQuantity(items, itemID)
+	if held == 0 {
+		return 0, ErrItemNotHeld
+	}
+	if held < quantity {
+		return 0, ErrInsufficientItems
+	}
+	return held - quantity, nil
+}
+
+// ArmoredThresholds returns damage thresholds for worn armor: the armor's base
+// thresholds plus the character's level.
+func ArmoredThresholds(level, baseMajor, baseSevere int) (majorThreshold, severeThreshold int) {
+	return baseMajor + level, baseSevere + level
+}
@@ -0,0 +1,92 @@
+package daggerheart
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGoldApplyTradesUpAndBreaksDown(t *testing.T) {
+	gold, err := Gold{Handfuls: 8, Bags: 9}.Apply(3)
+	if err != nil {
+		t.Fatalf("gain gold: %v", err)
+	}
+	if want := (Gold{Handfuls: 1, Bags: 0, Chests: 1}); gold != want {
+		t.Fatalf("gold = %+v, want %+v", gold, want)
+	}
+
+	gold, err = gold.Apply(-GoldHandfulsPerBag)
+	if err != nil {
+		t.Fatalf("spend a bag: %v", err)
+	}
+	if want := (Gold{Handfuls: 1, Bags: 9}); gold != want {
+		t.Fatalf("gold = %+v, want %+v", gold, want)
+	}
+
+	if _, err := gold.Apply(-100); !errors.Is(err, ErrInsufficientGold) {
+		t.Fatalf("expected ErrInsufficientGold, got %v", err)
+	}
+}
+
+func TestSetInventoryQuantity(t *testing.T) {
+	items := SetInventoryQuantity(nil, "item.rope", InventoryKindItem, 2)
+	items = SetInventoryQuantity(items, "weapon.dagger", InventoryKindWeapon, 1)
+	items = SetInventoryQuantity(items, "item.rope", InventoryKindItem, 3)
+	want := []InventoryItem{
+		{ItemID: "item.rope", Kind: InventoryKindItem, Quantity: 3},
+		{ItemID: "weapon.dagger", Kind: InventoryKindWeapon, Quantity: 1},
+	}
+	if !reflect.DeepEqual(items, want) {
+		t.Fatalf("inventory = %+v, want %+v", items, want)
+	}
+
+	items = SetInventoryQuantity(items, "item.rope", InventoryKindItem, 0)
+	if InventoryQuantity(items, "item.rope") != 0 || len(items) != 1 {
+		t.Fatalf("expected rope stack removed, got %+v", items)
+	}
+}
+
+func TestAcquireAndReleaseQuantity(t *testing.T) {
+	items := []InventoryItem{{ItemID: "item.potion", Kind: InventoryKindItem, Quantity: 2}}
+
+	after, err := AcquireQuantity(items, "item.potion", 1, 3)
+	if err != nil || after != 3 {
+		t.Fatalf("AcquireQuantity = %d, %v; want 3", after, err)
+	}
+	if _, err := AcquireQuantity(items, "item.potion", 2, 3); !errors.Is(err, ErrStackFull) {
+		t.Fatalf("expected ErrStackFull, got %v", err)
+	}
+	if _, err := AcquireQuantity(items, "item.potion", 0, 0); !errors.Is(err, ErrInvalidItemQuantity) {
+		t.Fatalf("expected ErrInvalidItemQuantity, got %v", err)
+	}
+
+	after, err = ReleaseQuantity(items, "item.potion", 2)
+	if err != nil || after != 0 {
+		t.Fatalf("ReleaseQuantity = %d, %v; want 0", after, err)
+	}
+	if _, err := ReleaseQuantity(items, "item.potion", 3); !errors.Is(err, ErrInsufficientItems) {
+		t.Fatalf("expected ErrInsufficientItems, got %v", err)
+	}
+	if _, err := ReleaseQuantity(items, "item.rope", 1); !errors.Is(err, ErrItemNotHeld) {
+		t.Fatalf("expected ErrItemNotHeld, got %v", err)
+	}
+}
+
+func TestWeaponSlotForCategory(t *testing.T) {
+	if slot, err := WeaponSlotForCategory(WeaponCategoryPrimary); err != nil || slot != EquipSlotPrimaryWeapon {
+		t.Fatalf("primary slot = %q, %v", slot, err)
+	}
+	if slot, err := WeaponSlotForCategory(WeaponCategorySecondary); err != nil || slot != EquipSlotSecondaryWeapon {
+		t.Fatalf("secondary slot = %q, %v", slot, err)
+	}
+	if _, err := WeaponSlotForCategory("tertiary"); err == nil {
+		t.Fatal("expected unknown category to fail")
+	}
+}
+
+func TestArmoredThresholds(t *testing.T) {
+	major, severe := ArmoredThresholds(3, 5, 11)
+	if major != 8 || severe != 14 {
+		t.Fatalf("ArmoredThresholds(3, 5, 11) = %d, %d; want 8, 14", major, severe)
+	}
+}
@@ -0,0 +1,462 @@
+package daggerheart
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Advancement types a character may choose when leveling up.
+const (
+	AdvancementTraits          = "traits"
+	AdvancementHPSlot          = "hp_slot"
+	AdvancementStressSlot      = "stress_slot"
+	AdvancementExperiences     = "experiences"
+	AdvancementDomainCard      = "domain_card"
+	AdvancementEvasion         = "evasion"
+	AdvancementSubclassUpgrade = "subclass_upgrade"
+	AdvancementProficiency     = "proficiency"
+	AdvancementMulticlass      = "multiclass"
+)
+
+const (
+	// LevelMax is the highest character level.
+	LevelMax = 10
+	// AdvancementSlotsPerLevel is the number of advancement slots spent per level-up.
+	AdvancementSlotsPerLevel = 2
+	// TierExperienceModifier is the modifier of the experience gained on entering a tier.
+	TierExperienceModifier = 2
+	// TraitAdvancementCount is the number of traits raised by a traits advancement.
+	TraitAdvancementCount = 2
+	// ExperienceAdvancementCount is the number of experiences raised by an experiences advancement.
+	ExperienceAdvancementCount = 2
+)
+
+var (
+	// ErrMaxLevel indicates the character cannot level past LevelMax.
+	ErrMaxLevel = errors.New("character is already at max level")
+	// ErrTierExperienceRequired indicates a tier entry level-up is missing its new experience.
+	ErrTierExperienceRequired = errors.New("new experience is required when entering a new tier")
+	// ErrSubclassMulticlassExclusive indicates both a subclass upgrade and multiclass were taken in one tier.
+	ErrSubclassMulticlassExclusive = errors.New("subclass upgrade and multiclass cannot be taken in the same tier")
+	// ErrAlreadyMulticlassed indicates the character has already multiclassed.
+	ErrAlreadyMulticlassed = errors.New("character has already multiclassed")
+)
+
+// advancementRule describes the slot cost and availability of an advancement.
+type advancementRule struct {
+	cost    int
+	perTier int
+	minTier int
+}
+
+// advancementRules mirrors the level-up options on the character sheet. Each
+// tier has its own set of boxes, so limits reset when a new tier begins.
+var advancementRules = map[string]advancementRule{
+	AdvancementTraits:          {cost: 1, perTier: 3, minTier: 2},
+	AdvancementHPSlot:          {cost: 1, perTier: 2, minTier: 2},
+	AdvancementStressSlot:      {cost: 1, perTier: 2, minTier: 2},
+	AdvancementExperiences:     {cost: 1, perTier: 1, minTier: 2},
+	AdvancementDomainCard:      {cost: 1, perTier: 1, minTier: 2},
+	AdvancementEvasion:         {cost: 1, perTier: 1, minTier: 2},
+	AdvancementSubclassUpgrade: {cost: 1, perTier: 1, minTier: 2},
+	AdvancementProficiency:     {cost: 2, perTier: 1, minTier: 2},
+	AdvancementMulticlass:      {cost: 2, perTier: 1, minTier: 3},
+}
+
+// Advancement is a single option chosen during a level-up.
+type Advancement struct {
+	Type         string
+	Traits       []string
+	Experiences  []string
+	DomainCardID string
+	SubclassID   string
+	ClassID      string
+	// DomainID is the domain gained from the multiclass class.
+	DomainID string
+}
+
+// LevelUpChoice captures the selections made for a single level-up.
+type LevelUpChoice struct {
+	Advancements []Advancement
+	// NewExperience names the +2 experience gained when entering a new tier.
+	NewExperience string
+	// DomainCardID is the domain card gained at the new level, if any.
+	DomainCardID string
+}
+
+// LevelUpRecord is one entry in a character's level history.
+type LevelUpRecord struct {
+	Level         int
+	Advancements  []Advancement
+	NewExperience string
+	DomainCardID  string
+}
+
+// Progression captures the profile fields read and written by a level-up.
+type Progression struct {
+	Level           int
+	HpMax           int
+	StressMax       int
+	Evasion         int
+	MajorThreshold  int
+	SevereThreshold int
+	Proficiency     int
+	ArmorScore      int
+	Traits          Traits
+	Experiences     []Experience
+	MarkedTraits    []string
+	Loadout         Loadout
+	Classes         ClassSelection
+	History         []LevelUpRecord
+}
+
+// TierForLevel returns the tier (1-4) for a character level.
+func TierForLevel(level int) int {
+	switch {
+	case level <= 1:
+		return 1
+	case level <= 4:
+		return 2
+	case level <= 7:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// IsTierEntryLevel reports whether reaching level starts a new tier.
+func IsTierEntryLevel(level int) bool {
+	return level == 2 || level == 5 || level == 8
+}
+
+// NormalizeAdvancementType validates and normalizes an advancement type.
+func NormalizeAdvancementType(value string) (string, error) {
+	trimmed := strings.TrimSpace(strings.ToLower(value))
+	if trimmed == "" {
+		return "", fmt.Errorf("advancement type is required")
+	}
+	if _, ok := advancementRules[trimmed]; !ok {
+		return "", fmt.Errorf("advancement type %q is invalid", value)
+	}
+	return trimmed, nil
+}
+
+// AdvancementCost returns the number of slots an advancement consumes.
+func AdvancementCost(advancementType string) int {
+	return advancementRules[advancementType].cost
+}
+
+// ApplyLevelUp validates a level-up choice against the tier rules and returns
+// the resulting progression. Catalog checks (card levels, class and subclass
+// existence) are the caller's responsibility.
+func ApplyLevelUp(current Progression, choice LevelUpChoice) (Progression, error) {
+	if err := ValidateLevel(current.Level); err != nil {
+		return Progression{}, err
+	}
+	if current.Level >= LevelMax {
+		return Progression{}, ErrMaxLevel
+	}
+
+	next := current.clone()
+	next.Level = current.Level + 1
+	tier := TierForLevel(next.Level)
+
+	newExperience := strings.TrimSpace(choice.NewExperience)
+	if IsTierEntryLevel(next.Level) {
+		if next.Level > 2 {
+			next.MarkedTraits = nil
+		}
+		if newExperience == "" {
+			return Progression{}, ErrTierExperienceRequired
+		}
+		if experienceIndex(next.Experiences, newExperience) != -1 {
+			return Progression{}, fmt.Errorf("experience %q already exists", newExperience)
+		}
+		next.Experiences = append(next.Experiences, Experience{Name: newExperience, Modifier: TierExperienceModifier})
+		next.Proficiency++
+	} else if newExperience != "" {
+		return Progression{}, fmt.Errorf("new experience is only gained when entering a new tier")
+	}
+
+	used := tierAdvancementUsage(current.History, tier)
+	advancements := make([]Advancement, 0, len(choice.Advancements))
+	slots := 0
+	for _, advancement := range choice.Advancements {
+		advancementType, err := NormalizeAdvancementType(advancement.Type)
+		if err != nil {
+			return Progression{}, err
+		}
+		rule := advancementRules[advancementType]
+		if tier < rule.minTier {
+			return Progression{}, fmt.Errorf("advancement %q is not available in tier %d", advancementType, tier)
+		}
+		used[advancementType]++
+		if used[advancementType] > rule.perTier {
+			return Progression{}, fmt.Errorf("advancement %q exceeds the tier %d limit of %d", advancementType, tier, rule.perTier)
+		}
+		slots += rule.cost
+		advancement.Type = advancementType
+		advancements = append(advancements, advancement)
+	}
+	if slots != AdvancementSlotsPerLevel {
+		return Progression{}, fmt.Errorf("advancements must use exactly %d slots, got %d", AdvancementSlotsPerLevel, slots)
+	}
+	if used[AdvancementSubclassUpgrade] > 0 && used[AdvancementMulticlass] > 0 {
+		return Progression{}, ErrSubclassMulticlassExclusive
+	}
+
+	cards := make([]string, 0, 2)
+	if cardID := strings.TrimSpace(choice.DomainCardID); cardID != "" {
+		cards = append(cards, cardID)
+	}
+	for i, advancement := range advancements {
+		switch advancement.Type {
+		case AdvancementTraits:
+			traits, err := applyTraitAdvancement(&next, advancement.Traits)
+			if err != nil {
+				return Progression{}, err
+			}
+			advancements[i].Traits = traits
+		case AdvancementHPSlot:
+			if next.HpMax+1 > HPMaxCap {
+				return Progression{}, ErrInvalidHpMax
+			}
+			next.HpMax++
+		case AdvancementStressSlot:
+			if next.StressMax+1 > StressMaxCap {
+				return Progression{}, ErrInvalidStressMax
+			}
+			next.StressMax++
+		case AdvancementExperiences:
+			names, err := applyExperienceAdvancement(&next, advancement.Experiences)
+			if err != nil {
+				return Progression{}, err
+			}
+			advancements[i].Experiences = names
+		case AdvancementDomainCard:
+			cardID := strings.TrimSpace(advancement.DomainCardID)
+			if cardID == "" {
+				return Progression{}, fmt.Errorf("domain card advancement requires a domain card id")
+			}
+			advancements[i].DomainCardID = cardID
+			cards = append(cards, cardID)
+		case AdvancementEvasion:
+			next.Evasion++
+		case AdvancementSubclassUpgrade:
+			subclassID, err := applySubclassUpgrade(&next.Classes, advancement.SubclassID)
+			if err != nil {
+				return Progression{}, err
+			}
+			advancements[i].SubclassID = subclassID
+		case AdvancementProficiency:
+			next.Proficiency++
+		case AdvancementMulticlass:
+			if hasMulticlassed(current.History) || current.Classes.MulticlassClassID != "" {
+				return Progression{}, ErrAlreadyMulticlassed
+			}
+			classID := strings.TrimSpace(advancement.ClassID)
+			if classID == "" {
+				return Progression{}, fmt.Errorf("multiclass advancement requires a class id")
+			}
+			if classID == current.Classes.ClassID {
+				return Progression{}, ErrMulticlassSameClass
+			}
+			subclassID := strings.TrimSpace(advancement.SubclassID)
+			if subclassID == "" {
+				return Progression{}, fmt.Errorf("multiclass advancement requires a subclass id")
+			}
+			domainID := strings.TrimSpace(advancement.DomainID)
+			if domainID == "" {
+				return Progression{}, fmt.Errorf("multiclass advancement requires a domain id")
+			}
+			next.Classes.MulticlassClassID = classID
+			next.Classes.MulticlassSubclassID = subclassID
+			next.Classes.MulticlassSubclassStage = SubclassStageFoundation
+			next.Classes.MulticlassDomainID = domainID
+			advancements[i].ClassID = classID
+			advancements[i].SubclassID = subclassID
+			advancements[i].DomainID = domainID
+		}
+	}
+
+	for _, cardID := range cards {
+		if indexOf(next.Loadout.Active, cardID) != -1 || indexOf(next.Loadout.Vault, cardID) != -1 {
+			return Progression{}, fmt.Errorf("domain card %q is already owned", cardID)
+		}
+		if len(next.Loadout.Active) < LoadoutMaxCards {
+			next.Loadout.Active = append(next.Loadout.Active, cardID)
+		} else {
+			next.Loadout.Vault = append(next.Loadout.Vault, cardID)
+		}
+	}
+
+	// Every level-up raises damage thresholds; unarmored characters then fall
+	// back to the level-based thresholds.
+	next.MajorThreshold, next.SevereThreshold = DeriveThresholds(
+		next.Level,
+		next.ArmorScore,
+		next.MajorThreshold+1,
+		next.SevereThreshold+1,
+	)
+
+	next.History = append(next.History, LevelUpRecord{
+		Level:         next.Level,
+		Advancements:  advancements,
+		NewExperience: newExperience,
+		DomainCardID:  strings.TrimSpace(choice.DomainCardID),
+	})
+	return next, nil
+}
+
+func (p Progression) clone() Progression {
+	cloned := p
+	cloned.Experiences = append([]Experience(nil), p.Experiences...)
+	cloned.MarkedTraits = append([]string(nil), p.MarkedTraits...)
+	cloned.Loadout = Loadout{
+		Active: append([]string(nil), p.Loadout.Active...),
+		Vault:  append([]string(nil), p.Loadout.Vault...),
+	}
+	cloned.History = append([]LevelUpRecord(nil), p.History...)
+	return cloned
+}
+
+func tierAdvancementUsage(history []LevelUpRecord, tier int) map[string]int {
+	used := make(map[string]int)
+	for _, record := range history {
+		if TierForLevel(record.Level) != tier {
+			continue
+		}
+		for _, advancement := range record.Advancements {
+			used[advancement.Type]++
+		}
+	}
+	return used
+}
+
+// applySubclassUpgrade advances the named subclass, defaulting to the primary
+// subclass, and returns the upgraded subclass id.
+func applySubclassUpgrade(classes *ClassSelection, subclassID string) (string, error) {
+	target := strings.TrimSpace(subclassID)
+	if target == "" {
+		target = classes.SubclassID
+	}
+	if target == "" {
+		return "", ErrSubclassRequired
+	}
+	switch target {
+	case classes.SubclassID:
+		stage, err := NextSubclassStage(classes.SubclassStage)
+		if err != nil {
+			return "", err
+		}
+		classes.SubclassStage = stage
+	case classes.MulticlassSubclassID:
+		stage, err := NextSubclassStage(classes.MulticlassSubclassStage)
+		if err != nil {
+			return "", err
+		}
+		classes.MulticlassSubclassStage = stage
+	default:
+		return "", fmt.Errorf("subclass %q is not one of the character's subclasses", target)
+	}
+	return target, nil
+}
+
+func hasMulticlassed(history []LevelUpRecord) bool {
+	for _, record := range history {
+		for _, advancement := range record.Advancements {
+			if advancement.Type == AdvancementMulticlass {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func applyTraitAdvancement(p *Progression, names []string) ([]string, error) {
+	normalized, err := distinctNames(names, TraitAdvancementCount, "traits")
+	if err != nil {
+		return nil, err
+	}
+	for i, name := range normalized {
+		name = strings.ToLower(name)
+		normalized[i] = name
+		value := traitField(&p.Traits, name)
+		if value == nil {
+			return nil, fmt.Errorf("trait %q is invalid", name)
+		}
+		if indexOf(p.MarkedTraits, name) != -1 {
+			return nil, fmt.Errorf("trait %q is already marked", name)
+		}
+		if err := ValidateTrait(name, *value+1); err != nil {
+			return nil, err
+		}
+		*value++
+		p.MarkedTraits = append(p.MarkedTraits, name)
+	}
+	return normalized, nil
+}
+
+func applyExperienceAdvancement(p *Progression, names []string) ([]string, error) {
+	normalized, err := distinctNames(names, ExperienceAdvancementCount, "experiences")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range normalized {
+		index := experienceIndex(p.Experiences, name)
+		if index == -1 {
+			return nil, fmt.Errorf("experience %q not found", name)
+		}
+		p.Experiences[index].Modifier++
+	}
+	return normalized, nil
+}
+
+func distinctNames(names []string, count int, label string) ([]string, error) {
+	if len(names) != count {
+		return nil, fmt.Errorf("%s advancement requires exactly %d %s", label, count, label)
+	}
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		trimmed := strings.TrimSpace(name)
+		if trimmed == "" {
+			return nil, fmt.Errorf("%s advancement must not contain empty values", label)
+		}
+		for _, existing := range normalized {
+			if strings.EqualFold(existing, trimmed) {
+				return nil, fmt.Errorf("%s advancement must name distinct %s", label, label)
+			}
+		}
+		normalized = append(normalized, trimmed)
+	}
+	return normalized, nil
+}
+
+func experienceIndex(experiences []Experience, name string) int {
+	for i, experience := range experiences {
+		if strings.EqualFold(experience.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func traitField(t *Traits, name string) *int {
+	switch name {
+	case "agility":
+		return &t.Agility
+	case "strength":
+		return &t.Strength
+	case "finesse":
+		return &t.Finesse
+	case "instinct":
+		return &t.Instinct
+	case "presence":
+		return &t.Presence
+	case "knowledge":
+		return &t.Knowledge
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,373 @@
+package daggerheart
+
+import (
+	"errors"
+	"testing"
+)
+
+func newTestProgression() Progression {
+	return Progression{
+		Level:           1,
+		HpMax:           6,
+		StressMax:       6,
+		Evasion:         10,
+		MajorThreshold:  1,
+		SevereThreshold: 2,
+		Proficiency:     1,
+		Traits:          Traits{Agility: 2, Strength: 1, Finesse: 1, Instinct: 0, Presence: 0, Knowledge: -1},
+		Experiences:     []Experience{{Name: "Scholar", Modifier: 2}, {Name: "Sailor", Modifier: 2}},
+		Classes:         ClassSelection{ClassID: "class.guardian", SubclassID: "subclass.stalwart", SubclassStage: SubclassStageFoundation},
+	}
+}
+
+func TestTierForLevel(t *testing.T) {
+	tests := []struct {
+		level int
+		tier  int
+	}{
+		{1, 1}, {2, 2}, {4, 2}, {5, 3}, {7, 3}, {8, 4}, {10, 4},
+	}
+	for _, tc := range tests {
+		if got := TierForLevel(tc.level); got != tc.tier {
+			t.Fatalf("TierForLevel(%d) = %d, want %d", tc.level, got, tc.tier)
+		}
+	}
+}
+
+func TestApplyLevelUpTierEntry(t *testing.T) {
+	current := newTestProgression()
+	next, err := ApplyLevelUp(current, LevelUpChoice{
+		NewExperience: "Cartographer",
+		DomainCardID:  "card-1",
+		Advancements: []Advancement{
+			{Type: "Traits", Traits: []string{"Agility", "strength"}},
+			{Type: AdvancementEvasion},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyLevelUp returned error: %v", err)
+	}
+	if next.Level != 2 {
+		t.Fatalf("level = %d, want 2", next.Level)
+	}
+	if next.Proficiency != 2 {
+		t.Fatalf("proficiency = %d, want 2", next.Proficiency)
+	}
+	if next.Traits.Agility != 3 || next.Traits.Strength != 2 {
+		t.Fatalf("traits = %+v, want agility 3 strength 2", next.Traits)
+	}
+	if len(next.MarkedTraits) != 2 || next.MarkedTraits[0] != "agility" {
+		t.Fatalf("marked traits = %v", next.MarkedTraits)
+	}
+	if next.Evasion != 11 {
+		t.Fatalf("evasion = %d, want 11", next.Evasion)
+	}
+	if len(next.Experiences) != 3 || next.Experiences[2].Modifier != TierExperienceModifier {
+		t.Fatalf("experiences = %+v", next.Experiences)
+	}
+	if next.MajorThreshold != 2 || next.SevereThreshold != 4 {
+		t.Fatalf("thresholds = %d/%d, want unarmored 2/4", next.MajorThreshold, next.SevereThreshold)
+	}
+	if len(next.Loadout.Active) != 1 || next.Loadout.Active[0] != "card-1" {
+		t.Fatalf("loadout = %+v", next.Loadout)
+	}
+	if len(next.History) != 1 || next.History[0].Level != 2 || next.History[0].Advancements[0].Type != AdvancementTraits {
+		t.Fatalf("history = %+v", next.History)
+	}
+	if len(current.Experiences) != 2 || current.Traits.Agility != 2 {
+		t.Fatal("expected current progression to be unchanged")
+	}
+}
+
+func TestApplyLevelUpArmoredThresholds(t *testing.T) {
+	current := newTestProgression()
+	current.ArmorScore = 3
+	current.MajorThreshold = 7
+	current.SevereThreshold = 15
+	next, err := ApplyLevelUp(current, LevelUpChoice{
+		NewExperience: "Cartographer",
+		Advancements:  []Advancement{{Type: AdvancementHPSlot}, {Type: AdvancementStressSlot}},
+	})
+	if err != nil {
+		t.Fatalf("ApplyLevelUp returned error: %v", err)
+	}
+	if next.MajorThreshold != 8 || next.SevereThreshold != 16 {
+		t.Fatalf("thresholds = %d/%d, want 8/16", next.MajorThreshold, next.SevereThreshold)
+	}
+	if next.HpMax != 7 || next.StressMax != 7 {
+		t.Fatalf("hp/stress max = %d/%d, want 7/7", next.HpMax, next.StressMax)
+	}
+}
+
+func TestApplyLevelUpRequiresTierExperience(t *testing.T) {
+	_, err := ApplyLevelUp(newTestProgression(), LevelUpChoice{
+		Advancements: []Advancement{{Type: AdvancementHPSlot}, {Type: AdvancementStressSlot}},
+	})
+	if !errors.Is(err, ErrTierExperienceRequired) {
+		t.Fatalf("expected ErrTierExperienceRequired, got %v", err)
+	}
+}
+
+func TestApplyLevelUpRejectsExperienceOutsideTierEntry(t *testing.T) {
+	current := newTestProgression()
+	current.Level = 2
+	_, err := ApplyLevelUp(current, LevelUpChoice{
+		NewExperience: "Extra",
+		Advancements:  []Advancement{{Type: AdvancementHPSlot}, {Type: AdvancementStressSlot}},
+	})
+	if err == nil {
+		t.Fatal("expected error for new experience outside tier entry")
+	}
+}
+
+func TestApplyLevelUpSlotBudget(t *testing.T) {
+	tests := []struct {
+		name         string
+		advancements []Advancement
+	}{
+		{name: "too few", advancements: []Advancement{{Type: AdvancementHPSlot}}},
+		{name: "too many", advancements: []Advancement{{Type: AdvancementHPSlot}, {Type: AdvancementProficiency}}},
+		{name: "unknown", advancements: []Advancement{{Type: "wish"}, {Type: AdvancementHPSlot}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ApplyLevelUp(newTestProgression(), LevelUpChoice{NewExperience: "Cartographer", Advancements: tc.advancements})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestApplyLevelUpProficiencyUsesBothSlots(t *testing.T) {
+	next, err := ApplyLevelUp(newTestProgression(), LevelUpChoice{
+		NewExperience: "Cartographer",
+		Advancements:  []Advancement{{Type: AdvancementProficiency}},
+	})
+	if err != nil {
+		t.Fatalf("ApplyLevelUp returned error: %v", err)
+	}
+	// +1 from entering tier 2, +1 from the advancement.
+	if next.Proficiency != 3 {
+		t.Fatalf("proficiency = %d, want 3", next.Proficiency)
+	}
+}
+
+func TestApplyLevelUpTierLimits(t *testing.T) {
+	current := newTestProgression()
+	current.Level = 2
+	current.History = []LevelUpRecord{{Level: 2, Advancements: []Advancement{{Type: AdvancementEvasion}, {Type: AdvancementHPSlot}}}}
+	_, err := ApplyLevelUp(current, LevelUpChoice{
+		Advancements: []Advancement{{Type: AdvancementEvasion}, {Type: AdvancementStressSlot}},
+	})
+	if err == nil {
+		t.Fatal("expected evasion tier limit error")
+	}
+
+	// Limits reset when entering a new tier.
+	current.Level = 4
+	next, err := ApplyLevelUp(current, LevelUpChoice{
+		NewExperience: "Veteran",
+		Advancements:  []Advancement{{Type: AdvancementEvasion}, {Type: AdvancementStressSlot}},
+	})
+	if err != nil {
+		t.Fatalf("ApplyLevelUp returned error: %v", err)
+	}
+	if next.Level != 5 {
+		t.Fatalf("level = %d, want 5", next.Level)
+	}
+}
+
+func TestApplyLevelUpMarkedTraits(t *testing.T) {
+	current := newTestProgression()
+	current.Level = 3
+	current.MarkedTraits = []string{"agility"}
+	_, err := ApplyLevelUp(current, LevelUpChoice{
+		Advancements: []Advancement{{Type: AdvancementTraits, Traits: []string{"agility", "finesse"}}, {Type: AdvancementHPSlot}},
+	})
+	if err == nil {
+		t.Fatal("expected marked trait error")
+	}
+
+	// Entering tier 3 clears marked traits.
+	current.Level = 4
+	next, err := ApplyLevelUp(current, LevelUpChoice{
+		NewExperience: "Veteran",
+		Advancements:  []Advancement{{Type: AdvancementTraits, Traits: []string{"agility", "finesse"}}, {Type: AdvancementHPSlot}},
+	})
+	if err != nil {
+		t.Fatalf("ApplyLevelUp returned error: %v", err)
+	}
+	if len(next.MarkedTraits) != 2 {
+		t.Fatalf("marked traits = %v, want agility and finesse", next.MarkedTraits)
+	}
+}
+
+func TestApplyLevelUpTraitCap(t *testing.T) {
+	current := newTestProgression()
+	current.Traits.Agility = TraitMax
+	_, err := ApplyLevelUp(current, LevelUpChoice{
+		NewExperience: "Cartographer",
+		Advancements:  []Advancement{{Type: AdvancementTraits, Traits: []string{"agility", "finesse"}}, {Type: AdvancementHPSlot}},
+	})
+	if err == nil {
+		t.Fatal("expected trait cap error")
+	}
+}
+
+func TestApplyLevelUpExperienceAdvancement(t *testing.T) {
+	current := newTestProgression()
+	current.Level = 2
+	next, err := ApplyLevelUp(current, LevelUpChoice{
+		Advancements: []Advancement{{Type: AdvancementExperiences, Experiences: []string{"scholar", "Sailor"}}, {Type: AdvancementHPSlot}},
+	})
+	if err != nil {
+		t.Fatalf("ApplyLevelUp returned error: %v", err)
+	}
+	if next.Experiences[0].Modifier != 3 || next.Experiences[1].Modifier != 3 {
+		t.Fatalf("experiences = %+v", next.Experiences)
+	}
+
+	_, err = ApplyLevelUp(current, LevelUpChoice{
+		Advancements: []Advancement{{Type: AdvancementExperiences, Experiences: []string{"Scholar", "Missing"}}, {Type: AdvancementHPSlot}},
+	})
+	if err == nil {
+		t.Fatal("expected missing experience error")
+	}
+}
+
+func TestApplyLevelUpMulticlass(t *testing.T) {
+	multiclass := Advancement{Type: AdvancementMulticlass, ClassID: "class.wizard", SubclassID: "subclass.school-of-war", DomainID: "domain.codex"}
+	current := newTestProgression()
+	current.Level = 2
+	_, err := ApplyLevelUp(current, LevelUpChoice{Advancements: []Advancement{multiclass}})
+	if err == nil {
+		t.Fatal("expected multiclass to be unavailable in tier 2")
+	}
+
+	current.Level = 5
+	current.History = []LevelUpRecord{{Level: 5, Advancements: []Advancement{{Type: AdvancementSubclassUpgrade}, {Type: AdvancementHPSlot}}}}
+	_, err = ApplyLevelUp(current, LevelUpChoice{Advancements: []Advancement{multiclass}})
+	if !errors.Is(err, ErrSubclassMulticlassExclusive) {
+		t.Fatalf("expected ErrSubclassMulticlassExclusive, got %v", err)
+	}
+
+	current.History = nil
+	_, err = ApplyLevelUp(current, LevelUpChoice{
+		Advancements: []Advancement{{Type: AdvancementMulticlass, ClassID: "class.guardian", SubclassID: "subclass.vengeance", DomainID: "domain.blade"}},
+	})
+	if !errors.Is(err, ErrMulticlassSameClass) {
+		t.Fatalf("expected ErrMulticlassSameClass, got %v", err)
+	}
+	_, err = ApplyLevelUp(current, LevelUpChoice{
+		Advancements: []Advancement{{Type: AdvancementMulticlass, ClassID: "class.wizard", SubclassID: "subclass.school-of-war"}},
+	})
+	if err == nil {
+		t.Fatal("expected multiclass without a domain to fail")
+	}
+
+	next, err := ApplyLevelUp(current, LevelUpChoice{Advancements: []Advancement{multiclass}})
+	if err != nil {
+		t.Fatalf("ApplyLevelUp returned error: %v", err)
+	}
+	if next.History[0].Advancements[0].ClassID != "class.wizard" || next.History[0].Advancements[0].DomainID != "domain.codex" {
+		t.Fatalf("history = %+v", next.History)
+	}
+	want := ClassSelection{
+		ClassID:                 "class.guardian",
+		SubclassID:              "subclass.stalwart",
+		SubclassStage:           SubclassStageFoundation,
+		MulticlassClassID:       "class.wizard",
+		MulticlassSubclassID:    "subclass.school-of-war",
+		MulticlassSubclassStage: SubclassStageFoundation,
+		MulticlassDomainID:      "domain.codex",
+	}
+	if next.Classes != want {
+		t.Fatalf("classes = %+v, want %+v", next.Classes, want)
+	}
+
+	next.Level = 7
+	_, err = ApplyLevelUp(next, LevelUpChoice{
+		NewExperience: "Archmage",
+		Advancements:  []Advancement{{Type: AdvancementMulticlass, ClassID: "class.bard", SubclassID: "subclass.troubadour", DomainID: "domain.grace"}},
+	})
+	if !errors.Is(err, ErrAlreadyMulticlassed) {
+		t.Fatalf("expected ErrAlreadyMulticlassed, got %v", err)
+	}
+}
+
+func TestApplyLevelUpSubclassUpgrade(t *testing.T) {
+	current := newTestProgression()
+	next, err := ApplyLevelUp(current, LevelUpChoice{
+		NewExperience: "Cartographer",
+		Advancements:  []Advancement{{Type: AdvancementSubclassUpgrade}, {Type: AdvancementEvasion}},
+	})
+	if err != nil {
+		t.Fatalf("ApplyLevelUp returned error: %v", err)
+	}
+	if next.Classes.SubclassStage != SubclassStageSpecialization {
+		t.Fatalf("subclass stage = %q, want specialization", next.Classes.SubclassStage)
+	}
+	if next.History[0].Advancements[0].SubclassID != "subclass.stalwart" {
+		t.Fatalf("upgrade should default to the primary subclass, got %+v", next.History[0].Advancements[0])
+	}
+
+	_, err = ApplyLevelUp(current, LevelUpChoice{
+		NewExperience: "Cartographer",
+		Advancements:  []Advancement{{Type: AdvancementSubclassUpgrade, SubclassID: "subclass.other"}, {Type: AdvancementEvasion}},
+	})
+	if err == nil {
+		t.Fatal("expected upgrade of a foreign subclass to fail")
+	}
+
+	current.Classes.SubclassStage = SubclassStageMastery
+	_, err = ApplyLevelUp(current, LevelUpChoice{
+		NewExperience: "Cartographer",
+		Advancements:  []Advancement{{Type: AdvancementSubclassUpgrade}, {Type: AdvancementEvasion}},
+	})
+	if !errors.Is(err, ErrSubclassMastered) {
+		t.Fatalf("expected ErrSubclassMastered, got %v", err)
+	}
+
+	current.Classes = ClassSelection{}
+	_, err = ApplyLevelUp(current, LevelUpChoice{
+		NewExperience: "Cartographer",
+		Advancements:  []Advancement{{Type: AdvancementSubclassUpgrade}, {Type: AdvancementEvasion}},
+	})
+	if !errors.Is(err, ErrSubclassRequired) {
+		t.Fatalf("expected ErrSubclassRequired, got %v", err)
+	}
+}
+
+func TestApplyLevelUpDomainCards(t *testing.T) {
+	current := newTestProgression()
+	current.Level = 2
+	current.Loadout = Loadout{Active: []string{"a", "b", "c", "d", "e"}}
+	next, err := ApplyLevelUp(current, LevelUpChoice{
+		DomainCardID: "f",
+		Advancements: []Advancement{{Type: AdvancementDomainCard, DomainCardID: "g"}, {Type: AdvancementHPSlot}},
+	})
+	if err != nil {
+		t.Fatalf("ApplyLevelUp returned error: %v", err)
+	}
+	if len(next.Loadout.Active) != LoadoutMaxCards || len(next.Loadout.Vault) != 2 {
+		t.Fatalf("loadout = %+v, want new cards in vault", next.Loadout)
+	}
+
+	_, err = ApplyLevelUp(current, LevelUpChoice{
+		DomainCardID: "a",
+		Advancements: []Advancement{{Type: AdvancementHPSlot}, {Type: AdvancementStressSlot}},
+	})
+	if err == nil {
+		t.Fatal("expected duplicate card error")
+	}
+}
+
+func TestApplyLevelUpMaxLevel(t *testing.T) {
+	current := newTestProgression()
+	current.Level = LevelMax
+	_, err := ApplyLevelUp(current, LevelUpChoice{})
+	if !errors.Is(err, ErrMaxLevel) {
+		t.Fatalf("expected ErrMaxLevel, got %v", err)
+	}
+}
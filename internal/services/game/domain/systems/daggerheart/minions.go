@@ -0,0 +1,54 @@
+package daggerheart
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrNotMinion indicates a Minion rule was applied to a non-Minion adversary.
+	ErrNotMinion = errors.New("adversary is not a minion")
+	// ErrMinionOverflowExceeded indicates more overflow defeats than the damage allows.
+	ErrMinionOverflowExceeded = errors.New("minion overflow exceeds damage dealt")
+)
+
+// MinionOverflow returns how many additional Minions a Minion (N) damage
+// instance defeats: one for every N damage dealt.
+func MinionOverflow(damage, threshold int) int {
+	if damage <= 0 || threshold <= 0 {
+		return 0
+	}
+	return damage / threshold
+}
+
+// ApplyMinionDamage resolves damage against a Minion. Any damage defeats it
+// and armor is never spent.
+func ApplyMinionDamage(hp, armor, damage int) DamageApplication {
+	app := DamageApplication{
+		HPBefore:    hp,
+		HPAfter:     hp,
+		ArmorBefore: armor,
+		ArmorAfter:  armor,
+	}
+	if damage <= 0 {
+		return app
+	}
+	app.HPAfter = 0
+	app.Result = DamageResult{Severity: DamageMinor, Marks: hp}
+	return app
+}
+
+// MinionThresholdFromFeature parses the N of a catalog "Minion (N)" feature
+// name, returning 0 for any other feature.
+func MinionThresholdFromFeature(name string) int {
+	name = strings.TrimSpace(name)
+	if !strings.HasPrefix(name, "Minion (") || !strings.HasSuffix(name, ")") {
+		return 0
+	}
+	threshold, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "Minion ("), ")"))
+	if err != nil || threshold < 0 {
+		return 0
+	}
+	return threshold
+}
@@ -0,0 +1,58 @@
+package daggerheart
+
+import "testing"
+
+func TestMinionOverflow(t *testing.T) {
+	tests := []struct {
+		name      string
+		damage    int
+		threshold int
+		want      int
+	}{
+		{name: "below threshold", damage: 2, threshold: 3, want: 0},
+		{name: "exact multiple", damage: 6, threshold: 3, want: 2},
+		{name: "remainder ignored", damage: 8, threshold: 3, want: 2},
+		{name: "high threshold", damage: 8, threshold: 8, want: 1},
+		{name: "not a minion", damage: 10, threshold: 0, want: 0},
+		{name: "no damage", damage: 0, threshold: 3, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MinionOverflow(tt.damage, tt.threshold); got != tt.want {
+				t.Fatalf("MinionOverflow(%d, %d) = %d, want %d", tt.damage, tt.threshold, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyMinionDamage(t *testing.T) {
+	app := ApplyMinionDamage(4, 2, 1)
+	if app.HPAfter != 0 || app.ArmorAfter != 2 || app.ArmorSpent != 0 {
+		t.Fatalf("app = %+v, want defeated with armor untouched", app)
+	}
+	if app.Result.Marks != 4 {
+		t.Fatalf("marks = %d, want 4", app.Result.Marks)
+	}
+
+	app = ApplyMinionDamage(4, 2, 0)
+	if app.HPAfter != 4 || app.Result.Marks != 0 {
+		t.Fatalf("app = %+v, want no change", app)
+	}
+}
+
+func TestMinionThresholdFromFeature(t *testing.T) {
+	tests := []struct {
+		name string
+		want int
+	}{
+		{name: "Minion (3)", want: 3},
+		{name: " Minion (12) ", want: 12},
+		{name: "Group Attack", want: 0},
+		{name: "Minion (x)", want: 0},
+	}
+	for _, tt := range tests {
+		if got := MinionThresholdFromFeature(tt.name); got != tt.want {
+			t.Fatalf("MinionThresholdFromFeature(%q) = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
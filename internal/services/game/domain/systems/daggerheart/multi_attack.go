@@ -0,0 +1,36 @@
+package daggerheart
+
+// Multi-target attack target types.
+const (
+	MultiAttackTargetCharacter = "character"
+	MultiAttackTargetAdversary = "adversary"
+)
+
+// MultiAttackAttackerCompanion marks an attack made by a character's animal
+// companion; the attacker ID is the owning character.
+const MultiAttackAttackerCompanion = "companion"
+
+// ResolveMultiAttackHits compares one attack total against each target's
+// Difficulty (Evasion for PCs). A critical success hits every target.
+func ResolveMultiAttackHits(total int, crit bool, difficulties []int) []bool {
+	hits := make([]bool, len(difficulties))
+	for i, difficulty := range difficulties {
+		hits[i] = crit || total >= difficulty
+	}
+	return hits
+}
+
+// MultiAttackRollDifficulty returns the Difficulty used to record the shared
+// roll: the easiest target, so the roll succeeds when any target is hit.
+func MultiAttackRollDifficulty(difficulties []int) int {
+	if len(difficulties) == 0 {
+		return 0
+	}
+	lowest := difficulties[0]
+	for _, difficulty := range difficulties[1:] {
+		if difficulty < lowest {
+			lowest = difficulty
+		}
+	}
+	return lowest
+}
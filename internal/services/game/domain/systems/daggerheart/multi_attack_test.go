@@ -0,0 +1,36 @@
+package daggerheart
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolveMultiAttackHits(t *testing.T) {
+	tests := []struct {
+		name         string
+		total        int
+		crit         bool
+		difficulties []int
+		want         []bool
+	}{
+		{name: "per target", total: 12, difficulties: []int{10, 12, 14}, want: []bool{true, true, false}},
+		{name: "misses all", total: 8, difficulties: []int{10, 11}, want: []bool{false, false}},
+		{name: "critical hits all", total: 8, crit: true, difficulties: []int{10, 20}, want: []bool{true, true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveMultiAttackHits(tt.total, tt.crit, tt.difficulties); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ResolveMultiAttackHits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiAttackRollDifficulty(t *testing.T) {
+	if got := MultiAttackRollDifficulty([]int{14, 9, 12}); got != 9 {
+		t.Fatalf("MultiAttackRollDifficulty() = %d, want 9", got)
+	}
+	if got := MultiAttackRollDifficulty(nil); got != 0 {
+		t.Fatalf("MultiAttackRollDifficulty(nil) = %d, want 0", got)
+	}
+}
@@ -0,0 +1,106 @@
+package daggerheart
+
+import (
+	"errors"
+	"strings"
+)
+
+// Range bands, ordered from nearest to farthest.
+const (
+	RangeMelee      = "melee"
+	RangeVeryClose  = "very_close"
+	RangeClose      = "close"
+	RangeFar        = "far"
+	RangeVeryFar    = "very_far"
+	RangeOutOfRange = "out_of_range"
+)
+
+// Scene entity types that can hold a position.
+const (
+	SceneEntityCharacter = "character"
+	SceneEntityAdversary = "adversary"
+	SceneEntityZone      = "zone"
+)
+
+// Movement kinds recorded when an entity changes position.
+const (
+	MovementKindMove      = "move"
+	MovementKindKnockback = "knockback"
+	MovementKindTeleport  = "teleport"
+	MovementKindForced    = "forced"
+)
+
+// ErrInvalidRangeBand indicates a range band outside the glossary bands.
+var ErrInvalidRangeBand = errors.New("range band is invalid")
+
+var rangeBands = []string{RangeMelee, RangeVeryClose, RangeClose, RangeFar, RangeVeryFar, RangeOutOfRange}
+
+// NormalizeRangeBand accepts catalog and glossary spellings ("Very Close",
+// "very-close", "very_close") and returns the canonical band.
+func NormalizeRangeBand(value string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	normalized = strings.NewReplacer(" ", "_", "-", "_").Replace(normalized)
+	if RangeBandIndex(normalized) < 0 {
+		return "", ErrInvalidRangeBand
+	}
+	return normalized, nil
+}
+
+// RangeBandIndex returns the band's distance order, or -1 when unknown.
+func RangeBandIndex(band string) int {
+	for i, candidate := range rangeBands {
+		if candidate == band {
+			return i
+		}
+	}
+	return -1
+}
+
+// RangeReaches reports whether something with the given reach (such as a
+// weapon's Range) can affect a target at the given distance.
+func RangeReaches(reach, distance string) bool {
+	reachIndex := RangeBandIndex(reach)
+	distanceIndex := RangeBandIndex(distance)
+	if reachIndex < 0 || distanceIndex < 0 || distance == RangeOutOfRange {
+		return false
+	}
+	return distanceIndex <= reachIndex
+}
+
+// PushRangeBand moves a band the given number of steps farther away, stopping
+// at Very Far.
+func PushRangeBand(band string, steps int) string {
+	index := RangeBandIndex(band)
+	if index < 0 || band == RangeOutOfRange {
+		return band
+	}
+	index += steps
+	limit := RangeBandIndex(RangeVeryFar)
+	if index > limit {
+		index = limit
+	}
+	if index < 0 {
+		index = 0
+	}
+	return rangeBands[index]
+}
+
+// IsSceneEntityType reports whether the value is a known scene entity type.
+func IsSceneEntityType(value string) bool {
+	switch value {
+	case SceneEntityCharacter, SceneEntityAdversary, SceneEntityZone:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsMovementKind reports whether the value is a known movement kind.
+func IsMovementKind(value string) bool {
+	switch value {
+	case MovementKindMove, MovementKindKnockback, MovementKindTeleport, MovementKindForced:
+		return true
+	default:
+		return false
+	}
+}
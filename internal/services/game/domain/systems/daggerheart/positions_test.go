@@ -0,0 +1,62 @@
+package daggerheart
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeRangeBand(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "melee", want: RangeMelee},
+		{input: "Very Close", want: RangeVeryClose},
+		{input: "very-far", want: RangeVeryFar},
+		{input: " FAR ", want: RangeFar},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeRangeBand(tt.input)
+		if err != nil {
+			t.Fatalf("NormalizeRangeBand(%q) returned error: %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Fatalf("NormalizeRangeBand(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+	if _, err := NormalizeRangeBand("nearby"); !errors.Is(err, ErrInvalidRangeBand) {
+		t.Fatalf("expected ErrInvalidRangeBand, got %v", err)
+	}
+}
+
+func TestRangeReaches(t *testing.T) {
+	tests := []struct {
+		reach    string
+		distance string
+		want     bool
+	}{
+		{reach: RangeMelee, distance: RangeMelee, want: true},
+		{reach: RangeMelee, distance: RangeVeryClose, want: false},
+		{reach: RangeFar, distance: RangeClose, want: true},
+		{reach: RangeFar, distance: RangeVeryFar, want: false},
+		{reach: RangeVeryFar, distance: RangeOutOfRange, want: false},
+		{reach: "unknown", distance: RangeMelee, want: false},
+	}
+	for _, tt := range tests {
+		if got := RangeReaches(tt.reach, tt.distance); got != tt.want {
+			t.Fatalf("RangeReaches(%q, %q) = %v, want %v", tt.reach, tt.distance, got, tt.want)
+		}
+	}
+}
+
+func TestPushRangeBand(t *testing.T) {
+	if got := PushRangeBand(RangeMelee, 1); got != RangeVeryClose {
+		t.Fatalf("push melee 1 = %q, want %q", got, RangeVeryClose)
+	}
+	if got := PushRangeBand(RangeFar, 3); got != RangeVeryFar {
+		t.Fatalf("push far 3 = %q, want %q", got, RangeVeryFar)
+	}
+	if got := PushRangeBand(RangeOutOfRange, 1); got != RangeOutOfRange {
+		t.Fatalf("push out of range = %q, want %q", got, RangeOutOfRange)
+	}
+}
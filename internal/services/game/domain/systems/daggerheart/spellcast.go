@@ -0,0 +1,53 @@
+package daggerheart
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrNoSpellcastTrait indicates the governing subclass grants no spellcast trait.
+	ErrNoSpellcastTrait = errors.New("subclass has no spellcast trait")
+	// ErrInvalidHopeCost indicates a negative spell Hope cost.
+	ErrInvalidHopeCost = errors.New("hope cost must be non-negative")
+)
+
+// SpellcastSubclassID returns the subclass whose spellcast trait governs a
+// card. Cards drawn from the multiclass domain use the multiclass subclass.
+func SpellcastSubclassID(selection ClassSelection, cardDomainID string) string {
+	if selection.MulticlassDomainID != "" && selection.MulticlassSubclassID != "" &&
+		strings.EqualFold(selection.MulticlassDomainID, cardDomainID) {
+		return selection.MulticlassSubclassID
+	}
+	return selection.SubclassID
+}
+
+// NormalizeSpellcastTrait validates a subclass spellcast trait and returns
+// its lowercase trait name.
+func NormalizeSpellcastTrait(value string) (string, error) {
+	trait := strings.ToLower(strings.TrimSpace(value))
+	if trait == "" {
+		return "", ErrNoSpellcastTrait
+	}
+	var traits Traits
+	if traitField(&traits, trait) == nil {
+		return "", fmt.Errorf("spellcast trait %q is invalid", value)
+	}
+	return trait, nil
+}
+
+// TraitValue returns the value of the named trait.
+func TraitValue(traits Traits, name string) (int, bool) {
+	field := traitField(&traits, strings.ToLower(strings.TrimSpace(name)))
+	if field == nil {
+		return 0, false
+	}
+	return *field, true
+}
+
+// SpellcastDamageDiceCount returns how many damage dice a spell rolls when
+// its damage scales with the Spellcast trait. At least one die is rolled.
+func SpellcastDamageDiceCount(traitValue int) int {
+	return max(traitValue, 1)
+}
@@ -0,0 +1,41 @@
+package daggerheart
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSpellcastSubclassID(t *testing.T) {
+	selection := ClassSelection{
+		SubclassID:           "subclass.elemental-origin",
+		MulticlassSubclassID: "subclass.school-of-war",
+		MulticlassDomainID:   "domain.codex",
+	}
+	if got := SpellcastSubclassID(selection, "domain.arcana"); got != "subclass.elemental-origin" {
+		t.Fatalf("primary domain subclass = %q", got)
+	}
+	if got := SpellcastSubclassID(selection, "domain.codex"); got != "subclass.school-of-war" {
+		t.Fatalf("multiclass domain subclass = %q", got)
+	}
+}
+
+func TestNormalizeSpellcastTrait(t *testing.T) {
+	trait, err := NormalizeSpellcastTrait(" Instinct ")
+	if err != nil || trait != "instinct" {
+		t.Fatalf("NormalizeSpellcastTrait = %q, %v", trait, err)
+	}
+	if _, err := NormalizeSpellcastTrait(""); !errors.Is(err, ErrNoSpellcastTrait) {
+		t.Fatalf("expected ErrNoSpellcastTrait, got %v", err)
+	}
+	if _, err := NormalizeSpellcastTrait("luck"); err == nil {
+		t.Fatal("expected invalid trait error")
+	}
+
+	value, ok := TraitValue(Traits{Instinct: 2}, "instinct")
+	if !ok || value != 2 {
+		t.Fatalf("TraitValue = %d, %v", value, ok)
+	}
+	if got := SpellcastDamageDiceCount(-1); got != 1 {
+		t.Fatalf("SpellcastDamageDiceCount(-1) = %d, want 1", got)
+	}
+}
@@ -44,6 +44,11 @@ func EventHash(evt event.Event) (string, error) {
 	if evt.SystemVersion != "" {
 		envelope["system_version"] = evt.SystemVersion
 	}
+	// Payloads journaled before versioning hash without a version, so the
+	// initial version is left out to keep their hashes stable.
+	if evt.PayloadVersion > event.InitialPayloadVersion {
+		envelope["payload_version"] = evt.PayloadVersion
+	}
 	return encoding.ContentHash(envelope)
 }
 
@@ -86,6 +91,9 @@ func ChainHash(evt event.Event, prevHash string) (string, error) {
 	if evt.SystemVersion != "" {
 		envelope["system_version"] = evt.SystemVersion
 	}
+	if evt.PayloadVersion > event.InitialPayloadVersion {
+		envelope["payload_version"] = evt.PayloadVersion
+	}
 
 	canonical, err := encoding.CanonicalJSON(envelope)
 	if err != nil {
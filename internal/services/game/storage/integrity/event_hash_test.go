@@ -57,6 +57,26 @@ func TestEventHashChangesWithOptionalFields(t *testing.T) {
 	if baseline == hashSession {
 		t.Fatal("expected hash to change when optional fields change")
 	}
+
+	initialVersion := base
+	initialVersion.PayloadVersion = event.InitialPayloadVersion
+	hashInitial, err := EventHash(initialVersion)
+	if err != nil {
+		t.Fatalf("event hash: %v", err)
+	}
+	if hashInitial != baseline {
+		t.Fatal("expected initial payload version to keep the unversioned hash")
+	}
+
+	laterVersion := base
+	laterVersion.PayloadVersion = event.InitialPayloadVersion + 1
+	hashLater, err := EventHash(laterVersion)
+	if err != nil {
+		t.Fatalf("event hash: %v", err)
+	}
+	if hashLater == baseline {
+		t.Fatal("expected hash to change with a later payload version")
+	}
 }
 
 func TestChainHashRequiresEventHash(t *testing.T) {
@@ -143,6 +143,21 @@ func (q *Queries) DeleteDaggerheartDomainCard(ctx context.Context, id string) er
 	return err
 }
 
+const deleteDaggerheartEffect = `-- name: DeleteDaggerheartEffect :exec
+DELETE FROM daggerheart_effects
+WHERE campaign_id = ? AND effect_id = ?
+`
+
+type DeleteDaggerheartEffectParams struct {
+	CampaignID string `json:"campaign_id"`
+	EffectID   string `json:"effect_id"`
+}
+
+func (q *Queries) DeleteDaggerheartEffect(ctx context.Context, arg DeleteDaggerheartEffectParams) error {
+	_, err := q.db.ExecContext(ctx, deleteDaggerheartEffect, arg.CampaignID, arg.EffectID)
+	return err
+}
+
 const deleteDaggerheartEnvironment = `-- name: DeleteDaggerheartEnvironment :exec
 DELETE FROM daggerheart_environments WHERE id = ?
 `
@@ -188,6 +203,21 @@ func (q *Queries) DeleteDaggerheartLootEntry(ctx context.Context, id string) err
 	return err
 }
 
+const deleteDaggerheartSessionEnvironment = `-- name: DeleteDaggerheartSessionEnvironment :exec
+DELETE FROM daggerheart_session_environments
+WHERE campaign_id = ? AND session_id = ?
+`
+
+type DeleteDaggerheartSessionEnvironmentParams struct {
+	CampaignID string `json:"campaign_id"`
+	SessionID  string `json:"session_id"`
+}
+
+func (q *Queries) DeleteDaggerheartSessionEnvironment(ctx context.Context, arg DeleteDaggerheartSessionEnvironmentParams) error {
+	_, err := q.db.ExecContext(ctx, deleteDaggerheartSessionEnvironment, arg.CampaignID, arg.SessionID)
+	return err
+}
+
 const deleteDaggerheartSnapshot = `-- name: DeleteDaggerheartSnapshot :exec
 DELETE FROM daggerheart_snapshots WHERE campaign_id = ?
 `
@@ -217,7 +247,7 @@ func (q *Queries) DeleteDaggerheartWeapon(ctx context.Context, id string) error
 
 const getDaggerheartAdversary = `-- name: GetDaggerheartAdversary :one
 
-SELECT campaign_id, adversary_id, name, kind, session_id, notes, hp, hp_max, stress, stress_max, evasion, major_threshold, severe_threshold, armor, conditions_json, created_at, updated_at FROM daggerheart_adversaries
+SELECT campaign_id, adversary_id, name, kind, session_id, notes, hp, hp_max, stress, stress_max, evasion, major_threshold, severe_threshold, armor, conditions_json, minion_threshold, adversary_entry_id, attack_modifier, standard_attack_json, experiences_json, features_json, created_at, updated_at FROM daggerheart_adversaries
 WHERE campaign_id = ? AND adversary_id = ?
 `
 
@@ -246,6 +276,12 @@ func (q *Queries) GetDaggerheartAdversary(ctx context.Context, arg GetDaggerhear
 		&i.SevereThreshold,
 		&i.Armor,
 		&i.ConditionsJson,
+		&i.MinionThreshold,
+		&i.AdversaryEntryID,
+		&i.AttackModifier,
+		&i.StandardAttackJson,
+		&i.ExperiencesJson,
+		&i.FeaturesJson,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -330,7 +366,7 @@ func (q *Queries) GetDaggerheartBeastform(ctx context.Context, id string) (Dagge
 const getDaggerheartCharacterProfile = `-- name: GetDaggerheartCharacterProfile :one
 
 
-SELECT campaign_id, character_id, level, hp_max, stress_max, evasion, major_threshold, severe_threshold, agility, strength, finesse, instinct, presence, knowledge, proficiency, armor_score, armor_max, experiences_json FROM daggerheart_character_profiles
+SELECT campaign_id, character_id, level, hp_max, stress_max, evasion, major_threshold, severe_threshold, agility, strength, finesse, instinct, presence, knowledge, proficiency, armor_score, armor_max, experiences_json, class_id, subclass_id, subclass_stage, multiclass_class_id, multiclass_subclass_id, multiclass_subclass_stage, multiclass_domain_id, marked_traits_json, loadout_active_json, loadout_vault_json, level_history_json FROM daggerheart_character_profiles
 WHERE campaign_id = ? AND character_id = ?
 `
 
@@ -363,6 +399,17 @@ func (q *Queries) GetDaggerheartCharacterProfile(ctx context.Context, arg GetDag
 		&i.ArmorScore,
 		&i.ArmorMax,
 		&i.ExperiencesJson,
+		&i.ClassID,
+		&i.SubclassID,
+		&i.SubclassStage,
+		&i.MulticlassClassID,
+		&i.MulticlassSubclassID,
+		&i.MulticlassSubclassStage,
+		&i.MulticlassDomainID,
+		&i.MarkedTraitsJson,
+		&i.LoadoutActiveJson,
+		&i.LoadoutVaultJson,
+		&i.LevelHistoryJson,
 	)
 	return i, err
 }
@@ -485,7 +532,7 @@ func (q *Queries) GetDaggerheartCharacterSheet(ctx context.Context, arg GetDagge
 
 const getDaggerheartCharacterState = `-- name: GetDaggerheartCharacterState :one
 
-SELECT campaign_id, character_id, hp, hope, hope_max, stress, armor, conditions_json, life_state FROM daggerheart_character_states
+SELECT campaign_id, character_id, hp, hope, hope_max, stress, armor, conditions_json, life_state, gold_handfuls, gold_bags, gold_chests, inventory_json, equipped_armor_id, equipped_primary_weapon_id, equipped_secondary_weapon_id, beastform_id FROM daggerheart_character_states
 WHERE campaign_id = ? AND character_id = ?
 `
 
@@ -508,6 +555,39 @@ func (q *Queries) GetDaggerheartCharacterState(ctx context.Context, arg GetDagge
 		&i.Armor,
 		&i.ConditionsJson,
 		&i.LifeState,
+		&i.GoldHandfuls,
+		&i.GoldBags,
+		&i.GoldChests,
+		&i.InventoryJson,
+		&i.EquippedArmorID,
+		&i.EquippedPrimaryWeaponID,
+		&i.EquippedSecondaryWeaponID,
+		&i.BeastformID,
+	)
+	return i, err
+}
+
+const getDaggerheartChase = `-- name: GetDaggerheartChase :one
+SELECT campaign_id, chase_id, name, progress_countdown_id, consequence_countdown_id, status, winner FROM daggerheart_chases
+WHERE campaign_id = ? AND chase_id = ?
+`
+
+type GetDaggerheartChaseParams struct {
+	CampaignID string `json:"campaign_id"`
+	ChaseID    string `json:"chase_id"`
+}
+
+func (q *Queries) GetDaggerheartChase(ctx context.Context, arg GetDaggerheartChaseParams) (DaggerheartChase, error) {
+	row := q.db.QueryRowContext(ctx, getDaggerheartChase, arg.CampaignID, arg.ChaseID)
+	var i DaggerheartChase
+	err := row.Scan(
+		&i.CampaignID,
+		&i.ChaseID,
+		&i.Name,
+		&i.ProgressCountdownID,
+		&i.ConsequenceCountdownID,
+		&i.Status,
+		&i.Winner,
 	)
 	return i, err
 }
@@ -536,6 +616,35 @@ func (q *Queries) GetDaggerheartClass(ctx context.Context, id string) (Daggerhea
 	return i, err
 }
 
+const getDaggerheartCompanion = `-- name: GetDaggerheartCompanion :one
+SELECT campaign_id, character_id, name, evasion, stress, stress_max, damage_die_sides, attack_range, experiences_json, level, upgrades_json FROM daggerheart_companions
+WHERE campaign_id = ? AND character_id = ?
+`
+
+type GetDaggerheartCompanionParams struct {
+	CampaignID  string `json:"campaign_id"`
+	CharacterID string `json:"character_id"`
+}
+
+func (q *Queries) GetDaggerheartCompanion(ctx context.Context, arg GetDaggerheartCompanionParams) (DaggerheartCompanion, error) {
+	row := q.db.QueryRowContext(ctx, getDaggerheartCompanion, arg.CampaignID, arg.CharacterID)
+	var i DaggerheartCompanion
+	err := row.Scan(
+		&i.CampaignID,
+		&i.CharacterID,
+		&i.Name,
+		&i.Evasion,
+		&i.Stress,
+		&i.StressMax,
+		&i.DamageDieSides,
+		&i.AttackRange,
+		&i.ExperiencesJson,
+		&i.Level,
+		&i.UpgradesJson,
+	)
+	return i, err
+}
+
 const getDaggerheartCompanionExperience = `-- name: GetDaggerheartCompanionExperience :one
 SELECT id, name, description, created_at, updated_at FROM daggerheart_companion_experiences WHERE id = ?
 `
@@ -555,7 +664,7 @@ func (q *Queries) GetDaggerheartCompanionExperience(ctx context.Context, id stri
 
 const getDaggerheartCountdown = `-- name: GetDaggerheartCountdown :one
 
-SELECT campaign_id, countdown_id, name, kind, "current", max, direction, looping FROM daggerheart_countdowns
+SELECT campaign_id, countdown_id, name, kind, "current", max, direction, looping, tick_trigger, tick_delta, tick_outcome_deltas_json FROM daggerheart_countdowns
 WHERE campaign_id = ? AND countdown_id = ?
 `
 
@@ -577,6 +686,9 @@ func (q *Queries) GetDaggerheartCountdown(ctx context.Context, arg GetDaggerhear
 		&i.Max,
 		&i.Direction,
 		&i.Looping,
+		&i.TickTrigger,
+		&i.TickDelta,
+		&i.TickOutcomeDeltasJson,
 	)
 	return i, err
 }
@@ -637,6 +749,34 @@ func (q *Queries) GetDaggerheartDomainCard(ctx context.Context, id string) (Dagg
 	return i, err
 }
 
+const getDaggerheartEffect = `-- name: GetDaggerheartEffect :one
+SELECT campaign_id, effect_id, session_id, target_type, target_id, source, kind, value, stacking, expires_on FROM daggerheart_effects
+WHERE campaign_id = ? AND effect_id = ?
+`
+
+type GetDaggerheartEffectParams struct {
+	CampaignID string `json:"campaign_id"`
+	EffectID   string `json:"effect_id"`
+}
+
+func (q *Queries) GetDaggerheartEffect(ctx context.Context, arg GetDaggerheartEffectParams) (DaggerheartEffect, error) {
+	row := q.db.QueryRowContext(ctx, getDaggerheartEffect, arg.CampaignID, arg.EffectID)
+	var i DaggerheartEffect
+	err := row.Scan(
+		&i.CampaignID,
+		&i.EffectID,
+		&i.SessionID,
+		&i.TargetType,
+		&i.TargetID,
+		&i.Source,
+		&i.Kind,
+		&i.Value,
+		&i.Stacking,
+		&i.ExpiresOn,
+	)
+	return i, err
+}
+
 const getDaggerheartEnvironment = `-- name: GetDaggerheartEnvironment :one
 SELECT id, name, tier, type, difficulty, impulses_json, potential_adversary_ids_json, features_json, prompts_json, created_at, updated_at FROM daggerheart_environments WHERE id = ?
 `
@@ -734,6 +874,65 @@ func (q *Queries) GetDaggerheartLootEntry(ctx context.Context, id string) (Dagge
 	return i, err
 }
 
+const getDaggerheartSceneRange = `-- name: GetDaggerheartSceneRange :one
+
+SELECT campaign_id, session_id, from_id, from_type, to_id, to_type, band FROM daggerheart_scene_ranges
+WHERE campaign_id = ? AND session_id = ? AND from_id = ? AND to_id = ?
+`
+
+type GetDaggerheartSceneRangeParams struct {
+	CampaignID string `json:"campaign_id"`
+	SessionID  string `json:"session_id"`
+	FromID     string `json:"from_id"`
+	ToID       string `json:"to_id"`
+}
+
+// Scene Range Extensions
+func (q *Queries) GetDaggerheartSceneRange(ctx context.Context, arg GetDaggerheartSceneRangeParams) (DaggerheartSceneRange, error) {
+	row := q.db.QueryRowContext(ctx, getDaggerheartSceneRange,
+		arg.CampaignID,
+		arg.SessionID,
+		arg.FromID,
+		arg.ToID,
+	)
+	var i DaggerheartSceneRange
+	err := row.Scan(
+		&i.CampaignID,
+		&i.SessionID,
+		&i.FromID,
+		&i.FromType,
+		&i.ToID,
+		&i.ToType,
+		&i.Band,
+	)
+	return i, err
+}
+
+const getDaggerheartSessionEnvironment = `-- name: GetDaggerheartSessionEnvironment :one
+SELECT campaign_id, session_id, environment_id, name, tier, type, difficulty FROM daggerheart_session_environments
+WHERE campaign_id = ? AND session_id = ?
+`
+
+type GetDaggerheartSessionEnvironmentParams struct {
+	CampaignID string `json:"campaign_id"`
+	SessionID  string `json:"session_id"`
+}
+
+func (q *Queries) GetDaggerheartSessionEnvironment(ctx context.Context, arg GetDaggerheartSessionEnvironmentParams) (DaggerheartSessionEnvironment, error) {
+	row := q.db.QueryRowContext(ctx, getDaggerheartSessionEnvironment, arg.CampaignID, arg.SessionID)
+	var i DaggerheartSessionEnvironment
+	err := row.Scan(
+		&i.CampaignID,
+		&i.SessionID,
+		&i.EnvironmentID,
+		&i.Name,
+		&i.Tier,
+		&i.Type,
+		&i.Difficulty,
+	)
+	return i, err
+}
+
 const getDaggerheartSnapshot = `-- name: GetDaggerheartSnapshot :one
 
 SELECT campaign_id, gm_fear, consecutive_short_rests FROM daggerheart_snapshots WHERE campaign_id = ?
@@ -792,7 +991,7 @@ func (q *Queries) GetDaggerheartWeapon(ctx context.Context, id string) (Daggerhe
 }
 
 const listDaggerheartAdversariesByCampaign = `-- name: ListDaggerheartAdversariesByCampaign :many
-SELECT campaign_id, adversary_id, name, kind, session_id, notes, hp, hp_max, stress, stress_max, evasion, major_threshold, severe_threshold, armor, conditions_json, created_at, updated_at FROM daggerheart_adversaries
+SELECT campaign_id, adversary_id, name, kind, session_id, notes, hp, hp_max, stress, stress_max, evasion, major_threshold, severe_threshold, armor, conditions_json, minion_threshold, adversary_entry_id, attack_modifier, standard_attack_json, experiences_json, features_json, created_at, updated_at FROM daggerheart_adversaries
 WHERE campaign_id = ?
 ORDER BY name ASC, adversary_id ASC
 `
@@ -822,6 +1021,12 @@ func (q *Queries) ListDaggerheartAdversariesByCampaign(ctx context.Context, camp
 			&i.SevereThreshold,
 			&i.Armor,
 			&i.ConditionsJson,
+			&i.MinionThreshold,
+			&i.AdversaryEntryID,
+			&i.AttackModifier,
+			&i.StandardAttackJson,
+			&i.ExperiencesJson,
+			&i.FeaturesJson,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -839,7 +1044,7 @@ func (q *Queries) ListDaggerheartAdversariesByCampaign(ctx context.Context, camp
 }
 
 const listDaggerheartAdversariesBySession = `-- name: ListDaggerheartAdversariesBySession :many
-SELECT campaign_id, adversary_id, name, kind, session_id, notes, hp, hp_max, stress, stress_max, evasion, major_threshold, severe_threshold, armor, conditions_json, created_at, updated_at FROM daggerheart_adversaries
+SELECT campaign_id, adversary_id, name, kind, session_id, notes, hp, hp_max, stress, stress_max, evasion, major_threshold, severe_threshold, armor, conditions_json, minion_threshold, adversary_entry_id, attack_modifier, standard_attack_json, experiences_json, features_json, created_at, updated_at FROM daggerheart_adversaries
 WHERE campaign_id = ? AND session_id = ?
 ORDER BY name ASC, adversary_id ASC
 `
@@ -874,6 +1079,12 @@ func (q *Queries) ListDaggerheartAdversariesBySession(ctx context.Context, arg L
 			&i.SevereThreshold,
 			&i.Armor,
 			&i.ConditionsJson,
+			&i.MinionThreshold,
+			&i.AdversaryEntryID,
+			&i.AttackModifier,
+			&i.StandardAttackJson,
+			&i.ExperiencesJson,
+			&i.FeaturesJson,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -936,6 +1147,41 @@ func (q *Queries) ListDaggerheartAdversaryEntries(ctx context.Context) ([]Dagger
 	return items, nil
 }
 
+const listDaggerheartAdversaryFeatureStates = `-- name: ListDaggerheartAdversaryFeatureStates :many
+SELECT campaign_id, adversary_id, feature_id, status, refresh_on FROM daggerheart_adversary_feature_states
+WHERE campaign_id = ?
+ORDER BY adversary_id ASC, feature_id ASC
+`
+
+func (q *Queries) ListDaggerheartAdversaryFeatureStates(ctx context.Context, campaignID string) ([]DaggerheartAdversaryFeatureState, error) {
+	rows, err := q.db.QueryContext(ctx, listDaggerheartAdversaryFeatureStates, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DaggerheartAdversaryFeatureState{}
+	for rows.Next() {
+		var i DaggerheartAdversaryFeatureState
+		if err := rows.Scan(
+			&i.CampaignID,
+			&i.AdversaryID,
+			&i.FeatureID,
+			&i.Status,
+			&i.RefreshOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listDaggerheartArmor = `-- name: ListDaggerheartArmor :many
 SELECT id, name, tier, base_major_threshold, base_severe_threshold, armor_score, feature, created_at, updated_at FROM daggerheart_armor ORDER BY name ASC, id ASC
 `
@@ -1028,15 +1274,27 @@ FROM daggerheart_character_states
 WHERE campaign_id = ?
 `
 
-func (q *Queries) ListDaggerheartCharacterStates(ctx context.Context, campaignID string) ([]DaggerheartCharacterState, error) {
+type ListDaggerheartCharacterStatesRow struct {
+	CampaignID     string `json:"campaign_id"`
+	CharacterID    string `json:"character_id"`
+	Hp             int64  `json:"hp"`
+	Hope           int64  `json:"hope"`
+	HopeMax        int64  `json:"hope_max"`
+	Stress         int64  `json:"stress"`
+	Armor          int64  `json:"armor"`
+	ConditionsJson string `json:"conditions_json"`
+	LifeState      string `json:"life_state"`
+}
+
+func (q *Queries) ListDaggerheartCharacterStates(ctx context.Context, campaignID string) ([]ListDaggerheartCharacterStatesRow, error) {
 	rows, err := q.db.QueryContext(ctx, listDaggerheartCharacterStates, campaignID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []DaggerheartCharacterState{}
+	items := []ListDaggerheartCharacterStatesRow{}
 	for rows.Next() {
-		var i DaggerheartCharacterState
+		var i ListDaggerheartCharacterStatesRow
 		if err := rows.Scan(
 			&i.CampaignID,
 			&i.CharacterID,
@@ -1061,6 +1319,43 @@ func (q *Queries) ListDaggerheartCharacterStates(ctx context.Context, campaignID
 	return items, nil
 }
 
+const listDaggerheartChases = `-- name: ListDaggerheartChases :many
+SELECT campaign_id, chase_id, name, progress_countdown_id, consequence_countdown_id, status, winner FROM daggerheart_chases
+WHERE campaign_id = ?
+ORDER BY chase_id ASC
+`
+
+func (q *Queries) ListDaggerheartChases(ctx context.Context, campaignID string) ([]DaggerheartChase, error) {
+	rows, err := q.db.QueryContext(ctx, listDaggerheartChases, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DaggerheartChase{}
+	for rows.Next() {
+		var i DaggerheartChase
+		if err := rows.Scan(
+			&i.CampaignID,
+			&i.ChaseID,
+			&i.Name,
+			&i.ProgressCountdownID,
+			&i.ConsequenceCountdownID,
+			&i.Status,
+			&i.Winner,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listDaggerheartClasses = `-- name: ListDaggerheartClasses :many
 SELECT id, name, starting_evasion, starting_hp, starting_items_json, features_json, hope_feature_json, domain_ids_json, created_at, updated_at FROM daggerheart_classes ORDER BY name ASC, id ASC
 `
@@ -1190,7 +1485,7 @@ func (q *Queries) ListDaggerheartContentStringsByIDs(ctx context.Context, arg Li
 }
 
 const listDaggerheartCountdowns = `-- name: ListDaggerheartCountdowns :many
-SELECT campaign_id, countdown_id, name, kind, "current", max, direction, looping FROM daggerheart_countdowns
+SELECT campaign_id, countdown_id, name, kind, "current", max, direction, looping, tick_trigger, tick_delta, tick_outcome_deltas_json FROM daggerheart_countdowns
 WHERE campaign_id = ?
 `
 
@@ -1212,6 +1507,9 @@ func (q *Queries) ListDaggerheartCountdowns(ctx context.Context, campaignID stri
 			&i.Max,
 			&i.Direction,
 			&i.Looping,
+			&i.TickTrigger,
+			&i.TickDelta,
+			&i.TickOutcomeDeltasJson,
 		); err != nil {
 			return nil, err
 		}
@@ -1368,6 +1666,46 @@ func (q *Queries) ListDaggerheartDomains(ctx context.Context) ([]DaggerheartDoma
 	return items, nil
 }
 
+const listDaggerheartEffects = `-- name: ListDaggerheartEffects :many
+SELECT campaign_id, effect_id, session_id, target_type, target_id, source, kind, value, stacking, expires_on FROM daggerheart_effects
+WHERE campaign_id = ?
+ORDER BY target_id ASC, effect_id ASC
+`
+
+func (q *Queries) ListDaggerheartEffects(ctx context.Context, campaignID string) ([]DaggerheartEffect, error) {
+	rows, err := q.db.QueryContext(ctx, listDaggerheartEffects, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DaggerheartEffect{}
+	for rows.Next() {
+		var i DaggerheartEffect
+		if err := rows.Scan(
+			&i.CampaignID,
+			&i.EffectID,
+			&i.SessionID,
+			&i.TargetType,
+			&i.TargetID,
+			&i.Source,
+			&i.Kind,
+			&i.Value,
+			&i.Stacking,
+			&i.ExpiresOn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listDaggerheartEnvironments = `-- name: ListDaggerheartEnvironments :many
 SELECT id, name, tier, type, difficulty, impulses_json, potential_adversary_ids_json, features_json, prompts_json, created_at, updated_at FROM daggerheart_environments ORDER BY name ASC, id ASC
 `
@@ -1545,6 +1883,48 @@ func (q *Queries) ListDaggerheartLootEntries(ctx context.Context) ([]Daggerheart
 	return items, nil
 }
 
+const listDaggerheartSceneRanges = `-- name: ListDaggerheartSceneRanges :many
+SELECT campaign_id, session_id, from_id, from_type, to_id, to_type, band FROM daggerheart_scene_ranges
+WHERE campaign_id = ? AND session_id = ?
+ORDER BY from_id, to_id
+`
+
+type ListDaggerheartSceneRangesParams struct {
+	CampaignID string `json:"campaign_id"`
+	SessionID  string `json:"session_id"`
+}
+
+func (q *Queries) ListDaggerheartSceneRanges(ctx context.Context, arg ListDaggerheartSceneRangesParams) ([]DaggerheartSceneRange, error) {
+	rows, err := q.db.QueryContext(ctx, listDaggerheartSceneRanges, arg.CampaignID, arg.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []DaggerheartSceneRange{}
+	for rows.Next() {
+		var i DaggerheartSceneRange
+		if err := rows.Scan(
+			&i.CampaignID,
+			&i.SessionID,
+			&i.FromID,
+			&i.FromType,
+			&i.ToID,
+			&i.ToType,
+			&i.Band,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listDaggerheartSubclasses = `-- name: ListDaggerheartSubclasses :many
 SELECT id, name, spellcast_trait, foundation_features_json, specialization_features_json, mastery_features_json, created_at, updated_at FROM daggerheart_subclasses ORDER BY name ASC, id ASC
 `
@@ -1624,8 +2004,9 @@ func (q *Queries) ListDaggerheartWeapons(ctx context.Context) ([]DaggerheartWeap
 const putDaggerheartAdversary = `-- name: PutDaggerheartAdversary :exec
 INSERT INTO daggerheart_adversaries (
     campaign_id, adversary_id, name, kind, session_id, notes, hp, hp_max, stress, stress_max,
-    evasion, major_threshold, severe_threshold, armor, conditions_json, created_at, updated_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    evasion, major_threshold, severe_threshold, armor, conditions_json, minion_threshold,
+    adversary_entry_id, attack_modifier, standard_attack_json, experiences_json, features_json, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(campaign_id, adversary_id) DO UPDATE SET
     name = excluded.name,
     kind = excluded.kind,
@@ -1640,28 +2021,40 @@ ON CONFLICT(campaign_id, adversary_id) DO UPDATE SET
     severe_threshold = excluded.severe_threshold,
     armor = excluded.armor,
     conditions_json = excluded.conditions_json,
+    minion_threshold = excluded.minion_threshold,
+    adversary_entry_id = excluded.adversary_entry_id,
+    attack_modifier = excluded.attack_modifier,
+    standard_attack_json = excluded.standard_attack_json,
+    experiences_json = excluded.experiences_json,
+    features_json = excluded.features_json,
     created_at = excluded.created_at,
     updated_at = excluded.updated_at
 `
 
 type PutDaggerheartAdversaryParams struct {
-	CampaignID      string         `json:"campaign_id"`
-	AdversaryID     string         `json:"adversary_id"`
-	Name            string         `json:"name"`
-	Kind            string         `json:"kind"`
-	SessionID       sql.NullString `json:"session_id"`
-	Notes           string         `json:"notes"`
-	Hp              int64          `json:"hp"`
-	HpMax           int64          `json:"hp_max"`
-	Stress          int64          `json:"stress"`
-	StressMax       int64          `json:"stress_max"`
-	Evasion         int64          `json:"evasion"`
-	MajorThreshold  int64          `json:"major_threshold"`
-	SevereThreshold int64          `json:"severe_threshold"`
-	Armor           int64          `json:"armor"`
-	ConditionsJson  string         `json:"conditions_json"`
-	CreatedAt       int64          `json:"created_at"`
-	UpdatedAt       int64          `json:"updated_at"`
+	CampaignID         string         `json:"campaign_id"`
+	AdversaryID        string         `json:"adversary_id"`
+	Name               string         `json:"name"`
+	Kind               string         `json:"kind"`
+	SessionID          sql.NullString `json:"session_id"`
+	Notes              string         `json:"notes"`
+	Hp                 int64          `json:"hp"`
+	HpMax              int64          `json:"hp_max"`
+	Stress             int64          `json:"stress"`
+	StressMax          int64          `json:"stress_max"`
+	Evasion            int64          `json:"evasion"`
+	MajorThreshold     int64          `json:"major_threshold"`
+	SevereThreshold    int64          `json:"severe_threshold"`
+	Armor              int64          `json:"armor"`
+	ConditionsJson     string         `json:"conditions_json"`
+	MinionThreshold    int64          `json:"minion_threshold"`
+	AdversaryEntryID   string         `json:"adversary_entry_id"`
+	AttackModifier     int64          `json:"attack_modifier"`
+	StandardAttackJson string         `json:"standard_attack_json"`
+	ExperiencesJson    string         `json:"experiences_json"`
+	FeaturesJson       string         `json:"features_json"`
+	CreatedAt          int64          `json:"created_at"`
+	UpdatedAt          int64          `json:"updated_at"`
 }
 
 func (q *Queries) PutDaggerheartAdversary(ctx context.Context, arg PutDaggerheartAdversaryParams) error {
@@ -1681,6 +2074,12 @@ func (q *Queries) PutDaggerheartAdversary(ctx context.Context, arg PutDaggerhear
 		arg.SevereThreshold,
 		arg.Armor,
 		arg.ConditionsJson,
+		arg.MinionThreshold,
+		arg.AdversaryEntryID,
+		arg.AttackModifier,
+		arg.StandardAttackJson,
+		arg.ExperiencesJson,
+		arg.FeaturesJson,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
@@ -1757,6 +2156,34 @@ func (q *Queries) PutDaggerheartAdversaryEntry(ctx context.Context, arg PutDagge
 	return err
 }
 
+const putDaggerheartAdversaryFeatureState = `-- name: PutDaggerheartAdversaryFeatureState :exec
+INSERT INTO daggerheart_adversary_feature_states (
+    campaign_id, adversary_id, feature_id, status, refresh_on
+) VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, adversary_id, feature_id) DO UPDATE SET
+    status = excluded.status,
+    refresh_on = excluded.refresh_on
+`
+
+type PutDaggerheartAdversaryFeatureStateParams struct {
+	CampaignID  string `json:"campaign_id"`
+	AdversaryID string `json:"adversary_id"`
+	FeatureID   string `json:"feature_id"`
+	Status      string `json:"status"`
+	RefreshOn   string `json:"refresh_on"`
+}
+
+func (q *Queries) PutDaggerheartAdversaryFeatureState(ctx context.Context, arg PutDaggerheartAdversaryFeatureStateParams) error {
+	_, err := q.db.ExecContext(ctx, putDaggerheartAdversaryFeatureState,
+		arg.CampaignID,
+		arg.AdversaryID,
+		arg.FeatureID,
+		arg.Status,
+		arg.RefreshOn,
+	)
+	return err
+}
+
 const putDaggerheartArmor = `-- name: PutDaggerheartArmor :exec
 INSERT INTO daggerheart_armor (
     id, name, tier, base_major_threshold, base_severe_threshold, armor_score, feature, created_at, updated_at
@@ -1855,8 +2282,10 @@ const putDaggerheartCharacterProfile = `-- name: PutDaggerheartCharacterProfile
 INSERT INTO daggerheart_character_profiles (
     campaign_id, character_id, level, hp_max, stress_max, evasion, major_threshold, severe_threshold,
     agility, strength, finesse, instinct, presence, knowledge, proficiency, armor_score, armor_max,
-    experiences_json
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    experiences_json, class_id, subclass_id, subclass_stage, multiclass_class_id, multiclass_subclass_id,
+    multiclass_subclass_stage, multiclass_domain_id, marked_traits_json, loadout_active_json, loadout_vault_json,
+    level_history_json
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(campaign_id, character_id) DO UPDATE SET
     level = excluded.level,
     hp_max = excluded.hp_max,
@@ -1873,28 +2302,50 @@ ON CONFLICT(campaign_id, character_id) DO UPDATE SET
     proficiency = excluded.proficiency,
     armor_score = excluded.armor_score,
     armor_max = excluded.armor_max,
-    experiences_json = excluded.experiences_json
+    experiences_json = excluded.experiences_json,
+    class_id = excluded.class_id,
+    subclass_id = excluded.subclass_id,
+    subclass_stage = excluded.subclass_stage,
+    multiclass_class_id = excluded.multiclass_class_id,
+    multiclass_subclass_id = excluded.multiclass_subclass_id,
+    multiclass_subclass_stage = excluded.multiclass_subclass_stage,
+    multiclass_domain_id = excluded.multiclass_domain_id,
+    marked_traits_json = excluded.marked_traits_json,
+    loadout_active_json = excluded.loadout_active_json,
+    loadout_vault_json = excluded.loadout_vault_json,
+    level_history_json = excluded.level_history_json
 `
 
 type PutDaggerheartCharacterProfileParams struct {
-	CampaignID      string `json:"campaign_id"`
-	CharacterID     string `json:"character_id"`
-	Level           int64  `json:"level"`
-	HpMax           int64  `json:"hp_max"`
-	StressMax       int64  `json:"stress_max"`
-	Evasion         int64  `json:"evasion"`
-	MajorThreshold  int64  `json:"major_threshold"`
-	SevereThreshold int64  `json:"severe_threshold"`
-	Agility         int64  `json:"agility"`
-	Strength        int64  `json:"strength"`
-	Finesse         int64  `json:"finesse"`
-	Instinct        int64  `json:"instinct"`
-	Presence        int64  `json:"presence"`
-	Knowledge       int64  `json:"knowledge"`
-	Proficiency     int64  `json:"proficiency"`
-	ArmorScore      int64  `json:"armor_score"`
-	ArmorMax        int64  `json:"armor_max"`
-	ExperiencesJson string `json:"experiences_json"`
+	CampaignID              string `json:"campaign_id"`
+	CharacterID             string `json:"character_id"`
+	Level                   int64  `json:"level"`
+	HpMax                   int64  `json:"hp_max"`
+	StressMax               int64  `json:"stress_max"`
+	Evasion                 int64  `json:"evasion"`
+	MajorThreshold          int64  `json:"major_threshold"`
+	SevereThreshold         int64  `json:"severe_threshold"`
+	Agility                 int64  `json:"agility"`
+	Strength                int64  `json:"strength"`
+	Finesse                 int64  `json:"finesse"`
+	Instinct                int64  `json:"instinct"`
+	Presence                int64  `json:"presence"`
+	Knowledge               int64  `json:"knowledge"`
+	Proficiency             int64  `json:"proficiency"`
+	ArmorScore              int64  `json:"armor_score"`
+	ArmorMax                int64  `json:"armor_max"`
+	ExperiencesJson         string `json:"experiences_json"`
+	ClassID                 string `json:"class_id"`
+	SubclassID              string `json:"subclass_id"`
+	SubclassStage           string `json:"subclass_stage"`
+	MulticlassClassID       string `json:"multiclass_class_id"`
+	MulticlassSubclassID    string `json:"multiclass_subclass_id"`
+	MulticlassSubclassStage string `json:"multiclass_subclass_stage"`
+	MulticlassDomainID      string `json:"multiclass_domain_id"`
+	MarkedTraitsJson        string `json:"marked_traits_json"`
+	LoadoutActiveJson       string `json:"loadout_active_json"`
+	LoadoutVaultJson        string `json:"loadout_vault_json"`
+	LevelHistoryJson        string `json:"level_history_json"`
 }
 
 func (q *Queries) PutDaggerheartCharacterProfile(ctx context.Context, arg PutDaggerheartCharacterProfileParams) error {
@@ -1917,14 +2368,27 @@ func (q *Queries) PutDaggerheartCharacterProfile(ctx context.Context, arg PutDag
 		arg.ArmorScore,
 		arg.ArmorMax,
 		arg.ExperiencesJson,
+		arg.ClassID,
+		arg.SubclassID,
+		arg.SubclassStage,
+		arg.MulticlassClassID,
+		arg.MulticlassSubclassID,
+		arg.MulticlassSubclassStage,
+		arg.MulticlassDomainID,
+		arg.MarkedTraitsJson,
+		arg.LoadoutActiveJson,
+		arg.LoadoutVaultJson,
+		arg.LevelHistoryJson,
 	)
 	return err
 }
 
 const putDaggerheartCharacterState = `-- name: PutDaggerheartCharacterState :exec
 INSERT INTO daggerheart_character_states (
-    campaign_id, character_id, hp, hope, hope_max, stress, armor, conditions_json, life_state
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+    campaign_id, character_id, hp, hope, hope_max, stress, armor, conditions_json, life_state,
+    gold_handfuls, gold_bags, gold_chests, inventory_json,
+    equipped_armor_id, equipped_primary_weapon_id, equipped_secondary_weapon_id, beastform_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(campaign_id, character_id) DO UPDATE SET
     hp = excluded.hp,
     hope = excluded.hope,
@@ -1932,19 +2396,35 @@ ON CONFLICT(campaign_id, character_id) DO UPDATE SET
     stress = excluded.stress,
     armor = excluded.armor,
     conditions_json = excluded.conditions_json,
-    life_state = excluded.life_state
+    life_state = excluded.life_state,
+    gold_handfuls = excluded.gold_handfuls,
+    gold_bags = excluded.gold_bags,
+    gold_chests = excluded.gold_chests,
+    inventory_json = excluded.inventory_json,
+    equipped_armor_id = excluded.equipped_armor_id,
+    equipped_primary_weapon_id = excluded.equipped_primary_weapon_id,
+    equipped_secondary_weapon_id = excluded.equipped_secondary_weapon_id,
+    beastform_id = excluded.beastform_id
 `
 
 type PutDaggerheartCharacterStateParams struct {
-	CampaignID     string `json:"campaign_id"`
-	CharacterID    string `json:"character_id"`
-	Hp             int64  `json:"hp"`
-	Hope           int64  `json:"hope"`
-	HopeMax        int64  `json:"hope_max"`
-	Stress         int64  `json:"stress"`
-	Armor          int64  `json:"armor"`
-	ConditionsJson string `json:"conditions_json"`
-	LifeState      string `json:"life_state"`
+	CampaignID                string `json:"campaign_id"`
+	CharacterID               string `json:"character_id"`
+	Hp                        int64  `json:"hp"`
+	Hope                      int64  `json:"hope"`
+	HopeMax                   int64  `json:"hope_max"`
+	Stress                    int64  `json:"stress"`
+	Armor                     int64  `json:"armor"`
+	ConditionsJson            string `json:"conditions_json"`
+	LifeState                 string `json:"life_state"`
+	GoldHandfuls              int64  `json:"gold_handfuls"`
+	GoldBags                  int64  `json:"gold_bags"`
+	GoldChests                int64  `json:"gold_chests"`
+	InventoryJson             string `json:"inventory_json"`
+	EquippedArmorID           string `json:"equipped_armor_id"`
+	EquippedPrimaryWeaponID   string `json:"equipped_primary_weapon_id"`
+	EquippedSecondaryWeaponID string `json:"equipped_secondary_weapon_id"`
+	BeastformID               string `json:"beastform_id"`
 }
 
 func (q *Queries) PutDaggerheartCharacterState(ctx context.Context, arg PutDaggerheartCharacterStateParams) error {
@@ -1958,6 +2438,50 @@ func (q *Queries) PutDaggerheartCharacterState(ctx context.Context, arg PutDagge
 		arg.Armor,
 		arg.ConditionsJson,
 		arg.LifeState,
+		arg.GoldHandfuls,
+		arg.GoldBags,
+		arg.GoldChests,
+		arg.InventoryJson,
+		arg.EquippedArmorID,
+		arg.EquippedPrimaryWeaponID,
+		arg.EquippedSecondaryWeaponID,
+		arg.BeastformID,
+	)
+	return err
+}
+
+const putDaggerheartChase = `-- name: PutDaggerheartChase :exec
+INSERT INTO daggerheart_chases (
+    campaign_id, chase_id, name, progress_countdown_id, consequence_countdown_id,
+    status, winner
+) VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, chase_id) DO UPDATE SET
+    name = excluded.name,
+    progress_countdown_id = excluded.progress_countdown_id,
+    consequence_countdown_id = excluded.consequence_countdown_id,
+    status = excluded.status,
+    winner = excluded.winner
+`
+
+type PutDaggerheartChaseParams struct {
+	CampaignID             string `json:"campaign_id"`
+	ChaseID                string `json:"chase_id"`
+	Name                   string `json:"name"`
+	ProgressCountdownID    string `json:"progress_countdown_id"`
+	ConsequenceCountdownID string `json:"consequence_countdown_id"`
+	Status                 string `json:"status"`
+	Winner                 string `json:"winner"`
+}
+
+func (q *Queries) PutDaggerheartChase(ctx context.Context, arg PutDaggerheartChaseParams) error {
+	_, err := q.db.ExecContext(ctx, putDaggerheartChase,
+		arg.CampaignID,
+		arg.ChaseID,
+		arg.Name,
+		arg.ProgressCountdownID,
+		arg.ConsequenceCountdownID,
+		arg.Status,
+		arg.Winner,
 	)
 	return err
 }
@@ -2008,6 +2532,54 @@ func (q *Queries) PutDaggerheartClass(ctx context.Context, arg PutDaggerheartCla
 	return err
 }
 
+const putDaggerheartCompanion = `-- name: PutDaggerheartCompanion :exec
+INSERT INTO daggerheart_companions (
+    campaign_id, character_id, name, evasion, stress, stress_max,
+    damage_die_sides, attack_range, experiences_json, level, upgrades_json
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, character_id) DO UPDATE SET
+    name = excluded.name,
+    evasion = excluded.evasion,
+    stress = excluded.stress,
+    stress_max = excluded.stress_max,
+    damage_die_sides = excluded.damage_die_sides,
+    attack_range = excluded.attack_range,
+    experiences_json = excluded.experiences_json,
+    level = excluded.level,
+    upgrades_json = excluded.upgrades_json
+`
+
+type PutDaggerheartCompanionParams struct {
+	CampaignID      string `json:"campaign_id"`
+	CharacterID     string `json:"character_id"`
+	Name            string `json:"name"`
+	Evasion         int64  `json:"evasion"`
+	Stress          int64  `json:"stress"`
+	StressMax       int64  `json:"stress_max"`
+	DamageDieSides  int64  `json:"damage_die_sides"`
+	AttackRange     string `json:"attack_range"`
+	ExperiencesJson string `json:"experiences_json"`
+	Level           int64  `json:"level"`
+	UpgradesJson    string `json:"upgrades_json"`
+}
+
+func (q *Queries) PutDaggerheartCompanion(ctx context.Context, arg PutDaggerheartCompanionParams) error {
+	_, err := q.db.ExecContext(ctx, putDaggerheartCompanion,
+		arg.CampaignID,
+		arg.CharacterID,
+		arg.Name,
+		arg.Evasion,
+		arg.Stress,
+		arg.StressMax,
+		arg.DamageDieSides,
+		arg.AttackRange,
+		arg.ExperiencesJson,
+		arg.Level,
+		arg.UpgradesJson,
+	)
+	return err
+}
+
 const putDaggerheartCompanionExperience = `-- name: PutDaggerheartCompanionExperience :exec
 INSERT INTO daggerheart_companion_experiences (
     id, name, description, created_at, updated_at
@@ -2076,26 +2648,33 @@ func (q *Queries) PutDaggerheartContentString(ctx context.Context, arg PutDagger
 
 const putDaggerheartCountdown = `-- name: PutDaggerheartCountdown :exec
 INSERT INTO daggerheart_countdowns (
-    campaign_id, countdown_id, name, kind, current, max, direction, looping
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    campaign_id, countdown_id, name, kind, current, max, direction, looping,
+    tick_trigger, tick_delta, tick_outcome_deltas_json
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(campaign_id, countdown_id) DO UPDATE SET
     name = excluded.name,
     kind = excluded.kind,
     current = excluded.current,
     max = excluded.max,
     direction = excluded.direction,
-    looping = excluded.looping
+    looping = excluded.looping,
+    tick_trigger = excluded.tick_trigger,
+    tick_delta = excluded.tick_delta,
+    tick_outcome_deltas_json = excluded.tick_outcome_deltas_json
 `
 
 type PutDaggerheartCountdownParams struct {
-	CampaignID  string `json:"campaign_id"`
-	CountdownID string `json:"countdown_id"`
-	Name        string `json:"name"`
-	Kind        string `json:"kind"`
-	Current     int64  `json:"current"`
-	Max         int64  `json:"max"`
-	Direction   string `json:"direction"`
-	Looping     int64  `json:"looping"`
+	CampaignID            string `json:"campaign_id"`
+	CountdownID           string `json:"countdown_id"`
+	Name                  string `json:"name"`
+	Kind                  string `json:"kind"`
+	Current               int64  `json:"current"`
+	Max                   int64  `json:"max"`
+	Direction             string `json:"direction"`
+	Looping               int64  `json:"looping"`
+	TickTrigger           string `json:"tick_trigger"`
+	TickDelta             int64  `json:"tick_delta"`
+	TickOutcomeDeltasJson string `json:"tick_outcome_deltas_json"`
 }
 
 func (q *Queries) PutDaggerheartCountdown(ctx context.Context, arg PutDaggerheartCountdownParams) error {
@@ -2108,6 +2687,9 @@ func (q *Queries) PutDaggerheartCountdown(ctx context.Context, arg PutDaggerhear
 		arg.Max,
 		arg.Direction,
 		arg.Looping,
+		arg.TickTrigger,
+		arg.TickDelta,
+		arg.TickOutcomeDeltasJson,
 	)
 	return err
 }
@@ -2217,6 +2799,51 @@ func (q *Queries) PutDaggerheartDomainCard(ctx context.Context, arg PutDaggerhea
 	return err
 }
 
+const putDaggerheartEffect = `-- name: PutDaggerheartEffect :exec
+INSERT INTO daggerheart_effects (
+    campaign_id, effect_id, session_id, target_type, target_id, source,
+    kind, value, stacking, expires_on
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, effect_id) DO UPDATE SET
+    session_id = excluded.session_id,
+    target_type = excluded.target_type,
+    target_id = excluded.target_id,
+    source = excluded.source,
+    kind = excluded.kind,
+    value = excluded.value,
+    stacking = excluded.stacking,
+    expires_on = excluded.expires_on
+`
+
+type PutDaggerheartEffectParams struct {
+	CampaignID string `json:"campaign_id"`
+	EffectID   string `json:"effect_id"`
+	SessionID  string `json:"session_id"`
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	Source     string `json:"source"`
+	Kind       string `json:"kind"`
+	Value      int64  `json:"value"`
+	Stacking   string `json:"stacking"`
+	ExpiresOn  string `json:"expires_on"`
+}
+
+func (q *Queries) PutDaggerheartEffect(ctx context.Context, arg PutDaggerheartEffectParams) error {
+	_, err := q.db.ExecContext(ctx, putDaggerheartEffect,
+		arg.CampaignID,
+		arg.EffectID,
+		arg.SessionID,
+		arg.TargetType,
+		arg.TargetID,
+		arg.Source,
+		arg.Kind,
+		arg.Value,
+		arg.Stacking,
+		arg.ExpiresOn,
+	)
+	return err
+}
+
 const putDaggerheartEnvironment = `-- name: PutDaggerheartEnvironment :exec
 INSERT INTO daggerheart_environments (
     id, name, tier, type, difficulty, impulses_json, potential_adversary_ids_json, features_json, prompts_json,
@@ -2404,6 +3031,74 @@ func (q *Queries) PutDaggerheartLootEntry(ctx context.Context, arg PutDaggerhear
 	return err
 }
 
+const putDaggerheartSceneRange = `-- name: PutDaggerheartSceneRange :exec
+INSERT INTO daggerheart_scene_ranges (
+    campaign_id, session_id, from_id, from_type, to_id, to_type, band
+) VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, session_id, from_id, to_id) DO UPDATE SET
+    from_type = excluded.from_type,
+    to_type = excluded.to_type,
+    band = excluded.band
+`
+
+type PutDaggerheartSceneRangeParams struct {
+	CampaignID string `json:"campaign_id"`
+	SessionID  string `json:"session_id"`
+	FromID     string `json:"from_id"`
+	FromType   string `json:"from_type"`
+	ToID       string `json:"to_id"`
+	ToType     string `json:"to_type"`
+	Band       string `json:"band"`
+}
+
+func (q *Queries) PutDaggerheartSceneRange(ctx context.Context, arg PutDaggerheartSceneRangeParams) error {
+	_, err := q.db.ExecContext(ctx, putDaggerheartSceneRange,
+		arg.CampaignID,
+		arg.SessionID,
+		arg.FromID,
+		arg.FromType,
+		arg.ToID,
+		arg.ToType,
+		arg.Band,
+	)
+	return err
+}
+
+const putDaggerheartSessionEnvironment = `-- name: PutDaggerheartSessionEnvironment :exec
+INSERT INTO daggerheart_session_environments (
+    campaign_id, session_id, environment_id, name, tier, type, difficulty
+) VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, session_id) DO UPDATE SET
+    environment_id = excluded.environment_id,
+    name = excluded.name,
+    tier = excluded.tier,
+    type = excluded.type,
+    difficulty = excluded.difficulty
+`
+
+type PutDaggerheartSessionEnvironmentParams struct {
+	CampaignID    string `json:"campaign_id"`
+	SessionID     string `json:"session_id"`
+	EnvironmentID string `json:"environment_id"`
+	Name          string `json:"name"`
+	Tier          int64  `json:"tier"`
+	Type          string `json:"type"`
+	Difficulty    int64  `json:"difficulty"`
+}
+
+func (q *Queries) PutDaggerheartSessionEnvironment(ctx context.Context, arg PutDaggerheartSessionEnvironmentParams) error {
+	_, err := q.db.ExecContext(ctx, putDaggerheartSessionEnvironment,
+		arg.CampaignID,
+		arg.SessionID,
+		arg.EnvironmentID,
+		arg.Name,
+		arg.Tier,
+		arg.Type,
+		arg.Difficulty,
+	)
+	return err
+}
+
 const putDaggerheartSnapshot = `-- name: PutDaggerheartSnapshot :exec
 INSERT INTO daggerheart_snapshots (campaign_id, gm_fear, consecutive_short_rests)
 VALUES (?, ?, ?)
@@ -15,8 +15,8 @@ const appendEvent = `-- name: AppendEvent :exec
 INSERT INTO events (
     campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature, timestamp, event_type,
     session_id, request_id, invocation_id,
-    actor_type, actor_id, entity_type, entity_id, system_id, system_version, payload_json
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    actor_type, actor_id, entity_type, entity_id, system_id, system_version, payload_json, payload_version
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type AppendEventParams struct {
@@ -39,6 +39,7 @@ type AppendEventParams struct {
 	SystemID       string `json:"system_id"`
 	SystemVersion  string `json:"system_version"`
 	PayloadJson    []byte `json:"payload_json"`
+	PayloadVersion int64  `json:"payload_version"`
 }
 
 // Unified Events Table Queries
@@ -63,6 +64,7 @@ func (q *Queries) AppendEvent(ctx context.Context, arg AppendEventParams) error
 		arg.SystemID,
 		arg.SystemVersion,
 		arg.PayloadJson,
+		arg.PayloadVersion,
 	)
 	return err
 }
@@ -110,7 +112,7 @@ func (q *Queries) GetCampaignForkMetadata(ctx context.Context, id string) (GetCa
 const getEventByHash = `-- name: GetEventByHash :one
 SELECT campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature,
     timestamp, event_type, session_id, request_id, invocation_id, actor_type, actor_id,
-    entity_type, entity_id, system_id, system_version, payload_json
+    entity_type, entity_id, system_id, system_version, payload_json, payload_version
 FROM events WHERE event_hash = ?
 `
 
@@ -134,6 +136,7 @@ type GetEventByHashRow struct {
 	SystemID       string `json:"system_id"`
 	SystemVersion  string `json:"system_version"`
 	PayloadJson    []byte `json:"payload_json"`
+	PayloadVersion int64  `json:"payload_version"`
 }
 
 func (q *Queries) GetEventByHash(ctx context.Context, eventHash string) (GetEventByHashRow, error) {
@@ -159,6 +162,7 @@ func (q *Queries) GetEventByHash(ctx context.Context, eventHash string) (GetEven
 		&i.SystemID,
 		&i.SystemVersion,
 		&i.PayloadJson,
+		&i.PayloadVersion,
 	)
 	return i, err
 }
@@ -166,7 +170,7 @@ func (q *Queries) GetEventByHash(ctx context.Context, eventHash string) (GetEven
 const getEventBySeq = `-- name: GetEventBySeq :one
 SELECT campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature,
     timestamp, event_type, session_id, request_id, invocation_id, actor_type, actor_id,
-    entity_type, entity_id, system_id, system_version, payload_json
+    entity_type, entity_id, system_id, system_version, payload_json, payload_version
 FROM events WHERE campaign_id = ? AND seq = ?
 `
 
@@ -195,6 +199,7 @@ type GetEventBySeqRow struct {
 	SystemID       string `json:"system_id"`
 	SystemVersion  string `json:"system_version"`
 	PayloadJson    []byte `json:"payload_json"`
+	PayloadVersion int64  `json:"payload_version"`
 }
 
 func (q *Queries) GetEventBySeq(ctx context.Context, arg GetEventBySeqParams) (GetEventBySeqRow, error) {
@@ -220,6 +225,7 @@ func (q *Queries) GetEventBySeq(ctx context.Context, arg GetEventBySeqParams) (G
 		&i.SystemID,
 		&i.SystemVersion,
 		&i.PayloadJson,
+		&i.PayloadVersion,
 	)
 	return i, err
 }
@@ -314,10 +320,52 @@ func (q *Queries) InitEventSeq(ctx context.Context, campaignID string) error {
 	return err
 }
 
+const listEventPayloadVersions = `-- name: ListEventPayloadVersions :many
+SELECT campaign_id, event_type, payload_version, COUNT(*) AS event_count
+FROM events
+GROUP BY campaign_id, event_type, payload_version
+ORDER BY event_type, payload_version, campaign_id
+`
+
+type ListEventPayloadVersionsRow struct {
+	CampaignID     string `json:"campaign_id"`
+	EventType      string `json:"event_type"`
+	PayloadVersion int64  `json:"payload_version"`
+	EventCount     int64  `json:"event_count"`
+}
+
+func (q *Queries) ListEventPayloadVersions(ctx context.Context) ([]ListEventPayloadVersionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEventPayloadVersions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListEventPayloadVersionsRow{}
+	for rows.Next() {
+		var i ListEventPayloadVersionsRow
+		if err := rows.Scan(
+			&i.CampaignID,
+			&i.EventType,
+			&i.PayloadVersion,
+			&i.EventCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listEvents = `-- name: ListEvents :many
 SELECT campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature,
     timestamp, event_type, session_id, request_id, invocation_id, actor_type, actor_id,
-    entity_type, entity_id, system_id, system_version, payload_json
+    entity_type, entity_id, system_id, system_version, payload_json, payload_version
 FROM events
 WHERE campaign_id = ? AND seq > ?
 ORDER BY seq
@@ -350,6 +398,7 @@ type ListEventsRow struct {
 	SystemID       string `json:"system_id"`
 	SystemVersion  string `json:"system_version"`
 	PayloadJson    []byte `json:"payload_json"`
+	PayloadVersion int64  `json:"payload_version"`
 }
 
 func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]ListEventsRow, error) {
@@ -381,6 +430,7 @@ func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]ListE
 			&i.SystemID,
 			&i.SystemVersion,
 			&i.PayloadJson,
+			&i.PayloadVersion,
 		); err != nil {
 			return nil, err
 		}
@@ -398,7 +448,7 @@ func (q *Queries) ListEvents(ctx context.Context, arg ListEventsParams) ([]ListE
 const listEventsBySession = `-- name: ListEventsBySession :many
 SELECT campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature,
     timestamp, event_type, session_id, request_id, invocation_id, actor_type, actor_id,
-    entity_type, entity_id, system_id, system_version, payload_json
+    entity_type, entity_id, system_id, system_version, payload_json, payload_version
 FROM events
 WHERE campaign_id = ? AND session_id = ? AND seq > ?
 ORDER BY seq
@@ -432,6 +482,7 @@ type ListEventsBySessionRow struct {
 	SystemID       string `json:"system_id"`
 	SystemVersion  string `json:"system_version"`
 	PayloadJson    []byte `json:"payload_json"`
+	PayloadVersion int64  `json:"payload_version"`
 }
 
 func (q *Queries) ListEventsBySession(ctx context.Context, arg ListEventsBySessionParams) ([]ListEventsBySessionRow, error) {
@@ -468,6 +519,7 @@ func (q *Queries) ListEventsBySession(ctx context.Context, arg ListEventsBySessi
 			&i.SystemID,
 			&i.SystemVersion,
 			&i.PayloadJson,
+			&i.PayloadVersion,
 		); err != nil {
 			return nil, err
 		}
@@ -46,23 +46,29 @@ type Character struct {
 }
 
 type DaggerheartAdversary struct {
-	CampaignID      string         `json:"campaign_id"`
-	AdversaryID     string         `json:"adversary_id"`
-	Name            string         `json:"name"`
-	Kind            string         `json:"kind"`
-	SessionID       sql.NullString `json:"session_id"`
-	Notes           string         `json:"notes"`
-	Hp              int64          `json:"hp"`
-	HpMax           int64          `json:"hp_max"`
-	Stress          int64          `json:"stress"`
-	StressMax       int64          `json:"stress_max"`
-	Evasion         int64          `json:"evasion"`
-	MajorThreshold  int64          `json:"major_threshold"`
-	SevereThreshold int64          `json:"severe_threshold"`
-	Armor           int64          `json:"armor"`
-	ConditionsJson  string         `json:"conditions_json"`
-	CreatedAt       int64          `json:"created_at"`
-	UpdatedAt       int64          `json:"updated_at"`
+	CampaignID         string         `json:"campaign_id"`
+	AdversaryID        string         `json:"adversary_id"`
+	Name               string         `json:"name"`
+	Kind               string         `json:"kind"`
+	SessionID          sql.NullString `json:"session_id"`
+	Notes              string         `json:"notes"`
+	Hp                 int64          `json:"hp"`
+	HpMax              int64          `json:"hp_max"`
+	Stress             int64          `json:"stress"`
+	StressMax          int64          `json:"stress_max"`
+	Evasion            int64          `json:"evasion"`
+	MajorThreshold     int64          `json:"major_threshold"`
+	SevereThreshold    int64          `json:"severe_threshold"`
+	Armor              int64          `json:"armor"`
+	ConditionsJson     string         `json:"conditions_json"`
+	MinionThreshold    int64          `json:"minion_threshold"`
+	AdversaryEntryID   string         `json:"adversary_entry_id"`
+	AttackModifier     int64          `json:"attack_modifier"`
+	StandardAttackJson string         `json:"standard_attack_json"`
+	ExperiencesJson    string         `json:"experiences_json"`
+	FeaturesJson       string         `json:"features_json"`
+	CreatedAt          int64          `json:"created_at"`
+	UpdatedAt          int64          `json:"updated_at"`
 }
 
 type DaggerheartAdversaryEntry struct {
@@ -86,6 +92,14 @@ type DaggerheartAdversaryEntry struct {
 	UpdatedAt          int64  `json:"updated_at"`
 }
 
+type DaggerheartAdversaryFeatureState struct {
+	CampaignID  string `json:"campaign_id"`
+	AdversaryID string `json:"adversary_id"`
+	FeatureID   string `json:"feature_id"`
+	Status      string `json:"status"`
+	RefreshOn   string `json:"refresh_on"`
+}
+
 type DaggerheartArmor struct {
 	ID                  string `json:"id"`
 	Name                string `json:"name"`
@@ -114,36 +128,65 @@ type DaggerheartBeastform struct {
 }
 
 type DaggerheartCharacterProfile struct {
-	CampaignID      string `json:"campaign_id"`
-	CharacterID     string `json:"character_id"`
-	Level           int64  `json:"level"`
-	HpMax           int64  `json:"hp_max"`
-	StressMax       int64  `json:"stress_max"`
-	Evasion         int64  `json:"evasion"`
-	MajorThreshold  int64  `json:"major_threshold"`
-	SevereThreshold int64  `json:"severe_threshold"`
-	Agility         int64  `json:"agility"`
-	Strength        int64  `json:"strength"`
-	Finesse         int64  `json:"finesse"`
-	Instinct        int64  `json:"instinct"`
-	Presence        int64  `json:"presence"`
-	Knowledge       int64  `json:"knowledge"`
-	Proficiency     int64  `json:"proficiency"`
-	ArmorScore      int64  `json:"armor_score"`
-	ArmorMax        int64  `json:"armor_max"`
-	ExperiencesJson string `json:"experiences_json"`
+	CampaignID              string `json:"campaign_id"`
+	CharacterID             string `json:"character_id"`
+	Level                   int64  `json:"level"`
+	HpMax                   int64  `json:"hp_max"`
+	StressMax               int64  `json:"stress_max"`
+	Evasion                 int64  `json:"evasion"`
+	MajorThreshold          int64  `json:"major_threshold"`
+	SevereThreshold         int64  `json:"severe_threshold"`
+	Agility                 int64  `json:"agility"`
+	Strength                int64  `json:"strength"`
+	Finesse                 int64  `json:"finesse"`
+	Instinct                int64  `json:"instinct"`
+	Presence                int64  `json:"presence"`
+	Knowledge               int64  `json:"knowledge"`
+	Proficiency             int64  `json:"proficiency"`
+	ArmorScore              int64  `json:"armor_score"`
+	ArmorMax                int64  `json:"armor_max"`
+	ExperiencesJson         string `json:"experiences_json"`
+	ClassID                 string `json:"class_id"`
+	SubclassID              string `json:"subclass_id"`
+	SubclassStage           string `json:"subclass_stage"`
+	MulticlassClassID       string `json:"multiclass_class_id"`
+	MulticlassSubclassID    string `json:"multiclass_subclass_id"`
+	MulticlassSubclassStage string `json:"multiclass_subclass_stage"`
+	MulticlassDomainID      string `json:"multiclass_domain_id"`
+	MarkedTraitsJson        string `json:"marked_traits_json"`
+	LoadoutActiveJson       string `json:"loadout_active_json"`
+	LoadoutVaultJson        string `json:"loadout_vault_json"`
+	LevelHistoryJson        string `json:"level_history_json"`
 }
 
 type DaggerheartCharacterState struct {
-	CampaignID     string `json:"campaign_id"`
-	CharacterID    string `json:"character_id"`
-	Hp             int64  `json:"hp"`
-	Hope           int64  `json:"hope"`
-	HopeMax        int64  `json:"hope_max"`
-	Stress         int64  `json:"stress"`
-	Armor          int64  `json:"armor"`
-	ConditionsJson string `json:"conditions_json"`
-	LifeState      string `json:"life_state"`
+	CampaignID                string `json:"campaign_id"`
+	CharacterID               string `json:"character_id"`
+	Hp                        int64  `json:"hp"`
+	Hope                      int64  `json:"hope"`
+	HopeMax                   int64  `json:"hope_max"`
+	Stress                    int64  `json:"stress"`
+	Armor                     int64  `json:"armor"`
+	ConditionsJson            string `json:"conditions_json"`
+	LifeState                 string `json:"life_state"`
+	GoldHandfuls              int64  `json:"gold_handfuls"`
+	GoldBags                  int64  `json:"gold_bags"`
+	GoldChests                int64  `json:"gold_chests"`
+	InventoryJson             string `json:"inventory_json"`
+	EquippedArmorID           string `json:"equipped_armor_id"`
+	EquippedPrimaryWeaponID   string `json:"equipped_primary_weapon_id"`
+	EquippedSecondaryWeaponID string `json:"equipped_secondary_weapon_id"`
+	BeastformID               string `json:"beastform_id"`
+}
+
+type DaggerheartChase struct {
+	CampaignID             string `json:"campaign_id"`
+	ChaseID                string `json:"chase_id"`
+	Name                   string `json:"name"`
+	ProgressCountdownID    string `json:"progress_countdown_id"`
+	ConsequenceCountdownID string `json:"consequence_countdown_id"`
+	Status                 string `json:"status"`
+	Winner                 string `json:"winner"`
 }
 
 type DaggerheartClass struct {
@@ -159,6 +202,20 @@ type DaggerheartClass struct {
 	UpdatedAt         int64  `json:"updated_at"`
 }
 
+type DaggerheartCompanion struct {
+	CampaignID      string `json:"campaign_id"`
+	CharacterID     string `json:"character_id"`
+	Name            string `json:"name"`
+	Evasion         int64  `json:"evasion"`
+	Stress          int64  `json:"stress"`
+	StressMax       int64  `json:"stress_max"`
+	DamageDieSides  int64  `json:"damage_die_sides"`
+	AttackRange     string `json:"attack_range"`
+	ExperiencesJson string `json:"experiences_json"`
+	Level           int64  `json:"level"`
+	UpgradesJson    string `json:"upgrades_json"`
+}
+
 type DaggerheartCompanionExperience struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
@@ -178,14 +235,17 @@ type DaggerheartContentString struct {
 }
 
 type DaggerheartCountdown struct {
-	CampaignID  string `json:"campaign_id"`
-	CountdownID string `json:"countdown_id"`
-	Name        string `json:"name"`
-	Kind        string `json:"kind"`
-	Current     int64  `json:"current"`
-	Max         int64  `json:"max"`
-	Direction   string `json:"direction"`
-	Looping     int64  `json:"looping"`
+	CampaignID            string `json:"campaign_id"`
+	CountdownID           string `json:"countdown_id"`
+	Name                  string `json:"name"`
+	Kind                  string `json:"kind"`
+	Current               int64  `json:"current"`
+	Max                   int64  `json:"max"`
+	Direction             string `json:"direction"`
+	Looping               int64  `json:"looping"`
+	TickTrigger           string `json:"tick_trigger"`
+	TickDelta             int64  `json:"tick_delta"`
+	TickOutcomeDeltasJson string `json:"tick_outcome_deltas_json"`
 }
 
 type DaggerheartDamageType struct {
@@ -217,6 +277,19 @@ type DaggerheartDomainCard struct {
 	UpdatedAt   int64  `json:"updated_at"`
 }
 
+type DaggerheartEffect struct {
+	CampaignID string `json:"campaign_id"`
+	EffectID   string `json:"effect_id"`
+	SessionID  string `json:"session_id"`
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	Source     string `json:"source"`
+	Kind       string `json:"kind"`
+	Value      int64  `json:"value"`
+	Stacking   string `json:"stacking"`
+	ExpiresOn  string `json:"expires_on"`
+}
+
 type DaggerheartEnvironment struct {
 	ID                        string `json:"id"`
 	Name                      string `json:"name"`
@@ -269,6 +342,26 @@ type DaggerheartLootEntry struct {
 	UpdatedAt   int64  `json:"updated_at"`
 }
 
+type DaggerheartSceneRange struct {
+	CampaignID string `json:"campaign_id"`
+	SessionID  string `json:"session_id"`
+	FromID     string `json:"from_id"`
+	FromType   string `json:"from_type"`
+	ToID       string `json:"to_id"`
+	ToType     string `json:"to_type"`
+	Band       string `json:"band"`
+}
+
+type DaggerheartSessionEnvironment struct {
+	CampaignID    string `json:"campaign_id"`
+	SessionID     string `json:"session_id"`
+	EnvironmentID string `json:"environment_id"`
+	Name          string `json:"name"`
+	Tier          int64  `json:"tier"`
+	Type          string `json:"type"`
+	Difficulty    int64  `json:"difficulty"`
+}
+
 type DaggerheartSnapshot struct {
 	CampaignID            string `json:"campaign_id"`
 	GmFear                int64  `json:"gm_fear"`
@@ -321,6 +414,7 @@ type Event struct {
 	EntityType     string `json:"entity_type"`
 	EntityID       string `json:"entity_id"`
 	PayloadJson    []byte `json:"payload_json"`
+	PayloadVersion int64  `json:"payload_version"`
 }
 
 type EventSeq struct {
@@ -328,6 +422,22 @@ type EventSeq struct {
 	NextSeq    int64  `json:"next_seq"`
 }
 
+type IdempotencyKey struct {
+	Caller         string `json:"caller"`
+	IdempotencyKey string `json:"idempotency_key"`
+	RpcMethod      string `json:"rpc_method"`
+	RequestHash    string `json:"request_hash"`
+	Completed      int64  `json:"completed"`
+	CampaignID     string `json:"campaign_id"`
+	FirstSeq       int64  `json:"first_seq"`
+	LastSeq        int64  `json:"last_seq"`
+	ResponseType   string `json:"response_type"`
+	Response       []byte `json:"response"`
+	Status         []byte `json:"status"`
+	CreatedAt      int64  `json:"created_at"`
+	ExpiresAt      int64  `json:"expires_at"`
+}
+
 type Invite struct {
 	ID                     string `json:"id"`
 	CampaignID             string `json:"campaign_id"`
@@ -364,6 +474,15 @@ type ParticipantClaim struct {
 	ClaimedAt     int64  `json:"claimed_at"`
 }
 
+type ProjectionCheckpoint struct {
+	CampaignID string `json:"campaign_id"`
+	Seq        int64  `json:"seq"`
+	ChainHash  string `json:"chain_hash"`
+	StateHash  string `json:"state_hash"`
+	State      []byte `json:"state"`
+	CreatedAt  int64  `json:"created_at"`
+}
+
 type Session struct {
 	CampaignID string        `json:"campaign_id"`
 	ID         string        `json:"id"`
@@ -0,0 +1,21 @@
+DROP INDEX IF EXISTS idx_idempotency_keys_expires_at;
+DROP TABLE IF EXISTS idempotency_keys;
+
+CREATE TABLE idempotency_keys (
+    caller TEXT NOT NULL DEFAULT '',
+    idempotency_key TEXT NOT NULL,
+    rpc_method TEXT NOT NULL,
+    request_hash TEXT NOT NULL,
+    completed INTEGER NOT NULL DEFAULT 0,
+    campaign_id TEXT NOT NULL DEFAULT '',
+    first_seq INTEGER NOT NULL DEFAULT 0,
+    last_seq INTEGER NOT NULL DEFAULT 0,
+    response_type TEXT NOT NULL DEFAULT '',
+    response BLOB,
+    status BLOB,
+    created_at INTEGER NOT NULL,
+    expires_at INTEGER NOT NULL,
+    PRIMARY KEY (caller, idempotency_key, rpc_method)
+);
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
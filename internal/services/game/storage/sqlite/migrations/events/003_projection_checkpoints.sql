@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS projection_checkpoints;
+
+CREATE TABLE projection_checkpoints (
+    campaign_id TEXT NOT NULL,
+    seq INTEGER NOT NULL,
+    chain_hash TEXT NOT NULL,
+    state_hash TEXT NOT NULL,
+    state BLOB NOT NULL,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (campaign_id, seq)
+);
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS daggerheart_scene_ranges;
+
+CREATE TABLE daggerheart_scene_ranges (
+    campaign_id TEXT NOT NULL,
+    session_id TEXT NOT NULL,
+    from_id TEXT NOT NULL,
+    from_type TEXT NOT NULL,
+    to_id TEXT NOT NULL,
+    to_type TEXT NOT NULL,
+    band TEXT NOT NULL,
+    PRIMARY KEY (campaign_id, session_id, from_id, to_id),
+    FOREIGN KEY (campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS daggerheart_session_environments;
+
+CREATE TABLE daggerheart_session_environments (
+    campaign_id TEXT NOT NULL,
+    session_id TEXT NOT NULL,
+    environment_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    tier INTEGER NOT NULL DEFAULT 0,
+    type TEXT NOT NULL DEFAULT '',
+    difficulty INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (campaign_id, session_id),
+    FOREIGN KEY (campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS daggerheart_companions;
+
+CREATE TABLE daggerheart_companions (
+    campaign_id TEXT NOT NULL,
+    character_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    evasion INTEGER NOT NULL DEFAULT 10,
+    stress INTEGER NOT NULL DEFAULT 0,
+    stress_max INTEGER NOT NULL DEFAULT 3,
+    damage_die_sides INTEGER NOT NULL DEFAULT 6,
+    attack_range TEXT NOT NULL DEFAULT 'melee',
+    experiences_json TEXT NOT NULL DEFAULT '[]',
+    level INTEGER NOT NULL DEFAULT 1,
+    upgrades_json TEXT NOT NULL DEFAULT '[]',
+    PRIMARY KEY (campaign_id, character_id),
+    FOREIGN KEY (campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
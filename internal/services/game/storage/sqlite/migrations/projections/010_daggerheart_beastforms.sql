@@ -0,0 +1,24 @@
+DROP TABLE IF EXISTS daggerheart_character_states;
+
+CREATE TABLE daggerheart_character_states (
+    campaign_id TEXT NOT NULL,
+    character_id TEXT NOT NULL,
+    hp INTEGER NOT NULL DEFAULT 6,
+    hope INTEGER NOT NULL DEFAULT 2,
+    hope_max INTEGER NOT NULL DEFAULT 6,
+    stress INTEGER NOT NULL DEFAULT 0,
+    armor INTEGER NOT NULL DEFAULT 0,
+    conditions_json TEXT NOT NULL DEFAULT '[]',
+    life_state TEXT NOT NULL DEFAULT 'alive',
+    gold_handfuls INTEGER NOT NULL DEFAULT 0,
+    gold_bags INTEGER NOT NULL DEFAULT 0,
+    gold_chests INTEGER NOT NULL DEFAULT 0,
+    inventory_json TEXT NOT NULL DEFAULT '[]',
+    equipped_armor_id TEXT NOT NULL DEFAULT '',
+    equipped_primary_weapon_id TEXT NOT NULL DEFAULT '',
+    equipped_secondary_weapon_id TEXT NOT NULL DEFAULT '',
+    beastform_id TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (campaign_id, character_id),
+    FOREIGN KEY (campaign_id, character_id)
+        REFERENCES characters(campaign_id, id) ON DELETE CASCADE
+);
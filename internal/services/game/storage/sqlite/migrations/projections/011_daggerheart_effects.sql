@@ -0,0 +1,18 @@
+DROP TABLE IF EXISTS daggerheart_effects;
+
+CREATE TABLE daggerheart_effects (
+    campaign_id TEXT NOT NULL,
+    effect_id TEXT NOT NULL,
+    session_id TEXT NOT NULL DEFAULT '',
+    target_type TEXT NOT NULL,
+    target_id TEXT NOT NULL,
+    source TEXT NOT NULL DEFAULT '',
+    kind TEXT NOT NULL,
+    value INTEGER NOT NULL DEFAULT 0,
+    stacking TEXT NOT NULL DEFAULT 'stack',
+    expires_on TEXT NOT NULL DEFAULT 'manual',
+    PRIMARY KEY (campaign_id, effect_id),
+    FOREIGN KEY (campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_daggerheart_effects_target ON daggerheart_effects(campaign_id, target_id);
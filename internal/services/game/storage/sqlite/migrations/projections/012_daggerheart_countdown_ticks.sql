@@ -0,0 +1,16 @@
+DROP TABLE IF EXISTS daggerheart_countdowns;
+
+CREATE TABLE daggerheart_countdowns (
+    campaign_id TEXT NOT NULL,
+    countdown_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    current INTEGER NOT NULL,
+    max INTEGER NOT NULL,
+    direction TEXT NOT NULL,
+    looping INTEGER NOT NULL DEFAULT 0,
+    tick_trigger TEXT NOT NULL DEFAULT '',
+    tick_delta INTEGER NOT NULL DEFAULT 0,
+    tick_outcome_deltas_json TEXT NOT NULL DEFAULT '{}',
+    PRIMARY KEY (campaign_id, countdown_id)
+);
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS daggerheart_chases;
+
+CREATE TABLE daggerheart_chases (
+    campaign_id TEXT NOT NULL,
+    chase_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    progress_countdown_id TEXT NOT NULL,
+    consequence_countdown_id TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'active',
+    winner TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (campaign_id, chase_id),
+    FOREIGN KEY (campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS daggerheart_adversary_feature_states;
+
+CREATE TABLE daggerheart_adversary_feature_states (
+    campaign_id TEXT NOT NULL,
+    adversary_id TEXT NOT NULL,
+    feature_id TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'ready',
+    refresh_on TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (campaign_id, adversary_id, feature_id),
+    FOREIGN KEY (campaign_id) REFERENCES campaigns(id) ON DELETE CASCADE
+);
@@ -0,0 +1,32 @@
+-- name: PutProjectionCheckpoint :exec
+INSERT INTO projection_checkpoints (
+  campaign_id,
+  seq,
+  chain_hash,
+  state_hash,
+  state,
+  created_at
+) VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (campaign_id, seq) DO UPDATE SET
+  chain_hash = excluded.chain_hash,
+  state_hash = excluded.state_hash,
+  state = excluded.state,
+  created_at = excluded.created_at;
+
+-- name: GetProjectionCheckpoint :one
+SELECT * FROM projection_checkpoints
+WHERE campaign_id = ? AND seq <= ?
+ORDER BY seq DESC
+LIMIT 1;
+
+-- name: PruneProjectionCheckpoints :exec
+DELETE FROM projection_checkpoints
+WHERE projection_checkpoints.campaign_id = sqlc.arg(campaign_id) AND seq NOT IN (
+  SELECT kept.seq FROM projection_checkpoints AS kept
+  WHERE kept.campaign_id = sqlc.arg(campaign_id)
+  ORDER BY kept.seq DESC
+  LIMIT sqlc.arg(keep)
+);
+
+-- name: ListEventCampaignIDs :many
+SELECT campaign_id FROM event_seq ORDER BY campaign_id;
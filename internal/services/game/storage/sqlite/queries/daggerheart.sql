@@ -10,8 +10,10 @@ WHERE campaign_id = ? AND character_id = ?;
 INSERT INTO daggerheart_character_profiles (
     campaign_id, character_id, level, hp_max, stress_max, evasion, major_threshold, severe_threshold,
     agility, strength, finesse, instinct, presence, knowledge, proficiency, armor_score, armor_max,
-    experiences_json
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    experiences_json, class_id, subclass_id, subclass_stage, multiclass_class_id, multiclass_subclass_id,
+    multiclass_subclass_stage, multiclass_domain_id, marked_traits_json, loadout_active_json, loadout_vault_json,
+    level_history_json
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(campaign_id, character_id) DO UPDATE SET
     level = excluded.level,
     hp_max = excluded.hp_max,
@@ -28,7 +30,18 @@ ON CONFLICT(campaign_id, character_id) DO UPDATE SET
     proficiency = excluded.proficiency,
     armor_score = excluded.armor_score,
     armor_max = excluded.armor_max,
-    experiences_json = excluded.experiences_json;
+    experiences_json = excluded.experiences_json,
+    class_id = excluded.class_id,
+    subclass_id = excluded.subclass_id,
+    subclass_stage = excluded.subclass_stage,
+    multiclass_class_id = excluded.multiclass_class_id,
+    multiclass_subclass_id = excluded.multiclass_subclass_id,
+    multiclass_subclass_stage = excluded.multiclass_subclass_stage,
+    multiclass_domain_id = excluded.multiclass_domain_id,
+    marked_traits_json = excluded.marked_traits_json,
+    loadout_active_json = excluded.loadout_active_json,
+    loadout_vault_json = excluded.loadout_vault_json,
+    level_history_json = excluded.level_history_json;
 
 -- name: DeleteDaggerheartCharacterProfile :exec
 DELETE FROM daggerheart_character_profiles
@@ -42,8 +55,10 @@ WHERE campaign_id = ? AND character_id = ?;
 
 -- name: PutDaggerheartCharacterState :exec
 INSERT INTO daggerheart_character_states (
-    campaign_id, character_id, hp, hope, hope_max, stress, armor, conditions_json, life_state
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+    campaign_id, character_id, hp, hope, hope_max, stress, armor, conditions_json, life_state,
+    gold_handfuls, gold_bags, gold_chests, inventory_json,
+    equipped_armor_id, equipped_primary_weapon_id, equipped_secondary_weapon_id, beastform_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(campaign_id, character_id) DO UPDATE SET
     hp = excluded.hp,
     hope = excluded.hope,
@@ -51,7 +66,15 @@ ON CONFLICT(campaign_id, character_id) DO UPDATE SET
     stress = excluded.stress,
     armor = excluded.armor,
     conditions_json = excluded.conditions_json,
-    life_state = excluded.life_state;
+    life_state = excluded.life_state,
+    gold_handfuls = excluded.gold_handfuls,
+    gold_bags = excluded.gold_bags,
+    gold_chests = excluded.gold_chests,
+    inventory_json = excluded.inventory_json,
+    equipped_armor_id = excluded.equipped_armor_id,
+    equipped_primary_weapon_id = excluded.equipped_primary_weapon_id,
+    equipped_secondary_weapon_id = excluded.equipped_secondary_weapon_id,
+    beastform_id = excluded.beastform_id;
 
 -- name: UpdateDaggerheartCharacterState :exec
 UPDATE daggerheart_character_states
@@ -97,15 +120,19 @@ WHERE campaign_id = ?;
 
 -- name: PutDaggerheartCountdown :exec
 INSERT INTO daggerheart_countdowns (
-    campaign_id, countdown_id, name, kind, current, max, direction, looping
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+    campaign_id, countdown_id, name, kind, current, max, direction, looping,
+    tick_trigger, tick_delta, tick_outcome_deltas_json
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(campaign_id, countdown_id) DO UPDATE SET
     name = excluded.name,
     kind = excluded.kind,
     current = excluded.current,
     max = excluded.max,
     direction = excluded.direction,
-    looping = excluded.looping;
+    looping = excluded.looping,
+    tick_trigger = excluded.tick_trigger,
+    tick_delta = excluded.tick_delta,
+    tick_outcome_deltas_json = excluded.tick_outcome_deltas_json;
 
 -- name: DeleteDaggerheartCountdown :exec
 DELETE FROM daggerheart_countdowns
@@ -130,8 +157,9 @@ ORDER BY name ASC, adversary_id ASC;
 -- name: PutDaggerheartAdversary :exec
 INSERT INTO daggerheart_adversaries (
     campaign_id, adversary_id, name, kind, session_id, notes, hp, hp_max, stress, stress_max,
-    evasion, major_threshold, severe_threshold, armor, conditions_json, created_at, updated_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    evasion, major_threshold, severe_threshold, armor, conditions_json, minion_threshold,
+    adversary_entry_id, attack_modifier, standard_attack_json, experiences_json, features_json, created_at, updated_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(campaign_id, adversary_id) DO UPDATE SET
     name = excluded.name,
     kind = excluded.kind,
@@ -146,6 +174,12 @@ ON CONFLICT(campaign_id, adversary_id) DO UPDATE SET
     severe_threshold = excluded.severe_threshold,
     armor = excluded.armor,
     conditions_json = excluded.conditions_json,
+    minion_threshold = excluded.minion_threshold,
+    adversary_entry_id = excluded.adversary_entry_id,
+    attack_modifier = excluded.attack_modifier,
+    standard_attack_json = excluded.standard_attack_json,
+    experiences_json = excluded.experiences_json,
+    features_json = excluded.features_json,
     created_at = excluded.created_at,
     updated_at = excluded.updated_at;
 
@@ -153,6 +187,127 @@ ON CONFLICT(campaign_id, adversary_id) DO UPDATE SET
 DELETE FROM daggerheart_adversaries
 WHERE campaign_id = ? AND adversary_id = ?;
 
+-- Scene Range Extensions
+
+-- name: GetDaggerheartSceneRange :one
+SELECT * FROM daggerheart_scene_ranges
+WHERE campaign_id = ? AND session_id = ? AND from_id = ? AND to_id = ?;
+
+-- name: ListDaggerheartSceneRanges :many
+SELECT * FROM daggerheart_scene_ranges
+WHERE campaign_id = ? AND session_id = ?
+ORDER BY from_id, to_id;
+
+-- name: PutDaggerheartSceneRange :exec
+INSERT INTO daggerheart_scene_ranges (
+    campaign_id, session_id, from_id, from_type, to_id, to_type, band
+) VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, session_id, from_id, to_id) DO UPDATE SET
+    from_type = excluded.from_type,
+    to_type = excluded.to_type,
+    band = excluded.band;
+
+-- name: GetDaggerheartSessionEnvironment :one
+SELECT * FROM daggerheart_session_environments
+WHERE campaign_id = ? AND session_id = ?;
+
+-- name: PutDaggerheartSessionEnvironment :exec
+INSERT INTO daggerheart_session_environments (
+    campaign_id, session_id, environment_id, name, tier, type, difficulty
+) VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, session_id) DO UPDATE SET
+    environment_id = excluded.environment_id,
+    name = excluded.name,
+    tier = excluded.tier,
+    type = excluded.type,
+    difficulty = excluded.difficulty;
+
+-- name: DeleteDaggerheartSessionEnvironment :exec
+DELETE FROM daggerheart_session_environments
+WHERE campaign_id = ? AND session_id = ?;
+
+-- name: GetDaggerheartCompanion :one
+SELECT * FROM daggerheart_companions
+WHERE campaign_id = ? AND character_id = ?;
+
+-- name: PutDaggerheartCompanion :exec
+INSERT INTO daggerheart_companions (
+    campaign_id, character_id, name, evasion, stress, stress_max,
+    damage_die_sides, attack_range, experiences_json, level, upgrades_json
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, character_id) DO UPDATE SET
+    name = excluded.name,
+    evasion = excluded.evasion,
+    stress = excluded.stress,
+    stress_max = excluded.stress_max,
+    damage_die_sides = excluded.damage_die_sides,
+    attack_range = excluded.attack_range,
+    experiences_json = excluded.experiences_json,
+    level = excluded.level,
+    upgrades_json = excluded.upgrades_json;
+
+-- name: GetDaggerheartEffect :one
+SELECT * FROM daggerheart_effects
+WHERE campaign_id = ? AND effect_id = ?;
+
+-- name: ListDaggerheartEffects :many
+SELECT * FROM daggerheart_effects
+WHERE campaign_id = ?
+ORDER BY target_id ASC, effect_id ASC;
+
+-- name: PutDaggerheartEffect :exec
+INSERT INTO daggerheart_effects (
+    campaign_id, effect_id, session_id, target_type, target_id, source,
+    kind, value, stacking, expires_on
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, effect_id) DO UPDATE SET
+    session_id = excluded.session_id,
+    target_type = excluded.target_type,
+    target_id = excluded.target_id,
+    source = excluded.source,
+    kind = excluded.kind,
+    value = excluded.value,
+    stacking = excluded.stacking,
+    expires_on = excluded.expires_on;
+
+-- name: DeleteDaggerheartEffect :exec
+DELETE FROM daggerheart_effects
+WHERE campaign_id = ? AND effect_id = ?;
+
+-- name: GetDaggerheartChase :one
+SELECT * FROM daggerheart_chases
+WHERE campaign_id = ? AND chase_id = ?;
+
+-- name: ListDaggerheartChases :many
+SELECT * FROM daggerheart_chases
+WHERE campaign_id = ?
+ORDER BY chase_id ASC;
+
+-- name: PutDaggerheartChase :exec
+INSERT INTO daggerheart_chases (
+    campaign_id, chase_id, name, progress_countdown_id, consequence_countdown_id,
+    status, winner
+) VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, chase_id) DO UPDATE SET
+    name = excluded.name,
+    progress_countdown_id = excluded.progress_countdown_id,
+    consequence_countdown_id = excluded.consequence_countdown_id,
+    status = excluded.status,
+    winner = excluded.winner;
+
+-- name: ListDaggerheartAdversaryFeatureStates :many
+SELECT * FROM daggerheart_adversary_feature_states
+WHERE campaign_id = ?
+ORDER BY adversary_id ASC, feature_id ASC;
+
+-- name: PutDaggerheartAdversaryFeatureState :exec
+INSERT INTO daggerheart_adversary_feature_states (
+    campaign_id, adversary_id, feature_id, status, refresh_on
+) VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(campaign_id, adversary_id, feature_id) DO UPDATE SET
+    status = excluded.status,
+    refresh_on = excluded.refresh_on;
+
 -- Content Catalog
 
 -- name: GetDaggerheartClass :one
@@ -4,25 +4,25 @@
 INSERT INTO events (
     campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature, timestamp, event_type,
     session_id, request_id, invocation_id,
-    actor_type, actor_id, entity_type, entity_id, system_id, system_version, payload_json
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+    actor_type, actor_id, entity_type, entity_id, system_id, system_version, payload_json, payload_version
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: GetEventByHash :one
 SELECT campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature,
     timestamp, event_type, session_id, request_id, invocation_id, actor_type, actor_id,
-    entity_type, entity_id, system_id, system_version, payload_json
+    entity_type, entity_id, system_id, system_version, payload_json, payload_version
 FROM events WHERE event_hash = ?;
 
 -- name: GetEventBySeq :one
 SELECT campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature,
     timestamp, event_type, session_id, request_id, invocation_id, actor_type, actor_id,
-    entity_type, entity_id, system_id, system_version, payload_json
+    entity_type, entity_id, system_id, system_version, payload_json, payload_version
 FROM events WHERE campaign_id = ? AND seq = ?;
 
 -- name: ListEvents :many
 SELECT campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature,
     timestamp, event_type, session_id, request_id, invocation_id, actor_type, actor_id,
-    entity_type, entity_id, system_id, system_version, payload_json
+    entity_type, entity_id, system_id, system_version, payload_json, payload_version
 FROM events
 WHERE campaign_id = ? AND seq > ?
 ORDER BY seq
@@ -31,12 +31,18 @@ LIMIT ?;
 -- name: ListEventsBySession :many
 SELECT campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature,
     timestamp, event_type, session_id, request_id, invocation_id, actor_type, actor_id,
-    entity_type, entity_id, system_id, system_version, payload_json
+    entity_type, entity_id, system_id, system_version, payload_json, payload_version
 FROM events
 WHERE campaign_id = ? AND session_id = ? AND seq > ?
 ORDER BY seq
 LIMIT ?;
 
+-- name: ListEventPayloadVersions :many
+SELECT campaign_id, event_type, payload_version, COUNT(*) AS event_count
+FROM events
+GROUP BY campaign_id, event_type, payload_version
+ORDER BY event_type, payload_version, campaign_id;
+
 -- name: GetEventSeq :one
 SELECT next_seq FROM event_seq WHERE campaign_id = ?;
 
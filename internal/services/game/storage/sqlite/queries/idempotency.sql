@@ -0,0 +1,38 @@
+-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM idempotency_keys WHERE expires_at <= ?;
+
+-- name: ReserveIdempotencyKey :execrows
+INSERT INTO idempotency_keys (
+  caller,
+  idempotency_key,
+  rpc_method,
+  request_hash,
+  created_at,
+  expires_at
+) VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT (caller, idempotency_key, rpc_method) DO NOTHING;
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys
+WHERE caller = ? AND idempotency_key = ? AND rpc_method = ?;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET completed = 1,
+  campaign_id = ?,
+  first_seq = ?,
+  last_seq = ?,
+  response_type = ?,
+  response = ?,
+  status = ?,
+  expires_at = ?
+WHERE caller = ? AND idempotency_key = ? AND rpc_method = ?;
+
+-- name: RenewIdempotencyKey :exec
+UPDATE idempotency_keys
+SET expires_at = ?
+WHERE caller = ? AND idempotency_key = ? AND rpc_method = ? AND completed = 0;
+
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE caller = ? AND idempotency_key = ? AND rpc_method = ?;
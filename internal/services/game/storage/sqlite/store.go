@@ -2,12 +2,16 @@ package sqlite
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/fs"
+	"math"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -56,9 +60,10 @@ func fromNullMillis(value sql.NullInt64) *time.Time {
 
 // Store provides a SQLite-backed store implementing all storage interfaces.
 type Store struct {
-	sqlDB   *sql.DB
-	q       *db.Queries
-	keyring *integrity.Keyring
+	sqlDB     *sql.DB
+	q         *db.Queries
+	keyring   *integrity.Keyring
+	publisher event.Publisher
 }
 
 // Open opens a SQLite projections store at the provided path.
@@ -81,6 +86,24 @@ func OpenContent(path string) (*Store, error) {
 	return openStore(path, migrations.ContentFS, "content", nil)
 }
 
+// SetEventPublisher registers a publisher notified after each event commit.
+func (s *Store) SetEventPublisher(publisher event.Publisher) {
+	if s == nil {
+		return
+	}
+	s.publisher = publisher
+}
+
+// publishCommitted notifies the publisher of events that were committed.
+func (s *Store) publishCommitted(events ...event.Event) {
+	if s.publisher == nil {
+		return
+	}
+	for _, evt := range events {
+		s.publisher.Publish(evt)
+	}
+}
+
 // Close closes the underlying SQLite database.
 func (s *Store) Close() error {
 	if s == nil || s.sqlDB == nil {
@@ -1500,6 +1523,20 @@ func (s *Store) ApplyRollOutcome(ctx context.Context, input storage.RollOutcomeA
 	defer tx.Rollback()
 
 	qtx := s.q.WithTx(tx)
+	// Events appended in this transaction are published only after commit.
+	var committed []event.Event
+	// appendTx validates each event the way AppendEvent does before handing it
+	// to the shared append path.
+	appendTx := func(evt event.Event) (event.Event, error) {
+		normalized, err := event.NormalizeForAppend(evt)
+		if err != nil {
+			return event.Event{}, err
+		}
+		return s.appendEventTx(ctx, qtx, normalized)
+	}
+	if err := checkExpectedLastSeq(ctx, qtx, input.CampaignID); err != nil {
+		return storage.RollOutcomeApplyResult{}, err
+	}
 
 	evtTimestamp := input.EventTimestamp
 	if evtTimestamp.IsZero() {
@@ -1566,7 +1603,7 @@ func (s *Store) ApplyRollOutcome(ctx context.Context, input storage.RollOutcomeA
 		if err != nil {
 			return storage.RollOutcomeApplyResult{}, fmt.Errorf("marshal gm fear payload: %w", err)
 		}
-		if _, err := appendEventTx(ctx, qtx, s.keyring, event.Event{
+		fearEvent, err := appendTx(event.Event{
 			CampaignID:    input.CampaignID,
 			Timestamp:     evtTimestamp,
 			Type:          daggerheart.EventTypeGMFearChanged,
@@ -1578,9 +1615,11 @@ func (s *Store) ApplyRollOutcome(ctx context.Context, input storage.RollOutcomeA
 			SystemID:      commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART.String(),
 			SystemVersion: daggerheart.SystemVersion,
 			PayloadJSON:   payloadJSON,
-		}); err != nil {
+		})
+		if err != nil {
 			return storage.RollOutcomeApplyResult{}, fmt.Errorf("append gm fear event: %w", err)
 		}
+		committed = append(committed, fearEvent)
 	}
 
 	for _, target := range input.Targets {
@@ -1664,7 +1703,7 @@ func (s *Store) ApplyRollOutcome(ctx context.Context, input storage.RollOutcomeA
 			if err != nil {
 				return storage.RollOutcomeApplyResult{}, fmt.Errorf("marshal character state payload: %w", err)
 			}
-			if _, err := appendEventTx(ctx, qtx, s.keyring, event.Event{
+			stateEvent, err := appendTx(event.Event{
 				CampaignID:    input.CampaignID,
 				Timestamp:     evtTimestamp,
 				Type:          daggerheart.EventTypeCharacterStatePatched,
@@ -1676,9 +1715,11 @@ func (s *Store) ApplyRollOutcome(ctx context.Context, input storage.RollOutcomeA
 				SystemID:      commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART.String(),
 				SystemVersion: daggerheart.SystemVersion,
 				PayloadJSON:   payloadJSON,
-			}); err != nil {
+			})
+			if err != nil {
 				return storage.RollOutcomeApplyResult{}, fmt.Errorf("append character state event: %w", err)
 			}
+			committed = append(committed, stateEvent)
 		}
 
 		// Build result state with updated values
@@ -1716,7 +1757,7 @@ func (s *Store) ApplyRollOutcome(ctx context.Context, input storage.RollOutcomeA
 	}
 
 	// Use unified event table
-	if _, err := appendEventTx(ctx, qtx, s.keyring, event.Event{
+	outcomeEvent, err := appendTx(event.Event{
 		CampaignID:   input.CampaignID,
 		Timestamp:    evtTimestamp,
 		Type:         event.TypeOutcomeApplied,
@@ -1727,9 +1768,11 @@ func (s *Store) ApplyRollOutcome(ctx context.Context, input storage.RollOutcomeA
 		EntityType:   "outcome",
 		EntityID:     input.RequestID,
 		PayloadJSON:  payload,
-	}); err != nil {
+	})
+	if err != nil {
 		return storage.RollOutcomeApplyResult{}, fmt.Errorf("append outcome applied event: %w", err)
 	}
+	committed = append(committed, outcomeEvent)
 
 	if err := qtx.MarkOutcomeApplied(ctx, db.MarkOutcomeAppliedParams{
 		CampaignID: input.CampaignID,
@@ -1742,109 +1785,12 @@ func (s *Store) ApplyRollOutcome(ctx context.Context, input storage.RollOutcomeA
 	if err := tx.Commit(); err != nil {
 		return storage.RollOutcomeApplyResult{}, fmt.Errorf("commit: %w", err)
 	}
+	storage.RecordAppendedEvents(ctx, committed...)
+	s.publishCommitted(committed...)
 
 	return result, nil
 }
 
-func appendEventTx(ctx context.Context, qtx *db.Queries, keyring *integrity.Keyring, evt event.Event) (event.Event, error) {
-	if qtx == nil {
-		return event.Event{}, fmt.Errorf("event store is not configured")
-	}
-
-	normalized, err := event.NormalizeForAppend(evt)
-	if err != nil {
-		return event.Event{}, err
-	}
-	evt = normalized
-	if evt.Timestamp.IsZero() {
-		evt.Timestamp = time.Now().UTC()
-	}
-
-	if err := qtx.InitEventSeq(ctx, evt.CampaignID); err != nil {
-		return event.Event{}, fmt.Errorf("init event seq: %w", err)
-	}
-
-	seq, err := qtx.GetEventSeq(ctx, evt.CampaignID)
-	if err != nil {
-		return event.Event{}, fmt.Errorf("get event seq: %w", err)
-	}
-	if err := qtx.IncrementEventSeq(ctx, evt.CampaignID); err != nil {
-		return event.Event{}, fmt.Errorf("increment event seq: %w", err)
-	}
-	if seq <= 0 {
-		return event.Event{}, fmt.Errorf("event seq is required")
-	}
-	evt.Seq = uint64(seq)
-
-	if keyring == nil {
-		return event.Event{}, fmt.Errorf("event integrity keyring is required")
-	}
-
-	hash, err := integrity.EventHash(evt)
-	if err != nil {
-		return event.Event{}, fmt.Errorf("compute event hash: %w", err)
-	}
-	if strings.TrimSpace(hash) == "" {
-		return event.Event{}, fmt.Errorf("event hash is required")
-	}
-	evt.Hash = hash
-
-	prevHash := ""
-	if evt.Seq > 1 {
-		prevRow, err := qtx.GetEventBySeq(ctx, db.GetEventBySeqParams{
-			CampaignID: evt.CampaignID,
-			Seq:        int64(evt.Seq - 1),
-		})
-		if err != nil {
-			return event.Event{}, fmt.Errorf("load previous event: %w", err)
-		}
-		prevHash = prevRow.ChainHash
-	}
-
-	chainHash, err := integrity.ChainHash(evt, prevHash)
-	if err != nil {
-		return event.Event{}, fmt.Errorf("compute chain hash: %w", err)
-	}
-	if strings.TrimSpace(chainHash) == "" {
-		return event.Event{}, fmt.Errorf("chain hash is required")
-	}
-
-	signature, keyID, err := keyring.SignChainHash(evt.CampaignID, chainHash)
-	if err != nil {
-		return event.Event{}, fmt.Errorf("sign chain hash: %w", err)
-	}
-
-	evt.PrevHash = prevHash
-	evt.ChainHash = chainHash
-	evt.Signature = signature
-	evt.SignatureKeyID = keyID
-	if err := qtx.AppendEvent(ctx, db.AppendEventParams{
-		CampaignID:     evt.CampaignID,
-		Seq:            seq,
-		EventHash:      hash,
-		PrevEventHash:  prevHash,
-		ChainHash:      chainHash,
-		SignatureKeyID: keyID,
-		EventSignature: signature,
-		Timestamp:      toMillis(evt.Timestamp),
-		EventType:      string(evt.Type),
-		SessionID:      evt.SessionID,
-		RequestID:      evt.RequestID,
-		InvocationID:   evt.InvocationID,
-		ActorType:      string(evt.ActorType),
-		ActorID:        evt.ActorID,
-		EntityType:     evt.EntityType,
-		EntityID:       evt.EntityID,
-		SystemID:       evt.SystemID,
-		SystemVersion:  evt.SystemVersion,
-		PayloadJson:    evt.PayloadJSON,
-	}); err != nil {
-		return event.Event{}, fmt.Errorf("append event: %w", err)
-	}
-
-	return evt, nil
-}
-
 // Conversion helpers
 
 func gameSystemToString(gs commonv1.GameSystem) string {
@@ -2620,26 +2566,53 @@ func (s *Store) PutDaggerheartCharacterProfile(ctx context.Context, profile stor
 	if err != nil {
 		return fmt.Errorf("marshal experiences: %w", err)
 	}
+	markedTraitsJSON, err := json.Marshal(profile.MarkedTraits)
+	if err != nil {
+		return fmt.Errorf("marshal marked traits: %w", err)
+	}
+	loadoutActiveJSON, err := json.Marshal(profile.LoadoutActive)
+	if err != nil {
+		return fmt.Errorf("marshal loadout active: %w", err)
+	}
+	loadoutVaultJSON, err := json.Marshal(profile.LoadoutVault)
+	if err != nil {
+		return fmt.Errorf("marshal loadout vault: %w", err)
+	}
+	levelHistoryJSON, err := json.Marshal(profile.LevelHistory)
+	if err != nil {
+		return fmt.Errorf("marshal level history: %w", err)
+	}
 
 	return s.q.PutDaggerheartCharacterProfile(ctx, db.PutDaggerheartCharacterProfileParams{
-		CampaignID:      profile.CampaignID,
-		CharacterID:     profile.CharacterID,
-		Level:           int64(profile.Level),
-		HpMax:           int64(profile.HpMax),
-		StressMax:       int64(profile.StressMax),
-		Evasion:         int64(profile.Evasion),
-		MajorThreshold:  int64(profile.MajorThreshold),
-		SevereThreshold: int64(profile.SevereThreshold),
-		Proficiency:     int64(profile.Proficiency),
-		ArmorScore:      int64(profile.ArmorScore),
-		ArmorMax:        int64(profile.ArmorMax),
-		ExperiencesJson: string(experiencesJSON),
-		Agility:         int64(profile.Agility),
-		Strength:        int64(profile.Strength),
-		Finesse:         int64(profile.Finesse),
-		Instinct:        int64(profile.Instinct),
-		Presence:        int64(profile.Presence),
-		Knowledge:       int64(profile.Knowledge),
+		CampaignID:              profile.CampaignID,
+		CharacterID:             profile.CharacterID,
+		Level:                   int64(profile.Level),
+		HpMax:                   int64(profile.HpMax),
+		StressMax:               int64(profile.StressMax),
+		Evasion:                 int64(profile.Evasion),
+		MajorThreshold:          int64(profile.MajorThreshold),
+		SevereThreshold:         int64(profile.SevereThreshold),
+		Proficiency:             int64(profile.Proficiency),
+		ArmorScore:              int64(profile.ArmorScore),
+		ArmorMax:                int64(profile.ArmorMax),
+		ExperiencesJson:         string(experiencesJSON),
+		Agility:                 int64(profile.Agility),
+		Strength:                int64(profile.Strength),
+		Finesse:                 int64(profile.Finesse),
+		Instinct:                int64(profile.Instinct),
+		Presence:                int64(profile.Presence),
+		Knowledge:               int64(profile.Knowledge),
+		ClassID:                 profile.ClassID,
+		SubclassID:              profile.SubclassID,
+		SubclassStage:           profile.SubclassStage,
+		MulticlassClassID:       profile.MulticlassClassID,
+		MulticlassSubclassID:    profile.MulticlassSubclassID,
+		MulticlassSubclassStage: profile.MulticlassSubclassStage,
+		MulticlassDomainID:      profile.MulticlassDomainID,
+		MarkedTraitsJson:        string(markedTraitsJSON),
+		LoadoutActiveJson:       string(loadoutActiveJSON),
+		LoadoutVaultJson:        string(loadoutVaultJSON),
+		LevelHistoryJson:        string(levelHistoryJSON),
 	})
 }
 
@@ -2670,29 +2643,56 @@ func (s *Store) GetDaggerheartCharacterProfile(ctx context.Context, campaignID,
 	}
 
 	profile := storage.DaggerheartCharacterProfile{
-		CampaignID:      row.CampaignID,
-		CharacterID:     row.CharacterID,
-		Level:           int(row.Level),
-		HpMax:           int(row.HpMax),
-		StressMax:       int(row.StressMax),
-		Evasion:         int(row.Evasion),
-		MajorThreshold:  int(row.MajorThreshold),
-		SevereThreshold: int(row.SevereThreshold),
-		Proficiency:     int(row.Proficiency),
-		ArmorScore:      int(row.ArmorScore),
-		ArmorMax:        int(row.ArmorMax),
-		Agility:         int(row.Agility),
-		Strength:        int(row.Strength),
-		Finesse:         int(row.Finesse),
-		Instinct:        int(row.Instinct),
-		Presence:        int(row.Presence),
-		Knowledge:       int(row.Knowledge),
+		CampaignID:              row.CampaignID,
+		CharacterID:             row.CharacterID,
+		Level:                   int(row.Level),
+		HpMax:                   int(row.HpMax),
+		StressMax:               int(row.StressMax),
+		Evasion:                 int(row.Evasion),
+		MajorThreshold:          int(row.MajorThreshold),
+		SevereThreshold:         int(row.SevereThreshold),
+		Proficiency:             int(row.Proficiency),
+		ArmorScore:              int(row.ArmorScore),
+		ArmorMax:                int(row.ArmorMax),
+		Agility:                 int(row.Agility),
+		Strength:                int(row.Strength),
+		Finesse:                 int(row.Finesse),
+		Instinct:                int(row.Instinct),
+		Presence:                int(row.Presence),
+		Knowledge:               int(row.Knowledge),
+		ClassID:                 row.ClassID,
+		SubclassID:              row.SubclassID,
+		SubclassStage:           row.SubclassStage,
+		MulticlassClassID:       row.MulticlassClassID,
+		MulticlassSubclassID:    row.MulticlassSubclassID,
+		MulticlassSubclassStage: row.MulticlassSubclassStage,
+		MulticlassDomainID:      row.MulticlassDomainID,
 	}
 	if row.ExperiencesJson != "" {
 		if err := json.Unmarshal([]byte(row.ExperiencesJson), &profile.Experiences); err != nil {
 			return storage.DaggerheartCharacterProfile{}, fmt.Errorf("decode experiences: %w", err)
 		}
 	}
+	if row.MarkedTraitsJson != "" {
+		if err := json.Unmarshal([]byte(row.MarkedTraitsJson), &profile.MarkedTraits); err != nil {
+			return storage.DaggerheartCharacterProfile{}, fmt.Errorf("decode marked traits: %w", err)
+		}
+	}
+	if row.LoadoutActiveJson != "" {
+		if err := json.Unmarshal([]byte(row.LoadoutActiveJson), &profile.LoadoutActive); err != nil {
+			return storage.DaggerheartCharacterProfile{}, fmt.Errorf("decode loadout active: %w", err)
+		}
+	}
+	if row.LoadoutVaultJson != "" {
+		if err := json.Unmarshal([]byte(row.LoadoutVaultJson), &profile.LoadoutVault); err != nil {
+			return storage.DaggerheartCharacterProfile{}, fmt.Errorf("decode loadout vault: %w", err)
+		}
+	}
+	if row.LevelHistoryJson != "" {
+		if err := json.Unmarshal([]byte(row.LevelHistoryJson), &profile.LevelHistory); err != nil {
+			return storage.DaggerheartCharacterProfile{}, fmt.Errorf("decode level history: %w", err)
+		}
+	}
 	return profile, nil
 }
 
@@ -2730,16 +2730,33 @@ func (s *Store) PutDaggerheartCharacterState(ctx context.Context, state storage.
 		lifeState = daggerheart.LifeStateAlive
 	}
 
+	inventory := state.Inventory
+	if inventory == nil {
+		inventory = []storage.DaggerheartInventoryItem{}
+	}
+	inventoryJSON, err := json.Marshal(inventory)
+	if err != nil {
+		return fmt.Errorf("encode inventory: %w", err)
+	}
+
 	return s.q.PutDaggerheartCharacterState(ctx, db.PutDaggerheartCharacterStateParams{
-		CampaignID:     state.CampaignID,
-		CharacterID:    state.CharacterID,
-		Hp:             int64(state.Hp),
-		Hope:           int64(state.Hope),
-		HopeMax:        int64(hopeMax),
-		Stress:         int64(state.Stress),
-		Armor:          int64(state.Armor),
-		ConditionsJson: string(conditionsJSON),
-		LifeState:      lifeState,
+		CampaignID:                state.CampaignID,
+		CharacterID:               state.CharacterID,
+		Hp:                        int64(state.Hp),
+		Hope:                      int64(state.Hope),
+		HopeMax:                   int64(hopeMax),
+		Stress:                    int64(state.Stress),
+		Armor:                     int64(state.Armor),
+		ConditionsJson:            string(conditionsJSON),
+		LifeState:                 lifeState,
+		GoldHandfuls:              int64(state.GoldHandfuls),
+		GoldBags:                  int64(state.GoldBags),
+		GoldChests:                int64(state.GoldChests),
+		InventoryJson:             string(inventoryJSON),
+		EquippedArmorID:           state.EquippedArmorID,
+		EquippedPrimaryWeaponID:   state.EquippedPrimaryWeaponID,
+		EquippedSecondaryWeaponID: state.EquippedSecondaryWeaponID,
+		BeastformID:               state.BeastformID,
 	})
 }
 
@@ -2781,16 +2798,31 @@ func (s *Store) GetDaggerheartCharacterState(ctx context.Context, campaignID, ch
 		lifeState = daggerheart.LifeStateAlive
 	}
 
+	var inventory []storage.DaggerheartInventoryItem
+	if row.InventoryJson != "" {
+		if err := json.Unmarshal([]byte(row.InventoryJson), &inventory); err != nil {
+			return storage.DaggerheartCharacterState{}, fmt.Errorf("decode inventory: %w", err)
+		}
+	}
+
 	return storage.DaggerheartCharacterState{
-		CampaignID:  row.CampaignID,
-		CharacterID: row.CharacterID,
-		Hp:          int(row.Hp),
-		Hope:        int(row.Hope),
-		HopeMax:     int(row.HopeMax),
-		Stress:      int(row.Stress),
-		Armor:       int(row.Armor),
-		Conditions:  conditions,
-		LifeState:   lifeState,
+		CampaignID:                row.CampaignID,
+		CharacterID:               row.CharacterID,
+		Hp:                        int(row.Hp),
+		Hope:                      int(row.Hope),
+		HopeMax:                   int(row.HopeMax),
+		Stress:                    int(row.Stress),
+		Armor:                     int(row.Armor),
+		Conditions:                conditions,
+		LifeState:                 lifeState,
+		GoldHandfuls:              int(row.GoldHandfuls),
+		GoldBags:                  int(row.GoldBags),
+		GoldChests:                int(row.GoldChests),
+		Inventory:                 inventory,
+		EquippedArmorID:           row.EquippedArmorID,
+		EquippedPrimaryWeaponID:   row.EquippedPrimaryWeaponID,
+		EquippedSecondaryWeaponID: row.EquippedSecondaryWeaponID,
+		BeastformID:               row.BeastformID,
 	}, nil
 }
 
@@ -2860,16 +2892,27 @@ func (s *Store) PutDaggerheartCountdown(ctx context.Context, countdown storage.D
 	if countdown.Looping {
 		looping = 1
 	}
+	outcomeDeltas := countdown.TickOutcomeDeltas
+	if outcomeDeltas == nil {
+		outcomeDeltas = map[string]int{}
+	}
+	outcomeDeltasJSON, err := json.Marshal(outcomeDeltas)
+	if err != nil {
+		return fmt.Errorf("encode countdown outcome deltas: %w", err)
+	}
 
 	return s.q.PutDaggerheartCountdown(ctx, db.PutDaggerheartCountdownParams{
-		CampaignID:  countdown.CampaignID,
-		CountdownID: countdown.CountdownID,
-		Name:        countdown.Name,
-		Kind:        countdown.Kind,
-		Current:     int64(countdown.Current),
-		Max:         int64(countdown.Max),
-		Direction:   countdown.Direction,
-		Looping:     looping,
+		CampaignID:            countdown.CampaignID,
+		CountdownID:           countdown.CountdownID,
+		Name:                  countdown.Name,
+		Kind:                  countdown.Kind,
+		Current:               int64(countdown.Current),
+		Max:                   int64(countdown.Max),
+		Direction:             countdown.Direction,
+		Looping:               looping,
+		TickTrigger:           countdown.TickTrigger,
+		TickDelta:             int64(countdown.TickDelta),
+		TickOutcomeDeltasJson: string(outcomeDeltasJSON),
 	})
 }
 
@@ -2899,16 +2942,7 @@ func (s *Store) GetDaggerheartCountdown(ctx context.Context, campaignID, countdo
 		return storage.DaggerheartCountdown{}, fmt.Errorf("get daggerheart countdown: %w", err)
 	}
 
-	return storage.DaggerheartCountdown{
-		CampaignID:  row.CampaignID,
-		CountdownID: row.CountdownID,
-		Name:        row.Name,
-		Kind:        row.Kind,
-		Current:     int(row.Current),
-		Max:         int(row.Max),
-		Direction:   row.Direction,
-		Looping:     row.Looping != 0,
-	}, nil
+	return dbDaggerheartCountdownToStorage(row)
 }
 
 // ListDaggerheartCountdowns retrieves countdown projections for a campaign.
@@ -2930,21 +2964,42 @@ func (s *Store) ListDaggerheartCountdowns(ctx context.Context, campaignID string
 
 	countdowns := make([]storage.DaggerheartCountdown, 0, len(rows))
 	for _, row := range rows {
-		countdowns = append(countdowns, storage.DaggerheartCountdown{
-			CampaignID:  row.CampaignID,
-			CountdownID: row.CountdownID,
-			Name:        row.Name,
-			Kind:        row.Kind,
-			Current:     int(row.Current),
-			Max:         int(row.Max),
-			Direction:   row.Direction,
-			Looping:     row.Looping != 0,
-		})
+		countdown, err := dbDaggerheartCountdownToStorage(row)
+		if err != nil {
+			return nil, err
+		}
+		countdowns = append(countdowns, countdown)
 	}
 
 	return countdowns, nil
 }
 
+func dbDaggerheartCountdownToStorage(row db.DaggerheartCountdown) (storage.DaggerheartCountdown, error) {
+	var outcomeDeltas map[string]int
+	if row.TickOutcomeDeltasJson != "" {
+		if err := json.Unmarshal([]byte(row.TickOutcomeDeltasJson), &outcomeDeltas); err != nil {
+			return storage.DaggerheartCountdown{}, fmt.Errorf("decode daggerheart countdown outcome deltas: %w", err)
+		}
+	}
+	if len(outcomeDeltas) == 0 {
+		outcomeDeltas = nil
+	}
+
+	return storage.DaggerheartCountdown{
+		CampaignID:        row.CampaignID,
+		CountdownID:       row.CountdownID,
+		Name:              row.Name,
+		Kind:              row.Kind,
+		Current:           int(row.Current),
+		Max:               int(row.Max),
+		Direction:         row.Direction,
+		Looping:           row.Looping != 0,
+		TickTrigger:       row.TickTrigger,
+		TickDelta:         int(row.TickDelta),
+		TickOutcomeDeltas: outcomeDeltas,
+	}, nil
+}
+
 // DeleteDaggerheartCountdown removes a countdown projection for a campaign.
 func (s *Store) DeleteDaggerheartCountdown(ctx context.Context, campaignID, countdownID string) error {
 	if err := ctx.Err(); err != nil {
@@ -2991,25 +3046,51 @@ func (s *Store) PutDaggerheartAdversary(ctx context.Context, adversary storage.D
 	if err != nil {
 		return fmt.Errorf("marshal adversary conditions: %w", err)
 	}
+	attackJSON, err := json.Marshal(adversary.StandardAttack)
+	if err != nil {
+		return fmt.Errorf("marshal adversary standard attack: %w", err)
+	}
+	experiences := adversary.Experiences
+	if experiences == nil {
+		experiences = []storage.DaggerheartAdversaryExperience{}
+	}
+	experiencesJSON, err := json.Marshal(experiences)
+	if err != nil {
+		return fmt.Errorf("marshal adversary experiences: %w", err)
+	}
+	features := adversary.Features
+	if features == nil {
+		features = []storage.DaggerheartAdversaryFeature{}
+	}
+	featuresJSON, err := json.Marshal(features)
+	if err != nil {
+		return fmt.Errorf("marshal adversary features: %w", err)
+	}
 
 	return s.q.PutDaggerheartAdversary(ctx, db.PutDaggerheartAdversaryParams{
-		CampaignID:      adversary.CampaignID,
-		AdversaryID:     adversary.AdversaryID,
-		Name:            adversary.Name,
-		Kind:            adversary.Kind,
-		SessionID:       toNullString(adversary.SessionID),
-		Notes:           adversary.Notes,
-		Hp:              int64(adversary.HP),
-		HpMax:           int64(adversary.HPMax),
-		Stress:          int64(adversary.Stress),
-		StressMax:       int64(adversary.StressMax),
-		Evasion:         int64(adversary.Evasion),
-		MajorThreshold:  int64(adversary.Major),
-		SevereThreshold: int64(adversary.Severe),
-		Armor:           int64(adversary.Armor),
-		ConditionsJson:  string(conditionsJSON),
-		CreatedAt:       toMillis(adversary.CreatedAt),
-		UpdatedAt:       toMillis(adversary.UpdatedAt),
+		CampaignID:         adversary.CampaignID,
+		AdversaryID:        adversary.AdversaryID,
+		Name:               adversary.Name,
+		Kind:               adversary.Kind,
+		SessionID:          toNullString(adversary.SessionID),
+		Notes:              adversary.Notes,
+		Hp:                 int64(adversary.HP),
+		HpMax:              int64(adversary.HPMax),
+		Stress:             int64(adversary.Stress),
+		StressMax:          int64(adversary.StressMax),
+		Evasion:            int64(adversary.Evasion),
+		MajorThreshold:     int64(adversary.Major),
+		SevereThreshold:    int64(adversary.Severe),
+		Armor:              int64(adversary.Armor),
+		ConditionsJson:     string(conditionsJSON),
+		MinionThreshold:    int64(adversary.MinionThreshold),
+		AdversaryEntryID:   adversary.AdversaryEntryID,
+		AttackModifier:     int64(adversary.AttackModifier),
+		StandardAttackJson: string(attackJSON),
+		ExperiencesJson:    string(experiencesJSON),
+		FeaturesJson:       string(featuresJSON),
+		CreatedAt:          toMillis(adversary.CreatedAt),
+		UpdatedAt:          toMillis(adversary.UpdatedAt),
 	})
 }
 
@@ -3039,36 +3120,7 @@ func (s *Store) GetDaggerheartAdversary(ctx context.Context, campaignID, adversa
 		return storage.DaggerheartAdversary{}, fmt.Errorf("get daggerheart adversary: %w", err)
 	}
 
-	sessionID := ""
-	if row.SessionID.Valid {
-		sessionID = row.SessionID.String
-	}
-	conditions := []string{}
-	if row.ConditionsJson != "" {
-		if err := json.Unmarshal([]byte(row.ConditionsJson), &conditions); err != nil {
-			return storage.DaggerheartAdversary{}, fmt.Errorf("decode daggerheart adversary conditions: %w", err)
-		}
-	}
-
-	return storage.DaggerheartAdversary{
-		CampaignID:  row.CampaignID,
-		AdversaryID: row.AdversaryID,
-		Name:        row.Name,
-		Kind:        row.Kind,
-		SessionID:   sessionID,
-		Notes:       row.Notes,
-		HP:          int(row.Hp),
-		HPMax:       int(row.HpMax),
-		Stress:      int(row.Stress),
-		StressMax:   int(row.StressMax),
-		Evasion:     int(row.Evasion),
-		Major:       int(row.MajorThreshold),
-		Severe:      int(row.SevereThreshold),
-		Armor:       int(row.Armor),
-		Conditions:  conditions,
-		CreatedAt:   fromMillis(row.CreatedAt),
-		UpdatedAt:   fromMillis(row.UpdatedAt),
-	}, nil
+	return dbDaggerheartAdversaryToStorage(row)
 }
 
 // ListDaggerheartAdversaries retrieves adversary projections for a campaign.
@@ -3099,40 +3151,66 @@ func (s *Store) ListDaggerheartAdversaries(ctx context.Context, campaignID, sess
 
 	adversaries := make([]storage.DaggerheartAdversary, 0, len(rows))
 	for _, row := range rows {
-		rowSessionID := ""
-		if row.SessionID.Valid {
-			rowSessionID = row.SessionID.String
-		}
-		conditions := []string{}
-		if row.ConditionsJson != "" {
-			if err := json.Unmarshal([]byte(row.ConditionsJson), &conditions); err != nil {
-				return nil, fmt.Errorf("decode daggerheart adversary conditions: %w", err)
-			}
+		adversary, err := dbDaggerheartAdversaryToStorage(row)
+		if err != nil {
+			return nil, err
 		}
-		adversaries = append(adversaries, storage.DaggerheartAdversary{
-			CampaignID:  row.CampaignID,
-			AdversaryID: row.AdversaryID,
-			Name:        row.Name,
-			Kind:        row.Kind,
-			SessionID:   rowSessionID,
-			Notes:       row.Notes,
-			HP:          int(row.Hp),
-			HPMax:       int(row.HpMax),
-			Stress:      int(row.Stress),
-			StressMax:   int(row.StressMax),
-			Evasion:     int(row.Evasion),
-			Major:       int(row.MajorThreshold),
-			Severe:      int(row.SevereThreshold),
-			Armor:       int(row.Armor),
-			Conditions:  conditions,
-			CreatedAt:   fromMillis(row.CreatedAt),
-			UpdatedAt:   fromMillis(row.UpdatedAt),
-		})
+		adversaries = append(adversaries, adversary)
 	}
 
 	return adversaries, nil
 }
 
+func dbDaggerheartAdversaryToStorage(row db.DaggerheartAdversary) (storage.DaggerheartAdversary, error) {
+	sessionID := ""
+	if row.SessionID.Valid {
+		sessionID = row.SessionID.String
+	}
+	adversary := storage.DaggerheartAdversary{
+		CampaignID:       row.CampaignID,
+		AdversaryID:      row.AdversaryID,
+		Name:             row.Name,
+		Kind:             row.Kind,
+		SessionID:        sessionID,
+		Notes:            row.Notes,
+		HP:               int(row.Hp),
+		HPMax:            int(row.HpMax),
+		Stress:           int(row.Stress),
+		StressMax:        int(row.StressMax),
+		Evasion:          int(row.Evasion),
+		Major:            int(row.MajorThreshold),
+		Severe:           int(row.SevereThreshold),
+		Armor:            int(row.Armor),
+		Conditions:       []string{},
+		MinionThreshold:  int(row.MinionThreshold),
+		AdversaryEntryID: row.AdversaryEntryID,
+		AttackModifier:   int(row.AttackModifier),
+		CreatedAt:        fromMillis(row.CreatedAt),
+		UpdatedAt:        fromMillis(row.UpdatedAt),
+	}
+	if row.ConditionsJson != "" {
+		if err := json.Unmarshal([]byte(row.ConditionsJson), &adversary.Conditions); err != nil {
+			return storage.DaggerheartAdversary{}, fmt.Errorf("decode daggerheart adversary conditions: %w", err)
+		}
+	}
+	if row.StandardAttackJson != "" {
+		if err := json.Unmarshal([]byte(row.StandardAttackJson), &adversary.StandardAttack); err != nil {
+			return storage.DaggerheartAdversary{}, fmt.Errorf("decode daggerheart adversary standard attack: %w", err)
+		}
+	}
+	if row.ExperiencesJson != "" {
+		if err := json.Unmarshal([]byte(row.ExperiencesJson), &adversary.Experiences); err != nil {
+			return storage.DaggerheartAdversary{}, fmt.Errorf("decode daggerheart adversary experiences: %w", err)
+		}
+	}
+	if row.FeaturesJson != "" {
+		if err := json.Unmarshal([]byte(row.FeaturesJson), &adversary.Features); err != nil {
+			return storage.DaggerheartAdversary{}, fmt.Errorf("decode daggerheart adversary features: %w", err)
+		}
+	}
+	return adversary, nil
+}
+
 // DeleteDaggerheartAdversary removes an adversary projection for a campaign.
 func (s *Store) DeleteDaggerheartAdversary(ctx context.Context, campaignID, adversaryID string) error {
 	if err := ctx.Err(); err != nil {
@@ -3154,468 +3232,652 @@ func (s *Store) DeleteDaggerheartAdversary(ctx context.Context, campaignID, adve
 	})
 }
 
-// Daggerheart content catalog methods
-
-// PutDaggerheartClass persists a Daggerheart class catalog entry.
-func (s *Store) PutDaggerheartClass(ctx context.Context, class storage.DaggerheartClass) error {
+// PutDaggerheartSceneRange persists the range band between two scene entities.
+func (s *Store) PutDaggerheartSceneRange(ctx context.Context, sceneRange storage.DaggerheartSceneRange) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(class.ID) == "" {
-		return fmt.Errorf("class id is required")
-	}
-
-	startingItemsJSON, err := json.Marshal(class.StartingItems)
-	if err != nil {
-		return fmt.Errorf("marshal class starting items: %w", err)
+	if strings.TrimSpace(sceneRange.CampaignID) == "" {
+		return fmt.Errorf("campaign id is required")
 	}
-	featuresJSON, err := json.Marshal(class.Features)
-	if err != nil {
-		return fmt.Errorf("marshal class features: %w", err)
+	if strings.TrimSpace(sceneRange.SessionID) == "" {
+		return fmt.Errorf("session id is required")
 	}
-	hopeFeatureJSON, err := json.Marshal(class.HopeFeature)
-	if err != nil {
-		return fmt.Errorf("marshal class hope feature: %w", err)
+	if strings.TrimSpace(sceneRange.FromID) == "" || strings.TrimSpace(sceneRange.ToID) == "" {
+		return fmt.Errorf("scene range entity ids are required")
 	}
-	domainIDsJSON, err := json.Marshal(class.DomainIDs)
-	if err != nil {
-		return fmt.Errorf("marshal class domain ids: %w", err)
+	if sceneRange.FromID > sceneRange.ToID {
+		sceneRange.FromID, sceneRange.ToID = sceneRange.ToID, sceneRange.FromID
+		sceneRange.FromType, sceneRange.ToType = sceneRange.ToType, sceneRange.FromType
 	}
 
-	return s.q.PutDaggerheartClass(ctx, db.PutDaggerheartClassParams{
-		ID:                class.ID,
-		Name:              class.Name,
-		StartingEvasion:   int64(class.StartingEvasion),
-		StartingHp:        int64(class.StartingHP),
-		StartingItemsJson: string(startingItemsJSON),
-		FeaturesJson:      string(featuresJSON),
-		HopeFeatureJson:   string(hopeFeatureJSON),
-		DomainIdsJson:     string(domainIDsJSON),
-		CreatedAt:         toMillis(class.CreatedAt),
-		UpdatedAt:         toMillis(class.UpdatedAt),
+	return s.q.PutDaggerheartSceneRange(ctx, db.PutDaggerheartSceneRangeParams{
+		CampaignID: sceneRange.CampaignID,
+		SessionID:  sceneRange.SessionID,
+		FromID:     sceneRange.FromID,
+		FromType:   sceneRange.FromType,
+		ToID:       sceneRange.ToID,
+		ToType:     sceneRange.ToType,
+		Band:       sceneRange.Band,
 	})
 }
 
-// GetDaggerheartClass retrieves a Daggerheart class catalog entry.
-func (s *Store) GetDaggerheartClass(ctx context.Context, id string) (storage.DaggerheartClass, error) {
+// GetDaggerheartSceneRange retrieves the range band between two scene
+// entities, in either order.
+func (s *Store) GetDaggerheartSceneRange(ctx context.Context, campaignID, sessionID, firstID, secondID string) (storage.DaggerheartSceneRange, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartClass{}, err
+		return storage.DaggerheartSceneRange{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartClass{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartSceneRange{}, fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartClass{}, fmt.Errorf("class id is required")
+	if strings.TrimSpace(campaignID) == "" {
+		return storage.DaggerheartSceneRange{}, fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(sessionID) == "" {
+		return storage.DaggerheartSceneRange{}, fmt.Errorf("session id is required")
+	}
+	if strings.TrimSpace(firstID) == "" || strings.TrimSpace(secondID) == "" {
+		return storage.DaggerheartSceneRange{}, fmt.Errorf("scene range entity ids are required")
+	}
+	if firstID > secondID {
+		firstID, secondID = secondID, firstID
 	}
 
-	row, err := s.q.GetDaggerheartClass(ctx, id)
+	row, err := s.q.GetDaggerheartSceneRange(ctx, db.GetDaggerheartSceneRangeParams{
+		CampaignID: campaignID,
+		SessionID:  sessionID,
+		FromID:     firstID,
+		ToID:       secondID,
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartClass{}, storage.ErrNotFound
+			return storage.DaggerheartSceneRange{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartClass{}, fmt.Errorf("get daggerheart class: %w", err)
+		return storage.DaggerheartSceneRange{}, fmt.Errorf("get daggerheart scene range: %w", err)
 	}
 
-	class, err := dbDaggerheartClassToStorage(row)
-	if err != nil {
-		return storage.DaggerheartClass{}, err
-	}
-	return class, nil
+	return dbDaggerheartSceneRangeToStorage(row), nil
 }
 
-// ListDaggerheartClasses lists all Daggerheart class catalog entries.
-func (s *Store) ListDaggerheartClasses(ctx context.Context) ([]storage.DaggerheartClass, error) {
+// ListDaggerheartSceneRanges retrieves every range band recorded for a session scene.
+func (s *Store) ListDaggerheartSceneRanges(ctx context.Context, campaignID, sessionID string) ([]storage.DaggerheartSceneRange, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
 	if s == nil || s.sqlDB == nil {
 		return nil, fmt.Errorf("storage is not configured")
 	}
+	if strings.TrimSpace(campaignID) == "" {
+		return nil, fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(sessionID) == "" {
+		return nil, fmt.Errorf("session id is required")
+	}
 
-	rows, err := s.q.ListDaggerheartClasses(ctx)
+	rows, err := s.q.ListDaggerheartSceneRanges(ctx, db.ListDaggerheartSceneRangesParams{
+		CampaignID: campaignID,
+		SessionID:  sessionID,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart classes: %w", err)
+		return nil, fmt.Errorf("list daggerheart scene ranges: %w", err)
 	}
 
-	classes := make([]storage.DaggerheartClass, 0, len(rows))
+	ranges := make([]storage.DaggerheartSceneRange, 0, len(rows))
 	for _, row := range rows {
-		class, err := dbDaggerheartClassToStorage(row)
-		if err != nil {
-			return nil, err
-		}
-		classes = append(classes, class)
+		ranges = append(ranges, dbDaggerheartSceneRangeToStorage(row))
 	}
-	return classes, nil
+	return ranges, nil
 }
 
-// DeleteDaggerheartClass removes a Daggerheart class catalog entry.
-func (s *Store) DeleteDaggerheartClass(ctx context.Context, id string) error {
+func dbDaggerheartSceneRangeToStorage(row db.DaggerheartSceneRange) storage.DaggerheartSceneRange {
+	return storage.DaggerheartSceneRange{
+		CampaignID: row.CampaignID,
+		SessionID:  row.SessionID,
+		FromID:     row.FromID,
+		FromType:   row.FromType,
+		ToID:       row.ToID,
+		ToType:     row.ToType,
+		Band:       row.Band,
+	}
+}
+
+// PutDaggerheartSessionEnvironment persists the environment active in a session.
+func (s *Store) PutDaggerheartSessionEnvironment(ctx context.Context, environment storage.DaggerheartSessionEnvironment) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("class id is required")
+	if strings.TrimSpace(environment.CampaignID) == "" {
+		return fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(environment.SessionID) == "" {
+		return fmt.Errorf("session id is required")
+	}
+	if strings.TrimSpace(environment.EnvironmentID) == "" {
+		return fmt.Errorf("environment id is required")
 	}
 
-	return s.q.DeleteDaggerheartClass(ctx, id)
+	return s.q.PutDaggerheartSessionEnvironment(ctx, db.PutDaggerheartSessionEnvironmentParams{
+		CampaignID:    environment.CampaignID,
+		SessionID:     environment.SessionID,
+		EnvironmentID: environment.EnvironmentID,
+		Name:          environment.Name,
+		Tier:          int64(environment.Tier),
+		Type:          environment.Type,
+		Difficulty:    int64(environment.Difficulty),
+	})
 }
 
-// PutDaggerheartSubclass persists a Daggerheart subclass catalog entry.
-func (s *Store) PutDaggerheartSubclass(ctx context.Context, subclass storage.DaggerheartSubclass) error {
+// GetDaggerheartSessionEnvironment retrieves the environment active in a session.
+func (s *Store) GetDaggerheartSessionEnvironment(ctx context.Context, campaignID, sessionID string) (storage.DaggerheartSessionEnvironment, error) {
 	if err := ctx.Err(); err != nil {
-		return err
+		return storage.DaggerheartSessionEnvironment{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return fmt.Errorf("storage is not configured")
+		return storage.DaggerheartSessionEnvironment{}, fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(subclass.ID) == "" {
-		return fmt.Errorf("subclass id is required")
+	if strings.TrimSpace(campaignID) == "" {
+		return storage.DaggerheartSessionEnvironment{}, fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(sessionID) == "" {
+		return storage.DaggerheartSessionEnvironment{}, fmt.Errorf("session id is required")
 	}
 
-	foundationJSON, err := json.Marshal(subclass.FoundationFeatures)
+	row, err := s.q.GetDaggerheartSessionEnvironment(ctx, db.GetDaggerheartSessionEnvironmentParams{
+		CampaignID: campaignID,
+		SessionID:  sessionID,
+	})
 	if err != nil {
-		return fmt.Errorf("marshal subclass foundation features: %w", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.DaggerheartSessionEnvironment{}, storage.ErrNotFound
+		}
+		return storage.DaggerheartSessionEnvironment{}, fmt.Errorf("get daggerheart session environment: %w", err)
 	}
-	specializationJSON, err := json.Marshal(subclass.SpecializationFeatures)
-	if err != nil {
-		return fmt.Errorf("marshal subclass specialization features: %w", err)
+
+	return storage.DaggerheartSessionEnvironment{
+		CampaignID:    row.CampaignID,
+		SessionID:     row.SessionID,
+		EnvironmentID: row.EnvironmentID,
+		Name:          row.Name,
+		Tier:          int(row.Tier),
+		Type:          row.Type,
+		Difficulty:    int(row.Difficulty),
+	}, nil
+}
+
+// DeleteDaggerheartSessionEnvironment clears the environment active in a session.
+func (s *Store) DeleteDaggerheartSessionEnvironment(ctx context.Context, campaignID, sessionID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
 	}
-	masteryJSON, err := json.Marshal(subclass.MasteryFeatures)
-	if err != nil {
-		return fmt.Errorf("marshal subclass mastery features: %w", err)
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(campaignID) == "" {
+		return fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(sessionID) == "" {
+		return fmt.Errorf("session id is required")
 	}
 
-	return s.q.PutDaggerheartSubclass(ctx, db.PutDaggerheartSubclassParams{
-		ID:                         subclass.ID,
-		Name:                       subclass.Name,
-		SpellcastTrait:             subclass.SpellcastTrait,
-		FoundationFeaturesJson:     string(foundationJSON),
-		SpecializationFeaturesJson: string(specializationJSON),
-		MasteryFeaturesJson:        string(masteryJSON),
-		CreatedAt:                  toMillis(subclass.CreatedAt),
-		UpdatedAt:                  toMillis(subclass.UpdatedAt),
+	return s.q.DeleteDaggerheartSessionEnvironment(ctx, db.DeleteDaggerheartSessionEnvironmentParams{
+		CampaignID: campaignID,
+		SessionID:  sessionID,
 	})
 }
 
-// GetDaggerheartSubclass retrieves a Daggerheart subclass catalog entry.
-func (s *Store) GetDaggerheartSubclass(ctx context.Context, id string) (storage.DaggerheartSubclass, error) {
+// PutDaggerheartCompanion persists the companion bonded to a character.
+func (s *Store) PutDaggerheartCompanion(ctx context.Context, companion storage.DaggerheartCompanion) error {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartSubclass{}, err
+		return err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartSubclass{}, fmt.Errorf("storage is not configured")
+		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartSubclass{}, fmt.Errorf("subclass id is required")
+	if strings.TrimSpace(companion.CampaignID) == "" {
+		return fmt.Errorf("campaign id is required")
 	}
-
-	row, err := s.q.GetDaggerheartSubclass(ctx, id)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartSubclass{}, storage.ErrNotFound
-		}
-		return storage.DaggerheartSubclass{}, fmt.Errorf("get daggerheart subclass: %w", err)
+	if strings.TrimSpace(companion.CharacterID) == "" {
+		return fmt.Errorf("character id is required")
+	}
+	if strings.TrimSpace(companion.Name) == "" {
+		return fmt.Errorf("companion name is required")
 	}
 
-	subclass, err := dbDaggerheartSubclassToStorage(row)
+	experiences := companion.Experiences
+	if experiences == nil {
+		experiences = []storage.DaggerheartCompanionExperience{}
+	}
+	experiencesJSON, err := json.Marshal(experiences)
 	if err != nil {
-		return storage.DaggerheartSubclass{}, err
+		return fmt.Errorf("encode companion experiences: %w", err)
 	}
-	return subclass, nil
+	upgrades := companion.Upgrades
+	if upgrades == nil {
+		upgrades = []string{}
+	}
+	upgradesJSON, err := json.Marshal(upgrades)
+	if err != nil {
+		return fmt.Errorf("encode companion upgrades: %w", err)
+	}
+
+	return s.q.PutDaggerheartCompanion(ctx, db.PutDaggerheartCompanionParams{
+		CampaignID:      companion.CampaignID,
+		CharacterID:     companion.CharacterID,
+		Name:            companion.Name,
+		Evasion:         int64(companion.Evasion),
+		Stress:          int64(companion.Stress),
+		StressMax:       int64(companion.StressMax),
+		DamageDieSides:  int64(companion.DamageDieSides),
+		AttackRange:     companion.Range,
+		ExperiencesJson: string(experiencesJSON),
+		Level:           int64(companion.Level),
+		UpgradesJson:    string(upgradesJSON),
+	})
 }
 
-// ListDaggerheartSubclasses lists all Daggerheart subclass catalog entries.
-func (s *Store) ListDaggerheartSubclasses(ctx context.Context) ([]storage.DaggerheartSubclass, error) {
+// GetDaggerheartCompanion retrieves the companion bonded to a character.
+func (s *Store) GetDaggerheartCompanion(ctx context.Context, campaignID, characterID string) (storage.DaggerheartCompanion, error) {
 	if err := ctx.Err(); err != nil {
-		return nil, err
+		return storage.DaggerheartCompanion{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return nil, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartCompanion{}, fmt.Errorf("storage is not configured")
 	}
-
-	rows, err := s.q.ListDaggerheartSubclasses(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("list daggerheart subclasses: %w", err)
+	if strings.TrimSpace(campaignID) == "" {
+		return storage.DaggerheartCompanion{}, fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(characterID) == "" {
+		return storage.DaggerheartCompanion{}, fmt.Errorf("character id is required")
 	}
 
-	subclasses := make([]storage.DaggerheartSubclass, 0, len(rows))
-	for _, row := range rows {
-		subclass, err := dbDaggerheartSubclassToStorage(row)
-		if err != nil {
-			return nil, err
+	row, err := s.q.GetDaggerheartCompanion(ctx, db.GetDaggerheartCompanionParams{
+		CampaignID:  campaignID,
+		CharacterID: characterID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.DaggerheartCompanion{}, storage.ErrNotFound
 		}
-		subclasses = append(subclasses, subclass)
+		return storage.DaggerheartCompanion{}, fmt.Errorf("get daggerheart companion: %w", err)
 	}
-	return subclasses, nil
-}
 
-// DeleteDaggerheartSubclass removes a Daggerheart subclass catalog entry.
-func (s *Store) DeleteDaggerheartSubclass(ctx context.Context, id string) error {
-	if err := ctx.Err(); err != nil {
-		return err
-	}
-	if s == nil || s.sqlDB == nil {
-		return fmt.Errorf("storage is not configured")
+	var experiences []storage.DaggerheartCompanionExperience
+	if row.ExperiencesJson != "" {
+		if err := json.Unmarshal([]byte(row.ExperiencesJson), &experiences); err != nil {
+			return storage.DaggerheartCompanion{}, fmt.Errorf("decode companion experiences: %w", err)
+		}
 	}
-	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("subclass id is required")
+	var upgrades []string
+	if row.UpgradesJson != "" {
+		if err := json.Unmarshal([]byte(row.UpgradesJson), &upgrades); err != nil {
+			return storage.DaggerheartCompanion{}, fmt.Errorf("decode companion upgrades: %w", err)
+		}
 	}
 
-	return s.q.DeleteDaggerheartSubclass(ctx, id)
+	return storage.DaggerheartCompanion{
+		CampaignID:     row.CampaignID,
+		CharacterID:    row.CharacterID,
+		Name:           row.Name,
+		Evasion:        int(row.Evasion),
+		Stress:         int(row.Stress),
+		StressMax:      int(row.StressMax),
+		DamageDieSides: int(row.DamageDieSides),
+		Range:          row.AttackRange,
+		Experiences:    experiences,
+		Level:          int(row.Level),
+		Upgrades:       upgrades,
+	}, nil
 }
 
-// PutDaggerheartHeritage persists a Daggerheart heritage catalog entry.
-func (s *Store) PutDaggerheartHeritage(ctx context.Context, heritage storage.DaggerheartHeritage) error {
+// PutDaggerheartEffect persists a timed effect projection.
+func (s *Store) PutDaggerheartEffect(ctx context.Context, effect storage.DaggerheartEffect) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(heritage.ID) == "" {
-		return fmt.Errorf("heritage id is required")
+	if strings.TrimSpace(effect.CampaignID) == "" {
+		return fmt.Errorf("campaign id is required")
 	}
-
-	featuresJSON, err := json.Marshal(heritage.Features)
-	if err != nil {
-		return fmt.Errorf("marshal heritage features: %w", err)
+	if strings.TrimSpace(effect.EffectID) == "" {
+		return fmt.Errorf("effect id is required")
+	}
+	if strings.TrimSpace(effect.TargetID) == "" {
+		return fmt.Errorf("target id is required")
 	}
 
-	return s.q.PutDaggerheartHeritage(ctx, db.PutDaggerheartHeritageParams{
-		ID:           heritage.ID,
-		Name:         heritage.Name,
-		Kind:         heritage.Kind,
-		FeaturesJson: string(featuresJSON),
-		CreatedAt:    toMillis(heritage.CreatedAt),
-		UpdatedAt:    toMillis(heritage.UpdatedAt),
+	return s.q.PutDaggerheartEffect(ctx, db.PutDaggerheartEffectParams{
+		CampaignID: effect.CampaignID,
+		EffectID:   effect.EffectID,
+		SessionID:  effect.SessionID,
+		TargetType: effect.TargetType,
+		TargetID:   effect.TargetID,
+		Source:     effect.Source,
+		Kind:       effect.Kind,
+		Value:      int64(effect.Value),
+		Stacking:   effect.Stacking,
+		ExpiresOn:  effect.ExpiresOn,
 	})
 }
 
-// GetDaggerheartHeritage retrieves a Daggerheart heritage catalog entry.
-func (s *Store) GetDaggerheartHeritage(ctx context.Context, id string) (storage.DaggerheartHeritage, error) {
+// GetDaggerheartEffect retrieves a timed effect projection.
+func (s *Store) GetDaggerheartEffect(ctx context.Context, campaignID, effectID string) (storage.DaggerheartEffect, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartHeritage{}, err
+		return storage.DaggerheartEffect{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartHeritage{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartEffect{}, fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartHeritage{}, fmt.Errorf("heritage id is required")
+	if strings.TrimSpace(campaignID) == "" {
+		return storage.DaggerheartEffect{}, fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(effectID) == "" {
+		return storage.DaggerheartEffect{}, fmt.Errorf("effect id is required")
 	}
 
-	row, err := s.q.GetDaggerheartHeritage(ctx, id)
+	row, err := s.q.GetDaggerheartEffect(ctx, db.GetDaggerheartEffectParams{
+		CampaignID: campaignID,
+		EffectID:   effectID,
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartHeritage{}, storage.ErrNotFound
+			return storage.DaggerheartEffect{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartHeritage{}, fmt.Errorf("get daggerheart heritage: %w", err)
+		return storage.DaggerheartEffect{}, fmt.Errorf("get daggerheart effect: %w", err)
 	}
-
-	heritage, err := dbDaggerheartHeritageToStorage(row)
-	if err != nil {
-		return storage.DaggerheartHeritage{}, err
-	}
-	return heritage, nil
+	return daggerheartEffectFromRow(row), nil
 }
 
-// ListDaggerheartHeritages lists all Daggerheart heritage catalog entries.
-func (s *Store) ListDaggerheartHeritages(ctx context.Context) ([]storage.DaggerheartHeritage, error) {
+// ListDaggerheartEffects lists the active timed effects in a campaign.
+func (s *Store) ListDaggerheartEffects(ctx context.Context, campaignID string) ([]storage.DaggerheartEffect, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
 	if s == nil || s.sqlDB == nil {
 		return nil, fmt.Errorf("storage is not configured")
 	}
+	if strings.TrimSpace(campaignID) == "" {
+		return nil, fmt.Errorf("campaign id is required")
+	}
 
-	rows, err := s.q.ListDaggerheartHeritages(ctx)
+	rows, err := s.q.ListDaggerheartEffects(ctx, campaignID)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart heritages: %w", err)
+		return nil, fmt.Errorf("list daggerheart effects: %w", err)
 	}
 
-	heritages := make([]storage.DaggerheartHeritage, 0, len(rows))
+	effects := make([]storage.DaggerheartEffect, 0, len(rows))
 	for _, row := range rows {
-		heritage, err := dbDaggerheartHeritageToStorage(row)
-		if err != nil {
-			return nil, err
-		}
-		heritages = append(heritages, heritage)
+		effects = append(effects, daggerheartEffectFromRow(row))
 	}
-	return heritages, nil
+	return effects, nil
 }
 
-// DeleteDaggerheartHeritage removes a Daggerheart heritage catalog entry.
-func (s *Store) DeleteDaggerheartHeritage(ctx context.Context, id string) error {
+// DeleteDaggerheartEffect removes a timed effect projection.
+func (s *Store) DeleteDaggerheartEffect(ctx context.Context, campaignID, effectID string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("heritage id is required")
+	if strings.TrimSpace(campaignID) == "" {
+		return fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(effectID) == "" {
+		return fmt.Errorf("effect id is required")
 	}
 
-	return s.q.DeleteDaggerheartHeritage(ctx, id)
+	return s.q.DeleteDaggerheartEffect(ctx, db.DeleteDaggerheartEffectParams{
+		CampaignID: campaignID,
+		EffectID:   effectID,
+	})
 }
 
-// PutDaggerheartExperience persists a Daggerheart experience catalog entry.
-func (s *Store) PutDaggerheartExperience(ctx context.Context, experience storage.DaggerheartExperienceEntry) error {
+func daggerheartEffectFromRow(row db.DaggerheartEffect) storage.DaggerheartEffect {
+	return storage.DaggerheartEffect{
+		CampaignID: row.CampaignID,
+		EffectID:   row.EffectID,
+		SessionID:  row.SessionID,
+		TargetType: row.TargetType,
+		TargetID:   row.TargetID,
+		Source:     row.Source,
+		Kind:       row.Kind,
+		Value:      int(row.Value),
+		Stacking:   row.Stacking,
+		ExpiresOn:  row.ExpiresOn,
+	}
+}
+
+// PutDaggerheartChase persists a chase projection.
+func (s *Store) PutDaggerheartChase(ctx context.Context, chase storage.DaggerheartChase) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(experience.ID) == "" {
-		return fmt.Errorf("experience id is required")
+	if strings.TrimSpace(chase.CampaignID) == "" {
+		return fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(chase.ChaseID) == "" {
+		return fmt.Errorf("chase id is required")
 	}
 
-	return s.q.PutDaggerheartExperience(ctx, db.PutDaggerheartExperienceParams{
-		ID:          experience.ID,
-		Name:        experience.Name,
-		Description: experience.Description,
-		CreatedAt:   toMillis(experience.CreatedAt),
-		UpdatedAt:   toMillis(experience.UpdatedAt),
+	return s.q.PutDaggerheartChase(ctx, db.PutDaggerheartChaseParams{
+		CampaignID:             chase.CampaignID,
+		ChaseID:                chase.ChaseID,
+		Name:                   chase.Name,
+		ProgressCountdownID:    chase.ProgressCountdownID,
+		ConsequenceCountdownID: chase.ConsequenceCountdownID,
+		Status:                 chase.Status,
+		Winner:                 chase.Winner,
 	})
 }
 
-// GetDaggerheartExperience retrieves a Daggerheart experience catalog entry.
-func (s *Store) GetDaggerheartExperience(ctx context.Context, id string) (storage.DaggerheartExperienceEntry, error) {
+// GetDaggerheartChase retrieves a chase projection.
+func (s *Store) GetDaggerheartChase(ctx context.Context, campaignID, chaseID string) (storage.DaggerheartChase, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartExperienceEntry{}, err
+		return storage.DaggerheartChase{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartExperienceEntry{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartChase{}, fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartExperienceEntry{}, fmt.Errorf("experience id is required")
+	if strings.TrimSpace(campaignID) == "" {
+		return storage.DaggerheartChase{}, fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(chaseID) == "" {
+		return storage.DaggerheartChase{}, fmt.Errorf("chase id is required")
 	}
 
-	row, err := s.q.GetDaggerheartExperience(ctx, id)
-	if err != nil {
+	row, err := s.q.GetDaggerheartChase(ctx, db.GetDaggerheartChaseParams{
+		CampaignID: campaignID,
+		ChaseID:    chaseID,
+	})
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartExperienceEntry{}, storage.ErrNotFound
+			return storage.DaggerheartChase{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartExperienceEntry{}, fmt.Errorf("get daggerheart experience: %w", err)
+		return storage.DaggerheartChase{}, fmt.Errorf("get daggerheart chase: %w", err)
 	}
-
-	return dbDaggerheartExperienceToStorage(row), nil
+	return daggerheartChaseFromRow(row), nil
 }
 
-// ListDaggerheartExperiences lists all Daggerheart experience catalog entries.
-func (s *Store) ListDaggerheartExperiences(ctx context.Context) ([]storage.DaggerheartExperienceEntry, error) {
+// ListDaggerheartChases lists the chases in a campaign.
+func (s *Store) ListDaggerheartChases(ctx context.Context, campaignID string) ([]storage.DaggerheartChase, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
 	if s == nil || s.sqlDB == nil {
 		return nil, fmt.Errorf("storage is not configured")
 	}
+	if strings.TrimSpace(campaignID) == "" {
+		return nil, fmt.Errorf("campaign id is required")
+	}
 
-	rows, err := s.q.ListDaggerheartExperiences(ctx)
+	rows, err := s.q.ListDaggerheartChases(ctx, campaignID)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart experiences: %w", err)
+		return nil, fmt.Errorf("list daggerheart chases: %w", err)
 	}
 
-	experiences := make([]storage.DaggerheartExperienceEntry, 0, len(rows))
+	chases := make([]storage.DaggerheartChase, 0, len(rows))
 	for _, row := range rows {
-		experiences = append(experiences, dbDaggerheartExperienceToStorage(row))
+		chases = append(chases, daggerheartChaseFromRow(row))
 	}
-	return experiences, nil
+	return chases, nil
 }
 
-// DeleteDaggerheartExperience removes a Daggerheart experience catalog entry.
-func (s *Store) DeleteDaggerheartExperience(ctx context.Context, id string) error {
+func daggerheartChaseFromRow(row db.DaggerheartChase) storage.DaggerheartChase {
+	return storage.DaggerheartChase{
+		CampaignID:             row.CampaignID,
+		ChaseID:                row.ChaseID,
+		Name:                   row.Name,
+		ProgressCountdownID:    row.ProgressCountdownID,
+		ConsequenceCountdownID: row.ConsequenceCountdownID,
+		Status:                 row.Status,
+		Winner:                 row.Winner,
+	}
+}
+
+// PutDaggerheartAdversaryFeatureState persists an adversary feature cooldown.
+func (s *Store) PutDaggerheartAdversaryFeatureState(ctx context.Context, state storage.DaggerheartAdversaryFeatureState) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("experience id is required")
+	if strings.TrimSpace(state.CampaignID) == "" {
+		return fmt.Errorf("campaign id is required")
+	}
+	if strings.TrimSpace(state.AdversaryID) == "" {
+		return fmt.Errorf("adversary id is required")
+	}
+	if strings.TrimSpace(state.FeatureID) == "" {
+		return fmt.Errorf("feature id is required")
 	}
 
-	return s.q.DeleteDaggerheartExperience(ctx, id)
+	return s.q.PutDaggerheartAdversaryFeatureState(ctx, db.PutDaggerheartAdversaryFeatureStateParams{
+		CampaignID:  state.CampaignID,
+		AdversaryID: state.AdversaryID,
+		FeatureID:   state.FeatureID,
+		Status:      state.Status,
+		RefreshOn:   state.RefreshOn,
+	})
 }
 
-// PutDaggerheartAdversaryEntry persists a Daggerheart adversary catalog entry.
-func (s *Store) PutDaggerheartAdversaryEntry(ctx context.Context, adversary storage.DaggerheartAdversaryEntry) error {
+// ListDaggerheartAdversaryFeatureStates lists the adversary feature cooldowns
+// in a campaign.
+func (s *Store) ListDaggerheartAdversaryFeatureStates(ctx context.Context, campaignID string) ([]storage.DaggerheartAdversaryFeatureState, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(campaignID) == "" {
+		return nil, fmt.Errorf("campaign id is required")
+	}
+
+	rows, err := s.q.ListDaggerheartAdversaryFeatureStates(ctx, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("list daggerheart adversary feature states: %w", err)
+	}
+
+	states := make([]storage.DaggerheartAdversaryFeatureState, 0, len(rows))
+	for _, row := range rows {
+		states = append(states, storage.DaggerheartAdversaryFeatureState{
+			CampaignID:  row.CampaignID,
+			AdversaryID: row.AdversaryID,
+			FeatureID:   row.FeatureID,
+			Status:      row.Status,
+			RefreshOn:   row.RefreshOn,
+		})
+	}
+	return states, nil
+}
+
+// Daggerheart content catalog methods
+
+// PutDaggerheartClass persists a Daggerheart class catalog entry.
+func (s *Store) PutDaggerheartClass(ctx context.Context, class storage.DaggerheartClass) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(adversary.ID) == "" {
-		return fmt.Errorf("adversary id is required")
+	if strings.TrimSpace(class.ID) == "" {
+		return fmt.Errorf("class id is required")
 	}
 
-	attackJSON, err := json.Marshal(adversary.StandardAttack)
+	startingItemsJSON, err := json.Marshal(class.StartingItems)
 	if err != nil {
-		return fmt.Errorf("marshal adversary standard attack: %w", err)
+		return fmt.Errorf("marshal class starting items: %w", err)
 	}
-	experiencesJSON, err := json.Marshal(adversary.Experiences)
+	featuresJSON, err := json.Marshal(class.Features)
 	if err != nil {
-		return fmt.Errorf("marshal adversary experiences: %w", err)
+		return fmt.Errorf("marshal class features: %w", err)
 	}
-	featuresJSON, err := json.Marshal(adversary.Features)
+	hopeFeatureJSON, err := json.Marshal(class.HopeFeature)
 	if err != nil {
-		return fmt.Errorf("marshal adversary features: %w", err)
+		return fmt.Errorf("marshal class hope feature: %w", err)
+	}
+	domainIDsJSON, err := json.Marshal(class.DomainIDs)
+	if err != nil {
+		return fmt.Errorf("marshal class domain ids: %w", err)
 	}
 
-	return s.q.PutDaggerheartAdversaryEntry(ctx, db.PutDaggerheartAdversaryEntryParams{
-		ID:                 adversary.ID,
-		Name:               adversary.Name,
-		Tier:               int64(adversary.Tier),
-		Role:               adversary.Role,
-		Description:        adversary.Description,
-		Motives:            adversary.Motives,
-		Difficulty:         int64(adversary.Difficulty),
-		MajorThreshold:     int64(adversary.MajorThreshold),
-		SevereThreshold:    int64(adversary.SevereThreshold),
-		Hp:                 int64(adversary.HP),
-		Stress:             int64(adversary.Stress),
-		Armor:              int64(adversary.Armor),
-		AttackModifier:     int64(adversary.AttackModifier),
-		StandardAttackJson: string(attackJSON),
-		ExperiencesJson:    string(experiencesJSON),
-		FeaturesJson:       string(featuresJSON),
-		CreatedAt:          toMillis(adversary.CreatedAt),
-		UpdatedAt:          toMillis(adversary.UpdatedAt),
+	return s.q.PutDaggerheartClass(ctx, db.PutDaggerheartClassParams{
+		ID:                class.ID,
+		Name:              class.Name,
+		StartingEvasion:   int64(class.StartingEvasion),
+		StartingHp:        int64(class.StartingHP),
+		StartingItemsJson: string(startingItemsJSON),
+		FeaturesJson:      string(featuresJSON),
+		HopeFeatureJson:   string(hopeFeatureJSON),
+		DomainIdsJson:     string(domainIDsJSON),
+		CreatedAt:         toMillis(class.CreatedAt),
+		UpdatedAt:         toMillis(class.UpdatedAt),
 	})
 }
 
-// GetDaggerheartAdversaryEntry retrieves a Daggerheart adversary catalog entry.
-func (s *Store) GetDaggerheartAdversaryEntry(ctx context.Context, id string) (storage.DaggerheartAdversaryEntry, error) {
+// GetDaggerheartClass retrieves a Daggerheart class catalog entry.
+func (s *Store) GetDaggerheartClass(ctx context.Context, id string) (storage.DaggerheartClass, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartAdversaryEntry{}, err
+		return storage.DaggerheartClass{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartAdversaryEntry{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartClass{}, fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartAdversaryEntry{}, fmt.Errorf("adversary id is required")
+		return storage.DaggerheartClass{}, fmt.Errorf("class id is required")
 	}
 
-	row, err := s.q.GetDaggerheartAdversaryEntry(ctx, id)
+	row, err := s.q.GetDaggerheartClass(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartAdversaryEntry{}, storage.ErrNotFound
+			return storage.DaggerheartClass{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartAdversaryEntry{}, fmt.Errorf("get daggerheart adversary: %w", err)
+		return storage.DaggerheartClass{}, fmt.Errorf("get daggerheart class: %w", err)
 	}
 
-	return dbDaggerheartAdversaryEntryToStorage(row)
+	class, err := dbDaggerheartClassToStorage(row)
+	if err != nil {
+		return storage.DaggerheartClass{}, err
+	}
+	return class, nil
 }
 
-// ListDaggerheartAdversaryEntries lists all Daggerheart adversary catalog entries.
-func (s *Store) ListDaggerheartAdversaryEntries(ctx context.Context) ([]storage.DaggerheartAdversaryEntry, error) {
+// ListDaggerheartClasses lists all Daggerheart class catalog entries.
+func (s *Store) ListDaggerheartClasses(ctx context.Context) ([]storage.DaggerheartClass, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -3623,24 +3885,24 @@ func (s *Store) ListDaggerheartAdversaryEntries(ctx context.Context) ([]storage.
 		return nil, fmt.Errorf("storage is not configured")
 	}
 
-	rows, err := s.q.ListDaggerheartAdversaryEntries(ctx)
+	rows, err := s.q.ListDaggerheartClasses(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart adversaries: %w", err)
+		return nil, fmt.Errorf("list daggerheart classes: %w", err)
 	}
 
-	adversaries := make([]storage.DaggerheartAdversaryEntry, 0, len(rows))
+	classes := make([]storage.DaggerheartClass, 0, len(rows))
 	for _, row := range rows {
-		entry, err := dbDaggerheartAdversaryEntryToStorage(row)
+		class, err := dbDaggerheartClassToStorage(row)
 		if err != nil {
 			return nil, err
 		}
-		adversaries = append(adversaries, entry)
+		classes = append(classes, class)
 	}
-	return adversaries, nil
+	return classes, nil
 }
 
-// DeleteDaggerheartAdversaryEntry removes a Daggerheart adversary catalog entry.
-func (s *Store) DeleteDaggerheartAdversaryEntry(ctx context.Context, id string) error {
+// DeleteDaggerheartClass removes a Daggerheart class catalog entry.
+func (s *Store) DeleteDaggerheartClass(ctx context.Context, id string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -3648,78 +3910,78 @@ func (s *Store) DeleteDaggerheartAdversaryEntry(ctx context.Context, id string)
 		return fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("adversary id is required")
+		return fmt.Errorf("class id is required")
 	}
 
-	return s.q.DeleteDaggerheartAdversaryEntry(ctx, id)
+	return s.q.DeleteDaggerheartClass(ctx, id)
 }
 
-// PutDaggerheartBeastform persists a Daggerheart beastform catalog entry.
-func (s *Store) PutDaggerheartBeastform(ctx context.Context, beastform storage.DaggerheartBeastformEntry) error {
+// PutDaggerheartSubclass persists a Daggerheart subclass catalog entry.
+func (s *Store) PutDaggerheartSubclass(ctx context.Context, subclass storage.DaggerheartSubclass) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(beastform.ID) == "" {
-		return fmt.Errorf("beastform id is required")
+	if strings.TrimSpace(subclass.ID) == "" {
+		return fmt.Errorf("subclass id is required")
 	}
 
-	attackJSON, err := json.Marshal(beastform.Attack)
+	foundationJSON, err := json.Marshal(subclass.FoundationFeatures)
 	if err != nil {
-		return fmt.Errorf("marshal beastform attack: %w", err)
+		return fmt.Errorf("marshal subclass foundation features: %w", err)
 	}
-	advantagesJSON, err := json.Marshal(beastform.Advantages)
+	specializationJSON, err := json.Marshal(subclass.SpecializationFeatures)
 	if err != nil {
-		return fmt.Errorf("marshal beastform advantages: %w", err)
+		return fmt.Errorf("marshal subclass specialization features: %w", err)
 	}
-	featuresJSON, err := json.Marshal(beastform.Features)
+	masteryJSON, err := json.Marshal(subclass.MasteryFeatures)
 	if err != nil {
-		return fmt.Errorf("marshal beastform features: %w", err)
+		return fmt.Errorf("marshal subclass mastery features: %w", err)
 	}
 
-	return s.q.PutDaggerheartBeastform(ctx, db.PutDaggerheartBeastformParams{
-		ID:             beastform.ID,
-		Name:           beastform.Name,
-		Tier:           int64(beastform.Tier),
-		Examples:       beastform.Examples,
-		Trait:          beastform.Trait,
-		TraitBonus:     int64(beastform.TraitBonus),
-		EvasionBonus:   int64(beastform.EvasionBonus),
-		AttackJson:     string(attackJSON),
-		AdvantagesJson: string(advantagesJSON),
-		FeaturesJson:   string(featuresJSON),
-		CreatedAt:      toMillis(beastform.CreatedAt),
-		UpdatedAt:      toMillis(beastform.UpdatedAt),
+	return s.q.PutDaggerheartSubclass(ctx, db.PutDaggerheartSubclassParams{
+		ID:                         subclass.ID,
+		Name:                       subclass.Name,
+		SpellcastTrait:             subclass.SpellcastTrait,
+		FoundationFeaturesJson:     string(foundationJSON),
+		SpecializationFeaturesJson: string(specializationJSON),
+		MasteryFeaturesJson:        string(masteryJSON),
+		CreatedAt:                  toMillis(subclass.CreatedAt),
+		UpdatedAt:                  toMillis(subclass.UpdatedAt),
 	})
 }
 
-// GetDaggerheartBeastform retrieves a Daggerheart beastform catalog entry.
-func (s *Store) GetDaggerheartBeastform(ctx context.Context, id string) (storage.DaggerheartBeastformEntry, error) {
+// GetDaggerheartSubclass retrieves a Daggerheart subclass catalog entry.
+func (s *Store) GetDaggerheartSubclass(ctx context.Context, id string) (storage.DaggerheartSubclass, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartBeastformEntry{}, err
+		return storage.DaggerheartSubclass{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartBeastformEntry{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartSubclass{}, fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartBeastformEntry{}, fmt.Errorf("beastform id is required")
+		return storage.DaggerheartSubclass{}, fmt.Errorf("subclass id is required")
 	}
 
-	row, err := s.q.GetDaggerheartBeastform(ctx, id)
+	row, err := s.q.GetDaggerheartSubclass(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartBeastformEntry{}, storage.ErrNotFound
+			return storage.DaggerheartSubclass{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartBeastformEntry{}, fmt.Errorf("get daggerheart beastform: %w", err)
+		return storage.DaggerheartSubclass{}, fmt.Errorf("get daggerheart subclass: %w", err)
 	}
 
-	return dbDaggerheartBeastformToStorage(row)
+	subclass, err := dbDaggerheartSubclassToStorage(row)
+	if err != nil {
+		return storage.DaggerheartSubclass{}, err
+	}
+	return subclass, nil
 }
 
-// ListDaggerheartBeastforms lists all Daggerheart beastform catalog entries.
-func (s *Store) ListDaggerheartBeastforms(ctx context.Context) ([]storage.DaggerheartBeastformEntry, error) {
+// ListDaggerheartSubclasses lists all Daggerheart subclass catalog entries.
+func (s *Store) ListDaggerheartSubclasses(ctx context.Context) ([]storage.DaggerheartSubclass, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -3727,24 +3989,24 @@ func (s *Store) ListDaggerheartBeastforms(ctx context.Context) ([]storage.Dagger
 		return nil, fmt.Errorf("storage is not configured")
 	}
 
-	rows, err := s.q.ListDaggerheartBeastforms(ctx)
+	rows, err := s.q.ListDaggerheartSubclasses(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart beastforms: %w", err)
+		return nil, fmt.Errorf("list daggerheart subclasses: %w", err)
 	}
 
-	beastforms := make([]storage.DaggerheartBeastformEntry, 0, len(rows))
+	subclasses := make([]storage.DaggerheartSubclass, 0, len(rows))
 	for _, row := range rows {
-		entry, err := dbDaggerheartBeastformToStorage(row)
+		subclass, err := dbDaggerheartSubclassToStorage(row)
 		if err != nil {
 			return nil, err
 		}
-		beastforms = append(beastforms, entry)
+		subclasses = append(subclasses, subclass)
 	}
-	return beastforms, nil
+	return subclasses, nil
 }
 
-// DeleteDaggerheartBeastform removes a Daggerheart beastform catalog entry.
-func (s *Store) DeleteDaggerheartBeastform(ctx context.Context, id string) error {
+// DeleteDaggerheartSubclass removes a Daggerheart subclass catalog entry.
+func (s *Store) DeleteDaggerheartSubclass(ctx context.Context, id string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -3752,58 +4014,68 @@ func (s *Store) DeleteDaggerheartBeastform(ctx context.Context, id string) error
 		return fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("beastform id is required")
+		return fmt.Errorf("subclass id is required")
 	}
 
-	return s.q.DeleteDaggerheartBeastform(ctx, id)
+	return s.q.DeleteDaggerheartSubclass(ctx, id)
 }
 
-// PutDaggerheartCompanionExperience persists a Daggerheart companion experience catalog entry.
-func (s *Store) PutDaggerheartCompanionExperience(ctx context.Context, experience storage.DaggerheartCompanionExperienceEntry) error {
+// PutDaggerheartHeritage persists a Daggerheart heritage catalog entry.
+func (s *Store) PutDaggerheartHeritage(ctx context.Context, heritage storage.DaggerheartHeritage) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(experience.ID) == "" {
-		return fmt.Errorf("companion experience id is required")
+	if strings.TrimSpace(heritage.ID) == "" {
+		return fmt.Errorf("heritage id is required")
 	}
 
-	return s.q.PutDaggerheartCompanionExperience(ctx, db.PutDaggerheartCompanionExperienceParams{
-		ID:          experience.ID,
-		Name:        experience.Name,
-		Description: experience.Description,
-		CreatedAt:   toMillis(experience.CreatedAt),
-		UpdatedAt:   toMillis(experience.UpdatedAt),
+	featuresJSON, err := json.Marshal(heritage.Features)
+	if err != nil {
+		return fmt.Errorf("marshal heritage features: %w", err)
+	}
+
+	return s.q.PutDaggerheartHeritage(ctx, db.PutDaggerheartHeritageParams{
+		ID:           heritage.ID,
+		Name:         heritage.Name,
+		Kind:         heritage.Kind,
+		FeaturesJson: string(featuresJSON),
+		CreatedAt:    toMillis(heritage.CreatedAt),
+		UpdatedAt:    toMillis(heritage.UpdatedAt),
 	})
 }
 
-// GetDaggerheartCompanionExperience retrieves a Daggerheart companion experience catalog entry.
-func (s *Store) GetDaggerheartCompanionExperience(ctx context.Context, id string) (storage.DaggerheartCompanionExperienceEntry, error) {
+// GetDaggerheartHeritage retrieves a Daggerheart heritage catalog entry.
+func (s *Store) GetDaggerheartHeritage(ctx context.Context, id string) (storage.DaggerheartHeritage, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartCompanionExperienceEntry{}, err
+		return storage.DaggerheartHeritage{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartCompanionExperienceEntry{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartHeritage{}, fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartCompanionExperienceEntry{}, fmt.Errorf("companion experience id is required")
+		return storage.DaggerheartHeritage{}, fmt.Errorf("heritage id is required")
 	}
 
-	row, err := s.q.GetDaggerheartCompanionExperience(ctx, id)
+	row, err := s.q.GetDaggerheartHeritage(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartCompanionExperienceEntry{}, storage.ErrNotFound
+			return storage.DaggerheartHeritage{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartCompanionExperienceEntry{}, fmt.Errorf("get daggerheart companion experience: %w", err)
+		return storage.DaggerheartHeritage{}, fmt.Errorf("get daggerheart heritage: %w", err)
 	}
 
-	return dbDaggerheartCompanionExperienceToStorage(row), nil
+	heritage, err := dbDaggerheartHeritageToStorage(row)
+	if err != nil {
+		return storage.DaggerheartHeritage{}, err
+	}
+	return heritage, nil
 }
 
-// ListDaggerheartCompanionExperiences lists all Daggerheart companion experience catalog entries.
-func (s *Store) ListDaggerheartCompanionExperiences(ctx context.Context) ([]storage.DaggerheartCompanionExperienceEntry, error) {
+// ListDaggerheartHeritages lists all Daggerheart heritage catalog entries.
+func (s *Store) ListDaggerheartHeritages(ctx context.Context) ([]storage.DaggerheartHeritage, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -3811,20 +4083,24 @@ func (s *Store) ListDaggerheartCompanionExperiences(ctx context.Context) ([]stor
 		return nil, fmt.Errorf("storage is not configured")
 	}
 
-	rows, err := s.q.ListDaggerheartCompanionExperiences(ctx)
+	rows, err := s.q.ListDaggerheartHeritages(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart companion experiences: %w", err)
+		return nil, fmt.Errorf("list daggerheart heritages: %w", err)
 	}
 
-	experiences := make([]storage.DaggerheartCompanionExperienceEntry, 0, len(rows))
+	heritages := make([]storage.DaggerheartHeritage, 0, len(rows))
 	for _, row := range rows {
-		experiences = append(experiences, dbDaggerheartCompanionExperienceToStorage(row))
+		heritage, err := dbDaggerheartHeritageToStorage(row)
+		if err != nil {
+			return nil, err
+		}
+		heritages = append(heritages, heritage)
 	}
-	return experiences, nil
+	return heritages, nil
 }
 
-// DeleteDaggerheartCompanionExperience removes a Daggerheart companion experience catalog entry.
-func (s *Store) DeleteDaggerheartCompanionExperience(ctx context.Context, id string) error {
+// DeleteDaggerheartHeritage removes a Daggerheart heritage catalog entry.
+func (s *Store) DeleteDaggerheartHeritage(ctx context.Context, id string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -3832,59 +4108,58 @@ func (s *Store) DeleteDaggerheartCompanionExperience(ctx context.Context, id str
 		return fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("companion experience id is required")
+		return fmt.Errorf("heritage id is required")
 	}
 
-	return s.q.DeleteDaggerheartCompanionExperience(ctx, id)
+	return s.q.DeleteDaggerheartHeritage(ctx, id)
 }
 
-// PutDaggerheartLootEntry persists a Daggerheart loot catalog entry.
-func (s *Store) PutDaggerheartLootEntry(ctx context.Context, entry storage.DaggerheartLootEntry) error {
+// PutDaggerheartExperience persists a Daggerheart experience catalog entry.
+func (s *Store) PutDaggerheartExperience(ctx context.Context, experience storage.DaggerheartExperienceEntry) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(entry.ID) == "" {
-		return fmt.Errorf("loot entry id is required")
+	if strings.TrimSpace(experience.ID) == "" {
+		return fmt.Errorf("experience id is required")
 	}
 
-	return s.q.PutDaggerheartLootEntry(ctx, db.PutDaggerheartLootEntryParams{
-		ID:          entry.ID,
-		Name:        entry.Name,
-		Roll:        int64(entry.Roll),
-		Description: entry.Description,
-		CreatedAt:   toMillis(entry.CreatedAt),
-		UpdatedAt:   toMillis(entry.UpdatedAt),
+	return s.q.PutDaggerheartExperience(ctx, db.PutDaggerheartExperienceParams{
+		ID:          experience.ID,
+		Name:        experience.Name,
+		Description: experience.Description,
+		CreatedAt:   toMillis(experience.CreatedAt),
+		UpdatedAt:   toMillis(experience.UpdatedAt),
 	})
 }
 
-// GetDaggerheartLootEntry retrieves a Daggerheart loot catalog entry.
-func (s *Store) GetDaggerheartLootEntry(ctx context.Context, id string) (storage.DaggerheartLootEntry, error) {
+// GetDaggerheartExperience retrieves a Daggerheart experience catalog entry.
+func (s *Store) GetDaggerheartExperience(ctx context.Context, id string) (storage.DaggerheartExperienceEntry, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartLootEntry{}, err
+		return storage.DaggerheartExperienceEntry{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartLootEntry{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartExperienceEntry{}, fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartLootEntry{}, fmt.Errorf("loot entry id is required")
+		return storage.DaggerheartExperienceEntry{}, fmt.Errorf("experience id is required")
 	}
 
-	row, err := s.q.GetDaggerheartLootEntry(ctx, id)
+	row, err := s.q.GetDaggerheartExperience(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartLootEntry{}, storage.ErrNotFound
+			return storage.DaggerheartExperienceEntry{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartLootEntry{}, fmt.Errorf("get daggerheart loot entry: %w", err)
+		return storage.DaggerheartExperienceEntry{}, fmt.Errorf("get daggerheart experience: %w", err)
 	}
 
-	return dbDaggerheartLootEntryToStorage(row), nil
+	return dbDaggerheartExperienceToStorage(row), nil
 }
 
-// ListDaggerheartLootEntries lists all Daggerheart loot catalog entries.
-func (s *Store) ListDaggerheartLootEntries(ctx context.Context) ([]storage.DaggerheartLootEntry, error) {
+// ListDaggerheartExperiences lists all Daggerheart experience catalog entries.
+func (s *Store) ListDaggerheartExperiences(ctx context.Context) ([]storage.DaggerheartExperienceEntry, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -3892,20 +4167,20 @@ func (s *Store) ListDaggerheartLootEntries(ctx context.Context) ([]storage.Dagge
 		return nil, fmt.Errorf("storage is not configured")
 	}
 
-	rows, err := s.q.ListDaggerheartLootEntries(ctx)
+	rows, err := s.q.ListDaggerheartExperiences(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart loot entries: %w", err)
+		return nil, fmt.Errorf("list daggerheart experiences: %w", err)
 	}
 
-	entries := make([]storage.DaggerheartLootEntry, 0, len(rows))
+	experiences := make([]storage.DaggerheartExperienceEntry, 0, len(rows))
 	for _, row := range rows {
-		entries = append(entries, dbDaggerheartLootEntryToStorage(row))
+		experiences = append(experiences, dbDaggerheartExperienceToStorage(row))
 	}
-	return entries, nil
+	return experiences, nil
 }
 
-// DeleteDaggerheartLootEntry removes a Daggerheart loot catalog entry.
-func (s *Store) DeleteDaggerheartLootEntry(ctx context.Context, id string) error {
+// DeleteDaggerheartExperience removes a Daggerheart experience catalog entry.
+func (s *Store) DeleteDaggerheartExperience(ctx context.Context, id string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -3913,58 +4188,84 @@ func (s *Store) DeleteDaggerheartLootEntry(ctx context.Context, id string) error
 		return fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("loot entry id is required")
+		return fmt.Errorf("experience id is required")
 	}
 
-	return s.q.DeleteDaggerheartLootEntry(ctx, id)
+	return s.q.DeleteDaggerheartExperience(ctx, id)
 }
 
-// PutDaggerheartDamageType persists a Daggerheart damage type catalog entry.
-func (s *Store) PutDaggerheartDamageType(ctx context.Context, entry storage.DaggerheartDamageTypeEntry) error {
+// PutDaggerheartAdversaryEntry persists a Daggerheart adversary catalog entry.
+func (s *Store) PutDaggerheartAdversaryEntry(ctx context.Context, adversary storage.DaggerheartAdversaryEntry) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(entry.ID) == "" {
-		return fmt.Errorf("damage type id is required")
+	if strings.TrimSpace(adversary.ID) == "" {
+		return fmt.Errorf("adversary id is required")
 	}
 
-	return s.q.PutDaggerheartDamageType(ctx, db.PutDaggerheartDamageTypeParams{
-		ID:          entry.ID,
-		Name:        entry.Name,
-		Description: entry.Description,
-		CreatedAt:   toMillis(entry.CreatedAt),
-		UpdatedAt:   toMillis(entry.UpdatedAt),
+	attackJSON, err := json.Marshal(adversary.StandardAttack)
+	if err != nil {
+		return fmt.Errorf("marshal adversary standard attack: %w", err)
+	}
+	experiencesJSON, err := json.Marshal(adversary.Experiences)
+	if err != nil {
+		return fmt.Errorf("marshal adversary experiences: %w", err)
+	}
+	featuresJSON, err := json.Marshal(adversary.Features)
+	if err != nil {
+		return fmt.Errorf("marshal adversary features: %w", err)
+	}
+
+	return s.q.PutDaggerheartAdversaryEntry(ctx, db.PutDaggerheartAdversaryEntryParams{
+		ID:                 adversary.ID,
+		Name:               adversary.Name,
+		Tier:               int64(adversary.Tier),
+		Role:               adversary.Role,
+		Description:        adversary.Description,
+		Motives:            adversary.Motives,
+		Difficulty:         int64(adversary.Difficulty),
+		MajorThreshold:     int64(adversary.MajorThreshold),
+		SevereThreshold:    int64(adversary.SevereThreshold),
+		Hp:                 int64(adversary.HP),
+		Stress:             int64(adversary.Stress),
+		Armor:              int64(adversary.Armor),
+		AttackModifier:     int64(adversary.AttackModifier),
+		StandardAttackJson: string(attackJSON),
+		ExperiencesJson:    string(experiencesJSON),
+		FeaturesJson:       string(featuresJSON),
+		CreatedAt:          toMillis(adversary.CreatedAt),
+		UpdatedAt:          toMillis(adversary.UpdatedAt),
 	})
 }
 
-// GetDaggerheartDamageType retrieves a Daggerheart damage type catalog entry.
-func (s *Store) GetDaggerheartDamageType(ctx context.Context, id string) (storage.DaggerheartDamageTypeEntry, error) {
+// GetDaggerheartAdversaryEntry retrieves a Daggerheart adversary catalog entry.
+func (s *Store) GetDaggerheartAdversaryEntry(ctx context.Context, id string) (storage.DaggerheartAdversaryEntry, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartDamageTypeEntry{}, err
+		return storage.DaggerheartAdversaryEntry{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartDamageTypeEntry{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartAdversaryEntry{}, fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartDamageTypeEntry{}, fmt.Errorf("damage type id is required")
+		return storage.DaggerheartAdversaryEntry{}, fmt.Errorf("adversary id is required")
 	}
 
-	row, err := s.q.GetDaggerheartDamageType(ctx, id)
+	row, err := s.q.GetDaggerheartAdversaryEntry(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartDamageTypeEntry{}, storage.ErrNotFound
+			return storage.DaggerheartAdversaryEntry{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartDamageTypeEntry{}, fmt.Errorf("get daggerheart damage type: %w", err)
+		return storage.DaggerheartAdversaryEntry{}, fmt.Errorf("get daggerheart adversary: %w", err)
 	}
 
-	return dbDaggerheartDamageTypeToStorage(row), nil
+	return dbDaggerheartAdversaryEntryToStorage(row)
 }
 
-// ListDaggerheartDamageTypes lists all Daggerheart damage type catalog entries.
-func (s *Store) ListDaggerheartDamageTypes(ctx context.Context) ([]storage.DaggerheartDamageTypeEntry, error) {
+// ListDaggerheartAdversaryEntries lists all Daggerheart adversary catalog entries.
+func (s *Store) ListDaggerheartAdversaryEntries(ctx context.Context) ([]storage.DaggerheartAdversaryEntry, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -3972,20 +4273,24 @@ func (s *Store) ListDaggerheartDamageTypes(ctx context.Context) ([]storage.Dagge
 		return nil, fmt.Errorf("storage is not configured")
 	}
 
-	rows, err := s.q.ListDaggerheartDamageTypes(ctx)
+	rows, err := s.q.ListDaggerheartAdversaryEntries(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart damage types: %w", err)
+		return nil, fmt.Errorf("list daggerheart adversaries: %w", err)
 	}
 
-	entries := make([]storage.DaggerheartDamageTypeEntry, 0, len(rows))
+	adversaries := make([]storage.DaggerheartAdversaryEntry, 0, len(rows))
 	for _, row := range rows {
-		entries = append(entries, dbDaggerheartDamageTypeToStorage(row))
+		entry, err := dbDaggerheartAdversaryEntryToStorage(row)
+		if err != nil {
+			return nil, err
+		}
+		adversaries = append(adversaries, entry)
 	}
-	return entries, nil
+	return adversaries, nil
 }
 
-// DeleteDaggerheartDamageType removes a Daggerheart damage type catalog entry.
-func (s *Store) DeleteDaggerheartDamageType(ctx context.Context, id string) error {
+// DeleteDaggerheartAdversaryEntry removes a Daggerheart adversary catalog entry.
+func (s *Store) DeleteDaggerheartAdversaryEntry(ctx context.Context, id string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -3993,58 +4298,78 @@ func (s *Store) DeleteDaggerheartDamageType(ctx context.Context, id string) erro
 		return fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("damage type id is required")
+		return fmt.Errorf("adversary id is required")
 	}
 
-	return s.q.DeleteDaggerheartDamageType(ctx, id)
+	return s.q.DeleteDaggerheartAdversaryEntry(ctx, id)
 }
 
-// PutDaggerheartDomain persists a Daggerheart domain catalog entry.
-func (s *Store) PutDaggerheartDomain(ctx context.Context, domain storage.DaggerheartDomain) error {
+// PutDaggerheartBeastform persists a Daggerheart beastform catalog entry.
+func (s *Store) PutDaggerheartBeastform(ctx context.Context, beastform storage.DaggerheartBeastformEntry) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(domain.ID) == "" {
-		return fmt.Errorf("domain id is required")
+	if strings.TrimSpace(beastform.ID) == "" {
+		return fmt.Errorf("beastform id is required")
 	}
 
-	return s.q.PutDaggerheartDomain(ctx, db.PutDaggerheartDomainParams{
-		ID:          domain.ID,
-		Name:        domain.Name,
-		Description: domain.Description,
-		CreatedAt:   toMillis(domain.CreatedAt),
-		UpdatedAt:   toMillis(domain.UpdatedAt),
-	})
-}
-
-// GetDaggerheartDomain retrieves a Daggerheart domain catalog entry.
-func (s *Store) GetDaggerheartDomain(ctx context.Context, id string) (storage.DaggerheartDomain, error) {
-	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartDomain{}, err
-	}
-	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartDomain{}, fmt.Errorf("storage is not configured")
+	attackJSON, err := json.Marshal(beastform.Attack)
+	if err != nil {
+		return fmt.Errorf("marshal beastform attack: %w", err)
 	}
-	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartDomain{}, fmt.Errorf("domain id is required")
+	advantagesJSON, err := json.Marshal(beastform.Advantages)
+	if err != nil {
+		return fmt.Errorf("marshal beastform advantages: %w", err)
 	}
-
-	row, err := s.q.GetDaggerheartDomain(ctx, id)
+	featuresJSON, err := json.Marshal(beastform.Features)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartDomain{}, storage.ErrNotFound
-		}
-		return storage.DaggerheartDomain{}, fmt.Errorf("get daggerheart domain: %w", err)
+		return fmt.Errorf("marshal beastform features: %w", err)
 	}
 
-	return dbDaggerheartDomainToStorage(row), nil
-}
-
-// ListDaggerheartDomains lists all Daggerheart domain catalog entries.
-func (s *Store) ListDaggerheartDomains(ctx context.Context) ([]storage.DaggerheartDomain, error) {
+	return s.q.PutDaggerheartBeastform(ctx, db.PutDaggerheartBeastformParams{
+		ID:             beastform.ID,
+		Name:           beastform.Name,
+		Tier:           int64(beastform.Tier),
+		Examples:       beastform.Examples,
+		Trait:          beastform.Trait,
+		TraitBonus:     int64(beastform.TraitBonus),
+		EvasionBonus:   int64(beastform.EvasionBonus),
+		AttackJson:     string(attackJSON),
+		AdvantagesJson: string(advantagesJSON),
+		FeaturesJson:   string(featuresJSON),
+		CreatedAt:      toMillis(beastform.CreatedAt),
+		UpdatedAt:      toMillis(beastform.UpdatedAt),
+	})
+}
+
+// GetDaggerheartBeastform retrieves a Daggerheart beastform catalog entry.
+func (s *Store) GetDaggerheartBeastform(ctx context.Context, id string) (storage.DaggerheartBeastformEntry, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.DaggerheartBeastformEntry{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.DaggerheartBeastformEntry{}, fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(id) == "" {
+		return storage.DaggerheartBeastformEntry{}, fmt.Errorf("beastform id is required")
+	}
+
+	row, err := s.q.GetDaggerheartBeastform(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.DaggerheartBeastformEntry{}, storage.ErrNotFound
+		}
+		return storage.DaggerheartBeastformEntry{}, fmt.Errorf("get daggerheart beastform: %w", err)
+	}
+
+	return dbDaggerheartBeastformToStorage(row)
+}
+
+// ListDaggerheartBeastforms lists all Daggerheart beastform catalog entries.
+func (s *Store) ListDaggerheartBeastforms(ctx context.Context) ([]storage.DaggerheartBeastformEntry, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -4052,20 +4377,24 @@ func (s *Store) ListDaggerheartDomains(ctx context.Context) ([]storage.Daggerhea
 		return nil, fmt.Errorf("storage is not configured")
 	}
 
-	rows, err := s.q.ListDaggerheartDomains(ctx)
+	rows, err := s.q.ListDaggerheartBeastforms(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart domains: %w", err)
+		return nil, fmt.Errorf("list daggerheart beastforms: %w", err)
 	}
 
-	domains := make([]storage.DaggerheartDomain, 0, len(rows))
+	beastforms := make([]storage.DaggerheartBeastformEntry, 0, len(rows))
 	for _, row := range rows {
-		domains = append(domains, dbDaggerheartDomainToStorage(row))
+		entry, err := dbDaggerheartBeastformToStorage(row)
+		if err != nil {
+			return nil, err
+		}
+		beastforms = append(beastforms, entry)
 	}
-	return domains, nil
+	return beastforms, nil
 }
 
-// DeleteDaggerheartDomain removes a Daggerheart domain catalog entry.
-func (s *Store) DeleteDaggerheartDomain(ctx context.Context, id string) error {
+// DeleteDaggerheartBeastform removes a Daggerheart beastform catalog entry.
+func (s *Store) DeleteDaggerheartBeastform(ctx context.Context, id string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -4073,108 +4402,79 @@ func (s *Store) DeleteDaggerheartDomain(ctx context.Context, id string) error {
 		return fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("domain id is required")
+		return fmt.Errorf("beastform id is required")
 	}
 
-	return s.q.DeleteDaggerheartDomain(ctx, id)
+	return s.q.DeleteDaggerheartBeastform(ctx, id)
 }
 
-// PutDaggerheartDomainCard persists a Daggerheart domain card catalog entry.
-func (s *Store) PutDaggerheartDomainCard(ctx context.Context, card storage.DaggerheartDomainCard) error {
+// PutDaggerheartCompanionExperience persists a Daggerheart companion experience catalog entry.
+func (s *Store) PutDaggerheartCompanionExperience(ctx context.Context, experience storage.DaggerheartCompanionExperienceEntry) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(card.ID) == "" {
-		return fmt.Errorf("domain card id is required")
+	if strings.TrimSpace(experience.ID) == "" {
+		return fmt.Errorf("companion experience id is required")
 	}
 
-	return s.q.PutDaggerheartDomainCard(ctx, db.PutDaggerheartDomainCardParams{
-		ID:          card.ID,
-		Name:        card.Name,
-		DomainID:    card.DomainID,
-		Level:       int64(card.Level),
-		Type:        card.Type,
-		RecallCost:  int64(card.RecallCost),
-		UsageLimit:  card.UsageLimit,
-		FeatureText: card.FeatureText,
-		CreatedAt:   toMillis(card.CreatedAt),
-		UpdatedAt:   toMillis(card.UpdatedAt),
+	return s.q.PutDaggerheartCompanionExperience(ctx, db.PutDaggerheartCompanionExperienceParams{
+		ID:          experience.ID,
+		Name:        experience.Name,
+		Description: experience.Description,
+		CreatedAt:   toMillis(experience.CreatedAt),
+		UpdatedAt:   toMillis(experience.UpdatedAt),
 	})
 }
 
-// GetDaggerheartDomainCard retrieves a Daggerheart domain card catalog entry.
-func (s *Store) GetDaggerheartDomainCard(ctx context.Context, id string) (storage.DaggerheartDomainCard, error) {
+// GetDaggerheartCompanionExperience retrieves a Daggerheart companion experience catalog entry.
+func (s *Store) GetDaggerheartCompanionExperience(ctx context.Context, id string) (storage.DaggerheartCompanionExperienceEntry, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartDomainCard{}, err
+		return storage.DaggerheartCompanionExperienceEntry{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartDomainCard{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartCompanionExperienceEntry{}, fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartDomainCard{}, fmt.Errorf("domain card id is required")
+		return storage.DaggerheartCompanionExperienceEntry{}, fmt.Errorf("companion experience id is required")
 	}
 
-	row, err := s.q.GetDaggerheartDomainCard(ctx, id)
+	row, err := s.q.GetDaggerheartCompanionExperience(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartDomainCard{}, storage.ErrNotFound
+			return storage.DaggerheartCompanionExperienceEntry{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartDomainCard{}, fmt.Errorf("get daggerheart domain card: %w", err)
-	}
-
-	return dbDaggerheartDomainCardToStorage(row), nil
-}
-
-// ListDaggerheartDomainCards lists all Daggerheart domain card catalog entries.
-func (s *Store) ListDaggerheartDomainCards(ctx context.Context) ([]storage.DaggerheartDomainCard, error) {
-	if err := ctx.Err(); err != nil {
-		return nil, err
-	}
-	if s == nil || s.sqlDB == nil {
-		return nil, fmt.Errorf("storage is not configured")
-	}
-
-	rows, err := s.q.ListDaggerheartDomainCards(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("list daggerheart domain cards: %w", err)
+		return storage.DaggerheartCompanionExperienceEntry{}, fmt.Errorf("get daggerheart companion experience: %w", err)
 	}
 
-	cards := make([]storage.DaggerheartDomainCard, 0, len(rows))
-	for _, row := range rows {
-		cards = append(cards, dbDaggerheartDomainCardToStorage(row))
-	}
-	return cards, nil
+	return dbDaggerheartCompanionExperienceToStorage(row), nil
 }
 
-// ListDaggerheartDomainCardsByDomain lists Daggerheart domain cards for a domain.
-func (s *Store) ListDaggerheartDomainCardsByDomain(ctx context.Context, domainID string) ([]storage.DaggerheartDomainCard, error) {
+// ListDaggerheartCompanionExperiences lists all Daggerheart companion experience catalog entries.
+func (s *Store) ListDaggerheartCompanionExperiences(ctx context.Context) ([]storage.DaggerheartCompanionExperienceEntry, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
 	if s == nil || s.sqlDB == nil {
 		return nil, fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(domainID) == "" {
-		return nil, fmt.Errorf("domain id is required")
-	}
 
-	rows, err := s.q.ListDaggerheartDomainCardsByDomain(ctx, domainID)
+	rows, err := s.q.ListDaggerheartCompanionExperiences(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart domain cards by domain: %w", err)
+		return nil, fmt.Errorf("list daggerheart companion experiences: %w", err)
 	}
 
-	cards := make([]storage.DaggerheartDomainCard, 0, len(rows))
+	experiences := make([]storage.DaggerheartCompanionExperienceEntry, 0, len(rows))
 	for _, row := range rows {
-		cards = append(cards, dbDaggerheartDomainCardToStorage(row))
+		experiences = append(experiences, dbDaggerheartCompanionExperienceToStorage(row))
 	}
-	return cards, nil
+	return experiences, nil
 }
 
-// DeleteDaggerheartDomainCard removes a Daggerheart domain card catalog entry.
-func (s *Store) DeleteDaggerheartDomainCard(ctx context.Context, id string) error {
+// DeleteDaggerheartCompanionExperience removes a Daggerheart companion experience catalog entry.
+func (s *Store) DeleteDaggerheartCompanionExperience(ctx context.Context, id string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -4182,74 +4482,59 @@ func (s *Store) DeleteDaggerheartDomainCard(ctx context.Context, id string) erro
 		return fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("domain card id is required")
+		return fmt.Errorf("companion experience id is required")
 	}
 
-	return s.q.DeleteDaggerheartDomainCard(ctx, id)
+	return s.q.DeleteDaggerheartCompanionExperience(ctx, id)
 }
 
-// PutDaggerheartWeapon persists a Daggerheart weapon catalog entry.
-func (s *Store) PutDaggerheartWeapon(ctx context.Context, weapon storage.DaggerheartWeapon) error {
+// PutDaggerheartLootEntry persists a Daggerheart loot catalog entry.
+func (s *Store) PutDaggerheartLootEntry(ctx context.Context, entry storage.DaggerheartLootEntry) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(weapon.ID) == "" {
-		return fmt.Errorf("weapon id is required")
-	}
-
-	damageDiceJSON, err := json.Marshal(weapon.DamageDice)
-	if err != nil {
-		return fmt.Errorf("marshal weapon damage dice: %w", err)
+	if strings.TrimSpace(entry.ID) == "" {
+		return fmt.Errorf("loot entry id is required")
 	}
 
-	return s.q.PutDaggerheartWeapon(ctx, db.PutDaggerheartWeaponParams{
-		ID:             weapon.ID,
-		Name:           weapon.Name,
-		Category:       weapon.Category,
-		Tier:           int64(weapon.Tier),
-		Trait:          weapon.Trait,
-		Range:          weapon.Range,
-		DamageDiceJson: string(damageDiceJSON),
-		DamageType:     weapon.DamageType,
-		Burden:         int64(weapon.Burden),
-		Feature:        weapon.Feature,
-		CreatedAt:      toMillis(weapon.CreatedAt),
-		UpdatedAt:      toMillis(weapon.UpdatedAt),
+	return s.q.PutDaggerheartLootEntry(ctx, db.PutDaggerheartLootEntryParams{
+		ID:          entry.ID,
+		Name:        entry.Name,
+		Roll:        int64(entry.Roll),
+		Description: entry.Description,
+		CreatedAt:   toMillis(entry.CreatedAt),
+		UpdatedAt:   toMillis(entry.UpdatedAt),
 	})
 }
 
-// GetDaggerheartWeapon retrieves a Daggerheart weapon catalog entry.
-func (s *Store) GetDaggerheartWeapon(ctx context.Context, id string) (storage.DaggerheartWeapon, error) {
+// GetDaggerheartLootEntry retrieves a Daggerheart loot catalog entry.
+func (s *Store) GetDaggerheartLootEntry(ctx context.Context, id string) (storage.DaggerheartLootEntry, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartWeapon{}, err
+		return storage.DaggerheartLootEntry{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartWeapon{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartLootEntry{}, fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartWeapon{}, fmt.Errorf("weapon id is required")
+		return storage.DaggerheartLootEntry{}, fmt.Errorf("loot entry id is required")
 	}
 
-	row, err := s.q.GetDaggerheartWeapon(ctx, id)
+	row, err := s.q.GetDaggerheartLootEntry(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartWeapon{}, storage.ErrNotFound
+			return storage.DaggerheartLootEntry{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartWeapon{}, fmt.Errorf("get daggerheart weapon: %w", err)
+		return storage.DaggerheartLootEntry{}, fmt.Errorf("get daggerheart loot entry: %w", err)
 	}
 
-	weapon, err := dbDaggerheartWeaponToStorage(row)
-	if err != nil {
-		return storage.DaggerheartWeapon{}, err
-	}
-	return weapon, nil
+	return dbDaggerheartLootEntryToStorage(row), nil
 }
 
-// ListDaggerheartWeapons lists all Daggerheart weapon catalog entries.
-func (s *Store) ListDaggerheartWeapons(ctx context.Context) ([]storage.DaggerheartWeapon, error) {
+// ListDaggerheartLootEntries lists all Daggerheart loot catalog entries.
+func (s *Store) ListDaggerheartLootEntries(ctx context.Context) ([]storage.DaggerheartLootEntry, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -4257,24 +4542,20 @@ func (s *Store) ListDaggerheartWeapons(ctx context.Context) ([]storage.Daggerhea
 		return nil, fmt.Errorf("storage is not configured")
 	}
 
-	rows, err := s.q.ListDaggerheartWeapons(ctx)
+	rows, err := s.q.ListDaggerheartLootEntries(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart weapons: %w", err)
+		return nil, fmt.Errorf("list daggerheart loot entries: %w", err)
 	}
 
-	weapons := make([]storage.DaggerheartWeapon, 0, len(rows))
+	entries := make([]storage.DaggerheartLootEntry, 0, len(rows))
 	for _, row := range rows {
-		weapon, err := dbDaggerheartWeaponToStorage(row)
-		if err != nil {
-			return nil, err
-		}
-		weapons = append(weapons, weapon)
+		entries = append(entries, dbDaggerheartLootEntryToStorage(row))
 	}
-	return weapons, nil
+	return entries, nil
 }
 
-// DeleteDaggerheartWeapon removes a Daggerheart weapon catalog entry.
-func (s *Store) DeleteDaggerheartWeapon(ctx context.Context, id string) error {
+// DeleteDaggerheartLootEntry removes a Daggerheart loot catalog entry.
+func (s *Store) DeleteDaggerheartLootEntry(ctx context.Context, id string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -4282,62 +4563,58 @@ func (s *Store) DeleteDaggerheartWeapon(ctx context.Context, id string) error {
 		return fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("weapon id is required")
+		return fmt.Errorf("loot entry id is required")
 	}
 
-	return s.q.DeleteDaggerheartWeapon(ctx, id)
+	return s.q.DeleteDaggerheartLootEntry(ctx, id)
 }
 
-// PutDaggerheartArmor persists a Daggerheart armor catalog entry.
-func (s *Store) PutDaggerheartArmor(ctx context.Context, armor storage.DaggerheartArmor) error {
+// PutDaggerheartDamageType persists a Daggerheart damage type catalog entry.
+func (s *Store) PutDaggerheartDamageType(ctx context.Context, entry storage.DaggerheartDamageTypeEntry) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(armor.ID) == "" {
-		return fmt.Errorf("armor id is required")
+	if strings.TrimSpace(entry.ID) == "" {
+		return fmt.Errorf("damage type id is required")
 	}
 
-	return s.q.PutDaggerheartArmor(ctx, db.PutDaggerheartArmorParams{
-		ID:                  armor.ID,
-		Name:                armor.Name,
-		Tier:                int64(armor.Tier),
-		BaseMajorThreshold:  int64(armor.BaseMajorThreshold),
-		BaseSevereThreshold: int64(armor.BaseSevereThreshold),
-		ArmorScore:          int64(armor.ArmorScore),
-		Feature:             armor.Feature,
-		CreatedAt:           toMillis(armor.CreatedAt),
-		UpdatedAt:           toMillis(armor.UpdatedAt),
-	})
-}
-
-// GetDaggerheartArmor retrieves a Daggerheart armor catalog entry.
-func (s *Store) GetDaggerheartArmor(ctx context.Context, id string) (storage.DaggerheartArmor, error) {
+	return s.q.PutDaggerheartDamageType(ctx, db.PutDaggerheartDamageTypeParams{
+		ID:          entry.ID,
+		Name:        entry.Name,
+		Description: entry.Description,
+		CreatedAt:   toMillis(entry.CreatedAt),
+		UpdatedAt:   toMillis(entry.UpdatedAt),
+	})
+}
+
+// GetDaggerheartDamageType retrieves a Daggerheart damage type catalog entry.
+func (s *Store) GetDaggerheartDamageType(ctx context.Context, id string) (storage.DaggerheartDamageTypeEntry, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartArmor{}, err
+		return storage.DaggerheartDamageTypeEntry{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartArmor{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartDamageTypeEntry{}, fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartArmor{}, fmt.Errorf("armor id is required")
+		return storage.DaggerheartDamageTypeEntry{}, fmt.Errorf("damage type id is required")
 	}
 
-	row, err := s.q.GetDaggerheartArmor(ctx, id)
+	row, err := s.q.GetDaggerheartDamageType(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartArmor{}, storage.ErrNotFound
+			return storage.DaggerheartDamageTypeEntry{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartArmor{}, fmt.Errorf("get daggerheart armor: %w", err)
+		return storage.DaggerheartDamageTypeEntry{}, fmt.Errorf("get daggerheart damage type: %w", err)
 	}
 
-	return dbDaggerheartArmorToStorage(row), nil
+	return dbDaggerheartDamageTypeToStorage(row), nil
 }
 
-// ListDaggerheartArmor lists all Daggerheart armor catalog entries.
-func (s *Store) ListDaggerheartArmor(ctx context.Context) ([]storage.DaggerheartArmor, error) {
+// ListDaggerheartDamageTypes lists all Daggerheart damage type catalog entries.
+func (s *Store) ListDaggerheartDamageTypes(ctx context.Context) ([]storage.DaggerheartDamageTypeEntry, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -4345,20 +4622,20 @@ func (s *Store) ListDaggerheartArmor(ctx context.Context) ([]storage.Daggerheart
 		return nil, fmt.Errorf("storage is not configured")
 	}
 
-	rows, err := s.q.ListDaggerheartArmor(ctx)
+	rows, err := s.q.ListDaggerheartDamageTypes(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart armor: %w", err)
+		return nil, fmt.Errorf("list daggerheart damage types: %w", err)
 	}
 
-	armor := make([]storage.DaggerheartArmor, 0, len(rows))
+	entries := make([]storage.DaggerheartDamageTypeEntry, 0, len(rows))
 	for _, row := range rows {
-		armor = append(armor, dbDaggerheartArmorToStorage(row))
+		entries = append(entries, dbDaggerheartDamageTypeToStorage(row))
 	}
-	return armor, nil
+	return entries, nil
 }
 
-// DeleteDaggerheartArmor removes a Daggerheart armor catalog entry.
-func (s *Store) DeleteDaggerheartArmor(ctx context.Context, id string) error {
+// DeleteDaggerheartDamageType removes a Daggerheart damage type catalog entry.
+func (s *Store) DeleteDaggerheartDamageType(ctx context.Context, id string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -4366,62 +4643,58 @@ func (s *Store) DeleteDaggerheartArmor(ctx context.Context, id string) error {
 		return fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("armor id is required")
+		return fmt.Errorf("damage type id is required")
 	}
 
-	return s.q.DeleteDaggerheartArmor(ctx, id)
+	return s.q.DeleteDaggerheartDamageType(ctx, id)
 }
 
-// PutDaggerheartItem persists a Daggerheart item catalog entry.
-func (s *Store) PutDaggerheartItem(ctx context.Context, item storage.DaggerheartItem) error {
+// PutDaggerheartDomain persists a Daggerheart domain catalog entry.
+func (s *Store) PutDaggerheartDomain(ctx context.Context, domain storage.DaggerheartDomain) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(item.ID) == "" {
-		return fmt.Errorf("item id is required")
+	if strings.TrimSpace(domain.ID) == "" {
+		return fmt.Errorf("domain id is required")
 	}
 
-	return s.q.PutDaggerheartItem(ctx, db.PutDaggerheartItemParams{
-		ID:          item.ID,
-		Name:        item.Name,
-		Rarity:      item.Rarity,
-		Kind:        item.Kind,
-		StackMax:    int64(item.StackMax),
-		Description: item.Description,
-		EffectText:  item.EffectText,
-		CreatedAt:   toMillis(item.CreatedAt),
-		UpdatedAt:   toMillis(item.UpdatedAt),
+	return s.q.PutDaggerheartDomain(ctx, db.PutDaggerheartDomainParams{
+		ID:          domain.ID,
+		Name:        domain.Name,
+		Description: domain.Description,
+		CreatedAt:   toMillis(domain.CreatedAt),
+		UpdatedAt:   toMillis(domain.UpdatedAt),
 	})
 }
 
-// GetDaggerheartItem retrieves a Daggerheart item catalog entry.
-func (s *Store) GetDaggerheartItem(ctx context.Context, id string) (storage.DaggerheartItem, error) {
+// GetDaggerheartDomain retrieves a Daggerheart domain catalog entry.
+func (s *Store) GetDaggerheartDomain(ctx context.Context, id string) (storage.DaggerheartDomain, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartItem{}, err
+		return storage.DaggerheartDomain{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartItem{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartDomain{}, fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartItem{}, fmt.Errorf("item id is required")
+		return storage.DaggerheartDomain{}, fmt.Errorf("domain id is required")
 	}
 
-	row, err := s.q.GetDaggerheartItem(ctx, id)
+	row, err := s.q.GetDaggerheartDomain(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartItem{}, storage.ErrNotFound
+			return storage.DaggerheartDomain{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartItem{}, fmt.Errorf("get daggerheart item: %w", err)
+		return storage.DaggerheartDomain{}, fmt.Errorf("get daggerheart domain: %w", err)
 	}
 
-	return dbDaggerheartItemToStorage(row), nil
+	return dbDaggerheartDomainToStorage(row), nil
 }
 
-// ListDaggerheartItems lists all Daggerheart item catalog entries.
-func (s *Store) ListDaggerheartItems(ctx context.Context) ([]storage.DaggerheartItem, error) {
+// ListDaggerheartDomains lists all Daggerheart domain catalog entries.
+func (s *Store) ListDaggerheartDomains(ctx context.Context) ([]storage.DaggerheartDomain, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -4429,20 +4702,20 @@ func (s *Store) ListDaggerheartItems(ctx context.Context) ([]storage.Daggerheart
 		return nil, fmt.Errorf("storage is not configured")
 	}
 
-	rows, err := s.q.ListDaggerheartItems(ctx)
+	rows, err := s.q.ListDaggerheartDomains(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart items: %w", err)
+		return nil, fmt.Errorf("list daggerheart domains: %w", err)
 	}
 
-	items := make([]storage.DaggerheartItem, 0, len(rows))
+	domains := make([]storage.DaggerheartDomain, 0, len(rows))
 	for _, row := range rows {
-		items = append(items, dbDaggerheartItemToStorage(row))
+		domains = append(domains, dbDaggerheartDomainToStorage(row))
 	}
-	return items, nil
+	return domains, nil
 }
 
-// DeleteDaggerheartItem removes a Daggerheart item catalog entry.
-func (s *Store) DeleteDaggerheartItem(ctx context.Context, id string) error {
+// DeleteDaggerheartDomain removes a Daggerheart domain catalog entry.
+func (s *Store) DeleteDaggerheartDomain(ctx context.Context, id string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -4450,85 +4723,63 @@ func (s *Store) DeleteDaggerheartItem(ctx context.Context, id string) error {
 		return fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("item id is required")
+		return fmt.Errorf("domain id is required")
 	}
 
-	return s.q.DeleteDaggerheartItem(ctx, id)
+	return s.q.DeleteDaggerheartDomain(ctx, id)
 }
 
-// PutDaggerheartEnvironment persists a Daggerheart environment catalog entry.
-func (s *Store) PutDaggerheartEnvironment(ctx context.Context, env storage.DaggerheartEnvironment) error {
+// PutDaggerheartDomainCard persists a Daggerheart domain card catalog entry.
+func (s *Store) PutDaggerheartDomainCard(ctx context.Context, card storage.DaggerheartDomainCard) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(env.ID) == "" {
-		return fmt.Errorf("environment id is required")
-	}
-
-	impulsesJSON, err := json.Marshal(env.Impulses)
-	if err != nil {
-		return fmt.Errorf("marshal environment impulses: %w", err)
-	}
-	adversariesJSON, err := json.Marshal(env.PotentialAdversaryIDs)
-	if err != nil {
-		return fmt.Errorf("marshal environment adversaries: %w", err)
-	}
-	featuresJSON, err := json.Marshal(env.Features)
-	if err != nil {
-		return fmt.Errorf("marshal environment features: %w", err)
-	}
-	promptsJSON, err := json.Marshal(env.Prompts)
-	if err != nil {
-		return fmt.Errorf("marshal environment prompts: %w", err)
+	if strings.TrimSpace(card.ID) == "" {
+		return fmt.Errorf("domain card id is required")
 	}
 
-	return s.q.PutDaggerheartEnvironment(ctx, db.PutDaggerheartEnvironmentParams{
-		ID:                        env.ID,
-		Name:                      env.Name,
-		Tier:                      int64(env.Tier),
-		Type:                      env.Type,
-		Difficulty:                int64(env.Difficulty),
-		ImpulsesJson:              string(impulsesJSON),
-		PotentialAdversaryIdsJson: string(adversariesJSON),
-		FeaturesJson:              string(featuresJSON),
-		PromptsJson:               string(promptsJSON),
-		CreatedAt:                 toMillis(env.CreatedAt),
-		UpdatedAt:                 toMillis(env.UpdatedAt),
+	return s.q.PutDaggerheartDomainCard(ctx, db.PutDaggerheartDomainCardParams{
+		ID:          card.ID,
+		Name:        card.Name,
+		DomainID:    card.DomainID,
+		Level:       int64(card.Level),
+		Type:        card.Type,
+		RecallCost:  int64(card.RecallCost),
+		UsageLimit:  card.UsageLimit,
+		FeatureText: card.FeatureText,
+		CreatedAt:   toMillis(card.CreatedAt),
+		UpdatedAt:   toMillis(card.UpdatedAt),
 	})
 }
 
-// GetDaggerheartEnvironment retrieves a Daggerheart environment catalog entry.
-func (s *Store) GetDaggerheartEnvironment(ctx context.Context, id string) (storage.DaggerheartEnvironment, error) {
+// GetDaggerheartDomainCard retrieves a Daggerheart domain card catalog entry.
+func (s *Store) GetDaggerheartDomainCard(ctx context.Context, id string) (storage.DaggerheartDomainCard, error) {
 	if err := ctx.Err(); err != nil {
-		return storage.DaggerheartEnvironment{}, err
+		return storage.DaggerheartDomainCard{}, err
 	}
 	if s == nil || s.sqlDB == nil {
-		return storage.DaggerheartEnvironment{}, fmt.Errorf("storage is not configured")
+		return storage.DaggerheartDomainCard{}, fmt.Errorf("storage is not configured")
 	}
 	if strings.TrimSpace(id) == "" {
-		return storage.DaggerheartEnvironment{}, fmt.Errorf("environment id is required")
+		return storage.DaggerheartDomainCard{}, fmt.Errorf("domain card id is required")
 	}
 
-	row, err := s.q.GetDaggerheartEnvironment(ctx, id)
+	row, err := s.q.GetDaggerheartDomainCard(ctx, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return storage.DaggerheartEnvironment{}, storage.ErrNotFound
+			return storage.DaggerheartDomainCard{}, storage.ErrNotFound
 		}
-		return storage.DaggerheartEnvironment{}, fmt.Errorf("get daggerheart environment: %w", err)
+		return storage.DaggerheartDomainCard{}, fmt.Errorf("get daggerheart domain card: %w", err)
 	}
 
-	env, err := dbDaggerheartEnvironmentToStorage(row)
-	if err != nil {
-		return storage.DaggerheartEnvironment{}, err
-	}
-	return env, nil
+	return dbDaggerheartDomainCardToStorage(row), nil
 }
 
-// ListDaggerheartEnvironments lists all Daggerheart environment catalog entries.
-func (s *Store) ListDaggerheartEnvironments(ctx context.Context) ([]storage.DaggerheartEnvironment, error) {
+// ListDaggerheartDomainCards lists all Daggerheart domain card catalog entries.
+func (s *Store) ListDaggerheartDomainCards(ctx context.Context) ([]storage.DaggerheartDomainCard, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
@@ -4536,391 +4787,1480 @@ func (s *Store) ListDaggerheartEnvironments(ctx context.Context) ([]storage.Dagg
 		return nil, fmt.Errorf("storage is not configured")
 	}
 
-	rows, err := s.q.ListDaggerheartEnvironments(ctx)
+	rows, err := s.q.ListDaggerheartDomainCards(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart environments: %w", err)
+		return nil, fmt.Errorf("list daggerheart domain cards: %w", err)
 	}
 
-	envs := make([]storage.DaggerheartEnvironment, 0, len(rows))
+	cards := make([]storage.DaggerheartDomainCard, 0, len(rows))
 	for _, row := range rows {
-		env, err := dbDaggerheartEnvironmentToStorage(row)
-		if err != nil {
-			return nil, err
-		}
-		envs = append(envs, env)
-	}
-	return envs, nil
-}
-
-// DeleteDaggerheartEnvironment removes a Daggerheart environment catalog entry.
-func (s *Store) DeleteDaggerheartEnvironment(ctx context.Context, id string) error {
-	if err := ctx.Err(); err != nil {
-		return err
-	}
-	if s == nil || s.sqlDB == nil {
-		return fmt.Errorf("storage is not configured")
-	}
-	if strings.TrimSpace(id) == "" {
-		return fmt.Errorf("environment id is required")
+		cards = append(cards, dbDaggerheartDomainCardToStorage(row))
 	}
-
-	return s.q.DeleteDaggerheartEnvironment(ctx, id)
+	return cards, nil
 }
 
-// ListDaggerheartContentStrings returns localized content strings for content ids.
-func (s *Store) ListDaggerheartContentStrings(ctx context.Context, contentType string, contentIDs []string, locale string) ([]storage.DaggerheartContentString, error) {
+// ListDaggerheartDomainCardsByDomain lists Daggerheart domain cards for a domain.
+func (s *Store) ListDaggerheartDomainCardsByDomain(ctx context.Context, domainID string) ([]storage.DaggerheartDomainCard, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
 	if s == nil || s.sqlDB == nil {
 		return nil, fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(contentType) == "" {
-		return nil, fmt.Errorf("content type is required")
-	}
-	if strings.TrimSpace(locale) == "" {
-		return nil, fmt.Errorf("locale is required")
-	}
-	if len(contentIDs) == 0 {
-		return []storage.DaggerheartContentString{}, nil
+	if strings.TrimSpace(domainID) == "" {
+		return nil, fmt.Errorf("domain id is required")
 	}
 
-	rows, err := s.q.ListDaggerheartContentStringsByIDs(ctx, db.ListDaggerheartContentStringsByIDsParams{
-		ContentType: contentType,
-		Locale:      locale,
-		ContentIds:  contentIDs,
-	})
+	rows, err := s.q.ListDaggerheartDomainCardsByDomain(ctx, domainID)
 	if err != nil {
-		return nil, fmt.Errorf("list daggerheart content strings: %w", err)
+		return nil, fmt.Errorf("list daggerheart domain cards by domain: %w", err)
 	}
-	entries := make([]storage.DaggerheartContentString, 0, len(rows))
+
+	cards := make([]storage.DaggerheartDomainCard, 0, len(rows))
 	for _, row := range rows {
-		entries = append(entries, dbDaggerheartContentStringToStorage(row))
+		cards = append(cards, dbDaggerheartDomainCardToStorage(row))
 	}
-	return entries, nil
+	return cards, nil
 }
 
-// PutDaggerheartContentString upserts a localized content string.
-func (s *Store) PutDaggerheartContentString(ctx context.Context, entry storage.DaggerheartContentString) error {
+// DeleteDaggerheartDomainCard removes a Daggerheart domain card catalog entry.
+func (s *Store) DeleteDaggerheartDomainCard(ctx context.Context, id string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if strings.TrimSpace(entry.ContentID) == "" {
-		return fmt.Errorf("content id is required")
-	}
-	if strings.TrimSpace(entry.Field) == "" {
-		return fmt.Errorf("field is required")
+	if strings.TrimSpace(id) == "" {
+		return fmt.Errorf("domain card id is required")
 	}
-	if strings.TrimSpace(entry.Locale) == "" {
-		return fmt.Errorf("locale is required")
+
+	return s.q.DeleteDaggerheartDomainCard(ctx, id)
+}
+
+// PutDaggerheartWeapon persists a Daggerheart weapon catalog entry.
+func (s *Store) PutDaggerheartWeapon(ctx context.Context, weapon storage.DaggerheartWeapon) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(weapon.ID) == "" {
+		return fmt.Errorf("weapon id is required")
+	}
+
+	damageDiceJSON, err := json.Marshal(weapon.DamageDice)
+	if err != nil {
+		return fmt.Errorf("marshal weapon damage dice: %w", err)
+	}
+
+	return s.q.PutDaggerheartWeapon(ctx, db.PutDaggerheartWeaponParams{
+		ID:             weapon.ID,
+		Name:           weapon.Name,
+		Category:       weapon.Category,
+		Tier:           int64(weapon.Tier),
+		Trait:          weapon.Trait,
+		Range:          weapon.Range,
+		DamageDiceJson: string(damageDiceJSON),
+		DamageType:     weapon.DamageType,
+		Burden:         int64(weapon.Burden),
+		Feature:        weapon.Feature,
+		CreatedAt:      toMillis(weapon.CreatedAt),
+		UpdatedAt:      toMillis(weapon.UpdatedAt),
+	})
+}
+
+// GetDaggerheartWeapon retrieves a Daggerheart weapon catalog entry.
+func (s *Store) GetDaggerheartWeapon(ctx context.Context, id string) (storage.DaggerheartWeapon, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.DaggerheartWeapon{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.DaggerheartWeapon{}, fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(id) == "" {
+		return storage.DaggerheartWeapon{}, fmt.Errorf("weapon id is required")
+	}
+
+	row, err := s.q.GetDaggerheartWeapon(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.DaggerheartWeapon{}, storage.ErrNotFound
+		}
+		return storage.DaggerheartWeapon{}, fmt.Errorf("get daggerheart weapon: %w", err)
+	}
+
+	weapon, err := dbDaggerheartWeaponToStorage(row)
+	if err != nil {
+		return storage.DaggerheartWeapon{}, err
+	}
+	return weapon, nil
+}
+
+// ListDaggerheartWeapons lists all Daggerheart weapon catalog entries.
+func (s *Store) ListDaggerheartWeapons(ctx context.Context) ([]storage.DaggerheartWeapon, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+
+	rows, err := s.q.ListDaggerheartWeapons(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list daggerheart weapons: %w", err)
+	}
+
+	weapons := make([]storage.DaggerheartWeapon, 0, len(rows))
+	for _, row := range rows {
+		weapon, err := dbDaggerheartWeaponToStorage(row)
+		if err != nil {
+			return nil, err
+		}
+		weapons = append(weapons, weapon)
+	}
+	return weapons, nil
+}
+
+// DeleteDaggerheartWeapon removes a Daggerheart weapon catalog entry.
+func (s *Store) DeleteDaggerheartWeapon(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(id) == "" {
+		return fmt.Errorf("weapon id is required")
+	}
+
+	return s.q.DeleteDaggerheartWeapon(ctx, id)
+}
+
+// PutDaggerheartArmor persists a Daggerheart armor catalog entry.
+func (s *Store) PutDaggerheartArmor(ctx context.Context, armor storage.DaggerheartArmor) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(armor.ID) == "" {
+		return fmt.Errorf("armor id is required")
+	}
+
+	return s.q.PutDaggerheartArmor(ctx, db.PutDaggerheartArmorParams{
+		ID:                  armor.ID,
+		Name:                armor.Name,
+		Tier:                int64(armor.Tier),
+		BaseMajorThreshold:  int64(armor.BaseMajorThreshold),
+		BaseSevereThreshold: int64(armor.BaseSevereThreshold),
+		ArmorScore:          int64(armor.ArmorScore),
+		Feature:             armor.Feature,
+		CreatedAt:           toMillis(armor.CreatedAt),
+		UpdatedAt:           toMillis(armor.UpdatedAt),
+	})
+}
+
+// GetDaggerheartArmor retrieves a Daggerheart armor catalog entry.
+func (s *Store) GetDaggerheartArmor(ctx context.Context, id string) (storage.DaggerheartArmor, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.DaggerheartArmor{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.DaggerheartArmor{}, fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(id) == "" {
+		return storage.DaggerheartArmor{}, fmt.Errorf("armor id is required")
+	}
+
+	row, err := s.q.GetDaggerheartArmor(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.DaggerheartArmor{}, storage.ErrNotFound
+		}
+		return storage.DaggerheartArmor{}, fmt.Errorf("get daggerheart armor: %w", err)
+	}
+
+	return dbDaggerheartArmorToStorage(row), nil
+}
+
+// ListDaggerheartArmor lists all Daggerheart armor catalog entries.
+func (s *Store) ListDaggerheartArmor(ctx context.Context) ([]storage.DaggerheartArmor, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+
+	rows, err := s.q.ListDaggerheartArmor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list daggerheart armor: %w", err)
+	}
+
+	armor := make([]storage.DaggerheartArmor, 0, len(rows))
+	for _, row := range rows {
+		armor = append(armor, dbDaggerheartArmorToStorage(row))
+	}
+	return armor, nil
+}
+
+// DeleteDaggerheartArmor removes a Daggerheart armor catalog entry.
+func (s *Store) DeleteDaggerheartArmor(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(id) == "" {
+		return fmt.Errorf("armor id is required")
+	}
+
+	return s.q.DeleteDaggerheartArmor(ctx, id)
+}
+
+// PutDaggerheartItem persists a Daggerheart item catalog entry.
+func (s *Store) PutDaggerheartItem(ctx context.Context, item storage.DaggerheartItem) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(item.ID) == "" {
+		return fmt.Errorf("item id is required")
+	}
+
+	return s.q.PutDaggerheartItem(ctx, db.PutDaggerheartItemParams{
+		ID:          item.ID,
+		Name:        item.Name,
+		Rarity:      item.Rarity,
+		Kind:        item.Kind,
+		StackMax:    int64(item.StackMax),
+		Description: item.Description,
+		EffectText:  item.EffectText,
+		CreatedAt:   toMillis(item.CreatedAt),
+		UpdatedAt:   toMillis(item.UpdatedAt),
+	})
+}
+
+// GetDaggerheartItem retrieves a Daggerheart item catalog entry.
+func (s *Store) GetDaggerheartItem(ctx context.Context, id string) (storage.DaggerheartItem, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.DaggerheartItem{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.DaggerheartItem{}, fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(id) == "" {
+		return storage.DaggerheartItem{}, fmt.Errorf("item id is required")
+	}
+
+	row, err := s.q.GetDaggerheartItem(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.DaggerheartItem{}, storage.ErrNotFound
+		}
+		return storage.DaggerheartItem{}, fmt.Errorf("get daggerheart item: %w", err)
+	}
+
+	return dbDaggerheartItemToStorage(row), nil
+}
+
+// ListDaggerheartItems lists all Daggerheart item catalog entries.
+func (s *Store) ListDaggerheartItems(ctx context.Context) ([]storage.DaggerheartItem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+
+	rows, err := s.q.ListDaggerheartItems(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list daggerheart items: %w", err)
+	}
+
+	items := make([]storage.DaggerheartItem, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, dbDaggerheartItemToStorage(row))
+	}
+	return items, nil
+}
+
+// DeleteDaggerheartItem removes a Daggerheart item catalog entry.
+func (s *Store) DeleteDaggerheartItem(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(id) == "" {
+		return fmt.Errorf("item id is required")
+	}
+
+	return s.q.DeleteDaggerheartItem(ctx, id)
+}
+
+// PutDaggerheartEnvironment persists a Daggerheart environment catalog entry.
+func (s *Store) PutDaggerheartEnvironment(ctx context.Context, env storage.DaggerheartEnvironment) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(env.ID) == "" {
+		return fmt.Errorf("environment id is required")
+	}
+
+	impulsesJSON, err := json.Marshal(env.Impulses)
+	if err != nil {
+		return fmt.Errorf("marshal environment impulses: %w", err)
+	}
+	adversariesJSON, err := json.Marshal(env.PotentialAdversaryIDs)
+	if err != nil {
+		return fmt.Errorf("marshal environment adversaries: %w", err)
+	}
+	featuresJSON, err := json.Marshal(env.Features)
+	if err != nil {
+		return fmt.Errorf("marshal environment features: %w", err)
+	}
+	promptsJSON, err := json.Marshal(env.Prompts)
+	if err != nil {
+		return fmt.Errorf("marshal environment prompts: %w", err)
+	}
+
+	return s.q.PutDaggerheartEnvironment(ctx, db.PutDaggerheartEnvironmentParams{
+		ID:                        env.ID,
+		Name:                      env.Name,
+		Tier:                      int64(env.Tier),
+		Type:                      env.Type,
+		Difficulty:                int64(env.Difficulty),
+		ImpulsesJson:              string(impulsesJSON),
+		PotentialAdversaryIdsJson: string(adversariesJSON),
+		FeaturesJson:              string(featuresJSON),
+		PromptsJson:               string(promptsJSON),
+		CreatedAt:                 toMillis(env.CreatedAt),
+		UpdatedAt:                 toMillis(env.UpdatedAt),
+	})
+}
+
+// GetDaggerheartEnvironment retrieves a Daggerheart environment catalog entry.
+func (s *Store) GetDaggerheartEnvironment(ctx context.Context, id string) (storage.DaggerheartEnvironment, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.DaggerheartEnvironment{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.DaggerheartEnvironment{}, fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(id) == "" {
+		return storage.DaggerheartEnvironment{}, fmt.Errorf("environment id is required")
+	}
+
+	row, err := s.q.GetDaggerheartEnvironment(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.DaggerheartEnvironment{}, storage.ErrNotFound
+		}
+		return storage.DaggerheartEnvironment{}, fmt.Errorf("get daggerheart environment: %w", err)
+	}
+
+	env, err := dbDaggerheartEnvironmentToStorage(row)
+	if err != nil {
+		return storage.DaggerheartEnvironment{}, err
+	}
+	return env, nil
+}
+
+// ListDaggerheartEnvironments lists all Daggerheart environment catalog entries.
+func (s *Store) ListDaggerheartEnvironments(ctx context.Context) ([]storage.DaggerheartEnvironment, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+
+	rows, err := s.q.ListDaggerheartEnvironments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list daggerheart environments: %w", err)
+	}
+
+	envs := make([]storage.DaggerheartEnvironment, 0, len(rows))
+	for _, row := range rows {
+		env, err := dbDaggerheartEnvironmentToStorage(row)
+		if err != nil {
+			return nil, err
+		}
+		envs = append(envs, env)
+	}
+	return envs, nil
+}
+
+// DeleteDaggerheartEnvironment removes a Daggerheart environment catalog entry.
+func (s *Store) DeleteDaggerheartEnvironment(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(id) == "" {
+		return fmt.Errorf("environment id is required")
+	}
+
+	return s.q.DeleteDaggerheartEnvironment(ctx, id)
+}
+
+// ListDaggerheartContentStrings returns localized content strings for content ids.
+func (s *Store) ListDaggerheartContentStrings(ctx context.Context, contentType string, contentIDs []string, locale string) ([]storage.DaggerheartContentString, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(contentType) == "" {
+		return nil, fmt.Errorf("content type is required")
+	}
+	if strings.TrimSpace(locale) == "" {
+		return nil, fmt.Errorf("locale is required")
+	}
+	if len(contentIDs) == 0 {
+		return []storage.DaggerheartContentString{}, nil
+	}
+
+	rows, err := s.q.ListDaggerheartContentStringsByIDs(ctx, db.ListDaggerheartContentStringsByIDsParams{
+		ContentType: contentType,
+		Locale:      locale,
+		ContentIds:  contentIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list daggerheart content strings: %w", err)
 	}
+	entries := make([]storage.DaggerheartContentString, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, dbDaggerheartContentStringToStorage(row))
+	}
+	return entries, nil
+}
+
+// PutDaggerheartContentString upserts a localized content string.
+func (s *Store) PutDaggerheartContentString(ctx context.Context, entry storage.DaggerheartContentString) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(entry.ContentID) == "" {
+		return fmt.Errorf("content id is required")
+	}
+	if strings.TrimSpace(entry.Field) == "" {
+		return fmt.Errorf("field is required")
+	}
+	if strings.TrimSpace(entry.Locale) == "" {
+		return fmt.Errorf("locale is required")
+	}
+
+	createdAt := entry.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now().UTC()
+	}
+	updatedAt := entry.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = createdAt
+	}
+
+	return s.q.PutDaggerheartContentString(ctx, db.PutDaggerheartContentStringParams{
+		ContentID:   entry.ContentID,
+		ContentType: entry.ContentType,
+		Field:       entry.Field,
+		Locale:      entry.Locale,
+		Text:        entry.Text,
+		CreatedAt:   toMillis(createdAt),
+		UpdatedAt:   toMillis(updatedAt),
+	})
+}
+
+// EventStore methods (unified event journal)
+
+// AppendEvent atomically appends an event and returns it with sequence and hash set.
+func (s *Store) AppendEvent(ctx context.Context, evt event.Event) (event.Event, error) {
+	if err := ctx.Err(); err != nil {
+		return event.Event{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return event.Event{}, fmt.Errorf("storage is not configured")
+	}
+
+	validated, err := event.NormalizeForAppend(evt)
+	if err != nil {
+		return event.Event{}, err
+	}
+	evt = validated
+
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return event.Event{}, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	qtx := s.q.WithTx(tx)
+	if err := checkExpectedLastSeq(ctx, qtx, evt.CampaignID); err != nil {
+		return event.Event{}, err
+	}
+	evt, err = s.appendEventTx(ctx, qtx, evt)
+	if err != nil {
+		if isConstraintError(err) {
+			stored, lookupErr := s.GetEventByHash(ctx, evt.Hash)
+			if lookupErr == nil {
+				return stored, nil
+			}
+		}
+		return event.Event{}, fmt.Errorf("append event: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return event.Event{}, fmt.Errorf("commit: %w", err)
+	}
+	storage.RecordAppendedEvents(ctx, evt)
+	s.publishCommitted(evt)
+
+	return evt, nil
+}
+
+// AppendEvents atomically appends events in order: either every event is
+// stored or none is.
+func (s *Store) AppendEvents(ctx context.Context, evts []event.Event) ([]event.Event, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+
+	normalized := make([]event.Event, 0, len(evts))
+	for _, evt := range evts {
+		validated, err := event.NormalizeForAppend(evt)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, validated)
+	}
+
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	qtx := s.q.WithTx(tx)
+	if len(normalized) > 0 {
+		if err := checkExpectedLastSeq(ctx, qtx, normalized[0].CampaignID); err != nil {
+			return nil, err
+		}
+	}
+	stored := make([]event.Event, 0, len(normalized))
+	for _, evt := range normalized {
+		evt, err = s.appendEventTx(ctx, qtx, evt)
+		if err != nil {
+			return nil, fmt.Errorf("append event: %w", err)
+		}
+		stored = append(stored, evt)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+	storage.RecordAppendedEvents(ctx, stored...)
+	for _, evt := range stored {
+		s.publishCommitted(evt)
+	}
+
+	return stored, nil
+}
+
+// checkExpectedLastSeq enforces the context's expected last event sequence,
+// if any, for a campaign within the append transaction.
+func checkExpectedLastSeq(ctx context.Context, qtx *db.Queries, campaignID string) error {
+	if err := qtx.InitEventSeq(ctx, campaignID); err != nil {
+		return fmt.Errorf("init event seq: %w", err)
+	}
+	next, err := qtx.GetEventSeq(ctx, campaignID)
+	if err != nil {
+		return fmt.Errorf("get event seq: %w", err)
+	}
+	return storage.CheckExpectedLastSeq(ctx, campaignID, uint64(next)-1)
+}
+
+// appendEventTx assigns the next sequence, hashes, chains and signs a
+// normalized event and inserts it within qtx. On an insert error the returned
+// event carries its hash so callers can look up a duplicate.
+func (s *Store) appendEventTx(ctx context.Context, qtx *db.Queries, evt event.Event) (event.Event, error) {
+	if evt.Timestamp.IsZero() {
+		evt.Timestamp = time.Now().UTC()
+	}
+
+	if err := qtx.InitEventSeq(ctx, evt.CampaignID); err != nil {
+		return event.Event{}, fmt.Errorf("init event seq: %w", err)
+	}
+
+	seq, err := qtx.GetEventSeq(ctx, evt.CampaignID)
+	if err != nil {
+		return event.Event{}, fmt.Errorf("get event seq: %w", err)
+	}
+	evt.Seq = uint64(seq)
+
+	if err := qtx.IncrementEventSeq(ctx, evt.CampaignID); err != nil {
+		return event.Event{}, fmt.Errorf("increment event seq: %w", err)
+	}
+
+	if s.keyring == nil {
+		return event.Event{}, fmt.Errorf("event integrity keyring is required")
+	}
+
+	hash, err := integrity.EventHash(evt)
+	if err != nil {
+		return event.Event{}, fmt.Errorf("compute event hash: %w", err)
+	}
+	if strings.TrimSpace(hash) == "" {
+		return event.Event{}, fmt.Errorf("event hash is required")
+	}
+	evt.Hash = hash
+
+	prevHash := ""
+	if evt.Seq > 1 {
+		prevRow, err := qtx.GetEventBySeq(ctx, db.GetEventBySeqParams{
+			CampaignID: evt.CampaignID,
+			Seq:        int64(evt.Seq - 1),
+		})
+		if err != nil {
+			return event.Event{}, fmt.Errorf("load previous event: %w", err)
+		}
+		prevHash = prevRow.ChainHash
+	}
+
+	chainHash, err := integrity.ChainHash(evt, prevHash)
+	if err != nil {
+		return event.Event{}, fmt.Errorf("compute chain hash: %w", err)
+	}
+	if strings.TrimSpace(chainHash) == "" {
+		return event.Event{}, fmt.Errorf("chain hash is required")
+	}
+
+	signature, keyID, err := s.keyring.SignChainHash(evt.CampaignID, chainHash)
+	if err != nil {
+		return event.Event{}, fmt.Errorf("sign chain hash: %w", err)
+	}
+
+	evt.PrevHash = prevHash
+	evt.ChainHash = chainHash
+	evt.Signature = signature
+	evt.SignatureKeyID = keyID
+
+	if err := qtx.AppendEvent(ctx, db.AppendEventParams{
+		CampaignID:     evt.CampaignID,
+		Seq:            int64(evt.Seq),
+		EventHash:      evt.Hash,
+		PrevEventHash:  prevHash,
+		ChainHash:      chainHash,
+		SignatureKeyID: keyID,
+		EventSignature: signature,
+		Timestamp:      toMillis(evt.Timestamp),
+		EventType:      string(evt.Type),
+		SessionID:      evt.SessionID,
+		RequestID:      evt.RequestID,
+		InvocationID:   evt.InvocationID,
+		ActorType:      string(evt.ActorType),
+		ActorID:        evt.ActorID,
+		EntityType:     evt.EntityType,
+		EntityID:       evt.EntityID,
+		SystemID:       evt.SystemID,
+		SystemVersion:  evt.SystemVersion,
+		PayloadJson:    evt.PayloadJSON,
+		PayloadVersion: int64(evt.PayloadVersion),
+	}); err != nil {
+		return event.Event{Hash: evt.Hash}, err
+	}
+
+	return evt, nil
+}
+
+// VerifyEventIntegrity validates the event chain and signatures for all campaigns.
+func (s *Store) VerifyEventIntegrity(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
+	}
+	if s.keyring == nil {
+		return fmt.Errorf("event integrity keyring is required")
+	}
+
+	campaignIDs, err := s.listEventCampaignIDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, campaignID := range campaignIDs {
+		if err := s.verifyCampaignEvents(ctx, campaignID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Store) listEventCampaignIDs(ctx context.Context) ([]string, error) {
+	rows, err := s.sqlDB.QueryContext(ctx, "SELECT DISTINCT campaign_id FROM events ORDER BY campaign_id")
+	if err != nil {
+		return nil, fmt.Errorf("list campaign ids: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan campaign id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate campaign ids: %w", err)
+	}
+	return ids, nil
+}
 
-	createdAt := entry.CreatedAt
-	if createdAt.IsZero() {
-		createdAt = time.Now().UTC()
+func (s *Store) verifyCampaignEvents(ctx context.Context, campaignID string) error {
+	var lastSeq uint64
+	prevChainHash := ""
+	for {
+		events, err := s.ListEvents(ctx, campaignID, lastSeq, 200)
+		if err != nil {
+			return fmt.Errorf("list events campaign_id=%s: %w", campaignID, err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		for _, evt := range events {
+			if evt.Seq != lastSeq+1 {
+				return fmt.Errorf("event sequence gap campaign_id=%s expected=%d got=%d", campaignID, lastSeq+1, evt.Seq)
+			}
+			if evt.Seq == 1 && evt.PrevHash != "" {
+				return fmt.Errorf("first event prev hash must be empty campaign_id=%s", campaignID)
+			}
+			if evt.Seq > 1 && evt.PrevHash != prevChainHash {
+				return fmt.Errorf("prev hash mismatch campaign_id=%s seq=%d", campaignID, evt.Seq)
+			}
+
+			hash, err := integrity.EventHash(evt)
+			if err != nil {
+				return fmt.Errorf("compute event hash campaign_id=%s seq=%d: %w", campaignID, evt.Seq, err)
+			}
+			if hash != evt.Hash {
+				return fmt.Errorf("event hash mismatch campaign_id=%s seq=%d", campaignID, evt.Seq)
+			}
+
+			chainHash, err := integrity.ChainHash(evt, prevChainHash)
+			if err != nil {
+				return fmt.Errorf("compute chain hash campaign_id=%s seq=%d: %w", campaignID, evt.Seq, err)
+			}
+			if chainHash != evt.ChainHash {
+				return fmt.Errorf("chain hash mismatch campaign_id=%s seq=%d", campaignID, evt.Seq)
+			}
+
+			if err := s.keyring.VerifyChainHash(campaignID, chainHash, evt.Signature, evt.SignatureKeyID); err != nil {
+				return fmt.Errorf("signature mismatch campaign_id=%s seq=%d: %w", campaignID, evt.Seq, err)
+			}
+
+			prevChainHash = evt.ChainHash
+			lastSeq = evt.Seq
+		}
 	}
-	updatedAt := entry.UpdatedAt
-	if updatedAt.IsZero() {
-		updatedAt = createdAt
+}
+
+func isConstraintError(err error) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
 	}
+	code := sqliteErr.Code()
+	return code == sqlite3.SQLITE_CONSTRAINT || code == sqlite3.SQLITE_CONSTRAINT_UNIQUE || code == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY
+}
 
-	return s.q.PutDaggerheartContentString(ctx, db.PutDaggerheartContentStringParams{
-		ContentID:   entry.ContentID,
-		ContentType: entry.ContentType,
-		Field:       entry.Field,
-		Locale:      entry.Locale,
-		Text:        entry.Text,
-		CreatedAt:   toMillis(createdAt),
-		UpdatedAt:   toMillis(updatedAt),
-	})
+func isParticipantUserConflict(err error) bool {
+	if !isConstraintError(err) {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "idx_participants_campaign_user") ||
+		(strings.Contains(message, "participant") && strings.Contains(message, "user_id"))
 }
 
-// EventStore methods (unified event journal)
+func isParticipantClaimConflict(err error) bool {
+	if !isConstraintError(err) {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "participant_claims") ||
+		strings.Contains(message, "idx_participant_claims")
+}
 
-// AppendEvent atomically appends an event and returns it with sequence and hash set.
-func (s *Store) AppendEvent(ctx context.Context, evt event.Event) (event.Event, error) {
+// AppendTelemetryEvent records an operational telemetry event.
+func (s *Store) AppendTelemetryEvent(ctx context.Context, evt storage.TelemetryEvent) error {
 	if err := ctx.Err(); err != nil {
-		return event.Event{}, err
+		return err
 	}
 	if s == nil || s.sqlDB == nil {
-		return event.Event{}, fmt.Errorf("storage is not configured")
+		return fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(evt.EventName) == "" {
+		return fmt.Errorf("event name is required")
+	}
+	if strings.TrimSpace(evt.Severity) == "" {
+		return fmt.Errorf("severity is required")
+	}
+	if evt.Timestamp.IsZero() {
+		evt.Timestamp = time.Now().UTC()
+	}
+	if len(evt.AttributesJSON) == 0 && len(evt.Attributes) > 0 {
+		payload, err := json.Marshal(evt.Attributes)
+		if err != nil {
+			return fmt.Errorf("marshal telemetry attributes: %w", err)
+		}
+		evt.AttributesJSON = payload
 	}
 
-	validated, err := event.NormalizeForAppend(evt)
-	if err != nil {
-		return event.Event{}, err
+	return s.q.AppendTelemetryEvent(ctx, db.AppendTelemetryEventParams{
+		Timestamp:      toMillis(evt.Timestamp),
+		EventName:      evt.EventName,
+		Severity:       evt.Severity,
+		CampaignID:     toNullString(evt.CampaignID),
+		SessionID:      toNullString(evt.SessionID),
+		ActorType:      toNullString(evt.ActorType),
+		ActorID:        toNullString(evt.ActorID),
+		RequestID:      toNullString(evt.RequestID),
+		InvocationID:   toNullString(evt.InvocationID),
+		TraceID:        toNullString(evt.TraceID),
+		SpanID:         toNullString(evt.SpanID),
+		AttributesJson: evt.AttributesJSON,
+	})
+}
+
+// ReserveIdempotencyKey claims an idempotency key, or returns the unexpired
+// record that already holds it.
+func (s *Store) ReserveIdempotencyKey(ctx context.Context, record storage.IdempotencyRecord) (storage.IdempotencyRecord, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.IdempotencyRecord{}, false, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.IdempotencyRecord{}, false, fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(record.Key) == "" {
+		return storage.IdempotencyRecord{}, false, fmt.Errorf("idempotency key is required")
+	}
+	if strings.TrimSpace(record.Method) == "" {
+		return storage.IdempotencyRecord{}, false, fmt.Errorf("method is required")
+	}
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now().UTC()
 	}
-	evt = validated
 
 	tx, err := s.sqlDB.BeginTx(ctx, nil)
 	if err != nil {
-		return event.Event{}, fmt.Errorf("begin tx: %w", err)
+		return storage.IdempotencyRecord{}, false, fmt.Errorf("begin tx: %w", err)
 	}
 	defer tx.Rollback()
 
 	qtx := s.q.WithTx(tx)
+	if err := qtx.DeleteExpiredIdempotencyKeys(ctx, toMillis(record.CreatedAt)); err != nil {
+		return storage.IdempotencyRecord{}, false, fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+	rows, err := qtx.ReserveIdempotencyKey(ctx, db.ReserveIdempotencyKeyParams{
+		Caller:         record.Caller,
+		IdempotencyKey: record.Key,
+		RpcMethod:      record.Method,
+		RequestHash:    record.RequestHash,
+		CreatedAt:      toMillis(record.CreatedAt),
+		ExpiresAt:      toMillis(record.ExpiresAt),
+	})
+	if err != nil {
+		return storage.IdempotencyRecord{}, false, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+	if rows == 0 {
+		row, err := qtx.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{Caller: record.Caller, IdempotencyKey: record.Key, RpcMethod: record.Method})
+		if err != nil {
+			return storage.IdempotencyRecord{}, false, fmt.Errorf("get idempotency key: %w", err)
+		}
+		return dbIdempotencyKeyToDomain(row), false, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return storage.IdempotencyRecord{}, false, fmt.Errorf("commit: %w", err)
+	}
+	return storage.IdempotencyRecord{}, true, nil
+}
 
-	if evt.Timestamp.IsZero() {
-		evt.Timestamp = time.Now().UTC()
+// CompleteIdempotencyKey stores the outcome of a reserved idempotency key.
+func (s *Store) CompleteIdempotencyKey(ctx context.Context, record storage.IdempotencyRecord) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
 	}
 
-	if err := qtx.InitEventSeq(ctx, evt.CampaignID); err != nil {
-		return event.Event{}, fmt.Errorf("init event seq: %w", err)
+	return s.q.CompleteIdempotencyKey(ctx, db.CompleteIdempotencyKeyParams{
+		CampaignID:     record.CampaignID,
+		FirstSeq:       int64(record.FirstSeq),
+		LastSeq:        int64(record.LastSeq),
+		ResponseType:   record.ResponseType,
+		Response:       record.Response,
+		Status:         record.Status,
+		ExpiresAt:      toMillis(record.ExpiresAt),
+		Caller:         record.Caller,
+		IdempotencyKey: record.Key,
+		RpcMethod:      record.Method,
+	})
+}
+
+// RenewIdempotencyKey extends an in-progress reservation until expiresAt.
+func (s *Store) RenewIdempotencyKey(ctx context.Context, caller, key, method string, expiresAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
 	}
 
-	seq, err := qtx.GetEventSeq(ctx, evt.CampaignID)
-	if err != nil {
-		return event.Event{}, fmt.Errorf("get event seq: %w", err)
+	return s.q.RenewIdempotencyKey(ctx, db.RenewIdempotencyKeyParams{
+		ExpiresAt:      toMillis(expiresAt),
+		Caller:         caller,
+		IdempotencyKey: key,
+		RpcMethod:      method,
+	})
+}
+
+// ReleaseIdempotencyKey drops a reserved idempotency key.
+func (s *Store) ReleaseIdempotencyKey(ctx context.Context, caller, key, method string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if s == nil || s.sqlDB == nil {
+		return fmt.Errorf("storage is not configured")
 	}
-	evt.Seq = uint64(seq)
 
-	if err := qtx.IncrementEventSeq(ctx, evt.CampaignID); err != nil {
-		return event.Event{}, fmt.Errorf("increment event seq: %w", err)
+	return s.q.DeleteIdempotencyKey(ctx, db.DeleteIdempotencyKeyParams{Caller: caller, IdempotencyKey: key, RpcMethod: method})
+}
+
+func dbIdempotencyKeyToDomain(row db.IdempotencyKey) storage.IdempotencyRecord {
+	return storage.IdempotencyRecord{
+		Caller:       row.Caller,
+		Key:          row.IdempotencyKey,
+		Method:       row.RpcMethod,
+		RequestHash:  row.RequestHash,
+		Completed:    row.Completed != 0,
+		CampaignID:   row.CampaignID,
+		FirstSeq:     uint64(row.FirstSeq),
+		LastSeq:      uint64(row.LastSeq),
+		ResponseType: row.ResponseType,
+		Response:     row.Response,
+		Status:       row.Status,
+		CreatedAt:    fromMillis(row.CreatedAt),
+		ExpiresAt:    fromMillis(row.ExpiresAt),
 	}
+}
 
-	if s.keyring == nil {
-		return event.Event{}, fmt.Errorf("event integrity keyring is required")
+// projectionCheckpointRetention is how many checkpoints are kept per campaign.
+const projectionCheckpointRetention = 5
+
+// SaveProjectionCheckpoint stores a projection checkpoint linked to the chain
+// hash of the event at its sequence, keeping the most recent few per campaign.
+func (s *Store) SaveProjectionCheckpoint(ctx context.Context, checkpoint storage.ProjectionCheckpoint) (storage.ProjectionCheckpoint, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.ProjectionCheckpoint{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.ProjectionCheckpoint{}, fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(checkpoint.CampaignID) == "" {
+		return storage.ProjectionCheckpoint{}, fmt.Errorf("campaign id is required")
+	}
+	if checkpoint.Seq == 0 {
+		return storage.ProjectionCheckpoint{}, fmt.Errorf("checkpoint seq is required")
+	}
+	if checkpoint.CreatedAt.IsZero() {
+		checkpoint.CreatedAt = time.Now().UTC()
 	}
 
-	hash, err := integrity.EventHash(evt)
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
 	if err != nil {
-		return event.Event{}, fmt.Errorf("compute event hash: %w", err)
+		return storage.ProjectionCheckpoint{}, fmt.Errorf("begin tx: %w", err)
 	}
-	if strings.TrimSpace(hash) == "" {
-		return event.Event{}, fmt.Errorf("event hash is required")
+	defer tx.Rollback()
+
+	qtx := s.q.WithTx(tx)
+	row, err := qtx.GetEventBySeq(ctx, db.GetEventBySeqParams{CampaignID: checkpoint.CampaignID, Seq: int64(checkpoint.Seq)})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.ProjectionCheckpoint{}, storage.ErrNotFound
+		}
+		return storage.ProjectionCheckpoint{}, fmt.Errorf("get event by seq: %w", err)
 	}
-	evt.Hash = hash
+	checkpoint.ChainHash = row.ChainHash
+	sum := sha256.Sum256(checkpoint.State)
+	checkpoint.StateHash = hex.EncodeToString(sum[:])
 
-	prevHash := ""
-	if evt.Seq > 1 {
-		prevRow, err := qtx.GetEventBySeq(ctx, db.GetEventBySeqParams{
-			CampaignID: evt.CampaignID,
-			Seq:        int64(evt.Seq - 1),
-		})
-		if err != nil {
-			return event.Event{}, fmt.Errorf("load previous event: %w", err)
+	if err := qtx.PutProjectionCheckpoint(ctx, db.PutProjectionCheckpointParams{
+		CampaignID: checkpoint.CampaignID,
+		Seq:        int64(checkpoint.Seq),
+		ChainHash:  checkpoint.ChainHash,
+		StateHash:  checkpoint.StateHash,
+		State:      checkpoint.State,
+		CreatedAt:  toMillis(checkpoint.CreatedAt),
+	}); err != nil {
+		return storage.ProjectionCheckpoint{}, fmt.Errorf("put projection checkpoint: %w", err)
+	}
+	if err := qtx.PruneProjectionCheckpoints(ctx, db.PruneProjectionCheckpointsParams{
+		CampaignID: checkpoint.CampaignID,
+		Keep:       projectionCheckpointRetention,
+	}); err != nil {
+		return storage.ProjectionCheckpoint{}, fmt.Errorf("prune projection checkpoints: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return storage.ProjectionCheckpoint{}, fmt.Errorf("commit: %w", err)
+	}
+	return checkpoint, nil
+}
+
+// GetProjectionCheckpoint returns the latest projection checkpoint at or
+// before a sequence (0 = latest).
+func (s *Store) GetProjectionCheckpoint(ctx context.Context, campaignID string, atOrBeforeSeq uint64) (storage.ProjectionCheckpoint, error) {
+	if err := ctx.Err(); err != nil {
+		return storage.ProjectionCheckpoint{}, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return storage.ProjectionCheckpoint{}, fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(campaignID) == "" {
+		return storage.ProjectionCheckpoint{}, fmt.Errorf("campaign id is required")
+	}
+	seq := int64(math.MaxInt64)
+	if atOrBeforeSeq > 0 {
+		seq = int64(atOrBeforeSeq)
+	}
+
+	row, err := s.q.GetProjectionCheckpoint(ctx, db.GetProjectionCheckpointParams{CampaignID: campaignID, Seq: seq})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return storage.ProjectionCheckpoint{}, storage.ErrNotFound
 		}
-		prevHash = prevRow.ChainHash
+		return storage.ProjectionCheckpoint{}, fmt.Errorf("get projection checkpoint: %w", err)
+	}
+	return storage.ProjectionCheckpoint{
+		CampaignID: row.CampaignID,
+		Seq:        uint64(row.Seq),
+		ChainHash:  row.ChainHash,
+		StateHash:  row.StateHash,
+		State:      row.State,
+		CreatedAt:  fromMillis(row.CreatedAt),
+	}, nil
+}
+
+// ListEventCampaignIDs returns the IDs of every campaign with journal events.
+func (s *Store) ListEventCampaignIDs(ctx context.Context) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+
+	ids, err := s.q.ListEventCampaignIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list event campaign ids: %w", err)
+	}
+	return ids, nil
+}
+
+// projectionState is the serialized form of a campaign's projection rows.
+type projectionState struct {
+	Tables []projectionTable `json:"tables"`
+}
+
+// projectionTable holds the rows of one projection table. Each value keeps
+// its SQLite storage class; a nil value is NULL.
+type projectionTable struct {
+	Name    string               `json:"name"`
+	Columns []string             `json:"columns"`
+	Rows    [][]*projectionValue `json:"rows"`
+}
+
+type projectionValue struct {
+	Int   *int64   `json:"i,omitempty"`
+	Float *float64 `json:"f,omitempty"`
+	Text  *string  `json:"s,omitempty"`
+	Blob  *[]byte  `json:"b,omitempty"`
+}
+
+// ExportCampaignProjections serializes the campaign's row in campaigns and
+// its rows in every table with a campaign_id column.
+func (s *Store) ExportCampaignProjections(ctx context.Context, campaignID string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+	if strings.TrimSpace(campaignID) == "" {
+		return nil, fmt.Errorf("campaign id is required")
 	}
 
-	chainHash, err := integrity.ChainHash(evt, prevHash)
+	tx, err := s.sqlDB.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
 	if err != nil {
-		return event.Event{}, fmt.Errorf("compute chain hash: %w", err)
-	}
-	if strings.TrimSpace(chainHash) == "" {
-		return event.Event{}, fmt.Errorf("chain hash is required")
+		return nil, fmt.Errorf("begin tx: %w", err)
 	}
+	defer tx.Rollback()
 
-	signature, keyID, err := s.keyring.SignChainHash(evt.CampaignID, chainHash)
+	tables, err := campaignProjectionTables(ctx, tx)
 	if err != nil {
-		return event.Event{}, fmt.Errorf("sign chain hash: %w", err)
+		return nil, err
 	}
-
-	evt.PrevHash = prevHash
-	evt.ChainHash = chainHash
-	evt.Signature = signature
-	evt.SignatureKeyID = keyID
-
-	if err := qtx.AppendEvent(ctx, db.AppendEventParams{
-		CampaignID:     evt.CampaignID,
-		Seq:            int64(evt.Seq),
-		EventHash:      evt.Hash,
-		PrevEventHash:  prevHash,
-		ChainHash:      chainHash,
-		SignatureKeyID: keyID,
-		EventSignature: signature,
-		Timestamp:      toMillis(evt.Timestamp),
-		EventType:      string(evt.Type),
-		SessionID:      evt.SessionID,
-		RequestID:      evt.RequestID,
-		InvocationID:   evt.InvocationID,
-		ActorType:      string(evt.ActorType),
-		ActorID:        evt.ActorID,
-		EntityType:     evt.EntityType,
-		EntityID:       evt.EntityID,
-		SystemID:       evt.SystemID,
-		SystemVersion:  evt.SystemVersion,
-		PayloadJson:    evt.PayloadJSON,
-	}); err != nil {
-		if isConstraintError(err) {
-			stored, lookupErr := s.GetEventByHash(ctx, evt.Hash)
-			if lookupErr == nil {
-				return stored, nil
-			}
+	state := projectionState{Tables: make([]projectionTable, 0, len(tables))}
+	for _, table := range tables {
+		rows, err := exportProjectionRows(ctx, tx, table, campaignID)
+		if err != nil {
+			return nil, err
 		}
-		return event.Event{}, fmt.Errorf("append event: %w", err)
+		table.Rows = rows
+		state.Tables = append(state.Tables, table)
 	}
+	return json.Marshal(state)
+}
 
-	if err := tx.Commit(); err != nil {
-		return event.Event{}, fmt.Errorf("commit: %w", err)
-	}
+// ImportCampaignProjections replaces the campaign's projection rows with an
+// exported state. Foreign keys are checked at commit so rows can be inserted
+// table by table.
+func (s *Store) ImportCampaignProjections(ctx context.Context, campaignID string, state []byte) error {
+	return s.importCampaignProjections(ctx, campaignID, campaignID, state, nil)
+}
 
-	return evt, nil
+// forkIdentityColumns are the campaigns columns a fork keeps when it imports
+// its source campaign's projections.
+var forkIdentityColumns = []string{"name", "parent_campaign_id", "fork_event_seq", "origin_campaign_id", "created_at"}
+
+// ImportForkedCampaignProjections replaces the fork's projection rows with a
+// state exported from its source campaign. Rows are moved to the fork's id and
+// the fork's campaign row keeps its identity columns.
+func (s *Store) ImportForkedCampaignProjections(ctx context.Context, sourceCampaignID, forkCampaignID string, state []byte) error {
+	if strings.TrimSpace(sourceCampaignID) == "" {
+		return fmt.Errorf("source campaign id is required")
+	}
+	return s.importCampaignProjections(ctx, sourceCampaignID, forkCampaignID, state, forkIdentityColumns)
 }
 
-// VerifyEventIntegrity validates the event chain and signatures for all campaigns.
-func (s *Store) VerifyEventIntegrity(ctx context.Context) error {
+// importCampaignProjections imports a state exported from sourceCampaignID as
+// the projections of campaignID. The keep columns of campaignID's current
+// campaign row survive the import.
+func (s *Store) importCampaignProjections(ctx context.Context, sourceCampaignID, campaignID string, state []byte, keep []string) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
 	if s == nil || s.sqlDB == nil {
 		return fmt.Errorf("storage is not configured")
 	}
-	if s.keyring == nil {
-		return fmt.Errorf("event integrity keyring is required")
+	if strings.TrimSpace(campaignID) == "" {
+		return fmt.Errorf("campaign id is required")
+	}
+	var imported projectionState
+	if len(state) > 0 {
+		if err := json.Unmarshal(state, &imported); err != nil {
+			return fmt.Errorf("%w: decode: %w", storage.ErrIncompatibleProjectionState, err)
+		}
 	}
 
-	campaignIDs, err := s.listEventCampaignIDs(ctx)
+	tx, err := s.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "PRAGMA defer_foreign_keys = ON"); err != nil {
+		return fmt.Errorf("defer foreign keys: %w", err)
+	}
+	tables, err := campaignProjectionTables(ctx, tx)
 	if err != nil {
 		return err
 	}
-	for _, campaignID := range campaignIDs {
-		if err := s.verifyCampaignEvents(ctx, campaignID); err != nil {
+	if len(state) > 0 {
+		if err := matchProjectionSchema(tables, imported.Tables); err != nil {
+			return fmt.Errorf("%w: %w", storage.ErrIncompatibleProjectionState, err)
+		}
+	}
+	kept, err := campaignColumnValues(ctx, tx, campaignID, keep)
+	if err != nil {
+		return err
+	}
+	// Children first; deleting the campaign row would cascade anyway.
+	for i := len(tables) - 1; i >= 0; i-- {
+		table := tables[i]
+		query := fmt.Sprintf("DELETE FROM %s WHERE %s = ?", quoteIdentifier(table.Name), quoteIdentifier(projectionCampaignColumn(table.Name)))
+		if _, err := tx.ExecContext(ctx, query, campaignID); err != nil {
+			return fmt.Errorf("clear %s: %w", table.Name, err)
+		}
+	}
+	for _, table := range imported.Tables {
+		var overrides map[string]any
+		if table.Name == "campaigns" {
+			overrides = kept
+		}
+		if err := importProjectionRows(ctx, tx, table, sourceCampaignID, campaignID, overrides); err != nil {
 			return err
 		}
 	}
-
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
 	return nil
 }
 
-func (s *Store) listEventCampaignIDs(ctx context.Context) ([]string, error) {
-	rows, err := s.sqlDB.QueryContext(ctx, "SELECT DISTINCT campaign_id FROM events ORDER BY campaign_id")
-	if err != nil {
-		return nil, fmt.Errorf("list campaign ids: %w", err)
+// campaignColumnValues reads columns of a campaign row by name.
+func campaignColumnValues(ctx context.Context, tx *sql.Tx, campaignID string, columns []string) (map[string]any, error) {
+	if len(columns) == 0 {
+		return nil, nil
 	}
-	defer rows.Close()
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = quoteIdentifier(column)
+	}
+	values := make([]any, len(columns))
+	targets := make([]any, len(columns))
+	for i := range values {
+		targets[i] = &values[i]
+	}
+	query := fmt.Sprintf("SELECT %s FROM campaigns WHERE id = ?", strings.Join(quoted, ", "))
+	if err := tx.QueryRowContext(ctx, query, campaignID).Scan(targets...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("get campaign %s: %w", campaignID, err)
+	}
+	kept := make(map[string]any, len(columns))
+	for i, column := range columns {
+		kept[column] = values[i]
+	}
+	return kept, nil
+}
 
-	var ids []string
+// campaignProjectionTables lists campaigns followed by every other table with
+// a campaign_id column, in name order.
+func campaignProjectionTables(ctx context.Context, tx *sql.Tx) ([]projectionTable, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("list tables: %w", err)
+	}
+	var names []string
 	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
-			return nil, fmt.Errorf("scan campaign id: %w", err)
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan table name: %w", err)
 		}
-		ids = append(ids, id)
+		names = append(names, name)
 	}
+	rows.Close()
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("iterate campaign ids: %w", err)
+		return nil, fmt.Errorf("list tables: %w", err)
 	}
-	return ids, nil
-}
 
-func (s *Store) verifyCampaignEvents(ctx context.Context, campaignID string) error {
-	var lastSeq uint64
-	prevChainHash := ""
-	for {
-		events, err := s.ListEvents(ctx, campaignID, lastSeq, 200)
+	var campaigns []projectionTable
+	var tables []projectionTable
+	for _, name := range names {
+		columns, err := tableColumns(ctx, tx, name)
 		if err != nil {
-			return fmt.Errorf("list events campaign_id=%s: %w", campaignID, err)
+			return nil, err
 		}
-		if len(events) == 0 {
-			return nil
+		table := projectionTable{Name: name, Columns: columns}
+		switch {
+		case name == "campaigns":
+			campaigns = append(campaigns, table)
+		case slices.Contains(columns, "campaign_id"):
+			tables = append(tables, table)
 		}
-		for _, evt := range events {
-			if evt.Seq != lastSeq+1 {
-				return fmt.Errorf("event sequence gap campaign_id=%s expected=%d got=%d", campaignID, lastSeq+1, evt.Seq)
-			}
-			if evt.Seq == 1 && evt.PrevHash != "" {
-				return fmt.Errorf("first event prev hash must be empty campaign_id=%s", campaignID)
-			}
-			if evt.Seq > 1 && evt.PrevHash != prevChainHash {
-				return fmt.Errorf("prev hash mismatch campaign_id=%s seq=%d", campaignID, evt.Seq)
-			}
+	}
+	return append(campaigns, tables...), nil
+}
 
-			hash, err := integrity.EventHash(evt)
-			if err != nil {
-				return fmt.Errorf("compute event hash campaign_id=%s seq=%d: %w", campaignID, evt.Seq, err)
-			}
-			if hash != evt.Hash {
-				return fmt.Errorf("event hash mismatch campaign_id=%s seq=%d", campaignID, evt.Seq)
-			}
+func tableColumns(ctx context.Context, tx *sql.Tx, table string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT name FROM pragma_table_info(?) ORDER BY cid", table)
+	if err != nil {
+		return nil, fmt.Errorf("list %s columns: %w", table, err)
+	}
+	defer rows.Close()
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, fmt.Errorf("scan %s column: %w", table, err)
+		}
+		columns = append(columns, column)
+	}
+	return columns, rows.Err()
+}
 
-			chainHash, err := integrity.ChainHash(evt, prevChainHash)
+func exportProjectionRows(ctx context.Context, tx *sql.Tx, table projectionTable, campaignID string) ([][]*projectionValue, error) {
+	quoted := make([]string, len(table.Columns))
+	order := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		quoted[i] = quoteIdentifier(column)
+		order[i] = fmt.Sprint(i + 1)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s = ? ORDER BY %s",
+		strings.Join(quoted, ", "), quoteIdentifier(table.Name),
+		quoteIdentifier(projectionCampaignColumn(table.Name)), strings.Join(order, ", "))
+	rows, err := tx.QueryContext(ctx, query, campaignID)
+	if err != nil {
+		return nil, fmt.Errorf("export %s: %w", table.Name, err)
+	}
+	defer rows.Close()
+
+	result := [][]*projectionValue{}
+	for rows.Next() {
+		values := make([]any, len(table.Columns))
+		targets := make([]any, len(table.Columns))
+		for i := range values {
+			targets[i] = &values[i]
+		}
+		if err := rows.Scan(targets...); err != nil {
+			return nil, fmt.Errorf("scan %s: %w", table.Name, err)
+		}
+		row := make([]*projectionValue, len(values))
+		for i, value := range values {
+			row[i], err = projectionValueOf(value)
 			if err != nil {
-				return fmt.Errorf("compute chain hash campaign_id=%s seq=%d: %w", campaignID, evt.Seq, err)
-			}
-			if chainHash != evt.ChainHash {
-				return fmt.Errorf("chain hash mismatch campaign_id=%s seq=%d", campaignID, evt.Seq)
+				return nil, fmt.Errorf("export %s.%s: %w", table.Name, table.Columns[i], err)
 			}
+		}
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("export %s: %w", table.Name, err)
+	}
+	return result, nil
+}
 
-			if err := s.keyring.VerifyChainHash(campaignID, chainHash, evt.Signature, evt.SignatureKeyID); err != nil {
-				return fmt.Errorf("signature mismatch campaign_id=%s seq=%d: %w", campaignID, evt.Seq, err)
-			}
+// importProjectionRows inserts rows exported from sourceCampaignID under
+// campaignID, replacing the values of any overridden columns.
+func importProjectionRows(ctx context.Context, tx *sql.Tx, table projectionTable, sourceCampaignID, campaignID string, overrides map[string]any) error {
+	if len(table.Rows) == 0 {
+		return nil
+	}
+	keyIndex := -1
+	quoted := make([]string, len(table.Columns))
+	placeholders := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		quoted[i] = quoteIdentifier(column)
+		placeholders[i] = "?"
+		if column == projectionCampaignColumn(table.Name) {
+			keyIndex = i
+		}
+	}
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		quoteIdentifier(table.Name), strings.Join(quoted, ", "), strings.Join(placeholders, ", "))
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("prepare %s import: %w", table.Name, err)
+	}
+	defer stmt.Close()
 
-			prevChainHash = evt.ChainHash
-			lastSeq = evt.Seq
+	for _, row := range table.Rows {
+		if len(row) != len(table.Columns) {
+			return fmt.Errorf("%w: import %s: row has %d values, want %d", storage.ErrIncompatibleProjectionState, table.Name, len(row), len(table.Columns))
+		}
+		args := make([]any, len(row))
+		for i, value := range row {
+			args[i] = value.sqlValue()
+		}
+		if keyIndex < 0 || args[keyIndex] != sourceCampaignID {
+			return fmt.Errorf("import %s: row does not belong to campaign %s", table.Name, sourceCampaignID)
+		}
+		args[keyIndex] = campaignID
+		for i, column := range table.Columns {
+			if value, ok := overrides[column]; ok {
+				args[i] = value
+			}
+		}
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			return fmt.Errorf("import %s: %w", table.Name, err)
 		}
 	}
+	return nil
 }
 
-func isConstraintError(err error) bool {
-	var sqliteErr *sqlite.Error
-	if !errors.As(err, &sqliteErr) {
-		return false
+// matchProjectionSchema rejects states exported from a different set of
+// projection tables or columns, whose replayed tail would be incomplete.
+func matchProjectionSchema(current, imported []projectionTable) error {
+	if len(current) != len(imported) {
+		return fmt.Errorf("projection state has %d tables, schema has %d", len(imported), len(current))
 	}
-	code := sqliteErr.Code()
-	return code == sqlite3.SQLITE_CONSTRAINT || code == sqlite3.SQLITE_CONSTRAINT_UNIQUE || code == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY
+	for i, table := range current {
+		if imported[i].Name != table.Name || strings.Join(imported[i].Columns, ",") != strings.Join(table.Columns, ",") {
+			return fmt.Errorf("projection state table %s does not match schema table %s", imported[i].Name, table.Name)
+		}
+	}
+	return nil
 }
 
-func isParticipantUserConflict(err error) bool {
-	if !isConstraintError(err) {
-		return false
+func projectionValueOf(value any) (*projectionValue, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case int64:
+		return &projectionValue{Int: &v}, nil
+	case float64:
+		return &projectionValue{Float: &v}, nil
+	case string:
+		return &projectionValue{Text: &v}, nil
+	case []byte:
+		blob := append([]byte{}, v...)
+		return &projectionValue{Blob: &blob}, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
 	}
-	message := strings.ToLower(err.Error())
-	return strings.Contains(message, "idx_participants_campaign_user") ||
-		(strings.Contains(message, "participant") && strings.Contains(message, "user_id"))
 }
 
-func isParticipantClaimConflict(err error) bool {
-	if !isConstraintError(err) {
-		return false
+func (v *projectionValue) sqlValue() any {
+	switch {
+	case v == nil:
+		return nil
+	case v.Int != nil:
+		return *v.Int
+	case v.Float != nil:
+		return *v.Float
+	case v.Text != nil:
+		return *v.Text
+	case v.Blob != nil:
+		return *v.Blob
+	default:
+		return nil
 	}
-	message := strings.ToLower(err.Error())
-	return strings.Contains(message, "participant_claims") ||
-		strings.Contains(message, "idx_participant_claims")
 }
 
-// AppendTelemetryEvent records an operational telemetry event.
-func (s *Store) AppendTelemetryEvent(ctx context.Context, evt storage.TelemetryEvent) error {
-	if err := ctx.Err(); err != nil {
-		return err
-	}
-	if s == nil || s.sqlDB == nil {
-		return fmt.Errorf("storage is not configured")
-	}
-	if strings.TrimSpace(evt.EventName) == "" {
-		return fmt.Errorf("event name is required")
-	}
-	if strings.TrimSpace(evt.Severity) == "" {
-		return fmt.Errorf("severity is required")
-	}
-	if evt.Timestamp.IsZero() {
-		evt.Timestamp = time.Now().UTC()
-	}
-	if len(evt.AttributesJSON) == 0 && len(evt.Attributes) > 0 {
-		payload, err := json.Marshal(evt.Attributes)
-		if err != nil {
-			return fmt.Errorf("marshal telemetry attributes: %w", err)
-		}
-		evt.AttributesJSON = payload
+func projectionCampaignColumn(table string) string {
+	if table == "campaigns" {
+		return "id"
 	}
+	return "campaign_id"
+}
 
-	return s.q.AppendTelemetryEvent(ctx, db.AppendTelemetryEventParams{
-		Timestamp:      toMillis(evt.Timestamp),
-		EventName:      evt.EventName,
-		Severity:       evt.Severity,
-		CampaignID:     toNullString(evt.CampaignID),
-		SessionID:      toNullString(evt.SessionID),
-		ActorType:      toNullString(evt.ActorType),
-		ActorID:        toNullString(evt.ActorID),
-		RequestID:      toNullString(evt.RequestID),
-		InvocationID:   toNullString(evt.InvocationID),
-		TraceID:        toNullString(evt.TraceID),
-		SpanID:         toNullString(evt.SpanID),
-		AttributesJson: evt.AttributesJSON,
-	})
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
 }
 
 // GetGameStatistics returns aggregate counts across the game data set.
@@ -5081,6 +6421,31 @@ func (s *Store) GetLatestEventSeq(ctx context.Context, campaignID string) (uint6
 	return uint64(seq), nil
 }
 
+// ListEventPayloadVersions counts events by campaign, type and payload version.
+func (s *Store) ListEventPayloadVersions(ctx context.Context) ([]storage.EventPayloadVersionCount, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if s == nil || s.sqlDB == nil {
+		return nil, fmt.Errorf("storage is not configured")
+	}
+
+	rows, err := s.q.ListEventPayloadVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list event payload versions: %w", err)
+	}
+	counts := make([]storage.EventPayloadVersionCount, 0, len(rows))
+	for _, row := range rows {
+		counts = append(counts, storage.EventPayloadVersionCount{
+			CampaignID:     row.CampaignID,
+			Type:           event.Type(row.EventType),
+			PayloadVersion: int(row.PayloadVersion),
+			Count:          int(row.EventCount),
+		})
+	}
+	return counts, nil
+}
+
 // ListEventsPage returns a paginated, filtered, and sorted list of events.
 func (s *Store) ListEventsPage(ctx context.Context, req storage.ListEventsPageRequest) (storage.ListEventsPageResult, error) {
 	if err := ctx.Err(); err != nil {
@@ -5145,7 +6510,7 @@ func (s *Store) ListEventsPage(ctx context.Context, req storage.ListEventsPageRe
 
 	// Build and execute the query
 	query := fmt.Sprintf(
-		"SELECT campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature, timestamp, event_type, session_id, request_id, invocation_id, actor_type, actor_id, entity_type, entity_id, payload_json FROM events WHERE %s %s %s",
+		"SELECT campaign_id, seq, event_hash, prev_event_hash, chain_hash, signature_key_id, event_signature, timestamp, event_type, session_id, request_id, invocation_id, actor_type, actor_id, entity_type, entity_id, payload_json, payload_version FROM events WHERE %s %s %s",
 		whereClause,
 		orderClause,
 		limitClause,
@@ -5178,6 +6543,7 @@ func (s *Store) ListEventsPage(ctx context.Context, req storage.ListEventsPageRe
 			&row.EntityType,
 			&row.EntityID,
 			&row.PayloadJson,
+			&row.PayloadVersion,
 		); err != nil {
 			return storage.ListEventsPageResult{}, fmt.Errorf("scan event: %w", err)
 		}
@@ -5444,6 +6810,7 @@ type eventRowData struct {
 	SystemID       string
 	SystemVersion  string
 	PayloadJSON    []byte
+	PayloadVersion int64
 }
 
 func eventRowDataToDomain(row eventRowData) (event.Event, error) {
@@ -5467,6 +6834,7 @@ func eventRowDataToDomain(row eventRowData) (event.Event, error) {
 		SystemID:       row.SystemID,
 		SystemVersion:  row.SystemVersion,
 		PayloadJSON:    row.PayloadJSON,
+		PayloadVersion: int(row.PayloadVersion),
 	}, nil
 }
 
@@ -5491,6 +6859,7 @@ func eventRowDataFromEvent(row db.Event) eventRowData {
 		SystemID:       row.SystemID,
 		SystemVersion:  row.SystemVersion,
 		PayloadJSON:    row.PayloadJson,
+		PayloadVersion: row.PayloadVersion,
 	}
 }
 
@@ -5515,6 +6884,7 @@ func eventRowDataFromGetEventByHashRow(row db.GetEventByHashRow) eventRowData {
 		SystemID:       row.SystemID,
 		SystemVersion:  row.SystemVersion,
 		PayloadJSON:    row.PayloadJson,
+		PayloadVersion: row.PayloadVersion,
 	}
 }
 
@@ -5539,6 +6909,7 @@ func eventRowDataFromGetEventBySeqRow(row db.GetEventBySeqRow) eventRowData {
 		SystemID:       row.SystemID,
 		SystemVersion:  row.SystemVersion,
 		PayloadJSON:    row.PayloadJson,
+		PayloadVersion: row.PayloadVersion,
 	}
 }
 
@@ -5563,6 +6934,7 @@ func eventRowDataFromListEventsRow(row db.ListEventsRow) eventRowData {
 		SystemID:       row.SystemID,
 		SystemVersion:  row.SystemVersion,
 		PayloadJSON:    row.PayloadJson,
+		PayloadVersion: row.PayloadVersion,
 	}
 }
 
@@ -5587,6 +6959,7 @@ func eventRowDataFromListEventsBySessionRow(row db.ListEventsBySessionRow) event
 		SystemID:       row.SystemID,
 		SystemVersion:  row.SystemVersion,
 		PayloadJSON:    row.PayloadJson,
+		PayloadVersion: row.PayloadVersion,
 	}
 }
 
@@ -3,6 +3,7 @@ package sqlite
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
@@ -33,12 +34,33 @@ func TestDaggerheartCharacterProfilePutGet(t *testing.T) {
 			{Name: "Stealth", Modifier: 2},
 			{Name: "Perception", Modifier: 1},
 		},
-		Agility:   3,
-		Strength:  1,
-		Finesse:   4,
-		Instinct:  2,
-		Presence:  0,
-		Knowledge: -1,
+		Agility:                 3,
+		Strength:                1,
+		Finesse:                 4,
+		Instinct:                2,
+		Presence:                0,
+		Knowledge:               -1,
+		ClassID:                 "class.rogue",
+		SubclassID:              "subclass.nightwalker",
+		SubclassStage:           "specialization",
+		MulticlassClassID:       "class.bard",
+		MulticlassSubclassID:    "subclass.troubadour",
+		MulticlassSubclassStage: "foundation",
+		MulticlassDomainID:      "domain.grace",
+		MarkedTraits:            []string{"agility", "finesse"},
+		LoadoutActive:           []string{"card-a", "card-b"},
+		LoadoutVault:            []string{"card-c"},
+		LevelHistory: []storage.DaggerheartLevelUp{
+			{
+				Level:         2,
+				NewExperience: "Stealth",
+				DomainCardID:  "card-b",
+				Advancements: []storage.DaggerheartAdvancement{
+					{Type: "traits", Traits: []string{"agility", "finesse"}},
+					{Type: "evasion"},
+				},
+			},
+		},
 	}
 
 	if err := store.PutDaggerheartCharacterProfile(context.Background(), expected); err != nil {
@@ -75,6 +97,26 @@ func TestDaggerheartCharacterProfilePutGet(t *testing.T) {
 	if got.Experiences[0].Name != "Stealth" || got.Experiences[0].Modifier != 2 {
 		t.Fatalf("expected first experience to match")
 	}
+	if got.ClassID != expected.ClassID || got.SubclassID != expected.SubclassID || got.SubclassStage != expected.SubclassStage {
+		t.Fatalf("expected class selection to match, got %q/%q/%q", got.ClassID, got.SubclassID, got.SubclassStage)
+	}
+	if got.MulticlassClassID != expected.MulticlassClassID || got.MulticlassSubclassID != expected.MulticlassSubclassID ||
+		got.MulticlassSubclassStage != expected.MulticlassSubclassStage || got.MulticlassDomainID != expected.MulticlassDomainID {
+		t.Fatalf("expected multiclass selection to match, got %+v", got)
+	}
+	if len(got.MarkedTraits) != 2 || got.MarkedTraits[1] != "finesse" {
+		t.Fatalf("expected marked traits to match, got %v", got.MarkedTraits)
+	}
+	if len(got.LoadoutActive) != 2 || len(got.LoadoutVault) != 1 || got.LoadoutVault[0] != "card-c" {
+		t.Fatalf("expected loadout to match, got active=%v vault=%v", got.LoadoutActive, got.LoadoutVault)
+	}
+	if len(got.LevelHistory) != 1 {
+		t.Fatalf("expected 1 level history entry, got %d", len(got.LevelHistory))
+	}
+	entry := got.LevelHistory[0]
+	if entry.Level != 2 || entry.DomainCardID != "card-b" || len(entry.Advancements) != 2 || entry.Advancements[0].Traits[1] != "finesse" {
+		t.Fatalf("expected level history to match, got %+v", entry)
+	}
 }
 
 func TestDaggerheartCharacterProfileNotFound(t *testing.T) {
@@ -166,6 +208,45 @@ func TestDaggerheartCharacterStateConditions(t *testing.T) {
 	}
 }
 
+func TestDaggerheartCharacterStateInventory(t *testing.T) {
+	store := openTestStore(t)
+	now := time.Date(2026, 2, 3, 11, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-inv", now)
+	seedCharacter(t, store, "camp-inv", "char-1", "Cass", character.CharacterKindPC, now)
+
+	state := storage.DaggerheartCharacterState{
+		CampaignID:   "camp-inv",
+		CharacterID:  "char-1",
+		Hp:           6,
+		Hope:         2,
+		HopeMax:      6,
+		Armor:        3,
+		LifeState:    "alive",
+		GoldHandfuls: 4,
+		GoldBags:     2,
+		GoldChests:   1,
+		Inventory: []storage.DaggerheartInventoryItem{
+			{ItemID: "armor.gambeson-armor", Kind: "armor", Quantity: 1},
+			{ItemID: "item.minor-health-potion", Kind: "item", Quantity: 2},
+		},
+		EquippedArmorID:         "armor.gambeson-armor",
+		EquippedPrimaryWeaponID: "weapon.longsword",
+		BeastformID:             "beastform.agile-scout",
+	}
+	if err := store.PutDaggerheartCharacterState(context.Background(), state); err != nil {
+		t.Fatalf("put state: %v", err)
+	}
+
+	got, err := store.GetDaggerheartCharacterState(context.Background(), "camp-inv", "char-1")
+	if err != nil {
+		t.Fatalf("get state: %v", err)
+	}
+	got.Conditions = nil
+	if !reflect.DeepEqual(got, state) {
+		t.Fatalf("expected %+v, got %+v", state, got)
+	}
+}
+
 func TestDaggerheartCharacterStateNotFound(t *testing.T) {
 	store := openTestStore(t)
 	now := time.Date(2026, 2, 3, 11, 0, 0, 0, time.UTC)
@@ -235,6 +316,11 @@ func TestDaggerheartCountdownLifecycle(t *testing.T) {
 		Max:         8,
 		Direction:   "up",
 		Looping:     true,
+		TickTrigger: "action_roll",
+		TickDelta:   1,
+		TickOutcomeDeltas: map[string]int{
+			"failure_with_fear": 2,
+		},
 	}
 
 	if err := store.PutDaggerheartCountdown(context.Background(), expected); err != nil {
@@ -257,6 +343,12 @@ func TestDaggerheartCountdownLifecycle(t *testing.T) {
 	if got.Looping != expected.Looping {
 		t.Fatalf("expected looping %v, got %v", expected.Looping, got.Looping)
 	}
+	if got.TickTrigger != expected.TickTrigger || got.TickDelta != expected.TickDelta {
+		t.Fatalf("expected tick %s/%d, got %s/%d", expected.TickTrigger, expected.TickDelta, got.TickTrigger, got.TickDelta)
+	}
+	if got.TickOutcomeDeltas["failure_with_fear"] != 2 {
+		t.Fatalf("expected failure_with_fear delta 2, got %v", got.TickOutcomeDeltas)
+	}
 
 	// Non-looping countdown
 	cd2 := storage.DaggerheartCountdown{
@@ -305,6 +397,289 @@ func TestDaggerheartCountdownNotFound(t *testing.T) {
 	}
 }
 
+func TestDaggerheartSceneRangeLifecycle(t *testing.T) {
+	store := openTestStore(t)
+	now := time.Date(2026, 2, 3, 11, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-scene", now)
+
+	expected := storage.DaggerheartSceneRange{
+		CampaignID: "camp-scene",
+		SessionID:  "sess-1",
+		FromID:     "char-1",
+		FromType:   "character",
+		ToID:       "adv-1",
+		ToType:     "adversary",
+		Band:       "melee",
+	}
+	if err := store.PutDaggerheartSceneRange(context.Background(), expected); err != nil {
+		t.Fatalf("put scene range: %v", err)
+	}
+
+	got, err := store.GetDaggerheartSceneRange(context.Background(), "camp-scene", "sess-1", "char-1", "adv-1")
+	if err != nil {
+		t.Fatalf("get scene range: %v", err)
+	}
+	if got.Band != "melee" {
+		t.Fatalf("expected band melee, got %q", got.Band)
+	}
+
+	// Pairs are symmetric, so writing the reverse direction replaces the row.
+	expected.FromID, expected.ToID = "adv-1", "char-1"
+	expected.FromType, expected.ToType = "adversary", "character"
+	expected.Band = "close"
+	if err := store.PutDaggerheartSceneRange(context.Background(), expected); err != nil {
+		t.Fatalf("put reversed scene range: %v", err)
+	}
+	got, err = store.GetDaggerheartSceneRange(context.Background(), "camp-scene", "sess-1", "adv-1", "char-1")
+	if err != nil {
+		t.Fatalf("get reversed scene range: %v", err)
+	}
+	if got.Band != "close" {
+		t.Fatalf("expected band close, got %q", got.Band)
+	}
+
+	list, err := store.ListDaggerheartSceneRanges(context.Background(), "camp-scene", "sess-1")
+	if err != nil {
+		t.Fatalf("list scene ranges: %v", err)
+	}
+	if len(list) != 1 {
+		t.Fatalf("expected 1 scene range, got %d", len(list))
+	}
+
+	_, err = store.GetDaggerheartSceneRange(context.Background(), "camp-scene", "sess-2", "char-1", "adv-1")
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestDaggerheartSessionEnvironmentLifecycle(t *testing.T) {
+	store := openTestStore(t)
+	now := time.Date(2026, 2, 3, 11, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-env", now)
+
+	expected := storage.DaggerheartSessionEnvironment{
+		CampaignID:    "camp-env",
+		SessionID:     "sess-1",
+		EnvironmentID: "environment.outpost",
+		Name:          "Outpost Town",
+		Tier:          1,
+		Type:          "Social",
+		Difficulty:    12,
+	}
+	if err := store.PutDaggerheartSessionEnvironment(context.Background(), expected); err != nil {
+		t.Fatalf("put session environment: %v", err)
+	}
+	got, err := store.GetDaggerheartSessionEnvironment(context.Background(), "camp-env", "sess-1")
+	if err != nil {
+		t.Fatalf("get session environment: %v", err)
+	}
+	if got != expected {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+
+	// A session holds one environment, so a shift replaces the row.
+	expected.EnvironmentID = "environment.cliffside"
+	expected.Name = "Cliffside Ascent"
+	expected.Type = "Traversal"
+	expected.Difficulty = 14
+	if err := store.PutDaggerheartSessionEnvironment(context.Background(), expected); err != nil {
+		t.Fatalf("put shifted session environment: %v", err)
+	}
+	got, err = store.GetDaggerheartSessionEnvironment(context.Background(), "camp-env", "sess-1")
+	if err != nil {
+		t.Fatalf("get shifted session environment: %v", err)
+	}
+	if got != expected {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+
+	if err := store.DeleteDaggerheartSessionEnvironment(context.Background(), "camp-env", "sess-1"); err != nil {
+		t.Fatalf("delete session environment: %v", err)
+	}
+	_, err = store.GetDaggerheartSessionEnvironment(context.Background(), "camp-env", "sess-1")
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestDaggerheartCompanionRoundTrip(t *testing.T) {
+	store := openTestStore(t)
+	now := time.Date(2026, 2, 3, 11, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-comp", now)
+
+	_, err := store.GetDaggerheartCompanion(context.Background(), "camp-comp", "char-1")
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	expected := storage.DaggerheartCompanion{
+		CampaignID:     "camp-comp",
+		CharacterID:    "char-1",
+		Name:           "Huan",
+		Evasion:        12,
+		Stress:         1,
+		StressMax:      4,
+		DamageDieSides: 8,
+		Range:          "very_close",
+		Experiences: []storage.DaggerheartCompanionExperience{
+			{ExperienceID: "companion-experience.scout", Modifier: 3},
+			{ExperienceID: "companion-experience.loyal-until-the-end", Modifier: 2},
+		},
+		Level:    3,
+		Upgrades: []string{"aware", "intelligent"},
+	}
+	if err := store.PutDaggerheartCompanion(context.Background(), expected); err != nil {
+		t.Fatalf("put companion: %v", err)
+	}
+	got, err := store.GetDaggerheartCompanion(context.Background(), "camp-comp", "char-1")
+	if err != nil {
+		t.Fatalf("get companion: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestDaggerheartEffectLifecycle(t *testing.T) {
+	store := openTestStore(t)
+	now := time.Date(2026, 2, 3, 11, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-eff", now)
+
+	_, err := store.GetDaggerheartEffect(context.Background(), "camp-eff", "eff-1")
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	expected := []storage.DaggerheartEffect{
+		{
+			CampaignID: "camp-eff",
+			EffectID:   "eff-2",
+			SessionID:  "sess-1",
+			TargetType: "adversary",
+			TargetID:   "adv-1",
+			Source:     "rage",
+			Kind:       "damage",
+			Value:      3,
+			Stacking:   "replace",
+			ExpiresOn:  "scene_end",
+		},
+		{
+			CampaignID: "camp-eff",
+			EffectID:   "eff-1",
+			TargetType: "character",
+			TargetID:   "char-1",
+			Source:     "shield-spell",
+			Kind:       "armor",
+			Value:      2,
+			Stacking:   "stack",
+			ExpiresOn:  "rest",
+		},
+	}
+	for _, effect := range expected {
+		if err := store.PutDaggerheartEffect(context.Background(), effect); err != nil {
+			t.Fatalf("put effect: %v", err)
+		}
+	}
+	got, err := store.GetDaggerheartEffect(context.Background(), "camp-eff", "eff-1")
+	if err != nil {
+		t.Fatalf("get effect: %v", err)
+	}
+	if !reflect.DeepEqual(got, expected[1]) {
+		t.Fatalf("expected %+v, got %+v", expected[1], got)
+	}
+	listed, err := store.ListDaggerheartEffects(context.Background(), "camp-eff")
+	if err != nil {
+		t.Fatalf("list effects: %v", err)
+	}
+	if !reflect.DeepEqual(listed, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, listed)
+	}
+
+	if err := store.DeleteDaggerheartEffect(context.Background(), "camp-eff", "eff-2"); err != nil {
+		t.Fatalf("delete effect: %v", err)
+	}
+	listed, err = store.ListDaggerheartEffects(context.Background(), "camp-eff")
+	if err != nil {
+		t.Fatalf("list effects: %v", err)
+	}
+	if len(listed) != 1 || listed[0].EffectID != "eff-1" {
+		t.Fatalf("expected only eff-1 after delete, got %+v", listed)
+	}
+}
+
+func TestDaggerheartChaseLifecycle(t *testing.T) {
+	store := openTestStore(t)
+	now := time.Date(2026, 2, 3, 11, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-chase", now)
+
+	_, err := store.GetDaggerheartChase(context.Background(), "camp-chase", "chase-1")
+	if !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	chase := storage.DaggerheartChase{
+		CampaignID:             "camp-chase",
+		ChaseID:                "chase-1",
+		Name:                   "Thief",
+		ProgressCountdownID:    "chase-1-progress",
+		ConsequenceCountdownID: "chase-1-consequence",
+		Status:                 "active",
+	}
+	if err := store.PutDaggerheartChase(context.Background(), chase); err != nil {
+		t.Fatalf("put chase: %v", err)
+	}
+
+	chase.Status = "resolved"
+	chase.Winner = "progress"
+	if err := store.PutDaggerheartChase(context.Background(), chase); err != nil {
+		t.Fatalf("update chase: %v", err)
+	}
+	got, err := store.GetDaggerheartChase(context.Background(), "camp-chase", "chase-1")
+	if err != nil {
+		t.Fatalf("get chase: %v", err)
+	}
+	if !reflect.DeepEqual(got, chase) {
+		t.Fatalf("expected %+v, got %+v", chase, got)
+	}
+
+	listed, err := store.ListDaggerheartChases(context.Background(), "camp-chase")
+	if err != nil {
+		t.Fatalf("list chases: %v", err)
+	}
+	if !reflect.DeepEqual(listed, []storage.DaggerheartChase{chase}) {
+		t.Fatalf("expected [%+v], got %+v", chase, listed)
+	}
+}
+
+func TestDaggerheartAdversaryFeatureStates(t *testing.T) {
+	store := openTestStore(t)
+	now := time.Date(2026, 2, 3, 11, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-feat", now)
+
+	state := storage.DaggerheartAdversaryFeatureState{
+		CampaignID:  "camp-feat",
+		AdversaryID: "adv-1",
+		FeatureID:   "adversary-feature.war-wizard-warding-sphere",
+		Status:      "spent",
+		RefreshOn:   "feature",
+	}
+	if err := store.PutDaggerheartAdversaryFeatureState(context.Background(), state); err != nil {
+		t.Fatalf("put feature state: %v", err)
+	}
+	state.Status = "ready"
+	if err := store.PutDaggerheartAdversaryFeatureState(context.Background(), state); err != nil {
+		t.Fatalf("update feature state: %v", err)
+	}
+
+	listed, err := store.ListDaggerheartAdversaryFeatureStates(context.Background(), "camp-feat")
+	if err != nil {
+		t.Fatalf("list feature states: %v", err)
+	}
+	if !reflect.DeepEqual(listed, []storage.DaggerheartAdversaryFeatureState{state}) {
+		t.Fatalf("expected [%+v], got %+v", state, listed)
+	}
+}
+
 func TestDaggerheartAdversaryLifecycle(t *testing.T) {
 	store := openTestStore(t)
 	now := time.Date(2026, 2, 3, 11, 0, 0, 0, time.UTC)
@@ -362,14 +737,15 @@ func TestDaggerheartAdversaryLifecycle(t *testing.T) {
 
 	// Adversary without session (nullable SessionID)
 	adv2 := storage.DaggerheartAdversary{
-		CampaignID:  "camp-adv",
-		AdversaryID: "adv-2",
-		Name:        "Goblin Scout",
-		Kind:        "minion",
-		HP:          4,
-		HPMax:       4,
-		CreatedAt:   now,
-		UpdatedAt:   now,
+		CampaignID:      "camp-adv",
+		AdversaryID:     "adv-2",
+		Name:            "Goblin Scout",
+		Kind:            "minion",
+		HP:              4,
+		HPMax:           4,
+		MinionThreshold: 3,
+		CreatedAt:       now,
+		UpdatedAt:       now,
 	}
 	if err := store.PutDaggerheartAdversary(context.Background(), adv2); err != nil {
 		t.Fatalf("put adversary 2: %v", err)
@@ -383,6 +759,9 @@ func TestDaggerheartAdversaryLifecycle(t *testing.T) {
 	if len(all) != 2 {
 		t.Fatalf("expected 2 adversaries, got %d", len(all))
 	}
+	if all[0].AdversaryID != "adv-2" || all[0].MinionThreshold != 3 {
+		t.Fatalf("expected minion threshold 3 for adv-2, got %+v", all[0])
+	}
 
 	// List by session
 	bySession, err := store.ListDaggerheartAdversaries(context.Background(), "camp-adv", "sess-1")
@@ -403,6 +782,50 @@ func TestDaggerheartAdversaryLifecycle(t *testing.T) {
 	}
 }
 
+func TestDaggerheartAdversaryCatalogCopy(t *testing.T) {
+	store := openTestStore(t)
+	now := time.Date(2026, 2, 3, 11, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-adv-cat", now)
+
+	expected := storage.DaggerheartAdversary{
+		CampaignID:       "camp-adv-cat",
+		AdversaryID:      "adv-1",
+		Name:             "War Wizard",
+		HP:               5,
+		HPMax:            5,
+		StressMax:        6,
+		AdversaryEntryID: "adversary.war-wizard",
+		AttackModifier:   4,
+		StandardAttack: storage.DaggerheartAdversaryAttack{
+			Name:       "Staff",
+			Range:      "far",
+			DamageDice: []storage.DaggerheartDamageDie{{Sides: 10, Count: 2}},
+			DamageType: "magic",
+		},
+		Experiences: []storage.DaggerheartAdversaryExperience{{Name: "Magical Knowledge", Modifier: 2}},
+		Features:    []storage.DaggerheartAdversaryFeature{{ID: "feature.warding-sphere", Name: "Warding Sphere", Kind: "reaction"}},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := store.PutDaggerheartAdversary(context.Background(), expected); err != nil {
+		t.Fatalf("put adversary: %v", err)
+	}
+
+	got, err := store.GetDaggerheartAdversary(context.Background(), "camp-adv-cat", "adv-1")
+	if err != nil {
+		t.Fatalf("get adversary: %v", err)
+	}
+	if got.AdversaryEntryID != expected.AdversaryEntryID || got.AttackModifier != expected.AttackModifier {
+		t.Fatalf("expected catalog link to match, got %+v", got)
+	}
+	if !reflect.DeepEqual(got.StandardAttack, expected.StandardAttack) {
+		t.Fatalf("standard attack = %+v, want %+v", got.StandardAttack, expected.StandardAttack)
+	}
+	if !reflect.DeepEqual(got.Experiences, expected.Experiences) || !reflect.DeepEqual(got.Features, expected.Features) {
+		t.Fatalf("expected experiences and features to match, got %+v", got)
+	}
+}
+
 func TestDaggerheartAdversaryNotFound(t *testing.T) {
 	store := openTestStore(t)
 	now := time.Date(2026, 2, 3, 11, 0, 0, 0, time.UTC)
@@ -1,12 +1,15 @@
 package sqlite
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
+	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 )
@@ -20,7 +23,21 @@ func testEvent(campaignID string, typ event.Type, sessionID string) event.Event
 		ActorType:   event.ActorTypeSystem,
 		EntityType:  "campaign",
 		EntityID:    campaignID,
-		PayloadJSON: []byte(`{}`),
+		PayloadJSON: testPayload(typ, campaignID, sessionID),
+	}
+}
+
+// testPayload returns a payload that satisfies the schema of typ.
+func testPayload(typ event.Type, campaignID, sessionID string) []byte {
+	switch typ {
+	case event.TypeCampaignCreated:
+		return []byte(`{"name":"` + campaignID + `","locale":"en-US","game_system":"GAME_SYSTEM_DAGGERHEART","gm_mode":"HUMAN"}`)
+	case event.TypeSessionStarted:
+		return []byte(`{"session_id":"` + sessionID + `"}`)
+	case event.TypeCharacterCreated:
+		return []byte(`{"character_id":"char-1","name":"Aria","kind":"PC"}`)
+	default:
+		return []byte(`{}`)
 	}
 }
 
@@ -431,7 +448,7 @@ func TestAppendEventFieldRoundTrip(t *testing.T) {
 		EntityID:      "char-1",
 		SystemID:      "DAGGERHEART",
 		SystemVersion: "1.0",
-		PayloadJSON:   []byte(`{"name":"Aria"}`),
+		PayloadJSON:   []byte(`{"character_id":"char-1","name":"Aria","kind":"PC"}`),
 	}
 
 	stored, err := store.AppendEvent(context.Background(), evt)
@@ -462,10 +479,408 @@ func TestAppendEventFieldRoundTrip(t *testing.T) {
 			t.Fatalf("%s: expected %q, got %q", c.name, c.expected, c.actual)
 		}
 	}
-	if string(got.PayloadJSON) != `{"name":"Aria"}` {
+	if string(got.PayloadJSON) != `{"character_id":"char-1","name":"Aria","kind":"PC"}` {
 		t.Fatalf("expected payload to round-trip, got %s", string(got.PayloadJSON))
 	}
 	if fmt.Sprintf("%d", got.Seq) != fmt.Sprintf("%d", stored.Seq) {
 		t.Fatalf("expected seq to match")
 	}
 }
+
+type recordingPublisher struct {
+	events []event.Event
+}
+
+func (p *recordingPublisher) Publish(evt event.Event) {
+	p.events = append(p.events, evt)
+}
+
+func TestAppendEventPublishesAfterCommit(t *testing.T) {
+	store := openTestEventsStore(t)
+	publisher := &recordingPublisher{}
+	store.SetEventPublisher(publisher)
+
+	stored, err := store.AppendEvent(context.Background(), testEvent("camp-pub", event.TypeCampaignCreated, ""))
+	if err != nil {
+		t.Fatalf("append event: %v", err)
+	}
+	if len(publisher.events) != 1 {
+		t.Fatalf("published events = %d, want 1", len(publisher.events))
+	}
+	if publisher.events[0].Seq != stored.Seq || publisher.events[0].Hash != stored.Hash {
+		t.Fatalf("published event = %+v, want seq %d hash %s", publisher.events[0], stored.Seq, stored.Hash)
+	}
+}
+
+func TestAppendEventDoesNotPublishOnFailure(t *testing.T) {
+	store := openTestEventsStore(t)
+	publisher := &recordingPublisher{}
+	store.SetEventPublisher(publisher)
+
+	_, err := store.AppendEvent(context.Background(), event.Event{Type: event.TypeCampaignCreated})
+	if err == nil {
+		t.Fatal("expected error for missing campaign id")
+	}
+	if len(publisher.events) != 0 {
+		t.Fatalf("published events = %d, want 0", len(publisher.events))
+	}
+}
+
+func TestAppendEventsBatch(t *testing.T) {
+	store := openTestEventsStore(t)
+	publisher := &recordingPublisher{}
+	store.SetEventPublisher(publisher)
+
+	if _, err := store.AppendEvent(context.Background(), testEvent("camp-batch", event.TypeCampaignCreated, "")); err != nil {
+		t.Fatalf("append first event: %v", err)
+	}
+	batch := make([]event.Event, 0, 3)
+	for i := 0; i < 3; i++ {
+		evt := testEvent("camp-batch", event.TypeCharacterCreated, "")
+		evt.EntityID = fmt.Sprintf("char-%d", i+1)
+		batch = append(batch, evt)
+	}
+	stored, err := store.AppendEvents(context.Background(), batch)
+	if err != nil {
+		t.Fatalf("append events: %v", err)
+	}
+	if len(stored) != 3 {
+		t.Fatalf("stored events = %d, want 3", len(stored))
+	}
+	for i, evt := range stored {
+		if evt.Seq != uint64(i+2) {
+			t.Fatalf("event %d seq = %d, want %d", i, evt.Seq, i+2)
+		}
+	}
+	if len(publisher.events) != 4 {
+		t.Fatalf("published events = %d, want 4", len(publisher.events))
+	}
+	if err := store.VerifyEventIntegrity(context.Background()); err != nil {
+		t.Fatalf("verify integrity: %v", err)
+	}
+
+	// An invalid event rejects the whole batch.
+	_, err = store.AppendEvents(context.Background(), []event.Event{
+		testEvent("camp-batch", event.TypeCharacterCreated, ""),
+		{CampaignID: "camp-batch"},
+	})
+	if err == nil {
+		t.Fatal("expected error for invalid event in batch")
+	}
+	seq, err := store.GetLatestEventSeq(context.Background(), "camp-batch")
+	if err != nil {
+		t.Fatalf("get latest seq: %v", err)
+	}
+	if seq != 4 {
+		t.Fatalf("latest seq = %d, want 4", seq)
+	}
+}
+
+func TestAppendEventExpectedLastSeq(t *testing.T) {
+	store := openTestEventsStore(t)
+
+	// An empty log has a last sequence of zero.
+	ctx := storage.WithExpectedLastSeq(context.Background(), 0)
+	if _, err := store.AppendEvent(ctx, testEvent("camp-occ", event.TypeCampaignCreated, "")); err != nil {
+		t.Fatalf("append first event: %v", err)
+	}
+	// The expectation advances with the request's own appends.
+	second := testEvent("camp-occ", event.TypeCharacterCreated, "")
+	second.EntityID = "char-1"
+	if _, err := store.AppendEvent(ctx, second); err != nil {
+		t.Fatalf("append second event: %v", err)
+	}
+
+	stale := storage.WithExpectedLastSeq(context.Background(), 1)
+	third := testEvent("camp-occ", event.TypeCharacterCreated, "")
+	third.EntityID = "char-2"
+	_, err := store.AppendEvent(stale, third)
+	if !errors.Is(err, storage.ErrEventSeqConflict) {
+		t.Fatalf("expected sequence conflict, got %v", err)
+	}
+	if metadata := apperrors.GetMetadata(err); metadata["LastSeq"] != "2" || metadata["ExpectedLastSeq"] != "1" {
+		t.Fatalf("conflict metadata = %v", metadata)
+	}
+	if conflict := storage.EventSeqConflictFromContext(stale); conflict == nil {
+		t.Fatal("expected conflict recorded in context")
+	}
+	if _, err := store.AppendEvents(stale, []event.Event{third}); !errors.Is(err, storage.ErrEventSeqConflict) {
+		t.Fatalf("expected batch sequence conflict, got %v", err)
+	}
+
+	seq, err := store.GetLatestEventSeq(context.Background(), "camp-occ")
+	if err != nil {
+		t.Fatalf("get latest seq: %v", err)
+	}
+	if seq != 2 {
+		t.Fatalf("latest seq = %d, want 2", seq)
+	}
+}
+
+func TestAppendEventsRecordAppendRange(t *testing.T) {
+	store := openTestEventsStore(t)
+	if _, err := store.AppendEvent(context.Background(), testEvent("camp-range", event.TypeCampaignCreated, "")); err != nil {
+		t.Fatalf("append first event: %v", err)
+	}
+
+	ctx := storage.WithAppendRange(context.Background())
+	if _, _, last := storage.AppendRangeFromContext(ctx); last != 0 {
+		t.Fatalf("last seq = %d before appends, want 0", last)
+	}
+	second := testEvent("camp-range", event.TypeCharacterCreated, "")
+	second.EntityID = "char-1"
+	third := testEvent("camp-range", event.TypeCharacterCreated, "")
+	third.EntityID = "char-2"
+	if _, err := store.AppendEvent(ctx, second); err != nil {
+		t.Fatalf("append second event: %v", err)
+	}
+	if _, err := store.AppendEvents(ctx, []event.Event{third}); err != nil {
+		t.Fatalf("append third event: %v", err)
+	}
+
+	campaignID, first, last := storage.AppendRangeFromContext(ctx)
+	if campaignID != "camp-range" || first != 2 || last != 3 {
+		t.Fatalf("append range = %s %d-%d, want camp-range 2-3", campaignID, first, last)
+	}
+}
+
+func TestIdempotencyKeys(t *testing.T) {
+	store := openTestEventsStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 2, 3, 4, 5, 6, 0, time.UTC)
+	record := storage.IdempotencyRecord{
+		Key:         "key-1",
+		Method:      "/svc/Method",
+		RequestHash: "hash-1",
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Hour),
+	}
+
+	if _, reserved, err := store.ReserveIdempotencyKey(ctx, record); err != nil || !reserved {
+		t.Fatalf("reserve = %v, %v; want reserved", reserved, err)
+	}
+	existing, reserved, err := store.ReserveIdempotencyKey(ctx, record)
+	if err != nil || reserved {
+		t.Fatalf("second reserve = %v, %v; want existing record", reserved, err)
+	}
+	if existing.Completed || existing.RequestHash != "hash-1" {
+		t.Fatalf("existing = %+v, want pending record", existing)
+	}
+
+	// Renewing a pending reservation extends its expiry.
+	if err := store.RenewIdempotencyKey(ctx, record.Caller, record.Key, record.Method, now.Add(2*time.Hour)); err != nil {
+		t.Fatalf("renew: %v", err)
+	}
+	if existing, _, err = store.ReserveIdempotencyKey(ctx, record); err != nil || !existing.ExpiresAt.Equal(now.Add(2*time.Hour)) {
+		t.Fatalf("renewed = %+v, %v; want expiry extended", existing, err)
+	}
+
+	record.CampaignID = "camp-1"
+	record.FirstSeq = 4
+	record.LastSeq = 6
+	record.ResponseType = "pkg.Response"
+	record.Response = []byte("payload")
+	if err := store.CompleteIdempotencyKey(ctx, record); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+	// Renewing only applies to pending reservations.
+	if err := store.RenewIdempotencyKey(ctx, record.Caller, record.Key, record.Method, now.Add(5*time.Hour)); err != nil {
+		t.Fatalf("renew completed: %v", err)
+	}
+	existing, _, err = store.ReserveIdempotencyKey(ctx, record)
+	if err != nil {
+		t.Fatalf("reserve completed: %v", err)
+	}
+	if !existing.Completed || existing.CampaignID != "camp-1" || existing.FirstSeq != 4 || existing.LastSeq != 6 ||
+		existing.ResponseType != "pkg.Response" || string(existing.Response) != "payload" || !existing.ExpiresAt.Equal(now.Add(time.Hour)) {
+		t.Fatalf("existing = %+v", existing)
+	}
+
+	// The same key is independent per method.
+	other := record
+	other.Method = "/svc/Other"
+	if _, reserved, err := store.ReserveIdempotencyKey(ctx, other); err != nil || !reserved {
+		t.Fatalf("reserve other method = %v, %v; want reserved", reserved, err)
+	}
+	if err := store.ReleaseIdempotencyKey(ctx, other.Caller, other.Key, other.Method); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+	if _, reserved, err := store.ReserveIdempotencyKey(ctx, other); err != nil || !reserved {
+		t.Fatalf("reserve released key = %v, %v; want reserved", reserved, err)
+	}
+
+	// The same key is independent per caller.
+	otherCaller := record
+	otherCaller.Caller = "participant:part-2"
+	otherCaller.RequestHash = "hash-2"
+	if _, reserved, err := store.ReserveIdempotencyKey(ctx, otherCaller); err != nil || !reserved {
+		t.Fatalf("reserve other caller = %v, %v; want reserved", reserved, err)
+	}
+
+	// Completing a reservation keeps it until the completed expiry.
+	otherCaller.ExpiresAt = now.Add(3 * time.Hour)
+	if err := store.CompleteIdempotencyKey(ctx, otherCaller); err != nil {
+		t.Fatalf("complete other caller: %v", err)
+	}
+	existing, _, err = store.ReserveIdempotencyKey(ctx, otherCaller)
+	if err != nil {
+		t.Fatalf("reserve completed other caller: %v", err)
+	}
+	if existing.Caller != "participant:part-2" || existing.RequestHash != "hash-2" || !existing.ExpiresAt.Equal(now.Add(3*time.Hour)) {
+		t.Fatalf("existing = %+v", existing)
+	}
+
+	// Expired records are discarded.
+	later := record
+	later.CreatedAt = now.Add(2 * time.Hour)
+	later.ExpiresAt = later.CreatedAt.Add(time.Hour)
+	if _, reserved, err := store.ReserveIdempotencyKey(ctx, later); err != nil || !reserved {
+		t.Fatalf("reserve expired key = %v, %v; want reserved", reserved, err)
+	}
+}
+
+func TestProjectionCheckpoints(t *testing.T) {
+	store := openTestEventsStore(t)
+	ctx := context.Background()
+	var stored []event.Event
+	for i := 0; i < 7; i++ {
+		evt := testEvent("camp-ckpt", event.TypeCharacterCreated, "")
+		evt.EntityID = fmt.Sprintf("char-%d", i)
+		appended, err := store.AppendEvent(ctx, evt)
+		if err != nil {
+			t.Fatalf("append event: %v", err)
+		}
+		stored = append(stored, appended)
+	}
+
+	if _, err := store.GetProjectionCheckpoint(ctx, "camp-ckpt", 0); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	saved, err := store.SaveProjectionCheckpoint(ctx, storage.ProjectionCheckpoint{CampaignID: "camp-ckpt", Seq: 2, State: []byte(`{"tables":[]}`)})
+	if err != nil {
+		t.Fatalf("save checkpoint: %v", err)
+	}
+	if saved.ChainHash != stored[1].ChainHash || saved.StateHash == "" {
+		t.Fatalf("saved = %+v, want chain hash %s", saved, stored[1].ChainHash)
+	}
+	if _, err := store.SaveProjectionCheckpoint(ctx, storage.ProjectionCheckpoint{CampaignID: "camp-ckpt", Seq: 99}); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for missing event, got %v", err)
+	}
+
+	for seq := uint64(3); seq <= 7; seq++ {
+		if _, err := store.SaveProjectionCheckpoint(ctx, storage.ProjectionCheckpoint{CampaignID: "camp-ckpt", Seq: seq, State: []byte(`{}`)}); err != nil {
+			t.Fatalf("save checkpoint %d: %v", seq, err)
+		}
+	}
+	latest, err := store.GetProjectionCheckpoint(ctx, "camp-ckpt", 0)
+	if err != nil || latest.Seq != 7 {
+		t.Fatalf("latest = %d, %v; want 7", latest.Seq, err)
+	}
+	before, err := store.GetProjectionCheckpoint(ctx, "camp-ckpt", 4)
+	if err != nil || before.Seq != 4 || before.ChainHash != stored[3].ChainHash {
+		t.Fatalf("checkpoint at or before 4 = %+v, %v", before, err)
+	}
+	// Only the most recent checkpoints are kept.
+	if _, err := store.GetProjectionCheckpoint(ctx, "camp-ckpt", 2); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected pruned checkpoint, got %v", err)
+	}
+
+	ids, err := store.ListEventCampaignIDs(ctx)
+	if err != nil || len(ids) != 1 || ids[0] != "camp-ckpt" {
+		t.Fatalf("campaign ids = %v, %v", ids, err)
+	}
+}
+
+// typeVersionedTest has one upcaster, renaming "nick" to "name", so new
+// events are written at version 2.
+const typeVersionedTest event.Type = "test.versioned"
+
+func init() {
+	event.RegisterUpcaster(typeVersionedTest, 1, func(payload []byte) ([]byte, error) {
+		return bytes.Replace(payload, []byte(`"nick"`), []byte(`"name"`), 1), nil
+	})
+}
+
+func TestEventPayloadVersions(t *testing.T) {
+	store := openTestEventsStore(t)
+	ctx := context.Background()
+
+	for _, evt := range []event.Event{
+		testEvent("camp-a", event.TypeCampaignCreated, ""),
+		testEvent("camp-a", typeVersionedTest, ""),
+		testEvent("camp-b", typeVersionedTest, ""),
+	} {
+		if _, err := store.AppendEvent(ctx, evt); err != nil {
+			t.Fatalf("append event: %v", err)
+		}
+	}
+	legacy := testEvent("camp-b", typeVersionedTest, "")
+	legacy.PayloadVersion = 1
+	if _, err := store.AppendEvent(ctx, legacy); err != nil {
+		t.Fatalf("append legacy event: %v", err)
+	}
+
+	got, err := store.GetEventBySeq(ctx, "camp-b", 2)
+	if err != nil {
+		t.Fatalf("get event by seq: %v", err)
+	}
+	if got.PayloadVersion != 1 {
+		t.Fatalf("payload version = %d, want 1", got.PayloadVersion)
+	}
+	events, err := store.ListEvents(ctx, "camp-a", 0, 10)
+	if err != nil {
+		t.Fatalf("list events: %v", err)
+	}
+	if len(events) != 2 || events[0].PayloadVersion != 1 || events[1].PayloadVersion != 2 {
+		t.Fatalf("events = %+v, want versions 1 and 2", events)
+	}
+	if err := store.VerifyEventIntegrity(ctx); err != nil {
+		t.Fatalf("verify event integrity: %v", err)
+	}
+
+	counts, err := store.ListEventPayloadVersions(ctx)
+	if err != nil {
+		t.Fatalf("list event payload versions: %v", err)
+	}
+	want := []storage.EventPayloadVersionCount{
+		{CampaignID: "camp-a", Type: event.TypeCampaignCreated, PayloadVersion: 1, Count: 1},
+		{CampaignID: "camp-b", Type: typeVersionedTest, PayloadVersion: 1, Count: 1},
+		{CampaignID: "camp-a", Type: typeVersionedTest, PayloadVersion: 2, Count: 1},
+		{CampaignID: "camp-b", Type: typeVersionedTest, PayloadVersion: 2, Count: 1},
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Fatalf("counts = %+v, want %+v", counts, want)
+	}
+}
+
+func TestLegacyPayloadReadsUpcast(t *testing.T) {
+	store := openTestEventsStore(t)
+	ctx := context.Background()
+
+	legacy := testEvent("camp-legacy", typeVersionedTest, "")
+	legacy.PayloadJSON = []byte(`{"nick":"Kira"}`)
+	legacy.PayloadVersion = 1
+	if _, err := store.AppendEvent(ctx, legacy); err != nil {
+		t.Fatalf("append legacy event: %v", err)
+	}
+
+	stored, err := store.GetEventBySeq(ctx, "camp-legacy", 1)
+	if err != nil {
+		t.Fatalf("get event by seq: %v", err)
+	}
+	if stored.PayloadVersion != 1 || string(stored.PayloadJSON) != `{"nick":"Kira"}` {
+		t.Fatalf("journaled event = %s v%d, want the version 1 payload", stored.PayloadJSON, stored.PayloadVersion)
+	}
+	var payload struct {
+		Name string `json:"name"`
+	}
+	if err := event.DecodePayload(stored, &payload); err != nil {
+		t.Fatalf("decode payload: %v", err)
+	}
+	if payload.Name != "Kira" {
+		t.Fatalf("payload name = %q, want the upcast Kira", payload.Name)
+	}
+	if err := store.VerifyEventIntegrity(ctx); err != nil {
+		t.Fatalf("verify event integrity: %v", err)
+	}
+}
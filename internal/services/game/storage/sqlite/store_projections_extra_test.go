@@ -3,9 +3,13 @@ package sqlite
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
+	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
+	platformi18n "github.com/louisbranch/fracturing.space/internal/platform/i18n"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/character"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/session"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
@@ -383,3 +387,129 @@ func TestSnapshotLifecycle(t *testing.T) {
 		t.Fatalf("expected ErrNotFound for latest, got %v", err)
 	}
 }
+
+func TestExportImportCampaignProjections(t *testing.T) {
+	store := openTestStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 2, 3, 15, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-a", now)
+	seedParticipant(t, store, "camp-a", "part-1", "user-1", now)
+	seedCharacter(t, store, "camp-a", "char-1", "Hero", character.CharacterKindPC, now)
+	seedSession(t, store, "camp-a", "sess-1", now)
+	seedCampaign(t, store, "camp-b", now)
+	seedCharacter(t, store, "camp-b", "char-b", "Other", character.CharacterKindNPC, now)
+
+	state, err := store.ExportCampaignProjections(ctx, "camp-a")
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	again, err := store.ExportCampaignProjections(ctx, "camp-a")
+	if err != nil {
+		t.Fatalf("export again: %v", err)
+	}
+	if string(state) != string(again) {
+		t.Fatal("expected deterministic export")
+	}
+
+	seedCharacter(t, store, "camp-a", "char-2", "Sidekick", character.CharacterKindNPC, now)
+	if err := store.ImportCampaignProjections(ctx, "camp-a", state); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if _, err := store.GetCharacter(ctx, "camp-a", "char-2"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected char-2 removed, got %v", err)
+	}
+	if _, err := store.GetSession(ctx, "camp-a", "sess-1"); err != nil {
+		t.Fatalf("expected session restored: %v", err)
+	}
+
+	// An empty state clears the campaign without touching others.
+	if err := store.ImportCampaignProjections(ctx, "camp-a", nil); err != nil {
+		t.Fatalf("clear: %v", err)
+	}
+	if _, err := store.Get(ctx, "camp-a"); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected campaign cleared, got %v", err)
+	}
+	if _, err := store.GetCharacter(ctx, "camp-b", "char-b"); err != nil {
+		t.Fatalf("expected other campaign untouched: %v", err)
+	}
+	if err := store.ImportCampaignProjections(ctx, "camp-a", state); err != nil {
+		t.Fatalf("import after clear: %v", err)
+	}
+	if _, err := store.GetParticipant(ctx, "camp-a", "part-1"); err != nil {
+		t.Fatalf("expected participant restored: %v", err)
+	}
+
+	// States from another schema are rejected.
+	stale := strings.Replace(string(state), `"name":"characters"`, `"name":"heroes"`, 1)
+	if err := store.ImportCampaignProjections(ctx, "camp-a", []byte(stale)); !errors.Is(err, storage.ErrIncompatibleProjectionState) {
+		t.Fatalf("mismatched schema error = %v, want ErrIncompatibleProjectionState", err)
+	}
+	if err := store.ImportCampaignProjections(ctx, "camp-a", []byte("not json")); !errors.Is(err, storage.ErrIncompatibleProjectionState) {
+		t.Fatalf("undecodable state error = %v, want ErrIncompatibleProjectionState", err)
+	}
+	if err := store.ImportCampaignProjections(ctx, "camp-b", state); err == nil {
+		t.Fatal("expected error importing another campaign's state")
+	}
+}
+
+func TestImportForkedCampaignProjections(t *testing.T) {
+	store := openTestStore(t)
+	ctx := context.Background()
+	now := time.Date(2026, 2, 3, 15, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-a", now)
+	seedParticipant(t, store, "camp-a", "part-1", "user-1", now)
+	seedCharacter(t, store, "camp-a", "char-1", "Hero", character.CharacterKindPC, now)
+	state, err := store.ExportCampaignProjections(ctx, "camp-a")
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	if err := store.ImportForkedCampaignProjections(ctx, "camp-a", "fork-a", state); !errors.Is(err, storage.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for a missing fork, got %v", err)
+	}
+
+	forkedAt := now.Add(time.Hour)
+	if err := store.Put(ctx, campaign.Campaign{
+		ID:        "fork-a",
+		Name:      "Fork",
+		Locale:    platformi18n.DefaultLocale(),
+		System:    commonv1.GameSystem_GAME_SYSTEM_DAGGERHEART,
+		Status:    campaign.CampaignStatusDraft,
+		GmMode:    campaign.GmModeHuman,
+		CreatedAt: forkedAt,
+		UpdatedAt: forkedAt,
+	}); err != nil {
+		t.Fatalf("put fork: %v", err)
+	}
+	lineage := storage.ForkMetadata{ParentCampaignID: "camp-a", ForkEventSeq: 3, OriginCampaignID: "camp-a"}
+	if err := store.SetCampaignForkMetadata(ctx, "fork-a", lineage); err != nil {
+		t.Fatalf("set fork metadata: %v", err)
+	}
+
+	if err := store.ImportForkedCampaignProjections(ctx, "camp-a", "fork-a", state); err != nil {
+		t.Fatalf("import fork: %v", err)
+	}
+	fork, err := store.Get(ctx, "fork-a")
+	if err != nil {
+		t.Fatalf("get fork: %v", err)
+	}
+	if fork.Name != "Fork" || !fork.CreatedAt.Equal(forkedAt) || fork.Status != campaign.CampaignStatusActive {
+		t.Fatalf("fork = %+v, want its own name and creation time with the source status", fork)
+	}
+	metadata, err := store.GetCampaignForkMetadata(ctx, "fork-a")
+	if err != nil {
+		t.Fatalf("get fork metadata: %v", err)
+	}
+	if metadata != lineage {
+		t.Fatalf("fork metadata = %+v, want %+v", metadata, lineage)
+	}
+	if _, err := store.GetParticipant(ctx, "fork-a", "part-1"); err != nil {
+		t.Fatalf("expected participant moved to the fork: %v", err)
+	}
+	if _, err := store.GetCharacter(ctx, "fork-a", "char-1"); err != nil {
+		t.Fatalf("expected character moved to the fork: %v", err)
+	}
+	if _, err := store.GetCharacter(ctx, "camp-a", "char-1"); err != nil {
+		t.Fatalf("expected source untouched: %v", err)
+	}
+}
@@ -222,6 +222,90 @@ func TestApplyRollOutcomeIdempotent(t *testing.T) {
 	}
 }
 
+func TestApplyRollOutcomeExpectedLastSeq(t *testing.T) {
+	store := openTestCombinedStore(t)
+	now := time.Date(2026, 2, 3, 16, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-occ", now)
+	seedRollOutcomeCharacter(t, store, "camp-occ", "char-1", now, 3, 6, 0, 12)
+
+	input := storage.RollOutcomeApplyInput{
+		CampaignID:     "camp-occ",
+		SessionID:      "sess-1",
+		RollSeq:        1,
+		Targets:        []string{"char-1"},
+		RequestID:      "req-occ",
+		EventTimestamp: now,
+		CharacterDeltas: []storage.RollOutcomeDelta{
+			{CharacterID: "char-1", HopeDelta: 1},
+		},
+	}
+
+	stale := storage.WithExpectedLastSeq(context.Background(), 5)
+	if _, err := store.ApplyRollOutcome(stale, input); !errors.Is(err, storage.ErrEventSeqConflict) {
+		t.Fatalf("expected sequence conflict, got %v", err)
+	}
+	state, err := store.GetDaggerheartCharacterState(context.Background(), "camp-occ", "char-1")
+	if err != nil {
+		t.Fatalf("get character state: %v", err)
+	}
+	if state.Hope != 3 {
+		t.Fatalf("hope = %d, want 3 after a rejected apply", state.Hope)
+	}
+
+	lastSeq, err := store.GetLatestEventSeq(context.Background(), "camp-occ")
+	if err != nil {
+		t.Fatalf("get latest seq: %v", err)
+	}
+	ctx := storage.WithExpectedLastSeq(context.Background(), lastSeq)
+	if _, err := store.ApplyRollOutcome(ctx, input); err != nil {
+		t.Fatalf("apply roll outcome: %v", err)
+	}
+	// The expectation advances past the outcome's own events.
+	latest, err := store.GetLatestEventSeq(context.Background(), "camp-occ")
+	if err != nil {
+		t.Fatalf("get latest seq: %v", err)
+	}
+	if err := storage.CheckExpectedLastSeq(ctx, "camp-occ", latest); err != nil {
+		t.Fatalf("expectation did not advance to %d: %v", latest, err)
+	}
+}
+
+func TestApplyRollOutcomeRecordsAppendRange(t *testing.T) {
+	store := openTestCombinedStore(t)
+	now := time.Date(2026, 2, 3, 16, 0, 0, 0, time.UTC)
+	seedCampaign(t, store, "camp-range", now)
+	seedRollOutcomeCharacter(t, store, "camp-range", "char-1", now, 3, 6, 0, 12)
+	before, err := store.GetLatestEventSeq(context.Background(), "camp-range")
+	if err != nil {
+		t.Fatalf("get latest seq: %v", err)
+	}
+
+	// Idempotent retries rely on the range to tell whether events committed.
+	ctx := storage.WithAppendRange(context.Background())
+	if _, err := store.ApplyRollOutcome(ctx, storage.RollOutcomeApplyInput{
+		CampaignID:     "camp-range",
+		SessionID:      "sess-1",
+		RollSeq:        1,
+		Targets:        []string{"char-1"},
+		RequestID:      "req-range",
+		EventTimestamp: now,
+		GMFearDelta:    1,
+		CharacterDeltas: []storage.RollOutcomeDelta{
+			{CharacterID: "char-1", HopeDelta: 1},
+		},
+	}); err != nil {
+		t.Fatalf("apply roll outcome: %v", err)
+	}
+	after, err := store.GetLatestEventSeq(context.Background(), "camp-range")
+	if err != nil {
+		t.Fatalf("get latest seq: %v", err)
+	}
+	campaignID, first, last := storage.AppendRangeFromContext(ctx)
+	if campaignID != "camp-range" || first != before+1 || last != after {
+		t.Fatalf("append range = %s %d-%d, want camp-range %d-%d", campaignID, first, last, before+1, after)
+	}
+}
+
 func TestApplyRollOutcomeCharacterNotFound(t *testing.T) {
 	store := openTestCombinedStore(t)
 	now := time.Date(2026, 2, 3, 16, 0, 0, 0, time.UTC)
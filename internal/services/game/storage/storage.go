@@ -2,6 +2,10 @@ package storage
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
 	apperrors "github.com/louisbranch/fracturing.space/internal/platform/errors"
@@ -19,6 +23,15 @@ var ErrNotFound = apperrors.New(apperrors.CodeNotFound, "record not found")
 // ErrActiveSessionExists indicates an active session already exists for a campaign.
 var ErrActiveSessionExists = apperrors.New(apperrors.CodeActiveSessionExists, "active session already exists for campaign")
 
+// ErrEventSeqConflict indicates a campaign's event log moved past the sequence
+// an append expected. Match it with errors.Is.
+var ErrEventSeqConflict = apperrors.New(apperrors.CodeEventSeqConflict, "event sequence conflict")
+
+// ErrIncompatibleProjectionState indicates an exported projection state cannot
+// be decoded or was exported from a different projection schema. Match it with
+// errors.Is.
+var ErrIncompatibleProjectionState = errors.New("incompatible projection state")
+
 // CampaignStore persists campaign metadata records.
 type CampaignStore interface {
 	Put(ctx context.Context, c campaign.Campaign) error
@@ -102,6 +115,9 @@ type SessionStore interface {
 type EventStore interface {
 	// AppendEvent atomically appends an event and returns it with sequence and hash set.
 	AppendEvent(ctx context.Context, evt event.Event) (event.Event, error)
+	// AppendEvents atomically appends events in order and returns them with
+	// sequence and hash set; either every event is stored or none is.
+	AppendEvents(ctx context.Context, evts []event.Event) ([]event.Event, error)
 	// GetEventByHash retrieves an event by its content hash.
 	GetEventByHash(ctx context.Context, hash string) (event.Event, error)
 	// GetEventBySeq retrieves a specific event by sequence number.
@@ -117,6 +133,138 @@ type EventStore interface {
 	ListEventsPage(ctx context.Context, req ListEventsPageRequest) (ListEventsPageResult, error)
 }
 
+// EventPayloadVersionCount counts a campaign's journaled events of one type at
+// one payload version.
+type EventPayloadVersionCount struct {
+	CampaignID     string
+	Type           event.Type
+	PayloadVersion int
+	Count          int
+}
+
+// EventPayloadVersionStore reports which payload versions the journal holds.
+type EventPayloadVersionStore interface {
+	// ListEventPayloadVersions counts events by campaign, type and payload
+	// version, ordered by type, version and campaign.
+	ListEventPayloadVersions(ctx context.Context) ([]EventPayloadVersionCount, error)
+}
+
+// appendExpectation is the optimistic concurrency precondition for the event
+// appends made under one context.
+type appendExpectation struct {
+	mu       sync.Mutex
+	lastSeq  uint64
+	conflict error
+}
+
+type appendExpectationKey struct{}
+
+// WithExpectedLastSeq returns a context whose event appends require the
+// campaign's last event sequence to be seq. Each append advances the
+// expectation to the stored event, so one request may append several events
+// as long as no other writer appends in between.
+func WithExpectedLastSeq(ctx context.Context, seq uint64) context.Context {
+	return context.WithValue(ctx, appendExpectationKey{}, &appendExpectation{lastSeq: seq})
+}
+
+// CheckExpectedLastSeq compares a campaign's last event sequence with the
+// context's expectation, if any. Event stores call it inside the append
+// transaction and the conflict is remembered for EventSeqConflictFromContext.
+func CheckExpectedLastSeq(ctx context.Context, campaignID string, lastSeq uint64) error {
+	expectation, ok := ctx.Value(appendExpectationKey{}).(*appendExpectation)
+	if !ok {
+		return nil
+	}
+	expectation.mu.Lock()
+	defer expectation.mu.Unlock()
+	if expectation.lastSeq == lastSeq {
+		return nil
+	}
+	expectation.conflict = apperrors.WithMetadata(
+		apperrors.CodeEventSeqConflict,
+		fmt.Sprintf("campaign %s last event seq is %d, expected %d", campaignID, lastSeq, expectation.lastSeq),
+		map[string]string{
+			"CampaignID":      campaignID,
+			"ExpectedLastSeq": strconv.FormatUint(expectation.lastSeq, 10),
+			"LastSeq":         strconv.FormatUint(lastSeq, 10),
+		},
+	)
+	return expectation.conflict
+}
+
+// RecordAppendedEvents tells the context that an append committed evts: the
+// expected last sequence advances to the last of them and the append range,
+// if one is being recorded, grows to cover them. Event stores call it after
+// every committed append.
+func RecordAppendedEvents(ctx context.Context, evts ...event.Event) {
+	if len(evts) == 0 {
+		return
+	}
+	if expectation, ok := ctx.Value(appendExpectationKey{}).(*appendExpectation); ok {
+		expectation.mu.Lock()
+		expectation.lastSeq = evts[len(evts)-1].Seq
+		expectation.mu.Unlock()
+	}
+	if appended, ok := ctx.Value(appendRangeKey{}).(*appendRange); ok {
+		appended.mu.Lock()
+		defer appended.mu.Unlock()
+		for _, evt := range evts {
+			if appended.campaignID == "" {
+				appended.campaignID = evt.CampaignID
+				appended.firstSeq = evt.Seq
+			}
+			if evt.CampaignID != appended.campaignID {
+				continue
+			}
+			appended.firstSeq = min(appended.firstSeq, evt.Seq)
+			appended.lastSeq = max(appended.lastSeq, evt.Seq)
+		}
+	}
+}
+
+// EventSeqConflictFromContext returns the sequence conflict an append under
+// the context hit, or nil. Callers that wrap store errors use it to surface
+// the typed conflict.
+func EventSeqConflictFromContext(ctx context.Context) error {
+	expectation, ok := ctx.Value(appendExpectationKey{}).(*appendExpectation)
+	if !ok {
+		return nil
+	}
+	expectation.mu.Lock()
+	defer expectation.mu.Unlock()
+	return expectation.conflict
+}
+
+// appendRange collects the sequence range of the events committed under one
+// context.
+type appendRange struct {
+	mu         sync.Mutex
+	campaignID string
+	firstSeq   uint64
+	lastSeq    uint64
+}
+
+type appendRangeKey struct{}
+
+// WithAppendRange returns a context that records the events appended under it
+// for AppendRangeFromContext.
+func WithAppendRange(ctx context.Context) context.Context {
+	return context.WithValue(ctx, appendRangeKey{}, &appendRange{})
+}
+
+// AppendRangeFromContext returns the campaign and inclusive sequence range of
+// the events appended under a WithAppendRange context. Only the first
+// campaign appended to is tracked; lastSeq is zero when nothing was appended.
+func AppendRangeFromContext(ctx context.Context) (campaignID string, firstSeq, lastSeq uint64) {
+	appended, ok := ctx.Value(appendRangeKey{}).(*appendRange)
+	if !ok {
+		return "", 0, 0
+	}
+	appended.mu.Lock()
+	defer appended.mu.Unlock()
+	return appended.campaignID, appended.firstSeq, appended.lastSeq
+}
+
 // TelemetryEvent describes an operational telemetry record.
 type TelemetryEvent struct {
 	Timestamp      time.Time
@@ -139,6 +287,46 @@ type TelemetryStore interface {
 	AppendTelemetryEvent(ctx context.Context, evt TelemetryEvent) error
 }
 
+// IdempotencyRecord remembers a request made with an idempotency key and,
+// once completed, the outcome to replay for it.
+type IdempotencyRecord struct {
+	// Caller identifies who sent the request; keys never match across callers.
+	Caller      string
+	Key         string
+	Method      string
+	RequestHash string
+	Completed   bool
+	// CampaignID, FirstSeq and LastSeq identify the events the request
+	// appended; LastSeq is zero when it appended none.
+	CampaignID string
+	FirstSeq   uint64
+	LastSeq    uint64
+	// ResponseType is the full protobuf name of Response. Status holds an
+	// encoded google.rpc.Status when the request failed instead.
+	ResponseType string
+	Response     []byte
+	Status       []byte
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+// IdempotencyStore persists idempotency keys and the outcomes they replay.
+type IdempotencyStore interface {
+	// ReserveIdempotencyKey claims record.Key for record.Caller and
+	// record.Method until record.ExpiresAt, discarding expired records first.
+	// When an unexpired record already holds the key it is returned with
+	// reserved set to false.
+	ReserveIdempotencyKey(ctx context.Context, record IdempotencyRecord) (existing IdempotencyRecord, reserved bool, err error)
+	// CompleteIdempotencyKey stores the outcome of a reserved key and keeps it
+	// until record.ExpiresAt.
+	CompleteIdempotencyKey(ctx context.Context, record IdempotencyRecord) error
+	// RenewIdempotencyKey extends the reservation of a key whose request is
+	// still running until expiresAt. Completed keys are left unchanged.
+	RenewIdempotencyKey(ctx context.Context, caller, key, method string, expiresAt time.Time) error
+	// ReleaseIdempotencyKey drops a reserved key so the request can be retried.
+	ReleaseIdempotencyKey(ctx context.Context, caller, key, method string) error
+}
+
 // GameStatistics contains aggregate counts across the game data set.
 type GameStatistics struct {
 	CampaignCount    int64
@@ -303,6 +491,45 @@ type SnapshotStore interface {
 	ListSnapshots(ctx context.Context, campaignID string, limit int) ([]Snapshot, error)
 }
 
+// ProjectionCheckpoint is every projection row of a campaign serialized as of
+// an event sequence. ChainHash is the chain hash of the event at Seq, so a
+// checkpoint is only trusted while the journal still holds that event.
+type ProjectionCheckpoint struct {
+	CampaignID string
+	Seq        uint64
+	ChainHash  string
+	// StateHash is the hex SHA-256 of State.
+	StateHash string
+	State     []byte
+	CreatedAt time.Time
+}
+
+// ProjectionCheckpointStore persists projection checkpoints alongside the
+// event journal so they survive the loss of the projections database.
+type ProjectionCheckpointStore interface {
+	// SaveProjectionCheckpoint stores a checkpoint, linking it to the chain
+	// hash of the campaign event at checkpoint.Seq.
+	SaveProjectionCheckpoint(ctx context.Context, checkpoint ProjectionCheckpoint) (ProjectionCheckpoint, error)
+	// GetProjectionCheckpoint returns the latest checkpoint at or before
+	// atOrBeforeSeq (0 = latest), or ErrNotFound.
+	GetProjectionCheckpoint(ctx context.Context, campaignID string, atOrBeforeSeq uint64) (ProjectionCheckpoint, error)
+}
+
+// ProjectionStateStore exports and replaces the projection rows of a campaign.
+type ProjectionStateStore interface {
+	// ExportCampaignProjections serializes every projection row of a campaign.
+	ExportCampaignProjections(ctx context.Context, campaignID string) ([]byte, error)
+	// ImportCampaignProjections replaces every projection row of a campaign
+	// with an exported state; an empty state clears them. It fails with
+	// ErrIncompatibleProjectionState when the state cannot be decoded or was
+	// exported from a different projection schema.
+	ImportCampaignProjections(ctx context.Context, campaignID string, state []byte) error
+	// ImportForkedCampaignProjections replaces every projection row of a fork
+	// with a state exported from its source campaign. The rows move to the
+	// fork, which keeps its own name, creation time and lineage.
+	ImportForkedCampaignProjections(ctx context.Context, sourceCampaignID, forkCampaignID string, state []byte) error
+}
+
 // ParticipantClaim describes a user-to-participant binding in a campaign.
 type ParticipantClaim struct {
 	CampaignID    string
@@ -390,6 +617,20 @@ type DaggerheartCharacterProfile struct {
 	Instinct  int
 	Presence  int
 	Knowledge int
+	// Class and subclass chosen at creation.
+	ClassID       string
+	SubclassID    string
+	SubclassStage string
+	// Multiclass selection, set by a multiclass level-up advancement.
+	MulticlassClassID       string
+	MulticlassSubclassID    string
+	MulticlassSubclassStage string
+	MulticlassDomainID      string
+	// Progression state maintained by level-up events.
+	MarkedTraits  []string
+	LoadoutActive []string
+	LoadoutVault  []string
+	LevelHistory  []DaggerheartLevelUp
 }
 
 // DaggerheartExperience captures experience modifiers.
@@ -398,6 +639,25 @@ type DaggerheartExperience struct {
 	Modifier int
 }
 
+// DaggerheartLevelUp records a single level-up in a character's level history.
+type DaggerheartLevelUp struct {
+	Level         int
+	Advancements  []DaggerheartAdvancement
+	NewExperience string
+	DomainCardID  string
+}
+
+// DaggerheartAdvancement captures one advancement chosen during a level-up.
+type DaggerheartAdvancement struct {
+	Type         string
+	Traits       []string
+	Experiences  []string
+	DomainCardID string
+	SubclassID   string
+	ClassID      string
+	DomainID     string
+}
+
 // DaggerheartCharacterState contains Daggerheart-specific character state data.
 type DaggerheartCharacterState struct {
 	CampaignID  string
@@ -409,6 +669,24 @@ type DaggerheartCharacterState struct {
 	Armor       int
 	Conditions  []string
 	LifeState   string
+	// Gold is tracked in handfuls, bags and chests.
+	GoldHandfuls int
+	GoldBags     int
+	GoldChests   int
+	Inventory    []DaggerheartInventoryItem
+	// Equipped slots reference catalog IDs that are also held in Inventory.
+	EquippedArmorID           string
+	EquippedPrimaryWeaponID   string
+	EquippedSecondaryWeaponID string
+	// BeastformID is the catalog beastform the character is transformed into.
+	BeastformID string
+}
+
+// DaggerheartInventoryItem is one catalog item stack carried by a character.
+type DaggerheartInventoryItem struct {
+	ItemID   string
+	Kind     string
+	Quantity int
 }
 
 // DaggerheartSnapshot contains Daggerheart-specific campaign-level state.
@@ -428,6 +706,92 @@ type DaggerheartCountdown struct {
 	Max         int
 	Direction   string
 	Looping     bool
+	// TickTrigger, TickDelta and TickOutcomeDeltas describe the automatic
+	// tick policy; an empty trigger means the countdown only moves manually.
+	TickTrigger       string
+	TickDelta         int
+	TickOutcomeDeltas map[string]int
+}
+
+// DaggerheartChase links a progress countdown racing a consequence countdown.
+// Winner stays empty until the chase is resolved.
+type DaggerheartChase struct {
+	CampaignID             string
+	ChaseID                string
+	Name                   string
+	ProgressCountdownID    string
+	ConsequenceCountdownID string
+	Status                 string
+	Winner                 string
+}
+
+// DaggerheartAdversaryFeatureState tracks whether an adversary feature with a
+// cooldown can be used again. RefreshOn names what makes a spent feature ready.
+type DaggerheartAdversaryFeatureState struct {
+	CampaignID  string
+	AdversaryID string
+	FeatureID   string
+	Status      string
+	RefreshOn   string
+}
+
+// DaggerheartSceneRange contains the range band between two scene entities
+// in a session. Pairs are stored with FromID ordered before ToID.
+type DaggerheartSceneRange struct {
+	CampaignID string
+	SessionID  string
+	FromID     string
+	FromType   string
+	ToID       string
+	ToType     string
+	Band       string
+}
+
+// DaggerheartEffect contains a timed modifier placed on a character or
+// adversary until its expiry trigger fires.
+type DaggerheartEffect struct {
+	CampaignID string
+	EffectID   string
+	SessionID  string
+	TargetType string
+	TargetID   string
+	Source     string
+	Kind       string
+	Value      int
+	Stacking   string
+	ExpiresOn  string
+}
+
+// DaggerheartSessionEnvironment contains the environment active in a session.
+type DaggerheartSessionEnvironment struct {
+	CampaignID    string
+	SessionID     string
+	EnvironmentID string
+	Name          string
+	Tier          int
+	Type          string
+	Difficulty    int
+}
+
+// DaggerheartCompanion contains the animal companion bonded to a character.
+type DaggerheartCompanion struct {
+	CampaignID     string
+	CharacterID    string
+	Name           string
+	Evasion        int
+	Stress         int
+	StressMax      int
+	DamageDieSides int
+	Range          string
+	Experiences    []DaggerheartCompanionExperience
+	Level          int
+	Upgrades       []string
+}
+
+// DaggerheartCompanionExperience captures a companion Experience modifier.
+type DaggerheartCompanionExperience struct {
+	ExperienceID string
+	Modifier     int
 }
 
 // DaggerheartAdversary contains adversary metadata for a campaign.
@@ -447,8 +811,18 @@ type DaggerheartAdversary struct {
 	Severe      int
 	Armor       int
 	Conditions  []string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// MinionThreshold is the Minion (N) overflow threshold; zero for non-minions.
+	MinionThreshold int
+	// AdversaryEntryID links back to the catalog entry the adversary was
+	// instantiated from; empty for hand-built stat blocks. The catalog fields
+	// below are copied at creation so later catalog fixes can be diffed.
+	AdversaryEntryID string
+	AttackModifier   int
+	StandardAttack   DaggerheartAdversaryAttack
+	Experiences      []DaggerheartAdversaryExperience
+	Features         []DaggerheartAdversaryFeature
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 // DaggerheartFeature captures a class, subclass, heritage, or environment feature.
@@ -741,6 +1115,35 @@ type DaggerheartStore interface {
 	GetDaggerheartAdversary(ctx context.Context, campaignID, adversaryID string) (DaggerheartAdversary, error)
 	ListDaggerheartAdversaries(ctx context.Context, campaignID, sessionID string) ([]DaggerheartAdversary, error)
 	DeleteDaggerheartAdversary(ctx context.Context, campaignID, adversaryID string) error
+
+	// Scene Range Extensions
+	PutDaggerheartSceneRange(ctx context.Context, sceneRange DaggerheartSceneRange) error
+	GetDaggerheartSceneRange(ctx context.Context, campaignID, sessionID, firstID, secondID string) (DaggerheartSceneRange, error)
+	ListDaggerheartSceneRanges(ctx context.Context, campaignID, sessionID string) ([]DaggerheartSceneRange, error)
+
+	// Session Environment Extensions
+	PutDaggerheartSessionEnvironment(ctx context.Context, environment DaggerheartSessionEnvironment) error
+	GetDaggerheartSessionEnvironment(ctx context.Context, campaignID, sessionID string) (DaggerheartSessionEnvironment, error)
+	DeleteDaggerheartSessionEnvironment(ctx context.Context, campaignID, sessionID string) error
+
+	// Companion Extensions
+	PutDaggerheartCompanion(ctx context.Context, companion DaggerheartCompanion) error
+	GetDaggerheartCompanion(ctx context.Context, campaignID, characterID string) (DaggerheartCompanion, error)
+
+	// Effect Extensions
+	PutDaggerheartEffect(ctx context.Context, effect DaggerheartEffect) error
+	GetDaggerheartEffect(ctx context.Context, campaignID, effectID string) (DaggerheartEffect, error)
+	ListDaggerheartEffects(ctx context.Context, campaignID string) ([]DaggerheartEffect, error)
+	DeleteDaggerheartEffect(ctx context.Context, campaignID, effectID string) error
+
+	// Chase Extensions
+	PutDaggerheartChase(ctx context.Context, chase DaggerheartChase) error
+	GetDaggerheartChase(ctx context.Context, campaignID, chaseID string) (DaggerheartChase, error)
+	ListDaggerheartChases(ctx context.Context, campaignID string) ([]DaggerheartChase, error)
+
+	// Adversary Feature Extensions
+	PutDaggerheartAdversaryFeatureState(ctx context.Context, state DaggerheartAdversaryFeatureState) error
+	ListDaggerheartAdversaryFeatureStates(ctx context.Context, campaignID string) ([]DaggerheartAdversaryFeatureState, error)
 }
 
 // DaggerheartContentStore provides access to the Daggerheart content catalog.
@@ -0,0 +1,310 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ChaseCountdownInput describes one side of a chase.
+type ChaseCountdownInput struct {
+	CountdownID   string         `json:"countdown_id,omitempty" jsonschema:"optional countdown identifier (defaults to chase id plus side)"`
+	Name          string         `json:"name,omitempty" jsonschema:"optional countdown name"`
+	Max           int            `json:"max" jsonschema:"countdown size; the countdown starts here and ticks down to zero"`
+	OutcomeDeltas map[string]int `json:"outcome_deltas,omitempty" jsonschema:"optional ticks per roll outcome (e.g. SUCCESS_WITH_HOPE) replacing the dynamic defaults"`
+}
+
+// ChaseStartInput represents the MCP tool input for starting a chase.
+type ChaseStartInput struct {
+	CampaignID  string              `json:"campaign_id,omitempty" jsonschema:"campaign identifier (defaults to context)"`
+	SessionID   string              `json:"session_id,omitempty" jsonschema:"session identifier (defaults to context)"`
+	ChaseID     string              `json:"chase_id,omitempty" jsonschema:"optional chase identifier"`
+	Name        string              `json:"name" jsonschema:"chase name"`
+	Progress    ChaseCountdownInput `json:"progress" jsonschema:"progress countdown the PCs race to finish"`
+	Consequence ChaseCountdownInput `json:"consequence" jsonschema:"consequence countdown the opposition races to finish"`
+}
+
+// ChaseGetInput represents the MCP tool input for reading a chase.
+type ChaseGetInput struct {
+	CampaignID string `json:"campaign_id,omitempty" jsonschema:"campaign identifier (defaults to context)"`
+	ChaseID    string `json:"chase_id" jsonschema:"chase identifier"`
+}
+
+// ChaseListInput represents the MCP tool input for listing chases.
+type ChaseListInput struct {
+	CampaignID string `json:"campaign_id,omitempty" jsonschema:"campaign identifier (defaults to context)"`
+}
+
+// ChaseCountdownResult represents one side of a chase.
+type ChaseCountdownResult struct {
+	CountdownID string `json:"countdown_id" jsonschema:"countdown identifier"`
+	Name        string `json:"name" jsonschema:"countdown name"`
+	Current     int    `json:"current" jsonschema:"current countdown value"`
+	Max         int    `json:"max" jsonschema:"countdown size"`
+}
+
+// ChaseResult represents a chase and its linked countdowns.
+type ChaseResult struct {
+	ChaseID     string                `json:"chase_id" jsonschema:"chase identifier"`
+	Name        string                `json:"name" jsonschema:"chase name"`
+	Status      string                `json:"status" jsonschema:"chase status (ACTIVE, RESOLVED)"`
+	Winner      string                `json:"winner,omitempty" jsonschema:"winning side once resolved (PROGRESS, CONSEQUENCE)"`
+	Progress    *ChaseCountdownResult `json:"progress,omitempty" jsonschema:"progress countdown"`
+	Consequence *ChaseCountdownResult `json:"consequence,omitempty" jsonschema:"consequence countdown"`
+}
+
+// ChaseListResult represents the MCP tool output for listing chases.
+type ChaseListResult struct {
+	Chases []ChaseResult `json:"chases" jsonschema:"chases in the campaign"`
+}
+
+// ChaseStartTool defines the MCP tool schema for starting a chase.
+func ChaseStartTool() *mcp.Tool {
+	return &mcp.Tool{
+		Name:        "chase_start",
+		Description: "Starts a chase: a progress countdown racing a consequence countdown, both ticked by action rolls",
+	}
+}
+
+// ChaseGetTool defines the MCP tool schema for reading a chase.
+func ChaseGetTool() *mcp.Tool {
+	return &mcp.Tool{
+		Name:        "chase_get",
+		Description: "Returns a chase with its countdowns and winner",
+	}
+}
+
+// ChaseListTool defines the MCP tool schema for listing chases.
+func ChaseListTool() *mcp.Tool {
+	return &mcp.Tool{
+		Name:        "chase_list",
+		Description: "Lists the chases in a campaign",
+	}
+}
+
+// ChaseStartHandler executes a chase start request.
+func ChaseStartHandler(client pb.DaggerheartServiceClient, getContext func() Context) mcp.ToolHandlerFor[ChaseStartInput, ChaseResult] {
+	return func(ctx context.Context, _ *mcp.CallToolRequest, input ChaseStartInput) (*mcp.CallToolResult, ChaseResult, error) {
+		callContext, err := newToolInvocationContext(ctx, getContext)
+		if err != nil {
+			return nil, ChaseResult{}, fmt.Errorf("generate invocation id: %w", err)
+		}
+		defer callContext.Cancel()
+
+		campaignID := input.CampaignID
+		if campaignID == "" {
+			campaignID = callContext.MCPContext.CampaignID
+		}
+		sessionID := input.SessionID
+		if sessionID == "" {
+			sessionID = callContext.MCPContext.SessionID
+		}
+		if campaignID == "" {
+			return nil, ChaseResult{}, fmt.Errorf("campaign_id is required")
+		}
+		if sessionID == "" {
+			return nil, ChaseResult{}, fmt.Errorf("session_id is required")
+		}
+		progress, err := chaseCountdownSpec(input.Progress)
+		if err != nil {
+			return nil, ChaseResult{}, fmt.Errorf("progress: %w", err)
+		}
+		consequence, err := chaseCountdownSpec(input.Consequence)
+		if err != nil {
+			return nil, ChaseResult{}, fmt.Errorf("consequence: %w", err)
+		}
+
+		callCtx, callMeta, err := NewOutgoingContext(callContext.RunCtx, callContext.InvocationID)
+		if err != nil {
+			return nil, ChaseResult{}, fmt.Errorf("create request metadata: %w", err)
+		}
+
+		var header metadata.MD
+		response, err := client.StartChase(callCtx, &pb.DaggerheartStartChaseRequest{
+			CampaignId:  campaignID,
+			SessionId:   sessionID,
+			ChaseId:     input.ChaseID,
+			Name:        input.Name,
+			Progress:    progress,
+			Consequence: consequence,
+		}, grpc.Header(&header))
+		if err != nil {
+			return nil, ChaseResult{}, fmt.Errorf("chase start failed: %w", err)
+		}
+		if response == nil || response.GetChase() == nil {
+			return nil, ChaseResult{}, fmt.Errorf("chase start response is missing")
+		}
+
+		responseMeta := MergeResponseMetadata(callMeta, header)
+		return CallToolResultWithMetadata(responseMeta), chaseResultFromProto(response.GetChase()), nil
+	}
+}
+
+// ChaseGetHandler executes a chase read request.
+func ChaseGetHandler(client pb.DaggerheartServiceClient, getContext func() Context) mcp.ToolHandlerFor[ChaseGetInput, ChaseResult] {
+	return func(ctx context.Context, _ *mcp.CallToolRequest, input ChaseGetInput) (*mcp.CallToolResult, ChaseResult, error) {
+		callContext, err := newToolInvocationContext(ctx, getContext)
+		if err != nil {
+			return nil, ChaseResult{}, fmt.Errorf("generate invocation id: %w", err)
+		}
+		defer callContext.Cancel()
+
+		campaignID := input.CampaignID
+		if campaignID == "" {
+			campaignID = callContext.MCPContext.CampaignID
+		}
+		if campaignID == "" {
+			return nil, ChaseResult{}, fmt.Errorf("campaign_id is required")
+		}
+		if input.ChaseID == "" {
+			return nil, ChaseResult{}, fmt.Errorf("chase_id is required")
+		}
+
+		callCtx, callMeta, err := NewOutgoingContext(callContext.RunCtx, callContext.InvocationID)
+		if err != nil {
+			return nil, ChaseResult{}, fmt.Errorf("create request metadata: %w", err)
+		}
+
+		var header metadata.MD
+		response, err := client.GetChase(callCtx, &pb.DaggerheartGetChaseRequest{
+			CampaignId: campaignID,
+			ChaseId:    input.ChaseID,
+		}, grpc.Header(&header))
+		if err != nil {
+			return nil, ChaseResult{}, fmt.Errorf("chase get failed: %w", err)
+		}
+		if response == nil || response.GetChase() == nil {
+			return nil, ChaseResult{}, fmt.Errorf("chase get response is missing")
+		}
+
+		responseMeta := MergeResponseMetadata(callMeta, header)
+		return CallToolResultWithMetadata(responseMeta), chaseResultFromProto(response.GetChase()), nil
+	}
+}
+
+// ChaseListHandler executes a chase list request.
+func ChaseListHandler(client pb.DaggerheartServiceClient, getContext func() Context) mcp.ToolHandlerFor[ChaseListInput, ChaseListResult] {
+	return func(ctx context.Context, _ *mcp.CallToolRequest, input ChaseListInput) (*mcp.CallToolResult, ChaseListResult, error) {
+		callContext, err := newToolInvocationContext(ctx, getContext)
+		if err != nil {
+			return nil, ChaseListResult{}, fmt.Errorf("generate invocation id: %w", err)
+		}
+		defer callContext.Cancel()
+
+		campaignID := input.CampaignID
+		if campaignID == "" {
+			campaignID = callContext.MCPContext.CampaignID
+		}
+		if campaignID == "" {
+			return nil, ChaseListResult{}, fmt.Errorf("campaign_id is required")
+		}
+
+		callCtx, callMeta, err := NewOutgoingContext(callContext.RunCtx, callContext.InvocationID)
+		if err != nil {
+			return nil, ChaseListResult{}, fmt.Errorf("create request metadata: %w", err)
+		}
+
+		var header metadata.MD
+		response, err := client.ListChases(callCtx, &pb.DaggerheartListChasesRequest{
+			CampaignId: campaignID,
+		}, grpc.Header(&header))
+		if err != nil {
+			return nil, ChaseListResult{}, fmt.Errorf("chase list failed: %w", err)
+		}
+		if response == nil {
+			return nil, ChaseListResult{}, fmt.Errorf("chase list response is missing")
+		}
+
+		result := ChaseListResult{Chases: make([]ChaseResult, 0, len(response.GetChases()))}
+		for _, chase := range response.GetChases() {
+			result.Chases = append(result.Chases, chaseResultFromProto(chase))
+		}
+
+		responseMeta := MergeResponseMetadata(callMeta, header)
+		return CallToolResultWithMetadata(responseMeta), result, nil
+	}
+}
+
+// chaseCountdownSpec converts a chase side input, mapping outcome names to
+// the protobuf outcome enum.
+func chaseCountdownSpec(input ChaseCountdownInput) (*pb.DaggerheartChaseCountdownSpec, error) {
+	if input.Max <= 0 {
+		return nil, fmt.Errorf("max must be positive")
+	}
+	outcomes := make([]string, 0, len(input.OutcomeDeltas))
+	for outcome := range input.OutcomeDeltas {
+		outcomes = append(outcomes, outcome)
+	}
+	sort.Strings(outcomes)
+
+	spec := &pb.DaggerheartChaseCountdownSpec{
+		CountdownId: input.CountdownID,
+		Name:        input.Name,
+		Max:         int32(input.Max),
+	}
+	for _, outcome := range outcomes {
+		value, ok := pb.Outcome_value[strings.ToUpper(strings.TrimSpace(outcome))]
+		if !ok || pb.Outcome(value) == pb.Outcome_OUTCOME_UNSPECIFIED {
+			return nil, fmt.Errorf("outcome %q is invalid", outcome)
+		}
+		spec.OutcomeDeltas = append(spec.OutcomeDeltas, &pb.DaggerheartCountdownOutcomeDelta{
+			Outcome: pb.Outcome(value),
+			Delta:   int32(input.OutcomeDeltas[outcome]),
+		})
+	}
+	return spec, nil
+}
+
+func chaseResultFromProto(chase *pb.DaggerheartChase) ChaseResult {
+	return ChaseResult{
+		ChaseID:     chase.GetChaseId(),
+		Name:        chase.GetName(),
+		Status:      chaseStatusToString(chase.GetStatus()),
+		Winner:      chaseWinnerToString(chase.GetWinner()),
+		Progress:    chaseCountdownResultFromProto(chase.GetProgress()),
+		Consequence: chaseCountdownResultFromProto(chase.GetConsequence()),
+	}
+}
+
+func chaseCountdownResultFromProto(countdown *pb.DaggerheartCountdown) *ChaseCountdownResult {
+	if countdown == nil {
+		return nil
+	}
+	return &ChaseCountdownResult{
+		CountdownID: countdown.GetCountdownId(),
+		Name:        countdown.GetName(),
+		Current:     int(countdown.GetCurrent()),
+		Max:         int(countdown.GetMax()),
+	}
+}
+
+// chaseStatusToString converts a protobuf chase status to a string representation.
+func chaseStatusToString(value pb.DaggerheartChaseStatus) string {
+	switch value {
+	case pb.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_ACTIVE:
+		return "ACTIVE"
+	case pb.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_RESOLVED:
+		return "RESOLVED"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// chaseWinnerToString converts a protobuf chase winner to a string
+// representation; unresolved chases have no winner.
+func chaseWinnerToString(value pb.DaggerheartChaseWinner) string {
+	switch value {
+	case pb.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_PROGRESS:
+		return "PROGRESS"
+	case pb.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_CONSEQUENCE:
+		return "CONSEQUENCE"
+	default:
+		return ""
+	}
+}
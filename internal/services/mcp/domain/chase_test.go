@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+)
+
+func testChase(status pb.DaggerheartChaseStatus, winner pb.DaggerheartChaseWinner) *pb.DaggerheartChase {
+	return &pb.DaggerheartChase{
+		ChaseId: "chase-1",
+		Name:    "Thief",
+		Status:  status,
+		Winner:  winner,
+		Progress: &pb.DaggerheartCountdown{
+			CountdownId: "chase-1-progress", Name: "Thief progress", Current: 4, Max: 6,
+		},
+		Consequence: &pb.DaggerheartCountdown{
+			CountdownId: "chase-1-consequence", Name: "Thief consequence", Current: 0, Max: 4,
+		},
+	}
+}
+
+func TestChaseStartHandler(t *testing.T) {
+	t.Run("success with context", func(t *testing.T) {
+		client := &fakeDaggerheartClient{
+			startChaseResp: &pb.DaggerheartStartChaseResponse{
+				Chase: testChase(pb.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_ACTIVE, pb.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_UNSPECIFIED),
+			},
+		}
+		getCtx := func() Context { return Context{CampaignID: "c1", SessionID: "s1"} }
+		handler := ChaseStartHandler(client, getCtx)
+		_, result, err := handler(context.Background(), nil, ChaseStartInput{
+			Name:        "Thief",
+			Progress:    ChaseCountdownInput{Max: 6, OutcomeDeltas: map[string]int{"failure_with_hope": 1}},
+			Consequence: ChaseCountdownInput{Max: 4},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Status != "ACTIVE" || result.Winner != "" {
+			t.Errorf("expected active chase without winner, got %+v", result)
+		}
+		if result.Progress == nil || result.Progress.Current != 4 || result.Progress.Max != 6 {
+			t.Errorf("unexpected progress %+v", result.Progress)
+		}
+		req := client.startChaseReq
+		if req.GetCampaignId() != "c1" || req.GetSessionId() != "s1" {
+			t.Errorf("expected context ids, got %q/%q", req.GetCampaignId(), req.GetSessionId())
+		}
+		deltas := req.GetProgress().GetOutcomeDeltas()
+		if len(deltas) != 1 || deltas[0].GetOutcome() != pb.Outcome_FAILURE_WITH_HOPE || deltas[0].GetDelta() != 1 {
+			t.Errorf("unexpected outcome deltas %+v", deltas)
+		}
+	})
+
+	t.Run("invalid outcome", func(t *testing.T) {
+		handler := ChaseStartHandler(&fakeDaggerheartClient{}, nil)
+		_, _, err := handler(context.Background(), nil, ChaseStartInput{
+			CampaignID:  "c1",
+			SessionID:   "s1",
+			Progress:    ChaseCountdownInput{Max: 6, OutcomeDeltas: map[string]int{"BIG_WIN": 1}},
+			Consequence: ChaseCountdownInput{Max: 4},
+		})
+		if err == nil {
+			t.Fatal("expected error for invalid outcome")
+		}
+	})
+
+	t.Run("missing session_id", func(t *testing.T) {
+		handler := ChaseStartHandler(&fakeDaggerheartClient{}, nil)
+		_, _, err := handler(context.Background(), nil, ChaseStartInput{CampaignID: "c1"})
+		if err == nil {
+			t.Fatal("expected error for missing session_id")
+		}
+	})
+
+	t.Run("gRPC error", func(t *testing.T) {
+		client := &fakeDaggerheartClient{startChaseErr: fmt.Errorf("boom")}
+		handler := ChaseStartHandler(client, nil)
+		_, _, err := handler(context.Background(), nil, ChaseStartInput{
+			CampaignID:  "c1",
+			SessionID:   "s1",
+			Progress:    ChaseCountdownInput{Max: 6},
+			Consequence: ChaseCountdownInput{Max: 4},
+		})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestChaseGetHandler(t *testing.T) {
+	client := &fakeDaggerheartClient{
+		getChaseResp: &pb.DaggerheartGetChaseResponse{
+			Chase: testChase(pb.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_RESOLVED, pb.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_CONSEQUENCE),
+		},
+	}
+	handler := ChaseGetHandler(client, nil)
+	_, result, err := handler(context.Background(), nil, ChaseGetInput{CampaignID: "c1", ChaseID: "chase-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status != "RESOLVED" || result.Winner != "CONSEQUENCE" {
+		t.Errorf("expected resolved consequence chase, got %+v", result)
+	}
+
+	if _, _, err := handler(context.Background(), nil, ChaseGetInput{CampaignID: "c1"}); err == nil {
+		t.Fatal("expected error for missing chase_id")
+	}
+}
+
+func TestChaseListHandler(t *testing.T) {
+	client := &fakeDaggerheartClient{
+		listChasesResp: &pb.DaggerheartListChasesResponse{
+			Chases: []*pb.DaggerheartChase{
+				testChase(pb.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_ACTIVE, pb.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_UNSPECIFIED),
+			},
+		},
+	}
+	getCtx := func() Context { return Context{CampaignID: "c1"} }
+	handler := ChaseListHandler(client, getCtx)
+	_, result, err := handler(context.Background(), nil, ChaseListInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Chases) != 1 || result.Chases[0].ChaseID != "chase-1" {
+		t.Errorf("unexpected chases %+v", result.Chases)
+	}
+
+	if _, _, err := ChaseListHandler(client, nil)(context.Background(), nil, ChaseListInput{}); err == nil {
+		t.Fatal("expected error for missing campaign_id")
+	}
+}
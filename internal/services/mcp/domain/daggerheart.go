@@ -38,6 +38,22 @@ func rollModeToProto(value string) commonv1.RollMode {
 	}
 }
 
+// advantageSourceKindToProto maps a source kind label to the protobuf enum.
+func advantageSourceKindToProto(value string) pb.AdvantageSourceKind {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "condition":
+		return pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_CONDITION
+	case "target":
+		return pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_TARGET
+	case "feature":
+		return pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_FEATURE
+	case "gm_ruling":
+		return pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_GM_RULING
+	default:
+		return pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_UNSPECIFIED
+	}
+}
+
 // rollModeLabel maps a protobuf roll mode to a label for MCP output.
 func rollModeLabel(value commonv1.RollMode) string {
 	switch value {
@@ -83,11 +99,27 @@ type DualityOutcomeResult = ActionRollResult
 
 // DualityExplainInput represents the MCP tool input for explanations.
 type DualityExplainInput struct {
-	Hope       int     `json:"hope" jsonschema:"hope die result"`
-	Fear       int     `json:"fear" jsonschema:"fear die result"`
-	Modifier   int     `json:"modifier" jsonschema:"modifier applied to the roll"`
-	Difficulty *int    `json:"difficulty" jsonschema:"optional difficulty target"`
-	RequestID  *string `json:"request_id,omitempty" jsonschema:"optional correlation identifier"`
+	Hope             int                    `json:"hope" jsonschema:"hope die result"`
+	Fear             int                    `json:"fear" jsonschema:"fear die result"`
+	Modifier         int                    `json:"modifier" jsonschema:"modifier applied to the roll"`
+	Difficulty       *int                   `json:"difficulty" jsonschema:"optional difficulty target"`
+	RequestID        *string                `json:"request_id,omitempty" jsonschema:"optional correlation identifier"`
+	AdvantageSources []AdvantageSourceInput `json:"advantage_sources,omitempty" jsonschema:"optional named advantage/disadvantage sources to explain"`
+	HelpDice         []int                  `json:"help_dice,omitempty" jsonschema:"optional Help an Ally d6 results left after cancellation"`
+	Experiences      []ExperienceInput      `json:"experiences,omitempty" jsonschema:"optional experiences spent on the roll, 1 Hope each"`
+}
+
+// ExperienceInput represents an Experience spent on a roll.
+type ExperienceInput struct {
+	Name     string `json:"name" jsonschema:"experience name"`
+	Modifier int    `json:"modifier" jsonschema:"experience modifier included in the roll modifier"`
+}
+
+// AdvantageSourceInput represents a named advantage or disadvantage source.
+type AdvantageSourceInput struct {
+	Kind         string `json:"kind" jsonschema:"source kind (condition, target, feature, gm_ruling)"`
+	Name         string `json:"name" jsonschema:"source name, e.g. Hidden or Vulnerable"`
+	Disadvantage bool   `json:"disadvantage,omitempty" jsonschema:"whether the source imposes disadvantage"`
 }
 
 // DualityExplainIntermediates represents derived evaluation values.
@@ -383,12 +415,36 @@ func DualityExplainHandler(client pb.DaggerheartServiceClient) mcp.ToolHandlerFo
 
 		var header metadata.MD
 
+		sources := make([]*pb.AdvantageSource, 0, len(input.AdvantageSources))
+		for _, source := range input.AdvantageSources {
+			sources = append(sources, &pb.AdvantageSource{
+				Kind:         advantageSourceKindToProto(source.Kind),
+				Name:         source.Name,
+				Disadvantage: source.Disadvantage,
+			})
+		}
+
+		helpDice := make([]int32, 0, len(input.HelpDice))
+		for _, die := range input.HelpDice {
+			helpDice = append(helpDice, int32(die))
+		}
+		experiences := make([]*pb.DaggerheartExperience, 0, len(input.Experiences))
+		for _, experience := range input.Experiences {
+			experiences = append(experiences, &pb.DaggerheartExperience{
+				Name:     experience.Name,
+				Modifier: int32(experience.Modifier),
+			})
+		}
+
 		response, err := client.DualityExplain(callCtx, &pb.DualityExplainRequest{
-			Hope:       int32(input.Hope),
-			Fear:       int32(input.Fear),
-			Modifier:   int32(modifier),
-			Difficulty: difficulty,
-			RequestId:  requestID,
+			Hope:             int32(input.Hope),
+			Fear:             int32(input.Fear),
+			Modifier:         int32(modifier),
+			Difficulty:       difficulty,
+			RequestId:        requestID,
+			AdvantageSources: sources,
+			HelpDice:         helpDice,
+			Experiences:      experiences,
 		}, grpc.Header(&header))
 		if err != nil {
 			return nil, DualityExplainResult{}, fmt.Errorf("duality explain failed: %w", err)
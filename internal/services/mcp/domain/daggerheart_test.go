@@ -204,6 +204,55 @@ func TestDualityExplainHandler(t *testing.T) {
 		}
 	})
 
+	t.Run("advantage sources", func(t *testing.T) {
+		client := &fakeDaggerheartClient{
+			explainResp: &pb.DualityExplainResponse{Intermediates: &pb.Intermediates{}},
+		}
+		handler := DualityExplainHandler(client)
+		_, _, err := handler(context.Background(), nil, DualityExplainInput{
+			Hope: 4, Fear: 3,
+			AdvantageSources: []AdvantageSourceInput{
+				{Kind: "condition", Name: "Hidden"},
+				{Kind: "GM_Ruling", Name: "Darkness", Disadvantage: true},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		sources := client.explainReq.GetAdvantageSources()
+		if len(sources) != 2 {
+			t.Fatalf("expected 2 sources, got %d", len(sources))
+		}
+		if sources[0].GetKind() != pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_CONDITION {
+			t.Errorf("expected condition kind, got %v", sources[0].GetKind())
+		}
+		if sources[1].GetKind() != pb.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_GM_RULING || !sources[1].GetDisadvantage() {
+			t.Errorf("expected gm ruling disadvantage, got %v", sources[1])
+		}
+	})
+
+	t.Run("help dice and experiences", func(t *testing.T) {
+		client := &fakeDaggerheartClient{
+			explainResp: &pb.DualityExplainResponse{Intermediates: &pb.Intermediates{}},
+		}
+		handler := DualityExplainHandler(client)
+		_, _, err := handler(context.Background(), nil, DualityExplainInput{
+			Hope: 4, Fear: 3, Modifier: 7,
+			HelpDice:    []int{3, 5},
+			Experiences: []ExperienceInput{{Name: "Stealth", Modifier: 2}},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if dice := client.explainReq.GetHelpDice(); len(dice) != 2 || dice[1] != 5 {
+			t.Errorf("expected help dice [3 5], got %v", dice)
+		}
+		experiences := client.explainReq.GetExperiences()
+		if len(experiences) != 1 || experiences[0].GetName() != "Stealth" || experiences[0].GetModifier() != 2 {
+			t.Errorf("expected Stealth +2, got %v", experiences)
+		}
+	})
+
 	t.Run("nil intermediates", func(t *testing.T) {
 		client := &fakeDaggerheartClient{
 			explainResp: &pb.DualityExplainResponse{
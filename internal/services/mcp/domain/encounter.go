@@ -0,0 +1,249 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// EncounterGroupInput describes a group of catalog adversaries.
+type EncounterGroupInput struct {
+	AdversaryEntryID string   `json:"adversary_entry_id" jsonschema:"catalog adversary identifier"`
+	Count            int      `json:"count,omitempty" jsonschema:"number of adversaries (defaults to 1)"`
+	Role             string   `json:"role,omitempty" jsonschema:"optional battle point role (minion, social, support, horde, ranged, skulk, standard, leader, bruiser, solo); defaults to the catalog role"`
+	Names            []string `json:"names,omitempty" jsonschema:"optional per-adversary names, in order"`
+}
+
+// EncounterPlanInput represents the MCP tool input for pricing an encounter.
+type EncounterPlanInput struct {
+	CampaignID  string                `json:"campaign_id,omitempty" jsonschema:"campaign identifier (defaults to context)"`
+	Groups      []EncounterGroupInput `json:"groups" jsonschema:"adversary groups in the encounter"`
+	EasierFight bool                  `json:"easier_fight,omitempty" jsonschema:"make the fight easier or shorter (-1 battle point)"`
+	HarderFight bool                  `json:"harder_fight,omitempty" jsonschema:"make the fight harder or longer (+2 battle points)"`
+	BonusDamage bool                  `json:"bonus_damage,omitempty" jsonschema:"adversaries deal +1d4 or +2 bonus damage (-2 battle points)"`
+	PartySize   *int                  `json:"party_size,omitempty" jsonschema:"optional party size (defaults to the campaign's player characters)"`
+}
+
+// EncounterCommitInput represents the MCP tool input for committing an
+// encounter to a session.
+type EncounterCommitInput struct {
+	EncounterPlanInput
+	SessionID string `json:"session_id,omitempty" jsonschema:"session identifier (defaults to context)"`
+}
+
+// EncounterAdjustmentResult is one modifier to the battle point budget.
+type EncounterAdjustmentResult struct {
+	Reason string `json:"reason" jsonschema:"adjustment reason"`
+	Points int    `json:"points" jsonschema:"battle points added to the budget"`
+}
+
+// EncounterGroupResult is the battle point cost of one adversary group.
+type EncounterGroupResult struct {
+	AdversaryEntryID string `json:"adversary_entry_id" jsonschema:"catalog adversary identifier"`
+	Name             string `json:"name" jsonschema:"catalog adversary name"`
+	Role             string `json:"role" jsonschema:"battle point role"`
+	Tier             int    `json:"tier" jsonschema:"adversary tier"`
+	Count            int    `json:"count" jsonschema:"number of adversaries"`
+	BattlePoints     int    `json:"battle_points" jsonschema:"battle points spent on the group"`
+}
+
+// EncounterBudgetResult represents an encounter's battle point budget.
+type EncounterBudgetResult struct {
+	PartySize   int                         `json:"party_size" jsonschema:"number of player characters"`
+	PartyTier   int                         `json:"party_tier" jsonschema:"party tier"`
+	Base        int                         `json:"base" jsonschema:"base budget of (3 x party size) + 2"`
+	Adjustments []EncounterAdjustmentResult `json:"adjustments,omitempty" jsonschema:"budget adjustments"`
+	Total       int                         `json:"total" jsonschema:"budget after adjustments"`
+	Spent       int                         `json:"spent" jsonschema:"battle points spent on the groups"`
+	Remaining   int                         `json:"remaining" jsonschema:"battle points left; negative when over budget"`
+	Groups      []EncounterGroupResult      `json:"groups" jsonschema:"cost of each group"`
+}
+
+// EncounterAdversaryResult represents an adversary created by an encounter.
+type EncounterAdversaryResult struct {
+	AdversaryID      string `json:"adversary_id" jsonschema:"adversary identifier"`
+	Name             string `json:"name" jsonschema:"adversary name"`
+	AdversaryEntryID string `json:"adversary_entry_id" jsonschema:"catalog adversary identifier"`
+}
+
+// EncounterCommitResult represents the MCP tool output for committing an
+// encounter.
+type EncounterCommitResult struct {
+	Budget      EncounterBudgetResult      `json:"budget" jsonschema:"encounter budget"`
+	Adversaries []EncounterAdversaryResult `json:"adversaries" jsonschema:"created adversaries, in group order"`
+}
+
+// EncounterPlanTool defines the MCP tool schema for pricing an encounter.
+func EncounterPlanTool() *mcp.Tool {
+	return &mcp.Tool{
+		Name:        "encounter_plan",
+		Description: "Prices catalog adversaries against the party's battle point budget and reports the adjustments and remaining points",
+	}
+}
+
+// EncounterCommitTool defines the MCP tool schema for committing an encounter.
+func EncounterCommitTool() *mcp.Tool {
+	return &mcp.Tool{
+		Name:        "encounter_commit",
+		Description: "Creates every adversary of an encounter in a session in one batch and returns the budget",
+	}
+}
+
+// EncounterPlanHandler executes an encounter plan request.
+func EncounterPlanHandler(client pb.DaggerheartServiceClient, getContext func() Context) mcp.ToolHandlerFor[EncounterPlanInput, EncounterBudgetResult] {
+	return func(ctx context.Context, _ *mcp.CallToolRequest, input EncounterPlanInput) (*mcp.CallToolResult, EncounterBudgetResult, error) {
+		callContext, err := newToolInvocationContext(ctx, getContext)
+		if err != nil {
+			return nil, EncounterBudgetResult{}, fmt.Errorf("generate invocation id: %w", err)
+		}
+		defer callContext.Cancel()
+
+		campaignID := input.CampaignID
+		if campaignID == "" {
+			campaignID = callContext.MCPContext.CampaignID
+		}
+		if campaignID == "" {
+			return nil, EncounterBudgetResult{}, fmt.Errorf("campaign_id is required")
+		}
+
+		callCtx, callMeta, err := NewOutgoingContext(callContext.RunCtx, callContext.InvocationID)
+		if err != nil {
+			return nil, EncounterBudgetResult{}, fmt.Errorf("create request metadata: %w", err)
+		}
+
+		var header metadata.MD
+		response, err := client.PlanEncounter(callCtx, &pb.DaggerheartPlanEncounterRequest{
+			CampaignId: campaignID,
+			Groups:     encounterGroupsToProto(input.Groups),
+			Options:    encounterOptionsToProto(input),
+		}, grpc.Header(&header))
+		if err != nil {
+			return nil, EncounterBudgetResult{}, fmt.Errorf("encounter plan failed: %w", err)
+		}
+		if response == nil || response.GetBudget() == nil {
+			return nil, EncounterBudgetResult{}, fmt.Errorf("encounter plan response is missing")
+		}
+
+		responseMeta := MergeResponseMetadata(callMeta, header)
+		return CallToolResultWithMetadata(responseMeta), encounterBudgetFromProto(response.GetBudget()), nil
+	}
+}
+
+// EncounterCommitHandler executes an encounter commit request.
+func EncounterCommitHandler(client pb.DaggerheartServiceClient, getContext func() Context) mcp.ToolHandlerFor[EncounterCommitInput, EncounterCommitResult] {
+	return func(ctx context.Context, _ *mcp.CallToolRequest, input EncounterCommitInput) (*mcp.CallToolResult, EncounterCommitResult, error) {
+		callContext, err := newToolInvocationContext(ctx, getContext)
+		if err != nil {
+			return nil, EncounterCommitResult{}, fmt.Errorf("generate invocation id: %w", err)
+		}
+		defer callContext.Cancel()
+
+		campaignID := input.CampaignID
+		if campaignID == "" {
+			campaignID = callContext.MCPContext.CampaignID
+		}
+		sessionID := input.SessionID
+		if sessionID == "" {
+			sessionID = callContext.MCPContext.SessionID
+		}
+		if campaignID == "" {
+			return nil, EncounterCommitResult{}, fmt.Errorf("campaign_id is required")
+		}
+		if sessionID == "" {
+			return nil, EncounterCommitResult{}, fmt.Errorf("session_id is required")
+		}
+
+		callCtx, callMeta, err := NewOutgoingContext(callContext.RunCtx, callContext.InvocationID)
+		if err != nil {
+			return nil, EncounterCommitResult{}, fmt.Errorf("create request metadata: %w", err)
+		}
+
+		var header metadata.MD
+		response, err := client.CommitEncounter(callCtx, &pb.DaggerheartCommitEncounterRequest{
+			CampaignId: campaignID,
+			SessionId:  sessionID,
+			Groups:     encounterGroupsToProto(input.Groups),
+			Options:    encounterOptionsToProto(input.EncounterPlanInput),
+		}, grpc.Header(&header))
+		if err != nil {
+			return nil, EncounterCommitResult{}, fmt.Errorf("encounter commit failed: %w", err)
+		}
+		if response == nil || response.GetBudget() == nil {
+			return nil, EncounterCommitResult{}, fmt.Errorf("encounter commit response is missing")
+		}
+
+		result := EncounterCommitResult{
+			Budget:      encounterBudgetFromProto(response.GetBudget()),
+			Adversaries: make([]EncounterAdversaryResult, 0, len(response.GetAdversaries())),
+		}
+		for _, adversary := range response.GetAdversaries() {
+			result.Adversaries = append(result.Adversaries, EncounterAdversaryResult{
+				AdversaryID:      adversary.GetId(),
+				Name:             adversary.GetName(),
+				AdversaryEntryID: adversary.GetAdversaryEntryId(),
+			})
+		}
+
+		responseMeta := MergeResponseMetadata(callMeta, header)
+		return CallToolResultWithMetadata(responseMeta), result, nil
+	}
+}
+
+func encounterGroupsToProto(groups []EncounterGroupInput) []*pb.DaggerheartEncounterGroup {
+	result := make([]*pb.DaggerheartEncounterGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, &pb.DaggerheartEncounterGroup{
+			AdversaryEntryId: group.AdversaryEntryID,
+			Count:            int32(group.Count),
+			Role:             group.Role,
+			Names:            group.Names,
+		})
+	}
+	return result
+}
+
+func encounterOptionsToProto(input EncounterPlanInput) *pb.DaggerheartEncounterOptions {
+	options := &pb.DaggerheartEncounterOptions{
+		EasierFight: input.EasierFight,
+		HarderFight: input.HarderFight,
+		BonusDamage: input.BonusDamage,
+	}
+	if input.PartySize != nil {
+		options.PartySize = wrapperspb.Int32(int32(*input.PartySize))
+	}
+	return options
+}
+
+func encounterBudgetFromProto(budget *pb.DaggerheartEncounterBudget) EncounterBudgetResult {
+	result := EncounterBudgetResult{
+		PartySize: int(budget.GetPartySize()),
+		PartyTier: int(budget.GetPartyTier()),
+		Base:      int(budget.GetBase()),
+		Total:     int(budget.GetTotal()),
+		Spent:     int(budget.GetSpent()),
+		Remaining: int(budget.GetRemaining()),
+		Groups:    make([]EncounterGroupResult, 0, len(budget.GetGroups())),
+	}
+	for _, adjustment := range budget.GetAdjustments() {
+		result.Adjustments = append(result.Adjustments, EncounterAdjustmentResult{
+			Reason: adjustment.GetReason(),
+			Points: int(adjustment.GetPoints()),
+		})
+	}
+	for _, group := range budget.GetGroups() {
+		result.Groups = append(result.Groups, EncounterGroupResult{
+			AdversaryEntryID: group.GetAdversaryEntryId(),
+			Name:             group.GetName(),
+			Role:             group.GetRole(),
+			Tier:             int(group.GetTier()),
+			Count:            int(group.GetCount()),
+			BattlePoints:     int(group.GetBattlePoints()),
+		})
+	}
+	return result
+}
@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pb "github.com/louisbranch/fracturing.space/api/gen/go/systems/daggerheart/v1"
+)
+
+func testEncounterBudget() *pb.DaggerheartEncounterBudget {
+	return &pb.DaggerheartEncounterBudget{
+		PartySize: 4,
+		PartyTier: 1,
+		Base:      14,
+		Adjustments: []*pb.DaggerheartEncounterAdjustment{
+			{Reason: "harder_fight", Points: 2},
+		},
+		Total:     16,
+		Spent:     9,
+		Remaining: 7,
+		Groups: []*pb.DaggerheartEncounterGroupCost{
+			{AdversaryEntryId: "adversary.bruiser", Name: "Bear", Role: "bruiser", Tier: 1, Count: 1, BattlePoints: 4},
+			{AdversaryEntryId: "adversary.zombie", Name: "Rotted Zombie", Role: "minion", Tier: 1, Count: 8, BattlePoints: 2},
+		},
+	}
+}
+
+func TestEncounterPlanHandler(t *testing.T) {
+	t.Run("success with context", func(t *testing.T) {
+		client := &fakeDaggerheartClient{
+			planEncounterResp: &pb.DaggerheartPlanEncounterResponse{Budget: testEncounterBudget()},
+		}
+		getCtx := func() Context { return Context{CampaignID: "c1"} }
+		handler := EncounterPlanHandler(client, getCtx)
+		partySize := 4
+		_, result, err := handler(context.Background(), nil, EncounterPlanInput{
+			Groups: []EncounterGroupInput{
+				{AdversaryEntryID: "adversary.bruiser"},
+				{AdversaryEntryID: "adversary.zombie", Count: 8},
+			},
+			HarderFight: true,
+			PartySize:   &partySize,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Total != 16 || result.Remaining != 7 || len(result.Adjustments) != 1 || len(result.Groups) != 2 {
+			t.Errorf("unexpected budget %+v", result)
+		}
+		req := client.planEncounterReq
+		if req.GetCampaignId() != "c1" || len(req.GetGroups()) != 2 || req.GetGroups()[1].GetCount() != 8 {
+			t.Errorf("unexpected request %+v", req)
+		}
+		if !req.GetOptions().GetHarderFight() || req.GetOptions().GetPartySize().GetValue() != 4 {
+			t.Errorf("unexpected options %+v", req.GetOptions())
+		}
+	})
+
+	t.Run("missing campaign_id", func(t *testing.T) {
+		handler := EncounterPlanHandler(&fakeDaggerheartClient{}, nil)
+		_, _, err := handler(context.Background(), nil, EncounterPlanInput{})
+		if err == nil {
+			t.Fatal("expected error for missing campaign_id")
+		}
+	})
+
+	t.Run("gRPC error", func(t *testing.T) {
+		handler := EncounterPlanHandler(&fakeDaggerheartClient{planEncounterErr: fmt.Errorf("boom")}, nil)
+		_, _, err := handler(context.Background(), nil, EncounterPlanInput{CampaignID: "c1"})
+		if err == nil {
+			t.Fatal("expected gRPC error")
+		}
+	})
+}
+
+func TestEncounterCommitHandler(t *testing.T) {
+	t.Run("success with context", func(t *testing.T) {
+		client := &fakeDaggerheartClient{
+			commitEncounterResp: &pb.DaggerheartCommitEncounterResponse{
+				Budget: testEncounterBudget(),
+				Adversaries: []*pb.DaggerheartAdversary{
+					{Id: "adv-1", Name: "Bear", AdversaryEntryId: "adversary.bruiser"},
+				},
+			},
+		}
+		getCtx := func() Context { return Context{CampaignID: "c1", SessionID: "s1"} }
+		handler := EncounterCommitHandler(client, getCtx)
+		_, result, err := handler(context.Background(), nil, EncounterCommitInput{
+			EncounterPlanInput: EncounterPlanInput{
+				Groups: []EncounterGroupInput{{AdversaryEntryID: "adversary.bruiser", Names: []string{"Bear"}}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result.Adversaries) != 1 || result.Adversaries[0].AdversaryID != "adv-1" || result.Budget.Spent != 9 {
+			t.Errorf("unexpected result %+v", result)
+		}
+		req := client.commitEncounterReq
+		if req.GetCampaignId() != "c1" || req.GetSessionId() != "s1" {
+			t.Errorf("expected context ids, got %q/%q", req.GetCampaignId(), req.GetSessionId())
+		}
+	})
+
+	t.Run("missing session_id", func(t *testing.T) {
+		handler := EncounterCommitHandler(&fakeDaggerheartClient{}, nil)
+		_, _, err := handler(context.Background(), nil, EncounterCommitInput{EncounterPlanInput: EncounterPlanInput{CampaignID: "c1"}})
+		if err == nil {
+			t.Fatal("expected error for missing session_id")
+		}
+	})
+
+	t.Run("missing response", func(t *testing.T) {
+		handler := EncounterCommitHandler(&fakeDaggerheartClient{}, nil)
+		_, _, err := handler(context.Background(), nil, EncounterCommitInput{
+			EncounterPlanInput: EncounterPlanInput{CampaignID: "c1"},
+			SessionID:          "s1",
+		})
+		if err == nil {
+			t.Fatal("expected error for missing response")
+		}
+	})
+}
@@ -212,18 +212,32 @@ func (f *fakeForkClient) GetLineage(_ context.Context, _ *statev1.GetLineageRequ
 type fakeDaggerheartClient struct {
 	pb.DaggerheartServiceClient
 
-	actionRollResp   *pb.ActionRollResponse
-	actionRollErr    error
-	outcomeResp      *pb.DualityOutcomeResponse
-	outcomeErr       error
-	explainResp      *pb.DualityExplainResponse
-	explainErr       error
-	probabilityResp  *pb.DualityProbabilityResponse
-	probabilityErr   error
-	rulesVersionResp *pb.RulesVersionResponse
-	rulesVersionErr  error
-	rollDiceResp     *pb.RollDiceResponse
-	rollDiceErr      error
+	actionRollResp      *pb.ActionRollResponse
+	actionRollErr       error
+	outcomeResp         *pb.DualityOutcomeResponse
+	outcomeErr          error
+	explainResp         *pb.DualityExplainResponse
+	explainErr          error
+	explainReq          *pb.DualityExplainRequest
+	probabilityResp     *pb.DualityProbabilityResponse
+	probabilityErr      error
+	rulesVersionResp    *pb.RulesVersionResponse
+	rulesVersionErr     error
+	rollDiceResp        *pb.RollDiceResponse
+	rollDiceErr         error
+	startChaseReq       *pb.DaggerheartStartChaseRequest
+	startChaseResp      *pb.DaggerheartStartChaseResponse
+	startChaseErr       error
+	getChaseResp        *pb.DaggerheartGetChaseResponse
+	getChaseErr         error
+	listChasesResp      *pb.DaggerheartListChasesResponse
+	listChasesErr       error
+	planEncounterReq    *pb.DaggerheartPlanEncounterRequest
+	planEncounterResp   *pb.DaggerheartPlanEncounterResponse
+	planEncounterErr    error
+	commitEncounterReq  *pb.DaggerheartCommitEncounterRequest
+	commitEncounterResp *pb.DaggerheartCommitEncounterResponse
+	commitEncounterErr  error
 }
 
 func (f *fakeDaggerheartClient) ActionRoll(_ context.Context, _ *pb.ActionRollRequest, _ ...grpc.CallOption) (*pb.ActionRollResponse, error) {
@@ -234,7 +248,8 @@ func (f *fakeDaggerheartClient) DualityOutcome(_ context.Context, _ *pb.DualityO
 	return f.outcomeResp, f.outcomeErr
 }
 
-func (f *fakeDaggerheartClient) DualityExplain(_ context.Context, _ *pb.DualityExplainRequest, _ ...grpc.CallOption) (*pb.DualityExplainResponse, error) {
+func (f *fakeDaggerheartClient) DualityExplain(_ context.Context, req *pb.DualityExplainRequest, _ ...grpc.CallOption) (*pb.DualityExplainResponse, error) {
+	f.explainReq = req
 	return f.explainResp, f.explainErr
 }
 
@@ -250,6 +265,29 @@ func (f *fakeDaggerheartClient) RollDice(_ context.Context, _ *pb.RollDiceReques
 	return f.rollDiceResp, f.rollDiceErr
 }
 
+func (f *fakeDaggerheartClient) StartChase(_ context.Context, req *pb.DaggerheartStartChaseRequest, _ ...grpc.CallOption) (*pb.DaggerheartStartChaseResponse, error) {
+	f.startChaseReq = req
+	return f.startChaseResp, f.startChaseErr
+}
+
+func (f *fakeDaggerheartClient) GetChase(_ context.Context, _ *pb.DaggerheartGetChaseRequest, _ ...grpc.CallOption) (*pb.DaggerheartGetChaseResponse, error) {
+	return f.getChaseResp, f.getChaseErr
+}
+
+func (f *fakeDaggerheartClient) ListChases(_ context.Context, _ *pb.DaggerheartListChasesRequest, _ ...grpc.CallOption) (*pb.DaggerheartListChasesResponse, error) {
+	return f.listChasesResp, f.listChasesErr
+}
+
+func (f *fakeDaggerheartClient) PlanEncounter(_ context.Context, req *pb.DaggerheartPlanEncounterRequest, _ ...grpc.CallOption) (*pb.DaggerheartPlanEncounterResponse, error) {
+	f.planEncounterReq = req
+	return f.planEncounterResp, f.planEncounterErr
+}
+
+func (f *fakeDaggerheartClient) CommitEncounter(_ context.Context, req *pb.DaggerheartCommitEncounterRequest, _ ...grpc.CallOption) (*pb.DaggerheartCommitEncounterResponse, error) {
+	f.commitEncounterReq = req
+	return f.commitEncounterResp, f.commitEncounterErr
+}
+
 // newStructValue builds a *structpb.Struct from a map for test data.
 func newStructValue(m map[string]any) *structpb.Struct {
 	s, _ := structpb.NewStruct(m)
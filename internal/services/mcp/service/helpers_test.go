@@ -741,7 +741,7 @@ func TestRegisterToolsNoPanic(t *testing.T) {
 	server := &Server{}
 
 	t.Run("registerDaggerheartTools", func(t *testing.T) {
-		registerDaggerheartTools(mcpServer, nil)
+		registerDaggerheartTools(mcpServer, nil, server.getContext)
 	})
 
 	t.Run("registerCampaignTools", func(t *testing.T) {
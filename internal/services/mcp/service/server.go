@@ -97,7 +97,7 @@ func newServer(conn *grpc.ClientConn) *Server {
 		}
 	}
 
-	registerDaggerheartTools(mcpServer, daggerheartClient)
+	registerDaggerheartTools(mcpServer, daggerheartClient, server.getContext)
 	registerCampaignTools(mcpServer, campaignClient, participantClient, characterClient, snapshotClient, server.getContext, resourceNotifier)
 	registerSessionTools(mcpServer, sessionClient, server.getContext, resourceNotifier)
 	registerForkTools(mcpServer, forkClient, resourceNotifier)
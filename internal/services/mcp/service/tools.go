@@ -7,13 +7,18 @@ import (
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
-func registerDaggerheartTools(mcpServer *mcp.Server, client daggerheartv1.DaggerheartServiceClient) {
+func registerDaggerheartTools(mcpServer *mcp.Server, client daggerheartv1.DaggerheartServiceClient, getContext func() domain.Context) {
 	mcp.AddTool(mcpServer, domain.ActionRollTool(), domain.ActionRollHandler(client))
 	mcp.AddTool(mcpServer, domain.DualityExplainTool(), domain.DualityExplainHandler(client))
 	mcp.AddTool(mcpServer, domain.DualityOutcomeTool(), domain.DualityOutcomeHandler(client))
 	mcp.AddTool(mcpServer, domain.DualityProbabilityTool(), domain.DualityProbabilityHandler(client))
 	mcp.AddTool(mcpServer, domain.RulesVersionTool(), domain.RulesVersionHandler(client))
 	mcp.AddTool(mcpServer, domain.RollDiceTool(), domain.RollDiceHandler(client))
+	mcp.AddTool(mcpServer, domain.ChaseStartTool(), domain.ChaseStartHandler(client, getContext))
+	mcp.AddTool(mcpServer, domain.ChaseGetTool(), domain.ChaseGetHandler(client, getContext))
+	mcp.AddTool(mcpServer, domain.ChaseListTool(), domain.ChaseListHandler(client, getContext))
+	mcp.AddTool(mcpServer, domain.EncounterPlanTool(), domain.EncounterPlanHandler(client, getContext))
+	mcp.AddTool(mcpServer, domain.EncounterCommitTool(), domain.EncounterCommitHandler(client, getContext))
 }
 
 func registerCampaignTools(
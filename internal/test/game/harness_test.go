@@ -7,6 +7,7 @@ import (
 	"crypto/ed25519"
 	"crypto/rand"
 	"encoding/base64"
+	"io"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -17,6 +18,7 @@ import (
 	authv1 "github.com/louisbranch/fracturing.space/api/gen/go/auth/v1"
 	authserver "github.com/louisbranch/fracturing.space/internal/services/auth/app"
 	server "github.com/louisbranch/fracturing.space/internal/services/game/app"
+	catalogimporter "github.com/louisbranch/fracturing.space/internal/tools/importer/content/daggerheart/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	grpc_health_v1 "google.golang.org/grpc/health/grpc_health_v1"
@@ -38,6 +40,7 @@ func startGRPCServer(t *testing.T) (string, string, func()) {
 	t.Helper()
 
 	setTempDBPath(t)
+	contentDBPath := setTempContentDBPath(t)
 	setTempAuthDBPath(t)
 	setJoinGrantEnv(t)
 	authAddr, stopAuth := startAuthServer(t)
@@ -58,6 +61,7 @@ func startGRPCServer(t *testing.T) (string, string, func()) {
 
 	addr := grpcServer.Addr()
 	waitForGRPCHealth(t, addr)
+	importCatalog(t, contentDBPath)
 	stop := func() {
 		cancel()
 		select {
@@ -132,6 +136,28 @@ func setTempDBPath(t *testing.T) {
 	t.Setenv("FRACTURING_SPACE_GAME_EVENT_HMAC_KEY", "test-key")
 }
 
+func setTempContentDBPath(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "game-content.db")
+	t.Setenv("FRACTURING_SPACE_GAME_CONTENT_DB_PATH", path)
+	return path
+}
+
+// importCatalog loads the bundled Daggerheart catalog so scenarios can
+// reference real environment and adversary entries. Content migrations reset
+// the tables on open, so this runs after the server has opened the store.
+func importCatalog(t *testing.T, path string) {
+	t.Helper()
+	err := catalogimporter.Run(context.Background(), catalogimporter.Config{
+		Dir:        filepath.Join(repoRoot(t), "internal", "tools", "importer", "content", "daggerheart", "v1"),
+		DBPath:     path,
+		BaseLocale: "en-US",
+	}, io.Discard)
+	if err != nil {
+		t.Fatalf("import daggerheart catalog: %v", err)
+	}
+}
+
 func setTempAuthDBPath(t *testing.T) {
 	t.Helper()
 	base := t.TempDir()
@@ -42,6 +42,7 @@ type scenarioState struct {
 	actors               map[string]string
 	adversaries          map[string]string
 	countdowns           map[string]string
+	chases               map[string]string
 	gmFear               int
 	userID               string
 	lastRollSeq          uint64
@@ -113,6 +114,7 @@ func runScenario(t *testing.T, env scenarioEnv, scenario *Scenario) {
 		actors:      map[string]string{},
 		adversaries: map[string]string{},
 		countdowns:  map[string]string{},
+		chases:      map[string]string{},
 		userID:      env.userID,
 	}
 	for index, step := range scenario.Steps {
@@ -184,6 +186,54 @@ func runStep(t *testing.T, env scenarioEnv, state *scenarioState, step Step) {
 		runCountdownUpdateStep(t, ctx, env, state, step)
 	case "countdown_delete":
 		runCountdownDeleteStep(t, ctx, env, state, step)
+	case "countdown_expect":
+		runCountdownExpectStep(t, ctx, env, state, step)
+	case "chase_start":
+		runChaseStartStep(t, ctx, env, state, step)
+	case "chase_expect":
+		runChaseExpectStep(t, ctx, env, state, step)
+	case "set_range":
+		runSetRangeStep(t, ctx, env, state, step)
+	case "move":
+		runMoveStep(t, ctx, env, state, step)
+	case "expect_range":
+		runExpectRangeStep(t, ctx, env, state, step)
+	case "environment":
+		runEnvironmentStep(t, ctx, env, state, step)
+	case "environment_shift":
+		runEnvironmentShiftStep(t, ctx, env, state, step)
+	case "environment_clear":
+		runEnvironmentClearStep(t, ctx, env, state, step)
+	case "environment_feature":
+		runEnvironmentFeatureStep(t, ctx, env, state, step)
+	case "acquire_item":
+		runAcquireItemStep(t, ctx, env, state, step)
+	case "drop_item":
+		runDropItemStep(t, ctx, env, state, step)
+	case "transfer_item":
+		runTransferItemStep(t, ctx, env, state, step)
+	case "equip":
+		runEquipStep(t, ctx, env, state, step)
+	case "unequip":
+		runUnequipStep(t, ctx, env, state, step)
+	case "gold":
+		runGoldStep(t, ctx, env, state, step)
+	case "companion":
+		runCompanionStep(t, ctx, env, state, step)
+	case "companion_level_up":
+		runCompanionLevelUpStep(t, ctx, env, state, step)
+	case "companion_stress":
+		runCompanionStressStep(t, ctx, env, state, step)
+	case "companion_attack":
+		runCompanionAttackStep(t, ctx, env, state, step)
+	case "beastform":
+		runBeastformStep(t, ctx, env, state, step)
+	case "beastform_exit":
+		runBeastformExitStep(t, ctx, env, state, step)
+	case "effect":
+		runEffectStep(t, ctx, env, state, step)
+	case "effect_remove":
+		runEffectRemoveStep(t, ctx, env, state, step)
 	case "action_roll":
 		runActionRollStep(t, ctx, env, state, step)
 	case "reaction_roll":
@@ -346,6 +396,10 @@ func runAdversaryStep(t *testing.T, ctx context.Context, env scenarioEnv, state
 	if state.sessionID != "" {
 		request.SessionId = wrapperspb.String(state.sessionID)
 	}
+	if threshold := optionalInt(step.Args, "minion", 0); threshold > 0 {
+		request.MinionThreshold = wrapperspb.Int32(int32(threshold))
+	}
+	request.AdversaryEntryId = optionalString(step.Args, "entry", "")
 	response, err := env.daggerheartClient.CreateAdversary(ctx, request)
 	if err != nil {
 		t.Fatalf("create adversary: %v", err)
@@ -879,7 +933,7 @@ func runAttackStep(t *testing.T, ctx context.Context, env scenarioEnv, state *sc
 	before := latestSeq(t, ctx, env, state)
 	if !targetIsAdversary {
 		stateBefore := getCharacterState(t, ctx, env, state, targetID)
-		response, err := env.daggerheartClient.SessionAttackFlow(ctx, &daggerheartv1.SessionAttackFlowRequest{
+		request := &daggerheartv1.SessionAttackFlowRequest{
 			CampaignId:        state.campaignID,
 			SessionId:         state.sessionID,
 			CharacterId:       attackerID,
@@ -898,7 +952,9 @@ func runAttackStep(t *testing.T, ctx context.Context, env scenarioEnv, state *sc
 				Seed:     &damageSeed,
 				RollMode: commonv1.RollMode_REPLAY,
 			},
-		})
+		}
+		applyAttackWeaponArgs(request, step.Args)
+		response, err := env.daggerheartClient.SessionAttackFlow(ctx, request)
 		if err != nil {
 			t.Fatalf("attack flow: %v", err)
 		}
@@ -953,10 +1009,12 @@ func runAttackStep(t *testing.T, ctx context.Context, env scenarioEnv, state *sc
 		resolveOpenSessionGate(t, ctx, env, state, before)
 	}
 
+	weaponID, useWeapon := attackWeaponArg(step.Args)
 	attackOutcome, err := env.daggerheartClient.ApplyAttackOutcome(ctxWithMeta, &daggerheartv1.DaggerheartApplyAttackOutcomeRequest{
 		SessionId: state.sessionID,
 		RollSeq:   rollResp.GetRollSeq(),
 		Targets:   []string{targetID},
+		WeaponId:  weaponID,
 	})
 	if err != nil {
 		t.Fatalf("attack outcome: %v", err)
@@ -965,7 +1023,9 @@ func runAttackStep(t *testing.T, ctx context.Context, env scenarioEnv, state *sc
 
 	if attackOutcome.GetResult() != nil && attackOutcome.GetResult().GetSuccess() {
 		dice := buildDamageDice(step.Args)
-		if len(dice) == 0 {
+		if _, ok := step.Args["damage_dice"]; useWeapon && !ok {
+			dice = nil
+		} else if len(dice) == 0 {
 			t.Fatal("attack requires damage_dice")
 		}
 		critical := attackOutcome.GetResult().GetCrit()
@@ -980,6 +1040,7 @@ func runAttackStep(t *testing.T, ctx context.Context, env scenarioEnv, state *sc
 				Seed:     &damageSeed,
 				RollMode: commonv1.RollMode_REPLAY,
 			},
+			WeaponId: weaponID,
 		})
 		if err != nil {
 			t.Fatalf("attack damage roll: %v", err)
@@ -994,6 +1055,10 @@ func runAttackStep(t *testing.T, ctx context.Context, env scenarioEnv, state *sc
 
 func runMultiAttackStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
 	ensureSession(t, ctx, env, state)
+	if optionalBool(step.Args, "per_target", false) {
+		runMultiAttackFlowStep(t, ctx, env, state, step)
+		return
+	}
 	actorName := requiredString(step.Args, "actor")
 	if actorName == "" {
 		t.Fatal("multi_attack requires actor")
@@ -1123,6 +1188,219 @@ func runMultiAttackStep(t *testing.T, ctx context.Context, env scenarioEnv, stat
 	assertExpectedDeltas(t, ctx, env, state, expectedSpec, expectedBefore)
 }
 
+func runMultiAttackFlowStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	actorName := requiredString(step.Args, "actor")
+	if actorName == "" {
+		t.Fatal("multi_attack requires actor")
+	}
+	attackerID := actorID(t, state, actorName)
+	targets, names := buildMultiAttackTargets(t, state, step.Args, "multi_attack")
+
+	expectedSpec, expectedBefore := captureExpectedDeltas(t, ctx, env, state, step.Args, actorName)
+	expectedAdversary := readExpectedAdversaryDeltas(t, step.Args, "")
+	adversariesBefore := captureMultiAttackAdversaries(t, ctx, env, state, names)
+
+	actionSeed := chooseActionSeed(t, step.Args, multiAttackSeedDifficulty(targets, step.Args))
+	damageSeed := actionSeed + 1
+
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.SessionMultiAttackFlow(ctx, &daggerheartv1.SessionMultiAttackFlowRequest{
+		CampaignId:     state.campaignID,
+		SessionId:      state.sessionID,
+		CharacterId:    attackerID,
+		Trait:          optionalString(step.Args, "trait", "instinct"),
+		Modifiers:      buildActionRollModifiers(step.Args, "modifiers"),
+		Targets:        targets,
+		DamageDice:     buildDamageDice(step.Args),
+		DamageModifier: int32(optionalInt(step.Args, "damage_modifier", 0)),
+		Damage:         buildDamageSpec(step.Args, attackerID, "attack"),
+		ActionRng: &commonv1.RngRequest{
+			Seed:     &actionSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+		DamageRng: &commonv1.RngRequest{
+			Seed:     &damageSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+	})
+	if err != nil {
+		t.Fatalf("multi_attack flow: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeMultiAttackResolved)
+	assertExpectedComplication(t, response.GetRollOutcome(), step.Args)
+	if response.GetDamageRoll() != nil {
+		assertExpectedDamageRoll(t, ctx, env, state, response.GetDamageRoll().GetRollSeq(), step.Args)
+	}
+	assertMultiAttackResults(t, response.GetResults(), names, step.Args)
+	assertMultiAttackAdversaryDeltas(t, ctx, env, state, adversariesBefore, names, expectedAdversary)
+	assertExpectedDeltas(t, ctx, env, state, expectedSpec, expectedBefore)
+}
+
+func runAdversaryMultiAttackStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	actorName := requiredString(step.Args, "actor")
+	if actorName == "" {
+		t.Fatal("adversary_attack requires actor")
+	}
+	adversaryID := adversaryID(t, state, actorName)
+	targets, names := buildMultiAttackTargets(t, state, step.Args, "adversary_attack")
+	stressCost := optionalInt(step.Args, "stress_cost", 0)
+
+	attackSeed := uint64(42)
+	if seed := optionalInt(step.Args, "seed", 0); seed > 0 {
+		attackSeed = uint64(seed)
+	}
+	damageSeed := attackSeed + 1
+
+	before := latestSeq(t, ctx, env, state)
+	attackerBefore := getAdversary(t, ctx, env, state, adversaryID)
+	response, err := env.daggerheartClient.SessionAdversaryMultiAttackFlow(ctx, &daggerheartv1.SessionAdversaryMultiAttackFlowRequest{
+		CampaignId:     state.campaignID,
+		SessionId:      state.sessionID,
+		AdversaryId:    adversaryID,
+		Targets:        targets,
+		AttackModifier: int32(optionalInt(step.Args, "attack_modifier", 0)),
+		Advantage:      int32(optionalInt(step.Args, "advantage", 0)),
+		Disadvantage:   int32(optionalInt(step.Args, "disadvantage", 0)),
+		DamageDice:     buildDamageDice(step.Args),
+		DamageModifier: int32(optionalInt(step.Args, "damage_modifier", 0)),
+		Damage:         buildDamageSpec(step.Args, "", "adversary_attack"),
+		StressCost:     int32(stressCost),
+		AttackRng: &commonv1.RngRequest{
+			Seed:     &attackSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+		DamageRng: &commonv1.RngRequest{
+			Seed:     &damageSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+	})
+	if err != nil {
+		t.Fatalf("adversary multi attack flow: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeMultiAttackResolved)
+	if stressCost > 0 {
+		attackerAfter := getAdversary(t, ctx, env, state, adversaryID)
+		if delta := int(attackerAfter.GetStress() - attackerBefore.GetStress()); delta != stressCost {
+			t.Fatalf("adversary stress delta for %s = %d, want %d", actorName, delta, stressCost)
+		}
+	}
+	if response.GetDamageRoll() != nil {
+		assertExpectedDamageRoll(t, ctx, env, state, response.GetDamageRoll().GetRollSeq(), step.Args)
+	}
+	assertMultiAttackResults(t, response.GetResults(), names, step.Args)
+}
+
+// buildMultiAttackTargets resolves the targets list, applying per-target
+// difficulties and armor choices. It returns the target names keyed by id.
+func buildMultiAttackTargets(t *testing.T, state *scenarioState, args map[string]any, stepName string) ([]*daggerheartv1.MultiAttackTarget, map[string]string) {
+	t.Helper()
+	targetNames := readStringSlice(args, "targets")
+	if len(targetNames) == 0 {
+		t.Fatalf("%s requires targets", stepName)
+	}
+	difficulties, _ := args["target_difficulties"].(map[string]any)
+	declined := make(map[string]bool)
+	for _, name := range readStringSlice(args, "decline_armor") {
+		declined[name] = true
+	}
+	defaultDifficulty, hasDefault := readInt(args, "difficulty")
+
+	targets := make([]*daggerheartv1.MultiAttackTarget, 0, len(targetNames))
+	names := make(map[string]string, len(targetNames))
+	for _, name := range targetNames {
+		id, _ := resolveTargetID(t, state, name)
+		target := &daggerheartv1.MultiAttackTarget{TargetId: id, DeclineArmor: declined[name]}
+		if difficulty, ok := readInt(difficulties, name); ok {
+			target.Difficulty = wrapperspb.Int32(int32(difficulty))
+		} else if hasDefault {
+			target.Difficulty = wrapperspb.Int32(int32(defaultDifficulty))
+		}
+		targets = append(targets, target)
+		names[id] = name
+	}
+	return targets, names
+}
+
+// multiAttackSeedDifficulty returns the difficulty used to pick an outcome
+// seed: the lowest explicit target difficulty, or the step difficulty.
+func multiAttackSeedDifficulty(targets []*daggerheartv1.MultiAttackTarget, args map[string]any) int {
+	difficulty := optionalInt(args, "difficulty", 10)
+	found := false
+	for _, target := range targets {
+		if target.GetDifficulty() == nil {
+			continue
+		}
+		value := int(target.GetDifficulty().GetValue())
+		if !found || value < difficulty {
+			difficulty = value
+			found = true
+		}
+	}
+	return difficulty
+}
+
+func captureMultiAttackAdversaries(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, names map[string]string) map[string]*daggerheartv1.DaggerheartAdversary {
+	t.Helper()
+	adversaries := make(map[string]*daggerheartv1.DaggerheartAdversary)
+	for _, name := range names {
+		id, isAdversary := resolveTargetID(t, state, name)
+		if !isAdversary {
+			continue
+		}
+		adversaries[id] = getAdversary(t, ctx, env, state, id)
+	}
+	return adversaries
+}
+
+func assertMultiAttackAdversaryDeltas(
+	t *testing.T,
+	ctx context.Context,
+	env scenarioEnv,
+	state *scenarioState,
+	before map[string]*daggerheartv1.DaggerheartAdversary,
+	names map[string]string,
+	expected map[string]expectedAdversaryDelta,
+) {
+	t.Helper()
+	for id, adversaryBefore := range before {
+		expectation, ok := expected[names[id]]
+		if !ok {
+			continue
+		}
+		after := getAdversary(t, ctx, env, state, id)
+		if expectation.hpDelta != nil {
+			if delta := int(after.GetHp()) - int(adversaryBefore.GetHp()); delta != *expectation.hpDelta {
+				t.Fatalf("adversary hp delta for %s = %d, want %d", names[id], delta, *expectation.hpDelta)
+			}
+		}
+		if expectation.armorDelta != nil {
+			if delta := int(after.GetArmor()) - int(adversaryBefore.GetArmor()); delta != *expectation.armorDelta {
+				t.Fatalf("adversary armor delta for %s = %d, want %d", names[id], delta, *expectation.armorDelta)
+			}
+		}
+	}
+}
+
+// assertMultiAttackResults checks expect_hits and expect_misses against the
+// per-target results of a multi-target attack.
+func assertMultiAttackResults(t *testing.T, results []*daggerheartv1.MultiAttackTargetResult, names map[string]string, args map[string]any) {
+	t.Helper()
+	hits := make(map[string]bool, len(results))
+	for _, result := range results {
+		hits[names[result.GetTargetId()]] = result.GetHit()
+	}
+	for _, name := range readStringSlice(args, "expect_hits") {
+		if hit, ok := hits[name]; !ok || !hit {
+			t.Fatalf("expected multi-target attack to hit %s", name)
+		}
+	}
+	for _, name := range readStringSlice(args, "expect_misses") {
+		if hit, ok := hits[name]; !ok || hit {
+			t.Fatalf("expected multi-target attack to miss %s", name)
+		}
+	}
+}
+
 func runCombinedDamageStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
 	ensureSession(t, ctx, env, state)
 	name := requiredString(step.Args, "target")
@@ -1198,6 +1476,7 @@ func runCombinedDamageStep(t *testing.T, ctx context.Context, env scenarioEnv, s
 	}
 
 	adversaryBefore := getAdversary(t, ctx, env, state, targetID)
+	overflowIDs := resolveAdversaryList(t, state, step.Args, "minion_overflow")
 	_, err := env.daggerheartClient.ApplyAdversaryDamage(ctxWithSession, &daggerheartv1.DaggerheartApplyAdversaryDamageRequest{
 		CampaignId:  state.campaignID,
 		AdversaryId: targetID,
@@ -1208,10 +1487,16 @@ func runCombinedDamageStep(t *testing.T, ctx context.Context, env scenarioEnv, s
 			sourceIDs,
 		),
 		RequireDamageRoll: false,
+		MinionOverflowIds: overflowIDs,
 	})
 	if err != nil {
 		t.Fatalf("combined_damage apply adversary damage: %v", err)
 	}
+	for _, overflowID := range overflowIDs {
+		if hp := getAdversary(t, ctx, env, state, overflowID).GetHp(); hp != 0 {
+			t.Fatalf("minion overflow target %s hp = %d, want 0", overflowID, hp)
+		}
+	}
 	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeAdversaryDamageApplied)
 	assertAdversaryDamageAppliedExpectations(t, ctx, env, state, before, targetID, step.Args)
 	adversaryAfter := getAdversary(t, ctx, env, state, targetID)
@@ -1247,6 +1532,10 @@ func runCombinedDamageStep(t *testing.T, ctx context.Context, env scenarioEnv, s
 
 func runAdversaryAttackStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
 	ensureSession(t, ctx, env, state)
+	if len(readStringSlice(step.Args, "targets")) > 0 {
+		runAdversaryMultiAttackStep(t, ctx, env, state, step)
+		return
+	}
 	actorName := requiredString(step.Args, "actor")
 	targetName := requiredString(step.Args, "target")
 	if actorName == "" || targetName == "" {
@@ -1278,6 +1567,7 @@ func runAdversaryAttackStep(t *testing.T, ctx context.Context, env scenarioEnv,
 		DamageDice:        buildDamageDice(step.Args),
 		Damage:            buildDamageSpec(step.Args, "", "adversary_attack"),
 		RequireDamageRoll: true,
+		GroupAdversaryIds: resolveAdversaryList(t, state, step.Args, "group"),
 		AttackRng: &commonv1.RngRequest{
 			Seed:     &attackSeed,
 			RollMode: commonv1.RollMode_REPLAY,
@@ -1366,70 +1656,701 @@ func runCountdownCreateStep(t *testing.T, ctx context.Context, env scenarioEnv,
 	if countdownID := optionalString(step.Args, "countdown_id", ""); countdownID != "" {
 		request.CountdownId = countdownID
 	}
+	request.TickPolicy = buildCountdownTickPolicy(t, step.Args)
 	response, err := env.daggerheartClient.CreateCountdown(ctx, request)
 	if err != nil {
-		t.Fatalf("countdown_create: %v", err)
-	}
-	if response.GetCountdown() == nil {
-		t.Fatal("expected countdown")
+		t.Fatalf("countdown_create: %v", err)
+	}
+	if response.GetCountdown() == nil {
+		t.Fatal("expected countdown")
+	}
+	state.countdowns[name] = response.GetCountdown().GetCountdownId()
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeCountdownCreated)
+}
+
+func runCountdownUpdateStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureSession(t, ctx, env, state)
+	countdownID := resolveCountdownID(t, state, step.Args)
+	if countdownID == "" {
+		t.Fatal("countdown_update countdown_id or name is required")
+	}
+
+	delta := optionalInt(step.Args, "delta", 0)
+	current, hasCurrent := readInt(step.Args, "current")
+	if delta == 0 && !hasCurrent {
+		t.Fatal("countdown_update requires delta or current")
+	}
+
+	request := &daggerheartv1.DaggerheartUpdateCountdownRequest{
+		CampaignId:  state.campaignID,
+		SessionId:   state.sessionID,
+		CountdownId: countdownID,
+		Delta:       int32(delta),
+		Reason:      optionalString(step.Args, "reason", ""),
+	}
+	if hasCurrent {
+		value := int32(current)
+		request.Current = &value
+	}
+
+	before := latestSeq(t, ctx, env, state)
+	_, err := env.daggerheartClient.UpdateCountdown(ctx, request)
+	if err != nil {
+		t.Fatalf("countdown_update: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeCountdownUpdated)
+}
+
+func runCountdownDeleteStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureSession(t, ctx, env, state)
+	countdownID := resolveCountdownID(t, state, step.Args)
+	if countdownID == "" {
+		t.Fatal("countdown_delete countdown_id or name is required")
+	}
+
+	before := latestSeq(t, ctx, env, state)
+	_, err := env.daggerheartClient.DeleteCountdown(ctx, &daggerheartv1.DaggerheartDeleteCountdownRequest{
+		CampaignId:  state.campaignID,
+		SessionId:   state.sessionID,
+		CountdownId: countdownID,
+		Reason:      optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		t.Fatalf("countdown_delete: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeCountdownDeleted)
+	if name := optionalString(step.Args, "name", ""); name != "" {
+		delete(state.countdowns, name)
+	}
+}
+
+func runCountdownExpectStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	countdownID := resolveCountdownID(t, state, step.Args)
+	if countdownID == "" {
+		t.Fatal("countdown_expect countdown_id or name is required")
+	}
+
+	if expected, ok := readInt(step.Args, "current"); ok {
+		if current := countdownCurrent(t, ctx, env, state, countdownID); current != expected {
+			t.Fatalf("countdown %s current = %d, want %d", countdownID, current, expected)
+		}
+	}
+	if expected, ok := step.Args["triggered"].(bool); ok {
+		response, err := env.eventClient.ListEvents(ctx, &gamev1.ListEventsRequest{
+			CampaignId: state.campaignID,
+			PageSize:   1,
+			OrderBy:    "seq desc",
+			Filter:     fmt.Sprintf("type = \"%s\" AND entity_id = \"%s\"", daggerheart.EventTypeCountdownTriggered, countdownID),
+		})
+		if err != nil {
+			t.Fatalf("list events for %s: %v", daggerheart.EventTypeCountdownTriggered, err)
+		}
+		if triggered := len(response.GetEvents()) > 0; triggered != expected {
+			t.Fatalf("countdown %s triggered = %v, want %v", countdownID, triggered, expected)
+		}
+	}
+}
+
+func runChaseStartStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureSession(t, ctx, env, state)
+	name := requiredString(step.Args, "name")
+	if name == "" {
+		t.Fatal("chase_start name is required")
+	}
+
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.StartChase(ctx, &daggerheartv1.DaggerheartStartChaseRequest{
+		CampaignId:  state.campaignID,
+		SessionId:   state.sessionID,
+		ChaseId:     optionalString(step.Args, "chase_id", ""),
+		Name:        name,
+		Progress:    buildChaseCountdownSpec(t, step.Args, "progress"),
+		Consequence: buildChaseCountdownSpec(t, step.Args, "consequence"),
+	})
+	if err != nil {
+		t.Fatalf("chase_start: %v", err)
+	}
+	chase := response.GetChase()
+	if chase == nil {
+		t.Fatal("expected chase")
+	}
+	state.chases[name] = chase.GetChaseId()
+	for _, countdown := range []*daggerheartv1.DaggerheartCountdown{chase.GetProgress(), chase.GetConsequence()} {
+		if countdown != nil {
+			state.countdowns[countdown.GetName()] = countdown.GetCountdownId()
+		}
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeCountdownCreated, daggerheart.EventTypeChaseStarted)
+}
+
+func runChaseExpectStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	chaseID := resolveChaseID(t, state, step.Args)
+	if chaseID == "" {
+		t.Fatal("chase_expect chase_id or name is required")
+	}
+	response, err := env.daggerheartClient.GetChase(ctx, &daggerheartv1.DaggerheartGetChaseRequest{
+		CampaignId: state.campaignID,
+		ChaseId:    chaseID,
+	})
+	if err != nil {
+		t.Fatalf("chase_expect: %v", err)
+	}
+	chase := response.GetChase()
+	if expected, ok := step.Args["status"].(string); ok {
+		status := strings.TrimPrefix(chase.GetStatus().String(), "DAGGERHEART_CHASE_STATUS_")
+		if !strings.EqualFold(status, expected) {
+			t.Fatalf("chase %s status = %s, want %s", chaseID, status, expected)
+		}
+	}
+	if expected, ok := step.Args["winner"].(string); ok {
+		winner := ""
+		if chase.GetWinner() != daggerheartv1.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_UNSPECIFIED {
+			winner = strings.TrimPrefix(chase.GetWinner().String(), "DAGGERHEART_CHASE_WINNER_")
+		}
+		if !strings.EqualFold(winner, expected) {
+			t.Fatalf("chase %s winner = %s, want %s", chaseID, winner, expected)
+		}
+	}
+	if expected, ok := readInt(step.Args, "progress"); ok {
+		if current := int(chase.GetProgress().GetCurrent()); current != expected {
+			t.Fatalf("chase %s progress = %d, want %d", chaseID, current, expected)
+		}
+	}
+	if expected, ok := readInt(step.Args, "consequence"); ok {
+		if current := int(chase.GetConsequence().GetCurrent()); current != expected {
+			t.Fatalf("chase %s consequence = %d, want %d", chaseID, current, expected)
+		}
+	}
+}
+
+func runSetRangeStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureSession(t, ctx, env, state)
+	from := sceneEntity(t, state, requiredString(step.Args, "from"), "set_range")
+	to := sceneEntity(t, state, requiredString(step.Args, "to"), "set_range")
+
+	before := latestSeq(t, ctx, env, state)
+	_, err := env.daggerheartClient.SetSceneRanges(ctx, &daggerheartv1.DaggerheartSetSceneRangesRequest{
+		CampaignId: state.campaignID,
+		SessionId:  state.sessionID,
+		Ranges: []*daggerheartv1.DaggerheartSceneRange{{
+			From: from,
+			To:   to,
+			Band: parseRangeBand(t, requiredString(step.Args, "band")),
+		}},
+	})
+	if err != nil {
+		t.Fatalf("set_range: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeSceneRangesSet)
+}
+
+func runMoveStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureSession(t, ctx, env, state)
+	entity := sceneEntity(t, state, requiredString(step.Args, "entity"), "move")
+	request := &daggerheartv1.DaggerheartMoveSceneEntityRequest{
+		CampaignId: state.campaignID,
+		SessionId:  state.sessionID,
+		Entity:     entity,
+		Kind:       parseMovementKind(t, optionalString(step.Args, "kind", "move")),
+		PushBands:  int32(optionalInt(step.Args, "push_bands", 0)),
+		Reason:     optionalString(step.Args, "reason", ""),
+	}
+	if source := optionalString(step.Args, "source", ""); source != "" {
+		request.Source = sceneEntity(t, state, source, "move")
+	}
+	ranges, _ := step.Args["ranges"].(map[string]any)
+	names := make([]string, 0, len(ranges))
+	for name := range ranges {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		band, _ := ranges[name].(string)
+		request.Ranges = append(request.Ranges, &daggerheartv1.DaggerheartSceneRangeUpdate{
+			Target: sceneEntity(t, state, name, "move"),
+			Band:   parseRangeBand(t, band),
+		})
+	}
+
+	before := latestSeq(t, ctx, env, state)
+	if _, err := env.daggerheartClient.MoveSceneEntity(ctx, request); err != nil {
+		t.Fatalf("move: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeSceneEntityMoved)
+}
+
+func runExpectRangeStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureSession(t, ctx, env, state)
+	from := sceneEntity(t, state, requiredString(step.Args, "from"), "expect_range")
+	to := sceneEntity(t, state, requiredString(step.Args, "to"), "expect_range")
+	want := parseRangeBand(t, requiredString(step.Args, "band"))
+
+	response, err := env.daggerheartClient.ListSceneRanges(ctx, &daggerheartv1.DaggerheartListSceneRangesRequest{
+		CampaignId: state.campaignID,
+		SessionId:  state.sessionID,
+		EntityId:   from.GetId(),
+	})
+	if err != nil {
+		t.Fatalf("expect_range: %v", err)
+	}
+	for _, sceneRange := range response.GetRanges() {
+		if sceneRange.GetFrom().GetId() == to.GetId() || sceneRange.GetTo().GetId() == to.GetId() {
+			if sceneRange.GetBand() != want {
+				t.Fatalf("range %s to %s = %s, want %s", step.Args["from"], step.Args["to"], sceneRange.GetBand(), want)
+			}
+			return
+		}
+	}
+	t.Fatalf("range %s to %s is not set", step.Args["from"], step.Args["to"])
+}
+
+func runEnvironmentStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureSession(t, ctx, env, state)
+	environmentID := requiredString(step.Args, "id")
+	if environmentID == "" {
+		t.Fatal("environment id is required")
+	}
+	request := &daggerheartv1.DaggerheartCreateSessionEnvironmentRequest{
+		CampaignId:    state.campaignID,
+		SessionId:     state.sessionID,
+		EnvironmentId: environmentID,
+	}
+	if difficulty, ok := readInt(step.Args, "difficulty"); ok {
+		request.Difficulty = wrapperspb.Int32(int32(difficulty))
+	}
+
+	before := latestSeq(t, ctx, env, state)
+	if _, err := env.daggerheartClient.CreateSessionEnvironment(ctx, request); err != nil {
+		t.Fatalf("environment: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeEnvironmentActivated)
+}
+
+func runEnvironmentShiftStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureSession(t, ctx, env, state)
+	environmentID := requiredString(step.Args, "id")
+	if environmentID == "" {
+		t.Fatal("environment_shift id is required")
+	}
+	request := &daggerheartv1.DaggerheartShiftSessionEnvironmentRequest{
+		CampaignId:    state.campaignID,
+		SessionId:     state.sessionID,
+		EnvironmentId: environmentID,
+		Reason:        optionalString(step.Args, "reason", ""),
+	}
+	if difficulty, ok := readInt(step.Args, "difficulty"); ok {
+		request.Difficulty = wrapperspb.Int32(int32(difficulty))
+	}
+
+	before := latestSeq(t, ctx, env, state)
+	if _, err := env.daggerheartClient.ShiftSessionEnvironment(ctx, request); err != nil {
+		t.Fatalf("environment_shift: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeEnvironmentShifted)
+}
+
+func runEnvironmentClearStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureSession(t, ctx, env, state)
+	before := latestSeq(t, ctx, env, state)
+	_, err := env.daggerheartClient.ClearSessionEnvironment(ctx, &daggerheartv1.DaggerheartClearSessionEnvironmentRequest{
+		CampaignId: state.campaignID,
+		SessionId:  state.sessionID,
+		Reason:     optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		t.Fatalf("environment_clear: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeEnvironmentCleared)
+}
+
+func runEnvironmentFeatureStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureSession(t, ctx, env, state)
+	featureID := requiredString(step.Args, "feature")
+	if featureID == "" {
+		t.Fatal("environment_feature feature is required")
+	}
+	fear := optionalInt(step.Args, "fear", 0)
+	request := &daggerheartv1.DaggerheartTriggerEnvironmentFeatureRequest{
+		CampaignId:  state.campaignID,
+		SessionId:   state.sessionID,
+		FeatureId:   featureID,
+		Kind:        parseEnvironmentFeatureKind(t, optionalString(step.Args, "kind", "action")),
+		FearCost:    int32(fear),
+		Description: optionalString(step.Args, "description", ""),
+	}
+	if spawnsRaw, ok := step.Args["spawns"]; ok {
+		spawnList, ok := spawnsRaw.([]any)
+		if !ok {
+			t.Fatal("environment_feature spawns must be a list")
+		}
+		for index, entry := range spawnList {
+			item, ok := entry.(map[string]any)
+			if !ok {
+				t.Fatalf("environment_feature spawn %d must be an object", index)
+			}
+			entryID := requiredString(item, "entry")
+			if entryID == "" {
+				t.Fatalf("environment_feature spawn %d requires entry", index)
+			}
+			request.Spawns = append(request.Spawns, &daggerheartv1.DaggerheartEnvironmentSpawn{
+				AdversaryEntryId: entryID,
+				Name:             optionalString(item, "name", ""),
+				Count:            int32(optionalInt(item, "count", 1)),
+			})
+		}
+	}
+
+	expectedSpec, expectedBefore := captureExpectedDeltas(t, ctx, env, state, step.Args, "")
+
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.TriggerEnvironmentFeature(ctx, request)
+	if err != nil {
+		t.Fatalf("environment_feature: %v", err)
+	}
+	if fear > 0 {
+		requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeGMFearChanged, daggerheart.EventTypeEnvironmentFeatureUsed)
+	} else {
+		requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeEnvironmentFeatureUsed)
+	}
+	if len(request.Spawns) > 0 {
+		requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeAdversaryCreated)
+	}
+	for _, adversary := range response.GetAdversaries() {
+		state.adversaries[adversary.GetName()] = adversary.GetId()
+	}
+	state.gmFear = int(response.GetGmFearAfter())
+	assertExpectedDeltas(t, ctx, env, state, expectedSpec, expectedBefore)
+}
+
+// sceneEntity resolves a scenario name to a positioned entity. Names that are
+// not characters or adversaries are treated as zones.
+func sceneEntity(t *testing.T, state *scenarioState, name, stepName string) *daggerheartv1.DaggerheartSceneEntity {
+	t.Helper()
+	if name == "" {
+		t.Fatalf("%s requires entity names", stepName)
+	}
+	for key, value := range state.actors {
+		if strings.EqualFold(key, name) {
+			return &daggerheartv1.DaggerheartSceneEntity{Id: value, Type: daggerheartv1.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_CHARACTER}
+		}
+	}
+	for key, value := range state.adversaries {
+		if strings.EqualFold(key, name) {
+			return &daggerheartv1.DaggerheartSceneEntity{Id: value, Type: daggerheartv1.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ADVERSARY}
+		}
+	}
+	return &daggerheartv1.DaggerheartSceneEntity{Id: name, Type: daggerheartv1.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ZONE}
+}
+
+func runAcquireItemStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
+	itemID := requiredString(step.Args, "item")
+	if itemID == "" {
+		t.Fatal("acquire_item item is required")
+	}
+	before := latestSeq(t, ctx, env, state)
+	_, err := env.daggerheartClient.AcquireItem(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartAcquireItemRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: actorID(t, state, requiredString(step.Args, "target")),
+		ItemId:      itemID,
+		Kind:        parseInventoryItemKind(t, optionalString(step.Args, "kind", "item")),
+		Quantity:    int32(optionalInt(step.Args, "quantity", 1)),
+		Source:      optionalString(step.Args, "source", ""),
+	})
+	if err != nil {
+		t.Fatalf("acquire_item: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeItemAcquired)
+}
+
+func runDropItemStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
+	itemID := requiredString(step.Args, "item")
+	if itemID == "" {
+		t.Fatal("drop_item item is required")
+	}
+	before := latestSeq(t, ctx, env, state)
+	_, err := env.daggerheartClient.DropItem(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartDropItemRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: actorID(t, state, requiredString(step.Args, "target")),
+		ItemId:      itemID,
+		Quantity:    int32(optionalInt(step.Args, "quantity", 1)),
+		Reason:      optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		t.Fatalf("drop_item: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeItemDropped)
+}
+
+func runTransferItemStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
+	itemID := requiredString(step.Args, "item")
+	if itemID == "" {
+		t.Fatal("transfer_item item is required")
+	}
+	before := latestSeq(t, ctx, env, state)
+	_, err := env.daggerheartClient.TransferItem(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartTransferItemRequest{
+		CampaignId:      state.campaignID,
+		FromCharacterId: actorID(t, state, requiredString(step.Args, "from")),
+		ToCharacterId:   actorID(t, state, requiredString(step.Args, "to")),
+		ItemId:          itemID,
+		Quantity:        int32(optionalInt(step.Args, "quantity", 1)),
+	})
+	if err != nil {
+		t.Fatalf("transfer_item: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeItemTransferred)
+}
+
+func runEquipStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
+	itemID := requiredString(step.Args, "item")
+	if itemID == "" {
+		t.Fatal("equip item is required")
+	}
+	slot := daggerheartv1.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED
+	if value := optionalString(step.Args, "slot", ""); value != "" {
+		slot = parseEquipSlot(t, value)
+	}
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.EquipItem(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartEquipItemRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: actorID(t, state, requiredString(step.Args, "target")),
+		ItemId:      itemID,
+		Slot:        slot,
+	})
+	if err != nil {
+		t.Fatalf("equip: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeItemEquipped)
+	assertEquipmentProfile(t, response.GetProfile(), step.Args)
+}
+
+func runUnequipStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.UnequipItem(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartUnequipItemRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: actorID(t, state, requiredString(step.Args, "target")),
+		Slot:        parseEquipSlot(t, requiredString(step.Args, "slot")),
+		Reason:      optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		t.Fatalf("unequip: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeItemUnequipped)
+	assertEquipmentProfile(t, response.GetProfile(), step.Args)
+}
+
+func runGoldStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.UpdateGold(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartUpdateGoldRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: actorID(t, state, requiredString(step.Args, "target")),
+		Handfuls:    int32(optionalInt(step.Args, "handfuls", 0)),
+		Bags:        int32(optionalInt(step.Args, "bags", 0)),
+		Chests:      int32(optionalInt(step.Args, "chests", 0)),
+		Reason:      optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		t.Fatalf("gold: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeGoldChanged)
+	gold := response.GetState().GetGold()
+	for _, check := range []struct {
+		key string
+		got int32
+	}{
+		{"expect_handfuls", gold.GetHandfuls()},
+		{"expect_bags", gold.GetBags()},
+		{"expect_chests", gold.GetChests()},
+	} {
+		if want, ok := readInt(step.Args, check.key); ok && int(check.got) != want {
+			t.Fatalf("gold %s = %d, want %d", check.key, check.got, want)
+		}
+	}
+}
+
+func runCompanionStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.CreateCompanion(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartCreateCompanionRequest{
+		CampaignId:    state.campaignID,
+		CharacterId:   actorID(t, state, requiredString(step.Args, "target")),
+		Name:          requiredString(step.Args, "name"),
+		ExperienceIds: readStringSlice(step.Args, "experiences"),
+	})
+	if err != nil {
+		t.Fatalf("companion: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeCompanionCreated)
+	assertCompanion(t, response.GetCompanion(), step.Args)
+}
+
+func runCompanionLevelUpStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.LevelUpCompanion(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartLevelUpCompanionRequest{
+		CampaignId:   state.campaignID,
+		CharacterId:  actorID(t, state, requiredString(step.Args, "target")),
+		Upgrade:      parseCompanionUpgrade(t, requiredString(step.Args, "upgrade")),
+		ExperienceId: optionalString(step.Args, "experience", ""),
+		ViciousRange: optionalBool(step.Args, "vicious_range", false),
+	})
+	if err != nil {
+		t.Fatalf("companion_level_up: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeCompanionLeveledUp)
+	assertCompanion(t, response.GetCompanion(), step.Args)
+}
+
+func runCompanionStressStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.UpdateCompanionStress(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartUpdateCompanionStressRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: actorID(t, state, requiredString(step.Args, "target")),
+		Delta:       int32(optionalInt(step.Args, "delta", 1)),
+		Reason:      optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		t.Fatalf("companion_stress: %v", err)
 	}
-	state.countdowns[name] = response.GetCountdown().GetCountdownId()
-	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeCountdownCreated)
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeCompanionStressChanged)
+	assertCompanion(t, response.GetCompanion(), step.Args)
 }
 
-func runCountdownUpdateStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+func runCompanionAttackStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
 	ensureSession(t, ctx, env, state)
-	countdownID := resolveCountdownID(t, state, step.Args)
-	if countdownID == "" {
-		t.Fatal("countdown_update countdown_id or name is required")
+	actorName := requiredString(step.Args, "actor")
+	if actorName == "" {
+		t.Fatal("companion_attack requires actor")
+	}
+	targetID, _ := resolveTargetID(t, state, requiredString(step.Args, "target"))
+	target := &daggerheartv1.MultiAttackTarget{TargetId: targetID}
+	if difficulty, ok := readInt(step.Args, "difficulty"); ok {
+		target.Difficulty = wrapperspb.Int32(int32(difficulty))
 	}
+	actionSeed := chooseActionSeed(t, step.Args, optionalInt(step.Args, "difficulty", 10))
+	damageSeed := actionSeed + 1
 
-	delta := optionalInt(step.Args, "delta", 0)
-	current, hasCurrent := readInt(step.Args, "current")
-	if delta == 0 && !hasCurrent {
-		t.Fatal("countdown_update requires delta or current")
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.SessionCompanionAttackFlow(ctx, &daggerheartv1.SessionCompanionAttackFlowRequest{
+		CampaignId:     state.campaignID,
+		SessionId:      state.sessionID,
+		CharacterId:    actorID(t, state, actorName),
+		Trait:          optionalString(step.Args, "trait", ""),
+		Target:         target,
+		Modifiers:      buildActionRollModifiers(step.Args, "modifiers"),
+		DamageModifier: int32(optionalInt(step.Args, "damage_modifier", 0)),
+		ActionRng: &commonv1.RngRequest{
+			Seed:     &actionSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+		DamageRng: &commonv1.RngRequest{
+			Seed:     &damageSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+	})
+	if err != nil {
+		t.Fatalf("companion_attack: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeMultiAttackResolved)
+	if want, ok := readBool(step.Args, "expect_hit"); ok && response.GetResult().GetHit() != want {
+		t.Fatalf("companion_attack hit = %t, want %t", response.GetResult().GetHit(), want)
 	}
+}
 
-	request := &daggerheartv1.DaggerheartUpdateCountdownRequest{
+func runBeastformStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.EnterBeastform(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartEnterBeastformRequest{
 		CampaignId:  state.campaignID,
-		SessionId:   state.sessionID,
-		CountdownId: countdownID,
-		Delta:       int32(delta),
-		Reason:      optionalString(step.Args, "reason", ""),
+		CharacterId: actorID(t, state, requiredString(step.Args, "target")),
+		BeastformId: requiredString(step.Args, "form"),
+	})
+	if err != nil {
+		t.Fatalf("beastform: %v", err)
 	}
-	if hasCurrent {
-		value := int32(current)
-		request.Current = &value
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeBeastformEntered)
+	if want, ok := readInt(step.Args, "expect_stress"); ok && int(response.GetState().GetStress()) != want {
+		t.Fatalf("beastform stress = %d, want %d", response.GetState().GetStress(), want)
+	}
+	if want, ok := readInt(step.Args, "expect_evasion"); ok && int(response.GetEvasion()) != want {
+		t.Fatalf("beastform evasion = %d, want %d", response.GetEvasion(), want)
 	}
+}
 
+func runBeastformExitStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
 	before := latestSeq(t, ctx, env, state)
-	_, err := env.daggerheartClient.UpdateCountdown(ctx, request)
+	_, err := env.daggerheartClient.ExitBeastform(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartExitBeastformRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: actorID(t, state, requiredString(step.Args, "target")),
+		Reason:      optionalString(step.Args, "reason", ""),
+	})
 	if err != nil {
-		t.Fatalf("countdown_update: %v", err)
+		t.Fatalf("beastform_exit: %v", err)
 	}
-	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeCountdownUpdated)
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeBeastformExited)
 }
 
-func runCountdownDeleteStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
-	ensureSession(t, ctx, env, state)
-	countdownID := resolveCountdownID(t, state, step.Args)
-	if countdownID == "" {
-		t.Fatal("countdown_delete countdown_id or name is required")
+func runEffectStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
+	targetID, isAdversary := resolveTargetID(t, state, requiredString(step.Args, "target"))
+	targetType := daggerheartv1.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_CHARACTER
+	if isAdversary {
+		targetType = daggerheartv1.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_ADVERSARY
+	}
+	before := latestSeq(t, ctx, env, state)
+	response, err := env.daggerheartClient.ApplyEffect(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartApplyEffectRequest{
+		CampaignId: state.campaignID,
+		EffectId:   optionalString(step.Args, "id", ""),
+		TargetType: targetType,
+		TargetId:   targetID,
+		Source:     optionalString(step.Args, "source", ""),
+		Kind:       parseEffectKind(t, requiredString(step.Args, "kind")),
+		Value:      int32(optionalInt(step.Args, "value", 1)),
+		Stacking:   parseEffectStacking(t, optionalString(step.Args, "stacking", "stack")),
+		ExpiresOn:  parseEffectExpiry(t, optionalString(step.Args, "expires_on", "manual")),
+	})
+	if err != nil {
+		t.Fatalf("effect: %v", err)
+	}
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeEffectApplied)
+	if want, ok := readInt(step.Args, "expect_replaced"); ok && len(response.GetReplacedEffectIds()) != want {
+		t.Fatalf("effect replaced = %d, want %d", len(response.GetReplacedEffectIds()), want)
 	}
+	assertEffectArmor(t, ctx, env, state, step, targetID)
+}
 
+func runEffectRemoveStep(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step) {
+	ensureCampaign(t, state)
 	before := latestSeq(t, ctx, env, state)
-	_, err := env.daggerheartClient.DeleteCountdown(ctx, &daggerheartv1.DaggerheartDeleteCountdownRequest{
-		CampaignId:  state.campaignID,
-		SessionId:   state.sessionID,
-		CountdownId: countdownID,
-		Reason:      optionalString(step.Args, "reason", ""),
+	_, err := env.daggerheartClient.RemoveEffect(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartRemoveEffectRequest{
+		CampaignId: state.campaignID,
+		EffectId:   requiredString(step.Args, "id"),
 	})
 	if err != nil {
-		t.Fatalf("countdown_delete: %v", err)
+		t.Fatalf("effect_remove: %v", err)
 	}
-	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeCountdownDeleted)
-	if name := optionalString(step.Args, "name", ""); name != "" {
-		delete(state.countdowns, name)
+	requireEventTypesAfterSeq(t, ctx, env, state, before, daggerheart.EventTypeEffectExpired)
+	if _, ok := readInt(step.Args, "expect_armor"); ok {
+		assertEffectArmor(t, ctx, env, state, step, actorID(t, state, requiredString(step.Args, "target")))
+	}
+}
+
+// assertEffectArmor checks expect_armor against a character's Armor Slots.
+func assertEffectArmor(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, step Step, characterID string) {
+	want, ok := readInt(step.Args, "expect_armor")
+	if !ok {
+		return
+	}
+	current := getCharacterState(t, ctx, env, state, characterID)
+	if int(current.GetArmor()) != want {
+		t.Fatalf("effect armor = %d, want %d", current.GetArmor(), want)
 	}
 }
 
@@ -1448,13 +2369,17 @@ func runActionRollStep(t *testing.T, ctx context.Context, env scenarioEnv, state
 
 	before := latestSeq(t, ctx, env, state)
 	response, err := env.daggerheartClient.SessionActionRoll(ctx, &daggerheartv1.SessionActionRollRequest{
-		CampaignId:  state.campaignID,
-		SessionId:   state.sessionID,
-		CharacterId: actorID(t, state, actorName),
-		Trait:       trait,
-		RollKind:    daggerheartv1.RollKind_ROLL_KIND_ACTION,
-		Difficulty:  int32(difficulty),
-		Modifiers:   buildActionRollModifiers(step.Args, "modifiers"),
+		CampaignId:         state.campaignID,
+		SessionId:          state.sessionID,
+		CharacterId:        actorID(t, state, actorName),
+		Trait:              trait,
+		RollKind:           daggerheartv1.RollKind_ROLL_KIND_ACTION,
+		Difficulty:         int32(difficulty),
+		Modifiers:          buildActionRollModifiers(step.Args, "modifiers"),
+		AdvantageSources:   buildAdvantageSources(step.Args, "advantage_sources"),
+		HelperCharacterIds: resolveCharacterList(t, state, step.Args, "helpers"),
+		Experiences:        readStringSlice(step.Args, "experiences"),
+		ExtraFearDie:       optionalBool(step.Args, "extra_fear_die", false),
 		Rng: &commonv1.RngRequest{
 			Seed:     &seed,
 			RollMode: commonv1.RollMode_REPLAY,
@@ -1483,13 +2408,14 @@ func runReactionRollStep(t *testing.T, ctx context.Context, env scenarioEnv, sta
 
 	before := latestSeq(t, ctx, env, state)
 	response, err := env.daggerheartClient.SessionActionRoll(ctx, &daggerheartv1.SessionActionRollRequest{
-		CampaignId:  state.campaignID,
-		SessionId:   state.sessionID,
-		CharacterId: actorID(t, state, actorName),
-		Trait:       trait,
-		RollKind:    daggerheartv1.RollKind_ROLL_KIND_REACTION,
-		Difficulty:  int32(difficulty),
-		Modifiers:   buildActionRollModifiers(step.Args, "modifiers"),
+		CampaignId:       state.campaignID,
+		SessionId:        state.sessionID,
+		CharacterId:      actorID(t, state, actorName),
+		Trait:            trait,
+		RollKind:         daggerheartv1.RollKind_ROLL_KIND_REACTION,
+		Difficulty:       int32(difficulty),
+		Modifiers:        buildActionRollModifiers(step.Args, "modifiers"),
+		AdvantageSources: buildAdvantageSources(step.Args, "advantage_sources"),
 		Rng: &commonv1.RngRequest{
 			Seed:     &seed,
 			RollMode: commonv1.RollMode_REPLAY,
@@ -1878,6 +2804,9 @@ func applyDefaultDaggerheartProfile(t *testing.T, ctx context.Context, env scena
 	applyTraitValue(profile, "instinct", args)
 	applyTraitValue(profile, "presence", args)
 	applyTraitValue(profile, "knowledge", args)
+	profile.Experiences = buildProfileExperiences(args, "experiences")
+	profile.ClassId = optionalString(args, "class", "")
+	profile.SubclassId = optionalString(args, "subclass", "")
 
 	_, err := env.characterClient.PatchCharacterProfile(ctx, &gamev1.PatchCharacterProfileRequest{
 		CampaignId:  state.campaignID,
@@ -2045,6 +2974,8 @@ func matchesOutcomeHint(result daggerheartdomain.ActionResult, hint string) bool
 		return result.IsCrit
 	case "failure_hope":
 		return result.Outcome == daggerheartdomain.OutcomeFailureWithHope
+	case "success_fear":
+		return result.Outcome == daggerheartdomain.OutcomeSuccessWithFear
 	default:
 		return false
 	}
@@ -2477,6 +3408,64 @@ func resolveOutcomeSeed(t *testing.T, args map[string]any, key string, difficult
 	return chooseActionSeed(t, map[string]any{"outcome": hint}, difficulty)
 }
 
+func buildProfileExperiences(args map[string]any, key string) []*daggerheartv1.DaggerheartExperience {
+	list, ok := args[key].([]any)
+	if !ok || len(list) == 0 {
+		return nil
+	}
+	experiences := make([]*daggerheartv1.DaggerheartExperience, 0, len(list))
+	for _, entry := range list {
+		item, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		name := optionalString(item, "name", "")
+		if name == "" {
+			continue
+		}
+		experiences = append(experiences, &daggerheartv1.DaggerheartExperience{
+			Name:     name,
+			Modifier: int32(optionalInt(item, "modifier", 0)),
+		})
+	}
+	return experiences
+}
+
+func buildAdvantageSources(args map[string]any, key string) []*daggerheartv1.AdvantageSource {
+	list, ok := args[key].([]any)
+	if !ok || len(list) == 0 {
+		return nil
+	}
+	sources := make([]*daggerheartv1.AdvantageSource, 0, len(list))
+	for _, entry := range list {
+		item, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		sources = append(sources, &daggerheartv1.AdvantageSource{
+			Kind:         parseAdvantageSourceKind(optionalString(item, "kind", "gm_ruling")),
+			Name:         optionalString(item, "name", ""),
+			Disadvantage: optionalBool(item, "disadvantage", false),
+		})
+	}
+	return sources
+}
+
+func parseAdvantageSourceKind(value string) daggerheartv1.AdvantageSourceKind {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "condition":
+		return daggerheartv1.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_CONDITION
+	case "target":
+		return daggerheartv1.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_TARGET
+	case "feature":
+		return daggerheartv1.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_FEATURE
+	case "gm_ruling":
+		return daggerheartv1.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_GM_RULING
+	default:
+		return daggerheartv1.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_UNSPECIFIED
+	}
+}
+
 func buildActionRollModifiers(args map[string]any, key string) []*daggerheartv1.ActionRollModifier {
 	if args == nil {
 		return nil
@@ -2540,6 +3529,36 @@ func buildDamageDice(args map[string]any) []*daggerheartv1.DiceSpec {
 	return results
 }
 
+// attackWeaponArg reads an attack step's weapon: a catalog weapon ID, or
+// "equipped" for the attacker's equipped primary weapon.
+func attackWeaponArg(args map[string]any) (string, bool) {
+	weapon := strings.TrimSpace(optionalString(args, "weapon", ""))
+	if weapon == "" || weapon == "equipped" {
+		return "", weapon != ""
+	}
+	return weapon, true
+}
+
+// applyAttackWeaponArgs lets the weapon supply the trait, damage dice and
+// damage type unless the step sets them.
+func applyAttackWeaponArgs(request *daggerheartv1.SessionAttackFlowRequest, args map[string]any) {
+	request.Improvised = optionalBool(args, "improvised", false)
+	weaponID, useWeapon := attackWeaponArg(args)
+	if !useWeapon {
+		return
+	}
+	request.WeaponId = weaponID
+	if _, ok := args["trait"]; !ok {
+		request.Trait = ""
+	}
+	if _, ok := args["damage_dice"]; !ok {
+		request.DamageDice = nil
+	}
+	if _, ok := args["damage_type"]; !ok {
+		request.Damage.DamageType = daggerheartv1.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_UNSPECIFIED
+	}
+}
+
 func buildDamageSpec(args map[string]any, actorID, source string) *daggerheartv1.DaggerheartAttackDamageSpec {
 	damageType := parseDamageType(optionalString(args, "damage_type", "physical"))
 	spec := &daggerheartv1.DaggerheartAttackDamageSpec{DamageType: damageType}
@@ -3867,6 +4886,18 @@ func resolveCharacterList(t *testing.T, state *scenarioState, args map[string]an
 	return ids
 }
 
+func resolveAdversaryList(t *testing.T, state *scenarioState, args map[string]any, key string) []string {
+	list := readStringSlice(args, key)
+	if len(list) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(list))
+	for _, name := range list {
+		ids = append(ids, adversaryID(t, state, name))
+	}
+	return ids
+}
+
 func allActorIDs(state *scenarioState) []string {
 	if len(state.actors) == 0 {
 		return nil
@@ -3895,6 +4926,56 @@ func parseRestType(t *testing.T, value string) daggerheartv1.DaggerheartRestType
 	}
 }
 
+func parseEffectKind(t *testing.T, value string) daggerheartv1.DaggerheartEffectKind {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "armor":
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ARMOR
+	case "evasion":
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_EVASION
+	case "roll":
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ROLL
+	case "damage":
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_DAMAGE
+	case "hope_die":
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_HOPE_DIE
+	default:
+		t.Fatalf("unsupported effect kind %q", value)
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_UNSPECIFIED
+	}
+}
+
+func parseEffectStacking(t *testing.T, value string) daggerheartv1.DaggerheartEffectStacking {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "stack":
+		return daggerheartv1.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_STACK
+	case "highest":
+		return daggerheartv1.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_HIGHEST
+	case "replace":
+		return daggerheartv1.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_REPLACE
+	default:
+		t.Fatalf("unsupported effect stacking %q", value)
+		return daggerheartv1.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_UNSPECIFIED
+	}
+}
+
+func parseEffectExpiry(t *testing.T, value string) daggerheartv1.DaggerheartEffectExpiry {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "manual":
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_MANUAL
+	case "rest":
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_REST
+	case "long_rest":
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_LONG_REST
+	case "scene_end":
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_SCENE_END
+	case "next_attack":
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK
+	default:
+		t.Fatalf("unsupported effect expiry %q", value)
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_UNSPECIFIED
+	}
+}
+
 func parseCountdownKind(t *testing.T, value string) daggerheartv1.DaggerheartCountdownKind {
 	switch strings.ToLower(strings.TrimSpace(value)) {
 	case "progress":
@@ -3909,6 +4990,132 @@ func parseCountdownKind(t *testing.T, value string) daggerheartv1.DaggerheartCou
 	}
 }
 
+func parseRangeBand(t *testing.T, value string) daggerheartv1.DaggerheartRangeBand {
+	band, err := daggerheart.NormalizeRangeBand(value)
+	if err != nil {
+		t.Fatalf("unsupported range band %q", value)
+	}
+	switch band {
+	case daggerheart.RangeMelee:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_MELEE
+	case daggerheart.RangeVeryClose:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_VERY_CLOSE
+	case daggerheart.RangeClose:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_CLOSE
+	case daggerheart.RangeFar:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_FAR
+	case daggerheart.RangeVeryFar:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_VERY_FAR
+	default:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_OUT_OF_RANGE
+	}
+}
+
+func parseMovementKind(t *testing.T, value string) daggerheartv1.DaggerheartMovementKind {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "move":
+		return daggerheartv1.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_MOVE
+	case "knockback":
+		return daggerheartv1.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_KNOCKBACK
+	case "teleport":
+		return daggerheartv1.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_TELEPORT
+	case "forced":
+		return daggerheartv1.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_FORCED
+	default:
+		t.Fatalf("unsupported movement kind %q", value)
+		return daggerheartv1.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_UNSPECIFIED
+	}
+}
+
+func parseEnvironmentFeatureKind(t *testing.T, value string) daggerheartv1.DaggerheartEnvironmentFeatureKind {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "action":
+		return daggerheartv1.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_ACTION
+	case "reaction":
+		return daggerheartv1.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_REACTION
+	default:
+		t.Fatalf("unsupported environment feature kind %q", value)
+		return daggerheartv1.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_UNSPECIFIED
+	}
+}
+
+func parseInventoryItemKind(t *testing.T, value string) daggerheartv1.DaggerheartInventoryItemKind {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "weapon":
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_WEAPON
+	case "armor":
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ARMOR
+	case "item":
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ITEM
+	case "loot":
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_LOOT
+	default:
+		t.Fatalf("unsupported inventory kind %q", value)
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_UNSPECIFIED
+	}
+}
+
+func parseEquipSlot(t *testing.T, value string) daggerheartv1.DaggerheartEquipSlot {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "armor":
+		return daggerheartv1.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_ARMOR
+	case "primary", "primary_weapon":
+		return daggerheartv1.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_PRIMARY_WEAPON
+	case "secondary", "secondary_weapon":
+		return daggerheartv1.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_SECONDARY_WEAPON
+	default:
+		t.Fatalf("unsupported equip slot %q", value)
+		return daggerheartv1.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED
+	}
+}
+
+func assertEquipmentProfile(t *testing.T, profile *daggerheartv1.DaggerheartProfile, args map[string]any) {
+	for _, check := range []struct {
+		key string
+		got int32
+	}{
+		{"expect_armor_score", profile.GetArmorScore().GetValue()},
+		{"expect_major", profile.GetMajorThreshold().GetValue()},
+		{"expect_severe", profile.GetSevereThreshold().GetValue()},
+	} {
+		if want, ok := readInt(args, check.key); ok && int(check.got) != want {
+			t.Fatalf("equipment %s = %d, want %d", check.key, check.got, want)
+		}
+	}
+}
+
+func parseCompanionUpgrade(t *testing.T, value string) daggerheartv1.DaggerheartCompanionUpgrade {
+	name := strings.ToUpper(strings.TrimSpace(value))
+	upgrade, ok := daggerheartv1.DaggerheartCompanionUpgrade_value["DAGGERHEART_COMPANION_UPGRADE_"+name]
+	if !ok || upgrade == 0 {
+		t.Fatalf("unsupported companion upgrade %q", value)
+	}
+	return daggerheartv1.DaggerheartCompanionUpgrade(upgrade)
+}
+
+func assertCompanion(t *testing.T, companion *daggerheartv1.DaggerheartCompanion, args map[string]any) {
+	for _, check := range []struct {
+		key string
+		got int32
+	}{
+		{"expect_level", companion.GetLevel()},
+		{"expect_evasion", companion.GetEvasion()},
+		{"expect_stress", companion.GetStress()},
+		{"expect_stress_max", companion.GetStressMax()},
+		{"expect_damage_die", companion.GetDamageDieSides()},
+	} {
+		if want, ok := readInt(args, check.key); ok && int(check.got) != want {
+			t.Fatalf("companion %s = %d, want %d", check.key, check.got, want)
+		}
+	}
+	if want := optionalString(args, "expect_range", ""); want != "" && companion.GetRange() != want {
+		t.Fatalf("companion range = %q, want %q", companion.GetRange(), want)
+	}
+	if want, ok := readBool(args, "expect_out_of_scene"); ok && companion.GetOutOfScene() != want {
+		t.Fatalf("companion out_of_scene = %t, want %t", companion.GetOutOfScene(), want)
+	}
+}
+
 func parseCountdownDirection(t *testing.T, value string) daggerheartv1.DaggerheartCountdownDirection {
 	switch strings.ToLower(strings.TrimSpace(value)) {
 	case "increase":
@@ -3921,6 +5128,118 @@ func parseCountdownDirection(t *testing.T, value string) daggerheartv1.Daggerhea
 	}
 }
 
+func parseCountdownTrigger(t *testing.T, value string) daggerheartv1.DaggerheartCountdownTrigger {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "action_roll":
+		return daggerheartv1.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL
+	case "rest":
+		return daggerheartv1.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_REST
+	case "fear_spent":
+		return daggerheartv1.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_FEAR_SPENT
+	case "major_damage":
+		return daggerheartv1.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_MAJOR_DAMAGE
+	default:
+		t.Fatalf("unsupported countdown trigger %q", value)
+		return daggerheartv1.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_UNSPECIFIED
+	}
+}
+
+func buildCountdownTickPolicy(t *testing.T, args map[string]any) *daggerheartv1.DaggerheartCountdownTickPolicy {
+	tick := optionalString(args, "tick", "")
+	if tick == "" {
+		return nil
+	}
+	return &daggerheartv1.DaggerheartCountdownTickPolicy{
+		Trigger:       parseCountdownTrigger(t, tick),
+		Delta:         int32(optionalInt(args, "tick_delta", 0)),
+		OutcomeDeltas: parseCountdownOutcomeDeltas(t, args, "tick_outcomes"),
+	}
+}
+
+func parseCountdownOutcomeDeltas(t *testing.T, args map[string]any, key string) []*daggerheartv1.DaggerheartCountdownOutcomeDelta {
+	t.Helper()
+	outcomes, _ := args[key].(map[string]any)
+	keys := make([]string, 0, len(outcomes))
+	for name := range outcomes {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	deltas := make([]*daggerheartv1.DaggerheartCountdownOutcomeDelta, 0, len(keys))
+	for _, name := range keys {
+		outcome, ok := daggerheartv1.Outcome_value[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			t.Fatalf("unsupported countdown outcome %q", name)
+		}
+		delta, _ := readInt(outcomes, name)
+		deltas = append(deltas, &daggerheartv1.DaggerheartCountdownOutcomeDelta{
+			Outcome: daggerheartv1.Outcome(outcome),
+			Delta:   int32(delta),
+		})
+	}
+	return deltas
+}
+
+func buildChaseCountdownSpec(t *testing.T, args map[string]any, side string) *daggerheartv1.DaggerheartChaseCountdownSpec {
+	t.Helper()
+	maxValue := optionalInt(args, side+"_max", 0)
+	if maxValue <= 0 {
+		maxValue = 4
+	}
+	return &daggerheartv1.DaggerheartChaseCountdownSpec{
+		Name:          optionalString(args, side+"_name", ""),
+		Max:           int32(maxValue),
+		OutcomeDeltas: parseCountdownOutcomeDeltas(t, args, side+"_outcomes"),
+	}
+}
+
+func resolveChaseID(t *testing.T, state *scenarioState, args map[string]any) string {
+	if chaseID := optionalString(args, "chase_id", ""); chaseID != "" {
+		return chaseID
+	}
+	name := optionalString(args, "name", "")
+	if name == "" {
+		return ""
+	}
+	chaseID, ok := state.chases[name]
+	if !ok {
+		t.Fatalf("unknown chase %q", name)
+	}
+	return chaseID
+}
+
+func countdownCurrent(t *testing.T, ctx context.Context, env scenarioEnv, state *scenarioState, countdownID string) int {
+	t.Helper()
+	for _, eventType := range []event.Type{daggerheart.EventTypeCountdownUpdated, daggerheart.EventTypeCountdownCreated} {
+		response, err := env.eventClient.ListEvents(ctx, &gamev1.ListEventsRequest{
+			CampaignId: state.campaignID,
+			PageSize:   1,
+			OrderBy:    "seq desc",
+			Filter:     fmt.Sprintf("type = \"%s\" AND entity_id = \"%s\"", eventType, countdownID),
+		})
+		if err != nil {
+			t.Fatalf("list events for %s: %v", eventType, err)
+		}
+		if len(response.GetEvents()) == 0 {
+			continue
+		}
+		payloadJSON := response.GetEvents()[0].GetPayloadJson()
+		if eventType == daggerheart.EventTypeCountdownUpdated {
+			var payload daggerheart.CountdownUpdatedPayload
+			if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+				t.Fatalf("decode countdown update payload: %v", err)
+			}
+			return payload.After
+		}
+		var payload daggerheart.CountdownCreatedPayload
+		if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+			t.Fatalf("decode countdown created payload: %v", err)
+		}
+		return payload.Current
+	}
+	t.Fatalf("countdown %s not found", countdownID)
+	return 0
+}
+
 func parseDowntimeMove(t *testing.T, value string) daggerheartv1.DaggerheartDowntimeMove {
 	switch strings.ToLower(strings.TrimSpace(value)) {
 	case "clear_all_stress":
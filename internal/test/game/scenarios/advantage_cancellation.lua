@@ -13,8 +13,18 @@ scene:pc("Frodo")
 -- Two sources of advantage and one of disadvantage resolve to advantage.
 scene:start_session("Advantage Cancellation")
 
--- Missing DSL: apply multiple advantage/disadvantage sources to a single roll.
-scene:action_roll{ actor = "Frodo", trait = "presence", difficulty = 12, outcome = "hope" }
+-- Hidden and a Vulnerable target cancel against darkness, leaving one advantage die.
+scene:action_roll{
+  actor = "Frodo",
+  trait = "presence",
+  difficulty = 12,
+  outcome = "hope",
+  advantage_sources = {
+    { kind = "condition", name = "Hidden" },
+    { kind = "target", name = "Vulnerable" },
+    { kind = "gm_ruling", name = "Darkness", disadvantage = true }
+  }
+}
 
 scene:end_session()
 
@@ -0,0 +1,26 @@
+local scene = Scenario.new("beastform_druid_pack_predator")
+
+-- A Druid marks Stress to become a Pack Predator, attacks with its bite and returns.
+scene:campaign{
+  name = "Beastform Druid Pack Predator",
+  system = "DAGGERHEART",
+  gm_mode = "HUMAN",
+  theme = "beastform"
+}
+
+scene:pc("Willow", { class = "class.druid", strength = 1 })
+scene:adversary("Bandit")
+
+scene:start_session("Wilds")
+
+-- Pack Predator costs 1 Stress and adds 1 to Evasion.
+scene:beastform{ target = "Willow", form = "beastform.pack-predator", expect_stress = 1, expect_evasion = 11 }
+
+-- The form rolls Strength +2 and deals d8+2 physical damage per Proficiency.
+scene:attack{ actor = "Willow", target = "Bandit", weapon = "equipped", difficulty = 0, outcome = "hope" }
+
+scene:beastform_exit{ target = "Willow" }
+
+scene:end_session()
+
+return scene
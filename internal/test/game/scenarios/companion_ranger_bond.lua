@@ -0,0 +1,39 @@
+local scene = Scenario.new("companion_ranger_bond")
+
+-- A Beastbound ranger bonds with a companion that attacks, takes Stress and grows.
+scene:campaign{
+  name = "Companion Ranger Bond",
+  system = "DAGGERHEART",
+  gm_mode = "HUMAN",
+  theme = "companion"
+}
+
+scene:pc("Rowan", { level = 2, agility = 2 })
+scene:adversary("Wolf")
+
+scene:companion{
+  target = "Rowan",
+  name = "Ash",
+  experiences = { "companion-experience.expert-climber", "companion-experience.loyal-until-the-end" },
+  expect_level = 1,
+  expect_evasion = 10,
+  expect_stress_max = 3,
+  expect_damage_die = 6,
+  expect_range = "melee"
+}
+
+scene:start_session("Hunt")
+
+-- The ranger rolls Agility for the companion; a hit deals the companion's d6.
+scene:companion_attack{ actor = "Rowan", target = "Wolf", trait = "agility", difficulty = 0, outcome = "hope", expect_hit = true }
+
+-- The companion marks Stress instead of taking damage; on its last Stress it leaves the scene.
+scene:companion_stress{ target = "Rowan", delta = 3, reason = "wolf_bite", expect_stress = 3, expect_out_of_scene = true }
+scene:companion_stress{ target = "Rowan", delta = -1, reason = "short_rest", expect_stress = 2, expect_out_of_scene = false }
+
+scene:end_session()
+
+-- Vicious steps the damage die up from d6 to d8.
+scene:companion_level_up{ target = "Rowan", upgrade = "vicious", expect_level = 2, expect_damage_die = 8 }
+
+return scene
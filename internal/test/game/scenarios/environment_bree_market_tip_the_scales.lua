@@ -13,8 +13,17 @@ scene:pc("Frodo")
 -- A handful of gold buys advantage on a Presence roll.
 scene:start_session("Tip the Scales")
 
--- Missing DSL: spend gold and apply advantage die.
-scene:action_roll{ actor = "Frodo", trait = "presence", difficulty = 10, outcome = "hope" }
+scene:gold{ target = "Frodo", handfuls = 2, reason = "purse", expect_handfuls = 2 }
+scene:gold{ target = "Frodo", handfuls = -1, reason = "tip_the_scales", expect_handfuls = 1 }
+scene:action_roll{
+  actor = "Frodo",
+  trait = "presence",
+  difficulty = 10,
+  outcome = "hope",
+  advantage_sources = {
+    { kind = "feature", name = "Tip the Scales" }
+  }
+}
 
 scene:end_session()
 
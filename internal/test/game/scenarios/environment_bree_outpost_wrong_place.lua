@@ -9,17 +9,28 @@ scene:campaign{
 }
 
 scene:pc("Frodo")
-scene:adversary("Orc Captain")
-scene:adversary("Orc Lackeys")
-scene:adversary("Orc Lieutenant")
 
 -- Thieves emerge at close range when the party is isolated.
 scene:start_session("Wrong Place, Wrong Time")
+scene:environment{ id = "environment.outpost-town" }
 scene:gm_fear(1)
 
--- Example: spend Fear to introduce a robber group at Close range.
--- Missing DSL: spawn multiple adversaries based on party size.
-scene:gm_spend_fear(1):spotlight("Orc Captain")
+-- Spend Fear to introduce a robber group: a kneebreaker, one lackey per PC, and a lieutenant.
+scene:environment_feature{
+  feature = "feature.environment-outpost-town-wr-on-g-place-wr-on-g-time",
+  kind = "reaction",
+  fear = 1,
+  spawns = {
+    { entry = "adversary.jagged-knife-kneebreaker", name = "Kneebreaker" },
+    { entry = "adversary.jagged-knife-lackey", name = "Lackey", count = 1 },
+    { entry = "adversary.jagged-knife-lieutenant", name = "Lieutenant" }
+  },
+  expect_gm_fear_delta = -1
+}
+scene:set_range{ from = "Frodo", to = "Kneebreaker", band = "close" }
+scene:set_range{ from = "Frodo", to = "Lackey", band = "close" }
+scene:set_range{ from = "Frodo", to = "Lieutenant", band = "close" }
+scene:gm_spend_fear(0):spotlight("Lieutenant")
 
 scene:end_session()
 
@@ -9,13 +9,23 @@ scene:campaign{
 }
 
 scene:pc("Frodo")
-scene:adversary("Great Eagles")
 
 -- The PCs enter a hunting ground and predators appear.
 scene:start_session("Raptor Nest")
+scene:environment{ id = "environment.mountain-pass" }
 
--- Missing DSL: spawn two eagles at Very Far range.
-scene:gm_spend_fear(0):spotlight("Great Eagles")
+-- Two eagles appear at Very Far range of a chosen PC.
+scene:environment_feature{
+  feature = "feature.environment-mountain-pass-rap-to-r-nest",
+  kind = "reaction",
+  spawns = {
+    { entry = "adversary.giant-eagle", name = "Great Eagle", count = 2 }
+  }
+}
+scene:set_range{ from = "Frodo", to = "Great Eagle 1", band = "very_far" }
+scene:set_range{ from = "Frodo", to = "Great Eagle 2", band = "very_far" }
+scene:expect_range{ from = "Frodo", to = "Great Eagle 2", band = "very_far" }
+scene:gm_spend_fear(0):spotlight("Great Eagle 1")
 
 scene:end_session()
 
@@ -14,8 +14,16 @@ scene:pc("Frodo")
 scene:start_session("Desecrated Ground")
 
 -- Example: reduce Hope Die to d10 until a progress countdown clears it.
--- Missing DSL: apply Hope die size change and countdown clearance.
+scene:effect{
+  target = "Frodo",
+  id = "desecrated-ground",
+  kind = "hope_die",
+  value = -2,
+  source = "desecrated ground"
+}
+-- Missing DSL: clear the effect when the progress countdown completes.
 scene:countdown_create{ name = "Cleanse Desecration", kind = "progress", current = 0, max = 6, direction = "increase" }
+scene:effect_remove{ id = "desecrated-ground", target = "Frodo" }
 
 scene:end_session()
 
@@ -13,8 +13,8 @@ scene:pc("Gandalf")
 -- Spellcasting draws extra Fear in the corrupted woods.
 scene:start_session("Chaos Magic Locus")
 
--- Missing DSL: roll two Fear dice and take the higher on Spellcast.
-scene:action_roll{ actor = "Gandalf", trait = "spellcast", difficulty = 16, outcome = "fear" }
+-- Chaos Magic: roll two Fear dice and keep the higher on the Spellcast roll.
+scene:action_roll{ actor = "Gandalf", trait = "knowledge", difficulty = 16, extra_fear_die = true, outcome = "fear" }
 
 scene:end_session()
 
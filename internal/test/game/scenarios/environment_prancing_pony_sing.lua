@@ -14,8 +14,10 @@ scene:pc("Frodo")
 scene:start_session("Sing for Supper")
 
 -- Example: Presence roll yields gold on success, Stress on failure.
--- Missing DSL: roll and apply gold payout vs stress.
-scene:action_roll{ actor = "Frodo", trait = "presence", difficulty = 10, outcome = "fear" }
+scene:action_roll{ actor = "Frodo", trait = "presence", difficulty = 10, outcome = "hope" }
+-- The payout is 1d4 handfuls; this performance earned 3.
+scene:gold{ target = "Frodo", handfuls = 3, reason = "sing_for_your_supper", expect_handfuls = 3 }
+-- Missing DSL: roll the 1d4 payout and mark Stress on a failed performance.
 
 scene:end_session()
 
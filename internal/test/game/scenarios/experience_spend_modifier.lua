@@ -8,13 +8,13 @@ scene:campaign{
   theme = "experience"
 }
 
-scene:pc("Frodo")
+scene:pc("Frodo", { experiences = { { name = "Ring-bearer", modifier = 2 } } })
 
 -- Frodo uses a relevant Experience by spending Hope for a modifier.
 scene:start_session("Experience Modifier")
 
--- Missing DSL: spend Hope and apply an Experience bonus to the roll.
-scene:action_roll{ actor = "Frodo", trait = "presence", difficulty = 12, outcome = "hope" }
+-- Spending 1 Hope adds the Experience's +2 to the roll.
+scene:action_roll{ actor = "Frodo", trait = "presence", difficulty = 12, outcome = "hope", experiences = { "Ring-bearer" } }
 
 scene:end_session()
 
@@ -15,14 +15,19 @@ scene:adversary("Orc Pack B")
 -- Gandalf casts Fireball to catch multiple orc packs at once.
 scene:start_session("Fireball")
 
--- Example: one roll applied to multiple targets.
--- Missing DSL: assert per-target outcomes and damage tiers.
+-- Example: one roll applied to multiple targets, each checked against its own Difficulty.
 scene:multi_attack{
   actor = "Gandalf",
   targets = { "Orc Pack A", "Orc Pack B" },
+  per_target = true,
   trait = "spellcast",
-  difficulty = 0,
+  target_difficulties = { ["Orc Pack A"] = 0, ["Orc Pack B"] = 40 },
   outcome = "hope",
+  expect_hits = { "Orc Pack A" },
+  expect_misses = { "Orc Pack B" },
+  expect_adversary_deltas = {
+    { target = "Orc Pack B", hp_delta = 0 }
+  },
   damage_type = "magic",
   damage_dice = { { sides = 6, count = 2 } }
 }
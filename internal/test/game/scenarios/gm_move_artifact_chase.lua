@@ -16,9 +16,27 @@ scene:start_session("Artifact Theft")
 scene:gm_fear(1)
 
 -- Example: GM move steals an artifact and forces a chase.
--- Missing DSL: represent item theft and chase trigger.
+-- Missing DSL: represent item theft.
 scene:gm_spend_fear(1):spotlight("Golum")
-scene:countdown_create{ name = "Thief Escape", kind = "consequence", current = 0, max = 4, direction = "increase" }
+
+-- The chase links Gandalf's pursuit to Golum's escape; each action roll
+-- ticks both countdowns by its outcome.
+scene:chase_start{
+  name = "Artifact Chase",
+  progress_name = "Pursuit",
+  progress_max = 4,
+  consequence_name = "Thief Escape",
+  consequence_max = 3
+}
+
+-- Success with Fear closes the gap but lets Golum slip further ahead.
+scene:action_roll{ actor = "Gandalf", trait = "agility", difficulty = 10, outcome = "success_fear" }
+scene:chase_expect{ name = "Artifact Chase", status = "active", progress = 3, consequence = 2 }
+
+-- A failure with Hope lets the thief escape before Gandalf catches up.
+scene:action_roll{ actor = "Gandalf", trait = "agility", difficulty = 10, outcome = "failure_hope" }
+scene:countdown_expect{ name = "Thief Escape", current = 0, triggered = true }
+scene:chase_expect{ name = "Artifact Chase", status = "resolved", winner = "consequence", progress = 3 }
 
 scene:end_session()
 
@@ -14,9 +14,8 @@ scene:pc("Gandalf")
 -- Gandalf spends Hope to help Aragorn's Instinct roll.
 scene:start_session("Help an Ally")
 
--- Example: help adds an advantage die and a +3 bonus from assistance.
--- Missing DSL: spend Hope on help and apply the advantage die.
-scene:action_roll{ actor = "Aragorn", trait = "instinct", difficulty = 10, outcome = "fear" }
+-- Gandalf spends 1 Hope and rolls a d6 that Aragorn adds to the total.
+scene:action_roll{ actor = "Aragorn", trait = "instinct", difficulty = 10, outcome = "fear", helpers = { "Gandalf" } }
 
 -- Close the session after the assisted roll.
 scene:end_session()
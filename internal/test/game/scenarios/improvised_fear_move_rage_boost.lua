@@ -16,8 +16,29 @@ scene:start_session("Rage Boost")
 scene:gm_fear(2)
 
 -- Example: the adversary flies into a rage for the remainder of the scene.
--- Missing DSL: apply a temporary damage bonus feature.
 scene:gm_spend_fear(1):spotlight("Uruk-hai Brute")
+scene:effect{
+  target = "Uruk-hai Brute",
+  id = "rage-1",
+  kind = "damage",
+  value = 2,
+  source = "rage",
+  stacking = "replace",
+  expires_on = "scene_end"
+}
+
+-- Spending Fear again refreshes the rage instead of stacking it.
+scene:gm_spend_fear(1):spotlight("Uruk-hai Brute")
+scene:effect{
+  target = "Uruk-hai Brute",
+  id = "rage-2",
+  kind = "damage",
+  value = 2,
+  source = "rage",
+  stacking = "replace",
+  expires_on = "scene_end",
+  expect_replaced = 1
+}
 
 -- Close the session after the fear move.
 scene:end_session()
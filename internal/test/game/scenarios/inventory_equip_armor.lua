@@ -0,0 +1,34 @@
+local scene = Scenario.new("inventory_equip_armor")
+
+-- Worn armor sets Armor Score and damage thresholds from the catalog.
+scene:campaign{
+  name = "Inventory Equip Armor",
+  system = "DAGGERHEART",
+  gm_mode = "HUMAN",
+  theme = "inventory"
+}
+
+scene:pc("Frodo")
+scene:pc("Sam")
+
+scene:start_session("Outfitting")
+
+-- Gambeson Armor: base thresholds 5/11 and Armor Score 3; level 1 adds 1.
+scene:acquire_item{ target = "Frodo", item = "armor.gambeson-armor", kind = "armor", source = "market" }
+scene:equip{ target = "Frodo", item = "armor.gambeson-armor", expect_armor_score = 3, expect_major = 6, expect_severe = 12 }
+
+-- A two-handed longsword fills both hands.
+scene:acquire_item{ target = "Frodo", item = "weapon.longsword", kind = "weapon" }
+scene:equip{ target = "Frodo", item = "weapon.longsword", slot = "primary" }
+
+-- Supplies change hands between companions.
+scene:acquire_item{ target = "Frodo", item = "item.basic-supplies", kind = "item" }
+scene:transfer_item{ from = "Frodo", to = "Sam", item = "item.basic-supplies" }
+scene:drop_item{ target = "Sam", item = "item.basic-supplies", reason = "left at camp" }
+
+-- Taking the armor off restores unarmored thresholds (level, 2x level).
+scene:unequip{ target = "Frodo", slot = "armor", expect_armor_score = 0, expect_major = 1, expect_severe = 2 }
+
+scene:end_session()
+
+return scene
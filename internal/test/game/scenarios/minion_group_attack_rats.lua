@@ -9,16 +9,23 @@ scene:campaign{
 }
 
 scene:pc("Frodo")
-scene:adversary("Moria Rats")
+scene:adversary("Moria Rats", { minion = 3 })
+scene:adversary("Moria Rat B", { minion = 3 })
+scene:adversary("Moria Rat C", { minion = 3 })
 
 -- The GM spends Fear to trigger a group attack.
 scene:start_session("Rat Swarm")
 scene:gm_fear(1)
 
 -- Example: shared attack roll, 1 damage each, combined.
--- Missing DSL: resolve group attack damage aggregation.
 scene:gm_spend_fear(1):spotlight("Moria Rats")
-scene:adversary_attack{ actor = "Moria Rats", target = "Frodo", difficulty = 0, damage_type = "physical" }
+scene:adversary_attack{
+  actor = "Moria Rats",
+  target = "Frodo",
+  group = { "Moria Rat B", "Moria Rat C" },
+  difficulty = 0,
+  damage_type = "physical"
+}
 
 scene:end_session()
 
@@ -9,17 +9,17 @@ scene:campaign{
 }
 
 scene:pc("Frodo")
-scene:adversary("Goblin A")
-scene:adversary("Goblin B")
+scene:adversary("Goblin A", { minion = 8 })
+scene:adversary("Goblin B", { minion = 8 })
 
 -- Heavier hits are needed to drop extra imps at once.
 scene:start_session("Imp Overflow")
 
 -- Example: 8 damage defeats the target plus one more Minion.
--- Missing DSL: apply Minion (8) overflow.
 scene:combined_damage{
   target = "Goblin A",
   damage_type = "magic",
+  minion_overflow = { "Goblin B" },
   sources = {
     { character = "Frodo", amount = 8 }
   }
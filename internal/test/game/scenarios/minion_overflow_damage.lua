@@ -9,18 +9,18 @@ scene:campaign{
 }
 
 scene:pc("Frodo")
-scene:adversary("Moria Rat A")
-scene:adversary("Moria Rat B")
-scene:adversary("Moria Rat C")
+scene:adversary("Moria Rat A", { minion = 3 })
+scene:adversary("Moria Rat B", { minion = 3 })
+scene:adversary("Moria Rat C", { minion = 3 })
 
 -- One hit drops multiple rats when damage meets Minion (3).
 scene:start_session("Minion Overflow")
 
 -- Example: 6 damage defeats the target plus two more Minions.
--- Missing DSL: apply Minion (3) overflow and select extra targets.
 scene:combined_damage{
   target = "Moria Rat A",
   damage_type = "physical",
+  minion_overflow = { "Moria Rat B", "Moria Rat C" },
   sources = {
     { character = "Frodo", amount = 6 }
   }
@@ -9,18 +9,20 @@ scene:campaign{
 }
 
 scene:pc("Aragorn")
-scene:adversary("Orc Raiders")
+scene:adversary("Orc Raiders", { minion = 5 })
+scene:adversary("Orc Raider B", { minion = 5 })
+scene:adversary("Orc Raider C", { minion = 5 })
 
 -- The GM spends fear to have the raiders strike as a group.
 scene:start_session("Group Attack")
 scene:gm_fear(1)
 
 -- Example: single group attack roll against Aragorn's Evasion.
--- Missing DSL: represent group attack roll and shared damage.
 scene:gm_spend_fear(1):spotlight("Orc Raiders")
 scene:adversary_attack{
   actor = "Orc Raiders",
   target = "Aragorn",
+  group = { "Orc Raider B", "Orc Raider C" },
   difficulty = 0,
   damage_type = "physical"
 }
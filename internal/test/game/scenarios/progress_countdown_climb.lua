@@ -15,12 +15,30 @@ scene:pc("Sam")
 scene:start_session("Whitecrest Ascent")
 
 -- Example: progress countdown starts at 3 instead of 5.
-scene:countdown_create{ name = "Whitecrest Ascent", kind = "progress", current = 3, max = 3, direction = "decrease" }
+-- The countdown ticks on every action roll, by how well the roll went.
+scene:countdown_create{
+  name = "Whitecrest Ascent",
+  kind = "progress",
+  current = 3,
+  max = 3,
+  direction = "decrease",
+  tick = "action_roll",
+  tick_outcomes = {
+    critical_success = 3,
+    success_with_hope = 3,
+    success_with_fear = 2,
+    failure_with_hope = 1,
+    failure_with_fear = 0
+  }
+}
 
 -- Sam succeeds with Fear, advancing the climb despite consequences.
--- Missing DSL: tick countdown by result tier and award Hope/Fear changes.
-scene:action_roll{ actor = "Sam", trait = "agility", difficulty = 12, outcome = "fear" }
-scene:countdown_update{ name = "Whitecrest Ascent", delta = -1, reason = "climb" }
+scene:action_roll{ actor = "Sam", trait = "agility", difficulty = 12, outcome = "success_fear" }
+scene:countdown_expect{ name = "Whitecrest Ascent", current = 1, triggered = false }
+
+-- Frodo rolls with Hope; either Hope tier finishes the climb and triggers it.
+scene:action_roll{ actor = "Frodo", trait = "agility", difficulty = 12, outcome = "hope" }
+scene:countdown_expect{ name = "Whitecrest Ascent", current = 0, triggered = true }
 
 -- Close the session after the ascent advances.
 scene:end_session()
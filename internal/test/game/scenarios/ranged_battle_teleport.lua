@@ -15,8 +15,10 @@ scene:adversary("Saruman")
 scene:start_session("Battle Teleport")
 
 -- Example: mark Stress to teleport within Far range.
--- Missing DSL: move the adversary and spend Stress.
-scene:adversary_attack{ actor = "Saruman", target = "Frodo", difficulty = 0, damage_type = "magic" }
+scene:set_range{ from = "Saruman", to = "Frodo", band = "close" }
+scene:move{ entity = "Saruman", kind = "teleport", ranges = { Frodo = "far" }, reason = "battle_teleport" }
+scene:expect_range{ from = "Saruman", to = "Frodo", band = "far" }
+scene:adversary_attack{ actor = "Saruman", targets = { "Frodo" }, difficulty = 0, stress_cost = 1, damage_type = "magic" }
 
 scene:end_session()
 
@@ -15,8 +15,11 @@ scene:adversary("Fell Beast")
 scene:start_session("Swift Claws")
 
 -- Example: on hit, deal 2d10+5 and force a Strength reaction to avoid knockback.
--- Missing DSL: apply movement, stress spend, and knockback.
-scene:adversary_attack{ actor = "Fell Beast", target = "Frodo", difficulty = 0, damage_type = "physical" }
+scene:set_range{ from = "Fell Beast", to = "Frodo", band = "close" }
+scene:move{ entity = "Fell Beast", kind = "move", ranges = { Frodo = "melee" }, reason = "swift_claws" }
+scene:adversary_attack{ actor = "Fell Beast", targets = { "Frodo" }, difficulty = 0, stress_cost = 1, damage_type = "physical" }
+scene:move{ entity = "Frodo", kind = "knockback", source = "Fell Beast", push_bands = 2, reason = "swift_claws" }
+scene:expect_range{ from = "Frodo", to = "Fell Beast", band = "close" }
 
 scene:end_session()
 
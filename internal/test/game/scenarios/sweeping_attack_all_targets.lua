@@ -17,13 +17,16 @@ scene:adversary("Nazgul")
 -- The Nazgul spends Stress to swing at everyone within Very Close range.
 scene:start_session("Sweeping Attack")
 
--- Example: attack total 8 compared to each PC's Evasion, misses all.
--- Missing DSL: spend adversary stress and resolve a multi-target adversary attack.
+-- Example: one attack roll compared to each PC's Evasion; Stress is marked once.
 scene:adversary_attack{
   actor = "Nazgul",
-  target = "Sam",
-  difficulty = 0,
-  damage_type = "physical"
+  targets = { "Sam", "Frodo", "Gandalf", "Aragorn" },
+  stress_cost = 1,
+  attack_modifier = 30,
+  expect_hits = { "Sam", "Frodo", "Gandalf", "Aragorn" },
+  damage_type = "physical",
+  damage_dice = { { sides = 8, count = 1 } },
+  damage_modifier = 2
 }
 
 -- Close the session after the sweeping attack.
@@ -14,9 +14,29 @@ scene:pc("Gandalf", { armor = 3 })
 scene:start_session("Armor Bonus")
 
 -- Example: Gandalf's Armor Score increases by 2, then resets on rest.
--- Missing DSL: apply temporary armor bonus and clear Armor Slots on rest.
+scene:effect{
+  target = "Gandalf",
+  id = "ward-1",
+  kind = "armor",
+  value = 2,
+  source = "ward",
+  expires_on = "rest",
+  expect_armor = 5
+}
 scene:rest{ type = "short", party_size = 1 }
 
+-- The ward lapsed with the rest, so recasting it lands on the base slots.
+scene:effect{
+  target = "Gandalf",
+  id = "ward-2",
+  kind = "armor",
+  value = 2,
+  source = "ward",
+  expires_on = "rest",
+  expect_armor = 5
+}
+scene:effect_remove{ id = "ward-2", target = "Gandalf", expect_armor = 3 }
+
 -- Close the session after the rest clears temporary armor.
 scene:end_session()
 
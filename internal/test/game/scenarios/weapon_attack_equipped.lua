@@ -0,0 +1,38 @@
+local scene = Scenario.new("weapon_attack_equipped")
+
+-- Attacks with an equipped weapon draw trait and damage from the catalog.
+scene:campaign{
+  name = "Weapon Attack Equipped",
+  system = "DAGGERHEART",
+  gm_mode = "HUMAN",
+  theme = "combat"
+}
+
+scene:pc("Frodo")
+scene:pc("Sam")
+scene:adversary("Nazgul")
+
+scene:start_session("Longsword")
+
+scene:acquire_item{ target = "Frodo", item = "weapon.longsword", kind = "weapon" }
+scene:equip{ target = "Frodo", item = "weapon.longsword" }
+
+-- The longsword rolls Agility and deals d10 physical damage per Proficiency.
+scene:attack{ actor = "Frodo", target = "Sam", weapon = "equipped", difficulty = 0, outcome = "hope" }
+scene:attack{ actor = "Frodo", target = "Nazgul", weapon = "weapon.longsword", difficulty = 0, outcome = "hope" }
+
+-- An improvised attack ignores the equipped weapon.
+scene:attack{
+  actor = "Frodo",
+  target = "Sam",
+  improvised = true,
+  trait = "strength",
+  difficulty = 0,
+  outcome = "hope",
+  damage_type = "physical",
+  damage_dice = { { sides = 4, count = 1 } }
+}
+
+scene:end_session()
+
+return scene
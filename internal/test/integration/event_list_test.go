@@ -55,7 +55,7 @@ func runEventListTests(t *testing.T, grpcAddr string, authAddr string) {
 			ActorType:   "system",
 			EntityType:  "campaign",
 			EntityId:    campaignID,
-			PayloadJson: []byte("{}"),
+			PayloadJson: []byte(`{"content":"Event list note"}`),
 		}); err != nil {
 			t.Fatalf("append note event %d: %v", i, err)
 		}
@@ -66,7 +66,7 @@ func runEventListTests(t *testing.T, grpcAddr string, authAddr string) {
 			ActorType:   "system",
 			EntityType:  "campaign",
 			EntityId:    campaignID,
-			PayloadJson: []byte("{}"),
+			PayloadJson: []byte(`{"request_id":"event-list-roll","roll_seq":1,"results":{}}`),
 		}); err != nil {
 			t.Fatalf("append roll event %d: %v", i, err)
 		}
@@ -34,17 +34,23 @@ type payloadDef struct {
 	Name      string
 	DefinedAt string
 	Fields    []payloadField
+	Struct    *ast.StructType
 }
 
 type packageDefs struct {
 	Events   []eventDef
 	Payloads map[string]payloadDef
+	// Types holds every type declared in the package so payload schemas can
+	// resolve nested structs.
+	Types map[string]ast.Expr
 }
 
 func main() {
 	var outPath string
+	var schemasPath string
 	var rootFlag string
 	flag.StringVar(&outPath, "out", "docs/events/event-catalog.md", "output path for the catalog")
+	flag.StringVar(&schemasPath, "schemas", "internal/services/game/domain/campaign/event/payload_schemas.json", "output path for the payload JSON Schemas")
 	flag.StringVar(&rootFlag, "root", "", "repo root (defaults to locating go.mod)")
 	flag.Parse()
 
@@ -56,6 +62,10 @@ func main() {
 	if !filepath.IsAbs(output) {
 		output = filepath.Join(root, outPath)
 	}
+	schemasOutput := schemasPath
+	if !filepath.IsAbs(schemasOutput) {
+		schemasOutput = filepath.Join(root, schemasPath)
+	}
 
 	coreDir := filepath.Join(root, "internal/services/game/domain/campaign/event")
 	daggerheartDir := filepath.Join(root, "internal/services/game/domain/systems/daggerheart")
@@ -85,6 +95,17 @@ func main() {
 	if err := os.WriteFile(output, []byte(content), 0o644); err != nil {
 		fatal(fmt.Errorf("write catalog: %w", err))
 	}
+
+	schemas, err := renderSchemas([]packageDefs{coreDefs, daggerheartDefs})
+	if err != nil {
+		fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(schemasOutput), 0o755); err != nil {
+		fatal(fmt.Errorf("create schemas dir: %w", err))
+	}
+	if err := os.WriteFile(schemasOutput, schemas, 0o644); err != nil {
+		fatal(fmt.Errorf("write schemas: %w", err))
+	}
 }
 
 func resolveRoot(flagRoot string) (string, error) {
@@ -121,7 +142,7 @@ func parsePackage(dir, root, owner string) (packageDefs, error) {
 	if err != nil {
 		return packageDefs{}, fmt.Errorf("parse %s: %w", dir, err)
 	}
-	defs := packageDefs{Payloads: make(map[string]payloadDef)}
+	defs := packageDefs{Payloads: make(map[string]payloadDef), Types: make(map[string]ast.Expr)}
 	for _, pkg := range pkgs {
 		for _, file := range pkg.Files {
 			ast.Inspect(file, func(node ast.Node) bool {
@@ -136,6 +157,7 @@ func parsePackage(dir, root, owner string) (packageDefs, error) {
 							if !ok {
 								continue
 							}
+							defs.Types[typeSpec.Name.Name] = typeSpec.Type
 							structType, ok := typeSpec.Type.(*ast.StructType)
 							if !ok {
 								continue
@@ -149,6 +171,7 @@ func parsePackage(dir, root, owner string) (packageDefs, error) {
 								Name:      name,
 								DefinedAt: formatPosition(fset.Position(typeSpec.Pos()), root),
 								Fields:    parsePayloadFields(structType.Fields, fset),
+								Struct:    structType,
 							}
 							defs.Payloads[name] = payload
 						}
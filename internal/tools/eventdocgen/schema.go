@@ -0,0 +1,220 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchema is the JSON Schema subset emitted for payloads: types,
+// properties, required fields, array items and map values. Objects stay open
+// to unknown properties so adding a payload field is not a breaking change.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 any                    `json:"type,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+}
+
+type schemaEntry struct {
+	Type     string      `json:"type"`
+	SystemID string      `json:"system_id,omitempty"`
+	Payload  string      `json:"payload"`
+	Schema   *jsonSchema `json:"schema"`
+}
+
+type schemaFile struct {
+	Comment string        `json:"$comment"`
+	Events  []schemaEntry `json:"events"`
+}
+
+// renderSchemas emits one JSON Schema per event type with a payload struct,
+// ordered like the catalog.
+func renderSchemas(packages []packageDefs) ([]byte, error) {
+	file := schemaFile{
+		Comment: "Generated by `go generate ./internal/services/game/domain/campaign/event`. DO NOT EDIT.",
+		Events:  make([]schemaEntry, 0),
+	}
+	seen := make(map[string]string)
+	for _, pkg := range packages {
+		events := append([]eventDef(nil), pkg.Events...)
+		sort.Slice(events, func(i, j int) bool { return events[i].Value < events[j].Value })
+		for _, evt := range events {
+			payload, ok := pkg.Payloads[payloadNameForEvent(evt.Name, evt.Owner)]
+			if !ok || payload.Struct == nil {
+				continue
+			}
+			if previous, ok := seen[evt.Value]; ok {
+				return nil, fmt.Errorf("event type %s is declared by both %s and %s", evt.Value, previous, evt.Owner)
+			}
+			seen[evt.Value] = evt.Owner
+
+			schema := structSchema(payload.Struct, pkg.Types, map[string]bool{})
+			schema.Schema = jsonSchemaDialect
+			schema.Title = payload.Name
+			file.Events = append(file.Events, schemaEntry{
+				Type:     evt.Value,
+				SystemID: systemIDForOwner(evt.Owner),
+				Payload:  payload.Name,
+				Schema:   schema,
+			})
+		}
+	}
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode schemas: %w", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// systemIDForOwner maps a catalog owner to the game system id events carry;
+// core events have none.
+func systemIDForOwner(owner string) string {
+	if owner == "Core" {
+		return ""
+	}
+	return "GAME_SYSTEM_" + strings.ToUpper(owner)
+}
+
+func structSchema(structType *ast.StructType, types map[string]ast.Expr, visiting map[string]bool) *jsonSchema {
+	schema := &jsonSchema{Type: "object", Properties: make(map[string]*jsonSchema)}
+	if structType.Fields == nil {
+		return schema
+	}
+	for _, field := range structType.Fields.List {
+		name, omitEmpty, asString, ok := fieldJSONName(field)
+		if !ok {
+			continue
+		}
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+			property := name
+			if property == "" {
+				property = ident.Name
+			}
+			fieldSchema := typeSchema(field.Type, types, visiting)
+			if asString {
+				fieldSchema = &jsonSchema{Type: "string"}
+			}
+			schema.Properties[property] = fieldSchema
+			if !omitEmpty {
+				schema.Required = append(schema.Required, property)
+			}
+		}
+	}
+	sort.Strings(schema.Required)
+	return schema
+}
+
+// fieldJSONName reads the json tag of a named field. ok is false for
+// embedded fields and fields excluded from encoding.
+func fieldJSONName(field *ast.Field) (name string, omitEmpty, asString, ok bool) {
+	if len(field.Names) == 0 {
+		return "", false, false, false
+	}
+	if field.Tag == nil {
+		return "", false, false, true
+	}
+	tagValue, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return "", false, false, true
+	}
+	tag := reflect.StructTag(tagValue).Get("json")
+	if tag == "-" {
+		return "", false, false, false
+	}
+	parts := strings.Split(tag, ",")
+	for _, option := range parts[1:] {
+		switch option {
+		case "omitempty", "omitzero":
+			omitEmpty = true
+		case "string":
+			asString = true
+		}
+	}
+	return parts[0], omitEmpty, asString, true
+}
+
+func typeSchema(expr ast.Expr, types map[string]ast.Expr, visiting map[string]bool) *jsonSchema {
+	switch typed := expr.(type) {
+	case *ast.Ident:
+		if schema, ok := builtinSchema(typed.Name); ok {
+			return schema
+		}
+		def, ok := types[typed.Name]
+		if !ok || visiting[typed.Name] {
+			return &jsonSchema{}
+		}
+		visiting[typed.Name] = true
+		defer delete(visiting, typed.Name)
+		return typeSchema(def, types, visiting)
+	case *ast.StarExpr:
+		return nullable(typeSchema(typed.X, types, visiting))
+	case *ast.ArrayType:
+		if ident, ok := typed.Elt.(*ast.Ident); ok && ident.Name == "byte" {
+			return nullable(&jsonSchema{Type: "string"})
+		}
+		schema := &jsonSchema{Type: "array", Items: typeSchema(typed.Elt, types, visiting)}
+		if typed.Len != nil {
+			return schema
+		}
+		return nullable(schema)
+	case *ast.MapType:
+		return nullable(&jsonSchema{Type: "object", AdditionalProperties: typeSchema(typed.Value, types, visiting)})
+	case *ast.StructType:
+		return structSchema(typed, types, visiting)
+	case *ast.SelectorExpr:
+		if ident, ok := typed.X.(*ast.Ident); ok && ident.Name == "time" && typed.Sel.Name == "Time" {
+			return &jsonSchema{Type: "string"}
+		}
+		// Types from other packages are left unconstrained.
+		return &jsonSchema{}
+	default:
+		return &jsonSchema{}
+	}
+}
+
+func builtinSchema(name string) (*jsonSchema, bool) {
+	switch name {
+	case "string":
+		return &jsonSchema{Type: "string"}, true
+	case "bool":
+		return &jsonSchema{Type: "boolean"}, true
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "byte", "rune":
+		return &jsonSchema{Type: "integer"}, true
+	case "float32", "float64":
+		return &jsonSchema{Type: "number"}, true
+	case "any":
+		return &jsonSchema{}, true
+	}
+	return nil, false
+}
+
+// nullable widens schema to also accept null, as Go encodes nil pointers,
+// slices and maps.
+func nullable(schema *jsonSchema) *jsonSchema {
+	switch typed := schema.Type.(type) {
+	case string:
+		schema.Type = []string{typed, "null"}
+	case []string:
+		for _, name := range typed {
+			if name == "null" {
+				return schema
+			}
+		}
+		schema.Type = append(typed, "null")
+	}
+	return schema
+}
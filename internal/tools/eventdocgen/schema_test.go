@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func parseSample(t *testing.T, owner string, lines ...string) packageDefs {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "sample.go"), []byte(strings.Join(lines, "\n")), 0o644); err != nil {
+		t.Fatalf("write sample.go: %v", err)
+	}
+	defs, err := parsePackage(root, root, owner)
+	if err != nil {
+		t.Fatalf("parsePackage returned error: %v", err)
+	}
+	return defs
+}
+
+func TestRenderSchemas(t *testing.T) {
+	core := parseSample(t, "Core",
+		"package sample",
+		"",
+		"type Type string",
+		"",
+		"const TypeFoo Type = \"foo\"",
+		"",
+		"type Level int",
+		"",
+		"type Change struct {",
+		"\tField string `json:\"field\"`",
+		"\tAfter *int `json:\"after,omitempty\"`",
+		"}",
+		"",
+		"type FooPayload struct {",
+		"\tID string `json:\"id\"`",
+		"\tLevel Level `json:\"level\"`",
+		"\tChanges []Change `json:\"changes\"`",
+		"\tFields map[string]string `json:\"fields,omitempty\"`",
+		"\tRoll dice.Roll `json:\"roll\"`",
+		"\tCount uint64 `json:\"count,string\"`",
+		"\tHidden string `json:\"-\"`",
+		"\tinternal string",
+		"\tRaw bool",
+		"}",
+	)
+	daggerheart := parseSample(t, "Daggerheart",
+		"package sample",
+		"",
+		"const EventTypeBar event.Type = \"bar\"",
+		"",
+		"type BarPayload struct {",
+		"\tNote *string `json:\"note\"`",
+		"}",
+	)
+
+	data, err := renderSchemas([]packageDefs{core, daggerheart})
+	if err != nil {
+		t.Fatalf("renderSchemas returned error: %v", err)
+	}
+	var file struct {
+		Events []struct {
+			Type     string         `json:"type"`
+			SystemID string         `json:"system_id"`
+			Payload  string         `json:"payload"`
+			Schema   map[string]any `json:"schema"`
+		} `json:"events"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatalf("decode schemas: %v", err)
+	}
+	if len(file.Events) != 2 {
+		t.Fatalf("expected 2 schemas, got %d", len(file.Events))
+	}
+
+	foo := file.Events[0]
+	if foo.Type != "foo" || foo.SystemID != "" || foo.Payload != "FooPayload" {
+		t.Fatalf("unexpected core entry: %+v", foo)
+	}
+	want := map[string]any{
+		"$schema": jsonSchemaDialect,
+		"title":   "FooPayload",
+		"type":    "object",
+		"properties": map[string]any{
+			"id":    map[string]any{"type": "string"},
+			"level": map[string]any{"type": "integer"},
+			"changes": map[string]any{
+				"type": []any{"array", "null"},
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"field": map[string]any{"type": "string"},
+						"after": map[string]any{"type": []any{"integer", "null"}},
+					},
+					"required": []any{"field"},
+				},
+			},
+			"fields": map[string]any{
+				"type":                 []any{"object", "null"},
+				"additionalProperties": map[string]any{"type": "string"},
+			},
+			"roll":  map[string]any{},
+			"count": map[string]any{"type": "string"},
+			"Raw":   map[string]any{"type": "boolean"},
+		},
+		"required": []any{"Raw", "changes", "count", "id", "level", "roll"},
+	}
+	if !reflect.DeepEqual(foo.Schema, want) {
+		got, _ := json.MarshalIndent(foo.Schema, "", "  ")
+		t.Fatalf("unexpected FooPayload schema:\n%s", got)
+	}
+
+	bar := file.Events[1]
+	if bar.Type != "bar" || bar.SystemID != "GAME_SYSTEM_DAGGERHEART" {
+		t.Fatalf("unexpected daggerheart entry: %+v", bar)
+	}
+}
+
+func TestRenderSchemas_DuplicateEventType(t *testing.T) {
+	core := parseSample(t, "Core",
+		"package sample",
+		"",
+		"const TypeFoo Type = \"foo\"",
+		"",
+		"type FooPayload struct{}",
+	)
+	daggerheart := parseSample(t, "Daggerheart",
+		"package sample",
+		"",
+		"const EventTypeFoo event.Type = \"foo\"",
+		"",
+		"type FooPayload struct{}",
+	)
+	if _, err := renderSchemas([]packageDefs{core, daggerheart}); err == nil {
+		t.Fatal("expected error for an event type declared twice")
+	}
+}
@@ -24,6 +24,10 @@ func (f *fakeEventStore) AppendEvent(_ context.Context, _ event.Event) (event.Ev
 	return event.Event{}, fmt.Errorf("not implemented")
 }
 
+func (f *fakeEventStore) AppendEvents(_ context.Context, _ []event.Event) ([]event.Event, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (f *fakeEventStore) GetEventByHash(_ context.Context, _ string) (event.Event, error) {
 	return event.Event{}, fmt.Errorf("not implemented")
 }
@@ -276,6 +280,74 @@ func (f *fakeProjectionStore) DeleteDaggerheartAdversary(ctx context.Context, ca
 	return fmt.Errorf("not implemented")
 }
 
+func (f *fakeProjectionStore) PutDaggerheartSceneRange(context.Context, storage.DaggerheartSceneRange) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) GetDaggerheartSceneRange(context.Context, string, string, string, string) (storage.DaggerheartSceneRange, error) {
+	return storage.DaggerheartSceneRange{}, fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) ListDaggerheartSceneRanges(context.Context, string, string) ([]storage.DaggerheartSceneRange, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) PutDaggerheartSessionEnvironment(context.Context, storage.DaggerheartSessionEnvironment) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) GetDaggerheartSessionEnvironment(context.Context, string, string) (storage.DaggerheartSessionEnvironment, error) {
+	return storage.DaggerheartSessionEnvironment{}, fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) DeleteDaggerheartSessionEnvironment(context.Context, string, string) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) PutDaggerheartCompanion(context.Context, storage.DaggerheartCompanion) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) GetDaggerheartCompanion(context.Context, string, string) (storage.DaggerheartCompanion, error) {
+	return storage.DaggerheartCompanion{}, fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) PutDaggerheartEffect(context.Context, storage.DaggerheartEffect) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) GetDaggerheartEffect(context.Context, string, string) (storage.DaggerheartEffect, error) {
+	return storage.DaggerheartEffect{}, fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) ListDaggerheartEffects(context.Context, string) ([]storage.DaggerheartEffect, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) DeleteDaggerheartEffect(context.Context, string, string) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) PutDaggerheartChase(context.Context, storage.DaggerheartChase) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) GetDaggerheartChase(context.Context, string, string) (storage.DaggerheartChase, error) {
+	return storage.DaggerheartChase{}, fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) ListDaggerheartChases(context.Context, string) ([]storage.DaggerheartChase, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) PutDaggerheartAdversaryFeatureState(context.Context, storage.DaggerheartAdversaryFeatureState) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (f *fakeProjectionStore) ListDaggerheartAdversaryFeatureStates(context.Context, string) ([]storage.DaggerheartAdversaryFeatureState, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
 func (f *fakeProjectionStore) PutSession(context.Context, session.Session) error {
 	return fmt.Errorf("not implemented")
 }
@@ -9,12 +9,14 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/caarlos0/env/v11"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/event"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/campaign/projection"
+	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems"
 	"github.com/louisbranch/fracturing.space/internal/services/game/domain/systems/daggerheart"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage"
 	"github.com/louisbranch/fracturing.space/internal/services/game/storage/integrity"
@@ -35,6 +37,8 @@ type Config struct {
 	DryRun            bool
 	Validate          bool
 	Integrity         bool
+	Rebuild           bool
+	PayloadVersions   bool
 	WarningsCap       int
 	JSONOutput        bool
 }
@@ -74,6 +78,8 @@ func ParseConfig(fs *flag.FlagSet, args []string) (Config, error) {
 	fs.BoolVar(&cfg.DryRun, "dry-run", false, "scan snapshot-related events without applying projections")
 	fs.BoolVar(&cfg.Validate, "validate", false, "validate snapshot event payloads without applying projections (implies -dry-run)")
 	fs.BoolVar(&cfg.Integrity, "integrity", false, "replay snapshot-related events into a scratch store and compare against stored projections")
+	fs.BoolVar(&cfg.Rebuild, "rebuild", false, "rebuild all projections from the nearest projection checkpoint, saving new checkpoints along the way")
+	fs.BoolVar(&cfg.PayloadVersions, "payload-versions", false, "report which campaigns still hold events at legacy payload versions (campaign IDs are optional)")
 	fs.IntVar(&cfg.WarningsCap, "warnings-cap", cfg.WarningsCap, "max warnings to print (0 = no limit)")
 	fs.BoolVar(&cfg.JSONOutput, "json", false, "output JSON reports")
 	fs.DurationVar(&cfg.Timeout, "timeout", cfg.Timeout, "overall timeout")
@@ -95,12 +101,21 @@ func Run(ctx context.Context, cfg Config, out io.Writer, errOut io.Writer) error
 	if cfg.Validate {
 		cfg.DryRun = true
 	}
+	if cfg.PayloadVersions {
+		return runPayloadVersions(ctx, cfg, out, errOut)
+	}
 	if cfg.Integrity && (cfg.DryRun || cfg.Validate) {
 		return errors.New("-integrity cannot be combined with -dry-run or -validate")
 	}
 	if cfg.Integrity && cfg.AfterSeq > 0 {
 		return errors.New("-integrity does not support -after-seq; replay must start at the beginning")
 	}
+	if cfg.Rebuild && (cfg.DryRun || cfg.Integrity) {
+		return errors.New("-rebuild cannot be combined with -dry-run, -validate or -integrity")
+	}
+	if cfg.Rebuild && cfg.AfterSeq > 0 {
+		return errors.New("-rebuild does not support -after-seq; it starts from the nearest checkpoint")
+	}
 
 	if _, err := resolveCampaignIDs(cfg.CampaignID, cfg.CampaignIDs); err != nil {
 		return err
@@ -151,6 +166,7 @@ func runWithDeps(ctx context.Context, cfg Config, eventStore closableEventStore,
 		DryRun:      cfg.DryRun,
 		Validate:    cfg.Validate,
 		Integrity:   cfg.Integrity,
+		Rebuild:     cfg.Rebuild,
 		WarningsCap: cfg.WarningsCap,
 		JSONOutput:  cfg.JSONOutput,
 	}
@@ -193,12 +209,20 @@ type integrityReport struct {
 	GmFearReplay        int
 }
 
+type rebuildReport struct {
+	CheckpointSeq uint64
+	LastSeq       uint64
+	Applied       int
+	Checkpoints   int
+}
+
 type runOptions struct {
 	AfterSeq    uint64
 	UntilSeq    uint64
 	DryRun      bool
 	Validate    bool
 	Integrity   bool
+	Rebuild     bool
 	WarningsCap int
 	JSONOutput  bool
 }
@@ -263,6 +287,29 @@ func runCampaign(ctx context.Context, eventStore storage.EventStore, projStore s
 		return result
 	}
 
+	if options.Rebuild {
+		result.Mode = "rebuild"
+		rebuilt, err := rebuildCampaign(ctx, eventStore, projStore, campaignID, options.UntilSeq)
+		if err != nil {
+			result.Error = fmt.Sprintf("rebuild projections: %v", err)
+			result.ExitCode = 1
+			return result
+		}
+		payload, err := json.Marshal(rebuildReport{
+			CheckpointSeq: rebuilt.CheckpointSeq,
+			LastSeq:       rebuilt.LastSeq,
+			Applied:       rebuilt.Applied,
+			Checkpoints:   rebuilt.Saved,
+		})
+		if err != nil {
+			result.Error = fmt.Sprintf("encode report: %v", err)
+			result.ExitCode = 1
+			return result
+		}
+		result.Report = payload
+		return result
+	}
+
 	result.Mode = "replay"
 	if projStore == nil {
 		result.Error = "projection store is not configured"
@@ -300,6 +347,140 @@ func runCampaign(ctx context.Context, eventStore storage.EventStore, projStore s
 	return result
 }
 
+// rebuildCampaign rebuilds every projection of a campaign from its nearest
+// projection checkpoint. The event store must keep checkpoints and the
+// projection store must export and import campaign state.
+func rebuildCampaign(ctx context.Context, eventStore storage.EventStore, projStore storage.ProjectionStore, campaignID string, untilSeq uint64) (projection.RebuildResult, error) {
+	if eventStore == nil || projStore == nil {
+		return projection.RebuildResult{}, errors.New("event and projection stores are required")
+	}
+	checkpointStore, ok := eventStore.(storage.ProjectionCheckpointStore)
+	if !ok {
+		return projection.RebuildResult{}, errors.New("event store does not keep projection checkpoints")
+	}
+	stateStore, ok := projStore.(storage.ProjectionStateStore)
+	if !ok {
+		return projection.RebuildResult{}, errors.New("projection store cannot export campaign projections")
+	}
+	adapters := systems.NewAdapterRegistry()
+	adapters.Register(daggerheart.NewAdapter(projStore))
+	applier := projection.Applier{
+		Campaign:     projStore,
+		Character:    projStore,
+		CampaignFork: projStore,
+		Daggerheart:  projStore,
+		ClaimIndex:   projStore,
+		Invite:       projStore,
+		Participant:  projStore,
+		Session:      projStore,
+		Adapters:     adapters,
+	}
+	if gateStore, ok := projStore.(storage.SessionGateStore); ok {
+		applier.SessionGate = gateStore
+	}
+	if spotlightStore, ok := projStore.(storage.SessionSpotlightStore); ok {
+		applier.SessionSpotlight = spotlightStore
+	}
+	checkpoints := projection.Checkpoints{
+		Store:    checkpointStore,
+		State:    stateStore,
+		Interval: projection.DefaultCheckpointInterval,
+	}
+	return projection.RebuildCampaign(ctx, eventStore, applier, checkpoints, campaignID, untilSeq)
+}
+
+type legacyPayloadVersion struct {
+	Type           string   `json:"event_type"`
+	PayloadVersion int      `json:"payload_version"`
+	CurrentVersion int      `json:"current_version"`
+	Events         int      `json:"events"`
+	CampaignIDs    []string `json:"campaign_ids"`
+}
+
+type payloadVersionReport struct {
+	Legacy []legacyPayloadVersion `json:"legacy"`
+}
+
+// runPayloadVersions reports the journaled events whose payload version is
+// older than the current version of their type. It only reads the events
+// database.
+func runPayloadVersions(ctx context.Context, cfg Config, out io.Writer, errOut io.Writer) error {
+	if cfg.DryRun || cfg.Integrity || cfg.Rebuild {
+		return errors.New("-payload-versions cannot be combined with -dry-run, -validate, -integrity or -rebuild")
+	}
+	var campaignIDs []string
+	if cfg.CampaignID != "" || cfg.CampaignIDs != "" {
+		ids, err := resolveCampaignIDs(cfg.CampaignID, cfg.CampaignIDs)
+		if err != nil {
+			return err
+		}
+		campaignIDs = ids
+	}
+	eventStore, err := openEventStore(ctx, cfg.EventsDBPath)
+	if err != nil {
+		return err
+	}
+	defer eventStore.Close()
+
+	report, err := legacyPayloadVersions(ctx, eventStore, campaignIDs)
+	if err != nil {
+		return err
+	}
+	printPayloadVersionReport(out, errOut, report, cfg.JSONOutput)
+	return nil
+}
+
+// legacyPayloadVersions groups legacy payload versions by event type and
+// version, optionally limited to campaignIDs.
+func legacyPayloadVersions(ctx context.Context, store storage.EventPayloadVersionStore, campaignIDs []string) (payloadVersionReport, error) {
+	counts, err := store.ListEventPayloadVersions(ctx)
+	if err != nil {
+		return payloadVersionReport{}, fmt.Errorf("list event payload versions: %w", err)
+	}
+	report := payloadVersionReport{Legacy: []legacyPayloadVersion{}}
+	for _, count := range counts {
+		if len(campaignIDs) > 0 && !slices.Contains(campaignIDs, count.CampaignID) {
+			continue
+		}
+		current := event.CurrentPayloadVersion(count.Type)
+		if count.PayloadVersion >= current {
+			continue
+		}
+		// Counts are ordered by type and version, so each group is contiguous.
+		last := len(report.Legacy) - 1
+		if last < 0 || report.Legacy[last].Type != string(count.Type) || report.Legacy[last].PayloadVersion != count.PayloadVersion {
+			report.Legacy = append(report.Legacy, legacyPayloadVersion{
+				Type:           string(count.Type),
+				PayloadVersion: count.PayloadVersion,
+				CurrentVersion: current,
+			})
+			last++
+		}
+		report.Legacy[last].Events += count.Count
+		report.Legacy[last].CampaignIDs = append(report.Legacy[last].CampaignIDs, count.CampaignID)
+	}
+	return report, nil
+}
+
+func printPayloadVersionReport(out io.Writer, errOut io.Writer, report payloadVersionReport, jsonOutput bool) {
+	if jsonOutput {
+		encoded, err := json.Marshal(report)
+		if err != nil {
+			fmt.Fprintf(errOut, "Error: encode report: %v\n", err)
+			return
+		}
+		fmt.Fprintln(out, string(encoded))
+		return
+	}
+	if len(report.Legacy) == 0 {
+		fmt.Fprintln(out, "No events at legacy payload versions")
+		return
+	}
+	for _, legacy := range report.Legacy {
+		fmt.Fprintf(out, "%s v%d (current v%d): %d events in %d campaigns: %s\n", legacy.Type, legacy.PayloadVersion, legacy.CurrentVersion, legacy.Events, len(legacy.CampaignIDs), strings.Join(legacy.CampaignIDs, ", "))
+	}
+}
+
 func resolveCampaignIDs(singleID, list string) ([]string, error) {
 	if singleID == "" && list == "" {
 		return nil, fmt.Errorf("-campaign-id or -campaign-ids is required")
@@ -374,6 +555,16 @@ func printResult(out io.Writer, errOut io.Writer, result runResult, prefix strin
 		return
 	}
 
+	if result.Mode == "rebuild" {
+		var report rebuildReport
+		if err := json.Unmarshal(result.Report, &report); err != nil {
+			fmt.Fprintf(errOut, "%sError: decode report: %v\n", prefix, err)
+			return
+		}
+		fmt.Fprintf(out, "%sRebuilt projections for campaign %s through seq %d from checkpoint seq %d (%d events applied, %d checkpoints saved)\n", prefix, result.CampaignID, report.LastSeq, report.CheckpointSeq, report.Applied, report.Checkpoints)
+		return
+	}
+
 	var report snapshotScanReport
 	if err := json.Unmarshal(result.Report, &report); err != nil {
 		fmt.Fprintf(errOut, "%sError: decode report: %v\n", prefix, err)
@@ -498,6 +689,10 @@ func isSnapshotEvent(evt event.Event) bool {
 }
 
 func validateSnapshotEvent(evt event.Event) error {
+	evt, err := event.Upcast(evt)
+	if err != nil {
+		return err
+	}
 	switch evt.Type {
 	case daggerheart.EventTypeCharacterStatePatched:
 		var payload daggerheart.CharacterStatePatchedPayload
@@ -592,6 +787,25 @@ func validateSnapshotEvent(evt event.Event) error {
 		if payload.Outcome == "" {
 			return fmt.Errorf("outcome is required")
 		}
+	case daggerheart.EventTypeMultiAttackResolved:
+		var payload daggerheart.MultiAttackResolvedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode multi attack payload: %w", err)
+		}
+		if payload.AttackerID == "" {
+			return fmt.Errorf("attacker id is required")
+		}
+		if payload.RollSeq == 0 {
+			return fmt.Errorf("roll_seq is required")
+		}
+		if len(payload.Targets) == 0 {
+			return fmt.Errorf("targets are required")
+		}
+		for _, target := range payload.Targets {
+			if strings.TrimSpace(target.TargetID) == "" {
+				return fmt.Errorf("targets must not contain empty values")
+			}
+		}
 	case daggerheart.EventTypeReactionResolved:
 		var payload daggerheart.ReactionResolvedPayload
 		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
@@ -606,6 +820,23 @@ func validateSnapshotEvent(evt event.Event) error {
 		if payload.Outcome == "" {
 			return fmt.Errorf("outcome is required")
 		}
+	case daggerheart.EventTypeSpellcastResolved:
+		var payload daggerheart.SpellcastResolvedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode spellcast payload: %w", err)
+		}
+		if payload.CharacterID == "" {
+			return fmt.Errorf("character id is required")
+		}
+		if payload.RollSeq == 0 {
+			return fmt.Errorf("roll_seq is required")
+		}
+		if payload.DomainCardID == "" {
+			return fmt.Errorf("domain card id is required")
+		}
+		if payload.Outcome == "" {
+			return fmt.Errorf("outcome is required")
+		}
 	case daggerheart.EventTypeDamageRollResolved:
 		var payload daggerheart.DamageRollResolvedPayload
 		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
@@ -628,6 +859,292 @@ func validateSnapshotEvent(evt event.Event) error {
 		if payload.After < daggerheart.GMFearMin || payload.After > daggerheart.GMFearMax {
 			return fmt.Errorf("gm fear %d exceeds range %d..%d", payload.After, daggerheart.GMFearMin, daggerheart.GMFearMax)
 		}
+	case daggerheart.EventTypeSceneRangesSet:
+		var payload daggerheart.SceneRangesSetPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode scene ranges payload: %w", err)
+		}
+		if len(payload.Ranges) == 0 {
+			return fmt.Errorf("ranges are required")
+		}
+		for _, sceneRange := range payload.Ranges {
+			if daggerheart.RangeBandIndex(sceneRange.Band) < 0 {
+				return fmt.Errorf("range band %q is invalid", sceneRange.Band)
+			}
+		}
+	case daggerheart.EventTypeSceneEntityMoved:
+		var payload daggerheart.SceneEntityMovedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode scene movement payload: %w", err)
+		}
+		if payload.EntityID == "" {
+			return fmt.Errorf("entity id is required")
+		}
+		if !daggerheart.IsMovementKind(payload.Kind) {
+			return fmt.Errorf("movement kind %q is invalid", payload.Kind)
+		}
+		for _, sceneRange := range payload.Ranges {
+			if daggerheart.RangeBandIndex(sceneRange.Band) < 0 {
+				return fmt.Errorf("range band %q is invalid", sceneRange.Band)
+			}
+		}
+	case daggerheart.EventTypeEnvironmentActivated:
+		var payload daggerheart.EnvironmentActivatedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode environment activated payload: %w", err)
+		}
+		if payload.EnvironmentID == "" {
+			return fmt.Errorf("environment id is required")
+		}
+	case daggerheart.EventTypeEnvironmentShifted:
+		var payload daggerheart.EnvironmentShiftedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode environment shifted payload: %w", err)
+		}
+		if payload.FromEnvironmentID == "" || payload.EnvironmentID == "" {
+			return fmt.Errorf("environment ids are required")
+		}
+	case daggerheart.EventTypeEnvironmentCleared:
+		var payload daggerheart.EnvironmentClearedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode environment cleared payload: %w", err)
+		}
+		if payload.EnvironmentID == "" {
+			return fmt.Errorf("environment id is required")
+		}
+	case daggerheart.EventTypeEnvironmentFeatureUsed:
+		var payload daggerheart.EnvironmentFeatureUsedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode environment feature payload: %w", err)
+		}
+		if payload.FeatureID == "" {
+			return fmt.Errorf("feature id is required")
+		}
+		if !daggerheart.IsEnvironmentFeatureKind(payload.Kind) {
+			return fmt.Errorf("environment feature kind %q is invalid", payload.Kind)
+		}
+		if payload.FearCost < 0 {
+			return fmt.Errorf("fear cost must be non-negative")
+		}
+	case daggerheart.EventTypeItemAcquired:
+		var payload daggerheart.ItemAcquiredPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode item acquired payload: %w", err)
+		}
+		if payload.CharacterID == "" || payload.ItemID == "" {
+			return fmt.Errorf("character and item ids are required")
+		}
+		if !daggerheart.IsInventoryKind(payload.Kind) {
+			return fmt.Errorf("inventory kind %q is invalid", payload.Kind)
+		}
+		if payload.Quantity <= 0 {
+			return fmt.Errorf("quantity must be positive")
+		}
+	case daggerheart.EventTypeItemDropped:
+		var payload daggerheart.ItemDroppedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode item dropped payload: %w", err)
+		}
+		if payload.CharacterID == "" || payload.ItemID == "" {
+			return fmt.Errorf("character and item ids are required")
+		}
+		if payload.Quantity <= 0 || payload.QuantityAfter < 0 {
+			return fmt.Errorf("quantities are invalid")
+		}
+	case daggerheart.EventTypeItemTransferred:
+		var payload daggerheart.ItemTransferredPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode item transferred payload: %w", err)
+		}
+		if payload.FromCharacterID == "" || payload.ToCharacterID == "" || payload.ItemID == "" {
+			return fmt.Errorf("character and item ids are required")
+		}
+		if payload.Quantity <= 0 {
+			return fmt.Errorf("quantity must be positive")
+		}
+	case daggerheart.EventTypeItemEquipped:
+		var payload daggerheart.ItemEquippedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode item equipped payload: %w", err)
+		}
+		if payload.CharacterID == "" || payload.ItemID == "" {
+			return fmt.Errorf("character and item ids are required")
+		}
+		if !daggerheart.IsEquipSlot(payload.Slot) {
+			return fmt.Errorf("equip slot %q is invalid", payload.Slot)
+		}
+	case daggerheart.EventTypeItemUnequipped:
+		var payload daggerheart.ItemUnequippedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode item unequipped payload: %w", err)
+		}
+		if payload.CharacterID == "" || payload.ItemID == "" {
+			return fmt.Errorf("character and item ids are required")
+		}
+		if !daggerheart.IsEquipSlot(payload.Slot) {
+			return fmt.Errorf("equip slot %q is invalid", payload.Slot)
+		}
+	case daggerheart.EventTypeGoldChanged:
+		var payload daggerheart.GoldChangedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode gold changed payload: %w", err)
+		}
+		if payload.CharacterID == "" {
+			return fmt.Errorf("character id is required")
+		}
+		if payload.HandfulsAfter < 0 || payload.BagsAfter < 0 || payload.ChestsAfter < 0 {
+			return fmt.Errorf("gold must be non-negative")
+		}
+	case daggerheart.EventTypeCompanionCreated:
+		var payload daggerheart.CompanionCreatedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode companion created payload: %w", err)
+		}
+		if payload.CharacterID == "" {
+			return fmt.Errorf("character id is required")
+		}
+		if payload.Name == "" {
+			return fmt.Errorf("companion name is required")
+		}
+		if payload.StressMax <= 0 || payload.DamageDieSides <= 0 {
+			return fmt.Errorf("companion stress max and damage die must be positive")
+		}
+	case daggerheart.EventTypeCompanionLeveledUp:
+		var payload daggerheart.CompanionLeveledUpPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode companion leveled up payload: %w", err)
+		}
+		if payload.CharacterID == "" {
+			return fmt.Errorf("character id is required")
+		}
+		if !daggerheart.IsCompanionUpgrade(payload.Upgrade) {
+			return fmt.Errorf("companion upgrade %q is invalid", payload.Upgrade)
+		}
+		if payload.LevelAfter < 2 {
+			return fmt.Errorf("companion level after must be at least 2")
+		}
+	case daggerheart.EventTypeCompanionStressChanged:
+		var payload daggerheart.CompanionStressChangedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode companion stress changed payload: %w", err)
+		}
+		if payload.CharacterID == "" {
+			return fmt.Errorf("character id is required")
+		}
+		if payload.StressBefore < 0 || payload.StressAfter < 0 {
+			return fmt.Errorf("companion stress must be non-negative")
+		}
+	case daggerheart.EventTypeBeastformEntered:
+		var payload daggerheart.BeastformEnteredPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode beastform entered payload: %w", err)
+		}
+		if payload.CharacterID == "" {
+			return fmt.Errorf("character id is required")
+		}
+		if payload.BeastformID == "" {
+			return fmt.Errorf("beastform id is required")
+		}
+		if payload.StressAfter < payload.StressBefore {
+			return fmt.Errorf("beastform stress after must not be below stress before")
+		}
+	case daggerheart.EventTypeBeastformExited:
+		var payload daggerheart.BeastformExitedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode beastform exited payload: %w", err)
+		}
+		if payload.CharacterID == "" {
+			return fmt.Errorf("character id is required")
+		}
+		if payload.Reason == "" {
+			return fmt.Errorf("beastform exit reason is required")
+		}
+	case daggerheart.EventTypeEffectApplied:
+		var payload daggerheart.EffectAppliedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode effect applied payload: %w", err)
+		}
+		if payload.EffectID == "" {
+			return fmt.Errorf("effect id is required")
+		}
+		if payload.TargetID == "" {
+			return fmt.Errorf("effect target id is required")
+		}
+		if _, err := daggerheart.NormalizeEffectKind(payload.Kind); err != nil {
+			return err
+		}
+		if _, err := daggerheart.NormalizeEffectExpiry(payload.ExpiresOn); err != nil {
+			return err
+		}
+	case daggerheart.EventTypeEffectExpired:
+		var payload daggerheart.EffectExpiredPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode effect expired payload: %w", err)
+		}
+		if payload.EffectID == "" {
+			return fmt.Errorf("effect id is required")
+		}
+		if payload.Reason == "" {
+			return fmt.Errorf("effect expiry reason is required")
+		}
+	case daggerheart.EventTypeCountdownTriggered:
+		var payload daggerheart.CountdownTriggeredPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode countdown triggered payload: %w", err)
+		}
+		if payload.CountdownID == "" {
+			return fmt.Errorf("countdown id is required")
+		}
+		if _, err := daggerheart.NormalizeCountdownTrigger(payload.Trigger); err != nil {
+			return err
+		}
+	case daggerheart.EventTypeChaseStarted:
+		var payload daggerheart.ChaseStartedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode chase started payload: %w", err)
+		}
+		if payload.ChaseID == "" {
+			return fmt.Errorf("chase id is required")
+		}
+		if payload.ProgressCountdownID == "" || payload.ConsequenceCountdownID == "" {
+			return fmt.Errorf("chase countdown ids are required")
+		}
+	case daggerheart.EventTypeChaseResolved:
+		var payload daggerheart.ChaseResolvedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode chase resolved payload: %w", err)
+		}
+		if payload.ChaseID == "" {
+			return fmt.Errorf("chase id is required")
+		}
+		if _, err := daggerheart.NormalizeChaseWinner(payload.Winner); err != nil {
+			return err
+		}
+	case daggerheart.EventTypeAdversaryFeatureUsed:
+		var payload daggerheart.AdversaryFeatureUsedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode adversary feature used payload: %w", err)
+		}
+		if payload.AdversaryID == "" {
+			return fmt.Errorf("adversary id is required")
+		}
+		if payload.FeatureID == "" {
+			return fmt.Errorf("feature id is required")
+		}
+		if payload.FearCost < 0 {
+			return fmt.Errorf("fear cost must be non-negative")
+		}
+	case daggerheart.EventTypeAdversaryFeatureRefreshed:
+		var payload daggerheart.AdversaryFeatureRefreshedPayload
+		if err := json.Unmarshal(evt.PayloadJSON, &payload); err != nil {
+			return fmt.Errorf("decode adversary feature refreshed payload: %w", err)
+		}
+		if payload.AdversaryID == "" {
+			return fmt.Errorf("adversary id is required")
+		}
+		if payload.FeatureID == "" {
+			return fmt.Errorf("feature id is required")
+		}
 	}
 	return nil
 }
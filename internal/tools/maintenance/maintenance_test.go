@@ -290,6 +290,20 @@ func TestPrintResult(t *testing.T) {
 		}
 	})
 
+	t.Run("rebuild mode", func(t *testing.T) {
+		var out, errOut bytes.Buffer
+		reportJSON, _ := json.Marshal(rebuildReport{CheckpointSeq: 500, LastSeq: 642, Applied: 142})
+		result := runResult{
+			CampaignID: "c1",
+			Mode:       "rebuild",
+			Report:     reportJSON,
+		}
+		printResult(&out, &errOut, result, "")
+		if !strings.Contains(out.String(), "Rebuilt projections for campaign c1 through seq 642 from checkpoint seq 500 (142 events applied") {
+			t.Errorf("expected rebuild output: %s", out.String())
+		}
+	})
+
 	t.Run("invalid scan JSON", func(t *testing.T) {
 		var out, errOut bytes.Buffer
 		result := runResult{
@@ -341,6 +355,41 @@ func TestRunValidationErrors(t *testing.T) {
 		}
 	})
 
+	t.Run("rebuild with dry-run", func(t *testing.T) {
+		cfg := Config{
+			CampaignID: "c1",
+			Rebuild:    true,
+			DryRun:     true,
+		}
+		err := Run(t.Context(), cfg, nil, nil)
+		if err == nil || !strings.Contains(err.Error(), "-rebuild cannot be combined") {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("rebuild with after-seq", func(t *testing.T) {
+		cfg := Config{
+			CampaignID: "c1",
+			Rebuild:    true,
+			AfterSeq:   10,
+		}
+		err := Run(t.Context(), cfg, nil, nil)
+		if err == nil || !strings.Contains(err.Error(), "-rebuild does not support -after-seq") {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+	})
+
+	t.Run("payload versions with integrity", func(t *testing.T) {
+		cfg := Config{
+			PayloadVersions: true,
+			Integrity:       true,
+		}
+		err := Run(t.Context(), cfg, nil, nil)
+		if err == nil || !strings.Contains(err.Error(), "-payload-versions cannot be combined") {
+			t.Fatalf("expected validation error, got %v", err)
+		}
+	})
+
 	t.Run("no campaign IDs", func(t *testing.T) {
 		cfg := Config{}
 		err := Run(t.Context(), cfg, nil, nil)
@@ -846,6 +895,17 @@ func TestRunCampaignReplayNilProjStore(t *testing.T) {
 	}
 }
 
+func TestRunCampaignRebuildRequiresCheckpointStores(t *testing.T) {
+	store := &fakeEventStore{events: map[string][]event.Event{}}
+	result := runCampaign(t.Context(), store, &fakeProjectionStore{}, "c1", runOptions{Rebuild: true}, io.Discard)
+	if result.Mode != "rebuild" || result.ExitCode != 1 {
+		t.Fatalf("result = %+v, want failed rebuild", result)
+	}
+	if !strings.Contains(result.Error, "projection checkpoints") {
+		t.Fatalf("expected checkpoint store error, got %s", result.Error)
+	}
+}
+
 func TestRunCampaignScanError(t *testing.T) {
 	store := &fakeEventStore{listErr: fmt.Errorf("db error")}
 	result := runCampaign(t.Context(), store, nil, "c1", runOptions{DryRun: true, WarningsCap: 25}, io.Discard)
@@ -1249,3 +1309,78 @@ func TestCheckIntegrityWithStores_CampaignLoadError(t *testing.T) {
 		t.Fatalf("expected load campaign error, got: %v", err)
 	}
 }
+
+// --- payload version report tests ---
+
+// typeMaintenanceVersioned has one upcaster, so version 1 is legacy.
+const typeMaintenanceVersioned event.Type = "test.maintenance_versioned"
+
+func init() {
+	event.RegisterUpcaster(typeMaintenanceVersioned, 1, func(payload []byte) ([]byte, error) {
+		return payload, nil
+	})
+}
+
+type fakePayloadVersionStore struct {
+	counts []storage.EventPayloadVersionCount
+	err    error
+}
+
+func (f fakePayloadVersionStore) ListEventPayloadVersions(context.Context) ([]storage.EventPayloadVersionCount, error) {
+	return f.counts, f.err
+}
+
+func TestLegacyPayloadVersions(t *testing.T) {
+	store := fakePayloadVersionStore{counts: []storage.EventPayloadVersionCount{
+		{CampaignID: "c1", Type: event.TypeCampaignCreated, PayloadVersion: 1, Count: 1},
+		{CampaignID: "c1", Type: typeMaintenanceVersioned, PayloadVersion: 1, Count: 3},
+		{CampaignID: "c2", Type: typeMaintenanceVersioned, PayloadVersion: 1, Count: 2},
+		{CampaignID: "c2", Type: typeMaintenanceVersioned, PayloadVersion: 2, Count: 4},
+	}}
+
+	report, err := legacyPayloadVersions(t.Context(), store, nil)
+	if err != nil {
+		t.Fatalf("legacy payload versions: %v", err)
+	}
+	want := []legacyPayloadVersion{{
+		Type:           string(typeMaintenanceVersioned),
+		PayloadVersion: 1,
+		CurrentVersion: 2,
+		Events:         5,
+		CampaignIDs:    []string{"c1", "c2"},
+	}}
+	if !reflect.DeepEqual(report.Legacy, want) {
+		t.Fatalf("legacy = %+v, want %+v", report.Legacy, want)
+	}
+
+	report, err = legacyPayloadVersions(t.Context(), store, []string{"c2"})
+	if err != nil {
+		t.Fatalf("legacy payload versions: %v", err)
+	}
+	if len(report.Legacy) != 1 || report.Legacy[0].Events != 2 || !reflect.DeepEqual(report.Legacy[0].CampaignIDs, []string{"c2"}) {
+		t.Fatalf("filtered legacy = %+v", report.Legacy)
+	}
+
+	var out bytes.Buffer
+	printPayloadVersionReport(&out, io.Discard, report, false)
+	if got := out.String(); got != "test.maintenance_versioned v1 (current v2): 2 events in 1 campaigns: c2\n" {
+		t.Fatalf("output = %q", got)
+	}
+
+	if _, err := legacyPayloadVersions(t.Context(), fakePayloadVersionStore{err: fmt.Errorf("boom")}, nil); err == nil {
+		t.Fatal("expected store error")
+	}
+}
+
+func TestPrintPayloadVersionReportEmpty(t *testing.T) {
+	var out bytes.Buffer
+	printPayloadVersionReport(&out, io.Discard, payloadVersionReport{Legacy: []legacyPayloadVersion{}}, false)
+	if !strings.Contains(out.String(), "No events at legacy payload versions") {
+		t.Fatalf("output = %q", out.String())
+	}
+	out.Reset()
+	printPayloadVersionReport(&out, io.Discard, payloadVersionReport{Legacy: []legacyPayloadVersion{}}, true)
+	if strings.TrimSpace(out.String()) != `{"legacy":[]}` {
+		t.Fatalf("json output = %q", out.String())
+	}
+}
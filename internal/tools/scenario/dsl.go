@@ -227,6 +227,30 @@ var scenarioMethods = []lua.RegistryFunction{
 	{Name: "countdown_create", Function: scenarioCountdownCreate},
 	{Name: "countdown_update", Function: scenarioCountdownUpdate},
 	{Name: "countdown_delete", Function: scenarioCountdownDelete},
+	{Name: "countdown_expect", Function: scenarioCountdownExpect},
+	{Name: "chase_start", Function: scenarioChaseStart},
+	{Name: "chase_expect", Function: scenarioChaseExpect},
+	{Name: "set_range", Function: scenarioSetRange},
+	{Name: "move", Function: scenarioMove},
+	{Name: "expect_range", Function: scenarioExpectRange},
+	{Name: "environment", Function: scenarioEnvironment},
+	{Name: "environment_shift", Function: scenarioEnvironmentShift},
+	{Name: "environment_clear", Function: scenarioEnvironmentClear},
+	{Name: "environment_feature", Function: scenarioEnvironmentFeature},
+	{Name: "acquire_item", Function: scenarioAcquireItem},
+	{Name: "drop_item", Function: scenarioDropItem},
+	{Name: "transfer_item", Function: scenarioTransferItem},
+	{Name: "equip", Function: scenarioEquip},
+	{Name: "unequip", Function: scenarioUnequip},
+	{Name: "gold", Function: scenarioGold},
+	{Name: "companion", Function: scenarioCompanion},
+	{Name: "companion_level_up", Function: scenarioCompanionLevelUp},
+	{Name: "companion_stress", Function: scenarioCompanionStress},
+	{Name: "companion_attack", Function: scenarioCompanionAttack},
+	{Name: "beastform", Function: scenarioBeastform},
+	{Name: "beastform_exit", Function: scenarioBeastformExit},
+	{Name: "effect", Function: scenarioEffect},
+	{Name: "effect_remove", Function: scenarioEffectRemove},
 	{Name: "action_roll", Function: scenarioActionRoll},
 	{Name: "reaction_roll", Function: scenarioReactionRoll},
 	{Name: "damage_roll", Function: scenarioDamageRoll},
@@ -474,6 +498,198 @@ func scenarioCountdownDelete(state *lua.State) int {
 	return 0
 }
 
+func scenarioCountdownExpect(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "countdown_expect", data)
+	return 0
+}
+
+func scenarioChaseStart(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "chase_start", data)
+	return 0
+}
+
+func scenarioChaseExpect(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "chase_expect", data)
+	return 0
+}
+
+func scenarioSetRange(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "set_range", data)
+	return 0
+}
+
+func scenarioMove(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "move", data)
+	return 0
+}
+
+func scenarioExpectRange(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "expect_range", data)
+	return 0
+}
+
+func scenarioEnvironment(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "environment", data)
+	return 0
+}
+
+func scenarioEnvironmentShift(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "environment_shift", data)
+	return 0
+}
+
+func scenarioEnvironmentClear(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "environment_clear", data)
+	return 0
+}
+
+func scenarioEnvironmentFeature(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "environment_feature", data)
+	return 0
+}
+
+func scenarioAcquireItem(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "acquire_item", data)
+	return 0
+}
+
+func scenarioDropItem(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "drop_item", data)
+	return 0
+}
+
+func scenarioTransferItem(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "transfer_item", data)
+	return 0
+}
+
+func scenarioEquip(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "equip", data)
+	return 0
+}
+
+func scenarioUnequip(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "unequip", data)
+	return 0
+}
+
+func scenarioGold(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "gold", data)
+	return 0
+}
+
+func scenarioCompanion(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "companion", data)
+	return 0
+}
+
+func scenarioCompanionLevelUp(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "companion_level_up", data)
+	return 0
+}
+
+func scenarioCompanionStress(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "companion_stress", data)
+	return 0
+}
+
+func scenarioCompanionAttack(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "companion_attack", data)
+	return 0
+}
+
+func scenarioBeastform(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "beastform", data)
+	return 0
+}
+
+func scenarioBeastformExit(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "beastform_exit", data)
+	return 0
+}
+
+func scenarioEffect(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "effect", data)
+	return 0
+}
+
+func scenarioEffectRemove(state *lua.State) int {
+	scenario := checkScenario(state)
+	lua.CheckType(state, 2, lua.TypeTable)
+	data := tableToMap(state, 2)
+	appendStep(scenario, "effect_remove", data)
+	return 0
+}
+
 func scenarioActionRoll(state *lua.State) int {
 	scenario := checkScenario(state)
 	lua.CheckType(state, 2, lua.TypeTable)
@@ -148,6 +148,11 @@ func (f *fakeEventClient) ListTimelineEntries(context.Context, *gamev1.ListTimel
 	return nil, unimplemented("ListTimelineEntries")
 }
 
+// SubscribeEvents is a stub so the fake satisfies EventServiceClient.
+func (f *fakeEventClient) SubscribeEvents(context.Context, *gamev1.SubscribeEventsRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[gamev1.SubscribeEventsResponse], error) {
+	return nil, unimplemented("SubscribeEvents")
+}
+
 type fakeSnapshotClient struct {
 	patchState     func(context.Context, *gamev1.PatchCharacterStateRequest, ...grpc.CallOption) (*gamev1.PatchCharacterStateResponse, error)
 	getSnapshot    func(context.Context, *gamev1.GetSnapshotRequest, ...grpc.CallOption) (*gamev1.GetSnapshotResponse, error)
@@ -245,38 +250,72 @@ func (f *fakeSessionClient) ClearSessionSpotlight(ctx context.Context, in *gamev
 
 // fakeDaggerheartClient implements daggerheartv1.DaggerheartServiceClient for testing.
 type fakeDaggerheartClient struct {
-	actionRoll                  func(context.Context, *daggerheartv1.ActionRollRequest, ...grpc.CallOption) (*daggerheartv1.ActionRollResponse, error)
-	rollDice                    func(context.Context, *daggerheartv1.RollDiceRequest, ...grpc.CallOption) (*daggerheartv1.RollDiceResponse, error)
-	applyDamage                 func(context.Context, *daggerheartv1.DaggerheartApplyDamageRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyDamageResponse, error)
-	applyAdversaryDamage        func(context.Context, *daggerheartv1.DaggerheartApplyAdversaryDamageRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyAdversaryDamageResponse, error)
-	applyRest                   func(context.Context, *daggerheartv1.DaggerheartApplyRestRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyRestResponse, error)
-	applyDowntimeMove           func(context.Context, *daggerheartv1.DaggerheartApplyDowntimeMoveRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyDowntimeMoveResponse, error)
-	swapLoadout                 func(context.Context, *daggerheartv1.DaggerheartSwapLoadoutRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartSwapLoadoutResponse, error)
-	applyDeathMove              func(context.Context, *daggerheartv1.DaggerheartApplyDeathMoveRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyDeathMoveResponse, error)
-	applyConditions             func(context.Context, *daggerheartv1.DaggerheartApplyConditionsRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyConditionsResponse, error)
-	applyAdversaryConditions    func(context.Context, *daggerheartv1.DaggerheartApplyAdversaryConditionsRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyAdversaryConditionsResponse, error)
-	applyGmMove                 func(context.Context, *daggerheartv1.DaggerheartApplyGmMoveRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyGmMoveResponse, error)
-	createCountdown             func(context.Context, *daggerheartv1.DaggerheartCreateCountdownRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartCreateCountdownResponse, error)
-	updateCountdown             func(context.Context, *daggerheartv1.DaggerheartUpdateCountdownRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartUpdateCountdownResponse, error)
-	deleteCountdown             func(context.Context, *daggerheartv1.DaggerheartDeleteCountdownRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartDeleteCountdownResponse, error)
-	createAdversary             func(context.Context, *daggerheartv1.DaggerheartCreateAdversaryRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartCreateAdversaryResponse, error)
-	updateAdversary             func(context.Context, *daggerheartv1.DaggerheartUpdateAdversaryRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartUpdateAdversaryResponse, error)
-	deleteAdversary             func(context.Context, *daggerheartv1.DaggerheartDeleteAdversaryRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartDeleteAdversaryResponse, error)
-	getAdversary                func(context.Context, *daggerheartv1.DaggerheartGetAdversaryRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartGetAdversaryResponse, error)
-	listAdversaries             func(context.Context, *daggerheartv1.DaggerheartListAdversariesRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartListAdversariesResponse, error)
-	resolveBlazeOfGlory         func(context.Context, *daggerheartv1.DaggerheartResolveBlazeOfGloryRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartResolveBlazeOfGloryResponse, error)
-	sessionActionRoll           func(context.Context, *daggerheartv1.SessionActionRollRequest, ...grpc.CallOption) (*daggerheartv1.SessionActionRollResponse, error)
-	sessionDamageRoll           func(context.Context, *daggerheartv1.SessionDamageRollRequest, ...grpc.CallOption) (*daggerheartv1.SessionDamageRollResponse, error)
-	sessionAttackFlow           func(context.Context, *daggerheartv1.SessionAttackFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionAttackFlowResponse, error)
-	sessionReactionFlow         func(context.Context, *daggerheartv1.SessionReactionFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionReactionFlowResponse, error)
-	sessionAdversaryAttackRoll  func(context.Context, *daggerheartv1.SessionAdversaryAttackRollRequest, ...grpc.CallOption) (*daggerheartv1.SessionAdversaryAttackRollResponse, error)
-	sessionAdversaryAttackFlow  func(context.Context, *daggerheartv1.SessionAdversaryAttackFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionAdversaryAttackFlowResponse, error)
-	sessionGroupActionFlow      func(context.Context, *daggerheartv1.SessionGroupActionFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionGroupActionFlowResponse, error)
-	sessionTagTeamFlow          func(context.Context, *daggerheartv1.SessionTagTeamFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionTagTeamFlowResponse, error)
-	applyRollOutcome            func(context.Context, *daggerheartv1.ApplyRollOutcomeRequest, ...grpc.CallOption) (*daggerheartv1.ApplyRollOutcomeResponse, error)
-	applyAttackOutcome          func(context.Context, *daggerheartv1.DaggerheartApplyAttackOutcomeRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyAttackOutcomeResponse, error)
-	applyAdversaryAttackOutcome func(context.Context, *daggerheartv1.DaggerheartApplyAdversaryAttackOutcomeRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyAdversaryAttackOutcomeResponse, error)
-	applyReactionOutcome        func(context.Context, *daggerheartv1.DaggerheartApplyReactionOutcomeRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyReactionOutcomeResponse, error)
+	actionRoll                      func(context.Context, *daggerheartv1.ActionRollRequest, ...grpc.CallOption) (*daggerheartv1.ActionRollResponse, error)
+	rollDice                        func(context.Context, *daggerheartv1.RollDiceRequest, ...grpc.CallOption) (*daggerheartv1.RollDiceResponse, error)
+	applyDamage                     func(context.Context, *daggerheartv1.DaggerheartApplyDamageRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyDamageResponse, error)
+	applyAdversaryDamage            func(context.Context, *daggerheartv1.DaggerheartApplyAdversaryDamageRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyAdversaryDamageResponse, error)
+	applyRest                       func(context.Context, *daggerheartv1.DaggerheartApplyRestRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyRestResponse, error)
+	applyDowntimeMove               func(context.Context, *daggerheartv1.DaggerheartApplyDowntimeMoveRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyDowntimeMoveResponse, error)
+	swapLoadout                     func(context.Context, *daggerheartv1.DaggerheartSwapLoadoutRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartSwapLoadoutResponse, error)
+	applyDeathMove                  func(context.Context, *daggerheartv1.DaggerheartApplyDeathMoveRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyDeathMoveResponse, error)
+	applyConditions                 func(context.Context, *daggerheartv1.DaggerheartApplyConditionsRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyConditionsResponse, error)
+	applyAdversaryConditions        func(context.Context, *daggerheartv1.DaggerheartApplyAdversaryConditionsRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyAdversaryConditionsResponse, error)
+	applyGmMove                     func(context.Context, *daggerheartv1.DaggerheartApplyGmMoveRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyGmMoveResponse, error)
+	createCountdown                 func(context.Context, *daggerheartv1.DaggerheartCreateCountdownRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartCreateCountdownResponse, error)
+	updateCountdown                 func(context.Context, *daggerheartv1.DaggerheartUpdateCountdownRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartUpdateCountdownResponse, error)
+	deleteCountdown                 func(context.Context, *daggerheartv1.DaggerheartDeleteCountdownRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartDeleteCountdownResponse, error)
+	setSceneRanges                  func(context.Context, *daggerheartv1.DaggerheartSetSceneRangesRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartSetSceneRangesResponse, error)
+	moveSceneEntity                 func(context.Context, *daggerheartv1.DaggerheartMoveSceneEntityRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartMoveSceneEntityResponse, error)
+	listSceneRanges                 func(context.Context, *daggerheartv1.DaggerheartListSceneRangesRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartListSceneRangesResponse, error)
+	createSessionEnvironment        func(context.Context, *daggerheartv1.DaggerheartCreateSessionEnvironmentRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartCreateSessionEnvironmentResponse, error)
+	shiftSessionEnvironment         func(context.Context, *daggerheartv1.DaggerheartShiftSessionEnvironmentRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartShiftSessionEnvironmentResponse, error)
+	clearSessionEnvironment         func(context.Context, *daggerheartv1.DaggerheartClearSessionEnvironmentRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartClearSessionEnvironmentResponse, error)
+	getSessionEnvironment           func(context.Context, *daggerheartv1.DaggerheartGetSessionEnvironmentRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartGetSessionEnvironmentResponse, error)
+	triggerEnvironmentFeature       func(context.Context, *daggerheartv1.DaggerheartTriggerEnvironmentFeatureRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartTriggerEnvironmentFeatureResponse, error)
+	createAdversary                 func(context.Context, *daggerheartv1.DaggerheartCreateAdversaryRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartCreateAdversaryResponse, error)
+	updateAdversary                 func(context.Context, *daggerheartv1.DaggerheartUpdateAdversaryRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartUpdateAdversaryResponse, error)
+	deleteAdversary                 func(context.Context, *daggerheartv1.DaggerheartDeleteAdversaryRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartDeleteAdversaryResponse, error)
+	getAdversary                    func(context.Context, *daggerheartv1.DaggerheartGetAdversaryRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartGetAdversaryResponse, error)
+	listAdversaries                 func(context.Context, *daggerheartv1.DaggerheartListAdversariesRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartListAdversariesResponse, error)
+	resolveBlazeOfGlory             func(context.Context, *daggerheartv1.DaggerheartResolveBlazeOfGloryRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartResolveBlazeOfGloryResponse, error)
+	sessionActionRoll               func(context.Context, *daggerheartv1.SessionActionRollRequest, ...grpc.CallOption) (*daggerheartv1.SessionActionRollResponse, error)
+	sessionDamageRoll               func(context.Context, *daggerheartv1.SessionDamageRollRequest, ...grpc.CallOption) (*daggerheartv1.SessionDamageRollResponse, error)
+	sessionAttackFlow               func(context.Context, *daggerheartv1.SessionAttackFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionAttackFlowResponse, error)
+	sessionMultiAttackFlow          func(context.Context, *daggerheartv1.SessionMultiAttackFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionMultiAttackFlowResponse, error)
+	sessionSpellcastFlow            func(context.Context, *daggerheartv1.SessionSpellcastFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionSpellcastFlowResponse, error)
+	sessionReactionFlow             func(context.Context, *daggerheartv1.SessionReactionFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionReactionFlowResponse, error)
+	sessionAdversaryAttackRoll      func(context.Context, *daggerheartv1.SessionAdversaryAttackRollRequest, ...grpc.CallOption) (*daggerheartv1.SessionAdversaryAttackRollResponse, error)
+	sessionAdversaryAttackFlow      func(context.Context, *daggerheartv1.SessionAdversaryAttackFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionAdversaryAttackFlowResponse, error)
+	sessionAdversaryMultiAttackFlow func(context.Context, *daggerheartv1.SessionAdversaryMultiAttackFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionAdversaryMultiAttackFlowResponse, error)
+	sessionGroupActionFlow          func(context.Context, *daggerheartv1.SessionGroupActionFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionGroupActionFlowResponse, error)
+	sessionTagTeamFlow              func(context.Context, *daggerheartv1.SessionTagTeamFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionTagTeamFlowResponse, error)
+	applyRollOutcome                func(context.Context, *daggerheartv1.ApplyRollOutcomeRequest, ...grpc.CallOption) (*daggerheartv1.ApplyRollOutcomeResponse, error)
+	applyAttackOutcome              func(context.Context, *daggerheartv1.DaggerheartApplyAttackOutcomeRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyAttackOutcomeResponse, error)
+	applyAdversaryAttackOutcome     func(context.Context, *daggerheartv1.DaggerheartApplyAdversaryAttackOutcomeRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyAdversaryAttackOutcomeResponse, error)
+	applyReactionOutcome            func(context.Context, *daggerheartv1.DaggerheartApplyReactionOutcomeRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyReactionOutcomeResponse, error)
+	levelUp                         func(context.Context, *daggerheartv1.DaggerheartLevelUpRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartLevelUpResponse, error)
+	acquireItem                     func(context.Context, *daggerheartv1.DaggerheartAcquireItemRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartAcquireItemResponse, error)
+	dropItem                        func(context.Context, *daggerheartv1.DaggerheartDropItemRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartDropItemResponse, error)
+	transferItem                    func(context.Context, *daggerheartv1.DaggerheartTransferItemRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartTransferItemResponse, error)
+	equipItem                       func(context.Context, *daggerheartv1.DaggerheartEquipItemRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartEquipItemResponse, error)
+	unequipItem                     func(context.Context, *daggerheartv1.DaggerheartUnequipItemRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartUnequipItemResponse, error)
+	updateGold                      func(context.Context, *daggerheartv1.DaggerheartUpdateGoldRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartUpdateGoldResponse, error)
+	createCompanion                 func(context.Context, *daggerheartv1.DaggerheartCreateCompanionRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartCreateCompanionResponse, error)
+	levelUpCompanion                func(context.Context, *daggerheartv1.DaggerheartLevelUpCompanionRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartLevelUpCompanionResponse, error)
+	updateCompanionStress           func(context.Context, *daggerheartv1.DaggerheartUpdateCompanionStressRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartUpdateCompanionStressResponse, error)
+	sessionCompanionAttackFlow      func(context.Context, *daggerheartv1.SessionCompanionAttackFlowRequest, ...grpc.CallOption) (*daggerheartv1.SessionCompanionAttackFlowResponse, error)
+	enterBeastform                  func(context.Context, *daggerheartv1.DaggerheartEnterBeastformRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartEnterBeastformResponse, error)
+	exitBeastform                   func(context.Context, *daggerheartv1.DaggerheartExitBeastformRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartExitBeastformResponse, error)
+	applyEffect                     func(context.Context, *daggerheartv1.DaggerheartApplyEffectRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyEffectResponse, error)
+	removeEffect                    func(context.Context, *daggerheartv1.DaggerheartRemoveEffectRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartRemoveEffectResponse, error)
+	listEffects                     func(context.Context, *daggerheartv1.DaggerheartListEffectsRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartListEffectsResponse, error)
+	startChase                      func(context.Context, *daggerheartv1.DaggerheartStartChaseRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartStartChaseResponse, error)
+	getChase                        func(context.Context, *daggerheartv1.DaggerheartGetChaseRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartGetChaseResponse, error)
+	listChases                      func(context.Context, *daggerheartv1.DaggerheartListChasesRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartListChasesResponse, error)
+	activateAdversaryFeature        func(context.Context, *daggerheartv1.DaggerheartActivateAdversaryFeatureRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartActivateAdversaryFeatureResponse, error)
+	listAdversaryFeatureStates      func(context.Context, *daggerheartv1.DaggerheartListAdversaryFeatureStatesRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartListAdversaryFeatureStatesResponse, error)
+	planEncounter                   func(context.Context, *daggerheartv1.DaggerheartPlanEncounterRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartPlanEncounterResponse, error)
+	commitEncounter                 func(context.Context, *daggerheartv1.DaggerheartCommitEncounterRequest, ...grpc.CallOption) (*daggerheartv1.DaggerheartCommitEncounterResponse, error)
 }
 
 func (f *fakeDaggerheartClient) ActionRoll(ctx context.Context, in *daggerheartv1.ActionRollRequest, opts ...grpc.CallOption) (*daggerheartv1.ActionRollResponse, error) {
@@ -393,6 +432,62 @@ func (f *fakeDaggerheartClient) DeleteCountdown(ctx context.Context, in *daggerh
 	return nil, unimplemented("DeleteCountdown")
 }
 
+func (f *fakeDaggerheartClient) SetSceneRanges(ctx context.Context, in *daggerheartv1.DaggerheartSetSceneRangesRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartSetSceneRangesResponse, error) {
+	if f.setSceneRanges != nil {
+		return f.setSceneRanges(ctx, in, opts...)
+	}
+	return nil, unimplemented("SetSceneRanges")
+}
+
+func (f *fakeDaggerheartClient) MoveSceneEntity(ctx context.Context, in *daggerheartv1.DaggerheartMoveSceneEntityRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartMoveSceneEntityResponse, error) {
+	if f.moveSceneEntity != nil {
+		return f.moveSceneEntity(ctx, in, opts...)
+	}
+	return nil, unimplemented("MoveSceneEntity")
+}
+
+func (f *fakeDaggerheartClient) ListSceneRanges(ctx context.Context, in *daggerheartv1.DaggerheartListSceneRangesRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartListSceneRangesResponse, error) {
+	if f.listSceneRanges != nil {
+		return f.listSceneRanges(ctx, in, opts...)
+	}
+	return nil, unimplemented("ListSceneRanges")
+}
+
+func (f *fakeDaggerheartClient) CreateSessionEnvironment(ctx context.Context, in *daggerheartv1.DaggerheartCreateSessionEnvironmentRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartCreateSessionEnvironmentResponse, error) {
+	if f.createSessionEnvironment != nil {
+		return f.createSessionEnvironment(ctx, in, opts...)
+	}
+	return nil, unimplemented("CreateSessionEnvironment")
+}
+
+func (f *fakeDaggerheartClient) ShiftSessionEnvironment(ctx context.Context, in *daggerheartv1.DaggerheartShiftSessionEnvironmentRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartShiftSessionEnvironmentResponse, error) {
+	if f.shiftSessionEnvironment != nil {
+		return f.shiftSessionEnvironment(ctx, in, opts...)
+	}
+	return nil, unimplemented("ShiftSessionEnvironment")
+}
+
+func (f *fakeDaggerheartClient) ClearSessionEnvironment(ctx context.Context, in *daggerheartv1.DaggerheartClearSessionEnvironmentRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartClearSessionEnvironmentResponse, error) {
+	if f.clearSessionEnvironment != nil {
+		return f.clearSessionEnvironment(ctx, in, opts...)
+	}
+	return nil, unimplemented("ClearSessionEnvironment")
+}
+
+func (f *fakeDaggerheartClient) GetSessionEnvironment(ctx context.Context, in *daggerheartv1.DaggerheartGetSessionEnvironmentRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartGetSessionEnvironmentResponse, error) {
+	if f.getSessionEnvironment != nil {
+		return f.getSessionEnvironment(ctx, in, opts...)
+	}
+	return nil, unimplemented("GetSessionEnvironment")
+}
+
+func (f *fakeDaggerheartClient) TriggerEnvironmentFeature(ctx context.Context, in *daggerheartv1.DaggerheartTriggerEnvironmentFeatureRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartTriggerEnvironmentFeatureResponse, error) {
+	if f.triggerEnvironmentFeature != nil {
+		return f.triggerEnvironmentFeature(ctx, in, opts...)
+	}
+	return nil, unimplemented("TriggerEnvironmentFeature")
+}
+
 func (f *fakeDaggerheartClient) CreateAdversary(ctx context.Context, in *daggerheartv1.DaggerheartCreateAdversaryRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartCreateAdversaryResponse, error) {
 	if f.createAdversary != nil {
 		return f.createAdversary(ctx, in, opts...)
@@ -456,6 +551,27 @@ func (f *fakeDaggerheartClient) SessionAttackFlow(ctx context.Context, in *dagge
 	return nil, unimplemented("SessionAttackFlow")
 }
 
+func (f *fakeDaggerheartClient) SessionMultiAttackFlow(ctx context.Context, in *daggerheartv1.SessionMultiAttackFlowRequest, opts ...grpc.CallOption) (*daggerheartv1.SessionMultiAttackFlowResponse, error) {
+	if f.sessionMultiAttackFlow != nil {
+		return f.sessionMultiAttackFlow(ctx, in, opts...)
+	}
+	return nil, unimplemented("SessionMultiAttackFlow")
+}
+
+func (f *fakeDaggerheartClient) SessionAdversaryMultiAttackFlow(ctx context.Context, in *daggerheartv1.SessionAdversaryMultiAttackFlowRequest, opts ...grpc.CallOption) (*daggerheartv1.SessionAdversaryMultiAttackFlowResponse, error) {
+	if f.sessionAdversaryMultiAttackFlow != nil {
+		return f.sessionAdversaryMultiAttackFlow(ctx, in, opts...)
+	}
+	return nil, unimplemented("SessionAdversaryMultiAttackFlow")
+}
+
+func (f *fakeDaggerheartClient) SessionSpellcastFlow(ctx context.Context, in *daggerheartv1.SessionSpellcastFlowRequest, opts ...grpc.CallOption) (*daggerheartv1.SessionSpellcastFlowResponse, error) {
+	if f.sessionSpellcastFlow != nil {
+		return f.sessionSpellcastFlow(ctx, in, opts...)
+	}
+	return nil, unimplemented("SessionSpellcastFlow")
+}
+
 func (f *fakeDaggerheartClient) SessionReactionFlow(ctx context.Context, in *daggerheartv1.SessionReactionFlowRequest, opts ...grpc.CallOption) (*daggerheartv1.SessionReactionFlowResponse, error) {
 	if f.sessionReactionFlow != nil {
 		return f.sessionReactionFlow(ctx, in, opts...)
@@ -523,6 +639,167 @@ func (f *fakeDaggerheartClient) ApplyReactionOutcome(ctx context.Context, in *da
 	return nil, unimplemented("ApplyReactionOutcome")
 }
 
+func (f *fakeDaggerheartClient) LevelUp(ctx context.Context, in *daggerheartv1.DaggerheartLevelUpRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartLevelUpResponse, error) {
+	if f.levelUp != nil {
+		return f.levelUp(ctx, in, opts...)
+	}
+	return nil, unimplemented("LevelUp")
+}
+
+func (f *fakeDaggerheartClient) AcquireItem(ctx context.Context, in *daggerheartv1.DaggerheartAcquireItemRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartAcquireItemResponse, error) {
+	if f.acquireItem != nil {
+		return f.acquireItem(ctx, in, opts...)
+	}
+	return nil, unimplemented("AcquireItem")
+}
+
+func (f *fakeDaggerheartClient) DropItem(ctx context.Context, in *daggerheartv1.DaggerheartDropItemRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartDropItemResponse, error) {
+	if f.dropItem != nil {
+		return f.dropItem(ctx, in, opts...)
+	}
+	return nil, unimplemented("DropItem")
+}
+
+func (f *fakeDaggerheartClient) TransferItem(ctx context.Context, in *daggerheartv1.DaggerheartTransferItemRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartTransferItemResponse, error) {
+	if f.transferItem != nil {
+		return f.transferItem(ctx, in, opts...)
+	}
+	return nil, unimplemented("TransferItem")
+}
+
+func (f *fakeDaggerheartClient) EquipItem(ctx context.Context, in *daggerheartv1.DaggerheartEquipItemRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartEquipItemResponse, error) {
+	if f.equipItem != nil {
+		return f.equipItem(ctx, in, opts...)
+	}
+	return nil, unimplemented("EquipItem")
+}
+
+func (f *fakeDaggerheartClient) UnequipItem(ctx context.Context, in *daggerheartv1.DaggerheartUnequipItemRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartUnequipItemResponse, error) {
+	if f.unequipItem != nil {
+		return f.unequipItem(ctx, in, opts...)
+	}
+	return nil, unimplemented("UnequipItem")
+}
+
+func (f *fakeDaggerheartClient) UpdateGold(ctx context.Context, in *daggerheartv1.DaggerheartUpdateGoldRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartUpdateGoldResponse, error) {
+	if f.updateGold != nil {
+		return f.updateGold(ctx, in, opts...)
+	}
+	return nil, unimplemented("UpdateGold")
+}
+
+func (f *fakeDaggerheartClient) CreateCompanion(ctx context.Context, in *daggerheartv1.DaggerheartCreateCompanionRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartCreateCompanionResponse, error) {
+	if f.createCompanion != nil {
+		return f.createCompanion(ctx, in, opts...)
+	}
+	return nil, unimplemented("CreateCompanion")
+}
+
+func (f *fakeDaggerheartClient) LevelUpCompanion(ctx context.Context, in *daggerheartv1.DaggerheartLevelUpCompanionRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartLevelUpCompanionResponse, error) {
+	if f.levelUpCompanion != nil {
+		return f.levelUpCompanion(ctx, in, opts...)
+	}
+	return nil, unimplemented("LevelUpCompanion")
+}
+
+func (f *fakeDaggerheartClient) UpdateCompanionStress(ctx context.Context, in *daggerheartv1.DaggerheartUpdateCompanionStressRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartUpdateCompanionStressResponse, error) {
+	if f.updateCompanionStress != nil {
+		return f.updateCompanionStress(ctx, in, opts...)
+	}
+	return nil, unimplemented("UpdateCompanionStress")
+}
+
+func (f *fakeDaggerheartClient) SessionCompanionAttackFlow(ctx context.Context, in *daggerheartv1.SessionCompanionAttackFlowRequest, opts ...grpc.CallOption) (*daggerheartv1.SessionCompanionAttackFlowResponse, error) {
+	if f.sessionCompanionAttackFlow != nil {
+		return f.sessionCompanionAttackFlow(ctx, in, opts...)
+	}
+	return nil, unimplemented("SessionCompanionAttackFlow")
+}
+
+func (f *fakeDaggerheartClient) EnterBeastform(ctx context.Context, in *daggerheartv1.DaggerheartEnterBeastformRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartEnterBeastformResponse, error) {
+	if f.enterBeastform != nil {
+		return f.enterBeastform(ctx, in, opts...)
+	}
+	return nil, unimplemented("EnterBeastform")
+}
+
+func (f *fakeDaggerheartClient) ExitBeastform(ctx context.Context, in *daggerheartv1.DaggerheartExitBeastformRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartExitBeastformResponse, error) {
+	if f.exitBeastform != nil {
+		return f.exitBeastform(ctx, in, opts...)
+	}
+	return nil, unimplemented("ExitBeastform")
+}
+
+func (f *fakeDaggerheartClient) ApplyEffect(ctx context.Context, in *daggerheartv1.DaggerheartApplyEffectRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartApplyEffectResponse, error) {
+	if f.applyEffect != nil {
+		return f.applyEffect(ctx, in, opts...)
+	}
+	return nil, unimplemented("ApplyEffect")
+}
+
+func (f *fakeDaggerheartClient) RemoveEffect(ctx context.Context, in *daggerheartv1.DaggerheartRemoveEffectRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartRemoveEffectResponse, error) {
+	if f.removeEffect != nil {
+		return f.removeEffect(ctx, in, opts...)
+	}
+	return nil, unimplemented("RemoveEffect")
+}
+
+func (f *fakeDaggerheartClient) ListEffects(ctx context.Context, in *daggerheartv1.DaggerheartListEffectsRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartListEffectsResponse, error) {
+	if f.listEffects != nil {
+		return f.listEffects(ctx, in, opts...)
+	}
+	return nil, unimplemented("ListEffects")
+}
+
+func (f *fakeDaggerheartClient) StartChase(ctx context.Context, in *daggerheartv1.DaggerheartStartChaseRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartStartChaseResponse, error) {
+	if f.startChase != nil {
+		return f.startChase(ctx, in, opts...)
+	}
+	return nil, unimplemented("StartChase")
+}
+
+func (f *fakeDaggerheartClient) GetChase(ctx context.Context, in *daggerheartv1.DaggerheartGetChaseRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartGetChaseResponse, error) {
+	if f.getChase != nil {
+		return f.getChase(ctx, in, opts...)
+	}
+	return nil, unimplemented("GetChase")
+}
+
+func (f *fakeDaggerheartClient) ListChases(ctx context.Context, in *daggerheartv1.DaggerheartListChasesRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartListChasesResponse, error) {
+	if f.listChases != nil {
+		return f.listChases(ctx, in, opts...)
+	}
+	return nil, unimplemented("ListChases")
+}
+
+func (f *fakeDaggerheartClient) ActivateAdversaryFeature(ctx context.Context, in *daggerheartv1.DaggerheartActivateAdversaryFeatureRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartActivateAdversaryFeatureResponse, error) {
+	if f.activateAdversaryFeature != nil {
+		return f.activateAdversaryFeature(ctx, in, opts...)
+	}
+	return nil, unimplemented("ActivateAdversaryFeature")
+}
+
+func (f *fakeDaggerheartClient) ListAdversaryFeatureStates(ctx context.Context, in *daggerheartv1.DaggerheartListAdversaryFeatureStatesRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartListAdversaryFeatureStatesResponse, error) {
+	if f.listAdversaryFeatureStates != nil {
+		return f.listAdversaryFeatureStates(ctx, in, opts...)
+	}
+	return nil, unimplemented("ListAdversaryFeatureStates")
+}
+
+func (f *fakeDaggerheartClient) PlanEncounter(ctx context.Context, in *daggerheartv1.DaggerheartPlanEncounterRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartPlanEncounterResponse, error) {
+	if f.planEncounter != nil {
+		return f.planEncounter(ctx, in, opts...)
+	}
+	return nil, unimplemented("PlanEncounter")
+}
+
+func (f *fakeDaggerheartClient) CommitEncounter(ctx context.Context, in *daggerheartv1.DaggerheartCommitEncounterRequest, opts ...grpc.CallOption) (*daggerheartv1.DaggerheartCommitEncounterResponse, error) {
+	if f.commitEncounter != nil {
+		return f.commitEncounter(ctx, in, opts...)
+	}
+	return nil, unimplemented("CommitEncounter")
+}
+
 // newTestRunner creates a Runner with pre-built clients for testing.
 // Production code should use NewRunner which dials the real gRPC server.
 func newTestRunner(env scenarioEnv, opts ...func(*Runner)) *Runner {
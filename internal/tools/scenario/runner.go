@@ -145,6 +145,7 @@ func (r *Runner) RunScenario(ctx context.Context, scenario *Scenario) error {
 		actors:       map[string]string{},
 		adversaries:  map[string]string{},
 		countdowns:   map[string]string{},
+		chases:       map[string]string{},
 		participants: map[string]string{},
 		userID:       r.userID,
 	}
@@ -192,10 +192,12 @@ func (r *Runner) applyDefaultDaggerheartProfile(ctx context.Context, state *scen
 	if !hasDaggerheartProfileOverrides(args) {
 		return nil
 	}
+	if err := r.levelUpTo(ctx, state, characterID, optionalInt(args, "level", 1)); err != nil {
+		return err
+	}
 	armorValue := optionalInt(args, "armor", 0)
 	armorMaxValue := optionalInt(args, "armor_max", 0)
 	profile := &daggerheartv1.DaggerheartProfile{
-		Level:           int32(optionalInt(args, "level", 1)),
 		HpMax:           int32(optionalInt(args, "hp_max", 6)),
 		StressMax:       wrapperspb.Int32(int32(optionalInt(args, "stress_max", 6))),
 		Evasion:         wrapperspb.Int32(int32(optionalInt(args, "evasion", 10))),
@@ -216,6 +218,9 @@ func (r *Runner) applyDefaultDaggerheartProfile(ctx context.Context, state *scen
 	applyTraitValue(profile, "instinct", args)
 	applyTraitValue(profile, "presence", args)
 	applyTraitValue(profile, "knowledge", args)
+	profile.Experiences = buildProfileExperiences(args, "experiences")
+	profile.ClassId = optionalString(args, "class", "")
+	profile.SubclassId = optionalString(args, "subclass", "")
 
 	_, err := r.env.characterClient.PatchCharacterProfile(ctx, &gamev1.PatchCharacterProfileRequest{
 		CampaignId:  state.campaignID,
@@ -230,6 +235,36 @@ func (r *Runner) applyDefaultDaggerheartProfile(ctx context.Context, state *scen
 	return nil
 }
 
+// levelUpTo raises a new character from level 1 to level through LevelUp, the
+// only way levels change. Each tier takes two Hit Point and Stress slot
+// levels, then Proficiency, and gains a tier experience on entry.
+func (r *Runner) levelUpTo(ctx context.Context, state *scenarioState, characterID string, level int) error {
+	for next := 2; next <= level; next++ {
+		request := &daggerheartv1.DaggerheartLevelUpRequest{
+			CampaignId:  state.campaignID,
+			CharacterId: characterID,
+		}
+		switch (next - 2) % 3 {
+		case 0:
+			request.NewExperience = fmt.Sprintf("Tier %d Training", (next-2)/3+2)
+			fallthrough
+		case 1:
+			request.Advancements = []*daggerheartv1.DaggerheartAdvancement{
+				{Type: daggerheartv1.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_HP_SLOT},
+				{Type: daggerheartv1.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_STRESS_SLOT},
+			}
+		default:
+			request.Advancements = []*daggerheartv1.DaggerheartAdvancement{
+				{Type: daggerheartv1.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_PROFICIENCY},
+			}
+		}
+		if _, err := r.env.daggerheartClient.LevelUp(ctx, request); err != nil {
+			return fmt.Errorf("level up to %d: %w", next, err)
+		}
+	}
+	return nil
+}
+
 func hasDaggerheartProfileOverrides(args map[string]any) bool {
 	if len(args) == 0 {
 		return false
@@ -250,6 +285,9 @@ func hasDaggerheartProfileOverrides(args map[string]any) bool {
 		"instinct",
 		"presence",
 		"knowledge",
+		"experiences",
+		"class",
+		"subclass",
 	}
 	for _, key := range keys {
 		if _, ok := args[key]; ok {
@@ -448,6 +486,8 @@ func matchesOutcomeHint(result daggerheartdomain.ActionResult, hint string) bool
 		return result.IsCrit
 	case "failure_hope":
 		return result.Outcome == daggerheartdomain.OutcomeFailureWithHope
+	case "success_fear":
+		return result.Outcome == daggerheartdomain.OutcomeSuccessWithFear
 	default:
 		return false
 	}
@@ -461,6 +501,64 @@ func resolveOutcomeSeed(args map[string]any, key string, difficulty int, fallbac
 	return chooseActionSeed(map[string]any{"outcome": hint}, difficulty)
 }
 
+func buildProfileExperiences(args map[string]any, key string) []*daggerheartv1.DaggerheartExperience {
+	list, ok := args[key].([]any)
+	if !ok || len(list) == 0 {
+		return nil
+	}
+	experiences := make([]*daggerheartv1.DaggerheartExperience, 0, len(list))
+	for _, entry := range list {
+		item, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		name := optionalString(item, "name", "")
+		if name == "" {
+			continue
+		}
+		experiences = append(experiences, &daggerheartv1.DaggerheartExperience{
+			Name:     name,
+			Modifier: int32(optionalInt(item, "modifier", 0)),
+		})
+	}
+	return experiences
+}
+
+func buildAdvantageSources(args map[string]any, key string) []*daggerheartv1.AdvantageSource {
+	list, ok := args[key].([]any)
+	if !ok || len(list) == 0 {
+		return nil
+	}
+	sources := make([]*daggerheartv1.AdvantageSource, 0, len(list))
+	for _, entry := range list {
+		item, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		sources = append(sources, &daggerheartv1.AdvantageSource{
+			Kind:         parseAdvantageSourceKind(optionalString(item, "kind", "gm_ruling")),
+			Name:         optionalString(item, "name", ""),
+			Disadvantage: optionalBool(item, "disadvantage", false),
+		})
+	}
+	return sources
+}
+
+func parseAdvantageSourceKind(value string) daggerheartv1.AdvantageSourceKind {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "condition":
+		return daggerheartv1.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_CONDITION
+	case "target":
+		return daggerheartv1.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_TARGET
+	case "feature":
+		return daggerheartv1.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_FEATURE
+	case "gm_ruling":
+		return daggerheartv1.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_GM_RULING
+	default:
+		return daggerheartv1.AdvantageSourceKind_ADVANTAGE_SOURCE_KIND_UNSPECIFIED
+	}
+}
+
 func buildActionRollModifiers(args map[string]any, key string) []*daggerheartv1.ActionRollModifier {
 	value, ok := args[key]
 	if !ok {
@@ -518,6 +616,36 @@ func buildDamageDice(args map[string]any) []*daggerheartv1.DiceSpec {
 	return results
 }
 
+// attackWeaponArg reads an attack step's weapon: a catalog weapon ID, or
+// "equipped" for the attacker's equipped primary weapon.
+func attackWeaponArg(args map[string]any) (string, bool) {
+	weapon := strings.TrimSpace(optionalString(args, "weapon", ""))
+	if weapon == "" || weapon == "equipped" {
+		return "", weapon != ""
+	}
+	return weapon, true
+}
+
+// applyAttackWeaponArgs lets the weapon supply the trait, damage dice and
+// damage type unless the step sets them.
+func applyAttackWeaponArgs(request *daggerheartv1.SessionAttackFlowRequest, args map[string]any) {
+	request.Improvised = optionalBool(args, "improvised", false)
+	weaponID, useWeapon := attackWeaponArg(args)
+	if !useWeapon {
+		return
+	}
+	request.WeaponId = weaponID
+	if _, ok := args["trait"]; !ok {
+		request.Trait = ""
+	}
+	if _, ok := args["damage_dice"]; !ok {
+		request.DamageDice = nil
+	}
+	if _, ok := args["damage_type"]; !ok {
+		request.Damage.DamageType = daggerheartv1.DaggerheartDamageType_DAGGERHEART_DAMAGE_TYPE_UNSPECIFIED
+	}
+}
+
 func buildDamageSpec(args map[string]any, actorID, source string) *daggerheartv1.DaggerheartAttackDamageSpec {
 	damageType := parseDamageType(optionalString(args, "damage_type", "physical"))
 	spec := &daggerheartv1.DaggerheartAttackDamageSpec{DamageType: damageType}
@@ -848,6 +976,25 @@ func adversaryID(state *scenarioState, name string) (string, error) {
 	return id, nil
 }
 
+// sceneEntity resolves a scenario name to a positioned entity. Names that are
+// not characters or adversaries are treated as zones.
+func (r *Runner) sceneEntity(state *scenarioState, name, stepName string) (*daggerheartv1.DaggerheartSceneEntity, error) {
+	if name == "" {
+		return nil, r.failf("%s requires entity names", stepName)
+	}
+	for key, value := range state.actors {
+		if strings.EqualFold(key, name) {
+			return &daggerheartv1.DaggerheartSceneEntity{Id: value, Type: daggerheartv1.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_CHARACTER}, nil
+		}
+	}
+	for key, value := range state.adversaries {
+		if strings.EqualFold(key, name) {
+			return &daggerheartv1.DaggerheartSceneEntity{Id: value, Type: daggerheartv1.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ADVERSARY}, nil
+		}
+	}
+	return &daggerheartv1.DaggerheartSceneEntity{Id: name, Type: daggerheartv1.DaggerheartSceneEntityType_DAGGERHEART_SCENE_ENTITY_TYPE_ZONE}, nil
+}
+
 func resolveTargetID(state *scenarioState, name string) (string, bool, error) {
 	if id, ok := state.actors[name]; ok {
 		return id, false, nil
@@ -1292,6 +1439,22 @@ func uniqueNonEmptyStrings(values []string) []string {
 	return result
 }
 
+func resolveAdversaryList(state *scenarioState, args map[string]any, key string) ([]string, error) {
+	list := readStringSlice(args, key)
+	if len(list) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(list))
+	for _, name := range list {
+		id, err := adversaryID(state, name)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
 func resolveCharacterList(state *scenarioState, args map[string]any, key string) ([]string, error) {
 	list := readStringSlice(args, key)
 	if len(list) == 0 {
@@ -1335,6 +1498,53 @@ func parseRestType(value string) (daggerheartv1.DaggerheartRestType, error) {
 	}
 }
 
+func parseEffectKind(value string) (daggerheartv1.DaggerheartEffectKind, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "armor":
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ARMOR, nil
+	case "evasion":
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_EVASION, nil
+	case "roll":
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_ROLL, nil
+	case "damage":
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_DAMAGE, nil
+	case "hope_die":
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_HOPE_DIE, nil
+	default:
+		return daggerheartv1.DaggerheartEffectKind_DAGGERHEART_EFFECT_KIND_UNSPECIFIED, fmt.Errorf("unsupported effect kind %q", value)
+	}
+}
+
+func parseEffectStacking(value string) (daggerheartv1.DaggerheartEffectStacking, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "stack":
+		return daggerheartv1.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_STACK, nil
+	case "highest":
+		return daggerheartv1.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_HIGHEST, nil
+	case "replace":
+		return daggerheartv1.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_REPLACE, nil
+	default:
+		return daggerheartv1.DaggerheartEffectStacking_DAGGERHEART_EFFECT_STACKING_UNSPECIFIED, fmt.Errorf("unsupported effect stacking %q", value)
+	}
+}
+
+func parseEffectExpiry(value string) (daggerheartv1.DaggerheartEffectExpiry, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "manual":
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_MANUAL, nil
+	case "rest":
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_REST, nil
+	case "long_rest":
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_LONG_REST, nil
+	case "scene_end":
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_SCENE_END, nil
+	case "next_attack":
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_NEXT_ATTACK, nil
+	default:
+		return daggerheartv1.DaggerheartEffectExpiry_DAGGERHEART_EFFECT_EXPIRY_UNSPECIFIED, fmt.Errorf("unsupported effect expiry %q", value)
+	}
+}
+
 func parseCountdownKind(value string) (daggerheartv1.DaggerheartCountdownKind, error) {
 	switch strings.ToLower(strings.TrimSpace(value)) {
 	case "progress":
@@ -1348,6 +1558,285 @@ func parseCountdownKind(value string) (daggerheartv1.DaggerheartCountdownKind, e
 	}
 }
 
+func parseRangeBand(value string) (daggerheartv1.DaggerheartRangeBand, error) {
+	band, err := daggerheart.NormalizeRangeBand(value)
+	if err != nil {
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_UNSPECIFIED, fmt.Errorf("unsupported range band %q", value)
+	}
+	switch band {
+	case daggerheart.RangeMelee:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_MELEE, nil
+	case daggerheart.RangeVeryClose:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_VERY_CLOSE, nil
+	case daggerheart.RangeClose:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_CLOSE, nil
+	case daggerheart.RangeFar:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_FAR, nil
+	case daggerheart.RangeVeryFar:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_VERY_FAR, nil
+	default:
+		return daggerheartv1.DaggerheartRangeBand_DAGGERHEART_RANGE_BAND_OUT_OF_RANGE, nil
+	}
+}
+
+func parseMovementKind(value string) (daggerheartv1.DaggerheartMovementKind, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "move":
+		return daggerheartv1.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_MOVE, nil
+	case "knockback":
+		return daggerheartv1.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_KNOCKBACK, nil
+	case "teleport":
+		return daggerheartv1.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_TELEPORT, nil
+	case "forced":
+		return daggerheartv1.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_FORCED, nil
+	default:
+		return daggerheartv1.DaggerheartMovementKind_DAGGERHEART_MOVEMENT_KIND_UNSPECIFIED, fmt.Errorf("unsupported movement kind %q", value)
+	}
+}
+
+func parseEnvironmentFeatureKind(value string) (daggerheartv1.DaggerheartEnvironmentFeatureKind, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "action":
+		return daggerheartv1.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_ACTION, nil
+	case "reaction":
+		return daggerheartv1.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_REACTION, nil
+	default:
+		return daggerheartv1.DaggerheartEnvironmentFeatureKind_DAGGERHEART_ENVIRONMENT_FEATURE_KIND_UNSPECIFIED, fmt.Errorf("unsupported environment feature kind %q", value)
+	}
+}
+
+func parseInventoryItemKind(value string) (daggerheartv1.DaggerheartInventoryItemKind, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "weapon":
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_WEAPON, nil
+	case "armor":
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ARMOR, nil
+	case "item":
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_ITEM, nil
+	case "loot":
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_LOOT, nil
+	default:
+		return daggerheartv1.DaggerheartInventoryItemKind_DAGGERHEART_INVENTORY_ITEM_KIND_UNSPECIFIED, fmt.Errorf("unsupported inventory kind %q", value)
+	}
+}
+
+func parseEquipSlot(value string) (daggerheartv1.DaggerheartEquipSlot, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "armor":
+		return daggerheartv1.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_ARMOR, nil
+	case "primary", "primary_weapon":
+		return daggerheartv1.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_PRIMARY_WEAPON, nil
+	case "secondary", "secondary_weapon":
+		return daggerheartv1.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_SECONDARY_WEAPON, nil
+	default:
+		return daggerheartv1.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED, fmt.Errorf("unsupported equip slot %q", value)
+	}
+}
+
+// assertEquipmentProfile checks armor-derived profile values after an equip change.
+func (r *Runner) assertEquipmentProfile(profile *daggerheartv1.DaggerheartProfile, args map[string]any) error {
+	for _, check := range []struct {
+		key string
+		got int32
+	}{
+		{"expect_armor_score", profile.GetArmorScore().GetValue()},
+		{"expect_major", profile.GetMajorThreshold().GetValue()},
+		{"expect_severe", profile.GetSevereThreshold().GetValue()},
+	} {
+		if want, ok := readInt(args, check.key); ok && int(check.got) != want {
+			if err := r.assertf("equipment %s = %d, want %d", check.key, check.got, want); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func parseCompanionUpgrade(value string) (daggerheartv1.DaggerheartCompanionUpgrade, error) {
+	name := strings.ToUpper(strings.TrimSpace(value))
+	upgrade, ok := daggerheartv1.DaggerheartCompanionUpgrade_value["DAGGERHEART_COMPANION_UPGRADE_"+name]
+	if !ok || upgrade == 0 {
+		return daggerheartv1.DaggerheartCompanionUpgrade_DAGGERHEART_COMPANION_UPGRADE_UNSPECIFIED, fmt.Errorf("unsupported companion upgrade %q", value)
+	}
+	return daggerheartv1.DaggerheartCompanionUpgrade(upgrade), nil
+}
+
+// assertCompanion checks companion values after a companion step.
+func (r *Runner) assertCompanion(companion *daggerheartv1.DaggerheartCompanion, args map[string]any) error {
+	for _, check := range []struct {
+		key string
+		got int32
+	}{
+		{"expect_level", companion.GetLevel()},
+		{"expect_evasion", companion.GetEvasion()},
+		{"expect_stress", companion.GetStress()},
+		{"expect_stress_max", companion.GetStressMax()},
+		{"expect_damage_die", companion.GetDamageDieSides()},
+	} {
+		if want, ok := readInt(args, check.key); ok && int(check.got) != want {
+			if err := r.assertf("companion %s = %d, want %d", check.key, check.got, want); err != nil {
+				return err
+			}
+		}
+	}
+	if want := optionalString(args, "expect_range", ""); want != "" && companion.GetRange() != want {
+		if err := r.assertf("companion range = %q, want %q", companion.GetRange(), want); err != nil {
+			return err
+		}
+	}
+	if want, ok := readBool(args, "expect_out_of_scene"); ok && companion.GetOutOfScene() != want {
+		if err := r.assertf("companion out_of_scene = %t, want %t", companion.GetOutOfScene(), want); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseCountdownTrigger(value string) (daggerheartv1.DaggerheartCountdownTrigger, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "action_roll":
+		return daggerheartv1.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_ACTION_ROLL, nil
+	case "rest":
+		return daggerheartv1.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_REST, nil
+	case "fear_spent":
+		return daggerheartv1.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_FEAR_SPENT, nil
+	case "major_damage":
+		return daggerheartv1.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_MAJOR_DAMAGE, nil
+	default:
+		return daggerheartv1.DaggerheartCountdownTrigger_DAGGERHEART_COUNTDOWN_TRIGGER_UNSPECIFIED, fmt.Errorf("unsupported countdown trigger %q", value)
+	}
+}
+
+// buildCountdownTickPolicy reads the tick, tick_delta and tick_outcomes
+// countdown_create args. Outcome keys are duality outcome names such as
+// success_with_hope.
+func buildCountdownTickPolicy(args map[string]any) (*daggerheartv1.DaggerheartCountdownTickPolicy, error) {
+	tick := optionalString(args, "tick", "")
+	if tick == "" {
+		return nil, nil
+	}
+	trigger, err := parseCountdownTrigger(tick)
+	if err != nil {
+		return nil, err
+	}
+	outcomeDeltas, err := parseCountdownOutcomeDeltas(args, "tick_outcomes")
+	if err != nil {
+		return nil, err
+	}
+	return &daggerheartv1.DaggerheartCountdownTickPolicy{
+		Trigger:       trigger,
+		Delta:         int32(optionalInt(args, "tick_delta", 0)),
+		OutcomeDeltas: outcomeDeltas,
+	}, nil
+}
+
+// parseCountdownOutcomeDeltas reads a table keyed by duality outcome name
+// into per-outcome countdown steps, sorted by outcome name.
+func parseCountdownOutcomeDeltas(args map[string]any, key string) ([]*daggerheartv1.DaggerheartCountdownOutcomeDelta, error) {
+	outcomes, _ := args[key].(map[string]any)
+	keys := make([]string, 0, len(outcomes))
+	for name := range outcomes {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	deltas := make([]*daggerheartv1.DaggerheartCountdownOutcomeDelta, 0, len(keys))
+	for _, name := range keys {
+		outcome, ok := daggerheartv1.Outcome_value[strings.ToUpper(strings.TrimSpace(name))]
+		if !ok {
+			return nil, fmt.Errorf("unsupported countdown outcome %q", name)
+		}
+		delta, _ := readInt(outcomes, name)
+		deltas = append(deltas, &daggerheartv1.DaggerheartCountdownOutcomeDelta{
+			Outcome: daggerheartv1.Outcome(outcome),
+			Delta:   int32(delta),
+		})
+	}
+	return deltas, nil
+}
+
+// buildChaseCountdownSpec reads the <side>_max and <side>_outcomes chase_start
+// args for one of the chase's countdowns.
+func buildChaseCountdownSpec(args map[string]any, side string) (*daggerheartv1.DaggerheartChaseCountdownSpec, error) {
+	maxValue := optionalInt(args, side+"_max", 0)
+	if maxValue <= 0 {
+		maxValue = 4
+	}
+	outcomeDeltas, err := parseCountdownOutcomeDeltas(args, side+"_outcomes")
+	if err != nil {
+		return nil, err
+	}
+	return &daggerheartv1.DaggerheartChaseCountdownSpec{
+		Name:          optionalString(args, side+"_name", ""),
+		Max:           int32(maxValue),
+		OutcomeDeltas: outcomeDeltas,
+	}, nil
+}
+
+func resolveChaseID(state *scenarioState, args map[string]any) (string, error) {
+	if chaseID := optionalString(args, "chase_id", ""); chaseID != "" {
+		return chaseID, nil
+	}
+	name := optionalString(args, "name", "")
+	if name == "" {
+		return "", nil
+	}
+	chaseID, ok := state.chases[name]
+	if !ok {
+		return "", fmt.Errorf("unknown chase %q", name)
+	}
+	return chaseID, nil
+}
+
+// chaseStatusName returns the lower-case DSL name of a chase status.
+func chaseStatusName(status daggerheartv1.DaggerheartChaseStatus) string {
+	if status == daggerheartv1.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(status.String(), "DAGGERHEART_CHASE_STATUS_"))
+}
+
+// chaseWinnerName returns the lower-case DSL name of a chase winner, empty
+// while the chase is running.
+func chaseWinnerName(winner daggerheartv1.DaggerheartChaseWinner) string {
+	if winner == daggerheartv1.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(winner.String(), "DAGGERHEART_CHASE_WINNER_"))
+}
+
+// countdownCurrent reads a countdown's value from its latest
+// countdown_updated event, falling back to the value it was created with.
+func (r *Runner) countdownCurrent(ctx context.Context, state *scenarioState, countdownID string) (int, error) {
+	for _, eventType := range []event.Type{daggerheart.EventTypeCountdownUpdated, daggerheart.EventTypeCountdownCreated} {
+		response, err := r.env.eventClient.ListEvents(ctx, &gamev1.ListEventsRequest{
+			CampaignId: state.campaignID,
+			PageSize:   1,
+			OrderBy:    "seq desc",
+			Filter:     fmt.Sprintf("type = \"%s\" AND entity_id = \"%s\"", eventType, countdownID),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("list events for %s: %w", eventType, err)
+		}
+		if len(response.GetEvents()) == 0 {
+			continue
+		}
+		payloadJSON := response.GetEvents()[0].GetPayloadJson()
+		if eventType == daggerheart.EventTypeCountdownUpdated {
+			var payload daggerheart.CountdownUpdatedPayload
+			if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+				return 0, fmt.Errorf("decode countdown update payload: %w", err)
+			}
+			return payload.After, nil
+		}
+		var payload daggerheart.CountdownCreatedPayload
+		if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+			return 0, fmt.Errorf("decode countdown created payload: %w", err)
+		}
+		return payload.Current, nil
+	}
+	return 0, r.failf("countdown %s not found", countdownID)
+}
+
 func parseCountdownDirection(value string) (daggerheartv1.DaggerheartCountdownDirection, error) {
 	switch strings.ToLower(strings.TrimSpace(value)) {
 	case "increase":
@@ -1429,3 +1918,78 @@ func withParticipantID(ctx context.Context, participantID string) context.Contex
 	}
 	return metadata.AppendToOutgoingContext(ctx, grpcmeta.ParticipantIDHeader, participantID)
 }
+
+// buildMultiAttackTargets resolves the targets list, applying per-target
+// difficulties and armor choices. It returns the target names keyed by id.
+func (r *Runner) buildMultiAttackTargets(state *scenarioState, args map[string]any, stepName string) ([]*daggerheartv1.MultiAttackTarget, map[string]string, error) {
+	targetNames := readStringSlice(args, "targets")
+	if len(targetNames) == 0 {
+		return nil, nil, r.failf("%s requires targets", stepName)
+	}
+	difficulties, _ := args["target_difficulties"].(map[string]any)
+	declined := make(map[string]bool)
+	for _, name := range readStringSlice(args, "decline_armor") {
+		declined[name] = true
+	}
+	defaultDifficulty, hasDefault := readInt(args, "difficulty")
+
+	targets := make([]*daggerheartv1.MultiAttackTarget, 0, len(targetNames))
+	names := make(map[string]string, len(targetNames))
+	for _, name := range targetNames {
+		id, _, err := resolveTargetID(state, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		target := &daggerheartv1.MultiAttackTarget{TargetId: id, DeclineArmor: declined[name]}
+		if difficulty, ok := readInt(difficulties, name); ok {
+			target.Difficulty = wrapperspb.Int32(int32(difficulty))
+		} else if hasDefault {
+			target.Difficulty = wrapperspb.Int32(int32(defaultDifficulty))
+		}
+		targets = append(targets, target)
+		names[id] = name
+	}
+	return targets, names, nil
+}
+
+// multiAttackSeedDifficulty returns the difficulty used to pick an outcome
+// seed: the lowest explicit target difficulty, or the step difficulty.
+func multiAttackSeedDifficulty(targets []*daggerheartv1.MultiAttackTarget, args map[string]any) int {
+	difficulty := optionalInt(args, "difficulty", 10)
+	found := false
+	for _, target := range targets {
+		if target.GetDifficulty() == nil {
+			continue
+		}
+		value := int(target.GetDifficulty().GetValue())
+		if !found || value < difficulty {
+			difficulty = value
+			found = true
+		}
+	}
+	return difficulty
+}
+
+// assertMultiAttackResults checks expect_hits and expect_misses against the
+// per-target results of a multi-target attack.
+func (r *Runner) assertMultiAttackResults(results []*daggerheartv1.MultiAttackTargetResult, names map[string]string, args map[string]any) error {
+	hits := make(map[string]bool, len(results))
+	for _, result := range results {
+		hits[names[result.GetTargetId()]] = result.GetHit()
+	}
+	for _, name := range readStringSlice(args, "expect_hits") {
+		if hit, ok := hits[name]; !ok || !hit {
+			if err := r.assertf("expected multi-target attack to hit %s", name); err != nil {
+				return err
+			}
+		}
+	}
+	for _, name := range readStringSlice(args, "expect_misses") {
+		if hit, ok := hits[name]; !ok || hit {
+			if err := r.assertf("expected multi-target attack to miss %s", name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
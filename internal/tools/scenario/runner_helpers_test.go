@@ -1171,7 +1171,14 @@ func TestApplyDefaultDaggerheartProfile(t *testing.T) {
 			return &gamev1.PatchCharacterProfileResponse{}, nil
 		},
 	}
-	r := newTestRunner(scenarioEnv{characterClient: char})
+	var levelUps []*daggerheartv1.DaggerheartLevelUpRequest
+	dh := &fakeDaggerheartClient{
+		levelUp: func(_ context.Context, in *daggerheartv1.DaggerheartLevelUpRequest, _ ...grpc.CallOption) (*daggerheartv1.DaggerheartLevelUpResponse, error) {
+			levelUps = append(levelUps, in)
+			return &daggerheartv1.DaggerheartLevelUpResponse{}, nil
+		},
+	}
+	r := newTestRunner(scenarioEnv{characterClient: char, daggerheartClient: dh})
 	state := &scenarioState{campaignID: "c-1"}
 
 	t.Run("defaults", func(t *testing.T) {
@@ -1195,8 +1202,17 @@ func TestApplyDefaultDaggerheartProfile(t *testing.T) {
 		if patchedProfile == nil {
 			t.Fatal("expected profile patch")
 		}
-		if patchedProfile.Level != 5 {
-			t.Fatalf("expected level=5, got %d", patchedProfile.Level)
+		if patchedProfile.Level != 0 {
+			t.Fatalf("expected level to be left to LevelUp, got %d", patchedProfile.Level)
+		}
+		if len(levelUps) != 4 {
+			t.Fatalf("expected 4 level ups, got %d", len(levelUps))
+		}
+		if levelUps[0].GetNewExperience() == "" || levelUps[3].GetNewExperience() == "" || levelUps[1].GetNewExperience() != "" {
+			t.Fatalf("expected tier experiences at levels 2 and 5, got %+v", levelUps)
+		}
+		if got := levelUps[2].GetAdvancements(); len(got) != 1 || got[0].GetType() != daggerheartv1.DaggerheartAdvancementType_DAGGERHEART_ADVANCEMENT_TYPE_PROFICIENCY {
+			t.Fatalf("expected proficiency at level 4, got %+v", got)
 		}
 		if patchedProfile.HpMax != 20 {
 			t.Fatalf("expected hp_max=20, got %d", patchedProfile.HpMax)
@@ -3,6 +3,7 @@ package scenario
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	commonv1 "github.com/louisbranch/fracturing.space/api/gen/go/common/v1"
@@ -69,6 +70,54 @@ func (r *Runner) runStep(ctx context.Context, state *scenarioState, step Step) e
 		return r.runCountdownUpdateStep(ctx, state, step)
 	case "countdown_delete":
 		return r.runCountdownDeleteStep(ctx, state, step)
+	case "countdown_expect":
+		return r.runCountdownExpectStep(ctx, state, step)
+	case "chase_start":
+		return r.runChaseStartStep(ctx, state, step)
+	case "chase_expect":
+		return r.runChaseExpectStep(ctx, state, step)
+	case "set_range":
+		return r.runSetRangeStep(ctx, state, step)
+	case "move":
+		return r.runMoveStep(ctx, state, step)
+	case "expect_range":
+		return r.runExpectRangeStep(ctx, state, step)
+	case "environment":
+		return r.runEnvironmentStep(ctx, state, step)
+	case "environment_shift":
+		return r.runEnvironmentShiftStep(ctx, state, step)
+	case "environment_clear":
+		return r.runEnvironmentClearStep(ctx, state, step)
+	case "environment_feature":
+		return r.runEnvironmentFeatureStep(ctx, state, step)
+	case "acquire_item":
+		return r.runAcquireItemStep(ctx, state, step)
+	case "drop_item":
+		return r.runDropItemStep(ctx, state, step)
+	case "transfer_item":
+		return r.runTransferItemStep(ctx, state, step)
+	case "equip":
+		return r.runEquipStep(ctx, state, step)
+	case "unequip":
+		return r.runUnequipStep(ctx, state, step)
+	case "gold":
+		return r.runGoldStep(ctx, state, step)
+	case "companion":
+		return r.runCompanionStep(ctx, state, step)
+	case "companion_level_up":
+		return r.runCompanionLevelUpStep(ctx, state, step)
+	case "companion_stress":
+		return r.runCompanionStressStep(ctx, state, step)
+	case "companion_attack":
+		return r.runCompanionAttackStep(ctx, state, step)
+	case "beastform":
+		return r.runBeastformStep(ctx, state, step)
+	case "beastform_exit":
+		return r.runBeastformExitStep(ctx, state, step)
+	case "effect":
+		return r.runEffectStep(ctx, state, step)
+	case "effect_remove":
+		return r.runEffectRemoveStep(ctx, state, step)
 	case "action_roll":
 		return r.runActionRollStep(ctx, state, step)
 	case "reaction_roll":
@@ -366,6 +415,10 @@ func (r *Runner) runAdversaryStep(ctx context.Context, state *scenarioState, ste
 	if state.sessionID != "" {
 		request.SessionId = wrapperspb.String(state.sessionID)
 	}
+	if threshold := optionalInt(step.Args, "minion", 0); threshold > 0 {
+		request.MinionThreshold = wrapperspb.Int32(int32(threshold))
+	}
+	request.AdversaryEntryId = optionalString(step.Args, "entry", "")
 	response, err := r.env.daggerheartClient.CreateAdversary(ctx, request)
 	if err != nil {
 		return fmt.Errorf("create adversary: %w", err)
@@ -1082,7 +1135,7 @@ func (r *Runner) runAttackStep(ctx context.Context, state *scenarioState, step S
 		if err != nil {
 			return err
 		}
-		response, err := r.env.daggerheartClient.SessionAttackFlow(ctx, &daggerheartv1.SessionAttackFlowRequest{
+		request := &daggerheartv1.SessionAttackFlowRequest{
 			CampaignId:        state.campaignID,
 			SessionId:         state.sessionID,
 			CharacterId:       attackerID,
@@ -1101,7 +1154,9 @@ func (r *Runner) runAttackStep(ctx context.Context, state *scenarioState, step S
 				Seed:     &damageSeed,
 				RollMode: commonv1.RollMode_REPLAY,
 			},
-		})
+		}
+		applyAttackWeaponArgs(request, step.Args)
+		response, err := r.env.daggerheartClient.SessionAttackFlow(ctx, request)
 		if err != nil {
 			return fmt.Errorf("attack flow: %w", err)
 		}
@@ -1161,10 +1216,12 @@ func (r *Runner) runAttackStep(ctx context.Context, state *scenarioState, step S
 		}
 	}
 
+	weaponID, useWeapon := attackWeaponArg(step.Args)
 	attackOutcome, err := r.env.daggerheartClient.ApplyAttackOutcome(ctxWithMeta, &daggerheartv1.DaggerheartApplyAttackOutcomeRequest{
 		SessionId: state.sessionID,
 		RollSeq:   rollResp.GetRollSeq(),
 		Targets:   []string{targetID},
+		WeaponId:  weaponID,
 	})
 	if err != nil {
 		return fmt.Errorf("attack outcome: %w", err)
@@ -1175,6 +1232,9 @@ func (r *Runner) runAttackStep(ctx context.Context, state *scenarioState, step S
 
 	if attackOutcome.GetResult() != nil && attackOutcome.GetResult().GetSuccess() {
 		dice := buildDamageDice(step.Args)
+		if _, ok := step.Args["damage_dice"]; useWeapon && !ok {
+			dice = nil
+		}
 		critical := attackOutcome.GetResult().GetCrit()
 		damageRoll, err := r.env.daggerheartClient.SessionDamageRoll(ctx, &daggerheartv1.SessionDamageRollRequest{
 			CampaignId:  state.campaignID,
@@ -1187,6 +1247,7 @@ func (r *Runner) runAttackStep(ctx context.Context, state *scenarioState, step S
 				Seed:     &damageSeed,
 				RollMode: commonv1.RollMode_REPLAY,
 			},
+			WeaponId: weaponID,
 		})
 		if err != nil {
 			return fmt.Errorf("attack damage roll: %w", err)
@@ -1208,6 +1269,9 @@ func (r *Runner) runMultiAttackStep(ctx context.Context, state *scenarioState, s
 	if err := r.ensureSession(ctx, state); err != nil {
 		return err
 	}
+	if optionalBool(step.Args, "per_target", false) {
+		return r.runMultiAttackFlowStep(ctx, state, step)
+	}
 	actorName := requiredString(step.Args, "actor")
 	if actorName == "" {
 		return r.failf("multi_attack requires actor")
@@ -1369,6 +1433,136 @@ func (r *Runner) runMultiAttackStep(ctx context.Context, state *scenarioState, s
 	return r.assertExpectedDeltas(ctx, state, expectedSpec, expectedBefore)
 }
 
+func (r *Runner) runMultiAttackFlowStep(ctx context.Context, state *scenarioState, step Step) error {
+	actorName := requiredString(step.Args, "actor")
+	if actorName == "" {
+		return r.failf("multi_attack requires actor")
+	}
+	attackerID, err := actorID(state, actorName)
+	if err != nil {
+		return err
+	}
+	targets, names, err := r.buildMultiAttackTargets(state, step.Args, "multi_attack")
+	if err != nil {
+		return err
+	}
+
+	expectedSpec, expectedBefore, err := r.captureExpectedDeltas(ctx, state, step.Args, actorName)
+	if err != nil {
+		return err
+	}
+
+	actionSeed, err := chooseActionSeed(step.Args, multiAttackSeedDifficulty(targets, step.Args))
+	if err != nil {
+		return err
+	}
+	damageSeed := actionSeed + 1
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.SessionMultiAttackFlow(ctx, &daggerheartv1.SessionMultiAttackFlowRequest{
+		CampaignId:     state.campaignID,
+		SessionId:      state.sessionID,
+		CharacterId:    attackerID,
+		Trait:          optionalString(step.Args, "trait", "instinct"),
+		Modifiers:      buildActionRollModifiers(step.Args, "modifiers"),
+		Targets:        targets,
+		DamageDice:     buildDamageDice(step.Args),
+		DamageModifier: int32(optionalInt(step.Args, "damage_modifier", 0)),
+		Damage:         buildDamageSpec(step.Args, attackerID, "attack"),
+		ActionRng: &commonv1.RngRequest{
+			Seed:     &actionSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+		DamageRng: &commonv1.RngRequest{
+			Seed:     &damageSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("multi_attack flow: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeMultiAttackResolved); err != nil {
+		return err
+	}
+	if err := r.assertMultiAttackResults(response.GetResults(), names, step.Args); err != nil {
+		return err
+	}
+	return r.assertExpectedDeltas(ctx, state, expectedSpec, expectedBefore)
+}
+
+func (r *Runner) runAdversaryMultiAttackStep(ctx context.Context, state *scenarioState, step Step) error {
+	actorName := requiredString(step.Args, "actor")
+	if actorName == "" {
+		return r.failf("adversary_attack requires actor")
+	}
+	adversaryIDValue, err := adversaryID(state, actorName)
+	if err != nil {
+		return err
+	}
+	targets, names, err := r.buildMultiAttackTargets(state, step.Args, "adversary_attack")
+	if err != nil {
+		return err
+	}
+	stressCost := optionalInt(step.Args, "stress_cost", 0)
+
+	attackSeed := uint64(42)
+	if seed := optionalInt(step.Args, "seed", 0); seed > 0 {
+		attackSeed = uint64(seed)
+	}
+	damageSeed := attackSeed + 1
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	attackerBefore, err := r.getAdversary(ctx, state, adversaryIDValue)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.SessionAdversaryMultiAttackFlow(ctx, &daggerheartv1.SessionAdversaryMultiAttackFlowRequest{
+		CampaignId:     state.campaignID,
+		SessionId:      state.sessionID,
+		AdversaryId:    adversaryIDValue,
+		Targets:        targets,
+		AttackModifier: int32(optionalInt(step.Args, "attack_modifier", 0)),
+		Advantage:      int32(optionalInt(step.Args, "advantage", 0)),
+		Disadvantage:   int32(optionalInt(step.Args, "disadvantage", 0)),
+		DamageDice:     buildDamageDice(step.Args),
+		DamageModifier: int32(optionalInt(step.Args, "damage_modifier", 0)),
+		Damage:         buildDamageSpec(step.Args, "", "adversary_attack"),
+		StressCost:     int32(stressCost),
+		AttackRng: &commonv1.RngRequest{
+			Seed:     &attackSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+		DamageRng: &commonv1.RngRequest{
+			Seed:     &damageSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("adversary multi attack flow: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeMultiAttackResolved); err != nil {
+		return err
+	}
+	if stressCost > 0 {
+		attackerAfter, err := r.getAdversary(ctx, state, adversaryIDValue)
+		if err != nil {
+			return err
+		}
+		if delta := int(attackerAfter.GetStress() - attackerBefore.GetStress()); delta != stressCost {
+			if err := r.assertf("adversary stress delta for %s = %d, want %d", actorName, delta, stressCost); err != nil {
+				return err
+			}
+		}
+	}
+	return r.assertMultiAttackResults(response.GetResults(), names, step.Args)
+}
+
 func (r *Runner) runCombinedDamageStep(ctx context.Context, state *scenarioState, step Step) error {
 	if err := r.ensureSession(ctx, state); err != nil {
 		return err
@@ -1463,6 +1657,9 @@ func (r *Runner) runAdversaryAttackStep(ctx context.Context, state *scenarioStat
 	if err := r.ensureSession(ctx, state); err != nil {
 		return err
 	}
+	if len(readStringSlice(step.Args, "targets")) > 0 {
+		return r.runAdversaryMultiAttackStep(ctx, state, step)
+	}
 	actorName := requiredString(step.Args, "actor")
 	targetName := requiredString(step.Args, "target")
 	if actorName == "" || targetName == "" {
@@ -1497,6 +1694,10 @@ func (r *Runner) runAdversaryAttackStep(ctx context.Context, state *scenarioStat
 	if err != nil {
 		return err
 	}
+	groupIDs, err := resolveAdversaryList(state, step.Args, "group")
+	if err != nil {
+		return err
+	}
 	response, err := r.env.daggerheartClient.SessionAdversaryAttackFlow(ctx, &daggerheartv1.SessionAdversaryAttackFlowRequest{
 		CampaignId:        state.campaignID,
 		SessionId:         state.sessionID,
@@ -1509,6 +1710,7 @@ func (r *Runner) runAdversaryAttackStep(ctx context.Context, state *scenarioStat
 		DamageDice:        buildDamageDice(step.Args),
 		Damage:            buildDamageSpec(step.Args, "", "adversary_attack"),
 		RequireDamageRoll: true,
+		GroupAdversaryIds: groupIDs,
 		AttackRng: &commonv1.RngRequest{
 			Seed:     &attackSeed,
 			RollMode: commonv1.RollMode_REPLAY,
@@ -1628,6 +1830,11 @@ func (r *Runner) runCountdownCreateStep(ctx context.Context, state *scenarioStat
 	if countdownID := optionalString(step.Args, "countdown_id", ""); countdownID != "" {
 		request.CountdownId = countdownID
 	}
+	tickPolicy, err := buildCountdownTickPolicy(step.Args)
+	if err != nil {
+		return err
+	}
+	request.TickPolicy = tickPolicy
 	response, err := r.env.daggerheartClient.CreateCountdown(ctx, request)
 	if err != nil {
 		return fmt.Errorf("countdown_create: %w", err)
@@ -1681,6 +1888,139 @@ func (r *Runner) runCountdownUpdateStep(ctx context.Context, state *scenarioStat
 	return r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeCountdownUpdated)
 }
 
+// runCountdownExpectStep checks a countdown's current value, read from its
+// latest update (or its creation), and optionally whether it has triggered.
+func (r *Runner) runCountdownExpectStep(ctx context.Context, state *scenarioState, step Step) error {
+	countdownID, err := resolveCountdownID(state, step.Args)
+	if err != nil {
+		return err
+	}
+	if countdownID == "" {
+		return r.failf("countdown_expect countdown_id or name is required")
+	}
+
+	if expected, ok := readInt(step.Args, "current"); ok {
+		current, err := r.countdownCurrent(ctx, state, countdownID)
+		if err != nil {
+			return err
+		}
+		if current != expected {
+			if err := r.assertf("countdown %s current = %d, want %d", countdownID, current, expected); err != nil {
+				return err
+			}
+		}
+	}
+	if expected, ok := step.Args["triggered"].(bool); ok {
+		response, err := r.env.eventClient.ListEvents(ctx, &gamev1.ListEventsRequest{
+			CampaignId: state.campaignID,
+			PageSize:   1,
+			OrderBy:    "seq desc",
+			Filter:     fmt.Sprintf("type = \"%s\" AND entity_id = \"%s\"", daggerheart.EventTypeCountdownTriggered, countdownID),
+		})
+		if err != nil {
+			return fmt.Errorf("list events for %s: %w", daggerheart.EventTypeCountdownTriggered, err)
+		}
+		if triggered := len(response.GetEvents()) > 0; triggered != expected {
+			return r.assertf("countdown %s triggered = %v, want %v", countdownID, triggered, expected)
+		}
+	}
+	return nil
+}
+
+// runChaseStartStep starts a chase and registers its two countdowns by name so
+// countdown_expect and countdown_update can address them.
+func (r *Runner) runChaseStartStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureSession(ctx, state); err != nil {
+		return err
+	}
+	name := requiredString(step.Args, "name")
+	if name == "" {
+		return r.failf("chase_start name is required")
+	}
+	progress, err := buildChaseCountdownSpec(step.Args, "progress")
+	if err != nil {
+		return err
+	}
+	consequence, err := buildChaseCountdownSpec(step.Args, "consequence")
+	if err != nil {
+		return err
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.StartChase(ctx, &daggerheartv1.DaggerheartStartChaseRequest{
+		CampaignId:  state.campaignID,
+		SessionId:   state.sessionID,
+		ChaseId:     optionalString(step.Args, "chase_id", ""),
+		Name:        name,
+		Progress:    progress,
+		Consequence: consequence,
+	})
+	if err != nil {
+		return fmt.Errorf("chase_start: %w", err)
+	}
+	chase := response.GetChase()
+	if chase == nil {
+		return r.failf("expected chase")
+	}
+	state.chases[name] = chase.GetChaseId()
+	for _, countdown := range []*daggerheartv1.DaggerheartCountdown{chase.GetProgress(), chase.GetConsequence()} {
+		if countdown != nil {
+			state.countdowns[countdown.GetName()] = countdown.GetCountdownId()
+		}
+	}
+	r.logf("chase started: name=%s id=%s", name, chase.GetChaseId())
+	return r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeCountdownCreated, daggerheart.EventTypeChaseStarted)
+}
+
+// runChaseExpectStep checks a chase's status, winner and countdown values.
+func (r *Runner) runChaseExpectStep(ctx context.Context, state *scenarioState, step Step) error {
+	chaseID, err := resolveChaseID(state, step.Args)
+	if err != nil {
+		return err
+	}
+	if chaseID == "" {
+		return r.failf("chase_expect chase_id or name is required")
+	}
+	response, err := r.env.daggerheartClient.GetChase(ctx, &daggerheartv1.DaggerheartGetChaseRequest{
+		CampaignId: state.campaignID,
+		ChaseId:    chaseID,
+	})
+	if err != nil {
+		return fmt.Errorf("chase_expect: %w", err)
+	}
+	chase := response.GetChase()
+	if expected, ok := step.Args["status"].(string); ok {
+		if status := chaseStatusName(chase.GetStatus()); status != strings.ToLower(expected) {
+			if err := r.assertf("chase %s status = %s, want %s", chaseID, status, expected); err != nil {
+				return err
+			}
+		}
+	}
+	if expected, ok := step.Args["winner"].(string); ok {
+		if winner := chaseWinnerName(chase.GetWinner()); winner != strings.ToLower(expected) {
+			if err := r.assertf("chase %s winner = %s, want %s", chaseID, winner, expected); err != nil {
+				return err
+			}
+		}
+	}
+	if expected, ok := readInt(step.Args, "progress"); ok {
+		if current := int(chase.GetProgress().GetCurrent()); current != expected {
+			if err := r.assertf("chase %s progress = %d, want %d", chaseID, current, expected); err != nil {
+				return err
+			}
+		}
+	}
+	if expected, ok := readInt(step.Args, "consequence"); ok {
+		if current := int(chase.GetConsequence().GetCurrent()); current != expected {
+			return r.assertf("chase %s consequence = %d, want %d", chaseID, current, expected)
+		}
+	}
+	return nil
+}
+
 func (r *Runner) runCountdownDeleteStep(ctx context.Context, state *scenarioState, step Step) error {
 	if err := r.ensureSession(ctx, state); err != nil {
 		return err
@@ -1715,89 +2055,893 @@ func (r *Runner) runCountdownDeleteStep(ctx context.Context, state *scenarioStat
 	return nil
 }
 
-func (r *Runner) runActionRollStep(ctx context.Context, state *scenarioState, step Step) error {
+func (r *Runner) runSetRangeStep(ctx context.Context, state *scenarioState, step Step) error {
 	if err := r.ensureSession(ctx, state); err != nil {
 		return err
 	}
-	actorName := requiredString(step.Args, "actor")
-	if actorName == "" {
-		return r.failf("action_roll requires actor")
-	}
-	trait := optionalString(step.Args, "trait", "instinct")
-	difficulty := optionalInt(step.Args, "difficulty", 10)
-	seed := uint64(optionalInt(step.Args, "seed", 0))
-	if seed == 0 {
-		seedValue, err := chooseActionSeed(step.Args, difficulty)
-		if err != nil {
-			return err
-		}
-		seed = seedValue
+	from, err := r.sceneEntity(state, requiredString(step.Args, "from"), "set_range")
+	if err != nil {
+		return err
 	}
-
-	before, err := r.latestSeq(ctx, state)
+	to, err := r.sceneEntity(state, requiredString(step.Args, "to"), "set_range")
 	if err != nil {
 		return err
 	}
-	actorIDValue, err := actorID(state, actorName)
+	band, err := parseRangeBand(requiredString(step.Args, "band"))
+	if err != nil {
+		return r.failf("set_range: %v", err)
+	}
+
+	before, err := r.latestSeq(ctx, state)
 	if err != nil {
 		return err
 	}
-	response, err := r.env.daggerheartClient.SessionActionRoll(ctx, &daggerheartv1.SessionActionRollRequest{
-		CampaignId:  state.campaignID,
-		SessionId:   state.sessionID,
-		CharacterId: actorIDValue,
-		Trait:       trait,
-		RollKind:    daggerheartv1.RollKind_ROLL_KIND_ACTION,
-		Difficulty:  int32(difficulty),
-		Modifiers:   buildActionRollModifiers(step.Args, "modifiers"),
-		Rng: &commonv1.RngRequest{
-			Seed:     &seed,
-			RollMode: commonv1.RollMode_REPLAY,
-		},
+	_, err = r.env.daggerheartClient.SetSceneRanges(ctx, &daggerheartv1.DaggerheartSetSceneRangesRequest{
+		CampaignId: state.campaignID,
+		SessionId:  state.sessionID,
+		Ranges:     []*daggerheartv1.DaggerheartSceneRange{{From: from, To: to, Band: band}},
 	})
 	if err != nil {
-		return fmt.Errorf("action_roll: %w", err)
+		return fmt.Errorf("set_range: %w", err)
 	}
-	state.lastRollSeq = response.GetRollSeq()
-	r.logf("action roll: actor=%s roll_seq=%d", actorName, state.lastRollSeq)
-	return r.requireEventTypesAfterSeq(ctx, state, before, event.TypeRollResolved)
+	return r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeSceneRangesSet)
 }
 
-func (r *Runner) runReactionRollStep(ctx context.Context, state *scenarioState, step Step) error {
+func (r *Runner) runMoveStep(ctx context.Context, state *scenarioState, step Step) error {
 	if err := r.ensureSession(ctx, state); err != nil {
 		return err
 	}
-	actorName := requiredString(step.Args, "actor")
-	if actorName == "" {
-		return r.failf("reaction_roll requires actor")
+	entity, err := r.sceneEntity(state, requiredString(step.Args, "entity"), "move")
+	if err != nil {
+		return err
 	}
-	trait := optionalString(step.Args, "trait", "instinct")
-	difficulty := optionalInt(step.Args, "difficulty", 10)
-	seed := uint64(optionalInt(step.Args, "seed", 0))
-	if seed == 0 {
-		seedValue, err := chooseActionSeed(step.Args, difficulty)
+	kind, err := parseMovementKind(optionalString(step.Args, "kind", "move"))
+	if err != nil {
+		return r.failf("move: %v", err)
+	}
+	request := &daggerheartv1.DaggerheartMoveSceneEntityRequest{
+		CampaignId: state.campaignID,
+		SessionId:  state.sessionID,
+		Entity:     entity,
+		Kind:       kind,
+		PushBands:  int32(optionalInt(step.Args, "push_bands", 0)),
+		Reason:     optionalString(step.Args, "reason", ""),
+	}
+	if source := optionalString(step.Args, "source", ""); source != "" {
+		request.Source, err = r.sceneEntity(state, source, "move")
 		if err != nil {
 			return err
 		}
-		seed = seedValue
+	}
+	ranges, _ := step.Args["ranges"].(map[string]any)
+	names := make([]string, 0, len(ranges))
+	for name := range ranges {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		target, err := r.sceneEntity(state, name, "move")
+		if err != nil {
+			return err
+		}
+		value, _ := ranges[name].(string)
+		band, err := parseRangeBand(value)
+		if err != nil {
+			return r.failf("move: %v", err)
+		}
+		request.Ranges = append(request.Ranges, &daggerheartv1.DaggerheartSceneRangeUpdate{Target: target, Band: band})
 	}
 
 	before, err := r.latestSeq(ctx, state)
 	if err != nil {
 		return err
 	}
-	actorIDValue, err := actorID(state, actorName)
+	if _, err := r.env.daggerheartClient.MoveSceneEntity(ctx, request); err != nil {
+		return fmt.Errorf("move: %w", err)
+	}
+	return r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeSceneEntityMoved)
+}
+
+func (r *Runner) runExpectRangeStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureSession(ctx, state); err != nil {
+		return err
+	}
+	from, err := r.sceneEntity(state, requiredString(step.Args, "from"), "expect_range")
 	if err != nil {
 		return err
 	}
-	response, err := r.env.daggerheartClient.SessionActionRoll(ctx, &daggerheartv1.SessionActionRollRequest{
+	to, err := r.sceneEntity(state, requiredString(step.Args, "to"), "expect_range")
+	if err != nil {
+		return err
+	}
+	want, err := parseRangeBand(requiredString(step.Args, "band"))
+	if err != nil {
+		return r.failf("expect_range: %v", err)
+	}
+
+	response, err := r.env.daggerheartClient.ListSceneRanges(ctx, &daggerheartv1.DaggerheartListSceneRangesRequest{
+		CampaignId: state.campaignID,
+		SessionId:  state.sessionID,
+		EntityId:   from.GetId(),
+	})
+	if err != nil {
+		return fmt.Errorf("expect_range: %w", err)
+	}
+	for _, sceneRange := range response.GetRanges() {
+		if sceneRange.GetFrom().GetId() == to.GetId() || sceneRange.GetTo().GetId() == to.GetId() {
+			if sceneRange.GetBand() != want {
+				return r.assertf("range %s to %s = %s, want %s", step.Args["from"], step.Args["to"], sceneRange.GetBand(), want)
+			}
+			return nil
+		}
+	}
+	return r.assertf("range %s to %s is not set", step.Args["from"], step.Args["to"])
+}
+
+func (r *Runner) runEnvironmentStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureSession(ctx, state); err != nil {
+		return err
+	}
+	environmentID := requiredString(step.Args, "id")
+	if environmentID == "" {
+		return r.failf("environment id is required")
+	}
+	request := &daggerheartv1.DaggerheartCreateSessionEnvironmentRequest{
+		CampaignId:    state.campaignID,
+		SessionId:     state.sessionID,
+		EnvironmentId: environmentID,
+	}
+	if difficulty, ok := readInt(step.Args, "difficulty"); ok {
+		request.Difficulty = wrapperspb.Int32(int32(difficulty))
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	if _, err := r.env.daggerheartClient.CreateSessionEnvironment(ctx, request); err != nil {
+		return fmt.Errorf("environment: %w", err)
+	}
+	return r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeEnvironmentActivated)
+}
+
+func (r *Runner) runEnvironmentShiftStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureSession(ctx, state); err != nil {
+		return err
+	}
+	environmentID := requiredString(step.Args, "id")
+	if environmentID == "" {
+		return r.failf("environment_shift id is required")
+	}
+	request := &daggerheartv1.DaggerheartShiftSessionEnvironmentRequest{
+		CampaignId:    state.campaignID,
+		SessionId:     state.sessionID,
+		EnvironmentId: environmentID,
+		Reason:        optionalString(step.Args, "reason", ""),
+	}
+	if difficulty, ok := readInt(step.Args, "difficulty"); ok {
+		request.Difficulty = wrapperspb.Int32(int32(difficulty))
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	if _, err := r.env.daggerheartClient.ShiftSessionEnvironment(ctx, request); err != nil {
+		return fmt.Errorf("environment_shift: %w", err)
+	}
+	return r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeEnvironmentShifted)
+}
+
+func (r *Runner) runEnvironmentClearStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureSession(ctx, state); err != nil {
+		return err
+	}
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	_, err = r.env.daggerheartClient.ClearSessionEnvironment(ctx, &daggerheartv1.DaggerheartClearSessionEnvironmentRequest{
+		CampaignId: state.campaignID,
+		SessionId:  state.sessionID,
+		Reason:     optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		return fmt.Errorf("environment_clear: %w", err)
+	}
+	return r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeEnvironmentCleared)
+}
+
+func (r *Runner) runEnvironmentFeatureStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureSession(ctx, state); err != nil {
+		return err
+	}
+	featureID := requiredString(step.Args, "feature")
+	if featureID == "" {
+		return r.failf("environment_feature feature is required")
+	}
+	kind, err := parseEnvironmentFeatureKind(optionalString(step.Args, "kind", "action"))
+	if err != nil {
+		return r.failf("environment_feature: %v", err)
+	}
+	fear := optionalInt(step.Args, "fear", 0)
+	request := &daggerheartv1.DaggerheartTriggerEnvironmentFeatureRequest{
 		CampaignId:  state.campaignID,
 		SessionId:   state.sessionID,
-		CharacterId: actorIDValue,
-		Trait:       trait,
-		RollKind:    daggerheartv1.RollKind_ROLL_KIND_REACTION,
-		Difficulty:  int32(difficulty),
-		Modifiers:   buildActionRollModifiers(step.Args, "modifiers"),
+		FeatureId:   featureID,
+		Kind:        kind,
+		FearCost:    int32(fear),
+		Description: optionalString(step.Args, "description", ""),
+	}
+	if spawnsRaw, ok := step.Args["spawns"]; ok {
+		spawnList, ok := spawnsRaw.([]any)
+		if !ok {
+			return r.failf("environment_feature spawns must be a list")
+		}
+		for index, entry := range spawnList {
+			item, ok := entry.(map[string]any)
+			if !ok {
+				return r.failf("environment_feature spawn %d must be an object", index)
+			}
+			entryID := requiredString(item, "entry")
+			if entryID == "" {
+				return r.failf("environment_feature spawn %d requires entry", index)
+			}
+			request.Spawns = append(request.Spawns, &daggerheartv1.DaggerheartEnvironmentSpawn{
+				AdversaryEntryId: entryID,
+				Name:             optionalString(item, "name", ""),
+				Count:            int32(optionalInt(item, "count", 1)),
+			})
+		}
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.TriggerEnvironmentFeature(ctx, request)
+	if err != nil {
+		return fmt.Errorf("environment_feature: %w", err)
+	}
+	if fear > 0 {
+		if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeGMFearChanged, daggerheart.EventTypeEnvironmentFeatureUsed); err != nil {
+			return err
+		}
+	} else {
+		if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeEnvironmentFeatureUsed); err != nil {
+			return err
+		}
+	}
+	if len(request.Spawns) > 0 {
+		if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeAdversaryCreated); err != nil {
+			return err
+		}
+	}
+	for _, adversary := range response.GetAdversaries() {
+		state.adversaries[adversary.GetName()] = adversary.GetId()
+	}
+	state.gmFear = int(response.GetGmFearAfter())
+	return nil
+}
+
+func (r *Runner) runAcquireItemStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	characterID, err := actorID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+	itemID := requiredString(step.Args, "item")
+	if itemID == "" {
+		return r.failf("acquire_item item is required")
+	}
+	kind, err := parseInventoryItemKind(optionalString(step.Args, "kind", "item"))
+	if err != nil {
+		return r.failf("acquire_item: %v", err)
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	_, err = r.env.daggerheartClient.AcquireItem(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartAcquireItemRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: characterID,
+		ItemId:      itemID,
+		Kind:        kind,
+		Quantity:    int32(optionalInt(step.Args, "quantity", 1)),
+		Source:      optionalString(step.Args, "source", ""),
+	})
+	if err != nil {
+		return fmt.Errorf("acquire_item: %w", err)
+	}
+	return r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeItemAcquired)
+}
+
+func (r *Runner) runDropItemStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	characterID, err := actorID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+	itemID := requiredString(step.Args, "item")
+	if itemID == "" {
+		return r.failf("drop_item item is required")
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	_, err = r.env.daggerheartClient.DropItem(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartDropItemRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: characterID,
+		ItemId:      itemID,
+		Quantity:    int32(optionalInt(step.Args, "quantity", 1)),
+		Reason:      optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		return fmt.Errorf("drop_item: %w", err)
+	}
+	return r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeItemDropped)
+}
+
+func (r *Runner) runTransferItemStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	fromID, err := actorID(state, requiredString(step.Args, "from"))
+	if err != nil {
+		return err
+	}
+	toID, err := actorID(state, requiredString(step.Args, "to"))
+	if err != nil {
+		return err
+	}
+	itemID := requiredString(step.Args, "item")
+	if itemID == "" {
+		return r.failf("transfer_item item is required")
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	_, err = r.env.daggerheartClient.TransferItem(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartTransferItemRequest{
+		CampaignId:      state.campaignID,
+		FromCharacterId: fromID,
+		ToCharacterId:   toID,
+		ItemId:          itemID,
+		Quantity:        int32(optionalInt(step.Args, "quantity", 1)),
+	})
+	if err != nil {
+		return fmt.Errorf("transfer_item: %w", err)
+	}
+	return r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeItemTransferred)
+}
+
+func (r *Runner) runEquipStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	characterID, err := actorID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+	itemID := requiredString(step.Args, "item")
+	if itemID == "" {
+		return r.failf("equip item is required")
+	}
+	slot := daggerheartv1.DaggerheartEquipSlot_DAGGERHEART_EQUIP_SLOT_UNSPECIFIED
+	if value := optionalString(step.Args, "slot", ""); value != "" {
+		slot, err = parseEquipSlot(value)
+		if err != nil {
+			return r.failf("equip: %v", err)
+		}
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.EquipItem(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartEquipItemRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: characterID,
+		ItemId:      itemID,
+		Slot:        slot,
+	})
+	if err != nil {
+		return fmt.Errorf("equip: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeItemEquipped); err != nil {
+		return err
+	}
+	return r.assertEquipmentProfile(response.GetProfile(), step.Args)
+}
+
+func (r *Runner) runUnequipStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	characterID, err := actorID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+	slot, err := parseEquipSlot(requiredString(step.Args, "slot"))
+	if err != nil {
+		return r.failf("unequip: %v", err)
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.UnequipItem(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartUnequipItemRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: characterID,
+		Slot:        slot,
+		Reason:      optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		return fmt.Errorf("unequip: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeItemUnequipped); err != nil {
+		return err
+	}
+	return r.assertEquipmentProfile(response.GetProfile(), step.Args)
+}
+
+func (r *Runner) runGoldStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	characterID, err := actorID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.UpdateGold(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartUpdateGoldRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: characterID,
+		Handfuls:    int32(optionalInt(step.Args, "handfuls", 0)),
+		Bags:        int32(optionalInt(step.Args, "bags", 0)),
+		Chests:      int32(optionalInt(step.Args, "chests", 0)),
+		Reason:      optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		return fmt.Errorf("gold: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeGoldChanged); err != nil {
+		return err
+	}
+	gold := response.GetState().GetGold()
+	for _, check := range []struct {
+		key string
+		got int32
+	}{
+		{"expect_handfuls", gold.GetHandfuls()},
+		{"expect_bags", gold.GetBags()},
+		{"expect_chests", gold.GetChests()},
+	} {
+		if want, ok := readInt(step.Args, check.key); ok && int(check.got) != want {
+			if err := r.assertf("gold %s = %d, want %d", check.key, check.got, want); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Runner) runCompanionStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	characterID, err := actorID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.CreateCompanion(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartCreateCompanionRequest{
+		CampaignId:    state.campaignID,
+		CharacterId:   characterID,
+		Name:          requiredString(step.Args, "name"),
+		ExperienceIds: readStringSlice(step.Args, "experiences"),
+	})
+	if err != nil {
+		return fmt.Errorf("companion: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeCompanionCreated); err != nil {
+		return err
+	}
+	return r.assertCompanion(response.GetCompanion(), step.Args)
+}
+
+func (r *Runner) runCompanionLevelUpStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	characterID, err := actorID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+	upgrade, err := parseCompanionUpgrade(requiredString(step.Args, "upgrade"))
+	if err != nil {
+		return r.failf("companion_level_up: %v", err)
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.LevelUpCompanion(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartLevelUpCompanionRequest{
+		CampaignId:   state.campaignID,
+		CharacterId:  characterID,
+		Upgrade:      upgrade,
+		ExperienceId: optionalString(step.Args, "experience", ""),
+		ViciousRange: optionalBool(step.Args, "vicious_range", false),
+	})
+	if err != nil {
+		return fmt.Errorf("companion_level_up: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeCompanionLeveledUp); err != nil {
+		return err
+	}
+	return r.assertCompanion(response.GetCompanion(), step.Args)
+}
+
+func (r *Runner) runCompanionStressStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	characterID, err := actorID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.UpdateCompanionStress(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartUpdateCompanionStressRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: characterID,
+		Delta:       int32(optionalInt(step.Args, "delta", 1)),
+		Reason:      optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		return fmt.Errorf("companion_stress: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeCompanionStressChanged); err != nil {
+		return err
+	}
+	return r.assertCompanion(response.GetCompanion(), step.Args)
+}
+
+func (r *Runner) runCompanionAttackStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureSession(ctx, state); err != nil {
+		return err
+	}
+	actorName := requiredString(step.Args, "actor")
+	if actorName == "" {
+		return r.failf("companion_attack requires actor")
+	}
+	characterID, err := actorID(state, actorName)
+	if err != nil {
+		return err
+	}
+	targetName := requiredString(step.Args, "target")
+	targetID, _, err := resolveTargetID(state, targetName)
+	if err != nil {
+		return err
+	}
+	target := &daggerheartv1.MultiAttackTarget{TargetId: targetID}
+	if difficulty, ok := readInt(step.Args, "difficulty"); ok {
+		target.Difficulty = wrapperspb.Int32(int32(difficulty))
+	}
+
+	actionSeed, err := chooseActionSeed(step.Args, optionalInt(step.Args, "difficulty", 10))
+	if err != nil {
+		return err
+	}
+	damageSeed := actionSeed + 1
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.SessionCompanionAttackFlow(ctx, &daggerheartv1.SessionCompanionAttackFlowRequest{
+		CampaignId:     state.campaignID,
+		SessionId:      state.sessionID,
+		CharacterId:    characterID,
+		Trait:          optionalString(step.Args, "trait", ""),
+		Target:         target,
+		Modifiers:      buildActionRollModifiers(step.Args, "modifiers"),
+		DamageModifier: int32(optionalInt(step.Args, "damage_modifier", 0)),
+		ActionRng: &commonv1.RngRequest{
+			Seed:     &actionSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+		DamageRng: &commonv1.RngRequest{
+			Seed:     &damageSeed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("companion_attack: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeMultiAttackResolved); err != nil {
+		return err
+	}
+	if want, ok := readBool(step.Args, "expect_hit"); ok && response.GetResult().GetHit() != want {
+		if err := r.assertf("companion_attack hit = %t, want %t", response.GetResult().GetHit(), want); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Runner) runBeastformStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	characterID, err := actorID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.EnterBeastform(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartEnterBeastformRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: characterID,
+		BeastformId: requiredString(step.Args, "form"),
+	})
+	if err != nil {
+		return fmt.Errorf("beastform: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeBeastformEntered); err != nil {
+		return err
+	}
+	if want, ok := readInt(step.Args, "expect_stress"); ok && int(response.GetState().GetStress()) != want {
+		if err := r.assertf("beastform stress = %d, want %d", response.GetState().GetStress(), want); err != nil {
+			return err
+		}
+	}
+	if want, ok := readInt(step.Args, "expect_evasion"); ok && int(response.GetEvasion()) != want {
+		if err := r.assertf("beastform evasion = %d, want %d", response.GetEvasion(), want); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Runner) runBeastformExitStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	characterID, err := actorID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	_, err = r.env.daggerheartClient.ExitBeastform(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartExitBeastformRequest{
+		CampaignId:  state.campaignID,
+		CharacterId: characterID,
+		Reason:      optionalString(step.Args, "reason", ""),
+	})
+	if err != nil {
+		return fmt.Errorf("beastform_exit: %w", err)
+	}
+	return r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeBeastformExited)
+}
+
+func (r *Runner) runEffectStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	targetID, isAdversary, err := resolveTargetID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+	targetType := daggerheartv1.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_CHARACTER
+	if isAdversary {
+		targetType = daggerheartv1.DaggerheartEffectTargetType_DAGGERHEART_EFFECT_TARGET_TYPE_ADVERSARY
+	}
+	kind, err := parseEffectKind(requiredString(step.Args, "kind"))
+	if err != nil {
+		return err
+	}
+	stacking, err := parseEffectStacking(optionalString(step.Args, "stacking", "stack"))
+	if err != nil {
+		return err
+	}
+	expiry, err := parseEffectExpiry(optionalString(step.Args, "expires_on", "manual"))
+	if err != nil {
+		return err
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.ApplyEffect(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartApplyEffectRequest{
+		CampaignId: state.campaignID,
+		EffectId:   optionalString(step.Args, "id", ""),
+		TargetType: targetType,
+		TargetId:   targetID,
+		Source:     optionalString(step.Args, "source", ""),
+		Kind:       kind,
+		Value:      int32(optionalInt(step.Args, "value", 1)),
+		Stacking:   stacking,
+		ExpiresOn:  expiry,
+	})
+	if err != nil {
+		return fmt.Errorf("effect: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeEffectApplied); err != nil {
+		return err
+	}
+	if want, ok := readInt(step.Args, "expect_replaced"); ok && len(response.GetReplacedEffectIds()) != want {
+		if err := r.assertf("effect replaced = %d, want %d", len(response.GetReplacedEffectIds()), want); err != nil {
+			return err
+		}
+	}
+	return r.assertEffectArmor(ctx, state, step, targetID)
+}
+
+func (r *Runner) runEffectRemoveStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureCampaign(state); err != nil {
+		return err
+	}
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	_, err = r.env.daggerheartClient.RemoveEffect(withSessionID(ctx, state.sessionID), &daggerheartv1.DaggerheartRemoveEffectRequest{
+		CampaignId: state.campaignID,
+		EffectId:   requiredString(step.Args, "id"),
+	})
+	if err != nil {
+		return fmt.Errorf("effect_remove: %w", err)
+	}
+	if err := r.requireEventTypesAfterSeq(ctx, state, before, daggerheart.EventTypeEffectExpired); err != nil {
+		return err
+	}
+	if _, ok := readInt(step.Args, "expect_armor"); !ok {
+		return nil
+	}
+	characterID, err := actorID(state, requiredString(step.Args, "target"))
+	if err != nil {
+		return err
+	}
+	return r.assertEffectArmor(ctx, state, step, characterID)
+}
+
+// assertEffectArmor checks expect_armor against a character's Armor Slots.
+func (r *Runner) assertEffectArmor(ctx context.Context, state *scenarioState, step Step, characterID string) error {
+	want, ok := readInt(step.Args, "expect_armor")
+	if !ok {
+		return nil
+	}
+	current, err := r.getCharacterState(ctx, state, characterID)
+	if err != nil {
+		return err
+	}
+	if int(current.GetArmor()) != want {
+		return r.assertf("effect armor = %d, want %d", current.GetArmor(), want)
+	}
+	return nil
+}
+
+func (r *Runner) runActionRollStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureSession(ctx, state); err != nil {
+		return err
+	}
+	actorName := requiredString(step.Args, "actor")
+	if actorName == "" {
+		return r.failf("action_roll requires actor")
+	}
+	trait := optionalString(step.Args, "trait", "instinct")
+	difficulty := optionalInt(step.Args, "difficulty", 10)
+	seed := uint64(optionalInt(step.Args, "seed", 0))
+	if seed == 0 {
+		seedValue, err := chooseActionSeed(step.Args, difficulty)
+		if err != nil {
+			return err
+		}
+		seed = seedValue
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	actorIDValue, err := actorID(state, actorName)
+	if err != nil {
+		return err
+	}
+	helperIDs, err := resolveCharacterList(state, step.Args, "helpers")
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.SessionActionRoll(ctx, &daggerheartv1.SessionActionRollRequest{
+		CampaignId:         state.campaignID,
+		SessionId:          state.sessionID,
+		CharacterId:        actorIDValue,
+		Trait:              trait,
+		RollKind:           daggerheartv1.RollKind_ROLL_KIND_ACTION,
+		Difficulty:         int32(difficulty),
+		Modifiers:          buildActionRollModifiers(step.Args, "modifiers"),
+		AdvantageSources:   buildAdvantageSources(step.Args, "advantage_sources"),
+		HelperCharacterIds: helperIDs,
+		Experiences:        readStringSlice(step.Args, "experiences"),
+		ExtraFearDie:       optionalBool(step.Args, "extra_fear_die", false),
+		Rng: &commonv1.RngRequest{
+			Seed:     &seed,
+			RollMode: commonv1.RollMode_REPLAY,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("action_roll: %w", err)
+	}
+	state.lastRollSeq = response.GetRollSeq()
+	r.logf("action roll: actor=%s roll_seq=%d", actorName, state.lastRollSeq)
+	return r.requireEventTypesAfterSeq(ctx, state, before, event.TypeRollResolved)
+}
+
+func (r *Runner) runReactionRollStep(ctx context.Context, state *scenarioState, step Step) error {
+	if err := r.ensureSession(ctx, state); err != nil {
+		return err
+	}
+	actorName := requiredString(step.Args, "actor")
+	if actorName == "" {
+		return r.failf("reaction_roll requires actor")
+	}
+	trait := optionalString(step.Args, "trait", "instinct")
+	difficulty := optionalInt(step.Args, "difficulty", 10)
+	seed := uint64(optionalInt(step.Args, "seed", 0))
+	if seed == 0 {
+		seedValue, err := chooseActionSeed(step.Args, difficulty)
+		if err != nil {
+			return err
+		}
+		seed = seedValue
+	}
+
+	before, err := r.latestSeq(ctx, state)
+	if err != nil {
+		return err
+	}
+	actorIDValue, err := actorID(state, actorName)
+	if err != nil {
+		return err
+	}
+	response, err := r.env.daggerheartClient.SessionActionRoll(ctx, &daggerheartv1.SessionActionRollRequest{
+		CampaignId:       state.campaignID,
+		SessionId:        state.sessionID,
+		CharacterId:      actorIDValue,
+		Trait:            trait,
+		RollKind:         daggerheartv1.RollKind_ROLL_KIND_REACTION,
+		Difficulty:       int32(difficulty),
+		Modifiers:        buildActionRollModifiers(step.Args, "modifiers"),
+		AdvantageSources: buildAdvantageSources(step.Args, "advantage_sources"),
 		Rng: &commonv1.RngRequest{
 			Seed:     &seed,
 			RollMode: commonv1.RollMode_REPLAY,
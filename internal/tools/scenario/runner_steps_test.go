@@ -96,6 +96,7 @@ func testState() *scenarioState {
 		actors:             map[string]string{},
 		adversaries:        map[string]string{},
 		countdowns:         map[string]string{},
+		chases:             map[string]string{},
 		participants:       map[string]string{},
 	}
 }
@@ -666,6 +667,29 @@ func TestRunAdversaryStep(t *testing.T) {
 	}
 }
 
+func TestRunAdversaryStepFromCatalog(t *testing.T) {
+	env, _, _, dhClient := testEnv()
+	var gotEntry string
+	dhClient.createAdversary = func(_ context.Context, req *daggerheartv1.DaggerheartCreateAdversaryRequest, _ ...grpc.CallOption) (*daggerheartv1.DaggerheartCreateAdversaryResponse, error) {
+		gotEntry = req.GetAdversaryEntryId()
+		return &daggerheartv1.DaggerheartCreateAdversaryResponse{
+			Adversary: &daggerheartv1.DaggerheartAdversary{Id: "adv-1"},
+		}, nil
+	}
+	runner := quietRunner(env)
+	state := testState()
+	err := runner.runAdversaryStep(context.Background(), state, Step{
+		Kind: "adversary",
+		Args: map[string]any{"name": "Wizard", "entry": "adversary.war-wizard"},
+	})
+	if err != nil {
+		t.Fatalf("runAdversaryStep: %v", err)
+	}
+	if gotEntry != "adversary.war-wizard" {
+		t.Fatalf("adversary entry id = %q, want adversary.war-wizard", gotEntry)
+	}
+}
+
 func TestRunAdversaryStepMissingName(t *testing.T) {
 	env, _, _, _ := testEnv()
 	runner := quietRunner(env)
@@ -784,6 +808,73 @@ func TestRunCountdownDeleteStep(t *testing.T) {
 	}
 }
 
+func TestRunChaseStartStep(t *testing.T) {
+	env, _, _, dhClient := testEnv()
+	var captured *daggerheartv1.DaggerheartStartChaseRequest
+	dhClient.startChase = func(_ context.Context, req *daggerheartv1.DaggerheartStartChaseRequest, _ ...grpc.CallOption) (*daggerheartv1.DaggerheartStartChaseResponse, error) {
+		captured = req
+		return &daggerheartv1.DaggerheartStartChaseResponse{
+			Chase: &daggerheartv1.DaggerheartChase{
+				ChaseId:     "chase-1",
+				Progress:    &daggerheartv1.DaggerheartCountdown{CountdownId: "cd-p", Name: "Pursuit"},
+				Consequence: &daggerheartv1.DaggerheartCountdown{CountdownId: "cd-c", Name: "Escape"},
+			},
+		}, nil
+	}
+	runner := quietRunner(env)
+	state := testState()
+	err := runner.runChaseStartStep(context.Background(), state, Step{
+		Kind: "chase_start",
+		Args: map[string]any{
+			"name":              "Heist",
+			"progress_max":      6,
+			"progress_outcomes": map[string]any{"failure_with_hope": 1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("runChaseStartStep: %v", err)
+	}
+	if captured.GetProgress().GetMax() != 6 || captured.GetConsequence().GetMax() != 4 {
+		t.Fatalf("request = %+v", captured)
+	}
+	if deltas := captured.GetProgress().GetOutcomeDeltas(); len(deltas) != 1 || deltas[0].GetOutcome() != daggerheartv1.Outcome_FAILURE_WITH_HOPE {
+		t.Fatalf("progress outcome deltas = %+v", deltas)
+	}
+	if state.chases["Heist"] != "chase-1" || state.countdowns["Pursuit"] != "cd-p" || state.countdowns["Escape"] != "cd-c" {
+		t.Fatalf("state chases = %v countdowns = %v", state.chases, state.countdowns)
+	}
+}
+
+func TestRunChaseExpectStep(t *testing.T) {
+	env, _, _, dhClient := testEnv()
+	dhClient.getChase = func(_ context.Context, _ *daggerheartv1.DaggerheartGetChaseRequest, _ ...grpc.CallOption) (*daggerheartv1.DaggerheartGetChaseResponse, error) {
+		return &daggerheartv1.DaggerheartGetChaseResponse{
+			Chase: &daggerheartv1.DaggerheartChase{
+				ChaseId: "chase-1",
+				Status:  daggerheartv1.DaggerheartChaseStatus_DAGGERHEART_CHASE_STATUS_RESOLVED,
+				Winner:  daggerheartv1.DaggerheartChaseWinner_DAGGERHEART_CHASE_WINNER_PROGRESS,
+			},
+		}, nil
+	}
+	runner := quietRunner(env)
+	state := testState()
+	state.chases["Heist"] = "chase-1"
+	err := runner.runChaseExpectStep(context.Background(), state, Step{
+		Kind: "chase_expect",
+		Args: map[string]any{"name": "Heist", "status": "resolved", "winner": "progress"},
+	})
+	if err != nil {
+		t.Fatalf("runChaseExpectStep: %v", err)
+	}
+	err = runner.runChaseExpectStep(context.Background(), state, Step{
+		Kind: "chase_expect",
+		Args: map[string]any{"name": "Heist", "winner": "consequence"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "winner") {
+		t.Fatalf("expected winner mismatch, got %v", err)
+	}
+}
+
 // --- mitigate_damage step ---
 
 func TestRunMitigateDamageStep(t *testing.T) {
@@ -842,6 +933,37 @@ func TestRunActionRollStep(t *testing.T) {
 	}
 }
 
+func TestRunActionRollStepHelpersAndExperiences(t *testing.T) {
+	env, _, _, dhClient := testEnv()
+	var captured *daggerheartv1.SessionActionRollRequest
+	dhClient.sessionActionRoll = func(_ context.Context, req *daggerheartv1.SessionActionRollRequest, _ ...grpc.CallOption) (*daggerheartv1.SessionActionRollResponse, error) {
+		captured = req
+		return &daggerheartv1.SessionActionRollResponse{RollSeq: 42}, nil
+	}
+	runner := quietRunner(env)
+	state := testState()
+	state.actors["Frodo"] = "char-frodo"
+	state.actors["Sam"] = "char-sam"
+	err := runner.runActionRollStep(context.Background(), state, Step{
+		Kind: "action_roll",
+		Args: map[string]any{
+			"actor":       "Frodo",
+			"seed":        1,
+			"helpers":     []any{"Sam"},
+			"experiences": []any{"Burglar"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("runActionRollStep: %v", err)
+	}
+	if len(captured.GetHelperCharacterIds()) != 1 || captured.GetHelperCharacterIds()[0] != "char-sam" {
+		t.Fatalf("helpers = %v", captured.GetHelperCharacterIds())
+	}
+	if len(captured.GetExperiences()) != 1 || captured.GetExperiences()[0] != "Burglar" {
+		t.Fatalf("experiences = %v", captured.GetExperiences())
+	}
+}
+
 func TestRunActionRollStepMissingActor(t *testing.T) {
 	env, _, _, _ := testEnv()
 	runner := quietRunner(env)
@@ -24,6 +24,7 @@ type scenarioState struct {
 	participants         map[string]string
 	adversaries          map[string]string
 	countdowns           map[string]string
+	chases               map[string]string
 	gmFear               int
 	userID               string
 	lastRollSeq          uint64